@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/cachemanager"
+	"github.com/zjrosen/perles/internal/paths"
+)
+
+var (
+	archiveOlderThan string
+	archiveEpic      string
+	archiveDryRun    bool
+)
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive [issue-id...]",
+	Short: "Archive issues so they leave default views and search",
+	Long: `Archive issues by ID, by age or by epic. Archived issues keep their status
+but are hidden from views and search unless perles runs with --include-archived
+or a query filters on "label = archived". Orchestration refuses to assign
+archived tasks. Use 'perles restore' to bring issues back.
+
+Examples:
+  # Archive specific issues
+  perles archive bd-12 bd-13
+
+  # Archive issues closed more than 30 days ago
+  perles archive --older-than 30d
+
+  # Archive an epic and all of its descendants
+  perles archive --epic bd-7
+
+  # Preview which closed tasks of an epic would be archived
+  perles archive --epic bd-7 --older-than 2w --dry-run`,
+	SilenceUsage: true,
+	RunE:         runArchive,
+}
+
+var restoreCmd = &cobra.Command{
+	Use:          "restore <issue-id>...",
+	Short:        "Restore archived issues",
+	Long:         `Restore archived issues so they show up in views and search again.`,
+	Args:         cobra.MinimumNArgs(1),
+	SilenceUsage: true,
+	RunE:         runRestore,
+}
+
+func init() {
+	archiveCmd.Flags().StringVar(&archiveOlderThan, "older-than", "",
+		"archive issues closed longer ago than this, e.g. 30d, 2w or 36h")
+	archiveCmd.Flags().StringVar(&archiveEpic, "epic", "",
+		"archive this epic and all of its descendants")
+	archiveCmd.Flags().BoolVar(&archiveDryRun, "dry-run", false,
+		"list the issues that would be archived without archiving them")
+	rootCmd.AddCommand(archiveCmd)
+	rootCmd.AddCommand(restoreCmd)
+}
+
+func runArchive(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 && archiveOlderThan == "" && archiveEpic == "" {
+		return cmd.Help()
+	}
+	if len(args) > 0 && (archiveOlderThan != "" || archiveEpic != "") {
+		return fmt.Errorf("issue IDs can't be combined with --older-than or --epic")
+	}
+
+	var filter beads.ArchiveFilter
+	if archiveOlderThan != "" {
+		age, err := parseAge(archiveOlderThan)
+		if err != nil {
+			return err
+		}
+		filter.ClosedBefore = time.Now().Add(-age)
+	}
+
+	query := "status = closed"
+	switch {
+	case len(args) > 0:
+		query = bql.BuildIDQuery(args)
+	case archiveEpic != "":
+		query = fmt.Sprintf("id = %q expand down depth *", archiveEpic)
+	}
+
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	issues, err := loadIssues(beadsDir, query)
+	if err != nil {
+		return err
+	}
+	if archiveEpic != "" && len(issues) == 0 {
+		return fmt.Errorf("epic not found: %s", archiveEpic)
+	}
+
+	selected := beads.SelectArchivable(issues, filter)
+	if len(selected) == 0 {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "No issues to archive")
+		return nil
+	}
+
+	ids := make([]string, len(selected))
+	for i, issue := range selected {
+		ids[i] = issue.ID
+	}
+	if !archiveDryRun {
+		if err := infrabeads.NewBDExecutor(workDir, beadsDir).ArchiveIssues(ids); err != nil {
+			return fmt.Errorf("archiving issues: %w", err)
+		}
+	}
+	printArchived(cmd.OutOrStdout(), selected, archiveDryRun)
+	return nil
+}
+
+func runRestore(cmd *cobra.Command, args []string) error {
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	if err := infrabeads.NewBDExecutor(workDir, beadsDir).RestoreIssues(args); err != nil {
+		return fmt.Errorf("restoring issues: %w", err)
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Restored %d issue(s): %s\n", len(args), strings.Join(args, ", "))
+	return nil
+}
+
+// resolveArchiveBeadsDir resolves the beads directory the same way as the daemon:
+// BEADS_DIR, then the beads_dir config setting, then the working directory.
+func resolveArchiveBeadsDir() (beadsDir, workDir string, err error) {
+	workDir, err = os.Getwd()
+	if err != nil {
+		return "", "", fmt.Errorf("getting working directory: %w", err)
+	}
+	dbPath := workDir
+	if envDir := os.Getenv("BEADS_DIR"); envDir != "" {
+		dbPath = envDir
+	} else if cfg.BeadsDir != "" {
+		dbPath = cfg.BeadsDir
+	}
+	return paths.ResolveBeadsDir(dbPath), workDir, nil
+}
+
+// loadIssues runs a BQL query against the beads database without caching.
+func loadIssues(beadsDir, query string) ([]beads.Issue, error) {
+	client, err := infrabeads.NewSQLiteClient(beadsDir)
+	if err != nil {
+		return nil, fmt.Errorf("opening beads database: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	executor := bql.NewExecutor(
+		client.DB(),
+		cachemanager.NewInMemoryCacheManager[string, []beads.Issue]("archive-bql-cache", 0, 0),
+		cachemanager.NewInMemoryCacheManager[string, *bql.DependencyGraph]("archive-dep-cache", 0, 0),
+	)
+	return executor.Execute(query)
+}
+
+// printArchived lists archived issues, one per line.
+func printArchived(w io.Writer, issues []beads.Issue, dryRun bool) {
+	verb := "Archived"
+	if dryRun {
+		verb = "Would archive"
+	}
+	_, _ = fmt.Fprintf(w, "%s %d issue(s):\n", verb, len(issues))
+	for _, issue := range issues {
+		_, _ = fmt.Fprintf(w, "  %s [%s] %s\n", issue.ID, issue.Status, issue.TitleText)
+	}
+}
+
+// parseAge parses an age like 30d or 2w, or any time.ParseDuration value.
+func parseAge(s string) (time.Duration, error) {
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(s, suffix); ok {
+			days, err := strconv.Atoi(n)
+			if err != nil || days <= 0 {
+				return 0, fmt.Errorf("invalid age %q: expected e.g. 30d, 2w or 36h", s)
+			}
+			return time.Duration(days) * unit, nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid age %q: expected e.g. 30d, 2w or 36h", s)
+	}
+	return d, nil
+}
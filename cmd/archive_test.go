@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+func TestArchiveCommands_Registration(t *testing.T) {
+	names := make(map[string]bool)
+	for _, cmd := range rootCmd.Commands() {
+		names[cmd.Name()] = true
+	}
+	require.True(t, names["archive"])
+	require.True(t, names["restore"])
+	require.NotNil(t, rootCmd.Flags().Lookup("include-archived"))
+}
+
+func TestParseAge(t *testing.T) {
+	for input, want := range map[string]time.Duration{
+		"30d": 30 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+		"36h": 36 * time.Hour,
+	} {
+		got, err := parseAge(input)
+		require.NoError(t, err, input)
+		require.Equal(t, want, got, input)
+	}
+
+	for _, input := range []string{"", "d", "-3d", "soon", "0h"} {
+		_, err := parseAge(input)
+		require.Error(t, err, input)
+	}
+}
+
+func TestPrintArchived(t *testing.T) {
+	issues := []beads.Issue{{ID: "bd-1", Status: beads.StatusClosed, TitleText: "Old task"}}
+
+	var buf bytes.Buffer
+	printArchived(&buf, issues, true)
+	require.Equal(t, "Would archive 1 issue(s):\n  bd-1 [closed] Old task\n", buf.String())
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zjrosen/perles/internal/orchestration/audit"
+)
+
+var (
+	auditActions []string
+	auditProcess string
+	auditSince   string
+	auditUntil   string
+	auditFailed  bool
+	auditJSON    bool
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit <session-id|session-dir>",
+	Short: "Query the audit log of the coordinator's decisions in a session",
+	Long: `Print the audit log of an orchestration session: every worker the
+coordinator spawned, replaced, retired or stopped, every task it assigned,
+approved or failed, with the arguments, the result and the process that made
+the call. Rotated logs are included, oldest first.
+
+The session can be given as a full session ID, a unique ID prefix, or the path
+to the session directory.
+
+Examples:
+  # Everything the coordinator decided
+  perles audit 3f2a
+
+  # Failed task assignments of the last two hours as JSONL
+  perles audit 3f2a --action assign_task --failed --since 2h --json
+
+  # Commit approvals before a point in time
+  perles audit 3f2a --action approve_commit --until 2026-01-02T15:04:05Z`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runAudit,
+}
+
+func init() {
+	auditCmd.Flags().StringSliceVar(&auditActions, "action", nil,
+		"only show these actions, e.g. spawn_worker,approve_commit (repeatable)")
+	auditCmd.Flags().StringVar(&auditProcess, "process", "",
+		"only show calls made by this process, e.g. coordinator")
+	auditCmd.Flags().StringVar(&auditSince, "since", "",
+		"only show entries newer than an age (30m, 2d) or an RFC 3339 time")
+	auditCmd.Flags().StringVar(&auditUntil, "until", "",
+		"only show entries older than an age (30m, 2d) or an RFC 3339 time")
+	auditCmd.Flags().BoolVar(&auditFailed, "failed", false,
+		"only show calls that failed")
+	auditCmd.Flags().BoolVar(&auditJSON, "json", false,
+		"print matching entries as JSONL")
+	rootCmd.AddCommand(auditCmd)
+}
+
+func runAudit(cmd *cobra.Command, args []string) error {
+	filter := audit.Filter{
+		Actions:   auditActions,
+		ProcessID: auditProcess,
+		Failed:    auditFailed,
+	}
+	var err error
+	if filter.Since, err = parseAuditTime(auditSince, time.Now()); err != nil {
+		return fmt.Errorf("--since: %w", err)
+	}
+	if filter.Until, err = parseAuditTime(auditUntil, time.Now()); err != nil {
+		return fmt.Errorf("--until: %w", err)
+	}
+
+	dir, err := resolveSessionDir(args[0])
+	if err != nil {
+		return err
+	}
+	entries, err := audit.Load(dir, filter)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	if auditJSON {
+		enc := json.NewEncoder(out)
+		for _, entry := range entries {
+			if err := enc.Encode(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintln(out, "No audit entries")
+		return nil
+	}
+	return audit.Format(out, entries)
+}
+
+// parseAuditTime parses an RFC 3339 time, or an age like 2h or 30d before now.
+// An empty value is the zero time.
+func parseAuditTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	age, err := parseAge(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected an age like 2h or 30d, or an RFC 3339 time", s)
+	}
+	return now.Add(-age), nil
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/audit"
+)
+
+func TestParseAuditTime(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	got, err := parseAuditTime("", now)
+	require.NoError(t, err)
+	require.True(t, got.IsZero())
+
+	got, err = parseAuditTime("2h", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-2*time.Hour), got)
+
+	got, err = parseAuditTime("2026-01-01T10:00:00Z", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), got)
+
+	_, err = parseAuditTime("yesterday", now)
+	require.Error(t, err)
+}
+
+func TestRunAudit(t *testing.T) {
+	dir := t.TempDir()
+	log, err := audit.Open(dir, audit.Config{})
+	require.NoError(t, err)
+	require.NoError(t, log.Record(audit.Entry{Timestamp: time.Now(), Action: "spawn_worker", ProcessID: "coordinator", Success: true, Result: "worker-1 spawned"}))
+	require.NoError(t, log.Record(audit.Entry{Timestamp: time.Now(), Action: "mark_task_failed", ProcessID: "coordinator", Success: true}))
+	require.NoError(t, log.Close())
+
+	t.Cleanup(func() { auditActions, auditJSON = nil, false })
+	auditActions = []string{"spawn_worker"}
+	auditJSON = true
+
+	var out bytes.Buffer
+	auditCmd.SetOut(&out)
+	require.NoError(t, runAudit(auditCmd, []string{dir}))
+	require.Contains(t, out.String(), `"action":"spawn_worker"`)
+	require.NotContains(t, out.String(), "mark_task_failed")
+}
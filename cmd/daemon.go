@@ -17,9 +17,13 @@ import (
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	infragit "github.com/zjrosen/perles/internal/git/infrastructure"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/notify"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+	"github.com/zjrosen/perles/internal/orchestration/mcp"
 	"github.com/zjrosen/perles/internal/orchestration/session"
+	"github.com/zjrosen/perles/internal/orchestration/tracing"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/paths"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
@@ -45,6 +49,8 @@ for workflow management. Other tools can connect to manage workflows.
 
 The daemon listens on localhost with the specified port and provides REST
 endpoints for creating, starting, stopping, and monitoring workflows.
+When orchestration.api_token is set, every request except health checks must
+present it as an "Authorization: Bearer <token>" header.
 
 Example:
   perles daemon                # Start on auto-assigned port
@@ -64,22 +70,40 @@ func init() {
 }
 
 func runDaemon(_ *cobra.Command, _ []string) error {
-	// Initialize logging if debug mode enabled (via flag or env var)
-	debug := os.Getenv("PERLES_DEBUG") != "" || debugFlag
-	if debug {
-		logPath := os.Getenv("PERLES_LOG")
-		if logPath == "" {
-			logPath = "debug.log"
-		}
+	// Determine API server address
+	// Priority: --port flag > config api_port > auto-assign (port 0)
+	port := daemonPort
+	if port == 0 {
+		port = cfg.Orchestration.APIPort
+	}
+	return runAPIServer(apiServerOptions{
+		Name:  "daemon",
+		Addr:  fmt.Sprintf("localhost:%d", port),
+		Token: cfg.Orchestration.APIToken,
+	})
+}
 
-		cleanup, err := log.InitWithTeaLog(logPath, "perles-daemon")
-		if err != nil {
-			return fmt.Errorf("initializing logging: %w", err)
-		}
-		defer cleanup()
+// apiServerOptions configures runAPIServer.
+type apiServerOptions struct {
+	// Name identifies the command in logs and console output ("daemon", "serve").
+	Name string
+	// Addr is the listen address.
+	Addr string
+	// Token, when set, is required by every API request.
+	Token string
+	// OnStarted is called with the bound port once the server is listening.
+	// Optional - defaults to printing the port.
+	OnStarted func(port int)
+}
 
-		log.Info(log.CatConfig, "Perles daemon starting", "debug", true, "logPath", logPath)
+// runAPIServer creates a control plane, serves it over HTTP until SIGINT/SIGTERM,
+// then shuts both down. Shared by the daemon and serve commands.
+func runAPIServer(opts apiServerOptions) error {
+	cleanup, err := initServerLogging(opts.Name)
+	if err != nil {
+		return err
 	}
+	defer cleanup()
 
 	// Get working directory
 	workDir, err := os.Getwd()
@@ -126,27 +150,30 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		workflowCreator = appreg.NewWorkflowCreator(registryService, beadsExec, cfg.Orchestration.Templates)
 	}
 
-	// Create control plane
-	cp, err := createDaemonControlPlane(&cfg, workDir)
+	// Trace and measure the command pipeline when configured
+	telemetry, err := tracing.NewProvider(controlplane.TracingConfig(cfg.Orchestration.Tracing))
 	if err != nil {
-		return fmt.Errorf("creating control plane: %w", err)
+		return fmt.Errorf("starting tracing: %w", err)
 	}
 
-	// Determine API server address
-	// Priority: --port flag > config api_port > auto-assign (port 0)
-	port := daemonPort
-	if port == 0 {
-		port = cfg.Orchestration.APIPort
+	// Post lifecycle events to the configured webhooks
+	webhooks := webhook.New(controlplane.WebhookConfig(cfg.Orchestration.Webhooks))
+
+	// Create control plane
+	cp, err := createDaemonControlPlane(&cfg, workDir, telemetry, webhooks)
+	if err != nil {
+		return fmt.Errorf("creating control plane: %w", err)
 	}
-	addr := fmt.Sprintf("localhost:%d", port)
 
 	// Create API server
 	server, err := api.NewServer(api.ServerConfig{
-		Addr:            addr,
+		Addr:            opts.Addr,
 		ControlPlane:    cp,
 		WorkflowCreator: workflowCreator,
 		RegistryService: registryService,
+		SessionBaseDir:  cfg.Orchestration.SessionStorage.BaseDir,
 		FrontendFS:      frontend.DistFS(),
+		Token:           opts.Token,
 	})
 	if err != nil {
 		return fmt.Errorf("creating API server: %w", err)
@@ -165,7 +192,11 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		errCh <- server.Start()
 	}()
 
-	fmt.Printf("Perles daemon started on port %d\n", server.Port())
+	if opts.OnStarted != nil {
+		opts.OnStarted(server.Port())
+	} else {
+		fmt.Printf("Perles %s started on port %d\n", opts.Name, server.Port())
+	}
 	fmt.Println("Press Ctrl+C to stop")
 
 	// Wait for shutdown signal or error
@@ -192,11 +223,75 @@ func runDaemon(_ *cobra.Command, _ []string) error {
 		log.Error(log.CatOrch, "Error shutting down control plane", "error", err)
 	}
 
-	fmt.Println("Daemon stopped")
+	// Deliver the last lifecycle events
+	if err := webhooks.Close(shutdownCtx); err != nil {
+		log.Error(log.CatOrch, "Error flushing webhooks", "error", err)
+	}
+
+	// Flush spans and metrics of the stopped workflows
+	if err := telemetry.Shutdown(shutdownCtx); err != nil {
+		log.Error(log.CatOrch, "Error shutting down tracing", "error", err)
+	}
+
+	fmt.Printf("Perles %s stopped\n", opts.Name)
 	return nil
 }
 
-func createDaemonControlPlane(cfg *config.Config, _ string) (controlplane.ControlPlane, error) {
+// initServerLogging initializes logging for a long-running command when debug
+// mode is enabled (via flag or env var). The returned cleanup is always safe to call.
+func initServerLogging(name string) (func(), error) {
+	if os.Getenv("PERLES_DEBUG") == "" && !debugFlag {
+		return func() {}, nil
+	}
+
+	logPath := os.Getenv("PERLES_LOG")
+	if logPath == "" {
+		logPath = "debug.log"
+	}
+
+	cleanup, err := log.InitWithTeaLog(logPath, "perles-"+name)
+	if err != nil {
+		return nil, fmt.Errorf("initializing logging: %w", err)
+	}
+
+	log.Info(log.CatConfig, "Perles server starting", "command", name, "debug", true, "logPath", logPath)
+	return cleanup, nil
+}
+
+// daemonSupervisorConfig returns the supervisor configuration derived from cfg.
+// Runtime dependencies are filled in by createDaemonControlPlane.
+func daemonSupervisorConfig(cfg *config.Config) controlplane.SupervisorConfig {
+	orchConfig := cfg.Orchestration
+
+	// Solo mode: the processor assigns tasks instead of a coordinator agent
+	var solo *controlplane.SoloOptions
+	if orchConfig.IsSolo() {
+		solo = &controlplane.SoloOptions{Workers: orchConfig.Solo.WorkerCount(), Coordinator: orchConfig.Solo.Coordinator}
+	}
+
+	return controlplane.SupervisorConfig{
+		AgentProviders:  orchConfig.AgentProviders(),
+		WorktreeTimeout: orchConfig.Timeouts.WorktreeCreation,
+		Autoscale:       controlplane.AutoscalePolicy(orchConfig.Autoscale),
+		FabricStorage:   orchConfig.Fabric.Storage,
+		ProjectMemory:   orchConfig.Fabric.ProjectMemory,
+		CustomFields:    cfg.FieldDefs(),
+		RateLimits:      controlplane.RateLimitPolicy(orchConfig.RateLimits),
+		Dedup:           mcp.DedupPolicy{Window: orchConfig.Dedup.Window, Strategy: mcp.DedupStrategy(orchConfig.Dedup.Strategy)},
+		AuditMaxSize:    orchConfig.Audit.MaxSize(),
+		DAGPath:         orchConfig.DAG,
+		TurnPolicy:      controlplane.TurnPolicy(orchConfig.TurnPolicy),
+		WorkerBudget:    controlplane.WorkerBudget(orchConfig.Budget),
+		SessionBudget:   controlplane.SessionBudget(orchConfig.Budget),
+		PhaseTimeouts:   controlplane.PhaseTimeouts(orchConfig.PhaseTimeouts),
+		Solo:            solo,
+		PruningHints:    orchConfig.PruningHints,
+		WorkerWorktrees: orchConfig.WorkerWorktrees,
+		BeadsDir:        cfg.ResolvedBeadsDir,
+	}
+}
+
+func createDaemonControlPlane(cfg *config.Config, _ string, telemetry *tracing.Provider, webhooks *webhook.Dispatcher) (controlplane.ControlPlane, error) {
 	orchConfig := cfg.Orchestration
 
 	// Create workflow registry
@@ -207,23 +302,27 @@ func createDaemonControlPlane(cfg *config.Config, _ string) (controlplane.Contro
 	eventBus := controlplane.NewCrossWorkflowEventBus()
 
 	sessionFactory := session.NewFactory(session.FactoryConfig{
-		BaseDir: orchConfig.SessionStorage.BaseDir,
+		BaseDir:   orchConfig.SessionStorage.BaseDir,
+		Redaction: controlplane.RedactionPolicy(orchConfig.Redaction),
 		// Note: GitExecutor not available in daemon mode without git context
 	})
 
 	soundService := sound.NewSystemSoundService(cfg.Sound.Events)
+	notifier := notify.NewDesktopNotifier(cfg.Notifications.Events)
+
+	supervisorConfig := daemonSupervisorConfig(cfg)
+	supervisorConfig.WorkflowRegistry = workflowRegistry
+	supervisorConfig.Tracer = telemetry.EnabledTracer()
+	supervisorConfig.Metrics = telemetry.Metrics()
+	supervisorConfig.Webhooks = webhooks
+	supervisorConfig.SessionFactory = sessionFactory
+	supervisorConfig.SoundService = soundService
+	supervisorConfig.Notifier = notifier
+	supervisorConfig.GitExecutorFactory = func(path string) appgit.GitExecutor {
+		return infragit.NewRealExecutor(path)
+	}
 
-	supervisor, err := controlplane.NewSupervisor(controlplane.SupervisorConfig{
-		AgentProviders:   orchConfig.AgentProviders(),
-		WorkflowRegistry: workflowRegistry,
-		WorktreeTimeout:  orchConfig.Timeouts.WorktreeCreation,
-		SessionFactory:   sessionFactory,
-		SoundService:     soundService,
-		BeadsDir:         cfg.ResolvedBeadsDir,
-		GitExecutorFactory: func(path string) appgit.GitExecutor {
-			return infragit.NewRealExecutor(path)
-		},
-	})
+	supervisor, err := controlplane.NewSupervisor(supervisorConfig)
 	if err != nil {
 		return nil, fmt.Errorf("creating supervisor: %w", err)
 	}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+)
+
+func TestDaemonSupervisorConfig_PhaseTimeouts(t *testing.T) {
+	cfg := config.Defaults()
+	cfg.Orchestration.PhaseTimeouts = config.PhaseTimeoutsConfig{
+		Phases:  map[string]time.Duration{"implementing": time.Hour},
+		Workers: map[string]map[string]time.Duration{"worker-2": {"reviewing": 10 * time.Minute}},
+	}
+
+	timeouts := daemonSupervisorConfig(&cfg).PhaseTimeouts
+	require.Equal(t, time.Hour, timeouts.Phases[events.ProcessPhaseImplementing])
+	require.Equal(t, 10*time.Minute, timeouts.Workers["worker-2"][events.ProcessPhaseReviewing])
+}
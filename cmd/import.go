@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/interop/jira"
+)
+
+var (
+	importJQL    string
+	importFile   string
+	importDryRun bool
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import issues from other trackers",
+}
+
+var importJiraCmd = &cobra.Command{
+	Use:   "jira",
+	Short: "Import issues from Jira",
+	Long: `Import Jira issues into beads, from the Jira REST API or a JSON export.
+
+Epics, stories, tasks, bugs and subtasks become beads issues of the mapped
+type, keeping their parent links, and "Blocks" issue links become
+dependencies. Priorities, labels and status (to do, in progress, done) are
+kept. Each imported issue gets a "jira:<KEY>" label; issues imported before
+are skipped, so an import can be rerun to pick up new issues.
+
+The API connection is read from the jira section of the config. Use
+--dry-run to review the plan before anything is written.
+
+Examples:
+  # Preview importing the open issues of a project
+  perles import jira --jql "project = SHOP AND resolution = Unresolved" --dry-run
+
+  # Import the issues matched by jira.jql in the config
+  perles import jira
+
+  # Import from a saved search API response instead of the API
+  perles import jira --file shop-issues.json`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runImportJira,
+}
+
+func init() {
+	importJiraCmd.Flags().StringVar(&importJQL, "jql", "",
+		"JQL query selecting the issues to import (default: jira.jql from the config)")
+	importJiraCmd.Flags().StringVar(&importFile, "file", "",
+		"read issues from a Jira JSON export instead of the API")
+	importJiraCmd.Flags().BoolVar(&importDryRun, "dry-run", false,
+		"print the import plan without writing anything")
+	importJiraCmd.MarkFlagsMutuallyExclusive("jql", "file")
+	importCmd.AddCommand(importJiraCmd)
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImportJira(cmd *cobra.Command, args []string) error {
+	if err := config.ValidateJira(cfg.Jira); err != nil {
+		return err
+	}
+	issues, err := readJiraIssues(cfg.Jira, importJQL, importFile)
+	if err != nil {
+		return err
+	}
+
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	imported, err := loadIssues(beadsDir, jira.ImportedQuery)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	plan := jira.BuildPlan(issues, jira.NewMapping(cfg.Jira), jira.ImportedKeys(imported))
+	printJiraPlan(out, plan)
+	if importDryRun || len(plan.Issues)+len(plan.Dependencies) == 0 {
+		return nil
+	}
+
+	result, err := jira.Apply(infrabeads.NewBDExecutor(workDir, beadsDir), plan)
+	if err != nil {
+		return err
+	}
+	return printJiraResult(out, result)
+}
+
+// readJiraIssues reads issues from file, or searches the Jira API with jql
+// (default: the configured query).
+func readJiraIssues(jc config.JiraConfig, jql, file string) ([]jira.Issue, error) {
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer func() { _ = f.Close() }()
+		return jira.ReadExport(f, jc.EpicLinkField)
+	}
+
+	if jql == "" {
+		jql = jc.JQL
+	}
+	if jql == "" {
+		return nil, errors.New("no issues selected: pass --jql or --file, or set jira.jql in the config")
+	}
+	if err := config.ValidateJiraAPI(jc); err != nil {
+		return nil, err
+	}
+	return jira.NewClient(jc.URL, jc.Email, jc.Token, jc.EpicLinkField).Search(jql)
+}
+
+// printJiraPlan lists the issues and dependencies an import will create.
+func printJiraPlan(w io.Writer, plan jira.Plan) {
+	_, _ = fmt.Fprintf(w, "%d issue(s) to import:\n", len(plan.Issues))
+	for _, issue := range plan.Issues {
+		line := fmt.Sprintf("  %s [%s P%d %s] %s", issue.Key, issue.Type, issue.Priority, issue.Status, issue.Title)
+		if issue.ParentKey != "" {
+			line += fmt.Sprintf(" (parent %s)", issue.ParentKey)
+		}
+		_, _ = fmt.Fprintln(w, line)
+	}
+	if len(plan.Dependencies) > 0 {
+		_, _ = fmt.Fprintf(w, "%d dependency(ies) to add:\n", len(plan.Dependencies))
+		for _, dep := range plan.Dependencies {
+			_, _ = fmt.Fprintf(w, "  %s is blocked by %s\n", dep.Key, dep.DependsOn)
+		}
+	}
+	if len(plan.Skipped) > 0 {
+		_, _ = fmt.Fprintf(w, "%d issue(s) already imported\n", len(plan.Skipped))
+	}
+	for _, warning := range plan.Warnings {
+		_, _ = fmt.Fprintf(w, "Warning: %s\n", warning)
+	}
+}
+
+// printJiraResult reports the created issues and returns an error if any
+// issue or dependency failed.
+func printJiraResult(w io.Writer, result jira.Result) error {
+	for _, ir := range result.Issues {
+		if ir.BeadsID != "" {
+			_, _ = fmt.Fprintf(w, "Created %s from %s\n", ir.BeadsID, ir.Key)
+		}
+		if ir.Err != nil {
+			_, _ = fmt.Fprintf(w, "Failed %s: %v\n", ir.Key, ir.Err)
+		}
+	}
+	for _, dr := range result.Dependencies {
+		if dr.Err != nil {
+			_, _ = fmt.Fprintf(w, "Failed dependency %s -> %s: %v\n", dr.Key, dr.DependsOn, dr.Err)
+		}
+	}
+	if n := result.Failed(); n > 0 {
+		return fmt.Errorf("%d import step(s) failed", n)
+	}
+	return nil
+}
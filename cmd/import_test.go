@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/interop/jira"
+)
+
+func TestImportCommand_Registration(t *testing.T) {
+	require.Equal(t, importCmd, importJiraCmd.Parent())
+	for _, name := range []string{"jql", "file", "dry-run"} {
+		require.NotNil(t, importJiraCmd.Flags().Lookup(name), name)
+	}
+}
+
+func TestReadJiraIssues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export.json")
+	require.NoError(t, os.WriteFile(path, []byte(`[{"key": "SHOP-1", "fields": {"summary": "Checkout"}}]`), 0o644))
+	issues, err := readJiraIssues(config.JiraConfig{}, "", path)
+	require.NoError(t, err)
+	require.Equal(t, []jira.Issue{{Key: "SHOP-1", Summary: "Checkout"}}, issues)
+
+	_, err = readJiraIssues(config.JiraConfig{}, "", "")
+	require.EqualError(t, err, "no issues selected: pass --jql or --file, or set jira.jql in the config")
+
+	_, err = readJiraIssues(config.JiraConfig{JQL: "project = SHOP"}, "", "")
+	require.ErrorContains(t, err, "jira.url must be")
+}
+
+func TestPrintJiraPlan(t *testing.T) {
+	plan := jira.Plan{
+		Issues: []jira.PlannedIssue{
+			{Key: "SHOP-1", Title: "Checkout", Type: "epic", Priority: 1, Status: "open"},
+			{Key: "SHOP-2", Title: "Cart", Type: "feature", Priority: 2, Status: "in_progress", ParentKey: "SHOP-1"},
+		},
+		Dependencies: []jira.Dependency{{Key: "SHOP-2", DependsOn: "SHOP-9"}},
+		Skipped:      []string{"SHOP-9"},
+		Warnings:     []string{"SHOP-2 blocks OPS-1, which is not part of the import"},
+	}
+
+	var buf bytes.Buffer
+	printJiraPlan(&buf, plan)
+	require.Equal(t, ""+
+		"2 issue(s) to import:\n"+
+		"  SHOP-1 [epic P1 open] Checkout\n"+
+		"  SHOP-2 [feature P2 in_progress] Cart (parent SHOP-1)\n"+
+		"1 dependency(ies) to add:\n"+
+		"  SHOP-2 is blocked by SHOP-9\n"+
+		"1 issue(s) already imported\n"+
+		"Warning: SHOP-2 blocks OPS-1, which is not part of the import\n", buf.String())
+}
+
+func TestPrintJiraResult(t *testing.T) {
+	var buf bytes.Buffer
+	err := printJiraResult(&buf, jira.Result{
+		Issues: []jira.IssueResult{
+			{Key: "SHOP-1", BeadsID: "bd-1"},
+			{Key: "SHOP-2", BeadsID: "bd-2", Err: errors.New("closing issue: bd failed")},
+		},
+		Dependencies: []jira.DependencyResult{{Dependency: jira.Dependency{Key: "SHOP-3", DependsOn: "SHOP-1"}, Err: errors.New("SHOP-3 was not imported")}},
+	})
+	require.EqualError(t, err, "2 import step(s) failed")
+	require.Equal(t, ""+
+		"Created bd-1 from SHOP-1\n"+
+		"Created bd-2 from SHOP-2\n"+
+		"Failed SHOP-2: closing issue: bd failed\n"+
+		"Failed dependency SHOP-3 -> SHOP-1: SHOP-3 was not imported\n", buf.String())
+}
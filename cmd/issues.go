@@ -0,0 +1,251 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/interop/issuefile"
+)
+
+// allIssuesQuery is a BQL query returning every issue.
+const allIssuesQuery = "order by id asc"
+
+var (
+	issuesExportFormat string
+	issuesExportFields string
+	issuesExportQuery  string
+	issuesExportOutput string
+	issuesImportFormat string
+	issuesImportDryRun bool
+)
+
+var issuesCmd = &cobra.Command{
+	Use:   "issues",
+	Short: "Export and import issues as CSV or JSON",
+}
+
+var issuesExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export issues to CSV or JSON",
+	Long: fmt.Sprintf(`Export issues to a CSV or JSON file, for spreadsheets, reports or moving
+issues to another tracker.
+
+Select the issues with a BQL query and the columns with --fields. Labels
+are joined with ";" in CSV and are an array in JSON.
+
+Fields: %s
+Default fields: %s
+
+Examples:
+  # Export all issues as CSV
+  perles issues export > issues.csv
+
+  # Export open bugs with a few fields as JSON
+  perles issues export --format json --fields id,title,priority,assignee \
+    --query "type = bug and status = open" --output bugs.json`,
+		strings.Join(issuefile.Fields, ", "), strings.Join(issuefile.DefaultFields, ", ")),
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runIssuesExport,
+}
+
+var issuesImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Create and update issues from CSV or JSON",
+	Long: `Create and update issues from a CSV or JSON file in the format written by
+"perles issues export".
+
+Rows whose id is an existing issue update it, changing only the columns
+present in the file; other rows create new issues, which get new IDs.
+A created issue keeps its row's id as an "import:<id>" label, so importing
+the same file again updates it instead of creating a duplicate.
+A new issue's parent may be an existing issue or the id of another new
+row. Created issues default to open P2 tasks. The created_at, updated_at
+and closed_at columns are ignored.
+
+Every row is validated before anything is written: if any row is invalid
+the errors are listed and nothing is imported. Use --dry-run to review the
+changes first.
+
+Examples:
+  # Preview an import
+  perles issues import issues.csv --dry-run
+
+  # Import a JSON file with a non-standard extension
+  perles issues import backlog.txt --format json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runIssuesImport,
+}
+
+func init() {
+	issuesExportCmd.Flags().StringVar(&issuesExportFormat, "format", string(issuefile.FormatCSV),
+		"output format: csv or json")
+	issuesExportCmd.Flags().StringVar(&issuesExportFields, "fields", "",
+		"comma-separated fields to export (default: "+strings.Join(issuefile.DefaultFields, ",")+")")
+	issuesExportCmd.Flags().StringVar(&issuesExportQuery, "query", allIssuesQuery,
+		"BQL query selecting the issues to export")
+	issuesExportCmd.Flags().StringVarP(&issuesExportOutput, "output", "o", "",
+		"file to write (default: stdout)")
+	issuesImportCmd.Flags().StringVar(&issuesImportFormat, "format", "",
+		"input format: csv or json (default: from the file extension)")
+	issuesImportCmd.Flags().BoolVar(&issuesImportDryRun, "dry-run", false,
+		"print the import plan without writing anything")
+	issuesCmd.AddCommand(issuesExportCmd, issuesImportCmd)
+	rootCmd.AddCommand(issuesCmd)
+}
+
+func runIssuesExport(cmd *cobra.Command, args []string) error {
+	format, err := issuefile.ParseFormat(issuesExportFormat)
+	if err != nil {
+		return err
+	}
+	fields, err := issuefile.ParseFields(issuesExportFields)
+	if err != nil {
+		return err
+	}
+
+	beadsDir, _, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	issues, err := loadIssues(beadsDir, issuesExportQuery)
+	if err != nil {
+		return err
+	}
+
+	if issuesExportOutput == "" {
+		return issuefile.Export(cmd.OutOrStdout(), issues, format, fields)
+	}
+	f, err := os.Create(issuesExportOutput)
+	if err != nil {
+		return err
+	}
+	if err := issuefile.Export(f, issues, format, fields); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Exported %d issue(s) to %s\n", len(issues), issuesExportOutput)
+	return nil
+}
+
+func runIssuesImport(cmd *cobra.Command, args []string) error {
+	path := args[0]
+	format, err := importFormat(path, issuesImportFormat)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	rows, rowErrs, err := issuefile.Read(f, format)
+	_ = f.Close()
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	issues, err := loadIssues(beadsDir, allIssuesQuery)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]beads.Issue, len(issues))
+	for _, issue := range issues {
+		existing[issue.ID] = issue
+	}
+
+	out := cmd.OutOrStdout()
+	plan := issuefile.BuildPlan(rows, existing)
+	plan.Errors = append(rowErrs, plan.Errors...)
+	slices.SortFunc(plan.Errors, func(a, b issuefile.RowError) int { return a.Number - b.Number })
+	if len(plan.Errors) > 0 {
+		for _, rowErr := range plan.Errors {
+			_, _ = fmt.Fprintf(out, "Invalid %v\n", rowErr)
+		}
+		return fmt.Errorf("%d invalid row(s); nothing imported", len(plan.Errors))
+	}
+	printIssuesPlan(out, plan)
+	if issuesImportDryRun || len(plan.Creates)+len(plan.Updates) == 0 {
+		return nil
+	}
+
+	result, err := issuefile.Apply(infrabeads.NewBDExecutor(workDir, beadsDir), plan)
+	if err != nil {
+		return err
+	}
+	return printIssuesResult(out, result)
+}
+
+// importFormat returns the format named by flag, or else the one of path's
+// extension.
+func importFormat(path, flag string) (issuefile.Format, error) {
+	if flag != "" {
+		return issuefile.ParseFormat(flag)
+	}
+	format, err := issuefile.ParseFormat(strings.TrimPrefix(filepath.Ext(path), "."))
+	if err != nil {
+		return "", fmt.Errorf("cannot tell the format of %s: pass --format csv or --format json", path)
+	}
+	return format, nil
+}
+
+// printIssuesPlan lists the issues an import will create and update.
+func printIssuesPlan(w io.Writer, plan issuefile.Plan) {
+	_, _ = fmt.Fprintf(w, "%d issue(s) to create:\n", len(plan.Creates))
+	for _, pc := range plan.Creates {
+		line := fmt.Sprintf("  row %d [%s P%d %s] %s", pc.Row, pc.Options.Type, pc.Options.Priority, pc.Status, pc.Options.Title)
+		if parent := pc.Options.ParentID + pc.ParentRef; parent != "" {
+			line += fmt.Sprintf(" (parent %s)", parent)
+		}
+		_, _ = fmt.Fprintln(w, line)
+	}
+	_, _ = fmt.Fprintf(w, "%d issue(s) to update:\n", len(plan.Updates))
+	for _, pu := range plan.Updates {
+		_, _ = fmt.Fprintf(w, "  %s: %s\n", pu.ID, strings.Join(pu.Changed, ", "))
+	}
+	if len(plan.Unchanged) > 0 {
+		_, _ = fmt.Fprintf(w, "%d issue(s) unchanged\n", len(plan.Unchanged))
+	}
+	for _, warning := range plan.Warnings {
+		_, _ = fmt.Fprintf(w, "Warning: %s\n", warning)
+	}
+}
+
+// printIssuesResult reports the created and updated issues and returns an
+// error if any of them failed.
+func printIssuesResult(w io.Writer, result issuefile.Result) error {
+	for _, cr := range result.Created {
+		if cr.BeadsID != "" {
+			_, _ = fmt.Fprintf(w, "Created %s from row %d\n", cr.BeadsID, cr.Row)
+		}
+		if cr.Err != nil {
+			_, _ = fmt.Fprintf(w, "Failed row %d: %v\n", cr.Row, cr.Err)
+		}
+	}
+	for _, ur := range result.Updated {
+		if ur.Err != nil {
+			_, _ = fmt.Fprintf(w, "Failed %s (row %d): %v\n", ur.ID, ur.Row, ur.Err)
+		} else {
+			_, _ = fmt.Fprintf(w, "Updated %s\n", ur.ID)
+		}
+	}
+	if n := result.Failed(); n > 0 {
+		return fmt.Errorf("%d issue(s) failed", n)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/interop/issuefile"
+)
+
+func TestIssuesCommand_Registration(t *testing.T) {
+	require.Equal(t, issuesCmd, issuesExportCmd.Parent())
+	require.Equal(t, issuesCmd, issuesImportCmd.Parent())
+	for _, name := range []string{"format", "fields", "query", "output"} {
+		require.NotNil(t, issuesExportCmd.Flags().Lookup(name), name)
+	}
+	for _, name := range []string{"format", "dry-run"} {
+		require.NotNil(t, issuesImportCmd.Flags().Lookup(name), name)
+	}
+}
+
+func TestImportFormat(t *testing.T) {
+	format, err := importFormat("backlog.JSON", "")
+	require.NoError(t, err)
+	require.Equal(t, issuefile.FormatJSON, format)
+
+	format, err = importFormat("backlog.txt", "csv")
+	require.NoError(t, err)
+	require.Equal(t, issuefile.FormatCSV, format)
+
+	_, err = importFormat("backlog.txt", "")
+	require.EqualError(t, err, "cannot tell the format of backlog.txt: pass --format csv or --format json")
+}
+
+func TestPrintIssuesPlan(t *testing.T) {
+	plan := issuefile.Plan{
+		Creates: []issuefile.PlannedCreate{
+			{Row: 3, Ref: "new-1", Options: beads.CreateIssueOptions{Title: "Cart", Type: "task", Priority: 2, ParentID: "bd-1"}, Status: "open"},
+			{Row: 2, Options: beads.CreateIssueOptions{Title: "Totals", Type: "bug", Priority: 1}, ParentRef: "new-1", Status: "closed"},
+		},
+		Updates:   []issuefile.PlannedUpdate{{Row: 1, ID: "bd-1", Changed: []string{"status", "labels"}}},
+		Unchanged: []string{"bd-2"},
+		Warnings:  []string{"row 4: the parent of bd-3 cannot be changed by an import"},
+	}
+
+	var buf bytes.Buffer
+	printIssuesPlan(&buf, plan)
+	require.Equal(t, ""+
+		"2 issue(s) to create:\n"+
+		"  row 3 [task P2 open] Cart (parent bd-1)\n"+
+		"  row 2 [bug P1 closed] Totals (parent new-1)\n"+
+		"1 issue(s) to update:\n"+
+		"  bd-1: status, labels\n"+
+		"1 issue(s) unchanged\n"+
+		"Warning: row 4: the parent of bd-3 cannot be changed by an import\n", buf.String())
+}
+
+func TestPrintIssuesResult(t *testing.T) {
+	var buf bytes.Buffer
+	err := printIssuesResult(&buf, issuefile.Result{
+		Created: []issuefile.CreateResult{
+			{Row: 1, BeadsID: "bd-10"},
+			{Row: 2, Err: errors.New("creating issue: bd failed")},
+		},
+		Updated: []issuefile.UpdateResult{{Row: 3, ID: "bd-1"}, {Row: 4, ID: "bd-2", Err: errors.New("bd failed")}},
+	})
+	require.EqualError(t, err, "2 issue(s) failed")
+	require.Equal(t, ""+
+		"Created bd-10 from row 1\n"+
+		"Failed row 2: creating issue: bd failed\n"+
+		"Updated bd-1\n"+
+		"Failed bd-2 (row 4): bd failed\n", buf.String())
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zjrosen/perles/internal/orchestration/promptlint"
+)
+
+var promptsCmd = &cobra.Command{
+	Use:   "prompts",
+	Short: "Inspect orchestration prompts",
+}
+
+var promptsLintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Validate the built-in orchestration prompts",
+	Long: `Validate the coordinator, worker, agent type and observer prompts against the code.
+
+Checks that:
+  - every placeholder is rendered and no template artifacts remain
+  - every tool a prompt references is registered on the MCP server of that agent
+  - every prompt stays within its length budget
+
+Exits with an error if any issue is found.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runPromptsLint,
+}
+
+func init() {
+	promptsCmd.AddCommand(promptsLintCmd)
+	rootCmd.AddCommand(promptsCmd)
+}
+
+func runPromptsLint(cmd *cobra.Command, args []string) error {
+	prompts, issues, err := promptlint.Run()
+	if err != nil {
+		return fmt.Errorf("rendering prompts: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	for _, issue := range issues {
+		_, _ = fmt.Fprintln(out, issue)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%d issues in %d prompts", len(issues), len(prompts))
+	}
+	_, _ = fmt.Fprintf(out, "%d prompts OK\n", len(prompts))
+	return nil
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
+)
+
+var recurDryRun bool
+
+var recurCmd = &cobra.Command{
+	Use:   "recur",
+	Short: "Manage recurring issues",
+	Long: `Make issues recurring so a fresh copy is created when they close or on a schedule.
+
+A rule is stored as a "recur:<rule>" label on the latest instance. When the
+next instance is created the label moves to it, so each instance is copied
+only once. Rules:
+  on-close          when the issue is closed
+  daily, weekly, monthly
+  every-<N><d|w|m>  e.g. every-2w, counted from when the instance was created
+
+perles creates due instances while it runs. Use 'perles recur run' to do it
+from cron or CI.
+
+Examples:
+  # Create a new dependency-bump task every week
+  perles recur add bd-12 weekly
+
+  # Create the next instance whenever the current one is closed
+  perles recur add bd-13 on-close
+
+  # List recurring issues and when their next instance is due
+  perles recur list`,
+}
+
+var recurAddCmd = &cobra.Command{
+	Use:          "add <issue-id> <rule>",
+	Short:        "Make an issue recurring",
+	Args:         cobra.ExactArgs(2),
+	SilenceUsage: true,
+	RunE:         runRecurAdd,
+}
+
+var recurRemoveCmd = &cobra.Command{
+	Use:          "remove <issue-id>",
+	Short:        "Stop an issue from recurring",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runRecurRemove,
+}
+
+var recurListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "List recurring issues",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runRecurList,
+}
+
+var recurRunCmd = &cobra.Command{
+	Use:          "run",
+	Short:        "Create the next instance of every due recurring issue",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runRecurRun,
+}
+
+func init() {
+	recurRunCmd.Flags().BoolVar(&recurDryRun, "dry-run", false,
+		"list the issues that are due without creating their next instance")
+	recurCmd.AddCommand(recurAddCmd, recurRemoveCmd, recurListCmd, recurRunCmd)
+	rootCmd.AddCommand(recurCmd)
+}
+
+func runRecurAdd(cmd *cobra.Command, args []string) error {
+	rule, err := beads.ParseRecurrenceRule(args[1])
+	if err != nil {
+		return err
+	}
+	if err := setRecurrence(args[0], &rule); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s now repeats %s\n", args[0], rule)
+	return nil
+}
+
+func runRecurRemove(cmd *cobra.Command, args []string) error {
+	if err := setRecurrence(args[0], nil); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s no longer repeats\n", args[0])
+	return nil
+}
+
+// setRecurrence replaces the recurrence label of an issue, or removes it when
+// rule is nil.
+func setRecurrence(issueID string, rule *beads.RecurrenceRule) error {
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	issues, err := loadIssues(beadsDir, bql.BuildIDQuery([]string{issueID}))
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		return fmt.Errorf("issue not found: %s", issueID)
+	}
+	labels := beads.SetRecurrence(issues[0].Labels, rule)
+	if err := infrabeads.NewBDExecutor(workDir, beadsDir).SetLabels(issueID, labels); err != nil {
+		return fmt.Errorf("updating labels: %w", err)
+	}
+	return nil
+}
+
+func runRecurList(cmd *cobra.Command, args []string) error {
+	beadsDir, _, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	issues, err := loadIssues(beadsDir, bql.RecurringQuery)
+	if err != nil {
+		return err
+	}
+	printRecurrences(cmd.OutOrStdout(), issues, time.Now())
+	return nil
+}
+
+func runRecurRun(cmd *cobra.Command, args []string) error {
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	issues, err := loadIssues(beadsDir, bql.RecurringQuery)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	now := time.Now()
+	if recurDryRun {
+		due := beads.SelectDueRecurrences(issues, now)
+		_, _ = fmt.Fprintf(out, "%d recurring issue(s) due\n", len(due))
+		for _, issue := range due {
+			_, _ = fmt.Fprintf(out, "  %s %s\n", issue.ID, issue.TitleText)
+		}
+		return nil
+	}
+
+	results := appbeads.MaterializeRecurrences(infrabeads.NewBDExecutor(workDir, beadsDir), issues, now)
+	return printMaterialized(out, results)
+}
+
+// printRecurrences lists recurring issues with their rule and when the next
+// instance is due.
+func printRecurrences(w io.Writer, issues []beads.Issue, now time.Time) {
+	var count int
+	for _, issue := range issues {
+		rule, ok := issue.Recurrence()
+		if !ok {
+			continue
+		}
+		count++
+		next := "when closed"
+		switch {
+		case issue.RecurrenceDue(now):
+			next = "due now"
+		case !rule.OnClose:
+			next = rule.Next(issue.CreatedAt).Format("2006-01-02")
+		}
+		_, _ = fmt.Fprintf(w, "%s [%s] %s  repeats %s, next %s\n", issue.ID, issue.Status, issue.TitleText, rule, next)
+	}
+	if count == 0 {
+		_, _ = fmt.Fprintln(w, "No recurring issues")
+	}
+}
+
+// printMaterialized reports the created instances and returns an error if any
+// recurrence failed.
+func printMaterialized(w io.Writer, results []appbeads.RecurrenceResult) error {
+	var failed int
+	for _, r := range results {
+		if r.NextID != "" {
+			_, _ = fmt.Fprintf(w, "Created %s from %s\n", r.NextID, r.FromID)
+		}
+		if r.Err != nil {
+			failed++
+			_, _ = fmt.Fprintf(w, "Failed %s: %v\n", r.FromID, r.Err)
+		}
+	}
+	if len(results) == 0 {
+		_, _ = fmt.Fprintln(w, "No recurring issues due")
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d recurrence(s) failed", failed)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+func TestRecurCommand_Registration(t *testing.T) {
+	names := make(map[string]bool)
+	for _, cmd := range recurCmd.Commands() {
+		names[cmd.Name()] = true
+	}
+	require.Equal(t, map[string]bool{"add": true, "remove": true, "list": true, "run": true}, names)
+	require.NotNil(t, recurRunCmd.Flags().Lookup("dry-run"))
+}
+
+func TestPrintRecurrences(t *testing.T) {
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	issues := []beads.Issue{
+		{ID: "bd-1", Status: beads.StatusOpen, TitleText: "Bump deps", CreatedAt: now.AddDate(0, 0, -2), Labels: []string{"recur:weekly"}},
+		{ID: "bd-2", Status: beads.StatusOpen, TitleText: "Release", Labels: []string{"recur:on-close"}},
+		{ID: "bd-3", Status: beads.StatusClosed, TitleText: "Triage", Labels: []string{"recur:on-close"}},
+	}
+
+	var buf bytes.Buffer
+	printRecurrences(&buf, issues, now)
+	require.Equal(t, ""+
+		"bd-1 [open] Bump deps  repeats weekly, next 2026-03-15\n"+
+		"bd-2 [open] Release  repeats on-close, next when closed\n"+
+		"bd-3 [closed] Triage  repeats on-close, next due now\n", buf.String())
+
+	buf.Reset()
+	printRecurrences(&buf, nil, now)
+	require.Equal(t, "No recurring issues\n", buf.String())
+}
+
+func TestPrintMaterialized(t *testing.T) {
+	var buf bytes.Buffer
+	err := printMaterialized(&buf, []appbeads.RecurrenceResult{
+		{FromID: "bd-1", NextID: "bd-4"},
+		{FromID: "bd-2", Err: errors.New("bd failed")},
+	})
+	require.EqualError(t, err, "1 recurrence(s) failed")
+	require.Equal(t, "Created bd-4 from bd-1\nFailed bd-2: bd failed\n", buf.String())
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/zjrosen/perles/internal/templates"
 	"github.com/zjrosen/perles/internal/ui/nobeads"
 	"github.com/zjrosen/perles/internal/ui/outdated"
+	"github.com/zjrosen/perles/internal/ui/shared/vimtextarea"
 )
 
 func init() {
@@ -40,6 +41,7 @@ var (
 	version         = "dev"
 	cfgFile         string
 	cfg             config.Config
+	cfgResolveErr   error
 	debugFlag       bool
 	apiPortFlag     int
 	registryService *appreg.RegistryService
@@ -50,7 +52,10 @@ var rootCmd = &cobra.Command{
 	Short:   "A terminal ui for beads issue tracking",
 	Long:    `A terminal user interface for viewing and managing beads issues in a kanban-style board with BQL support.`,
 	Version: version,
-	RunE:    runApp,
+	// Every subcommand reads cfg, so a configuration that failed to resolve
+	// stops them all before they run.
+	PersistentPreRunE: checkConfig,
+	RunE:              runApp,
 }
 
 func init() {
@@ -66,9 +71,46 @@ func init() {
 		"enable debug mode with logging (also: PERLES_DEBUG=1)")
 	rootCmd.Flags().IntVarP(&apiPortFlag, "port", "p", 0,
 		"API server port (0 = auto-assign, overrides config)")
+	rootCmd.Flags().Bool("include-archived", false,
+		"show archived issues in views and search")
+	rootCmd.Flags().Bool("accessible", false,
+		"screen-reader friendly output: no alternate screen or mouse, plain frames, announcements")
+
+	rootCmd.PersistentFlags().Int("worker-token-budget", 0,
+		"replace a worker after it spends this many tokens (0 = unlimited)")
+	rootCmd.PersistentFlags().Duration("worker-time-budget", 0,
+		"replace a worker after it runs this long, e.g. 45m (0 = unlimited)")
+	rootCmd.PersistentFlags().Int("session-token-budget", 0,
+		"warn the coordinator when a session spends this many tokens (0 = unlimited)")
+	rootCmd.PersistentFlags().Duration("session-time-budget", 0,
+		"warn the coordinator when a session runs this long, e.g. 4h (0 = unlimited)")
+	rootCmd.PersistentFlags().String("orchestration-mode", "",
+		"orchestration mode: coordinator (default) or solo (no coordinator agent)")
+	rootCmd.PersistentFlags().Bool("trace", false,
+		"trace orchestration commands and MCP tool calls with OpenTelemetry")
+	rootCmd.PersistentFlags().String("trace-exporter", "",
+		"trace exporter: file (default), stdout, otlp or none")
+	rootCmd.PersistentFlags().Float64("trace-sample-rate", 0,
+		"fraction of traces to keep, 0.0 to 1.0 (default 1.0)")
+	rootCmd.PersistentFlags().Bool("metrics", false,
+		"export command rate, queue depth and per-phase latency metrics over OTLP")
+	rootCmd.PersistentFlags().String("otlp-endpoint", "",
+		"OTLP collector endpoint for traces and metrics (default localhost:4317)")
 
 	_ = viper.BindPFlag("beads_dir", rootCmd.Flags().Lookup("beads-dir"))
 	_ = viper.BindPFlag("ui.markdown_style", rootCmd.Flags().Lookup("markdown-style"))
+	_ = viper.BindPFlag("include_archived", rootCmd.Flags().Lookup("include-archived"))
+	_ = viper.BindPFlag("ui.accessibility.enabled", rootCmd.Flags().Lookup("accessible"))
+	_ = viper.BindPFlag("orchestration.budget.worker_tokens", rootCmd.PersistentFlags().Lookup("worker-token-budget"))
+	_ = viper.BindPFlag("orchestration.budget.worker_duration", rootCmd.PersistentFlags().Lookup("worker-time-budget"))
+	_ = viper.BindPFlag("orchestration.budget.session_tokens", rootCmd.PersistentFlags().Lookup("session-token-budget"))
+	_ = viper.BindPFlag("orchestration.budget.session_duration", rootCmd.PersistentFlags().Lookup("session-time-budget"))
+	_ = viper.BindPFlag("orchestration.mode", rootCmd.PersistentFlags().Lookup("orchestration-mode"))
+	_ = viper.BindPFlag("orchestration.tracing.enabled", rootCmd.PersistentFlags().Lookup("trace"))
+	_ = viper.BindPFlag("orchestration.tracing.exporter", rootCmd.PersistentFlags().Lookup("trace-exporter"))
+	_ = viper.BindPFlag("orchestration.tracing.sample_rate", rootCmd.PersistentFlags().Lookup("trace-sample-rate"))
+	_ = viper.BindPFlag("orchestration.tracing.metrics", rootCmd.PersistentFlags().Lookup("metrics"))
+	_ = viper.BindPFlag("orchestration.tracing.otlp_endpoint", rootCmd.PersistentFlags().Lookup("otlp-endpoint"))
 }
 
 func initConfig() {
@@ -85,6 +127,10 @@ func initConfig() {
 	viper.SetDefault("orchestration.claude.model", defaults.Orchestration.Claude.Model)
 	viper.SetDefault("orchestration.amp.model", defaults.Orchestration.Amp.Model)
 	viper.SetDefault("orchestration.amp.mode", defaults.Orchestration.Amp.Mode)
+	viper.SetDefault("orchestration.tracing.exporter", defaults.Orchestration.Tracing.Exporter)
+	viper.SetDefault("orchestration.tracing.file_path", config.DefaultTracesFilePath())
+	viper.SetDefault("orchestration.tracing.otlp_endpoint", defaults.Orchestration.Tracing.OTLPEndpoint)
+	viper.SetDefault("orchestration.tracing.sample_rate", defaults.Orchestration.Tracing.SampleRate)
 
 	// Sound defaults
 	viper.SetDefault("sound.events", defaults.Sound.Events)
@@ -125,9 +171,30 @@ func initConfig() {
 		log.Info(log.CatConfig, "Config loaded", "path", viper.ConfigFileUsed())
 	}
 
+	// Expand ${ENV_VAR} and secret references (op://, keychain:) so config
+	// files can be committed without embedding tokens.
+	settings, err := config.NewResolver().ResolveSettings(viper.AllSettings())
+	if err != nil {
+		cfgResolveErr = err
+		log.Warn(log.CatConfig, "Config interpolation failed", "error", err)
+	} else {
+		for key, value := range settings {
+			viper.Set(key, value)
+		}
+	}
+
 	_ = viper.Unmarshal(&cfg)
 }
 
+// checkConfig reports a failure to resolve the configuration's environment
+// variable and secret references.
+func checkConfig(_ *cobra.Command, _ []string) error {
+	if cfgResolveErr != nil {
+		return fmt.Errorf("resolving configuration: %w", cfgResolveErr)
+	}
+	return nil
+}
+
 func initServices() {
 	// Initialize registry service with embedded templates and user-defined workflows
 	// templates.RegistryFS() contains template.yaml, workflow templates, and coordinator instructions
@@ -169,6 +236,10 @@ func runApp(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid view configuration: %w", err)
 	}
 
+	if err := config.ValidateCustomFields(cfg.CustomFields); err != nil {
+		return fmt.Errorf("invalid custom field configuration: %w", err)
+	}
+
 	if err := config.ValidateOrchestration(cfg.Orchestration); err != nil {
 		return fmt.Errorf("invalid orchestration configuration: %w", err)
 	}
@@ -194,6 +265,11 @@ func runApp(cmd *cobra.Command, args []string) error {
 
 	// Apply keybinding overrides from config
 	keys.ApplyConfig(cfg.UI.Keybindings.Search, cfg.UI.Keybindings.Dashboard)
+	if err := vimtextarea.ApplyConfig(cfg.UI.Keybindings.Vim); err != nil {
+		// Bad or conflicting remaps are skipped; their keys keep the default behavior
+		log.Warn(log.CatConfig, "Skipped vim key mappings", "error", err)
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Skipping vim key mappings: %v\n", err)
+	}
 
 	// Working directory is always the current directory (where perles was invoked)
 	workDir, err := os.Getwd()
@@ -283,11 +359,7 @@ func runApp(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("initializing application: %w", err)
 	}
-	p := tea.NewProgram(
-		&model,
-		tea.WithAltScreen(),
-		tea.WithMouseAllMotion(),
-	)
+	p := tea.NewProgram(&model, programOptions(cfg.UI.Accessibility)...)
 
 	finalModel, err := p.Run()
 
@@ -315,6 +387,16 @@ func runApp(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// programOptions returns the Bubble Tea options for the main TUI.
+// Accessibility mode stays on the normal screen without mouse tracking so
+// screen readers can follow the output.
+func programOptions(a11y config.AccessibilityConfig) []tea.ProgramOption {
+	if a11y.Enabled {
+		return nil
+	}
+	return []tea.ProgramOption{tea.WithAltScreen(), tea.WithMouseAllMotion()}
+}
+
 // Execute runs the root command
 func Execute() error {
 	return rootCmd.Execute()
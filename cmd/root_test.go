@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -227,3 +228,21 @@ func TestStartup_PartialKeybindings(t *testing.T) {
 			"dashboard key should be ctrl+d")
 	})
 }
+
+// TestCheckConfig_RunsForSubcommands verifies that every subcommand is stopped
+// when the configuration failed to resolve.
+func TestCheckConfig_RunsForSubcommands(t *testing.T) {
+	prev := cfgResolveErr
+	t.Cleanup(func() { cfgResolveErr = prev })
+
+	cfgResolveErr = nil
+	require.NoError(t, rootCmd.PersistentPreRunE(scheduleCmd, nil))
+
+	cfgResolveErr = errors.New("op://vault/item: not signed in")
+	for _, sub := range rootCmd.Commands() {
+		require.Nil(t, sub.PersistentPreRunE, "%s would skip the root config check", sub.Name())
+		require.Nil(t, sub.PersistentPreRun, "%s would skip the root config check", sub.Name())
+	}
+	err := rootCmd.PersistentPreRunE(scheduleCmd, nil)
+	require.ErrorContains(t, err, "resolving configuration: op://vault/item: not signed in")
+}
@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/notify"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+	"github.com/zjrosen/perles/internal/orchestration/schedule"
+	"github.com/zjrosen/perles/internal/orchestration/tracing"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
+	appreg "github.com/zjrosen/perles/internal/registry/application"
+	"github.com/zjrosen/perles/internal/templates"
+)
+
+var (
+	scheduleEpic     string
+	scheduleTemplate string
+	scheduleName     string
+)
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Run orchestration sessions on a cron schedule",
+	Long: `Start orchestration sessions for an epic at set times.
+
+Schedules are stored in the beads directory (` + schedule.StoreFile + `) and run by
+'perles schedule daemon', which must be left running in the project. The
+daemon picks up added and removed schedules without a restart. A run is
+skipped while the previous run of the same schedule is still going, and runs
+missed while the daemon was stopped are made up once when it starts.
+
+The cron expression has five fields (minute hour day-of-month month
+day-of-week) in local time; @hourly, @daily, @weekly and @monthly also work.
+
+Enable the "` + schedule.NotifyUseCase + `" notification event to get a desktop notification
+when a scheduled run completes or fails.
+
+Examples:
+  # Work through an epic every Monday at 9:00
+  perles schedule add "0 9 * * 1" --epic perles-xyz
+
+  # List schedules with their next and last run
+  perles schedule list
+
+  # Run the schedules
+  perles schedule daemon`,
+}
+
+var scheduleAddCmd = &cobra.Command{
+	Use:          "add <cron>",
+	Short:        "Add a schedule",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runScheduleAdd,
+}
+
+var scheduleRemoveCmd = &cobra.Command{
+	Use:          "remove <schedule-id>",
+	Short:        "Remove a schedule",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runScheduleRemove,
+}
+
+var scheduleListCmd = &cobra.Command{
+	Use:          "list",
+	Short:        "List schedules",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runScheduleList,
+}
+
+var scheduleDaemonCmd = &cobra.Command{
+	Use:          "daemon",
+	Short:        "Start orchestration sessions when schedules are due",
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runScheduleDaemon,
+}
+
+func init() {
+	scheduleAddCmd.Flags().StringVar(&scheduleEpic, "epic", "", "epic the scheduled session works on (required)")
+	scheduleAddCmd.Flags().StringVar(&scheduleTemplate, "template", schedule.DefaultTemplate, "epic-driven workflow template to run")
+	scheduleAddCmd.Flags().StringVar(&scheduleName, "name", "", "workflow display name (default: the template name)")
+	_ = scheduleAddCmd.MarkFlagRequired("epic")
+
+	scheduleCmd.AddCommand(scheduleAddCmd, scheduleRemoveCmd, scheduleListCmd, scheduleDaemonCmd)
+	rootCmd.AddCommand(scheduleCmd)
+}
+
+// openScheduleStore returns the schedule store of the current project.
+func openScheduleStore() (*schedule.Store, error) {
+	beadsDir, _, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return nil, err
+	}
+	return schedule.NewStore(filepath.Join(beadsDir, schedule.StoreFile)), nil
+}
+
+func runScheduleAdd(cmd *cobra.Command, args []string) error {
+	if err := checkScheduleTemplate(scheduleTemplate); err != nil {
+		return err
+	}
+	store, err := openScheduleStore()
+	if err != nil {
+		return err
+	}
+
+	sched, err := store.Add(schedule.Schedule{
+		Cron:     args[0],
+		EpicID:   scheduleEpic,
+		Template: scheduleTemplate,
+		Name:     scheduleName,
+	})
+	if err != nil {
+		return err
+	}
+
+	next, _ := sched.Next()
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Added %s: %s on %q, next run %s\n",
+		sched.ID, sched.Template, sched.EpicID, next.Format("2006-01-02 15:04"))
+	return nil
+}
+
+// checkScheduleTemplate verifies the template exists and works on an
+// existing epic, since scheduled runs have no one to create the epic for.
+func checkScheduleTemplate(templateID string) error {
+	registryService, err := appreg.NewRegistryService(templates.RegistryFS(), appreg.UserRegistryBaseDir())
+	if err != nil {
+		return fmt.Errorf("loading workflow templates: %w", err)
+	}
+	reg, err := registryService.GetByKey("workflow", templateID)
+	if err != nil {
+		return fmt.Errorf("unknown workflow template %q", templateID)
+	}
+	if !reg.IsEpicDriven() {
+		return fmt.Errorf("workflow template %q does not work on an existing epic", templateID)
+	}
+	return nil
+}
+
+func runScheduleRemove(cmd *cobra.Command, args []string) error {
+	store, err := openScheduleStore()
+	if err != nil {
+		return err
+	}
+	if err := store.Remove(args[0]); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Removed %s\n", args[0])
+	return nil
+}
+
+func runScheduleList(cmd *cobra.Command, _ []string) error {
+	store, err := openScheduleStore()
+	if err != nil {
+		return err
+	}
+	schedules, err := store.List()
+	if err != nil {
+		return err
+	}
+	printSchedules(cmd.OutOrStdout(), schedules, time.Now())
+	return nil
+}
+
+// printSchedules lists schedules with their next and last run.
+func printSchedules(w io.Writer, schedules []schedule.Schedule, now time.Time) {
+	if len(schedules) == 0 {
+		_, _ = fmt.Fprintln(w, "No schedules")
+		return
+	}
+	const layout = "2006-01-02 15:04"
+	for _, sched := range schedules {
+		next := "never"
+		if t, err := sched.Next(); err != nil {
+			next = "invalid: " + err.Error()
+		} else if !t.IsZero() && !t.After(now) {
+			next = "due now"
+		} else if !t.IsZero() {
+			next = t.Format(layout)
+		}
+
+		_, _ = fmt.Fprintf(w, "%s  %-15s %s (%s)  next %s", sched.ID, sched.Cron, sched.EpicID, sched.Template, next)
+		if !sched.LastRunAt.IsZero() {
+			_, _ = fmt.Fprintf(w, ", last %s %s", sched.LastRunAt.Local().Format(layout), sched.LastStatus)
+			if sched.LastWorkflowID != "" {
+				_, _ = fmt.Fprintf(w, " (%s)", sched.LastWorkflowID)
+			}
+			if sched.LastError != "" {
+				_, _ = fmt.Fprintf(w, ": %s", sched.LastError)
+			}
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+}
+
+func runScheduleDaemon(cmd *cobra.Command, _ []string) error {
+	cleanup, err := initServerLogging("schedule")
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	cfg.ResolvedBeadsDir = beadsDir
+
+	registryService, err := appreg.NewRegistryService(templates.RegistryFS(), appreg.UserRegistryBaseDir())
+	if err != nil {
+		log.Error(log.CatConfig, "Failed to create registry service", "error", err)
+		// Continue without registry service - prompts will only reference the epic
+	}
+
+	telemetry, err := tracing.NewProvider(controlplane.TracingConfig(cfg.Orchestration.Tracing))
+	if err != nil {
+		return fmt.Errorf("starting tracing: %w", err)
+	}
+
+	webhooks := webhook.New(controlplane.WebhookConfig(cfg.Orchestration.Webhooks))
+	cp, err := createDaemonControlPlane(&cfg, workDir, telemetry, webhooks)
+	if err != nil {
+		return fmt.Errorf("creating control plane: %w", err)
+	}
+
+	store := schedule.NewStore(filepath.Join(beadsDir, schedule.StoreFile))
+	scheduler := schedule.New(schedule.Config{
+		Store:        store,
+		ControlPlane: cp,
+		Prompt: func(templateID, epicID string) string {
+			return api.BuildCoordinatorPrompt(registryService, templateID, epicID)
+		},
+		WorkDir:  workDir,
+		Notifier: notify.NewDesktopNotifier(cfg.Notifications.Events),
+		Out:      cmd.OutOrStdout(),
+	})
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "Perles scheduler running schedules from %s\n", store.Path())
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Press Ctrl+C to stop")
+	scheduler.Run(ctx)
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), "\nShutting down...")
+
+	// Stop the running workflows and flush their webhooks and telemetry
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer shutdownCancel()
+	if err := cp.Shutdown(shutdownCtx); err != nil {
+		log.Error(log.CatOrch, "Error shutting down control plane", "error", err)
+	}
+	if err := webhooks.Close(shutdownCtx); err != nil {
+		log.Error(log.CatOrch, "Error flushing webhooks", "error", err)
+	}
+	if err := telemetry.Shutdown(shutdownCtx); err != nil {
+		log.Error(log.CatOrch, "Error shutting down tracing", "error", err)
+	}
+
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Perles scheduler stopped")
+	return nil
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/schedule"
+)
+
+func TestScheduleCommand_Registration(t *testing.T) {
+	names := make(map[string]bool)
+	for _, cmd := range scheduleCmd.Commands() {
+		names[cmd.Name()] = true
+	}
+	require.Equal(t, map[string]bool{"add": true, "remove": true, "list": true, "daemon": true}, names)
+	require.NotNil(t, scheduleAddCmd.Flags().Lookup("epic"))
+	require.Equal(t, schedule.DefaultTemplate, scheduleAddCmd.Flags().Lookup("template").DefValue)
+}
+
+func TestCheckScheduleTemplate(t *testing.T) {
+	require.NoError(t, checkScheduleTemplate("cook"))
+	require.EqualError(t, checkScheduleTemplate("nope"), `unknown workflow template "nope"`)
+}
+
+func TestPrintSchedules(t *testing.T) {
+	now := time.Date(2026, 3, 11, 10, 0, 0, 0, time.Local)
+	schedules := []schedule.Schedule{
+		{ID: "s1", Cron: "0 9 * * 1", EpicID: "perles-abc", Template: "cook", CreatedAt: now},
+		{
+			ID: "s2", Cron: "@daily", EpicID: "perles-def", Template: "cook", CreatedAt: now.AddDate(0, 0, -3),
+			LastRunAt: now.AddDate(0, 0, -1).Add(-10 * time.Hour), LastStatus: schedule.StatusFailed, LastWorkflowID: "wf-1",
+		},
+	}
+
+	var buf bytes.Buffer
+	printSchedules(&buf, schedules, now)
+	require.Equal(t, ""+
+		"s1  0 9 * * 1       perles-abc (cook)  next 2026-03-16 09:00\n"+
+		"s2  @daily          perles-def (cook)  next due now, last 2026-03-10 00:00 failed (wf-1)\n", buf.String())
+
+	buf.Reset()
+	printSchedules(&buf, nil, now)
+	require.Equal(t, "No schedules\n", buf.String())
+}
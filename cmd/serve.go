@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve orchestration over an authenticated HTTP API",
+	Long: `Run orchestration headless and expose it over an authenticated HTTP API,
+so web dashboards and remote CLIs can create, start and control workflows.
+
+Besides workflow lifecycle endpoints, the API exposes the runtime of started
+workflows under /api/v1/workflows/{id}/: processes, tasks, commands (messages,
+spawning, stopping and replacing processes, emergency stop, pausing and
+stopping the workflow) and Fabric channels. /api/v1/sessions lists persisted
+sessions and their Fabric history, including workflows that no longer run.
+
+Every request must present the API token as an "Authorization: Bearer <token>"
+header. Browsers can open the dashboard URL printed at startup, which carries
+the token once and keeps it in a cookie. The token is read from --token, then
+PERLES_API_TOKEN, then orchestration.api_token; if none is set a random token
+is generated and printed.
+
+The API is plain HTTP: when binding to a non-loopback address, put it behind a
+TLS-terminating proxy.
+
+Example:
+  perles serve                            # localhost, auto-assigned port
+  perles serve --addr 0.0.0.0:8080        # all interfaces, port 8080
+  PERLES_API_TOKEN=... perles serve -p 8080`,
+	RunE: runServe,
+}
+
+var (
+	serveAddr  string
+	servePort  int
+	serveToken string
+)
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().StringVar(&serveAddr, "addr", "", "listen address host:port (overrides --port)")
+	serveCmd.Flags().IntVarP(&servePort, "port", "p", 0, "API server port on localhost (0 = config api_port or auto-assign)")
+	serveCmd.Flags().StringVar(&serveToken, "token", "", "API token (default: $PERLES_API_TOKEN, then orchestration.api_token, else generated)")
+}
+
+func runServe(_ *cobra.Command, _ []string) error {
+	addr := serveAddr
+	if addr == "" {
+		port := servePort
+		if port == 0 {
+			port = cfg.Orchestration.APIPort
+		}
+		addr = net.JoinHostPort("localhost", strconv.Itoa(port))
+	}
+
+	token := serveToken
+	if token == "" {
+		token = os.Getenv("PERLES_API_TOKEN")
+	}
+	if token == "" {
+		token = cfg.Orchestration.APIToken
+	}
+	generated := token == ""
+	if generated {
+		var err error
+		if token, err = api.GenerateToken(); err != nil {
+			return err
+		}
+	}
+
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid --addr %q: %w", addr, err)
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+
+	return runAPIServer(apiServerOptions{
+		Name:  "serve",
+		Addr:  addr,
+		Token: token,
+		OnStarted: func(port int) {
+			base := "http://" + net.JoinHostPort(host, strconv.Itoa(port))
+			fmt.Printf("Perles API listening on %s/api/v1\n", base)
+			// Only a generated token is printed; a configured one stays out of the terminal
+			if generated {
+				fmt.Printf("API token: %s\n", token)
+				fmt.Printf("Dashboard: %s/?token=%s\n", base, token)
+			} else {
+				fmt.Printf("Dashboard: %s/?token=<your API token>\n", base)
+			}
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zjrosen/perles/internal/orchestration/session"
+)
+
+var sessionCmd = &cobra.Command{
+	Use:   "session",
+	Short: "Inspect orchestration sessions",
+}
+
+var sessionTimelineCmd = &cobra.Command{
+	Use:   "timeline <session-id|session-dir>",
+	Short: "Print the command and fabric event timeline of a session",
+	Long: `Print timeline.jsonl of an orchestration session: every processed command
+and fabric event in the order they happened, with the offset from the first entry.
+
+The session can be given as a full session ID, a unique ID prefix, or the path
+to the session directory.`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runSessionTimeline,
+}
+
+func init() {
+	sessionCmd.AddCommand(sessionTimelineCmd)
+	rootCmd.AddCommand(sessionCmd)
+}
+
+func runSessionTimeline(cmd *cobra.Command, args []string) error {
+	dir, err := resolveSessionDir(args[0])
+	if err != nil {
+		return err
+	}
+
+	entries, err := session.LoadTimeline(dir)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), "Timeline is empty")
+		return nil
+	}
+	return session.FormatTimeline(cmd.OutOrStdout(), entries)
+}
+
+// resolveSessionDir maps a session directory, ID or unique ID prefix to its session directory.
+func resolveSessionDir(arg string) (string, error) {
+	if info, err := os.Stat(arg); err == nil && info.IsDir() {
+		return arg, nil
+	}
+
+	baseDir := cfg.Orchestration.SessionStorage.BaseDir
+	if baseDir == "" {
+		baseDir = session.DefaultBaseDir()
+	}
+	index, err := session.LoadSessionIndex(session.NewSessionPathBuilder(baseDir, "").IndexPath())
+	if err != nil {
+		return "", fmt.Errorf("loading session index: %w", err)
+	}
+
+	var matches []session.SessionIndexEntry
+	for _, entry := range index.Sessions {
+		if entry.ID == arg {
+			return entry.SessionDir, nil
+		}
+		if strings.HasPrefix(entry.ID, arg) {
+			matches = append(matches, entry)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("session not found: %s", arg)
+	case 1:
+		return matches[0].SessionDir, nil
+	default:
+		return "", fmt.Errorf("session ID prefix %q is ambiguous (%d sessions)", arg, len(matches))
+	}
+}
@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/interop/github"
+)
+
+var (
+	syncDirection string
+	syncPrefer    string
+	syncDryRun    bool
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Sync issues with external trackers",
+}
+
+var syncGitHubCmd = &cobra.Command{
+	Use:   "github",
+	Short: "Sync issues with a GitHub repository",
+	Long: `Two-way sync between beads and the issues of the GitHub repository set in
+the github section of the config.
+
+GitHub issues are imported into beads, and beads issues matching
+github.export_query are created on GitHub. Linked issues are recorded in
+github-sync.json in the beads directory; each sync only looks at issues
+changed since the last one and copies title, description, status, priority,
+type and labels to the other side.
+
+An issue changed on both sides since the last sync is reported as a conflict
+and left alone. Resolve it by editing either side to match, or pick a winner
+with --prefer.
+
+Examples:
+  # Import and export everything that changed
+  perles sync github
+
+  # Only pull changes from GitHub
+  perles sync github --direction import
+
+  # See what would change without writing anything
+  perles sync github --dry-run
+
+  # Resolve conflicts in favor of GitHub
+  perles sync github --prefer github`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runSyncGitHub,
+}
+
+func init() {
+	syncGitHubCmd.Flags().StringVar(&syncDirection, "direction", string(github.DirectionBoth),
+		"which way changes flow: both, import (GitHub to beads) or export (beads to GitHub)")
+	syncGitHubCmd.Flags().StringVar(&syncPrefer, "prefer", "",
+		"resolve issues changed on both sides in favor of github or beads")
+	syncGitHubCmd.Flags().BoolVar(&syncDryRun, "dry-run", false,
+		"report what would change without writing to either side")
+	syncCmd.AddCommand(syncGitHubCmd)
+	rootCmd.AddCommand(syncCmd)
+}
+
+func runSyncGitHub(cmd *cobra.Command, args []string) error {
+	opts, err := syncOptions(syncDirection, syncPrefer, syncDryRun)
+	if err != nil {
+		return err
+	}
+	if err := config.ValidateGitHub(cfg.GitHub); err != nil {
+		return err
+	}
+
+	beadsDir, workDir, err := resolveArchiveBeadsDir()
+	if err != nil {
+		return err
+	}
+	statePath := filepath.Join(beadsDir, github.StateFile)
+	state, err := github.LoadState(statePath, cfg.GitHub.Repo)
+	if err != nil {
+		return err
+	}
+
+	var linked, candidates []beads.Issue
+	if ids := state.BeadsIDs(); len(ids) > 0 {
+		if linked, err = loadIssues(beadsDir, bql.BuildIDQuery(ids)); err != nil {
+			return err
+		}
+	}
+	if cfg.GitHub.ExportQuery != "" && opts.Direction != github.DirectionImport {
+		if candidates, err = loadIssues(beadsDir, cfg.GitHub.ExportQuery); err != nil {
+			return fmt.Errorf("github.export_query: %w", err)
+		}
+	}
+
+	syncer := github.Syncer{
+		Client:  github.NewRESTClient(cfg.GitHub.APIURL, cfg.GitHub.Repo, cfg.GitHub.Token),
+		Beads:   infrabeads.NewBDExecutor(workDir, beadsDir),
+		Mapping: github.NewMapping(cfg.GitHub),
+	}
+	report, err := syncer.Sync(state, linked, candidates, opts)
+	if err != nil {
+		return err
+	}
+	if !opts.DryRun {
+		if err := state.Save(statePath); err != nil {
+			return err
+		}
+	}
+	return printSyncReport(cmd.OutOrStdout(), report, opts.DryRun)
+}
+
+// syncOptions validates the sync flags.
+func syncOptions(direction, prefer string, dryRun bool) (github.Options, error) {
+	opts := github.Options{Direction: github.Direction(direction), Prefer: github.Side(prefer), DryRun: dryRun}
+	switch opts.Direction {
+	case github.DirectionBoth, github.DirectionImport, github.DirectionExport:
+	default:
+		return opts, fmt.Errorf("invalid --direction %q: want both, import or export", direction)
+	}
+	switch opts.Prefer {
+	case github.SideNone, github.SideGitHub, github.SideBeads:
+	default:
+		return opts, fmt.Errorf("invalid --prefer %q: want github or beads", prefer)
+	}
+	return opts, nil
+}
+
+// syncVerbs are the past-tense labels of sync actions.
+var syncVerbs = map[github.ActionKind]string{
+	github.ActionImported: "Imported",
+	github.ActionExported: "Exported",
+	github.ActionPulled:   "Pulled",
+	github.ActionPushed:   "Pushed",
+}
+
+// printSyncReport lists the actions of a sync followed by a summary, and
+// returns an error if any issue failed to sync.
+func printSyncReport(w io.Writer, report github.Report, dryRun bool) error {
+	for _, a := range report.Actions {
+		ref := syncRef(a)
+		switch {
+		case a.Err != nil:
+			_, _ = fmt.Fprintf(w, "Failed to %s %s %s: %v\n", strings.TrimSuffix(string(a.Kind), "ed"), ref, a.Title, a.Err)
+		case a.Kind == github.ActionConflict:
+			_, _ = fmt.Fprintf(w, "Conflict %s %s: changed on both sides, use --prefer to resolve\n", ref, a.Title)
+		case dryRun:
+			_, _ = fmt.Fprintf(w, "Would have %s %s %s\n", a.Kind, ref, a.Title)
+		default:
+			_, _ = fmt.Fprintf(w, "%s %s %s\n", syncVerbs[a.Kind], ref, a.Title)
+		}
+	}
+	_, _ = fmt.Fprintln(w, report.Summary())
+	if n := len(report.Failed()); n > 0 {
+		return fmt.Errorf("%d issue(s) failed to sync", n)
+	}
+	return nil
+}
+
+// syncRef names the issues of an action, e.g. "bd-1 <-> #4".
+func syncRef(a github.Action) string {
+	switch {
+	case a.Number == 0:
+		return a.BeadsID
+	case a.BeadsID == "":
+		return fmt.Sprintf("#%d", a.Number)
+	default:
+		return fmt.Sprintf("%s <-> #%d", a.BeadsID, a.Number)
+	}
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/interop/github"
+)
+
+func TestSyncCommand_Registration(t *testing.T) {
+	require.Equal(t, syncCmd, syncGitHubCmd.Parent())
+	require.NotNil(t, syncGitHubCmd.Flags().Lookup("direction"))
+	require.NotNil(t, syncGitHubCmd.Flags().Lookup("prefer"))
+	require.NotNil(t, syncGitHubCmd.Flags().Lookup("dry-run"))
+}
+
+func TestSyncOptions(t *testing.T) {
+	opts, err := syncOptions("import", "github", true)
+	require.NoError(t, err)
+	require.Equal(t, github.Options{Direction: github.DirectionImport, Prefer: github.SideGitHub, DryRun: true}, opts)
+
+	_, err = syncOptions("sideways", "", false)
+	require.EqualError(t, err, `invalid --direction "sideways": want both, import or export`)
+	_, err = syncOptions("both", "mine", false)
+	require.EqualError(t, err, `invalid --prefer "mine": want github or beads`)
+}
+
+func TestPrintSyncReport(t *testing.T) {
+	report := github.Report{Actions: []github.Action{
+		{Kind: github.ActionImported, BeadsID: "bd-5", Number: 5, Title: "From GitHub"},
+		{Kind: github.ActionExported, BeadsID: "bd-9", Number: 12, Title: "To GitHub"},
+		{Kind: github.ActionConflict, BeadsID: "bd-1", Number: 1, Title: "Both"},
+		{Kind: github.ActionImported, Number: 6, Title: "Broken", Err: errors.New("bd failed")},
+	}}
+
+	var buf bytes.Buffer
+	err := printSyncReport(&buf, report, false)
+	require.EqualError(t, err, "1 issue(s) failed to sync")
+	require.Equal(t, ""+
+		"Imported bd-5 <-> #5 From GitHub\n"+
+		"Exported bd-9 <-> #12 To GitHub\n"+
+		"Conflict bd-1 <-> #1 Both: changed on both sides, use --prefer to resolve\n"+
+		"Failed to import #6 Broken: bd failed\n"+
+		"1 imported, 1 exported, 1 conflict, 1 failed\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, printSyncReport(&buf, github.Report{Actions: []github.Action{
+		{Kind: github.ActionExported, BeadsID: "bd-9", Title: "To GitHub"},
+	}}, true))
+	require.Equal(t, "Would have exported bd-9 To GitHub\n1 exported\n", buf.String())
+}
@@ -2,7 +2,7 @@ package cmd
 
 import (
 	"fmt"
-	"sort"
+	"os"
 
 	"github.com/zjrosen/perles/internal/ui/styles"
 
@@ -12,7 +12,7 @@ import (
 var themesCmd = &cobra.Command{
 	Use:   "themes",
 	Short: "List available theme presets",
-	Long:  `Display all built-in theme presets that can be used in your config file.`,
+	Long:  `Display all built-in theme presets and user themes that can be used in your config file.`,
 	Run:   runThemes,
 }
 
@@ -24,12 +24,21 @@ func runThemes(cmd *cobra.Command, args []string) {
 	fmt.Println("Available theme presets:")
 	fmt.Println()
 
-	// Sort preset names for consistent output
-	names := make([]string, 0, len(styles.Presets))
-	for name := range styles.Presets {
-		names = append(names, name)
+	// Include user themes from the config file
+	for _, theme := range cfg.Theme.Themes {
+		err := styles.RegisterTheme(styles.UserTheme{
+			Name:        theme.Name,
+			Description: theme.Description,
+			Base:        theme.Base,
+			Colors:      theme.FlattenedColors(),
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Skipping user theme: %v\n", err)
+		}
 	}
-	sort.Strings(names)
+
+	// Built-in presets first, then user themes
+	names := styles.PresetNames()
 
 	// Find max name length for alignment
 	maxLen := 0
@@ -55,4 +64,14 @@ func runThemes(cmd *cobra.Command, args []string) {
 	fmt.Println("    preset: dracula")
 	fmt.Println("    colors:")
 	fmt.Println("      status.error: \"#FF0000\"")
+	fmt.Println()
+	fmt.Println("Define your own theme:")
+	fmt.Println("  theme:")
+	fmt.Println("    themes:")
+	fmt.Println("      - name: ocean")
+	fmt.Println("        base: light")
+	fmt.Println("        colors:")
+	fmt.Println("          text.primary: \"#003366\"")
+	fmt.Println()
+	fmt.Println("Switch themes with a live preview using ctrl+y.")
 }
@@ -1,27 +1,26 @@
 package cmd
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
 	"net/http"
 	"os"
-	"os/exec"
+	"regexp"
+	"runtime"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
-)
-
-var versionFlag string
 
-// lookPath is the function used to check if executables are in PATH.
-// It defaults to exec.LookPath and can be overridden in tests.
-var lookPath = exec.LookPath
+	"github.com/zjrosen/perles/internal/selfupdate"
+)
 
-// execCommand is the function used to create exec.Cmd instances.
-// It defaults to exec.Command and can be overridden in tests.
-var execCommand = exec.Command
+var (
+	versionFlag     string
+	channelFlag     string
+	minisignKeyFlag string
+	cosignKeyFlag   string
+)
 
 // printInfo is the function used to print informational messages.
 // It defaults to fmt.Println and can be overridden in tests.
@@ -33,111 +32,138 @@ var printInfo = func(msg string) {
 // It defaults to os.Executable and can be overridden in tests.
 var getExecutable = os.Executable
 
-// ErrCurlNotFound is returned when curl is not available in PATH.
-var ErrCurlNotFound = errors.New("curl not found")
+// installBinary replaces the executable at target with the downloaded binary.
+// It defaults to selfupdate.Replace and can be overridden in tests.
+var installBinary = selfupdate.Replace
 
-// ErrScriptFailed is returned when the install script execution fails.
-var ErrScriptFailed = errors.New("script execution failed")
-
-const installScriptURL = "https://raw.githubusercontent.com/zjrosen/perles/main/install.sh"
-const githubReleasesAPI = "https://api.github.com/repos/zjrosen/perles/releases/latest"
+// releasesAPI is the GitHub API endpoint releases are fetched from.
+// It can be overridden in tests.
+var releasesAPI = selfupdate.DefaultAPIURL
 
 // httpClient is the HTTP client used to fetch release info.
 // It can be overridden in tests.
 var httpClient = &http.Client{Timeout: 10 * time.Second}
 
+// downloadClient is the HTTP client used to download release assets.
+// It can be overridden in tests.
+var downloadClient = &http.Client{Timeout: 5 * time.Minute}
+
 // getVersion returns the current version of perles.
 // It can be overridden in tests.
 var getVersion = func() string {
 	return version
 }
 
-// githubRelease represents the GitHub API response for a release.
-type githubRelease struct {
-	TagName string `json:"tag_name"`
-}
-
 var updateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update perles to the latest version",
-	Long: `Update perles to the latest version by running the install script.
+	Long: `Update perles to the latest version by downloading the release for this
+platform from GitHub and replacing the running binary.
 
-By default, updates to the latest release. Use --version to install a specific version.
+The release archive must match its SHA256 in the release's checksums.txt.
+When a minisign or cosign public key is configured (--minisign-key,
+--cosign-key or update.minisign_key / update.cosign_key in the config), the
+checksums file must also carry a valid signature; verification runs the
+minisign or cosign tool, which must be installed. The new binary must run
+before it replaces the current one, and the current one is restored if the
+replacement fails.
+
+By default, updates to the latest stable release. Use --channel prerelease to
+also consider prereleases, or --version to install a specific version.
 
 Examples:
   perles update              # Update to latest version
-  perles update --version v1.0.0  # Install specific version`,
+  perles update --version v1.0.0  # Install specific version
+  perles update --channel prerelease  # Update to the newest release, including prereleases
+  perles update --minisign-key RWQ...  # Also require a minisign signature`,
 	RunE: runUpdate,
 }
 
 func init() {
 	rootCmd.AddCommand(updateCmd)
 	updateCmd.Flags().StringVarP(&versionFlag, "version", "v", "", "specific version to install (e.g., v1.0.0)")
+	updateCmd.Flags().StringVar(&channelFlag, "channel", string(selfupdate.ChannelStable), "release channel to follow: stable or prerelease")
+	updateCmd.Flags().StringVar(&minisignKeyFlag, "minisign-key", "", "minisign public key (or .pub file) checksums.txt must be signed with (default: update.minisign_key)")
+	updateCmd.Flags().StringVar(&cosignKeyFlag, "cosign-key", "", "cosign public key checksums.txt must be signed with (default: update.cosign_key)")
 }
 
 func runUpdate(cmd *cobra.Command, args []string) error {
+	channel, err := selfupdate.ParseChannel(channelFlag)
+	if err != nil {
+		return err
+	}
+
 	// Check if installed via Homebrew first
 	if isHomebrewInstallation() {
 		printInfo("perles was installed via Homebrew. Use: brew upgrade perles")
 		return nil
 	}
 
-	// Check prerequisites before attempting update
-	if err := checkPrerequisites(); err != nil {
-		return err
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
 	}
 
-	// If no specific version requested, check if already on latest
+	// Resolve the release to install
+	var release *selfupdate.Release
 	if versionFlag == "" {
-		currentVersion := getVersion()
-		latestVersion, err := fetchLatestRelease()
-		if err == nil && isAlreadyLatest(currentVersion, latestVersion) {
-			printInfo(fmt.Sprintf("Already on the latest version (%s)", latestVersion))
+		latest, err := fetchLatestRelease(channel)
+		if err != nil {
+			return err
+		}
+		if currentVersion := getVersion(); isAlreadyLatest(currentVersion, latest.TagName) {
+			printInfo(fmt.Sprintf("Already on the latest version (%s)", latest.TagName))
 			return nil
 		}
-		// If we can't fetch latest, proceed with update anyway
-	}
-
-	// Display informational message before update
-	if versionFlag != "" {
-		printInfo(fmt.Sprintf("Installing version: %s", versionFlag))
+		release = latest
+		if release.Prerelease {
+			printInfo(fmt.Sprintf("Updating to latest prerelease (%s)...", release.TagName))
+		} else {
+			printInfo(fmt.Sprintf("Updating to latest version (%s)...", release.TagName))
+		}
 	} else {
-		printInfo("Updating to latest version...")
+		printInfo(fmt.Sprintf("Installing version: %s", versionFlag))
+		tagged, err := (&selfupdate.Client{APIURL: releasesAPI, HTTP: httpClient}).Tag(ctx, versionFlag)
+		if err != nil {
+			return err
+		}
+		release = tagged
 	}
 
-	// Execute the install script
-	return executeInstallScript(versionFlag)
-}
-
-// executeInstallScript runs the install.sh script via curl | bash.
-// If version is non-empty, it sets the VERSION environment variable.
-func executeInstallScript(version string) error {
-	script := fmt.Sprintf("curl -sSL %s | bash", installScriptURL)
-	bashCmd := execCommand("bash", "-c", script)
-
-	// Set VERSION environment variable if specified
-	bashCmd.Env = os.Environ()
-	if version != "" {
-		bashCmd.Env = append(bashCmd.Env, "VERSION="+version)
+	// Download and verify the binary for this platform
+	signature := selfupdate.Signature{Minisign: cfg.Update.MinisignKey, Cosign: cfg.Update.CosignKey}
+	if minisignKeyFlag != "" {
+		signature.Minisign = minisignKeyFlag
 	}
-
-	// Stream stdout and stderr to terminal for progress visibility
-	bashCmd.Stdout = os.Stdout
-	bashCmd.Stderr = os.Stderr
-
-	if err := bashCmd.Run(); err != nil {
-		return fmt.Errorf("%w: %s", ErrScriptFailed, err.Error())
+	if cosignKeyFlag != "" {
+		signature.Cosign = cosignKeyFlag
+	}
+	updater := &selfupdate.Updater{
+		Client:    &selfupdate.Client{APIURL: releasesAPI, HTTP: downloadClient},
+		Signature: signature,
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+	}
+	binary, err := updater.Fetch(ctx, release)
+	if err != nil {
+		return fmt.Errorf("updating to %s: %w", release.TagName, err)
+	}
+	if signature.Enabled() {
+		printInfo("Verified checksum and signature of " + selfupdate.ArchiveName(release.TagName, updater.GOOS, updater.GOARCH))
+	} else {
+		printInfo("Verified checksum of " + selfupdate.ArchiveName(release.TagName, updater.GOOS, updater.GOARCH))
 	}
 
-	return nil
-}
-
-// checkPrerequisites verifies required tools are available before attempting update.
-func checkPrerequisites() error {
-	_, err := lookPath("curl")
+	// Swap the running binary
+	execPath, err := getExecutable()
 	if err != nil {
-		return fmt.Errorf("%w: curl is required but not found in PATH. Install curl and try again", ErrCurlNotFound)
+		return fmt.Errorf("locating the perles binary: %w", err)
+	}
+	if err := installBinary(ctx, execPath, binary); err != nil {
+		return fmt.Errorf("updating to %s: %w", release.TagName, err)
 	}
+
+	printInfo(fmt.Sprintf("Updated perles to %s", release.TagName))
 	return nil
 }
 
@@ -170,40 +196,30 @@ func isHomebrewInstallation() bool {
 	return false
 }
 
-// fetchLatestRelease fetches the latest release tag from GitHub.
-func fetchLatestRelease() (string, error) {
-	resp, err := httpClient.Get(githubReleasesAPI)
-	if err != nil {
-		return "", err
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("GitHub API returned status %d", resp.StatusCode)
-	}
-
-	var release githubRelease
-	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
-		return "", err
-	}
-
-	return release.TagName, nil
+// fetchLatestRelease fetches the latest release of a channel from GitHub.
+func fetchLatestRelease(channel selfupdate.Channel) (*selfupdate.Release, error) {
+	return (&selfupdate.Client{APIURL: releasesAPI, HTTP: httpClient}).Latest(context.Background(), channel)
 }
 
+// gitDescribeSuffix matches the suffix git describe adds to builds past a tag.
+var gitDescribeSuffix = regexp.MustCompile(`-\d+-g[0-9a-f]+(-dirty)?$|-dirty$`)
+
 // isAlreadyLatest compares current and latest versions.
 // Returns true if current matches latest (with or without 'v' prefix).
 // Handles dev versions like "v0.7.2-6-gaa951141-dirty" by extracting base version.
+// A prerelease latest like "v0.8.0-rc.1" only matches that exact prerelease.
 func isAlreadyLatest(current, latest string) bool {
 	current = strings.TrimPrefix(current, "v")
 	latest = strings.TrimPrefix(latest, "v")
 
+	if strings.Contains(latest, "-") {
+		return gitDescribeSuffix.ReplaceAllString(current, "") == latest
+	}
+
 	// Extract base version (before any -suffix like -6-gaa951141-dirty)
 	if idx := strings.Index(current, "-"); idx != -1 {
 		current = current[:idx]
 	}
-	if idx := strings.Index(latest, "-"); idx != -1 {
-		latest = latest[:idx]
-	}
 
 	return current == latest
 }
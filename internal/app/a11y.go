@@ -0,0 +1,130 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/mode/dashboard"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/ui/shared/a11y"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+// newAnnouncer creates the announcer for accessibility mode, or returns nil
+// when it is disabled. If an announcement file is configured but can't be
+// opened, announcements are only shown on screen.
+func newAnnouncer(cfg config.AccessibilityConfig) (*a11y.Announcer, *os.File) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	if cfg.AnnounceFile == "" {
+		return a11y.NewAnnouncer(nil), nil
+	}
+
+	path := cfg.AnnounceFile
+	if rest, ok := cutHome(path); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, rest)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		log.Warn(log.CatConfig, "Failed to create announcement log directory", "path", path, "error", err)
+		return a11y.NewAnnouncer(nil), nil
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600) //nolint:gosec // path comes from user config
+	if err != nil {
+		log.Warn(log.CatConfig, "Failed to open announcement log", "path", path, "error", err)
+		return a11y.NewAnnouncer(nil), nil
+	}
+	return a11y.NewAnnouncer(f), f
+}
+
+// cutHome strips a leading "~/" from path.
+func cutHome(path string) (string, bool) {
+	if len(path) >= 2 && path[0] == '~' && path[1] == '/' {
+		return path[2:], true
+	}
+	return "", false
+}
+
+// announceEvent announces important events carried by msg: toasts, the
+// start and end of long-running operations and orchestration events.
+func (m Model) announceEvent(msg tea.Msg) {
+	switch msg := msg.(type) {
+	case controlplane.ControlPlaneEvent:
+		m.announcer.Announce(dashboard.EventAnnouncement(msg))
+	case mode.ShowToastMsg:
+		m.announcer.Announce(toastPrefix(msg.Style) + msg.Message)
+	case mode.StartProgressMsg:
+		m.announcer.Announce("Started: " + msg.Progress.Label)
+	case mode.FinishProgressMsg:
+		if msg.Message != "" {
+			m.announcer.Announce(toastPrefix(msg.Style) + msg.Message)
+		}
+	}
+}
+
+// toastPrefix labels a toast so its meaning doesn't depend on color or icon.
+func toastPrefix(style toaster.Style) string {
+	switch style {
+	case toaster.StyleSuccess:
+		return "Done: "
+	case toaster.StyleError:
+		return "Error: "
+	case toaster.StyleWarn:
+		return "Warning: "
+	default:
+		return "Info: "
+	}
+}
+
+// announceFocus announces the focus description when it changed.
+func (m Model) announceFocus() Model {
+	focus := m.focusDescription()
+	if focus != m.lastFocus {
+		m.lastFocus = focus
+		m.announcer.Announce(focus)
+	}
+	return m
+}
+
+// focusDescription describes what has focus in plain text, starting with
+// the overlay or panel in front, then the active mode.
+func (m Model) focusDescription() string {
+	switch {
+	case m.quitModal.IsVisible():
+		return "Quit confirmation: Are you sure you want to quit? Enter to quit, Esc to cancel"
+	case m.commandPalette != nil:
+		return "Command palette"
+	case m.diffViewer.Visible():
+		return "Diff viewer"
+	case m.debugMode && m.logOverlay.Visible():
+		return "Debug log"
+	case m.chatPanelFocused && m.chatPanel.Visible() && m.currentMode != mode.ModeDashboard:
+		return "Chat panel"
+	}
+
+	var (
+		name       string
+		controller any
+	)
+	switch m.currentMode {
+	case mode.ModeSearch:
+		name, controller = "Search", m.search
+	case mode.ModeDashboard:
+		name, controller = "Orchestration dashboard", m.dashboard
+	default:
+		name, controller = "Board", m.kanban
+	}
+	if d, ok := controller.(a11y.Describer); ok {
+		if desc := d.FocusDescription(); desc != "" {
+			return desc
+		}
+	}
+	return name
+}
@@ -4,6 +4,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -28,16 +29,27 @@ import (
 	"github.com/zjrosen/perles/internal/mode/kanban"
 	"github.com/zjrosen/perles/internal/mode/search"
 	"github.com/zjrosen/perles/internal/mode/shared"
+	"github.com/zjrosen/perles/internal/notify"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane/api"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/mcp"
 	"github.com/zjrosen/perles/internal/orchestration/session"
+	"github.com/zjrosen/perles/internal/orchestration/tracing"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/pubsub"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
 	domain "github.com/zjrosen/perles/internal/sessions/domain"
 	"github.com/zjrosen/perles/internal/sound"
 
+	"github.com/zjrosen/perles/internal/ui/board"
+	"github.com/zjrosen/perles/internal/ui/commandpalette"
+	"github.com/zjrosen/perles/internal/ui/modals/themeswitcher"
+	"github.com/zjrosen/perles/internal/ui/shared/a11y"
 	"github.com/zjrosen/perles/internal/ui/shared/chatpanel"
 	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
 	"github.com/zjrosen/perles/internal/ui/shared/editor"
@@ -60,6 +72,12 @@ type Model struct {
 	// ControlPlane for multi-workflow management (lazy initialized on dashboard entry)
 	controlPlane controlplane.ControlPlane
 
+	// Tracing and metrics of the control plane's workflows (created with the control plane)
+	telemetry *tracing.Provider
+
+	// Lifecycle event webhooks of the control plane's workflows (created with the control plane)
+	webhooks *webhook.Dispatcher
+
 	// Shared services (passed to mode controllers)
 	services mode.Services
 
@@ -77,6 +95,15 @@ type Model struct {
 	// Diff viewer overlay
 	diffViewer diffviewer.Model
 
+	// Theme switcher overlay (live preview of color themes)
+	themeSwitcher themeswitcher.Model
+
+	// Command palette overlay (nil when hidden), the commands it lists, and
+	// the registry of app-level commands
+	commandPalette  *commandpalette.Model
+	paletteCommands []mode.Command
+	commands        *mode.CommandRegistry
+
 	// Chat panel for Kanban/Search modes (excluded from orchestration)
 	chatPanel        chatpanel.Model
 	chatPanelFocused bool
@@ -92,6 +119,9 @@ type Model struct {
 	watcherCancel   context.CancelFunc
 	watcherListener *pubsub.ContinuousListener[watcher.WatcherEvent]
 
+	// Set while a recurring issue check runs (see materializeRecurrences)
+	recurrenceRunning bool
+
 	// Quit confirmation modal (for chat panel Ctrl+C)
 	quitModal quitmodal.Model
 
@@ -108,6 +138,11 @@ type Model struct {
 
 	// SQLite database for session persistence (owned by app, closed on shutdown)
 	db *sqlite.DB
+
+	// Accessibility mode (nil announcer when disabled)
+	announcer    *a11y.Announcer
+	announceFile *os.File // Announcement log (owned by app, closed on shutdown)
+	lastFocus    string   // Last announced focus description
 }
 
 // NewWithConfig creates a new application model with the provided configuration.
@@ -167,6 +202,19 @@ func NewWithConfig(
 		// Silently ignore watcher init errors - app works fine without auto-refresh
 	}
 
+	// Register user themes so they can be selected as a preset or from the switcher
+	for _, theme := range cfg.Theme.Themes {
+		err := styles.RegisterTheme(styles.UserTheme{
+			Name:        theme.Name,
+			Description: theme.Description,
+			Base:        theme.Base,
+			Colors:      theme.FlattenedColors(),
+		})
+		if err != nil {
+			log.Warn(log.CatConfig, "Skipped user theme", "error", err)
+		}
+	}
+
 	// Apply theme colors from config
 	themeCfg := styles.ThemeConfig{
 		Preset: cfg.Theme.Preset,
@@ -188,7 +236,7 @@ func NewWithConfig(
 	// Create BQL executor only if client is available (nil when beads DB not present)
 	var bqlExec bql.BQLExecutor
 	if client != nil {
-		bqlExec = bql.NewExecutor(client.DB(), bqlCache, depGraphCache)
+		bqlExec = bql.NewExecutor(client.DB(), bqlCache, depGraphCache).WithIncludeArchived(cfg.IncludeArchived)
 	}
 
 	services := mode.Services{
@@ -203,6 +251,7 @@ func NewWithConfig(
 		Clock:         shared.RealClock{},
 		Flags:         flagService,
 		Sounds:        sound.NewSystemSoundService(cfg.Sound.Events),
+		Notifier:      notify.NewDesktopNotifier(cfg.Notifications.Events),
 		GitExecutorFactory: func(path string) appgit.GitExecutor {
 			return infragit.NewRealExecutor(path)
 		},
@@ -249,6 +298,8 @@ func NewWithConfig(
 	}
 	cp := chatpanel.New(chatPanelCfg)
 
+	announcer, announceFile := newAnnouncer(cfg.UI.Accessibility)
+
 	return Model{
 		currentMode:      mode.ModeKanban,
 		kanban:           kanban.New(services),
@@ -260,6 +311,8 @@ func NewWithConfig(
 		debugMode:        debugMode,
 		logListenCmd:     logListenCmd,
 		diffViewer:       dv,
+		themeSwitcher:    themeswitcher.New(),
+		commands:         newCommandRegistry(),
 		chatPanel:        cp,
 		watcherHandle:    watcherHandle,
 		watcherCtx:       watcherCtx,
@@ -272,7 +325,9 @@ func NewWithConfig(
 			Title:   "Exit Application?",
 			Message: "Are you sure you want to quit?",
 		}),
-		db: db,
+		db:           db,
+		announcer:    announcer,
+		announceFile: announceFile,
 	}, nil
 }
 
@@ -292,11 +347,25 @@ func (m Model) Init() tea.Cmd {
 	if m.logListenCmd != nil {
 		cmds = append(cmds, m.logListenCmd)
 	}
+
+	// Check recurring issues now, then every recurrenceInterval
+	cmds = append(cmds, func() tea.Msg { return recurrenceTickMsg{} })
 	return tea.Batch(cmds...)
 }
 
 // Update implements tea.Model.
+// In accessibility mode, events and focus changes are announced afterwards.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	result, cmd := m.update(msg)
+	if next, ok := result.(Model); ok && next.announcer != nil {
+		next.announceEvent(msg)
+		result = next.announceFocus()
+	}
+	return result, cmd
+}
+
+// update handles a message.
+func (m Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Handle quit modal first when visible (captures all input)
 	if m.quitModal.IsVisible() {
 		var cmd tea.Cmd
@@ -328,6 +397,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.toaster = m.toaster.SetSize(msg.Width, msg.Height)
 		m.logOverlay.SetSize(msg.Width, msg.Height)
 		m.diffViewer = m.diffViewer.SetSize(msg.Width, msg.Height)
+		m.themeSwitcher = m.themeSwitcher.SetSize(msg.Width, msg.Height)
+		if m.commandPalette != nil {
+			palette := m.commandPalette.SetSize(msg.Width, msg.Height)
+			m.commandPalette = &palette
+		}
 		m.chatPanel = m.chatPanel.SetSize(m.chatPanelWidth(), m.chatPanelHeight())
 		m.quitModal.SetSize(msg.Width, msg.Height)
 
@@ -355,6 +429,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 
+		// Route mouse events to theme switcher when visible (drawn above the diff viewer)
+		if m.themeSwitcher.Visible() {
+			var cmd tea.Cmd
+			m.themeSwitcher, cmd = m.themeSwitcher.Update(msg)
+			return m, cmd
+		}
+
+		// Route mouse events to the command palette when visible
+		if m.commandPalette != nil {
+			palette, cmd := m.commandPalette.Update(msg)
+			m.commandPalette = &palette
+			return m, cmd
+		}
+
 		// Route mouse events to diff viewer when visible
 		if m.diffViewer.Visible() {
 			var cmd tea.Cmd
@@ -376,12 +464,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case controlplane.ControlPlaneEvent:
+		// Patch issues changed by orchestration in the active issue pane
+		var issueCmd tea.Cmd
+		if issueEvent, ok := msg.Payload.(events.IssueEvent); ok && msg.Type.IsIssueEvent() {
+			switch m.currentMode {
+			case mode.ModeKanban:
+				m.kanban, issueCmd = m.kanban.UpdateIssue(issueEvent.IssueID, issueEvent.Apply)
+			case mode.ModeSearch:
+				m.search, issueCmd = m.search.UpdateIssue(issueEvent.IssueID, issueEvent.Apply)
+			}
+		}
+
 		// Forward ControlPlane events to dashboard even when not in dashboard mode.
 		// This keeps the dashboard's cached UI state updated in the background.
 		if m.dashboard.IsInitialized() && m.currentMode != mode.ModeDashboard {
 			result, cmd := m.dashboard.Update(msg)
 			m.dashboard = result.(dashboard.Model)
-			return m, cmd
+			return m, tea.Batch(issueCmd, cmd)
+		}
+		if issueCmd != nil {
+			return m, issueCmd
 		}
 
 	case tea.KeyMsg:
@@ -398,6 +500,33 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 
+		// Theme switcher takes precedence when visible
+		if m.themeSwitcher.Visible() {
+			var cmd tea.Cmd
+			m.themeSwitcher, cmd = m.themeSwitcher.Update(msg)
+			return m, cmd
+		}
+
+		// Command palette takes precedence when visible
+		if m.commandPalette != nil {
+			palette, cmd := m.commandPalette.Update(msg)
+			m.commandPalette = &palette
+			return m, cmd
+		}
+
+		// Open the theme switcher from any mode
+		if key.Matches(msg, keys.App.ThemeSwitcher) {
+			m.themeSwitcher = m.themeSwitcher.Show()
+			return m, nil
+		}
+
+		// Cancel the latest cancelable long-running operation, from any mode
+		if key.Matches(msg, keys.App.CancelProgress) {
+			if p, ok := m.toaster.LatestCancelable(); ok {
+				return m.cancelProgress(p)
+			}
+		}
+
 		// Handle Ctrl+W to toggle chat panel (not in dashboard mode)
 		// Dashboard mode has its own coordinator panel toggle
 		if key.Matches(msg, keys.App.ToggleChatPanel) && m.currentMode != mode.ModeDashboard {
@@ -432,6 +561,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, cmd
 		}
 
+		// Open the command palette unless the active mode is capturing keys
+		// (modals and text inputs use ctrl+p/ctrl+k themselves)
+		if key.Matches(msg, keys.App.CommandPalette) && m.canOpenCommandPalette() {
+			return m.openCommandPalette()
+		}
+
 		// Handle global mode switching between Kanban and Search
 		// (Ctrl+Space, which is ctrl+@ in terminals)
 		if key.Matches(msg, keys.Kanban.SwitchMode) {
@@ -501,7 +636,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				ControlPlane:    m.controlPlane,
 				WorkflowCreator: m.workflowCreator,
 				RegistryService: m.registryService,
+				SessionBaseDir:  m.services.Config.Orchestration.SessionStorage.BaseDir,
 				FrontendFS:      frontend.DistFS(),
+				Token:           m.services.Config.Orchestration.APIToken,
 			})
 			if err != nil {
 				log.Error(log.CatOrch, "Failed to create API server", "error", err)
@@ -533,6 +670,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			GitExecutorFactory: m.services.GitExecutorFactory,
 			WorkDir:            m.services.WorkDir,
 			APIPort:            m.apiServerPort,
+			APIToken:           m.services.Config.Orchestration.APIToken,
 			DebugMode:          m.debugMode,
 			VimMode:            m.services.Config.UI.VimMode,
 			ObserverEnabled:    m.services.Config.Orchestration.IsObserverEnabled(),
@@ -589,7 +727,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case mode.ModeDashboard:
 				m.dashboard, modeCmd = m.dashboard.HandleDBChanged()
 			}
-			return m, tea.Batch(modeCmd, m.watcherListener.Listen())
+			var recurCmd tea.Cmd
+			m, recurCmd = m.materializeRecurrences()
+			return m, tea.Batch(modeCmd, recurCmd, m.watcherListener.Listen())
 
 		case watcher.WatcherError:
 			log.Warn(log.CatWatcher, "Watcher error received", "error", msg.Payload.Error)
@@ -674,6 +814,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.quitModal.Show()
 		return m, nil
 
+	case board.WIPLimitExceededMsg:
+		return m.handleWIPLimitExceeded(msg)
+
+	case recurrenceTickMsg:
+		var cmd tea.Cmd
+		m, cmd = m.materializeRecurrences()
+		return m, tea.Batch(cmd, recurrenceTick())
+
+	case recurrencesMaterializedMsg:
+		return m.handleRecurrencesMaterialized(msg)
+
 	case mode.ShowToastMsg:
 		m.toaster = m.toaster.Show(msg.Message, msg.Style)
 
@@ -684,11 +835,62 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		return m, nil
 
+	case mode.StartProgressMsg:
+		ticking := m.toaster.HasProgress()
+		m.toaster = m.toaster.StartProgress(msg.Progress)
+		if ticking {
+			return m, nil
+		}
+		return m, toaster.ScheduleProgressTick()
+
+	case mode.FinishProgressMsg:
+		m.toaster = m.toaster.FinishProgress(msg.ID)
+		if msg.Message == "" {
+			return m, nil
+		}
+		m.toaster = m.toaster.Show(msg.Message, msg.Style)
+		return m, toaster.ScheduleDismiss(3 * time.Second)
+
+	case toaster.ProgressTickMsg:
+		// Stop ticking once nothing is in progress; StartProgressMsg restarts it
+		if !m.toaster.HasProgress() {
+			return m, nil
+		}
+		m.toaster = m.toaster.Tick(msg.Time)
+		return m, toaster.ScheduleProgressTick()
+
 	case logoverlay.CloseMsg:
 		m.logOverlay.Hide()
 
 		return m, nil
 
+	case themeswitcher.SelectMsg:
+		return m.handleThemeSelected(msg.Preset)
+
+	case commandSelectedMsg:
+		return m.handleCommandSelected(msg.id)
+
+	case commandPaletteClosedMsg:
+		m.commandPalette = nil
+		m.paletteCommands = nil
+		return m, nil
+
+	case showThemeSwitcherMsg:
+		m.themeSwitcher = m.themeSwitcher.Show()
+		return m, nil
+
+	case applyThemeMsg:
+		return m.handleApplyTheme(msg.preset)
+
+	case toggleChatPanelMsg:
+		if m.currentMode == mode.ModeDashboard {
+			return m, nil
+		}
+		return m.handleToggleChatPanel()
+
+	case switchModeMsg:
+		return m.switchMode()
+
 	case diffviewer.ShowDiffViewerMsg:
 		var cmd tea.Cmd
 		m.diffViewer, cmd = m.diffViewer.ShowAndLoad()
@@ -851,6 +1053,62 @@ func (m Model) handleSaveSearchAsColumn(msg search.SaveSearchAsColumnMsg) (tea.M
 	return m, nil
 }
 
+// cancelProgress requests cancellation of a long-running operation. The
+// indicator stays up until the operation reports that it finished; if it
+// already finished, the stale indicator is removed.
+func (m Model) cancelProgress(p toaster.Progress) (tea.Model, tea.Cmd) {
+	if !p.Cancel() {
+		m.toaster = m.toaster.FinishProgress(p.ID)
+		return m, nil
+	}
+	m.toaster = m.toaster.Show("Cancel requested: "+p.Label, toaster.StyleInfo)
+	return m, toaster.ScheduleDismiss(3 * time.Second)
+}
+
+// handleWIPLimitExceeded warns the user and notifies the coordinator of every
+// running workflow that a board column has grown past its WIP limit.
+func (m Model) handleWIPLimitExceeded(msg board.WIPLimitExceededMsg) (tea.Model, tea.Cmd) {
+	log.Info(log.CatMode, "WIP limit exceeded",
+		"view", msg.ViewName, "column", msg.ColumnName, "count", msg.Count, "limit", msg.Limit)
+
+	toast := func() tea.Msg {
+		return mode.ShowToastMsg{
+			Message: fmt.Sprintf("WIP limit exceeded: %s (%d/%d)", msg.ColumnName, msg.Count, msg.Limit),
+			Style:   toaster.StyleWarn,
+		}
+	}
+
+	if m.controlPlane == nil {
+		return m, toast
+	}
+
+	cp := m.controlPlane
+	notify := func() tea.Msg {
+		workflows, err := cp.List(context.Background(), controlplane.ListQuery{
+			States: []controlplane.WorkflowState{controlplane.WorkflowRunning},
+		})
+		if err != nil {
+			log.Warn(log.CatOrch, "Failed to list workflows for WIP notification", "error", err)
+			return nil
+		}
+
+		content := fmt.Sprintf(
+			"[WIP LIMIT] Column %q in view %q has %d issues (limit %d). "+
+				"Finish or review in-flight work before starting new tasks.",
+			msg.ColumnName, msg.ViewName, msg.Count, msg.Limit)
+		for _, wf := range workflows {
+			if wf.Infrastructure == nil || wf.Infrastructure.Core.CmdSubmitter == nil {
+				continue
+			}
+			wf.Infrastructure.Core.CmdSubmitter.Submit(
+				command.NewSendToProcessCommand(command.SourceUser, repository.CoordinatorID, content))
+		}
+		return nil
+	}
+
+	return m, tea.Batch(toast, notify)
+}
+
 // handleSaveSearchToNewView processes a request to create a new view from search.
 func (m Model) handleSaveSearchToNewView(msg search.SaveSearchToNewViewMsg) (tea.Model, tea.Cmd) {
 	// Create the column config
@@ -1114,6 +1372,29 @@ func (m Model) handleNewSessionRequest() (tea.Model, tea.Cmd) {
 	)
 }
 
+// handleThemeSelected persists a theme chosen in the theme switcher.
+// The theme is already applied; only the config file needs updating.
+func (m Model) handleThemeSelected(preset string) (tea.Model, tea.Cmd) {
+	if m.services.Config != nil {
+		m.services.Config.Theme.Preset = preset
+	}
+	if m.services.ConfigPath == "" {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Theme: " + preset, Style: toaster.StyleInfo}
+		}
+	}
+
+	if err := config.SaveThemePreset(m.services.ConfigPath, preset); err != nil {
+		log.ErrorErr(log.CatConfig, "Failed to save theme", err, "preset", preset)
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Theme applied but not saved: " + err.Error(), Style: toaster.StyleError}
+		}
+	}
+	return m, func() tea.Msg {
+		return mode.ShowToastMsg{Message: "Theme: " + preset, Style: toaster.StyleSuccess}
+	}
+}
+
 // View implements tea.Model.
 func (m Model) View() string {
 	// Determine if chat panel should be shown (excluded from dashboard mode which has its own coordinator panel)
@@ -1151,6 +1432,16 @@ func (m Model) View() string {
 		view = m.diffViewer.Overlay(view)
 	}
 
+	// Overlay theme switcher when visible
+	if m.themeSwitcher.Visible() {
+		view = m.themeSwitcher.Overlay(view)
+	}
+
+	// Overlay command palette when visible
+	if m.commandPalette != nil {
+		view = m.commandPalette.Overlay(view)
+	}
+
 	// Overlay log viewer on top (only in debug mode when visible)
 	if m.debugMode && m.logOverlay.Visible() {
 		view = m.logOverlay.Overlay(view)
@@ -1161,6 +1452,10 @@ func (m Model) View() string {
 		view = m.quitModal.Overlay(view)
 	}
 
+	if m.announcer != nil {
+		view = a11y.WithAnnouncement(a11y.Linearize(view), m.announcer.Latest(), m.width)
+	}
+
 	return view
 }
 
@@ -1181,6 +1476,24 @@ func (m *Model) Close() error {
 		}
 	}
 
+	// Deliver the last lifecycle events
+	if m.webhooks != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.webhooks.Close(ctx); err != nil {
+			log.Error(log.CatOrch, "Error flushing webhooks", "error", err)
+		}
+	}
+
+	// Flush spans and metrics of the stopped workflows
+	if m.telemetry != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := m.telemetry.Shutdown(ctx); err != nil {
+			log.Error(log.CatOrch, "Error shutting down tracing", "error", err)
+		}
+	}
+
 	// Close mode controllers
 	if err := m.kanban.Close(); err != nil {
 		return err
@@ -1207,6 +1520,11 @@ func (m *Model) Close() error {
 		}
 	}
 
+	// Close the announcement log
+	if m.announceFile != nil {
+		_ = m.announceFile.Close()
+	}
+
 	// Close SQLite database connection
 	if m.db != nil {
 		if err := m.db.Close(); err != nil {
@@ -1247,18 +1565,59 @@ func (m *Model) createControlPlane() controlplane.ControlPlane {
 	// Create session factory for workflow session tracking
 	sessionFactory := session.NewFactory(session.FactoryConfig{
 		BaseDir:     orchConfig.SessionStorage.BaseDir,
+		Redaction:   controlplane.RedactionPolicy(orchConfig.Redaction),
 		GitExecutor: m.services.GitExecutorFactory(m.services.WorkDir),
 	})
 
+	// Solo mode: the processor assigns tasks instead of a coordinator agent
+	var solo *controlplane.SoloOptions
+	if orchConfig.IsSolo() {
+		solo = &controlplane.SoloOptions{Workers: orchConfig.Solo.WorkerCount(), Coordinator: orchConfig.Solo.Coordinator}
+	}
+
+	// Trace and measure the command pipeline when configured
+	if m.telemetry == nil {
+		telemetry, err := tracing.NewProvider(controlplane.TracingConfig(orchConfig.Tracing))
+		if err != nil {
+			log.Warn(log.CatOrch, "Failed to start tracing, continuing without it", "error", err)
+			telemetry, _ = tracing.NewProvider(tracing.Config{})
+		}
+		m.telemetry = telemetry
+	}
+
+	// Post lifecycle events to the configured webhooks
+	if m.webhooks == nil {
+		m.webhooks = webhook.New(controlplane.WebhookConfig(orchConfig.Webhooks))
+	}
+
 	// Create supervisor with full configuration
 	supervisor, err := controlplane.NewSupervisor(controlplane.SupervisorConfig{
 		AgentProviders:     orchConfig.AgentProviders(),
 		WorkflowRegistry:   m.workflowRegistry,
 		GitExecutorFactory: m.services.GitExecutorFactory,
 		WorktreeTimeout:    orchConfig.Timeouts.WorktreeCreation,
+		Autoscale:          controlplane.AutoscalePolicy(orchConfig.Autoscale),
+		FabricStorage:      orchConfig.Fabric.Storage,
+		ProjectMemory:      orchConfig.Fabric.ProjectMemory,
+		CustomFields:       m.services.Config.FieldDefs(),
+		RateLimits:         controlplane.RateLimitPolicy(orchConfig.RateLimits),
+		Dedup:              mcp.DedupPolicy{Window: orchConfig.Dedup.Window, Strategy: mcp.DedupStrategy(orchConfig.Dedup.Strategy)},
+		AuditMaxSize:       orchConfig.Audit.MaxSize(),
+		DAGPath:            orchConfig.DAG,
+		TurnPolicy:         controlplane.TurnPolicy(orchConfig.TurnPolicy),
+		Tracer:             m.telemetry.EnabledTracer(),
+		Metrics:            m.telemetry.Metrics(),
+		Webhooks:           m.webhooks,
+		WorkerBudget:       controlplane.WorkerBudget(orchConfig.Budget),
+		SessionBudget:      controlplane.SessionBudget(orchConfig.Budget),
+		PhaseTimeouts:      controlplane.PhaseTimeouts(orchConfig.PhaseTimeouts),
+		Solo:               solo,
+		PruningHints:       orchConfig.PruningHints,
+		WorkerWorktrees:    orchConfig.WorkerWorktrees,
 		Flags:              m.services.Flags,
 		SessionFactory:     sessionFactory,
 		SoundService:       m.services.Sounds,
+		Notifier:           m.services.Notifier,
 		BeadsDir:           m.services.Config.ResolvedBeadsDir,
 	})
 	if err != nil {
@@ -1,11 +1,14 @@
 package app
 
 import (
+	"bytes"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
 	"testing/fstest"
+	"time"
 	"unsafe"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -15,6 +18,7 @@ import (
 
 	beadsapp "github.com/zjrosen/perles/internal/beads/application"
 	beadsdomain "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/flags"
 	"github.com/zjrosen/perles/internal/mocks"
@@ -23,11 +27,18 @@ import (
 	"github.com/zjrosen/perles/internal/mode/kanban"
 	"github.com/zjrosen/perles/internal/mode/search"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
+	"github.com/zjrosen/perles/internal/ui/board"
+	"github.com/zjrosen/perles/internal/ui/modals/themeswitcher"
+	"github.com/zjrosen/perles/internal/ui/shared/a11y"
 	"github.com/zjrosen/perles/internal/ui/shared/chatpanel"
 	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
 	"github.com/zjrosen/perles/internal/ui/shared/editor"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+	"github.com/zjrosen/perles/internal/ui/styles"
 )
 
 // TestMain initializes the global zone manager for all tests in this package.
@@ -94,6 +105,7 @@ func createTestModel(t *testing.T) Model {
 		search:      search.New(services),
 		services:    services,
 		chatPanel:   chatpanel.New(chatPanelCfg),
+		commands:    newCommandRegistry(),
 		width:       100,
 		height:      40,
 	}
@@ -511,6 +523,22 @@ func TestApp_SearchModeReceivesUpdates(t *testing.T) {
 	require.NotEmpty(t, view, "view should render")
 }
 
+func TestApp_IssueEventUpdatesActivePane(t *testing.T) {
+	m := createTestModel(t)
+	event := controlplane.NewControlPlaneEvent(controlplane.EventIssueUpdated,
+		events.IssueEvent{Type: events.IssueStatusChanged, IssueID: "perles-abc1", Status: beadsdomain.StatusClosed})
+
+	// Kanban highlights the issue and schedules the end of the highlight
+	_, cmd := m.Update(event)
+	require.NotNil(t, cmd, "kanban should schedule the end of the highlight")
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlAt})
+	m = newModel.(Model)
+	require.Equal(t, mode.ModeSearch, m.currentMode)
+	_, cmd = m.Update(event)
+	require.NotNil(t, cmd, "search should schedule the end of the highlight")
+}
+
 func TestApp_ModeSwitchRoundTrip(t *testing.T) {
 	m := createTestModel(t)
 
@@ -924,6 +952,152 @@ func TestApp_ShowDiffViewer(t *testing.T) {
 	require.NotNil(t, cmd, "should return LoadDiff command")
 }
 
+func TestApp_CtrlY_OpensThemeSwitcher(t *testing.T) {
+	m := createTestModel(t)
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	m = newModel.(Model)
+	require.True(t, m.themeSwitcher.Visible(), "ctrl+y should open the theme switcher")
+	require.Nil(t, cmd)
+
+	// Keys are routed to the switcher while it is open
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = newModel.(Model)
+	require.Equal(t, m.themeSwitcher.Selected(), styles.ActiveTheme(), "selection should be previewed")
+
+	newModel, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = newModel.(Model)
+	require.False(t, m.themeSwitcher.Visible())
+	require.Equal(t, themeswitcher.CancelMsg{}, cmd())
+	require.Equal(t, "default", styles.ActiveTheme(), "esc should restore the previous theme")
+}
+
+func TestApp_ThemeSwitcher_ReceivesMouse(t *testing.T) {
+	m := createTestModel(t)
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlY})
+	m = newModel.(Model)
+	before := m.themeSwitcher.Selected()
+
+	newModel, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	m = newModel.(Model)
+	require.True(t, m.themeSwitcher.Visible())
+	require.NotEqual(t, before, m.themeSwitcher.Selected(), "wheel should move the switcher selection")
+	require.Equal(t, m.themeSwitcher.Selected(), styles.ActiveTheme(), "selection should be previewed")
+}
+
+func TestApp_ThemeSelected_SavesPreset(t *testing.T) {
+	m := createTestModel(t)
+	m.services.ConfigPath = filepath.Join(t.TempDir(), ".perles.yaml")
+
+	newModel, cmd := m.Update(themeswitcher.SelectMsg{Preset: "nord"})
+	m = newModel.(Model)
+
+	require.Equal(t, "nord", m.services.Config.Theme.Preset)
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, toaster.StyleSuccess, toast.Style)
+
+	data, err := os.ReadFile(m.services.ConfigPath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "preset: nord")
+}
+
+func TestApp_CommandPalette_Opens(t *testing.T) {
+	for _, keyType := range []tea.KeyType{tea.KeyCtrlP, tea.KeyCtrlK} {
+		m := createTestModel(t)
+
+		newModel, cmd := m.Update(tea.KeyMsg{Type: keyType})
+		m = newModel.(Model)
+		require.NotNil(t, m.commandPalette, "%s should open the command palette", keyType)
+		require.NotNil(t, cmd, "should start the cursor blink")
+
+		// Contextual, mode-scoped and global commands are all listed
+		for _, id := range []string{"kanban.dashboard", "app.chat-panel", "app.switch-mode", "app.theme-switcher"} {
+			_, ok := mode.FindCommand(m.paletteCommands, id)
+			require.True(t, ok, "expected command %q", id)
+		}
+		require.Len(t, m.commandPalette.FilteredItems(), len(m.paletteCommands))
+	}
+}
+
+func TestApp_CommandPalette_RunsSelectedCommand(t *testing.T) {
+	m := createTestModel(t)
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = newModel.(Model)
+
+	// Fuzzy match "Switch theme"
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("swtheme")})
+	m = newModel.(Model)
+	selected, ok := m.commandPalette.Selected()
+	require.True(t, ok)
+	require.Equal(t, "app.theme-switcher", selected.ID)
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = newModel.(Model)
+	require.NotNil(t, cmd)
+
+	newModel, cmd = m.Update(cmd())
+	m = newModel.(Model)
+	require.Nil(t, m.commandPalette, "palette should close after selecting")
+	require.NotNil(t, cmd)
+
+	newModel, _ = m.Update(cmd())
+	m = newModel.(Model)
+	require.True(t, m.themeSwitcher.Visible(), "command should open the theme switcher")
+}
+
+func TestApp_CommandPalette_EscCloses(t *testing.T) {
+	m := createTestModel(t)
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = newModel.(Model)
+
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = newModel.(Model)
+	require.NotNil(t, m.commandPalette, "palette closes once the cancel message arrives")
+
+	newModel, _ = m.Update(cmd())
+	m = newModel.(Model)
+	require.Nil(t, m.commandPalette)
+	require.Nil(t, m.paletteCommands)
+}
+
+func TestApp_CommandPalette_NotOpenedOverModeOverlay(t *testing.T) {
+	m := createTestModel(t)
+
+	// Open the kanban help overlay
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	m = newModel.(Model)
+	require.False(t, m.kanban.Idle())
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = newModel.(Model)
+	require.Nil(t, m.commandPalette, "ctrl+p belongs to the open overlay")
+}
+
+func TestApp_CommandPalette_ApplyTheme(t *testing.T) {
+	m := createTestModel(t)
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+
+	newModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = newModel.(Model)
+	c, ok := mode.FindCommand(m.paletteCommands, "app.theme.nord")
+	require.True(t, ok)
+
+	newModel, cmd := m.Update(c.Run())
+	m = newModel.(Model)
+	require.Equal(t, "nord", styles.ActiveTheme())
+	require.Equal(t, "nord", m.services.Config.Theme.Preset)
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Theme: nord", toast.Message)
+}
+
 func TestApp_HideDiffViewer(t *testing.T) {
 	m := createTestModel(t)
 
@@ -2372,3 +2546,126 @@ func TestApp_ForwardsExternalEditorMessages(t *testing.T) {
 	// verify the update didn't panic and the model is still valid
 	require.True(t, m.chatPanel.Visible(), "panel should still be visible after editor message")
 }
+
+func TestApp_WIPLimitExceeded_ShowsWarningToast(t *testing.T) {
+	m := createTestModel(t)
+
+	_, cmd := m.Update(board.WIPLimitExceededMsg{ViewName: "Work", ColumnName: "Doing", Count: 4, Limit: 3})
+	require.NotNil(t, cmd)
+
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "command should produce ShowToastMsg")
+	require.Equal(t, "WIP limit exceeded: Doing (4/3)", toastMsg.Message)
+	require.Equal(t, toaster.StyleWarn, toastMsg.Style)
+}
+
+func TestApp_ProgressLifecycle(t *testing.T) {
+	m := createTestModel(t)
+
+	// Starting the first operation starts the spinner tick
+	newModel, cmd := m.Update(mode.StartProgressMsg{Progress: toaster.Progress{ID: "wf-1/cmd-1", Label: "Spawning worker"}})
+	m = newModel.(Model)
+	require.NotNil(t, cmd, "first progress should schedule a tick")
+	require.True(t, m.toaster.HasProgress())
+
+	// A second operation reuses the running tick
+	newModel, cmd = m.Update(mode.StartProgressMsg{Progress: toaster.Progress{ID: "wf-1/cmd-2", Label: "Stopping process"}})
+	m = newModel.(Model)
+	require.Nil(t, cmd)
+
+	newModel, cmd = m.Update(toaster.ProgressTickMsg{Time: time.Now()})
+	m = newModel.(Model)
+	require.NotNil(t, cmd, "tick continues while operations are running")
+
+	newModel, _ = m.Update(mode.FinishProgressMsg{ID: "wf-1/cmd-1", Message: "Spawning worker done (2s)", Style: toaster.StyleSuccess})
+	m = newModel.(Model)
+	newModel, _ = m.Update(mode.FinishProgressMsg{ID: "wf-1/cmd-2"})
+	m = newModel.(Model)
+	require.False(t, m.toaster.HasProgress())
+	require.Contains(t, m.View(), "Spawning worker done (2s)")
+
+	_, cmd = m.Update(toaster.ProgressTickMsg{Time: time.Now()})
+	require.Nil(t, cmd, "tick stops once nothing is in progress")
+}
+
+func TestApp_CancelProgressKey(t *testing.T) {
+	m := createTestModel(t)
+
+	canceled := false
+	newModel, _ := m.Update(mode.StartProgressMsg{Progress: toaster.Progress{
+		ID:     "wf-1/cmd-1",
+		Label:  "Spawning worker",
+		Cancel: func() bool { canceled = true; return true },
+	}})
+	m = newModel.(Model)
+
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlB})
+	m = newModel.(Model)
+	require.True(t, canceled)
+	require.True(t, m.toaster.HasProgress(), "indicator stays until the operation reports it finished")
+
+	// An operation that already finished is dropped instead
+	newModel, _ = m.Update(mode.StartProgressMsg{Progress: toaster.Progress{
+		ID:     "wf-1/cmd-1",
+		Label:  "Spawning worker",
+		Cancel: func() bool { return false },
+	}})
+	m = newModel.(Model)
+	newModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlB})
+	m = newModel.(Model)
+	require.False(t, m.toaster.HasProgress())
+}
+
+func TestApp_AccessibilityAnnouncesFocusAndToasts(t *testing.T) {
+	var buf bytes.Buffer
+	m := createTestModel(t)
+	m.announcer = a11y.NewAnnouncer(&buf)
+
+	result, _ := m.Update(mode.ShowToastMsg{Message: "Save failed", Style: toaster.StyleError})
+	m = result.(Model)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.True(t, strings.HasPrefix(lines[0], "Error: Save failed"))
+	require.True(t, strings.HasPrefix(lines[1], "Board"), "focus is announced: %q", lines[1])
+
+	// Unchanged focus is not announced again
+	result, _ = m.Update(mode.ShowToastMsg{Message: "Saved", Style: toaster.StyleSuccess})
+	m = result.(Model)
+	require.Equal(t, "Done: Saved", m.announcer.Latest())
+	require.Equal(t, 3, strings.Count(buf.String(), "\n"))
+
+	view := m.View()
+	require.NotContains(t, view, "│")
+	require.Contains(t, view, "» Done: Saved")
+}
+
+func TestApp_RecurringIssuesMaterializedOnce(t *testing.T) {
+	m := createTestModel(t)
+	closed := beadsdomain.Issue{ID: "bd-1", TitleText: "Bump deps", Status: beadsdomain.StatusClosed, Labels: []string{"recur:on-close"}}
+
+	bqlExecutor := mocks.NewMockBQLExecutor(t)
+	bqlExecutor.EXPECT().Execute(bql.RecurringQuery).Return([]beadsdomain.Issue{closed}, nil).Once()
+	m.services.Executor = bqlExecutor
+	m.services.BeadsExecutor = mocks.NewMockIssueExecutor(t)
+
+	m, cmd := m.materializeRecurrences()
+	require.True(t, m.recurrenceRunning)
+	require.NotNil(t, cmd)
+
+	// A second check while the first runs is skipped
+	_, skipped := m.materializeRecurrences()
+	require.Nil(t, skipped)
+
+	// The mock executor cannot create issues, so the check reports a failure
+	done := cmd().(recurrencesMaterializedMsg)
+	require.Len(t, done.results, 1)
+
+	result, cmd := m.Update(done)
+	m = result.(Model)
+	require.False(t, m.recurrenceRunning)
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Failed to create next instance of bd-1", toast.Message)
+	require.Equal(t, toaster.StyleError, toast.Style)
+}
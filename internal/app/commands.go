@@ -0,0 +1,156 @@
+package app
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/commandpalette"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// commandSelectedMsg is sent when a command is picked in the command palette.
+type commandSelectedMsg struct {
+	id string
+}
+
+// commandPaletteClosedMsg is sent when the command palette is dismissed.
+type commandPaletteClosedMsg struct{}
+
+// showThemeSwitcherMsg opens the theme switcher.
+type showThemeSwitcherMsg struct{}
+
+// applyThemeMsg applies and saves a theme preset.
+type applyThemeMsg struct {
+	preset string
+}
+
+// toggleChatPanelMsg shows or hides the chat panel.
+type toggleChatPanelMsg struct{}
+
+// switchModeMsg switches between kanban and search mode.
+type switchModeMsg struct{}
+
+// newCommandRegistry creates the registry with the app-level commands.
+// Modes contribute their contextual commands through mode.CommandSource.
+func newCommandRegistry() *mode.CommandRegistry {
+	r := mode.NewCommandRegistry()
+
+	r.Register(func() []mode.Command {
+		cmds := []mode.Command{{
+			ID:    "app.theme-switcher",
+			Title: "Switch theme",
+			Key:   keys.App.ThemeSwitcher.Help().Key,
+			Run:   func() tea.Msg { return showThemeSwitcherMsg{} },
+		}}
+		active := styles.ActiveTheme()
+		for _, name := range styles.PresetNames() {
+			if name == active {
+				continue
+			}
+			cmds = append(cmds, mode.Command{
+				ID:    "app.theme." + name,
+				Title: "Theme: " + name,
+				Run:   func() tea.Msg { return applyThemeMsg{preset: name} },
+			})
+		}
+		return cmds
+	})
+
+	chat := func() []mode.Command {
+		return []mode.Command{{
+			ID:    "app.chat-panel",
+			Title: "Toggle chat panel",
+			Key:   keys.App.ToggleChatPanel.Help().Key,
+			Run:   func() tea.Msg { return toggleChatPanelMsg{} },
+		}}
+	}
+	r.RegisterFor(mode.ModeKanban, chat)
+	r.RegisterFor(mode.ModeSearch, chat)
+
+	r.RegisterFor(mode.ModeKanban, func() []mode.Command {
+		return []mode.Command{{
+			ID:    "app.switch-mode",
+			Title: "Switch to search",
+			Key:   keys.Kanban.SwitchMode.Help().Key,
+			Run:   func() tea.Msg { return switchModeMsg{} },
+		}}
+	})
+
+	return r
+}
+
+// activeCommandSource returns the active mode's contextual commands, or nil
+// if the mode doesn't contribute any.
+func (m Model) activeCommandSource() mode.CommandSource {
+	var controller any
+	switch m.currentMode {
+	case mode.ModeSearch:
+		controller = m.search
+	case mode.ModeDashboard:
+		controller = m.dashboard
+	default:
+		controller = m.kanban
+	}
+	src, _ := controller.(mode.CommandSource)
+	return src
+}
+
+// canOpenCommandPalette reports whether the active mode isn't capturing keys
+// in a modal, overlay or text input.
+func (m Model) canOpenCommandPalette() bool {
+	src := m.activeCommandSource()
+	return src == nil || src.Idle()
+}
+
+// openCommandPalette shows the command palette with the commands of the
+// active mode followed by the app-wide ones.
+func (m Model) openCommandPalette() (tea.Model, tea.Cmd) {
+	var contextual []mode.Command
+	if src := m.activeCommandSource(); src != nil {
+		contextual = src.Commands()
+	}
+	m.paletteCommands = m.commands.Commands(m.currentMode, contextual)
+
+	items := make([]commandpalette.Item, len(m.paletteCommands))
+	for i, c := range m.paletteCommands {
+		items[i] = commandpalette.Item{ID: c.ID, Name: c.Title, Description: c.Description, Hint: c.Key}
+	}
+
+	palette := commandpalette.New(commandpalette.Config{
+		Title:           "Commands",
+		Placeholder:     "Type a command...",
+		Items:           items,
+		OnSelect:        func(item commandpalette.Item) tea.Msg { return commandSelectedMsg{id: item.ID} },
+		OnCancel:        func() tea.Msg { return commandPaletteClosedMsg{} },
+		MaxWidth:        60,
+		MaxVisibleItems: 8,
+		Fuzzy:           true,
+	}).SetSize(m.width, m.height)
+	m.commandPalette = &palette
+	return m, palette.Init()
+}
+
+// handleCommandSelected closes the palette and runs the picked command.
+func (m Model) handleCommandSelected(id string) (tea.Model, tea.Cmd) {
+	m.commandPalette = nil
+	c, ok := mode.FindCommand(m.paletteCommands, id)
+	m.paletteCommands = nil
+	if !ok {
+		return m, nil
+	}
+	log.Debug(log.CatMode, "Running palette command", "id", id)
+	return m, c.Run
+}
+
+// handleApplyTheme applies a theme preset picked in the command palette and saves it.
+func (m Model) handleApplyTheme(preset string) (tea.Model, tea.Cmd) {
+	if err := styles.SetPreset(preset); err != nil {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Theme not applied: " + err.Error(), Style: toaster.StyleError}
+		}
+	}
+	return m.handleThemeSelected(preset)
+}
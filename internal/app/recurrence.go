@@ -0,0 +1,88 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+// recurrenceInterval is how often scheduled recurrences are checked while
+// perles runs. On-close recurrences are also checked on every DB change.
+const recurrenceInterval = time.Hour
+
+// recurrenceTickMsg triggers the periodic recurrence check.
+type recurrenceTickMsg struct{}
+
+// recurrencesMaterializedMsg carries the outcome of a recurrence check.
+type recurrencesMaterializedMsg struct {
+	results []appbeads.RecurrenceResult
+}
+
+// recurrenceTick schedules the next periodic recurrence check.
+func recurrenceTick() tea.Cmd {
+	return tea.Tick(recurrenceInterval, func(time.Time) tea.Msg { return recurrenceTickMsg{} })
+}
+
+// materializeRecurrences creates the next instance of every due recurring
+// issue in the background. Only one check runs at a time: creating an
+// instance changes the DB before the old instance loses its recurrence label,
+// and a second check in between would copy it twice.
+func (m Model) materializeRecurrences() (Model, tea.Cmd) {
+	executor, beadsExecutor := m.services.Executor, m.services.BeadsExecutor
+	if executor == nil || beadsExecutor == nil || m.recurrenceRunning {
+		return m, nil
+	}
+	m.recurrenceRunning = true
+
+	now := time.Now()
+	if m.services.Clock != nil {
+		now = m.services.Clock.Now()
+	}
+	return m, func() tea.Msg {
+		issues, err := executor.Execute(bql.RecurringQuery)
+		if err != nil {
+			log.Warn(log.CatBeads, "Failed to load recurring issues", "error", err)
+			return recurrencesMaterializedMsg{}
+		}
+		return recurrencesMaterializedMsg{results: appbeads.MaterializeRecurrences(beadsExecutor, issues, now)}
+	}
+}
+
+// handleRecurrencesMaterialized reports created instances and failures.
+func (m Model) handleRecurrencesMaterialized(msg recurrencesMaterializedMsg) (Model, tea.Cmd) {
+	m.recurrenceRunning = false
+
+	var created, failed []string
+	for _, r := range msg.results {
+		if r.NextID != "" {
+			created = append(created, r.NextID)
+		}
+		if r.Err != nil {
+			log.Warn(log.CatBeads, "Failed to create recurring issue", "from", r.FromID, "error", r.Err)
+			failed = append(failed, r.FromID)
+		}
+	}
+
+	var cmds []tea.Cmd
+	switch {
+	case len(created) == 1:
+		cmds = append(cmds, showToast(fmt.Sprintf("Created recurring issue %s", created[0]), toaster.StyleSuccess))
+	case len(created) > 1:
+		cmds = append(cmds, showToast(fmt.Sprintf("Created %d recurring issues", len(created)), toaster.StyleSuccess))
+	}
+	if len(failed) > 0 {
+		cmds = append(cmds, showToast(fmt.Sprintf("Failed to create next instance of %s", failed[0]), toaster.StyleError))
+	}
+	return m, tea.Batch(cmds...)
+}
+
+func showToast(message string, style toaster.Style) tea.Cmd {
+	return func() tea.Msg { return mode.ShowToastMsg{Message: message, Style: style} }
+}
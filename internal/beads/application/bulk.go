@@ -0,0 +1,75 @@
+package application
+
+import (
+	"errors"
+
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// ErrParentUnsupported is reported for each issue when the executor cannot
+// assign issues to an epic.
+var ErrParentUnsupported = errors.New("assigning to an epic is not supported")
+
+// BulkUpdate applies opts to every issue in issueIDs and reports the outcome
+// per issue. It uses the executor's BulkUpdater when available, so the
+// change is a single backend operation; otherwise each issue is updated in
+// turn and failures do not stop the remaining updates.
+func BulkUpdate(executor IssueExecutor, issueIDs []string, opts domain.BulkUpdateOptions) domain.BulkResult {
+	if len(issueIDs) == 0 || opts.IsEmpty() {
+		return domain.BulkResult{}
+	}
+
+	if updater, ok := executor.(BulkUpdater); ok {
+		result, err := updater.BulkUpdate(issueIDs, opts)
+		if err != nil {
+			return failAll(issueIDs, err)
+		}
+		return result
+	}
+
+	var result domain.BulkResult
+	for _, id := range issueIDs {
+		if err := updateOne(executor, id, opts); err != nil {
+			result.Failed = append(result.Failed, domain.BulkFailure{IssueID: id, Err: err})
+			continue
+		}
+		result.Updated = append(result.Updated, id)
+	}
+	return result
+}
+
+// updateOne applies opts to a single issue using the IssueWriter port.
+func updateOne(executor IssueExecutor, issueID string, opts domain.BulkUpdateOptions) error {
+	if opts.ParentID != nil && *opts.ParentID != "" {
+		return ErrParentUnsupported
+	}
+
+	if opts.Status != nil || opts.Priority != nil {
+		if err := executor.UpdateIssue(issueID, domain.UpdateIssueOptions{
+			Status:   opts.Status,
+			Priority: opts.Priority,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(opts.AddLabels) > 0 || len(opts.RemoveLabels) > 0 {
+		issue, err := executor.ShowIssue(issueID)
+		if err != nil {
+			return err
+		}
+		if err := executor.SetLabels(issueID, opts.ApplyLabels(issue.Labels)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// failAll reports err for every issue.
+func failAll(issueIDs []string, err error) domain.BulkResult {
+	result := domain.BulkResult{Failed: make([]domain.BulkFailure, 0, len(issueIDs))}
+	for _, id := range issueIDs {
+		result.Failed = append(result.Failed, domain.BulkFailure{IssueID: id, Err: err})
+	}
+	return result
+}
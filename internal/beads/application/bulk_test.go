@@ -0,0 +1,76 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+// bulkExecutor adds a BulkUpdater implementation to the IssueExecutor mock.
+type bulkExecutor struct {
+	*mocks.MockIssueExecutor
+	update func(ids []string, opts domain.BulkUpdateOptions) (domain.BulkResult, error)
+}
+
+func (e bulkExecutor) BulkUpdate(ids []string, opts domain.BulkUpdateOptions) (domain.BulkResult, error) {
+	return e.update(ids, opts)
+}
+
+func TestBulkUpdate_UsesBulkUpdater(t *testing.T) {
+	status := domain.StatusClosed
+	opts := domain.BulkUpdateOptions{Status: &status}
+
+	var gotIDs []string
+	executor := bulkExecutor{
+		MockIssueExecutor: mocks.NewMockIssueExecutor(t),
+		update: func(ids []string, got domain.BulkUpdateOptions) (domain.BulkResult, error) {
+			gotIDs = ids
+			require.Equal(t, opts, got)
+			return domain.BulkResult{Updated: ids}, nil
+		},
+	}
+
+	result := appbeads.BulkUpdate(executor, []string{"PROJ-1", "PROJ-2"}, opts)
+	require.Equal(t, []string{"PROJ-1", "PROJ-2"}, gotIDs)
+	require.Equal(t, []string{"PROJ-1", "PROJ-2"}, result.Updated)
+	require.Empty(t, result.Failed)
+
+	executor.update = func([]string, domain.BulkUpdateOptions) (domain.BulkResult, error) {
+		return domain.BulkResult{}, errors.New("database locked")
+	}
+	result = appbeads.BulkUpdate(executor, []string{"PROJ-1", "PROJ-2"}, opts)
+	require.Empty(t, result.Updated)
+	require.Len(t, result.Failed, 2)
+	require.EqualError(t, result.Failed[1].Err, "database locked")
+}
+
+func TestBulkUpdate_FallsBackToPerIssueUpdates(t *testing.T) {
+	priority := domain.Priority(1)
+	opts := domain.BulkUpdateOptions{Priority: &priority, AddLabels: []string{"ui"}, RemoveLabels: []string{"stale"}}
+
+	executor := mocks.NewMockIssueExecutor(t)
+	executor.EXPECT().UpdateIssue("PROJ-1", domain.UpdateIssueOptions{Priority: &priority}).Return(nil)
+	executor.EXPECT().ShowIssue("PROJ-1").Return(&domain.Issue{ID: "PROJ-1", Labels: []string{"bug", "stale"}}, nil)
+	executor.EXPECT().SetLabels("PROJ-1", []string{"bug", "ui"}).Return(nil)
+	executor.EXPECT().UpdateIssue("PROJ-2", domain.UpdateIssueOptions{Priority: &priority}).Return(errors.New("not found"))
+
+	result := appbeads.BulkUpdate(executor, []string{"PROJ-1", "PROJ-2"}, opts)
+	require.Equal(t, []string{"PROJ-1"}, result.Updated)
+	require.Equal(t, "Updated 1 of 2 issues (1 failed: PROJ-2: not found)", result.Summary())
+}
+
+func TestBulkUpdate_FallbackRejectsEpicAssignment(t *testing.T) {
+	epic := "PROJ-9"
+	result := appbeads.BulkUpdate(mocks.NewMockIssueExecutor(t), []string{"PROJ-1"}, domain.BulkUpdateOptions{ParentID: &epic})
+	require.Len(t, result.Failed, 1)
+	require.ErrorIs(t, result.Failed[0].Err, appbeads.ErrParentUnsupported)
+}
+
+func TestBulkUpdate_NothingToDo(t *testing.T) {
+	require.Zero(t, appbeads.BulkUpdate(mocks.NewMockIssueExecutor(t), []string{"PROJ-1"}, domain.BulkUpdateOptions{}).Total())
+}
@@ -21,11 +21,13 @@
 //   - CommentReader: reads issue comments
 //   - IssueReader: reads issue details
 //   - IssueWriter: mutates issues via CLI
+//   - BulkUpdater: optionally applies one change to many issues at once (see BulkUpdate)
+//   - IssueCreator: optionally creates issues of any type (see MaterializeRecurrences)
 //
 // # Infrastructure Adapters
 //
 // SQLiteClient implements the read ports (VersionReader, CommentReader).
-// BDExecutor implements both IssueReader and IssueWriter via the bd CLI.
+// BDExecutor implements IssueReader, IssueWriter and CommentReader via the bd CLI.
 //
 // # Import Aliasing
 //
@@ -17,6 +17,50 @@ type IssueReader interface {
 	ShowIssue(issueID string) (*domain.Issue, error)
 }
 
+// IssueLister lists issues by status.
+// It is optional: callers type-assert an IssueExecutor to it when they need history.
+type IssueLister interface {
+	ListIssues(status domain.Status, limit int) ([]domain.Issue, error)
+}
+
+// ReadyLister lists open issues with no open blockers.
+// It is optional: callers type-assert an IssueExecutor to it when they need the ready queue.
+type ReadyLister interface {
+	ReadyIssues(limit int) ([]domain.Issue, error)
+}
+
+// ChildLister lists the child issues of a parent (e.g. an epic's tasks) by status.
+// It is optional: callers type-assert an IssueExecutor to it when they need an epic's tasks.
+type ChildLister interface {
+	ListChildren(parentID string, status domain.Status) ([]domain.Issue, error)
+}
+
+// IssueArchiver archives and restores issues in bulk.
+// It is optional: callers type-assert an IssueExecutor to it when they manage the archive.
+type IssueArchiver interface {
+	ArchiveIssues(issueIDs []string) error
+	RestoreIssues(issueIDs []string) error
+}
+
+// BulkUpdater applies one change to many issues in a single backend operation,
+// so backends that support transactions can apply it all-or-nothing.
+// It is optional: use BulkUpdate, which falls back to per-issue updates.
+type BulkUpdater interface {
+	BulkUpdate(issueIDs []string, opts domain.BulkUpdateOptions) (domain.BulkResult, error)
+}
+
+// IssueCreator creates an issue of any type with the given fields.
+// It is optional: callers type-assert an IssueExecutor to it when they copy issues.
+type IssueCreator interface {
+	CreateIssue(opts domain.CreateIssueOptions) (domain.CreateResult, error)
+}
+
+// CommandRunner runs a raw bd subcommand and returns its stdout.
+// It is optional: callers type-assert an IssueExecutor to it and must validate args themselves.
+type CommandRunner interface {
+	RunCommand(args ...string) (string, error)
+}
+
 // IssueWriter provides write operations for issues.
 type IssueWriter interface {
 	UpdateStatus(issueID string, status domain.Status) error
@@ -0,0 +1,57 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// ErrCreateUnsupported is reported when the executor cannot create issues of
+// any type, so recurring issues cannot be copied.
+var ErrCreateUnsupported = errors.New("creating issues is not supported")
+
+// RecurrenceResult is the outcome of materializing one recurring issue.
+type RecurrenceResult struct {
+	FromID string
+	NextID string
+	Err    error
+}
+
+// MaterializeRecurrences creates the next instance of every issue whose
+// recurrence is due at now, then removes the recurrence label from the
+// previous instance so it is materialized only once. Failures are reported
+// per issue and do not stop the remaining recurrences.
+func MaterializeRecurrences(executor IssueExecutor, issues []domain.Issue, now time.Time) []RecurrenceResult {
+	due := domain.SelectDueRecurrences(issues, now)
+	if len(due) == 0 {
+		return nil
+	}
+
+	creator, ok := executor.(IssueCreator)
+	if !ok {
+		results := make([]RecurrenceResult, len(due))
+		for i, issue := range due {
+			results[i] = RecurrenceResult{FromID: issue.ID, Err: ErrCreateUnsupported}
+		}
+		return results
+	}
+
+	results := make([]RecurrenceResult, 0, len(due))
+	for _, issue := range due {
+		result := RecurrenceResult{FromID: issue.ID}
+		created, err := creator.CreateIssue(issue.NextRecurrence())
+		if err != nil {
+			result.Err = fmt.Errorf("creating next instance: %w", err)
+			results = append(results, result)
+			continue
+		}
+		result.NextID = created.ID
+		if err := executor.SetLabels(issue.ID, domain.SetRecurrence(issue.Labels, nil)); err != nil {
+			result.Err = fmt.Errorf("removing recurrence from %s: %w", issue.ID, err)
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,62 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+// createExecutor adds an IssueCreator implementation to the IssueExecutor mock.
+type createExecutor struct {
+	*mocks.MockIssueExecutor
+	create func(opts domain.CreateIssueOptions) (domain.CreateResult, error)
+}
+
+func (e createExecutor) CreateIssue(opts domain.CreateIssueOptions) (domain.CreateResult, error) {
+	return e.create(opts)
+}
+
+func TestMaterializeRecurrences(t *testing.T) {
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	issues := []domain.Issue{
+		{ID: "PROJ-1", TitleText: "Bump deps", Status: domain.StatusClosed, Labels: []string{"deps", "recur:on-close"}},
+		{ID: "PROJ-2", TitleText: "Rotate keys", Status: domain.StatusOpen, CreatedAt: now.AddDate(0, -1, 0), Labels: []string{"recur:monthly"}},
+		{ID: "PROJ-3", TitleText: "Not yet", Status: domain.StatusOpen, Labels: []string{"recur:on-close"}},
+	}
+
+	mock := mocks.NewMockIssueExecutor(t)
+	mock.EXPECT().SetLabels("PROJ-1", []string{"deps"}).Return(nil)
+	var created []domain.CreateIssueOptions
+	executor := createExecutor{
+		MockIssueExecutor: mock,
+		create: func(opts domain.CreateIssueOptions) (domain.CreateResult, error) {
+			created = append(created, opts)
+			if opts.Title == "Rotate keys" {
+				return domain.CreateResult{}, errors.New("bd failed")
+			}
+			return domain.CreateResult{ID: "PROJ-4", Title: opts.Title}, nil
+		},
+	}
+
+	results := appbeads.MaterializeRecurrences(executor, issues, now)
+	require.Len(t, results, 2)
+	require.Equal(t, appbeads.RecurrenceResult{FromID: "PROJ-1", NextID: "PROJ-4"}, results[0])
+	require.Equal(t, "PROJ-2", results[1].FromID)
+	require.EqualError(t, results[1].Err, "creating next instance: bd failed")
+	require.Equal(t, []string{"deps", "recur:on-close"}, created[0].Labels)
+}
+
+func TestMaterializeRecurrences_RequiresIssueCreator(t *testing.T) {
+	issues := []domain.Issue{{ID: "PROJ-1", Status: domain.StatusClosed, Labels: []string{"recur:on-close"}}}
+	results := appbeads.MaterializeRecurrences(mocks.NewMockIssueExecutor(t), issues, time.Now())
+	require.Len(t, results, 1)
+	require.ErrorIs(t, results[0].Err, appbeads.ErrCreateUnsupported)
+
+	require.Empty(t, appbeads.MaterializeRecurrences(mocks.NewMockIssueExecutor(t), nil, time.Now()))
+}
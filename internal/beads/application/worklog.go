@@ -0,0 +1,17 @@
+package application
+
+import (
+	domain "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// LoadWorkLog fills issue's time tracking fields (StartedAt, CompletedAt,
+// WorkDuration, WorkedBy) from the work log comments the orchestrator stamps
+// when workers start and complete it.
+func LoadWorkLog(comments CommentReader, issue *domain.Issue) error {
+	issueComments, err := comments.GetComments(issue.ID)
+	if err != nil {
+		return err
+	}
+	issue.ApplyWorkLog(issueComments)
+	return nil
+}
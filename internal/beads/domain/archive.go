@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// ArchivedLabel marks an issue as archived. Archiving is separate from the
+// issue's status: an archived issue keeps its status and leaves default views
+// and search until it is restored by removing the label.
+const ArchivedLabel = "archived"
+
+// IsArchived returns true if the issue carries the archived label.
+func (i Issue) IsArchived() bool {
+	return slices.Contains(i.Labels, ArchivedLabel)
+}
+
+// ArchiveFilter selects issues for bulk archiving.
+type ArchiveFilter struct {
+	// ClosedBefore limits the selection to issues closed before this time.
+	// Zero selects issues regardless of status.
+	ClosedBefore time.Time
+}
+
+// SelectArchivable returns the issues matched by filter, skipping issues that
+// are already archived.
+func SelectArchivable(issues []Issue, filter ArchiveFilter) []Issue {
+	var selected []Issue
+	for _, issue := range issues {
+		if issue.IsArchived() {
+			continue
+		}
+		if !filter.ClosedBefore.IsZero() {
+			if issue.Status != StatusClosed || issue.ClosedAt.IsZero() || !issue.ClosedAt.Before(filter.ClosedBefore) {
+				continue
+			}
+		}
+		selected = append(selected, issue)
+	}
+	return selected
+}
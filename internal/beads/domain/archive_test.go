@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssue_IsArchived(t *testing.T) {
+	require.False(t, Issue{Labels: []string{"bug"}}.IsArchived())
+	require.True(t, Issue{Labels: []string{"bug", ArchivedLabel}}.IsArchived())
+}
+
+func TestSelectArchivable(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	issues := []Issue{
+		{ID: "old", Status: StatusClosed, ClosedAt: now.AddDate(0, 0, -40)},
+		{ID: "recent", Status: StatusClosed, ClosedAt: now.AddDate(0, 0, -2)},
+		{ID: "open", Status: StatusOpen},
+		{ID: "archived", Status: StatusClosed, ClosedAt: now.AddDate(0, 0, -90), Labels: []string{ArchivedLabel}},
+	}
+
+	ids := func(selected []Issue) []string {
+		var out []string
+		for _, issue := range selected {
+			out = append(out, issue.ID)
+		}
+		return out
+	}
+
+	require.Equal(t, []string{"old"}, ids(SelectArchivable(issues, ArchiveFilter{ClosedBefore: now.AddDate(0, 0, -30)})))
+	require.Equal(t, []string{"old", "recent", "open"}, ids(SelectArchivable(issues, ArchiveFilter{})))
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AttachmentPrefix marks labels that link a file to an issue, e.g.
+// "attachment:docs/design.md". Like custom fields, attachments are stored as
+// labels because beads has no schema for them.
+const AttachmentPrefix = "attachment:"
+
+// AttachmentLabel returns the label that links path to an issue.
+func AttachmentLabel(path string) string {
+	return AttachmentPrefix + path
+}
+
+// IsAttachmentLabel reports whether label links a file.
+func IsAttachmentLabel(label string) bool {
+	return strings.HasPrefix(label, AttachmentPrefix)
+}
+
+// Attachments returns the file paths linked by labels, in label order.
+func Attachments(labels []string) []string {
+	var paths []string
+	for _, label := range labels {
+		if path, ok := strings.CutPrefix(label, AttachmentPrefix); ok && path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// Attachments returns the file paths linked to the issue.
+func (i Issue) Attachments() []string {
+	return Attachments(i.Labels)
+}
+
+// SetAttachments returns labels with the attachment labels replaced by paths.
+// Other labels keep their order.
+func SetAttachments(labels, paths []string) []string {
+	result := make([]string, 0, len(labels)+len(paths))
+	for _, label := range labels {
+		if !IsAttachmentLabel(label) {
+			result = append(result, label)
+		}
+	}
+	for _, path := range paths {
+		result = append(result, AttachmentLabel(path))
+	}
+	return result
+}
+
+// ValidateAttachmentPath checks that path can be stored in a label: labels
+// are sent to bd comma-separated and on a single line.
+func ValidateAttachmentPath(path string) error {
+	switch {
+	case path == "":
+		return fmt.Errorf("attachment path is empty")
+	case strings.ContainsAny(path, ",\r\n"):
+		return fmt.Errorf("attachment path %q must not contain commas or line breaks", path)
+	}
+	return nil
+}
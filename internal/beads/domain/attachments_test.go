@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachments_RoundTrip(t *testing.T) {
+	labels := []string{"bug", "attachment:docs/design.md", "component:api"}
+	require.Equal(t, []string{"docs/design.md"}, Attachments(labels))
+	require.Equal(t, []string{"docs/design.md"}, Issue{Labels: labels}.Attachments())
+
+	updated := SetAttachments(labels, []string{"shot.png", "/tmp/trace.log"})
+	require.Equal(t, []string{"bug", "component:api", "attachment:shot.png", "attachment:/tmp/trace.log"}, updated)
+	require.Equal(t, []string{"bug"}, PlainLabels(updated, testFieldDefs), "attachments are not plain labels")
+	require.Equal(t, updated[:2], SetAttachments(updated, nil))
+}
+
+func TestSetCustomFields_KeepsAttachments(t *testing.T) {
+	labels := []string{"bug", "attachment:shot.png", "component:api"}
+	updated := SetCustomFields(labels, testFieldDefs, map[string]string{"component": "ui"})
+	require.Equal(t, []string{"bug", "attachment:shot.png", "component:ui"}, updated)
+}
+
+func TestValidateAttachmentPath(t *testing.T) {
+	require.NoError(t, ValidateAttachmentPath("docs/my design.md"))
+	require.ErrorContains(t, ValidateAttachmentPath(""), "empty")
+	require.ErrorContains(t, ValidateAttachmentPath("a,b.txt"), "commas")
+	require.ErrorContains(t, ValidateAttachmentPath("a\nb.txt"), "line breaks")
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BulkUpdateOptions specifies one change applied to many issues at once.
+// Nil pointer fields and empty slices are skipped.
+type BulkUpdateOptions struct {
+	Status       *Status
+	Priority     *Priority
+	AddLabels    []string
+	RemoveLabels []string
+	ParentID     *string // Epic to assign the issues to
+}
+
+// IsEmpty returns true if the options change nothing.
+func (o BulkUpdateOptions) IsEmpty() bool {
+	return o.Status == nil && o.Priority == nil &&
+		len(o.AddLabels) == 0 && len(o.RemoveLabels) == 0 &&
+		(o.ParentID == nil || *o.ParentID == "")
+}
+
+// ApplyLabels returns labels with the options' label changes applied.
+// Removals win over additions, and the input slice is not modified.
+func (o BulkUpdateOptions) ApplyLabels(labels []string) []string {
+	result := make([]string, 0, len(labels)+len(o.AddLabels))
+	for _, label := range labels {
+		if !slices.Contains(o.RemoveLabels, label) && !slices.Contains(result, label) {
+			result = append(result, label)
+		}
+	}
+	for _, label := range o.AddLabels {
+		if !slices.Contains(o.RemoveLabels, label) && !slices.Contains(result, label) {
+			result = append(result, label)
+		}
+	}
+	return result
+}
+
+// BulkFailure records why a bulk update failed for one issue.
+type BulkFailure struct {
+	IssueID string
+	Err     error
+}
+
+// BulkResult reports the per-issue outcome of a bulk update.
+type BulkResult struct {
+	Updated []string
+	Failed  []BulkFailure
+}
+
+// Total returns the number of issues the update was applied to.
+func (r BulkResult) Total() int {
+	return len(r.Updated) + len(r.Failed)
+}
+
+// Summary describes the result in one line, e.g. "Updated 3 of 5 issues (2 failed: PROJ-1: not found, ...)".
+func (r BulkResult) Summary() string {
+	noun := "issues"
+	if r.Total() == 1 {
+		noun = "issue"
+	}
+	if len(r.Failed) == 0 {
+		return fmt.Sprintf("Updated %d %s", len(r.Updated), noun)
+	}
+
+	failures := make([]string, 0, len(r.Failed))
+	for _, f := range r.Failed {
+		failures = append(failures, fmt.Sprintf("%s: %v", f.IssueID, f.Err))
+	}
+	return fmt.Sprintf("Updated %d of %d %s (%d failed: %s)",
+		len(r.Updated), r.Total(), noun, len(r.Failed), strings.Join(failures, ", "))
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkUpdateOptions_IsEmpty(t *testing.T) {
+	empty := ""
+	epic := "PROJ-1"
+	status := StatusClosed
+
+	require.True(t, BulkUpdateOptions{}.IsEmpty())
+	require.True(t, BulkUpdateOptions{ParentID: &empty}.IsEmpty())
+	require.False(t, BulkUpdateOptions{ParentID: &epic}.IsEmpty())
+	require.False(t, BulkUpdateOptions{Status: &status}.IsEmpty())
+	require.False(t, BulkUpdateOptions{RemoveLabels: []string{"bug"}}.IsEmpty())
+}
+
+func TestBulkUpdateOptions_ApplyLabels(t *testing.T) {
+	opts := BulkUpdateOptions{AddLabels: []string{"ui", "bug", "urgent"}, RemoveLabels: []string{"stale", "urgent"}}
+	labels := []string{"bug", "stale"}
+
+	require.Equal(t, []string{"bug", "ui"}, opts.ApplyLabels(labels))
+	require.Equal(t, []string{"bug", "stale"}, labels)
+}
+
+func TestBulkResult_Summary(t *testing.T) {
+	require.Equal(t, "Updated 1 issue", BulkResult{Updated: []string{"PROJ-1"}}.Summary())
+	require.Equal(t, "Updated 2 issues", BulkResult{Updated: []string{"PROJ-1", "PROJ-2"}}.Summary())
+
+	result := BulkResult{
+		Updated: []string{"PROJ-1"},
+		Failed:  []BulkFailure{{IssueID: "PROJ-2", Err: errors.New("not found")}},
+	}
+	require.Equal(t, 2, result.Total())
+	require.Equal(t, "Updated 1 of 2 issues (1 failed: PROJ-2: not found)", result.Summary())
+}
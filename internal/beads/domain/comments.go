@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Beads comments are flat, so a reply records the comment it answers in a
+// first line "Re #<id>" that ParseCommentReply strips again.
+var commentReplyPattern = regexp.MustCompile(`^Re #(\d+)\n\n`)
+
+// CommentThread is a top-level comment with the replies to it, oldest first.
+type CommentThread struct {
+	Comment Comment
+	Replies []Comment
+}
+
+// FormatCommentReply renders the text of a comment replying to the comment
+// with ID parentID.
+func FormatCommentReply(parentID int, text string) string {
+	return fmt.Sprintf("Re #%d\n\n%s", parentID, text)
+}
+
+// ParseCommentReply returns the ID of the comment text replies to and the
+// reply without its marker. ok is false if text isn't a reply.
+func ParseCommentReply(text string) (parentID int, body string, ok bool) {
+	m := commentReplyPattern.FindStringSubmatch(text)
+	if m == nil {
+		return 0, text, false
+	}
+	parentID, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, text, false
+	}
+	return parentID, text[len(m[0]):], true
+}
+
+// ThreadComments groups comments, which must be oldest first, into threads.
+// Replies to a reply join the thread of its top-level comment; replies whose
+// parent isn't among comments start their own thread. The marker is stripped
+// from the text of replies.
+func ThreadComments(comments []Comment) []CommentThread {
+	var threads []CommentThread
+	threadOf := make(map[int]int, len(comments)) // comment ID -> index in threads
+	for _, c := range comments {
+		if parentID, body, ok := ParseCommentReply(c.Text); ok {
+			if idx, found := threadOf[parentID]; found {
+				c.Text = body
+				threads[idx].Replies = append(threads[idx].Replies, c)
+				threadOf[c.ID] = idx
+				continue
+			}
+			c.Text = body
+		}
+		threadOf[c.ID] = len(threads)
+		threads = append(threads, CommentThread{Comment: c})
+	}
+	return threads
+}
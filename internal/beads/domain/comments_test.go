@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentReply_RoundTrip(t *testing.T) {
+	text := FormatCommentReply(12, "Agreed, done in abc123.")
+	require.Equal(t, "Re #12\n\nAgreed, done in abc123.", text)
+
+	parentID, body, ok := ParseCommentReply(text)
+	require.True(t, ok)
+	require.Equal(t, 12, parentID)
+	require.Equal(t, "Agreed, done in abc123.", body)
+
+	_, body, ok = ParseCommentReply("Re #12 is wrong")
+	require.False(t, ok)
+	require.Equal(t, "Re #12 is wrong", body)
+}
+
+func TestThreadComments(t *testing.T) {
+	threads := ThreadComments([]Comment{
+		{ID: 1, Author: "alice", Text: "Which API version?"},
+		{ID: 2, Author: "worker-1", Text: "Blocked on the schema."},
+		{ID: 3, Author: "worker-2", Text: FormatCommentReply(1, "v2")},
+		{ID: 4, Author: "alice", Text: FormatCommentReply(3, "Thanks")},
+		{ID: 5, Author: "bob", Text: FormatCommentReply(99, "Orphan")},
+	})
+
+	require.Len(t, threads, 3)
+	require.Equal(t, 1, threads[0].Comment.ID)
+	require.Equal(t, []Comment{
+		{ID: 3, Author: "worker-2", Text: "v2"},
+		{ID: 4, Author: "alice", Text: "Thanks"},
+	}, threads[0].Replies)
+	require.Equal(t, 2, threads[1].Comment.ID)
+	require.Empty(t, threads[1].Replies)
+	require.Equal(t, Comment{ID: 5, Author: "bob", Text: "Orphan"}, threads[2].Comment)
+}
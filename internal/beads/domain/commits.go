@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CommitLink links an issue to a git commit made for it and to the Fabric
+// thread where the work was discussed. Links are stored as issue comments
+// written by FormatCommitComment, so they survive outside orchestration sessions.
+type CommitLink struct {
+	SHA      string
+	Subject  string
+	ThreadID string // Fabric thread ID, empty if the commit has no thread
+}
+
+// ShortSHA returns the abbreviated (7 character) commit SHA.
+func (l CommitLink) ShortSHA() string {
+	if len(l.SHA) > 7 {
+		return l.SHA[:7]
+	}
+	return l.SHA
+}
+
+var (
+	commitLinePattern = regexp.MustCompile(`^Commit ([0-9a-f]{7,40}): (.*)$`)
+	threadLinePattern = regexp.MustCompile(`^Fabric thread: (\S+)$`)
+)
+
+// FormatCommitComment renders a commit link as an issue comment.
+// diffStat is appended when non-empty.
+//
+//	Commit 3f2a9c1e...: Add login form validation
+//	Fabric thread: 01JB...
+//
+//	3 files changed, +40 -12
+func FormatCommitComment(link CommitLink, diffStat string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Commit %s: %s", link.SHA, link.Subject)
+	if link.ThreadID != "" {
+		fmt.Fprintf(&sb, "\nFabric thread: %s", link.ThreadID)
+	}
+	if diffStat = strings.TrimSpace(diffStat); diffStat != "" {
+		sb.WriteString("\n\n" + diffStat)
+	}
+	return sb.String()
+}
+
+// ParseCommitComment parses a comment written by FormatCommitComment.
+func ParseCommitComment(text string) (CommitLink, bool) {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	match := commitLinePattern.FindStringSubmatch(lines[0])
+	if match == nil {
+		return CommitLink{}, false
+	}
+	link := CommitLink{SHA: match[1], Subject: match[2]}
+	if len(lines) > 1 {
+		if m := threadLinePattern.FindStringSubmatch(lines[1]); m != nil {
+			link.ThreadID = m[1]
+		}
+	}
+	return link, true
+}
+
+// CommitLinks returns the commit links among comments, oldest first.
+func CommitLinks(comments []Comment) []CommitLink {
+	var links []CommitLink
+	for _, c := range comments {
+		if link, ok := ParseCommitComment(c.Text); ok {
+			links = append(links, link)
+		}
+	}
+	return links
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitComment_RoundTrip(t *testing.T) {
+	link := CommitLink{SHA: "3f2a9c1e8d7b6a5f4e3d2c1b0a9f8e7d6c5b4a39", Subject: "Add login: form validation", ThreadID: "msg-123"}
+
+	text := FormatCommitComment(link, "2 files changed, +40 -12\n")
+	require.Equal(t, "Commit 3f2a9c1e8d7b6a5f4e3d2c1b0a9f8e7d6c5b4a39: Add login: form validation\nFabric thread: msg-123\n\n2 files changed, +40 -12", text)
+
+	parsed, ok := ParseCommitComment(text)
+	require.True(t, ok)
+	require.Equal(t, link, parsed)
+	require.Equal(t, "3f2a9c1", parsed.ShortSHA())
+
+	parsed, ok = ParseCommitComment(FormatCommitComment(CommitLink{SHA: "abc1234", Subject: "Fix"}, ""))
+	require.True(t, ok)
+	require.Empty(t, parsed.ThreadID)
+}
+
+func TestCommitLinks(t *testing.T) {
+	comments := []Comment{
+		{Text: "Looks good"},
+		{Text: "Commit abc1234: Fix typo"},
+		{Text: "Commit message should be shorter"},
+	}
+	require.Equal(t, []CommitLink{{SHA: "abc1234", Subject: "Fix typo"}}, CommitLinks(comments))
+}
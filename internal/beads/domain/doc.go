@@ -16,6 +16,32 @@
 // Status, Priority, and IssueType are value objects representing the issue lifecycle
 // state, urgency level, and categorization respectively.
 //
+// # Custom Fields
+//
+// FieldDef describes a project-specific typed field (enum, number, text, url).
+// Values are stored as "key:value" labels, so they need no beads schema changes.
+//
+// # Recurrence
+//
+// RecurrenceRule makes an issue recurring: a "recur:<rule>" label on the latest
+// instance says when the next one is created (on close or on a schedule).
+//
+// # Comments
+//
+// Comments are an issue's activity log. Beads stores them flat; a reply is a
+// comment starting with "Re #<id>", and ThreadComments groups replies under
+// the comment they answer.
+//
+// # Time Tracking
+//
+// WorkLog is an issue's work time and the workers who did it, parsed from the
+// "Work started/completed by" comments the orchestrator stamps on tasks.
+//
+// # Epic Progress
+//
+// ComputeEpicProgress and ComputeBurndown roll up an epic's tasks into status
+// counts, progress weighted by "size:<size>" labels, and daily remaining work.
+//
 // # Version Checking
 //
 // The package provides version comparison utilities for ensuring compatibility
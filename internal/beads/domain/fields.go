@@ -0,0 +1,163 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// FieldType is the value type of a custom issue field.
+type FieldType string
+
+const (
+	FieldEnum   FieldType = "enum"
+	FieldNumber FieldType = "number"
+	FieldText   FieldType = "text"
+	FieldURL    FieldType = "url"
+)
+
+// FieldDef defines a project-specific issue field (e.g., component, estimate).
+//
+// Beads has no schema for extra fields, so values are stored as "key:value"
+// labels. They round-trip through bd export/import unchanged and can be
+// queried with BQL label filters (label = "component:auth").
+type FieldDef struct {
+	Key     string    // Label prefix, e.g. "component"
+	Label   string    // Display name; defaults to Key
+	Type    FieldType // Value type
+	Options []string  // Allowed values (FieldEnum only)
+}
+
+// fieldKeyPattern restricts keys to lowercase identifiers so they never collide
+// with the ':' separator and stay easy to type in BQL.
+var fieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// DisplayLabel returns the field's display name.
+func (d FieldDef) DisplayLabel() string {
+	if d.Label != "" {
+		return d.Label
+	}
+	return d.Key
+}
+
+// Validate checks a value against the field's type. Empty values are always
+// valid and mean the field is unset.
+func (d FieldDef) Validate(value string) error {
+	if value == "" {
+		return nil
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return fmt.Errorf("%s: value must be a single line", d.Key)
+	}
+
+	switch d.Type {
+	case FieldEnum:
+		if !slices.Contains(d.Options, value) {
+			return fmt.Errorf("%s: must be one of %v, got %q", d.Key, d.Options, value)
+		}
+	case FieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("%s: must be a number, got %q", d.Key, value)
+		}
+	case FieldURL:
+		u, err := url.ParseRequestURI(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s: must be an http(s) URL, got %q", d.Key, value)
+		}
+	}
+	return nil
+}
+
+// ValidateFieldDefs checks that field definitions have valid, unique keys and types.
+func ValidateFieldDefs(defs []FieldDef) error {
+	seen := make(map[string]bool, len(defs))
+	for i, d := range defs {
+		if !fieldKeyPattern.MatchString(d.Key) {
+			return fmt.Errorf("field %d: key must match %s, got %q", i, fieldKeyPattern, d.Key)
+		}
+		if seen[d.Key] {
+			return fmt.Errorf("field %q: duplicate key", d.Key)
+		}
+		seen[d.Key] = true
+
+		switch d.Type {
+		case FieldEnum:
+			if len(d.Options) == 0 {
+				return fmt.Errorf("field %q: enum fields require options", d.Key)
+			}
+		case FieldNumber, FieldText, FieldURL:
+			if len(d.Options) > 0 {
+				return fmt.Errorf("field %q: options are only allowed for enum fields", d.Key)
+			}
+		default:
+			return fmt.Errorf("field %q: type must be one of enum, number, text, url, got %q", d.Key, d.Type)
+		}
+	}
+	return nil
+}
+
+// FieldLabel returns the label that stores value for the field key.
+func FieldLabel(key, value string) string {
+	return key + ":" + value
+}
+
+// fieldValue returns the value stored in label for def, if label belongs to it.
+func fieldValue(label string, def FieldDef) (string, bool) {
+	return strings.CutPrefix(label, def.Key+":")
+}
+
+// CustomFields returns the values of the defined fields found in labels.
+// Fields without a value are omitted. If a field has several labels, the first wins.
+func CustomFields(labels []string, defs []FieldDef) map[string]string {
+	values := make(map[string]string)
+	for _, def := range defs {
+		for _, label := range labels {
+			if v, ok := fieldValue(label, def); ok && v != "" {
+				values[def.Key] = v
+				break
+			}
+		}
+	}
+	return values
+}
+
+// PlainLabels returns the labels that don't store a defined field, an attachment
+// or a recurrence rule.
+func PlainLabels(labels []string, defs []FieldDef) []string {
+	plain := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if !isFieldLabel(label, defs) && !IsAttachmentLabel(label) && !IsRecurrenceLabel(label) {
+			plain = append(plain, label)
+		}
+	}
+	return plain
+}
+
+// SetCustomFields returns labels with the defined fields replaced by values.
+// Fields missing from values (or set to "") are removed. Other labels keep their order.
+func SetCustomFields(labels []string, defs []FieldDef, values map[string]string) []string {
+	result := make([]string, 0, len(labels))
+	for _, label := range labels {
+		if !isFieldLabel(label, defs) {
+			result = append(result, label)
+		}
+	}
+	for _, def := range defs {
+		if v := values[def.Key]; v != "" {
+			result = append(result, FieldLabel(def.Key, v))
+		}
+	}
+	return result
+}
+
+func isFieldLabel(label string, defs []FieldDef) bool {
+	for _, def := range defs {
+		if _, ok := fieldValue(label, def); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testFieldDefs = []FieldDef{
+	{Key: "component", Label: "Component", Type: FieldEnum, Options: []string{"api", "ui"}},
+	{Key: "estimate", Type: FieldNumber},
+	{Key: "owner", Type: FieldText},
+	{Key: "spec", Type: FieldURL},
+}
+
+func TestFieldDef_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		def   FieldDef
+		value string
+		err   string
+	}{
+		{"empty is unset", testFieldDefs[0], "", ""},
+		{"enum option", testFieldDefs[0], "api", ""},
+		{"enum unknown", testFieldDefs[0], "db", "must be one of"},
+		{"number", testFieldDefs[1], "2.5", ""},
+		{"not a number", testFieldDefs[1], "two", "must be a number"},
+		{"text", testFieldDefs[2], "alice", ""},
+		{"multiline text", testFieldDefs[2], "a\nb", "single line"},
+		{"url", testFieldDefs[3], "https://example.com/spec", ""},
+		{"relative url", testFieldDefs[3], "/spec", "http(s) URL"},
+		{"non-http url", testFieldDefs[3], "ftp://example.com", "http(s) URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.def.Validate(tt.value)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestValidateFieldDefs(t *testing.T) {
+	require.NoError(t, ValidateFieldDefs(testFieldDefs))
+	require.NoError(t, ValidateFieldDefs(nil))
+
+	require.ErrorContains(t, ValidateFieldDefs([]FieldDef{{Key: "Bad Key", Type: FieldText}}), "key must match")
+	require.ErrorContains(t, ValidateFieldDefs([]FieldDef{{Key: "a", Type: FieldText}, {Key: "a", Type: FieldURL}}), "duplicate key")
+	require.ErrorContains(t, ValidateFieldDefs([]FieldDef{{Key: "a", Type: FieldEnum}}), "require options")
+	require.ErrorContains(t, ValidateFieldDefs([]FieldDef{{Key: "a", Type: FieldText, Options: []string{"x"}}}), "only allowed for enum")
+	require.ErrorContains(t, ValidateFieldDefs([]FieldDef{{Key: "a", Type: "date"}}), "type must be one of")
+}
+
+func TestCustomFields_RoundTrip(t *testing.T) {
+	labels := []string{"bug", "component:api", "spec:https://example.com/a:b", "team:core"}
+
+	require.Equal(t, map[string]string{"component": "api", "spec": "https://example.com/a:b"}, CustomFields(labels, testFieldDefs))
+	require.Equal(t, []string{"bug", "team:core"}, PlainLabels(labels, testFieldDefs), "undefined key:value labels stay plain")
+
+	updated := SetCustomFields(labels, testFieldDefs, map[string]string{"component": "ui", "estimate": "3"})
+	require.Equal(t, []string{"bug", "team:core", "component:ui", "estimate:3"}, updated)
+	require.Equal(t, map[string]string{"component": "ui", "estimate": "3"}, CustomFields(updated, testFieldDefs))
+}
+
+func TestFieldDef_DisplayLabel(t *testing.T) {
+	require.Equal(t, "Component", testFieldDefs[0].DisplayLabel())
+	require.Equal(t, "estimate", testFieldDefs[1].DisplayLabel())
+}
@@ -69,6 +69,12 @@ type Issue struct {
 	ClosedAt           time.Time `json:"closed_at"`
 	CloseReason        string    `json:"close_reason,omitempty"`
 
+	// Time tracking, populated from work log comments by ApplyWorkLog
+	StartedAt    time.Time     `json:"started_at,omitzero"`
+	CompletedAt  time.Time     `json:"completed_at,omitzero"`
+	WorkDuration time.Duration `json:"work_duration,omitempty"`
+	WorkedBy     []string      `json:"worked_by,omitempty"`
+
 	// Agent fields (agent-as-bead pattern)
 	HookBead     string    `json:"hook_bead,omitempty"`
 	RoleBead     string    `json:"role_bead,omitempty"`
@@ -111,4 +117,17 @@ type UpdateIssueOptions struct {
 	Labels      *[]string  // nil = unchanged, &[]string{} = clear all
 	Assignee    *string    // proactive; not used by current editor
 	Type        *IssueType // proactive; not used by current editor
+
+	// Dependencies are "blocks" edges to add or remove, applied after the
+	// field updates. They may involve other issues (e.g. when this issue
+	// blocks another one).
+	Dependencies []DependencyChange
+}
+
+// DependencyChange adds or removes a "blocks" dependency: IssueID is blocked
+// by DependsOnID.
+type DependencyChange struct {
+	IssueID     string
+	DependsOnID string
+	Remove      bool
 }
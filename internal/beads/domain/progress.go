@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// SizeLabelPrefix starts the size label of an issue (e.g., "size:s", "size:xl").
+const SizeLabelPrefix = "size:"
+
+// sizePoints weigh an issue's share of an epic by its size label. Unlabeled
+// issues count as medium.
+var sizePoints = map[string]float64{"xs": 1, "s": 2, "m": 3, "l": 5, "xl": 8}
+
+const defaultSizePoints = 3
+
+// IssuePoints returns the weight of an issue in its epic's progress, from its
+// size label.
+func IssuePoints(issue Issue) float64 {
+	for _, l := range issue.Labels {
+		if size, ok := strings.CutPrefix(l, SizeLabelPrefix); ok {
+			if points, ok := sizePoints[size]; ok {
+				return points
+			}
+		}
+	}
+	return defaultSizePoints
+}
+
+// EpicProgress is the rollup of an epic's tasks.
+type EpicProgress struct {
+	EpicID     string `json:"epic_id"`
+	Total      int    `json:"total"`
+	Open       int    `json:"open"`
+	InProgress int    `json:"in_progress"`
+	Blocked    int    `json:"blocked"`
+	Deferred   int    `json:"deferred"`
+	Closed     int    `json:"closed"`
+	// Points weigh the tasks by size (see IssuePoints).
+	TotalPoints  float64 `json:"total_points"`
+	ClosedPoints float64 `json:"closed_points"`
+	// Burndown is the remaining work at the end of each day, oldest first.
+	Burndown []BurndownPoint `json:"burndown,omitempty"`
+}
+
+// BurndownPoint is the work remaining in an epic at the end of a day.
+type BurndownPoint struct {
+	Date            time.Time `json:"date"`
+	Remaining       int       `json:"remaining"`
+	RemainingPoints float64   `json:"remaining_points"`
+}
+
+// ComputeEpicProgress rolls up the tasks of an epic. Child epics among tasks
+// are left out: pass their tasks instead.
+func ComputeEpicProgress(epicID string, tasks []Issue) EpicProgress {
+	p := EpicProgress{EpicID: epicID}
+	for _, task := range tasks {
+		if task.Type == TypeEpic {
+			continue
+		}
+		points := IssuePoints(task)
+		p.Total++
+		p.TotalPoints += points
+		switch task.Status {
+		case StatusClosed:
+			p.Closed++
+			p.ClosedPoints += points
+		case StatusInProgress:
+			p.InProgress++
+		case StatusBlocked:
+			p.Blocked++
+		case StatusDeferred:
+			p.Deferred++
+		default:
+			p.Open++
+		}
+	}
+	return p
+}
+
+// Percent returns the share of closed tasks, from 0 to 100.
+func (p EpicProgress) Percent() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return float64(p.Closed) / float64(p.Total) * 100
+}
+
+// WeightedPercent returns the share of closed points, from 0 to 100.
+func (p EpicProgress) WeightedPercent() float64 {
+	if p.TotalPoints == 0 {
+		return 0
+	}
+	return p.ClosedPoints / p.TotalPoints * 100
+}
+
+// ComputeBurndown returns the work remaining among tasks at the end of each of
+// the days days up to and including now's day, oldest first. A task counts
+// from the day it was created until the day it was closed. Child epics are
+// left out.
+func ComputeBurndown(tasks []Issue, now time.Time, days int) []BurndownPoint {
+	if days <= 0 {
+		return nil
+	}
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+
+	points := make([]BurndownPoint, 0, days)
+	for i := days - 1; i >= 0; i-- {
+		date := today.AddDate(0, 0, -i)
+		end := date.AddDate(0, 0, 1)
+		point := BurndownPoint{Date: date}
+		for _, task := range tasks {
+			if task.Type == TypeEpic || !task.CreatedAt.Before(end) {
+				continue
+			}
+			if task.Status == StatusClosed && !task.ClosedAt.IsZero() && task.ClosedAt.Before(end) {
+				continue
+			}
+			point.Remaining++
+			point.RemainingPoints += IssuePoints(task)
+		}
+		points = append(points, point)
+	}
+	return points
+}
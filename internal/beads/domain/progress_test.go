@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuePoints(t *testing.T) {
+	require.Equal(t, 8.0, IssuePoints(Issue{Labels: []string{"backend", "size:xl"}}))
+	require.Equal(t, 1.0, IssuePoints(Issue{Labels: []string{"size:xs"}}))
+	require.Equal(t, 3.0, IssuePoints(Issue{}), "unlabeled issues count as medium")
+	require.Equal(t, 3.0, IssuePoints(Issue{Labels: []string{"size:huge"}}))
+}
+
+func TestComputeEpicProgress(t *testing.T) {
+	p := ComputeEpicProgress("perles-s157", []Issue{
+		{ID: "perles-s157.1", Status: StatusClosed, Labels: []string{"size:l"}},
+		{ID: "perles-s157.2", Status: StatusInProgress, Labels: []string{"size:xs"}},
+		{ID: "perles-s157.3", Status: StatusBlocked},
+		{ID: "perles-s157.4", Status: StatusOpen, Labels: []string{"size:s"}},
+		{ID: "perles-s157.5", Status: StatusDeferred, Labels: []string{"size:xs"}},
+		{ID: "perles-s157.6", Status: StatusOpen, Type: TypeEpic},
+	})
+
+	require.Equal(t, EpicProgress{
+		EpicID: "perles-s157", Total: 5, Open: 1, InProgress: 1, Blocked: 1, Deferred: 1, Closed: 1,
+		TotalPoints: 12, ClosedPoints: 5,
+	}, p)
+	require.InDelta(t, 20.0, p.Percent(), 0.01)
+	require.InDelta(t, 41.67, p.WeightedPercent(), 0.01)
+
+	empty := ComputeEpicProgress("perles-empty", nil)
+	require.Zero(t, empty.Percent())
+	require.Zero(t, empty.WeightedPercent())
+}
+
+func TestComputeBurndown(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2026, 3, d, h, 0, 0, 0, time.UTC) }
+	tasks := []Issue{
+		{ID: "a", Status: StatusClosed, CreatedAt: day(1, 9), ClosedAt: day(2, 15), Labels: []string{"size:s"}},
+		{ID: "b", Status: StatusOpen, CreatedAt: day(1, 10)},
+		{ID: "c", Status: StatusClosed, CreatedAt: day(3, 8), ClosedAt: day(3, 17), Labels: []string{"size:xs"}},
+		{ID: "epic", Type: TypeEpic, CreatedAt: day(1, 8)},
+	}
+
+	require.Equal(t, []BurndownPoint{
+		{Date: day(1, 0), Remaining: 2, RemainingPoints: 5},
+		{Date: day(2, 0), Remaining: 1, RemainingPoints: 3},
+		{Date: day(3, 0), Remaining: 1, RemainingPoints: 3},
+	}, ComputeBurndown(tasks, day(3, 20), 3))
+	require.Nil(t, ComputeBurndown(tasks, day(3, 20), 0))
+}
@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrencePrefix marks labels that make an issue recurring, e.g.
+// "recur:weekly". Only the latest instance of a recurrence carries the label:
+// materializing the next instance moves it there.
+const RecurrencePrefix = "recur:"
+
+// RecurrenceUnit is the calendar unit of a scheduled recurrence.
+type RecurrenceUnit string
+
+const (
+	RecurrenceDays   RecurrenceUnit = "d"
+	RecurrenceWeeks  RecurrenceUnit = "w"
+	RecurrenceMonths RecurrenceUnit = "m"
+)
+
+// RecurrenceRule describes when the next instance of a recurring issue is
+// created: when the current instance closes, or every Interval units after it
+// was created.
+type RecurrenceRule struct {
+	OnClose  bool
+	Interval int
+	Unit     RecurrenceUnit
+}
+
+// recurrenceAliases are the named rules accepted besides every-<N><unit>.
+var recurrenceAliases = map[string]RecurrenceRule{
+	"daily":   {Interval: 1, Unit: RecurrenceDays},
+	"weekly":  {Interval: 1, Unit: RecurrenceWeeks},
+	"monthly": {Interval: 1, Unit: RecurrenceMonths},
+}
+
+// ParseRecurrenceRule parses a rule: on-close, daily, weekly, monthly or
+// every-<N><d|w|m> (e.g. every-2w).
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "on-close" {
+		return RecurrenceRule{OnClose: true}, nil
+	}
+	if rule, ok := recurrenceAliases[s]; ok {
+		return rule, nil
+	}
+	if spec, ok := strings.CutPrefix(s, "every-"); ok && len(spec) > 1 {
+		unit := RecurrenceUnit(spec[len(spec)-1:])
+		n, err := strconv.Atoi(spec[:len(spec)-1])
+		if err == nil && n > 0 && (unit == RecurrenceDays || unit == RecurrenceWeeks || unit == RecurrenceMonths) {
+			return RecurrenceRule{Interval: n, Unit: unit}, nil
+		}
+	}
+	return RecurrenceRule{}, fmt.Errorf("invalid recurrence %q: expected on-close, daily, weekly, monthly or every-<N><d|w|m>", s)
+}
+
+// String returns the rule in the form ParseRecurrenceRule accepts.
+func (r RecurrenceRule) String() string {
+	if r.OnClose {
+		return "on-close"
+	}
+	for name, alias := range recurrenceAliases {
+		if alias == r {
+			return name
+		}
+	}
+	return fmt.Sprintf("every-%d%s", r.Interval, r.Unit)
+}
+
+// Next returns when the instance after one created at from is due.
+// On-close rules have no schedule and return the zero time.
+func (r RecurrenceRule) Next(from time.Time) time.Time {
+	switch r.Unit {
+	case RecurrenceDays:
+		return from.AddDate(0, 0, r.Interval)
+	case RecurrenceWeeks:
+		return from.AddDate(0, 0, 7*r.Interval)
+	case RecurrenceMonths:
+		return from.AddDate(0, r.Interval, 0)
+	}
+	return time.Time{}
+}
+
+// RecurrenceLabel returns the label that stores rule.
+func RecurrenceLabel(rule RecurrenceRule) string {
+	return RecurrencePrefix + rule.String()
+}
+
+// IsRecurrenceLabel reports whether label stores a recurrence rule.
+func IsRecurrenceLabel(label string) bool {
+	return strings.HasPrefix(label, RecurrencePrefix)
+}
+
+// Recurrence returns the issue's recurrence rule. Labels with an invalid rule
+// are ignored.
+func (i Issue) Recurrence() (RecurrenceRule, bool) {
+	for _, label := range i.Labels {
+		if spec, ok := strings.CutPrefix(label, RecurrencePrefix); ok {
+			if rule, err := ParseRecurrenceRule(spec); err == nil {
+				return rule, true
+			}
+		}
+	}
+	return RecurrenceRule{}, false
+}
+
+// RecurrenceDue reports whether the next instance of a recurring issue should
+// be created at now: on-close rules once the issue is closed, scheduled rules
+// once the interval since the issue was created has passed.
+func (i Issue) RecurrenceDue(now time.Time) bool {
+	rule, ok := i.Recurrence()
+	if !ok || i.IsArchived() {
+		return false
+	}
+	if rule.OnClose {
+		return i.Status == StatusClosed
+	}
+	return !i.CreatedAt.IsZero() && !now.Before(rule.Next(i.CreatedAt))
+}
+
+// SelectDueRecurrences returns the recurring issues whose next instance is due.
+func SelectDueRecurrences(issues []Issue, now time.Time) []Issue {
+	var due []Issue
+	for _, issue := range issues {
+		if issue.RecurrenceDue(now) {
+			due = append(due, issue)
+		}
+	}
+	return due
+}
+
+// SetRecurrence returns labels with the recurrence label replaced by rule, or
+// removed when rule is nil. Other labels keep their order.
+func SetRecurrence(labels []string, rule *RecurrenceRule) []string {
+	result := make([]string, 0, len(labels)+1)
+	for _, label := range labels {
+		if !IsRecurrenceLabel(label) {
+			result = append(result, label)
+		}
+	}
+	if rule != nil {
+		result = append(result, RecurrenceLabel(*rule))
+	}
+	return result
+}
+
+// CreateIssueOptions holds the fields of a new issue. Empty fields use the
+// backend defaults.
+type CreateIssueOptions struct {
+	Title       string
+	Description string
+	Type        IssueType
+	Priority    Priority
+	Assignee    string
+	ParentID    string
+	Labels      []string
+}
+
+// NextRecurrence returns the options that create the next instance of a
+// recurring issue. It copies the issue's fields and labels, including the
+// recurrence label, but not the archived label.
+func (i Issue) NextRecurrence() CreateIssueOptions {
+	labels := slices.DeleteFunc(slices.Clone(i.Labels), func(label string) bool {
+		return label == ArchivedLabel
+	})
+	return CreateIssueOptions{
+		Title:       i.TitleText,
+		Description: i.DescriptionText,
+		Type:        i.Type,
+		Priority:    i.Priority,
+		Assignee:    i.Assignee,
+		ParentID:    i.ParentID,
+		Labels:      labels,
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := map[string]RecurrenceRule{
+		"on-close":  {OnClose: true},
+		"daily":     {Interval: 1, Unit: RecurrenceDays},
+		"Weekly":    {Interval: 1, Unit: RecurrenceWeeks},
+		"monthly":   {Interval: 1, Unit: RecurrenceMonths},
+		"every-2w":  {Interval: 2, Unit: RecurrenceWeeks},
+		"every-10d": {Interval: 10, Unit: RecurrenceDays},
+	}
+	for input, want := range tests {
+		got, err := ParseRecurrenceRule(input)
+		require.NoError(t, err, input)
+		require.Equal(t, want, got, input)
+	}
+
+	for _, input := range []string{"", "yearly", "every-", "every-0d", "every-3y", "every-xw"} {
+		_, err := ParseRecurrenceRule(input)
+		require.Error(t, err, input)
+	}
+}
+
+func TestRecurrenceRule_String(t *testing.T) {
+	for _, s := range []string{"on-close", "daily", "weekly", "monthly", "every-2w", "every-3m"} {
+		rule, err := ParseRecurrenceRule(s)
+		require.NoError(t, err)
+		require.Equal(t, s, rule.String())
+	}
+	require.Equal(t, "weekly", RecurrenceRule{Interval: 1, Unit: RecurrenceWeeks}.String())
+}
+
+func TestRecurrenceRule_Next(t *testing.T) {
+	from := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2026, 2, 3, 9, 0, 0, 0, time.UTC), RecurrenceRule{Interval: 3, Unit: RecurrenceDays}.Next(from))
+	require.Equal(t, time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC), RecurrenceRule{Interval: 2, Unit: RecurrenceWeeks}.Next(from))
+	require.Equal(t, time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC), RecurrenceRule{Interval: 1, Unit: RecurrenceMonths}.Next(from))
+	require.True(t, RecurrenceRule{OnClose: true}.Next(from).IsZero())
+}
+
+func TestSelectDueRecurrences(t *testing.T) {
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	issues := []Issue{
+		{ID: "closed", Status: StatusClosed, Labels: []string{"recur:on-close"}},
+		{ID: "open", Status: StatusOpen, Labels: []string{"recur:on-close"}},
+		{ID: "weekly-due", Status: StatusOpen, CreatedAt: now.AddDate(0, 0, -7), Labels: []string{"recur:weekly"}},
+		{ID: "weekly-early", Status: StatusClosed, CreatedAt: now.AddDate(0, 0, -6), Labels: []string{"recur:weekly"}},
+		{ID: "archived", Status: StatusClosed, Labels: []string{"recur:on-close", ArchivedLabel}},
+		{ID: "invalid", Status: StatusClosed, Labels: []string{"recur:sometimes"}},
+		{ID: "plain", Status: StatusClosed},
+	}
+
+	var ids []string
+	for _, issue := range SelectDueRecurrences(issues, now) {
+		ids = append(ids, issue.ID)
+	}
+	require.Equal(t, []string{"closed", "weekly-due"}, ids)
+}
+
+func TestSetRecurrence(t *testing.T) {
+	rule := RecurrenceRule{Interval: 2, Unit: RecurrenceWeeks}
+	labels := SetRecurrence([]string{"recur:weekly", "deps"}, &rule)
+	require.Equal(t, []string{"deps", "recur:every-2w"}, labels)
+	require.Equal(t, []string{"deps"}, SetRecurrence(labels, nil))
+	require.Equal(t, []string{"deps"}, PlainLabels(labels, nil))
+}
+
+func TestIssue_NextRecurrence(t *testing.T) {
+	issue := Issue{
+		ID:              "bd-1",
+		TitleText:       "Bump dependencies",
+		DescriptionText: "Run go get -u",
+		Type:            TypeChore,
+		Priority:        2,
+		Assignee:        "alice",
+		ParentID:        "bd-0",
+		Labels:          []string{"deps", ArchivedLabel, "recur:weekly"},
+	}
+	require.Equal(t, CreateIssueOptions{
+		Title:       "Bump dependencies",
+		Description: "Run go get -u",
+		Type:        TypeChore,
+		Priority:    2,
+		Assignee:    "alice",
+		ParentID:    "bd-0",
+		Labels:      []string{"deps", "recur:weekly"},
+	}, issue.NextRecurrence())
+	require.Equal(t, []string{"deps", ArchivedLabel, "recur:weekly"}, issue.Labels)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// Work log entries are stored as issue comments written by the orchestrator
+// when a worker starts and completes a task, so time tracking needs no beads
+// schema changes and survives outside orchestration sessions.
+var (
+	workStartedPattern   = regexp.MustCompile(`^Work started by (\S+)$`)
+	workCompletedPattern = regexp.MustCompile(`^Work completed by (\S+) after (\S+)$`)
+)
+
+// WorkLog is the time tracking of an issue, parsed from its work log comments.
+type WorkLog struct {
+	StartedAt   time.Time     // When work first started (zero if never)
+	CompletedAt time.Time     // When work last completed (zero while in progress)
+	Duration    time.Duration // Accumulated work time of completed attempts
+	Workers     []string      // Workers that worked on the issue, in order
+}
+
+// FormatWorkStartedComment renders the comment stamped when workerID starts
+// working on an issue.
+func FormatWorkStartedComment(workerID string) string {
+	return "Work started by " + workerID
+}
+
+// FormatWorkCompletedComment renders the comment stamped when workerID
+// completes an issue after working on it for worked.
+func FormatWorkCompletedComment(workerID string, worked time.Duration) string {
+	return fmt.Sprintf("Work completed by %s after %s", workerID, worked.Round(time.Second))
+}
+
+// ParseWorkLog builds the work log from the work log comments among comments,
+// which must be oldest first. Other comments are ignored.
+func ParseWorkLog(comments []Comment) WorkLog {
+	var log WorkLog
+	for _, c := range comments {
+		if m := workStartedPattern.FindStringSubmatch(c.Text); m != nil {
+			if log.StartedAt.IsZero() {
+				log.StartedAt = c.CreatedAt
+			}
+			log.CompletedAt = time.Time{} // work resumed
+			log.addWorker(m[1])
+			continue
+		}
+		if m := workCompletedPattern.FindStringSubmatch(c.Text); m != nil {
+			worked, err := time.ParseDuration(m[2])
+			if err != nil {
+				continue
+			}
+			log.CompletedAt = c.CreatedAt
+			log.Duration += worked
+			log.addWorker(m[1])
+		}
+	}
+	return log
+}
+
+// IsWorkLogComment returns true if text is a work log comment.
+func IsWorkLogComment(text string) bool {
+	return workStartedPattern.MatchString(text) || workCompletedPattern.MatchString(text)
+}
+
+func (l *WorkLog) addWorker(workerID string) {
+	if !slices.Contains(l.Workers, workerID) {
+		l.Workers = append(l.Workers, workerID)
+	}
+}
+
+// ApplyWorkLog sets the issue's time tracking fields from its work log comments.
+func (i *Issue) ApplyWorkLog(comments []Comment) {
+	log := ParseWorkLog(comments)
+	i.StartedAt = log.StartedAt
+	i.CompletedAt = log.CompletedAt
+	i.WorkDuration = log.Duration
+	i.WorkedBy = log.Workers
+}
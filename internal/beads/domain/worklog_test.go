@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkLogComments_Format(t *testing.T) {
+	require.Equal(t, "Work started by worker-1", FormatWorkStartedComment("worker-1"))
+	require.Equal(t, "Work completed by worker-1 after 42m10s", FormatWorkCompletedComment("worker-1", 42*time.Minute+10*time.Second+300*time.Millisecond))
+	require.True(t, IsWorkLogComment(FormatWorkStartedComment("worker-1")))
+	require.False(t, IsWorkLogComment("Task completed"))
+}
+
+func TestParseWorkLog(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	comments := []Comment{
+		{Text: FormatWorkStartedComment("worker-1"), CreatedAt: t0},
+		{Text: "Task failed: tests broke", CreatedAt: t0.Add(10 * time.Minute)},
+		{Text: FormatWorkStartedComment("worker-2"), CreatedAt: t0.Add(time.Hour)},
+		{Text: FormatWorkCompletedComment("worker-2", 30*time.Minute), CreatedAt: t0.Add(90 * time.Minute)},
+	}
+
+	log := ParseWorkLog(comments)
+	require.Equal(t, t0, log.StartedAt)
+	require.Equal(t, t0.Add(90*time.Minute), log.CompletedAt)
+	require.Equal(t, 30*time.Minute, log.Duration)
+	require.Equal(t, []string{"worker-1", "worker-2"}, log.Workers)
+
+	// Work resumed after completion is in progress again
+	comments = append(comments, Comment{Text: FormatWorkStartedComment("worker-1"), CreatedAt: t0.Add(2 * time.Hour)})
+	log = ParseWorkLog(comments)
+	require.True(t, log.CompletedAt.IsZero())
+	require.Equal(t, 30*time.Minute, log.Duration)
+
+	require.Equal(t, WorkLog{}, ParseWorkLog([]Comment{{Text: "Looks good"}}))
+}
+
+func TestIssue_ApplyWorkLog(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	issue := Issue{ID: "bd-1"}
+	issue.ApplyWorkLog([]Comment{
+		{Text: FormatWorkStartedComment("worker-1"), CreatedAt: t0},
+		{Text: FormatWorkCompletedComment("worker-1", 45*time.Minute), CreatedAt: t0.Add(45 * time.Minute)},
+	})
+
+	require.Equal(t, t0, issue.StartedAt)
+	require.Equal(t, t0.Add(45*time.Minute), issue.CompletedAt)
+	require.Equal(t, 45*time.Minute, issue.WorkDuration)
+	require.Equal(t, []string{"worker-1"}, issue.WorkedBy)
+}
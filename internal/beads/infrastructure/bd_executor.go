@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,8 +15,17 @@ import (
 	"github.com/zjrosen/perles/internal/log"
 )
 
-// Compile-time check that BDExecutor implements IssueExecutor.
-var _ appbeads.IssueExecutor = (*BDExecutor)(nil)
+// Compile-time checks that BDExecutor implements IssueExecutor and the optional listing ports.
+var (
+	_ appbeads.IssueExecutor = (*BDExecutor)(nil)
+	_ appbeads.IssueLister   = (*BDExecutor)(nil)
+	_ appbeads.ReadyLister   = (*BDExecutor)(nil)
+	_ appbeads.ChildLister   = (*BDExecutor)(nil)
+	_ appbeads.CommandRunner = (*BDExecutor)(nil)
+	_ appbeads.IssueArchiver = (*BDExecutor)(nil)
+	_ appbeads.BulkUpdater   = (*BDExecutor)(nil)
+	_ appbeads.IssueCreator  = (*BDExecutor)(nil)
+)
 
 // BDExecutor implements IssueExecutor by executing actual BD CLI commands.
 type BDExecutor struct {
@@ -146,7 +156,8 @@ func (e *BDExecutor) UpdateNotes(issueID, notes string) error {
 
 // UpdateIssue applies field updates to an issue via bd CLI.
 // Only non-nil fields in opts are included. Labels are handled as a separate
-// bd update call because --set-labels cannot be combined with other flags.
+// bd update call because --set-labels cannot be combined with other flags,
+// and each dependency change is a bd dep add/remove call.
 // Returns nil without invoking bd if no fields are set.
 func (e *BDExecutor) UpdateIssue(issueID string, opts domain.UpdateIssueOptions) error {
 	start := time.Now()
@@ -196,6 +207,16 @@ func (e *BDExecutor) UpdateIssue(issueID string, opts domain.UpdateIssueOptions)
 		}
 	}
 
+	for _, dep := range opts.Dependencies {
+		apply := e.AddDependency
+		if dep.Remove {
+			apply = e.RemoveDependency
+		}
+		if err := apply(dep.IssueID, dep.DependsOnID); err != nil {
+			return fmt.Errorf("saving issue %s dependencies: %w", issueID, err)
+		}
+	}
+
 	return nil
 }
 
@@ -250,6 +271,90 @@ func (e *BDExecutor) DeleteIssues(issueIDs []string) error {
 	return nil
 }
 
+// ArchiveIssues adds the archived label to one or more issues in a single bd CLI call.
+func (e *BDExecutor) ArchiveIssues(issueIDs []string) error {
+	return e.updateArchiveLabel("ArchiveIssues", "--add-label", issueIDs)
+}
+
+// RestoreIssues removes the archived label from one or more issues in a single bd CLI call.
+func (e *BDExecutor) RestoreIssues(issueIDs []string) error {
+	return e.updateArchiveLabel("RestoreIssues", "--remove-label", issueIDs)
+}
+
+// updateArchiveLabel runs 'bd update <ids...> <flag> archived --json'.
+func (e *BDExecutor) updateArchiveLabel(op, flag string, issueIDs []string) error {
+	if len(issueIDs) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, op+" completed", "count", len(issueIDs), "duration", time.Since(start))
+	}()
+
+	args := append([]string{"update"}, issueIDs...)
+	args = append(args, flag, domain.ArchivedLabel, "--json")
+
+	if _, err := e.runBeads(args...); err != nil {
+		log.Error(log.CatBeads, op+" failed", "count", len(issueIDs), "error", err)
+		return err
+	}
+	return nil
+}
+
+// BulkUpdate applies status, priority and label changes to all issues in a
+// single 'bd update <ids...>' call, then links each issue to the epic in
+// opts.ParentID with a parent-child dependency.
+//
+// bd does not report which issues a failed update call applied to, so the
+// call's error is returned and every issue counts as failed. Epic links are
+// added per issue and fail individually.
+func (e *BDExecutor) BulkUpdate(issueIDs []string, opts domain.BulkUpdateOptions) (domain.BulkResult, error) {
+	var result domain.BulkResult
+	if len(issueIDs) == 0 || opts.IsEmpty() {
+		return result, nil
+	}
+
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "BulkUpdate completed", "count", len(issueIDs), "duration", time.Since(start))
+	}()
+
+	args := append([]string{"update"}, issueIDs...)
+	if opts.Status != nil {
+		args = append(args, "--status", string(*opts.Status))
+	}
+	if opts.Priority != nil {
+		args = append(args, "--priority", fmt.Sprintf("%d", *opts.Priority))
+	}
+	for _, label := range opts.AddLabels {
+		args = append(args, "--add-label", label)
+	}
+	for _, label := range opts.RemoveLabels {
+		args = append(args, "--remove-label", label)
+	}
+
+	if len(args) > len(issueIDs)+1 {
+		args = append(args, "--json")
+		if _, err := e.runBeads(args...); err != nil {
+			log.Error(log.CatBeads, "BulkUpdate failed", "count", len(issueIDs), "error", err)
+			return result, fmt.Errorf("updating %d issues: %w", len(issueIDs), err)
+		}
+	}
+
+	for _, id := range issueIDs {
+		if opts.ParentID != nil && *opts.ParentID != "" {
+			if _, err := e.runBeads("dep", "add", id, *opts.ParentID, "-t", "parent-child"); err != nil {
+				log.Error(log.CatBeads, "BulkUpdate parent link failed", "issueID", id, "parentID", *opts.ParentID, "error", err)
+				result.Failed = append(result.Failed, domain.BulkFailure{IssueID: id, Err: err})
+				continue
+			}
+		}
+		result.Updated = append(result.Updated, id)
+	}
+	return result, nil
+}
+
 // SetLabels replaces all labels on an issue via bd CLI.
 // Pass an empty slice (or nil) to remove all labels.
 //
@@ -320,6 +425,103 @@ func (e *BDExecutor) ShowIssue(issueID string) (*domain.Issue, error) {
 	return &issues[0], nil
 }
 
+// ListIssues executes 'bd list --status <status> --limit <n> --json'.
+// A limit of 0 lists all matching issues.
+func (e *BDExecutor) ListIssues(status domain.Status, limit int) ([]domain.Issue, error) {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "ListIssues completed", "status", status, "duration", time.Since(start))
+	}()
+
+	output, err := e.runBeads("list", "--status", string(status), "--limit", strconv.Itoa(limit), "--json")
+	if err != nil {
+		log.Error(log.CatBeads, "ListIssues failed", "status", status, "error", err)
+		return nil, err
+	}
+	if output == "" {
+		return nil, nil
+	}
+
+	var issues []domain.Issue
+	if err := json.Unmarshal([]byte(output), &issues); err != nil {
+		err = fmt.Errorf("failed to parse bd list output: %w", err)
+		log.Error(log.CatBeads, "ListIssues parse failed", "status", status, "error", err)
+		return nil, err
+	}
+	return issues, nil
+}
+
+// ReadyIssues executes 'bd ready --limit <n> --json'.
+// A limit of 0 lists all ready issues.
+func (e *BDExecutor) ReadyIssues(limit int) ([]domain.Issue, error) {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "ReadyIssues completed", "duration", time.Since(start))
+	}()
+
+	output, err := e.runBeads("ready", "--limit", strconv.Itoa(limit), "--json")
+	if err != nil {
+		log.Error(log.CatBeads, "ReadyIssues failed", "error", err)
+		return nil, err
+	}
+	if output == "" {
+		return nil, nil
+	}
+
+	var issues []domain.Issue
+	if err := json.Unmarshal([]byte(output), &issues); err != nil {
+		err = fmt.Errorf("failed to parse bd ready output: %w", err)
+		log.Error(log.CatBeads, "ReadyIssues parse failed", "error", err)
+		return nil, err
+	}
+	return issues, nil
+}
+
+// ListChildren executes 'bd list --parent <id> --status <status> --limit 0 --json'.
+func (e *BDExecutor) ListChildren(parentID string, status domain.Status) ([]domain.Issue, error) {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "ListChildren completed", "parentID", parentID, "status", status, "duration", time.Since(start))
+	}()
+
+	output, err := e.runBeads("list", "--parent", parentID, "--status", string(status), "--limit", "0", "--json")
+	if err != nil {
+		log.Error(log.CatBeads, "ListChildren failed", "parentID", parentID, "error", err)
+		return nil, err
+	}
+	if output == "" {
+		return nil, nil
+	}
+
+	var issues []domain.Issue
+	if err := json.Unmarshal([]byte(output), &issues); err != nil {
+		err = fmt.Errorf("failed to parse bd list output: %w", err)
+		log.Error(log.CatBeads, "ListChildren parse failed", "parentID", parentID, "error", err)
+		return nil, err
+	}
+	return issues, nil
+}
+
+// RunCommand executes 'bd <args...>' and returns stdout.
+// Callers are responsible for validating args.
+func (e *BDExecutor) RunCommand(args ...string) (string, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("no bd subcommand given")
+	}
+
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "RunCommand completed", "args", args, "duration", time.Since(start))
+	}()
+
+	output, err := e.runBeads(args...)
+	if err != nil {
+		log.Error(log.CatBeads, "RunCommand failed", "args", args, "error", err)
+		return "", err
+	}
+	return output, nil
+}
+
 // AddComment executes 'bd comment <id> --author <author> -- <text>'.
 func (e *BDExecutor) AddComment(issueID, author, text string) error {
 	start := time.Now()
@@ -334,6 +536,31 @@ func (e *BDExecutor) AddComment(issueID, author, text string) error {
 	return nil
 }
 
+// GetComments executes 'bd comments <id> --json' and returns the comments oldest first.
+func (e *BDExecutor) GetComments(issueID string) ([]domain.Comment, error) {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "GetComments completed", "issueID", issueID, "duration", time.Since(start))
+	}()
+
+	output, err := e.runBeads("comments", issueID, "--json")
+	if err != nil {
+		log.Error(log.CatBeads, "GetComments failed", "issueID", issueID, "error", err)
+		return nil, err
+	}
+	if output == "" {
+		return nil, nil
+	}
+
+	var comments []domain.Comment
+	if err := json.Unmarshal([]byte(output), &comments); err != nil {
+		err = fmt.Errorf("failed to parse bd comments output: %w", err)
+		log.Error(log.CatBeads, "GetComments parse failed", "issueID", issueID, "error", err)
+		return nil, err
+	}
+	return comments, nil
+}
+
 // CreateEpic creates a new epic via bd CLI.
 func (e *BDExecutor) CreateEpic(title, description string, labels []string) (domain.CreateResult, error) {
 	start := time.Now()
@@ -393,6 +620,44 @@ func (e *BDExecutor) CreateTask(title, description, parentID, assignee string, l
 	return result, nil
 }
 
+// CreateIssue creates an issue of any type via bd CLI. Empty fields are left
+// to bd's defaults.
+func (e *BDExecutor) CreateIssue(opts domain.CreateIssueOptions) (domain.CreateResult, error) {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "CreateIssue completed", "title", opts.Title, "duration", time.Since(start))
+	}()
+
+	args := []string{"create", opts.Title, "-p", strconv.Itoa(int(opts.Priority)), "-d", opts.Description, "--json"}
+	if opts.Type != "" {
+		args = append(args, "-t", string(opts.Type))
+	}
+	if opts.ParentID != "" {
+		args = append(args, "--parent", opts.ParentID)
+	}
+	if opts.Assignee != "" {
+		args = append(args, "--assignee", opts.Assignee)
+	}
+	for _, l := range opts.Labels {
+		args = append(args, "--label", l)
+	}
+
+	output, err := e.runBeads(args...)
+	if err != nil {
+		log.Error(log.CatBeads, "CreateIssue failed", "title", opts.Title, "error", err)
+		return domain.CreateResult{}, err
+	}
+
+	var result domain.CreateResult
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		err = fmt.Errorf("failed to parse bd create output: %w", err)
+		log.Error(log.CatBeads, "CreateIssue parse failed", "error", err)
+		return domain.CreateResult{}, err
+	}
+
+	return result, nil
+}
+
 // AddDependency adds a dependency between two tasks via bd CLI.
 func (e *BDExecutor) AddDependency(taskID, dependsOnID string) error {
 	start := time.Now()
@@ -406,3 +671,17 @@ func (e *BDExecutor) AddDependency(taskID, dependsOnID string) error {
 	}
 	return nil
 }
+
+// RemoveDependency removes a dependency between two tasks via bd CLI.
+func (e *BDExecutor) RemoveDependency(taskID, dependsOnID string) error {
+	start := time.Now()
+	defer func() {
+		log.Debug(log.CatBeads, "RemoveDependency completed", "taskID", taskID, "dependsOnID", dependsOnID, "duration", time.Since(start))
+	}()
+
+	if _, err := e.runBeads("dep", "remove", taskID, dependsOnID); err != nil {
+		log.Error(log.CatBeads, "RemoveDependency failed", "taskID", taskID, "dependsOnID", dependsOnID, "error", err)
+		return err
+	}
+	return nil
+}
@@ -2,7 +2,9 @@ package infrastructure
 
 import (
 	"errors"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
@@ -12,6 +14,7 @@ import (
 // TestBDExecutor_ImplementsIssueExecutor verifies BDExecutor implements IssueExecutor.
 func TestBDExecutor_ImplementsIssueExecutor(t *testing.T) {
 	var _ appbeads.IssueExecutor = (*BDExecutor)(nil)
+	var _ appbeads.CommentReader = (*BDExecutor)(nil)
 }
 
 // TestBDExecutor_NewBDExecutor tests the constructor.
@@ -190,6 +193,33 @@ func TestBDExecutor_UpdateIssue_AllFields(t *testing.T) {
 	}, calls[1])
 }
 
+// TestBDExecutor_UpdateIssue_Dependencies verifies each dependency change becomes
+// a bd dep add or bd dep remove call after the field update.
+func TestBDExecutor_UpdateIssue_Dependencies(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, append([]string(nil), args...))
+		return "", nil
+	})
+
+	title := "Deps"
+	opts := domain.UpdateIssueOptions{
+		Title: &title,
+		Dependencies: []domain.DependencyChange{
+			{IssueID: "PROJ-4", DependsOnID: "PROJ-1"},
+			{IssueID: "PROJ-9", DependsOnID: "PROJ-4", Remove: true},
+		},
+	}
+
+	err := executor.UpdateIssue("PROJ-4", opts)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"update", "PROJ-4", "--title", "Deps", "--json"},
+		{"dep", "add", "PROJ-4", "PROJ-1", "-t", "blocks"},
+		{"dep", "remove", "PROJ-9", "PROJ-4"},
+	}, calls)
+}
+
 // TestBDExecutor_UpdateIssue_NoFieldsSet verifies no-op when all fields are nil (no CLI call).
 func TestBDExecutor_UpdateIssue_NoFieldsSet(t *testing.T) {
 	called := false
@@ -390,3 +420,230 @@ func TestUpdateIssueOptions_ZeroValue(t *testing.T) {
 	require.Nil(t, opts.Assignee)
 	require.Nil(t, opts.Type)
 }
+
+// TestBDExecutor_ListIssues verifies the bd list invocation and JSON parsing.
+func TestBDExecutor_ListIssues(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		return `[{"id":"PROJ-1","title":"A","status":"closed"},{"id":"PROJ-2","title":"B","status":"closed"}]`, nil
+	})
+
+	issues, err := executor.ListIssues(domain.StatusClosed, 50)
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	require.Equal(t, "PROJ-2", issues[1].ID)
+	require.Equal(t, [][]string{{"list", "--status", "closed", "--limit", "50", "--json"}}, calls)
+}
+
+// TestBDExecutor_ReadyIssues verifies the bd ready invocation and JSON parsing.
+func TestBDExecutor_ReadyIssues(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		return `[{"id":"PROJ-1","title":"A","status":"open"}]`, nil
+	})
+
+	issues, err := executor.ReadyIssues(100)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, "PROJ-1", issues[0].ID)
+	require.Equal(t, [][]string{{"ready", "--limit", "100", "--json"}}, calls)
+
+	executor = newTestExecutor(func(args ...string) (string, error) {
+		return "not json", nil
+	})
+	_, err = executor.ReadyIssues(0)
+	require.ErrorContains(t, err, "failed to parse bd ready output")
+}
+
+func TestBDExecutor_ListChildren(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		return `[{"id":"PROJ-1.1","title":"A","status":"closed"}]`, nil
+	})
+
+	issues, err := executor.ListChildren("PROJ-1", domain.StatusClosed)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, "PROJ-1.1", issues[0].ID)
+	require.Equal(t, [][]string{{"list", "--parent", "PROJ-1", "--status", "closed", "--limit", "0", "--json"}}, calls)
+
+	executor = newTestExecutor(func(args ...string) (string, error) {
+		return "not json", nil
+	})
+	_, err = executor.ListChildren("PROJ-1", domain.StatusClosed)
+	require.ErrorContains(t, err, "failed to parse bd list output")
+}
+
+func TestBDExecutor_GetComments(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		return `[{"id":7,"issue_id":"PROJ-1","author":"worker-1","text":"Found the bug","created_at":"2026-03-01T09:00:00Z"}]`, nil
+	})
+
+	comments, err := executor.GetComments("PROJ-1")
+	require.NoError(t, err)
+	require.Equal(t, []domain.Comment{
+		{ID: 7, Author: "worker-1", Text: "Found the bug", CreatedAt: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)},
+	}, comments)
+	require.Equal(t, [][]string{{"comments", "PROJ-1", "--json"}}, calls)
+
+	executor = newTestExecutor(func(args ...string) (string, error) {
+		return "not json", nil
+	})
+	_, err = executor.GetComments("PROJ-1")
+	require.ErrorContains(t, err, "failed to parse bd comments output")
+}
+
+func TestBDExecutor_LoadWorkLog(t *testing.T) {
+	started := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	completed := started.Add(45 * time.Minute)
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		switch args[0] {
+		case "show":
+			return `[{"id":"PROJ-1","title":"Fix login","status":"in_progress"}]`, nil
+		case "comments":
+			return `[{"id":1,"author":"coordinator","text":` + strconv.Quote(domain.FormatWorkStartedComment("worker-1")) +
+				`,"created_at":"` + started.Format(time.RFC3339) + `"},` +
+				`{"id":2,"author":"coordinator","text":` + strconv.Quote(domain.FormatWorkCompletedComment("worker-1", 45*time.Minute)) +
+				`,"created_at":"` + completed.Format(time.RFC3339) + `"}]`, nil
+		}
+		return "", errors.New("unexpected command")
+	})
+
+	issue, err := executor.ShowIssue("PROJ-1")
+	require.NoError(t, err)
+	require.NoError(t, appbeads.LoadWorkLog(executor, issue))
+
+	require.Equal(t, started, issue.StartedAt)
+	require.Equal(t, completed, issue.CompletedAt)
+	require.Equal(t, 45*time.Minute, issue.WorkDuration)
+	require.Equal(t, []string{"worker-1"}, issue.WorkedBy)
+
+	failing := newTestExecutor(func(args ...string) (string, error) {
+		return "", errors.New("bd comments failed: no database")
+	})
+	require.Error(t, appbeads.LoadWorkLog(failing, &domain.Issue{ID: "PROJ-1"}))
+}
+
+func TestBDExecutor_RunCommand(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		return `{"total":3}`, nil
+	})
+
+	output, err := executor.RunCommand("stats", "--json")
+	require.NoError(t, err)
+	require.Equal(t, `{"total":3}`, output)
+	require.Equal(t, [][]string{{"stats", "--json"}}, calls)
+
+	_, err = executor.RunCommand()
+	require.Error(t, err)
+}
+
+// TestBDExecutor_ListIssues_Errors verifies command and parse failures are returned.
+func TestBDExecutor_ListIssues_Errors(t *testing.T) {
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		return "", errors.New("bd list failed: no database")
+	})
+	_, err := executor.ListIssues(domain.StatusClosed, 0)
+	require.EqualError(t, err, "bd list failed: no database")
+
+	executor = newTestExecutor(func(args ...string) (string, error) {
+		return "not json", nil
+	})
+	_, err = executor.ListIssues(domain.StatusClosed, 0)
+	require.ErrorContains(t, err, "failed to parse bd list output")
+
+	executor = newTestExecutor(func(args ...string) (string, error) {
+		return "", nil
+	})
+	issues, err := executor.ListIssues(domain.StatusClosed, 0)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+}
+
+func TestBDExecutor_ArchiveAndRestoreIssues(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		return "", nil
+	})
+
+	require.NoError(t, executor.ArchiveIssues([]string{"PROJ-1", "PROJ-2"}))
+	require.NoError(t, executor.RestoreIssues([]string{"PROJ-1"}))
+	require.NoError(t, executor.ArchiveIssues(nil))
+	require.Equal(t, [][]string{
+		{"update", "PROJ-1", "PROJ-2", "--add-label", "archived", "--json"},
+		{"update", "PROJ-1", "--remove-label", "archived", "--json"},
+	}, calls)
+
+	executor = newTestExecutor(func(args ...string) (string, error) {
+		return "", errors.New("bd update failed: not found")
+	})
+	require.EqualError(t, executor.RestoreIssues([]string{"PROJ-9"}), "bd update failed: not found")
+}
+
+func TestBDExecutor_BulkUpdate(t *testing.T) {
+	var calls [][]string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		calls = append(calls, args)
+		if args[0] == "dep" && args[2] == "PROJ-2" {
+			return "", errors.New("bd dep add failed: cycle")
+		}
+		return "", nil
+	})
+
+	status := domain.StatusClosed
+	priority := domain.Priority(1)
+	epic := "PROJ-9"
+	result, err := executor.BulkUpdate([]string{"PROJ-1", "PROJ-2"}, domain.BulkUpdateOptions{
+		Status:       &status,
+		Priority:     &priority,
+		AddLabels:    []string{"ui"},
+		RemoveLabels: []string{"stale"},
+		ParentID:     &epic,
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"update", "PROJ-1", "PROJ-2", "--status", "closed", "--priority", "1", "--add-label", "ui", "--remove-label", "stale", "--json"},
+		{"dep", "add", "PROJ-1", "PROJ-9", "-t", "parent-child"},
+		{"dep", "add", "PROJ-2", "PROJ-9", "-t", "parent-child"},
+	}, calls)
+	require.Equal(t, []string{"PROJ-1"}, result.Updated)
+	require.Len(t, result.Failed, 1)
+	require.Equal(t, "PROJ-2", result.Failed[0].IssueID)
+
+	executor = newTestExecutor(func(args ...string) (string, error) {
+		return "", errors.New("bd update failed: not found")
+	})
+	_, err = executor.BulkUpdate([]string{"PROJ-1"}, domain.BulkUpdateOptions{Status: &status})
+	require.EqualError(t, err, "updating 1 issues: bd update failed: not found")
+}
+
+func TestBDExecutor_CreateIssue(t *testing.T) {
+	var got []string
+	executor := newTestExecutor(func(args ...string) (string, error) {
+		got = args
+		return `{"id":"PROJ-4","title":"Bump deps"}`, nil
+	})
+
+	result, err := executor.CreateIssue(domain.CreateIssueOptions{
+		Title:       "Bump deps",
+		Description: "Run go get -u",
+		Type:        domain.TypeChore,
+		Priority:    2,
+		ParentID:    "PROJ-1",
+		Labels:      []string{"deps", "recur:weekly"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, domain.CreateResult{ID: "PROJ-4", Title: "Bump deps"}, result)
+	require.Equal(t, []string{
+		"create", "Bump deps", "-p", "2", "-d", "Run go get -u", "--json",
+		"-t", "chore", "--parent", "PROJ-1", "--label", "deps", "--label", "recur:weekly",
+	}, got)
+}
@@ -25,6 +25,31 @@ func (q *Query) HasExpand() bool {
 	return q.Expand != nil && q.Expand.Type != ExpandNone
 }
 
+// EqualityValue returns the value field must equal for the filter to match,
+// taken from a "field = value" comparison that is ANDed with the rest of the
+// filter. Comparisons under OR or NOT don't constrain the field and are ignored.
+func (q *Query) EqualityValue(field string) (Value, bool) {
+	return equalityValue(q.Filter, field)
+}
+
+func equalityValue(expr Expr, field string) (Value, bool) {
+	switch e := expr.(type) {
+	case *CompareExpr:
+		if e.Field == field && e.Op == TokenEq {
+			return e.Value, true
+		}
+	case *BinaryExpr:
+		if e.Op != TokenAnd {
+			return Value{}, false
+		}
+		if v, ok := equalityValue(e.Left, field); ok {
+			return v, true
+		}
+		return equalityValue(e.Right, field)
+	}
+	return Value{}, false
+}
+
 // BinaryExpr represents "expr AND/OR expr".
 type BinaryExpr struct {
 	Left  Expr
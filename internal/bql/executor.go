@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +27,9 @@ type Executor struct {
 	db            *sql.DB
 	cacheManager  cachemanager.CacheManager[string, []beads.Issue]
 	depGraphCache cachemanager.CacheManager[string, *DependencyGraph]
+
+	// includeArchived returns archived issues from every query (--include-archived).
+	includeArchived bool
 }
 
 // depGraphCacheKey is the static key for caching the dependency graph.
@@ -44,6 +48,44 @@ func NewExecutor(
 	}
 }
 
+// WithIncludeArchived makes every query return archived issues.
+// By default archived issues are only returned by queries that ask for them,
+// see hidesArchived.
+func (e *Executor) WithIncludeArchived(include bool) *Executor {
+	e.includeArchived = include
+	return e
+}
+
+// hidesArchived returns true if archived issues are left out of the query results.
+// Queries that filter on the archived label or look up issues by ID still see them.
+func (e *Executor) hidesArchived(query *Query) bool {
+	return !e.includeArchived && !selectsArchived(query.Filter)
+}
+
+// selectsArchived returns true if the filter mentions the archived label or an issue ID.
+func selectsArchived(expr Expr) bool {
+	switch x := expr.(type) {
+	case *BinaryExpr:
+		return selectsArchived(x.Left) || selectsArchived(x.Right)
+	case *NotExpr:
+		return selectsArchived(x.Expr)
+	case *CompareExpr:
+		return x.Field == "id" || (x.Field == "label" && x.Value.String == beads.ArchivedLabel)
+	case *InExpr:
+		if x.Field == "id" {
+			return true
+		}
+		if x.Field == "label" {
+			for _, v := range x.Values {
+				if v.String == beads.ArchivedLabel {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // maxExpandIterations is the safety limit for unlimited depth expansion.
 const maxExpandIterations = 100
 
@@ -61,13 +103,13 @@ type DependencyGraph struct {
 	Reverse map[string][]DependencyEdge // depends_on_id -> edges pointing from issue_id
 }
 
-// Execute runs a BQL query and returns matching issues.
+// Execute runs a BQL query, or a query in filter syntax (see ParseFilter), and
+// returns matching issues.
 func (e *Executor) Execute(input string) ([]beads.Issue, error) {
 	start := time.Now()
 
-	// Parse the query
-	parser := NewParser(input)
-	query, err := parser.Parse()
+	// Parse the query (BQL or filter syntax)
+	query, err := ParseQuery(input)
 	if err != nil {
 		log.ErrorErr(log.CatBQL, "Parse failed", err, "query", input)
 		return nil, fmt.Errorf("parse error: %w", err)
@@ -92,6 +134,10 @@ func (e *Executor) Execute(input string) ([]beads.Issue, error) {
 			if err != nil {
 				return nil, err
 			}
+			// Expansion follows dependencies into archived issues, drop them again
+			if e.hidesArchived(query) {
+				issues = slices.DeleteFunc(issues, beads.Issue.IsArchived)
+			}
 		}
 
 		return issues, nil
@@ -170,6 +216,11 @@ func (e *Executor) executeBaseQuery(query *Query) ([]beads.Issue, error) {
 	  AND i.deleted_at is null
 	`
 
+	if e.hidesArchived(query) {
+		sqlQuery += " AND i.id NOT IN (SELECT issue_id FROM labels WHERE label = ?)"
+		params = append([]any{beads.ArchivedLabel}, params...)
+	}
+
 	if whereClause != "" {
 		sqlQuery += " AND " + whereClause
 	}
@@ -659,6 +710,9 @@ func BuildIDQuery(ids []string) string {
 	return fmt.Sprintf("id in (%s)", strings.Join(quoted, ", "))
 }
 
+// RecurringQuery matches the issues that carry a recurrence rule label.
+var RecurringQuery = fmt.Sprintf("label ~ %q", beads.RecurrencePrefix)
+
 // IsBQLQuery returns true if the input looks like a BQL query.
 // This is used to determine whether to use BQL or simple text search.
 func IsBQLQuery(input string) bool {
@@ -2798,3 +2798,49 @@ func TestBatchLoading_IntegrationFullQueryEquivalence(t *testing.T) {
 	require.Equal(t, "test-2", issue.ID)
 	require.Equal(t, "test-6", issue.ParentID)
 }
+
+func TestExecutor_HidesArchivedIssues(t *testing.T) {
+	db := setupDB(t, func(b *testutil.Builder) *testutil.Builder {
+		return b.
+			WithIssue("epic-1", testutil.Title("Epic"), testutil.IssueType("epic")).
+			WithIssue("task-1", testutil.Title("Active"), testutil.IssueType("task")).
+			WithIssue("task-2", testutil.Title("Archived"), testutil.IssueType("task"), testutil.Status("closed"), testutil.Labels(beads.ArchivedLabel)).
+			WithDependency("task-1", "epic-1", "parent-child").
+			WithDependency("task-2", "epic-1", "parent-child")
+	})
+	defer func() { _ = db.Close() }()
+
+	executor := newTestExecutor(t, db)
+	ids := func(query string) []string {
+		issues, err := executor.Execute(query)
+		require.NoError(t, err)
+		var out []string
+		for _, issue := range issues {
+			out = append(out, issue.ID)
+		}
+		return out
+	}
+
+	require.ElementsMatch(t, []string{"task-1"}, ids("type = task"))
+	require.ElementsMatch(t, []string{"epic-1", "task-1"}, ids("type = epic expand down"))
+
+	// Queries asking for archived issues or naming them by ID still see them
+	require.ElementsMatch(t, []string{"task-2"}, ids("label = archived"))
+	require.ElementsMatch(t, []string{"task-2"}, ids("id = task-2"))
+	require.ElementsMatch(t, []string{"task-1", "task-2"}, ids(`id in ("task-1", "task-2")`))
+}
+
+func TestExecutor_IncludeArchived(t *testing.T) {
+	db := setupDB(t, func(b *testutil.Builder) *testutil.Builder {
+		return b.
+			WithIssue("task-1", testutil.Title("Active"), testutil.IssueType("task")).
+			WithIssue("task-2", testutil.Title("Archived"), testutil.IssueType("task"), testutil.Labels(beads.ArchivedLabel))
+	})
+	defer func() { _ = db.Close() }()
+
+	executor := newTestExecutor(t, db).WithIncludeArchived(true)
+
+	issues, err := executor.Execute("type = task")
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+}
@@ -0,0 +1,384 @@
+package bql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Filter syntax is a compact alternative to BQL for quick searches and saved
+// views:
+//
+//	status:open label:bug priority<=P1 text:"auth" sort:-updated
+//
+// Terms are separated by whitespace and must all match. A term is one of:
+//   - key:value       equality (contains for title, description, design, notes)
+//   - key<op>value    comparison, op is <, >, <= or >=
+//   - key:a,b         matches any of the values
+//   - text:value      title or description contains value (a bare word does the same)
+//   - is:flag         boolean field is true (is:ready, is:blocked)
+//   - sort:field      order by field, sort:-field for descending
+//
+// A leading "-" negates a term (-label:backlog). Values with spaces are quoted.
+// Filters compile to the same Query AST as BQL, so they are validated and
+// executed the same way.
+
+// FilterError reports a filter syntax error and the position of the offending term.
+type FilterError struct {
+	Pos int
+	Msg string
+}
+
+// Error implements the error interface.
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+// filterKeys are the keys that are not BQL fields.
+var filterKeys = []string{"text", "is", "sort"}
+
+// textFields are string fields that key:value matches by substring.
+var textFields = map[string]bool{
+	"title":       true,
+	"description": true,
+	"design":      true,
+	"notes":       true,
+}
+
+// bqlKeywords are BQL words that are a mistake as bare filter words.
+var bqlKeywords = map[string]bool{
+	"and": true, "or": true, "not": true, "in": true,
+	"order": true, "by": true, "expand": true,
+}
+
+// filterTerm is one whitespace-separated term of a filter.
+type filterTerm struct {
+	pos    int
+	negate bool
+	key    string    // empty for a bare word
+	op     TokenType // TokenEq for ":"
+	colon  bool      // key was followed by ":"
+	value  string
+	quoted bool
+}
+
+// ParseQuery parses input as filter syntax when IsFilter reports it, and as
+// BQL otherwise.
+func ParseQuery(input string) (*Query, error) {
+	if IsFilter(input) {
+		return ParseFilter(input)
+	}
+	return NewParser(input).Parse()
+}
+
+// IsFilter reports whether input is written in filter syntax rather than BQL.
+// That is the case when the first term is key:value, or a comparison such as
+// priority<=P1 followed by a key:value term with a known key. BQL field names
+// never contain ":", so neither is valid BQL.
+func IsFilter(input string) bool {
+	terms, _ := scanFilterTerms(input)
+	if len(terms) == 0 || terms[0].key == "" {
+		return false
+	}
+	if terms[0].colon {
+		return true
+	}
+	return slices.ContainsFunc(terms[1:], func(t filterTerm) bool {
+		return t.colon && isFilterKey(t.key)
+	})
+}
+
+// ParseFilter compiles filter syntax into a validated Query. Errors are
+// *FilterError values that point at the offending term.
+func ParseFilter(input string) (*Query, error) {
+	terms, err := scanFilterTerms(input)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &Query{}
+	for _, t := range terms {
+		if t.key == "sort" {
+			order, err := t.orderTerms()
+			if err != nil {
+				return nil, err
+			}
+			query.OrderBy = append(query.OrderBy, order...)
+			continue
+		}
+
+		expr, err := t.expr()
+		if err != nil {
+			return nil, err
+		}
+		if err := validateExpr(expr); err != nil {
+			return nil, &FilterError{Pos: t.pos, Msg: err.Error()}
+		}
+		if query.Filter == nil {
+			query.Filter = expr
+		} else {
+			query.Filter = &BinaryExpr{Left: query.Filter, Op: TokenAnd, Right: expr}
+		}
+	}
+	return query, nil
+}
+
+// expr compiles a non-sort term into an expression.
+func (t filterTerm) expr() (Expr, error) {
+	var expr Expr
+	switch {
+	case t.key == "" || t.key == "text":
+		if t.key == "" && !t.quoted && bqlKeywords[strings.ToLower(t.value)] {
+			return nil, t.errorf("unexpected %q: filter terms are combined by spaces (use text:%q to search for it)", t.value, t.value)
+		}
+		if err := t.requireColon(); err != nil {
+			return nil, err
+		}
+		expr = &BinaryExpr{
+			Left:  &CompareExpr{Field: "title", Op: TokenContains, Value: stringValue(t.value)},
+			Op:    TokenOr,
+			Right: &CompareExpr{Field: "description", Op: TokenContains, Value: stringValue(t.value)},
+		}
+
+	case t.key == "is":
+		if err := t.requireColon(); err != nil {
+			return nil, err
+		}
+		if ValidFields[t.value] != FieldBool {
+			return nil, t.errorf("unknown flag %q for is: (valid: %s)", t.value, strings.Join(boolFieldNames(), ", "))
+		}
+		expr = &CompareExpr{Field: t.value, Op: TokenEq, Value: Value{Type: ValueBool, Raw: "true", Bool: true}}
+
+	default:
+		if _, ok := ValidFields[t.key]; !ok {
+			return nil, t.unknownKeyError()
+		}
+		values := []string{t.value}
+		if !t.quoted {
+			values = strings.Split(t.value, ",")
+		}
+		if slices.Contains(values, "") {
+			return nil, t.errorf("empty value in list for %q", t.key)
+		}
+
+		if len(values) > 1 {
+			if !t.colon {
+				return nil, t.errorf("%q %s takes a single value", t.key, t.op)
+			}
+			in := &InExpr{Field: t.key, Not: t.negate}
+			for _, v := range values {
+				in.Values = append(in.Values, filterValue(v, false))
+			}
+			return in, nil
+		}
+
+		op := t.op
+		if t.colon && textFields[t.key] {
+			op = TokenContains
+		}
+		expr = &CompareExpr{Field: t.key, Op: op, Value: filterValue(t.value, t.quoted)}
+	}
+
+	if t.negate {
+		expr = &NotExpr{Expr: expr}
+	}
+	return expr, nil
+}
+
+// orderTerms compiles a sort: term.
+func (t filterTerm) orderTerms() ([]OrderTerm, error) {
+	if err := t.requireColon(); err != nil {
+		return nil, err
+	}
+	if t.negate {
+		return nil, t.errorf("sort: cannot be negated (use sort:-%s for descending)", t.value)
+	}
+	var order []OrderTerm
+	for _, field := range strings.Split(t.value, ",") {
+		term := OrderTerm{Field: strings.TrimPrefix(field, "-"), Desc: strings.HasPrefix(field, "-")}
+		if err := validateOrderField(term.Field); err != nil {
+			return nil, &FilterError{Pos: t.pos, Msg: err.Error()}
+		}
+		order = append(order, term)
+	}
+	return order, nil
+}
+
+// requireColon rejects comparison operators on keys that only take ":".
+func (t filterTerm) requireColon() error {
+	if t.key != "" && !t.colon {
+		return t.errorf("%q only supports %s:value", t.key, t.key)
+	}
+	return nil
+}
+
+// unknownKeyError reports an unknown key, suggesting the closest known one.
+func (t filterTerm) unknownKeyError() error {
+	if suggestion := closestFilterKey(t.key); suggestion != "" {
+		return t.errorf("unknown field %q (did you mean %q?)", t.key, suggestion)
+	}
+	return t.errorf("unknown field %q (valid: %s)", t.key, strings.Join(allFilterKeys(), ", "))
+}
+
+func (t filterTerm) errorf(format string, args ...any) error {
+	return &FilterError{Pos: t.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// scanFilterTerms splits input into terms.
+func scanFilterTerms(input string) ([]filterTerm, error) {
+	var terms []filterTerm
+	i := 0
+	for {
+		for i < len(input) && isFilterSpace(input[i]) {
+			i++
+		}
+		if i >= len(input) {
+			return terms, nil
+		}
+
+		t := filterTerm{pos: i, op: TokenEq}
+		if input[i] == '-' && i+1 < len(input) && !isFilterSpace(input[i+1]) {
+			t.negate = true
+			i++
+		}
+
+		// A key is a run of letters and underscores followed by an operator
+		j := i
+		for j < len(input) && (isLetter(input[j]) || input[j] == '_') {
+			j++
+		}
+		if j > i {
+			if op, n := filterOperator(input[j:]); n > 0 {
+				t.key = strings.ToLower(input[i:j])
+				t.op = op
+				t.colon = input[j] == ':'
+				i = j + n
+			}
+		}
+
+		if i < len(input) && (input[i] == '"' || input[i] == '\'') {
+			quote := input[i]
+			end := strings.IndexByte(input[i+1:], quote)
+			if end < 0 {
+				return terms, &FilterError{Pos: i, Msg: "unterminated quoted value"}
+			}
+			t.value = input[i+1 : i+1+end]
+			t.quoted = true
+			i += end + 2
+			if i < len(input) && !isFilterSpace(input[i]) {
+				return terms, &FilterError{Pos: i, Msg: fmt.Sprintf("unexpected %q after quoted value", input[i])}
+			}
+		} else {
+			start := i
+			for i < len(input) && !isFilterSpace(input[i]) {
+				i++
+			}
+			t.value = input[start:i]
+		}
+
+		if t.value == "" && !t.quoted {
+			if t.key != "" {
+				return terms, &FilterError{Pos: t.pos, Msg: fmt.Sprintf("missing value for %q", t.key)}
+			}
+			return terms, &FilterError{Pos: t.pos, Msg: `"-" must be followed by a term`}
+		}
+		terms = append(terms, t)
+	}
+}
+
+// filterOperator returns the operator at the start of s and its length.
+func filterOperator(s string) (TokenType, int) {
+	switch {
+	case strings.HasPrefix(s, "<="):
+		return TokenLte, 2
+	case strings.HasPrefix(s, ">="):
+		return TokenGte, 2
+	case strings.HasPrefix(s, "<"):
+		return TokenLt, 1
+	case strings.HasPrefix(s, ">"):
+		return TokenGt, 1
+	case strings.HasPrefix(s, ":"):
+		return TokenEq, 1
+	}
+	return TokenIllegal, 0
+}
+
+// filterValue converts a filter value to a typed Value, like the BQL parser.
+func filterValue(raw string, quoted bool) Value {
+	switch {
+	case quoted:
+		return stringValue(raw)
+	case raw == "true" || raw == "false":
+		return Value{Type: ValueBool, Raw: raw, Bool: raw == "true"}
+	case isDigit(raw[0]) || (len(raw) > 1 && raw[0] == '-' && isDigit(raw[1])):
+		return parseNumberValue(raw)
+	}
+	return parseIdentValue(raw)
+}
+
+func stringValue(s string) Value {
+	return Value{Type: ValueString, Raw: s, String: s}
+}
+
+func isFilterSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isFilterKey(key string) bool {
+	_, ok := ValidFields[key]
+	return ok || slices.Contains(filterKeys, key)
+}
+
+// allFilterKeys returns the BQL fields and filter keys, sorted.
+func allFilterKeys() []string {
+	keys := slices.Clone(filterKeys)
+	for name := range ValidFields {
+		keys = append(keys, name)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// boolFieldNames returns the boolean BQL fields, sorted.
+func boolFieldNames() []string {
+	var names []string
+	for name, fieldType := range ValidFields {
+		if fieldType == FieldBool {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// closestFilterKey returns the known key within two edits of key, if any.
+func closestFilterKey(key string) string {
+	best, bestDist := "", 3
+	for _, candidate := range allFilterKeys() {
+		if d := editDistance(key, candidate); d < bestDist {
+			best, bestDist = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,127 @@
+package bql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/testutil"
+)
+
+func TestIsFilter(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"status:open", true},
+		{"-label:backlog", true},
+		{"stauts:open", true},
+		{`text:"auth"`, true},
+		{"priority<=P1 label:bug", true},
+		{"status = open", false},
+		{"priority<=P1", false},
+		{"label = area:ui", false},
+		{`title ~ "status:open"`, false},
+		{"priority <= P1 and label = area:ui", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			require.Equal(t, tt.want, IsFilter(tt.input))
+		})
+	}
+}
+
+func TestParseFilter_MatchesBQL(t *testing.T) {
+	tests := []struct {
+		filter string
+		bql    string
+	}{
+		{"status:open", "status = open"},
+		{"status:open label:bug priority<=P1", "status = open and label = bug and priority <= P1"},
+		{"status:open,in_progress", "status in (open, in_progress)"},
+		{"-label:backlog,wontfix", "label not in (backlog, wontfix)"},
+		{"-type:epic", "not type = epic"},
+		{`text:"auth flow"`, `(title ~ "auth flow" or description ~ "auth flow")`},
+		{"login", "(title ~ login or description ~ login)"},
+		{"title:Login", "title ~ Login"},
+		{`label:"needs review"`, `label = "needs review"`},
+		{"is:ready", "ready = true"},
+		{"blocked:false", "blocked = false"},
+		{"created>=-7d updated<today", "created >= -7d and updated < today"},
+		{"priority:1", "priority = 1"},
+		{"Status:open", "status = open"},
+		{"status:open sort:priority,-updated", "status = open order by priority, updated desc"},
+		{"sort:-created", "order by created desc"},
+		{"   ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			want, err := NewParser(tt.bql).Parse()
+			require.NoError(t, err)
+
+			got, err := ParseFilter(tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestParseFilter_Errors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{"stauts:open", `unknown field "stauts" (did you mean "status"?) at position 0`},
+		{"status:open lable:bug", `unknown field "lable" (did you mean "label"?) at position 12`},
+		{"status:opne", `invalid value "opne" for field "status" (valid: open, in_progress, closed, blocked, deferred) at position 0`},
+		{"priority<=P9", `field "priority" requires a priority value (P0-P4 or 0-4), got "P9" at position 0`},
+		{"status:", `missing value for "status" at position 0`},
+		{`text:"auth`, "unterminated quoted value at position 5"},
+		{`text:"auth"x`, `unexpected 'x' after quoted value at position 11`},
+		{"status:open,", `empty value in list for "status" at position 0`},
+		{"priority<P1,P2", `"priority" < takes a single value at position 0`},
+		{"is:open", `unknown flag "open" for is: (valid: blocked, is_template, pinned, ready) at position 0`},
+		{"text>a", `"text" only supports text:value at position 0`},
+		{"status:open and label:bug", `unexpected "and": filter terms are combined by spaces (use text:"and" to search for it) at position 12`},
+		{"sort:nope", `unknown field in ORDER BY: "nope"`},
+		{"-sort:created", "sort: cannot be negated (use sort:-created for descending) at position 0"},
+		{"title>b", `operator ">" is not valid for string field "title" (use =, !=, ~, or !~) at position 0`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseFilter(tt.input)
+			require.Error(t, err)
+			var filterErr *FilterError
+			require.ErrorAs(t, err, &filterErr)
+			require.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestParseFilter_UnknownFieldListsValidKeys(t *testing.T) {
+	_, err := ParseFilter("zzzzzz:1")
+	require.ErrorContains(t, err, `unknown field "zzzzzz" (valid: `)
+	require.ErrorContains(t, err, "sort, status, text")
+}
+
+func TestExecutor_FilterSyntax(t *testing.T) {
+	db := setupDB(t, (*testutil.Builder).WithStandardTestData)
+	defer func() { _ = db.Close() }()
+
+	executor := newTestExecutor(t, db)
+
+	fromBQL, err := executor.Execute("type = bug and label = urgent")
+	require.NoError(t, err)
+	require.NotEmpty(t, fromBQL)
+
+	fromFilter, err := executor.Execute("type:bug label:urgent")
+	require.NoError(t, err)
+	require.Equal(t, fromBQL, fromFilter)
+
+	_, err = executor.Execute("typ:bug")
+	require.EqualError(t, err, `parse error: unknown field "typ" (did you mean "type"?) at position 0`)
+}
@@ -504,3 +504,33 @@ func TestExpandType_String(t *testing.T) {
 		})
 	}
 }
+
+func TestQuery_EqualityValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+		found bool
+	}{
+		{"single comparison", "status = in_progress", "in_progress", true},
+		{"anded", "status = open and blocked = true", "open", true},
+		{"nested and", "type = bug and (priority = P0 and status = closed)", "closed", true},
+		{"or", "status = open or status = closed", "", false},
+		{"not", "not status = open", "", false},
+		{"not equals", "status != closed", "", false},
+		{"in", "status in (open, closed)", "", false},
+		{"other field", "ready = true", "", false},
+		{"order by only", "order by priority", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := NewParser(tt.input).Parse()
+			require.NoError(t, err)
+
+			value, ok := query.EqualityValue("status")
+			require.Equal(t, tt.found, ok)
+			require.Equal(t, tt.want, value.String)
+		})
+	}
+}
@@ -2,7 +2,9 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -11,8 +13,10 @@ import (
 	"testing"
 	"time"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 )
 
 // ColumnConfig defines a single kanban column.
@@ -23,24 +27,84 @@ type ColumnConfig struct {
 	IssueID  string `mapstructure:"issue_id"`  // Root issue ID (required when type=tree)
 	TreeMode string `mapstructure:"tree_mode"` // "deps" (default) or "child" for tree columns
 	Color    string `mapstructure:"color"`     // hex color e.g. "#10B981"
+
+	// WIPLimit caps how many issues the column should hold (0 = no limit, bql columns only).
+	// Exceeding the limit highlights the column header.
+	WIPLimit int `mapstructure:"wip_limit"`
+	// WIPNotify notifies the coordinator of running workflows when the column exceeds WIPLimit.
+	WIPNotify bool `mapstructure:"wip_notify"`
+	// Collapsed shrinks the column on the board to a narrow strip with its name and count.
+	Collapsed bool `mapstructure:"collapsed"`
 }
 
 // ViewConfig defines a named board view with its column configuration.
 type ViewConfig struct {
 	Name    string         `mapstructure:"name"`
 	Columns []ColumnConfig `mapstructure:"columns"`
+
+	// Fields lists the issue fields shown on each issue row (see IssueFields).
+	// Fields are always shown in IssueFields order; empty shows DefaultIssueFields.
+	Fields []string `mapstructure:"fields"`
+	// Sort is a BQL ORDER BY list (e.g. "priority asc, updated desc") applied to
+	// the view's columns whose query has no ORDER BY of its own.
+	Sort string `mapstructure:"sort"`
+}
+
+// Issue fields a board view can show on its issue rows.
+const (
+	IssueFieldType     = "type"
+	IssueFieldPriority = "priority"
+	IssueFieldID       = "id"
+	IssueFieldStatus   = "status"
+	IssueFieldAssignee = "assignee"
+	IssueFieldLabels   = "labels"
+	IssueFieldUpdated  = "updated"
+)
+
+// IssueFields lists the issue row fields in display order: type, priority
+// and ID form the badge before the title, the rest follow it.
+var IssueFields = []string{
+	IssueFieldType, IssueFieldPriority, IssueFieldID,
+	IssueFieldStatus, IssueFieldAssignee, IssueFieldLabels, IssueFieldUpdated,
+}
+
+// DefaultIssueFields are shown when a view doesn't configure its fields.
+var DefaultIssueFields = []string{IssueFieldType, IssueFieldPriority, IssueFieldID}
+
+// sortTermPattern matches one ORDER BY term: a field with an optional direction.
+var sortTermPattern = regexp.MustCompile(`(?i)^[a-z_]+(\s+(asc|desc))?$`)
+
+// ValidateViewSort checks that sort is a comma-separated list of
+// "field [asc|desc]" terms. Whether the fields can be sorted on is checked by
+// BQL when the columns load.
+func ValidateViewSort(sort string) error {
+	if strings.TrimSpace(sort) == "" {
+		return nil
+	}
+	for term := range strings.SplitSeq(sort, ",") {
+		if !sortTermPattern.MatchString(strings.TrimSpace(term)) {
+			return fmt.Errorf("invalid sort term %q (expected \"field [asc|desc]\")", strings.TrimSpace(term))
+		}
+	}
+	return nil
 }
 
 // Config holds all configuration options for perles.
 type Config struct {
-	BeadsDir      string              `mapstructure:"beads_dir"`
-	AutoRefresh   bool                `mapstructure:"auto_refresh"`
-	UI            UIConfig            `mapstructure:"ui"`
-	Theme         ThemeConfig         `mapstructure:"theme"`
-	Views         []ViewConfig        `mapstructure:"views"`
-	Orchestration OrchestrationConfig `mapstructure:"orchestration"`
-	Sound         SoundConfig         `mapstructure:"sound"`
-	Flags         map[string]bool     `mapstructure:"flags"`
+	BeadsDir        string              `mapstructure:"beads_dir"`
+	AutoRefresh     bool                `mapstructure:"auto_refresh"`
+	IncludeArchived bool                `mapstructure:"include_archived"` // Show archived issues in views and search
+	UI              UIConfig            `mapstructure:"ui"`
+	Theme           ThemeConfig         `mapstructure:"theme"`
+	Views           []ViewConfig        `mapstructure:"views"`
+	CustomFields    []CustomFieldConfig `mapstructure:"custom_fields"`
+	Orchestration   OrchestrationConfig `mapstructure:"orchestration"`
+	Sound           SoundConfig         `mapstructure:"sound"`
+	Notifications   NotificationsConfig `mapstructure:"notifications"`
+	GitHub          GitHubConfig        `mapstructure:"github"`
+	Jira            JiraConfig          `mapstructure:"jira"`
+	Update          UpdateConfig        `mapstructure:"update"`
+	Flags           map[string]bool     `mapstructure:"flags"`
 
 	// ResolvedBeadsDir is the final resolved beads directory path after applying
 	// resolution priority (flag > env var > config > cwd). Used for propagation to agents.
@@ -48,22 +112,169 @@ type Config struct {
 	ResolvedBeadsDir string `mapstructure:"-" yaml:"-"`
 }
 
+// UpdateConfig configures `perles update`.
+// With a key set, the release's checksums.txt must be signed with it;
+// verification runs the minisign or cosign tool.
+// Example YAML:
+//
+//	update:
+//	  minisign_key: RWQf6LRCGA9i53mlYecO4IzT51TGPpvWucNSCh1CBM0QTaLn73Y7GFO3
+type UpdateConfig struct {
+	MinisignKey string `mapstructure:"minisign_key"` // Base64 public key or path to a .pub file
+	CosignKey   string `mapstructure:"cosign_key"`   // Path to a PEM public key, env://VAR or KMS URI
+}
+
+// CustomFieldConfig defines a project-specific issue field.
+// Values are stored on issues as "key:value" labels.
+// Example YAML:
+//
+//	custom_fields:
+//	  - key: component
+//	    label: Component
+//	    type: enum
+//	    options: [api, ui, cli]
+//	  - key: spec
+//	    type: url
+type CustomFieldConfig struct {
+	Key     string   `mapstructure:"key"`
+	Label   string   `mapstructure:"label"`   // Display name (default: key)
+	Type    string   `mapstructure:"type"`    // enum, number, text, or url
+	Options []string `mapstructure:"options"` // Allowed values for enum fields
+}
+
+// GitHubConfig configures 'perles sync github', which syncs beads issues with
+// the issues of a GitHub repository.
+// Example YAML:
+//
+//	github:
+//	  repo: owner/name
+//	  token: ${GITHUB_TOKEN}
+//	  labels:
+//	    good first issue: starter
+//	  priorities:
+//	    critical: 0
+//	    priority/high: 1
+//	  types:
+//	    enhancement: feature
+//	  export_query: label = github
+type GitHubConfig struct {
+	Repo   string `mapstructure:"repo"`    // owner/name
+	Token  string `mapstructure:"token"`   // Personal access token with issues read/write
+	APIURL string `mapstructure:"api_url"` // Default: https://api.github.com (set for GitHub Enterprise)
+	// Labels renames GitHub labels to beads labels. Unmapped labels keep their name.
+	Labels map[string]string `mapstructure:"labels"`
+	// Priorities maps GitHub labels to beads priorities (0-4).
+	Priorities map[string]int `mapstructure:"priorities"`
+	// Types maps GitHub labels to beads issue types.
+	Types map[string]string `mapstructure:"types"`
+	// ExportQuery selects the beads issues to create on GitHub (BQL or filter syntax).
+	// Empty exports nothing; issues already linked to GitHub always sync.
+	ExportQuery string `mapstructure:"export_query"`
+}
+
+// JiraConfig configures 'perles import jira', which imports Jira issues into
+// beads. Types and priorities extend the built-in mappings of the standard
+// Jira issue types and priorities.
+// Example YAML:
+//
+//	jira:
+//	  url: https://acme.atlassian.net
+//	  email: me@acme.com
+//	  token: ${JIRA_TOKEN}
+//	  jql: project = SHOP AND resolution = Unresolved
+//	  types:
+//	    spike: chore
+//	  priorities:
+//	    p1: 0
+type JiraConfig struct {
+	URL   string `mapstructure:"url"`   // Site URL, e.g. https://acme.atlassian.net
+	Email string `mapstructure:"email"` // Jira Cloud account; empty sends token as a bearer token (Server/Data Center)
+	Token string `mapstructure:"token"` // API token or personal access token
+	JQL   string `mapstructure:"jql"`   // Default query for 'perles import jira'
+	// EpicLinkField is the custom field holding the epic of an issue on Jira
+	// sites that predate parent links for epics, e.g. customfield_10014.
+	EpicLinkField string `mapstructure:"epic_link_field"`
+	// Types maps Jira issue type names to beads issue types.
+	Types map[string]string `mapstructure:"types"`
+	// Priorities maps Jira priority names to beads priorities (0-4).
+	Priorities map[string]int `mapstructure:"priorities"`
+}
+
+// FieldDefs returns the configured custom issue fields as domain definitions.
+func (c Config) FieldDefs() []beads.FieldDef {
+	if len(c.CustomFields) == 0 {
+		return nil
+	}
+	defs := make([]beads.FieldDef, len(c.CustomFields))
+	for i, f := range c.CustomFields {
+		defs[i] = beads.FieldDef{Key: f.Key, Label: f.Label, Type: beads.FieldType(f.Type), Options: f.Options}
+	}
+	return defs
+}
+
 // UIConfig holds user interface configuration options.
 type UIConfig struct {
-	ShowCounts    bool              `mapstructure:"show_counts"`
-	ShowStatusBar bool              `mapstructure:"show_status_bar"`
-	MarkdownStyle string            `mapstructure:"markdown_style"` // "dark" (default) or "light"
-	VimMode       bool              `mapstructure:"vim_mode"`       // Enable vim keybindings in text input areas
-	Keybindings   KeybindingsConfig `mapstructure:"keybindings"`
-	Actions       ActionsConfig     `mapstructure:"actions"` // User-defined keybinding actions
+	ShowCounts    bool                `mapstructure:"show_counts"`
+	ShowStatusBar bool                `mapstructure:"show_status_bar"`
+	MarkdownStyle string              `mapstructure:"markdown_style"` // "dark" (default) or "light"
+	VimMode       bool                `mapstructure:"vim_mode"`       // Enable vim keybindings in text input areas
+	Keybindings   KeybindingsConfig   `mapstructure:"keybindings"`
+	Actions       ActionsConfig       `mapstructure:"actions"`       // User-defined keybinding actions
+	Accessibility AccessibilityConfig `mapstructure:"accessibility"` // Screen-reader-friendly output
+}
+
+// AccessibilityConfig configures the screen-reader-friendly output mode.
+// Example YAML:
+//
+//	accessibility:
+//	  enabled: true
+//	  announce_file: ~/.perles/announcements.log
+type AccessibilityConfig struct {
+	Enabled      bool   `mapstructure:"enabled"`       // Plain-text frames and announcements, no alt screen or mouse
+	AnnounceFile string `mapstructure:"announce_file"` // Also append announcements to this file ("" = screen only)
 }
 
 // KeybindingsConfig holds user-customizable keybinding overrides.
 type KeybindingsConfig struct {
-	Search    string `mapstructure:"search"`    // Default: "ctrl+space"
-	Dashboard string `mapstructure:"dashboard"` // Default: "ctrl+o"
+	Search    string          `mapstructure:"search"`    // Default: "ctrl+space"
+	Dashboard string          `mapstructure:"dashboard"` // Default: "ctrl+o"
+	Vim       VimKeymapConfig `mapstructure:"vim"`       // Remaps for vim mode text input
+}
+
+// VimKeymapConfig holds user key remaps and timeouts for vim mode text input.
+// Example YAML:
+//
+//	vim:
+//	  timeout_ms: 300
+//	  leader: <space>
+//	  mappings:
+//	    - mode: insert
+//	      from: jk
+//	      to: <esc>
+//	    - mode: normal
+//	      from: <leader>d
+//	      to: dd
+type VimKeymapConfig struct {
+	Leader           string       `mapstructure:"leader"`             // Keys <leader> stands for in mappings (default backslash)
+	TimeoutMs        int          `mapstructure:"timeout_ms"`         // Wait for the rest of a mapping (default 1000)
+	PendingTimeoutMs int          `mapstructure:"pending_timeout_ms"` // Cancel pending commands like "d" (0 = never)
+	Mappings         []VimMapping `mapstructure:"mappings"`
+}
+
+// VimMapping maps one key sequence to another in a vim mode.
+type VimMapping struct {
+	Mode string `mapstructure:"mode"` // "normal", "insert", or "visual"
+	From string `mapstructure:"from"` // Keys typed (e.g., "jk")
+	To   string `mapstructure:"to"`   // Keys sent instead (e.g., "<esc>")
 }
 
+// Vim mapping modes accepted in VimMapping.Mode.
+const (
+	VimMapModeNormal = "normal"
+	VimMapModeInsert = "insert"
+	VimMapModeVisual = "visual"
+)
+
 // ActionConfig defines a single user-defined action that can be triggered by a keybinding.
 // Actions are executed in fire-and-forget mode - the command is started and perles continues.
 type ActionConfig struct {
@@ -79,9 +290,10 @@ type ActionsConfig struct {
 
 // ThemeConfig holds all theme customization options.
 type ThemeConfig struct {
-	// Preset loads a built-in theme as the base (optional).
-	// Valid values: "default", "catppuccin-mocha", "catppuccin-latte",
-	// "dracula", "nord", "high-contrast"
+	// Preset loads a built-in or user theme as the base (optional).
+	// Valid values: "default", "dark", "light", "catppuccin-mocha",
+	// "catppuccin-latte", "dracula", "nord", "high-contrast", "gruvbox",
+	// or the name of an entry in Themes.
 	Preset string `mapstructure:"preset"`
 
 	// Mode forces light or dark mode. If empty, uses terminal detection.
@@ -98,6 +310,24 @@ type ThemeConfig struct {
 	//   colors:
 	//     "text.primary": "#FF0000"
 	Colors map[string]any `mapstructure:"colors"`
+
+	// Themes defines user color schemes, selectable as a preset and from
+	// the theme switcher.
+	// Example YAML:
+	//   themes:
+	//     - name: ocean
+	//       base: light
+	//       colors:
+	//         text.primary: "#003366"
+	Themes []UserThemeConfig `mapstructure:"themes"`
+}
+
+// UserThemeConfig defines a named user color scheme.
+type UserThemeConfig struct {
+	Name        string         `mapstructure:"name"`
+	Description string         `mapstructure:"description"`
+	Base        string         `mapstructure:"base"`   // Preset to start from (default: "default")
+	Colors      map[string]any `mapstructure:"colors"` // Same format as ThemeConfig.Colors
 }
 
 // FlattenedColors returns the Colors map flattened to dot-notation keys.
@@ -108,6 +338,13 @@ func (t ThemeConfig) FlattenedColors() map[string]string {
 	return result
 }
 
+// FlattenedColors returns the Colors map flattened to dot-notation keys.
+func (t UserThemeConfig) FlattenedColors() map[string]string {
+	result := make(map[string]string)
+	flattenColors("", t.Colors, result)
+	return result
+}
+
 // flattenColors recursively flattens a nested map into dot-notation keys.
 func flattenColors(prefix string, m map[string]any, result map[string]string) {
 	for k, v := range m {
@@ -189,8 +426,10 @@ type OrchestrationConfig struct {
 	CoordinatorClient string               `mapstructure:"coordinator_client"` // Client for coordinator (overrides Client)
 	WorkerClient      string               `mapstructure:"worker_client"`      // Client for workers (overrides Client)
 	ObserverClient    string               `mapstructure:"observer_client"`    // Client for observer (default: "claude" with haiku model)
+	WorkerBackends    []string             `mapstructure:"worker_backends"`    // Additional clients spawn_worker can select per worker
 	ObserverEnabled   bool                 `mapstructure:"observer_enabled"`   // Enable observer agent (default: false)
 	APIPort           int                  `mapstructure:"api_port"`           // HTTP API port (0 = auto-assign, default: 0)
+	APIToken          string               `mapstructure:"api_token"`          // Bearer token required by the HTTP API (empty = unauthenticated)
 	Claude            ClaudeClientConfig   `mapstructure:"claude"`
 	ClaudeWorker      ClaudeClientConfig   `mapstructure:"claude_worker"`   // Worker-specific Claude config (uses claude config if empty)
 	ClaudeObserver    ClaudeClientConfig   `mapstructure:"claude_observer"` // Observer-specific Claude config (uses claude config if empty)
@@ -198,11 +437,246 @@ type OrchestrationConfig struct {
 	Amp               AmpClientConfig      `mapstructure:"amp"`
 	Gemini            GeminiClientConfig   `mapstructure:"gemini"`
 	OpenCode          OpenCodeClientConfig `mapstructure:"opencode"`
-	Workflows         []WorkflowConfig     `mapstructure:"workflows"`       // Workflow template configurations
-	Tracing           TracingConfig        `mapstructure:"tracing"`         // Distributed tracing configuration
-	SessionStorage    SessionStorageConfig `mapstructure:"session_storage"` // Session storage location configuration
-	Templates         TemplatesConfig      `mapstructure:"templates"`       // Template rendering variables
-	Timeouts          TimeoutsConfig       `mapstructure:"timeouts"`        // Initialization phase timeout configuration
+	Workflows         []WorkflowConfig     `mapstructure:"workflows"`        // Workflow template configurations
+	Tracing           TracingConfig        `mapstructure:"tracing"`          // Distributed tracing configuration
+	SessionStorage    SessionStorageConfig `mapstructure:"session_storage"`  // Session storage location configuration
+	Templates         TemplatesConfig      `mapstructure:"templates"`        // Template rendering variables
+	Timeouts          TimeoutsConfig       `mapstructure:"timeouts"`         // Initialization phase timeout configuration
+	Autoscale         AutoscaleConfig      `mapstructure:"autoscale"`        // Worker pool auto-scaling configuration
+	Budget            BudgetConfig         `mapstructure:"budget"`           // Per-worker and per-session token/time budgets
+	PhaseTimeouts     PhaseTimeoutsConfig  `mapstructure:"phase_timeouts"`   // How long workers may stay in each phase before escalation
+	RateLimits        RateLimitsConfig     `mapstructure:"rate_limits"`      // MCP tool call rate limits per process
+	Dedup             DedupConfig          `mapstructure:"dedup"`            // Suppression of repeated agent messages
+	TurnPolicy        TurnPolicyConfig     `mapstructure:"turn_policy"`      // Required tools and escalation for incomplete worker turns
+	Fabric            FabricConfig         `mapstructure:"fabric"`           // Fabric message graph storage configuration
+	Redaction         RedactionConfig      `mapstructure:"redaction"`        // Secret masking for session transcripts and logs
+	Audit             AuditConfig          `mapstructure:"audit"`            // Audit log of the coordinator's decisions
+	Webhooks          WebhooksConfig       `mapstructure:"webhooks"`         // HTTP endpoints notified of lifecycle events
+	DAG               string               `mapstructure:"dag"`              // Workflow DAG file (YAML) with the phases to enforce, relative to the work directory
+	Mode              string               `mapstructure:"mode"`             // "coordinator" (default) or "solo"
+	Solo              SoloConfig           `mapstructure:"solo"`             // Coordinator-less solo mode settings
+	PruningHints      bool                 `mapstructure:"pruning_hints"`    // Send workers context pruning hints on phase transitions (default: false)
+	WorkerWorktrees   bool                 `mapstructure:"worker_worktrees"` // Give each worker its own git worktree and branch (default: false)
+}
+
+// Orchestration modes.
+const (
+	OrchestrationModeCoordinator = "coordinator"
+	OrchestrationModeSolo        = "solo"
+)
+
+// IsSolo reports whether workflows run in solo mode.
+func (o OrchestrationConfig) IsSolo() bool {
+	return o.Mode == OrchestrationModeSolo
+}
+
+// DefaultSoloWorkers is the number of workers spawned in solo mode when not configured.
+const DefaultSoloWorkers = 2
+
+// SoloConfig holds settings for solo mode, where the orchestration processor
+// assigns ready bd tasks to workers round-robin instead of a coordinator agent.
+// Review denials and worker failures are escalated to the user, and to the
+// coordinator when one is enabled.
+type SoloConfig struct {
+	Workers     int  `mapstructure:"workers"`     // Workers spawned at startup (0 = DefaultSoloWorkers)
+	Coordinator bool `mapstructure:"coordinator"` // Also spawn a coordinator to receive escalations (default: false)
+}
+
+// WorkerCount returns the number of workers to spawn, applying the default.
+func (s SoloConfig) WorkerCount() int {
+	if s.Workers == 0 {
+		return DefaultSoloWorkers
+	}
+	return s.Workers
+}
+
+// RedactionConfig controls masking of secrets (API keys, tokens, emails) in persisted
+// session transcripts and logs. Redaction is on by default.
+type RedactionConfig struct {
+	Disabled         bool                  `mapstructure:"disabled"`           // Write transcripts unredacted (default: false)
+	Rules            []RedactionRuleConfig `mapstructure:"rules"`              // Extra regex rules, applied after the built-in ones
+	EntropyThreshold float64               `mapstructure:"entropy_threshold"`  // Bits per character for random-looking tokens (0 = 4.5, negative disables)
+	EntropyMinLength int                   `mapstructure:"entropy_min_length"` // Shortest token checked for entropy (0 = 32)
+}
+
+// AuditConfig configures the audit log (audit.jsonl) that records every
+// consequential coordinator decision in the session directory. The log is
+// always written; when it reaches MaxSizeMB it is rotated to audit.<n>.jsonl.
+type AuditConfig struct {
+	MaxSizeMB int `mapstructure:"max_size_mb"` // Rotation size in megabytes (0 = 10)
+}
+
+// MaxSize returns the rotation size in bytes, or 0 for the default.
+func (a AuditConfig) MaxSize() int64 {
+	return int64(a.MaxSizeMB) << 20
+}
+
+// WebhooksConfig configures the endpoints that receive orchestration lifecycle
+// events (see webhook.Events) as JSON. Failed deliveries are retried with
+// exponential backoff and then appended to the dead-letter log.
+// Example YAML:
+//
+//	webhooks:
+//	  endpoints:
+//	    - url: https://hooks.slack.com/services/T000/B000/XXXX
+//	      events: [workflow.completed, worker.failed, review.denied]
+//	    - url: https://ci.example.com/perles
+//	      secret: ${PERLES_WEBHOOK_SECRET}
+//	  max_attempts: 5
+type WebhooksConfig struct {
+	Endpoints   []WebhookEndpointConfig `mapstructure:"endpoints"`
+	MaxAttempts int                     `mapstructure:"max_attempts"` // Attempts per delivery (0 = 5)
+	Backoff     time.Duration           `mapstructure:"backoff"`      // Wait before the first retry, doubled each time (0 = 1s)
+	Timeout     time.Duration           `mapstructure:"timeout"`      // Per-request timeout (0 = 10s)
+	DeadLetter  string                  `mapstructure:"dead_letter"`  // Dead-letter log (default: ~/.perles/webhooks-dead-letter.jsonl)
+}
+
+// WebhookEndpointConfig is a URL that receives lifecycle events.
+type WebhookEndpointConfig struct {
+	URL    string   `mapstructure:"url"`
+	Events []string `mapstructure:"events"` // Event types to send (empty = all)
+	Secret string   `mapstructure:"secret"` // Signs payloads with HMAC-SHA256 (X-Perles-Signature-256)
+}
+
+// DefaultWebhookDeadLetterPath returns the default path of the webhook dead-letter log.
+// Returns ~/.perles/webhooks-dead-letter.jsonl or empty string if home dir unavailable.
+func DefaultWebhookDeadLetterPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".perles", "webhooks-dead-letter.jsonl")
+}
+
+// RedactionRuleConfig is a user-defined redaction rule.
+type RedactionRuleConfig struct {
+	Name    string `mapstructure:"name"`    // Name shown in the redaction report
+	Pattern string `mapstructure:"pattern"` // Go regexp; with a capture group only the group is masked
+}
+
+// FabricConfig holds Fabric messaging layer settings.
+type FabricConfig struct {
+	// Storage selects where channels, threads, subscriptions and acks are kept:
+	// "memory" (default) or "sqlite" (fabric.db in the session directory, so a
+	// resumed session keeps its full channel and thread history).
+	Storage string `mapstructure:"storage"`
+
+	// ProjectMemory enables the #memory channel, which outlives individual
+	// sessions: entries are kept per project, imported into each new session,
+	// and pinned entries are listed in the coordinator's startup brief.
+	ProjectMemory bool `mapstructure:"project_memory"`
+}
+
+// AutoscaleConfig holds settings for sizing the worker pool to the ready-task backlog.
+// Zero durations fall back to the autoscaler defaults.
+type AutoscaleConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`      // Enable the autoscaler (default: false)
+	MinWorkers  int           `mapstructure:"min_workers"`  // Never retire idle workers below this count (default: 0)
+	MaxWorkers  int           `mapstructure:"max_workers"`  // Never spawn above this count (0 = unbounded)
+	MaxCostUSD  float64       `mapstructure:"max_cost_usd"` // Stop spawning once the workflow spends this much (0 = no budget)
+	Interval    time.Duration `mapstructure:"interval"`     // How often the backlog is evaluated (default: 30s)
+	IdleTimeout time.Duration `mapstructure:"idle_timeout"` // Idle time before a worker may be retired (default: 5m)
+	Cooldown    time.Duration `mapstructure:"cooldown"`     // Minimum time between scaling actions (default: 1m)
+}
+
+// BudgetConfig caps how many tokens and how much wall-clock time workers and
+// sessions may use. Zero values are unlimited. Workers are warned at 80% of their
+// budget and replaced with a fresh worker at 100%; the coordinator is warned
+// when the session budget reaches 80% and 100%.
+type BudgetConfig struct {
+	WorkerTokens    int           `mapstructure:"worker_tokens"`    // Tokens a worker may spend before it is replaced
+	WorkerDuration  time.Duration `mapstructure:"worker_duration"`  // Wall-clock time a worker may run before it is replaced
+	SessionTokens   int           `mapstructure:"session_tokens"`   // Tokens all processes of a session may spend
+	SessionDuration time.Duration `mapstructure:"session_duration"` // Wall-clock time a session may run
+}
+
+// PhaseTimeoutsConfig limits how long a worker may stay in a phase. A worker
+// over the limit is nudged; at twice the limit the coordinator is notified and
+// at three times the limit the worker is replaced. Phases without a timeout
+// are not watched. Worker entries override the phase timeouts for one worker.
+// Example YAML:
+//
+//	phase_timeouts:
+//	  phases:
+//	    implementing: 2h
+//	    reviewing: 1h
+//	  workers:
+//	    worker-3: { implementing: 4h }
+type PhaseTimeoutsConfig struct {
+	Phases  map[string]time.Duration            `mapstructure:"phases"`  // Timeout by worker phase, e.g. "reviewing"
+	Workers map[string]map[string]time.Duration `mapstructure:"workers"` // Phase timeouts by worker ID (0 = not watched)
+}
+
+// RateLimitsConfig limits how often each agent process may call MCP tools, so a
+// looping worker cannot flood fabric or the coordinator. Calls over a limit
+// fail with an error telling the agent when to retry. Zero values are unlimited.
+// Example YAML:
+//
+//	rate_limits:
+//	  process: { calls: 120, per: 1m }
+//	  tools:
+//	    fabric_send: { calls: 20, per: 1m }
+//	    query_worker_state: { calls: 10, per: 1m }
+type RateLimitsConfig struct {
+	Process RateLimitConfig            `mapstructure:"process"` // All tool calls of a process combined
+	Tools   map[string]RateLimitConfig `mapstructure:"tools"`   // Calls of one tool by a process, by tool name
+}
+
+// RateLimitConfig allows Calls calls per Per, with bursts of up to Calls calls.
+type RateLimitConfig struct {
+	Calls int           `mapstructure:"calls"`
+	Per   time.Duration `mapstructure:"per"` // Default: 1m
+}
+
+// Message deduplication strategies, see DedupConfig.
+const (
+	DedupStrategyPerRecipient = "per_recipient"
+	DedupStrategyExact        = "exact"
+	DedupStrategyNormalized   = "normalized"
+)
+
+// DedupConfig configures how repeated messages are suppressed. When an agent
+// sends a message (fabric_send, fabric_reply, assign_task) that repeats one it
+// sent within Window, the message is not sent again unless the agent passes
+// force=true. Strategy decides what counts as a repeat:
+//   - per_recipient (default): the same content to the same channel, thread or worker
+//   - exact: the same content, wherever it is sent
+//   - normalized: like exact, ignoring case and whitespace differences
+//
+// Example YAML:
+//
+//	dedup:
+//	  window: 10s
+//	  strategy: normalized
+type DedupConfig struct {
+	Window   time.Duration `mapstructure:"window"`   // Default: 5s
+	Strategy string        `mapstructure:"strategy"` // Default: per_recipient
+}
+
+// TurnPolicyConfig configures what happens when a worker ends a turn without
+// calling a tool that completes it (fabric_send, report_implementation_complete, ...).
+// Each consecutive incomplete turn takes the next escalation step: "nudge" reminds
+// the worker, "warn" also tells the coordinator, "replace" swaps the worker for a
+// fresh one. After the last step the turn is allowed to end. Once timeout has
+// passed since the first incomplete turn, remaining nudges are skipped.
+// Example YAML:
+//
+//	turn_policy:
+//	  escalation: [nudge, nudge, warn, replace]
+//	  timeout: 10m
+//	  phases:
+//	    reviewing: { tools: [report_review_verdict, fabric_send] }
+type TurnPolicyConfig struct {
+	Tools      []string                         `mapstructure:"tools"`      // Tools that complete a turn (default: fabric and report tools)
+	Escalation []string                         `mapstructure:"escalation"` // Action per consecutive incomplete turn (default: [nudge, nudge])
+	Timeout    time.Duration                    `mapstructure:"timeout"`    // Grace period for nudges (0 = no limit)
+	Phases     map[string]TurnPolicyPhaseConfig `mapstructure:"phases"`     // Overrides by worker phase, e.g. "implementing"
+}
+
+// TurnPolicyPhaseConfig overrides the turn policy for one worker phase.
+type TurnPolicyPhaseConfig struct {
+	Tools   []string      `mapstructure:"tools"`   // Tools that complete a turn in the phase
+	Timeout time.Duration `mapstructure:"timeout"` // Grace period for nudges in the phase
 }
 
 // ClaudeClientConfig holds Claude-specific settings.
@@ -274,6 +748,7 @@ func (o OrchestrationConfig) IsObserverEnabled() bool {
 
 // AgentProviders returns the AgentProviders map for coordinator, worker, and observer roles.
 // This is the preferred way to get AI clients for orchestration.
+// Each entry in WorkerBackends adds a worker provider keyed by client.WorkerBackendRole.
 // Observer is only included when ObserverEnabled is explicitly set to true.
 func (o OrchestrationConfig) AgentProviders() client.AgentProviders {
 	coordType := o.CoordinatorClientType()
@@ -284,6 +759,14 @@ func (o OrchestrationConfig) AgentProviders() client.AgentProviders {
 		client.RoleWorker:      client.NewAgentProvider(workerType, o.extensionsForClient(workerType, true)),
 	}
 
+	for _, backend := range o.WorkerBackends {
+		backendType := client.ClientType(backend)
+		if backendType == workerType {
+			continue
+		}
+		providers[client.WorkerBackendRole(backendType)] = client.NewAgentProvider(backendType, o.extensionsForClient(backendType, true))
+	}
+
 	if o.IsObserverEnabled() {
 		observerType := o.ObserverClientType()
 		providers[client.RoleObserver] = client.NewAgentProvider(observerType, o.extensionsForObserver(observerType))
@@ -408,6 +891,15 @@ type TracingConfig struct {
 	// 1.0 = all traces, 0.1 = 10% of traces
 	// Default: 1.0
 	SampleRate float64 `mapstructure:"sample_rate"`
+
+	// Metrics exports command pipeline metrics (commands processed, queue
+	// depth, per-phase latency histograms) to OTLPEndpoint. Independent of Enabled.
+	// Default: false
+	Metrics bool `mapstructure:"metrics"`
+
+	// MetricsInterval is how often metrics are exported.
+	// Default: 15s
+	MetricsInterval time.Duration `mapstructure:"metrics_interval"`
 }
 
 // IsEnabled returns whether the workflow is enabled (defaults to true if nil).
@@ -434,6 +926,20 @@ type SoundConfig struct {
 	Events map[string]SoundEventConfig `mapstructure:"events"`
 }
 
+// NotificationEventConfig configures desktop notifications for a single event.
+type NotificationEventConfig struct {
+	// Enabled controls whether this event shows a desktop notification.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// NotificationsConfig holds OS desktop notification configuration.
+// Desktop notifications are opt-in: events missing from Events never notify.
+type NotificationsConfig struct {
+	// Events maps notification event identifiers to their configuration.
+	// Keys match the sound event identifiers (e.g., "user_notification").
+	Events map[string]NotificationEventConfig `mapstructure:"events"`
+}
+
 // DefaultTracesFilePath returns the default path for trace file export.
 // Returns ~/.config/perles/traces/traces.jsonl or empty string if home dir unavailable.
 func DefaultTracesFilePath() string {
@@ -531,9 +1037,19 @@ func ValidateColumns(cols []ColumnConfig) error {
 				return fmt.Errorf("column %d (%s): issue_id is required for tree columns", i, col.Name)
 			}
 			// TreeMode defaults to "deps" (handled in tree column creation, not validation)
+			if col.WIPLimit != 0 {
+				return fmt.Errorf("column %d (%s): wip_limit is only supported for bql columns", i, col.Name)
+			}
 		default:
 			return fmt.Errorf("column %d (%s): invalid type %q (must be \"bql\" or \"tree\")", i, col.Name, col.Type)
 		}
+
+		if col.WIPLimit < 0 {
+			return fmt.Errorf("column %d (%s): wip_limit must be >= 0, got %d", i, col.Name, col.WIPLimit)
+		}
+		if col.WIPNotify && col.WIPLimit == 0 {
+			return fmt.Errorf("column %d (%s): wip_notify requires wip_limit", i, col.Name)
+		}
 	}
 	return nil
 }
@@ -553,6 +1069,14 @@ func ValidateViews(views []ViewConfig) error {
 		if err := ValidateColumns(view.Columns); err != nil {
 			return fmt.Errorf("view %d (%s): %w", i, view.Name, err)
 		}
+		for _, field := range view.Fields {
+			if !slices.Contains(IssueFields, field) {
+				return fmt.Errorf("view %d (%s): unknown field %q (valid: %s)", i, view.Name, field, strings.Join(IssueFields, ", "))
+			}
+		}
+		if err := ValidateViewSort(view.Sort); err != nil {
+			return fmt.Errorf("view %d (%s): %w", i, view.Name, err)
+		}
 	}
 	return nil
 }
@@ -588,6 +1112,13 @@ func ValidateOrchestration(orch OrchestrationConfig) error {
 		return fmt.Errorf("orchestration.observer_client must be one of %v, got %q", allowedClients, orch.ObserverClient)
 	}
 
+	// Validate worker_backends
+	for _, backend := range orch.WorkerBackends {
+		if !isAllowedClient(backend) {
+			return fmt.Errorf("orchestration.worker_backends must only contain %v, got %q", allowedClients, backend)
+		}
+	}
+
 	// Validate Amp mode
 	if orch.Amp.Mode != "" {
 		switch orch.Amp.Mode {
@@ -618,9 +1149,237 @@ func ValidateOrchestration(orch OrchestrationConfig) error {
 		return err
 	}
 
+	// Validate autoscaler bounds
+	if err := ValidateAutoscale(orch.Autoscale); err != nil {
+		return err
+	}
+
+	// Validate budgets
+	if err := ValidateBudget(orch.Budget); err != nil {
+		return err
+	}
+
+	// Validate phase timeouts
+	if err := ValidatePhaseTimeouts(orch.PhaseTimeouts); err != nil {
+		return err
+	}
+
+	// Validate rate limits
+	if err := ValidateRateLimits(orch.RateLimits); err != nil {
+		return err
+	}
+
+	// Validate message deduplication
+	if err := ValidateDedup(orch.Dedup); err != nil {
+		return err
+	}
+
+	// Validate turn policy
+	if err := ValidateTurnPolicy(orch.TurnPolicy); err != nil {
+		return err
+	}
+
+	// Validate orchestration mode
+	switch orch.Mode {
+	case "", OrchestrationModeCoordinator, OrchestrationModeSolo:
+		// Valid
+	default:
+		return fmt.Errorf("orchestration.mode must be %q or %q, got %q", OrchestrationModeCoordinator, OrchestrationModeSolo, orch.Mode)
+	}
+	if orch.Solo.Workers < 0 {
+		return fmt.Errorf("orchestration.solo.workers must not be negative, got %d", orch.Solo.Workers)
+	}
+
+	// Validate fabric storage backend
+	switch orch.Fabric.Storage {
+	case "", "memory", "sqlite":
+		// Valid
+	default:
+		return fmt.Errorf("orchestration.fabric.storage must be \"memory\" or \"sqlite\", got %q", orch.Fabric.Storage)
+	}
+
+	// Validate redaction rules
+	if err := ValidateRedaction(orch.Redaction); err != nil {
+		return err
+	}
+
+	if orch.Audit.MaxSizeMB < 0 {
+		return fmt.Errorf("orchestration.audit.max_size_mb must not be negative, got %d", orch.Audit.MaxSizeMB)
+	}
+
+	if err := ValidateWebhooks(orch.Webhooks); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateRedaction checks redaction configuration for errors.
+// Returns nil if the configuration is valid.
+func ValidateRedaction(r RedactionConfig) error {
+	for i, rule := range r.Rules {
+		if strings.TrimSpace(rule.Name) == "" {
+			return fmt.Errorf("orchestration.redaction.rules[%d]: name is required", i)
+		}
+		if rule.Pattern == "" {
+			return fmt.Errorf("orchestration.redaction.rules[%d] (%s): pattern is required", i, rule.Name)
+		}
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return fmt.Errorf("orchestration.redaction.rules[%d] (%s): invalid pattern: %w", i, rule.Name, err)
+		}
+	}
+	if r.EntropyMinLength < 0 {
+		return fmt.Errorf("orchestration.redaction.entropy_min_length must not be negative, got %d", r.EntropyMinLength)
+	}
+	return nil
+}
+
+// ValidateAutoscale checks autoscale configuration for errors.
+// Returns nil if the configuration is valid.
+func ValidateAutoscale(as AutoscaleConfig) error {
+	if as.MinWorkers < 0 {
+		return fmt.Errorf("orchestration.autoscale.min_workers must not be negative, got %d", as.MinWorkers)
+	}
+	if as.MaxWorkers < 0 {
+		return fmt.Errorf("orchestration.autoscale.max_workers must not be negative, got %d", as.MaxWorkers)
+	}
+	if as.MaxWorkers > 0 && as.MinWorkers > as.MaxWorkers {
+		return fmt.Errorf("orchestration.autoscale.min_workers (%d) must not exceed max_workers (%d)", as.MinWorkers, as.MaxWorkers)
+	}
+	if as.MaxCostUSD < 0 {
+		return fmt.Errorf("orchestration.autoscale.max_cost_usd must not be negative, got %v", as.MaxCostUSD)
+	}
+	if as.Interval < 0 || as.IdleTimeout < 0 || as.Cooldown < 0 {
+		return fmt.Errorf("orchestration.autoscale durations must not be negative")
+	}
+	return nil
+}
+
+// ValidateBudget checks budget configuration for errors.
+// Returns nil if the configuration is valid.
+func ValidateBudget(b BudgetConfig) error {
+	if b.WorkerTokens < 0 || b.SessionTokens < 0 {
+		return fmt.Errorf("orchestration.budget token limits must not be negative")
+	}
+	if b.WorkerDuration < 0 || b.SessionDuration < 0 {
+		return fmt.Errorf("orchestration.budget durations must not be negative")
+	}
+	return nil
+}
+
+// watchablePhases are the worker phases a phase timeout may be set for.
+var watchablePhases = []events.ProcessPhase{
+	events.ProcessPhaseIdle,
+	events.ProcessPhaseImplementing,
+	events.ProcessPhaseAwaitingReview,
+	events.ProcessPhaseReviewing,
+	events.ProcessPhaseAddressingFeedback,
+	events.ProcessPhaseCommitting,
+	events.ProcessPhaseBlocked,
+}
+
+// ValidatePhaseTimeouts checks phase timeout configuration for errors.
+func ValidatePhaseTimeouts(p PhaseTimeoutsConfig) error {
+	check := func(prefix string, phases map[string]time.Duration) error {
+		for phase, d := range phases {
+			if !slices.Contains(watchablePhases, events.ProcessPhase(phase)) {
+				return fmt.Errorf("%s: unknown phase %q", prefix, phase)
+			}
+			if d < 0 {
+				return fmt.Errorf("%s.%s must not be negative, got %s", prefix, phase, d)
+			}
+		}
+		return nil
+	}
+	if err := check("orchestration.phase_timeouts.phases", p.Phases); err != nil {
+		return err
+	}
+	for worker, phases := range p.Workers {
+		if err := check("orchestration.phase_timeouts.workers."+worker, phases); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateRateLimits checks MCP tool call rate limits for errors.
+func ValidateRateLimits(r RateLimitsConfig) error {
+	check := func(name string, l RateLimitConfig) error {
+		if l.Calls < 0 {
+			return fmt.Errorf("orchestration.rate_limits.%s.calls must not be negative, got %d", name, l.Calls)
+		}
+		if l.Per < 0 {
+			return fmt.Errorf("orchestration.rate_limits.%s.per must not be negative, got %s", name, l.Per)
+		}
+		return nil
+	}
+	if err := check("process", r.Process); err != nil {
+		return err
+	}
+	for tool, l := range r.Tools {
+		if err := check("tools."+tool, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// turnPolicyActions are the actions a turn policy escalation may list.
+var turnPolicyActions = []string{"nudge", "warn", "replace"}
+
+// ValidateTurnPolicy checks the turn completion policy for errors.
+func ValidateTurnPolicy(t TurnPolicyConfig) error {
+	for _, a := range t.Escalation {
+		if !slices.Contains(turnPolicyActions, a) {
+			return fmt.Errorf("orchestration.turn_policy: unknown escalation action %q (want nudge, warn or replace)", a)
+		}
+	}
+	if t.Timeout < 0 {
+		return fmt.Errorf("orchestration.turn_policy: timeout must not be negative, got %s", t.Timeout)
+	}
+	for phase, rule := range t.Phases {
+		if rule.Timeout < 0 {
+			return fmt.Errorf("orchestration.turn_policy: phase %s timeout must not be negative, got %s", phase, rule.Timeout)
+		}
+	}
 	return nil
 }
 
+// webhookEvents are the event types a webhook endpoint may subscribe to.
+var webhookEvents = []string{"workflow.completed", "workflow.failed", "worker.failed", "review.denied", "task.failed"}
+
+// ValidateWebhooks checks webhook endpoints and retry settings for errors.
+func ValidateWebhooks(w WebhooksConfig) error {
+	for i, e := range w.Endpoints {
+		if e.URL == "" {
+			return fmt.Errorf("orchestration.webhooks: webhook endpoint %d has no url", i)
+		}
+		for _, t := range e.Events {
+			if !slices.Contains(webhookEvents, t) {
+				return fmt.Errorf("orchestration.webhooks: webhook endpoint %d: unknown event %q (valid: %v)", i, t, webhookEvents)
+			}
+		}
+	}
+	if w.MaxAttempts < 0 || w.Backoff < 0 || w.Timeout < 0 {
+		return errors.New("orchestration.webhooks: webhook retry settings must not be negative")
+	}
+	return nil
+}
+
+// ValidateDedup checks message deduplication settings for errors.
+func ValidateDedup(d DedupConfig) error {
+	if d.Window < 0 {
+		return fmt.Errorf("orchestration.dedup.window must not be negative, got %s", d.Window)
+	}
+	switch d.Strategy {
+	case "", DedupStrategyPerRecipient, DedupStrategyExact, DedupStrategyNormalized:
+		return nil
+	default:
+		return fmt.Errorf("orchestration.dedup.strategy must be %q, %q or %q, got %q",
+			DedupStrategyPerRecipient, DedupStrategyExact, DedupStrategyNormalized, d.Strategy)
+	}
+}
+
 // maxSoundFileSize is the maximum allowed size for override sound files (1MB).
 const maxSoundFileSize = 1 * 1024 * 1024
 
@@ -841,6 +1600,31 @@ func ValidateKeybindings(kb KeybindingsConfig) error {
 		}
 	}
 
+	return validateVimKeymap(kb.Vim)
+}
+
+// validateVimKeymap validates vim mode remaps. Key notation is checked when
+// the keymap is built by the textarea.
+func validateVimKeymap(vim VimKeymapConfig) error {
+	if vim.TimeoutMs < 0 {
+		return fmt.Errorf("ui.keybindings.vim.timeout_ms must be >= 0, got %d", vim.TimeoutMs)
+	}
+	if vim.PendingTimeoutMs < 0 {
+		return fmt.Errorf("ui.keybindings.vim.pending_timeout_ms must be >= 0, got %d", vim.PendingTimeoutMs)
+	}
+	for i, mapping := range vim.Mappings {
+		switch mapping.Mode {
+		case VimMapModeNormal, VimMapModeInsert, VimMapModeVisual:
+		default:
+			return fmt.Errorf("ui.keybindings.vim.mappings[%d]: mode must be normal, insert, or visual, got %q", i, mapping.Mode)
+		}
+		if mapping.From == "" {
+			return fmt.Errorf("ui.keybindings.vim.mappings[%d]: from is required", i)
+		}
+		if mapping.To == "" {
+			return fmt.Errorf("ui.keybindings.vim.mappings[%d]: to is required", i)
+		}
+	}
 	return nil
 }
 
@@ -851,6 +1635,69 @@ var allowedIssueActionKeys = map[string]bool{
 	"5": true, "6": true, "7": true, "8": true, "9": true,
 }
 
+// ValidateCustomFields checks custom issue field definitions for errors.
+func ValidateCustomFields(fields []CustomFieldConfig) error {
+	if err := beads.ValidateFieldDefs(Config{CustomFields: fields}.FieldDefs()); err != nil {
+		return fmt.Errorf("custom_fields: %w", err)
+	}
+	return nil
+}
+
+// mappableIssueTypes are the beads types that labels and issue types of
+// other trackers can map to.
+var mappableIssueTypes = []beads.IssueType{beads.TypeBug, beads.TypeFeature, beads.TypeTask, beads.TypeEpic, beads.TypeChore}
+
+// ValidateGitHub checks the GitHub sync configuration for errors.
+func ValidateGitHub(gh GitHubConfig) error {
+	owner, name, ok := strings.Cut(gh.Repo, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("github.repo must be owner/name, got %q", gh.Repo)
+	}
+	if gh.Token == "" {
+		return fmt.Errorf("github.token is required (e.g. token: ${GITHUB_TOKEN})")
+	}
+	for label, p := range gh.Priorities {
+		if p < 0 || p > 4 {
+			return fmt.Errorf("github.priorities: %q maps to %d, want 0-4", label, p)
+		}
+	}
+	for label, t := range gh.Types {
+		if !slices.Contains(mappableIssueTypes, beads.IssueType(t)) {
+			return fmt.Errorf("github.types: %q maps to unknown type %q", label, t)
+		}
+	}
+	return nil
+}
+
+// ValidateJira checks the Jira import mappings for errors. The connection
+// settings are only required when importing from the API, so they are checked
+// by ValidateJiraAPI.
+func ValidateJira(jira JiraConfig) error {
+	for name, p := range jira.Priorities {
+		if p < 0 || p > 4 {
+			return fmt.Errorf("jira.priorities: %q maps to %d, want 0-4", name, p)
+		}
+	}
+	for name, t := range jira.Types {
+		if !slices.Contains(mappableIssueTypes, beads.IssueType(t)) {
+			return fmt.Errorf("jira.types: %q maps to unknown type %q", name, t)
+		}
+	}
+	return nil
+}
+
+// ValidateJiraAPI checks the Jira connection settings for errors.
+func ValidateJiraAPI(jira JiraConfig) error {
+	u, err := url.Parse(jira.URL)
+	if jira.URL == "" || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("jira.url must be the http(s) URL of the Jira site, got %q", jira.URL)
+	}
+	if jira.Token == "" {
+		return fmt.Errorf("jira.token is required (e.g. token: ${JIRA_TOKEN})")
+	}
+	return nil
+}
+
 // ValidateActions validates the actions configuration.
 // Returns an error if any action has invalid configuration.
 func ValidateActions(actions ActionsConfig) error {
@@ -907,6 +1754,10 @@ func ValidateTracing(tracing TracingConfig) error {
 		}
 	}
 
+	if tracing.MetricsInterval < 0 {
+		return fmt.Errorf("orchestration.tracing.metrics_interval must not be negative, got %s", tracing.MetricsInterval)
+	}
+
 	// Only validate path requirements when tracing is enabled
 	if tracing.Enabled {
 		// FilePath is required when Exporter is "file"
@@ -920,6 +1771,11 @@ func ValidateTracing(tracing TracingConfig) error {
 		}
 	}
 
+	// Metrics are always exported over OTLP
+	if tracing.Metrics && tracing.OTLPEndpoint == "" {
+		return fmt.Errorf("orchestration.tracing.otlp_endpoint is required when metrics are enabled")
+	}
+
 	return nil
 }
 
@@ -1013,6 +1869,13 @@ func Defaults() Config {
 				ApplicationName: "", // Derived from git remote or directory name
 			},
 			Timeouts: DefaultTimeoutsConfig(),
+			Fabric: FabricConfig{
+				Storage: "memory",
+			},
+			Mode: OrchestrationModeCoordinator,
+			Solo: SoloConfig{
+				Workers: DefaultSoloWorkers,
+			},
 		},
 		Sound: SoundConfig{
 			Events: map[string]SoundEventConfig{
@@ -1022,6 +1885,14 @@ func Defaults() Config {
 				"worker_out_of_context":      {Enabled: true},
 				"coordinator_out_of_context": {Enabled: true},
 				"user_notification":          {Enabled: true},
+				"fabric_user_mention":        {Enabled: true},
+			},
+		},
+		Notifications: NotificationsConfig{
+			Events: map[string]NotificationEventConfig{
+				"user_notification":   {Enabled: false},
+				"fabric_user_mention": {Enabled: false},
+				"scheduled_run":       {Enabled: false},
 			},
 		},
 	}
@@ -1057,10 +1928,13 @@ theme:
   #
   # Available presets:
   #   default           - Default perles theme
+  #   dark              - Dark theme (perles defaults)
+  #   light             - Light theme for light terminal backgrounds
   #   catppuccin-mocha  - Warm, cozy dark theme
   #   catppuccin-latte  - Warm, cozy light theme
   #   dracula           - Dark theme with vibrant colors
   #   nord              - Arctic, north-bluish palette
+  #   gruvbox           - Retro groove color scheme
   #   high-contrast     - High contrast for accessibility
   #
   # Override specific colors (works with or without preset):
@@ -1069,6 +1943,13 @@ theme:
   #   status.error: "#FF0000"
   #   priority.critical: "#FF5555"
   #
+  # Define your own themes, selectable as a preset or with Ctrl+Y:
+  # themes:
+  #   - name: ocean
+  #     base: light
+  #     colors:
+  #       text.primary: "#003366"
+  #
   # See all available color tokens with 'perles themes --help' or docs
 
 # Board views - each view is a named collection of columns
@@ -1168,6 +2049,52 @@ orchestration:
   #   workspace_setup: 30s      # MCP server and infrastructure setup (default: 30s)
   #   max_total: 120s           # Maximum total initialization time (default: 120s)
 
+  # Worker pool auto-scaling
+  # Spawns workers when ready (unblocked, unassigned) tasks outnumber idle workers
+  # and retires workers that stay idle. Decisions are shown in the coordinator chat.
+  # autoscale:
+  #   enabled: true
+  #   min_workers: 1            # Never retire below this many workers (default: 0)
+  #   max_workers: 4            # Never spawn above this many workers (0 = unbounded)
+  #   max_cost_usd: 20          # Stop spawning once the workflow has spent this much (0 = no budget)
+  #   interval: 30s             # How often the backlog is evaluated (default: 30s)
+  #   idle_timeout: 5m          # Idle time before a worker may be retired (default: 5m)
+  #   cooldown: 1m              # Minimum time between scaling actions (default: 1m)
+
+  # Token and wall-clock budgets (0 = unlimited)
+  # Workers are warned at 80% and replaced with a fresh worker at 100%.
+  # The coordinator is warned when the session reaches 80% and 100%.
+  # Also settable with --worker-token-budget, --worker-time-budget,
+  # --session-token-budget and --session-time-budget.
+  # budget:
+  #   worker_tokens: 2000000
+  #   worker_duration: 45m
+  #   session_tokens: 20000000
+  #   session_duration: 4h
+
+  # Fabric message graph storage
+  # "memory" keeps channels, threads, subscriptions and acks in memory (lost on restart).
+  # "sqlite" stores them in fabric.db in the session directory so a resumed session
+  # keeps its full channel and thread history.
+  # project_memory adds a #memory channel shared by all sessions of this project.
+  # Coordinators post durable decisions and conventions there and pin them with 📌;
+  # pinned entries are listed in the next session's startup brief.
+  # fabric:
+  #   storage: memory
+  #   project_memory: false
+
+  # Transcript redaction
+  # Secrets (API keys, tokens, private keys, emails, random-looking strings) are masked
+  # as [REDACTED:<rule>] before session transcripts and logs are written to disk.
+  # Per-rule counts are recorded in the session's metadata.json and summary.md.
+  # redaction:
+  #   disabled: false           # Write transcripts unredacted (default: false)
+  #   entropy_threshold: 4.5    # Bits per character for random-looking tokens (negative disables)
+  #   entropy_min_length: 32    # Shortest token checked for entropy (default: 32)
+  #   rules:                    # Extra rules applied after the built-in ones
+  #     - name: internal_token
+  #       pattern: "corp_[A-Za-z0-9]{24}"
+
   # Sound Notifications
   # Audio feedback for orchestration events. All events are enabled by default.
   # To override the default sounds use the override_sounds for each event.
@@ -1197,6 +2124,27 @@ orchestration:
       # Plays for general user notifications
       user_notification:
         enabled: true
+
+      # Plays when an agent @mentions you in a fabric channel
+      fabric_user_mention:
+        enabled: true
+
+# Desktop Notifications
+# OS notifications (macOS via osascript, Linux via notify-send) for events that
+# need your attention while the terminal is in the background. Disabled by default.
+notifications:
+  events:
+    # Coordinator called notify_user
+    user_notification:
+      enabled: false
+
+    # An agent @mentioned you in a fabric channel
+    fabric_user_mention:
+      enabled: false
+
+    # A run started by 'perles schedule daemon' completed or failed
+    scheduled_run:
+      enabled: false
 `
 }
 
@@ -6,9 +6,11 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
@@ -67,6 +69,28 @@ func TestValidateColumns_SecondColumnMissingQuery(t *testing.T) {
 	require.Contains(t, err.Error(), "query is required")
 }
 
+func TestValidateColumns_WIPLimit(t *testing.T) {
+	err := ValidateColumns([]ColumnConfig{
+		{Name: "In Progress", Query: "status = in_progress", WIPLimit: 3, WIPNotify: true},
+	})
+	require.NoError(t, err)
+
+	err = ValidateColumns([]ColumnConfig{
+		{Name: "In Progress", Query: "status = in_progress", WIPLimit: -1},
+	})
+	require.EqualError(t, err, "column 0 (In Progress): wip_limit must be >= 0, got -1")
+
+	err = ValidateColumns([]ColumnConfig{
+		{Name: "In Progress", Query: "status = in_progress", WIPNotify: true},
+	})
+	require.EqualError(t, err, "column 0 (In Progress): wip_notify requires wip_limit")
+
+	err = ValidateColumns([]ColumnConfig{
+		{Name: "Epic", Type: "tree", IssueID: "bd-1", WIPLimit: 2},
+	})
+	require.EqualError(t, err, "column 0 (Epic): wip_limit is only supported for bql columns")
+}
+
 func TestDefaultColumns(t *testing.T) {
 	cols := DefaultColumns()
 	require.Len(t, cols, 4)
@@ -211,6 +235,31 @@ func TestValidateViews_InvalidColumn(t *testing.T) {
 	require.Contains(t, err.Error(), "query is required")
 }
 
+func TestValidateViews_FieldsAndSort(t *testing.T) {
+	view := func(fields []string, sort string) []ViewConfig {
+		return []ViewConfig{{
+			Name:    "Work",
+			Columns: []ColumnConfig{{Name: "Open", Query: "status = open"}},
+			Fields:  fields,
+			Sort:    sort,
+		}}
+	}
+
+	require.NoError(t, ValidateViews(view([]string{"id", "status", "updated"}, "priority asc, updated DESC")))
+	require.NoError(t, ValidateViews(view(nil, "")))
+
+	err := ValidateViews(view([]string{"id", "owner"}, ""))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unknown field "owner"`)
+
+	err = ValidateViews(view(nil, "priority sideways"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `invalid sort term "priority sideways"`)
+
+	err = ValidateViews(view(nil, "priority,"))
+	require.Error(t, err)
+}
+
 func TestConfig_GetColumnsForView(t *testing.T) {
 	cfg := Config{
 		Views: []ViewConfig{
@@ -385,6 +434,142 @@ func TestValidateOrchestration_InvalidClient(t *testing.T) {
 	require.Contains(t, err.Error(), "gemini") // Verify gemini is mentioned as valid option
 }
 
+func TestValidateOrchestration_Autoscale(t *testing.T) {
+	valid := OrchestrationConfig{Autoscale: AutoscaleConfig{Enabled: true, MinWorkers: 1, MaxWorkers: 4, MaxCostUSD: 20}}
+	require.NoError(t, ValidateOrchestration(valid))
+
+	unbounded := OrchestrationConfig{Autoscale: AutoscaleConfig{Enabled: true, MinWorkers: 2}}
+	require.NoError(t, ValidateOrchestration(unbounded))
+
+	tests := []struct {
+		name string
+		as   AutoscaleConfig
+		want string
+	}{
+		{"negative min", AutoscaleConfig{MinWorkers: -1}, "min_workers must not be negative"},
+		{"negative max", AutoscaleConfig{MaxWorkers: -1}, "max_workers must not be negative"},
+		{"min above max", AutoscaleConfig{MinWorkers: 5, MaxWorkers: 2}, "must not exceed max_workers"},
+		{"negative budget", AutoscaleConfig{MaxCostUSD: -1}, "max_cost_usd must not be negative"},
+		{"negative interval", AutoscaleConfig{Interval: -time.Second}, "durations must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Autoscale: tt.as})
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestValidateOrchestration_Budget(t *testing.T) {
+	budget := BudgetConfig{WorkerTokens: 2_000_000, WorkerDuration: 45 * time.Minute, SessionDuration: 4 * time.Hour}
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Budget: budget}))
+
+	err := ValidateOrchestration(OrchestrationConfig{Budget: BudgetConfig{WorkerTokens: -1}})
+	require.ErrorContains(t, err, "token limits must not be negative")
+	err = ValidateOrchestration(OrchestrationConfig{Budget: BudgetConfig{SessionDuration: -time.Minute}})
+	require.ErrorContains(t, err, "durations must not be negative")
+}
+
+func TestValidateOrchestration_FabricStorage(t *testing.T) {
+	for _, storage := range []string{"", "memory", "sqlite"} {
+		require.NoError(t, ValidateOrchestration(OrchestrationConfig{Fabric: FabricConfig{Storage: storage}}), storage)
+	}
+
+	err := ValidateOrchestration(OrchestrationConfig{Fabric: FabricConfig{Storage: "postgres"}})
+	require.ErrorContains(t, err, "orchestration.fabric.storage must be")
+	require.Equal(t, "memory", Defaults().Orchestration.Fabric.Storage)
+}
+
+func TestValidateOrchestration_Mode(t *testing.T) {
+	for _, mode := range []string{"", "coordinator", "solo"} {
+		require.NoError(t, ValidateOrchestration(OrchestrationConfig{Mode: mode}), mode)
+	}
+	require.True(t, OrchestrationConfig{Mode: "solo"}.IsSolo())
+	require.False(t, Defaults().Orchestration.IsSolo())
+	require.Equal(t, DefaultSoloWorkers, SoloConfig{}.WorkerCount())
+	require.Equal(t, 4, SoloConfig{Workers: 4}.WorkerCount())
+
+	err := ValidateOrchestration(OrchestrationConfig{Mode: "swarm"})
+	require.ErrorContains(t, err, "orchestration.mode must be")
+	err = ValidateOrchestration(OrchestrationConfig{Mode: "solo", Solo: SoloConfig{Workers: -1}})
+	require.ErrorContains(t, err, "orchestration.solo.workers must not be negative")
+}
+
+func TestValidateDedup(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Dedup: DedupConfig{Window: 10 * time.Second, Strategy: DedupStrategyNormalized}}))
+	require.NoError(t, ValidateDedup(DedupConfig{}))
+
+	err := ValidateOrchestration(OrchestrationConfig{Dedup: DedupConfig{Strategy: "fuzzy"}})
+	require.EqualError(t, err, `orchestration.dedup.strategy must be "per_recipient", "exact" or "normalized", got "fuzzy"`)
+	err = ValidateDedup(DedupConfig{Window: -time.Second})
+	require.EqualError(t, err, "orchestration.dedup.window must not be negative, got -1s")
+}
+
+func TestAuditConfig(t *testing.T) {
+	require.Equal(t, int64(0), AuditConfig{}.MaxSize())
+	require.Equal(t, int64(25<<20), AuditConfig{MaxSizeMB: 25}.MaxSize())
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Audit: AuditConfig{MaxSizeMB: 25}}))
+
+	err := ValidateOrchestration(OrchestrationConfig{Audit: AuditConfig{MaxSizeMB: -1}})
+	require.EqualError(t, err, "orchestration.audit.max_size_mb must not be negative, got -1")
+}
+
+func TestValidateWebhooks(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Webhooks: WebhooksConfig{
+		Endpoints: []WebhookEndpointConfig{{URL: "https://ci.example.com/perles", Events: []string{"review.denied"}}},
+	}}))
+
+	err := ValidateOrchestration(OrchestrationConfig{Webhooks: WebhooksConfig{
+		Endpoints: []WebhookEndpointConfig{{URL: "https://x", Events: []string{"workflow.started"}}},
+	}})
+	require.ErrorContains(t, err, `orchestration.webhooks: webhook endpoint 0: unknown event "workflow.started"`)
+}
+
+func TestValidateRateLimits(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{RateLimits: RateLimitsConfig{Process: RateLimitConfig{Calls: 60}}}))
+	err := ValidateOrchestration(OrchestrationConfig{RateLimits: RateLimitsConfig{Tools: map[string]RateLimitConfig{"fabric_send": {Calls: -1}}}})
+	require.EqualError(t, err, "orchestration.rate_limits.tools.fabric_send.calls must not be negative, got -1")
+	err = ValidateRateLimits(RateLimitsConfig{Process: RateLimitConfig{Calls: 1, Per: -time.Second}})
+	require.EqualError(t, err, "orchestration.rate_limits.process.per must not be negative, got -1s")
+}
+
+func TestValidatePhaseTimeouts(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{PhaseTimeouts: PhaseTimeoutsConfig{Phases: map[string]time.Duration{"blocked": time.Hour}}}))
+	err := ValidateOrchestration(OrchestrationConfig{PhaseTimeouts: PhaseTimeoutsConfig{Phases: map[string]time.Duration{"testing": time.Hour}}})
+	require.EqualError(t, err, `orchestration.phase_timeouts.phases: unknown phase "testing"`)
+	err = ValidatePhaseTimeouts(PhaseTimeoutsConfig{Workers: map[string]map[string]time.Duration{"worker-2": {"reviewing": -time.Minute}}})
+	require.EqualError(t, err, "orchestration.phase_timeouts.workers.worker-2.reviewing must not be negative, got -1m0s")
+}
+
+func TestValidateTurnPolicy(t *testing.T) {
+	err := ValidateOrchestration(OrchestrationConfig{TurnPolicy: TurnPolicyConfig{Escalation: []string{"nudge", "kill"}}})
+	require.EqualError(t, err, `orchestration.turn_policy: unknown escalation action "kill" (want nudge, warn or replace)`)
+	err = ValidateTurnPolicy(TurnPolicyConfig{Phases: map[string]TurnPolicyPhaseConfig{"reviewing": {Timeout: -time.Minute}}})
+	require.EqualError(t, err, "orchestration.turn_policy: phase reviewing timeout must not be negative, got -1m0s")
+}
+
+func TestValidateOrchestration_Redaction(t *testing.T) {
+	valid := RedactionConfig{Rules: []RedactionRuleConfig{{Name: "ticket", Pattern: `CORP-\d+`}}}
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{Redaction: valid}))
+
+	tests := []struct {
+		name string
+		r    RedactionConfig
+		want string
+	}{
+		{"missing name", RedactionConfig{Rules: []RedactionRuleConfig{{Pattern: "x"}}}, "rules[0]: name is required"},
+		{"missing pattern", RedactionConfig{Rules: []RedactionRuleConfig{{Name: "x"}}}, "rules[0] (x): pattern is required"},
+		{"bad pattern", RedactionConfig{Rules: []RedactionRuleConfig{{Name: "x", Pattern: "("}}}, "rules[0] (x): invalid pattern"},
+		{"negative min length", RedactionConfig{EntropyMinLength: -1}, "entropy_min_length must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrchestration(OrchestrationConfig{Redaction: tt.r})
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
 func TestValidateOrchestration_ValidGemini(t *testing.T) {
 	cfg := OrchestrationConfig{
 		Client: "gemini",
@@ -505,6 +690,15 @@ func TestValidateOrchestration_InvalidObserverClient(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid")
 }
 
+func TestValidateOrchestration_WorkerBackends(t *testing.T) {
+	require.NoError(t, ValidateOrchestration(OrchestrationConfig{WorkerBackends: []string{"codex", "gemini"}}))
+
+	err := ValidateOrchestration(OrchestrationConfig{WorkerBackends: []string{"codex", "invalid"}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "orchestration.worker_backends must only contain")
+	require.Contains(t, err.Error(), "invalid")
+}
+
 func TestValidateOrchestration_MixedClientConfigs(t *testing.T) {
 	cfg := OrchestrationConfig{
 		Client:            "claude",
@@ -934,6 +1128,21 @@ func TestValidateTracing_NoneExporter_NoPathRequired(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestValidateTracing_MetricsRequireEndpoint(t *testing.T) {
+	err := ValidateTracing(TracingConfig{Metrics: true, SampleRate: 1.0})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "otlp_endpoint is required when metrics are enabled")
+
+	err = ValidateTracing(TracingConfig{Metrics: true, OTLPEndpoint: "localhost:4317", SampleRate: 1.0})
+	require.NoError(t, err)
+}
+
+func TestValidateTracing_NegativeMetricsInterval(t *testing.T) {
+	err := ValidateTracing(TracingConfig{MetricsInterval: -time.Second, SampleRate: 1.0})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "metrics_interval must not be negative")
+}
+
 func TestValidateOrchestration_WithValidTracing(t *testing.T) {
 	cfg := OrchestrationConfig{
 		Client: "claude",
@@ -1428,7 +1637,7 @@ func TestDefaults_SoundEventConfigValues(t *testing.T) {
 	cfg := Defaults()
 
 	// All events should exist in the map
-	require.Len(t, cfg.Sound.Events, 6)
+	require.Len(t, cfg.Sound.Events, 7)
 
 	// Check each event has correct default values
 	for _, eventName := range []string{"review_verdict_approve", "review_verdict_deny", "workflow_complete", "worker_out_of_context", "coordinator_out_of_context", "user_notification"} {
@@ -1440,11 +1649,11 @@ func TestDefaults_SoundEventConfigValues(t *testing.T) {
 }
 
 func TestDefaults_SoundEventsEnabled(t *testing.T) {
-	// Verify all 8 sound events are present and enabled by default
+	// Verify all 7 sound events are present and enabled by default
 	cfg := Defaults()
 
-	// Must have exactly 8 sound events
-	require.Len(t, cfg.Sound.Events, 6, "Defaults should have exactly 6 sound events")
+	// Must have exactly 7 sound events
+	require.Len(t, cfg.Sound.Events, 7, "Defaults should have exactly 7 sound events")
 
 	// All expected events must be present and enabled
 	expectedEvents := []string{
@@ -1454,6 +1663,7 @@ func TestDefaults_SoundEventsEnabled(t *testing.T) {
 		"worker_out_of_context",
 		"coordinator_out_of_context",
 		"user_notification",
+		"fabric_user_mention",
 	}
 
 	for _, eventName := range expectedEvents {
@@ -1471,7 +1681,7 @@ func TestDefaultConfigTemplate_SoundsEnabled(t *testing.T) {
 	require.Contains(t, template, "All events are enabled by default",
 		"Template should say events are enabled by default")
 
-	// All 6 expected events must be present with enabled: true
+	// All 7 expected events must be present with enabled: true
 	expectedEvents := []string{
 		"review_verdict_approve",
 		"review_verdict_deny",
@@ -1479,6 +1689,7 @@ func TestDefaultConfigTemplate_SoundsEnabled(t *testing.T) {
 		"worker_out_of_context",
 		"coordinator_out_of_context",
 		"user_notification",
+		"fabric_user_mention",
 	}
 
 	for _, eventName := range expectedEvents {
@@ -1954,6 +2165,22 @@ func TestAgentProviders_FallbackToClient(t *testing.T) {
 	require.Equal(t, "gemini-2.5-flash", providers[client.RoleWorker].Extensions()["gemini.model"])
 }
 
+func TestAgentProviders_WorkerBackends(t *testing.T) {
+	cfg := OrchestrationConfig{
+		WorkerClient:   "claude",
+		WorkerBackends: []string{"codex", "claude"},
+		Codex:          CodexClientConfig{Model: "gpt-5.2-codex"},
+	}
+	providers := cfg.AgentProviders()
+
+	codex, ok := providers.WorkerBackend(client.ClientCodex)
+	require.True(t, ok)
+	require.Equal(t, client.ClientCodex, codex.Type())
+	require.Equal(t, "gpt-5.2-codex", codex.Extensions()["codex.model"])
+	require.NotContains(t, providers, client.WorkerBackendRole(client.ClientClaude), "default worker client is not duplicated")
+	require.Equal(t, []client.ClientType{client.ClientClaude, client.ClientCodex}, providers.WorkerBackends())
+}
+
 // ============================================================================
 // extensionsForClient Tests
 // ============================================================================
@@ -2412,6 +2639,35 @@ func TestValidateKeybindings_DuplicateKeys(t *testing.T) {
 	}
 }
 
+func TestValidateKeybindings_Vim(t *testing.T) {
+	valid := KeybindingsConfig{Vim: VimKeymapConfig{
+		TimeoutMs:        300,
+		PendingTimeoutMs: 2000,
+		Mappings: []VimMapping{
+			{Mode: "insert", From: "jk", To: "<esc>"},
+			{Mode: "visual", From: "H", To: "0"},
+		},
+	}}
+	require.NoError(t, ValidateKeybindings(valid))
+
+	tests := []struct {
+		name string
+		vim  VimKeymapConfig
+		err  string
+	}{
+		{"negative timeout", VimKeymapConfig{TimeoutMs: -1}, "ui.keybindings.vim.timeout_ms must be >= 0, got -1"},
+		{"negative pending timeout", VimKeymapConfig{PendingTimeoutMs: -5}, "ui.keybindings.vim.pending_timeout_ms must be >= 0, got -5"},
+		{"bad mode", VimKeymapConfig{Mappings: []VimMapping{{Mode: "command", From: "a", To: "b"}}}, `ui.keybindings.vim.mappings[0]: mode must be normal, insert, or visual, got "command"`},
+		{"missing from", VimKeymapConfig{Mappings: []VimMapping{{Mode: "normal", To: "b"}}}, "ui.keybindings.vim.mappings[0]: from is required"},
+		{"missing to", VimKeymapConfig{Mappings: []VimMapping{{Mode: "normal", From: "a"}}}, "ui.keybindings.vim.mappings[0]: to is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.EqualError(t, ValidateKeybindings(KeybindingsConfig{Vim: tt.vim}), tt.err)
+		})
+	}
+}
+
 func TestValidateKeybindings_SwappedDefaults(t *testing.T) {
 	// Swapping defaults should be allowed: search: "ctrl+o", dashboard: "ctrl+space"
 	err := ValidateKeybindings(KeybindingsConfig{
@@ -2560,6 +2816,65 @@ func TestDefaultDatabasePath(t *testing.T) {
 
 // ValidateActions Tests
 
+func TestValidateCustomFields(t *testing.T) {
+	fields := []CustomFieldConfig{
+		{Key: "component", Label: "Component", Type: "enum", Options: []string{"api", "ui"}},
+		{Key: "spec", Type: "url"},
+	}
+	require.NoError(t, ValidateCustomFields(fields))
+	require.NoError(t, ValidateCustomFields(nil))
+
+	err := ValidateCustomFields([]CustomFieldConfig{{Key: "component", Type: "enum"}})
+	require.ErrorContains(t, err, "custom_fields: field \"component\": enum fields require options")
+
+	defs := Config{CustomFields: fields}.FieldDefs()
+	require.Len(t, defs, 2)
+	require.Equal(t, "Component", defs[0].DisplayLabel())
+	require.Equal(t, []string{"api", "ui"}, defs[0].Options)
+	require.Nil(t, Config{}.FieldDefs())
+}
+
+func TestValidateGitHub(t *testing.T) {
+	valid := GitHubConfig{
+		Repo:       "acme/app",
+		Token:      "secret",
+		Priorities: map[string]int{"critical": 0},
+		Types:      map[string]string{"enhancement": "feature"},
+	}
+	require.NoError(t, ValidateGitHub(valid))
+
+	for _, repo := range []string{"", "acme", "acme/", "/app", "acme/app/extra"} {
+		gh := valid
+		gh.Repo = repo
+		require.ErrorContains(t, ValidateGitHub(gh), "github.repo must be owner/name", repo)
+	}
+
+	gh := valid
+	gh.Token = ""
+	require.ErrorContains(t, ValidateGitHub(gh), "github.token is required")
+
+	gh = valid
+	gh.Priorities = map[string]int{"critical": 5}
+	require.EqualError(t, ValidateGitHub(gh), `github.priorities: "critical" maps to 5, want 0-4`)
+
+	gh = valid
+	gh.Types = map[string]string{"question": "story"}
+	require.EqualError(t, ValidateGitHub(gh), `github.types: "question" maps to unknown type "story"`)
+}
+
+func TestValidateJira(t *testing.T) {
+	require.NoError(t, ValidateJira(JiraConfig{}))
+	require.NoError(t, ValidateJira(JiraConfig{Types: map[string]string{"Spike": "chore"}, Priorities: map[string]int{"P1": 0}}))
+	require.EqualError(t, ValidateJira(JiraConfig{Priorities: map[string]int{"P0": -1}}), `jira.priorities: "P0" maps to -1, want 0-4`)
+	require.EqualError(t, ValidateJira(JiraConfig{Types: map[string]string{"Spike": "story"}}), `jira.types: "Spike" maps to unknown type "story"`)
+
+	require.NoError(t, ValidateJiraAPI(JiraConfig{URL: "https://acme.atlassian.net", Token: "secret"}))
+	for _, u := range []string{"", "acme.atlassian.net", "ftp://acme", "https://"} {
+		require.ErrorContains(t, ValidateJiraAPI(JiraConfig{URL: u, Token: "secret"}), "jira.url must be", u)
+	}
+	require.ErrorContains(t, ValidateJiraAPI(JiraConfig{URL: "https://acme.atlassian.net"}), "jira.token is required")
+}
+
 func TestValidateActions_OnlyAllows0Through9(t *testing.T) {
 	// Keys 0-9 should be accepted
 	validKeys := []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
@@ -2839,3 +3154,25 @@ func TestExtensionsForObserver_Unknown(t *testing.T) {
 	ext := cfg.extensionsForObserver(client.ClientType("unknown"))
 	require.Empty(t, ext, "unknown client should return empty extensions")
 }
+
+func TestDefaults_NotificationsOptIn(t *testing.T) {
+	cfg := Defaults()
+
+	for _, eventName := range []string{"user_notification", "fabric_user_mention", "scheduled_run"} {
+		eventConfig, exists := cfg.Notifications.Events[eventName]
+		require.True(t, exists, "Event %q should exist in defaults", eventName)
+		require.False(t, eventConfig.Enabled, "Desktop notifications should be opt-in")
+	}
+}
+
+func TestDefaultConfigTemplate_NotificationsTopLevel(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(DefaultConfigTemplate())))
+
+	var cfg Config
+	require.NoError(t, v.Unmarshal(&cfg))
+	require.Contains(t, cfg.Notifications.Events, "user_notification")
+	require.Contains(t, cfg.Notifications.Events, "fabric_user_mention")
+	require.Contains(t, cfg.Notifications.Events, "scheduled_run")
+}
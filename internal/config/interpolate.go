@@ -0,0 +1,242 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Secret reference prefixes recognized in config values.
+// A value that starts with one of these prefixes is replaced in full by the
+// secret it references.
+const (
+	// SecretPrefixOnePassword references a 1Password secret (e.g., "op://vault/item/field").
+	// Resolved with `op read <ref>`.
+	SecretPrefixOnePassword = "op://"
+	// SecretPrefixKeychain references a macOS keychain item (e.g., "keychain:service" or
+	// "keychain:service/account"). Resolved with `security find-generic-password -w`.
+	SecretPrefixKeychain = "keychain:"
+)
+
+// rawConfigKeys lists config subtrees that are never interpolated.
+// User actions are shell commands that expand their own variables when run.
+var rawConfigKeys = []string{"ui.actions"}
+
+// envRefPattern matches ${VAR} and ${VAR:-default} references, plus the $${ escape.
+var envRefPattern = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// CommandRunner runs an external command and returns its stdout.
+type CommandRunner func(name string, args ...string) (string, error)
+
+// Resolver expands environment variable references and secret-manager
+// references inside config values at load time.
+//
+// Supported syntax:
+//   - ${VAR} expands to the value of VAR; an unset variable is an error.
+//   - ${VAR:-default} expands to VAR, or default when VAR is unset or empty.
+//   - $${ produces a literal "${".
+//   - op://vault/item/field and keychain:service[/account] replace the whole value with the secret.
+type Resolver struct {
+	lookupEnv  func(string) (string, bool)
+	runCommand CommandRunner
+}
+
+// ResolverOption configures a Resolver.
+type ResolverOption func(*Resolver)
+
+// WithLookupEnv overrides the environment lookup function (defaults to os.LookupEnv).
+func WithLookupEnv(fn func(string) (string, bool)) ResolverOption {
+	return func(r *Resolver) {
+		r.lookupEnv = fn
+	}
+}
+
+// WithCommandRunner overrides how secret-manager CLIs are executed.
+func WithCommandRunner(fn CommandRunner) ResolverOption {
+	return func(r *Resolver) {
+		r.runCommand = fn
+	}
+}
+
+// NewResolver creates a Resolver that reads the process environment and
+// shells out to the secret-manager CLIs.
+func NewResolver(opts ...ResolverOption) *Resolver {
+	r := &Resolver{
+		lookupEnv:  os.LookupEnv,
+		runCommand: runSecretCommand,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ResolveSettings returns a copy of settings with every string value resolved.
+// Keys are expected in the lower-case dot-path form produced by viper.AllSettings.
+// Errors name the offending key so users can find it in their config file.
+func (r *Resolver) ResolveSettings(settings map[string]any) (map[string]any, error) {
+	return r.resolveMap("", settings)
+}
+
+// ResolveValue resolves a single config value.
+func (r *Resolver) ResolveValue(value string) (string, error) {
+	if ref, ok := strings.CutPrefix(value, SecretPrefixOnePassword); ok {
+		return r.resolveOnePassword(SecretPrefixOnePassword + ref)
+	}
+	if ref, ok := strings.CutPrefix(value, SecretPrefixKeychain); ok {
+		return r.resolveKeychain(ref)
+	}
+	return r.expandEnv(value)
+}
+
+func (r *Resolver) resolveMap(path string, m map[string]any) (map[string]any, error) {
+	// Sort keys so the first reported error is deterministic.
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make(map[string]any, len(m))
+	for _, k := range keys {
+		v, err := r.resolveAny(joinKey(path, k), m[k])
+		if err != nil {
+			return nil, err
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (r *Resolver) resolveAny(path string, v any) (any, error) {
+	for _, raw := range rawConfigKeys {
+		if path == raw {
+			return v, nil
+		}
+	}
+
+	switch val := v.(type) {
+	case string:
+		resolved, err := r.ResolveValue(val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return resolved, nil
+	case map[string]any:
+		return r.resolveMap(path, val)
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			resolved, err := r.resolveAny(fmt.Sprintf("%s[%d]", path, i), item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	case []string:
+		out := make([]string, len(val))
+		for i, item := range val {
+			resolved, err := r.ResolveValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", path, i, err)
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	default:
+		return v, nil
+	}
+}
+
+// expandEnv replaces ${VAR} references, collecting every missing variable
+// into a single error.
+func (r *Resolver) expandEnv(value string) (string, error) {
+	if !strings.Contains(value, "${") {
+		return value, nil
+	}
+
+	var missing []string
+	result := envRefPattern.ReplaceAllStringFunc(value, func(match string) string {
+		if match == "$${" {
+			return "${"
+		}
+		sub := envRefPattern.FindStringSubmatch(match)
+		name, hasDefault, def := sub[1], sub[2] != "", sub[3]
+		if val, ok := r.lookupEnv(name); ok && (val != "" || !hasDefault) {
+			return val
+		}
+		if hasDefault {
+			return def
+		}
+		missing = append(missing, name)
+		return match
+	})
+
+	switch len(missing) {
+	case 0:
+		return result, nil
+	case 1:
+		return "", fmt.Errorf("environment variable %s is not set", missing[0])
+	default:
+		return "", fmt.Errorf("environment variables %s are not set", strings.Join(missing, ", "))
+	}
+}
+
+func (r *Resolver) resolveOnePassword(ref string) (string, error) {
+	secret, err := r.runCommand("op", "read", ref)
+	if err != nil {
+		return "", fmt.Errorf("resolving 1Password reference %q: %w", ref, err)
+	}
+	return secret, nil
+}
+
+func (r *Resolver) resolveKeychain(ref string) (string, error) {
+	service, account, _ := strings.Cut(ref, "/")
+	if service == "" {
+		return "", fmt.Errorf("keychain reference must be keychain:service[/account], got %q", SecretPrefixKeychain+ref)
+	}
+
+	args := []string{"find-generic-password", "-s", service}
+	if account != "" {
+		args = append(args, "-a", account)
+	}
+	args = append(args, "-w")
+
+	secret, err := r.runCommand("security", args...)
+	if err != nil {
+		return "", fmt.Errorf("resolving keychain reference %q: %w", SecretPrefixKeychain+ref, err)
+	}
+	return secret, nil
+}
+
+// runSecretCommand executes a secret-manager CLI and returns its trimmed stdout.
+// Stderr is included in the error so users can see why the lookup failed.
+func runSecretCommand(name string, args ...string) (string, error) {
+	if _, err := exec.LookPath(name); err != nil {
+		return "", fmt.Errorf("%s CLI not found in PATH", name)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...) //nolint:gosec // G204: secret-manager CLI with user-configured reference
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s: %s", name, msg)
+		}
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
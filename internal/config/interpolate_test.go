@@ -0,0 +1,178 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakeEnv(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}
+}
+
+func TestResolver_ResolveValue_EnvInterpolation(t *testing.T) {
+	r := NewResolver(WithLookupEnv(fakeEnv(map[string]string{
+		"TOKEN": "abc123",
+		"HOST":  "example.com",
+		"EMPTY": "",
+	})))
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain value untouched", "status = open", "status = open"},
+		{"single var", "${TOKEN}", "abc123"},
+		{"embedded vars", "https://${HOST}/api?t=${TOKEN}", "https://example.com/api?t=abc123"},
+		{"default when unset", "${MISSING:-fallback}", "fallback"},
+		{"default when empty", "${EMPTY:-fallback}", "fallback"},
+		{"set var ignores default", "${TOKEN:-fallback}", "abc123"},
+		{"empty default", "${MISSING:-}", ""},
+		{"set but empty without default", "${EMPTY}", ""},
+		{"escaped reference", "$${TOKEN}", "${TOKEN}"},
+		{"bare dollar untouched", "$TOKEN", "$TOKEN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.ResolveValue(tt.input)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolver_ResolveValue_MissingEnvVar(t *testing.T) {
+	r := NewResolver(WithLookupEnv(fakeEnv(nil)))
+
+	_, err := r.ResolveValue("${API_TOKEN}")
+	require.EqualError(t, err, "environment variable API_TOKEN is not set")
+
+	_, err = r.ResolveValue("${USER_NAME}:${API_TOKEN}")
+	require.EqualError(t, err, "environment variables USER_NAME, API_TOKEN are not set")
+}
+
+func TestResolver_ResolveValue_OnePassword(t *testing.T) {
+	var gotName string
+	var gotArgs []string
+	r := NewResolver(WithCommandRunner(func(name string, args ...string) (string, error) {
+		gotName, gotArgs = name, args
+		return "s3cret", nil
+	}))
+
+	got, err := r.ResolveValue("op://Private/GitHub/token")
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", got)
+	require.Equal(t, "op", gotName)
+	require.Equal(t, []string{"read", "op://Private/GitHub/token"}, gotArgs)
+}
+
+func TestResolver_ResolveValue_Keychain(t *testing.T) {
+	var calls [][]string
+	r := NewResolver(WithCommandRunner(func(name string, args ...string) (string, error) {
+		calls = append(calls, append([]string{name}, args...))
+		return "s3cret", nil
+	}))
+
+	got, err := r.ResolveValue("keychain:perles")
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", got)
+
+	_, err = r.ResolveValue("keychain:perles/alice")
+	require.NoError(t, err)
+
+	require.Equal(t, [][]string{
+		{"security", "find-generic-password", "-s", "perles", "-w"},
+		{"security", "find-generic-password", "-s", "perles", "-a", "alice", "-w"},
+	}, calls)
+
+	_, err = r.ResolveValue("keychain:")
+	require.ErrorContains(t, err, "keychain reference must be keychain:service[/account]")
+}
+
+func TestResolver_ResolveValue_SecretCommandError(t *testing.T) {
+	r := NewResolver(WithCommandRunner(func(string, ...string) (string, error) {
+		return "", errors.New("op: not signed in")
+	}))
+
+	_, err := r.ResolveValue("op://Private/GitHub/token")
+	require.EqualError(t, err, `resolving 1Password reference "op://Private/GitHub/token": op: not signed in`)
+}
+
+func TestResolver_ResolveSettings(t *testing.T) {
+	r := NewResolver(
+		WithLookupEnv(fakeEnv(map[string]string{"MODEL": "opus", "BEADS": "/tmp/beads"})),
+		WithCommandRunner(func(string, ...string) (string, error) { return "tok", nil }),
+	)
+
+	settings := map[string]any{
+		"beads_dir":    "${BEADS}",
+		"auto_refresh": true,
+		"orchestration": map[string]any{
+			"claude": map[string]any{
+				"model": "${MODEL}",
+				"env":   map[string]any{"api_key": "op://vault/anthropic/key"},
+			},
+			"api_port": 8080,
+		},
+		"views": []any{
+			map[string]any{"name": "${MODEL} board"},
+		},
+		"sound": map[string]any{"paths": []string{"${BEADS}/a.wav"}},
+		"ui": map[string]any{
+			"actions": map[string]any{
+				"issue_action": map[string]any{
+					"open": map[string]any{"command": "echo ${ISSUE_ID}"},
+				},
+			},
+		},
+	}
+
+	got, err := r.ResolveSettings(settings)
+	require.NoError(t, err)
+
+	require.Equal(t, "/tmp/beads", got["beads_dir"])
+	require.Equal(t, true, got["auto_refresh"])
+	orch := got["orchestration"].(map[string]any)
+	claude := orch["claude"].(map[string]any)
+	require.Equal(t, "opus", claude["model"])
+	require.Equal(t, "tok", claude["env"].(map[string]any)["api_key"])
+	require.Equal(t, 8080, orch["api_port"])
+	require.Equal(t, "opus board", got["views"].([]any)[0].(map[string]any)["name"])
+	require.Equal(t, []string{"/tmp/beads/a.wav"}, got["sound"].(map[string]any)["paths"])
+
+	// User actions are shell commands and must be passed through verbatim.
+	require.Equal(t, settings["ui"], got["ui"])
+
+	// Input is not mutated.
+	require.Equal(t, "${BEADS}", settings["beads_dir"])
+}
+
+func TestResolver_ResolveSettings_ErrorNamesKey(t *testing.T) {
+	r := NewResolver(WithLookupEnv(fakeEnv(nil)))
+
+	_, err := r.ResolveSettings(map[string]any{
+		"orchestration": map[string]any{
+			"claude": map[string]any{"model": "${CLAUDE_MODEL}"},
+		},
+		"views": []any{
+			map[string]any{"name": "ok"},
+		},
+	})
+	require.EqualError(t, err, "orchestration.claude.model: environment variable CLAUDE_MODEL is not set")
+
+	_, err = r.ResolveSettings(map[string]any{
+		"views": []any{
+			map[string]any{"name": "ok"},
+			map[string]any{"name": "${VIEW_NAME}"},
+		},
+	})
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "views[1].name: "), err.Error())
+}
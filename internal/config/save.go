@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/zjrosen/perles/internal/log"
 
@@ -17,66 +18,114 @@ import (
 func SaveViews(configPath string, views []ViewConfig) error {
 	log.Debug(log.CatConfig, "Saving views", "path", configPath, "viewCount", len(views))
 
-	// Read existing file content
+	doc, err := readConfigDoc(configPath)
+	if err != nil {
+		return err
+	}
+
+	// Update or create the views section
+	if root := rootMapping(&doc); root != nil {
+		setMappingValue(root, "views", buildViewsNode(views))
+	}
+
+	return writeConfigDoc(configPath, &doc)
+}
+
+// SaveThemePreset sets theme.preset in the config file, keeping color
+// overrides, user themes, and all other sections intact.
+func SaveThemePreset(configPath, preset string) error {
+	log.Debug(log.CatConfig, "Saving theme preset", "path", configPath, "preset", preset)
+
+	doc, err := readConfigDoc(configPath)
+	if err != nil {
+		return err
+	}
+
+	if root := rootMapping(&doc); root != nil {
+		theme := mappingValue(root, "theme")
+		if theme == nil || theme.Kind != yaml.MappingNode {
+			// Missing or empty section (e.g. "theme:" followed only by comments)
+			replacement := &yaml.Node{Kind: yaml.MappingNode}
+			if theme != nil {
+				replacement.HeadComment = theme.HeadComment
+				replacement.LineComment = theme.LineComment
+				replacement.FootComment = theme.FootComment
+			}
+			theme = replacement
+			setMappingValue(root, "theme", theme)
+		}
+		setMappingValue(theme, "preset", &yaml.Node{Kind: yaml.ScalarNode, Value: preset})
+	}
+
+	return writeConfigDoc(configPath, &doc)
+}
+
+// readConfigDoc parses the config file into a yaml.Node to preserve comments.
+// A missing file yields an empty document.
+func readConfigDoc(configPath string) (yaml.Node, error) {
+	var doc yaml.Node
+
 	data, err := os.ReadFile(configPath) //nolint:gosec // G304: configPath is from user's config dir, not user input
 	if err != nil && !os.IsNotExist(err) {
 		log.ErrorErr(log.CatConfig, "Failed to read config file", err, "path", configPath)
-		return fmt.Errorf("reading config: %w", err)
+		return doc, fmt.Errorf("reading config: %w", err)
 	}
 
-	// Parse into yaml.Node to preserve comments
-	var doc yaml.Node
 	if len(data) > 0 {
 		if err := yaml.Unmarshal(data, &doc); err != nil {
 			log.ErrorErr(log.CatConfig, "Failed to parse config", err, "path", configPath)
-			return fmt.Errorf("parsing config: %w", err)
+			return doc, fmt.Errorf("parsing config: %w", err)
 		}
 	}
+	return doc, nil
+}
 
-	// Build the new views node
-	viewsNode := buildViewsNode(views)
-
-	// Update or create the views section
+// rootMapping returns the top-level mapping of doc, creating the document
+// structure for an empty or new file. Returns nil if the root is not a mapping.
+func rootMapping(doc *yaml.Node) *yaml.Node {
 	if doc.Kind == 0 {
-		// Empty or new file - create document structure
-		doc = yaml.Node{
-			Kind: yaml.DocumentNode,
-			Content: []*yaml.Node{
-				{
-					Kind: yaml.MappingNode,
-					Content: []*yaml.Node{
-						{Kind: yaml.ScalarNode, Value: "views"},
-						viewsNode,
-					},
-				},
-			},
+		*doc = yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode}},
 		}
-	} else if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
-		root := doc.Content[0]
-		if root.Kind == yaml.MappingNode {
-			// Find and replace views key, or append it
-			found := false
-			for i := 0; i < len(root.Content)-1; i += 2 {
-				if root.Content[i].Value == "views" {
-					root.Content[i+1] = viewsNode
-					found = true
-					break
-				}
-			}
-			if !found {
-				root.Content = append(root.Content,
-					&yaml.Node{Kind: yaml.ScalarNode, Value: "views"},
-					viewsNode,
-				)
-			}
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	return doc.Content[0]
+}
+
+// mappingValue returns the value node for key in a mapping node, or nil.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i < len(mapping.Content)-1; i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue replaces the value for key in a mapping node, or appends it.
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i < len(mapping.Content)-1; i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
 		}
 	}
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+		value,
+	)
+}
 
+// writeConfigDoc marshals doc and writes it atomically (write to temp, then rename).
+func writeConfigDoc(configPath string, doc *yaml.Node) error {
 	// Marshal back to YAML
 	var buf bytes.Buffer
 	encoder := yaml.NewEncoder(&buf)
 	encoder.SetIndent(2)
-	if err := encoder.Encode(&doc); err != nil {
+	if err := encoder.Encode(doc); err != nil {
 		log.ErrorErr(log.CatConfig, "Failed to marshal config", err)
 		return fmt.Errorf("marshaling config: %w", err)
 	}
@@ -177,6 +226,24 @@ func buildViewsNode(views []ViewConfig) *yaml.Node {
 			columnsNode,
 		)
 
+		if len(view.Fields) > 0 {
+			fieldsNode := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+			for _, field := range view.Fields {
+				fieldsNode.Content = append(fieldsNode.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: field})
+			}
+			viewNode.Content = append(viewNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "fields"},
+				fieldsNode,
+			)
+		}
+
+		if view.Sort != "" {
+			viewNode.Content = append(viewNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "sort"},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: view.Sort},
+			)
+		}
+
 		node.Content = append(node.Content, viewNode)
 	}
 
@@ -241,6 +308,27 @@ func buildColumnsNode(columns []ColumnConfig) *yaml.Node {
 			)
 		}
 
+		if col.WIPLimit > 0 {
+			colNode.Content = append(colNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "wip_limit"},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(col.WIPLimit)},
+			)
+		}
+
+		if col.WIPNotify {
+			colNode.Content = append(colNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "wip_notify"},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"},
+			)
+		}
+
+		if col.Collapsed {
+			colNode.Content = append(colNode.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "collapsed"},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"},
+			)
+		}
+
 		node.Content = append(node.Content, colNode)
 	}
 
@@ -335,6 +423,18 @@ func RenameView(configPath string, viewIndex int, newName string, allViews []Vie
 	return SaveViews(configPath, allViews)
 }
 
+// SetViewDisplay sets which issue fields a view shows and how it sorts its columns.
+func SetViewDisplay(configPath string, viewIndex int, fields []string, sort string, allViews []ViewConfig) error {
+	if viewIndex < 0 || viewIndex >= len(allViews) {
+		return fmt.Errorf("view index %d out of range (have %d views)", viewIndex, len(allViews))
+	}
+
+	allViews[viewIndex].Fields = fields
+	allViews[viewIndex].Sort = sort
+
+	return SaveViews(configPath, allViews)
+}
+
 // InsertColumnInView inserts a new column at the specified position within a specific view.
 // Position 0 inserts at the beginning of the column list.
 func InsertColumnInView(configPath string, viewIndex, position int, newCol ColumnConfig, allViews []ViewConfig) error {
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -832,6 +833,37 @@ func TestRenameView(t *testing.T) {
 	require.Equal(t, "Critical Bugs", loaded[1].Name)
 }
 
+func TestSetViewDisplay(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, ".perles.yaml")
+
+	views := []ViewConfig{
+		{Name: "Default", Columns: []ColumnConfig{{Name: "Open", Query: "status = open"}}},
+		{Name: "Bugs", Columns: []ColumnConfig{{Name: "All Bugs", Query: "type = bug"}}},
+	}
+
+	err := SetViewDisplay(configPath, 1, []string{"id", "status", "updated"}, "priority asc, updated desc", views)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "fields: [id, status, updated]")
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	var loaded []ViewConfig
+	require.NoError(t, v.UnmarshalKey("views", &loaded))
+	require.Len(t, loaded, 2)
+	require.Empty(t, loaded[0].Fields)
+	require.Empty(t, loaded[0].Sort)
+	require.Equal(t, []string{"id", "status", "updated"}, loaded[1].Fields)
+	require.Equal(t, "priority asc, updated desc", loaded[1].Sort)
+
+	require.Error(t, SetViewDisplay(configPath, 2, nil, "", views))
+}
+
 func TestRenameView_FirstView(t *testing.T) {
 	tempDir := t.TempDir()
 	configPath := filepath.Join(tempDir, ".perles.yaml")
@@ -1032,3 +1064,128 @@ func TestSaveColumns_BQLColumnBackwardCompatibility(t *testing.T) {
 	// Should NOT have type field for BQL columns
 	require.NotContains(t, content, "type:")
 }
+
+func TestSaveColumns_WIPLimitRoundtrip(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, ".perles.yaml")
+
+	columns := []ColumnConfig{
+		{Name: "In Progress", Query: "status = in_progress", WIPLimit: 3, WIPNotify: true},
+		{Name: "Ready", Query: "ready = true"},
+	}
+
+	err := SaveColumns(configPath, columns)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	content := string(data)
+	require.Contains(t, content, "wip_limit: 3")
+	require.Contains(t, content, "wip_notify: true")
+	require.Equal(t, 1, strings.Count(content, "wip_limit"), "unlimited columns should omit wip_limit")
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	var loaded []ViewConfig
+	require.NoError(t, v.UnmarshalKey("views", &loaded))
+	require.Len(t, loaded, 1)
+	require.Equal(t, 3, loaded[0].Columns[0].WIPLimit)
+	require.True(t, loaded[0].Columns[0].WIPNotify)
+	require.Zero(t, loaded[0].Columns[1].WIPLimit)
+	require.False(t, loaded[0].Columns[1].WIPNotify)
+}
+
+func TestSaveColumns_CollapsedRoundtrip(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, ".perles.yaml")
+
+	columns := []ColumnConfig{
+		{Name: "Closed", Query: "status = closed", Collapsed: true},
+		{Name: "Ready", Query: "ready = true"},
+	}
+
+	err := SaveColumns(configPath, columns)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(data), "collapsed: true"), "expanded columns should omit collapsed")
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	var loaded []ViewConfig
+	require.NoError(t, v.UnmarshalKey("views", &loaded))
+	require.Len(t, loaded, 1)
+	require.True(t, loaded[0].Columns[0].Collapsed)
+	require.False(t, loaded[0].Columns[1].Collapsed)
+}
+
+func TestSaveThemePreset_CreatesNewFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".perles.yaml")
+
+	require.NoError(t, SaveThemePreset(configPath, "nord"))
+
+	cfg := loadConfigFromYAMLFile(t, configPath)
+	require.Equal(t, "nord", cfg.Theme.Preset)
+}
+
+func TestSaveThemePreset_PreservesThemeSection(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".perles.yaml")
+	initial := `auto_refresh: true
+# Theme configuration
+theme:
+  preset: dracula
+  colors:
+    status.error: "#FF0000"
+  themes:
+    - name: ocean
+      base: light
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(initial), 0644))
+
+	require.NoError(t, SaveThemePreset(configPath, "ocean"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "# Theme configuration")
+
+	cfg := loadConfigFromYAMLFile(t, configPath)
+	require.True(t, cfg.AutoRefresh)
+	require.Equal(t, "ocean", cfg.Theme.Preset)
+	require.Equal(t, map[string]string{"status.error": "#FF0000"}, cfg.Theme.FlattenedColors())
+	require.Len(t, cfg.Theme.Themes, 1)
+	require.Equal(t, "light", cfg.Theme.Themes[0].Base)
+}
+
+func TestSaveThemePreset_EmptyThemeSection(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".perles.yaml")
+	initial := `theme:
+  # preset: catppuccin-mocha
+views:
+  - name: Default
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(initial), 0644))
+
+	require.NoError(t, SaveThemePreset(configPath, "light"))
+
+	cfg := loadConfigFromYAMLFile(t, configPath)
+	require.Equal(t, "light", cfg.Theme.Preset)
+	require.Len(t, cfg.Views, 1)
+}
+
+// loadConfigFromYAMLFile unmarshals a config file written by a save function.
+func loadConfigFromYAMLFile(t *testing.T, configPath string) Config {
+	t.Helper()
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	var cfg Config
+	require.NoError(t, v.Unmarshal(&cfg))
+	return cfg
+}
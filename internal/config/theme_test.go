@@ -320,3 +320,42 @@ func loadConfigFromYAML(t *testing.T, yaml string) Config {
 
 	return cfg
 }
+
+// TestThemeConfig_UserThemes tests loading user themes and selecting one as preset.
+func TestThemeConfig_UserThemes(t *testing.T) {
+	configYAML := `
+theme:
+  preset: ocean
+  themes:
+    - name: ocean
+      description: Deep blue
+      base: light
+      colors:
+        text:
+          primary: "#003366"
+`
+	cfg := loadConfigFromYAML(t, configYAML)
+
+	require.Len(t, cfg.Theme.Themes, 1)
+	theme := cfg.Theme.Themes[0]
+	require.Equal(t, "ocean", theme.Name)
+	require.Equal(t, "Deep blue", theme.Description)
+	require.Equal(t, "light", theme.Base)
+	require.Equal(t, map[string]string{"text.primary": "#003366"}, theme.FlattenedColors())
+
+	err := styles.RegisterTheme(styles.UserTheme{
+		Name:        theme.Name,
+		Description: theme.Description,
+		Base:        theme.Base,
+		Colors:      theme.FlattenedColors(),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		delete(styles.Presets, "ocean")
+		_ = styles.ApplyTheme(styles.ThemeConfig{})
+	})
+
+	require.NoError(t, styles.ApplyTheme(styles.ThemeConfig{Preset: cfg.Theme.Preset}))
+	require.Equal(t, "#003366", styles.TextPrimaryColor.Dark)
+	require.Equal(t, styles.LightPreset.Colors[styles.TokenStatusError], styles.StatusErrorColor.Dark)
+}
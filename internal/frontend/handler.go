@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
@@ -305,11 +306,9 @@ func (h *Handler) LoadSession(w http.ResponseWriter, r *http.Request) {
 				Raw:      loadRawJSONL(filepath.Join(workerDir, "raw.jsonl")),
 			}
 
-			// Load accountability summary if it exists
-			summaryPath := filepath.Join(workerDir, "accountability_summary.md")
-			if data, err := os.ReadFile(summaryPath); err == nil { //nolint:gosec // G304: path is validated by validateSessionPath before calling this handler
-				summaryStr := string(data)
-				workerData.AccountabilitySummary = &summaryStr
+			// Load the worker's accountability summaries (per task, or the legacy single file)
+			if summary := loadWorkerSummaries(workerDir); summary != "" {
+				workerData.AccountabilitySummary = &summary
 			}
 
 			resp.Workers[workerID] = workerData
@@ -336,6 +335,23 @@ func (h *Handler) LoadSession(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, resp)
 }
 
+// loadWorkerSummaries returns the accountability summaries of the worker in
+// workerDir joined in task order, or "" if it has none.
+func loadWorkerSummaries(workerDir string) string {
+	paths, err := accountability.WorkerSummaryPaths(workerDir)
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to list accountability summaries", "path", workerDir, "error", err)
+		return ""
+	}
+	var summaries []string
+	for _, path := range paths {
+		if data, err := os.ReadFile(path); err == nil { //nolint:gosec // G304: path is validated by validateSessionPath before calling this handler
+			summaries = append(summaries, strings.TrimSpace(string(data)))
+		}
+	}
+	return strings.Join(summaries, "\n\n")
+}
+
 // validateSessionPath validates that a path is safe to access.
 // It rejects path traversal attempts and paths outside sessionBaseDir.
 func (h *Handler) validateSessionPath(path string) error {
@@ -222,6 +222,42 @@ func TestHandler_LoadSession_Success(t *testing.T) {
 	assert.Len(t, resp.Commands, 1)
 }
 
+func TestHandler_LoadSession_AccountabilitySummaries(t *testing.T) {
+	tmpDir := t.TempDir()
+	sessionDir := createTestSession(t, tmpDir, "app1", "2026-01-15", "session-123", session.StatusRunning, "claude")
+
+	// worker-1 saved a summary per task
+	tasksDir := filepath.Join(sessionDir, "workers", "worker-1", "tasks")
+	require.NoError(t, os.MkdirAll(tasksDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(tasksDir, "task-2.md"), []byte("# Task 2\nSecond."), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tasksDir, "task-1.md"), []byte("# Task 1\nFirst."), 0600))
+
+	// worker-2 is from a session with the legacy single summary
+	legacyDir := filepath.Join(sessionDir, "workers", "worker-2")
+	require.NoError(t, os.MkdirAll(legacyDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(legacyDir, "accountability_summary.md"), []byte("# Legacy\nDone."), 0600))
+
+	// worker-3 has no summary
+	require.NoError(t, os.MkdirAll(filepath.Join(sessionDir, "workers", "worker-3"), 0750))
+
+	h := NewHandler(tmpDir, createTestFS(), nil)
+	mux := createTestMux(h)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/load-session", bytes.NewBufferString(makeLoadSessionBody(t, sessionDir)))
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp LoadSessionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	require.NotNil(t, resp.Workers["worker-1"].AccountabilitySummary)
+	assert.Equal(t, "# Task 1\nFirst.\n\n# Task 2\nSecond.", *resp.Workers["worker-1"].AccountabilitySummary)
+	require.NotNil(t, resp.Workers["worker-2"].AccountabilitySummary)
+	assert.Equal(t, "# Legacy\nDone.", *resp.Workers["worker-2"].AccountabilitySummary)
+	assert.Nil(t, resp.Workers["worker-3"].AccountabilitySummary)
+}
+
 func TestHandler_LoadSession_MissingFiles(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -21,6 +21,11 @@ type GitExecutor interface {
 	ListWorktrees() ([]domain.WorktreeInfo, error)
 	ListBranches() ([]domain.BranchInfo, error)
 	BranchExists(name string) bool
+	// MergeBranch merges branch into the current branch. A conflicting merge is
+	// aborted and returns ErrMergeConflict.
+	MergeBranch(branch string) error
+	// DeleteBranch deletes a branch that is merged into HEAD.
+	DeleteBranch(name string) error
 	// ValidateBranchName validates a branch name using git check-ref-format --branch.
 	// Returns nil if valid, ErrInvalidBranchName if invalid.
 	ValidateBranchName(name string) error
@@ -30,4 +30,7 @@ var (
 
 	// ErrDiffTimeout is returned when a git diff operation times out.
 	ErrDiffTimeout = errors.New("git diff timed out")
+
+	// ErrMergeConflict is returned when a merge conflicts and was aborted.
+	ErrMergeConflict = errors.New("merge conflict")
 )
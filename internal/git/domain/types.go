@@ -17,8 +17,12 @@ type CommitInfo struct {
 	Author    string    // Author name
 	Date      time.Time // Commit timestamp
 	IsPushed  bool      // True if commit exists on the remote tracking branch
+	Tasks     []string  // Values of the commit's Perles-Task trailers
 }
 
+// TaskTrailer is the commit message trailer naming the task a commit belongs to.
+const TaskTrailer = "Perles-Task"
+
 // WorktreeInfo holds information about a git worktree.
 type WorktreeInfo struct {
 	Path   string
@@ -485,6 +485,25 @@ func (e *RealExecutor) BranchExists(name string) bool {
 	return err == nil
 }
 
+// MergeBranch merges branch into the current branch, creating a merge commit
+// only when the histories diverged. A conflicting merge is aborted.
+func (e *RealExecutor) MergeBranch(branch string) error {
+	err := e.runGit("merge", "--no-edit", branch)
+	if err == nil {
+		return nil
+	}
+	// merge --abort only succeeds when the merge stopped on conflicts
+	if e.runGit("merge", "--abort") == nil {
+		return fmt.Errorf("%w merging %s: %w", domain.ErrMergeConflict, branch, err)
+	}
+	return err
+}
+
+// DeleteBranch deletes a branch that is merged into HEAD.
+func (e *RealExecutor) DeleteBranch(name string) error {
+	return e.runGit("branch", "-d", name)
+}
+
 // ValidateBranchName validates a branch name using git check-ref-format --branch.
 // Returns nil if valid, domain.ErrInvalidBranchName if invalid.
 func (e *RealExecutor) ValidateBranchName(name string) error {
@@ -634,9 +653,10 @@ func (e *RealExecutor) GetCommitLogForRef(ref string, limit int) ([]domain.Commi
 	ctx, cancel := context.WithTimeout(context.Background(), diffTimeout)
 	defer cancel()
 
-	// Format: full_hash<RS>short_hash<RS>subject<RS>author<RS>ISO_date
+	// Format: full_hash<RS>short_hash<RS>subject<RS>author<RS>ISO_date<RS>task_trailers
 	// Using ASCII Record Separator (0x1E) to avoid issues with commit messages
-	args := []string{"log", "--format=%H\x1e%h\x1e%s\x1e%an\x1e%aI", "-n", strconv.Itoa(limit)}
+	format := "--format=%H\x1e%h\x1e%s\x1e%an\x1e%aI\x1e%(trailers:key=" + domain.TaskTrailer + ",valueonly,separator=%x2C)"
+	args := []string{"log", format, "-n", strconv.Itoa(limit)}
 	if ref != "" {
 		args = append(args, ref)
 	}
@@ -710,8 +730,8 @@ func parseCommitLog(output string) []domain.CommitInfo {
 			continue
 		}
 
-		// Split on Record Separator delimiter: hash<RS>short<RS>subject<RS>author<RS>date
-		parts := strings.SplitN(line, commitLogDelimiter, 5)
+		// Split on Record Separator delimiter: hash<RS>short<RS>subject<RS>author<RS>date[<RS>tasks]
+		parts := strings.SplitN(line, commitLogDelimiter, 6)
 		if len(parts) < 5 {
 			continue // Invalid line format
 		}
@@ -722,6 +742,15 @@ func parseCommitLog(output string) []domain.CommitInfo {
 		author := parts[3]
 		dateStr := parts[4]
 
+		var tasks []string
+		if len(parts) == 6 {
+			for task := range strings.SplitSeq(parts[5], ",") {
+				if task = strings.TrimSpace(task); task != "" {
+					tasks = append(tasks, task)
+				}
+			}
+		}
+
 		// Parse ISO 8601 date (e.g., "2024-01-15T10:30:00-05:00")
 		date, err := time.Parse(time.RFC3339, dateStr)
 		if err != nil {
@@ -735,6 +764,7 @@ func parseCommitLog(output string) []domain.CommitInfo {
 			Subject:   subject,
 			Author:    author,
 			Date:      date,
+			Tasks:     tasks,
 		})
 	}
 
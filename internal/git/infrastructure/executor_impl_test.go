@@ -429,6 +429,52 @@ func TestRealExecutor_CreateWorktreeWithContext_Success(t *testing.T) {
 	require.NoError(t, err, "RemoveWorktree() error")
 }
 
+// TestRealExecutor_MergeBranch tests merging a worktree branch and deleting it,
+// and that a conflicting merge is aborted.
+func TestRealExecutor_MergeBranch(t *testing.T) {
+	repoDir := t.TempDir()
+	worktreePath := filepath.Join(t.TempDir(), "worker-1")
+	git := func(dir string, args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+	git(repoDir, "init")
+	git(repoDir, "config", "user.email", "test@test.com")
+	git(repoDir, "config", "user.name", "Test User")
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# Test\n"), 0644))
+	git(repoDir, "add", ".")
+	git(repoDir, "commit", "-m", "Initial commit")
+
+	executor := NewRealExecutor(repoDir)
+	require.NoError(t, executor.CreateWorktreeWithContext(context.Background(), worktreePath, "worker-1", ""))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "feature.txt"), []byte("feature\n"), 0644))
+	git(worktreePath, "add", ".")
+	git(worktreePath, "commit", "-m", "Add feature")
+
+	require.Error(t, executor.DeleteBranch("worker-1"), "unmerged branches are kept")
+	require.NoError(t, executor.MergeBranch("worker-1"))
+	_, err := os.Stat(filepath.Join(repoDir, "feature.txt"))
+	require.NoError(t, err, "merged file should be in the main work tree")
+	require.NoError(t, executor.RemoveWorktree(worktreePath))
+	require.NoError(t, executor.DeleteBranch("worker-1"))
+	require.False(t, executor.BranchExists("worker-1"))
+
+	// Both sides change README.md
+	require.NoError(t, executor.CreateWorktreeWithContext(context.Background(), worktreePath, "worker-2", ""))
+	require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "README.md"), []byte("# Worker\n"), 0644))
+	git(worktreePath, "commit", "-am", "Worker change")
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("# Main\n"), 0644))
+	git(repoDir, "commit", "-am", "Main change")
+
+	err = executor.MergeBranch("worker-2")
+	require.ErrorIs(t, err, domain.ErrMergeConflict)
+	dirty, err := executor.HasUncommittedChanges()
+	require.NoError(t, err)
+	require.False(t, dirty, "conflicting merge should be aborted")
+}
+
 // TestRealExecutor_CreateWorktreeWithContext_Timeout tests that context timeout is respected.
 // This test uses an already-cancelled context to verify timeout behavior.
 func TestRealExecutor_CreateWorktreeWithContext_Timeout(t *testing.T) {
@@ -802,6 +848,20 @@ func TestParseCommitLog(t *testing.T) {
 			input: "",
 			want:  nil,
 		},
+		{
+			name:  "commit with task trailers",
+			input: "abc123def456789012345678901234567890abcd" + d + "abc123d" + d + "Fix auth bug" + d + "Dev" + d + "2024-01-15T10:30:00Z" + d + "perles-abc,perles-def\n",
+			want: []domain.CommitInfo{
+				{
+					Hash:      "abc123def456789012345678901234567890abcd",
+					ShortHash: "abc123d",
+					Subject:   "Fix auth bug",
+					Author:    "Dev",
+					Date:      time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+					Tasks:     []string{"perles-abc", "perles-def"},
+				},
+			},
+		},
 		{
 			name:  "commit with pipe in subject",
 			input: "abc123def456789012345678901234567890abcd" + d + "abc123d" + d + "Add foo | bar feature" + d + "Dev" + d + "2024-01-15T10:30:00Z\n",
@@ -847,6 +907,7 @@ func TestParseCommitLog(t *testing.T) {
 				require.Equal(t, tc.want[i].Subject, got[i].Subject, "commit[%d].Subject", i)
 				require.Equal(t, tc.want[i].Author, got[i].Author, "commit[%d].Author", i)
 				require.True(t, tc.want[i].Date.Equal(got[i].Date), "commit[%d].Date: want %v, got %v", i, tc.want[i].Date, got[i].Date)
+				require.Equal(t, tc.want[i].Tasks, got[i].Tasks, "commit[%d].Tasks", i)
 			}
 		})
 	}
@@ -961,6 +1022,31 @@ func TestRealExecutor_GetCommitLogForRef_NotGitRepo(t *testing.T) {
 	require.ErrorIs(t, err, domain.ErrNotGitRepo, "GetCommitLogForRef() should return domain.ErrNotGitRepo")
 }
 
+// TestRealExecutor_GetCommitLogForRef_TaskTrailers tests that Perles-Task trailers are read.
+func TestRealExecutor_GetCommitLogForRef_TaskTrailers(t *testing.T) {
+	repoDir := t.TempDir()
+	cmds := [][]string{
+		{"git", "init"},
+		{"git", "config", "user.email", "test@test.com"},
+		{"git", "config", "user.name", "Test User"},
+		{"git", "commit", "--allow-empty", "-m", "Initial commit"},
+		{"git", "commit", "--allow-empty", "-m", "Fix auth bug\n\nPerles-Task: perles-abc\nPerles-Task: perles-def"},
+	}
+	for _, args := range cmds {
+		cmd := exec.Command(args[0], args[1:]...)
+		cmd.Dir = repoDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "git command %v failed: %s", args, out)
+	}
+
+	commits, err := NewRealExecutor(repoDir).GetCommitLogForRef("HEAD", 10)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, "Fix auth bug", commits[0].Subject)
+	require.Equal(t, []string{"perles-abc", "perles-def"}, commits[0].Tasks)
+	require.Empty(t, commits[1].Tasks)
+}
+
 // TestRealExecutor_ValidateBranchName_Valid tests ValidateBranchName with valid branch names.
 func TestRealExecutor_ValidateBranchName_Valid(t *testing.T) {
 	cwd, err := os.Getwd()
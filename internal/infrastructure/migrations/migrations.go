@@ -50,8 +50,15 @@ func MigrationsFS() fs.FS {
 //	    return fmt.Errorf("failed to run migrations: %w", err)
 //	}
 func RunMigrations(db *sql.DB) error {
-	// Create iofs source from embedded filesystem
-	source, err := iofs.New(embeddedMigrationsFS, ".")
+	return RunMigrationsFS(db, embeddedMigrationsFS)
+}
+
+// RunMigrationsFS applies all pending migrations found at the root of migrationsFS.
+// It lets other packages keep their own schema (e.g. a per-session database) while
+// sharing the ncruces-compatible driver.
+func RunMigrationsFS(db *sql.DB, migrationsFS fs.FS) error {
+	// Create iofs source from the migration filesystem
+	source, err := iofs.New(migrationsFS, ".")
 	if err != nil {
 		return err
 	}
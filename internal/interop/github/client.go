@@ -0,0 +1,214 @@
+// Package github syncs beads issues with the issues of a GitHub repository.
+//
+// Imported and exported issues are linked in a mapping table (see State) that
+// is kept next to the beads database, so later syncs only look at issues that
+// changed on either side since the last sync. An issue that changed on both
+// sides is reported as a conflict and left alone unless a side is preferred.
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL is the GitHub REST API endpoint used when none is configured.
+const DefaultAPIURL = "https://api.github.com"
+
+// Issue is a GitHub issue as seen by the sync.
+type Issue struct {
+	Number    int
+	Title     string
+	Body      string
+	State     string // "open" or "closed"
+	Labels    []string
+	UpdatedAt time.Time
+	URL       string
+}
+
+// IssueInput holds the fields the sync writes to a GitHub issue.
+type IssueInput struct {
+	Title  string
+	Body   string
+	State  string
+	Labels []string
+}
+
+// Client reads and writes the issues of one GitHub repository.
+type Client interface {
+	// ListIssues returns the issues (not pull requests) updated at or after
+	// since, or all issues when since is zero.
+	ListIssues(since time.Time) ([]Issue, error)
+	CreateIssue(in IssueInput) (Issue, error)
+	UpdateIssue(number int, in IssueInput) (Issue, error)
+}
+
+// RESTClient implements Client with the GitHub REST API.
+type RESTClient struct {
+	apiURL string
+	repo   string
+	token  string
+	http   *http.Client
+}
+
+// Verify RESTClient implements Client at compile time.
+var _ Client = (*RESTClient)(nil)
+
+// pageSize is the number of issues requested per page (the API maximum).
+const pageSize = 100
+
+// NewRESTClient creates a client for repo ("owner/name"). An empty apiURL uses
+// DefaultAPIURL.
+func NewRESTClient(apiURL, repo, token string) *RESTClient {
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	return &RESTClient{
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		repo:   repo,
+		token:  token,
+		http:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// restIssue is the REST API representation of an issue.
+type restIssue struct {
+	Number      int       `json:"number"`
+	Title       string    `json:"title"`
+	Body        string    `json:"body"`
+	State       string    `json:"state"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	HTMLURL     string    `json:"html_url"`
+	PullRequest *struct{} `json:"pull_request"`
+	Labels      []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+}
+
+func (r restIssue) issue() Issue {
+	issue := Issue{
+		Number:    r.Number,
+		Title:     r.Title,
+		Body:      r.Body,
+		State:     r.State,
+		UpdatedAt: r.UpdatedAt,
+		URL:       r.HTMLURL,
+	}
+	for _, l := range r.Labels {
+		issue.Labels = append(issue.Labels, l.Name)
+	}
+	return issue
+}
+
+// ListIssues implements Client.
+func (c *RESTClient) ListIssues(since time.Time) ([]Issue, error) {
+	query := url.Values{
+		"state":     {"all"},
+		"sort":      {"updated"},
+		"direction": {"asc"},
+		"per_page":  {fmt.Sprint(pageSize)},
+	}
+	if !since.IsZero() {
+		query.Set("since", since.UTC().Format(time.RFC3339))
+	}
+
+	var issues []Issue
+	for page := 1; ; page++ {
+		query.Set("page", fmt.Sprint(page))
+		var batch []restIssue
+		if err := c.do(http.MethodGet, "/issues?"+query.Encode(), nil, &batch); err != nil {
+			return nil, fmt.Errorf("listing issues: %w", err)
+		}
+		for _, r := range batch {
+			if r.PullRequest == nil {
+				issues = append(issues, r.issue())
+			}
+		}
+		if len(batch) < pageSize {
+			return issues, nil
+		}
+	}
+}
+
+// CreateIssue implements Client. GitHub creates issues open, so a closed
+// input is closed with a second request.
+func (c *RESTClient) CreateIssue(in IssueInput) (Issue, error) {
+	body := map[string]any{"title": in.Title, "body": in.Body, "labels": labelsOrEmpty(in.Labels)}
+	var created restIssue
+	if err := c.do(http.MethodPost, "/issues", body, &created); err != nil {
+		return Issue{}, fmt.Errorf("creating issue: %w", err)
+	}
+	if in.State == "closed" {
+		return c.UpdateIssue(created.Number, in)
+	}
+	return created.issue(), nil
+}
+
+// UpdateIssue implements Client.
+func (c *RESTClient) UpdateIssue(number int, in IssueInput) (Issue, error) {
+	body := map[string]any{"title": in.Title, "body": in.Body, "labels": labelsOrEmpty(in.Labels)}
+	if in.State != "" {
+		body["state"] = in.State
+	}
+	var updated restIssue
+	if err := c.do(http.MethodPatch, fmt.Sprintf("/issues/%d", number), body, &updated); err != nil {
+		return Issue{}, fmt.Errorf("updating issue #%d: %w", number, err)
+	}
+	return updated.issue(), nil
+}
+
+// labelsOrEmpty makes sure labels are sent as [] rather than null, which
+// GitHub rejects.
+func labelsOrEmpty(labels []string) []string {
+	if labels == nil {
+		return []string{}
+	}
+	return labels
+}
+
+// do sends a request to the repository API at path and decodes the JSON
+// response into out.
+func (c *RESTClient) do(method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, c.apiURL+"/repos/"+c.repo+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+		return fmt.Errorf("GitHub API %s %s: %d %s", method, path, resp.StatusCode, apiErr.Message)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
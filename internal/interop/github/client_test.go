@@ -0,0 +1,70 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_ListIssues(t *testing.T) {
+	since := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/repos/acme/app/issues", r.URL.Path)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.Equal(t, "all", r.URL.Query().Get("state"))
+		require.Equal(t, "2026-03-01T12:00:00Z", r.URL.Query().Get("since"))
+
+		// A full first page asks for the next one
+		var issues []map[string]any
+		if r.URL.Query().Get("page") == "1" {
+			for i := range pageSize {
+				issues = append(issues, map[string]any{"number": i + 1, "title": "Issue", "state": "open"})
+			}
+			issues[0]["labels"] = []map[string]string{{"name": "bug"}}
+			issues[1]["pull_request"] = map[string]string{"url": "x"}
+		} else {
+			issues = append(issues, map[string]any{"number": 500, "title": "Last", "state": "closed"})
+		}
+		_ = json.NewEncoder(w).Encode(issues)
+	}))
+	defer server.Close()
+
+	issues, err := NewRESTClient(server.URL, "acme/app", "secret").ListIssues(since)
+	require.NoError(t, err)
+	require.Len(t, issues, pageSize, "pull requests are skipped")
+	require.Equal(t, []string{"bug"}, issues[0].Labels)
+	require.Equal(t, Issue{Number: 500, Title: "Last", State: "closed"}, issues[len(issues)-1])
+}
+
+func TestRESTClient_CreateClosedIssue(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, fmt.Sprintf("%s %s %v", r.Method, r.URL.Path, body["state"]))
+		require.Equal(t, []any{}, body["labels"], "no labels are sent as an empty list")
+		_ = json.NewEncoder(w).Encode(map[string]any{"number": 7, "title": body["title"], "state": "open"})
+	}))
+	defer server.Close()
+
+	issue, err := NewRESTClient(server.URL, "acme/app", "secret").CreateIssue(IssueInput{Title: "Done", State: "closed"})
+	require.NoError(t, err)
+	require.Equal(t, 7, issue.Number)
+	require.Equal(t, []string{"POST /repos/acme/app/issues <nil>", "PATCH /repos/acme/app/issues/7 closed"}, requests)
+}
+
+func TestRESTClient_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewRESTClient(server.URL, "acme/app", "secret").UpdateIssue(3, IssueInput{Title: "x"})
+	require.EqualError(t, err, "updating issue #3: GitHub API PATCH /issues/3: 404 Not Found")
+}
@@ -0,0 +1,168 @@
+package github
+
+import (
+	"slices"
+	"strings"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+)
+
+// defaultPriority is the beads priority of imported issues without a priority label.
+const defaultPriority = beads.Priority(2)
+
+// Mapping translates labels, priorities and types between GitHub and beads.
+// GitHub labels are matched case-insensitively.
+type Mapping struct {
+	labels     map[string]string // lowercased GitHub label -> beads label
+	priorities map[string]beads.Priority
+	types      map[string]beads.IssueType
+}
+
+// NewMapping creates a mapping from the github config section.
+func NewMapping(cfg config.GitHubConfig) Mapping {
+	m := Mapping{
+		labels:     make(map[string]string, len(cfg.Labels)),
+		priorities: make(map[string]beads.Priority, len(cfg.Priorities)),
+		types:      make(map[string]beads.IssueType, len(cfg.Types)),
+	}
+	for gh, bd := range cfg.Labels {
+		m.labels[strings.ToLower(gh)] = bd
+	}
+	for gh, p := range cfg.Priorities {
+		m.priorities[strings.ToLower(gh)] = beads.Priority(p)
+	}
+	for gh, t := range cfg.Types {
+		m.types[strings.ToLower(gh)] = beads.IssueType(t)
+	}
+	return m
+}
+
+// Fields are the beads fields derived from a GitHub issue.
+type Fields struct {
+	Title       string
+	Description string
+	Status      beads.Status
+	Priority    beads.Priority
+	Type        beads.IssueType
+	Labels      []string
+}
+
+// ToBeads derives beads fields from a GitHub issue. Priority and type labels
+// set the priority and type; other labels are renamed or kept as is. Issues
+// without a priority label get P2, without a type label task.
+func (m Mapping) ToBeads(gh Issue) Fields {
+	fields := Fields{
+		Title:       gh.Title,
+		Description: gh.Body,
+		Status:      beads.StatusOpen,
+		Priority:    defaultPriority,
+		Type:        beads.TypeTask,
+	}
+	if gh.State == "closed" {
+		fields.Status = beads.StatusClosed
+	}
+
+	var hasPriority, hasType bool
+	for _, label := range gh.Labels {
+		key := strings.ToLower(label)
+		if p, ok := m.priorities[key]; ok {
+			if !hasPriority {
+				fields.Priority, hasPriority = p, true
+			}
+			continue
+		}
+		if t, ok := m.types[key]; ok {
+			if !hasType {
+				fields.Type, hasType = t, true
+			}
+			continue
+		}
+		if renamed, ok := m.labels[key]; ok {
+			label = renamed
+		}
+		if !slices.Contains(fields.Labels, label) {
+			fields.Labels = append(fields.Labels, label)
+		}
+	}
+	return fields
+}
+
+// ToGitHub returns the GitHub fields for a beads issue: plain labels renamed
+// back to their GitHub names, plus the labels mapped to the issue's priority
+// and type, sorted.
+func (m Mapping) ToGitHub(issue beads.Issue) IssueInput {
+	in := IssueInput{Title: issue.TitleText, Body: issue.DescriptionText, State: "open"}
+	if issue.Status == beads.StatusClosed {
+		in.State = "closed"
+	}
+
+	for _, label := range beads.PlainLabels(issue.Labels, nil) {
+		if label == beads.ArchivedLabel {
+			continue
+		}
+		in.Labels = append(in.Labels, m.githubLabel(label))
+	}
+	if label := firstKey(m.priorities, issue.Priority); label != "" {
+		in.Labels = append(in.Labels, label)
+	}
+	if label := firstKey(m.types, issue.Type); label != "" {
+		in.Labels = append(in.Labels, label)
+	}
+	slices.Sort(in.Labels)
+	in.Labels = slices.Compact(in.Labels)
+	return in
+}
+
+// githubLabel returns the GitHub name of a beads label.
+func (m Mapping) githubLabel(label string) string {
+	if gh := firstKey(m.labels, label); gh != "" {
+		return gh
+	}
+	return label
+}
+
+// firstKey returns the alphabetically first key that maps to value, so
+// exports are stable when several GitHub labels map to the same value.
+func firstKey[V comparable](mapping map[string]V, value V) string {
+	var keys []string
+	for k, v := range mapping {
+		if v == value {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	return slices.Min(keys)
+}
+
+// mergeLabels returns labels plus the labels of existing that are never
+// exported: attachments, recurrence rules and the archived label.
+func mergeLabels(existing, labels []string) []string {
+	merged := slices.Clone(labels)
+	plain := beads.PlainLabels(existing, nil)
+	for _, label := range existing {
+		if label == beads.ArchivedLabel || !slices.Contains(plain, label) {
+			merged = append(merged, label)
+		}
+	}
+	return merged
+}
+
+// sameContent reports whether the beads issue already matches the GitHub issue.
+func (m Mapping) sameContent(issue beads.Issue, gh Issue) bool {
+	in := m.ToGitHub(issue)
+	return in.Title == gh.Title && in.Body == gh.Body && in.State == gh.State &&
+		slices.Equal(foldLabels(in.Labels), foldLabels(gh.Labels))
+}
+
+// foldLabels returns labels lowercased and sorted, for comparison.
+func foldLabels(labels []string) []string {
+	folded := make([]string, len(labels))
+	for i, label := range labels {
+		folded[i] = strings.ToLower(label)
+	}
+	slices.Sort(folded)
+	return slices.Compact(folded)
+}
@@ -0,0 +1,66 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+)
+
+var testMapping = NewMapping(config.GitHubConfig{
+	Labels:     map[string]string{"good first issue": "starter"},
+	Priorities: map[string]int{"critical": 0, "priority/high": 1, "urgent": 1},
+	Types:      map[string]string{"bug": "bug", "enhancement": "feature"},
+})
+
+func TestMapping_ToBeads(t *testing.T) {
+	fields := testMapping.ToBeads(Issue{
+		Title:  "Crash on start",
+		Body:   "Stack trace",
+		State:  "closed",
+		Labels: []string{"Bug", "Priority/High", "Good First Issue", "ui"},
+	})
+	require.Equal(t, Fields{
+		Title:       "Crash on start",
+		Description: "Stack trace",
+		Status:      beads.StatusClosed,
+		Priority:    1,
+		Type:        beads.TypeBug,
+		Labels:      []string{"starter", "ui"},
+	}, fields)
+
+	fields = testMapping.ToBeads(Issue{Title: "Plain", State: "open"})
+	require.Equal(t, beads.StatusOpen, fields.Status)
+	require.Equal(t, beads.Priority(2), fields.Priority)
+	require.Equal(t, beads.TypeTask, fields.Type)
+}
+
+func TestMapping_ToGitHub(t *testing.T) {
+	in := testMapping.ToGitHub(beads.Issue{
+		TitleText:       "Crash on start",
+		DescriptionText: "Stack trace",
+		Status:          beads.StatusInProgress,
+		Priority:        1,
+		Type:            beads.TypeBug,
+		Labels:          []string{"ui", "starter", beads.ArchivedLabel, "attachment:log.txt", "recur:weekly"},
+	})
+	require.Equal(t, IssueInput{
+		Title:  "Crash on start",
+		Body:   "Stack trace",
+		State:  "open",
+		Labels: []string{"bug", "good first issue", "priority/high", "ui"},
+	}, in)
+}
+
+func TestMapping_SameContent(t *testing.T) {
+	issue := beads.Issue{TitleText: "T", Status: beads.StatusClosed, Priority: 0, Type: beads.TypeTask, Labels: []string{"ui"}}
+	require.True(t, testMapping.sameContent(issue, Issue{Title: "T", State: "closed", Labels: []string{"UI", "Critical"}}))
+	require.False(t, testMapping.sameContent(issue, Issue{Title: "T", State: "open", Labels: []string{"ui", "critical"}}))
+}
+
+func TestMergeLabels(t *testing.T) {
+	existing := []string{"old", "attachment:a.png", "recur:weekly", beads.ArchivedLabel}
+	require.Equal(t, []string{"new", "attachment:a.png", "recur:weekly", beads.ArchivedLabel}, mergeLabels(existing, []string{"new"}))
+}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// StateFile is the name of the mapping table, stored in the beads directory.
+const StateFile = "github-sync.json"
+
+// Link maps a beads issue to a GitHub issue and records what each side looked
+// like at the last sync, so changes since then can be detected.
+type Link struct {
+	BeadsID string `json:"beads_id"`
+	Number  int    `json:"number"`
+	// BeadsSyncedAt is the beads updated_at covered by the last sync.
+	BeadsSyncedAt time.Time `json:"beads_synced_at"`
+	// GitHubUpdatedAt is the GitHub updated_at covered by the last sync.
+	GitHubUpdatedAt time.Time `json:"github_updated_at"`
+}
+
+// State is the mapping table of one repository.
+type State struct {
+	Repo string `json:"repo"`
+	// LastSync is the latest GitHub updated_at seen. The next sync only lists
+	// GitHub issues updated since then.
+	LastSync time.Time `json:"last_sync"`
+	Links    []Link    `json:"links"`
+}
+
+// LoadState reads the mapping table at path. A missing file is an empty
+// table for repo; a table recorded for another repository is an error.
+func LoadState(path, repo string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &State{Repo: repo}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading sync state: %w", err)
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parsing sync state %s: %w", path, err)
+	}
+	if state.Repo != repo {
+		return nil, fmt.Errorf("sync state %s belongs to %s, not %s", path, state.Repo, repo)
+	}
+	return &state, nil
+}
+
+// Save writes the mapping table to path, replacing it atomically.
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), StateFile+".*")
+	if err != nil {
+		return fmt.Errorf("writing sync state: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("writing sync state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing sync state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("writing sync state: %w", err)
+	}
+	return nil
+}
+
+// BeadsIDs returns the linked beads issue IDs.
+func (s *State) BeadsIDs() []string {
+	ids := make([]string, len(s.Links))
+	for i, link := range s.Links {
+		ids[i] = link.BeadsID
+	}
+	return ids
+}
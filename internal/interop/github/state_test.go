@@ -0,0 +1,35 @@
+package github
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), StateFile)
+
+	state, err := LoadState(path, "acme/app")
+	require.NoError(t, err)
+	require.Equal(t, &State{Repo: "acme/app"}, state)
+
+	synced := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	state.LastSync = synced
+	state.Links = []Link{{BeadsID: "bd-1", Number: 4, BeadsSyncedAt: synced, GitHubUpdatedAt: synced}}
+	require.NoError(t, state.Save(path))
+
+	loaded, err := LoadState(path, "acme/app")
+	require.NoError(t, err)
+	require.Equal(t, state, loaded)
+	require.Equal(t, []string{"bd-1"}, loaded.BeadsIDs())
+
+	_, err = LoadState(path, "acme/other")
+	require.ErrorContains(t, err, "belongs to acme/app, not acme/other")
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o644))
+	_, err = LoadState(path, "acme/app")
+	require.ErrorContains(t, err, "parsing sync state")
+}
@@ -0,0 +1,292 @@
+package github
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// Direction limits a sync to changes flowing one way.
+type Direction string
+
+const (
+	DirectionBoth   Direction = "both"
+	DirectionImport Direction = "import" // GitHub -> beads only
+	DirectionExport Direction = "export" // beads -> GitHub only
+)
+
+// Side names the side that wins a conflict.
+type Side string
+
+const (
+	SideNone   Side = ""
+	SideGitHub Side = "github"
+	SideBeads  Side = "beads"
+)
+
+// Options control a sync.
+type Options struct {
+	Direction Direction
+	// Prefer resolves issues changed on both sides. SideNone reports them as
+	// conflicts and changes neither side.
+	Prefer Side
+	// DryRun reports the planned actions without writing anything.
+	DryRun bool
+}
+
+// ActionKind is what a sync did with one issue.
+type ActionKind string
+
+const (
+	ActionImported ActionKind = "imported" // new GitHub issue created in beads
+	ActionExported ActionKind = "exported" // new beads issue created on GitHub
+	ActionPulled   ActionKind = "pulled"   // GitHub changes applied to beads
+	ActionPushed   ActionKind = "pushed"   // beads changes applied to GitHub
+	ActionConflict ActionKind = "conflict" // changed on both sides, left alone
+)
+
+// Action is the outcome of syncing one issue.
+type Action struct {
+	Kind    ActionKind
+	BeadsID string
+	Number  int
+	Title   string
+	Err     error
+}
+
+// Report lists the actions of a sync.
+type Report struct {
+	Actions []Action
+}
+
+// Count returns the number of successful actions of kind.
+func (r Report) Count(kind ActionKind) int {
+	var n int
+	for _, a := range r.Actions {
+		if a.Kind == kind && a.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns the actions that failed.
+func (r Report) Failed() []Action {
+	var failed []Action
+	for _, a := range r.Actions {
+		if a.Err != nil {
+			failed = append(failed, a)
+		}
+	}
+	return failed
+}
+
+// Summary returns a one-line summary, e.g. "2 imported, 1 pushed, 1 conflict".
+func (r Report) Summary() string {
+	var parts []string
+	for _, kind := range []ActionKind{ActionImported, ActionExported, ActionPulled, ActionPushed, ActionConflict} {
+		if n := r.Count(kind); n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, kind))
+		}
+	}
+	if n := len(r.Failed()); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", n))
+	}
+	if len(parts) == 0 {
+		return "Already in sync"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Syncer syncs beads issues with a GitHub repository.
+type Syncer struct {
+	Client  Client
+	Beads   appbeads.IssueExecutor // Must implement IssueCreator to import issues
+	Mapping Mapping
+	Now     func() time.Time // Defaults to time.Now
+}
+
+// Sync runs one incremental sync and updates state with the new links and
+// timestamps (unless opts.DryRun). linked holds the beads issues linked in
+// state; candidates holds unlinked beads issues to export. A failed issue
+// does not stop the others; it is reported and retried by the next sync, as
+// are conflicts until they are resolved.
+func (s Syncer) Sync(state *State, linked, candidates []beads.Issue, opts Options) (Report, error) {
+	ghIssues, err := s.Client.ListIssues(state.LastSync)
+	if err != nil {
+		return Report{}, err
+	}
+
+	var report Report
+	pull := opts.Direction != DirectionExport
+	push := opts.Direction != DirectionImport
+	byNumber := make(map[int]Issue, len(ghIssues))
+	for _, gh := range ghIssues {
+		byNumber[gh.Number] = gh
+	}
+	byID := make(map[string]beads.Issue, len(linked))
+	for _, issue := range linked {
+		byID[issue.ID] = issue
+	}
+
+	lastSync := state.LastSync
+	seen := func(t time.Time) {
+		if t.After(lastSync) {
+			lastSync = t
+		}
+	}
+	for _, gh := range ghIssues {
+		seen(gh.UpdatedAt)
+	}
+
+	linkedNumbers := make(map[int]bool, len(state.Links))
+	for i := range state.Links {
+		link := &state.Links[i]
+		linkedNumbers[link.Number] = true
+		bd, ok := byID[link.BeadsID]
+		if !ok {
+			continue // deleted in beads
+		}
+		gh, ghListed := byNumber[link.Number]
+		ghChanged := ghListed && gh.UpdatedAt.After(link.GitHubUpdatedAt)
+		bdChanged := bd.UpdatedAt.After(link.BeadsSyncedAt)
+
+		winner := SideNone
+		switch {
+		case ghChanged && bdChanged && s.Mapping.sameContent(bd, gh):
+			if !opts.DryRun {
+				link.BeadsSyncedAt, link.GitHubUpdatedAt = bd.UpdatedAt, gh.UpdatedAt
+			}
+		case ghChanged && bdChanged:
+			winner = opts.Prefer
+			if winner == SideNone {
+				report.Actions = append(report.Actions, Action{Kind: ActionConflict, BeadsID: bd.ID, Number: gh.Number, Title: bd.TitleText})
+			}
+		case ghChanged:
+			winner = SideGitHub
+		case bdChanged:
+			winner = SideBeads
+		}
+
+		switch {
+		case winner == SideGitHub && pull:
+			action := Action{Kind: ActionPulled, BeadsID: bd.ID, Number: gh.Number, Title: gh.Title}
+			if !opts.DryRun {
+				action.Err = s.pull(bd, gh)
+				if action.Err == nil {
+					link.BeadsSyncedAt, link.GitHubUpdatedAt = s.now(), gh.UpdatedAt
+				}
+			}
+			report.Actions = append(report.Actions, action)
+		case winner == SideBeads && push:
+			action := Action{Kind: ActionPushed, BeadsID: bd.ID, Number: link.Number, Title: bd.TitleText}
+			if !opts.DryRun {
+				var updated Issue
+				updated, action.Err = s.Client.UpdateIssue(link.Number, s.Mapping.ToGitHub(bd))
+				if action.Err == nil {
+					link.BeadsSyncedAt, link.GitHubUpdatedAt = bd.UpdatedAt, updated.UpdatedAt
+					seen(updated.UpdatedAt)
+				}
+			}
+			report.Actions = append(report.Actions, action)
+		}
+	}
+
+	if pull {
+		for _, gh := range ghIssues {
+			if linkedNumbers[gh.Number] {
+				continue
+			}
+			action := Action{Kind: ActionImported, Number: gh.Number, Title: gh.Title}
+			if !opts.DryRun {
+				action.BeadsID, action.Err = s.importIssue(gh)
+				// Link even if closing failed, so the issue isn't imported twice
+				if action.BeadsID != "" {
+					state.Links = append(state.Links, Link{BeadsID: action.BeadsID, Number: gh.Number, BeadsSyncedAt: s.now(), GitHubUpdatedAt: gh.UpdatedAt})
+				}
+			}
+			report.Actions = append(report.Actions, action)
+		}
+	}
+
+	if push {
+		for _, bd := range candidates {
+			if slices.ContainsFunc(state.Links, func(l Link) bool { return l.BeadsID == bd.ID }) {
+				continue
+			}
+			action := Action{Kind: ActionExported, BeadsID: bd.ID, Title: bd.TitleText}
+			if !opts.DryRun {
+				var created Issue
+				created, action.Err = s.Client.CreateIssue(s.Mapping.ToGitHub(bd))
+				if action.Err == nil {
+					action.Number = created.Number
+					state.Links = append(state.Links, Link{BeadsID: bd.ID, Number: created.Number, BeadsSyncedAt: bd.UpdatedAt, GitHubUpdatedAt: created.UpdatedAt})
+					seen(created.UpdatedAt)
+				}
+			}
+			report.Actions = append(report.Actions, action)
+		}
+	}
+
+	// Failed and conflicting issues must be listed again by the next sync
+	if !opts.DryRun && len(report.Failed()) == 0 && report.Count(ActionConflict) == 0 {
+		state.LastSync = lastSync
+	}
+	return report, nil
+}
+
+// pull applies a GitHub issue to its linked beads issue. An open GitHub issue
+// keeps the beads status unless the beads issue is closed, so in_progress and
+// blocked survive a sync.
+func (s Syncer) pull(bd beads.Issue, gh Issue) error {
+	fields := s.Mapping.ToBeads(gh)
+	status := fields.Status
+	if status == beads.StatusOpen && bd.Status != beads.StatusClosed {
+		status = bd.Status
+	}
+	labels := mergeLabels(bd.Labels, fields.Labels)
+	return s.Beads.UpdateIssue(bd.ID, beads.UpdateIssueOptions{
+		Title:       &fields.Title,
+		Description: &fields.Description,
+		Status:      &status,
+		Priority:    &fields.Priority,
+		Type:        &fields.Type,
+		Labels:      &labels,
+	})
+}
+
+// importIssue creates a beads issue from a GitHub issue and returns its ID.
+func (s Syncer) importIssue(gh Issue) (string, error) {
+	creator, ok := s.Beads.(appbeads.IssueCreator)
+	if !ok {
+		return "", appbeads.ErrCreateUnsupported
+	}
+	fields := s.Mapping.ToBeads(gh)
+	created, err := creator.CreateIssue(beads.CreateIssueOptions{
+		Title:       fields.Title,
+		Description: fields.Description,
+		Type:        fields.Type,
+		Priority:    fields.Priority,
+		Labels:      fields.Labels,
+	})
+	if err != nil {
+		return "", err
+	}
+	if fields.Status == beads.StatusClosed {
+		if err := s.Beads.CloseIssue(created.ID, fmt.Sprintf("Closed on GitHub (#%d)", gh.Number)); err != nil {
+			return created.ID, err
+		}
+	}
+	return created.ID, nil
+}
+
+func (s Syncer) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
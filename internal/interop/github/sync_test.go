@@ -0,0 +1,185 @@
+package github
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+// fakeClient is an in-memory Client.
+type fakeClient struct {
+	issues  []Issue
+	created []IssueInput
+	updated map[int]IssueInput
+	now     time.Time
+}
+
+func (c *fakeClient) ListIssues(since time.Time) ([]Issue, error) {
+	var listed []Issue
+	for _, issue := range c.issues {
+		if !issue.UpdatedAt.Before(since) {
+			listed = append(listed, issue)
+		}
+	}
+	return listed, nil
+}
+
+func (c *fakeClient) CreateIssue(in IssueInput) (Issue, error) {
+	c.created = append(c.created, in)
+	return Issue{Number: 100 + len(c.created), Title: in.Title, UpdatedAt: c.now}, nil
+}
+
+func (c *fakeClient) UpdateIssue(number int, in IssueInput) (Issue, error) {
+	if c.updated == nil {
+		c.updated = make(map[int]IssueInput)
+	}
+	c.updated[number] = in
+	return Issue{Number: number, Title: in.Title, UpdatedAt: c.now}, nil
+}
+
+// createExecutor adds an IssueCreator implementation to the IssueExecutor mock.
+type createExecutor struct {
+	*mocks.MockIssueExecutor
+	create func(opts beads.CreateIssueOptions) (beads.CreateResult, error)
+}
+
+func (e createExecutor) CreateIssue(opts beads.CreateIssueOptions) (beads.CreateResult, error) {
+	return e.create(opts)
+}
+
+var (
+	t0 = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	t1 = t0.Add(time.Hour)
+	t2 = t0.Add(2 * time.Hour)
+)
+
+func linkedState() *State {
+	return &State{Repo: "acme/app", LastSync: t0, Links: []Link{{BeadsID: "bd-1", Number: 1, BeadsSyncedAt: t0, GitHubUpdatedAt: t0}}}
+}
+
+func TestSync_PullsGitHubChanges(t *testing.T) {
+	client := &fakeClient{issues: []Issue{{Number: 1, Title: "Renamed", State: "open", Labels: []string{"bug"}, UpdatedAt: t1}}}
+	executor := mocks.NewMockIssueExecutor(t)
+	executor.EXPECT().UpdateIssue("bd-1", mock.MatchedBy(func(opts beads.UpdateIssueOptions) bool {
+		return *opts.Title == "Renamed" && *opts.Status == beads.StatusInProgress && *opts.Type == beads.TypeBug &&
+			len(*opts.Labels) == 1 && (*opts.Labels)[0] == "attachment:a.png"
+	})).Return(nil)
+
+	state := linkedState()
+	linked := []beads.Issue{{ID: "bd-1", TitleText: "Old", Status: beads.StatusInProgress, Labels: []string{"attachment:a.png"}, UpdatedAt: t0}}
+	syncer := Syncer{Client: client, Beads: executor, Mapping: testMapping, Now: func() time.Time { return t2 }}
+
+	report, err := syncer.Sync(state, linked, nil, Options{Direction: DirectionBoth})
+	require.NoError(t, err)
+	require.Equal(t, "1 pulled", report.Summary())
+	require.Equal(t, Link{BeadsID: "bd-1", Number: 1, BeadsSyncedAt: t2, GitHubUpdatedAt: t1}, state.Links[0])
+	require.Equal(t, t1, state.LastSync)
+}
+
+func TestSync_PushesBeadsChanges(t *testing.T) {
+	client := &fakeClient{now: t2}
+	state := linkedState()
+	linked := []beads.Issue{{ID: "bd-1", TitleText: "Edited", Status: beads.StatusClosed, Priority: 2, Type: beads.TypeTask, UpdatedAt: t1}}
+	syncer := Syncer{Client: client, Beads: mocks.NewMockIssueExecutor(t), Mapping: testMapping}
+
+	report, err := syncer.Sync(state, linked, nil, Options{Direction: DirectionBoth})
+	require.NoError(t, err)
+	require.Equal(t, "1 pushed", report.Summary())
+	require.Equal(t, IssueInput{Title: "Edited", State: "closed"}, client.updated[1])
+	require.Equal(t, Link{BeadsID: "bd-1", Number: 1, BeadsSyncedAt: t1, GitHubUpdatedAt: t2}, state.Links[0])
+
+	// Import-only syncs leave beads changes alone
+	client.updated = nil
+	state = linkedState()
+	report, err = syncer.Sync(state, linked, nil, Options{Direction: DirectionImport})
+	require.NoError(t, err)
+	require.Equal(t, "Already in sync", report.Summary())
+	require.Empty(t, client.updated)
+}
+
+func TestSync_Conflict(t *testing.T) {
+	client := &fakeClient{issues: []Issue{{Number: 1, Title: "GitHub title", State: "open", UpdatedAt: t1}}, now: t2}
+	linked := []beads.Issue{{ID: "bd-1", TitleText: "Beads title", Status: beads.StatusOpen, Priority: 2, Type: beads.TypeTask, UpdatedAt: t1}}
+	syncer := Syncer{Client: client, Beads: mocks.NewMockIssueExecutor(t), Mapping: testMapping}
+
+	state := linkedState()
+	report, err := syncer.Sync(state, linked, nil, Options{Direction: DirectionBoth})
+	require.NoError(t, err)
+	require.Equal(t, []Action{{Kind: ActionConflict, BeadsID: "bd-1", Number: 1, Title: "Beads title"}}, report.Actions)
+	require.Equal(t, linkedState(), state, "conflicts change nothing")
+
+	report, err = syncer.Sync(state, linked, nil, Options{Direction: DirectionBoth, Prefer: SideBeads})
+	require.NoError(t, err)
+	require.Equal(t, "1 pushed", report.Summary())
+	require.Equal(t, "Beads title", client.updated[1].Title)
+}
+
+func TestSync_SameContentOnBothSides(t *testing.T) {
+	client := &fakeClient{issues: []Issue{{Number: 1, Title: "Same", State: "closed", UpdatedAt: t2}}}
+	linked := []beads.Issue{{ID: "bd-1", TitleText: "Same", Status: beads.StatusClosed, Priority: 2, Type: beads.TypeTask, UpdatedAt: t1}}
+	syncer := Syncer{Client: client, Beads: mocks.NewMockIssueExecutor(t), Mapping: testMapping}
+
+	state := linkedState()
+	report, err := syncer.Sync(state, linked, nil, Options{Direction: DirectionBoth})
+	require.NoError(t, err)
+	require.Empty(t, report.Actions)
+	require.Equal(t, Link{BeadsID: "bd-1", Number: 1, BeadsSyncedAt: t1, GitHubUpdatedAt: t2}, state.Links[0])
+}
+
+func TestSync_ImportsAndExports(t *testing.T) {
+	client := &fakeClient{
+		issues: []Issue{
+			{Number: 5, Title: "From GitHub", State: "closed", Labels: []string{"enhancement", "urgent"}, UpdatedAt: t1},
+			{Number: 6, Title: "Broken", State: "open", UpdatedAt: t1},
+		},
+		now: t2,
+	}
+	issueMock := mocks.NewMockIssueExecutor(t)
+	issueMock.EXPECT().CloseIssue("bd-5", "Closed on GitHub (#5)").Return(nil)
+	executor := createExecutor{
+		MockIssueExecutor: issueMock,
+		create: func(opts beads.CreateIssueOptions) (beads.CreateResult, error) {
+			if opts.Title == "Broken" {
+				return beads.CreateResult{}, errors.New("bd failed")
+			}
+			require.Equal(t, beads.CreateIssueOptions{Title: "From GitHub", Type: beads.TypeFeature, Priority: 1}, opts)
+			return beads.CreateResult{ID: "bd-5", Title: opts.Title}, nil
+		},
+	}
+	candidates := []beads.Issue{
+		{ID: "bd-1", TitleText: "Already linked"},
+		{ID: "bd-9", TitleText: "To GitHub", Status: beads.StatusOpen, Priority: 0, Type: beads.TypeBug, UpdatedAt: t1},
+	}
+	syncer := Syncer{Client: client, Beads: executor, Mapping: testMapping, Now: func() time.Time { return t2 }}
+
+	state := linkedState()
+	report, err := syncer.Sync(state, nil, candidates, Options{Direction: DirectionBoth})
+	require.NoError(t, err)
+	require.Equal(t, "1 imported, 1 exported, 1 failed", report.Summary())
+	require.Equal(t, []IssueInput{{Title: "To GitHub", State: "open", Labels: []string{"bug", "critical"}}}, client.created)
+	require.Equal(t, []Link{
+		{BeadsID: "bd-1", Number: 1, BeadsSyncedAt: t0, GitHubUpdatedAt: t0},
+		{BeadsID: "bd-5", Number: 5, BeadsSyncedAt: t2, GitHubUpdatedAt: t1},
+		{BeadsID: "bd-9", Number: 101, BeadsSyncedAt: t1, GitHubUpdatedAt: t2},
+	}, state.Links)
+	require.Equal(t, t0, state.LastSync, "a failed import is listed again next time")
+}
+
+func TestSync_DryRun(t *testing.T) {
+	client := &fakeClient{issues: []Issue{{Number: 5, Title: "From GitHub", State: "open", UpdatedAt: t1}}}
+	candidates := []beads.Issue{{ID: "bd-9", TitleText: "To GitHub"}}
+	syncer := Syncer{Client: client, Beads: mocks.NewMockIssueExecutor(t), Mapping: testMapping}
+
+	state := linkedState()
+	report, err := syncer.Sync(state, nil, candidates, Options{Direction: DirectionBoth, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, "1 imported, 1 exported", report.Summary())
+	require.Empty(t, client.created)
+	require.Equal(t, linkedState(), state)
+}
@@ -0,0 +1,178 @@
+// Package issuefile exports beads issues to CSV or JSON files and imports
+// them back, for spreadsheets and migrations from other trackers.
+package issuefile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// Format is a file format of an export or import.
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatCSV, FormatJSON:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unknown format %q: expected csv or json", s)
+	}
+}
+
+// Field names, used as CSV headers and JSON keys.
+const (
+	FieldID          = "id"
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldType        = "type"
+	FieldStatus      = "status"
+	FieldPriority    = "priority"
+	FieldAssignee    = "assignee"
+	FieldLabels      = "labels"
+	FieldParent      = "parent"
+	FieldNotes       = "notes"
+	FieldCreatedAt   = "created_at"
+	FieldUpdatedAt   = "updated_at"
+	FieldClosedAt    = "closed_at"
+)
+
+// Fields are all exportable fields, in column order.
+var Fields = []string{
+	FieldID, FieldTitle, FieldDescription, FieldType, FieldStatus, FieldPriority,
+	FieldAssignee, FieldLabels, FieldParent, FieldNotes, FieldCreatedAt, FieldUpdatedAt, FieldClosedAt,
+}
+
+// DefaultFields are the fields exported when none are selected.
+var DefaultFields = []string{
+	FieldID, FieldTitle, FieldType, FieldStatus, FieldPriority, FieldAssignee, FieldLabels, FieldParent, FieldDescription,
+}
+
+// labelSeparator joins labels in a CSV cell.
+const labelSeparator = ";"
+
+// ParseFields parses a comma-separated field list; empty selects DefaultFields.
+func ParseFields(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return DefaultFields, nil
+	}
+	var fields []string
+	for f := range strings.SplitSeq(s, ",") {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if !slices.Contains(Fields, f) {
+			return nil, fmt.Errorf("unknown field %q: expected one of %s", f, strings.Join(Fields, ", "))
+		}
+		if slices.Contains(fields, f) {
+			return nil, fmt.Errorf("field %q is listed twice", f)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// Export writes issues with the given fields to w.
+func Export(w io.Writer, issues []beads.Issue, format Format, fields []string) error {
+	switch format {
+	case FormatCSV:
+		return exportCSV(w, issues, fields)
+	case FormatJSON:
+		return exportJSON(w, issues, fields)
+	default:
+		return fmt.Errorf("unknown format %q: expected csv or json", format)
+	}
+}
+
+func exportCSV(w io.Writer, issues []beads.Issue, fields []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(fields); err != nil {
+		return err
+	}
+	record := make([]string, len(fields))
+	for _, issue := range issues {
+		for i, f := range fields {
+			if f == FieldLabels {
+				record[i] = strings.Join(issue.Labels, labelSeparator)
+				continue
+			}
+			record[i] = textValue(issue, f)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func exportJSON(w io.Writer, issues []beads.Issue, fields []string) error {
+	rows := make([]map[string]any, 0, len(issues))
+	for _, issue := range issues {
+		row := make(map[string]any, len(fields))
+		for _, f := range fields {
+			switch f {
+			case FieldLabels:
+				row[f] = append([]string{}, issue.Labels...)
+			case FieldPriority:
+				row[f] = int(issue.Priority)
+			default:
+				row[f] = textValue(issue, f)
+			}
+		}
+		rows = append(rows, row)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(rows)
+}
+
+// textValue returns a single-valued field of issue as text. Unset timestamps
+// are empty.
+func textValue(issue beads.Issue, field string) string {
+	switch field {
+	case FieldID:
+		return issue.ID
+	case FieldTitle:
+		return issue.TitleText
+	case FieldDescription:
+		return issue.DescriptionText
+	case FieldType:
+		return string(issue.Type)
+	case FieldStatus:
+		return string(issue.Status)
+	case FieldPriority:
+		return strconv.Itoa(int(issue.Priority))
+	case FieldAssignee:
+		return issue.Assignee
+	case FieldParent:
+		return issue.ParentID
+	case FieldNotes:
+		return issue.Notes
+	case FieldCreatedAt:
+		return formatTime(issue.CreatedAt)
+	case FieldUpdatedAt:
+		return formatTime(issue.UpdatedAt)
+	case FieldClosedAt:
+		return formatTime(issue.ClosedAt)
+	}
+	return ""
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
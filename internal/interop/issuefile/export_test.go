@@ -0,0 +1,75 @@
+package issuefile
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+var exportIssues = []beads.Issue{
+	{
+		ID: "bd-1", TitleText: "Checkout, v2", DescriptionText: "Line one\nline \"two\"", Type: beads.TypeEpic,
+		Status: beads.StatusOpen, Priority: 1, Labels: []string{"web", "size:l"},
+		CreatedAt: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+	},
+	{ID: "bd-1.1", TitleText: "Cart", Type: beads.TypeTask, Status: beads.StatusClosed, Priority: 2, Assignee: "ann", ParentID: "bd-1"},
+}
+
+func TestParseFields(t *testing.T) {
+	fields, err := ParseFields("")
+	require.NoError(t, err)
+	require.Equal(t, DefaultFields, fields)
+
+	fields, err = ParseFields(" ID, title ,labels")
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "title", "labels"}, fields)
+
+	_, err = ParseFields("id,estimate")
+	require.ErrorContains(t, err, `unknown field "estimate"`)
+	_, err = ParseFields("id,title,id")
+	require.EqualError(t, err, `field "id" is listed twice`)
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("CSV")
+	require.NoError(t, err)
+	require.Equal(t, FormatCSV, format)
+	_, err = ParseFormat("xml")
+	require.EqualError(t, err, `unknown format "xml": expected csv or json`)
+}
+
+func TestExport_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, exportIssues, FormatCSV, []string{"id", "title", "description", "labels", "priority", "parent", "created_at"}))
+	require.Equal(t, `id,title,description,labels,priority,parent,created_at
+bd-1,"Checkout, v2","Line one
+line ""two""",web;size:l,1,,2026-03-01T09:00:00Z
+bd-1.1,Cart,,,2,bd-1,
+`, buf.String())
+}
+
+func TestExport_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Export(&buf, exportIssues[1:], FormatJSON, []string{"id", "status", "priority", "labels"}))
+	require.JSONEq(t, `[{"id":"bd-1.1","status":"closed","priority":2,"labels":[]}]`, buf.String())
+}
+
+func TestExport_RoundTrip(t *testing.T) {
+	existing := map[string]beads.Issue{"bd-1": exportIssues[0], "bd-1.1": exportIssues[1]}
+	for _, format := range []Format{FormatCSV, FormatJSON} {
+		var buf bytes.Buffer
+		require.NoError(t, Export(&buf, exportIssues, format, Fields))
+
+		rows, rowErrs, err := Read(&buf, format)
+		require.NoError(t, err)
+		require.Empty(t, rowErrs)
+		plan := BuildPlan(rows, existing)
+		require.Equal(t, []string{"bd-1", "bd-1.1"}, plan.Unchanged, "an unedited export imports as no changes (%s)", format)
+		require.Empty(t, plan.Updates)
+		require.Empty(t, plan.Warnings)
+	}
+}
@@ -0,0 +1,352 @@
+package issuefile
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// RefLabelPrefix prefixes the label that records the row ID an issue was
+// created from, e.g. "import:JIRA-12". beads assigns created issues a new ID,
+// so the label is how a later import of the same file finds them again.
+const RefLabelPrefix = "import:"
+
+// Defaults of created issues whose row leaves the field empty.
+const (
+	defaultType     = beads.TypeTask
+	defaultPriority = beads.Priority(2)
+)
+
+// PlannedCreate is a row to create as a new issue.
+type PlannedCreate struct {
+	Row int
+	// Ref is the row's ID, which other rows may name as their parent. beads
+	// assigns the created issue a new ID and the ref is kept as a RefLabelPrefix label.
+	Ref     string
+	Options beads.CreateIssueOptions
+	// ParentRef is the Ref of a parent created by the same import.
+	ParentRef string
+	Status    beads.Status
+	Notes     string
+}
+
+// PlannedUpdate is a row that changes an existing issue.
+type PlannedUpdate struct {
+	Row     int
+	ID      string
+	Options beads.UpdateIssueOptions
+	// Changed lists the changed fields, in column order.
+	Changed []string
+}
+
+// Plan is what an import will write.
+type Plan struct {
+	// Creates are the new issues, parents before their children.
+	Creates []PlannedCreate
+	Updates []PlannedUpdate
+	// Unchanged lists the IDs of existing issues the file leaves as they are.
+	Unchanged []string
+	// Errors are rows that cannot be imported.
+	Errors []RowError
+	// Warnings describe fields that are not imported.
+	Warnings []string
+}
+
+// BuildPlan plans the import of rows. Rows whose ID is in existing, or whose
+// ID an existing issue was created from by an earlier import, update that
+// issue, changing only the fields they set; other rows create issues.
+func BuildPlan(rows []Row, existing map[string]beads.Issue) Plan {
+	existing = withImportRefs(existing)
+	var plan Plan
+	warn := func(row Row, format string, args ...any) {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf("row %d: ", row.Number)+fmt.Sprintf(format, args...))
+	}
+
+	// IDs must be unique so updates and parent references are unambiguous;
+	// a repeated ID is an error on every row after the first
+	firstRow := make(map[string]int)
+	creates := make(map[string]Row)
+	for _, row := range rows {
+		if _, dup := firstRow[row.ID]; dup || row.ID == "" {
+			continue
+		}
+		firstRow[row.ID] = row.Number
+		if _, ok := existing[row.ID]; !ok {
+			creates[row.ID] = row
+		}
+	}
+
+	// Parents are created first so children can point at them. Outcomes are
+	// kept by ID so each row is planned once, whether a child visits it first.
+	outcomes := make(map[string]error)
+	visiting := make(map[string]bool)
+	var planCreate func(row Row) error
+	var planParent func(ref string) error
+	planCreate = func(row Row) error {
+		if row.ID == "" {
+			return addCreate(&plan, row, existing, planParent)
+		}
+		if err, ok := outcomes[row.ID]; ok {
+			return err
+		}
+		if visiting[row.ID] {
+			return fmt.Errorf("%s is its own ancestor", row.ID)
+		}
+		visiting[row.ID] = true
+		err := addCreate(&plan, row, existing, planParent)
+		visiting[row.ID] = false
+		outcomes[row.ID] = err
+		return err
+	}
+	planParent = func(ref string) error {
+		parent, ok := creates[ref]
+		if !ok {
+			return fmt.Errorf("parent %s does not exist", ref)
+		}
+		if err := planCreate(parent); err != nil {
+			return fmt.Errorf("parent %s: %w", ref, err)
+		}
+		return nil
+	}
+
+	for _, row := range rows {
+		issue, ok := existing[row.ID]
+		var err error
+		switch {
+		case row.ID != "" && firstRow[row.ID] != row.Number:
+			err = fmt.Errorf("id %s is already used by row %d", row.ID, firstRow[row.ID])
+		case !ok:
+			err = planCreate(row)
+		case row.Has(FieldTitle) && row.Title == "":
+			err = errors.New("title cannot be empty")
+		default:
+			if row.Has(FieldParent) && parentID(row.Parent, existing) != issue.ParentID {
+				warn(row, "the parent of %s cannot be changed by an import", issue.ID)
+			}
+			if pu, changed := planUpdate(row, issue); changed {
+				plan.Updates = append(plan.Updates, pu)
+			} else {
+				plan.Unchanged = append(plan.Unchanged, issue.ID)
+			}
+		}
+		if err != nil {
+			plan.Errors = append(plan.Errors, RowError{Number: row.Number, Err: err})
+		}
+	}
+	return plan
+}
+
+// withImportRefs returns existing with each issue also listed under the row
+// IDs of its RefLabelPrefix labels. Real IDs take precedence over refs.
+func withImportRefs(existing map[string]beads.Issue) map[string]beads.Issue {
+	lookup := make(map[string]beads.Issue, len(existing))
+	for _, issue := range existing {
+		for _, label := range issue.Labels {
+			if ref, ok := strings.CutPrefix(label, RefLabelPrefix); ok && ref != "" {
+				lookup[ref] = issue
+			}
+		}
+	}
+	for id, issue := range existing {
+		lookup[id] = issue
+	}
+	return lookup
+}
+
+// parentID returns the beads ID of the parent named by ref.
+func parentID(ref string, existing map[string]beads.Issue) string {
+	if parent, ok := existing[ref]; ok {
+		return parent.ID
+	}
+	return ref
+}
+
+// addCreate appends the creation of row to plan. planParent plans a parent
+// that is not in existing, so it is created first.
+func addCreate(plan *Plan, row Row, existing map[string]beads.Issue, planParent func(ref string) error) error {
+	if row.Title == "" {
+		return errors.New("title is required to create an issue")
+	}
+	pc := PlannedCreate{
+		Row: row.Number,
+		Ref: row.ID,
+		Options: beads.CreateIssueOptions{
+			Title:       row.Title,
+			Description: row.Description,
+			Type:        defaultType,
+			Priority:    defaultPriority,
+			Assignee:    row.Assignee,
+			Labels:      row.Labels,
+		},
+		Status: beads.StatusOpen,
+		Notes:  row.Notes,
+	}
+	if row.Has(FieldType) {
+		pc.Options.Type = row.Type
+	}
+	if row.Has(FieldPriority) {
+		pc.Options.Priority = row.Priority
+	}
+	if row.Has(FieldStatus) {
+		pc.Status = row.Status
+	}
+	if row.ID != "" {
+		pc.Options.Labels = append(slices.Clone(row.Labels), RefLabelPrefix+row.ID)
+	}
+	switch parent, ok := existing[row.Parent]; {
+	case row.Parent == "":
+	case ok:
+		pc.Options.ParentID = parent.ID
+	default:
+		if err := planParent(row.Parent); err != nil {
+			return err
+		}
+		pc.ParentRef = row.Parent
+	}
+	plan.Creates = append(plan.Creates, pc)
+	return nil
+}
+
+// planUpdate returns the changes row makes to issue.
+func planUpdate(row Row, issue beads.Issue) (PlannedUpdate, bool) {
+	pu := PlannedUpdate{Row: row.Number, ID: issue.ID}
+	opts := &pu.Options
+	change := func(field string, differs bool) bool {
+		if row.Has(field) && differs {
+			pu.Changed = append(pu.Changed, field)
+			return true
+		}
+		return false
+	}
+
+	if change(FieldTitle, row.Title != issue.TitleText) {
+		opts.Title = &row.Title
+	}
+	if change(FieldDescription, row.Description != issue.DescriptionText) {
+		opts.Description = &row.Description
+	}
+	if change(FieldType, row.Type != issue.Type) {
+		opts.Type = &row.Type
+	}
+	if change(FieldStatus, row.Status != issue.Status) {
+		opts.Status = &row.Status
+	}
+	if change(FieldPriority, row.Priority != issue.Priority) {
+		opts.Priority = &row.Priority
+	}
+	if change(FieldAssignee, row.Assignee != issue.Assignee) {
+		opts.Assignee = &row.Assignee
+	}
+	// Import ref labels are not the file's to remove
+	labels := row.Labels
+	for _, label := range issue.Labels {
+		if strings.HasPrefix(label, RefLabelPrefix) && !slices.Contains(labels, label) {
+			labels = append(slices.Clone(labels), label)
+		}
+	}
+	if change(FieldLabels, !sameLabels(labels, issue.Labels)) {
+		opts.Labels = &labels
+	}
+	if change(FieldNotes, row.Notes != issue.Notes) {
+		opts.Notes = &row.Notes
+	}
+	return pu, len(pu.Changed) > 0
+}
+
+func sameLabels(a, b []string) bool {
+	return slices.Equal(slices.Sorted(slices.Values(a)), slices.Sorted(slices.Values(b)))
+}
+
+// CreateResult is the outcome of creating one issue.
+type CreateResult struct {
+	Row     int
+	Ref     string
+	BeadsID string // Set once the issue is created, even if a later step failed
+	Err     error
+}
+
+// UpdateResult is the outcome of updating one issue.
+type UpdateResult struct {
+	Row int
+	ID  string
+	Err error
+}
+
+// Result is the outcome of an import.
+type Result struct {
+	Created []CreateResult
+	Updated []UpdateResult
+}
+
+// Failed returns the number of issues that failed.
+func (r Result) Failed() int {
+	var n int
+	for _, cr := range r.Created {
+		if cr.Err != nil {
+			n++
+		}
+	}
+	for _, ur := range r.Updated {
+		if ur.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Apply creates and updates the planned issues. A failed issue does not stop
+// the others; children of a failed create are created without a parent.
+func Apply(executor appbeads.IssueExecutor, plan Plan) (Result, error) {
+	creator, ok := executor.(appbeads.IssueCreator)
+	if !ok && len(plan.Creates) > 0 {
+		return Result{}, appbeads.ErrCreateUnsupported
+	}
+
+	var result Result
+	ids := make(map[string]string, len(plan.Creates))
+	for _, planned := range plan.Creates {
+		cr := CreateResult{Row: planned.Row, Ref: planned.Ref}
+		opts := planned.Options
+		if planned.ParentRef != "" {
+			opts.ParentID = ids[planned.ParentRef]
+		}
+		created, err := creator.CreateIssue(opts)
+		if err != nil {
+			cr.Err = fmt.Errorf("creating issue: %w", err)
+			result.Created = append(result.Created, cr)
+			continue
+		}
+		cr.BeadsID = created.ID
+		if planned.Ref != "" {
+			ids[planned.Ref] = created.ID
+		}
+
+		// Status and notes are not create options
+		var update beads.UpdateIssueOptions
+		if planned.Status != beads.StatusOpen {
+			update.Status = &planned.Status
+		}
+		if planned.Notes != "" {
+			update.Notes = &planned.Notes
+		}
+		if update.Status != nil || update.Notes != nil {
+			if err := executor.UpdateIssue(created.ID, update); err != nil {
+				cr.Err = fmt.Errorf("setting status and notes: %w", err)
+			}
+		}
+		result.Created = append(result.Created, cr)
+	}
+
+	for _, planned := range plan.Updates {
+		result.Updated = append(result.Updated, UpdateResult{
+			Row: planned.Row,
+			ID:  planned.ID,
+			Err: executor.UpdateIssue(planned.ID, planned.Options),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,186 @@
+package issuefile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+var planExisting = map[string]beads.Issue{
+	"bd-1": {ID: "bd-1", TitleText: "Checkout", Type: beads.TypeEpic, Status: beads.StatusOpen, Priority: 1, Labels: []string{"web", "q3"}},
+	"bd-2": {ID: "bd-2", TitleText: "Docs", Type: beads.TypeTask, Status: beads.StatusOpen, Priority: 2, ParentID: "bd-1"},
+}
+
+// planRows are updates to both existing issues, a child listed before its new
+// parent, and rows that cannot be created.
+const planRows = `id,title,status,priority,labels,parent,notes
+bd-1,Checkout,in_progress,1,q3;web,,
+bd-2,Docs,,,,,
+new-2,Totals,closed,,,new-1,Check rounding
+new-1,Cart,,3,,bd-1,
+,Untitled child,,,,,
+,,,,,,
+,Orphan,,,,gone-1,
+loop-1,Loop,,,,loop-1,
+`
+
+func readPlanRows(t *testing.T) []Row {
+	t.Helper()
+	rows, rowErrs, err := Read(strings.NewReader(planRows), FormatCSV)
+	require.NoError(t, err)
+	require.Empty(t, rowErrs)
+	return rows
+}
+
+func TestBuildPlan(t *testing.T) {
+	plan := BuildPlan(readPlanRows(t), planExisting)
+
+	inProgress := beads.StatusInProgress
+	require.Equal(t, []PlannedUpdate{{
+		Row:     1,
+		ID:      "bd-1",
+		Options: beads.UpdateIssueOptions{Status: &inProgress},
+		Changed: []string{FieldStatus},
+	}}, plan.Updates, "labels in another order are unchanged")
+	require.Equal(t, []string{"bd-2"}, plan.Unchanged)
+
+	var refs []string
+	for _, pc := range plan.Creates {
+		refs = append(refs, pc.Ref)
+	}
+	require.Equal(t, []string{"new-1", "new-2", ""}, refs, "parents come first")
+	require.Equal(t, PlannedCreate{
+		Row:     4,
+		Ref:     "new-1",
+		Options: beads.CreateIssueOptions{Title: "Cart", Type: beads.TypeTask, Priority: 3, ParentID: "bd-1", Labels: []string{RefLabelPrefix + "new-1"}},
+		Status:  beads.StatusOpen,
+	}, plan.Creates[0])
+	require.Equal(t, "new-1", plan.Creates[1].ParentRef)
+	require.Equal(t, beads.StatusClosed, plan.Creates[1].Status)
+	require.Equal(t, "Check rounding", plan.Creates[1].Notes)
+
+	require.Equal(t, []string{"row 2: the parent of bd-2 cannot be changed by an import"}, plan.Warnings)
+	var messages []string
+	for _, rowErr := range plan.Errors {
+		messages = append(messages, rowErr.Error())
+	}
+	require.Equal(t, []string{
+		"row 6: title is required to create an issue",
+		"row 7: parent gone-1 does not exist",
+		"row 8: parent loop-1: loop-1 is its own ancestor",
+	}, messages)
+}
+
+func TestBuildPlan_ImportTwice(t *testing.T) {
+	const file = "id,title,labels,parent\nJIRA-1,Cart,web,\nJIRA-2,Totals,,JIRA-1\n"
+	rows, _, err := Read(strings.NewReader(file), FormatCSV)
+	require.NoError(t, err)
+
+	// The first import creates both rows, recording their row IDs
+	existing := map[string]beads.Issue{}
+	plan := BuildPlan(rows, existing)
+	require.Len(t, plan.Creates, 2)
+	require.Equal(t, []string{"web", RefLabelPrefix + "JIRA-1"}, plan.Creates[0].Options.Labels)
+	require.Equal(t, "JIRA-1", plan.Creates[1].ParentRef)
+	existing["bd-10"] = beads.Issue{ID: "bd-10", TitleText: "Cart", Labels: plan.Creates[0].Options.Labels}
+	existing["bd-11"] = beads.Issue{ID: "bd-11", TitleText: "Totals", ParentID: "bd-10", Labels: plan.Creates[1].Options.Labels}
+
+	// The second import finds them by their row IDs
+	plan = BuildPlan(rows, existing)
+	require.Empty(t, plan.Creates)
+	require.Empty(t, plan.Updates)
+	require.Empty(t, plan.Warnings)
+	require.ElementsMatch(t, []string{"bd-10", "bd-11"}, plan.Unchanged)
+
+	// Changes update the created issues and keep their ref labels
+	rows, _, err = Read(strings.NewReader("id,title,labels\nJIRA-1,Cart v2,web;q3\n"), FormatCSV)
+	require.NoError(t, err)
+	plan = BuildPlan(rows, existing)
+	require.Empty(t, plan.Creates)
+	require.Len(t, plan.Updates, 1)
+	require.Equal(t, "bd-10", plan.Updates[0].ID)
+	require.Equal(t, []string{"web", "q3", RefLabelPrefix + "JIRA-1"}, *plan.Updates[0].Options.Labels)
+}
+
+func TestBuildPlan_EmptyTitle(t *testing.T) {
+	rows, _, err := Read(strings.NewReader("id,title\nbd-1,\n"), FormatCSV)
+	require.NoError(t, err)
+	plan := BuildPlan(rows, planExisting)
+	require.Empty(t, plan.Updates)
+	require.EqualError(t, plan.Errors[0], "row 1: title cannot be empty")
+}
+
+func TestBuildPlan_DuplicateIDs(t *testing.T) {
+	rows, _, err := Read(strings.NewReader("id,title\nnew-1,Cart\nbd-1,Checkout\nnew-1,Basket\nbd-1,Checkout v2\n"), FormatCSV)
+	require.NoError(t, err)
+	plan := BuildPlan(rows, planExisting)
+
+	require.Len(t, plan.Creates, 1)
+	require.Equal(t, "Cart", plan.Creates[0].Options.Title, "the first row wins")
+	require.Empty(t, plan.Updates)
+	require.Equal(t, []string{"bd-1"}, plan.Unchanged)
+	var messages []string
+	for _, rowErr := range plan.Errors {
+		messages = append(messages, rowErr.Error())
+	}
+	require.Equal(t, []string{
+		"row 3: id new-1 is already used by row 1",
+		"row 4: id bd-1 is already used by row 2",
+	}, messages)
+}
+
+// createExecutor adds an IssueCreator implementation to the IssueExecutor mock.
+type createExecutor struct {
+	*mocks.MockIssueExecutor
+	create func(opts beads.CreateIssueOptions) (beads.CreateResult, error)
+}
+
+func (e createExecutor) CreateIssue(opts beads.CreateIssueOptions) (beads.CreateResult, error) {
+	return e.create(opts)
+}
+
+func TestApply(t *testing.T) {
+	plan := BuildPlan(readPlanRows(t), planExisting)
+
+	issueMock := mocks.NewMockIssueExecutor(t)
+	inProgress, closed, notes := beads.StatusInProgress, beads.StatusClosed, "Check rounding"
+	issueMock.EXPECT().UpdateIssue("bd-1", beads.UpdateIssueOptions{Status: &inProgress}).Return(errors.New("bd failed"))
+	issueMock.EXPECT().UpdateIssue("bd-11", beads.UpdateIssueOptions{Status: &closed, Notes: &notes}).Return(nil)
+	var parents []string
+	executor := createExecutor{
+		MockIssueExecutor: issueMock,
+		create: func(opts beads.CreateIssueOptions) (beads.CreateResult, error) {
+			if opts.Title == "Untitled child" {
+				return beads.CreateResult{}, errors.New("bd failed")
+			}
+			parents = append(parents, opts.ParentID)
+			return beads.CreateResult{ID: fmt.Sprintf("bd-%d", 9+len(parents))}, nil
+		},
+	}
+
+	result, err := Apply(executor, plan)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bd-1", "bd-10"}, parents)
+	require.Equal(t, []CreateResult{
+		{Row: 4, Ref: "new-1", BeadsID: "bd-10"},
+		{Row: 3, Ref: "new-2", BeadsID: "bd-11"},
+	}, result.Created[:2])
+	require.EqualError(t, result.Created[2].Err, "creating issue: bd failed")
+	require.EqualError(t, result.Updated[0].Err, "bd failed")
+	require.Equal(t, 2, result.Failed())
+}
+
+func TestApply_RequiresIssueCreator(t *testing.T) {
+	_, err := Apply(mocks.NewMockIssueExecutor(t), Plan{Creates: []PlannedCreate{{Row: 1}}})
+	require.ErrorIs(t, err, appbeads.ErrCreateUnsupported)
+
+	_, err = Apply(mocks.NewMockIssueExecutor(t), Plan{Unchanged: []string{"bd-1"}})
+	require.NoError(t, err, "updates alone do not create issues")
+}
@@ -0,0 +1,260 @@
+package issuefile
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// validTypes and validStatuses are the values an import accepts.
+var (
+	validTypes = []beads.IssueType{
+		beads.TypeBug, beads.TypeFeature, beads.TypeTask, beads.TypeEpic, beads.TypeChore,
+		beads.TypeMolecule, beads.TypeConvoy, beads.TypeAgent,
+	}
+	validStatuses = []beads.Status{
+		beads.StatusOpen, beads.StatusInProgress, beads.StatusBlocked, beads.StatusDeferred, beads.StatusClosed,
+	}
+)
+
+// Row is one issue read from a file. Only the fields present in the row are
+// set; Has tells them apart from empty values.
+type Row struct {
+	// Number is the 1-based position of the row among the file's issues.
+	Number      int
+	ID          string
+	Title       string
+	Description string
+	Type        beads.IssueType
+	Status      beads.Status
+	Priority    beads.Priority
+	Assignee    string
+	Labels      []string
+	Parent      string
+	Notes       string
+
+	present map[string]bool
+}
+
+// Has reports whether the row sets field.
+func (r Row) Has(field string) bool {
+	return r.present[field]
+}
+
+// RowError is a problem with one row of a file.
+type RowError struct {
+	Number int
+	Err    error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("row %d: %v", e.Number, e.Err)
+}
+
+// Read parses the issues of a file. Rows that fail validation are reported
+// as RowErrors and left out of the rows; an error is returned only when the
+// file itself cannot be read. The read-only timestamp fields are ignored.
+func Read(r io.Reader, format Format) ([]Row, []RowError, error) {
+	var rows []Row
+	var rowErrs []RowError
+	add := func(row Row, err error) {
+		if err != nil {
+			rowErrs = append(rowErrs, RowError{Number: row.Number, Err: err})
+			return
+		}
+		rows = append(rows, row)
+	}
+
+	switch format {
+	case FormatCSV:
+		if err := readCSV(r, add); err != nil {
+			return nil, nil, err
+		}
+	case FormatJSON:
+		if err := readJSON(r, add); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown format %q: expected csv or json", format)
+	}
+
+	return rows, rowErrs, nil
+}
+
+func readCSV(r io.Reader, add func(Row, error)) error {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return errors.New("file is empty")
+	}
+	if err != nil {
+		return fmt.Errorf("reading header: %w", err)
+	}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if !slices.Contains(Fields, name) {
+			return fmt.Errorf("unknown column %q: expected one of %s", name, strings.Join(Fields, ", "))
+		}
+		if slices.Contains(header[:i], name) {
+			return fmt.Errorf("column %q is listed twice", name)
+		}
+		header[i] = name
+	}
+
+	for number := 1; ; number++ {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		row := Row{Number: number, present: make(map[string]bool)}
+		if err != nil {
+			// Wrong field counts are per-row; broken quoting loses sync with the rest of the file
+			if !errors.Is(err, csv.ErrFieldCount) {
+				return err
+			}
+			add(row, errors.New("wrong number of columns"))
+			continue
+		}
+		add(row, parseCSVRecord(&row, header, record))
+	}
+}
+
+func parseCSVRecord(row *Row, header, record []string) error {
+	for i, field := range header {
+		value := strings.TrimSpace(record[i])
+		if field == FieldLabels {
+			row.Labels = splitLabels(value)
+			row.present[field] = true
+			continue
+		}
+		// An empty type, status or priority keeps the default or current value
+		if value == "" && (field == FieldType || field == FieldStatus || field == FieldPriority) {
+			continue
+		}
+		if err := row.set(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readJSON(r io.Reader, add func(Row, error)) error {
+	var objects []map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&objects); err != nil {
+		return fmt.Errorf("parsing json: expected an array of issue objects: %w", err)
+	}
+	for i, object := range objects {
+		row := Row{Number: i + 1, present: make(map[string]bool)}
+		add(row, parseJSONObject(&row, object))
+	}
+	return nil
+}
+
+func parseJSONObject(row *Row, object map[string]json.RawMessage) error {
+	// Sorted so the first error of a row does not depend on map order
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		raw := object[key]
+		field := strings.ToLower(key)
+		if !slices.Contains(Fields, field) {
+			return fmt.Errorf("unknown field %q", key)
+		}
+		if string(raw) == "null" {
+			continue
+		}
+		switch field {
+		case FieldLabels:
+			var labels []string
+			if err := json.Unmarshal(raw, &labels); err != nil {
+				var joined string
+				if json.Unmarshal(raw, &joined) != nil {
+					return errors.New("labels must be an array of strings")
+				}
+				labels = splitLabels(joined)
+			}
+			row.Labels = labels
+			row.present[field] = true
+		case FieldPriority:
+			var n int
+			if err := json.Unmarshal(raw, &n); err == nil {
+				if err := row.set(field, strconv.Itoa(n)); err != nil {
+					return err
+				}
+				continue
+			}
+			fallthrough
+		default:
+			var value string
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return fmt.Errorf("%s must be a string", field)
+			}
+			if err := row.set(field, strings.TrimSpace(value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// set validates and stores a single-valued field.
+func (r *Row) set(field, value string) error {
+	switch field {
+	case FieldID:
+		r.ID = value
+	case FieldTitle:
+		r.Title = value
+	case FieldDescription:
+		r.Description = value
+	case FieldType:
+		t := beads.IssueType(strings.ToLower(value))
+		if !slices.Contains(validTypes, t) {
+			return fmt.Errorf("invalid type %q", value)
+		}
+		r.Type = t
+	case FieldStatus:
+		s := beads.Status(strings.ToLower(value))
+		if !slices.Contains(validStatuses, s) {
+			return fmt.Errorf("invalid status %q", value)
+		}
+		r.Status = s
+	case FieldPriority:
+		p, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(value), "P"))
+		if err != nil || p < 0 || p > 4 {
+			return fmt.Errorf("invalid priority %q: expected 0-4", value)
+		}
+		r.Priority = beads.Priority(p)
+	case FieldAssignee:
+		r.Assignee = value
+	case FieldParent:
+		r.Parent = value
+	case FieldNotes:
+		r.Notes = value
+	default:
+		// Timestamps are exported for reference; beads sets them
+		return nil
+	}
+	r.present[field] = true
+	return nil
+}
+
+func splitLabels(s string) []string {
+	labels := []string{}
+	for label := range strings.SplitSeq(s, labelSeparator) {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,77 @@
+package issuefile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+func TestRead_CSV(t *testing.T) {
+	rows, rowErrs, err := Read(strings.NewReader(`ID,Title,Type,Priority,Status,Labels,created_at
+bd-1,Checkout,epic,P1,,web; size:l ,2026-03-01T09:00:00Z
+,Cart,story,2,open,,
+,Totals,task,9,open,,
+bd-1,Again,task,2,open,,
+,Too,few
+`), FormatCSV)
+	require.NoError(t, err)
+
+	require.Len(t, rows, 2, "BuildPlan reports the repeated bd-1")
+	require.Equal(t, "bd-1", rows[0].ID)
+	require.Equal(t, beads.TypeEpic, rows[0].Type)
+	require.Equal(t, beads.Priority(1), rows[0].Priority)
+	require.Equal(t, []string{"web", "size:l"}, rows[0].Labels)
+	require.False(t, rows[0].Has(FieldStatus), "an empty status is left unset")
+	require.True(t, rows[0].Has(FieldLabels))
+	require.False(t, rows[0].Has(FieldCreatedAt), "timestamps are not imported")
+
+	var messages []string
+	for _, rowErr := range rowErrs {
+		messages = append(messages, rowErr.Error())
+	}
+	require.Equal(t, []string{
+		`row 2: invalid type "story"`,
+		`row 3: invalid priority "9": expected 0-4`,
+		"row 5: wrong number of columns",
+	}, messages)
+}
+
+func TestRead_CSV_FileErrors(t *testing.T) {
+	_, _, err := Read(strings.NewReader(""), FormatCSV)
+	require.EqualError(t, err, "file is empty")
+
+	_, _, err = Read(strings.NewReader("id,estimate\n"), FormatCSV)
+	require.ErrorContains(t, err, `unknown column "estimate"`)
+
+	_, _, err = Read(strings.NewReader("id,title,ID\n"), FormatCSV)
+	require.EqualError(t, err, `column "id" is listed twice`)
+}
+
+func TestRead_JSON(t *testing.T) {
+	rows, rowErrs, err := Read(strings.NewReader(`[
+		{"id": "bd-1", "title": "Checkout", "priority": 1, "labels": ["web"], "assignee": null},
+		{"title": "Cart", "priority": "P3", "labels": "a;b", "status": "IN_PROGRESS"},
+		{"title": "Totals", "estimate": 3},
+		{"title": 42},
+		{"status": "done"}
+	]`), FormatJSON)
+	require.NoError(t, err)
+
+	require.Len(t, rows, 2)
+	require.Equal(t, beads.Priority(1), rows[0].Priority)
+	require.False(t, rows[0].Has(FieldAssignee), "null leaves a field unset")
+	require.Equal(t, beads.Priority(3), rows[1].Priority)
+	require.Equal(t, []string{"a", "b"}, rows[1].Labels)
+	require.Equal(t, beads.StatusInProgress, rows[1].Status)
+
+	require.Len(t, rowErrs, 3)
+	require.EqualError(t, rowErrs[0], `row 3: unknown field "estimate"`)
+	require.EqualError(t, rowErrs[1], "row 4: title must be a string")
+	require.EqualError(t, rowErrs[2], `row 5: invalid status "done"`)
+
+	_, _, err = Read(strings.NewReader(`{"id": "bd-1"}`), FormatJSON)
+	require.ErrorContains(t, err, "expected an array of issue objects")
+}
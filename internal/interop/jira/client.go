@@ -0,0 +1,156 @@
+// Package jira imports Jira issues into beads.
+//
+// Issues are read from the Jira REST API or from a JSON export and turned into
+// a Plan: epics, stories and subtasks become beads issues with their parent
+// links, and "Blocks" issue links become dependencies. The plan can be printed
+// for review before Apply writes it. Imported issues carry a "jira:<KEY>"
+// label, so importing the same issues again skips them.
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Issue is a Jira issue as seen by the import.
+type Issue struct {
+	Key         string
+	Summary     string
+	Description string
+	Type        string // Issue type name, e.g. "Story"
+	Subtask     bool
+	Priority    string // Priority name, e.g. "High"
+	// StatusCategory is the category of the status: "new", "indeterminate"
+	// or "done".
+	StatusCategory string
+	Labels         []string
+	ParentKey      string   // Parent issue or epic
+	Blocks         []string // Keys of the issues this issue blocks
+	BlockedBy      []string // Keys of the issues blocking this issue
+}
+
+// Client searches the issues of a Jira site.
+type Client struct {
+	baseURL string
+	email   string
+	token   string
+	// epicLinkField is the custom field holding the epic key, if any.
+	epicLinkField string
+	http          *http.Client
+}
+
+// searchPageSize is the number of issues requested per page.
+const searchPageSize = 100
+
+// NewClient creates a client for the Jira site at baseURL. With an email the
+// token is sent as a Jira Cloud API token, otherwise as a personal access
+// token (Server/Data Center). epicLinkField may be empty.
+func NewClient(baseURL, email, token, epicLinkField string) *Client {
+	return &Client{
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		email:         email,
+		token:         token,
+		epicLinkField: epicLinkField,
+		http:          &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// searchFields are the issue fields requested from the search API.
+var searchFields = []string{"summary", "description", "issuetype", "priority", "status", "labels", "parent", "issuelinks"}
+
+// Search returns all issues matching jql.
+func (c *Client) Search(jql string) ([]Issue, error) {
+	fields := searchFields
+	if c.epicLinkField != "" {
+		fields = append(fields[:len(fields):len(fields)], c.epicLinkField)
+	}
+
+	var issues []Issue
+	for startAt := 0; ; {
+		query := url.Values{
+			"jql":        {jql},
+			"startAt":    {fmt.Sprint(startAt)},
+			"maxResults": {fmt.Sprint(searchPageSize)},
+			"fields":     {strings.Join(fields, ",")},
+		}
+		var page searchResponse
+		if err := c.get("/rest/api/2/search?"+query.Encode(), &page); err != nil {
+			return nil, fmt.Errorf("searching Jira: %w", err)
+		}
+		for _, raw := range page.Issues {
+			issues = append(issues, raw.issue(c.epicLinkField))
+		}
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return issues, nil
+		}
+	}
+}
+
+// get sends a GET request to path and decodes the JSON response into out.
+func (c *Client) get(path string, out any) error {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.email != "" {
+		req.SetBasicAuth(c.email, c.token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			ErrorMessages []string `json:"errorMessages"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		msg := strings.Join(apiErr.ErrorMessages, "; ")
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+		return fmt.Errorf("Jira API: %d %s", resp.StatusCode, msg)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// ReadExport reads issues exported from Jira as JSON: either a saved search
+// API response ({"issues": [...]}) or a plain array of issues.
+func ReadExport(r io.Reader, epicLinkField string) ([]Issue, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading Jira export: %w", err)
+	}
+
+	var raws []rawIssue
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		err = json.Unmarshal(data, &raws)
+	} else {
+		var page searchResponse
+		err = json.Unmarshal(data, &page)
+		raws = page.Issues
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing Jira export: %w", err)
+	}
+
+	issues := make([]Issue, 0, len(raws))
+	for _, raw := range raws {
+		if raw.Key == "" {
+			return nil, fmt.Errorf("parsing Jira export: issue without a key")
+		}
+		issues = append(issues, raw.issue(epicLinkField))
+	}
+	return issues, nil
+}
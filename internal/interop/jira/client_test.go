@@ -0,0 +1,107 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// issueJSON returns an issue as the search API represents it.
+func issueJSON(key, fields string) string {
+	return fmt.Sprintf(`{"key": %q, "fields": {%s}}`, key, fields)
+}
+
+func TestClient_Search(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/rest/api/2/search", r.URL.Path)
+		require.Equal(t, "project = SHOP", r.URL.Query().Get("jql"))
+		require.Contains(t, r.URL.Query().Get("fields"), "customfield_10014")
+		user, pass, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "me@acme.com:secret", user+":"+pass)
+
+		// Two pages of one issue each
+		if r.URL.Query().Get("startAt") == "0" {
+			_, _ = fmt.Fprintf(w, `{"total": 2, "issues": [%s]}`, issueJSON("SHOP-1", `"summary": "Checkout", "issuetype": {"name": "Epic"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"total": 2, "issues": [%s]}`, issueJSON("SHOP-2", `"summary": "Cart", "customfield_10014": "SHOP-1"`))
+	}))
+	defer server.Close()
+
+	issues, err := NewClient(server.URL+"/", "me@acme.com", "secret", "customfield_10014").Search("project = SHOP")
+	require.NoError(t, err)
+	require.Equal(t, []Issue{
+		{Key: "SHOP-1", Summary: "Checkout", Type: "Epic"},
+		{Key: "SHOP-2", Summary: "Cart", ParentKey: "SHOP-1"},
+	}, issues)
+}
+
+func TestClient_SearchError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer pat", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errorMessages": ["The value 'NOPE' does not exist for the field 'project'."]}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "", "pat", "").Search("project = NOPE")
+	require.EqualError(t, err, "searching Jira: Jira API: 400 The value 'NOPE' does not exist for the field 'project'.")
+}
+
+func TestReadExport(t *testing.T) {
+	story := issueJSON("SHOP-2", `
+		"summary": "Cart page",
+		"description": "Show the cart",
+		"issuetype": {"name": "Story", "subtask": false},
+		"priority": {"name": "High"},
+		"status": {"statusCategory": {"key": "indeterminate"}},
+		"labels": ["web"],
+		"parent": {"key": "SHOP-1"},
+		"issuelinks": [
+			{"type": {"name": "Blocks"}, "outwardIssue": {"key": "SHOP-3"}},
+			{"type": {"name": "Blocks"}, "inwardIssue": {"key": "SHOP-4"}},
+			{"type": {"name": "Relates"}, "outwardIssue": {"key": "SHOP-5"}}
+		]`)
+	want := Issue{
+		Key:            "SHOP-2",
+		Summary:        "Cart page",
+		Description:    "Show the cart",
+		Type:           "Story",
+		Priority:       "High",
+		StatusCategory: "indeterminate",
+		Labels:         []string{"web"},
+		ParentKey:      "SHOP-1",
+		Blocks:         []string{"SHOP-3"},
+		BlockedBy:      []string{"SHOP-4"},
+	}
+
+	issues, err := ReadExport(strings.NewReader(`{"issues": [`+story+`]}`), "")
+	require.NoError(t, err)
+	require.Equal(t, []Issue{want}, issues)
+
+	issues, err = ReadExport(strings.NewReader(` [`+story+`]`), "")
+	require.NoError(t, err)
+	require.Equal(t, []Issue{want}, issues)
+
+	_, err = ReadExport(strings.NewReader(`{"issues": [{"fields": {}}]}`), "")
+	require.EqualError(t, err, "parsing Jira export: issue without a key")
+	_, err = ReadExport(strings.NewReader(`{`), "")
+	require.ErrorContains(t, err, "parsing Jira export")
+}
+
+func TestDescriptionText_ADF(t *testing.T) {
+	adf := `{"type": "doc", "content": [
+		{"type": "paragraph", "content": [{"type": "text", "text": "Show the "}, {"type": "text", "text": "cart"}]},
+		{"type": "bulletList", "content": [
+			{"type": "listItem", "content": [{"type": "paragraph", "content": [{"type": "text", "text": "totals"}]}]}
+		]}
+	]}`
+	require.Equal(t, "Show the cart\ntotals", descriptionText([]byte(adf)))
+	require.Equal(t, "plain", descriptionText([]byte(`"plain"`)))
+	require.Empty(t, descriptionText([]byte(`null`)))
+}
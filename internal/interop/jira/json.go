@@ -0,0 +1,134 @@
+package jira
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// searchResponse is a page of the search API.
+type searchResponse struct {
+	Total  int        `json:"total"`
+	Issues []rawIssue `json:"issues"`
+}
+
+// rawIssue is the REST API representation of an issue.
+type rawIssue struct {
+	Key    string          `json:"key"`
+	Fields json.RawMessage `json:"fields"`
+}
+
+type rawFields struct {
+	Summary     string          `json:"summary"`
+	Description json.RawMessage `json:"description"`
+	IssueType   struct {
+		Name    string `json:"name"`
+		Subtask bool   `json:"subtask"`
+	} `json:"issuetype"`
+	Priority *struct {
+		Name string `json:"name"`
+	} `json:"priority"`
+	Status struct {
+		StatusCategory struct {
+			Key string `json:"key"`
+		} `json:"statusCategory"`
+	} `json:"status"`
+	Labels []string `json:"labels"`
+	Parent *struct {
+		Key string `json:"key"`
+	} `json:"parent"`
+	IssueLinks []struct {
+		Type struct {
+			Name string `json:"name"`
+		} `json:"type"`
+		InwardIssue *struct {
+			Key string `json:"key"`
+		} `json:"inwardIssue"`
+		OutwardIssue *struct {
+			Key string `json:"key"`
+		} `json:"outwardIssue"`
+	} `json:"issuelinks"`
+}
+
+// issue converts the API representation. Fields that fail to decode are left
+// empty rather than failing the import.
+func (r rawIssue) issue(epicLinkField string) Issue {
+	issue := Issue{Key: r.Key}
+	var f rawFields
+	if err := json.Unmarshal(r.Fields, &f); err != nil {
+		return issue
+	}
+
+	issue.Summary = f.Summary
+	issue.Description = descriptionText(f.Description)
+	issue.Type = f.IssueType.Name
+	issue.Subtask = f.IssueType.Subtask
+	if f.Priority != nil {
+		issue.Priority = f.Priority.Name
+	}
+	issue.StatusCategory = f.Status.StatusCategory.Key
+	issue.Labels = f.Labels
+	if f.Parent != nil {
+		issue.ParentKey = f.Parent.Key
+	}
+	if issue.ParentKey == "" && epicLinkField != "" {
+		var custom map[string]json.RawMessage
+		if json.Unmarshal(r.Fields, &custom) == nil {
+			_ = json.Unmarshal(custom[epicLinkField], &issue.ParentKey)
+		}
+	}
+
+	// A "Blocks" link reads "outward blocks inward": the issue on the outward
+	// side of the link is blocked by this one, the inward side blocks it.
+	for _, link := range f.IssueLinks {
+		if !strings.EqualFold(link.Type.Name, "Blocks") {
+			continue
+		}
+		if link.OutwardIssue != nil {
+			issue.Blocks = append(issue.Blocks, link.OutwardIssue.Key)
+		}
+		if link.InwardIssue != nil {
+			issue.BlockedBy = append(issue.BlockedBy, link.InwardIssue.Key)
+		}
+	}
+	return issue
+}
+
+// adfNode is a node of an Atlassian Document Format document, which API v3
+// and newer exports use for descriptions.
+type adfNode struct {
+	Type    string    `json:"type"`
+	Text    string    `json:"text"`
+	Content []adfNode `json:"content"`
+}
+
+// blockNodes end a line of text.
+var blockNodes = map[string]bool{
+	"paragraph": true, "heading": true, "listItem": true, "codeBlock": true,
+	"blockquote": true, "rule": true, "hardBreak": true,
+}
+
+// descriptionText returns a description as plain text, whether it is a string
+// or an ADF document.
+func descriptionText(raw json.RawMessage) string {
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return text
+	}
+	var doc adfNode
+	if json.Unmarshal(raw, &doc) != nil {
+		return ""
+	}
+	var b strings.Builder
+	var walk func(n adfNode)
+	walk = func(n adfNode) {
+		b.WriteString(n.Text)
+		for _, child := range n.Content {
+			walk(child)
+		}
+		if blockNodes[n.Type] && !strings.HasSuffix(b.String(), "\n") {
+			b.WriteString("\n")
+		}
+	}
+	walk(doc)
+	return strings.TrimSpace(b.String())
+}
@@ -0,0 +1,335 @@
+package jira
+
+import (
+	"fmt"
+	"strings"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+)
+
+// KeyLabelPrefix prefixes the label recording the Jira key of an imported issue.
+const KeyLabelPrefix = "jira:"
+
+// KeyLabel returns the label recording the Jira key of an imported issue.
+func KeyLabel(key string) string {
+	return KeyLabelPrefix + key
+}
+
+// ImportedQuery is a BQL query returning the issues ImportedKeys needs. It
+// also returns all archived issues, so archived imports are not recreated.
+var ImportedQuery = fmt.Sprintf("label ~ %q or label = %s", KeyLabelPrefix, beads.ArchivedLabel)
+
+// ImportedKeys maps the Jira keys of previously imported issues to their
+// beads IDs.
+func ImportedKeys(issues []beads.Issue) map[string]string {
+	keys := make(map[string]string)
+	for _, issue := range issues {
+		for _, label := range issue.Labels {
+			if key, ok := strings.CutPrefix(label, KeyLabelPrefix); ok && key != "" {
+				keys[key] = issue.ID
+			}
+		}
+	}
+	return keys
+}
+
+// defaultPriority is the beads priority of issues with an unknown priority.
+const defaultPriority = beads.Priority(2)
+
+// defaultTypes maps the standard Jira issue types to beads types.
+var defaultTypes = map[string]beads.IssueType{
+	"epic":        beads.TypeEpic,
+	"story":       beads.TypeFeature,
+	"new feature": beads.TypeFeature,
+	"improvement": beads.TypeFeature,
+	"bug":         beads.TypeBug,
+	"task":        beads.TypeTask,
+	"sub-task":    beads.TypeTask,
+	"subtask":     beads.TypeTask,
+}
+
+// defaultPriorities maps the standard Jira priorities, current and classic,
+// to beads priorities.
+var defaultPriorities = map[string]beads.Priority{
+	"highest": 0, "blocker": 0,
+	"high": 1, "critical": 1,
+	"medium": 2, "major": 2,
+	"low": 3, "minor": 3,
+	"lowest": 4, "trivial": 4,
+}
+
+// Mapping translates Jira issue types and priorities to beads. Names are
+// matched case-insensitively.
+type Mapping struct {
+	types      map[string]beads.IssueType
+	priorities map[string]beads.Priority
+}
+
+// NewMapping creates a mapping from the jira config section, on top of the
+// defaults for the standard Jira types and priorities.
+func NewMapping(cfg config.JiraConfig) Mapping {
+	m := Mapping{
+		types:      make(map[string]beads.IssueType, len(defaultTypes)+len(cfg.Types)),
+		priorities: make(map[string]beads.Priority, len(defaultPriorities)+len(cfg.Priorities)),
+	}
+	for name, t := range defaultTypes {
+		m.types[name] = t
+	}
+	for name, p := range defaultPriorities {
+		m.priorities[name] = p
+	}
+	for name, t := range cfg.Types {
+		m.types[strings.ToLower(name)] = beads.IssueType(t)
+	}
+	for name, p := range cfg.Priorities {
+		m.priorities[strings.ToLower(name)] = beads.Priority(p)
+	}
+	return m
+}
+
+// Type returns the beads type of a Jira issue; unknown types become tasks.
+func (m Mapping) Type(issue Issue) beads.IssueType {
+	if t, ok := m.types[strings.ToLower(issue.Type)]; ok {
+		return t
+	}
+	return beads.TypeTask
+}
+
+// Priority returns the beads priority of a Jira issue; unknown priorities
+// become P2.
+func (m Mapping) Priority(issue Issue) beads.Priority {
+	if p, ok := m.priorities[strings.ToLower(issue.Priority)]; ok {
+		return p
+	}
+	return defaultPriority
+}
+
+// Status returns the beads status of a Jira issue from its status category.
+func Status(issue Issue) beads.Status {
+	switch issue.StatusCategory {
+	case "done":
+		return beads.StatusClosed
+	case "indeterminate":
+		return beads.StatusInProgress
+	default:
+		return beads.StatusOpen
+	}
+}
+
+// PlannedIssue is a Jira issue to create in beads.
+type PlannedIssue struct {
+	Key         string
+	Title       string
+	Description string
+	Type        beads.IssueType
+	Priority    beads.Priority
+	Status      beads.Status
+	Labels      []string
+	// ParentKey is the Jira key of the parent, empty when the parent is
+	// neither planned nor imported before.
+	ParentKey string
+}
+
+// Dependency records that the issue Key is blocked by the issue DependsOn.
+type Dependency struct {
+	Key       string
+	DependsOn string
+}
+
+// Plan is what an import will write.
+type Plan struct {
+	// Issues to create, parents before their children.
+	Issues []PlannedIssue
+	// Dependencies to add between planned and previously imported issues.
+	Dependencies []Dependency
+	// Skipped lists the keys of issues that were imported before.
+	Skipped []string
+	// Existing maps the keys of previously imported issues to their beads
+	// IDs, for linking new issues to them.
+	Existing map[string]string
+	// Warnings describe links that cannot be imported.
+	Warnings []string
+}
+
+// BuildPlan plans the import of issues. existing maps the keys of previously
+// imported issues to beads IDs (see ImportedKeys); they are not created again,
+// but new issues are still linked to them.
+func BuildPlan(issues []Issue, m Mapping, existing map[string]string) Plan {
+	plan := Plan{Existing: existing}
+	byKey := make(map[string]Issue, len(issues))
+	for _, issue := range issues {
+		byKey[issue.Key] = issue
+	}
+	known := func(key string) bool {
+		_, planned := byKey[key]
+		_, imported := existing[key]
+		return planned || imported
+	}
+	warn := func(format string, args ...any) {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(format, args...))
+	}
+
+	// Parents are created first so children can point at them
+	visited := make(map[string]bool, len(issues))
+	var visit func(issue Issue)
+	visit = func(issue Issue) {
+		if visited[issue.Key] {
+			return
+		}
+		visited[issue.Key] = true
+		if _, ok := existing[issue.Key]; ok {
+			plan.Skipped = append(plan.Skipped, issue.Key)
+			return
+		}
+		parentKey := issue.ParentKey
+		if parent, ok := byKey[parentKey]; ok {
+			visit(parent)
+		} else if parentKey != "" && !known(parentKey) {
+			warn("%s: parent %s is not part of the import", issue.Key, parentKey)
+			parentKey = ""
+		}
+		plan.Issues = append(plan.Issues, PlannedIssue{
+			Key:         issue.Key,
+			Title:       issue.Summary,
+			Description: issue.Description,
+			Type:        m.Type(issue),
+			Priority:    m.Priority(issue),
+			Status:      Status(issue),
+			Labels:      append(append([]string{}, issue.Labels...), KeyLabel(issue.Key)),
+			ParentKey:   parentKey,
+		})
+	}
+	for _, issue := range issues {
+		visit(issue)
+	}
+
+	// Both ends of a link list it, so collect each edge once
+	seen := make(map[Dependency]bool)
+	addDep := func(dep Dependency) {
+		if seen[dep] {
+			return
+		}
+		seen[dep] = true
+		_, keyImported := existing[dep.Key]
+		_, onImported := existing[dep.DependsOn]
+		switch {
+		case !known(dep.Key):
+			warn("%s blocks %s, which is not part of the import", dep.DependsOn, dep.Key)
+		case !known(dep.DependsOn):
+			warn("%s is blocked by %s, which is not part of the import", dep.Key, dep.DependsOn)
+		case keyImported && onImported:
+			// Added when the issues were imported
+		default:
+			plan.Dependencies = append(plan.Dependencies, dep)
+		}
+	}
+	for _, issue := range issues {
+		for _, blocked := range issue.Blocks {
+			addDep(Dependency{Key: blocked, DependsOn: issue.Key})
+		}
+		for _, blocker := range issue.BlockedBy {
+			addDep(Dependency{Key: issue.Key, DependsOn: blocker})
+		}
+	}
+	return plan
+}
+
+// IssueResult is the outcome of importing one issue.
+type IssueResult struct {
+	Key     string
+	BeadsID string // Set once the issue is created, even if a later step failed
+	Err     error
+}
+
+// DependencyResult is the outcome of adding one dependency.
+type DependencyResult struct {
+	Dependency
+	Err error
+}
+
+// Result is the outcome of an import.
+type Result struct {
+	Issues       []IssueResult
+	Dependencies []DependencyResult
+}
+
+// Failed returns the number of issues and dependencies that failed.
+func (r Result) Failed() int {
+	var n int
+	for _, ir := range r.Issues {
+		if ir.Err != nil {
+			n++
+		}
+	}
+	for _, dr := range r.Dependencies {
+		if dr.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Apply creates the planned issues and dependencies. A failed issue does not
+// stop the others; its children are created without a parent and its
+// dependencies fail.
+func Apply(executor appbeads.IssueExecutor, plan Plan) (Result, error) {
+	creator, ok := executor.(appbeads.IssueCreator)
+	if !ok {
+		return Result{}, appbeads.ErrCreateUnsupported
+	}
+
+	ids := make(map[string]string, len(plan.Existing)+len(plan.Issues))
+	for key, id := range plan.Existing {
+		ids[key] = id
+	}
+
+	var result Result
+	for _, planned := range plan.Issues {
+		ir := IssueResult{Key: planned.Key}
+		created, err := creator.CreateIssue(beads.CreateIssueOptions{
+			Title:       planned.Title,
+			Description: planned.Description,
+			Type:        planned.Type,
+			Priority:    planned.Priority,
+			ParentID:    ids[planned.ParentKey],
+			Labels:      planned.Labels,
+		})
+		if err != nil {
+			ir.Err = fmt.Errorf("creating issue: %w", err)
+			result.Issues = append(result.Issues, ir)
+			continue
+		}
+		ir.BeadsID = created.ID
+		ids[planned.Key] = created.ID
+
+		switch planned.Status {
+		case beads.StatusClosed:
+			if err := executor.CloseIssue(created.ID, fmt.Sprintf("Done in Jira (%s)", planned.Key)); err != nil {
+				ir.Err = fmt.Errorf("closing issue: %w", err)
+			}
+		case beads.StatusInProgress:
+			status := beads.StatusInProgress
+			if err := executor.UpdateIssue(created.ID, beads.UpdateIssueOptions{Status: &status}); err != nil {
+				ir.Err = fmt.Errorf("setting status: %w", err)
+			}
+		}
+		result.Issues = append(result.Issues, ir)
+	}
+
+	for _, dep := range plan.Dependencies {
+		dr := DependencyResult{Dependency: dep}
+		id, dependsOnID := ids[dep.Key], ids[dep.DependsOn]
+		switch {
+		case id == "":
+			dr.Err = fmt.Errorf("%s was not imported", dep.Key)
+		case dependsOnID == "":
+			dr.Err = fmt.Errorf("%s was not imported", dep.DependsOn)
+		default:
+			dr.Err = executor.AddDependency(id, dependsOnID)
+		}
+		result.Dependencies = append(result.Dependencies, dr)
+	}
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package jira
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+func TestMapping(t *testing.T) {
+	m := NewMapping(config.JiraConfig{
+		Types:      map[string]string{"Spike": "chore", "story": "task"},
+		Priorities: map[string]int{"P1": 0},
+	})
+	require.Equal(t, beads.TypeChore, m.Type(Issue{Type: "spike"}))
+	require.Equal(t, beads.TypeTask, m.Type(Issue{Type: "Story"}), "config overrides defaults")
+	require.Equal(t, beads.TypeEpic, m.Type(Issue{Type: "Epic"}))
+	require.Equal(t, beads.TypeTask, m.Type(Issue{Type: "Unknown"}))
+	require.Equal(t, beads.Priority(0), m.Priority(Issue{Priority: "p1"}))
+	require.Equal(t, beads.Priority(3), m.Priority(Issue{Priority: "Minor"}))
+	require.Equal(t, beads.Priority(2), m.Priority(Issue{}))
+	require.Equal(t, beads.StatusClosed, Status(Issue{StatusCategory: "done"}))
+	require.Equal(t, beads.StatusOpen, Status(Issue{StatusCategory: "new"}))
+}
+
+func TestImportedKeys(t *testing.T) {
+	keys := ImportedKeys([]beads.Issue{
+		{ID: "bd-1", Labels: []string{"web", "jira:SHOP-1"}},
+		{ID: "bd-2", Labels: []string{"archived"}},
+	})
+	require.Equal(t, map[string]string{"SHOP-1": "bd-1"}, keys)
+}
+
+// shopIssues is an epic with a story and a subtask listed before their
+// parents, plus a task that blocks the subtask and links outside the import.
+var shopIssues = []Issue{
+	{Key: "SHOP-3", Summary: "Totals", Type: "Sub-task", Subtask: true, ParentKey: "SHOP-2", BlockedBy: []string{"SHOP-4"}},
+	{Key: "SHOP-2", Summary: "Cart", Type: "Story", Priority: "High", StatusCategory: "indeterminate", Labels: []string{"web"}, ParentKey: "SHOP-1"},
+	{Key: "SHOP-1", Summary: "Checkout", Type: "Epic", StatusCategory: "done"},
+	{Key: "SHOP-4", Summary: "Prices API", Type: "Task", Blocks: []string{"SHOP-3", "OPS-9"}, ParentKey: "OPS-1"},
+}
+
+func TestBuildPlan(t *testing.T) {
+	plan := BuildPlan(shopIssues, NewMapping(config.JiraConfig{}), nil)
+
+	var order []string
+	for _, issue := range plan.Issues {
+		order = append(order, issue.Key)
+	}
+	require.Equal(t, []string{"SHOP-1", "SHOP-2", "SHOP-3", "SHOP-4"}, order, "parents come first")
+	require.Equal(t, PlannedIssue{
+		Key:       "SHOP-2",
+		Title:     "Cart",
+		Type:      beads.TypeFeature,
+		Priority:  1,
+		Status:    beads.StatusInProgress,
+		Labels:    []string{"web", "jira:SHOP-2"},
+		ParentKey: "SHOP-1",
+	}, plan.Issues[1])
+	require.Empty(t, plan.Issues[3].ParentKey)
+	require.Equal(t, []Dependency{{Key: "SHOP-3", DependsOn: "SHOP-4"}}, plan.Dependencies, "links are listed once")
+	require.Equal(t, []string{
+		"SHOP-4: parent OPS-1 is not part of the import",
+		"SHOP-4 blocks OPS-9, which is not part of the import",
+	}, plan.Warnings)
+}
+
+func TestBuildPlan_SkipsImportedIssues(t *testing.T) {
+	existing := map[string]string{"SHOP-1": "bd-1", "SHOP-4": "bd-4", "OPS-1": "bd-9"}
+	plan := BuildPlan(shopIssues, NewMapping(config.JiraConfig{}), existing)
+
+	require.Len(t, plan.Issues, 2)
+	require.Equal(t, "SHOP-2", plan.Issues[0].Key)
+	require.Equal(t, []string{"SHOP-1", "SHOP-4"}, plan.Skipped)
+	require.Equal(t, []Dependency{{Key: "SHOP-3", DependsOn: "SHOP-4"}}, plan.Dependencies)
+}
+
+// createExecutor adds an IssueCreator implementation to the IssueExecutor mock.
+type createExecutor struct {
+	*mocks.MockIssueExecutor
+	create func(opts beads.CreateIssueOptions) (beads.CreateResult, error)
+}
+
+func (e createExecutor) CreateIssue(opts beads.CreateIssueOptions) (beads.CreateResult, error) {
+	return e.create(opts)
+}
+
+func TestApply(t *testing.T) {
+	plan := BuildPlan(shopIssues, NewMapping(config.JiraConfig{}), map[string]string{"OPS-1": "bd-9"})
+
+	issueMock := mocks.NewMockIssueExecutor(t)
+	issueMock.EXPECT().CloseIssue("bd-10", "Done in Jira (SHOP-1)").Return(nil)
+	inProgress := beads.StatusInProgress
+	issueMock.EXPECT().UpdateIssue("bd-11", beads.UpdateIssueOptions{Status: &inProgress}).Return(nil)
+	var parents []string
+	executor := createExecutor{
+		MockIssueExecutor: issueMock,
+		create: func(opts beads.CreateIssueOptions) (beads.CreateResult, error) {
+			if opts.Title == "Totals" {
+				return beads.CreateResult{}, errors.New("bd failed")
+			}
+			parents = append(parents, opts.ParentID)
+			return beads.CreateResult{ID: fmt.Sprintf("bd-%d", 9+len(parents))}, nil
+		},
+	}
+
+	result, err := Apply(executor, plan)
+	require.NoError(t, err)
+	require.Equal(t, []string{"", "bd-10", "bd-9"}, parents)
+	require.Len(t, result.Issues, 4)
+	require.Equal(t, IssueResult{Key: "SHOP-2", BeadsID: "bd-11"}, result.Issues[1])
+	require.EqualError(t, result.Issues[2].Err, "creating issue: bd failed")
+	require.Equal(t, IssueResult{Key: "SHOP-4", BeadsID: "bd-12"}, result.Issues[3])
+	require.Len(t, result.Dependencies, 1)
+	require.EqualError(t, result.Dependencies[0].Err, "SHOP-3 was not imported")
+	require.Equal(t, 2, result.Failed())
+}
+
+func TestApply_RequiresIssueCreator(t *testing.T) {
+	_, err := Apply(mocks.NewMockIssueExecutor(t), Plan{})
+	require.ErrorIs(t, err, appbeads.ErrCreateUnsupported)
+}
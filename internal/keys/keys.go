@@ -87,6 +87,9 @@ var Kanban = struct {
 	EditColumn       key.Binding
 	MoveColumnLeft   key.Binding
 	MoveColumnRight  key.Binding
+	MoveIssueLeft    key.Binding // Move the selected issue to the column on the left (updates its status)
+	MoveIssueRight   key.Binding // Move the selected issue to the column on the right (updates its status)
+	CollapseColumn   key.Binding
 	NextView         key.Binding
 	PrevView         key.Binding
 	ViewMenu         key.Binding
@@ -137,13 +140,25 @@ var Kanban = struct {
 		key.WithKeys("ctrl+l"),
 		key.WithHelp("ctrl+l", "move column right"),
 	),
+	MoveIssueLeft: key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("H", "move issue left"),
+	),
+	MoveIssueRight: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "move issue right"),
+	),
+	CollapseColumn: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "collapse column"),
+	),
 	NextView: key.NewBinding(
-		key.WithKeys("ctrl+j", "ctrl+n"),
-		key.WithHelp("ctrl+j/n", "next view"),
+		key.WithKeys("]", "ctrl+j", "ctrl+n"),
+		key.WithHelp("]/ctrl+j", "next view"),
 	),
 	PrevView: key.NewBinding(
-		key.WithKeys("ctrl+k", "ctrl+p"),
-		key.WithHelp("ctrl+k/p", "previous view"),
+		key.WithKeys("["),
+		key.WithHelp("[", "previous view"),
 	),
 	ViewMenu: key.NewBinding(
 		key.WithKeys("ctrl+v"),
@@ -189,6 +204,8 @@ var Search = struct {
 	Priority    key.Binding
 	Status      key.Binding
 	Yank        key.Binding
+	Mark        key.Binding // Mark results for a bulk edit
+	BulkEdit    key.Binding
 	SaveColumn  key.Binding
 	SwitchMode  key.Binding
 	Help        key.Binding
@@ -243,6 +260,14 @@ var Search = struct {
 		key.WithKeys("y"),
 		key.WithHelp("y", "copy issue ID"),
 	),
+	Mark: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "mark issue"),
+	),
+	BulkEdit: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "bulk edit marked"),
+	),
 	SaveColumn: key.NewBinding(
 		key.WithKeys("ctrl+s"),
 		key.WithHelp("ctrl+s", "save to view"),
@@ -375,6 +400,36 @@ var LogOverlay = struct {
 	),
 }
 
+// Transcript contains keybindings specific to the worker transcript viewer.
+var Transcript = struct {
+	Search     key.Binding
+	NextMatch  key.Binding
+	PrevMatch  key.Binding
+	FollowTail key.Binding
+	Export     key.Binding
+}{
+	Search: key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "search"),
+	),
+	NextMatch: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "next match"),
+	),
+	PrevMatch: key.NewBinding(
+		key.WithKeys("N"),
+		key.WithHelp("N", "previous match"),
+	),
+	FollowTail: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "follow tail"),
+	),
+	Export: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "export"),
+	),
+}
+
 // App contains keybindings for app-level actions (cross-mode).
 var App = struct {
 	ToggleChatPanel key.Binding
@@ -383,6 +438,9 @@ var App = struct {
 	ChatPrevTab     key.Binding
 	ChatNextSession key.Binding
 	ChatPrevSession key.Binding
+	CancelProgress  key.Binding
+	ThemeSwitcher   key.Binding
+	CommandPalette  key.Binding
 }{
 	ToggleChatPanel: key.NewBinding(
 		key.WithKeys("ctrl+w"),
@@ -408,6 +466,18 @@ var App = struct {
 		key.WithKeys("ctrl+p"),
 		key.WithHelp("ctrl+p", "prev chat session"),
 	),
+	CancelProgress: key.NewBinding(
+		key.WithKeys("ctrl+b"),
+		key.WithHelp("ctrl+b", "cancel running operation"),
+	),
+	ThemeSwitcher: key.NewBinding(
+		key.WithKeys("ctrl+y"),
+		key.WithHelp("ctrl+y", "switch theme"),
+	),
+	CommandPalette: key.NewBinding(
+		key.WithKeys("ctrl+p", "ctrl+k"),
+		key.WithHelp("ctrl+p/k", "command palette"),
+	),
 }
 
 // DiffViewer contains keybindings specific to the diff viewer overlay.
@@ -509,8 +579,8 @@ func ShortHelp() []key.Binding {
 func FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{Common.Up, Common.Down, Common.Left, Common.Right},
-		{Common.Enter, Kanban.Refresh, Kanban.Yank, Kanban.Status, Kanban.Priority, Kanban.AddColumn, Kanban.EditColumn, Kanban.MoveColumnLeft, Kanban.MoveColumnRight},
-		{Kanban.NextView, Kanban.PrevView, Kanban.ViewMenu, Kanban.DeleteColumn},
+		{Common.Enter, Kanban.Refresh, Kanban.Yank, Kanban.Status, Kanban.Priority, Kanban.AddColumn, Kanban.EditColumn, Kanban.MoveColumnLeft, Kanban.MoveColumnRight, Kanban.MoveIssueLeft, Kanban.MoveIssueRight},
+		{Kanban.NextView, Kanban.PrevView, Kanban.ViewMenu, Kanban.DeleteColumn, Kanban.CollapseColumn},
 		{Common.Help, Kanban.ToggleStatus, Common.Escape, Kanban.QuitConfirm},
 	}
 }
@@ -524,9 +594,11 @@ var Dashboard = struct {
 	Tab             key.Binding
 	GotoTop         key.Binding
 	GotoBottom      key.Binding
+	NextAttention   key.Binding
 	Enter           key.Binding
 	Start           key.Binding
 	Stop            key.Binding
+	EmergencyStop   key.Binding
 	New             key.Binding
 	Rename          key.Binding
 	Filter          key.Binding
@@ -535,6 +607,7 @@ var Dashboard = struct {
 	Quit            key.Binding
 	CoordinatorChat key.Binding
 	OpenInBrowser   key.Binding
+	WorkerGrid      key.Binding
 }{
 	Up: key.NewBinding(
 		key.WithKeys("k", "up"),
@@ -564,6 +637,10 @@ var Dashboard = struct {
 		key.WithKeys("G"),
 		key.WithHelp("G", "go to last"),
 	),
+	NextAttention: key.NewBinding(
+		key.WithKeys("u"),
+		key.WithHelp("u", "next needing attention"),
+	),
 	Enter: key.NewBinding(
 		key.WithKeys("enter"),
 		key.WithHelp("enter", "view details"),
@@ -576,6 +653,10 @@ var Dashboard = struct {
 		key.WithKeys("x"),
 		key.WithHelp("x", "pause workflow"),
 	),
+	EmergencyStop: key.NewBinding(
+		key.WithKeys("!"),
+		key.WithHelp("!", "emergency stop workers"),
+	),
 	New: key.NewBinding(
 		key.WithKeys("n", "N"),
 		key.WithHelp("n", "new workflow"),
@@ -608,6 +689,10 @@ var Dashboard = struct {
 		key.WithKeys("o"),
 		key.WithHelp("o", "open in browser"),
 	),
+	WorkerGrid: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "worker grid"),
+	),
 }
 
 // DiffViewerShortHelp returns keybindings for the short help view (diff viewer).
@@ -640,8 +725,8 @@ func DashboardShortHelp() []key.Binding {
 // DashboardFullHelp returns keybindings for the full help view (dashboard mode).
 func DashboardFullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{Dashboard.Up, Dashboard.Down, Dashboard.GotoTop, Dashboard.GotoBottom},
-		{Dashboard.Enter, Dashboard.Stop},
+		{Dashboard.Up, Dashboard.Down, Dashboard.GotoTop, Dashboard.GotoBottom, Dashboard.NextAttention},
+		{Dashboard.Enter, Dashboard.Stop, Dashboard.EmergencyStop},
 		{Dashboard.New, Dashboard.Rename, Dashboard.Filter, Dashboard.ClearFilter},
 		{Dashboard.Help, Dashboard.Quit},
 	}
@@ -347,3 +347,10 @@ func TestResetForTesting_RestoresDefaults(t *testing.T) {
 	require.Equal(t, "ctrl+o", dashboardHelp.Key, "Kanban.Dashboard help key should be restored to ctrl+o")
 
 }
+
+func TestKanban_ViewSwitching_KeyAssignment(t *testing.T) {
+	require.Equal(t, []string{"]", "ctrl+j", "ctrl+n"}, Kanban.NextView.Keys())
+	require.Equal(t, []string{"["}, Kanban.PrevView.Keys())
+	// ctrl+p and ctrl+k open the command palette
+	require.Equal(t, []string{"ctrl+p", "ctrl+k"}, App.CommandPalette.Keys())
+}
@@ -117,6 +117,52 @@ func (_c *MockGitExecutor_CreateWorktreeWithContext_Call) RunAndReturn(run func(
 	return _c
 }
 
+// DeleteBranch provides a mock function with given fields: name
+func (_m *MockGitExecutor) DeleteBranch(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockGitExecutor_DeleteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteBranch is a helper method to define mock.On call
+//   - name string
+func (_e *MockGitExecutor_Expecter) DeleteBranch(name interface{}) *MockGitExecutor_DeleteBranch_Call {
+	return &MockGitExecutor_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch", name)}
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) Run(run func(name string)) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) Return(_a0 error) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_DeleteBranch_Call) RunAndReturn(run func(string) error) *MockGitExecutor_DeleteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DetermineWorktreePath provides a mock function with given fields: sessionID
 func (_m *MockGitExecutor) DetermineWorktreePath(sessionID string) (string, error) {
 	ret := _m.Called(sessionID)
@@ -1338,6 +1384,52 @@ func (_c *MockGitExecutor_ListWorktrees_Call) RunAndReturn(run func() ([]domain.
 	return _c
 }
 
+// MergeBranch provides a mock function with given fields: branch
+func (_m *MockGitExecutor) MergeBranch(branch string) error {
+	ret := _m.Called(branch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGitExecutor_MergeBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeBranch'
+type MockGitExecutor_MergeBranch_Call struct {
+	*mock.Call
+}
+
+// MergeBranch is a helper method to define mock.On call
+//   - branch string
+func (_e *MockGitExecutor_Expecter) MergeBranch(branch interface{}) *MockGitExecutor_MergeBranch_Call {
+	return &MockGitExecutor_MergeBranch_Call{Call: _e.mock.On("MergeBranch", branch)}
+}
+
+func (_c *MockGitExecutor_MergeBranch_Call) Run(run func(branch string)) *MockGitExecutor_MergeBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGitExecutor_MergeBranch_Call) Return(_a0 error) *MockGitExecutor_MergeBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGitExecutor_MergeBranch_Call) RunAndReturn(run func(string) error) *MockGitExecutor_MergeBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PruneWorktrees provides a mock function with no fields
 func (_m *MockGitExecutor) PruneWorktrees() error {
 	ret := _m.Called()
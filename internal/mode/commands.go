@@ -0,0 +1,86 @@
+package mode
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// Command is an action offered by the command palette.
+type Command struct {
+	ID          string         // Unique identifier (later duplicates are dropped)
+	Title       string         // Name shown and fuzzy-matched in the palette
+	Description string         // Optional muted second line
+	Key         string         // Optional keybinding hint (e.g. "ctrl+e")
+	Run         func() tea.Msg // Produces the message that performs the action
+}
+
+// CommandSource is implemented by mode controllers that contribute contextual
+// commands to the command palette.
+type CommandSource interface {
+	// Idle reports whether no modal, overlay or text input is capturing keys,
+	// so app-wide shortcuts can be handled without stealing them.
+	Idle() bool
+
+	// Commands returns the actions available in the current state.
+	Commands() []Command
+}
+
+// CommandProvider returns commands for the palette. Providers are called each
+// time the palette opens, so they can reflect the current state.
+type CommandProvider func() []Command
+
+// CommandRegistry collects palette commands contributed by the app and by
+// modules. Commands registered for a mode are only offered while that mode
+// is active; global commands are always offered.
+type CommandRegistry struct {
+	global []CommandProvider
+	byMode map[AppMode][]CommandProvider
+}
+
+// NewCommandRegistry creates an empty command registry.
+func NewCommandRegistry() *CommandRegistry {
+	return &CommandRegistry{byMode: make(map[AppMode][]CommandProvider)}
+}
+
+// Register adds a provider whose commands are offered in every mode.
+func (r *CommandRegistry) Register(p CommandProvider) {
+	r.global = append(r.global, p)
+}
+
+// RegisterFor adds a provider whose commands are offered only in mode m.
+func (r *CommandRegistry) RegisterFor(m AppMode, p CommandProvider) {
+	r.byMode[m] = append(r.byMode[m], p)
+}
+
+// Commands returns the commands available in the active mode: the contextual
+// commands of the active view first, then the mode's registered commands,
+// then global ones. When IDs collide the earlier command wins.
+func (r *CommandRegistry) Commands(active AppMode, contextual []Command) []Command {
+	var result []Command
+	seen := make(map[string]bool)
+	add := func(cmds []Command) {
+		for _, c := range cmds {
+			if seen[c.ID] || c.Run == nil {
+				continue
+			}
+			seen[c.ID] = true
+			result = append(result, c)
+		}
+	}
+
+	add(contextual)
+	for _, p := range r.byMode[active] {
+		add(p())
+	}
+	for _, p := range r.global {
+		add(p())
+	}
+	return result
+}
+
+// FindCommand returns the command with the given ID from cmds.
+func FindCommand(cmds []Command, id string) (Command, bool) {
+	for _, c := range cmds {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return Command{}, false
+}
@@ -0,0 +1,70 @@
+package mode
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+)
+
+type testCmdMsg struct{ id string }
+
+func testCommand(id string) Command {
+	return Command{ID: id, Title: id, Run: func() tea.Msg { return testCmdMsg{id: id} }}
+}
+
+func commandIDs(cmds []Command) []string {
+	ids := make([]string, len(cmds))
+	for i, c := range cmds {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+func TestCommandRegistry_Order(t *testing.T) {
+	r := NewCommandRegistry()
+	r.Register(func() []Command { return []Command{testCommand("global")} })
+	r.RegisterFor(ModeKanban, func() []Command { return []Command{testCommand("kanban")} })
+	r.RegisterFor(ModeSearch, func() []Command { return []Command{testCommand("search")} })
+
+	cmds := r.Commands(ModeKanban, []Command{testCommand("context")})
+	require.Equal(t, []string{"context", "kanban", "global"}, commandIDs(cmds))
+
+	cmds = r.Commands(ModeDashboard, nil)
+	require.Equal(t, []string{"global"}, commandIDs(cmds))
+}
+
+func TestCommandRegistry_DropsDuplicatesAndNilRun(t *testing.T) {
+	r := NewCommandRegistry()
+	r.Register(func() []Command {
+		return []Command{testCommand("shared"), {ID: "broken", Title: "No action"}}
+	})
+
+	cmds := r.Commands(ModeKanban, []Command{{ID: "shared", Title: "Contextual", Run: func() tea.Msg { return nil }}})
+	require.Len(t, cmds, 1)
+	require.Equal(t, "Contextual", cmds[0].Title)
+}
+
+func TestCommandRegistry_ProvidersCalledOnEachLookup(t *testing.T) {
+	r := NewCommandRegistry()
+	calls := 0
+	r.Register(func() []Command {
+		calls++
+		return nil
+	})
+
+	r.Commands(ModeKanban, nil)
+	r.Commands(ModeKanban, nil)
+	require.Equal(t, 2, calls)
+}
+
+func TestFindCommand(t *testing.T) {
+	cmds := []Command{testCommand("a"), testCommand("b")}
+
+	c, ok := FindCommand(cmds, "b")
+	require.True(t, ok)
+	require.Equal(t, testCmdMsg{id: "b"}, c.Run())
+
+	_, ok = FindCommand(cmds, "missing")
+	require.False(t, ok)
+}
@@ -0,0 +1,186 @@
+package dashboard
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+)
+
+// FocusDescription describes the focused overlay, zone and selection in plain
+// text for accessibility mode.
+func (m Model) FocusDescription() string {
+	switch {
+	case m.newWorkflowModal != nil:
+		return "New workflow"
+	case m.showHelp:
+		return "Dashboard help"
+	case m.archiveModal != nil:
+		return fmt.Sprintf("Archive confirmation: archive %s? Enter to archive, Esc to cancel", m.archiveModalWfName)
+	case m.renameModal != nil:
+		return "Rename workflow"
+	case m.completionModal != nil:
+		return "Workflow complete report"
+	case m.issueEditor != nil && m.editingIssue != nil:
+		return "Edit issue " + m.editingIssue.ID
+	case m.transcriptViewer != nil:
+		return "Transcript of " + m.transcriptViewer.WorkerID()
+	case m.threadGraph != nil:
+		return "Fabric thread graph"
+	}
+
+	wf := m.SelectedWorkflow()
+	if wf == nil {
+		return "Orchestration dashboard, no workflows"
+	}
+
+	switch m.focus {
+	case FocusCoordinator:
+		if m.coordinatorPanel != nil {
+			return fmt.Sprintf("Coordinator panel, %s, %s tab", wf.Name, m.coordinatorPanel.activeTabName())
+		}
+	case FocusEpicView:
+		if m.showWorkerGrid {
+			if worker := m.selectedGridWorker(); worker != "" {
+				return fmt.Sprintf("Worker grid, %s, %s", worker, m.workerPhase(wf.ID, worker))
+			}
+			return "Worker grid, no workers"
+		}
+		if m.epicTree != nil {
+			if node := m.epicTree.SelectedNode(); node != nil {
+				pane := "Epic tree"
+				if m.epicViewFocus == EpicFocusDetails {
+					pane = "Issue details"
+				}
+				return fmt.Sprintf("%s, %s %s, %s", pane, node.Issue.ID, node.Issue.TitleText, node.Issue.Status)
+			}
+		}
+		return "Epic tree, empty"
+	}
+
+	filtered := m.getFilteredWorkflows()
+	return fmt.Sprintf("Orchestration dashboard, workflow %s, %s (%d of %d)",
+		wf.Name, wf.State, m.selectedIndex+1, len(filtered))
+}
+
+// workerPhase returns the worker's phase in the workflow, or its status when
+// it has no phase.
+func (m Model) workerPhase(workflowID controlplane.WorkflowID, workerID string) string {
+	state := m.workflowUIState[workflowID]
+	if state == nil {
+		return "unknown"
+	}
+	if phase, ok := state.WorkerPhases[workerID]; ok && phase != "" {
+		return string(phase)
+	}
+	return string(state.WorkerStatus[workerID])
+}
+
+// activeTabName names the active tab of the panel.
+func (p *CoordinatorPanel) activeTabName() string {
+	switch {
+	case p.activeTab == TabCoordinator:
+		return "coordinator"
+	case p.isObserverTab(p.activeTab):
+		return "observer"
+	case p.activeTab == p.messagesTabIndex():
+		return "messages"
+	case p.debugMode && p.activeTab == p.commandLogTabIndex():
+		return "command log"
+	}
+	if i := p.activeTab - p.firstWorkerTabIndex(); i >= 0 && i < len(p.workerIDs) {
+		return p.workerIDs[i]
+	}
+	return "coordinator"
+}
+
+// EventAnnouncement describes a control plane event worth announcing in
+// accessibility mode: workflow lifecycle, workers joining and leaving,
+// phase changes, task outcomes, escalations and messages for the user.
+// Returns "" for other events.
+func EventAnnouncement(event controlplane.ControlPlaneEvent) string {
+	prefix := ""
+	if event.WorkflowName != "" {
+		prefix = event.WorkflowName + ": "
+	}
+
+	switch event.Type {
+	case controlplane.EventWorkflowStarted:
+		return prefix + "workflow started"
+	case controlplane.EventWorkflowPaused:
+		return prefix + "workflow paused"
+	case controlplane.EventWorkflowResumed:
+		return prefix + "workflow resumed"
+	case controlplane.EventWorkflowCompleted:
+		return prefix + "workflow completed"
+	case controlplane.EventWorkflowFailed:
+		return prefix + "workflow failed"
+	case controlplane.EventCoordinatorReplaced:
+		return prefix + "coordinator replaced"
+	case controlplane.EventHealthStuck:
+		return prefix + "workflow appears stuck"
+	}
+
+	var desc string
+	switch payload := event.Payload.(type) {
+	case events.ProcessEvent:
+		desc = processAnnouncement(event.Type, payload)
+	case processor.PhaseTimeoutEvent:
+		desc = payload.Summary()
+	case fabric.Event:
+		desc = fabricAnnouncement(payload)
+	}
+	if desc == "" {
+		return ""
+	}
+	return prefix + desc
+}
+
+// processAnnouncement describes worker changes and user notifications.
+func processAnnouncement(eventType controlplane.EventType, pe events.ProcessEvent) string {
+	switch eventType {
+	case controlplane.EventWorkerSpawned:
+		return pe.ProcessID + " spawned"
+	case controlplane.EventWorkerRetired:
+		return pe.ProcessID + " retired"
+	case controlplane.EventTaskFailed:
+		if pe.TaskID != "" {
+			return fmt.Sprintf("%s failed on %s", pe.ProcessID, pe.TaskID)
+		}
+		return pe.ProcessID + " failed"
+	case controlplane.EventUserNotification:
+		return "Notification: " + pe.Message
+	case controlplane.EventWorkerOutput:
+		if pe.Type != events.ProcessStatusChange || pe.Phase == nil {
+			return ""
+		}
+		if pe.TaskID != "" && *pe.Phase != events.ProcessPhaseIdle {
+			return fmt.Sprintf("%s is %s %s", pe.ProcessID, *pe.Phase, pe.TaskID)
+		}
+		return fmt.Sprintf("%s is %s", pe.ProcessID, *pe.Phase)
+	}
+	return ""
+}
+
+// fabricAnnouncement describes fabric messages that mention the user.
+func fabricAnnouncement(event fabric.Event) string {
+	if event.Type != fabric.EventMessagePosted && event.Type != fabric.EventReplyPosted {
+		return ""
+	}
+	if event.Thread == nil || event.Thread.CreatedBy == domain.AgentUser ||
+		!slices.Contains(event.Mentions, domain.AgentUser) {
+		return ""
+	}
+	return fmt.Sprintf("%s in #%s: %s", event.Thread.CreatedBy, event.ChannelSlug, firstLine(event.Thread.Content))
+}
+
+// firstLine returns the first line of text.
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(text), "\n")
+	return line
+}
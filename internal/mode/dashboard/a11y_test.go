@@ -0,0 +1,73 @@
+package dashboard
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+func TestModel_FocusDescription(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+	require.Equal(t, "Orchestration dashboard, no workflows", m.FocusDescription())
+
+	m, _ = createTestModel(t, []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Checkout", controlplane.WorkflowRunning),
+		createTestWorkflow("wf-2", "Search", controlplane.WorkflowPaused),
+	})
+	require.Equal(t, "Orchestration dashboard, workflow Checkout, running (1 of 2)", m.FocusDescription())
+
+	m.focus = FocusEpicView
+	m.showWorkerGrid = true
+	phase := events.ProcessPhaseImplementing
+	m.workflowUIState["wf-1"] = &WorkflowUIState{
+		WorkerIDs:    []string{"worker-1"},
+		WorkerPhases: map[string]events.ProcessPhase{"worker-1": phase},
+	}
+	require.Equal(t, "Worker grid, worker-1, implementing", m.FocusDescription())
+
+	m.showHelp = true
+	require.Equal(t, "Dashboard help", m.FocusDescription(), "overlays come first")
+}
+
+func TestEventAnnouncement(t *testing.T) {
+	reviewing := events.ProcessPhaseReviewing
+	workerEvent := func(eventType controlplane.EventType, pe events.ProcessEvent) controlplane.ControlPlaneEvent {
+		return controlplane.ControlPlaneEvent{Type: eventType, WorkflowName: "Checkout", Payload: pe}
+	}
+
+	tests := []struct {
+		name  string
+		event controlplane.ControlPlaneEvent
+		want  string
+	}{
+		{"lifecycle", controlplane.ControlPlaneEvent{Type: controlplane.EventWorkflowPaused, WorkflowName: "Checkout"}, "Checkout: workflow paused"},
+		{"worker spawned", workerEvent(controlplane.EventWorkerSpawned, events.ProcessEvent{ProcessID: "worker-2"}), "Checkout: worker-2 spawned"},
+		{"phase change", workerEvent(controlplane.EventWorkerOutput, events.ProcessEvent{
+			Type: events.ProcessStatusChange, ProcessID: "worker-2", Phase: &reviewing, TaskID: "perles-abc.1",
+		}), "Checkout: worker-2 is reviewing perles-abc.1"},
+		{"worker output", workerEvent(controlplane.EventWorkerOutput, events.ProcessEvent{
+			Type: events.ProcessOutput, ProcessID: "worker-2", Output: "Reading files",
+		}), ""},
+		{"task failed", workerEvent(controlplane.EventTaskFailed, events.ProcessEvent{
+			ProcessID: "worker-2", TaskID: "perles-abc.1", Error: errors.New("crashed"),
+		}), "Checkout: worker-2 failed on perles-abc.1"},
+		{"user mention", controlplane.ControlPlaneEvent{Type: controlplane.EventFabricPosted, Payload: fabric.Event{
+			Type: fabric.EventMessagePosted, ChannelSlug: "general", Mentions: []string{domain.AgentUser},
+			Thread: &domain.Thread{CreatedBy: "coordinator", Content: "@user which database?\nDetails follow"},
+		}}, "coordinator in #general: @user which database?"},
+		{"other message", controlplane.ControlPlaneEvent{Type: controlplane.EventFabricPosted, Payload: fabric.Event{
+			Type: fabric.EventMessagePosted, ChannelSlug: "tasks", Thread: &domain.Thread{CreatedBy: "worker-1", Content: "Done"},
+		}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, EventAnnouncement(tt.event))
+		})
+	}
+}
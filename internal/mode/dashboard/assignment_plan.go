@@ -0,0 +1,65 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/zjrosen/perles/internal/orchestration/planning"
+)
+
+var (
+	planWaveStyle       lipgloss.Style
+	planInProgressStyle lipgloss.Style
+	planReadyStyle      lipgloss.Style
+	planWaitingStyle    lipgloss.Style
+	planWarningStyle    lipgloss.Style
+)
+
+// renderAssignmentPlan renders the epic's suggested assignment order wave by wave,
+// the same plan the coordinator gets from suggest_assignment_plan.
+// Lines beyond height are cut off with a "more" indicator.
+func renderAssignmentPlan(plan planning.Plan, width, height int) string {
+	if len(plan.Waves) == 0 && len(plan.Cyclic) == 0 {
+		return lipgloss.NewStyle().Foreground(colorDimmed).Italic(true).PaddingLeft(1).
+			Render("No unfinished tasks to plan")
+	}
+
+	var lines []string
+	for _, w := range plan.Waves {
+		lines = append(lines, planWaveStyle.Render(fmt.Sprintf("Wave %d", w.Number))+
+			planWaitingStyle.Render(fmt.Sprintf(" · %d parallel", len(w.Tasks))))
+		for _, t := range w.Tasks {
+			lines = append(lines, renderPlanTask(t, w.Number, width))
+		}
+	}
+	if len(plan.Cyclic) > 0 {
+		lines = append(lines, planWarningStyle.Render(ansi.Truncate("⚠ Dependency cycle: "+strings.Join(plan.Cyclic, ", "), width, "…")))
+	}
+
+	if height > 0 && len(lines) > height {
+		hidden := len(lines) - height + 1
+		lines = append(lines[:height-1], planWaitingStyle.Render(fmt.Sprintf("… %d more", hidden)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderPlanTask renders one planned task: a status marker, the ID and the title,
+// followed by the tasks it waits for.
+func renderPlanTask(t planning.Task, wave, width int) string {
+	marker, style := "○", planWaitingStyle
+	switch {
+	case t.InProgress:
+		marker, style = "●", planInProgressStyle
+	case wave == 1:
+		marker, style = "◎", planReadyStyle
+	}
+
+	text := fmt.Sprintf("%s %s %s", marker, t.ID, t.Title)
+	if len(t.BlockedBy) > 0 {
+		text += " ← " + strings.Join(t.BlockedBy, ", ")
+	}
+	return " " + style.Render(ansi.Truncate(text, width-1, "…"))
+}
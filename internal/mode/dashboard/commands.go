@@ -0,0 +1,101 @@
+package dashboard
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/flags"
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// commandMsg is produced by the command palette. The dashboard runs the
+// action against its current state when the message arrives.
+type commandMsg struct {
+	run func(Model) (mode.Controller, tea.Cmd)
+}
+
+// dashboardCommand builds a palette command that runs action on the dashboard.
+func dashboardCommand(id, title, description, key string, action func(Model) (mode.Controller, tea.Cmd)) mode.Command {
+	return mode.Command{
+		ID:          id,
+		Title:       title,
+		Description: description,
+		Key:         key,
+		Run:         func() tea.Msg { return commandMsg{run: action} },
+	}
+}
+
+// slashCommand runs an orchestration slash command against a workflow,
+// exactly as if it was typed into the coordinator panel.
+func slashCommand(workflowID controlplane.WorkflowID, content string) func(Model) (mode.Controller, tea.Cmd) {
+	return func(m Model) (mode.Controller, tea.Cmd) {
+		return m.handleSlashCommand(workflowID, content)
+	}
+}
+
+// Idle reports whether the dashboard is shown without a modal, overlay or
+// active input, so app-wide shortcuts like the command palette won't steal
+// keys from them.
+func (m Model) Idle() bool {
+	return m.completionModal == nil && m.transcriptViewer == nil && m.threadGraph == nil &&
+		m.newWorkflowModal == nil && m.archiveModal == nil && m.renameModal == nil &&
+		m.issueEditor == nil && !m.showHelp && !m.filter.IsActive() && m.focus != FocusCoordinator
+}
+
+// Commands returns the command palette actions for the selected workflow,
+// including jumping to its workers and running orchestration commands.
+func (m Model) Commands() []mode.Command {
+	cmds := []mode.Command{
+		dashboardCommand("dashboard.new", "New workflow", "", keys.Dashboard.New.Help().Key, Model.openNewWorkflowModal),
+		dashboardCommand("dashboard.attention", "Jump to workflow needing attention", "", keys.Dashboard.NextAttention.Help().Key,
+			func(m Model) (mode.Controller, tea.Cmd) { return m.jumpToAttention() }),
+	}
+
+	if wf := m.SelectedWorkflow(); wf != nil {
+		name := wf.Name
+		cmds = append(cmds,
+			dashboardCommand("dashboard.start", "Start/resume workflow", name, keys.Dashboard.Start.Help().Key, Model.startOrResumeSelectedWorkflow),
+			dashboardCommand("dashboard.pause", "Pause workflow", name, keys.Dashboard.Stop.Help().Key, Model.pauseSelectedWorkflow),
+			dashboardCommand("dashboard.emergency-stop", "Emergency stop workers", name, keys.Dashboard.EmergencyStop.Help().Key, Model.emergencyStopSelectedWorkflow),
+			dashboardCommand("dashboard.unhalt", "Lift emergency stop", name, "", slashCommand(wf.ID, "/unhalt")),
+			dashboardCommand("dashboard.spawn", "Spawn worker", name, "", slashCommand(wf.ID, "/spawn")),
+			dashboardCommand("dashboard.failed", "Show failed commands", name, "", slashCommand(wf.ID, "/failed")),
+			dashboardCommand("dashboard.graph", "Show thread graph", name, "", slashCommand(wf.ID, "/graph")),
+			dashboardCommand("dashboard.rename", "Rename workflow", name, keys.Dashboard.Rename.Help().Key, Model.renameSelectedWorkflow),
+			dashboardCommand("dashboard.browser", "Open session in browser", name, keys.Dashboard.OpenInBrowser.Help().Key, Model.openSessionInBrowser),
+			dashboardCommand("dashboard.coordinator", "Toggle coordinator chat", name, keys.Dashboard.CoordinatorChat.Help().Key, Model.toggleCoordinatorPanel),
+			dashboardCommand("dashboard.worker-grid", "Toggle worker grid", name, keys.Dashboard.WorkerGrid.Help().Key, Model.toggleWorkerGrid),
+		)
+		if m.services.Flags != nil && m.services.Flags.Enabled(flags.FlagSessionPersistence) {
+			cmds = append(cmds, dashboardCommand("dashboard.archive", "Archive workflow", name, "a", Model.archiveSelectedWorkflow))
+		}
+
+		if state, ok := m.workflowUIState[wf.ID]; ok {
+			for _, workerID := range state.WorkerIDs {
+				cmds = append(cmds,
+					dashboardCommand("dashboard.worker."+workerID, "Jump to worker: "+workerID, name, "",
+						func(m Model) (mode.Controller, tea.Cmd) { return m.jumpToWorker(workerID) }),
+					dashboardCommand("dashboard.transcript."+workerID, "Open transcript: "+workerID, name, "",
+						func(m Model) (mode.Controller, tea.Cmd) { return m.openTranscriptViewer(workerID) }),
+				)
+				if workerID != repository.CoordinatorID {
+					cmds = append(cmds,
+						dashboardCommand("dashboard.retire."+workerID, "Retire worker: "+workerID, name, "",
+							slashCommand(wf.ID, "/retire "+workerID)),
+						dashboardCommand("dashboard.replace."+workerID, "Replace worker: "+workerID, name, "",
+							slashCommand(wf.ID, "/replace "+workerID)),
+					)
+				}
+			}
+		}
+	}
+
+	return append(cmds, mode.Command{
+		ID:    "dashboard.quit",
+		Title: "Back to kanban",
+		Key:   keys.Dashboard.Quit.Help().Key,
+		Run:   func() tea.Msg { return QuitMsg{} },
+	})
+}
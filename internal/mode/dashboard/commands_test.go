@@ -0,0 +1,66 @@
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+)
+
+func TestCommands_SelectedWorkflow(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+	m, _ := createTestModel(t, workflows)
+
+	cmds := m.Commands()
+
+	pause, ok := mode.FindCommand(cmds, "dashboard.pause")
+	require.True(t, ok)
+	require.Equal(t, "x", pause.Key)
+	require.Equal(t, "Workflow 1", pause.Description)
+
+	_, ok = mode.FindCommand(cmds, "dashboard.spawn")
+	require.True(t, ok, "orchestration commands are offered for the selected workflow")
+}
+
+func TestCommands_NoWorkflow(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+
+	cmds := m.Commands()
+
+	_, ok := mode.FindCommand(cmds, "dashboard.pause")
+	require.False(t, ok, "workflow commands need a selected workflow")
+	_, ok = mode.FindCommand(cmds, "dashboard.new")
+	require.True(t, ok)
+}
+
+func TestCommands_JumpToWorker(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+	m, _ := createTestModel(t, workflows)
+	state := m.getOrCreateUIState("wf-1")
+	state.WorkerIDs = []string{"worker-1", "worker-2"}
+
+	jump, ok := mode.FindCommand(m.Commands(), "dashboard.worker.worker-2")
+	require.True(t, ok)
+	require.Equal(t, "Jump to worker: worker-2", jump.Title)
+
+	result, _ := m.Update(jump.Run())
+	m = result.(Model)
+	require.True(t, m.showCoordinatorPanel)
+	require.Equal(t, FocusCoordinator, m.focus)
+	require.Equal(t, m.coordinatorPanel.firstWorkerTabIndex()+1, m.coordinatorPanel.ActiveTab())
+	require.False(t, m.Idle(), "the focused coordinator input captures keys")
+}
+
+func TestIdle(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+	require.True(t, m.Idle())
+
+	m.showHelp = true
+	require.False(t, m.Idle(), "help overlay captures keys")
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,8 +26,10 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/validation"
 	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
 	"github.com/zjrosen/perles/internal/ui/shared/editor"
+	"github.com/zjrosen/perles/internal/ui/shared/markdown"
 	"github.com/zjrosen/perles/internal/ui/shared/mention"
 	"github.com/zjrosen/perles/internal/ui/shared/overlay"
 	"github.com/zjrosen/perles/internal/ui/shared/panes"
@@ -72,16 +76,18 @@ type CoordinatorPanel struct {
 	observerQueue    int
 
 	// Message log state (uses SelectablePane for viewport + selection - NOT migrated yet)
-	messagePane  *selection.SelectablePane
-	fabricEvents []fabric.Event // Synced from WorkflowUIState
+	messagePane    *selection.SelectablePane
+	fabricEvents   []fabric.Event                    // Synced from WorkflowUIState
+	fabricReceipts map[string][]fabricdomain.Receipt // Synced from WorkflowUIState, by message ID
 
 	// Worker state (dynamic tabs)
-	workerIDs      []string                                    // Active worker IDs in display order
-	workerPanes    map[string]*selection.VirtualSelectablePane // VirtualSelectablePane per worker
-	workerMessages map[string][]chatrender.Message             // Messages per worker
-	workerStatus   map[string]events.ProcessStatus             // Status per worker
-	workerPhases   map[string]events.ProcessPhase              // Phase per worker
-	workerQueues   map[string]int                              // Queue count per worker
+	workerIDs       []string                                    // Active worker IDs in display order
+	workerPanes     map[string]*selection.VirtualSelectablePane // VirtualSelectablePane per worker
+	workerMessages  map[string][]chatrender.Message             // Messages per worker
+	workerStatus    map[string]events.ProcessStatus             // Status per worker
+	workerPhases    map[string]events.ProcessPhase              // Phase per worker
+	workerQueues    map[string]int                              // Queue count per worker
+	workerTelemetry map[string]*WorkerTelemetry                 // Structured telemetry per worker
 
 	// Token metrics for display
 	coordinatorMetrics *metrics.TokenMetrics
@@ -128,65 +134,49 @@ type CoordinatorPanel struct {
 	// Thread state for fabric channels (per-channel)
 	// Maps channel slug to active thread ID. When set, messages are sent as replies.
 	activeThreadIDs map[string]string
+
+	// Markdown rendering for fabric message content
+	markdownStyle string            // "dark" or "light"
+	mdRenderer    markdown.Renderer // Recreated when the message log width changes
+	mdCache       map[string]string // Rendered content by raw content, for mdRenderer's width
 }
 
 // coordinatorTitleColor is the base color for coordinator title text.
 // Uses the shared CoordinatorColor from chatrender for consistency across all chat UIs.
-var coordinatorTitleColor = chatrender.CoordinatorColor
+var coordinatorTitleColor lipgloss.AdaptiveColor
 
 // observerTitleColor is the base color for observer title text.
-var observerTitleColor = chatrender.ObserverColor
+var observerTitleColor lipgloss.AdaptiveColor
 
 // workerTitleColor is the base color for worker title text.
-var workerTitleColor = chatrender.WorkerColor
+var workerTitleColor lipgloss.AdaptiveColor
 
-// Message pane styles (matches orchestration mode)
+// Message pane styles (matches orchestration mode), rebuilt by rebuildStyles.
 var (
-	messageTimestampStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#666666", Dark: "#696969"})
-
-	coordinatorSenderStyle = lipgloss.NewStyle().
-				Foreground(chatrender.CoordinatorColor).
-				Bold(true)
-
-	observerSenderStyle = lipgloss.NewStyle().
-				Foreground(chatrender.ObserverColor).
-				Bold(true)
-
-	workerSenderStyle = lipgloss.NewStyle().
-				Foreground(chatrender.WorkerColor).
-				Bold(true)
-
-	userSenderStyle = lipgloss.NewStyle().
-			Foreground(chatrender.UserColor).
-			Bold(true)
+	messageTimestampStyle  lipgloss.Style
+	coordinatorSenderStyle lipgloss.Style
+	observerSenderStyle    lipgloss.Style
+	workerSenderStyle      lipgloss.Style
+	userSenderStyle        lipgloss.Style
+	systemSenderStyle      lipgloss.Style
+
+	// systemContentStyle mutes automated messages so agent conversation stands out.
+	systemContentStyle lipgloss.Style
 )
 
+// telemetryStyle renders the worker telemetry summary in the pane border.
+var telemetryStyle lipgloss.Style
+
 // Command log pane styles (matches orchestration mode command_pane.go)
 var (
-	commandTimestampStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#666666", Dark: "#696969"})
-
-	commandSourceStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#8888FF", Dark: "#9999FF"})
-
-	commandTypeStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#CCCCCC", Dark: "#AAAAAA"})
-
-	commandSuccessStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#43BF6D", Dark: "#43BF6D"})
-
-	commandFailStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#FF6B6B", Dark: "#FF8787"})
-
-	commandDurationStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#888888", Dark: "#777777"})
-
-	commandIDStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.AdaptiveColor{Light: "#555555", Dark: "#666666"})
-
-	commandTraceIDStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#777777", Dark: "#555555"})
+	commandTimestampStyle lipgloss.Style
+	commandSourceStyle    lipgloss.Style
+	commandTypeStyle      lipgloss.Style
+	commandSuccessStyle   lipgloss.Style
+	commandFailStyle      lipgloss.Style
+	commandDurationStyle  lipgloss.Style
+	commandIDStyle        lipgloss.Style
+	commandTraceIDStyle   lipgloss.Style
 )
 
 // NewCoordinatorPanel creates a new coordinator panel.
@@ -224,6 +214,7 @@ func NewCoordinatorPanel(debugMode, vimMode, observerEnabled bool, clipboard sha
 		workerMessages:             make(map[string][]chatrender.Message),
 		workerStatus:               make(map[string]events.ProcessStatus),
 		workerPhases:               make(map[string]events.ProcessPhase),
+		workerTelemetry:            make(map[string]*WorkerTelemetry),
 		workerQueues:               make(map[string]int),
 		workerMetrics:              make(map[string]*metrics.TokenMetrics),
 		commandLogViewport:         viewport.New(0, 0),
@@ -328,6 +319,7 @@ func (p *CoordinatorPanel) SetWorkflow(workflowID controlplane.WorkflowID, state
 		p.observerQueue = 0
 		p.observerMetrics = nil
 		p.fabricEvents = make([]fabric.Event, 0)
+		p.fabricReceipts = nil
 		p.workerIDs = make([]string, 0)
 		clear(p.workerMetrics)
 		return
@@ -353,6 +345,7 @@ func (p *CoordinatorPanel) SetWorkflow(workflowID controlplane.WorkflowID, state
 	if workflowChanged || len(state.FabricEvents) != len(p.fabricEvents) {
 		p.fabricEvents = state.FabricEvents
 	}
+	p.fabricReceipts = state.FabricReceipts
 
 	// Sync worker state
 	if workflowChanged || len(state.WorkerIDs) != len(p.workerIDs) {
@@ -379,6 +372,7 @@ func (p *CoordinatorPanel) SetWorkflow(workflowID controlplane.WorkflowID, state
 		p.workerStatus[wid] = state.WorkerStatus[wid]
 		p.workerPhases[wid] = state.WorkerPhases[wid]
 		p.workerQueues[wid] = state.WorkerQueueCounts[wid]
+		p.workerTelemetry[wid] = state.WorkerTelemetry[wid]
 	}
 
 	// Sync worker metrics (clear first to avoid stale entries from previous workflow)
@@ -531,6 +525,17 @@ func (p *CoordinatorPanel) PrevTab() {
 	p.activeTab = (p.activeTab - 1 + count) % count
 }
 
+// SelectWorkerTab switches to the transcript tab of workerID.
+// Returns false if the panel has no tab for the worker.
+func (p *CoordinatorPanel) SelectWorkerTab(workerID string) bool {
+	idx := slices.Index(p.workerIDs, workerID)
+	if idx < 0 {
+		return false
+	}
+	p.activeTab = p.firstWorkerTabIndex() + idx
+	return true
+}
+
 // ActiveTab returns the current active tab index.
 func (p *CoordinatorPanel) ActiveTab() int {
 	return p.activeTab
@@ -618,9 +623,10 @@ func (p *CoordinatorPanel) formatThreadIndicator() string {
 }
 
 // ActivateThreadPicker activates the thread picker with the given threads.
+// participants (keyed by thread ID) may be nil.
 // Called by Model when ThreadsLoadedMsg is received.
-func (p *CoordinatorPanel) ActivateThreadPicker(threads []fabricdomain.Thread) {
-	p.threadPickerModel = p.threadPickerModel.Activate(threads)
+func (p *CoordinatorPanel) ActivateThreadPicker(threads []fabricdomain.Thread, participants map[string]*fabricdomain.ThreadParticipants) {
+	p.threadPickerModel = p.threadPickerModel.Activate(threads).SetParticipants(participants)
 }
 
 // IsThreadPickerActive returns true if the thread picker is currently showing.
@@ -1074,7 +1080,15 @@ func (p *CoordinatorPanel) getActiveBottomIndicators() string {
 		if workerIdx >= 0 && workerIdx < len(p.workerIDs) {
 			workerID := p.workerIDs[workerIdx]
 			queueCount := p.workerQueues[workerID]
-			return chatrender.FormatQueueCount(queueCount)
+			indicators := chatrender.FormatQueueCount(queueCount)
+			if summary := p.workerTelemetry[workerID].Summary(); summary != "" {
+				summary = telemetryStyle.Render(summary)
+				if indicators != "" {
+					return indicators + " " + summary
+				}
+				return summary
+			}
+			return indicators
 		}
 		return ""
 	}
@@ -1359,7 +1373,10 @@ func (p *CoordinatorPanel) renderFabricEventsWithSelection(wrapWidth int, selSta
 		// Style sender based on who sent it (case-insensitive matching)
 		senderUpper := strings.ToUpper(sender)
 		var senderStyled string
+		isSystem := event.Thread != nil && event.Thread.IsSystem()
 		switch {
+		case isSystem:
+			senderStyled = systemSenderStyle.Render(sender)
 		case senderUpper == message.ActorCoordinator:
 			senderStyled = coordinatorSenderStyle.Render(sender)
 		case senderUpper == strings.ToUpper(repository.ObserverID):
@@ -1377,32 +1394,66 @@ func (p *CoordinatorPanel) renderFabricEventsWithSelection(wrapWidth int, selSta
 
 		// Get content from Thread
 		msgContent := event.Thread.Content
+		if event.Thread.IsEdited() {
+			msgContent += " (edited)"
+		}
 		if event.Type == fabric.EventReplyPosted {
 			msgContent = "↳ reply: " + msgContent
 		}
+		muted := isSystem || event.Thread.IsDeleted()
 
 		// Word wrap content (account for left border + space)
-		wrappedContent := chatrender.WordWrap(msgContent, wrapWidth-4)
-		wrappedLines := strings.Split(wrappedContent, "\n")
+		// System messages and deleted message tombstones stay plain text; others
+		// are rendered as markdown
+		var styledLines, wrappedLines []string
+		if muted {
+			wrappedLines = strings.Split(chatrender.WordWrap(msgContent, wrapWidth-4), "\n")
+			styledLines = wrappedLines
+		} else {
+			styledLines = strings.Split(p.renderMarkdown(event.Thread.Content, wrapWidth-4), "\n")
+			if event.Type == fabric.EventReplyPosted {
+				styledLines[0] = "↳ reply: " + styledLines[0]
+			}
+			if event.Thread.IsEdited() {
+				styledLines[len(styledLines)-1] += " (edited)"
+			}
+			wrappedLines = make([]string, len(styledLines))
+			for i, line := range styledLines {
+				wrappedLines[i] = ansi.Strip(line)
+			}
+		}
 
 		// Build plain lines for this entry
 		plainLines = append(plainLines, headerPlain)
 		plainLines = append(plainLines, wrappedLines...)
-		plainLines = append(plainLines, "") // blank line
 
 		// Header line with optional selection
 		content.WriteString(leftBorder + " " + renderLineWithSelection(headerStyled, headerPlain, currentLine, wrapWidth, selStart, selEnd))
 		content.WriteString("\n")
 		currentLine++
 
-		// Content lines with optional selection (unstyled, matches coordinator pane)
-		for _, line := range wrappedLines {
-			content.WriteString(leftBorder + " " + renderLineWithSelection(line, line, currentLine, wrapWidth, selStart, selEnd))
+		// Content lines with optional selection (unstyled, matches coordinator pane;
+		// system messages and deleted message tombstones are muted)
+		for i, line := range wrappedLines {
+			styled := styledLines[i]
+			if muted {
+				styled = systemContentStyle.Render(line)
+			}
+			content.WriteString(leftBorder + " " + renderLineWithSelection(styled, line, currentLine, wrapWidth, selStart, selEnd))
+			content.WriteString("\n")
+			currentLine++
+		}
+
+		// Read marker: who the message was delivered to and who read it
+		if marker := receiptMarker(p.fabricReceipts[event.Thread.ID]); marker != "" {
+			plainLines = append(plainLines, marker)
+			content.WriteString(leftBorder + " " + renderLineWithSelection(systemContentStyle.Render(marker), marker, currentLine, wrapWidth, selStart, selEnd))
 			content.WriteString("\n")
 			currentLine++
 		}
 
 		// Blank line
+		plainLines = append(plainLines, "")
 		content.WriteString(renderLineWithSelection("", "", currentLine, wrapWidth, selStart, selEnd))
 		content.WriteString("\n")
 		currentLine++
@@ -1411,6 +1462,57 @@ func (p *CoordinatorPanel) renderFabricEventsWithSelection(wrapWidth int, selSta
 	return strings.TrimRight(content.String(), "\n"), plainLines
 }
 
+// renderMarkdown renders fabric message content as markdown wrapped to width,
+// caching the result since the message log re-renders on every update.
+func (p *CoordinatorPanel) renderMarkdown(content string, width int) string {
+	width = max(width, 1)
+	if p.mdRenderer == nil || p.mdRenderer.Width() != width {
+		r, err := markdown.NewInline(width, p.markdownStyle)
+		if err != nil {
+			r = markdown.NewPlain(width)
+		}
+		p.mdRenderer = r
+		p.mdCache = make(map[string]string)
+	}
+	if rendered, ok := p.mdCache[content]; ok {
+		return rendered
+	}
+	rendered := markdown.RenderOrPlain(p.mdRenderer, content)
+	p.mdCache[content] = rendered
+	return rendered
+}
+
+// SetMarkdownStyle sets the markdown rendering style ("dark" or "light") for
+// fabric messages.
+func (p *CoordinatorPanel) SetMarkdownStyle(style string) {
+	p.markdownStyle = style
+	p.mdRenderer = nil
+}
+
+// receiptMarker summarizes the receipts of a message, e.g.
+// "✓✓ read by worker-1 · ✓ delivered to worker-2". Returns "" without receipts.
+func receiptMarker(receipts []fabricdomain.Receipt) string {
+	var read, delivered []string
+	for _, r := range receipts {
+		if r.IsRead() {
+			read = append(read, r.AgentID)
+		} else {
+			delivered = append(delivered, r.AgentID)
+		}
+	}
+	slices.Sort(read)
+	slices.Sort(delivered)
+
+	var parts []string
+	if len(read) > 0 {
+		parts = append(parts, "✓✓ read by "+strings.Join(read, ", "))
+	}
+	if len(delivered) > 0 {
+		parts = append(parts, "✓ delivered to "+strings.Join(delivered, ", "))
+	}
+	return strings.Join(parts, " · ")
+}
+
 // padContentToBottom pads content to push it to the bottom of the viewport.
 func padContentToBottom(content string, vpHeight int) string {
 	contentLines := strings.Split(content, "\n")
@@ -1527,7 +1629,7 @@ func (p *CoordinatorPanel) IsInputInNormalMode() bool {
 }
 
 // selectionBgStyle is the background highlight for selected text.
-var selectionBgStyle = lipgloss.NewStyle().Background(lipgloss.AdaptiveColor{Light: "#ADD8E6", Dark: "#264F78"})
+var selectionBgStyle lipgloss.Style
 
 // renderLineWithSelection renders a line with selection highlighting applied.
 func renderLineWithSelection(styledLine, plainLine string, lineNum, width int, selStart, selEnd *selection.Point) string {
@@ -1612,6 +1714,8 @@ type ThreadsLoadedMsg struct {
 	WorkflowID controlplane.WorkflowID
 	Channel    string                // Channel slug threads were loaded from
 	Threads    []fabricdomain.Thread // Loaded threads
+	// Participants holds participant summaries keyed by thread ID
+	Participants map[string]*fabricdomain.ThreadParticipants
 }
 
 // sendToCoordinator sends a message to the coordinator of the specified workflow.
@@ -1732,10 +1836,19 @@ func (m Model) loadThreadsForChannel(workflowID controlplane.WorkflowID, channel
 			return nil
 		}
 
+		// Participant summaries are best-effort; threads without one show no line
+		participants := make(map[string]*fabricdomain.ThreadParticipants, len(threads))
+		for _, t := range threads {
+			if summary, err := fabricSvc.GetThreadParticipants(t.ID); err == nil {
+				participants[t.ID] = summary
+			}
+		}
+
 		return ThreadsLoadedMsg{
-			WorkflowID: workflowID,
-			Channel:    channelSlug,
-			Threads:    threads,
+			WorkflowID:   workflowID,
+			Channel:      channelSlug,
+			Threads:      threads,
+			Participants: participants,
 		}
 	}
 }
@@ -1758,6 +1871,22 @@ func (m Model) handleSlashCommand(workflowID controlplane.WorkflowID, content st
 		return m.handleRetireCommand(workflowID, parts)
 	case "/replace":
 		return m.handleReplaceCommand(workflowID, parts)
+	case "/halt":
+		return m.handleHaltCommand(workflowID, parts)
+	case "/unhalt":
+		return m.handleUnhaltCommand(workflowID)
+	case "/approve":
+		return m.handleApproveCommand(workflowID, parts)
+	case "/export":
+		return m.handleExportCommand(workflowID, parts)
+	case "/graph":
+		return m.handleGraphCommand(workflowID, parts)
+	case "/failed":
+		return m, m.loadDeadLetters(workflowID)
+	case "/retry":
+		return m.handleRetryCommand(workflowID, parts, false)
+	case "/discard":
+		return m.handleRetryCommand(workflowID, parts, true)
 	default:
 		// Unknown slash commands are sent to coordinator as-is
 		return m, m.sendToCoordinator(workflowID, content)
@@ -1830,6 +1959,143 @@ func (m Model) handleReplaceCommand(workflowID controlplane.WorkflowID, parts []
 	})
 }
 
+// handleHaltCommand handles the /halt [reason] command (emergency stop).
+func (m Model) handleHaltCommand(workflowID controlplane.WorkflowID, parts []string) (Model, tea.Cmd) {
+	reason := "user_requested"
+	if len(parts) > 1 {
+		reason = strings.Join(parts[1:], " ")
+	}
+
+	return m, m.submitCommand(workflowID, func(submitter process.CommandSubmitter) {
+		cmd := command.NewEmergencyStopCommand(command.SourceUser, "user", reason)
+		submitter.Submit(cmd)
+	})
+}
+
+// handleUnhaltCommand handles the /unhalt command, lifting an emergency stop.
+func (m Model) handleUnhaltCommand(workflowID controlplane.WorkflowID) (Model, tea.Cmd) {
+	return m, m.submitCommand(workflowID, func(submitter process.CommandSubmitter) {
+		cmd := command.NewEmergencyResumeCommand(command.SourceUser)
+		submitter.Submit(cmd)
+	})
+}
+
+// handleApproveCommand handles the /approve [note] command, approving the human
+// checkpoint the workflow DAG is waiting at. The note is passed on to the coordinator.
+func (m Model) handleApproveCommand(workflowID controlplane.WorkflowID, parts []string) (Model, tea.Cmd) {
+	note := strings.Join(parts[1:], " ")
+
+	return m, m.submitCommand(workflowID, func(submitter process.CommandSubmitter) {
+		cmd := command.NewApproveCheckpointCommand(command.SourceUser, note)
+		submitter.Submit(cmd)
+	})
+}
+
+// handleExportCommand handles the /export [issue-id] command, posting the
+// active fabric thread as a comment on the given issue or the thread's linked task.
+func (m Model) handleExportCommand(workflowID controlplane.WorkflowID, parts []string) (Model, tea.Cmd) {
+	var threadID string
+	if m.coordinatorPanel != nil && m.coordinatorPanel.workflowID == workflowID {
+		threadID = m.coordinatorPanel.ActiveThreadID()
+	}
+	if threadID == "" {
+		return m, showWarning("Usage: /export [issue-id] from a thread (Ctrl+t to pick one)")
+	}
+
+	var issueID string
+	if len(parts) > 1 {
+		issueID = parts[1]
+		if !validation.IsValidTaskID(issueID) {
+			return m, showWarning("Invalid issue ID: " + issueID)
+		}
+	}
+
+	return m, m.exportThreadToIssue(workflowID, threadID, issueID)
+}
+
+// exportThreadToIssue renders a fabric thread and adds it as a bd comment.
+// If issueID is empty, the thread's linked task is used.
+func (m Model) exportThreadToIssue(workflowID controlplane.WorkflowID, threadID, issueID string) tea.Cmd {
+	return func() tea.Msg {
+		if m.controlPlane == nil || m.services.BeadsExecutor == nil {
+			return nil
+		}
+
+		wf, err := m.controlPlane.Get(context.Background(), workflowID)
+		if err != nil || wf == nil || wf.Infrastructure == nil || wf.Infrastructure.Core.FabricService == nil {
+			return nil
+		}
+
+		export, err := wf.Infrastructure.Core.FabricService.ExportThread(threadID)
+		if err != nil {
+			return mode.ShowToastMsg{Message: "Export failed: " + err.Error(), Style: toaster.StyleError}
+		}
+		if issueID == "" {
+			issueID = export.IssueID
+		}
+		if issueID == "" {
+			return mode.ShowToastMsg{Message: "Thread is not linked to a task. Use /export <issue-id>", Style: toaster.StyleWarn}
+		}
+
+		if err := m.services.BeadsExecutor.AddComment(issueID, fabricdomain.AgentUser, export.Markdown); err != nil {
+			return mode.ShowToastMsg{Message: "Export failed: " + err.Error(), Style: toaster.StyleError}
+		}
+		return mode.ShowToastMsg{Message: "Exported thread to " + issueID, Style: toaster.StyleSuccess}
+	}
+}
+
+// ThreadGraphLoadedMsg carries a fabric thread graph for the graph overlay.
+type ThreadGraphLoadedMsg struct {
+	WorkflowID controlplane.WorkflowID
+	Graph      *fabric.ThreadGraph
+}
+
+// handleGraphCommand handles the /graph [channel|thread-id] [depth] command,
+// showing the dependency tree below the given root. Without a root, the active
+// thread is used, falling back to the active channel.
+func (m Model) handleGraphCommand(workflowID controlplane.WorkflowID, parts []string) (Model, tea.Cmd) {
+	var root string
+	if len(parts) > 1 {
+		root = parts[1]
+	} else if m.coordinatorPanel != nil && m.coordinatorPanel.workflowID == workflowID {
+		root = m.coordinatorPanel.ActiveThreadID()
+		if root == "" && !m.coordinatorPanel.IsDMMode() {
+			root = m.coordinatorPanel.ActiveChannel()
+		}
+	}
+
+	depth := 0
+	if len(parts) > 2 {
+		n, err := strconv.Atoi(parts[2])
+		if err != nil || n <= 0 {
+			return m, showWarning("Usage: /graph [channel|thread-id] [depth]")
+		}
+		depth = n
+	}
+
+	return m, m.loadThreadGraph(workflowID, root, depth)
+}
+
+// loadThreadGraph loads a fabric thread graph for the graph overlay.
+func (m Model) loadThreadGraph(workflowID controlplane.WorkflowID, root string, depth int) tea.Cmd {
+	return func() tea.Msg {
+		if m.controlPlane == nil {
+			return nil
+		}
+
+		wf, err := m.controlPlane.Get(context.Background(), workflowID)
+		if err != nil || wf == nil || wf.Infrastructure == nil || wf.Infrastructure.Core.FabricService == nil {
+			return nil
+		}
+
+		graph, err := wf.Infrastructure.Core.FabricService.GetThreadGraph(root, depth)
+		if err != nil {
+			return mode.ShowToastMsg{Message: "Graph failed: " + err.Error(), Style: toaster.StyleError}
+		}
+		return ThreadGraphLoadedMsg{WorkflowID: workflowID, Graph: graph}
+	}
+}
+
 // showWarning returns a command that shows a warning toast.
 func showWarning(msg string) tea.Cmd {
 	return func() tea.Msg {
@@ -1858,3 +2124,119 @@ func (m Model) submitCommand(workflowID controlplane.WorkflowID, fn func(process
 		return nil
 	}
 }
+
+// DeadLettersLoadedMsg carries the failed commands of a workflow for /failed.
+type DeadLettersLoadedMsg struct {
+	WorkflowID  controlplane.WorkflowID
+	DeadLetters []*repository.DeadLetter
+}
+
+// deadLetterIDLength is how much of a command ID /failed shows. /retry and
+// /discard accept any unique prefix.
+const deadLetterIDLength = 8
+
+// loadDeadLetters loads the failed commands of the workflow for /failed.
+func (m Model) loadDeadLetters(workflowID controlplane.WorkflowID) tea.Cmd {
+	return func() tea.Msg {
+		if m.controlPlane == nil {
+			return nil
+		}
+
+		wf, err := m.controlPlane.Get(context.Background(), workflowID)
+		if err != nil || wf == nil || wf.Infrastructure == nil || wf.Infrastructure.Core.Processor == nil {
+			return nil
+		}
+
+		return DeadLettersLoadedMsg{WorkflowID: workflowID, DeadLetters: wf.Infrastructure.Core.Processor.DeadLetters()}
+	}
+}
+
+// renderDeadLetters renders failed commands as a system message for the coordinator pane.
+func renderDeadLetters(letters []*repository.DeadLetter) string {
+	if len(letters) == 0 {
+		return "No failed commands."
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Failed commands (%d):\n", len(letters))
+	for _, l := range letters {
+		shortID := l.CommandID
+		if len(shortID) > deadLetterIDLength {
+			shortID = shortID[:deadLetterIDLength]
+		}
+		fmt.Fprintf(&sb, "- %s %s [%s]", shortID, l.CommandType, l.LastFailed.Format("15:04:05"))
+		if l.Attempts > 1 {
+			fmt.Fprintf(&sb, " ×%d", l.Attempts)
+		}
+		fmt.Fprintf(&sb, ": %s\n", l.Error)
+		if l.Summary != "" {
+			fmt.Fprintf(&sb, "  %s\n", l.Summary)
+		}
+	}
+	sb.WriteString("Retry with /retry <id>, drop with /discard <id>.")
+	return sb.String()
+}
+
+// resolveDeadLetterID returns the command ID of the only failed command whose
+// ID starts with prefix.
+func resolveDeadLetterID(letters []*repository.DeadLetter, prefix string) (string, error) {
+	var matches []string
+	for _, l := range letters {
+		if strings.HasPrefix(l.CommandID, prefix) {
+			matches = append(matches, l.CommandID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no failed command %s (see /failed)", prefix)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%s matches %d failed commands, use a longer prefix", prefix, len(matches))
+	}
+}
+
+// handleRetryCommand handles the /retry <id> and /discard <id> commands,
+// resubmitting or dropping a failed command listed by /failed.
+func (m Model) handleRetryCommand(workflowID controlplane.WorkflowID, parts []string, discard bool) (Model, tea.Cmd) {
+	if len(parts) < 2 {
+		if discard {
+			return m, showWarning("Usage: /discard <command-id>")
+		}
+		return m, showWarning("Usage: /retry <command-id>")
+	}
+	prefix := parts[1]
+
+	return m, func() tea.Msg {
+		if m.controlPlane == nil {
+			return nil
+		}
+
+		wf, err := m.controlPlane.Get(context.Background(), workflowID)
+		if err != nil || wf == nil || wf.Infrastructure == nil || wf.Infrastructure.Core.Processor == nil {
+			return nil
+		}
+		proc := wf.Infrastructure.Core.Processor
+
+		commandID, err := resolveDeadLetterID(proc.DeadLetters(), prefix)
+		if err != nil {
+			return mode.ShowToastMsg{Message: err.Error(), Style: toaster.StyleWarn}
+		}
+
+		if discard {
+			if err := proc.DiscardDeadLetter(commandID); err != nil {
+				return mode.ShowToastMsg{Message: "Discard failed: " + err.Error(), Style: toaster.StyleError}
+			}
+			return mode.ShowToastMsg{Message: "Discarded failed command " + prefix, Style: toaster.StyleSuccess}
+		}
+
+		result, err := proc.RetryDeadLetter(context.Background(), commandID)
+		if err != nil {
+			return mode.ShowToastMsg{Message: "Retry failed: " + err.Error(), Style: toaster.StyleError}
+		}
+		if !result.Success {
+			return mode.ShowToastMsg{Message: fmt.Sprintf("Retry of %s failed again: %v", prefix, result.Error), Style: toaster.StyleError}
+		}
+		return mode.ShowToastMsg{Message: "Retried failed command " + prefix, Style: toaster.StyleSuccess}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zjrosen/perles/internal/keys"
 	"github.com/zjrosen/perles/internal/mode"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/planning"
 	"github.com/zjrosen/perles/internal/ui/details"
 	"github.com/zjrosen/perles/internal/ui/modals/issueeditor"
 	"github.com/zjrosen/perles/internal/ui/shared/toaster"
@@ -49,6 +50,7 @@ func (m Model) handleEpicTreeLoaded(msg epicTreeLoadedMsg) (mode.Controller, tea
 		// Clear tree on error so UI can show appropriate empty state
 		m.epicTree = nil
 		m.hasEpicDetail = false
+		m.epicPlan = planning.Plan{}
 		return m, nil
 	}
 
@@ -56,9 +58,12 @@ func (m Model) handleEpicTreeLoaded(msg epicTreeLoadedMsg) (mode.Controller, tea
 	if len(msg.Issues) == 0 {
 		m.epicTree = nil
 		m.hasEpicDetail = false
+		m.epicPlan = planning.Plan{}
 		return m, nil
 	}
 
+	m.epicPlan = planning.Build(msg.RootID, msg.Issues, planning.Options{})
+
 	// Build issue map for tree construction
 	issueMap := make(map[string]*beads.Issue, len(msg.Issues))
 	for i := range msg.Issues {
@@ -128,6 +133,7 @@ func (m *Model) updateEpicDetail() {
 	// Use executor and client from services for dependency loading and comments
 	m.epicDetails = details.New(node.Issue, m.services.Executor, m.services.Client).
 		SetMarkdownStyle(m.services.Config.UI.MarkdownStyle).
+		SetCustomFields(m.services.Config.FieldDefs()).
 		SetHideFooter(true)
 
 	// Set initial size so viewport is ready for scrolling
@@ -239,6 +245,11 @@ func (m Model) handleEpicTreeKeysFocusTree(msg tea.KeyMsg) (mode.Controller, tea
 		}
 		return m, nil
 
+	case "p":
+		// Toggle the details pane between the selected issue and the assignment plan
+		m.showEpicPlan = !m.showEpicPlan
+		return m, nil
+
 	case "l", "right":
 		// Switch to details pane
 		m.epicViewFocus = EpicFocusDetails
@@ -255,7 +266,11 @@ func (m Model) handleEpicTreeKeysFocusTree(msg tea.KeyMsg) (mode.Controller, tea
 			if node := m.epicTree.SelectedNode(); node != nil {
 				issue := node.Issue
 				m.editingIssue = &issue // Store for comparison on save
-				editor := issueeditor.New(issue).SetSize(m.width, m.height)
+				editor := issueeditor.New(issue, issueeditor.Config{
+					Fields:   m.services.Config.FieldDefs(),
+					Executor: m.services.Executor,
+					WorkDir:  m.services.WorkDir,
+				}).SetSize(m.width, m.height)
 				m.issueEditor = &editor
 				return m, m.issueEditor.Init()
 			}
@@ -298,7 +313,11 @@ func (m Model) handleEpicTreeKeysFocusDetails(msg tea.KeyMsg) (mode.Controller,
 			if node := m.epicTree.SelectedNode(); node != nil {
 				issue := node.Issue
 				m.editingIssue = &issue // Store for comparison on save
-				editor := issueeditor.New(issue).SetSize(m.width, m.height)
+				editor := issueeditor.New(issue, issueeditor.Config{
+					Fields:   m.services.Config.FieldDefs(),
+					Executor: m.services.Executor,
+					WorkDir:  m.services.WorkDir,
+				}).SetSize(m.width, m.height)
 				m.issueEditor = &editor
 				return m, m.issueEditor.Init()
 			}
@@ -759,7 +759,7 @@ func createIssueEditorTestModel(t *testing.T) Model {
 		Status:   beads.StatusOpen,
 		Labels:   []string{"test"},
 	}
-	editor := issueeditor.New(issue).SetSize(100, 40)
+	editor := issueeditor.New(issue, issueeditor.Config{}).SetSize(100, 40)
 	m.issueEditor = &editor
 
 	return m
@@ -1018,7 +1018,7 @@ func TestEditIssue_OpensFromTreeFocus(t *testing.T) {
 
 	// Verify modal is opened with correct issue
 	require.NotNil(t, m.issueEditor, "issue editor should be opened after ctrl+e")
-	require.Nil(t, cmd, "Init() returns nil for issue editor")
+	require.NotNil(t, cmd, "Init() starts loading the issue editor dependency pickers")
 }
 
 func TestEditIssue_OpensFromDetailsFocus(t *testing.T) {
@@ -1034,7 +1034,7 @@ func TestEditIssue_OpensFromDetailsFocus(t *testing.T) {
 
 	// Verify modal is opened
 	require.NotNil(t, m.issueEditor, "issue editor should be opened after ctrl+e from details focus")
-	require.Nil(t, cmd, "Init() returns nil for issue editor")
+	require.NotNil(t, cmd, "Init() starts loading the issue editor dependency pickers")
 }
 
 func TestEditIssue_NoOpWithNilTree(t *testing.T) {
@@ -1090,7 +1090,7 @@ func TestEditIssue_WorkflowSwitchClosesModal(t *testing.T) {
 		Status:   beads.StatusOpen,
 		Labels:   []string{"test"},
 	}
-	editor := issueeditor.New(issue).SetSize(100, 40)
+	editor := issueeditor.New(issue, issueeditor.Config{}).SetSize(100, 40)
 	m.issueEditor = &editor
 
 	require.NotNil(t, m.issueEditor, "issue editor should be open before workflow switch")
@@ -1132,3 +1132,31 @@ func TestEditIssue_SetSizeHandlesNilEditor(t *testing.T) {
 	require.Equal(t, 150, m.width, "model width should be updated")
 	require.Equal(t, 60, m.height, "model height should be updated")
 }
+
+func TestHandleEpicTreeLoadedBuildsAssignmentPlan(t *testing.T) {
+	m := createEpicTreeTestModel(t)
+	m.lastLoadedEpicID = "epic-123"
+
+	blocked := createTestIssue("task-2", "Task 2", "epic-123")
+	blocked.BlockedBy = []string{"task-1"}
+	msg := epicTreeLoadedMsg{
+		Issues: []beads.Issue{
+			createTestIssue("epic-123", "Test Epic", ""),
+			createTestIssue("task-1", "Task 1", "epic-123"),
+			blocked,
+		},
+		RootID: "epic-123",
+	}
+	result, _ := m.handleEpicTreeLoaded(msg)
+	m = result.(Model)
+
+	require.Len(t, m.epicPlan.Waves, 2)
+	require.Equal(t, []string{"task-1"}, m.epicPlan.Ready())
+
+	view := renderAssignmentPlan(m.epicPlan, 60, 10)
+	require.Contains(t, view, "Wave 1")
+	require.Contains(t, view, "task-2 Task 2 ← task-1")
+
+	// Lines past the height collapse into a "more" indicator
+	require.Contains(t, renderAssignmentPlan(m.epicPlan, 60, 2), "… 3 more")
+}
@@ -28,10 +28,15 @@ import (
 	"github.com/zjrosen/perles/internal/keys"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricdomain "github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/planning"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
 	"github.com/zjrosen/perles/internal/ui/details"
@@ -42,7 +47,9 @@ import (
 	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
 	"github.com/zjrosen/perles/internal/ui/shared/modal"
 	"github.com/zjrosen/perles/internal/ui/shared/table"
+	"github.com/zjrosen/perles/internal/ui/shared/threadgraph"
 	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+	"github.com/zjrosen/perles/internal/ui/shared/transcript"
 	"github.com/zjrosen/perles/internal/ui/shared/vimtextarea"
 	"github.com/zjrosen/perles/internal/ui/tree"
 )
@@ -118,6 +125,16 @@ type Model struct {
 	archiveModalWfID   controlplane.WorkflowID // Workflow ID to archive on confirm
 	archiveModalWfName string                  // Workflow name for display/toast
 
+	// Workflow completion report modal (nil when not showing)
+	completionModal *modal.Model
+
+	// Worker transcript viewer (nil when not showing)
+	transcriptViewer     *transcript.Model
+	transcriptWorkflowID controlplane.WorkflowID // Workflow of the worker shown in the viewer
+
+	// Fabric thread graph overlay (nil when not showing)
+	threadGraph *threadgraph.Model
+
 	// Rename modal state
 	renameModal     *formmodal.Model        // nil when not showing
 	renameModalWfID controlplane.WorkflowID // Workflow ID to rename on confirm
@@ -142,6 +159,10 @@ type Model struct {
 	hasEpicDetail    bool           // Whether epicDetails has valid content
 	epicViewFocus    EpicViewFocus  // Which pane within epic view has focus
 	lastLoadedEpicID string         // ID of the last loaded epic (for stale response detection)
+	epicPlan         planning.Plan  // Suggested assignment order of the epic's tasks
+	showEpicPlan     bool           // Whether the details pane shows the assignment plan
+	showWorkerGrid   bool           // Whether the epic section shows the live worker grid instead
+	workerGridIndex  int            // Selected card in the worker grid
 	focus            DashboardFocus // Which zone has focus (table, epic, coordinator)
 
 	// Event subscription (global - all workflows)
@@ -154,8 +175,13 @@ type Model struct {
 	gitExecutorFactory func(path string) appgit.GitExecutor
 	workDir            string
 
-	// API server port (for display in header)
-	apiPort int
+	// API server port (for display in the status bar) and token (for the session viewer)
+	apiPort  int
+	apiToken string
+
+	// Status bar state
+	sessionStart time.Time // When the dashboard was opened
+	gitBranch    string    // Current branch of workDir (loaded asynchronously)
 
 	// Debug mode enables command log tab in coordinator panel
 	debugMode bool
@@ -195,8 +221,11 @@ type Config struct {
 	// Used to create git executors for the current working directory.
 	WorkDir string
 	// APIPort is the port the HTTP API server is running on.
-	// Shown in the dashboard header for external tool integration.
+	// Shown in the status bar for external tool integration.
 	APIPort int
+	// APIToken is the token the HTTP API requires, if any.
+	// Passed to the session viewer opened in the browser.
+	APIToken string
 	// DebugMode enables the command log tab in the coordinator panel.
 	// When true, an additional tab showing command processing activity is displayed.
 	DebugMode bool
@@ -230,11 +259,14 @@ func New(cfg Config) Model {
 		gitExecutorFactory: cfg.GitExecutorFactory,
 		workDir:            cfg.WorkDir,
 		apiPort:            cfg.APIPort,
+		apiToken:           cfg.APIToken,
 		debugMode:          cfg.DebugMode,
 		vimMode:            cfg.VimMode,
 		observerEnabled:    cfg.ObserverEnabled,
 	}
 
+	m.sessionStart = m.now()
+
 	// Initialize the workflow table with config
 	m.tableConfigCache = m.createWorkflowTableConfig()
 	m.lastTableFocus = m.focus == FocusTable
@@ -250,6 +282,7 @@ func (m Model) Init() tea.Cmd {
 		m.subscribeToEvents(),
 		m.loadWorkflows(),
 		m.startHeartbeatTick(),
+		m.loadGitBranch(),
 	)
 }
 
@@ -267,6 +300,88 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 	if _, ok := msg.(heartbeatTickMsg); ok {
 		return m, m.startHeartbeatTick()
 	}
+	if msg, ok := msg.(gitBranchLoadedMsg); ok {
+		m.gitBranch = msg.branch
+		return m, nil
+	}
+	if msg, ok := msg.(transcript.ExportedMsg); ok {
+		return m, transcriptExportedToast(msg)
+	}
+
+	// The completion report is shown on top of whatever else is open
+	if m.completionModal != nil {
+		switch msg := msg.(type) {
+		case modal.SubmitMsg, modal.CancelMsg:
+			m.completionModal = nil
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			m.completionModal.SetSize(msg.Width, msg.Height)
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		case tea.KeyMsg, tea.MouseMsg:
+			var cmd tea.Cmd
+			*m.completionModal, cmd = m.completionModal.Update(msg)
+			return m, cmd
+		}
+	}
+
+	// Handle the worker transcript viewer when visible
+	if m.transcriptViewer != nil {
+		switch msg := msg.(type) {
+		case transcript.CloseMsg:
+			m.transcriptViewer = nil
+			m.transcriptWorkflowID = ""
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			viewer := m.transcriptViewer.SetSize(msg.Width, msg.Height)
+			m.transcriptViewer = &viewer
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		case tea.KeyMsg, tea.MouseMsg:
+			viewer, cmd := m.transcriptViewer.Update(msg)
+			m.transcriptViewer = &viewer
+			return m, cmd
+		}
+	}
+
+	// Handle the fabric thread graph overlay when visible
+	if m.threadGraph != nil {
+		switch msg := msg.(type) {
+		case threadgraph.CloseMsg:
+			m.threadGraph = nil
+			return m, nil
+		case tea.WindowSizeMsg:
+			m.width = msg.Width
+			m.height = msg.Height
+			graph := m.threadGraph.SetSize(msg.Width, msg.Height)
+			m.threadGraph = &graph
+			return m, nil
+		case controlplane.ControlPlaneEvent:
+			return m.handleControlPlaneEvent(msg)
+		case eventSubscriptionReadyMsg:
+			m.eventCh = msg.eventCh
+			m.unsubscribe = msg.unsubscribe
+			return m, m.listenForEvents()
+		case tea.KeyMsg, tea.MouseMsg:
+			graph, cmd := m.threadGraph.Update(msg)
+			m.threadGraph = &graph
+			return m, cmd
+		}
+	}
 
 	// If new workflow modal is open, delegate to modal
 	if m.newWorkflowModal != nil {
@@ -478,6 +593,9 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeyMsg(msg)
 
+	case commandMsg:
+		return msg.run(m)
+
 	case workflowsLoadedMsg:
 		// Preserve selection by workflow ID when list is reloaded.
 		// Workflows are sorted newest-first, so indices change when new workflows are created.
@@ -597,11 +715,32 @@ func (m Model) Update(msg tea.Msg) (mode.Controller, tea.Cmd) {
 						}
 					}
 				}
-				m.coordinatorPanel.ActivateThreadPicker(msg.Threads)
+				m.coordinatorPanel.ActivateThreadPicker(msg.Threads, msg.Participants)
 			}
 		}
 		return m, nil
 
+	case DeadLettersLoadedMsg:
+		// Show failed commands in the coordinator pane of their workflow
+		uiState := m.getOrCreateUIState(msg.WorkflowID)
+		uiState.CoordinatorMessages = append(uiState.CoordinatorMessages, chatrender.Message{
+			Role:      "system",
+			Content:   renderDeadLetters(msg.DeadLetters),
+			Timestamp: time.Now(),
+		})
+		if m.coordinatorPanel != nil && m.coordinatorPanel.workflowID == msg.WorkflowID {
+			m.coordinatorPanel.SetWorkflow(msg.WorkflowID, uiState)
+		}
+		return m, nil
+
+	case ThreadGraphLoadedMsg:
+		// Show the graph only if the user is still looking at that workflow
+		if m.coordinatorPanel != nil && m.coordinatorPanel.workflowID == msg.WorkflowID {
+			graph := threadgraph.New(msg.Graph).SetSize(m.width, m.height)
+			m.threadGraph = &graph
+		}
+		return m, nil
+
 	case vimtextarea.SubmitMsg:
 		// Forward to coordinator panel if open
 		if m.showCoordinatorPanel && m.coordinatorPanel != nil {
@@ -650,6 +789,11 @@ func (m Model) View() string {
 	// Get the base dashboard view
 	dashboardView := m.renderView()
 
+	// Workflow completion report overlays everything else
+	if m.completionModal != nil {
+		return zone.Scan(m.completionModal.Overlay(dashboardView))
+	}
+
 	// Issue editor modal overlay (checked before help modal)
 	// Note: issueeditor.Overlay() delegates to formmodal.Overlay() which
 	// calls zone.Scan() internally, so no manual zone.Scan() wrapping needed.
@@ -657,6 +801,16 @@ func (m Model) View() string {
 		return m.issueEditor.Overlay(dashboardView)
 	}
 
+	// Worker transcript viewer overlay
+	if m.transcriptViewer != nil {
+		return zone.Scan(m.transcriptViewer.Overlay(dashboardView))
+	}
+
+	// Fabric thread graph overlay
+	if m.threadGraph != nil {
+		return zone.Scan(m.threadGraph.Overlay(dashboardView))
+	}
+
 	// If help modal is showing, render it as an overlay
 	if m.showHelp {
 		return zone.Scan(m.helpModal.Overlay(dashboardView))
@@ -690,6 +844,14 @@ func (m Model) SetSize(width, height int) mode.Controller {
 		m.newWorkflowModal = m.newWorkflowModal.SetSize(width, height)
 	}
 	m.helpModal = m.helpModal.SetSize(width, height)
+	if m.transcriptViewer != nil {
+		viewer := m.transcriptViewer.SetSize(width, height)
+		m.transcriptViewer = &viewer
+	}
+	if m.threadGraph != nil {
+		graph := m.threadGraph.SetSize(width, height)
+		m.threadGraph = &graph
+	}
 	if m.issueEditor != nil {
 		editor := m.issueEditor.SetSize(width, height)
 		m.issueEditor = &editor
@@ -931,18 +1093,17 @@ func (m Model) handleTableKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 
 	// Quick actions
 	case "s": // Start or Resume workflow
-		workflow := m.SelectedWorkflow()
-		if workflow == nil {
-			return m, nil
-		}
-		if workflow.State == controlplane.WorkflowPaused {
-			return m.resumeSelectedWorkflow()
-		}
-		return m.startSelectedWorkflow()
+		return m.startOrResumeSelectedWorkflow()
 
 	case "x": // Pause workflow
 		return m.pauseSelectedWorkflow()
 
+	case "!": // Emergency stop: halt all workers in the selected workflow
+		return m.emergencyStopSelectedWorkflow()
+
+	case "u": // Jump to the next workflow with a notification or warning
+		return m.jumpToAttention()
+
 	case "a": // Archive workflow (only when session persistence is enabled)
 		return m.archiveSelectedWorkflow()
 
@@ -955,6 +1116,9 @@ func (m Model) handleTableKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 	case "ctrl+w": // Toggle coordinator chat panel
 		return m.toggleCoordinatorPanel()
 
+	case "w": // Toggle the live worker grid below the table
+		return m.toggleWorkerGrid()
+
 	case "ctrl+k": // Previous tab in coordinator panel
 		if m.showCoordinatorPanel && m.coordinatorPanel != nil {
 			m.coordinatorPanel.PrevTab()
@@ -1008,10 +1172,18 @@ func (m Model) handleEpicTreeKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
 	case "ctrl+w": // Toggle coordinator chat panel
 		return m.toggleCoordinatorPanel()
 
+	case "w": // Toggle between the epic tree and the worker grid
+		return m.toggleWorkerGrid()
+
 	case "q", "ctrl+c", "esc":
 		return m, func() tea.Msg { return QuitMsg{} }
 	}
 
+	// Dispatch to the worker grid when it replaces the epic tree
+	if m.showWorkerGrid {
+		return m.handleWorkerGridKeys(msg)
+	}
+
 	// Dispatch to pane-specific handler
 	switch m.epicViewFocus {
 	case EpicFocusTree:
@@ -1099,6 +1271,11 @@ func (m Model) handleCoordinatorKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd)
 func (m Model) handleMouseMsg(msg tea.MouseMsg) (mode.Controller, tea.Cmd) {
 	// Only handle left-click release events for zone selection
 	if msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionRelease {
+		// Check status bar segments
+		if next, cmd, handled := m.handleStatusBarClick(msg); handled {
+			return next, cmd
+		}
+
 		// Check workflow row zones
 		filtered := m.getFilteredWorkflows()
 		for i := range filtered {
@@ -1253,6 +1430,9 @@ func (m *Model) openCoordinatorPanelForSelected() {
 	// Create new panel (pass debugMode for command log tab, vimMode for input, observerEnabled, clipboard for copy)
 	panel := NewCoordinatorPanel(m.debugMode, m.vimMode, m.observerEnabled, m.services.Clipboard)
 	panel.SetSize(CoordinatorPanelWidth, m.height)
+	if m.services.Config != nil {
+		panel.SetMarkdownStyle(m.services.Config.UI.MarkdownStyle)
+	}
 
 	// Load cached state for this workflow (ensures state exists)
 	uiState := m.getOrCreateUIState(wf.ID)
@@ -1276,6 +1456,18 @@ func (m Model) handleControlPlaneEvent(event controlplane.ControlPlaneEvent) (mo
 		delete(m.workflowUIState, event.WorkflowID)
 	}
 
+	// Long-running command progress is shown by the app-level toaster
+	if event.Type == controlplane.EventCommandProgress {
+		return m, tea.Batch(m.commandProgressCmd(event), m.listenForEvents())
+	}
+
+	// Show the cost report of a workflow that just completed
+	if event.Type == controlplane.EventWorkflowCompleted {
+		if pe, ok := event.Payload.(events.ProcessEvent); ok && pe.CostReport != nil {
+			m = m.openCompletionModal(event.WorkflowName, pe.CostReport)
+		}
+	}
+
 	// Refresh workflow list on any lifecycle event
 	if event.Type.IsLifecycleEvent() {
 		return m, tea.Batch(
@@ -1294,12 +1486,84 @@ func (m Model) handleControlPlaneEvent(event controlplane.ControlPlaneEvent) (mo
 			uiState := m.getOrCreateUIState(event.WorkflowID)
 			m.coordinatorPanel.SetWorkflow(event.WorkflowID, uiState)
 		}
+
+		// Stream new output into the transcript viewer
+		if m.transcriptViewer != nil && m.transcriptWorkflowID == event.WorkflowID {
+			uiState := m.getOrCreateUIState(event.WorkflowID)
+			viewer := m.transcriptViewer.SetMessages(uiState.WorkerMessages[m.transcriptViewer.WorkerID()])
+			m.transcriptViewer = &viewer
+		}
 	}
 
 	// For other events, just continue listening
 	return m, m.listenForEvents()
 }
 
+// openCompletionModal shows the cost report of a completed workflow.
+func (m Model) openCompletionModal(workflowName string, report *metrics.CostReport) Model {
+	title := "Workflow Complete"
+	if workflowName != "" {
+		title += ": " + workflowName
+	}
+	completionModal := modal.New(modal.Config{
+		Title:       title,
+		Message:     report.Text() + "\n\nFull report: " + metrics.CostReportMarkdownFile + " in the session directory\n\n[enter/esc] close",
+		MinWidth:    72,
+		HideButtons: true,
+	})
+	completionModal.SetSize(m.width, m.height)
+	m.completionModal = &completionModal
+	return m
+}
+
+// commandProgressCmd converts a CommandProgressEvent into a progress indicator
+// update: started commands show a spinner, finished ones a completion toast.
+func (m Model) commandProgressCmd(event controlplane.ControlPlaneEvent) tea.Cmd {
+	progress, ok := event.Payload.(processor.CommandProgressEvent)
+	if !ok {
+		return nil
+	}
+
+	id := string(event.WorkflowID) + "/" + progress.CommandID
+	if !progress.Phase.IsTerminal() {
+		p := toaster.Progress{ID: id, Label: progress.Label, StartedAt: progress.StartedAt}
+		if progress.Cancelable {
+			p.Cancel = m.cancelCommand(event.WorkflowID, progress.CommandID)
+		}
+		return func() tea.Msg { return mode.StartProgressMsg{Progress: p} }
+	}
+
+	finish := mode.FinishProgressMsg{ID: id}
+	elapsed := formatDuration(progress.Elapsed)
+	switch progress.Phase {
+	case processor.ProgressSucceeded:
+		finish.Message = fmt.Sprintf("%s done (%s)", progress.Label, elapsed)
+		finish.Style = toaster.StyleSuccess
+	case processor.ProgressCanceled:
+		finish.Message = fmt.Sprintf("%s canceled (%s)", progress.Label, elapsed)
+		finish.Style = toaster.StyleWarn
+	default:
+		finish.Message = fmt.Sprintf("%s failed: %v", progress.Label, progress.Error)
+		finish.Style = toaster.StyleError
+	}
+	return func() tea.Msg { return finish }
+}
+
+// cancelCommand returns a function that cancels an in-flight command of the
+// specified workflow. It returns false if the command is no longer running.
+func (m Model) cancelCommand(workflowID controlplane.WorkflowID, commandID string) func() bool {
+	return func() bool {
+		if m.controlPlane == nil {
+			return false
+		}
+		wf, err := m.controlPlane.Get(context.Background(), workflowID)
+		if err != nil || wf == nil || wf.Infrastructure == nil || wf.Infrastructure.Core.Progress == nil {
+			return false
+		}
+		return wf.Infrastructure.Core.Progress.Cancel(commandID)
+	}
+}
+
 // handleStartWorkflowFailed handles errors when starting a workflow fails.
 // It converts worktree-specific errors to user-friendly messages.
 func (m Model) handleStartWorkflowFailed(msg StartWorkflowFailedMsg) (mode.Controller, tea.Cmd) {
@@ -1451,6 +1715,17 @@ func (m *Model) updateCachedUIState(event controlplane.ControlPlaneEvent) {
 			case events.ProcessQueueChanged:
 				// Queue changed events - update queue count
 				uiState.WorkerQueueCounts[workerID] = payload.QueueCount
+			case events.ProcessTelemetry:
+				// Structured telemetry - accumulate for the worker pane summary
+				if uiState.WorkerTelemetry == nil {
+					uiState.WorkerTelemetry = make(map[string]*WorkerTelemetry)
+				}
+				tel := uiState.WorkerTelemetry[workerID]
+				if tel == nil {
+					tel = &WorkerTelemetry{}
+					uiState.WorkerTelemetry[workerID] = tel
+				}
+				tel.Apply(payload.Telemetry)
 			default:
 				// For other event types, use the Status field if present
 				if payload.Status != "" {
@@ -1461,9 +1736,18 @@ func (m *Model) updateCachedUIState(event controlplane.ControlPlaneEvent) {
 					m.appendWorkerMessageToCache(uiState, payload)
 				}
 			}
-			// Update phase if present
+			// Update phase and task if present
 			if payload.Phase != nil {
 				uiState.WorkerPhases[workerID] = *payload.Phase
+				if *payload.Phase == events.ProcessPhaseIdle {
+					delete(uiState.WorkerTasks, workerID)
+				}
+			}
+			if payload.TaskID != "" {
+				if uiState.WorkerTasks == nil {
+					uiState.WorkerTasks = make(map[string]string)
+				}
+				uiState.WorkerTasks[workerID] = payload.TaskID
 			}
 		}
 
@@ -1498,9 +1782,21 @@ func (m *Model) updateCachedUIState(event controlplane.ControlPlaneEvent) {
 	case controlplane.EventFabricPosted:
 		// Filter to only store message.posted and reply.posted events.
 		// These are the only event types with user-visible content (Thread.Content).
-		// Other fabric events (subscribed, acked, channel.created) are control signals
-		// without content and would clutter the message pane.
+		// Edits and deletions update the stored message in place, receipts are kept
+		// by message for the read markers. Other fabric events
+		// (subscribed, acked, channel.created) are control signals without content
+		// and would clutter the message pane.
 		if fabricEvent, ok := event.Payload.(fabric.Event); ok {
+			if fabricEvent.Type == fabric.EventMessageEdited ||
+				fabricEvent.Type == fabric.EventMessageDeleted {
+				updateFabricEventThread(uiState.FabricEvents, fabricEvent.Thread)
+			}
+			if fabricEvent.Type == fabric.EventReceiptsUpdated {
+				if uiState.FabricReceipts == nil {
+					uiState.FabricReceipts = make(map[string][]fabricdomain.Receipt)
+				}
+				updateFabricReceipts(uiState.FabricReceipts, fabricEvent.Receipts)
+			}
 			if fabricEvent.Type == fabric.EventMessagePosted ||
 				fabricEvent.Type == fabric.EventReplyPosted {
 				uiState.FabricEvents = append(uiState.FabricEvents, fabricEvent)
@@ -1508,11 +1804,40 @@ func (m *Model) updateCachedUIState(event controlplane.ControlPlaneEvent) {
 				// 500 events is chosen to provide sufficient history while limiting
 				// memory growth to approximately 500KB per workflow (assuming ~1KB/event).
 				if len(uiState.FabricEvents) > maxFabricEvents {
+					if evicted := uiState.FabricEvents[0].Thread; evicted != nil {
+						delete(uiState.FabricReceipts, evicted.ID)
+					}
 					uiState.FabricEvents = uiState.FabricEvents[1:]
 				}
 			}
 		}
 
+	case controlplane.EventAutoscale:
+		// Surface worker pool scaling decisions in the coordinator pane
+		if d, ok := event.Payload.(autoscale.Decision); ok {
+			if d.OverBudget {
+				uiState.GuardrailWarning, uiState.GuardrailWarningAt = d.Reason, m.now()
+			} else {
+				// The autoscaler acting again means the guardrail no longer blocks it
+				uiState.GuardrailWarning, uiState.GuardrailWarningAt = "", time.Time{}
+			}
+			uiState.CoordinatorMessages = append(uiState.CoordinatorMessages, chatrender.Message{
+				Role:      "system",
+				Content:   "Autoscaler: " + d.Summary(),
+				Timestamp: d.Timestamp,
+			})
+		}
+
+	case controlplane.EventPhaseTimeout:
+		// Surface escalations of workers stuck in a phase in the coordinator pane
+		if e, ok := event.Payload.(processor.PhaseTimeoutEvent); ok {
+			uiState.CoordinatorMessages = append(uiState.CoordinatorMessages, chatrender.Message{
+				Role:      "system",
+				Content:   "Phase timeout: " + e.Summary(),
+				Timestamp: e.Timestamp,
+			})
+		}
+
 	case controlplane.EventUserNotification:
 		// Set notification flag to highlight this workflow row
 		uiState.HasNotification = true
@@ -1663,6 +1988,19 @@ func (m *Model) removeWorkerFromCache(state *WorkflowUIState, workerID string) {
 
 // === Action handlers ===
 
+// startOrResumeSelectedWorkflow resumes the selected workflow when it is paused
+// and starts it otherwise.
+func (m Model) startOrResumeSelectedWorkflow() (mode.Controller, tea.Cmd) {
+	workflow := m.SelectedWorkflow()
+	if workflow == nil {
+		return m, nil
+	}
+	if workflow.State == controlplane.WorkflowPaused {
+		return m.resumeSelectedWorkflow()
+	}
+	return m.startSelectedWorkflow()
+}
+
 // startSelectedWorkflow starts the currently selected workflow.
 func (m Model) startSelectedWorkflow() (mode.Controller, tea.Cmd) {
 	wf := m.SelectedWorkflow()
@@ -1767,6 +2105,38 @@ func (m Model) pauseSelectedWorkflow() (mode.Controller, tea.Cmd) {
 	}
 }
 
+// emergencyStopSelectedWorkflow halts every worker in the selected running workflow
+// and broadcasts a HALT notice. Workers stay paused until the user runs /unhalt.
+func (m Model) emergencyStopSelectedWorkflow() (mode.Controller, tea.Cmd) {
+	workflow := m.SelectedWorkflow()
+	if workflow == nil {
+		return m, nil
+	}
+	if workflow.IsLocked {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "🔒 Workflow is owned by another Perles process",
+				Style:   toaster.StyleWarn,
+			}
+		}
+	}
+	if !workflow.IsRunning() {
+		return m, showWarning("Emergency stop only applies to running workflows")
+	}
+
+	return m, tea.Batch(
+		m.submitCommand(workflow.ID, func(submitter process.CommandSubmitter) {
+			submitter.Submit(command.NewEmergencyStopCommand(command.SourceUser, "user", "emergency stop from dashboard"))
+		}),
+		func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: "🛑 Emergency stop: all workers halted. Use /unhalt to resume.",
+				Style:   toaster.StyleError,
+			}
+		},
+	)
+}
+
 // archiveSelectedWorkflow shows the archive confirmation modal after validating the workflow.
 // This is only available when session persistence is enabled.
 func (m Model) archiveSelectedWorkflow() (mode.Controller, tea.Cmd) {
@@ -1841,6 +2211,9 @@ func (m Model) openSessionInBrowser() (mode.Controller, tea.Cmd) {
 	// Build the session viewer URL with URL-encoded path
 	encodedPath := url.QueryEscape(workflow.SessionDir)
 	viewerURL := fmt.Sprintf("http://localhost:%d/?path=%s", m.apiPort, encodedPath)
+	if m.apiToken != "" {
+		viewerURL += "&token=" + url.QueryEscape(m.apiToken)
+	}
 
 	// Attempt to open the browser
 	if err := frontend.OpenBrowser(viewerURL); err != nil {
@@ -2056,6 +2429,7 @@ func (m *Model) handleWorkflowSelectionChange(newIndex int) tea.Cmd {
 
 	// Update selection
 	m.selectedIndex = newIndex
+	m.workerGridIndex = 0
 
 	// Close issue editor if open when switching workflows (prevents stale issue references)
 	m.issueEditor = nil
@@ -17,9 +17,17 @@ import (
 	domaingit "github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	controlplanemocks "github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/ui/shared/editor"
 	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
 	"github.com/zjrosen/perles/internal/ui/shared/modal"
@@ -254,6 +262,183 @@ func TestModel_PauseAction_DoesNotPauseNonRunningWorkflows(t *testing.T) {
 	require.Contains(t, toastMsg.Message, "Cannot pause")
 }
 
+// recordingSubmitter captures commands submitted by the dashboard.
+type recordingSubmitter struct {
+	cmds []command.Command
+}
+
+func (r *recordingSubmitter) Submit(cmd command.Command) {
+	r.cmds = append(r.cmds, cmd)
+}
+
+func TestModel_EmergencyStopAction_SubmitsEmergencyStop(t *testing.T) {
+	submitter := &recordingSubmitter{}
+	wf := createTestWorkflow("wf-running", "Running Workflow", controlplane.WorkflowRunning)
+	wf.Infrastructure = &v2.Infrastructure{Core: v2.CoreComponents{CmdSubmitter: submitter}}
+
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	mockCP.On("Get", mock.Anything, controlplane.WorkflowID("wf-running")).Return(wf, nil).Once()
+	m.workflows = []*controlplane.WorkflowInstance{wf}
+	m.selectedIndex = 0
+
+	// Press ! to trigger the emergency stop
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	require.NotNil(t, cmd)
+
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok, "expected tea.BatchMsg")
+	var toast *mode.ShowToastMsg
+	for _, c := range batch {
+		if msg, ok := c().(mode.ShowToastMsg); ok {
+			toast = &msg
+		}
+	}
+
+	require.NotNil(t, toast)
+	require.Contains(t, toast.Message, "Emergency stop")
+	require.Len(t, submitter.cmds, 1)
+	stopCmd, ok := submitter.cmds[0].(*command.EmergencyStopCommand)
+	require.True(t, ok)
+	require.Equal(t, "user", stopCmd.IssuedBy)
+	require.Equal(t, command.SourceUser, stopCmd.Source())
+}
+
+func TestModel_ExportCommand_CommentsThreadOnLinkedIssue(t *testing.T) {
+	threadRepo := fabricrepo.NewMemoryThreadRepository()
+	depRepo := fabricrepo.NewMemoryDependencyRepository()
+	subRepo := fabricrepo.NewMemorySubscriptionRepository()
+	fabricSvc := fabric.NewService(threadRepo, depRepo, subRepo,
+		fabricrepo.NewMemoryAckRepository(depRepo, threadRepo, subRepo), fabricrepo.NewMemoryParticipantRepository())
+	require.NoError(t, fabricSvc.InitSession("coordinator"))
+	root, err := fabricSvc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: "tasks",
+		Content:     "Task: Add login [perles-ab1] assigned to worker-1",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+
+	wf := createTestWorkflow("wf-running", "Running Workflow", controlplane.WorkflowRunning)
+	wf.Infrastructure = &v2.Infrastructure{Core: v2.CoreComponents{FabricService: fabricSvc}}
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	mockCP.On("Get", mock.Anything, controlplane.WorkflowID("wf-running")).Return(wf, nil).Once()
+
+	mockExec := mocks.NewMockIssueExecutor(t)
+	mockExec.EXPECT().AddComment("perles-ab1", "user", mock.MatchedBy(func(text string) bool {
+		return strings.Contains(text, "Task: Add login")
+	})).Return(nil).Once()
+	m.services.BeadsExecutor = mockExec
+
+	// Select the task thread in #tasks
+	m.coordinatorPanel = NewCoordinatorPanel(false, false, false, nil)
+	m.coordinatorPanel.workflowID = wf.ID
+	for m.coordinatorPanel.ActiveChannel() != "tasks" {
+		m.coordinatorPanel.CycleChannel()
+	}
+	m.coordinatorPanel.SetActiveThread(root.ID)
+
+	_, cmd := m.handleSlashCommand(wf.ID, "/export")
+	require.NotNil(t, cmd)
+
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Exported thread to perles-ab1", toast.Message)
+	require.Equal(t, toaster.StyleSuccess, toast.Style)
+}
+
+func TestModel_ExportCommand_Validation(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+	m.coordinatorPanel = NewCoordinatorPanel(false, false, false, nil)
+	m.coordinatorPanel.workflowID = "wf-1"
+
+	// No active thread
+	_, cmd := m.handleSlashCommand("wf-1", "/export")
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Contains(t, toast.Message, "Usage: /export")
+
+	m.coordinatorPanel.CycleChannel()
+	m.coordinatorPanel.SetActiveThread("msg-1")
+	_, cmd = m.handleSlashCommand("wf-1", "/export bad!")
+	toast, ok = cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, "Invalid issue ID: bad!", toast.Message)
+}
+
+func TestModel_GraphCommand_ShowsActiveChannelTree(t *testing.T) {
+	threadRepo := fabricrepo.NewMemoryThreadRepository()
+	depRepo := fabricrepo.NewMemoryDependencyRepository()
+	subRepo := fabricrepo.NewMemorySubscriptionRepository()
+	fabricSvc := fabric.NewService(threadRepo, depRepo, subRepo,
+		fabricrepo.NewMemoryAckRepository(depRepo, threadRepo, subRepo), fabricrepo.NewMemoryParticipantRepository())
+	require.NoError(t, fabricSvc.InitSession("coordinator"))
+	root, err := fabricSvc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: "tasks",
+		Content:     "Implement the parser",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+	_, err = fabricSvc.Reply(fabric.ReplyInput{MessageID: root.ID, Content: "On it", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+
+	wf := createTestWorkflow("wf-running", "Running Workflow", controlplane.WorkflowRunning)
+	wf.Infrastructure = &v2.Infrastructure{Core: v2.CoreComponents{FabricService: fabricSvc}}
+	m, mockCP := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	mockCP.On("Get", mock.Anything, controlplane.WorkflowID("wf-running")).Return(wf, nil).Once()
+	m = m.SetSize(160, 40).(Model)
+
+	m.coordinatorPanel = NewCoordinatorPanel(false, false, false, nil)
+	m.coordinatorPanel.workflowID = wf.ID
+	for m.coordinatorPanel.ActiveChannel() != "tasks" {
+		m.coordinatorPanel.CycleChannel()
+	}
+
+	_, cmd := m.handleSlashCommand(wf.ID, "/graph")
+	require.NotNil(t, cmd)
+	loaded, ok := cmd().(ThreadGraphLoadedMsg)
+	require.True(t, ok)
+	require.Equal(t, fabricSvc.GetChannelID("tasks"), loaded.Graph.RootID)
+
+	result, _ := m.Update(loaded)
+	m = result.(Model)
+	require.NotNil(t, m.threadGraph)
+	view := m.View()
+	require.Contains(t, view, "Thread Graph · #tasks")
+	require.Contains(t, view, "coordinator: Implement the parser")
+	require.Contains(t, view, "reply_to worker-1: On it")
+
+	result, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	result, _ = m.Update(cmd())
+	m = result.(Model)
+	require.Nil(t, m.threadGraph)
+}
+
+func TestModel_GraphCommand_Validation(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+	m.coordinatorPanel = NewCoordinatorPanel(false, false, false, nil)
+	m.coordinatorPanel.workflowID = "wf-1"
+
+	_, cmd := m.handleSlashCommand("wf-1", "/graph tasks deep")
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Contains(t, toast.Message, "Usage: /graph")
+}
+
+func TestModel_EmergencyStopAction_IgnoresNonRunningWorkflows(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-paused", "Paused Workflow", controlplane.WorkflowPaused),
+	}
+
+	m, _ := createTestModel(t, workflows)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	require.NotNil(t, cmd)
+
+	toastMsg, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "expected ShowToastMsg")
+	require.Contains(t, toastMsg.Message, "only applies to running workflows")
+}
+
 func TestModel_ResumeAction_CallsControlPlaneResume(t *testing.T) {
 	workflows := []*controlplane.WorkflowInstance{
 		createTestWorkflow("wf-paused", "Paused Workflow", controlplane.WorkflowPaused),
@@ -329,6 +514,114 @@ func TestModel_EventsTriggersViewRefresh(t *testing.T) {
 	require.NotNil(t, cmd)
 }
 
+func TestModel_WorkflowCompletedShowsCostReport(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+	m, mockCP := createTestModel(t, workflows)
+	mockCP.On("List", mock.Anything, mock.Anything).Return(workflows, nil).Maybe()
+
+	// The completion event from Complete() carries no report
+	result, _ := m.Update(controlplane.ControlPlaneEvent{Type: controlplane.EventWorkflowCompleted, WorkflowID: "wf-1"})
+	m = result.(Model)
+	require.Nil(t, m.completionModal)
+
+	event := controlplane.ControlPlaneEvent{
+		Type:         controlplane.EventWorkflowCompleted,
+		WorkflowID:   "wf-1",
+		WorkflowName: "Workflow 1",
+		Payload: events.ProcessEvent{
+			Type:       events.ProcessWorkflowComplete,
+			CostReport: &metrics.CostReport{Status: "success", TasksClosed: 3, CostUSD: 1.5},
+		},
+	}
+	result, _ = m.Update(event)
+	m = result.(Model)
+	require.NotNil(t, m.completionModal)
+	require.Contains(t, m.View(), "Workflow Complete: Workflow 1")
+	require.Contains(t, m.View(), "Workflow success in 0s: $1.50")
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	result, _ = m.Update(cmd())
+	m = result.(Model)
+	require.Nil(t, m.completionModal)
+}
+
+func TestModel_CommandProgressStartedShowsCancelableProgress(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+	m, mockCP := createTestModel(t, workflows)
+
+	tracker := processor.NewProgressTracker(processor.ProgressTrackerConfig{})
+	wf := createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning)
+	wf.Infrastructure = &v2.Infrastructure{Core: v2.CoreComponents{Progress: tracker}}
+	mockCP.On("Get", mock.Anything, controlplane.WorkflowID("wf-1")).Return(wf, nil).Once()
+
+	startedAt := time.Now()
+	cmd := m.commandProgressCmd(controlplane.ControlPlaneEvent{
+		Type:       controlplane.EventCommandProgress,
+		WorkflowID: "wf-1",
+		Payload: processor.CommandProgressEvent{
+			CommandID:  "cmd-1",
+			Label:      "Spawning worker",
+			Phase:      processor.ProgressStarted,
+			Cancelable: true,
+			StartedAt:  startedAt,
+		},
+	})
+	require.NotNil(t, cmd)
+
+	msg, ok := cmd().(mode.StartProgressMsg)
+	require.True(t, ok, "command should produce StartProgressMsg")
+	require.Equal(t, "wf-1/cmd-1", msg.Progress.ID)
+	require.Equal(t, "Spawning worker", msg.Progress.Label)
+	require.Equal(t, startedAt, msg.Progress.StartedAt)
+	require.True(t, msg.Progress.Cancelable())
+
+	// The command is not in flight, so the tracker has nothing to cancel
+	require.False(t, msg.Progress.Cancel())
+}
+
+func TestModel_CommandProgressFinishedShowsToast(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+
+	tests := []struct {
+		name    string
+		phase   processor.ProgressPhase
+		err     error
+		style   toaster.Style
+		message string
+	}{
+		{"succeeded", processor.ProgressSucceeded, nil, toaster.StyleSuccess, "Spawning worker done (4s)"},
+		{"failed", processor.ProgressFailed, errors.New("boom"), toaster.StyleError, "Spawning worker failed: boom"},
+		{"canceled", processor.ProgressCanceled, processor.ErrCommandCanceled, toaster.StyleWarn, "Spawning worker canceled (4s)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := m.commandProgressCmd(controlplane.ControlPlaneEvent{
+				Type:       controlplane.EventCommandProgress,
+				WorkflowID: "wf-1",
+				Payload: processor.CommandProgressEvent{
+					CommandID: "cmd-1",
+					Label:     "Spawning worker",
+					Phase:     tt.phase,
+					Elapsed:   4200 * time.Millisecond,
+					Error:     tt.err,
+				},
+			})
+			require.NotNil(t, cmd)
+
+			msg, ok := cmd().(mode.FinishProgressMsg)
+			require.True(t, ok, "command should produce FinishProgressMsg")
+			require.Equal(t, "wf-1/cmd-1", msg.ID)
+			require.Equal(t, tt.message, msg.Message)
+			require.Equal(t, tt.style, msg.Style)
+		})
+	}
+}
+
 // === Unit Tests: Workflow Selection ===
 
 func TestModel_SelectedWorkflow_ReturnsCorrectWorkflow(t *testing.T) {
@@ -1916,6 +2209,57 @@ func TestModel_UserNotification_SetsFlag(t *testing.T) {
 	require.True(t, m.workflowUIState["wf-1"].HasNotification)
 }
 
+func TestModel_AutoscaleDecision_AddsSystemMessage(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+
+	m, _ := createTestModel(t, workflows)
+
+	event := controlplane.ControlPlaneEvent{
+		Type:       controlplane.EventAutoscale,
+		WorkflowID: "wf-1",
+		Payload: autoscale.Decision{
+			Action:        autoscale.ActionScaleUp,
+			Spawn:         2,
+			ReadyTasks:    3,
+			ActiveWorkers: 1,
+		},
+	}
+	m.updateCachedUIState(event)
+
+	msgs := m.workflowUIState["wf-1"].CoordinatorMessages
+	require.Len(t, msgs, 1)
+	require.Equal(t, "system", msgs[0].Role)
+	require.Equal(t, "Autoscaler: spawning 2 workers (3 ready tasks, 0 idle of 1 worker)", msgs[0].Content)
+}
+
+func TestModel_PhaseTimeout_AddsSystemMessage(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+
+	m, _ := createTestModel(t, workflows)
+
+	event := controlplane.ControlPlaneEvent{
+		Type:       controlplane.EventPhaseTimeout,
+		WorkflowID: "wf-1",
+		Payload: processor.PhaseTimeoutEvent{
+			WorkerID: "worker-2",
+			Phase:    events.ProcessPhaseReviewing,
+			Action:   processor.PhaseTimeoutNotifyCoordinator,
+			Elapsed:  2 * time.Hour,
+			Timeout:  time.Hour,
+		},
+	}
+	m.updateCachedUIState(event)
+
+	msgs := m.workflowUIState["wf-1"].CoordinatorMessages
+	require.Len(t, msgs, 1)
+	require.Equal(t, "system", msgs[0].Role)
+	require.Equal(t, "Phase timeout: worker-2 has been reviewing for 2h0m0s (limit 1h0m0s), coordinator notified", msgs[0].Content)
+}
+
 func TestModel_QueueCount_UpdatedOnQueueChangedEvent(t *testing.T) {
 	workflows := []*controlplane.WorkflowInstance{
 		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
@@ -1999,6 +2343,39 @@ func TestModel_WorkerQueueCount_UpdatedOnQueueChangedEvent(t *testing.T) {
 	require.Equal(t, 2, m.workflowUIState["wf-1"].WorkerQueueCounts["worker-1"])
 }
 
+func TestModel_WorkerTelemetry_AccumulatedOnTelemetryEvent(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+
+	m, _ := createTestModel(t, workflows)
+
+	state := m.getOrCreateUIState("wf-1")
+	state.WorkerIDs = []string{"worker-1"}
+
+	for _, tel := range []*client.TelemetryEvent{
+		{Type: client.TelemetryFileEdit, Path: "main.go", LinesAdded: 5, LinesRemoved: 1},
+		{Type: client.TelemetryTestRun, Status: client.TestRunPassed, Passed: 12},
+	} {
+		m.updateCachedUIState(controlplane.ControlPlaneEvent{
+			Type:       controlplane.EventWorkerOutput,
+			WorkflowID: "wf-1",
+			Payload: events.ProcessEvent{
+				Type:      events.ProcessTelemetry,
+				ProcessID: "worker-1",
+				Role:      events.RoleWorker,
+				Telemetry: tel,
+			},
+		})
+	}
+
+	tel := m.workflowUIState["wf-1"].WorkerTelemetry["worker-1"]
+	require.NotNil(t, tel)
+	require.Equal(t, "✓ 12 tests · 1 file +5/-1", tel.Summary())
+	// Telemetry never becomes chat output.
+	require.Empty(t, m.workflowUIState["wf-1"].WorkerMessages["worker-1"])
+}
+
 func TestModel_UserNotification_ClearedOnEnter(t *testing.T) {
 	workflows := []*controlplane.WorkflowInstance{
 		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
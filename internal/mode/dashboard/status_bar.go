@@ -0,0 +1,253 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	zone "github.com/lrstanley/bubblezone"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// statusBarSeparator separates status bar segments.
+const statusBarSeparator = " │ "
+
+// guardrailWarningTTL is how long a guardrail warning stays in the status bar
+// when nothing clears it.
+const guardrailWarningTTL = 15 * time.Minute
+
+// gitBranchLoadedMsg carries the current branch of the working directory.
+type gitBranchLoadedMsg struct {
+	branch string
+}
+
+// StatusVitals is the session summary shown in the status bar.
+type StatusVitals struct {
+	// Elapsed is how long the dashboard session has been open.
+	Elapsed time.Duration
+	// Workers is the number of live workers in the selected workflow.
+	Workers int
+	// Phases summarizes worker phases, e.g. "2 impl · 1 review".
+	Phases string
+	// Notifications counts workflows with an unread user notification.
+	Notifications int
+	// Branch is the selected workflow's worktree branch, or the working directory branch.
+	Branch string
+	// Warnings lists guardrail warnings across workflows, e.g. "Build: unhealthy".
+	Warnings []string
+}
+
+// statusVitals collects the status bar data from the current model state.
+func (m Model) statusVitals() StatusVitals {
+	v := StatusVitals{
+		Elapsed: m.now().Sub(m.sessionStart),
+		Branch:  m.gitBranch,
+	}
+
+	if wf := m.SelectedWorkflow(); wf != nil {
+		if wf.WorktreeBranch != "" {
+			v.Branch = wf.WorktreeBranch
+		}
+		if uiState, ok := m.workflowUIState[wf.ID]; ok {
+			v.Workers, v.Phases = summarizeWorkers(uiState)
+		}
+	}
+
+	for _, wf := range m.workflows {
+		if uiState, ok := m.workflowUIState[wf.ID]; ok && uiState.HasNotification {
+			v.Notifications++
+		}
+		if warning := m.workflowWarning(wf); warning != "" {
+			v.Warnings = append(v.Warnings, fmt.Sprintf("%s: %s", wf.Name, warning))
+		}
+	}
+
+	return v
+}
+
+// summarizeWorkers counts live workers and groups them by phase.
+func summarizeWorkers(uiState *WorkflowUIState) (int, string) {
+	var workers int
+	counts := make(map[events.ProcessPhase]int)
+	for _, id := range uiState.WorkerIDs {
+		if uiState.WorkerStatus[id].IsDone() {
+			continue
+		}
+		workers++
+		counts[uiState.WorkerPhases[id]]++
+	}
+
+	// Fixed order so the bar doesn't jitter between renders
+	var parts []string
+	for _, phase := range []events.ProcessPhase{
+		events.ProcessPhaseBlocked,
+		events.ProcessPhaseImplementing,
+		events.ProcessPhaseAwaitingReview,
+		events.ProcessPhaseReviewing,
+		events.ProcessPhaseAddressingFeedback,
+		events.ProcessPhaseCommitting,
+	} {
+		if n := counts[phase]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, phaseShortName(phase)))
+		}
+	}
+	return workers, strings.Join(parts, " · ")
+}
+
+// workflowWarning returns the active guardrail warning for a workflow, or "".
+// Health problems take precedence over autoscaler budget warnings.
+func (m Model) workflowWarning(wf *controlplane.WorkflowInstance) string {
+	if wf.IsRunning() && m.controlPlane != nil {
+		if status, ok := m.controlPlane.GetHealthStatus(wf.ID); ok && !status.IsHealthy {
+			return "unhealthy"
+		}
+	}
+	if uiState, ok := m.workflowUIState[wf.ID]; ok && uiState.GuardrailWarning != "" {
+		if m.now().Sub(uiState.GuardrailWarningAt) < guardrailWarningTTL {
+			return uiState.GuardrailWarning
+		}
+	}
+	return ""
+}
+
+// now returns the current time from the services clock, or time.Now if unset.
+func (m Model) now() time.Time {
+	if m.services.Clock != nil {
+		return m.services.Clock.Now()
+	}
+	return time.Now()
+}
+
+// renderStatusBar renders the single-line status bar at the bottom of the dashboard.
+// Worker, notification and warning segments are clickable zones.
+func (m Model) renderStatusBar() string {
+	v := m.statusVitals()
+
+	dim := lipgloss.NewStyle().Foreground(colorDimmed)
+	text := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+
+	segments := []string{text.Render("⏱ " + formatDuration(v.Elapsed))}
+
+	if m.SelectedWorkflow() != nil {
+		workers := fmt.Sprintf("⚙ %d %s", v.Workers, pluralize(v.Workers, "worker", "workers"))
+		if v.Phases != "" {
+			workers += ": " + v.Phases
+		}
+		segments = append(segments, zone.Mark(zoneStatusWorkers, text.Render(workers)))
+	}
+
+	if v.Notifications > 0 {
+		bell := lipgloss.NewStyle().Foreground(colorPaused).Render(fmt.Sprintf("🔔 %d", v.Notifications))
+		segments = append(segments, zone.Mark(zoneStatusNotifications, bell))
+	}
+
+	if v.Branch != "" {
+		segments = append(segments, text.Render("⎇ "+v.Branch))
+	}
+
+	if m.apiPort > 0 {
+		segments = append(segments, text.Render(fmt.Sprintf("API :%d", m.apiPort)))
+	}
+
+	if len(v.Warnings) > 0 {
+		warning := "⚠ " + v.Warnings[0]
+		if len(v.Warnings) > 1 {
+			warning = fmt.Sprintf("⚠ %d warnings", len(v.Warnings))
+		}
+		warnStyle := lipgloss.NewStyle().Foreground(colorFailed).Bold(true)
+		segments = append(segments, zone.Mark(zoneStatusWarnings, warnStyle.Render(warning)))
+	}
+
+	line := " " + strings.Join(segments, dim.Render(statusBarSeparator))
+	return ansi.Truncate(line, m.width, "…")
+}
+
+// pluralize returns singular when n is 1 and plural otherwise.
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
+
+// jumpToNextWorkflow selects the next workflow after the current selection that
+// matches the predicate, wrapping around. Returns false if none matches.
+func (m *Model) jumpToNextWorkflow(match func(*controlplane.WorkflowInstance) bool) (tea.Cmd, bool) {
+	filtered := m.getFilteredWorkflows()
+	for offset := 1; offset <= len(filtered); offset++ {
+		i := (m.selectedIndex + offset) % len(filtered)
+		if match(filtered[i]) {
+			m.focus = FocusTable
+			m.updateComponentFocusStates()
+			return m.handleWorkflowSelectionChange(i), true
+		}
+	}
+	return nil, false
+}
+
+// hasNotification reports whether a workflow has an unread user notification.
+func (m Model) hasNotification(wf *controlplane.WorkflowInstance) bool {
+	uiState, ok := m.workflowUIState[wf.ID]
+	return ok && uiState.HasNotification
+}
+
+// needsAttention reports whether a workflow has a notification or guardrail warning.
+func (m Model) needsAttention(wf *controlplane.WorkflowInstance) bool {
+	return m.hasNotification(wf) || m.workflowWarning(wf) != ""
+}
+
+// jumpToAttention selects the next workflow that needs attention.
+func (m Model) jumpToAttention() (Model, tea.Cmd) {
+	cmd, _ := m.jumpToNextWorkflow(m.needsAttention)
+	return m, cmd
+}
+
+// loadGitBranch reads the current branch of the working directory.
+func (m Model) loadGitBranch() tea.Cmd {
+	if m.gitExecutorFactory == nil || m.workDir == "" {
+		return nil
+	}
+	factory, workDir := m.gitExecutorFactory, m.workDir
+	return func() tea.Msg {
+		branch, err := factory(workDir).GetCurrentBranch()
+		if err != nil {
+			return gitBranchLoadedMsg{}
+		}
+		return gitBranchLoadedMsg{branch: branch}
+	}
+}
+
+// handleStatusBarClick routes a click on a status bar segment to the relevant pane.
+// Returns false if the click was not on the status bar.
+func (m Model) handleStatusBarClick(msg tea.MouseMsg) (Model, tea.Cmd, bool) {
+	if z := zone.Get(zoneStatusWorkers); z != nil && z.InBounds(msg) {
+		if !m.showCoordinatorPanel {
+			m.openCoordinatorPanelForSelected()
+		}
+		if m.coordinatorPanel != nil {
+			m.focus = FocusCoordinator
+			m.updateComponentFocusStates()
+		}
+		return m, nil, true
+	}
+
+	if z := zone.Get(zoneStatusNotifications); z != nil && z.InBounds(msg) {
+		cmd, _ := m.jumpToNextWorkflow(m.hasNotification)
+		return m, cmd, true
+	}
+
+	if z := zone.Get(zoneStatusWarnings); z != nil && z.InBounds(msg) {
+		cmd, _ := m.jumpToNextWorkflow(func(wf *controlplane.WorkflowInstance) bool {
+			return m.workflowWarning(wf) != ""
+		})
+		return m, cmd, true
+	}
+
+	return m, nil, false
+}
@@ -0,0 +1,149 @@
+package dashboard
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+)
+
+func TestSummarizeWorkers(t *testing.T) {
+	state := NewWorkflowUIState()
+	state.WorkerIDs = []string{"worker-1", "worker-2", "worker-3", "worker-4"}
+	state.WorkerStatus["worker-1"] = events.ProcessStatusWorking
+	state.WorkerStatus["worker-2"] = events.ProcessStatusWorking
+	state.WorkerStatus["worker-3"] = events.ProcessStatusReady
+	state.WorkerStatus["worker-4"] = events.ProcessStatusRetired
+	state.WorkerPhases["worker-1"] = events.ProcessPhaseReviewing
+	state.WorkerPhases["worker-2"] = events.ProcessPhaseImplementing
+	state.WorkerPhases["worker-3"] = events.ProcessPhaseIdle
+	state.WorkerPhases["worker-4"] = events.ProcessPhaseImplementing
+
+	workers, phases := summarizeWorkers(state)
+	require.Equal(t, 3, workers)
+	require.Equal(t, "1 impl · 1 review", phases)
+}
+
+func TestModel_StatusVitals(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Build", controlplane.WorkflowRunning),
+		createTestWorkflow("wf-2", "Docs", controlplane.WorkflowPaused),
+		createTestWorkflow("wf-3", "Tests", controlplane.WorkflowPaused),
+	}
+	workflows[0].WorktreeBranch = "perles-build"
+
+	m, _ := createTestModel(t, workflows)
+	m.gitBranch = "main"
+	m.getOrCreateUIState("wf-2").HasNotification = true
+	m.getOrCreateUIState("wf-3").GuardrailWarning = "cost budget reached ($5.00 of $5.00)"
+	m.getOrCreateUIState("wf-3").GuardrailWarningAt = time.Now()
+
+	v := m.statusVitals()
+	require.Equal(t, "perles-build", v.Branch, "selected worktree branch wins")
+	require.Equal(t, 1, v.Notifications)
+	require.Equal(t, []string{"Tests: cost budget reached ($5.00 of $5.00)"}, v.Warnings)
+
+	line := ansi.Strip(m.renderStatusBar())
+	require.Contains(t, line, "⚙ 0 workers")
+	require.Contains(t, line, "🔔 1")
+	require.Contains(t, line, "⎇ perles-build")
+	require.Contains(t, line, "⚠ Tests: cost budget")
+}
+
+func TestModel_StatusVitals_UnhealthyWorkflowWarns(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Build", controlplane.WorkflowRunning),
+	}
+
+	m, mockCP := createTestModel(t, workflows)
+	mockCP.ExpectedCalls = filterMockCalls(mockCP.ExpectedCalls, "GetHealthStatus")
+	mockCP.On("GetHealthStatus", mock.Anything).Return(controlplane.HealthStatus{IsHealthy: false}, true)
+
+	require.Equal(t, []string{"Build: unhealthy"}, m.statusVitals().Warnings)
+}
+
+func TestModel_AutoscaleBudgetSetsGuardrailWarning(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Build", controlplane.WorkflowRunning),
+	}
+
+	m, _ := createTestModel(t, workflows)
+	m.updateCachedUIState(controlplane.ControlPlaneEvent{
+		Type:       controlplane.EventAutoscale,
+		WorkflowID: "wf-1",
+		Payload: autoscale.Decision{
+			Action:     autoscale.ActionNone,
+			Reason:     "cost budget reached ($5.00 of $5.00)",
+			OverBudget: true,
+		},
+	})
+
+	require.Equal(t, "cost budget reached ($5.00 of $5.00)", m.workflowUIState["wf-1"].GuardrailWarning)
+}
+
+func TestModel_GuardrailWarningClears(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Build", controlplane.WorkflowRunning),
+	}
+	overBudget := controlplane.ControlPlaneEvent{
+		Type:       controlplane.EventAutoscale,
+		WorkflowID: "wf-1",
+		Payload:    autoscale.Decision{Action: autoscale.ActionNone, Reason: "cost budget reached", OverBudget: true},
+	}
+
+	// A later decision within the budget clears the warning
+	m, _ := createTestModel(t, workflows)
+	m.updateCachedUIState(overBudget)
+	require.Equal(t, []string{"Build: cost budget reached"}, m.statusVitals().Warnings)
+	m.updateCachedUIState(controlplane.ControlPlaneEvent{
+		Type:       controlplane.EventAutoscale,
+		WorkflowID: "wf-1",
+		Payload:    autoscale.Decision{Action: autoscale.ActionScaleDown, Retire: []string{"worker-2"}, Reason: "idle workers"},
+	})
+	require.Empty(t, m.workflowUIState["wf-1"].GuardrailWarning)
+	require.Empty(t, m.statusVitals().Warnings)
+
+	// Otherwise it expires after guardrailWarningTTL
+	m.updateCachedUIState(overBudget)
+	m.workflowUIState["wf-1"].GuardrailWarningAt = time.Now().Add(-guardrailWarningTTL - time.Second)
+	require.Empty(t, m.statusVitals().Warnings)
+	require.False(t, m.needsAttention(workflows[0]))
+}
+
+func TestModel_JumpToAttention(t *testing.T) {
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+		createTestWorkflow("wf-2", "Workflow 2", controlplane.WorkflowRunning),
+		createTestWorkflow("wf-3", "Workflow 3", controlplane.WorkflowRunning),
+	}
+
+	m, _ := createTestModel(t, workflows)
+	m.getOrCreateUIState("wf-1").HasNotification = true
+	m.getOrCreateUIState("wf-3").GuardrailWarning = "cost budget reached"
+	m.getOrCreateUIState("wf-3").GuardrailWarningAt = time.Now()
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	m = result.(Model)
+	require.Equal(t, 2, m.selectedIndex)
+
+	// Wraps around to the first workflow
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	m = result.(Model)
+	require.Equal(t, 0, m.selectedIndex)
+}
+
+func TestModel_GitBranchLoaded(t *testing.T) {
+	m, _ := createTestModel(t, nil)
+
+	result, _ := m.Update(gitBranchLoadedMsg{branch: "main"})
+	m = result.(Model)
+	require.Equal(t, "main", m.gitBranch)
+	require.Contains(t, ansi.Strip(m.renderStatusBar()), "⎇ main")
+}
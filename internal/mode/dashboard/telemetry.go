@@ -0,0 +1,84 @@
+package dashboard
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+)
+
+// WorkerTelemetry accumulates the structured telemetry a worker has reported
+// on stdout (see client.TelemetryEvent). Only agents that speak the protocol
+// populate it; for everyone else it stays empty and nothing is rendered.
+type WorkerTelemetry struct {
+	// Progress is the latest progress event.
+	Progress *client.TelemetryEvent
+	// TestRun is the latest test run event.
+	TestRun *client.TelemetryEvent
+	// EditedFiles lists edited file paths in first-edit order.
+	EditedFiles []string
+	// LinesAdded and LinesRemoved are totals across all file edits.
+	LinesAdded   int
+	LinesRemoved int
+}
+
+// Apply folds a telemetry event into the accumulated state.
+func (t *WorkerTelemetry) Apply(ev *client.TelemetryEvent) {
+	if ev == nil {
+		return
+	}
+	switch ev.Type {
+	case client.TelemetryProgress:
+		t.Progress = ev
+	case client.TelemetryTestRun:
+		t.TestRun = ev
+	case client.TelemetryFileEdit:
+		if !slices.Contains(t.EditedFiles, ev.Path) {
+			t.EditedFiles = append(t.EditedFiles, ev.Path)
+		}
+		t.LinesAdded += ev.LinesAdded
+		t.LinesRemoved += ev.LinesRemoved
+	}
+}
+
+// Summary returns a compact one-line summary for the worker pane border,
+// e.g. "40% migrations · ✓ 41 tests · 3 files +12/-3".
+func (t *WorkerTelemetry) Summary() string {
+	if t == nil {
+		return ""
+	}
+
+	var parts []string
+	if p := t.Progress; p != nil {
+		var sb strings.Builder
+		if p.Percent != nil {
+			fmt.Fprintf(&sb, "%d%%", *p.Percent)
+		}
+		if p.Message != "" {
+			if sb.Len() > 0 {
+				sb.WriteString(" ")
+			}
+			sb.WriteString(p.Message)
+		}
+		parts = append(parts, sb.String())
+	}
+	if r := t.TestRun; r != nil {
+		switch r.Status {
+		case client.TestRunStarted:
+			parts = append(parts, "⏳ tests")
+		case client.TestRunPassed:
+			parts = append(parts, fmt.Sprintf("✓ %d tests", r.Passed))
+		case client.TestRunFailed:
+			parts = append(parts, fmt.Sprintf("✗ %d/%d tests", r.Failed, r.Passed+r.Failed))
+		}
+	}
+	if n := len(t.EditedFiles); n > 0 {
+		noun := "files"
+		if n == 1 {
+			noun = "file"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s +%d/-%d", n, noun, t.LinesAdded, t.LinesRemoved))
+	}
+	return strings.Join(parts, " · ")
+}
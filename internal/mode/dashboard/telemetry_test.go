@@ -0,0 +1,31 @@
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/client"
+)
+
+func TestWorkerTelemetry_Summary(t *testing.T) {
+	var nilTel *WorkerTelemetry
+	require.Empty(t, nilTel.Summary())
+
+	tel := &WorkerTelemetry{}
+	require.Empty(t, tel.Summary())
+
+	percent := 40
+	tel.Apply(&client.TelemetryEvent{Type: client.TelemetryProgress, Message: "migrations", Percent: &percent})
+	tel.Apply(&client.TelemetryEvent{Type: client.TelemetryFileEdit, Path: "a.go", LinesAdded: 10, LinesRemoved: 2})
+	tel.Apply(&client.TelemetryEvent{Type: client.TelemetryFileEdit, Path: "a.go", LinesAdded: 2, LinesRemoved: 1})
+	require.Equal(t, "40% migrations · 1 file +12/-3", tel.Summary())
+
+	tel.Apply(&client.TelemetryEvent{Type: client.TelemetryFileEdit, Path: "b.go", LinesAdded: 1})
+	tel.Apply(&client.TelemetryEvent{Type: client.TelemetryTestRun, Status: client.TestRunFailed, Passed: 41, Failed: 2})
+	require.Equal(t, "40% migrations · ✗ 2/43 tests · 2 files +13/-3", tel.Summary())
+	require.Equal(t, []string{"a.go", "b.go"}, tel.EditedFiles)
+
+	tel.Apply(&client.TelemetryEvent{Type: client.TelemetryTestRun, Status: client.TestRunPassed, Passed: 43})
+	require.Contains(t, tel.Summary(), "✓ 43 tests")
+}
@@ -1,10 +1,12 @@
 package dashboard
 
 import (
+	"slices"
 	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricdomain "github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
@@ -52,8 +54,11 @@ type WorkflowUIState struct {
 	ObserverStatus     events.ProcessStatus
 	ObserverQueueCount int
 
-	// Message pane state (filtered to message.posted and reply.posted events only)
+	// Message pane state (filtered to message.posted and reply.posted events only;
+	// edits and deletions replace the thread of the posted event)
 	FabricEvents []fabric.Event
+	// FabricReceipts are the delivery and read receipts of the messages, by message ID
+	FabricReceipts map[string][]fabricdomain.Receipt
 
 	// Worker pane state
 	WorkerIDs         []string
@@ -62,6 +67,8 @@ type WorkflowUIState struct {
 	WorkerMessages    map[string][]chatrender.Message
 	WorkerMetrics     map[string]*metrics.TokenMetrics
 	WorkerQueueCounts map[string]int
+	WorkerTelemetry   map[string]*WorkerTelemetry
+	WorkerTasks       map[string]string // Current task per worker (from ProcessEvent.TaskID)
 
 	// Scroll position persistence (integer offsets for VirtualSelectablePane)
 	// These store scroll offsets to preserve scroll positions across workflow switches.
@@ -75,6 +82,13 @@ type WorkflowUIState struct {
 	// Cleared when the user selects the row and presses Enter.
 	HasNotification bool
 
+	// GuardrailWarning is the latest guardrail warning (e.g. the autoscaler cost budget).
+	// Shown in the dashboard status bar until a later decision is within the
+	// guardrail or guardrailWarningTTL passes; empty when no guardrail has tripped.
+	GuardrailWarning string
+	// GuardrailWarningAt is when GuardrailWarning was raised.
+	GuardrailWarningAt time.Time
+
 	// Epic tree state
 	// These fields store minimal tree navigation state (enums and ID string)
 	// to avoid memory pressure while still preserving user context.
@@ -95,12 +109,15 @@ func NewWorkflowUIState() *WorkflowUIState {
 		CoordinatorMessages:     make([]chatrender.Message, 0),
 		ObserverMessages:        make([]chatrender.Message, 0),
 		FabricEvents:            make([]fabric.Event, 0),
+		FabricReceipts:          make(map[string][]fabricdomain.Receipt),
 		WorkerIDs:               make([]string, 0),
 		WorkerStatus:            make(map[string]events.ProcessStatus),
 		WorkerPhases:            make(map[string]events.ProcessPhase),
 		WorkerMessages:          make(map[string][]chatrender.Message),
 		WorkerMetrics:           make(map[string]*metrics.TokenMetrics),
 		WorkerQueueCounts:       make(map[string]int),
+		WorkerTelemetry:         make(map[string]*WorkerTelemetry),
+		WorkerTasks:             make(map[string]string),
 		CoordinatorScrollOffset: 0,
 		WorkerScrollOffsets:     make(map[string]int),
 		CommandLogEntries:       make([]CommandLogEntry, 0),
@@ -115,3 +132,32 @@ func (s *WorkflowUIState) IsEmpty() bool {
 		len(s.FabricEvents) == 0 &&
 		len(s.WorkerIDs) == 0
 }
+
+// updateFabricEventThread replaces the thread of the event that posted it, so an
+// edited or deleted message is re-rendered in place. Threads not in the list are ignored.
+func updateFabricEventThread(events []fabric.Event, thread *fabricdomain.Thread) {
+	if thread == nil {
+		return
+	}
+	for i := range events {
+		if events[i].Thread != nil && events[i].Thread.ID == thread.ID {
+			events[i].Thread = thread
+			return
+		}
+	}
+}
+
+// updateFabricReceipts merges changed receipts into the receipts by message ID,
+// replacing a recipient's previous receipt of the same message.
+func updateFabricReceipts(receipts map[string][]fabricdomain.Receipt, changed []fabricdomain.Receipt) {
+	for _, r := range changed {
+		list := receipts[r.MessageID]
+		i := slices.IndexFunc(list, func(e fabricdomain.Receipt) bool { return e.AgentID == r.AgentID })
+		if i >= 0 {
+			list[i] = r
+		} else {
+			list = append(list, r)
+		}
+		receipts[r.MessageID] = list
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricdomain "github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
 	"github.com/zjrosen/perles/internal/ui/tree"
@@ -1152,6 +1153,43 @@ func TestUpdateCachedUIState_FabricPosted_MessagePosted(t *testing.T) {
 	require.Equal(t, "tasks", state.FabricEvents[0].ChannelSlug)
 }
 
+func TestUpdateCachedUIState_FabricPosted_ReceiptsUpdated(t *testing.T) {
+	// Verify that receipts.updated events are merged by message and recipient
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+
+	mockCP := newMockControlPlane(t)
+	mockCP.On("List", mock.Anything, mock.Anything).Return(workflows, nil).Maybe()
+
+	globalEventCh := make(chan controlplane.ControlPlaneEvent)
+	close(globalEventCh)
+	mockCP.On("Subscribe", mock.Anything).Return((<-chan controlplane.ControlPlaneEvent)(globalEventCh), func() {}).Maybe()
+
+	m := New(Config{ControlPlane: mockCP, Services: mode.Services{}})
+	m.workflows = workflows
+	m.selectedIndex = 0
+	m = m.SetSize(100, 40).(Model)
+
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	send := func(agentID string, receipts ...fabricdomain.Receipt) {
+		result, _ := m.Update(controlplane.ControlPlaneEvent{
+			Type:       controlplane.EventFabricPosted,
+			WorkflowID: "wf-1",
+			Payload:    fabric.NewReceiptsUpdatedEvent(agentID, receipts),
+		})
+		m = result.(Model)
+	}
+	send("worker-1", fabricdomain.Receipt{MessageID: "msg-1", AgentID: "worker-1", DeliveredAt: at})
+	send("worker-2", fabricdomain.Receipt{MessageID: "msg-1", AgentID: "worker-2", DeliveredAt: at})
+	send("worker-1", fabricdomain.Receipt{MessageID: "msg-1", AgentID: "worker-1", DeliveredAt: at, ReadAt: at})
+
+	state := m.getOrCreateUIState("wf-1")
+	require.Empty(t, state.FabricEvents, "receipts are not messages")
+	require.Len(t, state.FabricReceipts["msg-1"], 2)
+	require.Equal(t, "✓✓ read by worker-1 · ✓ delivered to worker-2", receiptMarker(state.FabricReceipts["msg-1"]))
+}
+
 func TestUpdateCachedUIState_FabricPosted_ReplyPosted(t *testing.T) {
 	// Verify that EventFabricPosted with reply.posted event type stores the event
 	workflows := []*controlplane.WorkflowInstance{
@@ -1276,6 +1314,57 @@ func TestUpdateCachedUIState_FabricPosted_FilteredEvents(t *testing.T) {
 	require.Empty(t, state.FabricEvents, "FabricEvents should be empty - subscribed, acked, channel.created events should NOT be stored")
 }
 
+func TestUpdateCachedUIState_FabricPosted_EditUpdatesInPlace(t *testing.T) {
+	// Verify that message.edited and message.deleted replace the posted message's thread
+	workflows := []*controlplane.WorkflowInstance{
+		createTestWorkflow("wf-1", "Workflow 1", controlplane.WorkflowRunning),
+	}
+
+	mockCP := newMockControlPlane(t)
+	mockCP.On("List", mock.Anything, mock.Anything).Return(workflows, nil).Maybe()
+
+	globalEventCh := make(chan controlplane.ControlPlaneEvent)
+	close(globalEventCh)
+	mockCP.On("Subscribe", mock.Anything).Return((<-chan controlplane.ControlPlaneEvent)(globalEventCh), func() {}).Maybe()
+
+	cfg := Config{
+		ControlPlane: mockCP,
+		Services:     mode.Services{},
+	}
+
+	m := New(cfg)
+	m.workflows = workflows
+	m.selectedIndex = 0
+	m = m.SetSize(100, 40).(Model)
+
+	send := func(fabricEvent fabric.Event) {
+		result, _ := m.Update(controlplane.ControlPlaneEvent{
+			Type:       controlplane.EventFabricPosted,
+			WorkflowID: "wf-1",
+			Payload:    fabricEvent,
+		})
+		m = result.(Model)
+	}
+
+	send(fabric.Event{Type: fabric.EventMessagePosted, ChannelSlug: "tasks",
+		Thread: &fabricdomain.Thread{ID: "msg-1", Content: "start perles-abc", CreatedBy: "coordinator"}})
+	send(fabric.Event{Type: fabric.EventMessagePosted, ChannelSlug: "tasks",
+		Thread: &fabricdomain.Thread{ID: "msg-2", Content: "wrong", CreatedBy: "coordinator"}})
+
+	edited := &fabricdomain.Thread{ID: "msg-1", Content: "start perles-abd", CreatedBy: "coordinator",
+		Edits: []fabricdomain.ThreadEdit{{Content: "start perles-abc"}}}
+	send(fabric.Event{Type: fabric.EventMessageEdited, ChannelSlug: "tasks", Thread: edited})
+	deletedAt := time.Now()
+	deleted := &fabricdomain.Thread{ID: "msg-2", Content: fabricdomain.DeletedContent, CreatedBy: "coordinator", DeletedAt: &deletedAt}
+	send(fabric.Event{Type: fabric.EventMessageDeleted, ChannelSlug: "tasks", Thread: deleted})
+
+	state := m.getOrCreateUIState("wf-1")
+	require.Len(t, state.FabricEvents, 2, "edits and deletions are not appended")
+	require.Equal(t, fabric.EventMessagePosted, state.FabricEvents[0].Type)
+	require.Equal(t, "start perles-abd", state.FabricEvents[0].Thread.Content)
+	require.True(t, state.FabricEvents[1].Thread.IsDeleted())
+}
+
 func TestUpdateCachedUIState_FabricEventsCap(t *testing.T) {
 	// Verify FIFO eviction at 500 events (oldest removed when cap exceeded)
 	workflows := []*controlplane.WorkflowInstance{
@@ -12,22 +12,77 @@ import (
 
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
 	"github.com/zjrosen/perles/internal/ui/shared/panes"
 	"github.com/zjrosen/perles/internal/ui/shared/table"
 	"github.com/zjrosen/perles/internal/ui/styles"
 )
 
-// Color constants for status and health indicators.
+// Colors for status and health indicators, derived from the active theme.
 var (
-	colorRunning   = lipgloss.Color("#00BFFF") // Blue
-	colorPending   = lipgloss.Color("#808080") // Gray (per spec)
-	colorPaused    = lipgloss.Color("#FFFF00") // Yellow (per spec)
-	colorCompleted = lipgloss.Color("#00FF00") // Green (per spec)
-	colorFailed    = lipgloss.Color("#FF0000") // Red
-	colorDimmed    = lipgloss.Color("#666666") // Dimmed text
-	colorHeader    = lipgloss.Color("#FFFFFF") // White for headers
+	colorRunning   lipgloss.TerminalColor // Blue
+	colorPending   lipgloss.TerminalColor // Gray
+	colorPaused    lipgloss.TerminalColor // Yellow
+	colorCompleted lipgloss.TerminalColor // Green
+	colorFailed    lipgloss.TerminalColor // Red
+	colorDimmed    lipgloss.TerminalColor // Dimmed text
+	colorHeader    lipgloss.TerminalColor // Headers
 )
 
+func init() {
+	rebuildStyles()
+	// Register with styles package so dashboard styles follow theme changes
+	styles.RegisterStyleRebuilder(rebuildStyles)
+}
+
+// rebuildStyles recreates the dashboard's package-level colors and styles
+// from the active theme. Called by styles.ApplyTheme after colors are updated.
+func rebuildStyles() {
+	colorRunning = styles.BorderHighlightFocusColor
+	colorPending = styles.TextPlaceholderColor
+	colorPaused = styles.StatusWarningColor
+	colorCompleted = styles.StatusSuccessColor
+	colorFailed = styles.StatusErrorColor
+	colorDimmed = styles.TextMutedColor
+	colorHeader = styles.TextPrimaryColor
+
+	// Coordinator panel
+	coordinatorTitleColor = chatrender.CoordinatorColor
+	observerTitleColor = chatrender.ObserverColor
+	workerTitleColor = chatrender.WorkerColor
+
+	messageTimestampStyle = lipgloss.NewStyle().Foreground(chatrender.TimestampColor)
+	coordinatorSenderStyle = lipgloss.NewStyle().Foreground(chatrender.CoordinatorColor).Bold(true)
+	observerSenderStyle = lipgloss.NewStyle().Foreground(chatrender.ObserverColor).Bold(true)
+	workerSenderStyle = lipgloss.NewStyle().Foreground(chatrender.WorkerColor).Bold(true)
+	userSenderStyle = lipgloss.NewStyle().Foreground(chatrender.UserColor).Bold(true)
+	systemSenderStyle = lipgloss.NewStyle().Foreground(chatrender.SystemColor).Bold(true)
+	systemContentStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	telemetryStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+
+	commandTimestampStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	commandSourceStyle = lipgloss.NewStyle().Foreground(styles.BorderHighlightFocusColor)
+	commandTypeStyle = lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+	commandSuccessStyle = lipgloss.NewStyle().Foreground(styles.StatusSuccessColor)
+	commandFailStyle = lipgloss.NewStyle().Foreground(styles.StatusErrorColor)
+	commandDurationStyle = lipgloss.NewStyle().Foreground(styles.TextPlaceholderColor)
+	commandIDStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	commandTraceIDStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+
+	selectionBgStyle = lipgloss.NewStyle().Background(styles.SelectionBackgroundColor)
+
+	// Worker grid
+	workerCardLabelStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	workerCardToolStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor).Italic(true)
+
+	// Assignment plan
+	planWaveStyle = lipgloss.NewStyle().Bold(true).Foreground(styles.OverlayTitleColor)
+	planInProgressStyle = lipgloss.NewStyle().Foreground(styles.StatusInProgressColor)
+	planReadyStyle = lipgloss.NewStyle().Foreground(styles.StatusOpenColor)
+	planWaitingStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	planWarningStyle = lipgloss.NewStyle().Foreground(styles.StatusWarningColor)
+}
+
 // Status text labels for workflow states.
 const (
 	statusRunning   = "RUNNING"
@@ -91,7 +146,7 @@ func (m Model) createWorkflowTableConfig() table.TableConfig {
 					r := row.(WorkflowTableRow)
 					if r.HasNotification {
 						return lipgloss.NewStyle().
-							Foreground(lipgloss.AdaptiveColor{Light: "#FF6B6B", Dark: "#FFD700"}).
+							Foreground(styles.StatusWarningColor).
 							Render("🔔")
 					}
 					return "  " // Two spaces to match column width
@@ -265,11 +320,8 @@ func (m Model) getEmptyMessage() string {
 	return "No workflows yet. Press 'n' to create one."
 }
 
-// getTableTitle returns the title for the workflow table including API port.
+// getTableTitle returns the title for the workflow table.
 func (m Model) getTableTitle() string {
-	if m.apiPort > 0 {
-		return fmt.Sprintf("Workflows · API ::%d", m.apiPort)
-	}
 	return "Workflows"
 }
 
@@ -280,13 +332,12 @@ func (m Model) renderView() string {
 		return ""
 	}
 
-	// Footer section (action hints) - only show when there are workflows
-	var footer string
-	var footerHeight int
+	// Footer section: action hints (only when there are workflows) above the status bar
+	footer := m.renderStatusBar()
 	if len(m.workflows) > 0 {
-		footer = m.renderActionHints()
-		footerHeight = lipgloss.Height(footer)
+		footer = lipgloss.JoinVertical(lipgloss.Left, m.renderActionHints(), footer)
 	}
+	footerHeight := lipgloss.Height(footer)
 
 	// Calculate heights
 	headerHeight := 0
@@ -335,7 +386,7 @@ func (m Model) renderView() string {
 		// Build left column: table + epic section
 		var leftColumn string
 		if epicSectionHeight > 0 {
-			epicSection := m.renderEpicSection(tableWidth, epicSectionHeight)
+			epicSection := m.renderLowerSection(tableWidth, epicSectionHeight)
 			leftColumn = lipgloss.JoinVertical(lipgloss.Left, tableView, epicSection)
 		} else {
 			leftColumn = tableView
@@ -348,7 +399,7 @@ func (m Model) renderView() string {
 		tableView := m.renderBorderedWorkflowTable(m.width, tableHeight)
 
 		if epicSectionHeight > 0 {
-			epicSection := m.renderEpicSection(m.width, epicSectionHeight)
+			epicSection := m.renderLowerSection(m.width, epicSectionHeight)
 			mainContent = lipgloss.JoinVertical(lipgloss.Left, tableView, epicSection)
 		} else {
 			mainContent = tableView
@@ -356,12 +407,7 @@ func (m Model) renderView() string {
 	}
 
 	// Compose the layout with JoinVertical
-	var view string
-	if footer != "" {
-		view = lipgloss.JoinVertical(lipgloss.Left, mainContent, footer)
-	} else {
-		view = mainContent
-	}
+	view := lipgloss.JoinVertical(lipgloss.Left, mainContent, footer)
 
 	// Use Place to position content in a fixed-size container
 	// This ensures the layout fills the entire terminal with footer at bottom
@@ -413,6 +459,7 @@ func (m Model) renderActionHints() string {
 		fmt.Sprintf("%s cycle", keyStyle.Render("tab")),
 		fmt.Sprintf("%s new", keyStyle.Render("n")),
 		fmt.Sprintf("%s start", keyStyle.Render("s")),
+		fmt.Sprintf("%s workers", keyStyle.Render("w")),
 		fmt.Sprintf("%s pause", keyStyle.Render("x")),
 		fmt.Sprintf("%s help", keyStyle.Render("?")),
 		fmt.Sprintf("%s quit", keyStyle.Render("q")),
@@ -465,13 +512,7 @@ func (m Model) getHealthDisplay(wf *controlplane.WorkflowInstance) string {
 		return "-"
 	}
 
-	// Get current time from clock (or use time.Now() if no clock configured)
-	now := time.Now()
-	if m.services.Clock != nil {
-		now = m.services.Clock.Now()
-	}
-
-	elapsed := now.Sub(status.LastHeartbeatAt)
+	elapsed := m.now().Sub(status.LastHeartbeatAt)
 
 	if status.IsHealthy {
 		return fmt.Sprintf("❤️ %s", formatDuration(elapsed))
@@ -487,13 +528,7 @@ func (m Model) getUptimeDisplay(wf *controlplane.WorkflowInstance) string {
 		return "-"
 	}
 
-	// Get current time from clock (or use time.Now() if no clock configured)
-	now := time.Now()
-	if m.services.Clock != nil {
-		now = m.services.Clock.Now()
-	}
-
-	elapsed := now.Sub(*wf.StartedAt)
+	elapsed := m.now().Sub(*wf.StartedAt)
 	return formatDuration(elapsed)
 }
 
@@ -506,10 +541,7 @@ func (m Model) getStartedDisplay(wf *controlplane.WorkflowInstance) string {
 	return wf.StartedAt.Format("01/02 03:04PM")
 }
 
-// phaseShortName returns a short display name for a workflow phase.
-// Currently unused because WorkflowInstance doesn't expose Phase yet.
-//
-//nolint:unused // Retained for future use when Phase is exposed.
+// phaseShortName returns a short display name for a worker phase.
 func phaseShortName(phase events.ProcessPhase) string {
 	switch phase {
 	case events.ProcessPhaseImplementing:
@@ -522,6 +554,8 @@ func phaseShortName(phase events.ProcessPhase) string {
 		return "feedback"
 	case events.ProcessPhaseCommitting:
 		return "commit"
+	case events.ProcessPhaseBlocked:
+		return "blocked"
 	case events.ProcessPhaseIdle:
 		return ""
 	default:
@@ -548,6 +582,15 @@ const minEpicSectionWidth = 50
 // This ensures the table remains usable even when the epic section is visible.
 const minWorkflowTableRows = 6
 
+// renderLowerSection renders the section below the workflow table: the live
+// worker grid when toggled on, the epic tree otherwise.
+func (m Model) renderLowerSection(width, height int) string {
+	if m.showWorkerGrid {
+		return m.renderWorkerGrid(width, height)
+	}
+	return m.renderEpicSection(width, height)
+}
+
 // renderEpicSection renders the epic tree+details section below the workflow table.
 // It handles empty states (no epic, empty tree, loading) and the 40%/60% horizontal split.
 func (m Model) renderEpicSection(width, height int) string {
@@ -629,7 +672,11 @@ func (m Model) renderEpicSection(width, height int) string {
 
 	// Render details pane with border
 	var detailsContent string
-	if m.hasEpicDetail {
+	detailsTitle := "Details"
+	if m.showEpicPlan {
+		detailsContent = renderAssignmentPlan(m.epicPlan, layout.detailsWidth-2, height-2)
+		detailsTitle = "Plan"
+	} else if m.hasEpicDetail {
 		detailsContent = m.epicDetails.View()
 	} else {
 		emptyStyle := lipgloss.NewStyle().
@@ -638,7 +685,7 @@ func (m Model) renderEpicSection(width, height int) string {
 			PaddingLeft(1)
 		detailsContent = emptyStyle.Render("Select an issue to view details")
 	}
-	detailsPaneStyle := m.getEpicPaneBorderConfig(EpicFocusDetails, layout.detailsWidth, height, "Details")
+	detailsPaneStyle := m.getEpicPaneBorderConfig(EpicFocusDetails, layout.detailsWidth, height, detailsTitle)
 
 	detailsPane := zone.Mark(zoneEpicDetails, panes.BorderedPane(panes.BorderConfig{
 		Content:            detailsContent,
@@ -51,6 +51,7 @@ func TestPhaseShortName(t *testing.T) {
 		{"reviewing", events.ProcessPhaseReviewing, "review"},
 		{"addressing_feedback", events.ProcessPhaseAddressingFeedback, "feedback"},
 		{"committing", events.ProcessPhaseCommitting, "commit"},
+		{"blocked", events.ProcessPhaseBlocked, "blocked"},
 		{"idle", events.ProcessPhaseIdle, ""},
 		{"empty", events.ProcessPhase(""), ""},
 		{"unknown", events.ProcessPhase("unknown_phase"), ""},
@@ -397,7 +398,7 @@ func TestView_IssueEditorOverlay_ReturnsModalWhenNonNil(t *testing.T) {
 		Status:    beads.StatusOpen,
 		Priority:  beads.PriorityMedium,
 	}
-	editor := issueeditor.New(testIssue).SetSize(100, 40)
+	editor := issueeditor.New(testIssue, issueeditor.Config{}).SetSize(100, 40)
 	m.issueEditor = &editor
 
 	// Render the view
@@ -0,0 +1,368 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	zone "github.com/lrstanley/bubblezone"
+
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
+	"github.com/zjrosen/perles/internal/ui/shared/panes"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+	"github.com/zjrosen/perles/internal/ui/shared/transcript"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// Worker grid layout constants.
+const (
+	workerCardWidth  = 34 // Card width including borders
+	workerCardHeight = 6  // 4 content lines + 2 borders
+	activityMinWidth = 30 // Below this the activity feed is hidden
+	activityPercent  = 35 // Share of the section width given to the activity feed
+)
+
+var (
+	workerCardLabelStyle lipgloss.Style
+	workerCardToolStyle  lipgloss.Style
+)
+
+// toolCallPrefix marks worker output lines that are tool calls (see appendWorkerMessageToCache).
+const toolCallPrefix = "🔧"
+
+// WorkerCard is the state of one worker shown in the worker grid.
+type WorkerCard struct {
+	ID       string
+	Status   events.ProcessStatus
+	Phase    events.ProcessPhase
+	TaskID   string
+	Metrics  *metrics.TokenMetrics
+	LastTool string
+}
+
+// workerCards builds the worker grid cards from the cached UI state.
+// Everything is derived from the event stream; nothing is polled.
+func workerCards(state *WorkflowUIState) []WorkerCard {
+	if state == nil {
+		return nil
+	}
+	cards := make([]WorkerCard, 0, len(state.WorkerIDs))
+	for _, id := range state.WorkerIDs {
+		cards = append(cards, WorkerCard{
+			ID:       id,
+			Status:   state.WorkerStatus[id],
+			Phase:    state.WorkerPhases[id],
+			TaskID:   state.WorkerTasks[id],
+			Metrics:  state.WorkerMetrics[id],
+			LastTool: lastToolCall(state.WorkerMessages[id]),
+		})
+	}
+	return cards
+}
+
+// lastToolCall returns the most recent tool call in a worker's transcript
+// without its marker, or "" if the worker has not called a tool yet.
+func lastToolCall(messages []chatrender.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].IsToolCall {
+			return strings.TrimSpace(strings.TrimPrefix(messages[i].Content, toolCallPrefix))
+		}
+	}
+	return ""
+}
+
+// workerGridColumns returns how many cards fit side by side in width.
+func workerGridColumns(width int) int {
+	return max(width/workerCardWidth, 1)
+}
+
+// selectedGridWorker returns the ID of the worker selected in the grid,
+// or "" if the selected workflow has no workers.
+func (m Model) selectedGridWorker() string {
+	wf := m.SelectedWorkflow()
+	if wf == nil {
+		return ""
+	}
+	state := m.workflowUIState[wf.ID]
+	if state == nil || len(state.WorkerIDs) == 0 {
+		return ""
+	}
+	return state.WorkerIDs[min(m.workerGridIndex, len(state.WorkerIDs)-1)]
+}
+
+// renderWorkerGrid renders the live worker grid and the fabric activity feed
+// of the selected workflow. It replaces the epic section while toggled on.
+func (m Model) renderWorkerGrid(width, height int) string {
+	var state *WorkflowUIState
+	if wf := m.SelectedWorkflow(); wf != nil {
+		state = m.workflowUIState[wf.ID]
+	}
+
+	gridWidth, activityWidth := workerGridLayout(width)
+
+	cards := workerCards(state)
+	gridPane := zone.Mark(zoneWorkerGrid, panes.BorderedPane(panes.BorderConfig{
+		Content:            m.renderWorkerCards(cards, gridWidth-2, height-2),
+		Width:              gridWidth,
+		Height:             height,
+		TopLeft:            "Workers",
+		TopRight:           fmt.Sprintf("%d active", len(cards)),
+		Focused:            m.focus == FocusEpicView && m.epicViewFocus == EpicFocusTree,
+		TitleColor:         styles.OverlayTitleColor,
+		FocusedBorderColor: styles.BorderHighlightFocusColor,
+	}))
+	if activityWidth == 0 {
+		return gridPane
+	}
+
+	var fabricEvents []fabric.Event
+	if state != nil {
+		fabricEvents = state.FabricEvents
+	}
+	activityPane := zone.Mark(zoneWorkerActivity, panes.BorderedPane(panes.BorderConfig{
+		Content:            renderActivityFeed(fabricEvents, activityWidth-2, height-2),
+		Width:              activityWidth,
+		Height:             height,
+		TopLeft:            "Activity",
+		Focused:            m.focus == FocusEpicView && m.epicViewFocus == EpicFocusDetails,
+		TitleColor:         styles.OverlayTitleColor,
+		FocusedBorderColor: styles.BorderHighlightFocusColor,
+	}))
+	return lipgloss.JoinHorizontal(lipgloss.Top, gridPane, activityPane)
+}
+
+// renderWorkerCards lays the cards out row by row. Rows that do not fit are
+// cut off, keeping the row of the selected card visible.
+func (m Model) renderWorkerCards(cards []WorkerCard, width, height int) string {
+	if len(cards) == 0 {
+		return lipgloss.NewStyle().Foreground(colorDimmed).Italic(true).PaddingLeft(1).
+			Render("No workers yet")
+	}
+
+	cols := workerGridColumns(width)
+	selected := min(m.workerGridIndex, len(cards)-1)
+	visibleRows := max(height/workerCardHeight, 1)
+	firstRow := max(selected/cols-visibleRows+1, 0)
+
+	var rows []string
+	for row := firstRow; row < firstRow+visibleRows && row*cols < len(cards); row++ {
+		var rowCards []string
+		for i := row * cols; i < min((row+1)*cols, len(cards)); i++ {
+			focused := m.focus == FocusEpicView && m.epicViewFocus == EpicFocusTree && i == selected
+			rowCards = append(rowCards, renderWorkerCard(cards[i], focused))
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, rowCards...))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
+
+// renderWorkerCard renders one worker: status and phase, current task, tokens
+// used and the last tool call.
+func renderWorkerCard(card WorkerCard, focused bool) string {
+	inner := workerCardWidth - 4 // borders and padding
+
+	indicator, indicatorStyle := chatrender.StatusIndicator(card.Status)
+	header := indicatorStyle.Render(indicator) + " " + card.ID
+	if phase := phaseShortName(card.Phase); phase != "" {
+		header += workerCardLabelStyle.Render(" · " + phase)
+	}
+
+	task := "-"
+	if card.TaskID != "" {
+		task = card.TaskID
+	}
+	tokens := "-"
+	if card.Metrics != nil {
+		tokens = card.Metrics.FormatContextDisplay() + " " + card.Metrics.FormatCostDisplay()
+	}
+	tool := "no tool calls yet"
+	if card.LastTool != "" {
+		tool = toolCallPrefix + " " + card.LastTool
+	}
+
+	lines := []string{
+		ansi.Truncate(header, inner, "…"),
+		workerCardLabelStyle.Render("task   ") + ansi.Truncate(task, inner-7, "…"),
+		workerCardLabelStyle.Render("tokens ") + ansi.Truncate(tokens, inner-7, "…"),
+		workerCardToolStyle.Render(ansi.Truncate(tool, inner, "…")),
+	}
+
+	borderColor := chatrender.StatusBorderColor(card.Status)
+	if focused {
+		borderColor = styles.BorderHighlightFocusColor
+	}
+	return zone.Mark(zoneWorkerCardPrefix+card.ID, lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		Width(workerCardWidth-2).
+		Render(strings.Join(lines, "\n")))
+}
+
+// renderActivityFeed renders the newest fabric messages, newest last, one line each.
+func renderActivityFeed(fabricEvents []fabric.Event, width, height int) string {
+	if len(fabricEvents) == 0 {
+		return lipgloss.NewStyle().Foreground(colorDimmed).Italic(true).PaddingLeft(1).
+			Render("No channel activity yet")
+	}
+
+	start := max(len(fabricEvents)-height, 0)
+	lines := make([]string, 0, len(fabricEvents)-start)
+	for _, ev := range fabricEvents[start:] {
+		if ev.Thread == nil {
+			continue
+		}
+		sender := ev.Thread.CreatedBy
+		if sender == "" {
+			sender = ev.AgentID
+		}
+		channel := lipgloss.NewStyle().Foreground(chatrender.ChannelColor(ev.ChannelSlug)).Render("#" + ev.ChannelSlug)
+		content := strings.Join(strings.Fields(ev.Thread.Content), " ")
+		if ev.Type == fabric.EventReplyPosted {
+			content = "↳ " + content
+		}
+		line := fmt.Sprintf("%s %s %s: %s", messageTimestampStyle.Render(ev.Timestamp.Format("15:04")), channel, sender, content)
+		lines = append(lines, ansi.Truncate(line, width, "…"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// toggleWorkerGrid switches the section below the workflow table between the
+// epic tree and the worker grid.
+func (m Model) toggleWorkerGrid() (mode.Controller, tea.Cmd) {
+	m.showWorkerGrid = !m.showWorkerGrid
+	m.workerGridIndex = 0
+	return m, nil
+}
+
+// handleWorkerGridKeys handles key events when the worker grid has focus.
+// Arrow keys move between cards; enter opens the worker's transcript tab in the
+// coordinator panel and t opens the full-screen transcript viewer.
+func (m Model) handleWorkerGridKeys(msg tea.KeyMsg) (mode.Controller, tea.Cmd) {
+	var count int
+	if wf := m.SelectedWorkflow(); wf != nil {
+		if state := m.workflowUIState[wf.ID]; state != nil {
+			count = len(state.WorkerIDs)
+		}
+	}
+	cols := workerGridColumns(m.workerGridWidth() - 2)
+
+	switch msg.String() {
+	case "l", "right":
+		if m.epicViewFocus == EpicFocusTree && m.workerGridIndex < count-1 && (m.workerGridIndex+1)%cols != 0 {
+			m.workerGridIndex++
+		} else {
+			m.epicViewFocus = EpicFocusDetails
+		}
+		return m, nil
+
+	case "h", "left":
+		if m.epicViewFocus == EpicFocusDetails {
+			m.epicViewFocus = EpicFocusTree
+		} else if m.workerGridIndex%cols != 0 {
+			m.workerGridIndex--
+		}
+		return m, nil
+
+	case "j", "down":
+		if m.workerGridIndex+cols < count {
+			m.workerGridIndex += cols
+		}
+		return m, nil
+
+	case "k", "up":
+		if m.workerGridIndex-cols >= 0 {
+			m.workerGridIndex -= cols
+		}
+		return m, nil
+
+	case "t": // Open the full-screen transcript viewer
+		return m.openTranscriptViewer(m.selectedGridWorker())
+
+	case "enter": // Jump into the worker's transcript
+		return m.jumpToWorker(m.selectedGridWorker())
+	}
+
+	return m, nil
+}
+
+// jumpToWorker opens the coordinator panel for the selected workflow on the
+// transcript tab of workerID and focuses it.
+func (m Model) jumpToWorker(workerID string) (mode.Controller, tea.Cmd) {
+	if workerID == "" {
+		return m, nil
+	}
+	if !m.showCoordinatorPanel || m.coordinatorPanel == nil {
+		m.openCoordinatorPanelForSelected()
+	}
+	if m.coordinatorPanel != nil && m.coordinatorPanel.SelectWorkerTab(workerID) {
+		m.focus = FocusCoordinator
+		m.updateComponentFocusStates()
+	}
+	return m, nil
+}
+
+// openTranscriptViewer opens the streaming transcript viewer for a worker of
+// the selected workflow. Exports go to the workflow's session directory.
+func (m Model) openTranscriptViewer(workerID string) (mode.Controller, tea.Cmd) {
+	wf := m.SelectedWorkflow()
+	if wf == nil || workerID == "" {
+		return m, nil
+	}
+
+	exportDir := wf.SessionDir
+	if exportDir == "" {
+		exportDir = wf.WorkDir
+	}
+	if exportDir == "" {
+		exportDir = "."
+	}
+
+	state := m.getOrCreateUIState(wf.ID)
+	viewer := transcript.New(workerID, state.WorkerMessages[workerID], exportDir).SetSize(m.width, m.height)
+	m.transcriptViewer = &viewer
+	m.transcriptWorkflowID = wf.ID
+	return m, nil
+}
+
+// transcriptExportedToast reports the result of a transcript export.
+func transcriptExportedToast(msg transcript.ExportedMsg) tea.Cmd {
+	return func() tea.Msg {
+		if msg.Err != nil {
+			return mode.ShowToastMsg{
+				Message: "Transcript export failed: " + msg.Err.Error(),
+				Style:   toaster.StyleError,
+			}
+		}
+		return mode.ShowToastMsg{
+			Message: "Transcript exported to " + msg.Path,
+			Style:   toaster.StyleSuccess,
+		}
+	}
+}
+
+// workerGridLayout splits the section width between the grid and the activity
+// feed. The feed is dropped (zero width) when there is no room for it.
+func workerGridLayout(width int) (gridWidth, activityWidth int) {
+	activityWidth = width * activityPercent / 100
+	if activityWidth < activityMinWidth || width-activityWidth < workerCardWidth+2 {
+		return width, 0
+	}
+	return width - activityWidth, activityWidth
+}
+
+// workerGridWidth returns the width of the worker grid pane.
+func (m Model) workerGridWidth() int {
+	width := m.width
+	if m.showCoordinatorPanel && m.coordinatorPanel != nil {
+		width -= CoordinatorPanelWidth
+	}
+	gridWidth, _ := workerGridLayout(width)
+	return gridWidth
+}
@@ -0,0 +1,107 @@
+package dashboard
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
+)
+
+func TestWorkerCards_DerivedFromUIState(t *testing.T) {
+	state := NewWorkflowUIState()
+	state.WorkerIDs = []string{"worker-1", "worker-2"}
+	state.WorkerStatus["worker-1"] = events.ProcessStatusWorking
+	state.WorkerPhases["worker-1"] = events.ProcessPhaseImplementing
+	state.WorkerTasks["worker-1"] = "perles-abc.1"
+	state.WorkerMessages["worker-1"] = []chatrender.Message{
+		{Role: "worker", Content: toolCallPrefix + " Read: main.go", IsToolCall: true},
+		{Role: "worker", Content: "Looking at the code"},
+	}
+
+	cards := workerCards(state)
+	require.Len(t, cards, 2)
+	require.Equal(t, "worker-1", cards[0].ID)
+	require.Equal(t, events.ProcessStatusWorking, cards[0].Status)
+	require.Equal(t, events.ProcessPhaseImplementing, cards[0].Phase)
+	require.Equal(t, "perles-abc.1", cards[0].TaskID)
+	require.Equal(t, "Read: main.go", cards[0].LastTool)
+	require.Empty(t, cards[1].LastTool)
+
+	require.Nil(t, workerCards(nil))
+}
+
+func TestWorkerGridLayout_DropsActivityFeedWhenNarrow(t *testing.T) {
+	grid, activity := workerGridLayout(200)
+	require.Equal(t, 200, grid+activity)
+	require.Positive(t, activity)
+
+	grid, activity = workerGridLayout(60)
+	require.Equal(t, 60, grid)
+	require.Zero(t, activity)
+}
+
+func TestModel_WorkerGrid_ToggleAndNavigate(t *testing.T) {
+	wf := createTestWorkflow("wf-1", "Workflow", controlplane.WorkflowRunning)
+	m, _ := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m = m.SetSize(200, 40).(Model)
+
+	state := m.getOrCreateUIState(wf.ID)
+	state.WorkerIDs = []string{"worker-1", "worker-2", "worker-3"}
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	m = result.(Model)
+	require.True(t, m.showWorkerGrid)
+	require.Contains(t, m.View(), "Workers")
+
+	m.focus = FocusEpicView
+	m.epicViewFocus = EpicFocusTree
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	m = result.(Model)
+	require.Equal(t, 1, m.workerGridIndex)
+	require.Equal(t, "worker-2", m.selectedGridWorker())
+
+	result, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
+	m = result.(Model)
+	require.False(t, m.showWorkerGrid)
+	require.Zero(t, m.workerGridIndex)
+}
+
+func TestModel_TranscriptViewer_StreamsWorkerOutput(t *testing.T) {
+	wf := createTestWorkflow("wf-1", "Workflow", controlplane.WorkflowRunning)
+	m, _ := createTestModel(t, []*controlplane.WorkflowInstance{wf})
+	m = m.SetSize(200, 40).(Model)
+
+	state := m.getOrCreateUIState(wf.ID)
+	state.WorkerIDs = []string{"worker-1"}
+	m.showWorkerGrid = true
+	m.focus = FocusEpicView
+	m.epicViewFocus = EpicFocusTree
+
+	result, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	m = result.(Model)
+	require.NotNil(t, m.transcriptViewer)
+	require.Equal(t, "worker-1", m.transcriptViewer.WorkerID())
+
+	result, _ = m.Update(controlplane.ControlPlaneEvent{
+		Type:       controlplane.EventWorkerOutput,
+		WorkflowID: wf.ID,
+		Payload: events.ProcessEvent{
+			Type:      events.ProcessOutput,
+			Role:      events.RoleWorker,
+			ProcessID: "worker-1",
+			Output:    "Running the test suite",
+		},
+	})
+	m = result.(Model)
+	require.Contains(t, m.View(), "Running the test suite")
+
+	result, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = result.(Model)
+	result, _ = m.Update(cmd())
+	m = result.(Model)
+	require.Nil(t, m.transcriptViewer)
+}
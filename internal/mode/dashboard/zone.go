@@ -10,16 +10,25 @@ import (
 // - Workflow rows: workflow:{index}
 // - Coordinator tabs: tab:{index}
 // - Chat input: chat-input
+// - Status bar segments: status:{workers,notifications,warnings}
 
 // Zone ID prefixes
 const (
-	zoneWorkflowPrefix  = "workflow:"
-	zoneTabPrefix       = "tab:"
-	zoneChatInput       = "chat-input"
-	zoneWorkflowTable   = "workflow-table"
-	zoneEpicTree        = "epic-tree"
-	zoneEpicDetails     = "epic-details"
-	zoneEpicIssuePrefix = "epic-issue:" // Prefix for clickable issues in epic tree
+	zoneWorkflowPrefix   = "workflow:"
+	zoneTabPrefix        = "tab:"
+	zoneChatInput        = "chat-input"
+	zoneWorkflowTable    = "workflow-table"
+	zoneEpicTree         = "epic-tree"
+	zoneEpicDetails      = "epic-details"
+	zoneEpicIssuePrefix  = "epic-issue:" // Prefix for clickable issues in epic tree
+	zoneWorkerGrid       = "worker-grid"
+	zoneWorkerActivity   = "worker-activity"
+	zoneWorkerCardPrefix = "worker-card:" // Prefix for worker cards in the worker grid
+
+	// Status bar segments
+	zoneStatusWorkers       = "status:workers"
+	zoneStatusNotifications = "status:notifications"
+	zoneStatusWarnings      = "status:warnings"
 )
 
 // makeWorkflowZoneID creates a zone ID for a workflow row.
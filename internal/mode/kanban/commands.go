@@ -0,0 +1,168 @@
+package kanban
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/details"
+	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
+)
+
+// switchViewMsg is produced by the command palette to jump to a view by index.
+type switchViewMsg struct {
+	index int
+}
+
+// moveIssueMsg is produced by the command palette to move the selected issue
+// to the neighbouring column in direction dir (-1 left, +1 right).
+type moveIssueMsg struct {
+	dir int
+}
+
+// toggleCollapseMsg is produced by the command palette to collapse or expand
+// the focused column.
+type toggleCollapseMsg struct{}
+
+// Idle reports whether the board is shown without a modal or overlay, so
+// app-wide shortcuts like the command palette won't steal keys from them.
+func (m Model) Idle() bool {
+	return m.view == ViewBoard
+}
+
+// Commands returns the command palette actions for the current board state.
+func (m Model) Commands() []mode.Command {
+	var cmds []mode.Command
+
+	if issue := m.board.SelectedIssue(); issue != nil {
+		selected := *issue
+		cmds = append(cmds,
+			mode.Command{
+				ID:          "kanban.edit-issue",
+				Title:       "Edit issue",
+				Description: selected.ID + " " + selected.TitleText,
+				Key:         keys.Component.EditAction.Help().Key,
+				Run:         func() tea.Msg { return OpenEditMenuMsg{Issue: selected} },
+			},
+			mode.Command{
+				ID:          "kanban.open-tree",
+				Title:       "Open issue tree",
+				Description: selected.ID + " " + selected.TitleText,
+				Key:         keys.Kanban.Enter.Help().Key,
+				Run: func() tea.Msg {
+					return SwitchToSearchMsg{SubMode: mode.SubModeTree, IssueID: selected.ID}
+				},
+			},
+			mode.Command{
+				ID:          "kanban.delete-issue",
+				Title:       "Delete issue",
+				Description: selected.ID + " " + selected.TitleText,
+				Key:         keys.Component.DelAction.Help().Key,
+				Run: func() tea.Msg {
+					return details.DeleteIssueMsg{IssueID: selected.ID, IssueType: selected.Type}
+				},
+			},
+		)
+	}
+
+	if focused := m.board.FocusedColumn(); focused >= 0 && focused < m.board.ColCount() {
+		if issue := m.board.SelectedIssue(); issue != nil {
+			columns := m.currentViewColumns()
+			for _, move := range []struct {
+				dir     int
+				id, key string
+			}{
+				{-1, "kanban.move-left", keys.Kanban.MoveIssueLeft.Help().Key},
+				{1, "kanban.move-right", keys.Kanban.MoveIssueRight.Help().Key},
+			} {
+				target := focused + move.dir
+				if target < 0 || target >= len(columns) {
+					continue
+				}
+				if _, ok := m.board.ColumnStatus(target); !ok {
+					continue
+				}
+				dir := move.dir
+				cmds = append(cmds, mode.Command{
+					ID:          move.id,
+					Title:       "Move issue to " + columns[target].Name,
+					Description: issue.ID + " " + issue.TitleText,
+					Key:         move.key,
+					Run:         func() tea.Msg { return moveIssueMsg{dir: dir} },
+				})
+			}
+		}
+
+		title := "Collapse column"
+		if m.board.IsCollapsed(focused) {
+			title = "Expand column"
+		}
+		cmds = append(cmds, mode.Command{
+			ID:    "kanban.collapse-column",
+			Title: title,
+			Key:   keys.Kanban.CollapseColumn.Help().Key,
+			Run:   func() tea.Msg { return toggleCollapseMsg{} },
+		})
+
+		query := m.board.Column(focused).Query()
+		cmds = append(cmds, mode.Command{
+			ID:          "kanban.search-column",
+			Title:       "Search column",
+			Description: query,
+			Key:         keys.Kanban.SearchFromColumn.Help().Key,
+			Run: func() tea.Msg {
+				return SwitchToSearchMsg{SubMode: mode.SubModeList, Query: query}
+			},
+		})
+	}
+
+	current := m.board.CurrentViewIndex()
+	for i, view := range m.services.Config.GetViews() {
+		if i == current {
+			continue
+		}
+		index := i
+		cmds = append(cmds, mode.Command{
+			ID:    fmt.Sprintf("kanban.view.%d", i),
+			Title: "Switch to view: " + view.Name,
+			Run:   func() tea.Msg { return switchViewMsg{index: index} },
+		})
+	}
+
+	return append(cmds,
+		mode.Command{
+			ID:    "kanban.create-view",
+			Title: "Create new view",
+			Run:   func() tea.Msg { return viewMenuCreateMsg{} },
+		},
+		mode.Command{
+			ID:    "kanban.rename-view",
+			Title: "Rename current view",
+			Run:   func() tea.Msg { return viewMenuRenameMsg{} },
+		},
+		mode.Command{
+			ID:    "kanban.view-fields",
+			Title: "Choose fields & sort",
+			Run:   func() tea.Msg { return viewMenuFieldsMsg{} },
+		},
+		mode.Command{
+			ID:    "kanban.delete-view",
+			Title: "Delete current view",
+			Run:   func() tea.Msg { return viewMenuDeleteMsg{} },
+		},
+		mode.Command{
+			ID:    "kanban.dashboard",
+			Title: "Open dashboard",
+			Key:   keys.Kanban.Dashboard.Help().Key,
+			Run:   func() tea.Msg { return SwitchToDashboardMsg{} },
+		},
+		mode.Command{
+			ID:    "kanban.git-diff",
+			Title: "Show git diff",
+			Key:   keys.DiffViewer.Open.Help().Key,
+			Run:   func() tea.Msg { return diffviewer.ShowDiffViewerMsg{} },
+		},
+	)
+}
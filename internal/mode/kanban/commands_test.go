@@ -0,0 +1,107 @@
+package kanban
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/board"
+)
+
+func findCommand(t *testing.T, cmds []mode.Command, id string) mode.Command {
+	t.Helper()
+	c, ok := mode.FindCommand(cmds, id)
+	require.True(t, ok, "expected command %q", id)
+	return c
+}
+
+func TestKanban_Commands_SelectedIssue(t *testing.T) {
+	m := createTestModelWithIssue("test-123", "status = open")
+
+	cmds := m.Commands()
+
+	edit := findCommand(t, cmds, "kanban.edit-issue")
+	msg, ok := edit.Run().(OpenEditMenuMsg)
+	require.True(t, ok)
+	require.Equal(t, "test-123", msg.Issue.ID)
+	require.Equal(t, "ctrl+e", edit.Key)
+
+	search := findCommand(t, cmds, "kanban.search-column")
+	require.Equal(t, SwitchToSearchMsg{SubMode: mode.SubModeList, Query: "status = open"}, search.Run())
+}
+
+func TestKanban_Commands_NoIssue(t *testing.T) {
+	m := createTestModel(t)
+	m.board = board.NewFromViews(config.DefaultViews(), nil, nil)
+
+	cmds := m.Commands()
+
+	_, ok := mode.FindCommand(cmds, "kanban.edit-issue")
+	require.False(t, ok, "issue commands need a selected issue")
+	findCommand(t, cmds, "kanban.dashboard")
+}
+
+func TestKanban_Commands_SwitchView(t *testing.T) {
+	m := createTestModel(t)
+	columns := []config.ColumnConfig{{Name: "Open", Query: "status = open"}}
+	m.services.Config.Views = []config.ViewConfig{
+		{Name: "Main", Columns: columns},
+		{Name: "Bugs", Columns: columns},
+	}
+	m.board = board.NewFromViews(m.services.Config.Views, nil, nil).SetSize(100, 40)
+
+	cmds := m.Commands()
+	_, ok := mode.FindCommand(cmds, "kanban.view.0")
+	require.False(t, ok, "the current view is not offered")
+
+	switchView := findCommand(t, cmds, "kanban.view.1")
+	require.Equal(t, "Switch to view: Bugs", switchView.Title)
+
+	m, cmd := m.Update(switchView.Run())
+	require.Equal(t, 1, m.board.CurrentViewIndex())
+	require.Equal(t, "Bugs", m.board.CurrentViewName())
+	require.NotNil(t, cmd)
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok, "view name is toasted while the status bar is hidden")
+	require.Equal(t, "View: Bugs (2/2)", toast.Message)
+}
+
+func TestKanban_Idle(t *testing.T) {
+	m := createTestModel(t)
+	require.True(t, m.Idle())
+
+	m, _ = m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	require.Equal(t, ViewHelp, m.view)
+	require.False(t, m.Idle(), "help overlay captures keys")
+}
+
+func TestKanban_Commands_MoveAndCollapse(t *testing.T) {
+	m := createTestModelWithWorkflow(t)
+
+	cmds := m.Commands()
+	_, ok := mode.FindCommand(cmds, "kanban.move-left")
+	require.False(t, ok, "nothing to the left of the first column")
+
+	moveRight := findCommand(t, cmds, "kanban.move-right")
+	require.Equal(t, "Move issue to Doing", moveRight.Title)
+	require.Equal(t, "L", moveRight.Key)
+	require.Equal(t, moveIssueMsg{dir: 1}, moveRight.Run())
+
+	collapse := findCommand(t, cmds, "kanban.collapse-column")
+	require.Equal(t, "Collapse column", collapse.Title)
+	m, _ = m.Update(collapse.Run())
+	require.True(t, m.board.IsCollapsed(0))
+
+	collapse = findCommand(t, m.Commands(), "kanban.collapse-column")
+	require.Equal(t, "Expand column", collapse.Title)
+
+	// Doing can move left to Ready but not right to a column without a status
+	m.board = m.board.SetFocus(1)
+	cmds = m.Commands()
+	require.Equal(t, "Move issue to Ready", findCommand(t, cmds, "kanban.move-left").Title)
+	_, ok = mode.FindCommand(cmds, "kanban.move-right")
+	require.False(t, ok)
+}
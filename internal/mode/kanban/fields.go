@@ -0,0 +1,170 @@
+package kanban
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+// issueFieldLabels are the chooser labels for config.IssueFields.
+var issueFieldLabels = map[string]string{
+	config.IssueFieldType:     "Type",
+	config.IssueFieldPriority: "Priority",
+	config.IssueFieldID:       "ID",
+	config.IssueFieldStatus:   "Status",
+	config.IssueFieldAssignee: "Assignee",
+	config.IssueFieldLabels:   "Labels",
+	config.IssueFieldUpdated:  "Updated",
+}
+
+// viewFieldsSubmitMsg is the fields & sort form submission.
+type viewFieldsSubmitMsg struct {
+	fields []string
+	sort   string
+}
+
+// viewFieldsCancelMsg is produced when the fields & sort form is cancelled.
+type viewFieldsCancelMsg struct{}
+
+// openFieldsModal opens the fields & sort chooser for the current view.
+func (m Model) openFieldsModal() (Model, tea.Cmd) {
+	viewIndex := m.currentViewIndex()
+	if viewIndex < 0 || viewIndex >= len(m.services.Config.Views) {
+		m.view = ViewBoard
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "No saved view to configure", Style: toaster.StyleError}
+		}
+	}
+
+	m.fieldsModal = formmodal.New(makeFieldsFormConfig(m.services.Config.Views[viewIndex])).
+		SetSize(m.width, m.height)
+	m.view = ViewFieldsModal
+	return m, m.fieldsModal.Init()
+}
+
+// makeFieldsFormConfig creates the formmodal config for choosing a view's
+// issue fields and sort order.
+func makeFieldsFormConfig(view config.ViewConfig) formmodal.FormConfig {
+	selected := view.Fields
+	if len(selected) == 0 {
+		selected = config.DefaultIssueFields
+	}
+	options := make([]formmodal.ListOption, len(config.IssueFields))
+	for i, field := range config.IssueFields {
+		options[i] = formmodal.ListOption{
+			Label:    issueFieldLabels[field],
+			Value:    field,
+			Selected: slices.Contains(selected, field),
+		}
+	}
+
+	return formmodal.FormConfig{
+		Title: "Fields & Sort: " + view.Name,
+		Fields: []formmodal.FieldConfig{
+			{
+				Key:         "fields",
+				Type:        formmodal.FieldTypeList,
+				Label:       "Fields",
+				Hint:        "Space to toggle",
+				MultiSelect: true,
+				Options:     options,
+			},
+			{
+				Key:          "sort",
+				Type:         formmodal.FieldTypeText,
+				Label:        "Sort",
+				Hint:         "optional",
+				Placeholder:  "priority asc, updated desc",
+				InitialValue: view.Sort,
+			},
+		},
+		SubmitLabel: " Save ",
+		MinWidth:    50,
+		Validate: func(values map[string]any) error {
+			fields, sort := fieldsFormValues(values)
+			if len(fields) == 0 {
+				return errors.New("Choose at least one field")
+			}
+			return validateViewSort(sort)
+		},
+		OnSubmit: func(values map[string]any) tea.Msg {
+			fields, sort := fieldsFormValues(values)
+			return viewFieldsSubmitMsg{fields: fields, sort: sort}
+		},
+		OnCancel: func() tea.Msg { return viewFieldsCancelMsg{} },
+	}
+}
+
+// fieldsFormValues extracts the chosen fields and sort from the form values.
+func fieldsFormValues(values map[string]any) ([]string, string) {
+	fields, _ := values["fields"].([]string)
+	sort, _ := values["sort"].(string)
+	return fields, strings.TrimSpace(sort)
+}
+
+// validateViewSort checks the sort syntax and that BQL can order by its fields.
+func validateViewSort(sort string) error {
+	if sort == "" {
+		return nil
+	}
+	if err := config.ValidateViewSort(sort); err != nil {
+		return err
+	}
+	query, err := bql.NewParser("order by " + sort).Parse()
+	if err != nil {
+		return err
+	}
+	return bql.Validate(query)
+}
+
+// handleViewFieldsSubmit persists the chosen fields and sort for the current
+// view and reloads the board with them.
+func (m Model) handleViewFieldsSubmit(msg viewFieldsSubmitMsg) (Model, tea.Cmd) {
+	viewIndex := m.currentViewIndex()
+	m.view = ViewBoard
+
+	// The defaults are stored as no fields so rows keep their wrapping titles
+	fields := msg.fields
+	if slices.Equal(fields, config.DefaultIssueFields) {
+		fields = nil
+	}
+
+	err := config.SetViewDisplay(m.configPath(), viewIndex, fields, msg.sort, m.services.Config.Views)
+	if err != nil {
+		log.ErrorErr(log.CatConfig, "Failed to save view fields", err,
+			"viewIndex", viewIndex)
+		m.err = err
+		m.errContext = "saving view fields"
+		return m, scheduleErrorClear()
+	}
+
+	m.rebuildBoard()
+	m.loading = true
+	return m, tea.Batch(
+		func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Updated fields & sort", Style: toaster.StyleSuccess}
+		},
+		m.loadBoardCmd(),
+	)
+}
+
+func (m Model) handleFieldsModalKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if msg.Type == tea.KeyCtrlC {
+		// Close overlay instead of quitting
+		m.view = ViewBoard
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.fieldsModal, cmd = m.fieldsModal.Update(msg)
+	return m, cmd
+}
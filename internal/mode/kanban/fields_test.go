@@ -0,0 +1,77 @@
+package kanban
+
+import (
+	"os"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/ui/board"
+)
+
+func TestKanban_FieldsModal_OpensFromViewMenu(t *testing.T) {
+	m := createTestModelWithWorkflow(t).SetSize(100, 40)
+
+	m, _ = m.Update(viewMenuFieldsMsg{})
+	require.Equal(t, ViewFieldsModal, m.view)
+	view := m.View()
+	require.Contains(t, view, "Fields & Sort: Work")
+	require.Contains(t, view, "Assignee")
+
+	m, _ = m.Update(viewFieldsCancelMsg{})
+	require.Equal(t, ViewBoard, m.view)
+}
+
+func TestKanban_FieldsModal_SubmitSavesAndRebuilds(t *testing.T) {
+	m := createTestModelWithWorkflow(t).SetSize(100, 40)
+	m.view = ViewFieldsModal
+
+	m, cmd := m.Update(viewFieldsSubmitMsg{
+		fields: []string{config.IssueFieldID, config.IssueFieldAssignee},
+		sort:   "updated desc",
+	})
+	require.NotNil(t, cmd)
+	require.Equal(t, ViewBoard, m.view)
+	require.True(t, m.loading)
+	require.Equal(t, []string{"id", "assignee"}, m.services.Config.Views[0].Fields)
+	require.Equal(t, "updated desc", m.services.Config.Views[0].Sort)
+
+	data, err := os.ReadFile(m.services.ConfigPath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "fields: [id, assignee]")
+	require.Contains(t, string(data), "sort: updated desc")
+
+	m, _ = m.Update(board.ColumnLoadedMsg{ColumnIndex: 0, Issues: []beads.Issue{
+		{ID: "bd-1", TitleText: "Ready issue", Status: beads.StatusOpen, Assignee: "alice"},
+	}})
+	require.Contains(t, m.View(), "@alice")
+}
+
+func TestKanban_FieldsModal_DefaultFieldsStoredAsEmpty(t *testing.T) {
+	m := createTestModelWithWorkflow(t)
+	m.services.Config.Views[0].Fields = []string{config.IssueFieldStatus}
+
+	m, _ = m.Update(viewFieldsSubmitMsg{fields: config.DefaultIssueFields})
+	require.Nil(t, m.services.Config.Views[0].Fields)
+}
+
+func TestValidateViewSort(t *testing.T) {
+	require.NoError(t, validateViewSort(""))
+	require.NoError(t, validateViewSort("priority asc, updated desc"))
+	require.Error(t, validateViewSort("priority sideways"))
+	require.Error(t, validateViewSort("nonexistent"), "BQL rejects unknown fields")
+}
+
+func TestMakeFieldsFormConfig_Validate(t *testing.T) {
+	cfg := makeFieldsFormConfig(config.ViewConfig{Name: "Work"})
+
+	require.EqualError(t, cfg.Validate(map[string]any{"fields": []string{}, "sort": ""}), "Choose at least one field")
+	require.NoError(t, cfg.Validate(map[string]any{"fields": []string{"id"}, "sort": " priority desc "}))
+
+	msg := cfg.OnSubmit(map[string]any{"fields": []string{"id"}, "sort": " priority desc "})
+	require.Equal(t, viewFieldsSubmitMsg{fields: []string{"id"}, sort: "priority desc"}, msg)
+	require.Equal(t, tea.Msg(viewFieldsCancelMsg{}), cfg.OnCancel())
+}
@@ -50,6 +50,8 @@ func (m Model) handleKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		return m.handleEditIssueKey(msg)
 	case ViewDeleteIssue:
 		return m.handleDeleteIssueKey(msg)
+	case ViewFieldsModal:
+		return m.handleFieldsModalKey(msg)
 	}
 	return m, nil
 }
@@ -61,6 +63,8 @@ func (m Model) handleBoardKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		m.err = nil
 		m.errContext = ""
 	}
+	// Stop following a moved issue once the user navigates on their own
+	m.followCursor = nil
 
 	switch {
 	case msg.Type == tea.KeyCtrlC:
@@ -166,33 +170,20 @@ func (m Model) handleBoardKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		m.board = m.board.SwapColumns(focusedCol, focusedCol+1).SetFocus(focusedCol + 1)
 		return m, nil
 
+	case key.Matches(msg, keys.Kanban.MoveIssueLeft):
+		return m.moveSelectedIssue(-1)
+
+	case key.Matches(msg, keys.Kanban.MoveIssueRight):
+		return m.moveSelectedIssue(1)
+
+	case key.Matches(msg, keys.Kanban.CollapseColumn):
+		return m.toggleCollapsedColumn()
+
 	case key.Matches(msg, keys.Kanban.NextView):
 		if m.board.ViewCount() > 1 {
 			var cmd tea.Cmd
 			m.board, cmd = m.board.CycleViewNext()
-
-			// Show toast only if status bar is hidden
-			var toastCmd tea.Cmd
-			if !m.showStatusBar {
-				viewName := m.board.CurrentViewName()
-				viewNum := m.board.CurrentViewIndex() + 1
-				viewTotal := m.board.ViewCount()
-				toastCmd = func() tea.Msg {
-					return mode.ShowToastMsg{
-						Message: fmt.Sprintf("View: %s (%d/%d)", viewName, viewNum, viewTotal),
-						Style:   toaster.StyleInfo,
-					}
-				}
-			}
-
-			if cmd != nil {
-				m.loading = true
-				if toastCmd != nil {
-					return m, tea.Batch(cmd, toastCmd)
-				}
-				return m, cmd
-			}
-			return m, toastCmd
+			return m.viewSwitched(cmd)
 		}
 		return m, nil
 
@@ -200,29 +191,7 @@ func (m Model) handleBoardKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		if m.board.ViewCount() > 1 {
 			var cmd tea.Cmd
 			m.board, cmd = m.board.CycleViewPrev()
-
-			// Show toast only if status bar is hidden
-			var toastCmd tea.Cmd
-			if !m.showStatusBar {
-				viewName := m.board.CurrentViewName()
-				viewNum := m.board.CurrentViewIndex() + 1
-				viewTotal := m.board.ViewCount()
-				toastCmd = func() tea.Msg {
-					return mode.ShowToastMsg{
-						Message: fmt.Sprintf("View: %s (%d/%d)", viewName, viewNum, viewTotal),
-						Style:   toaster.StyleInfo,
-					}
-				}
-			}
-
-			if cmd != nil {
-				m.loading = true
-				if toastCmd != nil {
-					return m, tea.Batch(cmd, toastCmd)
-				}
-				return m, cmd
-			}
-			return m, toastCmd
+			return m.viewSwitched(cmd)
 		}
 		return m, nil
 
@@ -233,6 +202,7 @@ func (m Model) handleBoardKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 				{Label: "Create new view", Value: "create"},
 				{Label: "Delete current view", Value: "delete"},
 				{Label: "Rename current view", Value: "rename"},
+				{Label: "Fields & sort", Value: "fields"},
 			},
 			OnSelect: func(opt picker.Option) tea.Msg {
 				switch opt.Value {
@@ -242,6 +212,8 @@ func (m Model) handleBoardKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 					return viewMenuDeleteMsg{}
 				case "rename":
 					return viewMenuRenameMsg{}
+				case "fields":
+					return viewMenuFieldsMsg{}
 				}
 				return nil
 			},
@@ -484,8 +456,9 @@ func (m Model) handleRenameViewModalKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 
 // handleColumnLoaded processes column load completion.
 func (m Model) handleColumnLoaded(msg tea.Msg) (Model, tea.Cmd) {
-	// Pass message to board for handling
-	m.board, _ = m.board.Update(msg)
+	// Pass message to board for handling (may emit WIPLimitExceededMsg)
+	var boardCmd tea.Cmd
+	m.board, boardCmd = m.board.Update(msg)
 
 	// SQLite queries are instant, so treat every load message as completion
 	m.loading = false
@@ -495,18 +468,26 @@ func (m Model) handleColumnLoaded(msg tea.Msg) (Model, tea.Cmd) {
 		m = m.restoreCursor(m.pendingCursor)
 		m.pendingCursor = nil
 	}
+	// Columns load one by one, so a moved issue may only show up in a later load
+	if m.followCursor != nil {
+		if newBoard, found := m.board.SelectInColumn(m.followCursor.column, m.followCursor.issueID); found {
+			m.board = newBoard
+			m.followCursor = nil
+		}
+	}
 	// Auto sync is silent, manual refresh shows toaster
 	m.autoRefreshed = false
 	if m.manualRefreshed {
 		m.manualRefreshed = false
-		return m, func() tea.Msg { return mode.ShowToastMsg{Message: "refreshed issues", Style: toaster.StyleSuccess} }
+		return m, tea.Batch(boardCmd, func() tea.Msg { return mode.ShowToastMsg{Message: "refreshed issues", Style: toaster.StyleSuccess} })
 	}
-	return m, nil
+	return m, boardCmd
 }
 
 // handleIssueSaved processes the result of a consolidated issue save.
 func (m Model) handleIssueSaved(msg issueSavedMsg) (Model, tea.Cmd) {
 	if msg.err != nil {
+		m.followCursor = nil
 		return m, func() tea.Msg {
 			return mode.ShowToastMsg{Message: "Save failed: " + msg.err.Error(), Style: toaster.StyleError}
 		}
@@ -571,6 +552,16 @@ func (m Model) HandleDBChanged() (Model, tea.Cmd) {
 	return m, m.board.LoadAllColumns()
 }
 
+// UpdateIssue applies update to the issue with the given ID on the board and
+// highlights it, keeping the cursor. It is called by app.go for issue changes
+// made by orchestration; the DB watcher reload that follows moves the issue
+// between columns if its status changed.
+func (m Model) UpdateIssue(id string, update func(*beads.Issue)) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	m.board, cmd = m.board.UpdateIssue(id, update)
+	return m, cmd
+}
+
 // handleColEditorSave processes column editor save.
 func (m Model) handleColEditorSave(msg coleditor.SaveMsg) (Model, tea.Cmd) {
 	viewIndex := m.currentViewIndex()
@@ -777,3 +768,98 @@ func (m Model) handleIssueDeleted(msg issueDeletedMsg) (Model, tea.Cmd) {
 		func() tea.Msg { return mode.ShowToastMsg{Message: "Issue deleted", Style: toaster.StyleSuccess} },
 	)
 }
+
+// viewSwitched finishes a switch to another view. loadCmd loads the new view's
+// columns (nil when they are cached). The view name is toasted when the status
+// bar that normally shows it is hidden.
+func (m Model) viewSwitched(loadCmd tea.Cmd) (Model, tea.Cmd) {
+	var toastCmd tea.Cmd
+	if !m.showStatusBar {
+		viewName := m.board.CurrentViewName()
+		viewNum := m.board.CurrentViewIndex() + 1
+		viewTotal := m.board.ViewCount()
+		toastCmd = func() tea.Msg {
+			return mode.ShowToastMsg{
+				Message: fmt.Sprintf("View: %s (%d/%d)", viewName, viewNum, viewTotal),
+				Style:   toaster.StyleInfo,
+			}
+		}
+	}
+
+	if loadCmd != nil {
+		m.loading = true
+		if toastCmd != nil {
+			return m, tea.Batch(loadCmd, toastCmd)
+		}
+		return m, loadCmd
+	}
+	return m, toastCmd
+}
+
+// moveSelectedIssue moves the selected issue to the neighbouring column in
+// direction dir (-1 left, +1 right) by giving it the status that column's
+// query selects on. The board reloads after the save and focus follows the issue.
+func (m Model) moveSelectedIssue(dir int) (Model, tea.Cmd) {
+	issue := m.board.SelectedIssue()
+	if issue == nil {
+		return m, nil
+	}
+	target := m.board.FocusedColumn() + dir
+	columns := m.currentViewColumns()
+	if target < 0 || target >= len(columns) {
+		return m, nil // No column in that direction
+	}
+	name := columns[target].Name
+
+	status, ok := m.board.ColumnStatus(target)
+	if !ok {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Can't move to " + name + ": its query doesn't set a status", Style: toaster.StyleWarn}
+		}
+	}
+	if issue.Status == status {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: fmt.Sprintf("%s is already %s", issue.ID, status), Style: toaster.StyleInfo}
+		}
+	}
+
+	log.Debug(log.CatMode, "Moving issue between columns", "issue", issue.ID, "column", name, "status", status)
+	m.loading = true
+	m.followCursor = &cursorState{column: target, issueID: issue.ID}
+	cmds := []tea.Cmd{m.saveIssueCmd(issue.ID, beads.UpdateIssueOptions{Status: &status})}
+
+	// Warn before the move pushes the target column past its WIP limit
+	if col := m.board.Column(target); col.WIPLimit() > 0 && len(col.Items()) >= col.WIPLimit() {
+		warning := fmt.Sprintf("%s over WIP limit (%d/%d)", name, len(col.Items())+1, col.WIPLimit())
+		cmds = append(cmds, func() tea.Msg {
+			return mode.ShowToastMsg{Message: warning, Style: toaster.StyleWarn}
+		})
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// toggleCollapsedColumn collapses or expands the focused column and saves the
+// state to the config.
+func (m Model) toggleCollapsedColumn() (Model, tea.Cmd) {
+	focusedCol := m.board.FocusedColumn()
+	viewIndex := m.currentViewIndex()
+	columns := m.currentViewColumns()
+	if focusedCol < 0 || focusedCol >= len(columns) {
+		return m, nil
+	}
+
+	col := columns[focusedCol]
+	col.Collapsed = !col.Collapsed
+	if err := config.UpdateColumnInView(m.configPath(), viewIndex, focusedCol, col, columns, m.services.Config.Views); err != nil {
+		m.err = err
+		m.errContext = "collapsing column"
+		return m, scheduleErrorClear()
+	}
+
+	// Update in-memory config
+	columns[focusedCol] = col
+	m.services.Config.SetColumnsForView(viewIndex, columns)
+
+	m.board = m.board.SetCollapsed(focusedCol, col.Collapsed)
+	return m, nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/zjrosen/perles/internal/ui/modals/issueeditor"
 	"github.com/zjrosen/perles/internal/ui/shared/colorpicker"
 	"github.com/zjrosen/perles/internal/ui/shared/editor"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
 	"github.com/zjrosen/perles/internal/ui/shared/modal"
 	"github.com/zjrosen/perles/internal/ui/shared/picker"
 	"github.com/zjrosen/perles/internal/ui/shared/toaster"
@@ -40,6 +41,7 @@ const (
 	ViewRenameViewModal
 	ViewEditIssue   // Unified issue editor modal
 	ViewDeleteIssue // Delete issue confirmation modal
+	ViewFieldsModal // Fields & sort chooser for the current view
 )
 
 // cursorState tracks the current selection for restoration after refresh.
@@ -58,6 +60,7 @@ type Model struct {
 	colEditor   coleditor.Model
 	modal       modal.Model
 	issueEditor issueeditor.Model // Unified issue editor modal
+	fieldsModal formmodal.Model   // Fields & sort chooser for the current view
 	view        ViewMode
 	width       int
 	height      int
@@ -75,6 +78,8 @@ type Model struct {
 
 	// Pending cursor restoration after refresh
 	pendingCursor *cursorState
+	// Issue moved to another column; focus follows it once the reload shows it there
+	followCursor *cursorState
 
 	// Refresh state tracking
 	autoRefreshed   bool // Set when refresh triggered by file watcher
@@ -160,6 +165,9 @@ func (m Model) SetSize(width, height int) Model {
 	if m.view == ViewViewMenu {
 		m.picker = m.picker.SetSize(width, height)
 	}
+	if m.view == ViewFieldsModal {
+		m.fieldsModal = m.fieldsModal.SetSize(width, height)
+	}
 	return m
 }
 
@@ -197,6 +205,22 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			var cmd tea.Cmd
 			m.issueEditor, cmd = m.issueEditor.Update(msg)
 			return m, cmd
+		case ViewViewMenu:
+			var cmd tea.Cmd
+			m.picker, cmd = m.picker.Update(msg)
+			return m, cmd
+		case ViewFieldsModal:
+			var cmd tea.Cmd
+			m.fieldsModal, cmd = m.fieldsModal.Update(msg)
+			return m, cmd
+		case ViewColumnEditor:
+			var cmd tea.Cmd
+			m.colEditor, cmd = m.colEditor.Update(msg)
+			return m, cmd
+		case ViewNewViewModal, ViewDeleteViewModal, ViewRenameViewModal, ViewDeleteColumnModal, ViewDeleteIssue:
+			var cmd tea.Cmd
+			m.modal, cmd = m.modal.Update(msg)
+			return m, cmd
 		}
 		return m, nil
 
@@ -220,8 +244,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case OpenEditMenuMsg:
 		issue := msg.Issue
 		m.editingIssue = &issue // Store for title/description comparison on save
-		m.issueEditor = issueeditor.New(msg.Issue).
-			SetSize(m.width, m.height)
+		m.issueEditor = issueeditor.New(msg.Issue, issueeditor.Config{
+			Fields:   m.services.Config.FieldDefs(),
+			Executor: m.services.Executor,
+			WorkDir:  m.services.WorkDir,
+		}).SetSize(m.width, m.height)
 		m.view = ViewEditIssue
 		return m, m.issueEditor.Init()
 
@@ -281,6 +308,20 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		m.view = ViewBoard
 		return m, nil
 
+	case moveIssueMsg:
+		return m.moveSelectedIssue(msg.dir)
+
+	case toggleCollapseMsg:
+		return m.toggleCollapsedColumn()
+
+	case switchViewMsg:
+		if msg.index == m.board.CurrentViewIndex() {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.board, cmd = m.board.SwitchToView(msg.index)
+		return m.viewSwitched(cmd)
+
 	case viewMenuCreateMsg:
 		// Open new view modal
 		m.modal = modal.New(modal.Config{
@@ -327,6 +368,16 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		m.view = ViewRenameViewModal
 		return m, m.modal.Init()
 
+	case viewMenuFieldsMsg:
+		return m.openFieldsModal()
+
+	case viewFieldsSubmitMsg:
+		return m.handleViewFieldsSubmit(msg)
+
+	case viewFieldsCancelMsg:
+		m.view = ViewBoard
+		return m, nil
+
 	case modal.SubmitMsg:
 		return m.handleModalSubmit(msg)
 
@@ -355,6 +406,14 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Other messages (e.g. the dependency pickers' async loads) go to the
+	// issue editor while it's open
+	if m.view == ViewEditIssue {
+		var cmd tea.Cmd
+		m.issueEditor, cmd = m.issueEditor.Update(msg)
+		return m, cmd
+	}
+
 	return m, nil
 }
 
@@ -380,6 +439,10 @@ func (m Model) View() string {
 		// Render view menu overlay on top of board
 		bg := m.renderBoardWithStatusBar()
 		return m.picker.Overlay(bg)
+	case ViewFieldsModal:
+		// Render fields & sort chooser overlay on top of board
+		bg := m.renderBoardWithStatusBar()
+		return m.fieldsModal.Overlay(bg)
 	case ViewDeleteColumnModal, ViewDeleteIssue:
 		// Render delete modal overlay on top of board
 		bg := m.renderBoardWithStatusBar()
@@ -504,6 +567,22 @@ func (m Model) ShowStatusBar() bool {
 	return m.showStatusBar
 }
 
+// FocusDescription describes the focused column and issue in plain text
+// for accessibility mode.
+func (m Model) FocusDescription() string {
+	desc := "Board " + m.board.CurrentViewName()
+	col := m.board.BoardColumn(m.board.FocusedColumn())
+	if col == nil {
+		return desc + ", empty"
+	}
+	desc += ", column " + col.Title()
+	issue := m.board.SelectedIssue()
+	if issue == nil {
+		return desc + ", no issues"
+	}
+	return fmt.Sprintf("%s, %s %s, %s", desc, issue.ID, issue.TitleText, issue.Status)
+}
+
 func (m Model) renderStatusBar() string {
 	// Build left section with view indicator (if multiple views)
 	var content string
@@ -719,6 +798,9 @@ type viewMenuDeleteMsg struct{}
 // viewMenuRenameMsg is produced when "rename view" is selected in view menu picker.
 type viewMenuRenameMsg struct{}
 
+// viewMenuFieldsMsg is produced when "fields & sort" is selected in view menu picker.
+type viewMenuFieldsMsg struct{}
+
 // Async commands
 
 func (m Model) saveIssueCmd(issueID string, opts beads.UpdateIssueOptions) tea.Cmd {
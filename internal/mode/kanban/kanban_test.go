@@ -3,6 +3,8 @@ package kanban
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
 	"testing"
 	"time"
@@ -1271,3 +1273,115 @@ func TestKanban_SaveIssueCmd_PropagatesErrors(t *testing.T) {
 	require.Error(t, savedMsg.err)
 	require.Contains(t, savedMsg.err.Error(), "update failed")
 }
+
+// =============================================================================
+// Moving issues between columns and collapsing columns
+// =============================================================================
+
+// createTestModelWithWorkflow creates a Model whose view has Ready, Doing and
+// Labelled columns, with one issue in Ready and one in Doing, focused on Ready.
+func createTestModelWithWorkflow(t *testing.T) Model {
+	m := createTestModel(t)
+	m.services.ConfigPath = filepath.Join(t.TempDir(), ".perles.yaml")
+	m.services.Config.Views = []config.ViewConfig{{Name: "Work", Columns: []config.ColumnConfig{
+		{Name: "Ready", Query: "status = open and ready = true"},
+		{Name: "Doing", Query: "status = in_progress", WIPLimit: 1},
+		{Name: "Labelled", Query: "label = ui"},
+	}}}
+
+	m.board = board.NewFromViews(m.services.Config.Views, nil, nil).SetSize(100, 40)
+	m.board, _ = m.board.Update(board.ColumnLoadedMsg{ColumnIndex: 0, Issues: []beads.Issue{
+		{ID: "bd-1", TitleText: "Ready issue", Status: beads.StatusOpen},
+	}})
+	m.board, _ = m.board.Update(board.ColumnLoadedMsg{ColumnIndex: 1, Issues: []beads.Issue{
+		{ID: "bd-2", TitleText: "Doing issue", Status: beads.StatusInProgress},
+	}})
+	m.board = m.board.SetFocus(0)
+	return m
+}
+
+// batchMsgs runs cmd and returns the messages it produced, flattening batches.
+func batchMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, batchMsgs(c)...)
+	}
+	return msgs
+}
+
+func TestKanban_MoveIssueRight_UpdatesStatus(t *testing.T) {
+	m := createTestModelWithWorkflow(t)
+	mockExecutor := mocks.NewMockIssueExecutor(t)
+	mockExecutor.EXPECT().UpdateIssue("bd-1", mock.MatchedBy(func(opts beads.UpdateIssueOptions) bool {
+		return opts.Status != nil && *opts.Status == beads.StatusInProgress
+	})).Return(nil)
+	m.services.BeadsExecutor = mockExecutor
+
+	m, cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	require.True(t, m.loading)
+
+	msgs := batchMsgs(cmd)
+	require.Len(t, msgs, 2)
+	saved, ok := msgs[0].(issueSavedMsg)
+	require.True(t, ok)
+	require.NoError(t, saved.err)
+	require.Equal(t, mode.ShowToastMsg{Message: "Doing over WIP limit (2/1)", Style: toaster.StyleWarn}, msgs[1])
+
+	// The reload after the save follows the issue into its new column
+	m, _ = m.Update(saved)
+	m, _ = m.Update(board.ColumnLoadedMsg{ColumnIndex: 0})
+	m, _ = m.Update(board.ColumnLoadedMsg{ColumnIndex: 1, Issues: []beads.Issue{
+		{ID: "bd-2", TitleText: "Doing issue", Status: beads.StatusInProgress},
+		{ID: "bd-1", TitleText: "Ready issue", Status: beads.StatusInProgress},
+	}})
+	require.Equal(t, 1, m.board.FocusedColumn())
+	require.Equal(t, "bd-1", m.board.SelectedIssue().ID)
+}
+
+func TestKanban_MoveIssueLeft_AtFirstColumn(t *testing.T) {
+	m := createTestModelWithWorkflow(t)
+
+	_, cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	require.Nil(t, cmd, "nothing to the left of the first column")
+}
+
+func TestKanban_MoveIssue_ColumnWithoutStatus(t *testing.T) {
+	m := createTestModelWithWorkflow(t)
+	m.board = m.board.SetFocus(1)
+
+	m, cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'L'}})
+	require.False(t, m.loading)
+	require.Equal(t, mode.ShowToastMsg{
+		Message: "Can't move to Labelled: its query doesn't set a status",
+		Style:   toaster.StyleWarn,
+	}, cmd())
+}
+
+func TestKanban_CollapseColumn_TogglesAndSaves(t *testing.T) {
+	m := createTestModelWithWorkflow(t)
+
+	m, _ = m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
+	require.True(t, m.board.IsCollapsed(0))
+	require.True(t, m.currentViewColumns()[0].Collapsed)
+	require.Nil(t, m.board.SelectedIssue(), "issues of a collapsed column can't be selected")
+
+	data, err := os.ReadFile(m.services.ConfigPath)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "collapsed: true")
+
+	m, _ = m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}})
+	require.False(t, m.board.IsCollapsed(0))
+	require.Equal(t, "bd-1", m.board.SelectedIssue().ID)
+
+	data, err = os.ReadFile(m.services.ConfigPath)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "collapsed")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/zjrosen/perles/internal/flags"
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	"github.com/zjrosen/perles/internal/mode/shared"
+	"github.com/zjrosen/perles/internal/notify"
 	domain "github.com/zjrosen/perles/internal/sessions/domain"
 	"github.com/zjrosen/perles/internal/sound"
 	"github.com/zjrosen/perles/internal/ui/shared/toaster"
@@ -66,6 +67,7 @@ type Services struct {
 	Clock         shared.Clock
 	Flags         *flags.Registry
 	Sounds        sound.SoundService
+	Notifier      notify.Notifier
 	// GitExecutorFactory creates git executors for a given path.
 	// Used by orchestration mode to check uncommitted changes in worktrees.
 	GitExecutorFactory func(path string) appgit.GitExecutor
@@ -82,6 +84,20 @@ type ShowToastMsg struct {
 	Style   toaster.Style
 }
 
+// StartProgressMsg requests showing a progress indicator for a long-running operation.
+// Like ShowToastMsg, the app owns the indicator so it stays visible across modes.
+type StartProgressMsg struct {
+	Progress toaster.Progress
+}
+
+// FinishProgressMsg removes a progress indicator and, if Message is set,
+// shows a completion toast in its place.
+type FinishProgressMsg struct {
+	ID      string
+	Message string
+	Style   toaster.Style
+}
+
 // RequestQuitMsg requests showing the quit confirmation modal.
 // Modes bubble this up instead of handling quit directly, allowing the app
 // to manage a centralized quit modal with consistent behavior.
@@ -162,6 +162,7 @@ func createCommandPaletteDemo(width, height int) DemoModel {
 		Title:           "Demo Commands",
 		Placeholder:     "Search commands...",
 		Items:           items,
+		MaxWidth:        60, // Fits the demo pane so item zones aren't split by wrapping
 		MaxVisibleItems: 5,
 	}).SetSize(width, height)
 
@@ -697,9 +698,12 @@ func (m *VimtextareaDemoModel) Update(msg tea.Msg) (DemoModel, tea.Cmd, string)
 }
 
 func (m *VimtextareaDemoModel) View() string {
+	// Show the registers below the textarea so "ayiw / "ap can be followed
+	registers := lipgloss.NewStyle().Foreground(styles.TextMutedColor).Render(m.textarea.RegistersView())
+
 	// Wrap textarea in a bordered pane with mode indicator in bottom left
 	return panes.BorderedPane(panes.BorderConfig{
-		Content:     m.textarea.View(),
+		Content:     m.textarea.View() + "\n\n" + registers,
 		Width:       m.width,
 		Height:      m.height,
 		BottomLeft:  m.textarea.ModeIndicator(),
@@ -855,6 +859,32 @@ func (m *FormmodalDemoModel) Update(msg tea.Msg) (DemoModel, tea.Cmd, string) {
 								{Label: "release-v1.0", Value: "release-v1.0"},
 							},
 						},
+						{
+							Key:   "assignee",
+							Type:  formmodal.FieldTypeAsyncSelect,
+							Label: "Assignee (async)",
+							LoadOptions: func() ([]formmodal.ListOption, error) {
+								time.Sleep(time.Second) // Simulate a slow fetch
+								return []formmodal.ListOption{
+									{Label: "worker-1", Subtext: "idle", Value: "worker-1"},
+									{Label: "worker-2", Subtext: "working on demo-123", Value: "worker-2"},
+									{Label: "worker-3", Subtext: "idle", Value: "worker-3"},
+								}, nil
+							},
+						},
+						{
+							Key:     "due",
+							Type:    formmodal.FieldTypeDate,
+							Label:   "Due Date",
+							MinDate: time.Now(),
+						},
+						{
+							Key:             "estimate",
+							Type:            formmodal.FieldTypeDuration,
+							Label:           "Estimate",
+							InitialDuration: time.Hour,
+							DurationStep:    15 * time.Minute,
+						},
 						{
 							Key:         "description",
 							Type:        formmodal.FieldTypeTextArea,
@@ -1666,7 +1696,7 @@ func (m *IssueeditorDemoModel) Update(msg tea.Msg) (DemoModel, tea.Cmd, string)
 					Priority:  beads.PriorityMedium,
 					Status:    beads.StatusOpen,
 				}
-				editor := issueeditor.New(issue).SetSize(m.width, m.height)
+				editor := issueeditor.New(issue, issueeditor.Config{}).SetSize(m.width, m.height)
 				m.issueeditor = &editor
 				m.showingMenu = false
 				return m, editor.Init(), ""
@@ -237,8 +237,8 @@ func TestGetTokenColor(t *testing.T) {
 func TestGetTokenCategories(t *testing.T) {
 	categories := GetTokenCategories()
 
-	// Should have 14 categories
-	require.Len(t, categories, 14, "Should have 14 token categories")
+	// Should have 15 categories
+	require.Len(t, categories, 15, "Should have 15 token categories")
 
 	// Count total tokens across categories
 	totalTokens := 0
@@ -246,7 +246,7 @@ func TestGetTokenCategories(t *testing.T) {
 		totalTokens += len(cat.Tokens)
 	}
 
-	// Should match AllTokens count (46)
+	// Should match AllTokens count (66)
 	allTokens := styles.AllTokens()
 	require.Equal(t, len(allTokens), totalTokens, "Total tokens in categories should match AllTokens()")
 }
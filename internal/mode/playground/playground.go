@@ -7,6 +7,7 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
 
 	"github.com/zjrosen/perles/internal/keys"
 	"github.com/zjrosen/perles/internal/ui/shared/panes"
@@ -50,6 +51,9 @@ type QuitMsg struct{}
 
 // New creates a new playground model.
 func New() Model {
+	// Initialize global zone manager for mouse click detection in demos
+	zone.NewGlobal()
+
 	demos := GetComponentDemos()
 
 	m := Model{
@@ -252,7 +256,8 @@ func (m Model) View() string {
 		return m.quitModal.Overlay(content)
 	}
 
-	return content
+	// Scan for zone markers so demos can detect mouse clicks
+	return zone.Scan(content)
 }
 
 // renderComponentListView renders the main component list view with sidebar + demo area.
@@ -155,6 +155,18 @@ func GetTokenColor(token styles.ColorToken) string {
 	case styles.TokenDiffHunk:
 		return styles.DiffHunkColor.Dark
 
+	// Orchestration agents
+	case styles.TokenAgentCoordinator:
+		return styles.AgentCoordinatorColor.Dark
+	case styles.TokenAgentWorker:
+		return styles.AgentWorkerColor.Dark
+	case styles.TokenAgentObserver:
+		return styles.AgentObserverColor.Dark
+	case styles.TokenAgentUser:
+		return styles.AgentUserColor.Dark
+	case styles.TokenAgentSystem:
+		return styles.AgentSystemColor.Dark
+
 	// Misc
 	case styles.TokenSpinner:
 		return styles.SpinnerColor.Dark
@@ -298,6 +310,16 @@ func GetTokenCategories() []TokenCategory {
 				styles.TokenDiffHunk,
 			},
 		},
+		{
+			Name: "Agents",
+			Tokens: []styles.ColorToken{
+				styles.TokenAgentCoordinator,
+				styles.TokenAgentWorker,
+				styles.TokenAgentObserver,
+				styles.TokenAgentUser,
+				styles.TokenAgentSystem,
+			},
+		},
 		{
 			Name: "Misc",
 			Tokens: []styles.ColorToken{
@@ -0,0 +1,228 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/mode/shared"
+	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
+	"github.com/zjrosen/perles/internal/ui/shared/picker"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+// bulkUnchanged is the status/priority option value that leaves the field as is.
+const bulkUnchanged = ""
+
+// bulkEditSubmitMsg is the bulk edit form submission.
+type bulkEditSubmitMsg struct {
+	issueIDs []string
+	opts     beads.BulkUpdateOptions
+}
+
+// bulkUpdatedMsg carries the outcome of a bulk update.
+type bulkUpdatedMsg struct {
+	result beads.BulkResult
+}
+
+// toggleMark marks or unmarks the selected result for a bulk edit.
+func (m Model) toggleMark() (Model, tea.Cmd) {
+	issue := m.getSelectedIssue()
+	if issue == nil {
+		return m, nil
+	}
+	if m.marked[issue.ID] {
+		delete(m.marked, issue.ID)
+	} else {
+		m.marked[issue.ID] = true
+	}
+	return m, nil
+}
+
+// markedIssues returns the marked results in result order.
+func (m Model) markedIssues() []beads.Issue {
+	var issues []beads.Issue
+	for _, issue := range m.results {
+		if m.marked[issue.ID] {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// pruneMarks drops marks for issues no longer in the results.
+func (m Model) pruneMarks() {
+	for id := range m.marked {
+		if !slices.ContainsFunc(m.results, func(issue beads.Issue) bool { return issue.ID == id }) {
+			delete(m.marked, id)
+		}
+	}
+}
+
+// openBulkEdit opens the bulk edit form for the marked results.
+func (m Model) openBulkEdit() (Model, tea.Cmd) {
+	issues := m.markedIssues()
+	if len(issues) == 0 {
+		return m, func() tea.Msg {
+			return mode.ShowToastMsg{Message: "Mark issues with space first", Style: toaster.StyleWarn}
+		}
+	}
+
+	m.bulkModal = formmodal.New(makeBulkEditFormConfig(issues, m.services.Executor)).
+		SetSize(m.width, m.height)
+	m.view = ViewBulkEdit
+	return m, m.bulkModal.Init()
+}
+
+// makeBulkEditFormConfig creates the formmodal config for editing issues in bulk.
+// Every field starts unchanged, so only what the user picks is applied.
+func makeBulkEditFormConfig(issues []beads.Issue, executor bql.BQLExecutor) formmodal.FormConfig {
+	ids := make([]string, len(issues))
+	var labels []string
+	for i, issue := range issues {
+		ids[i] = issue.ID
+		for _, label := range issue.Labels {
+			if !slices.Contains(labels, label) {
+				labels = append(labels, label)
+			}
+		}
+	}
+	slices.Sort(labels)
+
+	noun := "Issues"
+	if len(issues) == 1 {
+		noun = "Issue"
+	}
+
+	removeOptions := make([]formmodal.ListOption, len(labels))
+	for i, label := range labels {
+		removeOptions[i] = formmodal.ListOption{Label: label, Value: label}
+	}
+
+	return formmodal.FormConfig{
+		Title: fmt.Sprintf("Edit %d %s", len(issues), noun),
+		Fields: []formmodal.FieldConfig{
+			{
+				Key:     "status",
+				Type:    formmodal.FieldTypeSelect,
+				Label:   "Status",
+				Hint:    "Space to toggle",
+				Options: bulkListOptions(shared.StatusOptions()),
+			},
+			{
+				Key:     "priority",
+				Type:    formmodal.FieldTypeSelect,
+				Label:   "Priority",
+				Hint:    "Space to toggle",
+				Options: bulkListOptions(shared.PriorityOptions()),
+			},
+			{
+				Key:              "addLabels",
+				Type:             formmodal.FieldTypeEditableList,
+				Label:            "Add Labels",
+				Hint:             "Space to toggle",
+				MultiSelect:      true,
+				InputLabel:       "Add Label",
+				InputHint:        "Enter to add",
+				InputPlaceholder: "Enter label name...",
+			},
+			{
+				Key:         "removeLabels",
+				Type:        formmodal.FieldTypeList,
+				Label:       "Remove Labels",
+				Hint:        "Space to toggle",
+				MultiSelect: true,
+				Options:     removeOptions,
+			},
+			{
+				Key:                "epic",
+				Type:               formmodal.FieldTypeEpicSearch,
+				Label:              "Assign to Epic",
+				Hint:               "optional",
+				EpicSearchExecutor: executor,
+				DebounceMs:         200,
+			},
+		},
+		SubmitLabel: " Apply ",
+		MinWidth:    50,
+		Validate: func(values map[string]any) error {
+			if bulkOptions(values).IsEmpty() {
+				return errors.New("Choose at least one change")
+			}
+			return nil
+		},
+		OnSubmit: func(values map[string]any) tea.Msg {
+			return bulkEditSubmitMsg{issueIDs: ids, opts: bulkOptions(values)}
+		},
+		OnCancel: func() tea.Msg { return closeSaveViewMsg{} },
+	}
+}
+
+// bulkListOptions prepends an "Unchanged" option, selected by default.
+func bulkListOptions(opts []picker.Option) []formmodal.ListOption {
+	result := []formmodal.ListOption{{Label: "Unchanged", Value: bulkUnchanged, Selected: true}}
+	for _, opt := range opts {
+		result = append(result, formmodal.ListOption{Label: opt.Label, Value: opt.Value, Color: opt.Color})
+	}
+	return result
+}
+
+// bulkOptions converts the bulk edit form values to update options.
+func bulkOptions(values map[string]any) beads.BulkUpdateOptions {
+	var opts beads.BulkUpdateOptions
+	if v, _ := values["status"].(string); v != bulkUnchanged {
+		status := beads.Status(v)
+		opts.Status = &status
+	}
+	if v, _ := values["priority"].(string); len(v) == 2 {
+		if p, err := strconv.Atoi(v[1:]); err == nil {
+			priority := beads.Priority(p)
+			opts.Priority = &priority
+		}
+	}
+	opts.AddLabels, _ = values["addLabels"].([]string)
+	opts.RemoveLabels, _ = values["removeLabels"].([]string)
+	if v, _ := values["epic"].(string); v != "" {
+		opts.ParentID = &v
+	}
+	return opts
+}
+
+// handleBulkEditSubmit starts the bulk update in the background.
+func (m Model) handleBulkEditSubmit(msg bulkEditSubmitMsg) (Model, tea.Cmd) {
+	m.view = ViewSearch
+	executor := m.services.BeadsExecutor
+	return m, tea.Batch(
+		func() tea.Msg {
+			return mode.ShowToastMsg{Message: fmt.Sprintf("Updating %d issues...", len(msg.issueIDs)), Style: toaster.StyleInfo}
+		},
+		func() tea.Msg {
+			return bulkUpdatedMsg{result: appbeads.BulkUpdate(executor, msg.issueIDs, msg.opts)}
+		},
+	)
+}
+
+// handleBulkUpdated reports the outcome and refreshes the results. Issues that
+// failed stay marked so the edit can be retried.
+func (m Model) handleBulkUpdated(msg bulkUpdatedMsg) (Model, tea.Cmd) {
+	for _, id := range msg.result.Updated {
+		delete(m.marked, id)
+	}
+
+	style := toaster.StyleSuccess
+	if len(msg.result.Failed) > 0 {
+		style = toaster.StyleError
+	}
+	summary := msg.result.Summary()
+	return m, tea.Batch(
+		func() tea.Msg { return mode.ShowToastMsg{Message: summary, Style: style} },
+		m.executeSearch(),
+	)
+}
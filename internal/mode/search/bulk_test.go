@@ -0,0 +1,123 @@
+package search
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/shared/toaster"
+)
+
+func TestSearch_Mark_TogglesSelectedResult(t *testing.T) {
+	m := createTestModelWithResults(t)
+	m.focus = FocusResults
+	space := tea.KeyMsg{Type: tea.KeySpace}
+
+	m, _ = m.Update(space)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m, _ = m.Update(space)
+	require.Equal(t, map[string]bool{"test-1": true, "test-2": true}, m.marked)
+	require.Contains(t, m.View(), "Marked: 2")
+
+	m, _ = m.Update(space)
+	require.Equal(t, map[string]bool{"test-1": true}, m.marked)
+
+	// Esc clears the marks before it exits search
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.Nil(t, cmd)
+	require.Empty(t, m.marked)
+}
+
+func TestSearch_Mark_PrunedWhenResultsChange(t *testing.T) {
+	m := createTestModelWithResults(t)
+	m.marked["test-1"] = true
+	m.marked["test-3"] = true
+
+	m, _ = m.handleSearchResults(searchResultsMsg{issues: []beads.Issue{{ID: "test-3"}}})
+	require.Equal(t, map[string]bool{"test-3": true}, m.marked)
+}
+
+func TestSearch_BulkEdit_RequiresMarks(t *testing.T) {
+	m := createTestModelWithResults(t)
+	m.focus = FocusResults
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	require.Equal(t, ViewSearch, m.view)
+	require.NotNil(t, cmd)
+	toast, ok := cmd().(mode.ShowToastMsg)
+	require.True(t, ok)
+	require.Equal(t, toaster.StyleWarn, toast.Style)
+}
+
+func TestSearch_BulkEdit_OpensForm(t *testing.T) {
+	m := createTestModelWithResults(t)
+	m.focus = FocusResults
+	m.marked["test-2"] = true
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}})
+	require.Equal(t, ViewBulkEdit, m.view)
+	require.Contains(t, m.View(), "Edit 1 Issue")
+
+	m, _ = m.Update(closeSaveViewMsg{})
+	require.Equal(t, ViewSearch, m.view)
+}
+
+func TestBulkOptions(t *testing.T) {
+	require.True(t, bulkOptions(map[string]any{
+		"status": bulkUnchanged, "priority": bulkUnchanged, "addLabels": []string{}, "removeLabels": []string{}, "epic": "",
+	}).IsEmpty())
+
+	opts := bulkOptions(map[string]any{
+		"status":       string(beads.StatusClosed),
+		"priority":     "P1",
+		"addLabels":    []string{"ui"},
+		"removeLabels": []string{"stale"},
+		"epic":         "epic-1",
+	})
+	require.Equal(t, beads.StatusClosed, *opts.Status)
+	require.Equal(t, beads.Priority(1), *opts.Priority)
+	require.Equal(t, []string{"ui"}, opts.AddLabels)
+	require.Equal(t, []string{"stale"}, opts.RemoveLabels)
+	require.Equal(t, "epic-1", *opts.ParentID)
+}
+
+func TestSearch_BulkEdit_SubmitReportsResult(t *testing.T) {
+	m := createTestModelWithResults(t)
+	m.view = ViewBulkEdit
+	m.marked["test-1"] = true
+	m.marked["test-2"] = true
+
+	status := beads.StatusClosed
+	executor := mocks.NewMockIssueExecutor(t)
+	executor.EXPECT().UpdateIssue("test-1", beads.UpdateIssueOptions{Status: &status}).Return(nil)
+	executor.EXPECT().UpdateIssue("test-2", beads.UpdateIssueOptions{Status: &status}).Return(errors.New("not found"))
+	m.services.BeadsExecutor = executor
+
+	m, cmd := m.Update(bulkEditSubmitMsg{issueIDs: []string{"test-1", "test-2"}, opts: beads.BulkUpdateOptions{Status: &status}})
+	require.Equal(t, ViewSearch, m.view)
+
+	var updated bulkUpdatedMsg
+	for _, c := range cmd().(tea.BatchMsg) {
+		if msg, ok := c().(bulkUpdatedMsg); ok {
+			updated = msg
+		}
+	}
+	require.Equal(t, []string{"test-1"}, updated.result.Updated)
+
+	m, cmd = m.Update(updated)
+	require.Equal(t, map[string]bool{"test-2": true}, m.marked, "failed issues stay marked")
+
+	var toast mode.ShowToastMsg
+	for _, c := range cmd().(tea.BatchMsg) {
+		if msg, ok := c().(mode.ShowToastMsg); ok {
+			toast = msg
+		}
+	}
+	require.Equal(t, toaster.StyleError, toast.Style)
+	require.Equal(t, "Updated 1 of 2 issues (1 failed: test-2: not found)", toast.Message)
+}
@@ -0,0 +1,85 @@
+package search
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/details"
+	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
+)
+
+// openBulkEditMsg is produced by the command palette to edit the marked results.
+type openBulkEditMsg struct{}
+
+// Idle reports whether the results are shown without a modal and the search
+// input isn't focused, so app-wide shortcuts like the command palette won't
+// steal keys from them.
+func (m Model) Idle() bool {
+	return m.view == ViewSearch && m.focus != FocusSearch
+}
+
+// Commands returns the command palette actions for the current search state.
+func (m Model) Commands() []mode.Command {
+	var cmds []mode.Command
+
+	if issue := m.getSelectedIssue(); issue != nil {
+		selected := *issue
+		cmds = append(cmds,
+			mode.Command{
+				ID:          "search.edit-issue",
+				Title:       "Edit issue",
+				Description: selected.ID + " " + selected.TitleText,
+				Key:         keys.Component.EditAction.Help().Key,
+				Run:         func() tea.Msg { return details.OpenEditMenuMsg{Issue: selected} },
+			},
+			mode.Command{
+				ID:          "search.delete-issue",
+				Title:       "Delete issue",
+				Description: selected.ID + " " + selected.TitleText,
+				Key:         keys.Component.DelAction.Help().Key,
+				Run: func() tea.Msg {
+					return details.DeleteIssueMsg{IssueID: selected.ID, IssueType: selected.Type}
+				},
+			},
+		)
+		if m.subMode == mode.SubModeList {
+			cmds = append(cmds, mode.Command{
+				ID:          "search.open-tree",
+				Title:       "Open issue tree",
+				Description: selected.ID + " " + selected.TitleText,
+				Key:         keys.Search.OpenTree.Help().Key,
+				Run: func() tea.Msg {
+					return EnterMsg{SubMode: mode.SubModeTree, IssueID: selected.ID}
+				},
+			})
+		}
+	}
+
+	if len(m.marked) > 0 && m.subMode == mode.SubModeList {
+		cmds = append(cmds, mode.Command{
+			ID:          "search.bulk-edit",
+			Title:       "Bulk edit marked issues",
+			Description: fmt.Sprintf("%d marked", len(m.marked)),
+			Key:         keys.Search.BulkEdit.Help().Key,
+			Run:         func() tea.Msg { return openBulkEditMsg{} },
+		})
+	}
+
+	return append(cmds,
+		mode.Command{
+			ID:    "search.git-diff",
+			Title: "Show git diff",
+			Key:   keys.DiffViewer.Open.Help().Key,
+			Run:   func() tea.Msg { return diffviewer.ShowDiffViewerMsg{} },
+		},
+		mode.Command{
+			ID:    "search.exit",
+			Title: "Back to kanban",
+			Key:   keys.Search.Blur.Help().Key,
+			Run:   func() tea.Msg { return ExitToKanbanMsg{} },
+		},
+	)
+}
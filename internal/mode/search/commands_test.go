@@ -0,0 +1,57 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mode"
+	"github.com/zjrosen/perles/internal/ui/details"
+)
+
+func TestSearch_Idle(t *testing.T) {
+	m := createTestModelWithResults(t)
+
+	m.focus = FocusSearch
+	require.False(t, m.Idle(), "search input captures keys")
+
+	m.focus = FocusResults
+	require.True(t, m.Idle())
+
+	m.view = ViewHelp
+	require.False(t, m.Idle(), "help overlay captures keys")
+}
+
+func TestSearch_Commands_SelectedIssue(t *testing.T) {
+	m := createTestModelWithResults(t)
+	m.focus = FocusResults
+	m.selectedIdx = 1
+
+	cmds := m.Commands()
+
+	edit, ok := mode.FindCommand(cmds, "search.edit-issue")
+	require.True(t, ok)
+	msg, ok := edit.Run().(details.OpenEditMenuMsg)
+	require.True(t, ok)
+	require.Equal(t, "test-2", msg.Issue.ID)
+
+	tree, ok := mode.FindCommand(cmds, "search.open-tree")
+	require.True(t, ok)
+	require.Equal(t, EnterMsg{SubMode: mode.SubModeTree, IssueID: "test-2"}, tree.Run())
+
+	_, ok = mode.FindCommand(cmds, "search.bulk-edit")
+	require.False(t, ok, "bulk edit needs marked results")
+}
+
+func TestSearch_Commands_BulkEditMarked(t *testing.T) {
+	m := createTestModelWithResults(t)
+	m.focus = FocusResults
+	m, _ = m.toggleMark()
+
+	bulk, ok := mode.FindCommand(m.Commands(), "search.bulk-edit")
+	require.True(t, ok)
+	require.Equal(t, "1 marked", bulk.Description)
+
+	m, _ = m.Update(bulk.Run())
+	require.Equal(t, ViewBulkEdit, m.view)
+}
@@ -4,6 +4,7 @@ package search
 import (
 	"fmt"
 	"io"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/zjrosen/perles/internal/ui/shared/colorpicker"
 	"github.com/zjrosen/perles/internal/ui/shared/diffviewer"
 	"github.com/zjrosen/perles/internal/ui/shared/editor"
+	"github.com/zjrosen/perles/internal/ui/shared/flash"
 	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
 	"github.com/zjrosen/perles/internal/ui/shared/issuebadge"
 	"github.com/zjrosen/perles/internal/ui/shared/modal"
@@ -58,6 +60,7 @@ const (
 	ViewNewView       // New view modal
 	ViewDeleteConfirm // Delete issue confirmation modal
 	ViewEditIssue     // Unified issue editor modal
+	ViewBulkEdit      // Bulk edit modal for marked results
 )
 
 // Model holds the search mode state.
@@ -73,8 +76,10 @@ type Model struct {
 	resultsList   list.Model
 	selectedIdx   int
 	searchErr     error
-	showSearchErr bool // Only show error after blur, not during typing
-	searchVersion int  // Incremented on each input change for debounce
+	showSearchErr bool            // Only show error after blur, not during typing
+	searchVersion int             // Incremented on each input change for debounce
+	flashed       *flash.Set      // Results highlighted after a live update (shared with the delegate)
+	marked        map[string]bool // Results marked for a bulk edit (shared with the delegate)
 
 	// Tree sub-mode (issue ID with tree rendering)
 	tree     *tree.Model  // Tree rendering model (from internal/ui/tree)
@@ -93,6 +98,7 @@ type Model struct {
 	newViewModal  formmodal.Model
 	modal         modal.Model
 	issueEditor   issueeditor.Model // Unified issue editor modal
+	bulkModal     formmodal.Model   // Bulk edit modal for marked results
 
 	// Delete operation state
 	deleteIssueIDs []string // IDs to delete (includes descendants for epics)
@@ -179,11 +185,10 @@ func makeNewViewFormConfig(existingViews []config.ViewConfig, currentQuery strin
 				MaxLength:   30,
 			},
 			{
-				Key:          "color",
-				Type:         formmodal.FieldTypeColor,
-				Label:        "Color",
-				Hint:         "Enter to change",
-				InitialColor: "#73F59F",
+				Key:   "color",
+				Type:  formmodal.FieldTypeColor,
+				Label: "Color",
+				Hint:  "Enter to change",
 			},
 		},
 		SubmitLabel: " Save ",
@@ -239,11 +244,10 @@ func makeUpdateViewFormConfig(views []config.ViewConfig, currentQuery string) fo
 				Placeholder: "Enter column name...",
 			},
 			{
-				Key:          "color",
-				Type:         formmodal.FieldTypeColor,
-				Label:        "Color",
-				Hint:         "Enter to change",
-				InitialColor: "#73F59F",
+				Key:   "color",
+				Type:  formmodal.FieldTypeColor,
+				Label: "Color",
+				Hint:  "Enter to change",
 			},
 			{
 				Key:         "views",
@@ -311,11 +315,10 @@ func makeNewViewTreeFormConfig(existingViews []string, issueID, treeMode string)
 				MaxLength:    30,
 			},
 			{
-				Key:          "color",
-				Type:         formmodal.FieldTypeColor,
-				Label:        "Color",
-				Hint:         "Enter to change",
-				InitialColor: "#73F59F",
+				Key:   "color",
+				Type:  formmodal.FieldTypeColor,
+				Label: "Color",
+				Hint:  "Enter to change",
 			},
 			{
 				Key:   "treeMode",
@@ -383,11 +386,10 @@ func makeUpdateViewTreeFormConfig(views []string, issueID, treeMode string) form
 				MaxLength:    30,
 			},
 			{
-				Key:          "color",
-				Type:         formmodal.FieldTypeColor,
-				Label:        "Color",
-				Hint:         "Enter to change",
-				InitialColor: "#73F59F",
+				Key:   "color",
+				Type:  formmodal.FieldTypeColor,
+				Label: "Color",
+				Hint:  "Enter to change",
 			},
 			{
 				Key:   "treeMode",
@@ -474,6 +476,10 @@ func New(services mode.Services) Model {
 	} else {
 		delegate = newIssueDelegate()
 	}
+	flashed := flash.New(services.Clock)
+	delegate.flashed = flashed
+	marked := make(map[string]bool)
+	delegate.marked = marked
 	resultsList := list.New([]list.Item{}, delegate, 0, 0)
 	resultsList.SetShowTitle(false)
 	resultsList.SetShowStatusBar(false)
@@ -499,6 +505,8 @@ func New(services mode.Services) Model {
 		services:    services,
 		input:       input,
 		resultsList: resultsList,
+		flashed:     flashed,
+		marked:      marked,
 		focus:       FocusSearch,
 		view:        ViewSearch,
 		help:        help.NewSearch().WithUserActions(userActions),
@@ -592,12 +600,35 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			m.issueEditor, cmd = m.issueEditor.Update(mouseMsg)
 			return m, cmd
 		}
-		// Forward wheel events to details regardless of focus
-		if mouseMsg.Button == tea.MouseButtonWheelUp || mouseMsg.Button == tea.MouseButtonWheelDown {
+		if m.view == ViewBulkEdit {
 			var cmd tea.Cmd
-			m.details, cmd = m.details.Update(mouseMsg)
+			m.bulkModal, cmd = m.bulkModal.Update(mouseMsg)
 			return m, cmd
 		}
+		switch m.view {
+		case ViewSaveAction:
+			var cmd tea.Cmd
+			m.picker, cmd = m.picker.Update(mouseMsg)
+			return m, cmd
+		case ViewSaveColumn:
+			var cmd tea.Cmd
+			m.viewSelector, cmd = m.viewSelector.Update(mouseMsg)
+			return m, cmd
+		case ViewNewView:
+			var cmd tea.Cmd
+			m.newViewModal, cmd = m.newViewModal.Update(mouseMsg)
+			return m, cmd
+		case ViewDeleteConfirm:
+			var cmd tea.Cmd
+			m.modal, cmd = m.modal.Update(mouseMsg)
+			return m, cmd
+		case ViewHelp:
+			return m, nil
+		}
+		// Wheel scrolls whichever pane is under the pointer, regardless of focus
+		if mouseMsg.Button == tea.MouseButtonWheelUp || mouseMsg.Button == tea.MouseButtonWheelDown {
+			return m.handleMouseWheel(mouseMsg)
+		}
 
 		// Handle left-click release for issue selection
 		if mouseMsg.Button == tea.MouseButtonLeft && mouseMsg.Action == tea.MouseActionRelease {
@@ -743,11 +774,17 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	case details.DeleteIssueMsg:
 		return m.openDeleteConfirm(msg)
 
+	case openBulkEditMsg:
+		return m.openBulkEdit()
+
 	case details.OpenEditMenuMsg:
 		issue := msg.Issue
 		m.selectedIssue = &issue // Store for title/description comparison on save
-		m.issueEditor = issueeditor.New(msg.Issue).
-			SetSize(m.width, m.height)
+		m.issueEditor = issueeditor.New(msg.Issue, issueeditor.Config{
+			Fields:   m.services.Config.FieldDefs(),
+			Executor: m.services.Executor,
+			WorkDir:  m.services.WorkDir,
+		}).SetSize(m.width, m.height)
 		m.view = ViewEditIssue
 		return m, m.issueEditor.Init()
 
@@ -793,6 +830,26 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	case shared.ActionExecutedMsg:
 		return m.handleActionExecuted(msg)
+
+	case bulkEditSubmitMsg:
+		return m.handleBulkEditSubmit(msg)
+
+	case bulkUpdatedMsg:
+		return m.handleBulkUpdated(msg)
+	}
+
+	// Other messages (e.g. the dependency pickers' async loads) go to the
+	// issue editor while it's open
+	if m.view == ViewEditIssue {
+		var cmd tea.Cmd
+		m.issueEditor, cmd = m.issueEditor.Update(msg)
+		return m, cmd
+	}
+	// ...and to the bulk edit modal (e.g. the epic search's query results)
+	if m.view == ViewBulkEdit {
+		var cmd tea.Cmd
+		m.bulkModal, cmd = m.bulkModal.Update(msg)
+		return m, cmd
 	}
 
 	return m, nil
@@ -813,6 +870,8 @@ func (m Model) View() string {
 		return zone.Scan(m.newViewModal.Overlay(m.renderMainView()))
 	case ViewDeleteConfirm:
 		return zone.Scan(m.modal.Overlay(m.renderMainView()))
+	case ViewBulkEdit:
+		return zone.Scan(m.bulkModal.Overlay(m.renderMainView()))
 	case ViewEditIssue:
 		// formmodal.Overlay() already calls zone.Scan() internally;
 		// wrapping again causes background tree zones to interfere
@@ -856,6 +915,16 @@ func (m Model) handleKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		m.newViewModal, cmd = m.newViewModal.Update(msg)
 		return m, cmd
 
+	case ViewBulkEdit:
+		if msg.Type == tea.KeyCtrlC {
+			// Close overlay instead of quitting
+			m.view = ViewSearch
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.bulkModal, cmd = m.bulkModal.Update(msg)
+		return m, cmd
+
 	case ViewDeleteConfirm:
 		if msg.Type == tea.KeyCtrlC {
 			// Close overlay instead of quitting
@@ -1057,7 +1126,11 @@ func (m Model) handleKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		return m, func() tea.Msg { return mode.RequestQuitMsg{} }
 
 	case key.Matches(msg, keys.Search.Blur):
-		// Exit search mode back to kanban
+		// Clear marks first, then exit search mode back to kanban
+		if len(m.marked) > 0 {
+			clear(m.marked)
+			return m, nil
+		}
 		return m, func() tea.Msg { return ExitToKanbanMsg{} }
 
 	case key.Matches(msg, keys.Search.Help):
@@ -1262,6 +1335,18 @@ func (m Model) handleKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 		}
 		// Fall through to details delegation when focused on details
 
+	case key.Matches(msg, keys.Search.Mark):
+		if m.focus == FocusResults && m.subMode == mode.SubModeList {
+			return m.toggleMark()
+		}
+		// Fall through to details delegation when focused on details
+
+	case key.Matches(msg, keys.Search.BulkEdit):
+		if m.focus == FocusResults && m.subMode == mode.SubModeList {
+			return m.openBulkEdit()
+		}
+		// Fall through to details delegation when focused on details
+
 	case key.Matches(msg, keys.Component.DelAction):
 		// Only handle in list pane (list sub-mode); tree sub-mode handles 'd' for direction toggle first
 		if m.focus == FocusResults {
@@ -1334,6 +1419,38 @@ func (m Model) handleNavUp() (Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleMouseWheel moves the selection when the pointer is over the results
+// (or tree) pane and scrolls the details panel otherwise.
+func (m Model) handleMouseWheel(msg tea.MouseMsg) (Model, tea.Cmd) {
+	z := zone.Get(zoneSearchResults)
+	if z == nil || !z.InBounds(msg) {
+		var cmd tea.Cmd
+		m.details, cmd = m.details.Update(msg)
+		return m, cmd
+	}
+
+	delta := 1
+	if msg.Button == tea.MouseButtonWheelUp {
+		delta = -1
+	}
+
+	switch m.subMode {
+	case mode.SubModeList:
+		next := m.selectedIdx + delta
+		if next >= 0 && next < len(m.results) {
+			m.selectedIdx = next
+			m.resultsList.Select(next)
+			m.updateDetailPanel()
+		}
+	case mode.SubModeTree:
+		if m.tree != nil {
+			m.tree.MoveCursor(delta)
+			m.updateDetailFromTree()
+		}
+	}
+	return m, nil
+}
+
 // handleMouseClick handles left-click release events on issues.
 func (m Model) handleMouseClick(msg tea.MouseMsg) (Model, tea.Cmd) {
 	switch m.subMode {
@@ -1389,6 +1506,7 @@ func (m *Model) updateDetailPanel() {
 		// rightWidth-2 for left/right border, height-2 for top/bottom border
 		m.details = details.New(issue, m.services.Executor, m.services.Client).
 			SetMarkdownStyle(m.services.Config.UI.MarkdownStyle).
+			SetCustomFields(m.services.Config.FieldDefs()).
 			SetSize(rightWidth-2, m.height-2)
 
 		// Restore scroll position for same issue
@@ -1424,6 +1542,7 @@ func (m *Model) updateDetailFromTree() {
 	// rightWidth-2 for left/right border, height-2 for top/bottom border
 	m.details = details.New(node.Issue, m.services.Executor, m.services.Client).
 		SetMarkdownStyle(m.services.Config.UI.MarkdownStyle).
+		SetCustomFields(m.services.Config.FieldDefs()).
 		SetSize(rightWidth-2, m.height-2)
 
 	// Restore scroll position for same issue
@@ -1573,6 +1692,16 @@ func (m Model) yankTreeIssueID() (Model, tea.Cmd) {
 	}
 }
 
+// FocusDescription describes the selected result in plain text for
+// accessibility mode.
+func (m Model) FocusDescription() string {
+	issue := m.getSelectedIssue()
+	if issue == nil {
+		return "Search, no results"
+	}
+	return fmt.Sprintf("Search, %s %s, %s", issue.ID, issue.TitleText, issue.Status)
+}
+
 // getSelectedIssue returns a pointer to the currently selected issue, or nil if none.
 func (m Model) getSelectedIssue() *beads.Issue {
 	// Tree sub-mode: get selected node's issue
@@ -1661,6 +1790,7 @@ func (m Model) handleSearchResults(msg searchResultsMsg) (Model, tea.Cmd) {
 	}
 
 	m.results = msg.issues
+	m.pruneMarks()
 
 	// Convert to list items
 	items := make([]list.Item, len(msg.issues))
@@ -1779,6 +1909,7 @@ func (m Model) navigateToDependency(issueID string) (Model, tea.Cmd) {
 	// rightWidth-2 for left/right border, height-2 for top/bottom border
 	m.details = details.New(issue, m.services.Executor, m.services.Client).
 		SetMarkdownStyle(m.services.Config.UI.MarkdownStyle).
+		SetCustomFields(m.services.Config.FieldDefs()).
 		SetSize(rightWidth-2, m.height-2)
 	m.hasDetail = true
 
@@ -1888,6 +2019,9 @@ func (m Model) renderListLeftPanel(width int) string {
 	if len(m.results) > 0 {
 		resultsCount = fmt.Sprintf("Count: %d", len(m.results))
 	}
+	if len(m.marked) > 0 {
+		resultsCount = fmt.Sprintf("Marked: %d · %s", len(m.marked), resultsCount)
+	}
 
 	// Results with titled border
 	resultsBorder := panes.BorderedPane(panes.BorderConfig{
@@ -1899,7 +2033,7 @@ func (m Model) renderListLeftPanel(width int) string {
 		TitleColor:         styles.OverlayTitleColor,
 		FocusedBorderColor: styles.BorderHighlightFocusColor,
 	})
-	sb.WriteString(resultsBorder)
+	sb.WriteString(zone.Mark(zoneSearchResults, resultsBorder))
 
 	return sb.String()
 }
@@ -1980,7 +2114,7 @@ func (m Model) renderTreeLeftPanel(width int) string {
 		rightTitle = renderCompactProgress(closed, total)
 	}
 
-	return panes.BorderedPane(panes.BorderConfig{
+	return zone.Mark(zoneSearchResults, panes.BorderedPane(panes.BorderConfig{
 		Content:            content,
 		Width:              width,
 		Height:             m.height,
@@ -1989,7 +2123,7 @@ func (m Model) renderTreeLeftPanel(width int) string {
 		Focused:            m.focus == FocusResults, // Tree panel uses "results" focus
 		TitleColor:         styles.OverlayTitleColor,
 		FocusedBorderColor: styles.BorderHighlightFocusColor,
-	})
+	}))
 }
 
 // Message types
@@ -2113,6 +2247,31 @@ func (m Model) HandleDBChanged() (Model, tea.Cmd) {
 	}
 }
 
+// UpdateIssue applies update to the result with the given ID, keeping the
+// selection, and highlights it. It is called by app.go for issue changes made by
+// orchestration; the DB watcher reload that follows reconciles results the
+// change moved into or out of the query. The highlight is kept by ID, so it
+// survives that reload.
+func (m Model) UpdateIssue(id string, update func(*beads.Issue)) (Model, tea.Cmd) {
+	if idx := slices.IndexFunc(m.results, func(issue beads.Issue) bool { return issue.ID == id }); idx >= 0 {
+		// Copy first since results may be shared with the BQL cache
+		m.results = slices.Clone(m.results)
+		update(&m.results[idx])
+
+		items := make([]list.Item, len(m.results))
+		for i, issue := range m.results {
+			items[i] = issueItem{issue: issue}
+		}
+		m.resultsList.SetItems(items)
+		m.resultsList.Select(m.selectedIdx)
+
+		if m.hasDetail && m.details.IssueID() == id {
+			m.details = m.details.UpdateStatus(m.results[idx].Status)
+		}
+	}
+	return m, m.flashed.Flash(id)
+}
+
 // Message handlers
 
 // handleIssueSaved processes the consolidated issue save result.
@@ -2209,6 +2368,7 @@ func (m Model) yankDetailsIssueID() (Model, tea.Cmd) {
 const (
 	zoneSearchListPrefix = "search:list:"
 	zoneSearchTreePrefix = "search:tree:"
+	zoneSearchResults    = "search:results" // Whole results/tree pane, for wheel scrolling
 )
 
 // makeSearchListZoneID creates a zone ID for an issue in the search results list.
@@ -2231,7 +2391,9 @@ func (i issueItem) FilterValue() string { return i.issue.TitleText }
 
 // issueDelegate renders issues in board style.
 type issueDelegate struct {
-	clock shared.Clock
+	clock   shared.Clock
+	flashed *flash.Set      // results highlighted after a live update
+	marked  map[string]bool // results marked for a bulk edit
 }
 
 func newIssueDelegate() issueDelegate {
@@ -2259,9 +2421,21 @@ func (d issueDelegate) Render(w io.Writer, m list.Model, index int, item list.It
 	// Format: > [T][P2][id] Title...          10h ago 💬 3
 	selected := index == m.Index()
 
+	flashed := d.flashed.Active(issue.ID)
 	prefix := " "
 	if selected {
 		prefix = styles.SelectionIndicatorStyle.Render(">")
+	} else if flashed {
+		prefix = styles.IssueFlashStyle.Render("•")
+	}
+
+	// Marked results get a check column while any result is marked
+	if len(d.marked) > 0 {
+		if d.marked[issue.ID] {
+			prefix += lipgloss.NewStyle().Foreground(styles.StatusSuccessColor).Render("✓")
+		} else {
+			prefix += " "
+		}
 	}
 
 	// Use shared issuebadge component for type/priority/id
@@ -2308,6 +2482,9 @@ func (d issueDelegate) Render(w io.Writer, m list.Model, index int, item list.It
 	// Calculate padding to right-align metadata
 	contentWidth := leftPrefixWidth + lipgloss.Width(title)
 	padding := max(1, width-contentWidth-rightWidth)
+	if flashed {
+		title = styles.IssueFlashStyle.Render(title)
+	}
 
 	line := fmt.Sprintf("%s%s%s%s",
 		leftPrefix,
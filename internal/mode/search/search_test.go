@@ -487,6 +487,29 @@ func TestSearch_IssueDelegate_HeightAndSpacing(t *testing.T) {
 	require.Equal(t, 0, d.Spacing(), "delegate spacing should be 0")
 }
 
+func TestSearch_UpdateIssue(t *testing.T) {
+	m := createTestModelWithResults(t)
+	loaded := m.results
+	m.selectedIdx = 1
+	m.resultsList.Select(1)
+
+	m, cmd := m.UpdateIssue("test-1", func(issue *beads.Issue) {
+		issue.Status = beads.StatusClosed
+		issue.CommentCount++
+	})
+	require.NotNil(t, cmd, "expected a command to end the highlight")
+	require.Equal(t, beads.StatusClosed, m.results[0].Status)
+	require.Equal(t, 1, m.results[0].CommentCount)
+	require.Equal(t, beads.StatusOpen, loaded[0].Status, "loaded results are not mutated")
+	require.Equal(t, 1, m.resultsList.Index(), "selection is kept")
+	require.True(t, m.flashed.Active("test-1"))
+	require.False(t, m.flashed.Active("test-2"))
+
+	// Issues outside the results are still highlighted in case a reload brings them in
+	m, _ = m.UpdateIssue("test-9", func(*beads.Issue) {})
+	require.True(t, m.flashed.Active("test-9"))
+}
+
 func TestSearch_EnterMsg_WithQuery(t *testing.T) {
 	m := createTestModel(t)
 
@@ -2501,3 +2524,36 @@ func TestSearch_SaveMsg_UpdatesNotesWhenChanged(t *testing.T) {
 	// Execute the command to trigger UpdateIssue
 	cmd()
 }
+
+func TestSearch_MouseWheel_OverResultsMovesSelection(t *testing.T) {
+	m := createTestModel(t)
+	issues := []beads.Issue{
+		{ID: "wheel-results-1", TitleText: "First Issue", Priority: 1, Status: beads.StatusOpen, Type: beads.TypeTask},
+		{ID: "wheel-results-2", TitleText: "Second Issue", Priority: 2, Status: beads.StatusOpen, Type: beads.TypeBug},
+	}
+	m, _ = m.handleSearchResults(searchResultsMsg{issues: issues, err: nil})
+	m.focus = FocusSearch // Wheel works without focusing the results pane first
+
+	var z *zone.ZoneInfo
+	for retries := 0; retries < 10; retries++ {
+		_ = m.View()
+		time.Sleep(time.Millisecond)
+		z = zone.Get(zoneSearchResults)
+		if z != nil && !z.IsZero() {
+			break
+		}
+	}
+	require.NotNil(t, z, "results zone should be registered after View()")
+	require.False(t, z.IsZero(), "results zone should not be zero")
+
+	wheel := tea.MouseMsg{X: z.StartX + 2, Y: z.StartY + 2, Button: tea.MouseButtonWheelDown}
+	m, _ = m.Update(wheel)
+	require.Equal(t, 1, m.selectedIdx, "wheel down over results should select the next issue")
+
+	m, _ = m.Update(wheel)
+	require.Equal(t, 1, m.selectedIdx, "wheel should stop at the last result")
+
+	wheel.Button = tea.MouseButtonWheelUp
+	m, _ = m.Update(wheel)
+	require.Equal(t, 0, m.selectedIdx)
+}
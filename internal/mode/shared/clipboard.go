@@ -37,6 +37,25 @@ func (SystemClipboard) Copy(text string) error {
 	return copyViaNative(text)
 }
 
+// Paste returns the system clipboard content using native clipboard tools.
+// OSC 52 cannot read the clipboard, so this fails in remote sessions
+// without a local clipboard tool.
+func (SystemClipboard) Paste() (string, error) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("pbpaste")
+	default:
+		cmd = exec.Command("xclip", "-selection", "clipboard", "-o")
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("reading clipboard: %w", err)
+	}
+	return string(out), nil
+}
+
 // isLocalTmux returns true if running in tmux without SSH.
 func isLocalTmux() bool {
 	return os.Getenv("TMUX") != "" && !isRemoteSession()
@@ -0,0 +1,126 @@
+// Package notify sends OS desktop notifications so operators who background
+// the terminal still see events that need their attention.
+package notify
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/log"
+)
+
+// Notifier shows desktop notifications. Implementations handle all errors
+// internally - Notify is fire-and-forget.
+type Notifier interface {
+	// Notify shows a desktop notification if the use case is enabled.
+	// useCase is checked against the notification event config for permission.
+	// Errors are logged, not returned.
+	Notify(title, body, useCase string)
+}
+
+// NoopNotifier is a Notifier that does nothing.
+// Use this as a safe default when desktop notifications are disabled or unavailable.
+type NoopNotifier struct{}
+
+// Notify does nothing. Safe to call with any input.
+func (NoopNotifier) Notify(_, _, _ string) {}
+
+// maxBodyLen caps the notification body; notification daemons truncate
+// long bodies anyway and some render them on a single line.
+const maxBodyLen = 200
+
+// DesktopNotifier shows notifications via OS-native commands
+// (osascript on macOS, notify-send on Linux).
+// Unlike sounds, desktop notifications are opt-in: a use case only notifies
+// when it is present in the event config and enabled.
+type DesktopNotifier struct {
+	eventConfigs map[string]config.NotificationEventConfig
+	command      string
+	goos         string
+	run          func(name string, args ...string) error
+}
+
+// NewDesktopNotifier creates a notifier with the given per-event configuration.
+func NewDesktopNotifier(eventConfigs map[string]config.NotificationEventConfig) *DesktopNotifier {
+	cmd := detectNotifyCommand()
+
+	log.Debug(log.CatConfig, "Desktop notifier initialized",
+		"available", cmd != "",
+		"command", cmd,
+		"config", eventConfigs,
+		"platform", runtime.GOOS,
+	)
+
+	return &DesktopNotifier{
+		eventConfigs: eventConfigs,
+		command:      cmd,
+		goos:         runtime.GOOS,
+		run: func(name string, args ...string) error {
+			return exec.Command(name, args...).Run() //nolint:gosec // name validated by detectNotifyCommand
+		},
+	}
+}
+
+// Notify shows the notification asynchronously if the use case is enabled.
+// Does nothing if the use case is not enabled or no notification command is available.
+func (n *DesktopNotifier) Notify(title, body, useCase string) {
+	if eventConfig, ok := n.eventConfigs[useCase]; !ok || !eventConfig.Enabled {
+		return
+	}
+	if n.command == "" {
+		log.Debug(log.CatConfig, "No desktop notification command available", "useCase", useCase)
+		return
+	}
+
+	args := buildArgs(n.goos, title, ansi.Truncate(body, maxBodyLen, "…"))
+	go func() {
+		if err := n.run(n.command, args...); err != nil {
+			log.Debug(log.CatConfig, "Desktop notification failed", "useCase", useCase, "error", err)
+		}
+	}()
+}
+
+// Available returns true if a notification command was detected on this platform.
+func (n *DesktopNotifier) Available() bool {
+	return n.command != ""
+}
+
+// buildArgs constructs the notification command arguments for the platform.
+func buildArgs(goos, title, body string) []string {
+	if goos == "darwin" {
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+		return []string{"-e", script}
+	}
+	return []string{"--app-name=perles", title, body}
+}
+
+// appleScriptString quotes s as an AppleScript string literal.
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", " ")
+	return `"` + s + `"`
+}
+
+// detectNotifyCommand returns the notification command for the current platform.
+// Returns empty string if desktop notifications aren't supported.
+func detectNotifyCommand() string {
+	var name string
+	switch runtime.GOOS {
+	case "darwin":
+		name = "osascript"
+	case "linux":
+		name = "notify-send"
+	default:
+		return ""
+	}
+	if path, err := exec.LookPath(name); err == nil {
+		return path
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
+)
+
+// newTestNotifier returns a notifier that records commands instead of running them.
+func newTestNotifier(goos string, eventConfigs map[string]config.NotificationEventConfig) (*DesktopNotifier, chan []string) {
+	calls := make(chan []string, 1)
+	return &DesktopNotifier{
+		eventConfigs: eventConfigs,
+		command:      "notify",
+		goos:         goos,
+		run: func(_ string, args ...string) error {
+			calls <- args
+			return nil
+		},
+	}, calls
+}
+
+func TestNoopNotifier_ImplementsInterface(t *testing.T) {
+	var n Notifier = NoopNotifier{}
+	require.NotPanics(t, func() { n.Notify("title", "body", "user_notification") })
+}
+
+func TestDesktopNotifier_NotifiesEnabledEvent(t *testing.T) {
+	n, calls := newTestNotifier("linux", map[string]config.NotificationEventConfig{
+		"user_notification": {Enabled: true},
+	})
+
+	n.Notify("Perles", "Review ready", "user_notification")
+
+	select {
+	case args := <-calls:
+		require.Equal(t, []string{"--app-name=perles", "Perles", "Review ready"}, args)
+	case <-time.After(time.Second):
+		t.Fatal("expected notification command to run")
+	}
+}
+
+func TestDesktopNotifier_OptIn(t *testing.T) {
+	n, calls := newTestNotifier("linux", map[string]config.NotificationEventConfig{
+		"user_notification": {Enabled: false},
+	})
+
+	n.Notify("Perles", "disabled", "user_notification")
+	n.Notify("Perles", "unconfigured", "fabric_user_mention")
+
+	select {
+	case <-calls:
+		t.Fatal("disabled and unconfigured events must not notify")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestDesktopNotifier_TruncatesBody(t *testing.T) {
+	n, calls := newTestNotifier("linux", map[string]config.NotificationEventConfig{
+		"user_notification": {Enabled: true},
+	})
+
+	n.Notify("Perles", strings.Repeat("x", 500), "user_notification")
+
+	args := <-calls
+	require.LessOrEqual(t, len([]rune(args[2])), maxBodyLen)
+}
+
+func TestBuildArgs_Darwin(t *testing.T) {
+	args := buildArgs("darwin", `Say "hi"`, `C:\path`+"\nnext")
+	require.Equal(t, []string{"-e", `display notification "C:\\path next" with title "Say \"hi\""`}, args)
+}
@@ -0,0 +1,200 @@
+package accountability
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DecisionsDir is the session directory that holds decision records, one
+// {NNN}-{slug}.md file per decision, numbered in the order they were recorded.
+const DecisionsDir = "decisions"
+
+// maxSlugLength caps the title part of a decision file name.
+const maxSlugLength = 50
+
+// Decision statuses.
+const (
+	DecisionProposed   = "proposed"
+	DecisionAccepted   = "accepted"
+	DecisionSuperseded = "superseded"
+)
+
+// IsValidDecisionStatus reports whether status is a known decision status.
+func IsValidDecisionStatus(status string) bool {
+	return status == DecisionProposed || status == DecisionAccepted || status == DecisionSuperseded
+}
+
+// Decision is an architectural decision record (ADR) written by record_decision:
+// YAML frontmatter (title, status, worker, task) followed by markdown sections.
+type Decision struct {
+	Title     string    `yaml:"title"`
+	Status    string    `yaml:"status"`
+	WorkerID  string    `yaml:"worker_id"`
+	TaskID    string    `yaml:"task_id,omitempty"`
+	Timestamp time.Time `yaml:"timestamp"`
+
+	// Markdown sections
+	Context      string   `yaml:"-"`
+	Decision     string   `yaml:"-"`
+	Alternatives []string `yaml:"-"`
+	Consequences string   `yaml:"-"`
+
+	// Number is the record's position in the session, from its file name.
+	Number int `yaml:"-"`
+	// File is the record's path relative to the session directory.
+	File string `yaml:"-"`
+}
+
+// Markdown renders the decision record with its YAML frontmatter.
+func (d *Decision) Markdown() ([]byte, error) {
+	frontmatter, err := yaml.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("encoding frontmatter: %w", err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n%s%s\n\n", frontmatterDelimiter, frontmatter, frontmatterDelimiter)
+	fmt.Fprintf(&b, "# %s\n\n", d.Title)
+	fmt.Fprintf(&b, "**Status:** %s\n", d.Status)
+	fmt.Fprintf(&b, "**Worker:** %s\n", d.WorkerID)
+	if d.TaskID != "" {
+		fmt.Fprintf(&b, "**Task:** %s\n", d.TaskID)
+	}
+	fmt.Fprintf(&b, "**Date:** %s\n\n", d.Timestamp.Format("2006-01-02 15:04:05"))
+
+	fmt.Fprintf(&b, "## Context\n\n%s\n\n", d.Context)
+	fmt.Fprintf(&b, "## Decision\n\n%s\n\n", d.Decision)
+	if len(d.Alternatives) > 0 {
+		b.WriteString("## Alternatives Considered\n\n")
+		for _, alt := range d.Alternatives {
+			fmt.Fprintf(&b, "- %s\n", alt)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "## Consequences\n\n%s\n", d.Consequences)
+	return []byte(b.String()), nil
+}
+
+// ParseDecision parses a decision record written by Decision.Markdown.
+func ParseDecision(content []byte) (*Decision, error) {
+	var d Decision
+	body, err := parseFrontmatter(content, &d)
+	if err != nil {
+		return nil, err
+	}
+	if d.Title == "" {
+		return nil, fmt.Errorf("frontmatter missing required field: title")
+	}
+
+	var section string
+	var text []string
+	flush := func() {
+		content := strings.TrimSpace(strings.Join(text, "\n"))
+		text = nil
+		switch section {
+		case "Context":
+			d.Context = content
+		case "Decision":
+			d.Decision = content
+		case "Alternatives Considered":
+			d.Alternatives = listItems(content)
+		case "Consequences":
+			d.Consequences = content
+		}
+	}
+	for line := range strings.SplitSeq(body, "\n") {
+		if heading, ok := strings.CutPrefix(line, "## "); ok {
+			flush()
+			section = strings.TrimSpace(heading)
+			continue
+		}
+		text = append(text, line)
+	}
+	flush()
+	return &d, nil
+}
+
+// DecisionSlug turns a decision title into the name part of its file name,
+// e.g. "Use SQLite for the cache" -> "use-sqlite-for-the-cache".
+func DecisionSlug(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			dash = false
+			b.WriteRune(r)
+			continue
+		}
+		dash = true
+	}
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		// Cut on a rune boundary so a multi-byte letter is never split.
+		n := maxSlugLength
+		for n > 0 && !utf8.RuneStart(slug[n]) {
+			n--
+		}
+		slug = strings.TrimRight(slug[:n], "-")
+	}
+	if slug == "" {
+		return "decision"
+	}
+	return slug
+}
+
+// DecisionNumber returns the number prefix of a decision file name, e.g. 3 for
+// "003-use-sqlite.md", and false if the name has none.
+func DecisionNumber(name string) (int, bool) {
+	prefix, _, found := strings.Cut(name, "-")
+	if !found {
+		return 0, false
+	}
+	n, err := strconv.Atoi(prefix)
+	if err != nil || n < 1 {
+		return 0, false
+	}
+	return n, true
+}
+
+// ReadDecisions parses the decision records of the session in sessionDir,
+// ordered by number. Records that fail to parse are returned as skipped.
+func ReadDecisions(sessionDir string) ([]*Decision, []SkippedSummary, error) {
+	paths, err := filepath.Glob(filepath.Join(sessionDir, DecisionsDir, "*.md"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing decisions: %w", err)
+	}
+
+	var decisions []*Decision
+	var skipped []SkippedSummary
+	for _, path := range paths {
+		number, ok := DecisionNumber(filepath.Base(path))
+		if !ok {
+			continue
+		}
+		content, err := os.ReadFile(path) //nolint:gosec // G304: path is under the session directory
+		if err == nil {
+			var d *Decision
+			if d, err = ParseDecision(content); err == nil {
+				d.Number = number
+				d.File = filepath.Join(DecisionsDir, filepath.Base(path))
+				decisions = append(decisions, d)
+				continue
+			}
+		}
+		skipped = append(skipped, SkippedSummary{Path: path, Error: err.Error()})
+	}
+	slices.SortFunc(decisions, func(a, b *Decision) int { return a.Number - b.Number })
+	return decisions, skipped, nil
+}
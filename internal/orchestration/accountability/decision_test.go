@@ -0,0 +1,90 @@
+package accountability
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testDecision() *Decision {
+	return &Decision{
+		Title:        "Use SQLite: not Redis",
+		Status:       DecisionAccepted,
+		WorkerID:     "worker-1",
+		TaskID:       "perles-abc.1",
+		Timestamp:    time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+		Context:      "Results must survive restarts.\n\nThe cache is rebuilt on every start today.",
+		Decision:     "Store cached results in SQLite.",
+		Alternatives: []string{"Flat files", "Redis"},
+		Consequences: "One more table to migrate.",
+	}
+}
+
+func TestDecision_MarkdownRoundTrip(t *testing.T) {
+	content, err := testDecision().Markdown()
+	require.NoError(t, err)
+	require.Contains(t, string(content), "title: 'Use SQLite: not Redis'", "titles are quoted in the frontmatter")
+	require.Contains(t, string(content), "## Alternatives Considered\n\n- Flat files\n- Redis\n")
+
+	parsed, err := ParseDecision(content)
+	require.NoError(t, err)
+	require.Equal(t, testDecision(), parsed)
+}
+
+func TestParseDecision_Invalid(t *testing.T) {
+	_, err := ParseDecision([]byte("# no frontmatter"))
+	require.ErrorContains(t, err, "frontmatter delimiter")
+
+	_, err = ParseDecision([]byte("---\nstatus: accepted\n---\n"))
+	require.ErrorContains(t, err, "missing required field: title")
+}
+
+func TestDecisionSlug(t *testing.T) {
+	require.Equal(t, "use-sqlite-for-the-cache", DecisionSlug("Use SQLite for the cache"))
+	require.Equal(t, "adopt-grpc-v2-api", DecisionSlug("  Adopt gRPC (v2) -- API!  "))
+	require.Equal(t, "decision", DecisionSlug("!!!"))
+	require.Equal(t, "a-very-long-decision-title-that-goes-on-and-on-and", DecisionSlug("a very long decision title that goes on and on and on and on"))
+	require.Equal(t, "a-very-long-decision-title-that-goes-on-and-on-and", DecisionSlug("a very long decision title that goes on and on and -x"), "no trailing dash after truncation")
+
+	slug := DecisionSlug("a" + strings.Repeat("é", 30))
+	require.True(t, utf8.ValidString(slug), "truncation must not split a multi-byte letter")
+	require.Equal(t, "a"+strings.Repeat("é", 24), slug)
+}
+
+func TestDecisionNumber(t *testing.T) {
+	n, ok := DecisionNumber("012-use-sqlite.md")
+	require.True(t, ok)
+	require.Equal(t, 12, n)
+
+	for _, name := range []string{"README.md", "use-sqlite.md", "000-zero.md"} {
+		_, ok := DecisionNumber(name)
+		require.False(t, ok, name)
+	}
+}
+
+func TestReadDecisions(t *testing.T) {
+	dir := t.TempDir()
+	decisionsDir := filepath.Join(dir, DecisionsDir)
+	require.NoError(t, os.MkdirAll(decisionsDir, 0750))
+	content, err := testDecision().Markdown()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(decisionsDir, "010-use-sqlite.md"), content, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(decisionsDir, "002-first.md"), []byte("---\ntitle: First\n---\n\n## Decision\n\nGo first.\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(decisionsDir, "003-broken.md"), []byte("broken"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(decisionsDir, "README.md"), []byte("not a record"), 0600))
+
+	decisions, skipped, err := ReadDecisions(dir)
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+	require.Equal(t, 2, decisions[0].Number)
+	require.Equal(t, "Go first.", decisions[0].Decision)
+	require.Equal(t, 10, decisions[1].Number)
+	require.Equal(t, filepath.Join(DecisionsDir, "010-use-sqlite.md"), decisions[1].File)
+	require.Len(t, skipped, 1)
+	require.Contains(t, skipped[0].Path, "003-broken.md")
+}
@@ -0,0 +1,561 @@
+package accountability
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// Report file names, written to the session directory.
+const (
+	ReportMarkdownFile = "session_report.md"
+	ReportJSONFile     = "session_report.json"
+)
+
+// Session directory layout of worker summaries: workers/{workerID}/tasks/{taskID}.md.
+const (
+	workersDir = "workers"
+	tasksDir   = "tasks"
+)
+
+// LegacySummaryFile is the single per-worker summary, workers/{workerID}/accountability_summary.md,
+// written by sessions from before summaries were saved per task.
+const LegacySummaryFile = "accountability_summary.md"
+
+// minCommitPrefix is the shortest hash treated as an abbreviation of a longer one.
+const minCommitPrefix = 7
+
+// ErrNoSummaries is returned by WriteReport when no worker has posted a summary.
+var ErrNoSummaries = errors.New("no worker accountability summaries found")
+
+// Report is the aggregated accountability report of a session.
+type Report struct {
+	GeneratedAt      time.Time        `json:"generated_at"`
+	Metrics          SessionMetrics   `json:"metrics"`
+	Workers          []WorkerMetrics  `json:"workers"`
+	Tasks            []TaskReport     `json:"tasks"`
+	Commits          []Attributed     `json:"commits"`
+	IssuesClosed     []Attributed     `json:"issues_closed"`
+	IssuesDiscovered []Attributed     `json:"issues_discovered"`
+	Decisions        []DecisionReport `json:"decisions,omitempty"`
+	Skipped          []SkippedSummary `json:"skipped,omitempty"`
+}
+
+// SessionMetrics are the totals of a session. Commits and issues are counted once
+// even when several workers reported them.
+type SessionMetrics struct {
+	Workers            int `json:"workers"`
+	Tasks              int `json:"tasks"`
+	Commits            int `json:"commits"`
+	IssuesClosed       int `json:"issues_closed"`
+	IssuesDiscovered   int `json:"issues_discovered"`
+	VerificationPoints int `json:"verification_points"`
+	Decisions          int `json:"decisions"`
+	// ScoredTasks is the number of tasks with review scores.
+	ScoredTasks int `json:"scored_tasks"`
+	// ReviewScores is the average score per dimension over the scored tasks.
+	ReviewScores map[repository.ReviewDimension]float64 `json:"review_scores,omitempty"`
+	// WorkDuration is the total work time of the tasks, from their work logs
+	// (nanoseconds in JSON).
+	WorkDuration time.Duration `json:"work_duration,omitempty"`
+}
+
+// WorkerMetrics are the totals of one worker.
+type WorkerMetrics struct {
+	WorkerID         string   `json:"worker_id"`
+	Tasks            []string `json:"tasks"`
+	Commits          int      `json:"commits"`
+	IssuesClosed     int      `json:"issues_closed"`
+	IssuesDiscovered int      `json:"issues_discovered"`
+	// AverageReviewScore is the mean of all dimension scores of the worker's
+	// scored tasks (0 if none was scored).
+	AverageReviewScore float64 `json:"average_review_score,omitempty"`
+	// WorkDuration is the work time of the worker's tasks.
+	WorkDuration time.Duration `json:"work_duration,omitempty"`
+}
+
+// TaskReport is the accountability of one task, from its worker's summary.
+type TaskReport struct {
+	TaskID             string                       `json:"task_id"`
+	WorkerID           string                       `json:"worker_id"`
+	CompletedAt        time.Time                    `json:"completed_at"`
+	Accomplished       string                       `json:"accomplished,omitempty"`
+	Commits            []string                     `json:"commits,omitempty"`
+	IssuesClosed       []string                     `json:"issues_closed,omitempty"`
+	IssuesDiscovered   []string                     `json:"issues_discovered,omitempty"`
+	VerificationPoints []string                     `json:"verification_points,omitempty"`
+	ReviewScores       repository.ReviewScores      `json:"review_scores,omitempty"`
+	AverageReviewScore float64                      `json:"average_review_score,omitempty"`
+	BelowMinimum       []repository.ReviewDimension `json:"below_minimum,omitempty"`
+	Retro              Retro                        `json:"retro"`
+	NextSteps          string                       `json:"next_steps,omitempty"`
+	// Time tracking from the task issue's work log (see AddWorkTime)
+	StartedAt    time.Time     `json:"started_at,omitzero"`
+	WorkDuration time.Duration `json:"work_duration,omitempty"`
+	WorkedBy     []string      `json:"worked_by,omitempty"`
+}
+
+// Attributed is a commit or issue with the workers and tasks that reported it.
+type Attributed struct {
+	ID      string   `json:"id"`
+	Workers []string `json:"workers"`
+	Tasks   []string `json:"tasks"`
+}
+
+// DecisionReport is a decision record of the session, without its full text.
+type DecisionReport struct {
+	Number     int       `json:"number"`
+	Title      string    `json:"title"`
+	Status     string    `json:"status"`
+	WorkerID   string    `json:"worker_id"`
+	TaskID     string    `json:"task_id,omitempty"`
+	RecordedAt time.Time `json:"recorded_at"`
+	Decision   string    `json:"decision"`
+	// File is the record's path relative to the session directory.
+	File string `json:"file"`
+}
+
+// SkippedSummary is a summary or decision file that could not be read or parsed.
+type SkippedSummary struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+// WriteReport aggregates the worker summaries and decision records of the
+// session in sessionDir and writes the report as ReportMarkdownFile and
+// ReportJSONFile. Files that fail to parse are listed in Report.Skipped.
+// When comments is non-nil, the tasks' work time is read from their work logs.
+// Returns ErrNoSummaries if there is neither a summary nor a decision to aggregate.
+func WriteReport(sessionDir string, now time.Time, comments appbeads.CommentReader) (*Report, error) {
+	workerDirs, err := filepath.Glob(filepath.Join(sessionDir, workersDir, "*"))
+	if err != nil {
+		return nil, fmt.Errorf("listing worker summaries: %w", err)
+	}
+	slices.Sort(workerDirs)
+
+	var summaries []*Summary
+	var skipped []SkippedSummary
+	for _, workerDir := range workerDirs {
+		paths, err := WorkerSummaryPaths(workerDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			content, err := os.ReadFile(path) //nolint:gosec // G304: path is under the session directory
+			if err == nil {
+				var s *Summary
+				if s, err = ParseSummary(content); err == nil {
+					if s.WorkerID == "" {
+						s.WorkerID = filepath.Base(workerDir)
+					}
+					summaries = append(summaries, s)
+					continue
+				}
+			}
+			skipped = append(skipped, SkippedSummary{Path: path, Error: err.Error()})
+		}
+	}
+	decisions, skippedDecisions, err := ReadDecisions(sessionDir)
+	if err != nil {
+		return nil, err
+	}
+	skipped = append(skipped, skippedDecisions...)
+	if len(summaries) == 0 && len(decisions) == 0 {
+		if len(skipped) > 0 {
+			return nil, fmt.Errorf("%w: %d unparseable (%s: %s)", ErrNoSummaries, len(skipped), skipped[0].Path, skipped[0].Error)
+		}
+		return nil, ErrNoSummaries
+	}
+
+	report := Aggregate(summaries, now)
+	report.AddDecisions(decisions)
+	if comments != nil {
+		report.AddWorkTime(report.ReadWorkTime(comments))
+	}
+	report.Skipped = skipped
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding report: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(sessionDir, ReportJSONFile), append(data, '\n'), 0600); err != nil {
+		return nil, fmt.Errorf("writing %s: %w", ReportJSONFile, err)
+	}
+	if err := os.WriteFile(filepath.Join(sessionDir, ReportMarkdownFile), []byte(report.Markdown()), 0600); err != nil {
+		return nil, fmt.Errorf("writing %s: %w", ReportMarkdownFile, err)
+	}
+	return report, nil
+}
+
+// WorkerSummaryPaths returns the summary files of the worker in workerDir: its
+// per-task summaries sorted by task ID, or LegacySummaryFile when it has none.
+func WorkerSummaryPaths(workerDir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(workerDir, tasksDir, "*.md"))
+	if err != nil {
+		return nil, fmt.Errorf("listing worker summaries: %w", err)
+	}
+	if len(paths) > 0 {
+		slices.Sort(paths)
+		return paths, nil
+	}
+	legacy := filepath.Join(workerDir, LegacySummaryFile)
+	if info, err := os.Stat(legacy); err == nil && !info.IsDir() {
+		return []string{legacy}, nil
+	}
+	return nil, nil
+}
+
+// Aggregate merges worker summaries into a report. Tasks are ordered by completion.
+func Aggregate(summaries []*Summary, generatedAt time.Time) *Report {
+	summaries = slices.Clone(summaries)
+	slices.SortStableFunc(summaries, func(a, b *Summary) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TaskID, b.TaskID)
+	})
+
+	report := &Report{GeneratedAt: generatedAt}
+	workers := make(map[string]*WorkerMetrics)
+	var workerOrder []string
+	scoreSums := make(map[repository.ReviewDimension]int)
+	scoreCounts := make(map[repository.ReviewDimension]int)
+
+	for _, s := range summaries {
+		task := TaskReport{
+			TaskID:             s.TaskID,
+			WorkerID:           s.WorkerID,
+			CompletedAt:        s.Timestamp,
+			Accomplished:       s.Accomplished,
+			Commits:            s.Commits,
+			IssuesClosed:       s.IssuesClosed,
+			IssuesDiscovered:   s.IssuesDiscovered,
+			VerificationPoints: s.VerificationPoints,
+			Retro:              s.Retro,
+			NextSteps:          s.NextSteps,
+		}
+		if len(s.ReviewScores) > 0 {
+			task.ReviewScores = s.ReviewScores
+			task.AverageReviewScore = averageScore(s.ReviewScores)
+			task.BelowMinimum = s.ReviewScores.BelowMinimum()
+			report.Metrics.ScoredTasks++
+			for dim, score := range s.ReviewScores {
+				scoreSums[dim] += score
+				scoreCounts[dim]++
+			}
+		}
+		report.Tasks = append(report.Tasks, task)
+		report.Metrics.VerificationPoints += len(s.VerificationPoints)
+
+		for _, commit := range s.Commits {
+			report.Commits = attribute(report.Commits, commit, s, sameCommit)
+		}
+		for _, issue := range s.IssuesClosed {
+			report.IssuesClosed = attribute(report.IssuesClosed, issue, s, sameID)
+		}
+		for _, issue := range s.IssuesDiscovered {
+			report.IssuesDiscovered = attribute(report.IssuesDiscovered, issue, s, sameID)
+		}
+
+		w, ok := workers[s.WorkerID]
+		if !ok {
+			w = &WorkerMetrics{WorkerID: s.WorkerID}
+			workers[s.WorkerID] = w
+			workerOrder = append(workerOrder, s.WorkerID)
+		}
+		w.Tasks = append(w.Tasks, s.TaskID)
+	}
+
+	for _, workerID := range workerOrder {
+		w := workers[workerID]
+		w.Commits = countReportedBy(report.Commits, workerID)
+		w.IssuesClosed = countReportedBy(report.IssuesClosed, workerID)
+		w.IssuesDiscovered = countReportedBy(report.IssuesDiscovered, workerID)
+
+		var sum float64
+		var scored int
+		for _, task := range report.Tasks {
+			if task.WorkerID == workerID && task.ReviewScores != nil {
+				sum += task.AverageReviewScore
+				scored++
+			}
+		}
+		if scored > 0 {
+			w.AverageReviewScore = sum / float64(scored)
+		}
+		report.Workers = append(report.Workers, *w)
+	}
+
+	if len(scoreCounts) > 0 {
+		report.Metrics.ReviewScores = make(map[repository.ReviewDimension]float64, len(scoreCounts))
+		for dim, count := range scoreCounts {
+			report.Metrics.ReviewScores[dim] = float64(scoreSums[dim]) / float64(count)
+		}
+	}
+	report.Metrics.Workers = len(report.Workers)
+	report.Metrics.Tasks = len(report.Tasks)
+	report.Metrics.Commits = len(report.Commits)
+	report.Metrics.IssuesClosed = len(report.IssuesClosed)
+	report.Metrics.IssuesDiscovered = len(report.IssuesDiscovered)
+	return report
+}
+
+// AddDecisions lists the decision records in the report, in order.
+func (r *Report) AddDecisions(decisions []*Decision) {
+	for _, d := range decisions {
+		r.Decisions = append(r.Decisions, DecisionReport{
+			Number:     d.Number,
+			Title:      d.Title,
+			Status:     d.Status,
+			WorkerID:   d.WorkerID,
+			TaskID:     d.TaskID,
+			RecordedAt: d.Timestamp,
+			Decision:   d.Decision,
+			File:       d.File,
+		})
+	}
+	r.Metrics.Decisions = len(r.Decisions)
+}
+
+// attribute adds the item id reported by s to items, merging it into an existing
+// entry that matches.
+func attribute(items []Attributed, id string, s *Summary, match func(a, b string) bool) []Attributed {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		return items
+	}
+	for i := range items {
+		if match(items[i].ID, id) {
+			if len(id) > len(items[i].ID) {
+				items[i].ID = id // prefer the full commit hash
+			}
+			items[i].Workers = appendUnique(items[i].Workers, s.WorkerID)
+			items[i].Tasks = appendUnique(items[i].Tasks, s.TaskID)
+			return items
+		}
+	}
+	return append(items, Attributed{ID: id, Workers: []string{s.WorkerID}, Tasks: []string{s.TaskID}})
+}
+
+func sameID(a, b string) bool {
+	return a == b
+}
+
+// sameCommit matches equal hashes and abbreviations of the same hash.
+func sameCommit(a, b string) bool {
+	if len(a) < minCommitPrefix || len(b) < minCommitPrefix {
+		return a == b
+	}
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+func appendUnique(list []string, s string) []string {
+	if slices.Contains(list, s) {
+		return list
+	}
+	return append(list, s)
+}
+
+// countReportedBy counts the items workerID reported.
+func countReportedBy(items []Attributed, workerID string) int {
+	n := 0
+	for _, item := range items {
+		if slices.Contains(item.Workers, workerID) {
+			n++
+		}
+	}
+	return n
+}
+
+// averageScore is the mean score over the scored dimensions.
+func averageScore(scores repository.ReviewScores) float64 {
+	sum := 0
+	for _, score := range scores {
+		sum += score
+	}
+	return float64(sum) / float64(len(scores))
+}
+
+// Markdown renders the report for humans.
+func (r *Report) Markdown() string {
+	var b strings.Builder
+	b.WriteString("# Session Report\n\n")
+	fmt.Fprintf(&b, "**Generated:** %s\n\n", r.GeneratedAt.Format("2006-01-02 15:04:05"))
+
+	// Session metrics
+	b.WriteString("## Session Metrics\n\n")
+	b.WriteString("| Metric | Count |\n|---|---|\n")
+	fmt.Fprintf(&b, "| Workers | %d |\n", r.Metrics.Workers)
+	fmt.Fprintf(&b, "| Tasks | %d |\n", r.Metrics.Tasks)
+	fmt.Fprintf(&b, "| Commits | %d |\n", r.Metrics.Commits)
+	fmt.Fprintf(&b, "| Issues Closed | %d |\n", r.Metrics.IssuesClosed)
+	fmt.Fprintf(&b, "| Issues Discovered | %d |\n", r.Metrics.IssuesDiscovered)
+	fmt.Fprintf(&b, "| Verification Points | %d |\n", r.Metrics.VerificationPoints)
+	if r.Metrics.Decisions > 0 {
+		fmt.Fprintf(&b, "| Decisions | %d |\n", r.Metrics.Decisions)
+	}
+	if r.Metrics.WorkDuration > 0 {
+		fmt.Fprintf(&b, "| Work Time | %s |\n", formatWorkDuration(r.Metrics.WorkDuration))
+	}
+	if len(r.Metrics.ReviewScores) > 0 {
+		parts := make([]string, 0, len(repository.ReviewDimensions))
+		for _, dim := range repository.ReviewDimensions {
+			if avg, ok := r.Metrics.ReviewScores[dim]; ok {
+				parts = append(parts, fmt.Sprintf("%s %.1f/%d", dim, avg, repository.MaxReviewScore))
+			}
+		}
+		fmt.Fprintf(&b, "\n**Average review scores** (%d scored tasks): %s\n", r.Metrics.ScoredTasks, strings.Join(parts, ", "))
+	}
+	b.WriteString("\n")
+
+	// Needs attention
+	var attention []string
+	for _, task := range r.Tasks {
+		if len(task.BelowMinimum) > 0 {
+			dims := make([]string, len(task.BelowMinimum))
+			for i, dim := range task.BelowMinimum {
+				dims[i] = string(dim)
+			}
+			attention = append(attention, fmt.Sprintf("%s (%s) scored below the review minimum on %s", task.TaskID, task.WorkerID, strings.Join(dims, ", ")))
+		}
+	}
+	for _, s := range r.Skipped {
+		attention = append(attention, fmt.Sprintf("Summary %s was skipped: %s", s.Path, s.Error))
+	}
+	if len(attention) > 0 {
+		b.WriteString("## Needs Your Attention\n\n")
+		for _, line := range attention {
+			fmt.Fprintf(&b, "- %s\n", line)
+		}
+		b.WriteString("\n")
+	}
+
+	// Per-worker metrics
+	b.WriteString("## Workers\n\n")
+	b.WriteString("| Worker | Tasks | Commits | Issues Closed | Issues Discovered | Avg Review Score |\n|---|---|---|---|---|---|\n")
+	for _, w := range r.Workers {
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %d | %s |\n", w.WorkerID, strings.Join(w.Tasks, ", "), w.Commits, w.IssuesClosed, w.IssuesDiscovered, formatScore(w.AverageReviewScore))
+	}
+	b.WriteString("\n")
+
+	// Per-task metrics
+	b.WriteString("## Tasks\n\n")
+	b.WriteString("| Task | Worker | Commits | Issues Closed | Issues Discovered | Verification Points | Review Scores |\n|---|---|---|---|---|---|---|\n")
+	for _, task := range r.Tasks {
+		scores := "-"
+		if task.ReviewScores != nil {
+			scores = task.ReviewScores.String()
+		}
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %d | %d | %s |\n", task.TaskID, task.WorkerID, len(task.Commits), len(task.IssuesClosed), len(task.IssuesDiscovered), len(task.VerificationPoints), scores)
+	}
+	b.WriteString("\n")
+
+	r.writeWorkTime(&b)
+
+	// Accomplishments
+	b.WriteString("## What Was Accomplished\n\n")
+	for _, task := range r.Tasks {
+		fmt.Fprintf(&b, "### %s (%s)\n\n", task.TaskID, task.WorkerID)
+		if task.Accomplished != "" {
+			b.WriteString(task.Accomplished)
+			b.WriteString("\n\n")
+		}
+		if len(task.VerificationPoints) > 0 {
+			b.WriteString("**Verification:**\n\n")
+			for _, point := range task.VerificationPoints {
+				fmt.Fprintf(&b, "- %s\n", point)
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	// Decisions
+	if len(r.Decisions) > 0 {
+		b.WriteString("## Decisions\n\n")
+		for _, d := range r.Decisions {
+			owner := d.WorkerID
+			if d.TaskID != "" {
+				owner += ", " + d.TaskID
+			}
+			fmt.Fprintf(&b, "- **%03d %s** (%s, %s): %s ([record](%s))\n", d.Number, d.Title, owner, d.Status, firstLine(d.Decision), filepath.ToSlash(d.File))
+		}
+		b.WriteString("\n")
+	}
+
+	writeAttributed(&b, "Commits", r.Commits)
+	writeAttributed(&b, "Issues Closed", r.IssuesClosed)
+	writeAttributed(&b, "Issues Discovered", r.IssuesDiscovered)
+
+	// Retro
+	retro := []struct {
+		title string
+		text  func(Retro) string
+	}{
+		{"What Went Well", func(r Retro) string { return r.WentWell }},
+		{"Friction", func(r Retro) string { return r.Friction }},
+		{"Patterns Noticed", func(r Retro) string { return r.Patterns }},
+		{"Takeaways", func(r Retro) string { return r.Takeaways }},
+	}
+	var retroBody strings.Builder
+	for _, section := range retro {
+		var lines []string
+		for _, task := range r.Tasks {
+			if text := section.text(task.Retro); text != "" {
+				lines = append(lines, fmt.Sprintf("- **%s** (%s): %s", task.WorkerID, task.TaskID, text))
+			}
+		}
+		if len(lines) > 0 {
+			fmt.Fprintf(&retroBody, "### %s\n\n%s\n\n", section.title, strings.Join(lines, "\n"))
+		}
+	}
+	if retroBody.Len() > 0 {
+		b.WriteString("## Retro\n\n")
+		b.WriteString(retroBody.String())
+	}
+
+	// Next steps
+	var nextSteps []string
+	for _, task := range r.Tasks {
+		if task.NextSteps != "" {
+			nextSteps = append(nextSteps, fmt.Sprintf("- **%s** (%s): %s", task.WorkerID, task.TaskID, task.NextSteps))
+		}
+	}
+	if len(nextSteps) > 0 {
+		fmt.Fprintf(&b, "## Next Steps\n\n%s\n\n", strings.Join(nextSteps, "\n"))
+	}
+
+	return b.String()
+}
+
+// writeAttributed renders a list of commits or issues with their attribution.
+func writeAttributed(b *strings.Builder, title string, items []Attributed) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "## %s\n\n", title)
+	for _, item := range items {
+		fmt.Fprintf(b, "- %s (%s: %s)\n", item.ID, strings.Join(item.Workers, ", "), strings.Join(item.Tasks, ", "))
+	}
+	b.WriteString("\n")
+}
+
+// firstLine returns the first line of text.
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
+
+func formatScore(score float64) string {
+	if score == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f/%d", score, repository.MaxReviewScore)
+}
@@ -0,0 +1,197 @@
+package accountability
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func scores(correctness, tests, style, security int) repository.ReviewScores {
+	return repository.ReviewScores{
+		repository.DimensionCorrectness: correctness,
+		repository.DimensionTests:       tests,
+		repository.DimensionStyle:       style,
+		repository.DimensionSecurity:    security,
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	summaries := []*Summary{
+		{
+			TaskID: "perles-abc.2", WorkerID: "worker-2", Timestamp: start.Add(time.Hour),
+			Commits:      []string{"abc1234"}, // abbreviation of worker-1's commit
+			IssuesClosed: []string{"perles-abc.2"},
+			ReviewScores: scores(3, 3, 3, 3),
+		},
+		{
+			TaskID: "perles-abc.1", WorkerID: "worker-1", Timestamp: start,
+			Commits:          []string{"abc1234567890", "def5678"},
+			IssuesClosed:     []string{"perles-abc.1"},
+			IssuesDiscovered: []string{"perles-new1"},
+			ReviewScores:     scores(5, 5, 5, 5),
+		},
+		{
+			TaskID: "perles-abc.3", WorkerID: "worker-1", Timestamp: start.Add(2 * time.Hour),
+			IssuesDiscovered:   []string{"perles-new1"},
+			VerificationPoints: []string{"tests pass"},
+		},
+	}
+
+	report := Aggregate(summaries, start.Add(3*time.Hour))
+
+	require.Equal(t, SessionMetrics{
+		Workers:            2,
+		Tasks:              3,
+		Commits:            2,
+		IssuesClosed:       2,
+		IssuesDiscovered:   1,
+		VerificationPoints: 1,
+		ScoredTasks:        2,
+		ReviewScores: map[repository.ReviewDimension]float64{
+			repository.DimensionCorrectness: 4,
+			repository.DimensionTests:       4,
+			repository.DimensionStyle:       4,
+			repository.DimensionSecurity:    4,
+		},
+	}, report.Metrics)
+
+	// Tasks are ordered by completion
+	require.Equal(t, "perles-abc.1", report.Tasks[0].TaskID)
+	require.Equal(t, "perles-abc.2", report.Tasks[1].TaskID)
+	require.Equal(t, "perles-abc.3", report.Tasks[2].TaskID)
+	require.Equal(t, []repository.ReviewDimension{repository.DimensionCorrectness, repository.DimensionSecurity}, report.Tasks[1].BelowMinimum)
+	require.Empty(t, report.Tasks[0].BelowMinimum)
+
+	require.Equal(t, []Attributed{
+		{ID: "abc1234567890", Workers: []string{"worker-1", "worker-2"}, Tasks: []string{"perles-abc.1", "perles-abc.2"}},
+		{ID: "def5678", Workers: []string{"worker-1"}, Tasks: []string{"perles-abc.1"}},
+	}, report.Commits)
+	require.Equal(t, []Attributed{
+		{ID: "perles-new1", Workers: []string{"worker-1"}, Tasks: []string{"perles-abc.1", "perles-abc.3"}},
+	}, report.IssuesDiscovered)
+
+	require.Equal(t, []WorkerMetrics{
+		{WorkerID: "worker-1", Tasks: []string{"perles-abc.1", "perles-abc.3"}, Commits: 2, IssuesClosed: 1, IssuesDiscovered: 1, AverageReviewScore: 5},
+		{WorkerID: "worker-2", Tasks: []string{"perles-abc.2"}, Commits: 1, IssuesClosed: 1, AverageReviewScore: 3},
+	}, report.Workers)
+}
+
+func TestReport_Markdown(t *testing.T) {
+	s, err := ParseSummary([]byte(testSummary))
+	require.NoError(t, err)
+	report := Aggregate([]*Summary{s}, time.Date(2026, 1, 2, 16, 0, 0, 0, time.UTC))
+
+	md := report.Markdown()
+	require.Contains(t, md, "# Session Report")
+	require.Contains(t, md, "| Commits | 1 |")
+	require.Contains(t, md, "## Needs Your Attention")
+	require.Contains(t, md, "perles-abc.1 (worker-1) scored below the review minimum on tests, style, security")
+	require.Contains(t, md, "| worker-1 | perles-abc.1 | 1 | 1 | 1 | 3.0/5 |")
+	require.Contains(t, md, "- go test ./... passes")
+	require.Contains(t, md, "- abc1234 (worker-1: perles-abc.1)")
+	require.Contains(t, md, "### Friction\n\n- **worker-1** (perles-abc.1): Flaky test.")
+	require.Contains(t, md, "## Next Steps\n\n- **worker-1** (perles-abc.1): Wire the handler.")
+	require.NotContains(t, md, "### Takeaways")
+}
+
+func TestWriteReport(t *testing.T) {
+	dir := t.TempDir()
+	writeSummary := func(workerID, taskID, content string) {
+		tasksDir := filepath.Join(dir, "workers", workerID, "tasks")
+		require.NoError(t, os.MkdirAll(tasksDir, 0750))
+		require.NoError(t, os.WriteFile(filepath.Join(tasksDir, taskID+".md"), []byte(content), 0600))
+	}
+	writeSummary("worker-1", "perles-abc.1", testSummary)
+	writeSummary("worker-2", "perles-abc.2", "---\ntask_id: perles-abc.2\n---\n\n## What I Accomplished\n\nDone.\n")
+	writeSummary("worker-2", "perles-abc.3", "---\ntask_id: perles-abc.3\n---\n\n## What I Accomplished\n\nAlso done.\n")
+	writeSummary("worker-3", "perles-abc.4", "not a summary")
+
+	report, err := WriteReport(dir, time.Now(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.Metrics.Tasks, "every task a worker summarized is reported")
+	workers := map[string]string{}
+	for _, task := range report.Tasks {
+		workers[task.TaskID] = task.WorkerID
+	}
+	require.Equal(t, map[string]string{"perles-abc.1": "worker-1", "perles-abc.2": "worker-2", "perles-abc.3": "worker-2"}, workers,
+		"worker ID falls back to the directory name")
+	require.Len(t, report.Skipped, 1)
+	require.Contains(t, report.Skipped[0].Path, "worker-3")
+
+	md, err := os.ReadFile(filepath.Join(dir, ReportMarkdownFile))
+	require.NoError(t, err)
+	require.Contains(t, string(md), "worker-3")
+
+	data, err := os.ReadFile(filepath.Join(dir, ReportJSONFile))
+	require.NoError(t, err)
+	var decoded Report
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, report.Metrics, decoded.Metrics)
+}
+
+func TestWriteReport_LegacySummary(t *testing.T) {
+	dir := t.TempDir()
+	legacyDir := filepath.Join(dir, "workers", "worker-1")
+	require.NoError(t, os.MkdirAll(legacyDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(legacyDir, LegacySummaryFile),
+		[]byte("---\ntask_id: perles-abc.1\n---\n\n## What I Accomplished\n\nDone.\n"), 0600))
+
+	// A worker with per-task summaries ignores a stale legacy file.
+	tasksDir := filepath.Join(dir, "workers", "worker-2", "tasks")
+	require.NoError(t, os.MkdirAll(tasksDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(tasksDir, "perles-abc.2.md"),
+		[]byte("---\ntask_id: perles-abc.2\n---\n\n## What I Accomplished\n\nAlso done.\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "workers", "worker-2", LegacySummaryFile), []byte("not a summary"), 0600))
+
+	report, err := WriteReport(dir, time.Now(), nil)
+	require.NoError(t, err)
+	workers := map[string]string{}
+	for _, task := range report.Tasks {
+		workers[task.TaskID] = task.WorkerID
+	}
+	require.Equal(t, map[string]string{"perles-abc.1": "worker-1", "perles-abc.2": "worker-2"}, workers)
+	require.Empty(t, report.Skipped)
+}
+
+func TestWriteReport_NoSummaries(t *testing.T) {
+	dir := t.TempDir()
+	_, err := WriteReport(dir, time.Now(), nil)
+	require.ErrorIs(t, err, ErrNoSummaries)
+
+	_, err = os.Stat(filepath.Join(dir, ReportMarkdownFile))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestWriteReport_IncludesDecisions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, DecisionsDir), 0750))
+	content, err := testDecision().Markdown()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, DecisionsDir, "001-use-sqlite-not-redis.md"), content, 0600))
+
+	// Decisions alone are enough for a report
+	report, err := WriteReport(dir, time.Now(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Metrics.Decisions)
+	require.Equal(t, DecisionReport{
+		Number:     1,
+		Title:      "Use SQLite: not Redis",
+		Status:     DecisionAccepted,
+		WorkerID:   "worker-1",
+		TaskID:     "perles-abc.1",
+		RecordedAt: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC),
+		Decision:   "Store cached results in SQLite.",
+		File:       filepath.Join(DecisionsDir, "001-use-sqlite-not-redis.md"),
+	}, report.Decisions[0])
+
+	md := report.Markdown()
+	require.Contains(t, md, "| Decisions | 1 |")
+	require.Contains(t, md, "## Decisions\n\n- **001 Use SQLite: not Redis** (worker-1, perles-abc.1, accepted): Store cached results in SQLite. ([record](decisions/001-use-sqlite-not-redis.md))\n")
+}
@@ -0,0 +1,140 @@
+// Package accountability aggregates worker accountability summaries into a
+// session report.
+//
+// Workers save a summary per task with post_accountability_summary to
+// {sessionDir}/workers/{workerID}/tasks/{taskID}.md: YAML frontmatter
+// (task, worker, commits, issues, review scores) followed by markdown sections.
+// Decisions recorded with record_decision are saved as numbered records to
+// {sessionDir}/decisions/{NNN}-{slug}.md in the same frontmatter format.
+// WriteReport parses every summary and decision of a session and writes the
+// merged report as session_report.md and session_report.json.
+package accountability
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+const frontmatterDelimiter = "---"
+
+// Summary is a parsed worker accountability summary.
+type Summary struct {
+	TaskID           string                  `yaml:"task_id"`
+	WorkerID         string                  `yaml:"worker_id"`
+	Timestamp        time.Time               `yaml:"timestamp"`
+	Commits          []string                `yaml:"commits"`
+	IssuesDiscovered []string                `yaml:"issues_discovered"`
+	IssuesClosed     []string                `yaml:"issues_closed"`
+	ReviewScores     repository.ReviewScores `yaml:"review_scores"`
+
+	// Markdown sections
+	Accomplished       string   `yaml:"-"`
+	VerificationPoints []string `yaml:"-"`
+	Retro              Retro    `yaml:"-"`
+	NextSteps          string   `yaml:"-"`
+}
+
+// Retro is the retrospective feedback of a summary.
+type Retro struct {
+	WentWell  string `json:"went_well,omitempty"`
+	Friction  string `json:"friction,omitempty"`
+	Patterns  string `json:"patterns,omitempty"`
+	Takeaways string `json:"takeaways,omitempty"`
+}
+
+// ParseSummary parses a worker accountability summary written by
+// post_accountability_summary.
+func ParseSummary(content []byte) (*Summary, error) {
+	var s Summary
+	body, err := parseFrontmatter(content, &s)
+	if err != nil {
+		return nil, err
+	}
+	if s.TaskID == "" {
+		return nil, fmt.Errorf("frontmatter missing required field: task_id")
+	}
+
+	s.parseBody(body)
+	return &s, nil
+}
+
+// parseFrontmatter decodes the YAML frontmatter of content into v and returns
+// the markdown body that follows it.
+func parseFrontmatter(content []byte, v any) (string, error) {
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	if !strings.HasPrefix(text, frontmatterDelimiter+"\n") {
+		return "", fmt.Errorf("content does not start with frontmatter delimiter")
+	}
+	yamlContent, body, found := strings.Cut(text[len(frontmatterDelimiter)+1:], "\n"+frontmatterDelimiter+"\n")
+	if !found {
+		return "", fmt.Errorf("no closing frontmatter delimiter found")
+	}
+	if err := yaml.NewDecoder(bytes.NewReader([]byte(yamlContent))).Decode(v); err != nil {
+		return "", fmt.Errorf("parsing frontmatter: %w", err)
+	}
+	return body, nil
+}
+
+// parseBody fills the summary's markdown sections. Unknown sections (such as
+// Issues Discovered and Review Scores, which repeat the frontmatter) are ignored.
+func (s *Summary) parseBody(body string) {
+	var section, subsection string
+	var text []string
+	flush := func() {
+		content := strings.TrimSpace(strings.Join(text, "\n"))
+		text = nil
+		if content == "" {
+			return
+		}
+		switch section {
+		case "What I Accomplished":
+			s.Accomplished = content
+		case "Verification Points":
+			s.VerificationPoints = listItems(content)
+		case "Next Steps":
+			s.NextSteps = content
+		case "Retro":
+			switch subsection {
+			case "What Went Well":
+				s.Retro.WentWell = content
+			case "Friction":
+				s.Retro.Friction = content
+			case "Patterns Noticed":
+				s.Retro.Patterns = content
+			case "Takeaways":
+				s.Retro.Takeaways = content
+			}
+		}
+	}
+
+	for line := range strings.SplitSeq(body, "\n") {
+		switch {
+		case strings.HasPrefix(line, "## "):
+			flush()
+			section, subsection = strings.TrimSpace(line[3:]), ""
+		case strings.HasPrefix(line, "### "):
+			flush()
+			subsection = strings.TrimSpace(line[4:])
+		default:
+			text = append(text, line)
+		}
+	}
+	flush()
+}
+
+// listItems returns the items of a markdown bullet list.
+func listItems(content string) []string {
+	var items []string
+	for line := range strings.SplitSeq(content, "\n") {
+		if item, ok := strings.CutPrefix(strings.TrimSpace(line), "- "); ok {
+			items = append(items, strings.TrimSpace(item))
+		}
+	}
+	return items
+}
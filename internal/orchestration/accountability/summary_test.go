@@ -0,0 +1,100 @@
+package accountability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+const testSummary = `---
+task_id: perles-abc.1
+worker_id: worker-1
+timestamp: 2026-01-02T15:04:05Z
+commits:
+  - abc1234
+issues_discovered:
+  - perles-new1
+issues_closed:
+  - perles-abc.1
+review_scores:
+  correctness: 4
+  tests: 2
+---
+
+# Worker Accountability Summary
+
+**Worker:** worker-1
+**Task:** perles-abc.1
+**Date:** 2026-01-02 15:04:05
+
+## What I Accomplished
+
+Added the parser.
+
+## Review Scores
+
+correctness 4/5, tests 2/5
+
+## Verification Points
+
+- go test ./... passes
+- Manually parsed a summary
+
+## Issues Discovered
+
+- perles-new1
+
+## Retro
+
+### What Went Well
+
+Clear spec.
+
+### Friction
+
+Flaky test.
+
+## Next Steps
+
+Wire the handler.
+`
+
+func TestParseSummary(t *testing.T) {
+	s, err := ParseSummary([]byte(testSummary))
+	require.NoError(t, err)
+
+	require.Equal(t, "perles-abc.1", s.TaskID)
+	require.Equal(t, "worker-1", s.WorkerID)
+	require.Equal(t, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), s.Timestamp.UTC())
+	require.Equal(t, []string{"abc1234"}, s.Commits)
+	require.Equal(t, []string{"perles-new1"}, s.IssuesDiscovered)
+	require.Equal(t, []string{"perles-abc.1"}, s.IssuesClosed)
+	require.Equal(t, repository.ReviewScores{repository.DimensionCorrectness: 4, repository.DimensionTests: 2}, s.ReviewScores)
+
+	require.Equal(t, "Added the parser.", s.Accomplished)
+	require.Equal(t, []string{"go test ./... passes", "Manually parsed a summary"}, s.VerificationPoints)
+	require.Equal(t, Retro{WentWell: "Clear spec.", Friction: "Flaky test."}, s.Retro)
+	require.Equal(t, "Wire the handler.", s.NextSteps)
+}
+
+func TestParseSummary_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"no frontmatter", "# Summary\n", "does not start with frontmatter"},
+		{"unclosed frontmatter", "---\ntask_id: x\n", "no closing frontmatter"},
+		{"invalid yaml", "---\ntask_id: [\n---\n", "parsing frontmatter"},
+		{"missing task id", "---\nworker_id: worker-1\n---\n", "task_id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSummary([]byte(tt.content))
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package accountability
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// ReadWorkTime loads the time tracking fields of the report's task issues
+// from their work log comments. Tasks whose comments can't be read are left out.
+func (r *Report) ReadWorkTime(comments appbeads.CommentReader) map[string]beads.Issue {
+	issues := make(map[string]beads.Issue)
+	for _, task := range r.Tasks {
+		if _, ok := issues[task.TaskID]; ok {
+			continue
+		}
+		issue := beads.Issue{ID: task.TaskID}
+		if err := appbeads.LoadWorkLog(comments, &issue); err != nil {
+			continue
+		}
+		issues[task.TaskID] = issue
+	}
+	return issues
+}
+
+// AddWorkTime adds the work time of each task from its issue's time tracking
+// fields, and totals it per worker and for the session. A task's time is
+// credited to the worker of its summary.
+func (r *Report) AddWorkTime(issues map[string]beads.Issue) {
+	perWorker := make(map[string]time.Duration)
+	for i := range r.Tasks {
+		task := &r.Tasks[i]
+		issue, ok := issues[task.TaskID]
+		if !ok {
+			continue
+		}
+		task.StartedAt = issue.StartedAt
+		task.WorkDuration = issue.WorkDuration
+		task.WorkedBy = issue.WorkedBy
+		perWorker[task.WorkerID] += issue.WorkDuration
+		r.Metrics.WorkDuration += issue.WorkDuration
+	}
+	for i := range r.Workers {
+		r.Workers[i].WorkDuration = perWorker[r.Workers[i].WorkerID]
+	}
+}
+
+// writeWorkTime renders the time spent per task and per worker.
+func (r *Report) writeWorkTime(b *strings.Builder) {
+	if r.Metrics.WorkDuration == 0 {
+		return
+	}
+	b.WriteString("## Time Spent\n\n")
+	b.WriteString("| Task | Worked By | Started | Work Time |\n|---|---|---|---|\n")
+	for _, task := range r.Tasks {
+		if task.WorkDuration == 0 {
+			continue
+		}
+		started := "-"
+		if !task.StartedAt.IsZero() {
+			started = task.StartedAt.Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(b, "| %s | %s | %s | %s |\n", task.TaskID, strings.Join(task.WorkedBy, ", "), started, formatWorkDuration(task.WorkDuration))
+	}
+	b.WriteString("\n")
+
+	var perWorker []string
+	for _, w := range r.Workers {
+		if w.WorkDuration > 0 {
+			perWorker = append(perWorker, fmt.Sprintf("%s %s", w.WorkerID, formatWorkDuration(w.WorkDuration)))
+		}
+	}
+	fmt.Fprintf(b, "**By worker:** %s\n\n", strings.Join(perWorker, ", "))
+}
+
+// formatWorkDuration renders a work time as hours and minutes, e.g. "1h 05m".
+func formatWorkDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		return "<1m"
+	}
+	if d < time.Hour {
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%dh %02dm", int(d.Hours()), int(d.Minutes())%60)
+}
@@ -0,0 +1,59 @@
+package accountability
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+func TestReport_AddWorkTime(t *testing.T) {
+	s, err := ParseSummary([]byte(testSummary))
+	require.NoError(t, err)
+	report := Aggregate([]*Summary{s}, time.Date(2026, 1, 2, 16, 0, 0, 0, time.UTC))
+
+	started := time.Date(2026, 1, 2, 14, 0, 0, 0, time.UTC)
+	report.AddWorkTime(map[string]beads.Issue{
+		"perles-abc.1": {ID: "perles-abc.1", StartedAt: started, WorkDuration: 65 * time.Minute, WorkedBy: []string{"worker-1"}},
+		"perles-zzz":   {ID: "perles-zzz", WorkDuration: time.Hour},
+	})
+
+	require.Equal(t, 65*time.Minute, report.Metrics.WorkDuration, "tasks without a summary are not counted")
+	require.Equal(t, 65*time.Minute, report.Workers[0].WorkDuration)
+	require.Equal(t, started, report.Tasks[0].StartedAt)
+	require.Equal(t, []string{"worker-1"}, report.Tasks[0].WorkedBy)
+
+	md := report.Markdown()
+	require.Contains(t, md, "| Work Time | 1h 05m |")
+	require.Contains(t, md, "| perles-abc.1 | worker-1 | 2026-01-02 14:00 | 1h 05m |")
+	require.Contains(t, md, "**By worker:** worker-1 1h 05m")
+}
+
+func TestReport_ReadWorkTime(t *testing.T) {
+	s, err := ParseSummary([]byte(testSummary))
+	require.NoError(t, err)
+	report := Aggregate([]*Summary{s}, time.Now())
+
+	comments := mocks.NewMockCommentReader(t)
+	comments.EXPECT().GetComments("perles-abc.1").Return([]beads.Comment{
+		{Text: beads.FormatWorkStartedComment("worker-1")},
+		{Text: beads.FormatWorkCompletedComment("worker-1", 20*time.Minute)},
+	}, nil).Once()
+	issues := report.ReadWorkTime(comments)
+	require.Equal(t, 20*time.Minute, issues["perles-abc.1"].WorkDuration)
+	require.Equal(t, []string{"worker-1"}, issues["perles-abc.1"].WorkedBy)
+
+	failing := mocks.NewMockCommentReader(t)
+	failing.EXPECT().GetComments("perles-abc.1").Return(nil, errors.New("no database"))
+	require.Empty(t, report.ReadWorkTime(failing))
+}
+
+func TestFormatWorkDuration(t *testing.T) {
+	require.Equal(t, "<1m", formatWorkDuration(20*time.Second))
+	require.Equal(t, "42m", formatWorkDuration(42*time.Minute))
+	require.Equal(t, "2h 00m", formatWorkDuration(2*time.Hour))
+}
@@ -0,0 +1,203 @@
+// Package audit keeps an append-only log of the consequential decisions the
+// coordinator makes: spawning, assigning, retiring and stopping workers,
+// approving commits and failing tasks.
+//
+// Each decision is one line of audit.jsonl in the session directory with the
+// tool arguments, the result and the process that made it. When the log reaches
+// its size limit it is renamed to audit.<n>.jsonl and a new log is started.
+// Rotated logs are made read-only and never deleted.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/redact"
+)
+
+// LogFile is the filename of the active audit log in the session directory.
+const LogFile = "audit.jsonl"
+
+// DefaultMaxSize is the size in bytes at which the audit log is rotated.
+const DefaultMaxSize int64 = 10 << 20
+
+// actions are the coordinator tools whose calls are audited.
+var actions = map[string]bool{
+	"spawn_worker":       true,
+	"replace_worker":     true,
+	"retire_worker":      true,
+	"stop_worker":        true,
+	"emergency_stop":     true,
+	"assign_task":        true,
+	"assign_task_review": true,
+	"approve_commit":     true,
+	"mark_task_complete": true,
+	"mark_task_failed":   true,
+	"advance_phase":      true,
+}
+
+// IsAudited reports whether calls of the named tool are recorded in the audit log.
+func IsAudited(tool string) bool {
+	return actions[tool]
+}
+
+// Entry is a single line of the audit log.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Action    string    `json:"action"`     // Tool name, e.g. "spawn_worker"
+	ProcessID string    `json:"process_id"` // Process that called the tool
+
+	// Arguments are the tool arguments as sent by the process.
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+
+	Success    bool   `json:"success"`
+	Result     string `json:"result,omitempty"` // Text returned to the process
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+	TraceID    string `json:"trace_id,omitempty"`
+}
+
+// Config configures an audit log.
+type Config struct {
+	// MaxSize is the size in bytes at which the log is rotated (0 = DefaultMaxSize).
+	MaxSize int64
+
+	// Redactor masks secrets in entries before they are written.
+	// Optional - if nil, entries are written unredacted.
+	Redactor *redact.Redactor
+}
+
+// Log appends entries to audit.jsonl, rotating it when it grows past its size limit.
+// Entries are written synchronously so a decision is on disk before its result
+// reaches the process. Thread-safe. A nil *Log records nothing.
+type Log struct {
+	mu       sync.Mutex
+	dir      string
+	maxSize  int64
+	redactor *redact.Redactor
+	file     *os.File
+	size     int64
+}
+
+// Open opens the audit log of a session directory for appending, creating it if needed.
+func Open(sessionDir string, cfg Config) (*Log, error) {
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	l := &Log{dir: sessionDir, maxSize: maxSize, redactor: cfg.Redactor}
+	if err := l.openLocked(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Record appends an entry to the log.
+func (l *Log) Record(entry Entry) error {
+	if l == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshaling audit entry: %w", err)
+	}
+	data = append(l.redactor.RedactBytes(data), '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return os.ErrClosed
+	}
+	if l.size > 0 && l.size+int64(len(data)) > l.maxSize {
+		// An oversized log is better than a lost entry: only fail without a file to write to
+		if err := l.rotateLocked(); err != nil && l.file == nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(data)
+	l.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("writing audit entry: %w", err)
+	}
+	return nil
+}
+
+// Close syncs and closes the log. Later calls to Record return os.ErrClosed.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Sync()
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	l.file = nil
+	if err != nil {
+		return fmt.Errorf("closing audit log: %w", err)
+	}
+	return nil
+}
+
+// openLocked opens the active log file in append mode. The caller must hold l.mu.
+func (l *Log) openLocked() error {
+	path := filepath.Join(l.dir, LogFile)
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec // G304: path is constructed from trusted sessionDir
+	if err != nil {
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+	l.file = file
+	l.size = info.Size()
+	return nil
+}
+
+// rotateLocked moves the active log to the next audit.<n>.jsonl, makes it
+// read-only and starts a new active log. The caller must hold l.mu.
+func (l *Log) rotateLocked() error {
+	rotated, err := rotatedFiles(l.dir)
+	if err != nil {
+		return err
+	}
+	next := 1
+	if len(rotated) > 0 {
+		next = rotated[len(rotated)-1].seq + 1
+	}
+
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	l.file = nil
+
+	target := filepath.Join(l.dir, rotatedName(next))
+	if err := os.Rename(filepath.Join(l.dir, LogFile), target); err != nil {
+		// Keep appending to the current log rather than losing entries
+		if openErr := l.openLocked(); openErr != nil {
+			return fmt.Errorf("rotating audit log: %w", openErr)
+		}
+		return fmt.Errorf("rotating audit log: %w", err)
+	}
+	_ = os.Chmod(target, 0400)
+
+	return l.openLocked()
+}
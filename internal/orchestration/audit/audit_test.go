@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/redact"
+)
+
+func TestLog_RecordAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir, Config{})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	require.NoError(t, l.Record(Entry{Timestamp: start, Action: "spawn_worker", ProcessID: "coordinator",
+		Arguments: json.RawMessage(`{"backend":"claude"}`), Success: true, Result: "Spawned worker-1"}))
+	require.NoError(t, l.Record(Entry{Timestamp: start.Add(time.Minute), Action: "assign_task", ProcessID: "coordinator",
+		Arguments: json.RawMessage(`{"worker_id":"worker-1","task_id":"bd-1"}`), Error: "worker busy"}))
+	require.NoError(t, l.Close())
+	require.ErrorIs(t, l.Record(Entry{Action: "stop_worker"}), os.ErrClosed)
+
+	entries, err := Load(dir, Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "spawn_worker", entries[0].Action)
+	require.JSONEq(t, `{"backend":"claude"}`, string(entries[0].Arguments))
+	require.Equal(t, "Spawned worker-1", entries[0].Result)
+
+	// Reopening appends (cold resume)
+	l, err = Open(dir, Config{})
+	require.NoError(t, err)
+	require.NoError(t, l.Record(Entry{Timestamp: start.Add(2 * time.Minute), Action: "retire_worker", ProcessID: "coordinator", Success: true}))
+	require.NoError(t, l.Close())
+
+	entries, err = Load(dir, Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+}
+
+func TestLog_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir, Config{MaxSize: 200})
+	require.NoError(t, err)
+
+	for i := 0; i < 6; i++ {
+		require.NoError(t, l.Record(Entry{Timestamp: time.Now(), Action: "assign_task", ProcessID: "coordinator",
+			Result: strings.Repeat("x", 80), Success: true, DurationMs: int64(i)}))
+	}
+	require.NoError(t, l.Close())
+
+	rotated, err := rotatedFiles(dir)
+	require.NoError(t, err)
+	require.NotEmpty(t, rotated)
+	require.Equal(t, 1, rotated[0].seq)
+	info, err := os.Stat(rotated[0].path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0400), info.Mode().Perm(), "rotated logs are read-only")
+
+	// Entries come back in the order they were recorded, across files
+	entries, err := Load(dir, Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 6)
+	for i, entry := range entries {
+		require.Equal(t, int64(i), entry.DurationMs)
+	}
+}
+
+func TestLog_Redacts(t *testing.T) {
+	dir := t.TempDir()
+	l, err := Open(dir, Config{Redactor: redact.New(redact.DefaultPolicy())})
+	require.NoError(t, err)
+	require.NoError(t, l.Record(Entry{Action: "assign_task", Arguments: json.RawMessage(`{"summary":"use key sk-ant-REDACTED"}`)}))
+	require.NoError(t, l.Close())
+
+	data, err := os.ReadFile(filepath.Join(dir, LogFile))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "sk-ant-REDACTED")
+}
+
+func TestLog_Nil(t *testing.T) {
+	var l *Log
+	require.NoError(t, l.Record(Entry{Action: "spawn_worker"}))
+	require.NoError(t, l.Close())
+}
+
+func TestLoad_MissingLog(t *testing.T) {
+	entries, err := Load(t.TempDir(), Filter{})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestFilter_Match(t *testing.T) {
+	at := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	entry := Entry{Timestamp: at, Action: "approve_commit", ProcessID: "coordinator", Success: true}
+
+	require.True(t, Filter{}.Match(entry))
+	require.True(t, Filter{Actions: []string{"spawn_worker", "approve_commit"}}.Match(entry))
+	require.False(t, Filter{Actions: []string{"spawn_worker"}}.Match(entry))
+	require.False(t, Filter{ProcessID: "worker-1"}.Match(entry))
+	require.True(t, Filter{Since: at}.Match(entry))
+	require.False(t, Filter{Since: at.Add(time.Second)}.Match(entry))
+	require.False(t, Filter{Until: at}.Match(entry))
+	require.False(t, Filter{Failed: true}.Match(entry))
+}
+
+func TestFormat(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Format(&buf, []Entry{
+		{Timestamp: time.Now(), Action: "spawn_worker", ProcessID: "coordinator", Success: true, Result: "Spawned worker-1\nmore"},
+		{Timestamp: time.Now(), Action: "assign_task", ProcessID: "coordinator", Error: "worker busy"},
+	}))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], "spawn_worker")
+	require.Contains(t, lines[0], "Spawned worker-1 …")
+	require.Contains(t, lines[1], "FAILED")
+	require.Contains(t, lines[1], "worker busy")
+}
+
+func TestIsAudited(t *testing.T) {
+	for _, tool := range []string{"spawn_worker", "assign_task", "retire_worker", "stop_worker", "approve_commit", "mark_task_failed"} {
+		require.True(t, IsAudited(tool), tool)
+	}
+	require.False(t, IsAudited("fabric_send"))
+}
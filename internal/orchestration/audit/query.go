@@ -0,0 +1,179 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// summaryWidth caps the argument and result excerpts shown by Format.
+const summaryWidth = 60
+
+// Filter selects audit entries. Zero fields match everything.
+type Filter struct {
+	Actions   []string  // Tool names, e.g. "spawn_worker"
+	ProcessID string    // Process that called the tool
+	Since     time.Time // Entries at or after this time
+	Until     time.Time // Entries before this time
+	Failed    bool      // Only calls that failed
+}
+
+// Match reports whether the entry passes the filter.
+func (f Filter) Match(e Entry) bool {
+	if len(f.Actions) > 0 {
+		found := false
+		for _, action := range f.Actions {
+			if e.Action == action {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.ProcessID != "" && e.ProcessID != f.ProcessID {
+		return false
+	}
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && !e.Timestamp.Before(f.Until) {
+		return false
+	}
+	if f.Failed && e.Success {
+		return false
+	}
+	return true
+}
+
+// Load reads the audit log of a session directory, rotated files first, and
+// returns the entries that pass the filter in the order they were recorded.
+// A session without an audit log has no entries. Lines that cannot be parsed
+// (e.g. a partial line from a crashed session) are skipped.
+func Load(sessionDir string, filter Filter) ([]Entry, error) {
+	rotated, err := rotatedFiles(sessionDir)
+	if err != nil {
+		return nil, err
+	}
+	paths := make([]string, 0, len(rotated)+1)
+	for _, r := range rotated {
+		paths = append(paths, r.path)
+	}
+	paths = append(paths, filepath.Join(sessionDir, LogFile))
+
+	var entries []Entry
+	for _, path := range paths {
+		entries, err = loadFile(path, filter, entries)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// loadFile appends the matching entries of one log file to entries.
+func loadFile(path string, filter Filter, entries []Entry) ([]Entry, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: path is constructed from trusted sessionDir
+	if errors.Is(err, os.ErrNotExist) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+		if filter.Match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading audit log %s: %w", filepath.Base(path), err)
+	}
+	return entries, nil
+}
+
+// Format writes entries as one human-readable line each: the time, the action,
+// the process, the outcome and a short summary of the arguments and result.
+func Format(w io.Writer, entries []Entry) error {
+	for _, e := range entries {
+		status := "ok"
+		detail := e.Result
+		if !e.Success {
+			status = "FAILED"
+			if e.Error != "" {
+				detail = e.Error
+			}
+		}
+		line := fmt.Sprintf("%s  %-18s  %-14s  %-6s  %s  %s",
+			e.Timestamp.Local().Format("2006-01-02 15:04:05"),
+			e.Action, e.ProcessID, status,
+			truncate(string(e.Arguments)), truncate(detail))
+		if _, err := fmt.Fprintln(w, strings.TrimRight(line, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// truncate flattens s to its first line, capped at summaryWidth runes.
+func truncate(s string) string {
+	first, _, multiline := strings.Cut(strings.TrimSpace(s), "\n")
+	runes := []rune(first)
+	if len(runes) > summaryWidth {
+		return string(runes[:summaryWidth-1]) + "…"
+	}
+	if multiline {
+		return first + " …"
+	}
+	return first
+}
+
+// rotatedFile is a rotated audit log and its rotation sequence number.
+type rotatedFile struct {
+	path string
+	seq  int
+}
+
+// rotatedName returns the filename of the seq-th rotated audit log.
+func rotatedName(seq int) string {
+	return fmt.Sprintf("audit.%d.jsonl", seq)
+}
+
+// rotatedFiles lists the rotated audit logs of a session directory, oldest first.
+func rotatedFiles(sessionDir string) ([]rotatedFile, error) {
+	matches, err := filepath.Glob(filepath.Join(sessionDir, "audit.*.jsonl"))
+	if err != nil {
+		return nil, fmt.Errorf("listing rotated audit logs: %w", err)
+	}
+	var files []rotatedFile
+	for _, path := range matches {
+		name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), "audit."), ".jsonl")
+		seq, err := strconv.Atoi(name)
+		if err != nil || seq < 1 {
+			continue
+		}
+		files = append(files, rotatedFile{path: path, seq: seq})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].seq < files[j].seq })
+	return files, nil
+}
@@ -0,0 +1,228 @@
+// Package autoscale sizes a workflow's worker pool to its ready-task backlog.
+//
+// On every tick the Autoscaler compares the number of ready (unblocked,
+// unassigned) bd tasks with the number of idle workers and spawns or retires
+// workers within the configured bounds. Spawning stops once the workflow's
+// spend reaches the cost budget. Every scaling decision is logged and
+// published on the workflow event bus so the dashboard can show it.
+package autoscale
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// Defaults applied by NewAutoscaler when the policy leaves a field zero.
+const (
+	DefaultInterval    = 30 * time.Second
+	DefaultIdleTimeout = 5 * time.Minute
+	DefaultCooldown    = time.Minute
+)
+
+// Policy bounds the autoscaler's decisions.
+type Policy struct {
+	// Interval is how often the backlog is evaluated.
+	Interval time.Duration
+	// MinWorkers is the pool floor. Idle workers are never retired below it.
+	MinWorkers int
+	// MaxWorkers is the pool ceiling. Zero means no upper bound.
+	MaxWorkers int
+	// MaxCostUSD stops scale-up once the workflow's cumulative spend reaches it.
+	// Zero means no budget.
+	MaxCostUSD float64
+	// IdleTimeout is how long a worker must sit idle before it may be retired.
+	IdleTimeout time.Duration
+	// Cooldown is the minimum time between two scaling actions.
+	Cooldown time.Duration
+}
+
+// Action is the kind of scaling decision.
+type Action string
+
+const (
+	// ActionNone means the pool already matches the backlog.
+	ActionNone Action = "none"
+	// ActionScaleUp means workers should be spawned.
+	ActionScaleUp Action = "scale_up"
+	// ActionScaleDown means idle workers should be retired.
+	ActionScaleDown Action = "scale_down"
+)
+
+// Decision is the outcome of one autoscaler evaluation.
+type Decision struct {
+	Action Action
+	// Spawn is the number of workers to spawn (ActionScaleUp).
+	Spawn int
+	// Retire lists the worker IDs to retire (ActionScaleDown).
+	Retire []string
+	// Reason explains the decision, including why nothing happened.
+	Reason string
+	// OverBudget is set when the cost budget blocked a scale-up.
+	OverBudget bool
+
+	// Inputs the decision was based on.
+	ReadyTasks    int
+	IdleWorkers   int
+	ActiveWorkers int
+	CostUSD       float64
+	Timestamp     time.Time
+}
+
+// Summary returns a one-line description for logs and the dashboard,
+// e.g. "spawning 2 workers (5 ready tasks, 1 idle of 3 workers)".
+func (d Decision) Summary() string {
+	var sb strings.Builder
+	switch d.Action {
+	case ActionScaleUp:
+		fmt.Fprintf(&sb, "spawning %d %s", d.Spawn, pluralWorkers(d.Spawn))
+	case ActionScaleDown:
+		fmt.Fprintf(&sb, "retiring %s", strings.Join(d.Retire, ", "))
+	default:
+		sb.WriteString("no change")
+	}
+	fmt.Fprintf(&sb, " (%d ready tasks, %d idle of %d %s)",
+		d.ReadyTasks, d.IdleWorkers, d.ActiveWorkers, pluralWorkers(d.ActiveWorkers))
+	if d.Reason != "" {
+		sb.WriteString(": ")
+		sb.WriteString(d.Reason)
+	}
+	return sb.String()
+}
+
+func pluralWorkers(n int) string {
+	if n == 1 {
+		return "worker"
+	}
+	return "workers"
+}
+
+// countReadyTasks counts bd ready issues that no worker has picked up.
+// Epics are containers, not work, and archived issues can't be assigned; neither counts.
+func countReadyTasks(issues []beads.Issue, workers []*repository.Process) int {
+	var n int
+	for _, issue := range issues {
+		if issue.Type == beads.TypeEpic || issue.Assignee != "" || issue.IsArchived() {
+			continue
+		}
+		if slices.ContainsFunc(workers, func(w *repository.Process) bool { return w.TaskID == issue.ID }) {
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// isIdle reports whether a worker is Ready in the Idle phase (see ProcessRepository.ReadyWorkers).
+func isIdle(w *repository.Process) bool {
+	return w.Status == repository.StatusReady && (w.Phase == nil || *w.Phase == events.ProcessPhaseIdle)
+}
+
+// isStarting reports whether a worker has been spawned but is not ready yet.
+// Starting workers count as capacity so consecutive ticks don't over-spawn.
+func isStarting(w *repository.Process) bool {
+	return w.Status == repository.StatusPending || w.Status == repository.StatusStarting
+}
+
+// Decide computes a scaling decision from the current backlog and worker pool.
+// workers should contain every worker process; terminal ones are ignored.
+func Decide(policy Policy, readyTasks int, workers []*repository.Process, costUSD float64, now time.Time) Decision {
+	d := Decision{Action: ActionNone, ReadyTasks: readyTasks, CostUSD: costUSD, Timestamp: now}
+
+	var idle []*repository.Process
+	var starting int
+	for _, w := range workers {
+		if w.Status.IsTerminal() || w.Status == repository.StatusRetiring || w.Status == repository.StatusStopped {
+			continue
+		}
+		if w.Status == repository.StatusPaused {
+			d.Reason = "workflow is paused"
+			return d
+		}
+		d.ActiveWorkers++
+		switch {
+		case isIdle(w):
+			idle = append(idle, w)
+		case isStarting(w):
+			starting++
+		}
+	}
+	d.IdleWorkers = len(idle)
+
+	overBudget := policy.MaxCostUSD > 0 && costUSD >= policy.MaxCostUSD
+	headroom := -1 // unlimited
+	if policy.MaxWorkers > 0 {
+		headroom = max(policy.MaxWorkers-d.ActiveWorkers, 0)
+	}
+
+	// Scale up: keep the floor, then cover backlog not served by idle or starting workers.
+	want := max(policy.MinWorkers-d.ActiveWorkers, readyTasks-len(idle)-starting, 0)
+	if headroom >= 0 {
+		want = min(want, headroom)
+	}
+	if want > 0 {
+		if overBudget {
+			d.Reason = fmt.Sprintf("cost budget reached ($%.2f of $%.2f)", costUSD, policy.MaxCostUSD)
+			d.OverBudget = true
+			return d
+		}
+		d.Action = ActionScaleUp
+		d.Spawn = want
+		if d.ActiveWorkers < policy.MinWorkers {
+			d.Reason = fmt.Sprintf("below minimum of %d", policy.MinWorkers)
+		}
+		return d
+	}
+	if readyTasks > len(idle)+starting && headroom == 0 {
+		d.Reason = fmt.Sprintf("at maximum of %d", policy.MaxWorkers)
+		return d
+	}
+
+	// Scale down: retire idle workers the backlog doesn't need, longest idle first.
+	surplus := min(len(idle)-readyTasks, d.ActiveWorkers-policy.MinWorkers)
+	if surplus <= 0 {
+		return d
+	}
+	var stale []*repository.Process
+	for _, w := range idle {
+		if now.Sub(lastActive(w)) >= policy.IdleTimeout {
+			stale = append(stale, w)
+		}
+	}
+	slices.SortStableFunc(stale, func(a, b *repository.Process) int {
+		return lastActive(a).Compare(lastActive(b))
+	})
+	for _, w := range stale[:min(surplus, len(stale))] {
+		d.Retire = append(d.Retire, w.ID)
+	}
+	if len(d.Retire) > 0 {
+		d.Action = ActionScaleDown
+		d.Reason = fmt.Sprintf("idle for over %s", policy.IdleTimeout)
+	}
+	return d
+}
+
+// lastActive returns when a worker last did something.
+func lastActive(w *repository.Process) time.Time {
+	if w.LastActivityAt.After(w.CreatedAt) {
+		return w.LastActivityAt
+	}
+	return w.CreatedAt
+}
+
+// TotalCostUSD sums the cumulative spend of every process in the workflow,
+// including retired workers.
+func TotalCostUSD(processes []*repository.Process) float64 {
+	var total float64
+	for _, p := range processes {
+		if p.Metrics != nil {
+			total += p.Metrics.CumulativeCostUSD
+		}
+	}
+	return total
+}
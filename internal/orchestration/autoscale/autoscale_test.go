@@ -0,0 +1,176 @@
+package autoscale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func worker(id string, status repository.ProcessStatus, idleFor time.Duration) *repository.Process {
+	phase := events.ProcessPhaseIdle
+	if status == repository.StatusWorking {
+		phase = events.ProcessPhaseImplementing
+	}
+	return &repository.Process{
+		ID:             id,
+		Role:           repository.RoleWorker,
+		Status:         status,
+		Phase:          &phase,
+		CreatedAt:      testNow.Add(-time.Hour),
+		LastActivityAt: testNow.Add(-idleFor),
+	}
+}
+
+func TestDecide(t *testing.T) {
+	policy := Policy{MinWorkers: 1, MaxWorkers: 4, MaxCostUSD: 10, IdleTimeout: 5 * time.Minute}
+
+	tests := []struct {
+		name       string
+		readyTasks int
+		workers    []*repository.Process
+		cost       float64
+		overBudget bool
+		action     Action
+		spawn      int
+		retire     []string
+		reason     string
+	}{
+		{
+			name:   "spawns up to the minimum",
+			action: ActionScaleUp, spawn: 1, reason: "below minimum of 1",
+		},
+		{
+			name:       "spawns for backlog beyond idle workers",
+			readyTasks: 3,
+			workers:    []*repository.Process{worker("worker-1", repository.StatusReady, 0)},
+			action:     ActionScaleUp, spawn: 2,
+		},
+		{
+			name:       "starting workers count as capacity",
+			readyTasks: 2,
+			workers: []*repository.Process{
+				worker("worker-1", repository.StatusReady, 0),
+				worker("worker-2", repository.StatusStarting, 0),
+			},
+			action: ActionNone,
+		},
+		{
+			name:       "capped at maximum",
+			readyTasks: 10,
+			workers: []*repository.Process{
+				worker("worker-1", repository.StatusWorking, 0),
+				worker("worker-2", repository.StatusWorking, 0),
+			},
+			action: ActionScaleUp, spawn: 2,
+		},
+		{
+			name:       "at maximum",
+			readyTasks: 1,
+			workers: []*repository.Process{
+				worker("worker-1", repository.StatusWorking, 0),
+				worker("worker-2", repository.StatusWorking, 0),
+				worker("worker-3", repository.StatusWorking, 0),
+				worker("worker-4", repository.StatusWorking, 0),
+			},
+			action: ActionNone, reason: "at maximum of 4",
+		},
+		{
+			name:       "budget blocks scale up",
+			readyTasks: 2,
+			workers:    []*repository.Process{worker("worker-1", repository.StatusWorking, 0)},
+			cost:       10,
+			overBudget: true,
+			action:     ActionNone, reason: "cost budget reached ($10.00 of $10.00)",
+		},
+		{
+			name: "retires longest idle surplus workers above the minimum",
+			workers: []*repository.Process{
+				worker("worker-1", repository.StatusReady, 10*time.Minute),
+				worker("worker-2", repository.StatusReady, 30*time.Minute),
+				worker("worker-3", repository.StatusReady, 20*time.Minute),
+			},
+			action: ActionScaleDown, retire: []string{"worker-2", "worker-3"}, reason: "idle for over 5m0s",
+		},
+		{
+			name: "recently idle workers are kept",
+			workers: []*repository.Process{
+				worker("worker-1", repository.StatusReady, time.Minute),
+				worker("worker-2", repository.StatusReady, time.Minute),
+			},
+			action: ActionNone,
+		},
+		{
+			name:       "idle workers cover ready tasks",
+			readyTasks: 2,
+			workers: []*repository.Process{
+				worker("worker-1", repository.StatusReady, time.Hour),
+				worker("worker-2", repository.StatusReady, time.Hour),
+			},
+			action: ActionNone,
+		},
+		{
+			name:       "paused workflows are left alone",
+			readyTasks: 5,
+			workers:    []*repository.Process{worker("worker-1", repository.StatusPaused, 0)},
+			action:     ActionNone, reason: "workflow is paused",
+		},
+		{
+			name: "terminal workers are ignored",
+			workers: []*repository.Process{
+				worker("worker-1", repository.StatusRetired, time.Hour),
+				worker("worker-2", repository.StatusReady, time.Hour),
+			},
+			action: ActionNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := Decide(policy, tt.readyTasks, tt.workers, tt.cost, testNow)
+			require.Equal(t, tt.action, d.Action)
+			require.Equal(t, tt.spawn, d.Spawn)
+			require.Equal(t, tt.retire, d.Retire)
+			require.Equal(t, tt.reason, d.Reason)
+			require.Equal(t, tt.overBudget, d.OverBudget)
+		})
+	}
+}
+
+func TestCountReadyTasks(t *testing.T) {
+	busy := worker("worker-1", repository.StatusWorking, 0)
+	busy.TaskID = "perles-a1"
+
+	issues := []beads.Issue{
+		{ID: "perles-a1", Type: beads.TypeTask},                  // being worked on
+		{ID: "perles-a2", Type: beads.TypeTask, Assignee: "bob"}, // claimed in bd
+		{ID: "perles-a3", Type: beads.TypeEpic},
+		{ID: "perles-a4", Type: beads.TypeTask},
+		{ID: "perles-a5", Type: beads.TypeBug},
+	}
+	require.Equal(t, 2, countReadyTasks(issues, []*repository.Process{busy}))
+}
+
+func TestDecision_Summary(t *testing.T) {
+	d := Decision{Action: ActionScaleUp, Spawn: 2, ReadyTasks: 5, IdleWorkers: 1, ActiveWorkers: 3}
+	require.Equal(t, "spawning 2 workers (5 ready tasks, 1 idle of 3 workers)", d.Summary())
+
+	d = Decision{Action: ActionScaleDown, Retire: []string{"worker-2"}, ActiveWorkers: 1, Reason: "idle for over 5m0s"}
+	require.Equal(t, "retiring worker-2 (0 ready tasks, 0 idle of 1 worker): idle for over 5m0s", d.Summary())
+}
+
+func TestTotalCostUSD(t *testing.T) {
+	procs := []*repository.Process{
+		{ID: "coordinator", Metrics: &metrics.TokenMetrics{CumulativeCostUSD: 1.5}},
+		{ID: "worker-1", Metrics: &metrics.TokenMetrics{CumulativeCostUSD: 0.25}},
+		{ID: "worker-2"},
+	}
+	require.InDelta(t, 1.75, TotalCostUSD(procs), 1e-9)
+}
@@ -0,0 +1,205 @@
+package autoscale
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+// readyIssueLimit caps how many ready issues are fetched per tick.
+const readyIssueLimit = 200
+
+// retireReason is recorded on workers retired by the autoscaler.
+const retireReason = "autoscaler: idle with no ready tasks"
+
+// Config holds configuration for creating an Autoscaler.
+type Config struct {
+	// Policy bounds scaling decisions.
+	Policy Policy
+
+	// Tasks lists the bd ready queue.
+	// Required.
+	Tasks appbeads.ReadyLister
+
+	// Processes provides the worker pool state.
+	// Required.
+	Processes repository.ProcessRepository
+
+	// CmdSubmitter is used to submit spawn and retire commands.
+	// Required.
+	CmdSubmitter process.CommandSubmitter
+
+	// EventBus receives every scaling action as a Decision payload.
+	// Optional - if nil, decisions are only logged.
+	EventBus *pubsub.Broker[any]
+
+	// Now returns the current time. Defaults to time.Now if nil.
+	Now func() time.Time
+}
+
+// Autoscaler periodically resizes a workflow's worker pool.
+type Autoscaler struct {
+	policy    Policy
+	tasks     appbeads.ReadyLister
+	processes repository.ProcessRepository
+	submitter process.CommandSubmitter
+	eventBus  *pubsub.Broker[any]
+	now       func() time.Time
+
+	mu           sync.Mutex
+	lastAction   time.Time
+	budgetWarned bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewAutoscaler creates an Autoscaler. Call Start to begin evaluating.
+func NewAutoscaler(cfg Config) *Autoscaler {
+	policy := cfg.Policy
+	if policy.Interval == 0 {
+		policy.Interval = DefaultInterval
+	}
+	if policy.IdleTimeout == 0 {
+		policy.IdleTimeout = DefaultIdleTimeout
+	}
+	if policy.Cooldown == 0 {
+		policy.Cooldown = DefaultCooldown
+	}
+
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Autoscaler{
+		policy:    policy,
+		tasks:     cfg.Tasks,
+		processes: cfg.Processes,
+		submitter: cfg.CmdSubmitter,
+		eventBus:  cfg.EventBus,
+		now:       now,
+	}
+}
+
+// Start begins the evaluation loop. It stops when ctx is cancelled or Stop is called.
+// Safe to call only once.
+func (a *Autoscaler) Start(ctx context.Context) {
+	ctx, a.cancel = context.WithCancel(ctx)
+	a.done = make(chan struct{})
+
+	log.SafeGo("autoscaler.loop", func() {
+		defer close(a.done)
+
+		ticker := time.NewTicker(a.policy.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.Evaluate()
+			}
+		}
+	})
+}
+
+// Stop terminates the evaluation loop and waits for it to exit.
+// Safe to call multiple times or before Start.
+func (a *Autoscaler) Stop() {
+	if a.cancel == nil {
+		return
+	}
+	a.cancel()
+	<-a.done
+}
+
+// Evaluate runs one scaling pass and applies the resulting decision.
+// Decisions are not applied during the cooldown after a previous action.
+func (a *Autoscaler) Evaluate() Decision {
+	now := a.now()
+
+	// Workers are only useful while the coordinator is running
+	coord, err := a.processes.GetCoordinator()
+	if err != nil || coord.Status.IsTerminal() {
+		return Decision{Action: ActionNone, Reason: "coordinator not running", Timestamp: now}
+	}
+	if coord.Status == repository.StatusPaused {
+		return Decision{Action: ActionNone, Reason: "workflow is paused", Timestamp: now}
+	}
+
+	issues, err := a.tasks.ReadyIssues(readyIssueLimit)
+	if err != nil {
+		log.Debug(log.CatOrch, "Autoscaler failed to list ready tasks", "subsystem", "autoscaler", "error", err)
+		return Decision{Action: ActionNone, Reason: "ready tasks unavailable", Timestamp: now}
+	}
+
+	workers := a.processes.Workers()
+	d := Decide(a.policy, countReadyTasks(issues, workers), workers, TotalCostUSD(a.processes.List()), now)
+	if d.Action == ActionNone {
+		// Report the budget guardrail once, the first time it blocks a scale-up
+		if d.OverBudget && a.markBudgetWarned() {
+			a.publish(d)
+		}
+		return d
+	}
+
+	a.mu.Lock()
+	if !a.lastAction.IsZero() && now.Sub(a.lastAction) < a.policy.Cooldown {
+		a.mu.Unlock()
+		d.Action, d.Spawn, d.Retire = ActionNone, 0, nil
+		d.Reason = "cooling down"
+		return d
+	}
+	a.lastAction = now
+	a.mu.Unlock()
+
+	a.apply(d)
+	return d
+}
+
+// apply submits the commands for a decision and publishes it.
+func (a *Autoscaler) apply(d Decision) {
+	switch d.Action {
+	case ActionScaleUp:
+		for range d.Spawn {
+			a.submitter.Submit(command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker))
+		}
+	case ActionScaleDown:
+		for _, id := range d.Retire {
+			a.submitter.Submit(command.NewRetireProcessCommand(command.SourceInternal, id, retireReason))
+		}
+	}
+
+	a.publish(d)
+}
+
+// publish logs a decision and sends it to the event bus.
+func (a *Autoscaler) publish(d Decision) {
+	log.Info(log.CatOrch, "Autoscaler "+d.Summary(), "subsystem", "autoscaler",
+		"action", d.Action, "readyTasks", d.ReadyTasks, "idleWorkers", d.IdleWorkers,
+		"activeWorkers", d.ActiveWorkers, "costUSD", d.CostUSD)
+
+	if a.eventBus != nil {
+		a.eventBus.Publish(pubsub.UpdatedEvent, d)
+	}
+}
+
+// markBudgetWarned records that the budget guardrail was reported.
+// Returns false if it had already been reported.
+func (a *Autoscaler) markBudgetWarned() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.budgetWarned {
+		return false
+	}
+	a.budgetWarned = true
+	return true
+}
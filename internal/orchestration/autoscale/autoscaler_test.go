@@ -0,0 +1,173 @@
+package autoscale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/pubsub"
+)
+
+type fakeReadyLister struct {
+	issues []beads.Issue
+	err    error
+}
+
+func (f *fakeReadyLister) ReadyIssues(int) ([]beads.Issue, error) {
+	return f.issues, f.err
+}
+
+type recordingSubmitter struct {
+	cmds []command.Command
+}
+
+func (r *recordingSubmitter) Submit(cmd command.Command) {
+	r.cmds = append(r.cmds, cmd)
+}
+
+func newTestProcesses(t *testing.T, status repository.ProcessStatus) *repository.MemoryProcessRepository {
+	t.Helper()
+	procs := repository.NewMemoryProcessRepository()
+	require.NoError(t, procs.Save(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: status}))
+	return procs
+}
+
+func newTestAutoscaler(tasks *fakeReadyLister, procs *repository.MemoryProcessRepository, now *time.Time) (*Autoscaler, *recordingSubmitter, *pubsub.Broker[any]) {
+	submitter := &recordingSubmitter{}
+	bus := pubsub.NewBroker[any]()
+	a := NewAutoscaler(Config{
+		Policy:       Policy{MinWorkers: 0, MaxWorkers: 3, Cooldown: time.Minute},
+		Tasks:        tasks,
+		Processes:    procs,
+		CmdSubmitter: submitter,
+		EventBus:     bus,
+		Now:          func() time.Time { return *now },
+	})
+	return a, submitter, bus
+}
+
+func TestAutoscaler_EvaluateSpawnsAndPublishes(t *testing.T) {
+	now := testNow
+	procs := newTestProcesses(t, repository.StatusReady)
+	require.NoError(t, procs.Save(worker("worker-1", repository.StatusWorking, 0)))
+	tasks := &fakeReadyLister{issues: []beads.Issue{{ID: "perles-a1"}, {ID: "perles-a2"}}}
+
+	a, submitter, bus := newTestAutoscaler(tasks, procs, &now)
+	sub := bus.Subscribe(context.Background())
+
+	d := a.Evaluate()
+	require.Equal(t, ActionScaleUp, d.Action)
+	require.Equal(t, 2, d.Spawn)
+	require.Len(t, submitter.cmds, 2)
+	spawn, ok := submitter.cmds[0].(*command.SpawnProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, repository.RoleWorker, spawn.Role)
+	require.Equal(t, command.SourceInternal, spawn.Source())
+
+	select {
+	case ev := <-sub:
+		published, ok := ev.Payload.(Decision)
+		require.True(t, ok)
+		require.Equal(t, d.Summary(), published.Summary())
+	case <-time.After(time.Second):
+		t.Fatal("expected decision to be published")
+	}
+}
+
+func TestAutoscaler_EvaluateRespectsCooldown(t *testing.T) {
+	now := testNow
+	procs := newTestProcesses(t, repository.StatusReady)
+	tasks := &fakeReadyLister{issues: []beads.Issue{{ID: "perles-a1"}}}
+
+	a, submitter, _ := newTestAutoscaler(tasks, procs, &now)
+	require.Equal(t, ActionScaleUp, a.Evaluate().Action)
+
+	// Spawned workers aren't in the repository yet, so demand persists
+	now = now.Add(30 * time.Second)
+	d := a.Evaluate()
+	require.Equal(t, ActionNone, d.Action)
+	require.Equal(t, "cooling down", d.Reason)
+	require.Len(t, submitter.cmds, 1)
+
+	now = now.Add(time.Minute)
+	require.Equal(t, ActionScaleUp, a.Evaluate().Action)
+	require.Len(t, submitter.cmds, 2)
+}
+
+func TestAutoscaler_EvaluateRetiresIdleWorkers(t *testing.T) {
+	now := testNow
+	procs := newTestProcesses(t, repository.StatusReady)
+	require.NoError(t, procs.Save(worker("worker-1", repository.StatusReady, time.Hour)))
+
+	a, submitter, _ := newTestAutoscaler(&fakeReadyLister{}, procs, &now)
+	d := a.Evaluate()
+	require.Equal(t, ActionScaleDown, d.Action)
+	require.Len(t, submitter.cmds, 1)
+	retire, ok := submitter.cmds[0].(*command.RetireProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, "worker-1", retire.ProcessID)
+}
+
+func TestAutoscaler_EvaluateSkipsWhenTasksUnavailable(t *testing.T) {
+	now := testNow
+	a, submitter, _ := newTestAutoscaler(&fakeReadyLister{err: errors.New("bd: no database")}, newTestProcesses(t, repository.StatusReady), &now)
+
+	d := a.Evaluate()
+	require.Equal(t, ActionNone, d.Action)
+	require.Equal(t, "ready tasks unavailable", d.Reason)
+	require.Empty(t, submitter.cmds)
+}
+
+func TestAutoscaler_EvaluateSkipsWithoutRunningCoordinator(t *testing.T) {
+	now := testNow
+	tasks := &fakeReadyLister{issues: []beads.Issue{{ID: "perles-a1"}}}
+
+	a, submitter, _ := newTestAutoscaler(tasks, repository.NewMemoryProcessRepository(), &now)
+	require.Equal(t, "coordinator not running", a.Evaluate().Reason)
+
+	a, _, _ = newTestAutoscaler(tasks, newTestProcesses(t, repository.StatusPaused), &now)
+	require.Equal(t, "workflow is paused", a.Evaluate().Reason)
+	require.Empty(t, submitter.cmds)
+}
+
+func TestAutoscaler_EvaluatePublishesBudgetWarningOnce(t *testing.T) {
+	now := testNow
+	procs := newTestProcesses(t, repository.StatusReady)
+	w := worker("worker-1", repository.StatusWorking, 0)
+	w.Metrics = &metrics.TokenMetrics{CumulativeCostUSD: 5}
+	require.NoError(t, procs.Save(w))
+	tasks := &fakeReadyLister{issues: []beads.Issue{{ID: "perles-a1"}}}
+
+	a, submitter, bus := newTestAutoscaler(tasks, procs, &now)
+	a.policy.MaxCostUSD = 5
+	sub := bus.Subscribe(context.Background())
+
+	require.True(t, a.Evaluate().OverBudget)
+	require.True(t, a.Evaluate().OverBudget)
+	require.Empty(t, submitter.cmds)
+
+	ev := <-sub
+	require.True(t, ev.Payload.(Decision).OverBudget)
+	select {
+	case <-sub:
+		t.Fatal("budget warning should only be published once")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestAutoscaler_StartStop(t *testing.T) {
+	now := testNow
+	a, _, _ := newTestAutoscaler(&fakeReadyLister{}, newTestProcesses(t, repository.StatusReady), &now)
+
+	a.Stop() // before Start is a no-op
+	a.Start(context.Background())
+	a.Stop()
+	a.Stop()
+}
@@ -3,6 +3,7 @@ package client
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -368,6 +369,22 @@ func (bp *BaseProcess) parseOutput() {
 
 		log.Debug(log.CatOrch, "raw client response", "subsystem", bp.providerName, "json", string(line))
 
+		// Structured telemetry lines bypass the provider parser.
+		if tel, ok := ParseTelemetry(line); ok {
+			event := OutputEvent{
+				Type:      EventTelemetry,
+				Telemetry: tel,
+				Raw:       bytes.Clone(line),
+				Timestamp: time.Now(),
+			}
+			select {
+			case bp.events <- event:
+			case <-bp.ctx.Done():
+				return
+			}
+			continue
+		}
+
 		event, err := bp.parseEventFn(line)
 		if err != nil {
 			log.Debug(log.CatOrch, "parse error", "subsystem", bp.providerName, "error", err, "line", string(line))
@@ -812,6 +812,42 @@ func TestBaseProcess_parseOutput_CallsParseEventFn(t *testing.T) {
 	bp.wg.Wait()
 }
 
+func TestBaseProcess_parseOutput_TelemetryBypassesParseEventFn(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lines := `{"perles_event":"test_run","status":"passed","passed":3}` + "\n" +
+		`{"type":"assistant","message":"hello"}` + "\n"
+	stdout := newMockReadCloser(lines)
+	stderr := newMockReadCloser("")
+
+	eventsParsed := 0
+	parseFunc := func(line []byte) (OutputEvent, error) {
+		eventsParsed++
+		return OutputEvent{Type: EventAssistant}, nil
+	}
+
+	cmd := exec.Command("echo", "test")
+	bp := NewBaseProcess(ctx, cancel, cmd, stdout, stderr, "/tmp",
+		WithProviderName("test"),
+		WithParseEventFunc(parseFunc))
+
+	bp.wg.Add(1)
+	go bp.parseOutput()
+
+	event := <-bp.Events()
+	require.True(t, event.IsTelemetry())
+	require.Equal(t, TelemetryTestRun, event.Telemetry.Type)
+	require.Equal(t, 3, event.Telemetry.Passed)
+	require.NotEmpty(t, event.Raw)
+
+	event = <-bp.Events()
+	require.Equal(t, EventAssistant, event.Type)
+	require.Equal(t, 1, eventsParsed)
+
+	bp.wg.Wait()
+}
+
 func TestBaseProcess_parseOutput_CallsExtractSessionFnForEveryEvent(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -24,6 +24,8 @@ const (
 	EventResult EventType = "result"
 	// EventError is an error event.
 	EventError EventType = "error"
+	// EventTelemetry is a structured telemetry event (see TelemetryEvent).
+	EventTelemetry EventType = "telemetry"
 )
 
 // OutputEvent represents a parsed event from the headless process output.
@@ -63,6 +65,9 @@ type OutputEvent struct {
 	IsErrorResult bool    `json:"is_error,omitempty"`
 	Result        string  `json:"result,omitempty"`
 
+	// Telemetry is set for EventTelemetry events.
+	Telemetry *TelemetryEvent `json:"telemetry,omitempty"`
+
 	// Raw payload for debugging
 	Raw json.RawMessage `json:"-"`
 }
@@ -92,6 +97,11 @@ func (e *OutputEvent) IsResult() bool {
 	return e.Type == EventResult
 }
 
+// IsTelemetry returns true if this is a structured telemetry event.
+func (e *OutputEvent) IsTelemetry() bool {
+	return e.Type == EventTelemetry && e.Telemetry != nil
+}
+
 // IsError returns true if this is an error event.
 // This includes explicit error events and result events with is_error=true.
 func (e *OutputEvent) IsError() bool {
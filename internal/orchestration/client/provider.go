@@ -1,6 +1,8 @@
 package client
 
 import (
+	"slices"
+	"strings"
 	"sync"
 )
 
@@ -45,6 +47,38 @@ func (p AgentProviders) Observer() AgentProvider {
 	return p.Worker()
 }
 
+// WorkerBackendRole returns the role key for workers spawned with a non-default backend.
+// For example, WorkerBackendRole(ClientCodex) is "WORKER:codex".
+func WorkerBackendRole(t ClientType) AgentProviderRole {
+	return AgentProviderRole(string(RoleWorker) + ":" + string(t))
+}
+
+// WorkerBackend returns the provider for workers spawned with the given backend.
+// An empty backend, or the default worker's own type, selects Worker().
+// Returns false if the backend is not configured.
+func (p AgentProviders) WorkerBackend(t ClientType) (AgentProvider, bool) {
+	worker := p.Worker()
+	if t == "" || t == worker.Type() {
+		return worker, true
+	}
+	provider, ok := p[WorkerBackendRole(t)]
+	return provider, ok
+}
+
+// WorkerBackends returns the backends workers can be spawned with,
+// the default worker backend first and the rest sorted by name.
+func (p AgentProviders) WorkerBackends() []ClientType {
+	defaultType := p.Worker().Type()
+	var extra []ClientType
+	for role, provider := range p {
+		if strings.HasPrefix(string(role), string(RoleWorker)+":") && provider.Type() != defaultType {
+			extra = append(extra, provider.Type())
+		}
+	}
+	slices.Sort(extra)
+	return append([]ClientType{defaultType}, extra...)
+}
+
 // AgentProvider creates and configures AI agent processes.
 // It combines the client factory with provider-specific configuration,
 // providing a single object that can be passed through the orchestration
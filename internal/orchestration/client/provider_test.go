@@ -105,3 +105,38 @@ func (m *mockHeadlessProcess) WorkDir() string            { return "/mock" }
 func (m *mockHeadlessProcess) PID() int                   { return 12345 }
 func (m *mockHeadlessProcess) Cancel() error              { return nil }
 func (m *mockHeadlessProcess) Wait() error                { return nil }
+
+func TestAgentProviders_WorkerBackend(t *testing.T) {
+	providers := AgentProviders{
+		RoleCoordinator:                NewAgentProvider(ClientClaude, nil),
+		WorkerBackendRole(ClientCodex): NewAgentProvider(ClientCodex, nil),
+		WorkerBackendRole(ClientAmp):   NewAgentProvider(ClientAmp, nil),
+	}
+
+	t.Run("empty backend selects default worker", func(t *testing.T) {
+		p, ok := providers.WorkerBackend("")
+		require.True(t, ok)
+		assert.Equal(t, ClientClaude, p.Type())
+	})
+
+	t.Run("default worker type selects default worker", func(t *testing.T) {
+		p, ok := providers.WorkerBackend(ClientClaude)
+		require.True(t, ok)
+		assert.Same(t, providers.Worker(), p)
+	})
+
+	t.Run("configured backend", func(t *testing.T) {
+		p, ok := providers.WorkerBackend(ClientCodex)
+		require.True(t, ok)
+		assert.Equal(t, ClientCodex, p.Type())
+	})
+
+	t.Run("unconfigured backend", func(t *testing.T) {
+		_, ok := providers.WorkerBackend(ClientGemini)
+		assert.False(t, ok)
+	})
+
+	t.Run("lists default first", func(t *testing.T) {
+		assert.Equal(t, []ClientType{ClientClaude, ClientAmp, ClientCodex}, providers.WorkerBackends())
+	})
+}
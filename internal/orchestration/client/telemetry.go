@@ -0,0 +1,101 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// TelemetryKey is the JSON field that marks a stdout line as a perles telemetry event.
+// Agent CLIs (or wrapper scripts) that support the protocol emit one JSON object per
+// line alongside their normal stream-json output, for example:
+//
+//	{"perles_event":"progress","message":"Running migrations","percent":40}
+//	{"perles_event":"file_edit","path":"internal/app.go","lines_added":12,"lines_removed":3}
+//	{"perles_event":"test_run","command":"go test ./...","status":"failed","passed":41,"failed":2}
+//
+// The protocol is optional. Lines without the key are handed to the provider's parser.
+const TelemetryKey = "perles_event"
+
+// telemetryMarker is a cheap pre-check so regular stream-json lines skip the extra unmarshal.
+var telemetryMarker = []byte(`"` + TelemetryKey + `"`)
+
+// TelemetryEventType identifies the kind of structured telemetry event.
+type TelemetryEventType string
+
+const (
+	// TelemetryProgress reports coarse progress on the current task.
+	TelemetryProgress TelemetryEventType = "progress"
+	// TelemetryFileEdit reports that the agent modified a file.
+	TelemetryFileEdit TelemetryEventType = "file_edit"
+	// TelemetryTestRun reports the outcome of a test run.
+	TelemetryTestRun TelemetryEventType = "test_run"
+)
+
+// Test run statuses reported in TelemetryEvent.Status.
+const (
+	TestRunStarted = "started"
+	TestRunPassed  = "passed"
+	TestRunFailed  = "failed"
+)
+
+// TelemetryEvent is a structured event emitted on stdout by agents that
+// support the perles telemetry protocol. Only the fields relevant to Type are set.
+type TelemetryEvent struct {
+	Type TelemetryEventType `json:"perles_event"`
+
+	// Progress fields
+	Message string `json:"message,omitempty"`
+	Percent *int   `json:"percent,omitempty"`
+
+	// File edit fields
+	Path         string `json:"path,omitempty"`
+	LinesAdded   int    `json:"lines_added,omitempty"`
+	LinesRemoved int    `json:"lines_removed,omitempty"`
+
+	// Test run fields
+	Command string `json:"command,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Passed  int    `json:"passed,omitempty"`
+	Failed  int    `json:"failed,omitempty"`
+	Skipped int    `json:"skipped,omitempty"`
+}
+
+// ParseTelemetry parses a stdout line as a telemetry event.
+// Returns false if the line is not a telemetry event or is malformed, so callers
+// can fall back to the provider's own parser.
+func ParseTelemetry(line []byte) (*TelemetryEvent, bool) {
+	if !bytes.Contains(line, telemetryMarker) {
+		return nil, false
+	}
+
+	var ev TelemetryEvent
+	if err := json.Unmarshal(line, &ev); err != nil {
+		return nil, false
+	}
+	if !ev.Valid() {
+		return nil, false
+	}
+	if ev.Percent != nil {
+		p := min(max(*ev.Percent, 0), 100)
+		ev.Percent = &p
+	}
+	return &ev, true
+}
+
+// Valid reports whether the event has a known type and its required fields.
+func (e *TelemetryEvent) Valid() bool {
+	switch e.Type {
+	case TelemetryProgress:
+		return e.Message != "" || e.Percent != nil
+	case TelemetryFileEdit:
+		return e.Path != ""
+	case TelemetryTestRun:
+		switch e.Status {
+		case TestRunStarted, TestRunPassed, TestRunFailed:
+			return true
+		}
+		return false
+	default:
+		return false
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTelemetry(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		ok   bool
+		want TelemetryEvent
+	}{
+		{
+			name: "progress",
+			line: `{"perles_event":"progress","message":"Running migrations","percent":40}`,
+			ok:   true,
+			want: TelemetryEvent{Type: TelemetryProgress, Message: "Running migrations", Percent: intPtr(40)},
+		},
+		{
+			name: "progress percent is clamped",
+			line: `{"perles_event":"progress","percent":140}`,
+			ok:   true,
+			want: TelemetryEvent{Type: TelemetryProgress, Percent: intPtr(100)},
+		},
+		{
+			name: "file edit",
+			line: `{"perles_event":"file_edit","path":"internal/app.go","lines_added":12,"lines_removed":3}`,
+			ok:   true,
+			want: TelemetryEvent{Type: TelemetryFileEdit, Path: "internal/app.go", LinesAdded: 12, LinesRemoved: 3},
+		},
+		{
+			name: "test run",
+			line: `{"perles_event":"test_run","command":"go test ./...","status":"failed","passed":41,"failed":2}`,
+			ok:   true,
+			want: TelemetryEvent{Type: TelemetryTestRun, Command: "go test ./...", Status: TestRunFailed, Passed: 41, Failed: 2},
+		},
+		{name: "stream-json line", line: `{"type":"assistant","message":{"content":[]}}`},
+		{name: "unknown event type", line: `{"perles_event":"deploy"}`},
+		{name: "progress without content", line: `{"perles_event":"progress"}`},
+		{name: "file edit without path", line: `{"perles_event":"file_edit","lines_added":1}`},
+		{name: "test run with bad status", line: `{"perles_event":"test_run","status":"maybe"}`},
+		{name: "malformed json", line: `{"perles_event":"progress",`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseTelemetry([]byte(tt.line))
+			require.Equal(t, tt.ok, ok)
+			if !tt.ok {
+				require.Nil(t, got)
+				return
+			}
+			require.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+func intPtr(v int) *int { return &v }
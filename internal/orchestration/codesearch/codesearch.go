@@ -0,0 +1,493 @@
+// Package codesearch implements the code search behind the search_codebase
+// worker tool, so agents search a workflow's code the same way instead of
+// each shelling out with its own grep flags.
+//
+// Text searches run ripgrep. Symbol searches use an index built with
+// Universal Ctags when it is installed, rebuilt once it is older than
+// IndexTTL; without ctags they fall back to searching for common definition
+// keywords (func, type, class, def, ...) with ripgrep.
+package codesearch
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Search limits.
+const (
+	// DefaultLimit is the page size when a query doesn't set one.
+	DefaultLimit = 20
+	// MaxLimit caps the page size.
+	MaxLimit = 100
+	// MaxContextLines caps the context lines shown around each hit.
+	MaxContextLines = 10
+	// MaxMatches caps how many matches a search collects before it stops.
+	MaxMatches = 1000
+	// IndexTTL is how long a symbol index is reused before it is rebuilt.
+	IndexTTL = 2 * time.Minute
+)
+
+// maxLineLength truncates very long lines such as minified files.
+const maxLineLength = 300
+
+// Mode selects what a query searches.
+type Mode string
+
+const (
+	// ModeText searches file contents.
+	ModeText Mode = "text"
+	// ModeSymbol searches symbol definitions by name.
+	ModeSymbol Mode = "symbol"
+)
+
+// Search backends reported in Result.Backend.
+const (
+	BackendRipgrep = "ripgrep"
+	BackendCtags   = "ctags"
+)
+
+// ErrRipgrepNotFound is returned when a search needs ripgrep and it isn't installed.
+var ErrRipgrepNotFound = errors.New("ripgrep (rg) is not installed")
+
+// Query describes a search.
+type Query struct {
+	Pattern string
+	Mode    Mode // Default: ModeText
+	// Regex treats Pattern as a regular expression (text mode only).
+	// Patterns are literal by default.
+	Regex bool
+	// Glob restricts the search to matching files, e.g. "*.go" or "internal/**".
+	Glob string
+	// ContextLines is the number of lines shown before and after each hit.
+	ContextLines int
+	Offset       int
+	Limit        int // Default: DefaultLimit
+}
+
+// Hit is a single match.
+type Hit struct {
+	File   string   `json:"file"`
+	Line   int      `json:"line"`
+	Text   string   `json:"text"`
+	Symbol string   `json:"symbol,omitempty"`
+	Kind   string   `json:"kind,omitempty"` // Symbol kind, e.g. "function"
+	Before []string `json:"before,omitempty"`
+	After  []string `json:"after,omitempty"`
+}
+
+// Result is one page of search results.
+type Result struct {
+	Mode    Mode   `json:"mode"`
+	Backend string `json:"backend"`
+	Hits    []Hit  `json:"hits"`
+	Total   int    `json:"total"`
+	Offset  int    `json:"offset"`
+	// NextOffset is the offset of the next page, or 0 if this is the last one.
+	NextOffset int `json:"next_offset,omitempty"`
+	// Truncated is set when the search stopped at MaxMatches.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Summary returns a one-line description of the result.
+func (r Result) Summary() string {
+	if r.Total == 0 {
+		return "No matches"
+	}
+	total := fmt.Sprintf("%d", r.Total)
+	if r.Truncated {
+		total += "+"
+	}
+	s := fmt.Sprintf("Showing %d-%d of %s matches", r.Offset+1, r.Offset+len(r.Hits), total)
+	if r.NextOffset > 0 {
+		s += fmt.Sprintf(" (next page: offset=%d)", r.NextOffset)
+	}
+	return s
+}
+
+// symbol is a symbol index entry.
+type symbol struct {
+	name string
+	kind string
+	file string
+	line int
+}
+
+// Searcher searches the code under a root directory. Safe for concurrent use.
+type Searcher struct {
+	root     string
+	lookPath func(string) (string, error)
+	now      func() time.Time
+
+	mu        sync.Mutex
+	symbols   []symbol
+	indexedAt time.Time
+}
+
+// New creates a searcher for the code under root.
+func New(root string) *Searcher {
+	return &Searcher{root: root, lookPath: exec.LookPath, now: time.Now}
+}
+
+// Search runs a query and returns the requested page of results.
+func (s *Searcher) Search(ctx context.Context, q Query) (Result, error) {
+	if strings.TrimSpace(q.Pattern) == "" {
+		return Result{}, fmt.Errorf("pattern is required")
+	}
+	if q.Mode == "" {
+		q.Mode = ModeText
+	}
+	if q.Limit <= 0 {
+		q.Limit = DefaultLimit
+	}
+	q.Limit = min(q.Limit, MaxLimit)
+	q.Offset = max(q.Offset, 0)
+	q.ContextLines = min(max(q.ContextLines, 0), MaxContextLines)
+
+	var (
+		hits      []Hit
+		backend   string
+		truncated bool
+		err       error
+	)
+	switch q.Mode {
+	case ModeText:
+		backend = BackendRipgrep
+		hits, truncated, err = s.ripgrep(ctx, q.Pattern, !q.Regex, q.Glob, q.ContextLines)
+	case ModeSymbol:
+		hits, backend, truncated, err = s.searchSymbols(ctx, q)
+	default:
+		return Result{}, fmt.Errorf("unknown mode %q: use %q or %q", q.Mode, ModeText, ModeSymbol)
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{Mode: q.Mode, Backend: backend, Total: len(hits), Offset: q.Offset, Truncated: truncated}
+	end := min(q.Offset+q.Limit, len(hits))
+	if q.Offset < end {
+		result.Hits = hits[q.Offset:end]
+	}
+	if end < len(hits) {
+		result.NextOffset = end
+	}
+	if q.Mode == ModeSymbol && backend == BackendCtags {
+		s.addLines(result.Hits, q.ContextLines)
+	}
+	return result, nil
+}
+
+// ============================================================================
+// Text search (ripgrep)
+// ============================================================================
+
+// rgEvent is a line of `rg --json` output.
+type rgEvent struct {
+	Type string `json:"type"`
+	Data struct {
+		Path       rgText `json:"path"`
+		Lines      rgText `json:"lines"`
+		LineNumber int    `json:"line_number"`
+	} `json:"data"`
+}
+
+// rgText is ripgrep's representation of a path or line.
+type rgText struct {
+	Text string `json:"text"`
+}
+
+// ripgrep runs rg and collects up to MaxMatches hits with their context.
+func (s *Searcher) ripgrep(ctx context.Context, pattern string, literal bool, glob string, contextLines int) ([]Hit, bool, error) {
+	rg, err := s.lookPath("rg")
+	if err != nil {
+		return nil, false, ErrRipgrepNotFound
+	}
+
+	args := []string{"--json", "--smart-case", "--line-number", "--max-columns", fmt.Sprint(maxLineLength), "--max-columns-preview"}
+	if literal {
+		args = append(args, "--fixed-strings")
+	}
+	if contextLines > 0 {
+		args = append(args, "--context", fmt.Sprint(contextLines))
+	}
+	if glob != "" {
+		args = append(args, "--glob", glob)
+	}
+	args = append(args, "--regexp", pattern, ".")
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, rg, args...) //nolint:gosec // arguments are passed without a shell
+	cmd.Dir = s.root
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, false, fmt.Errorf("running rg: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, false, fmt.Errorf("running rg: %w", err)
+	}
+
+	hits, truncated := parseRipgrep(stdout, contextLines)
+	if truncated {
+		cancel()
+	}
+	err = cmd.Wait()
+
+	// Exit status 1 means no matches; 2 means an error, possibly after some matches
+	var exitErr *exec.ExitError
+	switch {
+	case truncated, err == nil:
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == 1:
+	case len(hits) > 0:
+	default:
+		return nil, false, fmt.Errorf("rg failed: %s", cmp.Or(strings.TrimSpace(stderr.String()), err.Error()))
+	}
+	return hits, truncated, nil
+}
+
+// parseRipgrep reads `rg --json` output and attaches context lines to the
+// matches they surround. Stops after MaxMatches matches.
+func parseRipgrep(r io.Reader, contextLines int) ([]Hit, bool) {
+	type line struct {
+		number int
+		text   string
+	}
+	var (
+		hits    []Hit
+		file    string
+		pending []line // Context lines since the last match in this file
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var ev rgEvent
+		if json.Unmarshal(scanner.Bytes(), &ev) != nil {
+			continue
+		}
+		text := strings.TrimRight(ev.Data.Lines.Text, "\r\n")
+		switch ev.Type {
+		case "begin":
+			file = cleanPath(ev.Data.Path.Text)
+			pending = nil
+		case "context":
+			if n := len(hits); n > 0 {
+				last := &hits[n-1]
+				if last.File == file && ev.Data.LineNumber > last.Line && ev.Data.LineNumber <= last.Line+contextLines {
+					last.After = append(last.After, text)
+				}
+			}
+			pending = append(pending, line{ev.Data.LineNumber, text})
+		case "match":
+			if len(hits) == MaxMatches {
+				return hits, true
+			}
+			hit := Hit{File: file, Line: ev.Data.LineNumber, Text: text}
+			for _, l := range pending {
+				if l.number >= hit.Line-contextLines {
+					hit.Before = append(hit.Before, l.text)
+				}
+			}
+			pending = nil
+			hits = append(hits, hit)
+		}
+	}
+	return hits, false
+}
+
+// cleanPath makes a path reported by rg or ctags relative to the search root.
+func cleanPath(path string) string {
+	return filepath.ToSlash(strings.TrimPrefix(filepath.Clean(path), "./"))
+}
+
+// ============================================================================
+// Symbol search
+// ============================================================================
+
+// definitionKeywords introduce definitions in common languages. Used to find
+// symbols with ripgrep when ctags isn't installed.
+const definitionKeywords = `func|type|class|struct|interface|enum|trait|def|fn|const|var|let|module`
+
+// searchSymbols finds symbols whose name contains the pattern, using the
+// ctags index if available.
+func (s *Searcher) searchSymbols(ctx context.Context, q Query) ([]Hit, string, bool, error) {
+	symbols, err := s.symbolIndex(ctx)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if symbols == nil {
+		// No ctags: match definitions like "func (r *Repo) Name" or "class Name"
+		re := `\b(` + definitionKeywords + `)\s+(\([^)]*\)\s*)?\w*` + regexp.QuoteMeta(q.Pattern) + `\w*`
+		hits, truncated, err := s.ripgrep(ctx, re, false, q.Glob, q.ContextLines)
+		return hits, BackendRipgrep, truncated, err
+	}
+
+	hits := matchSymbols(symbols, q.Pattern, q.Glob)
+	truncated := len(hits) > MaxMatches
+	if truncated {
+		hits = hits[:MaxMatches]
+	}
+	return hits, BackendCtags, truncated, nil
+}
+
+// matchSymbols returns the symbols whose name contains pattern, exact
+// matches first, then prefix matches, then the rest. Matching ignores case
+// unless the pattern contains an uppercase letter.
+func matchSymbols(symbols []symbol, pattern, glob string) []Hit {
+	fold := !strings.ContainsFunc(pattern, unicode.IsUpper)
+	if fold {
+		pattern = strings.ToLower(pattern)
+	}
+	rank := func(name string) int {
+		if fold {
+			name = strings.ToLower(name)
+		}
+		switch {
+		case name == pattern:
+			return 0
+		case strings.HasPrefix(name, pattern):
+			return 1
+		case strings.Contains(name, pattern):
+			return 2
+		}
+		return -1
+	}
+
+	type ranked struct {
+		hit  Hit
+		rank int
+	}
+	var matches []ranked
+	for _, sym := range symbols {
+		r := rank(sym.name)
+		if r < 0 || !matchGlob(glob, sym.file) {
+			continue
+		}
+		matches = append(matches, ranked{Hit{File: sym.file, Line: sym.line, Symbol: sym.name, Kind: sym.kind}, r})
+	}
+	slices.SortStableFunc(matches, func(a, b ranked) int {
+		return cmp.Or(cmp.Compare(a.rank, b.rank), cmp.Compare(a.hit.File, b.hit.File), cmp.Compare(a.hit.Line, b.hit.Line))
+	})
+
+	hits := make([]Hit, len(matches))
+	for i, m := range matches {
+		hits[i] = m.hit
+	}
+	return hits
+}
+
+// matchGlob reports whether a file matches a glob. A glob without a slash
+// matches the file name, like ripgrep's --glob.
+func matchGlob(glob, file string) bool {
+	if glob == "" {
+		return true
+	}
+	if !strings.Contains(glob, "/") {
+		file = filepath.Base(file)
+	}
+	if ok, _ := filepath.Match(glob, file); ok {
+		return true
+	}
+	// "dir/**" matches everything under dir
+	if prefix, ok := strings.CutSuffix(glob, "**"); ok {
+		return strings.HasPrefix(file, prefix)
+	}
+	return false
+}
+
+// symbolIndex returns the ctags symbol index, building it if it is missing
+// or older than IndexTTL. Returns nil if ctags isn't installed.
+func (s *Searcher) symbolIndex(ctx context.Context) ([]symbol, error) {
+	ctags, err := s.lookPath("ctags")
+	if err != nil {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.symbols != nil && s.now().Sub(s.indexedAt) < IndexTTL {
+		return s.symbols, nil
+	}
+
+	cmd := exec.CommandContext(ctx, ctags, "--recurse", "--output-format=json", "--fields=+nK", "-f", "-", ".") //nolint:gosec // fixed arguments
+	cmd.Dir = s.root
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("building symbol index with ctags: %w", err)
+	}
+	s.symbols = parseCtags(out)
+	s.indexedAt = s.now()
+	return s.symbols, nil
+}
+
+// ctagsTag is a line of Universal Ctags JSON output.
+type ctagsTag struct {
+	Type string `json:"_type"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Line int    `json:"line"`
+	Kind string `json:"kind"`
+}
+
+// parseCtags parses Universal Ctags JSON output into symbols.
+func parseCtags(out []byte) []symbol {
+	symbols := []symbol{}
+	for line := range strings.SplitSeq(string(out), "\n") {
+		var tag ctagsTag
+		if json.Unmarshal([]byte(line), &tag) != nil || tag.Type != "tag" || tag.Line == 0 {
+			continue
+		}
+		symbols = append(symbols, symbol{name: tag.Name, kind: tag.Kind, file: cleanPath(tag.Path), line: tag.Line})
+	}
+	return symbols
+}
+
+// addLines fills in the source line and context of symbol hits.
+func (s *Searcher) addLines(hits []Hit, contextLines int) {
+	files := make(map[string][]string)
+	for i := range hits {
+		hit := &hits[i]
+		lines, ok := files[hit.File]
+		if !ok {
+			data, err := os.ReadFile(filepath.Join(s.root, filepath.FromSlash(hit.File)))
+			if err == nil {
+				lines = strings.Split(string(data), "\n")
+			}
+			files[hit.File] = lines
+		}
+		idx := hit.Line - 1
+		if idx < 0 || idx >= len(lines) {
+			continue
+		}
+		hit.Text = truncateLine(lines[idx])
+		for _, l := range lines[max(idx-contextLines, 0):idx] {
+			hit.Before = append(hit.Before, truncateLine(l))
+		}
+		for _, l := range lines[idx+1 : min(idx+1+contextLines, len(lines))] {
+			hit.After = append(hit.After, truncateLine(l))
+		}
+	}
+}
+
+// truncateLine strips the line ending and caps the length of a source line.
+func truncateLine(line string) string {
+	line = strings.TrimRight(line, "\r")
+	if len(line) > maxLineLength {
+		return line[:maxLineLength] + " [...]"
+	}
+	return line
+}
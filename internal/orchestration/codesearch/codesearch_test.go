@@ -0,0 +1,165 @@
+package codesearch
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTree writes files under a temp dir and returns a searcher for it.
+func newTestTree(t *testing.T, files map[string]string) *Searcher {
+	t.Helper()
+	if _, err := exec.LookPath("rg"); err != nil {
+		t.Skip("ripgrep not installed")
+	}
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	s := New(root)
+	// Keep symbol tests independent of whether ctags is installed
+	s.lookPath = func(name string) (string, error) {
+		if name == "ctags" {
+			return "", exec.ErrNotFound
+		}
+		return exec.LookPath(name)
+	}
+	return s
+}
+
+func TestSearch_TextWithContext(t *testing.T) {
+	s := newTestTree(t, map[string]string{
+		"a.go": "package a\n\n// Save stores it.\nfunc Save() {}\n\nfunc load() {}\n",
+	})
+
+	res, err := s.Search(context.Background(), Query{Pattern: "func Save", ContextLines: 1})
+	require.NoError(t, err)
+	require.Equal(t, BackendRipgrep, res.Backend)
+	require.Equal(t, 1, res.Total)
+	require.Equal(t, Hit{File: "a.go", Line: 4, Text: "func Save() {}", Before: []string{"// Save stores it."}, After: []string{""}}, res.Hits[0])
+}
+
+func TestSearch_LiteralByDefault(t *testing.T) {
+	s := newTestTree(t, map[string]string{"a.txt": "a.b\naxb\n"})
+
+	res, err := s.Search(context.Background(), Query{Pattern: "a.b"})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Total)
+
+	res, err = s.Search(context.Background(), Query{Pattern: "a.b", Regex: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, res.Total)
+}
+
+func TestSearch_Pagination(t *testing.T) {
+	s := newTestTree(t, map[string]string{"a.txt": "x\nx\nx\nx\nx\n"})
+
+	res, err := s.Search(context.Background(), Query{Pattern: "x", Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 5, res.Total)
+	require.Len(t, res.Hits, 2)
+	require.Equal(t, 2, res.NextOffset)
+	require.Equal(t, "Showing 1-2 of 5 matches (next page: offset=2)", res.Summary())
+
+	res, err = s.Search(context.Background(), Query{Pattern: "x", Limit: 2, Offset: 4})
+	require.NoError(t, err)
+	require.Len(t, res.Hits, 1)
+	require.Zero(t, res.NextOffset)
+	require.Equal(t, 5, res.Hits[0].Line)
+}
+
+func TestSearch_GlobAndNoMatches(t *testing.T) {
+	s := newTestTree(t, map[string]string{"a.go": "needle\n", "b.md": "needle\n"})
+
+	res, err := s.Search(context.Background(), Query{Pattern: "needle", Glob: "*.md"})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Total)
+	require.Equal(t, "b.md", res.Hits[0].File)
+
+	res, err = s.Search(context.Background(), Query{Pattern: "haystack"})
+	require.NoError(t, err)
+	require.Zero(t, res.Total)
+	require.Equal(t, "No matches", res.Summary())
+}
+
+func TestSearch_SymbolFallsBackToDefinitions(t *testing.T) {
+	s := newTestTree(t, map[string]string{
+		"repo.go": "package a\n\nfunc (r *Repo) SaveIssue() {}\n\n// SaveIssue is called here\nvar x = SaveIssue\n",
+		"util.py": "def save_issue():\n    pass\n",
+	})
+
+	res, err := s.Search(context.Background(), Query{Pattern: "SaveIssue", Mode: ModeSymbol})
+	require.NoError(t, err)
+	require.Equal(t, BackendRipgrep, res.Backend)
+	require.Equal(t, 1, res.Total, "usages are not definitions")
+	require.Equal(t, "repo.go", res.Hits[0].File)
+	require.Equal(t, 3, res.Hits[0].Line)
+}
+
+func TestSearch_Validation(t *testing.T) {
+	s := New(t.TempDir())
+
+	_, err := s.Search(context.Background(), Query{Pattern: " "})
+	require.ErrorContains(t, err, "pattern is required")
+
+	_, err = s.Search(context.Background(), Query{Pattern: "x", Mode: "fuzzy"})
+	require.ErrorContains(t, err, "unknown mode")
+
+	s.lookPath = func(string) (string, error) { return "", exec.ErrNotFound }
+	_, err = s.Search(context.Background(), Query{Pattern: "x"})
+	require.ErrorIs(t, err, ErrRipgrepNotFound)
+}
+
+func TestMatchSymbols_RanksExactThenPrefix(t *testing.T) {
+	symbols := parseCtags([]byte(`{"_type":"tag","name":"SaveAll","path":"./b.go","line":3,"kind":"function"}
+{"_type":"tag","name":"autoSave","path":"a.go","line":9,"kind":"function"}
+{"_type":"ptag","name":"JSON_OUTPUT_VERSION"}
+{"_type":"tag","name":"Save","path":"c.go","line":1,"kind":"method"}
+{"_type":"tag","name":"Save","path":"docs/c.md","line":1,"kind":"section"}`))
+	require.Len(t, symbols, 4)
+
+	hits := matchSymbols(symbols, "save", "*.go")
+	require.Equal(t, []Hit{
+		{File: "c.go", Line: 1, Symbol: "Save", Kind: "method"},
+		{File: "b.go", Line: 3, Symbol: "SaveAll", Kind: "function"},
+		{File: "a.go", Line: 9, Symbol: "autoSave", Kind: "function"},
+	}, hits)
+
+	require.Empty(t, matchSymbols(symbols, "SAVE", ""), "uppercase matches case-sensitively")
+	require.Len(t, matchSymbols(symbols, "save", "docs/**"), 1)
+}
+
+func TestSymbolIndex_ReusedUntilStale(t *testing.T) {
+	root := t.TempDir()
+	script := filepath.Join(root, "ctags")
+	count := filepath.Join(root, "count")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho x >> "+count+"\necho '{\"_type\":\"tag\",\"name\":\"Save\",\"path\":\"a.go\",\"line\":2,\"kind\":\"func\"}'\n"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.go"), []byte("package a\nfunc Save() {}\n"), 0o644))
+
+	now := time.Now()
+	s := New(root)
+	s.lookPath = func(name string) (string, error) { return script, nil }
+	s.now = func() time.Time { return now }
+
+	res, err := s.Search(context.Background(), Query{Pattern: "Save", Mode: ModeSymbol})
+	require.NoError(t, err)
+	require.Equal(t, BackendCtags, res.Backend)
+	require.Equal(t, "func Save() {}", res.Hits[0].Text)
+
+	_, err = s.Search(context.Background(), Query{Pattern: "Save", Mode: ModeSymbol})
+	require.NoError(t, err)
+	now = now.Add(IndexTTL)
+	_, err = s.Search(context.Background(), Query{Pattern: "Save", Mode: ModeSymbol})
+	require.NoError(t, err)
+
+	runs, err := os.ReadFile(count)
+	require.NoError(t, err)
+	require.Equal(t, "x\nx\n", string(runs))
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// TokenCookie is the cookie that carries the API token for browser clients.
+// It is set when a request authenticates with the token query parameter, so the
+// web dashboard can be opened with ?token=... and keep working after navigation.
+const TokenCookie = "perles_token"
+
+// GenerateToken returns a random API token.
+func GenerateToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating API token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// RequireToken wraps next so requests must present token, as an
+// "Authorization: Bearer <token>" header, a token query parameter (for
+// EventSource clients, which cannot set headers) or the TokenCookie.
+// Health checks are not authenticated.
+func RequireToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/health") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && tokenEqual(bearer, token) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if c, err := r.Cookie(TokenCookie); err == nil && tokenEqual(c.Value, token) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if q := r.URL.Query().Get("token"); q != "" && tokenEqual(q, token) {
+			http.SetCookie(w, &http.Cookie{
+				Name:     TokenCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+			})
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("WWW-Authenticate", `Bearer realm="perles"`)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(ErrorResponse{Error: "Missing or invalid API token", Code: "unauthorized"})
+	})
+}
+
+func tokenEqual(got, want string) bool {
+	return subtle.ConstantTimeCompare([]byte(got), []byte(want)) == 1
+}
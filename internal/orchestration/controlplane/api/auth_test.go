@@ -0,0 +1,40 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireToken(t *testing.T) {
+	h := RequireToken("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w
+	}
+
+	require.Equal(t, http.StatusUnauthorized, serve(httptest.NewRequest(http.MethodGet, "/workflows", nil)).Code)
+	require.Equal(t, http.StatusOK, serve(httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)).Code, "health is not authenticated")
+
+	req := httptest.NewRequest(http.MethodGet, "/workflows", nil)
+	req.Header.Set("Authorization", "Bearer wrong")
+	require.Equal(t, http.StatusUnauthorized, serve(req).Code)
+	req.Header.Set("Authorization", "Bearer secret")
+	require.Equal(t, http.StatusOK, serve(req).Code)
+
+	// The query parameter sets a cookie for subsequent browser requests
+	w := serve(httptest.NewRequest(http.MethodGet, "/?token=secret", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, TokenCookie, cookies[0].Name)
+
+	req = httptest.NewRequest(http.MethodGet, "/workflows", nil)
+	req.AddCookie(cookies[0])
+	require.Equal(t, http.StatusOK, serve(req).Code)
+}
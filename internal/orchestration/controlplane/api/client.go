@@ -0,0 +1,240 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Client is a Go client for the control plane HTTP API, for remote CLIs and
+// other tools that control orchestration running in `perles serve`.
+type Client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// ClientConfig configures a Client.
+type ClientConfig struct {
+	// BaseURL is the API root, e.g. "http://localhost:19999/api/v1" (required).
+	BaseURL string
+	// Token is sent as a bearer token (optional - only for authenticated servers).
+	Token string
+	// HTTPClient is used for requests (optional - defaults to http.DefaultClient).
+	HTTPClient *http.Client
+}
+
+// NewClient creates an API client.
+func NewClient(cfg ClientConfig) *Client {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		baseURL: strings.TrimRight(cfg.BaseURL, "/"),
+		token:   cfg.Token,
+		http:    httpClient,
+	}
+}
+
+// APIError is returned for non-2xx responses.
+type APIError struct {
+	StatusCode int
+	ErrorResponse
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("api: %d %s", e.StatusCode, e.ErrorResponse.Error)
+	if e.Details != "" {
+		msg += ": " + e.Details
+	}
+	return msg
+}
+
+// ListWorkflows returns all workflows.
+func (c *Client) ListWorkflows(ctx context.Context) ([]WorkflowResponse, error) {
+	var resp ListWorkflowsResponse
+	if err := c.do(ctx, http.MethodGet, "/workflows", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Workflows, nil
+}
+
+// GetWorkflow returns a workflow.
+func (c *Client) GetWorkflow(ctx context.Context, id string) (*WorkflowResponse, error) {
+	var resp WorkflowResponse
+	if err := c.do(ctx, http.MethodGet, "/workflows/"+url.PathEscape(id), nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateWorkflow creates a pending workflow and returns its ID.
+func (c *Client) CreateWorkflow(ctx context.Context, req CreateWorkflowRequest) (string, error) {
+	var resp CreateWorkflowResponse
+	if err := c.do(ctx, http.MethodPost, "/workflows", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.ID, nil
+}
+
+// StartWorkflow starts a pending workflow.
+func (c *Client) StartWorkflow(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/start", nil, nil)
+}
+
+// PauseWorkflow pauses a running workflow.
+func (c *Client) PauseWorkflow(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/pause", nil, nil)
+}
+
+// ResumeWorkflow resumes a paused workflow.
+func (c *Client) ResumeWorkflow(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/resume", nil, nil)
+}
+
+// Processes returns the processes of a started workflow.
+func (c *Client) Processes(ctx context.Context, id string) ([]ProcessResponse, error) {
+	var resp ListProcessesResponse
+	if err := c.do(ctx, http.MethodGet, "/workflows/"+url.PathEscape(id)+"/processes", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Processes, nil
+}
+
+// Tasks returns the in-flight task assignments of a started workflow.
+func (c *Client) Tasks(ctx context.Context, id string) ([]TaskResponse, error) {
+	var resp ListTasksResponse
+	if err := c.do(ctx, http.MethodGet, "/workflows/"+url.PathEscape(id)+"/tasks", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Tasks, nil
+}
+
+// ToolCalls returns the MCP tool call counters of a started workflow.
+func (c *Client) ToolCalls(ctx context.Context, id string) ([]ToolCallResponse, error) {
+	var resp ListToolCallsResponse
+	if err := c.do(ctx, http.MethodGet, "/workflows/"+url.PathEscape(id)+"/tool-calls", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.ToolCalls, nil
+}
+
+// SubmitCommand submits a user command to a started workflow and returns its result.
+// A command rejected by its handler is returned as an *APIError.
+func (c *Client) SubmitCommand(ctx context.Context, id string, req CommandRequest) (*CommandResponse, error) {
+	var resp CommandResponse
+	if err := c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/commands", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Messages returns up to limit recent messages of a Fabric channel (0 = server default).
+func (c *Client) Messages(ctx context.Context, id, channel string, limit int) ([]MessageResponse, error) {
+	path := "/workflows/" + url.PathEscape(id) + "/fabric/channels/" + url.PathEscape(channel) + "/messages"
+	if limit > 0 {
+		path += "?limit=" + strconv.Itoa(limit)
+	}
+	var resp ListMessagesResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Messages, nil
+}
+
+// SendMessage posts a Fabric message (or thread reply) as the user.
+func (c *Client) SendMessage(ctx context.Context, id string, req SendMessageRequest) (*MessageResponse, error) {
+	var resp MessageResponse
+	if err := c.do(ctx, http.MethodPost, "/workflows/"+url.PathEscape(id)+"/fabric/messages", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// StopWorkflow stops a running or paused workflow and releases its resources.
+// With force, uncommitted worktree changes are discarded.
+func (c *Client) StopWorkflow(ctx context.Context, id, reason string, force bool) error {
+	_, err := c.SubmitCommand(ctx, id, CommandRequest{Type: CommandStopWorkflow, Reason: reason, Force: force})
+	return err
+}
+
+// Sessions returns the persisted sessions, newest first.
+func (c *Client) Sessions(ctx context.Context) ([]SessionResponse, error) {
+	var resp ListSessionsResponse
+	if err := c.do(ctx, http.MethodGet, "/sessions", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Sessions, nil
+}
+
+// SessionMessages returns up to limit Fabric messages from a session's history
+// (0 = server default), optionally only those of channel.
+func (c *Client) SessionMessages(ctx context.Context, sessionID, channel string, limit int) ([]MessageResponse, error) {
+	query := url.Values{}
+	if channel != "" {
+		query.Set("channel", channel)
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	path := "/sessions/" + url.PathEscape(sessionID) + "/fabric/messages"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	var resp ListMessagesResponse
+	if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Messages, nil
+}
+
+// do sends a JSON request and decodes the JSON response into out (if non-nil).
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= 300 {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr.ErrorResponse); err != nil || apiErr.ErrorResponse.Error == "" {
+			apiErr.ErrorResponse.Error = http.StatusText(resp.StatusCode)
+		}
+		return apiErr
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
@@ -16,7 +16,6 @@ import (
 	"github.com/zjrosen/perles/internal/frontend"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/controlplane"
-	"github.com/zjrosen/perles/internal/orchestration/session"
 	appreg "github.com/zjrosen/perles/internal/registry/application"
 )
 
@@ -25,6 +24,7 @@ type Handler struct {
 	cp              controlplane.ControlPlane
 	workflowCreator *appreg.WorkflowCreator
 	registryService *appreg.RegistryService
+	sessionBaseDir  string
 }
 
 // HandlerConfig configures the API handler.
@@ -37,6 +37,9 @@ type HandlerConfig struct {
 	// RegistryService provides access to workflow templates (optional).
 	// Required for building coordinator prompts with instructions.
 	RegistryService *appreg.RegistryService
+	// SessionBaseDir is the session storage directory listed by the session endpoints
+	// (optional - defaults to session.DefaultBaseDir()).
+	SessionBaseDir string
 }
 
 // NewHandler creates a new API handler wrapping the given ControlPlane.
@@ -50,6 +53,7 @@ func NewHandlerWithConfig(cfg HandlerConfig) *Handler {
 		cp:              cfg.ControlPlane,
 		workflowCreator: cfg.WorkflowCreator,
 		registryService: cfg.RegistryService,
+		sessionBaseDir:  cfg.SessionBaseDir,
 	}
 }
 
@@ -75,6 +79,18 @@ func (h *Handler) Routes() http.Handler {
 	// Health check
 	mux.HandleFunc("GET /health", h.Health)
 
+	// Runtime state and control of started workflows
+	mux.HandleFunc("GET /workflows/{id}/processes", h.ListProcesses)
+	mux.HandleFunc("GET /workflows/{id}/tasks", h.ListTasks)
+	mux.HandleFunc("GET /workflows/{id}/tool-calls", h.ListToolCalls)
+	mux.HandleFunc("POST /workflows/{id}/commands", h.SubmitCommand)
+	mux.HandleFunc("GET /workflows/{id}/fabric/channels/{channel}/messages", h.ListMessages)
+	mux.HandleFunc("POST /workflows/{id}/fabric/messages", h.SendMessage)
+
+	// Persisted sessions, including workflows that are no longer running
+	mux.HandleFunc("GET /sessions", h.ListSessions)
+	mux.HandleFunc("GET /sessions/{id}/fabric/messages", h.ListSessionMessages)
+
 	return mux
 }
 
@@ -312,17 +328,23 @@ func (h *Handler) validateTemplateArgs(templateID string, args map[string]string
 	return nil
 }
 
-// buildCoordinatorPrompt assembles the coordinator prompt from:
+// buildCoordinatorPrompt assembles the coordinator prompt for a new workflow.
+func (h *Handler) buildCoordinatorPrompt(templateID, epicID string, _ map[string]string) string {
+	return BuildCoordinatorPrompt(h.registryService, templateID, epicID)
+}
+
+// BuildCoordinatorPrompt assembles the coordinator prompt from:
 // 1. Instructions template content (from registration's instructions field)
 // 2. Epic ID section (so coordinator can read detailed instructions via bd show)
-func (h *Handler) buildCoordinatorPrompt(templateID, epicID string, _ map[string]string) string {
+// registryService may be nil, in which case only the epic section is included.
+func BuildCoordinatorPrompt(registryService *appreg.RegistryService, templateID, epicID string) string {
 	// Load system prompt template if registry service is available
 	var systemPromptContent string
-	if h.registryService != nil {
+	if registryService != nil {
 		// Get the registration for this template
-		reg, err := h.registryService.GetByKey("workflow", templateID)
+		reg, err := registryService.GetByKey("workflow", templateID)
 		if err == nil {
-			content, err := h.registryService.GetSystemPromptTemplate(reg)
+			content, err := registryService.GetSystemPromptTemplate(reg)
 			if err == nil {
 				systemPromptContent = content
 			}
@@ -698,6 +720,8 @@ type ServerConfig struct {
 	WorkflowCreator *appreg.WorkflowCreator
 	// RegistryService provides access to workflow templates (optional).
 	RegistryService *appreg.RegistryService
+	// SessionBaseDir is the session storage directory (optional - defaults to session.DefaultBaseDir()).
+	SessionBaseDir string
 	// FrontendFS provides the embedded frontend assets filesystem.
 	// When set, the embedded frontend SPA is served at / with session APIs.
 	FrontendFS fs.FS
@@ -705,6 +729,9 @@ type ServerConfig struct {
 	ReadTimeout time.Duration
 	// WriteTimeout is the maximum duration before timing out writes of the response.
 	WriteTimeout time.Duration
+	// Token requires every request (except health checks) to present this API token.
+	// Optional - if empty, the API is unauthenticated (only safe on localhost).
+	Token string
 }
 
 // NewServer creates a new API server.
@@ -715,6 +742,7 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 		ControlPlane:    cfg.ControlPlane,
 		WorkflowCreator: cfg.WorkflowCreator,
 		RegistryService: cfg.RegistryService,
+		SessionBaseDir:  cfg.SessionBaseDir,
 	})
 
 	readTimeout := cfg.ReadTimeout
@@ -753,7 +781,7 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 			_ = listener.Close()
 			return nil, fmt.Errorf("creating frontend sub-filesystem: %w", err)
 		}
-		frontendHandler := frontend.NewHandler(session.DefaultBaseDir(), spaFS, cfg.ControlPlane)
+		frontendHandler := frontend.NewHandler(cfg.SessionBaseDir, spaFS, cfg.ControlPlane)
 		frontendHandler.RegisterAPIRoutes(mux)
 		frontendHandler.RegisterSPAHandler(mux)
 
@@ -761,6 +789,9 @@ func NewServer(cfg ServerConfig) (*Server, error) {
 	} else {
 		httpHandler = handler.Routes()
 	}
+	if cfg.Token != "" {
+		httpHandler = RequireToken(cfg.Token, httpHandler)
+	}
 
 	return &Server{
 		handler:  handler,
@@ -0,0 +1,471 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// defaultMessageLimit is the number of Fabric messages returned when no limit is given.
+const defaultMessageLimit = 50
+
+// apiSender is the Fabric author of messages posted through the API.
+const apiSender = "user"
+
+// Workflow commands accepted by SubmitCommand besides the v2 user commands.
+// They act on the whole workflow through the control plane.
+const (
+	CommandPauseWorkflow = "pause_workflow" // Pause the workflow, as POST /workflows/{id}/pause does
+	CommandStopWorkflow  = "stop_workflow"  // Stop the workflow and release its resources
+)
+
+// === Runtime Request/Response Types ===
+
+// ProcessResponse describes a coordinator or worker process of a running workflow.
+type ProcessResponse struct {
+	ID             string    `json:"id"`
+	Role           string    `json:"role"`
+	Status         string    `json:"status"`
+	Phase          string    `json:"phase,omitempty"`
+	TaskID         string    `json:"task_id,omitempty"`
+	TokensUsed     int       `json:"tokens_used,omitempty"`
+	CostUSD        float64   `json:"cost_usd,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	LastActivityAt time.Time `json:"last_activity_at,omitzero"`
+}
+
+// ListProcessesResponse is the response body for listing a workflow's processes.
+type ListProcessesResponse struct {
+	Processes []ProcessResponse `json:"processes"`
+	Total     int               `json:"total"`
+}
+
+// TaskResponse describes an in-flight task assignment of a running workflow.
+type TaskResponse struct {
+	TaskID      string    `json:"task_id"`
+	Implementer string    `json:"implementer"`
+	Reviewer    string    `json:"reviewer,omitempty"`
+	Status      string    `json:"status"`
+	ThreadID    string    `json:"thread_id,omitempty"`
+	StartedAt   time.Time `json:"started_at"`
+}
+
+// ListTasksResponse is the response body for listing a workflow's task assignments.
+type ListTasksResponse struct {
+	Tasks []TaskResponse `json:"tasks"`
+	Total int            `json:"total"`
+}
+
+// ToolCallResponse counts the MCP calls of one tool by one process.
+type ToolCallResponse struct {
+	ProcessID string `json:"process_id"`
+	Tool      string `json:"tool"`
+	Allowed   int64  `json:"allowed"`
+	Limited   int64  `json:"limited"`
+}
+
+// DedupResponse counts the messages checked and suppressed as duplicates.
+type DedupResponse struct {
+	Strategy   string `json:"strategy"`
+	Window     string `json:"window"`
+	Checked    int64  `json:"checked"`
+	Suppressed int64  `json:"suppressed"`
+	Bypassed   int64  `json:"bypassed"`
+}
+
+// ListToolCallsResponse is the response body for listing a workflow's MCP tool call counters.
+type ListToolCallsResponse struct {
+	ToolCalls []ToolCallResponse `json:"tool_calls"`
+	Total     int                `json:"total"`
+	Dedup     *DedupResponse     `json:"dedup,omitempty"`
+}
+
+// CommandRequest is the request body for submitting a command to a running workflow.
+// Only the commands a user can issue from the TUI are accepted.
+type CommandRequest struct {
+	// Type is the command type: send_to_process, spawn_process (a worker), stop_process,
+	// retire_process, replace_process, emergency_stop, emergency_resume, approve_checkpoint,
+	// pause_workflow or stop_workflow.
+	Type string `json:"type"`
+	// ProcessID is the target process (required except for spawn_process, emergency_*, approve_checkpoint and *_workflow).
+	ProcessID string `json:"process_id,omitempty"`
+	// Content is the message for send_to_process, or the note passed to the coordinator for approve_checkpoint.
+	Content string `json:"content,omitempty"`
+	// Reason is recorded for stop, retire, replace, emergency_stop and stop_workflow.
+	Reason string `json:"reason,omitempty"`
+	// Force terminates the process immediately for stop_process, and discards
+	// uncommitted worktree changes for stop_workflow.
+	Force bool `json:"force,omitempty"`
+}
+
+// CommandResponse is the response body for a submitted command.
+type CommandResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// MessageResponse is a Fabric message.
+type MessageResponse struct {
+	ID        string            `json:"id"`
+	Content   string            `json:"content"`
+	Kind      string            `json:"kind,omitempty"`
+	CreatedBy string            `json:"created_by"`
+	CreatedAt time.Time         `json:"created_at"`
+	Mentions  []string          `json:"mentions,omitempty"`
+	Meta      map[string]string `json:"meta,omitempty"`
+}
+
+// ListMessagesResponse is the response body for listing a Fabric channel's messages.
+type ListMessagesResponse struct {
+	Messages []MessageResponse `json:"messages"`
+	Total    int               `json:"total"`
+}
+
+// SendMessageRequest is the request body for posting a Fabric message.
+// A message with ReplyTo set is posted as a reply in that thread instead of to Channel.
+type SendMessageRequest struct {
+	Channel  string   `json:"channel,omitempty"`
+	ReplyTo  string   `json:"reply_to,omitempty"`
+	Content  string   `json:"content"`
+	Mentions []string `json:"mentions,omitempty"`
+}
+
+// === Runtime Handlers ===
+
+// ListProcesses returns the processes of a running workflow, coordinator first.
+// GET /workflows/{id}/processes
+func (h *Handler) ListProcesses(w http.ResponseWriter, r *http.Request) {
+	infra, ok := h.runningInfrastructure(w, r)
+	if !ok {
+		return
+	}
+
+	procs := infra.Repositories.ProcessRepo.List()
+	sort.Slice(procs, func(i, j int) bool {
+		if procs[i].Role != procs[j].Role {
+			return procs[i].Role == repository.RoleCoordinator
+		}
+		return procs[i].ID < procs[j].ID
+	})
+
+	resp := ListProcessesResponse{Processes: make([]ProcessResponse, 0, len(procs))}
+	for _, p := range procs {
+		pr := ProcessResponse{
+			ID:             p.ID,
+			Role:           string(p.Role),
+			Status:         string(p.Status),
+			TaskID:         p.TaskID,
+			CreatedAt:      p.CreatedAt,
+			LastActivityAt: p.LastActivityAt,
+		}
+		if p.Phase != nil {
+			pr.Phase = string(*p.Phase)
+		}
+		if p.Metrics != nil {
+			pr.TokensUsed = p.Metrics.TokensUsed
+			pr.CostUSD = p.Metrics.CumulativeCostUSD
+		}
+		resp.Processes = append(resp.Processes, pr)
+	}
+	resp.Total = len(resp.Processes)
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ListTasks returns the in-flight task assignments of a running workflow.
+// GET /workflows/{id}/tasks
+func (h *Handler) ListTasks(w http.ResponseWriter, r *http.Request) {
+	infra, ok := h.runningInfrastructure(w, r)
+	if !ok {
+		return
+	}
+
+	tasks := infra.Repositories.TaskRepo.All()
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].TaskID < tasks[j].TaskID })
+
+	resp := ListTasksResponse{Tasks: make([]TaskResponse, 0, len(tasks))}
+	for _, t := range tasks {
+		resp.Tasks = append(resp.Tasks, TaskResponse{
+			TaskID:      t.TaskID,
+			Implementer: t.Implementer,
+			Reviewer:    t.Reviewer,
+			Status:      string(t.Status),
+			ThreadID:    t.ThreadID,
+			StartedAt:   t.StartedAt,
+		})
+	}
+	resp.Total = len(resp.Tasks)
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ListToolCalls returns how often each process called each MCP tool, how many of
+// those calls were rejected by rate limits, and how many messages were
+// suppressed as duplicates.
+// GET /workflows/{id}/tool-calls
+func (h *Handler) ListToolCalls(w http.ResponseWriter, r *http.Request) {
+	wf, ok := h.runningWorkflow(w, r)
+	if !ok {
+		return
+	}
+
+	resp := ListToolCallsResponse{ToolCalls: []ToolCallResponse{}}
+	if wf.RateLimiter != nil {
+		for _, c := range wf.RateLimiter.Counters() {
+			resp.ToolCalls = append(resp.ToolCalls, ToolCallResponse(c))
+		}
+	}
+	resp.Total = len(resp.ToolCalls)
+	if wf.Deduplicator != nil {
+		stats := wf.Deduplicator.Stats()
+		resp.Dedup = &DedupResponse{
+			Strategy:   string(stats.Strategy),
+			Window:     stats.Window.String(),
+			Checked:    stats.Checked,
+			Suppressed: stats.Suppressed,
+			Bypassed:   stats.Bypassed,
+		}
+	}
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// SubmitCommand submits a user command to a running workflow and waits for its result.
+// POST /workflows/{id}/commands
+func (h *Handler) SubmitCommand(w http.ResponseWriter, r *http.Request) {
+	var req CommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_json", "Invalid JSON body", err.Error())
+		return
+	}
+
+	if req.Type == CommandPauseWorkflow || req.Type == CommandStopWorkflow {
+		h.submitWorkflowCommand(w, r, req)
+		return
+	}
+
+	cmd, err := userCommand(req)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_command", "Invalid command", err.Error())
+		return
+	}
+	if err := cmd.Validate(); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_command", "Invalid command", err.Error())
+		return
+	}
+
+	infra, ok := h.runningInfrastructure(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := infra.Core.Processor.SubmitAndWait(r.Context(), cmd)
+	if err != nil {
+		h.writeError(w, http.StatusServiceUnavailable, "submit_failed", "Failed to submit command", err.Error())
+		return
+	}
+	if !result.Success {
+		// The handler rejected the command (e.g. unknown process)
+		resp := CommandResponse{Error: "command failed"}
+		if result.Error != nil {
+			resp.Error = result.Error.Error()
+		}
+		h.writeJSON(w, http.StatusUnprocessableEntity, resp)
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, CommandResponse{Success: true})
+}
+
+// submitWorkflowCommand pauses or stops the workflow in the request path.
+func (h *Handler) submitWorkflowCommand(w http.ResponseWriter, r *http.Request, req CommandRequest) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	var err error
+	verb, past := "pause", "paused"
+	if req.Type == CommandPauseWorkflow {
+		err = h.cp.Pause(r.Context(), id)
+	} else {
+		verb, past = "stop", "stopped"
+		err = h.cp.Stop(r.Context(), id, controlplane.StopOptions{
+			Reason: reasonOr(req.Reason, "user_requested"),
+			Force:  req.Force,
+		})
+	}
+
+	switch {
+	case err == nil:
+		h.writeJSON(w, http.StatusOK, CommandResponse{Success: true})
+	case errors.Is(err, controlplane.ErrWorkflowNotFound):
+		h.writeError(w, http.StatusNotFound, "not_found", "Workflow not found", "")
+	case errors.Is(err, controlplane.ErrInvalidState):
+		h.writeError(w, http.StatusConflict, "invalid_state", "Workflow cannot be "+past+" in its current state", err.Error())
+	case errors.Is(err, controlplane.ErrUncommittedChanges):
+		h.writeError(w, http.StatusConflict, "uncommitted_changes", "Worktree has uncommitted changes, retry with force to discard them", err.Error())
+	default:
+		h.writeError(w, http.StatusInternalServerError, "command_failed", "Failed to "+verb+" workflow", err.Error())
+	}
+}
+
+// userCommand builds the v2 command for a command request.
+func userCommand(req CommandRequest) (command.Command, error) {
+	switch command.CommandType(req.Type) {
+	case command.CmdSendToProcess:
+		return command.NewSendToProcessCommand(command.SourceUser, req.ProcessID, req.Content), nil
+	case command.CmdSpawnProcess:
+		return command.NewSpawnProcessCommand(command.SourceUser, repository.RoleWorker), nil
+	case command.CmdStopProcess:
+		return command.NewStopProcessCommand(command.SourceUser, req.ProcessID, req.Force, reasonOr(req.Reason, "user_requested")), nil
+	case command.CmdRetireProcess:
+		return command.NewRetireProcessCommand(command.SourceUser, req.ProcessID, reasonOr(req.Reason, "user_requested")), nil
+	case command.CmdReplaceProcess:
+		return command.NewReplaceProcessCommand(command.SourceUser, req.ProcessID, reasonOr(req.Reason, "user_requested")), nil
+	case command.CmdEmergencyStop:
+		return command.NewEmergencyStopCommand(command.SourceUser, apiSender, reasonOr(req.Reason, "emergency stop from API")), nil
+	case command.CmdEmergencyResume:
+		return command.NewEmergencyResumeCommand(command.SourceUser), nil
+	case command.CmdApproveCheckpoint:
+		return command.NewApproveCheckpointCommand(command.SourceUser, req.Content), nil
+	default:
+		return nil, errors.New("unsupported command type " + strconv.Quote(req.Type))
+	}
+}
+
+func reasonOr(reason, fallback string) string {
+	if reason == "" {
+		return fallback
+	}
+	return reason
+}
+
+// ListMessages returns the most recent messages of a Fabric channel, oldest first.
+// GET /workflows/{id}/fabric/channels/{channel}/messages?limit=N
+func (h *Handler) ListMessages(w http.ResponseWriter, r *http.Request) {
+	limit, ok := h.queryLimit(w, r, defaultMessageLimit)
+	if !ok {
+		return
+	}
+
+	infra, ok := h.runningInfrastructure(w, r)
+	if !ok {
+		return
+	}
+
+	msgs, err := infra.Core.FabricService.ListMessages(r.PathValue("channel"), limit)
+	if err != nil {
+		h.writeError(w, http.StatusNotFound, "channel_not_found", "Failed to list channel messages", err.Error())
+		return
+	}
+
+	resp := ListMessagesResponse{Messages: make([]MessageResponse, 0, len(msgs))}
+	for _, m := range msgs {
+		resp.Messages = append(resp.Messages, messageToResponse(m))
+	}
+	resp.Total = len(resp.Messages)
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// SendMessage posts a Fabric message, or a reply when reply_to is set, as the user.
+// POST /workflows/{id}/fabric/messages
+func (h *Handler) SendMessage(w http.ResponseWriter, r *http.Request) {
+	var req SendMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "invalid_json", "Invalid JSON body", err.Error())
+		return
+	}
+	if req.Content == "" {
+		h.writeError(w, http.StatusBadRequest, "missing_content", "content is required", "")
+		return
+	}
+	if req.Channel == "" && req.ReplyTo == "" {
+		h.writeError(w, http.StatusBadRequest, "missing_channel", "channel or reply_to is required", "")
+		return
+	}
+
+	infra, ok := h.runningInfrastructure(w, r)
+	if !ok {
+		return
+	}
+
+	var (
+		msg *domain.Thread
+		err error
+	)
+	if req.ReplyTo != "" {
+		msg, err = infra.Core.FabricService.Reply(fabric.ReplyInput{
+			MessageID: req.ReplyTo,
+			Content:   req.Content,
+			Kind:      domain.KindInfo,
+			CreatedBy: apiSender,
+			Mentions:  req.Mentions,
+		})
+	} else {
+		msg, err = infra.Core.FabricService.SendMessage(fabric.SendMessageInput{
+			ChannelSlug: req.Channel,
+			Content:     req.Content,
+			Kind:        domain.KindInfo,
+			CreatedBy:   apiSender,
+			Mentions:    req.Mentions,
+		})
+	}
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "send_failed", "Failed to send message", err.Error())
+		return
+	}
+
+	h.writeJSON(w, http.StatusCreated, messageToResponse(*msg))
+}
+
+func messageToResponse(m domain.Thread) MessageResponse {
+	return MessageResponse{
+		ID:        m.ID,
+		Content:   m.Content,
+		Kind:      string(m.Kind),
+		CreatedBy: m.CreatedBy,
+		CreatedAt: m.CreatedAt,
+		Mentions:  m.Mentions,
+		Meta:      m.Meta,
+	}
+}
+
+// runningInfrastructure returns the v2 infrastructure of the workflow in the request path.
+// It writes an error response and returns false if the workflow is unknown or not started.
+func (h *Handler) runningInfrastructure(w http.ResponseWriter, r *http.Request) (*v2.Infrastructure, bool) {
+	wf, ok := h.runningWorkflow(w, r)
+	if !ok {
+		return nil, false
+	}
+	return wf.Infrastructure, true
+}
+
+// runningWorkflow returns the workflow in the request path.
+// It writes an error response and returns false if the workflow is unknown or not started.
+func (h *Handler) runningWorkflow(w http.ResponseWriter, r *http.Request) (*controlplane.WorkflowInstance, bool) {
+	id := controlplane.WorkflowID(r.PathValue("id"))
+
+	wf, err := h.cp.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, controlplane.ErrWorkflowNotFound) {
+			h.writeError(w, http.StatusNotFound, "not_found", "Workflow not found", "")
+			return nil, false
+		}
+		h.writeError(w, http.StatusInternalServerError, "get_failed", "Failed to get workflow", err.Error())
+		return nil, false
+	}
+	if wf.Infrastructure == nil {
+		h.writeError(w, http.StatusConflict, "not_running", "Workflow has not been started", string(wf.State))
+		return nil, false
+	}
+	return wf, true
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+	"github.com/zjrosen/perles/internal/orchestration/mcp"
+	"github.com/zjrosen/perles/internal/orchestration/ratelimit"
+	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// newRuntimeTestServer serves the API for a started workflow "wf-1" with a coordinator,
+// a worker on perles-abc1 and Fabric channels. send_to_process fails for unknown processes.
+// worker-1 has called fabric_send twice, once over its limit, and one repeated
+// message was suppressed.
+func newRuntimeTestServer(t *testing.T) (*Client, *v2.Infrastructure, *[]command.Command) {
+	t.Helper()
+
+	processes := repository.NewMemoryProcessRepository()
+	implementing := events.ProcessPhaseImplementing
+	require.NoError(t, processes.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &implementing, TaskID: "perles-abc1"}))
+	require.NoError(t, processes.Save(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady}))
+	tasks := repository.NewMemoryTaskRepository()
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc1", Implementer: "worker-1", Status: repository.TaskImplementing}))
+
+	threads := fabricrepo.NewMemoryThreadRepository()
+	deps := fabricrepo.NewMemoryDependencyRepository()
+	subs := fabricrepo.NewMemorySubscriptionRepository()
+	svc := fabric.NewService(threads, deps, subs, fabricrepo.NewMemoryAckRepository(deps, threads, subs), fabricrepo.NewMemoryParticipantRepository())
+	require.NoError(t, svc.InitSession(repository.CoordinatorID))
+
+	var submitted []command.Command
+	cmdProcessor := processor.NewCommandProcessor()
+	cmdProcessor.RegisterHandler(command.CmdSendToProcess, processor.HandlerFunc(func(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+		submitted = append(submitted, cmd)
+		if _, err := processes.Get(cmd.(*command.SendToProcessCommand).ProcessID); err != nil {
+			return nil, errors.New("process not found")
+		}
+		return &command.CommandResult{Success: true}, nil
+	}))
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go cmdProcessor.Run(ctx)
+	require.NoError(t, cmdProcessor.WaitForReady(ctx))
+
+	infra := &v2.Infrastructure{
+		Core:         v2.CoreComponents{Processor: cmdProcessor, FabricService: svc},
+		Repositories: v2.RepositoryComponents{ProcessRepo: processes, TaskRepo: tasks},
+	}
+
+	limiter := ratelimit.NewLimiter(ratelimit.Policy{Tools: map[string]ratelimit.Limit{"fabric_send": {Calls: 1, Per: time.Minute}}})
+	require.NoError(t, limiter.Allow("worker-1", "fabric_send"))
+	require.Error(t, limiter.Allow("worker-1", "fabric_send"))
+
+	dedup := mcp.NewMessageDeduplicator(time.Minute)
+	require.False(t, dedup.IsDuplicate("worker-1", "done"))
+	require.True(t, dedup.IsDuplicate("worker-1", "done"))
+
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Get(mock.Anything, controlplane.WorkflowID("wf-1")).
+		Return(&controlplane.WorkflowInstance{ID: "wf-1", State: controlplane.WorkflowRunning, Infrastructure: infra, RateLimiter: limiter, Deduplicator: dedup}, nil).Maybe()
+	mockCP.EXPECT().Get(mock.Anything, controlplane.WorkflowID("wf-pending")).
+		Return(&controlplane.WorkflowInstance{ID: "wf-pending", State: controlplane.WorkflowPending}, nil).Maybe()
+
+	srv := httptest.NewServer(RequireToken("secret", NewHandler(mockCP).Routes()))
+	t.Cleanup(srv.Close)
+	return NewClient(ClientConfig{BaseURL: srv.URL, Token: "secret"}), infra, &submitted
+}
+
+func TestRuntime_ProcessesAndTasks(t *testing.T) {
+	client, _, _ := newRuntimeTestServer(t)
+	ctx := context.Background()
+
+	procs, err := client.Processes(ctx, "wf-1")
+	require.NoError(t, err)
+	require.Len(t, procs, 2)
+	require.Equal(t, repository.CoordinatorID, procs[0].ID, "coordinator is listed first")
+	require.Equal(t, "implementing", procs[1].Phase)
+	require.Equal(t, "perles-abc1", procs[1].TaskID)
+
+	tasks, err := client.Tasks(ctx, "wf-1")
+	require.NoError(t, err)
+	require.Equal(t, []TaskResponse{{TaskID: "perles-abc1", Implementer: "worker-1", Status: string(repository.TaskImplementing)}}, tasks)
+
+	_, err = client.Tasks(ctx, "wf-pending")
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusConflict, apiErr.StatusCode)
+	require.Equal(t, "not_running", apiErr.Code)
+}
+
+func TestRuntime_ToolCalls(t *testing.T) {
+	client, _, _ := newRuntimeTestServer(t)
+
+	calls, err := client.ToolCalls(context.Background(), "wf-1")
+	require.NoError(t, err)
+	require.Equal(t, []ToolCallResponse{{ProcessID: "worker-1", Tool: "fabric_send", Allowed: 1, Limited: 1}}, calls)
+
+	var resp ListToolCallsResponse
+	require.NoError(t, client.do(context.Background(), http.MethodGet, "/workflows/wf-1/tool-calls", nil, &resp))
+	require.Equal(t, &DedupResponse{Strategy: "per_recipient", Window: "1m0s", Checked: 2, Suppressed: 1}, resp.Dedup)
+}
+
+func TestRuntime_SubmitCommand(t *testing.T) {
+	client, _, submitted := newRuntimeTestServer(t)
+	ctx := context.Background()
+
+	resp, err := client.SubmitCommand(ctx, "wf-1", CommandRequest{Type: "send_to_process", ProcessID: "worker-1", Content: "status?"})
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	require.Len(t, *submitted, 1)
+	require.Equal(t, command.SourceUser, (*submitted)[0].(*command.SendToProcessCommand).Source())
+
+	_, err = client.SubmitCommand(ctx, "wf-1", CommandRequest{Type: "send_to_process", ProcessID: "worker-9", Content: "hi"})
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+	require.Contains(t, apiErr.Error(), "process not found")
+
+	_, err = client.SubmitCommand(ctx, "wf-1", CommandRequest{Type: "assign_task", ProcessID: "worker-1"})
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "invalid_command", apiErr.Code)
+}
+
+func TestRuntime_FabricMessages(t *testing.T) {
+	client, _, _ := newRuntimeTestServer(t)
+	ctx := context.Background()
+
+	sent, err := client.SendMessage(ctx, "wf-1", SendMessageRequest{Channel: "general", Content: "Please prioritize the login bug", Mentions: []string{"coordinator"}})
+	require.NoError(t, err)
+	require.Equal(t, apiSender, sent.CreatedBy)
+
+	_, err = client.SendMessage(ctx, "wf-1", SendMessageRequest{ReplyTo: sent.ID, Content: "Thanks"})
+	require.NoError(t, err)
+
+	msgs, err := client.Messages(ctx, "wf-1", "general", 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1, "replies are not listed as channel messages")
+	require.Equal(t, "Please prioritize the login bug", msgs[0].Content)
+
+	_, err = client.SendMessage(ctx, "wf-1", SendMessageRequest{Content: "nowhere"})
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "missing_channel", apiErr.Code)
+}
+
+func TestRuntime_WorkflowCommands(t *testing.T) {
+	mockCP := mocks.NewMockControlPlane(t)
+	mockCP.EXPECT().Pause(mock.Anything, controlplane.WorkflowID("wf-1")).Return(nil).Once()
+	mockCP.EXPECT().Stop(mock.Anything, controlplane.WorkflowID("wf-1"), controlplane.StopOptions{Reason: "user_requested"}).
+		Return(controlplane.ErrUncommittedChanges).Once()
+	mockCP.EXPECT().Stop(mock.Anything, controlplane.WorkflowID("wf-1"), controlplane.StopOptions{Reason: "nightly cutoff", Force: true}).
+		Return(nil).Once()
+	mockCP.EXPECT().Stop(mock.Anything, controlplane.WorkflowID("wf-done"), mock.Anything).
+		Return(fmt.Errorf("%w: cannot stop workflow in state completed", controlplane.ErrInvalidState)).Once()
+
+	srv := httptest.NewServer(NewHandler(mockCP).Routes())
+	t.Cleanup(srv.Close)
+	client := NewClient(ClientConfig{BaseURL: srv.URL})
+	ctx := context.Background()
+
+	resp, err := client.SubmitCommand(ctx, "wf-1", CommandRequest{Type: CommandPauseWorkflow})
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+
+	var apiErr *APIError
+	err = client.StopWorkflow(ctx, "wf-1", "", false)
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusConflict, apiErr.StatusCode)
+	require.Equal(t, "uncommitted_changes", apiErr.Code)
+
+	require.NoError(t, client.StopWorkflow(ctx, "wf-1", "nightly cutoff", true))
+
+	err = client.StopWorkflow(ctx, "wf-done", "", false)
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, "invalid_state", apiErr.Code)
+	require.Equal(t, "Workflow cannot be stopped in its current state", apiErr.ErrorResponse.Error)
+}
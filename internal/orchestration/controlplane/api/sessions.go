@@ -0,0 +1,169 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
+	"github.com/zjrosen/perles/internal/orchestration/session"
+)
+
+// === Session Request/Response Types ===
+
+// SessionResponse summarizes a persisted orchestration session.
+type SessionResponse struct {
+	ID             string    `json:"id"`
+	Application    string    `json:"application,omitempty"`
+	Status         string    `json:"status"`
+	EpicID         string    `json:"epic_id,omitempty"`
+	StartTime      time.Time `json:"start_time"`
+	EndTime        time.Time `json:"end_time,omitzero"`
+	WorkerCount    int       `json:"worker_count"`
+	TasksCompleted int       `json:"tasks_completed"`
+	TotalCommits   int       `json:"total_commits"`
+	SessionDir     string    `json:"session_dir"`
+	WorkDir        string    `json:"work_dir,omitempty"`
+}
+
+// ListSessionsResponse is the response body for listing sessions.
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+	Total    int               `json:"total"`
+}
+
+// === Session Handlers ===
+
+// ListSessions returns the persisted sessions, newest first.
+// GET /sessions?application=perles&limit=N
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	limit, ok := h.queryLimit(w, r, 0)
+	if !ok {
+		return
+	}
+
+	entries, ok := h.sessionIndex(w)
+	if !ok {
+		return
+	}
+
+	application := r.URL.Query().Get("application")
+	resp := ListSessionsResponse{Sessions: []SessionResponse{}}
+	for _, e := range entries {
+		if application != "" && e.ApplicationName != application {
+			continue
+		}
+		resp.Sessions = append(resp.Sessions, SessionResponse{
+			ID:             e.ID,
+			Application:    e.ApplicationName,
+			Status:         string(e.Status),
+			EpicID:         e.EpicID,
+			StartTime:      e.StartTime,
+			EndTime:        e.EndTime,
+			WorkerCount:    e.WorkerCount,
+			TasksCompleted: e.TasksCompleted,
+			TotalCommits:   e.TotalCommits,
+			SessionDir:     e.SessionDir,
+			WorkDir:        e.WorkDir,
+		})
+		if limit > 0 && len(resp.Sessions) == limit {
+			break
+		}
+	}
+	resp.Total = len(resp.Sessions)
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// ListSessionMessages returns the Fabric messages and replies of a session from
+// its persisted history, oldest first. Unlike ListMessages it also works for
+// workflows that are no longer running.
+// GET /sessions/{id}/fabric/messages?channel=general&limit=N
+func (h *Handler) ListSessionMessages(w http.ResponseWriter, r *http.Request) {
+	limit, ok := h.queryLimit(w, r, defaultMessageLimit)
+	if !ok {
+		return
+	}
+
+	entries, ok := h.sessionIndex(w)
+	if !ok {
+		return
+	}
+	id := r.PathValue("id")
+	var sessionDir string
+	for _, e := range entries {
+		if e.ID == id {
+			sessionDir = e.SessionDir
+			break
+		}
+	}
+	if sessionDir == "" {
+		h.writeError(w, http.StatusNotFound, "not_found", "Session not found", "")
+		return
+	}
+
+	events, err := persistence.LoadPersistedEvents(sessionDir)
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "read_failed", "Failed to read Fabric history", err.Error())
+		return
+	}
+
+	channel := r.URL.Query().Get("channel")
+	var msgs []MessageResponse
+	for _, pe := range events {
+		event := pe.Event
+		if event.Type != fabric.EventMessagePosted && event.Type != fabric.EventReplyPosted {
+			continue
+		}
+		if event.Thread == nil || (channel != "" && event.ChannelSlug != channel) {
+			continue
+		}
+		msgs = append(msgs, messageToResponse(*event.Thread))
+	}
+	if len(msgs) > limit {
+		msgs = msgs[len(msgs)-limit:]
+	}
+
+	resp := ListMessagesResponse{Messages: append([]MessageResponse{}, msgs...)}
+	resp.Total = len(resp.Messages)
+
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// sessionIndex returns the entries of the global session index, newest first.
+// It writes an error response and returns false if the index cannot be read.
+func (h *Handler) sessionIndex(w http.ResponseWriter) ([]session.SessionIndexEntry, bool) {
+	baseDir := h.sessionBaseDir
+	if baseDir == "" {
+		baseDir = session.DefaultBaseDir()
+	}
+
+	index, err := session.LoadSessionIndex(session.NewSessionPathBuilder(baseDir, "").IndexPath())
+	if err != nil {
+		h.writeError(w, http.StatusInternalServerError, "read_failed", "Failed to read session index", err.Error())
+		return nil, false
+	}
+
+	entries := index.Sessions
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].StartTime.After(entries[j].StartTime)
+	})
+	return entries, true
+}
+
+// queryLimit parses the optional limit query parameter.
+// It writes an error response and returns false if the limit is not a positive integer.
+func (h *Handler) queryLimit(w http.ResponseWriter, r *http.Request, fallback int) (int, bool) {
+	raw := r.URL.Query().Get("limit")
+	if raw == "" {
+		return fallback, true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n <= 0 {
+		h.writeError(w, http.StatusBadRequest, "invalid_limit", "limit must be a positive integer", raw)
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
+	"github.com/zjrosen/perles/internal/orchestration/session"
+)
+
+// newSessionsTestClient serves the API over a session directory with two sessions;
+// the newer one has a Fabric history with two general messages, a reply and a
+// tasks message.
+func newSessionsTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	baseDir := t.TempDir()
+	sessionDir := filepath.Join(baseDir, "perles", "2026-03-11", "sess-2")
+	start := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, session.SaveSessionIndex(session.NewSessionPathBuilder(baseDir, "").IndexPath(), &session.SessionIndex{
+		Version: session.SessionIndexVersion,
+		Sessions: []session.SessionIndexEntry{
+			{ID: "sess-1", ApplicationName: "other", StartTime: start.Add(-24 * time.Hour), Status: session.StatusCompleted, SessionDir: filepath.Join(baseDir, "sess-1")},
+			{ID: "sess-2", ApplicationName: "perles", StartTime: start, Status: session.StatusRunning, SessionDir: sessionDir, WorkerCount: 2},
+		},
+	}))
+
+	require.NoError(t, os.MkdirAll(sessionDir, 0o750))
+	logger, err := persistence.NewEventLogger(sessionDir)
+	require.NoError(t, err)
+	post := func(id, slug, content string) *domain.Thread {
+		msg := &domain.Thread{ID: id, Type: domain.ThreadMessage, Content: content, CreatedBy: "coordinator", CreatedAt: start}
+		logger.HandleEvent(fabric.NewMessagePostedEvent(msg, "ch-"+slug, slug))
+		return msg
+	}
+	post("m1", "general", "Starting the epic")
+	post("m2", "tasks", "perles-abc.1 assigned to worker-1")
+	logger.HandleEvent(fabric.NewReplyPostedEvent(&domain.Thread{ID: "r1", Type: domain.ThreadMessage, Content: "On it", CreatedBy: "worker-1"}, "ch-general", "general", "m1", nil))
+	post("m3", "general", "All tasks done")
+	require.NoError(t, logger.Close())
+
+	srv := httptest.NewServer(NewHandlerWithConfig(HandlerConfig{ControlPlane: mocks.NewMockControlPlane(t), SessionBaseDir: baseDir}).Routes())
+	t.Cleanup(srv.Close)
+	return NewClient(ClientConfig{BaseURL: srv.URL})
+}
+
+func TestSessions_List(t *testing.T) {
+	client := newSessionsTestClient(t)
+
+	sessions, err := client.Sessions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, "sess-2", sessions[0].ID, "newest session first")
+	require.Equal(t, "running", sessions[0].Status)
+	require.Equal(t, 2, sessions[0].WorkerCount)
+
+	var resp ListSessionsResponse
+	require.NoError(t, client.do(context.Background(), http.MethodGet, "/sessions?application=other", nil, &resp))
+	require.Equal(t, 1, resp.Total)
+	require.Equal(t, "sess-1", resp.Sessions[0].ID)
+}
+
+func TestSessions_FabricHistory(t *testing.T) {
+	client := newSessionsTestClient(t)
+	ctx := context.Background()
+
+	msgs, err := client.SessionMessages(ctx, "sess-2", "", 0)
+	require.NoError(t, err)
+	require.Len(t, msgs, 4, "messages and replies of every channel")
+
+	msgs, err = client.SessionMessages(ctx, "sess-2", "general", 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"On it", "All tasks done"}, []string{msgs[0].Content, msgs[1].Content})
+
+	msgs, err = client.SessionMessages(ctx, "sess-1", "", 0)
+	require.NoError(t, err)
+	require.Empty(t, msgs, "sessions without a Fabric log have no history")
+
+	_, err = client.SessionMessages(ctx, "sess-9", "", 0)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
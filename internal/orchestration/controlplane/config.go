@@ -0,0 +1,167 @@
+package controlplane
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/ratelimit"
+	"github.com/zjrosen/perles/internal/orchestration/redact"
+	"github.com/zjrosen/perles/internal/orchestration/tracing"
+	"github.com/zjrosen/perles/internal/orchestration/turnpolicy"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
+)
+
+// WebhookConfig returns the webhook dispatcher configuration. An unset
+// dead-letter path uses config.DefaultWebhookDeadLetterPath.
+func WebhookConfig(w config.WebhooksConfig) webhook.Config {
+	cfg := webhook.Config{
+		MaxAttempts:    w.MaxAttempts,
+		InitialBackoff: w.Backoff,
+		Timeout:        w.Timeout,
+		DeadLetterPath: w.DeadLetter,
+	}
+	if cfg.DeadLetterPath == "" {
+		cfg.DeadLetterPath = config.DefaultWebhookDeadLetterPath()
+	}
+	for _, e := range w.Endpoints {
+		cfg.Endpoints = append(cfg.Endpoints, webhook.Endpoint{URL: e.URL, Events: e.Events, Secret: e.Secret})
+	}
+	return cfg
+}
+
+// WorkerBudget returns the per-worker budget.
+func WorkerBudget(b config.BudgetConfig) repository.Budget {
+	return repository.Budget{Tokens: b.WorkerTokens, Duration: b.WorkerDuration}
+}
+
+// SessionBudget returns the per-session budget.
+func SessionBudget(b config.BudgetConfig) repository.Budget {
+	return repository.Budget{Tokens: b.SessionTokens, Duration: b.SessionDuration}
+}
+
+// PhaseTimeouts returns the phase timeouts for the phase timeout watcher.
+func PhaseTimeouts(p config.PhaseTimeoutsConfig) processor.PhaseTimeouts {
+	var timeouts processor.PhaseTimeouts
+	if len(p.Phases) > 0 {
+		timeouts.Phases = phaseDurations(p.Phases)
+	}
+	if len(p.Workers) > 0 {
+		timeouts.Workers = make(map[string]map[events.ProcessPhase]time.Duration, len(p.Workers))
+		for worker, phases := range p.Workers {
+			timeouts.Workers[worker] = phaseDurations(phases)
+		}
+	}
+	return timeouts
+}
+
+func phaseDurations(m map[string]time.Duration) map[events.ProcessPhase]time.Duration {
+	durations := make(map[events.ProcessPhase]time.Duration, len(m))
+	for phase, d := range m {
+		durations[events.ProcessPhase(phase)] = d
+	}
+	return durations
+}
+
+// RateLimitPolicy returns the rate limit policy. Unset limits are unlimited.
+func RateLimitPolicy(r config.RateLimitsConfig) ratelimit.Policy {
+	policy := ratelimit.Policy{Process: rateLimit(r.Process), Tools: make(map[string]ratelimit.Limit, len(r.Tools))}
+	for tool, l := range r.Tools {
+		policy.Tools[tool] = rateLimit(l)
+	}
+	return policy
+}
+
+// rateLimit returns the rate limit, applying the default window.
+func rateLimit(r config.RateLimitConfig) ratelimit.Limit {
+	per := r.Per
+	if per == 0 {
+		per = time.Minute
+	}
+	return ratelimit.Limit{Calls: r.Calls, Per: per}
+}
+
+// TurnPolicy returns the turn completion policy. Unset values use the defaults.
+func TurnPolicy(t config.TurnPolicyConfig) turnpolicy.Policy {
+	policy := turnpolicy.Policy{Tools: t.Tools, Timeout: t.Timeout}
+	for _, a := range t.Escalation {
+		policy.Escalation = append(policy.Escalation, turnpolicy.Action(a))
+	}
+	if len(t.Phases) > 0 {
+		policy.Phases = make(map[string]turnpolicy.Rule, len(t.Phases))
+		for phase, rule := range t.Phases {
+			policy.Phases[phase] = turnpolicy.Rule{Tools: rule.Tools, Timeout: rule.Timeout}
+		}
+	}
+	return policy
+}
+
+// AutoscalePolicy returns the autoscaler policy, or nil when autoscaling is
+// disabled.
+func AutoscalePolicy(a config.AutoscaleConfig) *autoscale.Policy {
+	if !a.Enabled {
+		return nil
+	}
+	return &autoscale.Policy{
+		Interval:    a.Interval,
+		MinWorkers:  a.MinWorkers,
+		MaxWorkers:  a.MaxWorkers,
+		MaxCostUSD:  a.MaxCostUSD,
+		IdleTimeout: a.IdleTimeout,
+		Cooldown:    a.Cooldown,
+	}
+}
+
+// RedactionPolicy returns the redaction policy, or nil when redaction is
+// disabled. Rules with invalid patterns are skipped; config.ValidateRedaction
+// reports them.
+func RedactionPolicy(r config.RedactionConfig) *redact.Policy {
+	if r.Disabled {
+		return nil
+	}
+
+	policy := redact.DefaultPolicy()
+	for _, rule := range r.Rules {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			continue
+		}
+		policy.Rules = append(policy.Rules, redact.Rule{Name: rule.Name, Pattern: pattern})
+	}
+
+	switch {
+	case r.EntropyThreshold < 0:
+		policy.Entropy = nil
+	case r.EntropyThreshold > 0:
+		policy.Entropy.Threshold = r.EntropyThreshold
+	}
+	if policy.Entropy != nil && r.EntropyMinLength > 0 {
+		policy.Entropy.MinLength = r.EntropyMinLength
+	}
+	return &policy
+}
+
+// TracingConfig returns the tracing provider configuration. An unset file path
+// uses config.DefaultTracesFilePath.
+func TracingConfig(t config.TracingConfig) tracing.Config {
+	cfg := tracing.DefaultConfig()
+	cfg.Enabled = t.Enabled
+	cfg.Metrics = t.Metrics
+	cfg.MetricsInterval = t.MetricsInterval
+	cfg.SampleRate = t.SampleRate
+	if t.Exporter != "" {
+		cfg.Exporter = t.Exporter
+	}
+	if t.OTLPEndpoint != "" {
+		cfg.OTLPEndpoint = t.OTLPEndpoint
+	}
+	cfg.FilePath = t.FilePath
+	if cfg.FilePath == "" {
+		cfg.FilePath = config.DefaultTracesFilePath()
+	}
+	return cfg
+}
@@ -0,0 +1,138 @@
+package controlplane
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/ratelimit"
+	"github.com/zjrosen/perles/internal/orchestration/redact"
+	"github.com/zjrosen/perles/internal/orchestration/turnpolicy"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
+)
+
+func TestBudgets(t *testing.T) {
+	budget := config.BudgetConfig{WorkerTokens: 2_000_000, WorkerDuration: 45 * time.Minute, SessionDuration: 4 * time.Hour}
+	require.Equal(t, 2_000_000, WorkerBudget(budget).Tokens)
+	require.Equal(t, 45*time.Minute, WorkerBudget(budget).Duration)
+	require.Equal(t, 4*time.Hour, SessionBudget(budget).Duration)
+	require.True(t, SessionBudget(config.BudgetConfig{}).IsZero())
+}
+
+func TestAutoscalePolicy(t *testing.T) {
+	require.Nil(t, AutoscalePolicy(config.AutoscaleConfig{MaxWorkers: 4}))
+
+	policy := AutoscalePolicy(config.AutoscaleConfig{Enabled: true, MinWorkers: 1, MaxWorkers: 4, MaxCostUSD: 20, IdleTimeout: 10 * time.Minute})
+	require.NotNil(t, policy)
+	require.Equal(t, 1, policy.MinWorkers)
+	require.Equal(t, 4, policy.MaxWorkers)
+	require.InDelta(t, 20.0, policy.MaxCostUSD, 1e-9)
+	require.Equal(t, 10*time.Minute, policy.IdleTimeout)
+}
+
+func TestWebhookConfig(t *testing.T) {
+	cfg := WebhookConfig(config.WebhooksConfig{
+		Endpoints: []config.WebhookEndpointConfig{
+			{URL: "https://hooks.slack.com/services/T000", Events: []string{"review.denied"}},
+			{URL: "https://ci.example.com/perles", Secret: "s3cret"},
+		},
+		MaxAttempts: 3,
+		Backoff:     2 * time.Second,
+		DeadLetter:  "/tmp/dead.jsonl",
+	})
+	require.Len(t, cfg.Endpoints, 2)
+	require.Equal(t, []string{"review.denied"}, cfg.Endpoints[0].Events)
+	require.Equal(t, "s3cret", cfg.Endpoints[1].Secret)
+	require.Equal(t, 3, cfg.MaxAttempts)
+	require.Equal(t, 2*time.Second, cfg.InitialBackoff)
+	require.Equal(t, "/tmp/dead.jsonl", cfg.DeadLetterPath)
+	require.NoError(t, cfg.Validate())
+	require.Equal(t, config.DefaultWebhookDeadLetterPath(), WebhookConfig(config.WebhooksConfig{}).DeadLetterPath)
+
+	// Config validation accepts every event the dispatcher delivers
+	all := config.WebhooksConfig{Endpoints: []config.WebhookEndpointConfig{{URL: "https://x", Events: webhook.Events}}}
+	require.NoError(t, config.ValidateWebhooks(all))
+}
+
+func TestRateLimitPolicy(t *testing.T) {
+	policy := RateLimitPolicy(config.RateLimitsConfig{
+		Process: config.RateLimitConfig{Calls: 120},
+		Tools:   map[string]config.RateLimitConfig{"fabric_send": {Calls: 20, Per: 10 * time.Second}},
+	})
+	require.Equal(t, ratelimit.Limit{Calls: 120, Per: time.Minute}, policy.Process)
+	require.Equal(t, ratelimit.Limit{Calls: 20, Per: 10 * time.Second}, policy.Tools["fabric_send"])
+	require.True(t, RateLimitPolicy(config.RateLimitsConfig{}).Process.IsZero())
+}
+
+func TestPhaseTimeouts(t *testing.T) {
+	timeouts := PhaseTimeouts(config.PhaseTimeoutsConfig{
+		Phases:  map[string]time.Duration{"reviewing": time.Hour},
+		Workers: map[string]map[string]time.Duration{"worker-3": {"reviewing": 0}},
+	})
+	require.Equal(t, time.Hour, timeouts.For("worker-1", events.ProcessPhaseReviewing))
+	require.Zero(t, timeouts.For("worker-3", events.ProcessPhaseReviewing))
+	require.Zero(t, timeouts.For("worker-1", events.ProcessPhaseImplementing))
+	require.True(t, PhaseTimeouts(config.PhaseTimeoutsConfig{}).IsZero())
+}
+
+func TestTurnPolicy(t *testing.T) {
+	policy := TurnPolicy(config.TurnPolicyConfig{
+		Escalation: []string{"nudge", "warn", "replace"},
+		Timeout:    10 * time.Minute,
+		Phases:     map[string]config.TurnPolicyPhaseConfig{"reviewing": {Tools: []string{"report_review_verdict"}}},
+	})
+	require.Equal(t, []turnpolicy.Action{turnpolicy.ActionNudge, turnpolicy.ActionWarn, turnpolicy.ActionReplace}, policy.Escalation)
+	require.Equal(t, []string{"report_review_verdict"}, policy.RequiredTools("reviewing"))
+	require.Equal(t, turnpolicy.DefaultTools, policy.RequiredTools("implementing"))
+	require.Equal(t, 10*time.Minute, policy.GraceTimeout("reviewing"))
+	require.NoError(t, policy.Validate())
+
+	// Config validation accepts every escalation action the policy does
+	var actions []string
+	for _, a := range turnpolicy.Actions {
+		actions = append(actions, string(a))
+	}
+	require.NoError(t, config.ValidateTurnPolicy(config.TurnPolicyConfig{Escalation: actions}))
+}
+
+func TestRedactionPolicy(t *testing.T) {
+	require.Nil(t, RedactionPolicy(config.RedactionConfig{Disabled: true}))
+
+	// On by default with the built-in rules and entropy detection
+	policy := RedactionPolicy(config.Defaults().Orchestration.Redaction)
+	require.NotNil(t, policy)
+	require.Len(t, policy.Rules, len(redact.DefaultRules()))
+	require.NotNil(t, policy.Entropy)
+	require.InDelta(t, redact.DefaultEntropyThreshold, policy.Entropy.Threshold, 1e-9)
+
+	policy = RedactionPolicy(config.RedactionConfig{
+		Rules:            []config.RedactionRuleConfig{{Name: "ticket", Pattern: `CORP-\d+`}},
+		EntropyThreshold: 5,
+		EntropyMinLength: 40,
+	})
+	require.Equal(t, "ticket", policy.Rules[len(policy.Rules)-1].Name)
+	require.Equal(t, redact.EntropyRule{MinLength: 40, Threshold: 5}, *policy.Entropy)
+
+	require.Nil(t, RedactionPolicy(config.RedactionConfig{EntropyThreshold: -1}).Entropy)
+}
+
+func TestTracingConfig(t *testing.T) {
+	cfg := TracingConfig(config.TracingConfig{
+		Enabled:         true,
+		Metrics:         true,
+		MetricsInterval: 30 * time.Second,
+		SampleRate:      0.5,
+	})
+
+	require.True(t, cfg.Enabled)
+	require.True(t, cfg.Metrics)
+	require.Equal(t, 30*time.Second, cfg.MetricsInterval)
+	require.Equal(t, 0.5, cfg.SampleRate)
+	require.Equal(t, "file", cfg.Exporter, "unset exporter falls back to the default")
+	require.Equal(t, "localhost:4317", cfg.OTLPEndpoint, "unset endpoint falls back to the default")
+	require.Equal(t, config.DefaultTracesFilePath(), cfg.FilePath, "unset file path falls back to the traces directory")
+	require.Equal(t, "perles-orchestrator", cfg.ServiceName)
+}
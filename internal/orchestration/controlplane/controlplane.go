@@ -37,6 +37,14 @@ type ControlPlane interface {
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
 	Resume(ctx context.Context, id WorkflowID) error
 
+	// Stop terminates a running or paused workflow and releases its resources.
+	// The workflow ends in the Failed state; a running workflow is paused first
+	// so its session can still be resumed later.
+	// Returns ErrWorkflowNotFound if the workflow does not exist, ErrInvalidState
+	// if it is not running or paused, and ErrUncommittedChanges if its worktree
+	// has uncommitted changes and opts.Force is false.
+	Stop(ctx context.Context, id WorkflowID, opts StopOptions) error
+
 	// Complete marks a workflow as completed and persists the final state.
 	// This should be called when the coordinator signals completion.
 	// Returns ErrWorkflowNotFound if the workflow does not exist.
@@ -379,6 +387,47 @@ func (cp *defaultControlPlane) Complete(ctx context.Context, id WorkflowID) erro
 	return nil
 }
 
+// Stop terminates a running or paused workflow and persists the final state.
+func (cp *defaultControlPlane) Stop(ctx context.Context, id WorkflowID, opts StopOptions) error {
+	inst, ok := cp.registry.Get(id)
+	if !ok {
+		return ErrWorkflowNotFound
+	}
+	if inst.State != WorkflowRunning && inst.State != WorkflowPaused {
+		return fmt.Errorf("%w: cannot stop workflow in state %s", ErrInvalidState, inst.State)
+	}
+
+	if err := cp.stopWorkflow(ctx, id, opts); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	inst.CompletedAt = &now
+
+	//nolint:staticcheck // SA9003: Intentionally ignoring error - in-memory state is authoritative
+	if err := cp.registry.Update(id, func(w *WorkflowInstance) {
+		w.State = inst.State
+		w.CompletedAt = inst.CompletedAt
+	}); err != nil {
+		// Log but don't fail - the in-memory state is already updated
+	}
+
+	log.Info(log.CatOrch, "Workflow stopped", "workflowID", id, "reason", opts.Reason, "force", opts.Force)
+
+	// Emit workflow failed event
+	cp.eventBus.Publish(ControlPlaneEvent{
+		Type:         EventWorkflowFailed,
+		WorkflowID:   inst.ID,
+		WorkflowName: inst.Name,
+		TemplateID:   inst.TemplateID,
+		State:        inst.State,
+		Timestamp:    now,
+		Payload:      WorkflowStoppedPayload{Reason: opts.Reason, Force: opts.Force},
+	})
+
+	return nil
+}
+
 // Fail marks a workflow as failed and persists the final state.
 func (cp *defaultControlPlane) Fail(ctx context.Context, id WorkflowID) error {
 	// Get workflow from registry
@@ -923,6 +923,57 @@ func TestControlPlane_Fail_ReturnsErrorFromTerminalState(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid state transition")
 }
 
+// === Unit Tests: Stop ===
+
+func TestControlPlane_Stop_ShutsDownRunningWorkflow(t *testing.T) {
+	cp, mockFactory, mockProvider := newTestControlPlane(t)
+
+	id, err := cp.Create(context.Background(), WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build a feature"})
+	require.NoError(t, err)
+	cleanupWorkflowSessionOnTestEnd(t, cp, id)
+
+	infra := createTestInfrastructure(t)
+	mockFactory.On("Create", mock.AnythingOfType("v2.InfrastructureConfig")).Return(infra, nil)
+	setupTestAgentProviderMock(t, mockProvider)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+	require.NoError(t, cp.Start(ctx, id))
+
+	eventCh, unsubscribe := cp.SubscribeFiltered(ctx, EventFilter{Types: []EventType{EventWorkflowFailed}})
+	defer unsubscribe()
+
+	require.NoError(t, cp.Stop(ctx, id, StopOptions{Reason: "dashboard"}))
+
+	inst, err := cp.Get(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, WorkflowFailed, inst.State)
+	require.NotNil(t, inst.CompletedAt)
+
+	select {
+	case received := <-eventCh:
+		require.Equal(t, id, received.WorkflowID)
+		require.Equal(t, WorkflowStoppedPayload{Reason: "dashboard"}, received.Payload)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for EventWorkflowFailed event")
+	}
+}
+
+func TestControlPlane_Stop_RejectsPendingWorkflow(t *testing.T) {
+	cp, _, _ := newTestControlPlane(t)
+	ctx := context.Background()
+
+	id, err := cp.Create(ctx, WorkflowSpec{TemplateID: "test-template", InitialPrompt: "Build a feature"})
+	require.NoError(t, err)
+
+	err = cp.Stop(ctx, id, StopOptions{})
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	require.ErrorIs(t, cp.Stop(ctx, NewWorkflowID(), StopOptions{}), ErrWorkflowNotFound)
+}
+
 // === Unit Tests: Get ===
 
 func TestControlPlane_Get_RetrievesWorkflowFromRegistry(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 	"slices"
 	"time"
 
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
@@ -55,9 +56,21 @@ const (
 	// Command log events (for debug mode)
 	EventCommandLog EventType = "command.log"
 
+	// Command progress events (long-running commands started/finished)
+	EventCommandProgress EventType = "command.progress"
+
 	// Fabric events (inter-agent messaging)
 	EventFabricPosted EventType = "fabric.posted"
 
+	// Autoscaler events (worker pool scaling decisions)
+	EventAutoscale EventType = "autoscale.decision"
+
+	// Phase timeout events (escalation of workers stuck in a phase)
+	EventPhaseTimeout EventType = "worker.phase_timeout"
+
+	// Issue events (bd issue changes made by orchestration)
+	EventIssueUpdated EventType = "issue.updated"
+
 	// Unknown event type for unclassified events
 	EventUnknown EventType = "unknown"
 )
@@ -123,7 +136,15 @@ type WorkflowPausedPayload struct {
 	TriggeredBy string
 }
 
-// ClassifyEvent maps a v2 ProcessEvent, CommandLogEvent, or fabric.Event to the appropriate ControlPlane EventType.
+// WorkflowStoppedPayload contains details about a workflow stopped by the user.
+type WorkflowStoppedPayload struct {
+	// Reason why the workflow was stopped.
+	Reason string
+	// Force indicates uncommitted worktree changes were discarded.
+	Force bool
+}
+
+// ClassifyEvent maps a v2 ProcessEvent, CommandLogEvent, CommandProgressEvent, PhaseTimeoutEvent, autoscale.Decision, IssueEvent, or fabric.Event to the appropriate ControlPlane EventType.
 // It inspects the event's Type and Role to determine the correct classification.
 // Unknown events are mapped to EventUnknown.
 func ClassifyEvent(v2Event any) EventType {
@@ -132,11 +153,31 @@ func ClassifyEvent(v2Event any) EventType {
 		return EventFabricPosted
 	}
 
+	// Check for autoscaler scaling decisions
+	if _, ok := v2Event.(autoscale.Decision); ok {
+		return EventAutoscale
+	}
+
+	// Check for phase timeout escalations
+	if _, ok := v2Event.(processor.PhaseTimeoutEvent); ok {
+		return EventPhaseTimeout
+	}
+
+	// Check for bd issue changes
+	if _, ok := v2Event.(events.IssueEvent); ok {
+		return EventIssueUpdated
+	}
+
 	// Check for CommandLogEvent (debug mode command logging)
 	if _, ok := v2Event.(processor.CommandLogEvent); ok {
 		return EventCommandLog
 	}
 
+	// Check for CommandProgressEvent (long-running command progress)
+	if _, ok := v2Event.(processor.CommandProgressEvent); ok {
+		return EventCommandProgress
+	}
+
 	processEvent, ok := v2Event.(events.ProcessEvent)
 	if !ok {
 		return EventUnknown
@@ -182,8 +223,8 @@ func ClassifyEvent(v2Event any) EventType {
 			return EventWorkerOutput
 		}
 
-	case events.ProcessReady, events.ProcessWorking, events.ProcessTokenUsage, events.ProcessQueueChanged:
-		// Ready/Working/TokenUsage/QueueChanged state transitions - classify by role
+	case events.ProcessReady, events.ProcessWorking, events.ProcessTokenUsage, events.ProcessQueueChanged, events.ProcessTelemetry:
+		// Ready/Working/TokenUsage/QueueChanged/Telemetry updates - classify by role
 		switch processEvent.Role {
 		case events.RoleCoordinator:
 			return EventCoordinatorOutput
@@ -256,7 +297,8 @@ func (t EventType) IsWorkerEvent() bool {
 	case EventWorkerSpawned,
 		EventWorkerRetired,
 		EventWorkerOutput,
-		EventWorkerIncoming:
+		EventWorkerIncoming,
+		EventPhaseTimeout:
 		return true
 	default:
 		return false
@@ -304,6 +346,11 @@ func (t EventType) IsFabricEvent() bool {
 	return t == EventFabricPosted
 }
 
+// IsIssueEvent returns true if the event type is an issue event.
+func (t EventType) IsIssueEvent() bool {
+	return t == EventIssueUpdated
+}
+
 // String returns the string representation of the EventType.
 func (t EventType) String() string {
 	return string(t)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
@@ -44,8 +45,15 @@ func TestEventTypeConstants(t *testing.T) {
 		{"HealthRecovered", EventHealthRecovered, "health.recovered"},
 		// Command log events
 		{"CommandLog", EventCommandLog, "command.log"},
+		{"CommandProgress", EventCommandProgress, "command.progress"},
 		// Fabric events
 		{"FabricPosted", EventFabricPosted, "fabric.posted"},
+		// Autoscaler events
+		{"Autoscale", EventAutoscale, "autoscale.decision"},
+		// Phase timeout events
+		{"PhaseTimeout", EventPhaseTimeout, "worker.phase_timeout"},
+		// Issue events
+		{"IssueUpdated", EventIssueUpdated, "issue.updated"},
 		// Unknown
 		{"Unknown", EventUnknown, "unknown"},
 	}
@@ -423,6 +431,7 @@ func TestIsWorkerEvent(t *testing.T) {
 		EventWorkerSpawned,
 		EventWorkerRetired,
 		EventWorkerOutput,
+		EventPhaseTimeout,
 	}
 
 	for _, e := range workerEvents {
@@ -866,6 +875,28 @@ func TestClassifyEvent_FabricEvent(t *testing.T) {
 	require.Equal(t, EventFabricPosted, result)
 }
 
+func TestClassifyEvent_AutoscaleDecision(t *testing.T) {
+	d := autoscale.Decision{Action: autoscale.ActionScaleUp, Spawn: 1}
+	require.Equal(t, EventAutoscale, ClassifyEvent(d))
+}
+
+func TestClassifyEvent_PhaseTimeoutEvent(t *testing.T) {
+	e := processor.PhaseTimeoutEvent{WorkerID: "worker-1", Phase: events.ProcessPhaseReviewing, Action: processor.PhaseTimeoutNudge}
+	require.Equal(t, EventPhaseTimeout, ClassifyEvent(e))
+}
+
+func TestClassifyEvent_IssueEvent(t *testing.T) {
+	e := events.IssueEvent{Type: events.IssueCommented, IssueID: "perles-abc1", Author: "worker-1"}
+	require.Equal(t, EventIssueUpdated, ClassifyEvent(e))
+	require.True(t, EventIssueUpdated.IsIssueEvent())
+	require.False(t, EventTaskCompleted.IsIssueEvent())
+}
+
+func TestClassifyEvent_CommandProgress(t *testing.T) {
+	e := processor.CommandProgressEvent{CommandID: "cmd-1", Phase: processor.ProgressStarted}
+	require.Equal(t, EventCommandProgress, ClassifyEvent(e))
+}
+
 func TestClassifyEvent_OtherTypes_Unchanged(t *testing.T) {
 	// Verify existing classifications still work after adding fabric.Event support
 	tests := []struct {
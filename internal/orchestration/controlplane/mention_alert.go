@@ -0,0 +1,44 @@
+package controlplane
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/zjrosen/perles/internal/notify"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/sound"
+)
+
+// userMentionAlert returns a fabric event handler that plays a sound and shows a
+// desktop notification when an agent @mentions the user in an urgent message.
+// The broker skips these mentions because the user isn't a process, so without
+// this they only surface in the TUI message log. Normal and low priority
+// mentions stay there, so routine status updates don't interrupt the user.
+func userMentionAlert(soundService sound.SoundService, notifier notify.Notifier) func(fabric.Event) {
+	if soundService == nil {
+		soundService = sound.NoopSoundService{}
+	}
+	if notifier == nil {
+		notifier = notify.NoopNotifier{}
+	}
+
+	return func(event fabric.Event) {
+		if event.Type != fabric.EventMessagePosted && event.Type != fabric.EventReplyPosted {
+			return
+		}
+		if event.Thread == nil || event.Thread.CreatedBy == domain.AgentUser {
+			return
+		}
+		if event.Thread.Priority != domain.PriorityUrgent {
+			return
+		}
+		if !slices.Contains(event.Mentions, domain.AgentUser) {
+			return
+		}
+
+		title := fmt.Sprintf("Perles: @%s in #%s", event.Thread.CreatedBy, event.ChannelSlug)
+		soundService.Play("notification", "fabric_user_mention")
+		notifier.Notify(title, event.Thread.Content, "fabric_user_mention")
+	}
+}
@@ -0,0 +1,55 @@
+package controlplane
+
+import (
+	"testing"
+
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+func TestUserMentionAlert_AlertsOnUserMention(t *testing.T) {
+	soundService := mocks.NewMockSoundService(t)
+	notifier := mocks.NewMockNotifier(t)
+	soundService.EXPECT().Play("notification", "fabric_user_mention").Once()
+	notifier.EXPECT().Notify("Perles: @worker-1 in #tasks", "@user need a decision on the schema", "fabric_user_mention").Once()
+
+	alert := userMentionAlert(soundService, notifier)
+	msg := &domain.Thread{
+		CreatedBy: "worker-1",
+		Content:   "@user need a decision on the schema",
+		Mentions:  []string{domain.AgentUser},
+		Priority:  domain.PriorityUrgent,
+	}
+	alert(fabric.NewMessagePostedEvent(msg, "ch-1", "tasks"))
+}
+
+func TestUserMentionAlert_IgnoresOtherEvents(t *testing.T) {
+	// Mocks fail on any unexpected call
+	alert := userMentionAlert(mocks.NewMockSoundService(t), mocks.NewMockNotifier(t))
+
+	// Mentions of other agents
+	alert(fabric.NewMessagePostedEvent(&domain.Thread{
+		CreatedBy: "worker-1",
+		Mentions:  []string{"coordinator"},
+		Priority:  domain.PriorityUrgent,
+	}, "ch-1", "tasks"))
+
+	// Normal and low priority user mentions
+	for _, priority := range []domain.Priority{"", domain.PriorityNormal, domain.PriorityLow} {
+		alert(fabric.NewMessagePostedEvent(&domain.Thread{
+			CreatedBy: "worker-1",
+			Mentions:  []string{domain.AgentUser},
+			Priority:  priority,
+		}, "ch-1", "tasks"))
+	}
+
+	// The user mentioning themselves
+	alert(fabric.NewReplyPostedEvent(&domain.Thread{
+		CreatedBy: domain.AgentUser,
+		Mentions:  []string{domain.AgentUser},
+	}, "ch-1", "tasks", "msg-1", nil))
+
+	// Non-message events
+	alert(fabric.Event{Type: fabric.EventChannelCreated, Mentions: []string{domain.AgentUser}})
+}
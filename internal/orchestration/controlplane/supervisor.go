@@ -8,29 +8,45 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
 	"github.com/zjrosen/perles/internal/flags"
 	appgit "github.com/zjrosen/perles/internal/git/application"
 	domaingit "github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/notify"
+	"github.com/zjrosen/perles/internal/orchestration/audit"
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/codesearch"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/memory"
 	fabricpersist "github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
 	"github.com/zjrosen/perles/internal/orchestration/mcp"
+	"github.com/zjrosen/perles/internal/orchestration/ratelimit"
 	"github.com/zjrosen/perles/internal/orchestration/session"
+	"github.com/zjrosen/perles/internal/orchestration/tracing"
+	"github.com/zjrosen/perles/internal/orchestration/turnpolicy"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
 	"github.com/zjrosen/perles/internal/pubsub"
 	"github.com/zjrosen/perles/internal/sound"
 )
@@ -151,9 +167,83 @@ type SupervisorConfig struct {
 	// Optional - if nil, uses NoopSoundService (no audio).
 	SoundService sound.SoundService
 
+	// Notifier shows desktop notifications for events that need the user's attention.
+	// Optional - if nil, uses NoopNotifier (no desktop notifications).
+	Notifier notify.Notifier
+
 	// BeadsDir is the resolved path to the beads database directory.
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
+
+	// Autoscale sizes each workflow's worker pool to its ready-task backlog.
+	// Optional - if nil, workers are only spawned by the coordinator.
+	Autoscale *autoscale.Policy
+
+	// WorkerBudget and SessionBudget cap token and wall-clock usage (zero = unlimited).
+	// Workers over budget are replaced; see processor.BudgetEnforcer.
+	WorkerBudget  repository.Budget
+	SessionBudget repository.Budget
+
+	// PhaseTimeouts limits how long workers may stay in each phase (zero = unlimited);
+	// see processor.PhaseTimeoutWatcher.
+	PhaseTimeouts processor.PhaseTimeouts
+
+	// Solo runs workflows without a coordinator agent; see processor.SoloDispatcher.
+	// Optional - if nil, workflows are driven by a coordinator.
+	Solo *SoloOptions
+
+	// PruningHints sends workers context pruning hints on phase transitions;
+	// see processor.PruningHinter.
+	PruningHints bool
+
+	// WorkerWorktrees gives each worker its own git worktree and branch;
+	// see processor.WorkerWorktrees.
+	WorkerWorktrees bool
+
+	// FabricStorage selects the Fabric message graph backend: "memory" (default)
+	// or "sqlite" to keep channel and thread history in the session directory.
+	FabricStorage string
+
+	// ProjectMemory enables the #memory channel shared by all sessions of a project.
+	// Entries are kept next to the project's sessions and surfaced in the coordinator's startup brief.
+	ProjectMemory bool
+
+	// CustomFields are the project's custom issue fields, exposed to the coordinator's task tools.
+	CustomFields []beads.FieldDef
+
+	// RateLimits limits how often each process may call MCP tools (zero = unlimited).
+	// Calls are counted either way; see WorkflowInstance.RateLimiter.
+	RateLimits ratelimit.Policy
+
+	// Dedup configures how repeated messages of a process are suppressed.
+	// Suppressions are counted; see WorkflowInstance.Deduplicator.
+	Dedup mcp.DedupPolicy
+
+	// TurnPolicy decides the tools that complete a worker's turn and the
+	// escalation when none is called; see turnpolicy.Policy.
+	TurnPolicy turnpolicy.Policy
+
+	// AuditMaxSize is the size in bytes at which a session's audit log
+	// (audit.jsonl) is rotated. Zero uses audit.DefaultMaxSize.
+	AuditMaxSize int64
+
+	// DAGPath is a workflow DAG file (see dag.Definition) loaded when each workflow
+	// starts; relative paths are resolved against the workflow's work directory.
+	// Optional - if empty, workflows have no phases.
+	DAGPath string
+
+	// Webhooks posts lifecycle events of every workflow to the configured
+	// endpoints. The caller owns it and closes it after shutting down.
+	// Optional - if nil, no webhooks are sent.
+	Webhooks *webhook.Dispatcher
+
+	// Tracer traces commands and MCP tool calls end to end.
+	// Optional - if nil, nothing is traced.
+	Tracer trace.Tracer
+
+	// Metrics records command throughput, queue depth and per-phase latency.
+	// Optional - if nil, no metrics are recorded.
+	Metrics *tracing.Metrics
 }
 
 // defaultSupervisor is the default implementation of Supervisor.
@@ -167,7 +257,35 @@ type defaultSupervisor struct {
 	flags                 *flags.Registry
 	sessionFactory        *session.Factory
 	soundService          sound.SoundService
+	notifier              notify.Notifier
 	beadsDir              string
+	autoscale             *autoscale.Policy
+	fabricStorage         string
+	projectMemory         bool
+	customFields          []beads.FieldDef
+	rateLimits            ratelimit.Policy
+	dedup                 mcp.DedupPolicy
+	turnPolicy            turnpolicy.Policy
+	auditMaxSize          int64
+	dagPath               string
+	webhooks              *webhook.Dispatcher
+	tracer                trace.Tracer
+	metrics               *tracing.Metrics
+	workerBudget          repository.Budget
+	sessionBudget         repository.Budget
+	phaseTimeouts         processor.PhaseTimeouts
+	solo                  *SoloOptions
+	pruningHints          bool
+	workerWorktrees       bool
+}
+
+// SoloOptions configures solo mode workflows.
+type SoloOptions struct {
+	// Workers is the number of workers spawned when the workflow starts.
+	Workers int
+	// Coordinator also spawns a coordinator that receives escalations
+	// (review denials and worker failures) in addition to the user.
+	Coordinator bool
 }
 
 // NewSupervisor creates a new Supervisor with the given configuration.
@@ -209,7 +327,26 @@ func NewSupervisor(cfg SupervisorConfig) (Supervisor, error) {
 		flags:                 cfg.Flags,
 		sessionFactory:        cfg.SessionFactory,
 		soundService:          cfg.SoundService,
+		notifier:              cfg.Notifier,
 		beadsDir:              cfg.BeadsDir,
+		autoscale:             cfg.Autoscale,
+		fabricStorage:         cfg.FabricStorage,
+		projectMemory:         cfg.ProjectMemory,
+		customFields:          cfg.CustomFields,
+		rateLimits:            cfg.RateLimits,
+		dedup:                 cfg.Dedup,
+		turnPolicy:            cfg.TurnPolicy,
+		auditMaxSize:          cfg.AuditMaxSize,
+		dagPath:               cfg.DAGPath,
+		webhooks:              cfg.Webhooks,
+		tracer:                cfg.Tracer,
+		metrics:               cfg.Metrics,
+		workerBudget:          cfg.WorkerBudget,
+		sessionBudget:         cfg.SessionBudget,
+		phaseTimeouts:         cfg.PhaseTimeouts,
+		solo:                  cfg.Solo,
+		pruningHints:          cfg.PruningHints,
+		workerWorktrees:       cfg.WorkerWorktrees,
 	}, nil
 }
 
@@ -245,6 +382,7 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		worktreePath string
 		gitExec      appgit.GitExecutor
 		sess         *session.Session
+		auditLog     *audit.Log
 	)
 
 	// Cleanup function for error cases
@@ -258,6 +396,7 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		if infra != nil {
 			infra.Shutdown()
 		}
+		_ = auditLog.Close()
 		// Close session to release file handles
 		if sess != nil {
 			_ = sess.Close(session.StatusFailed)
@@ -363,6 +502,20 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 
 	// Step 3: Create or reopen session for this workflow
 	workDir := getWorkDir(inst)
+
+	// Load the workflow DAG before creating anything that outlives a bad definition
+	var workflowDAG *dag.Definition
+	if s.dagPath != "" {
+		dagPath := s.dagPath
+		if !filepath.IsAbs(dagPath) {
+			dagPath = filepath.Join(workDir, dagPath)
+		}
+		workflowDAG, err = dag.Load(dagPath)
+		if err != nil {
+			cleanup()
+			return fmt.Errorf("loading workflow DAG: %w", err)
+		}
+	}
 	if coldResume && inst.SessionDir != "" {
 		// Cold resume: reopen existing session directory to preserve message history
 		sess, err = session.Reopen(inst.ID.String(), inst.SessionDir)
@@ -400,9 +553,27 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		SessionRefNotifier:      sess,
 		SessionMetadataProvider: sess,
 		SoundService:            s.soundService,
+		Notifier:                s.notifier,
 		CommandPersistenceProvider: func() processor.CommandWriter {
 			return sess
 		},
+		FabricStorage:   s.fabricStorage,
+		WorkerBudget:    s.workerBudget,
+		SessionBudget:   s.sessionBudget,
+		PhaseTimeouts:   s.phaseTimeouts,
+		PruningHints:    s.pruningHints,
+		WorkerWorktrees: s.workerWorktrees,
+		TurnPolicy:      s.turnPolicy,
+		Tracer:          s.tracer,
+		Metrics:         s.metrics,
+		DAG:             workflowDAG,
+		Webhooks:        s.webhooks,
+		WorkflowID:      inst.ID.String(),
+		WorkflowName:    inst.Name,
+	}
+	if s.solo != nil {
+		infraCfg.SoloMode = true
+		infraCfg.SoloCoordinator = s.solo.Coordinator
 	}
 
 	// Step 5: Create Infrastructure
@@ -418,6 +589,8 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 	// Step 5.6: Create Fabric event logger and broker for mention-based notifications
 	var fabricLogger *fabricpersist.EventLogger
 	var fabricBroker *fabric.Broker
+	var memoryStore *memory.Store
+	var recordMemory func(fabric.Event)
 
 	if infra.Core.FabricService != nil {
 		// Create event logger (persists fabric.jsonl to session directory)
@@ -433,7 +606,11 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 			Subscriptions: infra.Core.FabricService.SubscriptionRepository(),
 			Participants:  infra.Core.FabricService.ParticipantRepository(),
 			SlugLookup:    infra.Core.FabricService,
+			Receipts:      infra.Core.FabricService,
+			Sender:        infra.Core.FabricService,
 		})
+		// The broker holds messages sent with deliver_at or delay until they are due
+		infra.Core.FabricService.SetScheduler(fabricBroker)
 
 		// Create forwarder that publishes fabric events to the control plane event bus.
 		// This enables the dashboard to receive fabric events for the message log.
@@ -441,12 +618,28 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 			infra.Core.EventBus.Publish(pubsub.UpdatedEvent, event)
 		}
 
-		// Wire all three handlers to FabricService using ChainHandler:
+		// Open the project memory log shared by all sessions of this project
+		if s.projectMemory {
+			memoryStore, err = memory.NewStore(s.sessionFactory.ProjectMemoryPath(workDir))
+			if err != nil {
+				// Non-critical - the workflow runs without #memory
+				log.Warn(log.CatOrch, "Failed to open project memory", "subsystem", "supervisor",
+					"workflowID", inst.ID, "error", err)
+			} else {
+				recordMemory = memory.NewRecorder(memoryStore, inst.ID.String()).HandleEvent
+			}
+		}
+
+		// Wire the handlers to FabricService using ChainHandler:
 		// 1. fabricLogger - persists events to fabric.jsonl
 		// 2. fabricBroker - handles @mention notifications
 		// 3. fabricForwarder - publishes events to control plane event bus for dashboard
+		// 4. userMentionAlert - sound and desktop notification when an agent @mentions the user
+		// 5. recordMemory - records #memory entries and pins (nil when project memory is off)
+		// 6. sess.HandleFabricEvent - appends events to the session timeline.jsonl
 		infra.Core.FabricService.SetEventHandler(
-			fabricpersist.ChainHandler(fabricLogger.HandleEvent, fabricBroker.HandleEvent, fabricForwarder),
+			fabricpersist.ChainHandler(fabricLogger.HandleEvent, fabricBroker.HandleEvent, fabricForwarder,
+				userMentionAlert(s.soundService, s.notifier), recordMemory, sess.HandleFabricEvent),
 		)
 
 		// Start the broker's event loop
@@ -473,6 +666,18 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		return fmt.Errorf("starting infrastructure: %w", err)
 	}
 
+	// Seed #memory with earlier sessions' entries and prepare the startup brief
+	if memoryStore != nil {
+		inst.ProjectMemoryBrief = s.seedProjectMemory(inst, infra.Core.FabricService, memoryStore)
+	}
+
+	// Open the audit log of the coordinator's consequential decisions (appends on cold resume)
+	auditLog, err = audit.Open(sess.Dir, audit.Config{MaxSize: s.auditMaxSize, Redactor: sess.Redactor()})
+	if err != nil {
+		cleanup()
+		return fmt.Errorf("opening audit log: %w", err)
+	}
+
 	// Create coordinator MCP server with the v2 adapter
 	// Note: BeadsDir is empty here; the v2 infrastructure config handles BEADS_DIR for spawned processes
 	mcpCoordServer := mcp.NewCoordinatorServerWithV2Adapter(
@@ -482,6 +687,21 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 		infra.Core.Adapter,
 	)
 
+	mcpCoordServer.SetCustomFields(s.customFields)
+	if infra.Internal.DAGRun != nil {
+		mcpCoordServer.SetWorkflowDAG(infra.Internal.DAGRun)
+	}
+
+	// One limiter for all processes of the workflow; each process is limited separately
+	rateLimiter := ratelimit.NewLimiter(s.rateLimits)
+	mcpCoordServer.SetRateLimiter(rateLimiter)
+
+	// One deduplicator for all processes of the workflow; each process's messages are tracked separately
+	deduplicator := mcp.NewMessageDeduplicatorWithPolicy(s.dedup)
+	mcpCoordServer.SetDeduplicator(deduplicator)
+	s.instrument(mcpCoordServer.Server, infra)
+	mcpCoordServer.SetAuditLog(auditLog)
+
 	// Wire Fabric messaging tools to coordinator MCP server
 	if infra.Core.FabricService != nil {
 		mcpCoordServer.SetFabricService(infra.Core.FabricService)
@@ -492,10 +712,24 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 
 	// Create worker server cache for /worker/ routes
 	// Pass sess as AccountabilityWriter so workers can persist their accountability summaries
-	workerServers := newWorkerServerCache(sess, infra.Core.Adapter, infra.Internal.TurnEnforcer, infra.Core.FabricService, sess, workflowCtx)
+	workerServers := newWorkerServerCache(sess, infra.Core.Adapter, infra.Internal.TurnEnforcer, infra.Core.FabricService,
+		codesearch.New(workDir), sess, workflowCtx)
+	workerServers.decisionWriter = sess
+	if store, ok := infra.Core.BeadsExecutor.(mcp.TaskCommentStore); ok {
+		workerServers.taskComments = store
+	}
+	if infra.Internal.WorkerWorktrees != nil {
+		workerServers.worktrees = infra.Internal.WorkerWorktrees
+	}
+	workerServers.rateLimiter = rateLimiter
+	workerServers.deduplicator = deduplicator
+	workerServers.instrument = func(server *mcp.Server) { s.instrument(server, infra) }
 
 	// Create observer MCP server (singleton - one observer per workflow)
 	observerServer := mcp.NewObserverServer(repository.ObserverID)
+	observerServer.SetRateLimiter(rateLimiter)
+	observerServer.SetDeduplicator(deduplicator)
+	s.instrument(observerServer.Server, infra)
 	if infra.Core.FabricService != nil {
 		observerServer.SetFabricService(infra.Core.FabricService)
 	}
@@ -534,9 +768,24 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 	inst.Cancel = cancel
 	inst.HTTPServer = httpServer
 	inst.MCPCoordServer = mcpCoordServer
+	inst.RateLimiter = rateLimiter
+	inst.Deduplicator = deduplicator
 	inst.Session = sess // May be nil if session factory not configured
 	inst.FabricBroker = fabricBroker
 	inst.FabricLogger = fabricLogger
+	inst.AuditLog = auditLog
+
+	// Start the autoscaler. Decisions are published on the workflow event bus.
+	if s.autoscale != nil {
+		inst.Autoscaler = autoscale.NewAutoscaler(autoscale.Config{
+			Policy:       *s.autoscale,
+			Tasks:        infrabeads.NewBDExecutor(workDir, s.beadsDir),
+			Processes:    infra.Repositories.ProcessRepo,
+			CmdSubmitter: infra.Core.CmdSubmitter,
+			EventBus:     infra.Core.EventBus,
+		})
+		inst.Autoscaler.Start(workflowCtx)
+	}
 
 	// For cold resume: restore ProcessRepository and ProcessRegistry from session data.
 	// This populates the coordinator and worker processes so Resume() can find them.
@@ -551,6 +800,19 @@ func (s *defaultSupervisor) AllocateResources(ctx context.Context, inst *Workflo
 	return nil
 }
 
+// instrument traces and measures the tool calls of an MCP server of infra's
+// workflow. Tool call spans join the trace of the command that delivered the
+// caller's turn.
+func (s *defaultSupervisor) instrument(server *mcp.Server, infra *v2.Infrastructure) {
+	if s.tracer != nil {
+		server.SetTracer(s.tracer)
+		server.SetTurnTraces(infra.Internal.TurnTraces)
+	}
+	if s.metrics != nil {
+		server.SetMetrics(s.metrics)
+	}
+}
+
 // SpawnCoordinator spawns the coordinator process for a workflow.
 // Must be called after AllocateResources. Transitions the workflow to Running state.
 // If observer is enabled, also spawns the observer sequentially after coordinator.
@@ -564,6 +826,22 @@ func (s *defaultSupervisor) SpawnCoordinator(ctx context.Context, inst *Workflow
 			ErrInvalidState, inst.State, WorkflowPending)
 	}
 
+	if s.solo != nil {
+		return s.spawnSolo(ctx, inst)
+	}
+
+	initialPrompt := inst.InitialPrompt
+	if inst.ProjectMemoryBrief != "" {
+		if initialPrompt == "" {
+			defaultPrompt, err := prompt.BuildCoordinatorInitialPrompt()
+			if err != nil {
+				return fmt.Errorf("building coordinator initial prompt: %w", err)
+			}
+			initialPrompt = defaultPrompt
+		}
+		initialPrompt = strings.TrimRight(initialPrompt, "\n") + "\n\n" + inst.ProjectMemoryBrief
+	}
+
 	// Spawn coordinator
 	spawnCmd := command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleCoordinator, command.WithWorkflowConfig(&roles.WorkflowConfig{
 		// TODO we need to figure out what we want to do here, currently
@@ -572,7 +850,7 @@ func (s *defaultSupervisor) SpawnCoordinator(ctx context.Context, inst *Workflow
 		// WorkflowSpec to include a SystemPrompt and InitialPrompt and update the web and tui clients
 		// as well.
 		// SystemPromptOverride: "",
-		InitialPromptOverride: inst.InitialPrompt,
+		InitialPromptOverride: initialPrompt,
 	}))
 	result, err := inst.Infrastructure.Core.Processor.SubmitAndWait(inst.Ctx, spawnCmd)
 	if err != nil {
@@ -594,6 +872,59 @@ func (s *defaultSupervisor) SpawnCoordinator(ctx context.Context, inst *Workflow
 	return nil
 }
 
+// spawnSolo starts a solo mode workflow: the worker pool, and the escalation
+// coordinator when enabled. Tasks are assigned by the processor once workers are ready.
+func (s *defaultSupervisor) spawnSolo(ctx context.Context, inst *WorkflowInstance) error {
+	cmdProcessor := inst.Infrastructure.Core.Processor
+
+	if s.solo.Coordinator {
+		spawnCmd := command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleCoordinator, command.WithWorkflowConfig(&roles.WorkflowConfig{
+			InitialPromptOverride: prompt.BuildSoloCoordinatorInitialPrompt(),
+		}))
+		result, err := cmdProcessor.SubmitAndWait(inst.Ctx, spawnCmd)
+		if err != nil {
+			return fmt.Errorf("spawning coordinator: %w", err)
+		}
+		if !result.Success {
+			return fmt.Errorf("spawn coordinator failed: %w", result.Error)
+		}
+	}
+
+	for i := 0; i < s.solo.Workers; i++ {
+		result, err := cmdProcessor.SubmitAndWait(inst.Ctx, command.NewSpawnProcessCommand(command.SourceInternal, repository.RoleWorker))
+		if err != nil {
+			return fmt.Errorf("spawning solo worker: %w", err)
+		}
+		if !result.Success {
+			return fmt.Errorf("spawn solo worker failed: %w", result.Error)
+		}
+	}
+	log.Info(log.CatOrch, "Solo mode workflow started", "subsystem", "supervisor",
+		"workflowID", inst.ID, "workers", s.solo.Workers, "coordinator", s.solo.Coordinator)
+
+	s.spawnObserver(ctx, inst)
+
+	if err := inst.TransitionTo(WorkflowRunning); err != nil {
+		return fmt.Errorf("transitioning to Running: %w", err)
+	}
+	return nil
+}
+
+// seedProjectMemory imports the project memory log into the #memory channel and
+// returns the coordinator's startup brief. Failures are logged and yield no brief.
+func (s *defaultSupervisor) seedProjectMemory(inst *WorkflowInstance, svc *fabric.Service, store *memory.Store) string {
+	entries, err := store.Entries()
+	if err == nil {
+		err = memory.Seed(svc, entries, repository.CoordinatorID)
+	}
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to seed project memory", "subsystem", "supervisor",
+			"workflowID", inst.ID, "path", store.Path(), "error", err)
+		return ""
+	}
+	return memory.Brief(memory.Pinned(entries, memory.DefaultBriefLimit))
+}
+
 // spawnObserver spawns the observer process for a workflow if enabled.
 // Uses fail-open error handling: logs warnings but returns nil on error
 // to avoid blocking the workflow if observer fails to spawn.
@@ -782,6 +1113,7 @@ func (s *defaultSupervisor) restoreProcessStateFromSession(inst *WorkflowInstanc
 			resumableSession,
 			submitter,
 			inst.Infrastructure.Core.EventBus,
+			s.agentProviders,
 		); err != nil {
 			return fmt.Errorf("restoring process registry: %w", err)
 		}
@@ -819,9 +1151,16 @@ func (s *defaultSupervisor) restoreFabricState(inst *WorkflowInstance) error {
 	threads, deps, subs, acks, participants := inst.Infrastructure.Core.FabricService.Repositories()
 	reactions := inst.Infrastructure.Core.FabricService.ReactionRepository()
 
-	// Replay events to restore state
-	if err := fabricpersist.RestoreFabricState(events, threads, deps, subs, acks, participants, reactions); err != nil {
-		return fmt.Errorf("restoring fabric state: %w", err)
+	if durableFabricState(inst.Infrastructure.Internal.FabricStore) {
+		// Threads, dependencies, subscriptions and acks were reloaded from the store
+		if err := fabricpersist.RestoreEphemeralState(events, participants, reactions); err != nil {
+			return fmt.Errorf("restoring fabric participants and reactions: %w", err)
+		}
+	} else {
+		// Replay events to restore state
+		if err := fabricpersist.RestoreFabricState(events, threads, deps, subs, acks, participants, reactions); err != nil {
+			return fmt.Errorf("restoring fabric state: %w", err)
+		}
 	}
 
 	// Restore cached channel IDs in FabricService
@@ -836,6 +1175,17 @@ func (s *defaultSupervisor) restoreFabricState(inst *WorkflowInstance) error {
 	return nil
 }
 
+// durableFabricState reports whether the Fabric message graph was reloaded from a SQLite
+// store. An empty store (e.g. a session started with memory storage) still needs the
+// full event replay, which also migrates its history into the store.
+func durableFabricState(store *fabricrepo.SQLiteStore) bool {
+	if store == nil {
+		return false
+	}
+	empty, err := store.Empty()
+	return err == nil && !empty
+}
+
 // sendResumeContextMessage sends a system message to the coordinator explaining the pause context.
 // This triggers the delivery flow which spawns a new AI session and attaches it to the coordinator.
 func (s *defaultSupervisor) sendResumeContextMessage(inst *WorkflowInstance) error {
@@ -899,7 +1249,11 @@ func (s *defaultSupervisor) Shutdown(ctx context.Context, inst *WorkflowInstance
 		}
 	}
 
-	// Step 1: Stop Fabric broker and close logger (before session close to ensure all events are flushed)
+	// Step 1: Stop the autoscaler, Fabric broker and close logger (before session close to ensure all events are flushed)
+	if inst.Autoscaler != nil {
+		inst.Autoscaler.Stop()
+		inst.Autoscaler = nil
+	}
 	if inst.FabricBroker != nil {
 		inst.FabricBroker.Stop()
 		inst.FabricBroker = nil
@@ -911,6 +1265,13 @@ func (s *defaultSupervisor) Shutdown(ctx context.Context, inst *WorkflowInstance
 		}
 		inst.FabricLogger = nil
 	}
+	if inst.AuditLog != nil {
+		if err := inst.AuditLog.Close(); err != nil {
+			log.Debug(log.CatOrch, "Failed to close audit log", "subsystem", "supervisor",
+				"workflowID", inst.ID, "error", err)
+		}
+		inst.AuditLog = nil
+	}
 
 	// Step 2: Close the session if present (finalize session data before infrastructure shutdown)
 	if inst.Session != nil {
@@ -1002,9 +1363,16 @@ func (a *processorSubmitterAdapter) Submit(cmd command.Command) {
 // Workers connect via HTTP to /worker/{workerID}.
 type workerServerCache struct {
 	accountabilityWriter mcp.AccountabilityWriter
+	decisionWriter       mcp.DecisionWriter
 	v2Adapter            *adapter.V2Adapter
 	turnEnforcer         handler.TurnCompletionEnforcer
 	fabricService        *fabric.Service
+	codeSearcher         *codesearch.Searcher
+	worktrees            workerWorktreeLookup // Roots code search at a worker's worktree, nil = none
+	taskComments         mcp.TaskCommentStore
+	rateLimiter          *ratelimit.Limiter
+	deduplicator         *mcp.MessageDeduplicator
+	instrument           func(*mcp.Server) // Adds tracing and metrics, nil = none
 	servers              map[string]*mcp.WorkerServer
 	mu                   sync.RWMutex
 
@@ -1013,12 +1381,29 @@ type workerServerCache struct {
 	workflowCtx context.Context
 }
 
+// workerWorktreeLookup finds the git worktree a worker runs in.
+type workerWorktreeLookup interface {
+	Worktree(workerID string) (processor.WorkerWorktree, bool)
+}
+
+// searcherFor returns the code searcher for a worker: one rooted at the
+// worker's worktree when it has one, the shared searcher otherwise.
+func (c *workerServerCache) searcherFor(workerID string) *codesearch.Searcher {
+	if c.worktrees != nil {
+		if wt, ok := c.worktrees.Worktree(workerID); ok {
+			return codesearch.New(wt.Path)
+		}
+	}
+	return c.codeSearcher
+}
+
 // newWorkerServerCache creates a new worker server cache.
 func newWorkerServerCache(
 	accountabilityWriter mcp.AccountabilityWriter,
 	v2Adapter *adapter.V2Adapter,
 	turnEnforcer handler.TurnCompletionEnforcer,
 	fabricService *fabric.Service,
+	codeSearcher *codesearch.Searcher,
 	sess *session.Session,
 	workflowCtx context.Context,
 ) *workerServerCache {
@@ -1027,6 +1412,7 @@ func newWorkerServerCache(
 		v2Adapter:            v2Adapter,
 		turnEnforcer:         turnEnforcer,
 		fabricService:        fabricService,
+		codeSearcher:         codeSearcher,
 		servers:              make(map[string]*mcp.WorkerServer),
 		session:              sess,
 		workflowCtx:          workflowCtx,
@@ -1067,6 +1453,9 @@ func (c *workerServerCache) getOrCreate(workerID string) *mcp.WorkerServer {
 	if c.accountabilityWriter != nil {
 		ws.SetAccountabilityWriter(c.accountabilityWriter)
 	}
+	if c.decisionWriter != nil {
+		ws.SetDecisionWriter(c.decisionWriter)
+	}
 	if c.v2Adapter != nil {
 		ws.SetV2Adapter(c.v2Adapter)
 	}
@@ -1076,6 +1465,21 @@ func (c *workerServerCache) getOrCreate(workerID string) *mcp.WorkerServer {
 	if c.fabricService != nil {
 		ws.SetFabricService(c.fabricService)
 	}
+	if searcher := c.searcherFor(workerID); searcher != nil {
+		ws.SetCodeSearcher(searcher)
+	}
+	if c.taskComments != nil {
+		ws.SetTaskComments(c.taskComments)
+	}
+	if c.rateLimiter != nil {
+		ws.SetRateLimiter(c.rateLimiter)
+	}
+	if c.deduplicator != nil {
+		ws.SetDeduplicator(c.deduplicator)
+	}
+	if c.instrument != nil {
+		c.instrument(ws.Server)
+	}
 
 	// Attach worker MCP broker to session for mcp_requests.jsonl logging
 	if c.session != nil && c.workflowCtx != nil {
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -17,7 +19,10 @@ import (
 	domaingit "github.com/zjrosen/perles/internal/git/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/codesearch"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricdomain "github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/memory"
 	fabricpersist "github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
 	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
 	"github.com/zjrosen/perles/internal/orchestration/session"
@@ -360,6 +365,44 @@ func TestSupervisor_AllocateResources_CleansUpOnInfrastructureError(t *testing.T
 	mockFactory.AssertExpectations(t)
 }
 
+func TestSupervisor_AllocateResources_LoadsWorkflowDAG(t *testing.T) {
+	cfg, _, mockFactory := newTestSupervisorConfig(t)
+	cfg.DAGPath = "workflow.yaml"
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+	inst.WorkDir = t.TempDir()
+	cleanupSessionOnTestEnd(t, inst) // Close session before TempDir cleanup (Windows)
+	require.NoError(t, os.WriteFile(filepath.Join(inst.WorkDir, "workflow.yaml"),
+		[]byte("phases:\n  - id: research\n    next: [{to: implement}]\n  - id: implement\n"), 0600))
+
+	// Stop after the config is built; the DAG is resolved against the work directory
+	mockFactory.On("Create", mock.MatchedBy(func(c v2.InfrastructureConfig) bool {
+		return c.DAG != nil && c.DAG.Phases[0].ID == "research"
+	})).Return(nil, errors.New("stop here"))
+
+	err = supervisor.AllocateResources(context.Background(), inst)
+	require.ErrorContains(t, err, "stop here")
+	mockFactory.AssertExpectations(t)
+}
+
+func TestSupervisor_AllocateResources_RejectsInvalidWorkflowDAG(t *testing.T) {
+	cfg, _, mockFactory := newTestSupervisorConfig(t)
+	cfg.DAGPath = filepath.Join(t.TempDir(), "workflow.yaml")
+	require.NoError(t, os.WriteFile(cfg.DAGPath, []byte("phases:\n  - id: a\n    next: [{to: a}]\n"), 0600))
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "test-workflow")
+
+	err = supervisor.AllocateResources(context.Background(), inst)
+	require.ErrorContains(t, err, "loading workflow DAG")
+	require.ErrorContains(t, err, "cycle")
+	require.Equal(t, WorkflowPending, inst.State)
+	mockFactory.AssertNotCalled(t, "Create", mock.Anything)
+}
+
 // === Unit Tests: Stop ===
 
 func TestSupervisor_Shutdown_TransitionsRunningToFailed(t *testing.T) {
@@ -1857,6 +1900,50 @@ func TestSupervisor_SpawnCoordinator_SkipsObserverWhenDisabled(t *testing.T) {
 	require.Equal(t, 1, spawnCalls, "Should only spawn coordinator when observer disabled")
 }
 
+func TestSupervisor_SpawnCoordinator_SoloModeSpawnsWorkers(t *testing.T) {
+	mockCoordinatorProvider := mocks.NewMockAgentProvider(t)
+	mockFactory := &mockInfrastructureFactory{}
+
+	supervisor, err := NewSupervisor(SupervisorConfig{
+		AgentProviders:        client.AgentProviders{client.RoleCoordinator: mockCoordinatorProvider},
+		InfrastructureFactory: mockFactory,
+		ListenerFactory:       &mockListenerFactory{},
+		SessionFactory:        session.NewFactory(session.FactoryConfig{BaseDir: t.TempDir()}),
+		Solo:                  &SoloOptions{Workers: 3},
+	})
+	require.NoError(t, err)
+
+	inst := newTestInstance(t, "solo-test")
+	cleanupSessionOnTestEnd(t, inst)
+
+	infra := createMinimalInfrastructure(t)
+	mockFactory.On("Create", mock.MatchedBy(func(cfg v2.InfrastructureConfig) bool {
+		return cfg.SoloMode && !cfg.SoloCoordinator
+	})).Return(infra, nil)
+	setupAgentProviderMock(t, mockCoordinatorProvider)
+
+	var spawnRoles []string
+	infra.Core.Processor.RegisterHandler(command.CmdSpawnProcess, &roleBasedSpawnHandler{
+		onCoordinator: func() (*command.CommandResult, error) {
+			spawnRoles = append(spawnRoles, "coordinator")
+			return &command.CommandResult{Success: true, Data: &mockSpawnResult{processID: "coordinator"}}, nil
+		},
+		onWorker: func() (*command.CommandResult, error) {
+			spawnRoles = append(spawnRoles, "worker")
+			return &command.CommandResult{Success: true, Data: &mockSpawnResult{processID: "worker"}}, nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go infra.Core.Processor.Run(ctx)
+	require.NoError(t, infra.Core.Processor.WaitForReady(ctx))
+
+	require.NoError(t, startWorkflow(ctx, supervisor, inst))
+	require.Equal(t, WorkflowRunning, inst.State)
+	require.Equal(t, []string{"worker", "worker", "worker"}, spawnRoles, "solo mode spawns no coordinator by default")
+}
+
 func TestSupervisor_SpawnObserver_FailOpenOnError(t *testing.T) {
 	mockObserverProvider := mocks.NewMockAgentProvider(t)
 	mockCoordinatorProvider := mocks.NewMockAgentProvider(t)
@@ -1998,6 +2085,33 @@ func (h *roleBasedSpawnHandler) Handle(_ context.Context, cmd command.Command) (
 
 // === Fabric State Restoration Tests ===
 
+func TestSupervisor_SeedProjectMemory(t *testing.T) {
+	cfg, _, _ := newTestSupervisorConfig(t)
+	cfg.ProjectMemory = true
+	supervisor, err := NewSupervisor(cfg)
+	require.NoError(t, err)
+
+	ds := supervisor.(*defaultSupervisor)
+	inst := newTestInstance(t, "test-workflow")
+	infra := createMinimalInfrastructureWithFabric(t)
+	require.NoError(t, infra.Core.FabricService.InitSession(repository.CoordinatorID))
+
+	store, err := memory.NewStore(filepath.Join(t.TempDir(), "project_memory.jsonl"))
+	require.NoError(t, err)
+	require.NoError(t, store.Append(memory.Entry{ID: "mem-1", Content: "Keep migrations additive", CreatedBy: "coordinator", CreatedAt: time.Now()}))
+	require.NoError(t, store.Append(memory.Entry{ID: "mem-2", Content: "Unpinned note", CreatedBy: "coordinator", CreatedAt: time.Now()}))
+	require.NoError(t, store.Pin("mem-1", "coordinator"))
+
+	brief := ds.seedProjectMemory(inst, infra.Core.FabricService, store)
+	require.Contains(t, brief, "## Project Memory")
+	require.Contains(t, brief, "Keep migrations additive")
+	require.NotContains(t, brief, "Unpinned note")
+
+	msgs, err := infra.Core.FabricService.ListMessages(fabricdomain.SlugMemory, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+}
+
 func TestSupervisor_RestoreFabricState_NoPersistedState(t *testing.T) {
 	cfg, _, _ := newTestSupervisorConfig(t)
 	supervisor, err := NewSupervisor(cfg)
@@ -2112,3 +2226,26 @@ func createMinimalInfrastructureWithFabric(t *testing.T) *v2.Infrastructure {
 
 	return infra
 }
+
+type fakeWorktreeLookup map[string]processor.WorkerWorktree
+
+func (f fakeWorktreeLookup) Worktree(workerID string) (processor.WorkerWorktree, bool) {
+	wt, ok := f[workerID]
+	return wt, ok
+}
+
+func TestWorkerServerCache_SearcherForUsesWorkerWorktree(t *testing.T) {
+	shared := codesearch.New(t.TempDir())
+	cache := newWorkerServerCache(nil, nil, nil, nil, shared, nil, context.Background())
+	cache.worktrees = fakeWorktreeLookup{
+		"worker-1": {Path: t.TempDir(), Branch: "perles/worker-1"},
+	}
+
+	searcher := cache.searcherFor("worker-1")
+	require.NotNil(t, searcher)
+	require.NotSame(t, shared, searcher, "worker with a worktree should search its worktree")
+	require.Same(t, shared, cache.searcherFor("worker-2"), "worker without a worktree should search the work dir")
+
+	cache.worktrees = nil
+	require.Same(t, shared, cache.searcherFor("worker-1"))
+}
@@ -13,10 +13,13 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/zjrosen/perles/internal/orchestration/audit"
+	"github.com/zjrosen/perles/internal/orchestration/autoscale"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricpersist "github.com/zjrosen/perles/internal/orchestration/fabric/persistence"
 	"github.com/zjrosen/perles/internal/orchestration/mcp"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/ratelimit"
 	"github.com/zjrosen/perles/internal/orchestration/session"
 	v2 "github.com/zjrosen/perles/internal/orchestration/v2"
 )
@@ -230,6 +233,22 @@ type WorkflowInstance struct {
 	FabricBroker *fabric.Broker             // Batches @mention notifications
 	FabricLogger *fabricpersist.EventLogger // Persists events to JSONL
 
+	// AuditLog records the coordinator's consequential decisions in audit.jsonl
+	AuditLog *audit.Log
+
+	// ProjectMemoryBrief is appended to the coordinator's initial prompt
+	// (empty when project memory is disabled)
+	ProjectMemoryBrief string
+
+	// Autoscaler resizes the worker pool (nil when autoscaling is disabled)
+	Autoscaler *autoscale.Autoscaler
+
+	// RateLimiter limits and counts the MCP tool calls of the workflow's processes
+	RateLimiter *ratelimit.Limiter
+
+	// Deduplicator suppresses and counts repeated messages of the workflow's processes
+	Deduplicator *mcp.MessageDeduplicator
+
 	// Resource tracking
 	MCPPort       int
 	TokensUsed    int64
@@ -5,6 +5,7 @@
 // Event types:
 //   - ProcessEvent: Unified events from both coordinator and worker processes
 //   - MCPEvent: MCP protocol events (tool calls, results)
+//   - IssueEvent: bd issue changes made by orchestration (status, comments)
 //
 // The ProcessEvent type uses the Role field to distinguish between coordinator
 // (RoleCoordinator) and worker (RoleWorker) events. This unified type replaces
@@ -0,0 +1,44 @@
+package events
+
+import (
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// IssueEventType identifies how orchestration changed a bd issue.
+type IssueEventType string
+
+const (
+	// IssueStatusChanged is emitted when an issue's status is updated (e.g. closed or blocked).
+	IssueStatusChanged IssueEventType = "status_changed"
+	// IssueCommented is emitted when a comment is added to an issue.
+	IssueCommented IssueEventType = "commented"
+	// IssueUpdated is emitted when other issue fields are updated.
+	IssueUpdated IssueEventType = "updated"
+)
+
+// IssueEvent reports a change orchestration made to a bd issue, so issue
+// panes can patch the affected row in place instead of reloading.
+type IssueEvent struct {
+	Type    IssueEventType
+	IssueID string
+	// Status is the new status (only for IssueStatusChanged, or IssueUpdated
+	// when the update changed the status).
+	Status beads.Status
+	// Author is the comment author (only for IssueCommented).
+	Author string
+}
+
+// GetTaskID returns the issue ID, so control plane events carry it as their task ID.
+func (e IssueEvent) GetTaskID() string {
+	return e.IssueID
+}
+
+// Apply patches issue with the change, for views that update rows in place.
+func (e IssueEvent) Apply(issue *beads.Issue) {
+	if e.Status != "" {
+		issue.Status = e.Status
+	}
+	if e.Type == IssueCommented {
+		issue.CommentCount++
+	}
+}
@@ -0,0 +1,24 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+func TestIssueEvent_Apply(t *testing.T) {
+	issue := beads.Issue{ID: "perles-abc1", Status: beads.StatusInProgress, CommentCount: 2}
+
+	IssueEvent{Type: IssueCommented, IssueID: "perles-abc1", Author: "worker-1"}.Apply(&issue)
+	require.Equal(t, 3, issue.CommentCount)
+	require.Equal(t, beads.StatusInProgress, issue.Status)
+
+	IssueEvent{Type: IssueStatusChanged, IssueID: "perles-abc1", Status: beads.StatusClosed}.Apply(&issue)
+	require.Equal(t, beads.StatusClosed, issue.Status)
+	require.Equal(t, 3, issue.CommentCount)
+
+	IssueEvent{Type: IssueUpdated, IssueID: "perles-abc1"}.Apply(&issue)
+	require.Equal(t, beads.StatusClosed, issue.Status, "updates without a status keep it")
+}
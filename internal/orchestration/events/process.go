@@ -3,6 +3,7 @@ package events
 import (
 	"time"
 
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 )
 
@@ -37,6 +38,9 @@ const (
 	// ProcessUserNotification is emitted when the coordinator requests user attention.
 	// This is used for human checkpoints in DAG workflows (e.g., clarification review).
 	ProcessUserNotification ProcessEventType = "user_notification"
+	// ProcessTelemetry is emitted when a process reports structured telemetry
+	// (progress, file edits, test runs) on stdout.
+	ProcessTelemetry ProcessEventType = "telemetry"
 )
 
 // ProcessRole identifies what kind of process this is.
@@ -121,6 +125,13 @@ type ProcessEvent struct {
 	RawJSON []byte `json:"raw_json,omitempty"`
 	// QueueCount contains pending messages in queue.
 	QueueCount int `json:"queue_count,omitempty"`
+	// Telemetry contains the structured event for telemetry events.
+	Telemetry *client.TelemetryEvent `json:"telemetry,omitempty"`
+	// CostReport contains the session cost report for workflow complete events.
+	CostReport *metrics.CostReport `json:"cost_report,omitempty"`
+	// Backend is the agent backend a worker was spawned with (spawned events only).
+	// Empty means the default worker client.
+	Backend string `json:"backend,omitempty"`
 }
 
 // IsCoordinator returns true if this event is from the coordinator.
@@ -157,6 +168,8 @@ const (
 	ProcessPhaseAddressingFeedback ProcessPhase = "addressing_feedback"
 	// ProcessPhaseCommitting means the worker is creating a git commit.
 	ProcessPhaseCommitting ProcessPhase = "committing"
+	// ProcessPhaseBlocked means the worker requested assistance and waits for an answer.
+	ProcessPhaseBlocked ProcessPhase = "blocked"
 )
 
 // IsDone returns true if the process is in a terminal state (retired or failed).
@@ -248,3 +261,15 @@ func (e ProcessEvent) WithQueueCount(count int) ProcessEvent {
 	e.QueueCount = count
 	return e
 }
+
+// WithBackend sets the Backend field and returns the event.
+func (e ProcessEvent) WithBackend(backend string) ProcessEvent {
+	e.Backend = backend
+	return e
+}
+
+// WithTelemetry sets the Telemetry field and returns the event.
+func (e ProcessEvent) WithTelemetry(telemetry *client.TelemetryEvent) ProcessEvent {
+	e.Telemetry = telemetry
+	return e
+}
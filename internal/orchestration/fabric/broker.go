@@ -4,18 +4,20 @@ package fabric
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	"github.com/zjrosen/perles/internal/orchestration/fabric/repository"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 )
 
-// DefaultDebounce is the default debounce duration for batching nudges.
+// DefaultDebounce is the default batching window of a recipient's nudges.
 // 3 seconds allows multiple worker completions to be batched into a single coordinator nudge.
 const DefaultDebounce = 3 * time.Second
 
@@ -49,10 +51,18 @@ type realTimer struct {
 func (t *realTimer) Stop() bool          { return t.timer.Stop() }
 func (t *realTimer) C() <-chan time.Time { return t.timer.C }
 
-// pendingNudge tracks a pending notification for an agent.
+// pendingNudge is the digest of the messages waiting to be notified to an agent.
 type pendingNudge struct {
-	channelSlug string
-	senders     map[string]bool // unique sender IDs
+	due        time.Time        // end of the agent's batching window
+	channels   []*channelDigest // in order of their first message
+	messageIDs []string         // messages the nudge delivers, for receipts
+}
+
+// channelDigest counts the pending messages of one channel.
+type channelDigest struct {
+	slug    string
+	count   int
+	senders map[string]bool // unique sender IDs
 }
 
 // ChannelSlugLookup provides channel ID to slug resolution.
@@ -60,14 +70,24 @@ type ChannelSlugLookup interface {
 	GetChannelSlug(channelID string) string
 }
 
+// ReceiptRecorder records which messages a nudge delivered to an agent.
+// Implemented by Service.
+type ReceiptRecorder interface {
+	MarkDelivered(agentID string, messageIDs ...string) error
+}
+
 // ParticipantLister provides access to active fabric participants.
 type ParticipantLister interface {
 	List() ([]domain.Participant, error)
 }
 
-// Broker accumulates @mention notifications and sends consolidated nudges
-// to agents after a debounce window. It listens to Fabric events and respects
-// subscription modes (all/mentions/none).
+// Broker accumulates @mention notifications and sends each agent a single
+// digest nudge per batching window. An agent's window opens with the first
+// message pending for it, so steady traffic cannot postpone the nudge
+// indefinitely. It listens to Fabric events and respects subscription modes
+// (all/mentions/none). Urgent messages skip the window and are nudged
+// immediately. The broker also holds scheduled messages
+// and posts them when they are due.
 type Broker struct {
 	debounce      time.Duration
 	clock         Clock
@@ -75,12 +95,19 @@ type Broker struct {
 	subscriptions repository.SubscriptionRepository
 	participants  ParticipantLister
 	slugLookup    ChannelSlugLookup
+	receipts      ReceiptRecorder
+	sender        MessageSender
 
 	mu      sync.Mutex
 	pending map[string]*pendingNudge // agentID -> pending nudge
-	timer   Timer
+	timer   Timer                    // fires when the earliest window closes
+
+	scheduled     map[string]*domain.ScheduledMessage // scheduled ID -> message
+	scheduleSeq   int
+	scheduleTimer Timer // fires when the earliest scheduled message is due
 
 	eventCh   chan Event
+	wake      chan struct{} // signals the loop that the schedule timer changed
 	ctx       context.Context
 	cancel    context.CancelFunc
 	done      chan struct{}
@@ -89,8 +116,9 @@ type Broker struct {
 
 // BrokerConfig holds configuration for creating a Broker.
 type BrokerConfig struct {
-	// Debounce is the duration to wait before sending a batched nudge.
-	// Defaults to DefaultDebounce (1 second) if zero.
+	// Debounce is each recipient's batching window: messages arriving within
+	// it are coalesced into one digest nudge.
+	// Defaults to DefaultDebounce if zero.
 	Debounce time.Duration
 
 	// CmdSubmitter is used to submit commands to processes.
@@ -109,6 +137,14 @@ type BrokerConfig struct {
 	// Optional - falls back to "channel" if nil.
 	SlugLookup ChannelSlugLookup
 
+	// Receipts records the messages each nudge delivered.
+	// Optional - if nil, no delivery receipts are recorded.
+	Receipts ReceiptRecorder
+
+	// Sender posts scheduled messages when they are due.
+	// Optional - if nil, messages cannot be scheduled.
+	Sender MessageSender
+
 	// Clock provides time operations. Defaults to RealClock if nil.
 	Clock Clock
 }
@@ -133,8 +169,12 @@ func NewBroker(cfg BrokerConfig) *Broker {
 		subscriptions: cfg.Subscriptions,
 		participants:  cfg.Participants,
 		slugLookup:    cfg.SlugLookup,
+		receipts:      cfg.Receipts,
+		sender:        cfg.Sender,
 		pending:       make(map[string]*pendingNudge),
+		scheduled:     make(map[string]*domain.ScheduledMessage),
 		eventCh:       make(chan Event, 100),
+		wake:          make(chan struct{}, 1),
 		ctx:           ctx,
 		cancel:        cancel,
 		done:          make(chan struct{}),
@@ -161,6 +201,11 @@ func (b *Broker) Stop() {
 		b.timer = nil
 	}
 	b.pending = make(map[string]*pendingNudge)
+	if b.scheduleTimer != nil {
+		b.scheduleTimer.Stop()
+		b.scheduleTimer = nil
+	}
+	b.scheduled = make(map[string]*domain.ScheduledMessage)
 }
 
 // closeDone safely closes the done channel exactly once.
@@ -186,17 +231,24 @@ func (b *Broker) loop() {
 
 	for {
 		timerCh := b.timerChan()
+		scheduleCh := b.scheduleTimerChan()
 
 		select {
 		case event, ok := <-b.eventCh:
 			if !ok {
-				b.flush()
+				b.flush(true)
 				return
 			}
 			b.handleEvent(event)
 
 		case <-timerCh:
-			b.flush()
+			b.flush(false)
+
+		case <-scheduleCh:
+			b.deliverDue()
+
+		case <-b.wake:
+			// The schedule changed; pick up the new schedule timer
 
 		case <-b.ctx.Done():
 			return
@@ -227,11 +279,27 @@ func (b *Broker) handleEvent(event Event) {
 
 	channelID := event.ChannelID
 	sender := event.Thread.CreatedBy
+	messageID := event.Thread.ID
 	mentions := event.Mentions
 
 	// Get channel slug for notification message
 	channelSlug := b.channelSlugForID(channelID)
 
+	// Urgent messages bypass the debounce window: each recipient is nudged
+	// once, immediately. Everything else is batched.
+	urgent := event.Thread.Priority == domain.PriorityUrgent
+	nudged := make(map[string]bool)
+	notify := func(agentID string) {
+		if !urgent {
+			b.addPending(agentID, channelSlug, sender, messageID)
+			return
+		}
+		if !nudged[agentID] {
+			nudged[agentID] = true
+			b.nudgeNow(agentID, channelSlug, sender, messageID)
+		}
+	}
+
 	// Check if this is a notification-suppressed channel (e.g., #observer)
 	// For these channels, we only notify the channel's "owner" agent (e.g., OBSERVER for #observer)
 	// This prevents coordinator/workers from receiving notifications about observer channel activity
@@ -270,7 +338,7 @@ func (b *Broker) handleEvent(event Event) {
 		}
 
 		if shouldNotify {
-			b.addPending(sub.AgentID, channelSlug, sender)
+			notify(sub.AgentID)
 		}
 	}
 
@@ -286,7 +354,7 @@ func (b *Broker) handleEvent(event Event) {
 				if isSuppressed && !isChannelOwner(channelSlug, p.AgentID) {
 					continue
 				}
-				b.addPending(p.AgentID, channelSlug, sender)
+				notify(p.AgentID)
 			}
 		}
 	}
@@ -295,8 +363,10 @@ func (b *Broker) handleEvent(event Event) {
 	// Skip for suppressed channels unless they're the channel owner
 	// Skip @here since it's handled above
 	// Skip "user" since that's the human using the TUI, not a process
+	// Self-mentions only notify in scheduled messages, which are reminders
+	reminder := event.Thread.Meta[MetaScheduledID] != ""
 	for _, mentionedID := range mentions {
-		if mentionedID == sender {
+		if mentionedID == sender && !reminder {
 			continue
 		}
 		if mentionedID == domain.MentionHere {
@@ -308,7 +378,7 @@ func (b *Broker) handleEvent(event Event) {
 		if isSuppressed && !isChannelOwner(channelSlug, mentionedID) {
 			continue
 		}
-		b.addPending(mentionedID, channelSlug, sender)
+		notify(mentionedID)
 	}
 
 	// For replies: notify all participants of the parent thread
@@ -330,35 +400,96 @@ func (b *Broker) handleEvent(event Event) {
 			if isSuppressed && !isChannelOwner(channelSlug, participantID) {
 				continue
 			}
-			b.addPending(participantID, channelSlug, sender)
+			notify(participantID)
 		}
 	}
 }
 
-// addPending adds a pending notification for an agent and resets the debounce timer.
-func (b *Broker) addPending(agentID, channelSlug, senderID string) {
+// addPending adds a message to an agent's digest. The first pending message
+// opens the agent's batching window.
+func (b *Broker) addPending(agentID, channelSlug, senderID, messageID string) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	p, exists := b.pending[agentID]
 	if !exists {
-		p = &pendingNudge{
-			channelSlug: channelSlug,
-			senders:     make(map[string]bool),
-		}
+		p = &pendingNudge{due: b.clock.Now().Add(b.debounce)}
 		b.pending[agentID] = p
 	}
-	p.senders[senderID] = true
+	if slices.Contains(p.messageIDs, messageID) {
+		return
+	}
+	p.messageIDs = append(p.messageIDs, messageID)
+
+	i := slices.IndexFunc(p.channels, func(c *channelDigest) bool { return c.slug == channelSlug })
+	if i < 0 {
+		p.channels = append(p.channels, &channelDigest{slug: channelSlug, senders: make(map[string]bool)})
+		i = len(p.channels) - 1
+	}
+	p.channels[i].count++
+	p.channels[i].senders[senderID] = true
+
+	if !exists {
+		b.resetTimer()
+	}
+}
 
-	// Reset or start timer
+// resetTimer points the timer at the earliest batching window to close, or
+// clears it if nothing is pending. Caller must hold b.mu.
+func (b *Broker) resetTimer() {
 	if b.timer != nil {
 		b.timer.Stop()
+		b.timer = nil
+	}
+
+	var next time.Time
+	for _, p := range b.pending {
+		if next.IsZero() || p.due.Before(next) {
+			next = p.due
+		}
+	}
+	if !next.IsZero() {
+		b.timer = b.clock.NewTimer(max(next.Sub(b.clock.Now()), 0))
+	}
+}
+
+// nudgeNow immediately nudges an agent about an urgent message. Any pending
+// nudge for the agent is dropped, since fabric_inbox shows those messages too;
+// they are recorded as delivered with the urgent message.
+func (b *Broker) nudgeNow(agentID, channelSlug, senderID, messageID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	messageIDs := []string{messageID}
+	if p, ok := b.pending[agentID]; ok {
+		messageIDs = append(p.messageIDs, messageID)
+	}
+	if _, ok := b.pending[agentID]; ok {
+		delete(b.pending, agentID)
+		b.resetTimer()
+	}
+
+	if b.cmdSubmitter != nil {
+		msg := fmt.Sprintf("[URGENT: %s sent a message in #%s] Use fabric_inbox to check messages.",
+			senderID, channelSlug)
+		b.cmdSubmitter.Submit(command.NewSendToProcessCommand(command.SourceInternal, agentID, msg))
+		b.markDelivered(agentID, messageIDs)
+	}
+}
+
+// markDelivered records the messages a nudge delivered to an agent.
+func (b *Broker) markDelivered(agentID string, messageIDs []string) {
+	if b.receipts == nil {
+		return
+	}
+	if err := b.receipts.MarkDelivered(agentID, messageIDs...); err != nil {
+		log.Debug(log.CatOrch, "Failed to record fabric delivery receipts", "agentID", agentID, "error", err)
 	}
-	b.timer = b.clock.NewTimer(b.debounce)
 }
 
-// flush sends consolidated nudges and clears state.
-func (b *Broker) flush() {
+// flush sends the digest nudges whose batching window closed, or all pending
+// nudges if all is set.
+func (b *Broker) flush(all bool) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
@@ -366,32 +497,53 @@ func (b *Broker) flush() {
 		return
 	}
 
-	// Submit nudges for each pending agent
+	now := b.clock.Now()
 	for agentID, nudge := range b.pending {
-		senders := make([]string, 0, len(nudge.senders))
-		for s := range nudge.senders {
-			senders = append(senders, s)
-		}
-		sort.Strings(senders)
-
-		var msg string
-		if len(senders) == 1 {
-			msg = fmt.Sprintf("[%s sent a message in #%s] Use fabric_inbox to check messages.",
-				senders[0], nudge.channelSlug)
-		} else {
-			msg = fmt.Sprintf("[%s sent messages in #%s] Use fabric_inbox to check messages.",
-				strings.Join(senders, ", "), nudge.channelSlug)
+		if !all && nudge.due.After(now) {
+			continue
 		}
+		delete(b.pending, agentID)
 
 		if b.cmdSubmitter != nil {
-			cmd := command.NewSendToProcessCommand(command.SourceInternal, agentID, msg)
+			cmd := command.NewSendToProcessCommand(command.SourceInternal, agentID, nudge.digest())
 			b.cmdSubmitter.Submit(cmd)
+			b.markDelivered(agentID, nudge.messageIDs)
 		}
 	}
 
-	// Clear state
-	b.pending = make(map[string]*pendingNudge)
-	b.timer = nil
+	b.resetTimer()
+}
+
+// digest describes the pending messages in one nudge, e.g.
+// "[3 new messages in #tasks from COORDINATOR, WORKER.2] Use fabric_inbox to check messages."
+// Messages in several channels are counted per channel.
+func (p *pendingNudge) digest() string {
+	const hint = " Use fabric_inbox to check messages."
+
+	if len(p.messageIDs) == 1 {
+		c := p.channels[0]
+		return fmt.Sprintf("[%s sent a message in #%s]", c.sortedSenders()[0], c.slug) + hint
+	}
+	if len(p.channels) == 1 {
+		c := p.channels[0]
+		return fmt.Sprintf("[%d new messages in #%s from %s]", c.count, c.slug, strings.Join(c.sortedSenders(), ", ")) + hint
+	}
+
+	parts := make([]string, 0, len(p.channels))
+	for _, c := range p.channels {
+		parts = append(parts, fmt.Sprintf("%d in #%s from %s", c.count, c.slug, strings.Join(c.sortedSenders(), ", ")))
+	}
+	return fmt.Sprintf("[%d new messages: %s]", len(p.messageIDs), strings.Join(parts, "; ")) + hint
+}
+
+// sortedSenders returns the channel's senders in alphabetical order.
+func (c *channelDigest) sortedSenders() []string {
+	senders := make([]string, 0, len(c.senders))
+	for s := range c.senders {
+		senders = append(senders, s)
+	}
+	sort.Strings(senders)
+	return senders
 }
 
 // channelSlugForID returns a channel slug for display. Falls back to "channel" if unknown.
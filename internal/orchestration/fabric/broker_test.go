@@ -1,6 +1,7 @@
 package fabric
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -263,6 +264,147 @@ func TestBroker_BatchMultipleSenders(t *testing.T) {
 	assert.Contains(t, sendCmd.Content, "WORKER.3")
 }
 
+func TestBroker_UrgentBypassesDebounce(t *testing.T) {
+	subs := repository.NewMemorySubscriptionRepository()
+	submitter := &mockCommandSubmitter{}
+
+	broker := NewBroker(BrokerConfig{
+		CmdSubmitter:  submitter,
+		Subscriptions: subs,
+		SlugLookup:    &mockSlugLookup{slugs: map[string]string{"channel-tasks": "tasks"}},
+		Debounce:      time.Hour,
+	})
+
+	channelID := "channel-tasks"
+	_, err := subs.Subscribe(channelID, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	broker.Start()
+	defer broker.Stop()
+
+	// A normal message is held for the (long) debounce window
+	broker.HandleEvent(Event{
+		Type:      EventMessagePosted,
+		ChannelID: channelID,
+		Thread:    &domain.Thread{ID: "msg-1", Type: domain.ThreadMessage, CreatedBy: "WORKER.1"},
+	})
+
+	// An urgent message that both matches the subscription and mentions the
+	// coordinator is delivered once, immediately, superseding the pending nudge
+	broker.HandleEvent(Event{
+		Type:      EventMessagePosted,
+		ChannelID: channelID,
+		Thread: &domain.Thread{
+			ID:        "msg-2",
+			Type:      domain.ThreadMessage,
+			CreatedBy: "WORKER.2",
+			Priority:  domain.PriorityUrgent,
+			Mentions:  []string{"COORDINATOR"},
+		},
+		Mentions: []string{"COORDINATOR"},
+	})
+
+	require.Eventually(t, func() bool { return len(submitter.getCommands()) == 1 }, time.Second, 5*time.Millisecond)
+
+	sendCmd, ok := submitter.getCommands()[0].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	assert.Equal(t, "COORDINATOR", sendCmd.ProcessID)
+	assert.Equal(t, "[URGENT: WORKER.2 sent a message in #tasks] Use fabric_inbox to check messages.", sendCmd.Content)
+
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	assert.Empty(t, broker.pending)
+	assert.Nil(t, broker.timer)
+}
+
+type mockReceiptRecorder struct {
+	mu        sync.Mutex
+	delivered map[string][]string // agentID -> message IDs
+}
+
+func (m *mockReceiptRecorder) MarkDelivered(agentID string, messageIDs ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.delivered == nil {
+		m.delivered = make(map[string][]string)
+	}
+	m.delivered[agentID] = append(m.delivered[agentID], messageIDs...)
+	return nil
+}
+
+func (m *mockReceiptRecorder) get(agentID string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.delivered[agentID]
+}
+
+func TestBroker_RecordsDeliveryReceipts(t *testing.T) {
+	subs := repository.NewMemorySubscriptionRepository()
+	submitter := &mockCommandSubmitter{}
+	receipts := &mockReceiptRecorder{}
+
+	broker := NewBroker(BrokerConfig{
+		CmdSubmitter:  submitter,
+		Subscriptions: subs,
+		Receipts:      receipts,
+		Debounce:      10 * time.Millisecond,
+	})
+
+	channelID := "channel-tasks"
+	_, err := subs.Subscribe(channelID, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	broker.Start()
+	defer broker.Stop()
+
+	for _, id := range []string{"msg-1", "msg-2"} {
+		broker.HandleEvent(Event{
+			Type:      EventMessagePosted,
+			ChannelID: channelID,
+			Thread:    &domain.Thread{ID: id, Type: domain.ThreadMessage, CreatedBy: "WORKER.1"},
+		})
+	}
+
+	require.Eventually(t, func() bool { return len(submitter.getCommands()) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"msg-1", "msg-2"}, receipts.get("COORDINATOR"))
+}
+
+func TestBroker_UrgentRecordsPendingDeliveryReceipts(t *testing.T) {
+	subs := repository.NewMemorySubscriptionRepository()
+	submitter := &mockCommandSubmitter{}
+	receipts := &mockReceiptRecorder{}
+
+	broker := NewBroker(BrokerConfig{
+		CmdSubmitter:  submitter,
+		Subscriptions: subs,
+		Receipts:      receipts,
+		Debounce:      time.Hour,
+	})
+
+	channelID := "channel-tasks"
+	_, err := subs.Subscribe(channelID, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	broker.Start()
+	defer broker.Stop()
+
+	broker.HandleEvent(Event{
+		Type:      EventMessagePosted,
+		ChannelID: channelID,
+		Thread:    &domain.Thread{ID: "msg-1", Type: domain.ThreadMessage, CreatedBy: "WORKER.1"},
+	})
+	broker.HandleEvent(Event{
+		Type:      EventMessagePosted,
+		ChannelID: channelID,
+		Thread:    &domain.Thread{ID: "msg-2", Type: domain.ThreadMessage, CreatedBy: "WORKER.2", Priority: domain.PriorityUrgent},
+	})
+
+	// The urgent nudge also delivers the message whose nudge it superseded
+	require.Eventually(t, func() bool { return len(receipts.get("COORDINATOR")) == 2 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"msg-1", "msg-2"}, receipts.get("COORDINATOR"))
+	assert.Len(t, submitter.getCommands(), 1)
+}
+
 func TestBroker_ChannelSlugLookup(t *testing.T) {
 	subs := repository.NewMemorySubscriptionRepository()
 	submitter := &mockCommandSubmitter{}
@@ -922,3 +1064,139 @@ func TestBroker_HereMention_UsesParticipantRegistry(t *testing.T) {
 	assert.False(t, notified["worker-3"], "worker-3 (not participant) should NOT be notified")
 	assert.False(t, notified["coordinator"], "coordinator (sender) should NOT be notified")
 }
+
+func TestPendingNudge_Digest(t *testing.T) {
+	single := &pendingNudge{
+		channels:   []*channelDigest{{slug: "tasks", count: 1, senders: map[string]bool{"WORKER.1": true}}},
+		messageIDs: []string{"msg-1"},
+	}
+	assert.Equal(t, "[WORKER.1 sent a message in #tasks] Use fabric_inbox to check messages.", single.digest())
+
+	oneChannel := &pendingNudge{
+		channels:   []*channelDigest{{slug: "tasks", count: 3, senders: map[string]bool{"WORKER.2": true, "COORDINATOR": true}}},
+		messageIDs: []string{"msg-1", "msg-2", "msg-3"},
+	}
+	assert.Equal(t, "[3 new messages in #tasks from COORDINATOR, WORKER.2] Use fabric_inbox to check messages.", oneChannel.digest())
+
+	channels := &pendingNudge{
+		channels: []*channelDigest{
+			{slug: "tasks", count: 2, senders: map[string]bool{"WORKER.2": true}},
+			{slug: "general", count: 1, senders: map[string]bool{"WORKER.1": true}},
+		},
+		messageIDs: []string{"msg-1", "msg-2", "msg-3"},
+	}
+	assert.Equal(t, "[3 new messages: 2 in #tasks from WORKER.2; 1 in #general from WORKER.1] Use fabric_inbox to check messages.", channels.digest())
+}
+
+func TestBroker_DigestCountsPerChannel(t *testing.T) {
+	subs := repository.NewMemorySubscriptionRepository()
+	submitter := &mockCommandSubmitter{}
+
+	broker := NewBroker(BrokerConfig{
+		CmdSubmitter:  submitter,
+		Subscriptions: subs,
+		SlugLookup:    &mockSlugLookup{slugs: map[string]string{"channel-tasks": "tasks", "channel-general": "general"}},
+		Debounce:      20 * time.Millisecond,
+	})
+
+	for _, channelID := range []string{"channel-tasks", "channel-general"} {
+		_, err := subs.Subscribe(channelID, "WORKER.1", domain.ModeAll)
+		require.NoError(t, err)
+	}
+
+	broker.Start()
+	defer broker.Stop()
+
+	post := func(id, channelID, sender string) {
+		broker.HandleEvent(Event{
+			Type:      EventMessagePosted,
+			ChannelID: channelID,
+			Thread:    &domain.Thread{ID: id, Type: domain.ThreadMessage, CreatedBy: sender},
+			Mentions:  []string{"WORKER.1"}, // subscribed and mentioned: still one message
+		})
+	}
+	post("msg-1", "channel-tasks", "COORDINATOR")
+	post("msg-2", "channel-general", "WORKER.3")
+	post("msg-3", "channel-tasks", "WORKER.2")
+
+	require.Eventually(t, func() bool { return len(submitter.getCommands()) == 1 }, time.Second, 5*time.Millisecond)
+	sendCmd, ok := submitter.getCommands()[0].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	assert.Equal(t, "WORKER.1", sendCmd.ProcessID)
+	assert.Equal(t, "[3 new messages: 2 in #tasks from COORDINATOR, WORKER.2; 1 in #general from WORKER.3] Use fabric_inbox to check messages.", sendCmd.Content)
+}
+
+func TestBroker_SteadyTrafficDoesNotPostponeNudges(t *testing.T) {
+	subs := repository.NewMemorySubscriptionRepository()
+	submitter := &mockCommandSubmitter{}
+
+	broker := NewBroker(BrokerConfig{
+		CmdSubmitter:  submitter,
+		Subscriptions: subs,
+		Debounce:      40 * time.Millisecond,
+	})
+
+	channelID := "channel-tasks"
+	_, err := subs.Subscribe(channelID, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	broker.Start()
+	defer broker.Stop()
+
+	// A message every 10ms for 200ms: a window that restarted on every message
+	// would never close while the traffic lasts
+	for i := range 20 {
+		broker.HandleEvent(Event{
+			Type:      EventMessagePosted,
+			ChannelID: channelID,
+			Thread:    &domain.Thread{ID: fmt.Sprintf("msg-%d", i), Type: domain.ThreadMessage, CreatedBy: "WORKER.1"},
+		})
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	assert.GreaterOrEqual(t, len(submitter.getCommands()), 2, "each window closes on its own schedule")
+}
+
+func TestBroker_RecipientsHaveSeparateWindows(t *testing.T) {
+	subs := repository.NewMemorySubscriptionRepository()
+	submitter := &mockCommandSubmitter{}
+
+	broker := NewBroker(BrokerConfig{
+		CmdSubmitter:  submitter,
+		Subscriptions: subs,
+		Debounce:      50 * time.Millisecond,
+	})
+
+	channelID := "channel-tasks"
+	_, err := subs.Subscribe(channelID, "WORKER.1", domain.ModeAll)
+	require.NoError(t, err)
+	_, err = subs.Subscribe(channelID, "WORKER.2", domain.ModeMentions)
+	require.NoError(t, err)
+
+	broker.Start()
+	defer broker.Stop()
+
+	broker.HandleEvent(Event{
+		Type:      EventMessagePosted,
+		ChannelID: channelID,
+		Thread:    &domain.Thread{ID: "msg-1", Type: domain.ThreadMessage, CreatedBy: "COORDINATOR"},
+	})
+	time.Sleep(30 * time.Millisecond)
+	broker.HandleEvent(Event{
+		Type:      EventMessagePosted,
+		ChannelID: channelID,
+		Thread:    &domain.Thread{ID: "msg-2", Type: domain.ThreadMessage, CreatedBy: "COORDINATOR"},
+		Mentions:  []string{"WORKER.2"},
+	})
+
+	// WORKER.1's window opened first and closes first, with both messages;
+	// WORKER.2's window opened with the second message
+	require.Eventually(t, func() bool { return len(submitter.getCommands()) == 2 }, time.Second, 5*time.Millisecond)
+	cmds := submitter.getCommands()
+	first := cmds[0].(*command.SendToProcessCommand)
+	second := cmds[1].(*command.SendToProcessCommand)
+	assert.Equal(t, "WORKER.1", first.ProcessID)
+	assert.Contains(t, first.Content, "[2 new messages in #channel from COORDINATOR]")
+	assert.Equal(t, "WORKER.2", second.ProcessID)
+	assert.Contains(t, second.Content, "[COORDINATOR sent a message in #channel]")
+}
@@ -0,0 +1,288 @@
+// Package client is a caching layer over the fabric service for one agent.
+//
+// Agents re-read the same busy threads many times while they work. The client
+// keeps the most recently read threads and refreshes only the parts that
+// changed since the last read, using the service's thread versions: new
+// replies are fetched one by one, while edits and deletes reload the thread.
+// It also remembers how far the agent has read each thread, so callers can
+// return only the replies that are new to the agent.
+package client
+
+import (
+	"container/list"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+// DefaultCapacity is the number of threads a client keeps by default.
+const DefaultCapacity = 32
+
+// previewLimit is the number of bytes of a text artifact included as preview.
+const previewLimit = 200
+
+// Service is the subset of fabric.Service the client reads from.
+type Service interface {
+	GetThread(id string) (*domain.Thread, error)
+	GetReplies(messageID string) ([]domain.Thread, error)
+	GetReplyIDs(messageID string) ([]string, error)
+	GetArtifacts(targetID string) ([]domain.Thread, error)
+	GetArtifactContent(artifactID string) ([]byte, error)
+	ThreadVersion(messageID string) fabric.ThreadVersion
+}
+
+// Thread is a message with its replies and, if requested, its artifacts.
+type Thread struct {
+	Message   domain.Thread
+	Replies   []domain.Thread
+	Artifacts []Artifact
+}
+
+// Artifact is an artifact attached to a thread with a preview of text content.
+type Artifact struct {
+	domain.Thread
+	Preview string
+}
+
+// Stats counts how thread reads were served.
+type Stats struct {
+	Hits      int // Served from the cache without any fetch
+	Refreshes int // Served from the cache after fetching only what changed
+	Misses    int // Fetched from the service
+}
+
+// entry is a cached thread.
+type entry struct {
+	id              string
+	thread          Thread
+	version         fabric.ThreadVersion
+	artifactsLoaded bool
+}
+
+// Client reads fabric threads for one agent, caching recently read threads.
+// It is safe for concurrent use.
+type Client struct {
+	service  Service
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element // thread ID -> element holding *entry
+	lru     *list.List               // most recently used first
+	read    map[string]int64         // thread ID -> highest reply seq read
+	stats   Stats
+}
+
+// New creates a client keeping up to capacity threads.
+// A capacity of zero or less disables caching; read positions are still tracked.
+func New(service Service, capacity int) *Client {
+	return &Client{
+		service:  service,
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+		read:     make(map[string]int64),
+	}
+}
+
+// ReadThread returns a message thread, from the cache when it is up to date.
+// Artifacts are only loaded when withArtifacts is set.
+// The returned thread is a copy and may be modified by the caller.
+func (c *Client) ReadThread(messageID string, withArtifacts bool) (Thread, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	version := c.service.ThreadVersion(messageID)
+	e, cached := c.lookup(messageID)
+	switch {
+	case !cached || e.version.Edits != version.Edits:
+		loaded, err := c.load(messageID)
+		if err != nil {
+			c.remove(messageID)
+			return Thread{}, err
+		}
+		e = loaded
+		c.stats.Misses++
+	case e.version != version || (withArtifacts && !e.artifactsLoaded):
+		if err := c.refresh(e, version, withArtifacts); err != nil {
+			c.remove(messageID)
+			return Thread{}, err
+		}
+		c.stats.Refreshes++
+	default:
+		c.stats.Hits++
+	}
+	e.version = version
+
+	if withArtifacts && !e.artifactsLoaded {
+		c.loadArtifacts(e)
+	}
+	c.store(e)
+
+	thread := Thread{
+		Message: e.thread.Message,
+		Replies: slices.Clone(e.thread.Replies),
+	}
+	if withArtifacts {
+		thread.Artifacts = slices.Clone(e.thread.Artifacts)
+	}
+	return thread, nil
+}
+
+// MarkRead records that the agent has read a thread up to the reply with
+// the given seq and returns the previous position. Positions never move back.
+func (c *Client) MarkRead(messageID string, seq int64) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prev := c.read[messageID]
+	if seq > prev {
+		c.read[messageID] = seq
+	}
+	return prev
+}
+
+// LastRead returns the seq of the last reply the agent read in a thread,
+// or 0 if the agent has not read it.
+func (c *Client) LastRead(messageID string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.read[messageID]
+}
+
+// Stats returns how thread reads were served so far.
+func (c *Client) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Len returns the number of cached threads.
+func (c *Client) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// load fetches a thread from the service.
+func (c *Client) load(messageID string) (*entry, error) {
+	msg, err := c.service.GetThread(messageID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread: %w", err)
+	}
+	if msg.Type != domain.ThreadMessage {
+		return nil, fmt.Errorf("thread %s is not a message", messageID)
+	}
+
+	replies, err := c.service.GetReplies(messageID)
+	if err != nil {
+		return nil, fmt.Errorf("get replies: %w", err)
+	}
+
+	return &entry{
+		id:     messageID,
+		thread: Thread{Message: *msg, Replies: replies},
+	}, nil
+}
+
+// refresh brings a cached thread up to version, assuming no edits happened:
+// only replies missing from the cache are fetched and artifacts are reloaded
+// if new ones were attached.
+func (c *Client) refresh(e *entry, version fabric.ThreadVersion, withArtifacts bool) error {
+	if e.version.Replies != version.Replies {
+		ids, err := c.service.GetReplyIDs(e.id)
+		if err != nil {
+			return fmt.Errorf("get replies: %w", err)
+		}
+
+		known := make(map[string]domain.Thread, len(e.thread.Replies))
+		for _, r := range e.thread.Replies {
+			known[r.ID] = r
+		}
+		replies := make([]domain.Thread, 0, len(ids))
+		for _, id := range ids {
+			if r, ok := known[id]; ok {
+				replies = append(replies, r)
+				continue
+			}
+			// Skip replies that can't be fetched, matching Service.GetReplies
+			if r, err := c.service.GetThread(id); err == nil {
+				replies = append(replies, *r)
+			}
+		}
+		e.thread.Replies = replies
+	}
+
+	if e.version.Artifacts != version.Artifacts {
+		e.artifactsLoaded = false
+		e.thread.Artifacts = nil
+	}
+	if withArtifacts && !e.artifactsLoaded {
+		c.loadArtifacts(e)
+	}
+	return nil
+}
+
+// loadArtifacts fetches a thread's artifacts with previews of text content.
+// Artifacts are optional context, so a failed fetch leaves the thread without
+// artifacts and is retried on the next read.
+func (c *Client) loadArtifacts(e *entry) {
+	artifacts, err := c.service.GetArtifacts(e.id)
+	if err != nil {
+		e.thread.Artifacts = nil
+		return
+	}
+
+	e.thread.Artifacts = make([]Artifact, 0, len(artifacts))
+	for _, art := range artifacts {
+		var preview string
+		if art.MediaType == "text/plain" || art.MediaType == "text/x-diff" {
+			content, _ := c.service.GetArtifactContent(art.ID)
+			if len(content) > previewLimit {
+				preview = string(content[:previewLimit]) + "..."
+			} else {
+				preview = string(content)
+			}
+		}
+		e.thread.Artifacts = append(e.thread.Artifacts, Artifact{Thread: art, Preview: preview})
+	}
+	e.artifactsLoaded = true
+}
+
+// lookup returns the cached entry for a thread.
+func (c *Client) lookup(messageID string) (*entry, bool) {
+	el, ok := c.entries[messageID]
+	if !ok {
+		return nil, false
+	}
+	return el.Value.(*entry), true
+}
+
+// store marks an entry as most recently used, evicting the least recently
+// used threads beyond capacity.
+func (c *Client) store(e *entry) {
+	if c.capacity <= 0 {
+		return
+	}
+	if el, ok := c.entries[e.id]; ok {
+		el.Value = e
+		c.lru.MoveToFront(el)
+	} else {
+		c.entries[e.id] = c.lru.PushFront(e)
+	}
+	for c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry).id)
+	}
+}
+
+// remove drops a thread from the cache.
+func (c *Client) remove(messageID string) {
+	if el, ok := c.entries[messageID]; ok {
+		c.lru.Remove(el)
+		delete(c.entries, messageID)
+	}
+}
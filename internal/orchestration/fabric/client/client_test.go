@@ -0,0 +1,176 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+)
+
+func newTestService(t *testing.T) *fabric.Service {
+	t.Helper()
+	threadRepo := repository.NewMemoryThreadRepository()
+	depRepo := repository.NewMemoryDependencyRepository()
+	subRepo := repository.NewMemorySubscriptionRepository()
+	ackRepo := repository.NewMemoryAckRepository(depRepo, threadRepo, subRepo)
+	participantRepo := repository.NewMemoryParticipantRepository()
+
+	svc := fabric.NewService(threadRepo, depRepo, subRepo, ackRepo, participantRepo)
+	require.NoError(t, svc.InitSession("coordinator"))
+	return svc
+}
+
+func send(t *testing.T, svc *fabric.Service, content string) *domain.Thread {
+	t.Helper()
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     content,
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+	return msg
+}
+
+func reply(t *testing.T, svc *fabric.Service, messageID, content string) *domain.Thread {
+	t.Helper()
+	r, err := svc.Reply(fabric.ReplyInput{MessageID: messageID, Content: content, CreatedBy: "worker-1"})
+	require.NoError(t, err)
+	return r
+}
+
+func replyContents(thread Thread) []string {
+	var out []string
+	for _, r := range thread.Replies {
+		out = append(out, r.Content)
+	}
+	return out
+}
+
+func TestClient_RepeatedReadsHitCache(t *testing.T) {
+	svc := newTestService(t)
+	msg := send(t, svc, "Task: login")
+	reply(t, svc, msg.ID, "on it")
+	c := New(svc, DefaultCapacity)
+
+	first, err := c.ReadThread(msg.ID, false)
+	require.NoError(t, err)
+	second, err := c.ReadThread(msg.ID, false)
+	require.NoError(t, err)
+
+	require.Equal(t, first, second)
+	require.Equal(t, []string{"on it"}, replyContents(second))
+	require.Equal(t, Stats{Hits: 1, Misses: 1}, c.Stats())
+}
+
+func TestClient_NewRepliesRefreshIncrementally(t *testing.T) {
+	svc := newTestService(t)
+	msg := send(t, svc, "Task: login")
+	first := reply(t, svc, msg.ID, "on it")
+	c := New(svc, DefaultCapacity)
+
+	_, err := c.ReadThread(msg.ID, false)
+	require.NoError(t, err)
+
+	// Replies to replies are flattened to the root thread
+	reply(t, svc, first.ID, "done")
+	thread, err := c.ReadThread(msg.ID, false)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"on it", "done"}, replyContents(thread))
+	require.Equal(t, Stats{Refreshes: 1, Misses: 1}, c.Stats())
+}
+
+func TestClient_EditsReloadThread(t *testing.T) {
+	svc := newTestService(t)
+	msg := send(t, svc, "Task: login")
+	r := reply(t, svc, msg.ID, "on it")
+	c := New(svc, DefaultCapacity)
+
+	_, err := c.ReadThread(msg.ID, false)
+	require.NoError(t, err)
+
+	_, err = svc.EditMessage(r.ID, "worker-1", "on it, ETA 10m")
+	require.NoError(t, err)
+	thread, err := c.ReadThread(msg.ID, false)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"on it, ETA 10m"}, replyContents(thread))
+	require.Equal(t, Stats{Misses: 2}, c.Stats())
+}
+
+func TestClient_ArtifactsLoadedOnDemand(t *testing.T) {
+	svc := newTestService(t)
+	msg := send(t, svc, "Task: login")
+	c := New(svc, DefaultCapacity)
+
+	thread, err := c.ReadThread(msg.ID, false)
+	require.NoError(t, err)
+	require.Nil(t, thread.Artifacts)
+
+	thread, err = c.ReadThread(msg.ID, true)
+	require.NoError(t, err)
+	require.Empty(t, thread.Artifacts)
+	require.Equal(t, Stats{Refreshes: 1, Misses: 1}, c.Stats())
+
+	_, err = c.ReadThread(msg.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, 1, c.Stats().Hits)
+}
+
+func TestClient_EvictsLeastRecentlyUsed(t *testing.T) {
+	svc := newTestService(t)
+	a := send(t, svc, "a")
+	b := send(t, svc, "b")
+	d := send(t, svc, "c")
+	c := New(svc, 2)
+
+	for _, id := range []string{a.ID, b.ID, a.ID, d.ID} {
+		_, err := c.ReadThread(id, false)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, c.Len())
+
+	// b was least recently used and evicted, a is still cached
+	_, err := c.ReadThread(a.ID, false)
+	require.NoError(t, err)
+	_, err = c.ReadThread(b.ID, false)
+	require.NoError(t, err)
+	require.Equal(t, Stats{Hits: 2, Misses: 4}, c.Stats())
+}
+
+func TestClient_ZeroCapacityDisablesCaching(t *testing.T) {
+	svc := newTestService(t)
+	msg := send(t, svc, "Task: login")
+	c := New(svc, 0)
+
+	for range 2 {
+		_, err := c.ReadThread(msg.ID, false)
+		require.NoError(t, err)
+	}
+	require.Zero(t, c.Len())
+	require.Equal(t, Stats{Misses: 2}, c.Stats())
+}
+
+func TestClient_ReadThreadErrors(t *testing.T) {
+	svc := newTestService(t)
+	c := New(svc, DefaultCapacity)
+
+	_, err := c.ReadThread("missing", false)
+	require.ErrorContains(t, err, "get thread")
+
+	_, err = c.ReadThread(svc.GetChannelID(domain.SlugTasks), false)
+	require.ErrorContains(t, err, "is not a message")
+	require.Zero(t, c.Len())
+}
+
+func TestClient_MarkRead(t *testing.T) {
+	c := New(newTestService(t), DefaultCapacity)
+
+	require.Zero(t, c.LastRead("msg"))
+	require.Zero(t, c.MarkRead("msg", 5))
+	require.Equal(t, int64(5), c.MarkRead("msg", 3))
+	require.Equal(t, int64(5), c.LastRead("msg"), "read positions never move back")
+}
@@ -0,0 +1,45 @@
+package fabric
+
+import (
+	"sort"
+	"strings"
+)
+
+// MetaCommitSHA is the message meta key linking a message to a git commit.
+// Set on the reply posted to a task thread when the task's work is committed.
+const MetaCommitSHA = "commit_sha"
+
+// ThreadCommits returns the commits linked to a thread (root or replies), oldest first.
+// threadID may be the root message or any reply.
+func (s *Service) ThreadCommits(threadID string) []string {
+	rootID := s.findThreadRoot(threadID)
+	if rootID == "" {
+		rootID = threadID
+	}
+
+	var commits []string
+	if root, err := s.threads.Get(rootID); err == nil && root.Meta[MetaCommitSHA] != "" {
+		commits = append(commits, root.Meta[MetaCommitSHA])
+	}
+	replies, _ := s.GetReplies(rootID)
+	sort.SliceStable(replies, func(i, j int) bool { return replies[i].Seq < replies[j].Seq })
+	for _, r := range replies {
+		if sha := r.Meta[MetaCommitSHA]; sha != "" {
+			commits = append(commits, sha)
+		}
+	}
+	return commits
+}
+
+// HasCommit returns true if any of commits matches sha, which may be abbreviated.
+func HasCommit(commits []string, sha string) bool {
+	if sha == "" {
+		return false
+	}
+	for _, c := range commits {
+		if strings.HasPrefix(c, sha) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,33 @@
+package fabric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+func TestService_ThreadCommits(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	root, err := svc.SendMessage(SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "Task: Add login [perles-abc1]", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	require.Empty(t, svc.ThreadCommits(root.ID))
+
+	_, err = svc.Reply(ReplyInput{MessageID: root.ID, Content: "Committed", CreatedBy: "system", Meta: map[string]string{MetaCommitSHA: "3f2a9c1e"}})
+	require.NoError(t, err)
+	reply, err := svc.Reply(ReplyInput{MessageID: root.ID, Content: "Committed", CreatedBy: "system", Meta: map[string]string{MetaCommitSHA: "9b8c7d6e"}})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"3f2a9c1e", "9b8c7d6e"}, svc.ThreadCommits(root.ID))
+	require.Equal(t, []string{"3f2a9c1e", "9b8c7d6e"}, svc.ThreadCommits(reply.ID), "any message of the thread works")
+}
+
+func TestHasCommit(t *testing.T) {
+	commits := []string{"3f2a9c1e", "9b8c7d6e"}
+	require.True(t, HasCommit(commits, "3f2a9c1"))
+	require.True(t, HasCommit(commits, "9b8c7d6e"))
+	require.False(t, HasCommit(commits, "abc"))
+	require.False(t, HasCommit(commits, ""))
+}
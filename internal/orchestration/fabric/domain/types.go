@@ -42,8 +42,42 @@ const (
 	KindResponse   MessageKind = "response"
 	KindCompletion MessageKind = "completion"
 	KindError      MessageKind = "error"
+	KindHalt       MessageKind = "halt"
 )
 
+// Priority orders a message in inboxes and controls how recipients are notified.
+type Priority string
+
+const (
+	// PriorityUrgent messages notify recipients immediately, bypassing the
+	// notification debounce, and are listed first in fabric_inbox.
+	PriorityUrgent Priority = "urgent"
+	PriorityNormal Priority = "normal"
+	PriorityLow    Priority = "low"
+)
+
+// IsValid returns true for a known priority or the empty priority (normal).
+func (p Priority) IsValid() bool {
+	switch p {
+	case "", PriorityUrgent, PriorityNormal, PriorityLow:
+		return true
+	}
+	return false
+}
+
+// Rank returns the sort rank of the priority: lower ranks come first.
+// The empty priority ranks as normal.
+func (p Priority) Rank() int {
+	switch p {
+	case PriorityUrgent:
+		return 0
+	case PriorityLow:
+		return 2
+	default:
+		return 1
+	}
+}
+
 // ChannelSlugs defines the fixed channel structure.
 const (
 	SlugRoot     = "root"
@@ -52,8 +86,17 @@ const (
 	SlugPlanning = "planning"
 	SlugGeneral  = "general"
 	SlugObserver = "observer"
+
+	// SlugMemory is the optional project memory channel. Unlike the fixed
+	// channels its messages outlive the session: they are recorded per project
+	// and imported into every new session.
+	SlugMemory = "memory"
 )
 
+// PinEmoji marks a #memory entry as pinned. Pinned entries are surfaced in the
+// coordinator's startup brief.
+const PinEmoji = "📌"
+
 // Special mentions and agent IDs
 const (
 	// MentionHere is a broadcast mention that notifies all channel subscribers.
@@ -62,6 +105,11 @@ const (
 	// AgentUser is the agent ID for the human user interacting via the TUI.
 	// This is not a process that can receive nudge messages.
 	AgentUser = "user"
+
+	// AgentSystem is the sender ID of automated components (guardrails, budget
+	// warnings, the solo mode scheduler). System messages never expect a reply
+	// and can be filtered out of fabric_inbox.
+	AgentSystem = "system"
 )
 
 // ParticipantRole identifies the role of a participant in the fabric.
@@ -87,8 +135,9 @@ type Thread struct {
 	CreatedAt time.Time  `json:"created_at"`
 	CreatedBy string     `json:"created_by"`
 
-	Content string `json:"content,omitempty"`
-	Kind    string `json:"kind,omitempty"`
+	Content  string   `json:"content,omitempty"`
+	Kind     string   `json:"kind,omitempty"`
+	Priority Priority `json:"priority,omitempty"`
 
 	Slug    string `json:"slug,omitempty"`
 	Title   string `json:"title,omitempty"`
@@ -106,13 +155,49 @@ type Thread struct {
 
 	Seq        int64      `json:"seq"`
 	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Edits holds the previous contents of an edited or deleted message, oldest first.
+	Edits     []ThreadEdit `json:"edits,omitempty"`
+	DeletedAt *time.Time   `json:"deleted_at,omitempty"`
 }
 
+// ThreadEdit is a previous revision of a message's content.
+type ThreadEdit struct {
+	Content string `json:"content"`
+	// EditedAt is when this revision was replaced.
+	EditedAt time.Time `json:"edited_at"`
+}
+
+// DeletedContent replaces the content of a deleted message.
+const DeletedContent = "[message deleted]"
+
 // IsArchived returns true if this thread has been archived.
 func (t *Thread) IsArchived() bool {
 	return t.ArchivedAt != nil
 }
 
+// IsEdited returns true if the message content was edited after posting.
+// Deleted messages are not considered edited.
+func (t *Thread) IsEdited() bool {
+	return len(t.Edits) > 0 && !t.IsDeleted()
+}
+
+// IsDeleted returns true if the message was deleted, leaving a tombstone.
+func (t *Thread) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+// Revise replaces the message content, keeping the previous content in Edits.
+func (t *Thread) Revise(content string, at time.Time) {
+	t.Edits = append(t.Edits, ThreadEdit{Content: t.Content, EditedAt: at})
+	t.Content = content
+}
+
+// IsSystem returns true if the thread was posted by an automated component.
+func (t *Thread) IsSystem() bool {
+	return t.CreatedBy == AgentSystem
+}
+
 // HasMention returns true if the given agent is mentioned.
 func (t *Thread) HasMention(agentID string) bool {
 	return slices.Contains(t.Mentions, agentID)
@@ -205,6 +290,60 @@ type ReactionSummary struct {
 	AgentIDs []string `json:"agent_ids"`
 }
 
+// Receipt tracks one message for one recipient: when the broker notified the
+// recipient of it and when fabric_inbox or fabric_read_thread returned it.
+type Receipt struct {
+	MessageID   string    `json:"message_id"`
+	AgentID     string    `json:"agent_id"`
+	DeliveredAt time.Time `json:"delivered_at,omitzero"`
+	ReadAt      time.Time `json:"read_at,omitzero"`
+}
+
+// Key returns a unique identifier for this receipt.
+func (r *Receipt) Key() string {
+	return r.MessageID + ":" + r.AgentID
+}
+
+// IsRead returns true if the recipient has read the message.
+func (r *Receipt) IsRead() bool {
+	return !r.ReadAt.IsZero()
+}
+
+// ThreadReceipts lists the receipts of every message in a thread, ordered by
+// message (root first, then replies in order) and recipient.
+type ThreadReceipts struct {
+	ThreadID string    `json:"thread_id"`
+	Receipts []Receipt `json:"receipts"`
+}
+
+// ScheduledMessage is a message held by the broker until its delivery time,
+// when it is posted to its channel like any other message.
+type ScheduledMessage struct {
+	ID          string      `json:"id"`
+	ChannelSlug string      `json:"channel_slug"`
+	Content     string      `json:"content"`
+	Kind        MessageKind `json:"kind,omitempty"`
+	Priority    Priority    `json:"priority,omitempty"`
+	CreatedBy   string      `json:"created_by"`
+	CreatedAt   time.Time   `json:"created_at"`
+	DeliverAt   time.Time   `json:"deliver_at"`
+}
+
+// ThreadParticipants summarizes who is involved in a message thread.
+// All lists are de-duplicated and ordered by first appearance.
+type ThreadParticipants struct {
+	ThreadID string `json:"thread_id"`
+	// Posters are agents who posted the root message or a reply.
+	Posters []string `json:"posters"`
+	// Mentioned are agents @mentioned anywhere in the thread (excluding @here).
+	Mentioned []string `json:"mentioned"`
+	// Acked are agents who acked or reacted to at least one message in the thread.
+	Acked []string `json:"acked"`
+	// Pending are mentioned agents who have neither posted nor acked - candidates for a nudge.
+	// The human user is never pending, nor are agents only mentioned by system messages.
+	Pending []string `json:"pending"`
+}
+
 // FixedChannels returns the channel definitions for a new session.
 func FixedChannels() []Thread {
 	return []Thread{
@@ -216,3 +355,18 @@ func FixedChannels() []Thread {
 		{Type: ThreadChannel, Slug: SlugObserver, Title: "Observer", Purpose: "User-to-observer communication"},
 	}
 }
+
+// IsFixedChannel reports whether slug names one of the channels every session
+// is created with (including the optional #memory channel), as opposed to a
+// channel created at runtime.
+func IsFixedChannel(slug string) bool {
+	if slug == SlugMemory {
+		return true
+	}
+	return slices.ContainsFunc(FixedChannels(), func(ch Thread) bool { return ch.Slug == slug })
+}
+
+// MemoryChannel returns the channel definition for the project memory channel.
+func MemoryChannel() Thread {
+	return Thread{Type: ThreadChannel, Slug: SlugMemory, Title: "Memory", Purpose: "Durable project decisions and conventions, shared across sessions"}
+}
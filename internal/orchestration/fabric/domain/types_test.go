@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -27,3 +28,27 @@ func TestSlugObserver_InFixedChannels(t *testing.T) {
 	require.Equal(t, "Observer", found.Title, "Observer channel title should be 'Observer'")
 	require.Equal(t, "User-to-observer communication", found.Purpose, "Observer channel purpose should match")
 }
+
+func TestThread_IsSystem(t *testing.T) {
+	require.True(t, (&Thread{CreatedBy: AgentSystem}).IsSystem())
+	require.False(t, (&Thread{CreatedBy: "worker-1"}).IsSystem())
+	require.False(t, (&Thread{CreatedBy: AgentUser}).IsSystem())
+}
+
+func TestThread_Revise(t *testing.T) {
+	msg := &Thread{Content: "Start on perles-abc"}
+	require.False(t, msg.IsEdited())
+
+	at := time.Now()
+	msg.Revise("Start on perles-abd", at)
+	require.Equal(t, "Start on perles-abd", msg.Content)
+	require.Equal(t, []ThreadEdit{{Content: "Start on perles-abc", EditedAt: at}}, msg.Edits)
+	require.True(t, msg.IsEdited())
+	require.False(t, msg.IsDeleted())
+
+	msg.Revise(DeletedContent, at)
+	msg.DeletedAt = &at
+	require.True(t, msg.IsDeleted())
+	require.False(t, msg.IsEdited(), "deleted messages are not reported as edited")
+	require.Len(t, msg.Edits, 2)
+}
@@ -14,6 +14,8 @@ const (
 	EventChannelArchived   EventType = "channel.archived"
 	EventMessagePosted     EventType = "message.posted"
 	EventReplyPosted       EventType = "reply.posted"
+	EventMessageEdited     EventType = "message.edited"
+	EventMessageDeleted    EventType = "message.deleted"
 	EventArtifactAdded     EventType = "artifact.added"
 	EventSubscribed        EventType = "subscribed"
 	EventUnsubscribed      EventType = "unsubscribed"
@@ -22,6 +24,7 @@ const (
 	EventParticipantLeft   EventType = "participant.left"
 	EventReactionAdded     EventType = "reaction.added"
 	EventReactionRemoved   EventType = "reaction.removed"
+	EventReceiptsUpdated   EventType = "receipts.updated"
 )
 
 // Event is published when something happens in Fabric.
@@ -43,6 +46,7 @@ type Event struct {
 	Subscription *domain.Subscription `json:"subscription,omitempty"`
 	Participant  *domain.Participant  `json:"participant,omitempty"`
 	Reaction     *domain.Reaction     `json:"reaction,omitempty"`
+	Receipts     []domain.Receipt     `json:"receipts,omitempty"`
 	Mentions     []string             `json:"mentions,omitempty"`
 	Participants []string             `json:"participants,omitempty"` // Parent thread participants for reply events
 }
@@ -85,6 +89,32 @@ func NewReplyPostedEvent(reply *domain.Thread, channelID, channelSlug, parentID
 	}
 }
 
+// NewMessageEditedEvent creates an event for an edited message or reply.
+// The thread carries the new content and the edit history.
+func NewMessageEditedEvent(message *domain.Thread, channelID, channelSlug string) Event {
+	return Event{
+		Type:        EventMessageEdited,
+		Timestamp:   time.Now(),
+		ChannelID:   channelID,
+		ChannelSlug: channelSlug,
+		AgentID:     message.CreatedBy,
+		Thread:      message,
+	}
+}
+
+// NewMessageDeletedEvent creates an event for a deleted message or reply.
+// The thread is the tombstone left in place of the message.
+func NewMessageDeletedEvent(message *domain.Thread, channelID, channelSlug string) Event {
+	return Event{
+		Type:        EventMessageDeleted,
+		Timestamp:   time.Now(),
+		ChannelID:   channelID,
+		ChannelSlug: channelSlug,
+		AgentID:     message.CreatedBy,
+		Thread:      message,
+	}
+}
+
 // NewArtifactAddedEvent creates an event for an artifact attachment.
 func NewArtifactAddedEvent(artifact *domain.Thread, targetID string) Event {
 	return Event{
@@ -180,3 +210,13 @@ func NewReactionRemovedEvent(reaction *domain.Reaction, channelID, channelSlug s
 		Reaction:    reaction,
 	}
 }
+
+// NewReceiptsUpdatedEvent creates an event for messages delivered to or read by an agent.
+func NewReceiptsUpdatedEvent(agentID string, receipts []domain.Receipt) Event {
+	return Event{
+		Type:      EventReceiptsUpdated,
+		Timestamp: time.Now(),
+		AgentID:   agentID,
+		Receipts:  receipts,
+	}
+}
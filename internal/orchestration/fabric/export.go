@@ -0,0 +1,93 @@
+package fabric
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/validation"
+)
+
+// MetaTaskID is the message meta key linking a thread to a bd task.
+const MetaTaskID = "task_id"
+
+// bracketedIDPattern finds "[...]" tokens that may hold a task ID,
+// as in "Task: Add login [perles-abc1] assigned to worker-1".
+var bracketedIDPattern = regexp.MustCompile(`\[([^\[\]\s]+)\]`)
+
+// ThreadExport is a message thread rendered for persisting outside fabric,
+// e.g. as a comment on a bd issue.
+type ThreadExport struct {
+	RootID       string
+	ChannelSlug  string
+	MessageCount int    // Root plus replies
+	IssueID      string // Linked task ID, empty if the thread has none
+	Markdown     string
+}
+
+// ExportThread renders a message thread (root + replies, oldest first) as markdown.
+// threadID may be the root message or any reply; the export always covers the whole thread.
+func (s *Service) ExportThread(threadID string) (*ThreadExport, error) {
+	rootID := s.findThreadRoot(threadID)
+	if rootID == "" {
+		rootID = threadID
+	}
+
+	root, err := s.threads.Get(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread: %w", err)
+	}
+	if root.Type != domain.ThreadMessage {
+		return nil, fmt.Errorf("thread %s is not a message", rootID)
+	}
+
+	replies, err := s.GetReplies(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("get replies: %w", err)
+	}
+	sort.SliceStable(replies, func(i, j int) bool { return replies[i].Seq < replies[j].Seq })
+
+	channelSlug := s.GetChannelSlug(s.findChannelForMessage(rootID))
+
+	var sb strings.Builder
+	if channelSlug != "" {
+		fmt.Fprintf(&sb, "**Fabric thread %s in #%s**\n", rootID, channelSlug)
+	} else {
+		fmt.Fprintf(&sb, "**Fabric thread %s**\n", rootID)
+	}
+	for _, msg := range append([]domain.Thread{*root}, replies...) {
+		sb.WriteString("\n---\n\n")
+		fmt.Fprintf(&sb, "**@%s** · %s", msg.CreatedBy, msg.CreatedAt.UTC().Format("2006-01-02 15:04 UTC"))
+		if msg.IsEdited() {
+			sb.WriteString(" · edited")
+		}
+		sb.WriteString("\n\n")
+		sb.WriteString(strings.TrimSpace(msg.Content))
+		sb.WriteString("\n")
+	}
+
+	return &ThreadExport{
+		RootID:       rootID,
+		ChannelSlug:  channelSlug,
+		MessageCount: 1 + len(replies),
+		IssueID:      LinkedIssueID(root),
+		Markdown:     sb.String(),
+	}, nil
+}
+
+// LinkedIssueID returns the bd task a message refers to: the MetaTaskID meta
+// value if set, otherwise the first bracketed task ID in the content.
+// Returns empty string if the message is not linked to a task.
+func LinkedIssueID(msg *domain.Thread) string {
+	if id := msg.Meta[MetaTaskID]; validation.IsValidTaskID(id) {
+		return id
+	}
+	for _, match := range bracketedIDPattern.FindAllStringSubmatch(msg.Content, -1) {
+		if validation.IsValidTaskID(match[1]) {
+			return match[1]
+		}
+	}
+	return ""
+}
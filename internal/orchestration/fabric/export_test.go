@@ -0,0 +1,72 @@
+package fabric
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+func TestService_ExportThread(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	root, err := svc.SendMessage(SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Task: Add login [perles-abc1] assigned to worker-1",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+	first, err := svc.Reply(ReplyInput{MessageID: root.ID, Content: "Use sessions, not JWT.\n", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+	_, err = svc.Reply(ReplyInput{MessageID: root.ID, Content: "Agreed", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+
+	// Exporting from a reply covers the whole thread
+	export, err := svc.ExportThread(first.ID)
+	require.NoError(t, err)
+	require.Equal(t, root.ID, export.RootID)
+	require.Equal(t, domain.SlugTasks, export.ChannelSlug)
+	require.Equal(t, 3, export.MessageCount)
+	require.Equal(t, "perles-abc1", export.IssueID)
+
+	md := export.Markdown
+	require.True(t, strings.HasPrefix(md, "**Fabric thread "+root.ID+" in #tasks**\n"), md)
+	require.Contains(t, md, "**@worker-1** · ")
+	require.Contains(t, md, "Use sessions, not JWT.\n")
+	require.Less(t, strings.Index(md, "Task: Add login"), strings.Index(md, "Use sessions"))
+	require.Less(t, strings.Index(md, "Use sessions"), strings.Index(md, "Agreed"))
+}
+
+func TestService_ExportThread_Errors(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	_, err := svc.ExportThread("missing")
+	require.Error(t, err)
+
+	channel, err := svc.GetChannel(domain.SlugTasks)
+	require.NoError(t, err)
+	_, err = svc.ExportThread(channel.ID)
+	require.ErrorContains(t, err, "is not a message")
+}
+
+func TestLinkedIssueID(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  domain.Thread
+		want string
+	}{
+		{"task assignment", domain.Thread{Content: "Task: Fix bug [perles-abc1.2] assigned to worker-2"}, "perles-abc1.2"},
+		{"meta wins", domain.Thread{Content: "See [perles-abc1]", Meta: map[string]string{MetaTaskID: "perles-xyz9"}}, "perles-xyz9"},
+		{"invalid meta falls back", domain.Thread{Content: "See [perles-abc1]", Meta: map[string]string{MetaTaskID: "nope"}}, "perles-abc1"},
+		{"skips non-task brackets", domain.Thread{Content: "[WIP] design for [perles-abc1]"}, "perles-abc1"},
+		{"no link", domain.Thread{Content: "Let's discuss caching"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, LinkedIssueID(&tt.msg))
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package fabric
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+const (
+	// DefaultGraphDepth is the traversal depth used when none is given.
+	DefaultGraphDepth = 3
+	// MaxGraphNodes caps the size of a thread graph; larger graphs are truncated.
+	MaxGraphNodes = 500
+
+	graphLabelMaxLen = 60
+)
+
+// GraphNode is a thread in a thread graph.
+type GraphNode struct {
+	ID        string
+	Type      domain.ThreadType
+	Label     string // #slug for channels, first line of content for messages, name for artifacts
+	CreatedBy string
+	Depth     int // Distance from the graph root
+	Archived  bool
+	Deleted   bool
+
+	// BlockedBy holds the IDs of the threads this thread depends on
+	// (its channel, the message it replies to, or the target it references).
+	BlockedBy []string
+	// Blocks holds the IDs of the threads that depend on this thread.
+	Blocks []string
+}
+
+// GraphEdge is a dependency between two threads of a graph: From depends on To.
+type GraphEdge struct {
+	From     string
+	To       string
+	Relation domain.RelationType
+}
+
+// ThreadGraph is the dependency graph below a thread, in breadth-first order.
+type ThreadGraph struct {
+	RootID    string
+	Nodes     []GraphNode
+	Edges     []GraphEdge
+	Truncated bool // Depth or node limit was hit
+}
+
+// Node returns the node with the given ID, or nil if it is not part of the graph.
+func (g *ThreadGraph) Node(id string) *GraphNode {
+	for i := range g.Nodes {
+		if g.Nodes[i].ID == id {
+			return &g.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// GetThreadGraph returns the dependency graph below root, up to depth levels
+// deep. root may be a channel slug (with or without #) or a thread ID; empty
+// means #root. A depth of 0 or less uses DefaultGraphDepth.
+//
+// Every dependency of a graph node is reported in BlockedBy, even if the
+// thread it points to lies outside the graph (e.g. a channel's #root parent).
+func (s *Service) GetThreadGraph(root string, depth int) (*ThreadGraph, error) {
+	rootID, err := s.resolveGraphRoot(root)
+	if err != nil {
+		return nil, err
+	}
+	if depth <= 0 {
+		depth = DefaultGraphDepth
+	}
+
+	thread, err := s.threads.Get(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread %s: %w", rootID, err)
+	}
+
+	graph := &ThreadGraph{RootID: rootID}
+	index := map[string]int{}
+	addNode := func(t *domain.Thread, level int) {
+		index[t.ID] = len(graph.Nodes)
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ID:        t.ID,
+			Type:      t.Type,
+			Label:     graphLabel(t),
+			CreatedBy: t.CreatedBy,
+			Depth:     level,
+			Archived:  t.IsArchived(),
+			Deleted:   t.IsDeleted(),
+		})
+	}
+	addNode(thread, 0)
+
+	for i := 0; i < len(graph.Nodes); i++ {
+		node := &graph.Nodes[i]
+
+		parents, err := s.dependencies.GetParents(node.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("get dependencies of %s: %w", node.ID, err)
+		}
+		for _, dep := range parents {
+			node.BlockedBy = append(node.BlockedBy, dep.DependsOnID)
+		}
+
+		children, err := s.dependencies.GetChildren(node.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("get dependents of %s: %w", node.ID, err)
+		}
+		children = s.sortBySeq(children)
+		for _, dep := range children {
+			node.Blocks = append(node.Blocks, dep.ThreadID)
+		}
+
+		level := node.Depth
+		for _, dep := range children {
+			if _, seen := index[dep.ThreadID]; !seen {
+				if level >= depth || len(graph.Nodes) >= MaxGraphNodes {
+					graph.Truncated = true
+					continue
+				}
+				child, err := s.threads.Get(dep.ThreadID)
+				if err != nil {
+					continue
+				}
+				addNode(child, level+1)
+			}
+			graph.Edges = append(graph.Edges, GraphEdge{From: dep.ThreadID, To: dep.DependsOnID, Relation: dep.Relation})
+		}
+	}
+
+	return graph, nil
+}
+
+// resolveGraphRoot maps a channel slug or thread ID to a thread ID.
+func (s *Service) resolveGraphRoot(root string) (string, error) {
+	root = strings.TrimSpace(root)
+	if root == "" {
+		root = domain.SlugRoot
+	}
+	if id := s.GetChannelID(strings.TrimPrefix(root, "#")); id != "" {
+		return id, nil
+	}
+	if strings.HasPrefix(root, "#") {
+		return "", fmt.Errorf("unknown channel: %s", root)
+	}
+	return root, nil
+}
+
+// sortBySeq orders dependency edges by the Seq of their dependent thread, so
+// graph children appear in the order they were posted.
+func (s *Service) sortBySeq(deps []domain.Dependency) []domain.Dependency {
+	seqs := make(map[string]int64, len(deps))
+	for _, dep := range deps {
+		if t, err := s.threads.Get(dep.ThreadID); err == nil {
+			seqs[dep.ThreadID] = t.Seq
+		}
+	}
+	sort.SliceStable(deps, func(i, j int) bool { return seqs[deps[i].ThreadID] < seqs[deps[j].ThreadID] })
+	return deps
+}
+
+// graphLabel returns a short, single-line label for a thread.
+func graphLabel(t *domain.Thread) string {
+	switch t.Type {
+	case domain.ThreadChannel:
+		return "#" + t.Slug
+	case domain.ThreadArtifact:
+		return t.Name
+	}
+	label, _, _ := strings.Cut(strings.TrimSpace(t.Content), "\n")
+	if len([]rune(label)) > graphLabelMaxLen {
+		label = string([]rune(label)[:graphLabelMaxLen-3]) + "..."
+	}
+	return label
+}
+
+// GraphTreeLine is one line of a thread graph rendered as an indented tree.
+type GraphTreeLine struct {
+	Prefix   string // Tree branch drawing, e.g. "│   ├── "
+	Node     GraphNode
+	Relation domain.RelationType // How the node depends on the line's parent; empty for the root
+	Repeat   bool                // Node was already listed under another parent
+}
+
+// GraphTree lays out a thread graph as an indented tree, with each thread
+// listed under the thread it depends on.
+func GraphTree(g *ThreadGraph) []GraphTreeLine {
+	if g == nil || len(g.Nodes) == 0 {
+		return nil
+	}
+
+	children := map[string][]GraphEdge{}
+	for _, edge := range g.Edges {
+		children[edge.To] = append(children[edge.To], edge)
+	}
+
+	var lines []GraphTreeLine
+	printed := map[string]bool{}
+	var walk func(id string, relation domain.RelationType, prefix string, last, top bool)
+	walk = func(id string, relation domain.RelationType, prefix string, last, top bool) {
+		node := g.Node(id)
+		if node == nil {
+			return
+		}
+
+		branch, childPrefix := "", ""
+		if !top {
+			branch, childPrefix = "├── ", prefix+"│   "
+			if last {
+				branch, childPrefix = "└── ", prefix+"    "
+			}
+		}
+		lines = append(lines, GraphTreeLine{Prefix: prefix + branch, Node: *node, Relation: relation, Repeat: printed[id]})
+		if printed[id] {
+			return
+		}
+		printed[id] = true
+
+		edges := children[id]
+		for i, edge := range edges {
+			walk(edge.From, edge.Relation, childPrefix, i == len(edges)-1, false)
+		}
+	}
+	walk(g.RootID, "", "", true, true)
+	return lines
+}
+
+// RenderGraphTree renders a thread graph as a plain-text indented tree, one
+// thread per line.
+func RenderGraphTree(g *ThreadGraph) string {
+	var sb strings.Builder
+	for _, line := range GraphTree(g) {
+		sb.WriteString(line.Prefix + GraphNodeLine(line.Node, line.Relation))
+		if line.Repeat {
+			sb.WriteString(" (see above)")
+		}
+		sb.WriteString("\n")
+	}
+	if g != nil && g.Truncated {
+		sb.WriteString(GraphTruncatedNote + "\n")
+	}
+	return sb.String()
+}
+
+// GraphTruncatedNote is appended to rendered graphs that hit a limit.
+const GraphTruncatedNote = "… (truncated; increase depth or start from a deeper thread)"
+
+// GraphNodeLine renders a single node as "[type] label (id) by agent",
+// prefixed with the relation through which it depends on its parent.
+func GraphNodeLine(node GraphNode, relation domain.RelationType) string {
+	var sb strings.Builder
+	if relation != "" {
+		sb.WriteString(string(relation) + ": ")
+	}
+	fmt.Fprintf(&sb, "[%s] %s (%s)", node.Type, node.Label, node.ID)
+	if node.CreatedBy != "" && node.Type != domain.ThreadChannel {
+		sb.WriteString(" by " + node.CreatedBy)
+	}
+	switch {
+	case node.Deleted:
+		sb.WriteString(" [deleted]")
+	case node.Archived:
+		sb.WriteString(" [archived]")
+	}
+	if len(node.Blocks) > 0 {
+		fmt.Fprintf(&sb, " · blocks %d", len(node.Blocks))
+	}
+	return sb.String()
+}
@@ -0,0 +1,84 @@
+package fabric
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+func TestService_GetThreadGraph(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	msg, err := svc.SendMessage(SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "Implement parser\nDetails follow", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	reply, err := svc.Reply(ReplyInput{MessageID: msg.ID, Content: "On it", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "plan.md")
+	require.NoError(t, os.WriteFile(path, []byte("# Plan"), 0o600))
+	artifact, err := svc.AttachArtifact(AttachArtifactInput{TargetID: msg.ID, Path: path, CreatedBy: "worker-1"})
+	require.NoError(t, err)
+
+	graph, err := svc.GetThreadGraph("#tasks", 0)
+	require.NoError(t, err)
+	require.Equal(t, svc.GetChannelID(domain.SlugTasks), graph.RootID)
+	require.Len(t, graph.Nodes, 4)
+	require.False(t, graph.Truncated)
+
+	channel := graph.Node(graph.RootID)
+	require.Equal(t, "#tasks", channel.Label)
+	require.Equal(t, []string{svc.GetChannelID(domain.SlugRoot)}, channel.BlockedBy)
+	require.Equal(t, []string{msg.ID}, channel.Blocks)
+
+	node := graph.Node(msg.ID)
+	require.Equal(t, "Implement parser", node.Label)
+	require.Equal(t, 1, node.Depth)
+	require.Equal(t, []string{graph.RootID}, node.BlockedBy)
+	require.ElementsMatch(t, []string{reply.ID, artifact.ID}, node.Blocks)
+
+	require.Contains(t, graph.Edges, GraphEdge{From: reply.ID, To: msg.ID, Relation: domain.RelationReplyTo})
+	require.Contains(t, graph.Edges, GraphEdge{From: artifact.ID, To: msg.ID, Relation: domain.RelationReferences})
+	require.Equal(t, "plan.md", graph.Node(artifact.ID).Label)
+
+	tree := RenderGraphTree(graph)
+	lines := strings.Split(strings.TrimSpace(tree), "\n")
+	require.Len(t, lines, 4)
+	require.True(t, strings.HasPrefix(lines[0], "[channel] #tasks"), tree)
+	require.True(t, strings.HasPrefix(lines[1], "└── child_of: [message] Implement parser"), tree)
+	require.Contains(t, tree, "    ├── reply_to: [message] On it ("+reply.ID+") by worker-1")
+	require.Contains(t, tree, "references: [artifact] plan.md")
+}
+
+func TestService_GetThreadGraph_DepthAndRoot(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	msg, err := svc.SendMessage(SendMessageInput{ChannelSlug: domain.SlugGeneral, Content: "Hello", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	_, err = svc.Reply(ReplyInput{MessageID: msg.ID, Content: "Hi", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+
+	// Empty root starts from #root; depth 1 stops at the channels
+	graph, err := svc.GetThreadGraph("", 1)
+	require.NoError(t, err)
+	require.Equal(t, svc.GetChannelID(domain.SlugRoot), graph.RootID)
+	require.True(t, graph.Truncated)
+	require.Nil(t, graph.Node(msg.ID))
+	require.Contains(t, RenderGraphTree(graph), "truncated")
+
+	// A thread ID works as a root
+	graph, err = svc.GetThreadGraph(msg.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes, 2)
+	require.False(t, graph.Truncated)
+
+	_, err = svc.GetThreadGraph("#missing", 0)
+	require.ErrorContains(t, err, "unknown channel")
+	_, err = svc.GetThreadGraph("no-such-thread", 0)
+	require.Error(t, err)
+}
@@ -1,11 +1,16 @@
 package mcp
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/client"
 	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	"github.com/zjrosen/perles/internal/orchestration/mcp/types"
 )
@@ -27,15 +32,18 @@ type Handlers struct {
 	service *fabric.Service
 	agentID string                 // The agent ID for this handler instance
 	role    domain.ParticipantRole // The role for fabric_join
+	threads *client.Client         // Reads threads and tracks read positions for fabric_read_thread
 }
 
 // NewHandlers creates a new Handlers instance.
 // The role defaults to RoleWorker if not set via WithRole.
+// Threads are not cached unless enabled via WithThreadCache.
 func NewHandlers(service *fabric.Service, agentID string) *Handlers {
 	return &Handlers{
 		service: service,
 		agentID: agentID,
 		role:    domain.RoleWorker, // Default role
+		threads: client.New(service, 0),
 	}
 }
 
@@ -45,11 +53,24 @@ func (h *Handlers) WithRole(role domain.ParticipantRole) *Handlers {
 	return h
 }
 
+// WithThreadCache caches up to capacity recently read threads for
+// fabric_read_thread, so hot threads are not fetched in full on every read.
+func (h *Handlers) WithThreadCache(capacity int) *Handlers {
+	h.threads = client.New(h.service, capacity)
+	return h
+}
+
+// ThreadStats returns how fabric_read_thread calls were served.
+func (h *Handlers) ThreadStats() client.Stats {
+	return h.threads.Stats()
+}
+
 // RegisterAll registers all Fabric tools with the MCP server.
 func (h *Handlers) RegisterAll(server ToolRegistrar) {
 	server.RegisterTool(ToolFabricJoin, h.HandleJoin)
 	server.RegisterTool(ToolFabricInbox, h.HandleInbox)
 	server.RegisterTool(ToolFabricSend, h.HandleSend)
+	server.RegisterTool(ToolFabricCancelScheduled, h.HandleCancelScheduled)
 	server.RegisterTool(ToolFabricReply, h.HandleReply)
 	server.RegisterTool(ToolFabricAck, h.HandleAck)
 	server.RegisterTool(ToolFabricSubscribe, h.HandleSubscribe)
@@ -57,7 +78,13 @@ func (h *Handlers) RegisterAll(server ToolRegistrar) {
 	server.RegisterTool(ToolFabricAttach, h.HandleAttach)
 	server.RegisterTool(ToolFabricHistory, h.HandleHistory)
 	server.RegisterTool(ToolFabricReadThread, h.HandleReadThread)
+	server.RegisterTool(ToolFabricThreadParticipants, h.HandleThreadParticipants)
+	server.RegisterTool(ToolFabricReceipts, h.HandleReceipts)
 	server.RegisterTool(ToolFabricReact, h.HandleReact)
+	server.RegisterTool(ToolFabricEdit, h.HandleEdit)
+	server.RegisterTool(ToolFabricDelete, h.HandleDelete)
+	server.RegisterTool(ToolFabricListChannels, h.HandleListChannels)
+	server.RegisterTool(ToolFabricGraph, h.HandleGraph)
 }
 
 // HandleJoin handles the fabric_join tool call.
@@ -78,7 +105,59 @@ func (h *Handlers) HandleJoin(_ context.Context, _ json.RawMessage) (*ToolCallRe
 }
 
 // HandleInbox handles the fabric_inbox tool call.
+// Every channel with matching unread messages is listed with its count, but
+// only one page of messages is returned so a large inbox cannot flood the
+// agent's context; next_cursor fetches the next page.
 func (h *Handlers) HandleInbox(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args inboxArgs
+	if len(rawArgs) > 0 {
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+	switch args.From {
+	case "", inboxFromAll, inboxFromAgents, inboxFromSystem:
+	default:
+		return nil, fmt.Errorf("invalid from: %s (must be all, agents or system)", args.From)
+	}
+
+	var since time.Time
+	if args.Since != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, args.Since); err != nil {
+			return nil, fmt.Errorf("invalid since (must be RFC3339): %w", err)
+		}
+	}
+	if args.Limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+	limit := args.Limit
+	if limit == 0 {
+		limit = defaultInboxLimit
+	}
+	limit = min(limit, maxInboxLimit)
+
+	var channelFilter string
+	if args.Channel != "" {
+		if channelFilter = h.service.GetChannelID(args.Channel); channelFilter == "" {
+			return nil, fmt.Errorf("unknown channel: %s", args.Channel)
+		}
+	}
+
+	// The cursor is the ID of the last message of the previous page; the next
+	// page starts after it even if the agent acked the previous page meanwhile.
+	var after *InboxMessage
+	if args.Cursor != "" {
+		thread, err := h.service.GetThread(args.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %s", args.Cursor)
+		}
+		msg := newInboxMessage(thread)
+		after = &msg
+	}
+
+	filtered := args.From == inboxFromAgents || args.From == inboxFromSystem || !since.IsZero()
+
 	unacked, err := h.service.GetUnacked(h.agentID)
 	if err != nil {
 		return nil, fmt.Errorf("get unacked: %w", err)
@@ -97,9 +176,19 @@ func (h *Handlers) HandleInbox(_ context.Context, rawArgs json.RawMessage) (*Too
 		h.service.GetChannelID(domain.SlugGeneral):  domain.SlugGeneral,
 		h.service.GetChannelID(domain.SlugObserver): domain.SlugObserver,
 	}
+	if memoryID := h.service.GetChannelID(domain.SlugMemory); memoryID != "" {
+		slugMap[memoryID] = domain.SlugMemory
+	}
 
 	for channelID, summary := range unacked {
+		if channelFilter != "" && channelID != channelFilter {
+			continue
+		}
 		slug := slugMap[channelID]
+		if slug == "" {
+			// Channels created at runtime
+			slug = h.service.GetChannelSlug(channelID)
+		}
 		if slug == "" {
 			continue
 		}
@@ -117,31 +206,188 @@ func (h *Handlers) HandleInbox(_ context.Context, rawArgs json.RawMessage) (*Too
 			if err != nil {
 				continue
 			}
+			if (args.From == inboxFromAgents && thread.IsSystem()) || (args.From == inboxFromSystem && !thread.IsSystem()) ||
+				thread.CreatedAt.Before(since) {
+				inbox.Unacked--
+				continue
+			}
 
-			inbox.Messages = append(inbox.Messages, InboxMessage{
-				ID:        thread.ID,
-				Content:   thread.Content,
-				CreatedBy: thread.CreatedBy,
-				CreatedAt: thread.CreatedAt,
-				Mentions:  thread.Mentions,
-			})
+			inbox.Messages = append(inbox.Messages, newInboxMessage(thread))
+		}
+		if filtered && len(inbox.Messages) == 0 {
+			continue
 		}
+		slices.SortStableFunc(inbox.Messages, compareInboxMessages)
 
 		response.Channels = append(response.Channels, inbox)
-		response.TotalUnacked += summary.Count
+		response.TotalUnacked += inbox.Unacked
 	}
 
-	return types.StructuredResult(
-		fmt.Sprintf("Found %d unread messages across %d channels", response.TotalUnacked, len(response.Channels)),
-		response,
-	), nil
+	response.NextCursor = paginateInbox(response.Channels, after, limit)
+
+	// Channels are ordered by their first (most urgent, oldest) message, so
+	// urgent messages lead the inbox. Channels without messages on this page
+	// go last, by slug.
+	slices.SortFunc(response.Channels, func(a, b ChannelInbox) int {
+		switch {
+		case len(a.Messages) == 0 && len(b.Messages) == 0:
+			return cmp.Compare(a.ChannelSlug, b.ChannelSlug)
+		case len(a.Messages) == 0:
+			return 1
+		case len(b.Messages) == 0:
+			return -1
+		}
+		if c := compareInboxMessages(a.Messages[0], b.Messages[0]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ChannelSlug, b.ChannelSlug)
+	})
+
+	urgent := 0
+	var returned []string
+	for _, ch := range response.Channels {
+		for _, msg := range ch.Messages {
+			returned = append(returned, msg.ID)
+			if domain.Priority(msg.Priority) == domain.PriorityUrgent {
+				urgent++
+			}
+		}
+	}
+	// Returned messages count as read; acking them is still up to the agent
+	if err := h.service.MarkRead(h.agentID, returned...); err != nil {
+		return nil, err
+	}
+
+	text := fmt.Sprintf("Found %d unread messages across %d channels", response.TotalUnacked, len(response.Channels))
+	if urgent > 0 {
+		text += fmt.Sprintf(" (%d urgent)", urgent)
+	}
+	if response.NextCursor != "" || after != nil {
+		counts := make([]string, 0, len(response.Channels))
+		for _, ch := range response.Channels {
+			counts = append(counts, fmt.Sprintf("#%s %d", ch.ChannelSlug, ch.Unacked))
+		}
+		text += fmt.Sprintf("; showing %d (%s)", len(returned), strings.Join(counts, ", "))
+	}
+	if response.NextCursor != "" {
+		text += fmt.Sprintf("; call fabric_inbox with cursor %q for more", response.NextCursor)
+	}
+	return types.StructuredResult(text, response), nil
+}
+
+// Page sizes of fabric_inbox.
+const (
+	defaultInboxLimit = 20
+	maxInboxLimit     = 100
+)
+
+// inboxArgs are arguments for fabric_inbox.
+type inboxArgs struct {
+	From    string `json:"from,omitempty"`
+	Channel string `json:"channel,omitempty"`
+	Since   string `json:"since,omitempty"`
+	Limit   int    `json:"limit,omitempty"`
+	Cursor  string `json:"cursor,omitempty"`
+}
+
+// Sender filters of fabric_inbox.
+const (
+	inboxFromAll    = "all"
+	inboxFromAgents = "agents" // peer messages only, no system messages
+	inboxFromSystem = "system" // automated messages only
+)
+
+// newInboxMessage summarizes a message for the inbox.
+func newInboxMessage(thread *domain.Thread) InboxMessage {
+	return InboxMessage{
+		ID:        thread.ID,
+		Content:   thread.Content,
+		CreatedBy: thread.CreatedBy,
+		CreatedAt: thread.CreatedAt,
+		Mentions:  thread.Mentions,
+		Priority:  string(thread.Priority),
+		System:    thread.IsSystem(),
+		Edited:    thread.IsEdited(),
+	}
+}
+
+// compareInboxMessages orders inbox messages by priority, then time, then ID.
+func compareInboxMessages(a, b InboxMessage) int {
+	if c := cmp.Compare(domain.Priority(a.Priority).Rank(), domain.Priority(b.Priority).Rank()); c != 0 {
+		return c
+	}
+	if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.ID, b.ID)
+}
+
+// paginateInbox keeps the messages of one page in the channels: the first
+// limit messages across all channels, in inbox order, that come after the
+// cursor message. Unread counts are left alone so the channels still
+// summarize the whole inbox. It returns the cursor of the next page, or ""
+// if this is the last page.
+func paginateInbox(channels []ChannelInbox, after *InboxMessage, limit int) string {
+	var all []InboxMessage
+	for _, ch := range channels {
+		for _, msg := range ch.Messages {
+			if after == nil || compareInboxMessages(msg, *after) > 0 {
+				all = append(all, msg)
+			}
+		}
+	}
+	slices.SortFunc(all, compareInboxMessages)
+
+	var next string
+	if len(all) > limit {
+		all = all[:limit]
+		next = all[limit-1].ID
+	}
+	page := make(map[string]bool, len(all))
+	for _, msg := range all {
+		page[msg.ID] = true
+	}
+	for i := range channels {
+		channels[i].Messages = slices.DeleteFunc(channels[i].Messages, func(msg InboxMessage) bool {
+			return !page[msg.ID]
+		})
+	}
+	return next
 }
 
 // sendArgs are arguments for fabric_send.
 type sendArgs struct {
-	Channel string `json:"channel"`
-	Content string `json:"content"`
-	Kind    string `json:"kind,omitempty"`
+	Channel   string `json:"channel"`
+	Content   string `json:"content"`
+	Kind      string `json:"kind,omitempty"`
+	Priority  string `json:"priority,omitempty"`
+	DeliverAt string `json:"deliver_at,omitempty"`
+	Delay     string `json:"delay,omitempty"`
+}
+
+// deliveryTime returns when a scheduled message should be posted, or the zero
+// time if the message should be posted now.
+func (a sendArgs) deliveryTime(now time.Time) (time.Time, error) {
+	switch {
+	case a.DeliverAt != "" && a.Delay != "":
+		return time.Time{}, fmt.Errorf("deliver_at and delay cannot be combined")
+	case a.DeliverAt != "":
+		at, err := time.Parse(time.RFC3339, a.DeliverAt)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid deliver_at (must be RFC3339): %w", err)
+		}
+		return at, nil
+	case a.Delay != "":
+		delay, err := time.ParseDuration(a.Delay)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid delay: %w", err)
+		}
+		if delay <= 0 {
+			return time.Time{}, fmt.Errorf("delay must be positive")
+		}
+		return now.Add(delay), nil
+	}
+	return time.Time{}, nil
 }
 
 // HandleSend handles the fabric_send tool call.
@@ -158,17 +404,28 @@ func (h *Handlers) HandleSend(_ context.Context, rawArgs json.RawMessage) (*Tool
 		return nil, fmt.Errorf("content is required")
 	}
 
+	deliverAt, err := args.deliveryTime(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	kind := domain.MessageKind(args.Kind)
 	if kind == "" {
 		kind = domain.KindInfo
 	}
 
-	msg, err := h.service.SendMessage(fabric.SendMessageInput{
+	input := fabric.SendMessageInput{
 		ChannelSlug: args.Channel,
 		Content:     args.Content,
 		Kind:        kind,
+		Priority:    domain.Priority(args.Priority),
 		CreatedBy:   h.agentID,
-	})
+	}
+	if !deliverAt.IsZero() {
+		return h.scheduleMessage(input, deliverAt)
+	}
+
+	msg, err := h.service.SendMessage(input)
 	if err != nil {
 		return nil, fmt.Errorf("send message: %w", err)
 	}
@@ -188,11 +445,59 @@ func (h *Handlers) HandleSend(_ context.Context, rawArgs json.RawMessage) (*Tool
 	), nil
 }
 
+// scheduleMessage schedules a fabric_send message for delivery at deliverAt.
+func (h *Handlers) scheduleMessage(input fabric.SendMessageInput, deliverAt time.Time) (*ToolCallResult, error) {
+	scheduled, err := h.service.ScheduleMessage(input, deliverAt)
+	if err != nil {
+		return nil, fmt.Errorf("schedule message: %w", err)
+	}
+
+	response := SendResponse{
+		ID:        scheduled.ID,
+		ChannelID: h.service.GetChannelID(input.ChannelSlug),
+		DeliverAt: scheduled.DeliverAt,
+	}
+
+	return types.StructuredResult(
+		fmt.Sprintf("Message scheduled for #%s at %s (id: %s, cancel with fabric_cancel_scheduled)",
+			input.ChannelSlug, scheduled.DeliverAt.Format(time.RFC3339), scheduled.ID),
+		response,
+	), nil
+}
+
+// cancelScheduledArgs are arguments for fabric_cancel_scheduled.
+type cancelScheduledArgs struct {
+	ID string `json:"id"`
+}
+
+// HandleCancelScheduled handles the fabric_cancel_scheduled tool call.
+func (h *Handlers) HandleCancelScheduled(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args cancelScheduledArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	cancelled, err := h.service.CancelScheduledMessage(args.ID, h.agentID)
+	if err != nil {
+		return nil, fmt.Errorf("cancel scheduled message: %w", err)
+	}
+
+	return types.StructuredResult(
+		fmt.Sprintf("Cancelled scheduled message %s for #%s", cancelled.ID, cancelled.ChannelSlug),
+		cancelled,
+	), nil
+}
+
 // replyArgs are arguments for fabric_reply.
 type replyArgs struct {
 	MessageID string `json:"message_id"`
 	Content   string `json:"content"`
 	Kind      string `json:"kind,omitempty"`
+	Priority  string `json:"priority,omitempty"`
 }
 
 // HandleReply handles the fabric_reply tool call.
@@ -218,6 +523,7 @@ func (h *Handlers) HandleReply(_ context.Context, rawArgs json.RawMessage) (*Too
 		MessageID: args.MessageID,
 		Content:   args.Content,
 		Kind:      kind,
+		Priority:  domain.Priority(args.Priority),
 		CreatedBy: h.agentID,
 	})
 	if err != nil {
@@ -389,6 +695,7 @@ type historyArgs struct {
 	Channel      string `json:"channel"`
 	Limit        int    `json:"limit,omitempty"`
 	IncludeAcked *bool  `json:"include_acked,omitempty"`
+	Commit       string `json:"commit,omitempty"`
 }
 
 // HandleHistory handles the fabric_history tool call.
@@ -407,7 +714,12 @@ func (h *Handlers) HandleHistory(_ context.Context, rawArgs json.RawMessage) (*T
 		limit = 50
 	}
 
-	messages, err := h.service.ListMessages(args.Channel, limit)
+	// A commit filter searches the whole channel; limit applies to the matches
+	listLimit := limit
+	if args.Commit != "" {
+		listLimit = 0
+	}
+	messages, err := h.service.ListMessages(args.Channel, listLimit)
 	if err != nil {
 		return nil, fmt.Errorf("list messages: %w", err)
 	}
@@ -431,6 +743,14 @@ func (h *Handlers) HandleHistory(_ context.Context, rawArgs json.RawMessage) (*T
 	}
 
 	for _, msg := range messages {
+		commits := h.service.ThreadCommits(msg.ID)
+		if args.Commit != "" && !fabric.HasCommit(commits, args.Commit) {
+			continue
+		}
+		if len(response.Messages) == limit {
+			break
+		}
+
 		// Check for replies
 		replies, _ := h.service.GetReplies(msg.ID)
 
@@ -452,8 +772,14 @@ func (h *Handlers) HandleHistory(_ context.Context, rawArgs json.RawMessage) (*T
 			IsAcked:     isAcked,
 			Mentions:    msg.Mentions,
 			HasArtifact: len(artifacts) > 0,
+			Commits:     commits,
+			Edited:      msg.IsEdited(),
+			Deleted:     msg.IsDeleted(),
 		})
 	}
+	if args.Commit != "" {
+		response.TotalCount = len(response.Messages)
+	}
 
 	return types.StructuredResult(
 		fmt.Sprintf("Retrieved %d messages from #%s", len(response.Messages), args.Channel),
@@ -465,9 +791,12 @@ func (h *Handlers) HandleHistory(_ context.Context, rawArgs json.RawMessage) (*T
 type readThreadArgs struct {
 	MessageID        string `json:"message_id"`
 	IncludeArtifacts *bool  `json:"include_artifacts,omitempty"`
+	UnreadOnly       bool   `json:"unread_only,omitempty"`
 }
 
 // HandleReadThread handles the fabric_read_thread tool call.
+// Replies are counted as read once returned, so unread_only returns only
+// replies posted since this agent's previous read of the thread.
 func (h *Handlers) HandleReadThread(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	var args readThreadArgs
 	if err := json.Unmarshal(rawArgs, &args); err != nil {
@@ -478,21 +807,13 @@ func (h *Handlers) HandleReadThread(_ context.Context, rawArgs json.RawMessage)
 		return nil, fmt.Errorf("message_id is required")
 	}
 
-	// Get root message
-	msg, err := h.service.GetThread(args.MessageID)
-	if err != nil {
-		return nil, fmt.Errorf("get thread: %w", err)
-	}
-
-	if msg.Type != domain.ThreadMessage {
-		return nil, fmt.Errorf("thread %s is not a message", args.MessageID)
-	}
-
-	// Get replies
-	replies, err := h.service.GetReplies(args.MessageID)
+	// Get artifacts if requested (default: true)
+	includeArtifacts := args.IncludeArtifacts == nil || *args.IncludeArtifacts
+	thread, err := h.threads.ReadThread(args.MessageID, includeArtifacts)
 	if err != nil {
-		return nil, fmt.Errorf("get replies: %w", err)
+		return nil, err
 	}
+	msg := thread.Message
 
 	response := ReadThreadResponse{
 		Message: ThreadMessage{
@@ -503,14 +824,30 @@ func (h *Handlers) HandleReadThread(_ context.Context, rawArgs json.RawMessage)
 			CreatedBy: msg.CreatedBy,
 			CreatedAt: msg.CreatedAt,
 			Mentions:  msg.Mentions,
+			Edited:    msg.IsEdited(),
+			Deleted:   msg.IsDeleted(),
 		},
-		Replies:      make([]ThreadMessage, 0, len(replies)),
+		Replies:      make([]ThreadMessage, 0, len(thread.Replies)),
 		Participants: []string{msg.CreatedBy},
 	}
 
 	participantSet := map[string]bool{msg.CreatedBy: true}
+	lastRead := h.threads.LastRead(args.MessageID)
+	var latestSeq int64
+
+	for _, reply := range thread.Replies {
+		if !participantSet[reply.CreatedBy] {
+			participantSet[reply.CreatedBy] = true
+			response.Participants = append(response.Participants, reply.CreatedBy)
+		}
+
+		latestSeq = max(latestSeq, reply.Seq)
+		if reply.Seq > lastRead {
+			response.NewReplies++
+		} else if args.UnreadOnly {
+			continue
+		}
 
-	for _, reply := range replies {
 		response.Replies = append(response.Replies, ThreadMessage{
 			ID:        reply.ID,
 			Seq:       reply.Seq,
@@ -519,31 +856,23 @@ func (h *Handlers) HandleReadThread(_ context.Context, rawArgs json.RawMessage)
 			CreatedBy: reply.CreatedBy,
 			CreatedAt: reply.CreatedAt,
 			Mentions:  reply.Mentions,
+			Edited:    reply.IsEdited(),
+			Deleted:   reply.IsDeleted(),
 		})
+	}
+	h.threads.MarkRead(args.MessageID, latestSeq)
 
-		if !participantSet[reply.CreatedBy] {
-			participantSet[reply.CreatedBy] = true
-			response.Participants = append(response.Participants, reply.CreatedBy)
-		}
+	readIDs := []string{msg.ID}
+	for _, reply := range response.Replies {
+		readIDs = append(readIDs, reply.ID)
+	}
+	if err := h.service.MarkRead(h.agentID, readIDs...); err != nil {
+		return nil, err
 	}
 
-	// Get artifacts if requested (default: true)
-	includeArtifacts := args.IncludeArtifacts == nil || *args.IncludeArtifacts
 	if includeArtifacts {
-		artifacts, _ := h.service.GetArtifacts(args.MessageID)
-		response.Artifacts = make([]ThreadArtifact, 0, len(artifacts))
-
-		for _, art := range artifacts {
-			preview := ""
-			if art.MediaType == "text/plain" || art.MediaType == "text/x-diff" {
-				content, _ := h.service.GetArtifactContent(art.ID)
-				if len(content) > 200 {
-					preview = string(content[:200]) + "..."
-				} else {
-					preview = string(content)
-				}
-			}
-
+		response.Artifacts = make([]ThreadArtifact, 0, len(thread.Artifacts))
+		for _, art := range thread.Artifacts {
 			response.Artifacts = append(response.Artifacts, ThreadArtifact{
 				ID:        art.ID,
 				Name:      art.Name,
@@ -551,14 +880,77 @@ func (h *Handlers) HandleReadThread(_ context.Context, rawArgs json.RawMessage)
 				SizeBytes: art.SizeBytes,
 				CreatedBy: art.CreatedBy,
 				CreatedAt: art.CreatedAt,
-				Preview:   preview,
+				Preview:   art.Preview,
 			})
 		}
 	}
 
+	summary := fmt.Sprintf("Thread with %d replies, %d participants", len(thread.Replies), len(response.Participants))
+	if args.UnreadOnly {
+		summary = fmt.Sprintf("Thread with %d new of %d replies, %d participants",
+			response.NewReplies, len(thread.Replies), len(response.Participants))
+	}
+	return types.StructuredResult(summary, response), nil
+}
+
+// threadParticipantsArgs are arguments for fabric_thread_participants.
+type threadParticipantsArgs struct {
+	MessageID string `json:"message_id"`
+}
+
+// HandleThreadParticipants handles the fabric_thread_participants tool call.
+func (h *Handlers) HandleThreadParticipants(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args threadParticipantsArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.MessageID == "" {
+		return nil, fmt.Errorf("message_id is required")
+	}
+
+	summary, err := h.service.GetThreadParticipants(args.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread participants: %w", err)
+	}
+
 	return types.StructuredResult(
-		fmt.Sprintf("Thread with %d replies, %d participants", len(response.Replies), len(response.Participants)),
-		response,
+		fmt.Sprintf("%d posted, %d mentioned, %d acked, %d pending",
+			len(summary.Posters), len(summary.Mentioned), len(summary.Acked), len(summary.Pending)),
+		summary,
+	), nil
+}
+
+// receiptsArgs are arguments for fabric_receipts.
+type receiptsArgs struct {
+	MessageID string `json:"message_id"`
+}
+
+// HandleReceipts handles the fabric_receipts tool call.
+func (h *Handlers) HandleReceipts(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args receiptsArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.MessageID == "" {
+		return nil, fmt.Errorf("message_id is required")
+	}
+
+	receipts, err := h.service.GetThreadReceipts(args.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread receipts: %w", err)
+	}
+
+	read := 0
+	for _, r := range receipts.Receipts {
+		if r.IsRead() {
+			read++
+		}
+	}
+	return types.StructuredResult(
+		fmt.Sprintf("%d receipts: %d read, %d delivered but unread", len(receipts.Receipts), read, len(receipts.Receipts)-read),
+		receipts,
 	), nil
 }
 
@@ -636,3 +1028,221 @@ func (h *Handlers) HandleReact(_ context.Context, rawArgs json.RawMessage) (*Too
 		response,
 	), nil
 }
+
+// editArgs are arguments for fabric_edit.
+type editArgs struct {
+	MessageID string `json:"message_id"`
+	Content   string `json:"content"`
+}
+
+// HandleEdit handles the fabric_edit tool call.
+func (h *Handlers) HandleEdit(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args editArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.MessageID == "" {
+		return nil, fmt.Errorf("message_id is required")
+	}
+	if args.Content == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+
+	msg, err := h.service.EditMessage(args.MessageID, h.agentID, args.Content)
+	if err != nil {
+		return nil, fmt.Errorf("edit message: %w", err)
+	}
+
+	response := EditResponse{
+		ID:       msg.ID,
+		Mentions: msg.Mentions,
+		Edits:    len(msg.Edits),
+	}
+
+	return types.StructuredResult(
+		fmt.Sprintf("Edited message %s (revision %d)", msg.ID, response.Edits+1),
+		response,
+	), nil
+}
+
+// deleteArgs are arguments for fabric_delete.
+type deleteArgs struct {
+	MessageID string `json:"message_id"`
+}
+
+// HandleDelete handles the fabric_delete tool call.
+func (h *Handlers) HandleDelete(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args deleteArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.MessageID == "" {
+		return nil, fmt.Errorf("message_id is required")
+	}
+
+	msg, err := h.service.DeleteMessage(args.MessageID, h.agentID)
+	if err != nil {
+		return nil, fmt.Errorf("delete message: %w", err)
+	}
+
+	response := DeleteResponse{
+		ID:        msg.ID,
+		DeletedAt: *msg.DeletedAt,
+	}
+
+	return types.StructuredResult(fmt.Sprintf("Deleted message %s", msg.ID), response), nil
+}
+
+// listChannelsArgs are arguments for fabric_list_channels.
+type listChannelsArgs struct {
+	IncludeArchived bool `json:"include_archived,omitempty"`
+}
+
+// HandleListChannels handles the fabric_list_channels tool call.
+func (h *Handlers) HandleListChannels(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args listChannelsArgs
+	if len(rawArgs) > 0 {
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	channels, err := h.service.ListChannels(args.IncludeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("list channels: %w", err)
+	}
+
+	response := ListChannelsResponse{Channels: make([]ChannelSummary, 0, len(channels))}
+	lines := make([]string, 0, len(channels))
+	for _, info := range channels {
+		subscribers := info.Subscribers
+		if subscribers == nil {
+			subscribers = []string{}
+		}
+		response.Channels = append(response.Channels, ChannelSummary{
+			ID:           info.Channel.ID,
+			Slug:         info.Channel.Slug,
+			Title:        info.Channel.Title,
+			Purpose:      info.Channel.Purpose,
+			Fixed:        info.Fixed,
+			Archived:     info.Channel.IsArchived(),
+			MessageCount: info.MessageCount,
+			Subscribers:  subscribers,
+		})
+		line := fmt.Sprintf("#%s (%d messages)", info.Channel.Slug, info.MessageCount)
+		if info.Channel.IsArchived() {
+			line += " [archived]"
+		}
+		if info.Channel.Purpose != "" {
+			line += ": " + info.Channel.Purpose
+		}
+		lines = append(lines, line)
+	}
+
+	return types.StructuredResult(strings.Join(lines, "\n"), response), nil
+}
+
+// createChannelArgs are arguments for fabric_create_channel.
+type createChannelArgs struct {
+	Slug    string `json:"slug"`
+	Title   string `json:"title,omitempty"`
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// HandleCreateChannel handles the fabric_create_channel tool call.
+func (h *Handlers) HandleCreateChannel(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args createChannelArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.Slug == "" {
+		return nil, fmt.Errorf("slug is required")
+	}
+
+	channel, err := h.service.CreateChannel(fabric.CreateChannelInput{
+		Slug:      args.Slug,
+		Title:     args.Title,
+		Purpose:   args.Purpose,
+		CreatedBy: h.agentID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create channel: %w", err)
+	}
+
+	response := ChannelResponse{ID: channel.ID, Slug: channel.Slug}
+	return types.StructuredResult(fmt.Sprintf("Created #%s (id: %s)", channel.Slug, channel.ID), response), nil
+}
+
+// archiveChannelArgs are arguments for fabric_archive_channel.
+type archiveChannelArgs struct {
+	Channel string `json:"channel"`
+}
+
+// HandleArchiveChannel handles the fabric_archive_channel tool call.
+func (h *Handlers) HandleArchiveChannel(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args archiveChannelArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.Channel == "" {
+		return nil, fmt.Errorf("channel is required")
+	}
+
+	channel, err := h.service.ArchiveChannel(args.Channel, h.agentID)
+	if err != nil {
+		return nil, fmt.Errorf("archive channel: %w", err)
+	}
+
+	response := ChannelResponse{ID: channel.ID, Slug: channel.Slug}
+	return types.StructuredResult(fmt.Sprintf("Archived #%s", channel.Slug), response), nil
+}
+
+// graphArgs are arguments for fabric_graph.
+type graphArgs struct {
+	Root  string `json:"root,omitempty"`
+	Depth int    `json:"depth,omitempty"`
+}
+
+// HandleGraph handles the fabric_graph tool call.
+func (h *Handlers) HandleGraph(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args graphArgs
+	if len(rawArgs) > 0 {
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	graph, err := h.service.GetThreadGraph(args.Root, args.Depth)
+	if err != nil {
+		return nil, fmt.Errorf("get thread graph: %w", err)
+	}
+
+	response := GraphResponse{
+		RootID:    graph.RootID,
+		Nodes:     make([]GraphNodeInfo, 0, len(graph.Nodes)),
+		Edges:     make([]GraphEdgeInfo, 0, len(graph.Edges)),
+		Truncated: graph.Truncated,
+	}
+	for _, node := range graph.Nodes {
+		response.Nodes = append(response.Nodes, GraphNodeInfo{
+			ID:        node.ID,
+			Type:      string(node.Type),
+			Label:     node.Label,
+			CreatedBy: node.CreatedBy,
+			Depth:     node.Depth,
+			Archived:  node.Archived,
+			Deleted:   node.Deleted,
+			BlockedBy: node.BlockedBy,
+			Blocks:    node.Blocks,
+		})
+	}
+	for _, edge := range graph.Edges {
+		response.Edges = append(response.Edges, GraphEdgeInfo{From: edge.From, To: edge.To, Relation: string(edge.Relation)})
+	}
+
+	return types.StructuredResult(fabric.RenderGraphTree(graph), response), nil
+}
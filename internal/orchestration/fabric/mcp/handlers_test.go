@@ -3,8 +3,10 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
@@ -68,6 +70,175 @@ func TestHandlers_Inbox(t *testing.T) {
 	require.Len(t, response.Channels, 2)
 }
 
+func TestHandlers_Inbox_FilterBySender(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	_, err := svc.Subscribe(domain.SlugTasks, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+	_, err = svc.Subscribe(domain.SlugGeneral, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	_, err = svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Budget at 80% @COORDINATOR",
+		CreatedBy:   domain.AgentSystem,
+	})
+	require.NoError(t, err)
+	_, err = svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugGeneral,
+		Content:     "Hello team",
+		CreatedBy:   "WORKER.2",
+	})
+	require.NoError(t, err)
+
+	inbox := func(args string) InboxResponse {
+		t.Helper()
+		result, err := h.HandleInbox(context.Background(), json.RawMessage(args))
+		require.NoError(t, err)
+		var response InboxResponse
+		responseBytes, _ := json.Marshal(result.StructuredContent)
+		require.NoError(t, json.Unmarshal(responseBytes, &response))
+		return response
+	}
+
+	all := inbox(`{"from":"all"}`)
+	require.Equal(t, 2, all.TotalUnacked)
+	require.Len(t, all.Channels, 2)
+
+	agents := inbox(`{"from":"agents"}`)
+	require.Equal(t, 1, agents.TotalUnacked)
+	require.Len(t, agents.Channels, 1)
+	require.Equal(t, domain.SlugGeneral, agents.Channels[0].ChannelSlug)
+	require.False(t, agents.Channels[0].Messages[0].System)
+
+	system := inbox(`{"from":"system"}`)
+	require.Equal(t, 1, system.TotalUnacked)
+	require.Len(t, system.Channels, 1)
+	require.Equal(t, "Budget at 80% @COORDINATOR", system.Channels[0].Messages[0].Content)
+	require.True(t, system.Channels[0].Messages[0].System)
+
+	_, err = h.HandleInbox(context.Background(), json.RawMessage(`{"from":"bots"}`))
+	require.ErrorContains(t, err, "invalid from")
+}
+
+func TestHandlers_Inbox_Pagination(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	_, err := svc.Subscribe(domain.SlugTasks, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+	_, err = svc.Subscribe(domain.SlugGeneral, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	for i := range 3 {
+		_, err = svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugTasks, Content: fmt.Sprintf("task %d", i), CreatedBy: "WORKER.1"})
+		require.NoError(t, err)
+		_, err = svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugGeneral, Content: fmt.Sprintf("chat %d", i), CreatedBy: "WORKER.2"})
+		require.NoError(t, err)
+	}
+
+	inbox := func(args string) (InboxResponse, string) {
+		t.Helper()
+		result, err := h.HandleInbox(context.Background(), json.RawMessage(args))
+		require.NoError(t, err)
+		var response InboxResponse
+		responseBytes, _ := json.Marshal(result.StructuredContent)
+		require.NoError(t, json.Unmarshal(responseBytes, &response))
+		return response, result.Content[0].Text
+	}
+	contents := func(response InboxResponse) []string {
+		var out []string
+		for _, ch := range response.Channels {
+			for _, msg := range ch.Messages {
+				out = append(out, msg.Content)
+			}
+		}
+		return out
+	}
+
+	// The first page summarizes every channel but returns only the oldest messages
+	page, text := inbox(`{"limit":4}`)
+	require.Equal(t, 6, page.TotalUnacked)
+	require.Len(t, page.Channels, 2)
+	require.Equal(t, 3, page.Channels[0].Unacked)
+	require.Equal(t, 3, page.Channels[1].Unacked)
+	require.ElementsMatch(t, []string{"task 0", "chat 0", "task 1", "chat 1"}, contents(page))
+	require.NotEmpty(t, page.NextCursor)
+	require.Contains(t, text, "showing 4 (#tasks 3, #general 3)")
+	require.Contains(t, text, page.NextCursor)
+
+	// The next page continues after the cursor
+	page, _ = inbox(fmt.Sprintf(`{"limit":4,"cursor":%q}`, page.NextCursor))
+	require.ElementsMatch(t, []string{"task 2", "chat 2"}, contents(page))
+	require.Empty(t, page.NextCursor)
+
+	// Channel filter
+	page, _ = inbox(`{"channel":"general"}`)
+	require.Len(t, page.Channels, 1)
+	require.Equal(t, 3, page.TotalUnacked)
+	require.Equal(t, []string{"chat 0", "chat 1", "chat 2"}, contents(page))
+	require.Empty(t, page.NextCursor)
+
+	// Since filter
+	since := time.Now()
+	_, err = svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "task 3", CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+	page, _ = inbox(fmt.Sprintf(`{"since":%q}`, since.Format(time.RFC3339Nano)))
+	require.Equal(t, 1, page.TotalUnacked)
+	require.Equal(t, []string{"task 3"}, contents(page))
+
+	for args, wantErr := range map[string]string{
+		`{"channel":"missing"}`: "unknown channel",
+		`{"since":"yesterday"}`: "invalid since",
+		`{"limit":-1}`:          "limit must not be negative",
+		`{"cursor":"missing"}`:  "invalid cursor",
+	} {
+		_, err := h.HandleInbox(context.Background(), json.RawMessage(args))
+		require.ErrorContains(t, err, wantErr, args)
+	}
+}
+
+func TestHandlers_Inbox_OrdersByPriority(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	_, err := svc.Subscribe(domain.SlugTasks, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+	_, err = svc.Subscribe(domain.SlugGeneral, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	for _, in := range []fabric.SendMessageInput{
+		{ChannelSlug: domain.SlugTasks, Content: "fyi", Priority: domain.PriorityLow},
+		{ChannelSlug: domain.SlugTasks, Content: "status update"},
+		{ChannelSlug: domain.SlugGeneral, Content: "hello"},
+		{ChannelSlug: domain.SlugGeneral, Content: "prod is down", Priority: domain.PriorityUrgent},
+		{ChannelSlug: domain.SlugTasks, Content: "done"},
+	} {
+		in.CreatedBy = "WORKER.1"
+		_, err := svc.SendMessage(in)
+		require.NoError(t, err)
+	}
+
+	result, err := h.HandleInbox(context.Background(), nil)
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "(1 urgent)")
+
+	var response InboxResponse
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &response))
+
+	contents := func(ch ChannelInbox) []string {
+		var out []string
+		for _, m := range ch.Messages {
+			out = append(out, m.Content)
+		}
+		return out
+	}
+	require.Len(t, response.Channels, 2)
+	require.Equal(t, domain.SlugGeneral, response.Channels[0].ChannelSlug, "channel with the urgent message comes first")
+	require.Equal(t, []string{"prod is down", "hello"}, contents(response.Channels[0]))
+	require.Equal(t, "urgent", response.Channels[0].Messages[0].Priority)
+	require.Equal(t, []string{"status update", "done", "fyi"}, contents(response.Channels[1]))
+}
+
 func TestHandlers_Inbox_IncludesObserverChannel(t *testing.T) {
 	// Create observer-specific handlers
 	threadRepo := repository.NewMemoryThreadRepository()
@@ -155,6 +326,21 @@ func TestHandlers_Send_ValidationErrors(t *testing.T) {
 			args:    sendArgs{Channel: domain.SlugTasks},
 			wantErr: "content is required",
 		},
+		{
+			name:    "deliver_at and delay",
+			args:    sendArgs{Channel: domain.SlugTasks, Content: "hello", DeliverAt: "2030-01-01T00:00:00Z", Delay: "5m"},
+			wantErr: "cannot be combined",
+		},
+		{
+			name:    "invalid deliver_at",
+			args:    sendArgs{Channel: domain.SlugTasks, Content: "hello", DeliverAt: "tomorrow"},
+			wantErr: "must be RFC3339",
+		},
+		{
+			name:    "negative delay",
+			args:    sendArgs{Channel: domain.SlugTasks, Content: "hello", Delay: "-5m"},
+			wantErr: "delay must be positive",
+		},
 	}
 
 	for _, tt := range tests {
@@ -167,6 +353,43 @@ func TestHandlers_Send_ValidationErrors(t *testing.T) {
 	}
 }
 
+func TestHandlers_Send_Scheduled(t *testing.T) {
+	h, svc := newTestHandlers(t)
+	broker := fabric.NewBroker(fabric.BrokerConfig{Sender: svc})
+	svc.SetScheduler(broker)
+
+	argsJSON, _ := json.Marshal(sendArgs{Channel: domain.SlugGeneral, Content: "@COORDINATOR check worker-3", Delay: "20m"})
+	result, err := h.HandleSend(context.Background(), argsJSON)
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "Message scheduled for #general")
+
+	var response SendResponse
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &response))
+	require.NotEmpty(t, response.ID)
+	require.Zero(t, response.Seq)
+	require.WithinDuration(t, time.Now().Add(20*time.Minute), response.DeliverAt, time.Minute)
+
+	// The message is held, not posted
+	messages, err := svc.ListMessages(domain.SlugGeneral, 0)
+	require.NoError(t, err)
+	require.Empty(t, messages)
+	require.Len(t, broker.Scheduled(), 1)
+
+	// Only the sender can cancel it
+	cancelJSON, _ := json.Marshal(cancelScheduledArgs{ID: response.ID})
+	_, err = NewHandlers(svc, "WORKER.1").HandleCancelScheduled(context.Background(), cancelJSON)
+	require.ErrorContains(t, err, "was scheduled by COORDINATOR")
+
+	result, err = h.HandleCancelScheduled(context.Background(), cancelJSON)
+	require.NoError(t, err)
+	require.Equal(t, "Cancelled scheduled message "+response.ID+" for #general", result.Content[0].Text)
+	require.Empty(t, broker.Scheduled())
+
+	_, err = h.HandleCancelScheduled(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "id is required")
+}
+
 func TestHandlers_Reply(t *testing.T) {
 	h, svc := newTestHandlers(t)
 
@@ -361,6 +584,46 @@ func TestHandlers_History(t *testing.T) {
 	require.Len(t, response.Messages, 3)
 }
 
+func TestHandlers_History_CommitFilter(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	var roots []*domain.Thread
+	for _, content := range []string{"Task: Add login [perles-abc1]", "Task: Fix logout [perles-abc2]"} {
+		root, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugTasks, Content: content, CreatedBy: "COORDINATOR"})
+		require.NoError(t, err)
+		roots = append(roots, root)
+	}
+	_, err := svc.Reply(fabric.ReplyInput{
+		MessageID: roots[1].ID,
+		Content:   "Committed 3f2a9c1 Fix logout",
+		CreatedBy: "system",
+		Meta:      map[string]string{fabric.MetaCommitSHA: "3f2a9c1e8d7b"},
+	})
+	require.NoError(t, err)
+
+	history := func(args historyArgs) HistoryResponse {
+		argsJSON, _ := json.Marshal(args)
+		result, err := h.HandleHistory(context.Background(), argsJSON)
+		require.NoError(t, err)
+		var response HistoryResponse
+		responseBytes, _ := json.Marshal(result.StructuredContent)
+		require.NoError(t, json.Unmarshal(responseBytes, &response))
+		return response
+	}
+
+	response := history(historyArgs{Channel: domain.SlugTasks})
+	require.Len(t, response.Messages, 2)
+	require.Empty(t, response.Messages[0].Commits)
+	require.Equal(t, []string{"3f2a9c1e8d7b"}, response.Messages[1].Commits)
+
+	response = history(historyArgs{Channel: domain.SlugTasks, Commit: "3f2a9c1"})
+	require.Len(t, response.Messages, 1)
+	require.Equal(t, roots[1].ID, response.Messages[0].ID)
+	require.Equal(t, 1, response.TotalCount)
+
+	require.Empty(t, history(historyArgs{Channel: domain.SlugTasks, Commit: "deadbeef"}).Messages)
+}
+
 func TestHandlers_ReadThread(t *testing.T) {
 	h, svc := newTestHandlers(t)
 
@@ -408,3 +671,313 @@ func TestHandlers_ReadThread(t *testing.T) {
 	require.Contains(t, response.Participants, "COORDINATOR")
 	require.Contains(t, response.Participants, "WORKER.1")
 }
+
+func TestHandlers_ReadThreadUnreadOnly(t *testing.T) {
+	h, svc := newTestHandlers(t)
+	h.WithThreadCache(4)
+
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Task: Implement feature",
+		CreatedBy:   "COORDINATOR",
+	})
+	require.NoError(t, err)
+	_, err = svc.Reply(fabric.ReplyInput{MessageID: msg.ID, Content: "Starting...", CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+
+	read := func(unreadOnly bool) ReadThreadResponse {
+		argsJSON, _ := json.Marshal(readThreadArgs{MessageID: msg.ID, UnreadOnly: unreadOnly})
+		result, err := h.HandleReadThread(context.Background(), argsJSON)
+		require.NoError(t, err)
+		var response ReadThreadResponse
+		responseBytes, _ := json.Marshal(result.StructuredContent)
+		require.NoError(t, json.Unmarshal(responseBytes, &response))
+		return response
+	}
+
+	first := read(true)
+	require.Len(t, first.Replies, 1)
+	require.Equal(t, 1, first.NewReplies)
+
+	_, err = svc.Reply(fabric.ReplyInput{MessageID: msg.ID, Content: "Done!", CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+
+	second := read(true)
+	require.Len(t, second.Replies, 1)
+	require.Equal(t, "Done!", second.Replies[0].Content)
+	require.Equal(t, 1, second.NewReplies)
+
+	// A full read still returns every reply, none of them new
+	full := read(false)
+	require.Len(t, full.Replies, 2)
+	require.Zero(t, full.NewReplies)
+
+	stats := h.ThreadStats()
+	require.Equal(t, 1, stats.Misses)
+	require.Equal(t, 1, stats.Refreshes)
+	require.Equal(t, 1, stats.Hits)
+}
+
+func TestHandlers_ThreadParticipants(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Review needed @worker-1 @worker-2",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+	_, err = svc.Reply(fabric.ReplyInput{
+		MessageID: msg.ID,
+		Content:   "Looking now",
+		CreatedBy: "worker-1",
+	})
+	require.NoError(t, err)
+
+	argsJSON, _ := json.Marshal(threadParticipantsArgs{MessageID: msg.ID})
+	result, err := h.HandleThreadParticipants(context.Background(), argsJSON)
+	require.NoError(t, err)
+
+	var response domain.ThreadParticipants
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &response))
+	require.Equal(t, msg.ID, response.ThreadID)
+	require.Equal(t, []string{"coordinator", "worker-1"}, response.Posters)
+	require.Equal(t, []string{"worker-2"}, response.Pending)
+
+	_, err = h.HandleThreadParticipants(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "message_id is required")
+}
+
+func TestHandlers_Receipts(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	_, err := svc.Subscribe(domain.SlugTasks, "COORDINATOR", domain.ModeAll)
+	require.NoError(t, err)
+
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Done with perles-abc @COORDINATOR",
+		CreatedBy:   "WORKER.1",
+	})
+	require.NoError(t, err)
+	reply, err := svc.Reply(fabric.ReplyInput{
+		MessageID: msg.ID,
+		Content:   "Thanks @WORKER.1",
+		CreatedBy: "COORDINATOR",
+	})
+	require.NoError(t, err)
+	require.NoError(t, svc.MarkDelivered("WORKER.1", reply.ID))
+
+	// Reading the inbox marks the message as read by the coordinator
+	_, err = h.HandleInbox(context.Background(), nil)
+	require.NoError(t, err)
+
+	argsJSON, _ := json.Marshal(receiptsArgs{MessageID: reply.ID})
+	result, err := h.HandleReceipts(context.Background(), argsJSON)
+	require.NoError(t, err)
+	require.Equal(t, "2 receipts: 1 read, 1 delivered but unread", result.Content[0].Text)
+
+	var response domain.ThreadReceipts
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &response))
+	require.Equal(t, msg.ID, response.ThreadID)
+	require.Len(t, response.Receipts, 2)
+	require.Equal(t, "COORDINATOR", response.Receipts[0].AgentID)
+	require.True(t, response.Receipts[0].IsRead())
+	require.Equal(t, "WORKER.1", response.Receipts[1].AgentID)
+	require.False(t, response.Receipts[1].IsRead())
+
+	// The worker reads the thread
+	workerHandlers := NewHandlers(svc, "WORKER.1")
+	threadJSON, _ := json.Marshal(readThreadArgs{MessageID: msg.ID})
+	_, err = workerHandlers.HandleReadThread(context.Background(), threadJSON)
+	require.NoError(t, err)
+
+	result, err = h.HandleReceipts(context.Background(), argsJSON)
+	require.NoError(t, err)
+	require.Equal(t, "2 receipts: 2 read, 0 delivered but unread", result.Content[0].Text)
+
+	_, err = h.HandleReceipts(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "message_id is required")
+}
+
+func TestHandlers_Edit(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Start perles-abc @worker-1",
+		CreatedBy:   "COORDINATOR",
+	})
+	require.NoError(t, err)
+
+	argsJSON, _ := json.Marshal(editArgs{MessageID: msg.ID, Content: "Start perles-abd @worker-1"})
+	result, err := h.HandleEdit(context.Background(), argsJSON)
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "revision 2")
+
+	var response EditResponse
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &response))
+	require.Equal(t, msg.ID, response.ID)
+	require.Equal(t, []string{"worker-1"}, response.Mentions)
+	require.Equal(t, 1, response.Edits)
+
+	// History marks the message as edited
+	historyJSON, _ := json.Marshal(historyArgs{Channel: domain.SlugTasks})
+	result, err = h.HandleHistory(context.Background(), historyJSON)
+	require.NoError(t, err)
+	var history HistoryResponse
+	responseBytes, _ = json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &history))
+	require.Len(t, history.Messages, 1)
+	require.Equal(t, "Start perles-abd @worker-1", history.Messages[0].Content)
+	require.True(t, history.Messages[0].Edited)
+
+	// Only the author can edit
+	other, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "mine", CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+	argsJSON, _ = json.Marshal(editArgs{MessageID: other.ID, Content: "yours"})
+	_, err = h.HandleEdit(context.Background(), argsJSON)
+	require.ErrorContains(t, err, "only the author can modify it")
+
+	_, err = h.HandleEdit(context.Background(), json.RawMessage(`{"message_id":"x"}`))
+	require.EqualError(t, err, "content is required")
+	_, err = h.HandleEdit(context.Background(), json.RawMessage(`{"content":"x"}`))
+	require.EqualError(t, err, "message_id is required")
+}
+
+func TestHandlers_Delete(t *testing.T) {
+	h, svc := newTestHandlers(t)
+
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Task 1",
+		CreatedBy:   "WORKER.1",
+	})
+	require.NoError(t, err)
+	reply, err := svc.Reply(fabric.ReplyInput{
+		MessageID: msg.ID,
+		Content:   "Wrong instructions",
+		CreatedBy: "COORDINATOR",
+	})
+	require.NoError(t, err)
+
+	argsJSON, _ := json.Marshal(deleteArgs{MessageID: reply.ID})
+	result, err := h.HandleDelete(context.Background(), argsJSON)
+	require.NoError(t, err)
+
+	var response DeleteResponse
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &response))
+	require.Equal(t, reply.ID, response.ID)
+	require.False(t, response.DeletedAt.IsZero())
+
+	// The tombstone stays in the thread
+	readJSON, _ := json.Marshal(readThreadArgs{MessageID: msg.ID})
+	result, err = h.HandleReadThread(context.Background(), readJSON)
+	require.NoError(t, err)
+	var thread ReadThreadResponse
+	responseBytes, _ = json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &thread))
+	require.Len(t, thread.Replies, 1)
+	require.True(t, thread.Replies[0].Deleted)
+	require.Equal(t, domain.DeletedContent, thread.Replies[0].Content)
+
+	// Only the author can delete
+	argsJSON, _ = json.Marshal(deleteArgs{MessageID: msg.ID})
+	_, err = h.HandleDelete(context.Background(), argsJSON)
+	require.ErrorContains(t, err, "only the author can modify it")
+
+	_, err = h.HandleDelete(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "message_id is required")
+}
+
+func TestHandlers_ChannelAdministration(t *testing.T) {
+	h, svc := newTestHandlers(t)
+	ctx := context.Background()
+
+	args, _ := json.Marshal(map[string]any{"slug": "epic-auth", "purpose": "Auth epic"})
+	result, err := h.HandleCreateChannel(ctx, args)
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "#epic-auth")
+
+	// The new channel works with the existing tools and shows up in the inbox
+	_, err = svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: "epic-auth",
+		Content:     "Starting on login",
+		CreatedBy:   "WORKER.1",
+	})
+	require.NoError(t, err)
+
+	result, err = h.HandleInbox(ctx, nil)
+	require.NoError(t, err)
+	var inbox InboxResponse
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &inbox))
+	require.Len(t, inbox.Channels, 1)
+	require.Equal(t, "epic-auth", inbox.Channels[0].ChannelSlug)
+
+	result, err = h.HandleListChannels(ctx, nil)
+	require.NoError(t, err)
+	var list ListChannelsResponse
+	responseBytes, _ = json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &list))
+	last := list.Channels[len(list.Channels)-1]
+	require.Equal(t, "epic-auth", last.Slug)
+	require.False(t, last.Fixed)
+	require.Equal(t, 1, last.MessageCount)
+	require.Equal(t, []string{"COORDINATOR", "observer"}, last.Subscribers)
+
+	// Session channels cannot be archived
+	args, _ = json.Marshal(map[string]string{"channel": "tasks"})
+	_, err = h.HandleArchiveChannel(ctx, args)
+	require.ErrorContains(t, err, "cannot be archived")
+
+	args, _ = json.Marshal(map[string]string{"channel": "epic-auth"})
+	_, err = h.HandleArchiveChannel(ctx, args)
+	require.NoError(t, err)
+
+	result, err = h.HandleListChannels(ctx, nil)
+	require.NoError(t, err)
+	responseBytes, _ = json.Marshal(result.StructuredContent)
+	list = ListChannelsResponse{}
+	require.NoError(t, json.Unmarshal(responseBytes, &list))
+	for _, ch := range list.Channels {
+		require.NotEqual(t, "epic-auth", ch.Slug)
+	}
+}
+
+func TestHandlers_Graph(t *testing.T) {
+	h, svc := newTestHandlers(t)
+	ctx := context.Background()
+
+	msg, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugPlanning,
+		Content:     "Design review",
+		CreatedBy:   "COORDINATOR",
+	})
+	require.NoError(t, err)
+	reply, err := svc.Reply(fabric.ReplyInput{MessageID: msg.ID, Content: "Looks good", CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+
+	args, _ := json.Marshal(map[string]any{"root": "planning"})
+	result, err := h.HandleGraph(ctx, args)
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "[channel] #planning")
+	require.Contains(t, result.Content[0].Text, "reply_to: [message] Looks good")
+
+	var graph GraphResponse
+	responseBytes, _ := json.Marshal(result.StructuredContent)
+	require.NoError(t, json.Unmarshal(responseBytes, &graph))
+	require.Equal(t, svc.GetChannelID(domain.SlugPlanning), graph.RootID)
+	require.Len(t, graph.Nodes, 3)
+	require.Equal(t, []string{reply.ID}, graph.Nodes[1].Blocks)
+	require.Equal(t, []string{msg.ID}, graph.Nodes[2].BlockedBy)
+	require.Contains(t, graph.Edges, GraphEdgeInfo{From: reply.ID, To: msg.ID, Relation: "reply_to"})
+
+	args, _ = json.Marshal(map[string]any{"root": "#nope"})
+	_, err = h.HandleGraph(ctx, args)
+	require.ErrorContains(t, err, "unknown channel")
+}
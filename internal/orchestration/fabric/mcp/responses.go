@@ -13,6 +13,7 @@ type JoinResponse struct {
 type InboxResponse struct {
 	Channels     []ChannelInbox `json:"channels"`
 	TotalUnacked int            `json:"total_unacked"`
+	NextCursor   string         `json:"next_cursor,omitempty"` // set if more messages remain
 }
 
 // ChannelInbox contains unread messages for a single channel.
@@ -30,14 +31,18 @@ type InboxMessage struct {
 	CreatedBy string    `json:"created_by"`
 	CreatedAt time.Time `json:"created_at"`
 	Mentions  []string  `json:"mentions,omitempty"`
+	Priority  string    `json:"priority,omitempty"`
+	System    bool      `json:"system,omitempty"`
+	Edited    bool      `json:"edited,omitempty"`
 }
 
 // SendResponse is the response for fabric_send.
 type SendResponse struct {
-	ID        string   `json:"id"`
-	Seq       int64    `json:"seq"`
-	ChannelID string   `json:"channel_id"`
-	Mentions  []string `json:"mentions,omitempty"`
+	ID        string    `json:"id"`
+	Seq       int64     `json:"seq"`
+	ChannelID string    `json:"channel_id"`
+	Mentions  []string  `json:"mentions,omitempty"`
+	DeliverAt time.Time `json:"deliver_at,omitzero"` // set if the message was scheduled
 }
 
 // ReplyResponse is the response for fabric_reply.
@@ -93,6 +98,9 @@ type HistoryMessage struct {
 	IsAcked     bool      `json:"is_acked"`
 	Mentions    []string  `json:"mentions,omitempty"`
 	HasArtifact bool      `json:"has_artifact"`
+	Commits     []string  `json:"commits,omitempty"` // Git commits linked to the thread
+	Edited      bool      `json:"edited,omitempty"`
+	Deleted     bool      `json:"deleted,omitempty"`
 }
 
 // ReadThreadResponse is the response for fabric_read_thread.
@@ -101,6 +109,8 @@ type ReadThreadResponse struct {
 	Replies      []ThreadMessage  `json:"replies"`
 	Artifacts    []ThreadArtifact `json:"artifacts,omitempty"`
 	Participants []string         `json:"participants"`
+	// NewReplies counts replies posted since the agent's previous read of the thread.
+	NewReplies int `json:"new_replies"`
 }
 
 // ThreadMessage is a message in a thread.
@@ -112,6 +122,8 @@ type ThreadMessage struct {
 	CreatedBy string    `json:"created_by"`
 	CreatedAt time.Time `json:"created_at"`
 	Mentions  []string  `json:"mentions,omitempty"`
+	Edited    bool      `json:"edited,omitempty"`
+	Deleted   bool      `json:"deleted,omitempty"`
 }
 
 // ThreadArtifact is an artifact attached to a thread.
@@ -140,3 +152,67 @@ type ReactionSummary struct {
 	Count    int      `json:"count"`
 	AgentIDs []string `json:"agent_ids"`
 }
+
+// EditResponse is the response for fabric_edit.
+type EditResponse struct {
+	ID       string   `json:"id"`
+	Mentions []string `json:"mentions,omitempty"`
+	Edits    int      `json:"edits"` // Number of previous revisions kept
+}
+
+// DeleteResponse is the response for fabric_delete.
+type DeleteResponse struct {
+	ID        string    `json:"id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// ListChannelsResponse is the response for fabric_list_channels.
+type ListChannelsResponse struct {
+	Channels []ChannelSummary `json:"channels"`
+}
+
+// ChannelSummary describes a channel in fabric_list_channels.
+type ChannelSummary struct {
+	ID           string   `json:"id"`
+	Slug         string   `json:"slug"`
+	Title        string   `json:"title,omitempty"`
+	Purpose      string   `json:"purpose,omitempty"`
+	Fixed        bool     `json:"fixed"`
+	Archived     bool     `json:"archived,omitempty"`
+	MessageCount int      `json:"message_count"`
+	Subscribers  []string `json:"subscribers"`
+}
+
+// ChannelResponse is the response for fabric_create_channel and fabric_archive_channel.
+type ChannelResponse struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// GraphResponse is the response for fabric_graph.
+type GraphResponse struct {
+	RootID    string          `json:"root_id"`
+	Nodes     []GraphNodeInfo `json:"nodes"`
+	Edges     []GraphEdgeInfo `json:"edges"`
+	Truncated bool            `json:"truncated,omitempty"`
+}
+
+// GraphNodeInfo describes a thread in fabric_graph.
+type GraphNodeInfo struct {
+	ID        string   `json:"id"`
+	Type      string   `json:"type"`
+	Label     string   `json:"label"`
+	CreatedBy string   `json:"created_by,omitempty"`
+	Depth     int      `json:"depth"`
+	Archived  bool     `json:"archived,omitempty"`
+	Deleted   bool     `json:"deleted,omitempty"`
+	BlockedBy []string `json:"blocked_by,omitempty"`
+	Blocks    []string `json:"blocks,omitempty"`
+}
+
+// GraphEdgeInfo describes a dependency in fabric_graph.
+type GraphEdgeInfo struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Relation string `json:"relation"`
+}
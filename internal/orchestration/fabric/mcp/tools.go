@@ -43,6 +43,7 @@ func FabricTools() []Tool {
 		ToolFabricJoin,
 		ToolFabricInbox,
 		ToolFabricSend,
+		ToolFabricCancelScheduled,
 		ToolFabricReply,
 		ToolFabricAck,
 		ToolFabricSubscribe,
@@ -50,7 +51,22 @@ func FabricTools() []Tool {
 		ToolFabricAttach,
 		ToolFabricHistory,
 		ToolFabricReadThread,
+		ToolFabricThreadParticipants,
+		ToolFabricReceipts,
 		ToolFabricReact,
+		ToolFabricEdit,
+		ToolFabricDelete,
+		ToolFabricListChannels,
+		ToolFabricGraph,
+	}
+}
+
+// FabricChannelAdminTools returns the MCP tool definitions for creating and
+// archiving channels at runtime. Only the coordinator gets these tools.
+func FabricChannelAdminTools() []Tool {
+	return []Tool{
+		ToolFabricCreateChannel,
+		ToolFabricArchiveChannel,
 	}
 }
 
@@ -77,18 +93,40 @@ var ToolFabricJoin = Tool{
 // ToolFabricInbox gets unacked messages for the current agent grouped by channel.
 var ToolFabricInbox = Tool{
 	Name:        "fabric_inbox",
-	Description: "Get unread messages for the current agent. Returns messages grouped by channel with unacked counts. Use this to check what needs your attention.",
+	Description: "Get unread messages for the current agent. Returns messages grouped by channel with unacked counts, urgent messages first (by priority, then time). Large inboxes are paged: every channel is listed with its unread count, but only the first page of messages is returned; pass next_cursor back as cursor for the next page. Use this to check what needs your attention.",
 	InputSchema: &InputSchema{
-		Type:       "object",
-		Properties: map[string]*PropertySchema{},
-		Required:   []string{},
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"from": {
+				Type:        "string",
+				Description: "Filter by sender: 'all' (default), 'agents' (peer messages only) or 'system' (automated messages only: guardrails, budget warnings, schedulers)",
+				Enum:        []string{"all", "agents", "system"},
+			},
+			"channel": {
+				Type:        "string",
+				Description: "Only return unread messages from this channel slug (e.g., 'tasks')",
+			},
+			"since": {
+				Type:        "string",
+				Description: "Only return messages posted at or after this time (RFC3339, e.g. '2025-01-15T14:30:00Z')",
+			},
+			"limit": {
+				Type:        "number",
+				Description: "Maximum messages to return (default: 20, max: 100)",
+			},
+			"cursor": {
+				Type:        "string",
+				Description: "next_cursor from the previous call, to get the next page",
+			},
+		},
+		Required: []string{},
 	},
 	OutputSchema: &OutputSchema{
 		Type: "object",
 		Properties: map[string]*PropertySchema{
 			"channels": {
 				Type:        "array",
-				Description: "Channels with unread messages",
+				Description: "Channels with unread messages, ordered by their most urgent message",
 				Items: &PropertySchema{
 					Type: "object",
 					Properties: map[string]*PropertySchema{
@@ -97,7 +135,7 @@ var ToolFabricInbox = Tool{
 						"unacked":      {Type: "number", Description: "Number of unread messages"},
 						"messages": {
 							Type:        "array",
-							Description: "Unread messages in this channel",
+							Description: "Unread messages in this channel on this page, ordered by priority then time",
 							Items: &PropertySchema{
 								Type: "object",
 								Properties: map[string]*PropertySchema{
@@ -106,6 +144,9 @@ var ToolFabricInbox = Tool{
 									"created_by": {Type: "string", Description: "Sender ID"},
 									"created_at": {Type: "string", Description: "Timestamp"},
 									"mentions":   {Type: "array", Description: "Mentioned agent IDs"},
+									"priority":   {Type: "string", Description: "Message priority if not normal ('urgent' or 'low')"},
+									"system":     {Type: "boolean", Description: "True for automated system messages, which never expect a reply"},
+									"edited":     {Type: "boolean", Description: "True if the author edited the message"},
 								},
 							},
 						},
@@ -113,6 +154,7 @@ var ToolFabricInbox = Tool{
 				},
 			},
 			"total_unacked": {Type: "number", Description: "Total unread messages across all channels"},
+			"next_cursor":   {Type: "string", Description: "Cursor for the next page, absent on the last page"},
 		},
 		Required: []string{"channels", "total_unacked"},
 	},
@@ -127,8 +169,7 @@ var ToolFabricSend = Tool{
 		Properties: map[string]*PropertySchema{
 			"channel": {
 				Type:        "string",
-				Description: "Channel slug: 'tasks', 'planning', 'general', 'system', or 'observer'",
-				Enum:        []string{"tasks", "planning", "general", "system", "observer"},
+				Description: "Channel slug: 'tasks', 'planning', 'general', 'system', 'observer', 'memory' (project memory, when enabled), or a channel created at runtime (see fabric_list_channels)",
 			},
 			"content": {
 				Type:        "string",
@@ -139,21 +180,65 @@ var ToolFabricSend = Tool{
 				Description: "Message kind: 'info' (default), 'request', 'response', 'completion', 'error'",
 				Enum:        []string{"info", "request", "response", "completion", "error"},
 			},
+			"priority": {
+				Type:        "string",
+				Description: "Message priority: 'normal' (default), 'urgent' (notifies recipients immediately and is listed first in their inbox), 'low'",
+				Enum:        []string{"urgent", "normal", "low"},
+			},
+			"force": {
+				Type:        "boolean",
+				Description: "Send even if identical content was just sent to the same place. Only for intentional resends; duplicates are otherwise suppressed (default: false)",
+			},
+			"deliver_at": {
+				Type:        "string",
+				Description: "Hold the message and post it at this time (RFC3339, e.g. '2025-01-15T14:30:00Z'). The response ID is then a scheduled ID for fabric_cancel_scheduled",
+			},
+			"delay": {
+				Type:        "string",
+				Description: "Hold the message and post it after this delay (e.g. '20m', '1h30m'), e.g. a reminder to check on a long-running worker. Cannot be combined with deliver_at",
+			},
 		},
 		Required: []string{"channel", "content"},
 	},
 	OutputSchema: &OutputSchema{
 		Type: "object",
 		Properties: map[string]*PropertySchema{
-			"id":         {Type: "string", Description: "Created message ID"},
-			"seq":        {Type: "number", Description: "Message sequence number"},
+			"id":         {Type: "string", Description: "Created message ID, or the scheduled ID if the message was scheduled"},
+			"seq":        {Type: "number", Description: "Message sequence number (0 if scheduled)"},
 			"channel_id": {Type: "string", Description: "Channel ID"},
 			"mentions":   {Type: "array", Description: "Extracted @mentions"},
+			"deliver_at": {Type: "string", Description: "When a scheduled message will be posted (RFC3339)"},
 		},
 		Required: []string{"id", "seq", "channel_id"},
 	},
 }
 
+// ToolFabricCancelScheduled drops a scheduled message before it is posted.
+var ToolFabricCancelScheduled = Tool{
+	Name:        "fabric_cancel_scheduled",
+	Description: "Cancel a message scheduled with fabric_send (deliver_at or delay) before it is posted. Only the sender can cancel it.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"id": {
+				Type:        "string",
+				Description: "Scheduled ID returned by fabric_send",
+			},
+		},
+		Required: []string{"id"},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"id":           {Type: "string", Description: "Scheduled ID"},
+			"channel_slug": {Type: "string", Description: "Channel the message would have been posted to"},
+			"content":      {Type: "string", Description: "Message content"},
+			"deliver_at":   {Type: "string", Description: "When the message would have been posted (RFC3339)"},
+		},
+		Required: []string{"id", "channel_slug", "deliver_at"},
+	},
+}
+
 // ToolFabricReply posts a reply to an existing message thread.
 var ToolFabricReply = Tool{
 	Name:        "fabric_reply",
@@ -174,6 +259,15 @@ var ToolFabricReply = Tool{
 				Description: "Message kind: 'response' (default), 'info', 'completion', 'error'",
 				Enum:        []string{"info", "request", "response", "completion", "error"},
 			},
+			"priority": {
+				Type:        "string",
+				Description: "Message priority: 'normal' (default), 'urgent' (notifies recipients immediately and is listed first in their inbox), 'low'",
+				Enum:        []string{"urgent", "normal", "low"},
+			},
+			"force": {
+				Type:        "boolean",
+				Description: "Send even if identical content was just sent to the same place. Only for intentional resends; duplicates are otherwise suppressed (default: false)",
+			},
 		},
 		Required: []string{"message_id", "content"},
 	},
@@ -224,8 +318,7 @@ var ToolFabricSubscribe = Tool{
 		Properties: map[string]*PropertySchema{
 			"channel": {
 				Type:        "string",
-				Description: "Channel slug to subscribe to",
-				Enum:        []string{"tasks", "planning", "general", "system", "observer"},
+				Description: "Channel slug to subscribe to (see fabric_list_channels)",
 			},
 			"mode": {
 				Type:        "string",
@@ -254,8 +347,7 @@ var ToolFabricUnsubscribe = Tool{
 		Properties: map[string]*PropertySchema{
 			"channel": {
 				Type:        "string",
-				Description: "Channel slug to unsubscribe from",
-				Enum:        []string{"tasks", "planning", "general", "system", "observer"},
+				Description: "Channel slug to unsubscribe from (see fabric_list_channels)",
 			},
 		},
 		Required: []string{"channel"},
@@ -305,14 +397,13 @@ var ToolFabricAttach = Tool{
 // ToolFabricHistory gets message history for a channel.
 var ToolFabricHistory = Tool{
 	Name:        "fabric_history",
-	Description: "Get message history for a channel. Returns messages in chronological order.",
+	Description: "Get message history for a channel. Returns messages in chronological order. Threads linked to git commits list them; pass commit to find the thread of a commit.",
 	InputSchema: &InputSchema{
 		Type: "object",
 		Properties: map[string]*PropertySchema{
 			"channel": {
 				Type:        "string",
-				Description: "Channel slug to get history for",
-				Enum:        []string{"tasks", "planning", "general", "system", "observer"},
+				Description: "Channel slug to get history for (see fabric_list_channels)",
 			},
 			"limit": {
 				Type:        "number",
@@ -322,6 +413,10 @@ var ToolFabricHistory = Tool{
 				Type:        "boolean",
 				Description: "Include messages already acknowledged (default: true)",
 			},
+			"commit": {
+				Type:        "string",
+				Description: "Only return threads linked to this git commit SHA (full or abbreviated)",
+			},
 		},
 		Required: []string{"channel"},
 	},
@@ -346,6 +441,9 @@ var ToolFabricHistory = Tool{
 						"is_acked":     {Type: "boolean", Description: "Whether message is acked by caller"},
 						"mentions":     {Type: "array", Description: "Mentioned agent IDs"},
 						"has_artifact": {Type: "boolean", Description: "Whether message has artifacts"},
+						"commits":      {Type: "array", Description: "Git commit SHAs linked to the thread"},
+						"edited":       {Type: "boolean", Description: "True if the author edited the message"},
+						"deleted":      {Type: "boolean", Description: "True if the author deleted the message"},
 					},
 				},
 			},
@@ -370,6 +468,10 @@ var ToolFabricReadThread = Tool{
 				Type:        "boolean",
 				Description: "Include artifact metadata (default: true)",
 			},
+			"unread_only": {
+				Type:        "boolean",
+				Description: "Only return replies posted since you last read this thread (default: false)",
+			},
 		},
 		Required: []string{"message_id"},
 	},
@@ -386,6 +488,8 @@ var ToolFabricReadThread = Tool{
 					"created_by": {Type: "string"},
 					"created_at": {Type: "string"},
 					"mentions":   {Type: "array"},
+					"edited":     {Type: "boolean"},
+					"deleted":    {Type: "boolean"},
 				},
 			},
 			"replies": {
@@ -400,11 +504,78 @@ var ToolFabricReadThread = Tool{
 				Type:        "array",
 				Description: "Unique agent IDs who participated in the thread",
 			},
+			"new_replies": {
+				Type:        "number",
+				Description: "Replies posted since your previous read of the thread",
+			},
 		},
 		Required: []string{"message", "replies"},
 	},
 }
 
+// ToolFabricThreadParticipants summarizes who is involved in a message thread.
+var ToolFabricThreadParticipants = Tool{
+	Name:        "fabric_thread_participants",
+	Description: "Summarize who has posted in, been mentioned in, and read (acked or reacted to) a message thread. Use 'pending' to decide whom to nudge.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"message_id": {
+				Type:        "string",
+				Description: "ID of the root message or any reply in the thread",
+			},
+		},
+		Required: []string{"message_id"},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"thread_id": {Type: "string", Description: "Root message ID of the thread"},
+			"posters":   {Type: "array", Description: "Agents who posted in the thread", Items: &PropertySchema{Type: "string"}},
+			"mentioned": {Type: "array", Description: "Agents mentioned in the thread", Items: &PropertySchema{Type: "string"}},
+			"acked":     {Type: "array", Description: "Agents who acked or reacted to a message in the thread", Items: &PropertySchema{Type: "string"}},
+			"pending":   {Type: "array", Description: "Mentioned agents who have neither posted nor acked", Items: &PropertySchema{Type: "string"}},
+		},
+		Required: []string{"thread_id", "posters", "mentioned", "acked", "pending"},
+	},
+}
+
+// ToolFabricReceipts lists who a thread's messages were delivered to and who read them.
+var ToolFabricReceipts = Tool{
+	Name:        "fabric_receipts",
+	Description: "List delivery and read receipts for a message thread. A message is delivered when its recipient is notified of it and read when fabric_inbox or fabric_read_thread returns it to them. Use to check whether a worker actually read a task thread.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"message_id": {
+				Type:        "string",
+				Description: "ID of the root message or any reply in the thread",
+			},
+		},
+		Required: []string{"message_id"},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"thread_id": {Type: "string", Description: "Root message ID of the thread"},
+			"receipts": {
+				Type:        "array",
+				Description: "Receipts by message (root first, then replies) and recipient",
+				Items: &PropertySchema{
+					Type: "object",
+					Properties: map[string]*PropertySchema{
+						"message_id":   {Type: "string", Description: "Message ID"},
+						"agent_id":     {Type: "string", Description: "Recipient"},
+						"delivered_at": {Type: "string", Description: "When the recipient was notified (RFC3339)"},
+						"read_at":      {Type: "string", Description: "When the recipient read the message (RFC3339), absent if unread"},
+					},
+				},
+			},
+		},
+		Required: []string{"thread_id", "receipts"},
+	},
+}
+
 // ToolFabricReact adds or removes an emoji reaction to a message.
 var ToolFabricReact = Tool{
 	Name:        "fabric_react",
@@ -451,3 +622,207 @@ var ToolFabricReact = Tool{
 		Required: []string{"success", "message_id", "emoji", "action"},
 	},
 }
+
+// ToolFabricEdit corrects the content of a message the agent posted.
+var ToolFabricEdit = Tool{
+	Name:        "fabric_edit",
+	Description: "Correct a message or reply you posted (e.g. a wrong task ID) instead of posting a follow-up correction. Only the author can edit; previous content is kept in the edit history and @mentions are re-parsed.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"message_id": {
+				Type:        "string",
+				Description: "ID of the message or reply to edit",
+			},
+			"content": {
+				Type:        "string",
+				Description: "New message content (replaces the old content)",
+			},
+		},
+		Required: []string{"message_id", "content"},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"id":       {Type: "string", Description: "The edited message ID"},
+			"mentions": {Type: "array", Description: "Agents mentioned in the new content", Items: &PropertySchema{Type: "string"}},
+			"edits":    {Type: "number", Description: "Number of previous revisions kept"},
+		},
+		Required: []string{"id", "edits"},
+	},
+}
+
+// ToolFabricDelete deletes a message the agent posted, leaving a tombstone.
+var ToolFabricDelete = Tool{
+	Name:        "fabric_delete",
+	Description: "Delete a message or reply you posted. Only the author can delete. The message is replaced by a tombstone so replies stay in place, and it is removed from inboxes.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"message_id": {
+				Type:        "string",
+				Description: "ID of the message or reply to delete",
+			},
+		},
+		Required: []string{"message_id"},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"id":         {Type: "string", Description: "The deleted message ID"},
+			"deleted_at": {Type: "string", Description: "When the message was deleted"},
+		},
+		Required: []string{"id", "deleted_at"},
+	},
+}
+
+// ToolFabricListChannels lists the session's channels.
+var ToolFabricListChannels = Tool{
+	Name:        "fabric_list_channels",
+	Description: "List the session's channels with their purpose, message count and subscribers. Includes channels created at runtime with fabric_create_channel.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"include_archived": {
+				Type:        "boolean",
+				Description: "Include archived channels (default: false)",
+			},
+		},
+		Required: []string{},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"channels": {
+				Type:        "array",
+				Description: "Channels in creation order",
+				Items: &PropertySchema{
+					Type: "object",
+					Properties: map[string]*PropertySchema{
+						"id":            {Type: "string", Description: "Channel ID"},
+						"slug":          {Type: "string", Description: "Channel slug"},
+						"title":         {Type: "string", Description: "Channel title"},
+						"purpose":       {Type: "string", Description: "Channel purpose"},
+						"fixed":         {Type: "boolean", Description: "Whether this is a session channel that cannot be archived"},
+						"archived":      {Type: "boolean", Description: "Whether the channel is archived"},
+						"message_count": {Type: "number", Description: "Number of top-level messages"},
+						"subscribers":   {Type: "array", Description: "Subscribed agent IDs", Items: &PropertySchema{Type: "string"}},
+					},
+				},
+			},
+		},
+		Required: []string{"channels"},
+	},
+}
+
+// ToolFabricCreateChannel creates a channel at runtime.
+var ToolFabricCreateChannel = Tool{
+	Name:        "fabric_create_channel",
+	Description: "Create a channel to organize work, e.g. one per epic or topic. You and the observer are subscribed to it; subscribe workers by asking them to call fabric_subscribe, or @mention them in the channel.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"slug": {
+				Type:        "string",
+				Description: "Channel slug: lowercase letters, digits and dashes, up to 40 characters (e.g., 'epic-auth')",
+			},
+			"title": {
+				Type:        "string",
+				Description: "Human-readable title (defaults to the slug)",
+			},
+			"purpose": {
+				Type:        "string",
+				Description: "What the channel is for",
+			},
+		},
+		Required: []string{"slug"},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"id":   {Type: "string", Description: "Created channel ID"},
+			"slug": {Type: "string", Description: "Channel slug"},
+		},
+		Required: []string{"id", "slug"},
+	},
+}
+
+// ToolFabricArchiveChannel archives a channel created at runtime.
+var ToolFabricArchiveChannel = Tool{
+	Name:        "fabric_archive_channel",
+	Description: "Archive a channel created with fabric_create_channel once its work is done. Subscriptions are removed and no new messages can be posted; existing threads stay readable by ID. Session channels cannot be archived.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"channel": {
+				Type:        "string",
+				Description: "Slug of the channel to archive",
+			},
+		},
+		Required: []string{"channel"},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"id":   {Type: "string", Description: "Archived channel ID"},
+			"slug": {Type: "string", Description: "Channel slug"},
+		},
+		Required: []string{"id", "slug"},
+	},
+}
+
+// ToolFabricGraph returns the dependency graph below a channel or thread.
+var ToolFabricGraph = Tool{
+	Name:        "fabric_graph",
+	Description: "Inspect the thread dependency graph below a channel or thread: its messages, replies and artifacts, and which threads each one blocks or is blocked by. Returns nodes and edges plus an indented tree.",
+	InputSchema: &InputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"root": {
+				Type:        "string",
+				Description: "Channel slug or thread ID to start from (default: root, i.e. all channels)",
+			},
+			"depth": {
+				Type:        "number",
+				Description: "Maximum number of levels below the root (default: 3)",
+			},
+		},
+		Required: []string{},
+	},
+	OutputSchema: &OutputSchema{
+		Type: "object",
+		Properties: map[string]*PropertySchema{
+			"root_id": {Type: "string", Description: "ID of the thread the graph starts from"},
+			"nodes": {
+				Type:        "array",
+				Description: "Threads in breadth-first order",
+				Items: &PropertySchema{
+					Type: "object",
+					Properties: map[string]*PropertySchema{
+						"id":         {Type: "string", Description: "Thread ID"},
+						"type":       {Type: "string", Description: "Thread type (channel, message, artifact)"},
+						"label":      {Type: "string", Description: "Channel slug, first line of the message, or artifact name"},
+						"created_by": {Type: "string", Description: "Agent that created the thread"},
+						"depth":      {Type: "number", Description: "Distance from the root"},
+						"blocked_by": {Type: "array", Description: "IDs of threads this thread depends on", Items: &PropertySchema{Type: "string"}},
+						"blocks":     {Type: "array", Description: "IDs of threads that depend on this thread", Items: &PropertySchema{Type: "string"}},
+					},
+				},
+			},
+			"edges": {
+				Type:        "array",
+				Description: "Dependencies between nodes: from depends on to",
+				Items: &PropertySchema{
+					Type: "object",
+					Properties: map[string]*PropertySchema{
+						"from":     {Type: "string", Description: "Dependent thread ID"},
+						"to":       {Type: "string", Description: "Thread ID it depends on"},
+						"relation": {Type: "string", Description: "Relation (child_of, reply_to, references)"},
+					},
+				},
+			},
+			"truncated": {Type: "boolean", Description: "Whether the depth or size limit cut the graph short"},
+		},
+		Required: []string{"root_id", "nodes", "edges"},
+	},
+}
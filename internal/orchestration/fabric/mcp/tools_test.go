@@ -1,42 +1,38 @@
 package mcp
 
 import (
-	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 )
 
-func TestFabricToolSchema_IncludesObserverChannel(t *testing.T) {
-	// Test that ToolFabricSend includes "observer" in channel enum
-	t.Run("ToolFabricSend", func(t *testing.T) {
-		channelProp := ToolFabricSend.InputSchema.Properties["channel"]
-		require.NotNil(t, channelProp, "channel property should exist")
-		require.True(t, slices.Contains(channelProp.Enum, "observer"),
-			"ToolFabricSend channel enum should include 'observer'")
-	})
-
-	// Test that ToolFabricSubscribe includes "observer" in channel enum
-	t.Run("ToolFabricSubscribe", func(t *testing.T) {
-		channelProp := ToolFabricSubscribe.InputSchema.Properties["channel"]
-		require.NotNil(t, channelProp, "channel property should exist")
-		require.True(t, slices.Contains(channelProp.Enum, "observer"),
-			"ToolFabricSubscribe channel enum should include 'observer'")
-	})
-
-	// Test that ToolFabricUnsubscribe includes "observer" in channel enum
-	t.Run("ToolFabricUnsubscribe", func(t *testing.T) {
-		channelProp := ToolFabricUnsubscribe.InputSchema.Properties["channel"]
-		require.NotNil(t, channelProp, "channel property should exist")
-		require.True(t, slices.Contains(channelProp.Enum, "observer"),
-			"ToolFabricUnsubscribe channel enum should include 'observer'")
-	})
+func TestFabricToolSchema_ChannelArguments(t *testing.T) {
+	// Channel arguments name the observer channel and accept channels created
+	// at runtime, so they must not be restricted to the fixed channel slugs.
+	tools := map[string]Tool{
+		"ToolFabricSend":        ToolFabricSend,
+		"ToolFabricSubscribe":   ToolFabricSubscribe,
+		"ToolFabricUnsubscribe": ToolFabricUnsubscribe,
+		"ToolFabricHistory":     ToolFabricHistory,
+	}
+	for name, tool := range tools {
+		t.Run(name, func(t *testing.T) {
+			channelProp := tool.InputSchema.Properties["channel"]
+			require.NotNil(t, channelProp, "channel property should exist")
+			require.Empty(t, channelProp.Enum, "%s channel should accept runtime channels", name)
+			require.Contains(t, channelProp.Description, "fabric_list_channels")
+		})
+	}
+	require.Contains(t, ToolFabricSend.InputSchema.Properties["channel"].Description, "'observer'")
+}
 
-	// Test that ToolFabricHistory includes "observer" in channel enum
-	t.Run("ToolFabricHistory", func(t *testing.T) {
-		channelProp := ToolFabricHistory.InputSchema.Properties["channel"]
-		require.NotNil(t, channelProp, "channel property should exist")
-		require.True(t, slices.Contains(channelProp.Enum, "observer"),
-			"ToolFabricHistory channel enum should include 'observer'")
-	})
+func TestFabricChannelAdminTools_NotInFabricTools(t *testing.T) {
+	names := make(map[string]bool)
+	for _, tool := range FabricTools() {
+		names[tool.Name] = true
+	}
+	require.True(t, names["fabric_list_channels"])
+	for _, tool := range FabricChannelAdminTools() {
+		require.False(t, names[tool.Name], "%s should only be registered for the coordinator", tool.Name)
+	}
 }
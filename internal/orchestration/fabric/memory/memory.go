@@ -0,0 +1,328 @@
+// Package memory keeps the Fabric #memory channel alive across sessions.
+//
+// Coordinators post durable decisions and conventions to #memory and pin the
+// important ones with a 📌 reaction. A Recorder appends those messages and pins
+// to a project-scoped JSONL log (outside any session directory); a Store replays
+// the log so the next session can seed its #memory channel and surface the
+// latest pinned entries in the coordinator's startup brief.
+package memory
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+// DefaultBriefLimit is the number of pinned entries included in the startup brief.
+const DefaultBriefLimit = 10
+
+// maxLineSize is the buffer size for reading JSONL lines.
+const maxLineSize = 1024 * 1024
+
+// Record types written to the log.
+const (
+	recordEntry  = "entry"
+	recordPin    = "pin"
+	recordUnpin  = "unpin"
+	recordEdit   = "edit"
+	recordDelete = "delete"
+)
+
+// Entry is a message posted to #memory.
+type Entry struct {
+	ID        string    `json:"id"` // Fabric thread ID, stable across sessions
+	Content   string    `json:"content"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	SessionID string    `json:"session_id,omitempty"`
+
+	// PinnedBy lists the agents currently pinning this entry. Derived on load.
+	PinnedBy []string `json:"-"`
+}
+
+// Pinned reports whether any agent has pinned the entry.
+func (e Entry) Pinned() bool {
+	return len(e.PinnedBy) > 0
+}
+
+// record is one line of the project memory log.
+type record struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Entry     *Entry    `json:"entry,omitempty"`
+	ThreadID  string    `json:"thread_id,omitempty"`
+	AgentID   string    `json:"agent_id,omitempty"`
+	Content   string    `json:"content,omitempty"`
+}
+
+// Store is the append-only project memory log. The file is opened per write
+// so several sessions of the same project can share it.
+type Store struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewStore returns a store backed by the JSONL file at path, creating its
+// parent directory if needed. The file itself is created on first write.
+func NewStore(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return nil, fmt.Errorf("creating project memory directory: %w", err)
+	}
+	return &Store{path: path}, nil
+}
+
+// Path returns the path to the JSONL log.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Append records a new entry. Re-appending an existing ID replaces its content on load.
+func (s *Store) Append(entry Entry) error {
+	return s.write(record{Type: recordEntry, Entry: &entry})
+}
+
+// Pin records that agentID pinned the entry.
+func (s *Store) Pin(threadID, agentID string) error {
+	return s.write(record{Type: recordPin, ThreadID: threadID, AgentID: agentID})
+}
+
+// Unpin records that agentID removed their pin from the entry.
+func (s *Store) Unpin(threadID, agentID string) error {
+	return s.write(record{Type: recordUnpin, ThreadID: threadID, AgentID: agentID})
+}
+
+// Edit records new content for the entry. Edits of unknown entries are ignored on load.
+func (s *Store) Edit(threadID, content string) error {
+	return s.write(record{Type: recordEdit, ThreadID: threadID, Content: content})
+}
+
+// Delete records that the entry was deleted; it is dropped on load.
+func (s *Store) Delete(threadID string) error {
+	return s.write(record{Type: recordDelete, ThreadID: threadID})
+}
+
+func (s *Store) write(rec record) error {
+	rec.Timestamp = time.Now()
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("encoding project memory record: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec // internal path
+	if err != nil {
+		return fmt.Errorf("opening project memory: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing project memory: %w", err)
+	}
+	return nil
+}
+
+// Entries replays the log and returns all entries in creation order, with pins applied.
+// A missing file yields no entries. Malformed lines are skipped.
+func (s *Store) Entries() ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening project memory: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var order []string
+	entries := make(map[string]*Entry)
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, maxLineSize), maxLineSize)
+	for scanner.Scan() {
+		var rec record
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			continue
+		}
+
+		switch rec.Type {
+		case recordEntry:
+			if rec.Entry == nil || rec.Entry.ID == "" {
+				continue
+			}
+			if existing, ok := entries[rec.Entry.ID]; ok {
+				pinnedBy := existing.PinnedBy
+				*existing = *rec.Entry
+				existing.PinnedBy = pinnedBy
+				continue
+			}
+			entry := *rec.Entry
+			entry.PinnedBy = nil
+			entries[entry.ID] = &entry
+			order = append(order, entry.ID)
+		case recordPin:
+			if entry, ok := entries[rec.ThreadID]; ok && !slices.Contains(entry.PinnedBy, rec.AgentID) {
+				entry.PinnedBy = append(entry.PinnedBy, rec.AgentID)
+			}
+		case recordUnpin:
+			if entry, ok := entries[rec.ThreadID]; ok {
+				entry.PinnedBy = slices.DeleteFunc(entry.PinnedBy, func(id string) bool { return id == rec.AgentID })
+			}
+		case recordEdit:
+			if entry, ok := entries[rec.ThreadID]; ok {
+				entry.Content = rec.Content
+			}
+		case recordDelete:
+			if _, ok := entries[rec.ThreadID]; ok {
+				delete(entries, rec.ThreadID)
+				order = slices.DeleteFunc(order, func(id string) bool { return id == rec.ThreadID })
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading project memory: %w", err)
+	}
+
+	result := make([]Entry, 0, len(order))
+	for _, id := range order {
+		result = append(result, *entries[id])
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// Pinned returns the latest limit pinned entries, oldest first.
+// A limit of zero or less returns all pinned entries.
+func Pinned(entries []Entry, limit int) []Entry {
+	var pinned []Entry
+	for _, e := range entries {
+		if e.Pinned() {
+			pinned = append(pinned, e)
+		}
+	}
+	if limit > 0 && len(pinned) > limit {
+		pinned = pinned[len(pinned)-limit:]
+	}
+	return pinned
+}
+
+// Seed creates the #memory channel in svc and imports entries and their pins.
+// createdBy is the coordinator, which is subscribed to the channel.
+func Seed(svc *fabric.Service, entries []Entry, createdBy string) error {
+	history := make([]domain.Thread, 0, len(entries))
+	var pins []domain.Reaction
+	for _, e := range entries {
+		history = append(history, domain.Thread{
+			ID:        e.ID,
+			Type:      domain.ThreadMessage,
+			Content:   e.Content,
+			Kind:      string(domain.KindInfo),
+			CreatedBy: e.CreatedBy,
+			CreatedAt: e.CreatedAt,
+		})
+		for _, agentID := range e.PinnedBy {
+			pins = append(pins, domain.Reaction{ThreadID: e.ID, AgentID: agentID, Emoji: domain.PinEmoji})
+		}
+	}
+	return svc.InitMemoryChannel(createdBy, history, pins)
+}
+
+// Brief renders the startup brief section for the coordinator's initial prompt.
+// It explains how to use #memory and lists the pinned entries, if any.
+func Brief(pinned []Entry) string {
+	var sb strings.Builder
+	sb.WriteString("## Project Memory\n\n")
+	sb.WriteString("The #memory channel persists across sessions of this project. ")
+	sb.WriteString("Post durable decisions and conventions there with fabric_send(channel: \"memory\"), ")
+	sb.WriteString("and pin the ones future sessions must know with fabric_react(emoji: \"" + domain.PinEmoji + "\").\n")
+
+	if len(pinned) == 0 {
+		sb.WriteString("\nNo pinned entries yet.\n")
+		return sb.String()
+	}
+
+	sb.WriteString("\nPinned entries (latest last):\n")
+	for _, e := range pinned {
+		fmt.Fprintf(&sb, "- [%s] %s (%s)\n", e.CreatedAt.Format("2006-01-02"), strings.TrimSpace(e.Content), e.CreatedBy)
+	}
+	return sb.String()
+}
+
+// Recorder appends #memory activity from a session to a Store.
+// HandleEvent is meant to be chained into FabricService.SetEventHandler.
+type Recorder struct {
+	store     *Store
+	sessionID string
+}
+
+// NewRecorder creates a recorder that tags new entries with sessionID.
+func NewRecorder(store *Store, sessionID string) *Recorder {
+	return &Recorder{store: store, sessionID: sessionID}
+}
+
+// HandleEvent records top-level #memory messages, edits and deletions of them,
+// and 📌 pins on them.
+// Write failures are logged; they never affect the session.
+func (r *Recorder) HandleEvent(event fabric.Event) {
+	if event.ChannelSlug != domain.SlugMemory {
+		return
+	}
+
+	var err error
+	switch event.Type {
+	case fabric.EventMessagePosted:
+		if event.Thread == nil {
+			return
+		}
+		err = r.store.Append(Entry{
+			ID:        event.Thread.ID,
+			Content:   event.Thread.Content,
+			CreatedBy: event.Thread.CreatedBy,
+			CreatedAt: event.Thread.CreatedAt,
+			SessionID: r.sessionID,
+		})
+	case fabric.EventMessageEdited:
+		if event.Thread == nil {
+			return
+		}
+		err = r.store.Edit(event.Thread.ID, event.Thread.Content)
+	case fabric.EventMessageDeleted:
+		if event.Thread == nil {
+			return
+		}
+		err = r.store.Delete(event.Thread.ID)
+	case fabric.EventReactionAdded:
+		if event.Reaction == nil || event.Reaction.Emoji != domain.PinEmoji {
+			return
+		}
+		err = r.store.Pin(event.Reaction.ThreadID, event.Reaction.AgentID)
+	case fabric.EventReactionRemoved:
+		if event.Reaction == nil || event.Reaction.Emoji != domain.PinEmoji {
+			return
+		}
+		err = r.store.Unpin(event.Reaction.ThreadID, event.Reaction.AgentID)
+	default:
+		return
+	}
+
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to record project memory", "subsystem", "fabric", "event", event.Type, "error", err)
+	}
+}
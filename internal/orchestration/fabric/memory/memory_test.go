@@ -0,0 +1,188 @@
+package memory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+)
+
+const coordinatorID = "coordinator"
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := NewStore(filepath.Join(t.TempDir(), "app", "project_memory.jsonl"))
+	require.NoError(t, err)
+	return store
+}
+
+func newTestService(t *testing.T) *fabric.Service {
+	t.Helper()
+	threads := repository.NewMemoryThreadRepository()
+	deps := repository.NewMemoryDependencyRepository()
+	subs := repository.NewMemorySubscriptionRepository()
+	acks := repository.NewMemoryAckRepository(deps, threads, subs)
+	svc := fabric.NewService(threads, deps, subs, acks, repository.NewMemoryParticipantRepository())
+	require.NoError(t, svc.InitSession(coordinatorID))
+	return svc
+}
+
+func TestStore_EntriesMissingFile(t *testing.T) {
+	entries, err := newTestStore(t).Entries()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestStore_ReplaysEntriesAndPins(t *testing.T) {
+	store := newTestStore(t)
+	now := time.Now()
+
+	require.NoError(t, store.Append(Entry{ID: "m2", Content: "Use testify require", CreatedBy: "coordinator", CreatedAt: now.Add(time.Minute)}))
+	require.NoError(t, store.Append(Entry{ID: "m1", Content: "Errors wrap with %w", CreatedBy: "coordinator", CreatedAt: now}))
+	require.NoError(t, store.Pin("m1", "coordinator"))
+	require.NoError(t, store.Pin("m1", "coordinator")) // Duplicate pin is ignored
+	require.NoError(t, store.Pin("m2", "coordinator"))
+	require.NoError(t, store.Pin("m2", "worker-1"))
+	require.NoError(t, store.Unpin("m2", "coordinator"))
+	require.NoError(t, store.Pin("unknown", "coordinator"))
+
+	// Malformed lines are skipped
+	f, err := os.OpenFile(store.Path(), os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("{not json\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	entries, err := store.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "m1", entries[0].ID, "entries are ordered by creation time")
+	require.Equal(t, []string{"coordinator"}, entries[0].PinnedBy)
+	require.Equal(t, []string{"worker-1"}, entries[1].PinnedBy)
+
+	// Unpinning by the last pinner clears the pin
+	require.NoError(t, store.Unpin("m2", "worker-1"))
+	entries, err = store.Entries()
+	require.NoError(t, err)
+	require.False(t, entries[1].Pinned())
+}
+
+func TestPinned_ReturnsLatest(t *testing.T) {
+	entries := []Entry{
+		{ID: "a", PinnedBy: []string{"coordinator"}},
+		{ID: "b"},
+		{ID: "c", PinnedBy: []string{"coordinator"}},
+		{ID: "d", PinnedBy: []string{"coordinator"}},
+	}
+
+	ids := func(list []Entry) []string {
+		var out []string
+		for _, e := range list {
+			out = append(out, e.ID)
+		}
+		return out
+	}
+
+	require.Equal(t, []string{"c", "d"}, ids(Pinned(entries, 2)))
+	require.Equal(t, []string{"a", "c", "d"}, ids(Pinned(entries, 0)))
+}
+
+func TestBrief(t *testing.T) {
+	empty := Brief(nil)
+	require.Contains(t, empty, "## Project Memory")
+	require.Contains(t, empty, "No pinned entries yet.")
+
+	createdAt := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+	brief := Brief([]Entry{{ID: "m1", Content: "  Run make lint before committing\n", CreatedBy: "coordinator", CreatedAt: createdAt}})
+	require.Contains(t, brief, "- [2026-03-04] Run make lint before committing (coordinator)\n")
+	require.NotContains(t, brief, "No pinned entries yet.")
+}
+
+func TestRecorder_RecordsMemoryActivity(t *testing.T) {
+	store := newTestStore(t)
+	svc := newTestService(t)
+	require.NoError(t, Seed(svc, nil, coordinatorID))
+	svc.SetEventHandler(NewRecorder(store, "session-1").HandleEvent)
+
+	msg, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugMemory, Content: "Prefer table-driven tests", CreatedBy: coordinatorID})
+	require.NoError(t, err)
+	_, err = svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugGeneral, Content: "not durable", CreatedBy: coordinatorID})
+	require.NoError(t, err)
+	_, err = svc.AddReaction(msg.ID, coordinatorID, "👍")
+	require.NoError(t, err)
+	_, err = svc.AddReaction(msg.ID, coordinatorID, domain.PinEmoji)
+	require.NoError(t, err)
+
+	entries, err := store.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, msg.ID, entries[0].ID)
+	require.Equal(t, "Prefer table-driven tests", entries[0].Content)
+	require.Equal(t, "session-1", entries[0].SessionID)
+	require.True(t, entries[0].Pinned())
+
+	require.NoError(t, svc.RemoveReaction(msg.ID, coordinatorID, domain.PinEmoji))
+	entries, err = store.Entries()
+	require.NoError(t, err)
+	require.False(t, entries[0].Pinned())
+}
+
+func TestRecorder_RecordsEditsAndDeletes(t *testing.T) {
+	store := newTestStore(t)
+	svc := newTestService(t)
+	require.NoError(t, Seed(svc, nil, coordinatorID))
+	svc.SetEventHandler(NewRecorder(store, "session-1").HandleEvent)
+
+	kept, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugMemory, Content: "Use testfy", CreatedBy: coordinatorID})
+	require.NoError(t, err)
+	dropped, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugMemory, Content: "Wrong decision", CreatedBy: coordinatorID})
+	require.NoError(t, err)
+	reply, err := svc.Reply(fabric.ReplyInput{MessageID: kept.ID, Content: "typo", CreatedBy: coordinatorID})
+	require.NoError(t, err)
+
+	_, err = svc.EditMessage(kept.ID, coordinatorID, "Use testify")
+	require.NoError(t, err)
+	_, err = svc.EditMessage(reply.ID, coordinatorID, "fixed the typo")
+	require.NoError(t, err)
+	_, err = svc.DeleteMessage(dropped.ID, coordinatorID)
+	require.NoError(t, err)
+
+	entries, err := store.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "replies are not entries, even when edited")
+	require.Equal(t, kept.ID, entries[0].ID)
+	require.Equal(t, "Use testify", entries[0].Content)
+}
+
+func TestSeed_ImportsHistoryIntoNewSession(t *testing.T) {
+	store := newTestStore(t)
+	first := newTestService(t)
+	require.NoError(t, Seed(first, nil, coordinatorID))
+	first.SetEventHandler(NewRecorder(store, "session-1").HandleEvent)
+
+	msg, err := first.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugMemory, Content: "Never edit generated files", CreatedBy: coordinatorID})
+	require.NoError(t, err)
+	_, err = first.AddReaction(msg.ID, coordinatorID, domain.PinEmoji)
+	require.NoError(t, err)
+
+	// A later session sees the entry, with the same ID and its pin
+	entries, err := store.Entries()
+	require.NoError(t, err)
+	second := newTestService(t)
+	require.NoError(t, Seed(second, entries, coordinatorID))
+
+	history, err := second.ListMessages(domain.SlugMemory, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, msg.ID, history[0].ID)
+	require.Equal(t, "Never edit generated files", history[0].Content)
+
+	reactions, err := second.GetReactions(msg.ID)
+	require.NoError(t, err)
+	require.Equal(t, []domain.ReactionSummary{{Emoji: domain.PinEmoji, Count: 1, AgentIDs: []string{coordinatorID}}}, reactions)
+}
@@ -430,6 +430,28 @@ func TestRestoreFabricState_ReactionRemoved(t *testing.T) {
 	require.Len(t, reactionList, 0)
 }
 
+func TestRestoreEphemeralState(t *testing.T) {
+	participants := repository.NewMemoryParticipantRepository()
+	reactions := repository.NewInMemoryReactionRepository()
+
+	msg := &domain.Thread{ID: "msg-1", Type: domain.ThreadMessage, Content: "Hello"}
+	events := []PersistedEvent{
+		{Version: currentVersion, Event: fabric.NewMessagePostedEvent(msg, "ch-general", "general")},
+		{Version: currentVersion, Event: fabric.NewParticipantJoinedEvent(&domain.Participant{AgentID: "worker-1", Role: domain.RoleWorker})},
+		{Version: currentVersion, Event: fabric.NewReactionAddedEvent(&domain.Reaction{ThreadID: "msg-1", AgentID: "worker-1", Emoji: "👍"}, "ch-general", "general")},
+	}
+
+	require.NoError(t, RestoreEphemeralState(events, participants, reactions))
+
+	participant, err := participants.Get("worker-1")
+	require.NoError(t, err)
+	require.NotNil(t, participant)
+
+	list, err := reactions.ListForThread("msg-1")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
 func TestRestoreFabricService(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -492,6 +514,60 @@ func TestRestoreFabricService(t *testing.T) {
 	require.Equal(t, "msg-1", taskChildren[0].ThreadID)
 }
 
+func TestRestoreFabricState_EditedAndDeletedMessages(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	logger, err := NewEventLogger(tmpDir)
+	require.NoError(t, err)
+
+	// Record a live session's events
+	threads := repository.NewMemoryThreadRepository()
+	deps := repository.NewMemoryDependencyRepository()
+	subs := repository.NewMemorySubscriptionRepository()
+	acks := repository.NewMemoryAckRepository(deps, threads, subs)
+	svc := fabric.NewService(threads, deps, subs, acks, repository.NewMemoryParticipantRepository())
+	svc.SetEventHandler(logger.HandleEvent)
+	require.NoError(t, svc.InitSession("system"))
+
+	edited, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "Start perles-abc", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	_, err = svc.EditMessage(edited.ID, "coordinator", "Start perles-abd")
+	require.NoError(t, err)
+	deleted, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "wrong channel", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	_, err = svc.DeleteMessage(deleted.ID, "coordinator")
+	require.NoError(t, err)
+	require.NoError(t, logger.Close())
+
+	// Restore into fresh repositories
+	threads = repository.NewMemoryThreadRepository()
+	deps = repository.NewMemoryDependencyRepository()
+	subs = repository.NewMemorySubscriptionRepository()
+	acks = repository.NewMemoryAckRepository(deps, threads, subs)
+	_, err = RestoreFabricService(tmpDir, threads, deps, subs, acks,
+		repository.NewMemoryParticipantRepository(), repository.NewInMemoryReactionRepository())
+	require.NoError(t, err)
+
+	got, err := threads.Get(edited.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Start perles-abd", got.Content)
+	require.True(t, got.IsEdited())
+	require.Equal(t, "Start perles-abc", got.Edits[0].Content)
+
+	got, err = threads.Get(deleted.ID)
+	require.NoError(t, err)
+	require.True(t, got.IsDeleted())
+	require.Equal(t, domain.DeletedContent, got.Content)
+}
+
+func TestReplayMessageChanged_UnknownThread(t *testing.T) {
+	threads := repository.NewMemoryThreadRepository()
+	event := fabric.Event{Type: fabric.EventMessageEdited, Thread: &domain.Thread{ID: "msg-missing", Content: "edited"}}
+
+	err := replayMessageChanged(event, threads)
+	require.ErrorContains(t, err, "update thread msg-missing")
+}
+
 func TestHasPersistedFabricState(t *testing.T) {
 	tmpDir := t.TempDir()
 
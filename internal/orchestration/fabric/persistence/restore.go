@@ -90,6 +90,29 @@ func RestoreFabricState(
 	return nil
 }
 
+// RestoreEphemeralState replays only participant and reaction events.
+// It is used when threads, dependencies, subscriptions and acks are kept in durable
+// storage (see repository.StorageSQLite) and survived the restart on their own.
+func RestoreEphemeralState(
+	events []PersistedEvent,
+	participants repository.ParticipantRepository,
+	reactions repository.ReactionRepository,
+) error {
+	for _, pe := range events {
+		switch pe.Event.Type {
+		case fabric.EventParticipantJoined:
+			_ = replayParticipantJoined(pe.Event, participants)
+		case fabric.EventParticipantLeft:
+			_ = replayParticipantLeft(pe.Event, participants)
+		case fabric.EventReactionAdded:
+			_ = replayReactionAdded(pe.Event, reactions)
+		case fabric.EventReactionRemoved:
+			_ = replayReactionRemoved(pe.Event, reactions)
+		}
+	}
+	return nil
+}
+
 // replayEvent processes a single persisted event and updates repositories accordingly.
 func replayEvent(
 	pe PersistedEvent,
@@ -112,6 +135,9 @@ func replayEvent(
 	case fabric.EventReplyPosted:
 		return replayReplyPosted(event, threads, deps)
 
+	case fabric.EventMessageEdited, fabric.EventMessageDeleted:
+		return replayMessageChanged(event, threads)
+
 	case fabric.EventArtifactAdded:
 		return replayArtifactAdded(pe, threads, deps)
 
@@ -202,6 +228,19 @@ func replayReplyPosted(event fabric.Event, threads repository.ThreadRepository,
 	return nil
 }
 
+// replayMessageChanged applies an edit or deletion. The event carries the
+// whole updated thread, edit history included.
+func replayMessageChanged(event fabric.Event, threads repository.ThreadRepository) error {
+	if event.Thread == nil {
+		return fmt.Errorf("%s event has no thread", event.Type)
+	}
+
+	if _, err := threads.Update(*event.Thread); err != nil {
+		return fmt.Errorf("update thread %s: %w", event.Thread.ID, err)
+	}
+	return nil
+}
+
 // replayArtifactAdded restores an artifact and its references dependency.
 // Note: Artifact content is not stored - the artifact references a file by path (StorageURI).
 func replayArtifactAdded(pe PersistedEvent, threads repository.ThreadRepository, deps repository.DependencyRepository) error {
@@ -17,6 +17,12 @@ type MemoryAckRepository struct {
 	byThread map[string][]string // threadID -> list of ack keys
 
 	// Dependencies for GetUnacked
+	unackedResolver
+}
+
+// unackedResolver works out which messages an agent should see in its inbox.
+// It is shared by the ack repositories, which differ only in how acks are stored.
+type unackedResolver struct {
 	depRepo         DependencyRepository
 	threadRepo      ThreadRepository
 	subRepo         SubscriptionRepository
@@ -26,12 +32,14 @@ type MemoryAckRepository struct {
 // NewMemoryAckRepository creates a new in-memory ack repository.
 func NewMemoryAckRepository(depRepo DependencyRepository, threadRepo ThreadRepository, subRepo SubscriptionRepository) *MemoryAckRepository {
 	return &MemoryAckRepository{
-		acks:       make(map[string]*domain.Ack),
-		byAgent:    make(map[string][]string),
-		byThread:   make(map[string][]string),
-		depRepo:    depRepo,
-		threadRepo: threadRepo,
-		subRepo:    subRepo,
+		acks:     make(map[string]*domain.Ack),
+		byAgent:  make(map[string][]string),
+		byThread: make(map[string][]string),
+		unackedResolver: unackedResolver{
+			depRepo:    depRepo,
+			threadRepo: threadRepo,
+			subRepo:    subRepo,
+		},
 	}
 }
 
@@ -93,6 +101,11 @@ func (r *MemoryAckRepository) GetUnacked(agentID string) (map[string]UnackedSumm
 		}
 	}
 
+	return r.unacked(agentID, ackedSet)
+}
+
+// unacked returns the messages visible to an agent that are not in ackedSet, grouped by channel.
+func (r *unackedResolver) unacked(agentID string, ackedSet map[string]bool) (map[string]UnackedSummary, error) {
 	msgType := domain.ThreadMessage
 	messages, err := r.threadRepo.List(ListOptions{Type: &msgType})
 	if err != nil {
@@ -105,7 +118,7 @@ func (r *MemoryAckRepository) GetUnacked(agentID string) (map[string]UnackedSumm
 		if ackedSet[msg.ID] {
 			continue
 		}
-		if msg.IsArchived() {
+		if msg.IsArchived() || msg.IsDeleted() {
 			continue
 		}
 		// Don't show messages the agent sent themselves
@@ -152,7 +165,7 @@ func (r *MemoryAckRepository) GetUnacked(agentID string) (map[string]UnackedSumm
 
 // getChannelForReply traverses the reply chain to find the channel.
 // Replies have ReplyTo → parent, and eventually a message has ChildOf → channel.
-func (r *MemoryAckRepository) getChannelForReply(messageID string) string {
+func (r *unackedResolver) getChannelForReply(messageID string) string {
 	visited := make(map[string]bool)
 	current := messageID
 
@@ -198,7 +211,7 @@ func (r *MemoryAckRepository) GetAckedThreadIDs(agentID string) ([]string, error
 	return result, nil
 }
 
-func (r *MemoryAckRepository) getChannelForMessage(messageID string) (string, error) {
+func (r *unackedResolver) getChannelForMessage(messageID string) (string, error) {
 	relation := domain.RelationChildOf
 	deps, err := r.depRepo.GetParents(messageID, &relation)
 	if err != nil {
@@ -214,7 +227,7 @@ func (r *MemoryAckRepository) getChannelForMessage(messageID string) (string, er
 
 // isParticipantInThread checks if an agent is a participant in the root thread.
 // With flat threading, all replies point to the same root, so we check participants there.
-func (r *MemoryAckRepository) isParticipantInThread(agentID, replyID string) bool {
+func (r *unackedResolver) isParticipantInThread(agentID, replyID string) bool {
 	// Find the root message via reply_to
 	replyTo := domain.RelationReplyTo
 	parents, err := r.depRepo.GetParents(replyID, &replyTo)
@@ -232,7 +245,7 @@ func (r *MemoryAckRepository) isParticipantInThread(agentID, replyID string) boo
 }
 
 // isSubscribed checks if an agent is subscribed to a channel.
-func (r *MemoryAckRepository) isSubscribed(agentID, channelID string) bool {
+func (r *unackedResolver) isSubscribed(agentID, channelID string) bool {
 	if r.subRepo == nil {
 		return false
 	}
@@ -245,7 +258,7 @@ func (r *MemoryAckRepository) isSubscribed(agentID, channelID string) bool {
 
 // isHereMentionTarget checks if the message has @here and the agent is a fabric participant.
 // @here is a broadcast mention that should be visible to all registered participants.
-func (r *MemoryAckRepository) isHereMentionTarget(msg domain.Thread, agentID string) bool {
+func (r *unackedResolver) isHereMentionTarget(msg domain.Thread, agentID string) bool {
 	if r.participantRepo == nil {
 		return false
 	}
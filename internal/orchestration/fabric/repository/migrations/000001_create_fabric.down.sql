@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_fabric_acks_agent;
+DROP TABLE IF EXISTS fabric_acks;
+DROP INDEX IF EXISTS idx_fabric_subscriptions_agent;
+DROP TABLE IF EXISTS fabric_subscriptions;
+DROP INDEX IF EXISTS idx_fabric_dependencies_depends_on;
+DROP TABLE IF EXISTS fabric_dependencies;
+DROP INDEX IF EXISTS idx_fabric_threads_type;
+DROP INDEX IF EXISTS idx_fabric_threads_channel_slug;
+DROP TABLE IF EXISTS fabric_threads;
@@ -0,0 +1,67 @@
+-- Fabric message graph for one orchestration session, stored at {session_dir}/fabric.db
+-- Timestamps are Unix nanoseconds.
+
+-- Threads: channels, messages and artifacts
+CREATE TABLE fabric_threads (
+    seq INTEGER PRIMARY KEY AUTOINCREMENT,
+    id TEXT NOT NULL UNIQUE,
+    type TEXT NOT NULL CHECK(type IN ('channel', 'message', 'artifact')),
+    created_at INTEGER NOT NULL,
+    created_by TEXT NOT NULL DEFAULT '',
+
+    -- Message fields
+    content TEXT NOT NULL DEFAULT '',
+    kind TEXT NOT NULL DEFAULT '',
+
+    -- Channel fields
+    slug TEXT NOT NULL DEFAULT '',
+    title TEXT NOT NULL DEFAULT '',
+    purpose TEXT NOT NULL DEFAULT '',
+
+    -- Artifact fields
+    name TEXT NOT NULL DEFAULT '',
+    media_type TEXT NOT NULL DEFAULT '',
+    size_bytes INTEGER NOT NULL DEFAULT 0,
+    storage_uri TEXT NOT NULL DEFAULT '',
+
+    mentions TEXT,      -- JSON encoded []string
+    participants TEXT,  -- JSON encoded []string
+    meta TEXT,          -- JSON encoded map[string]string
+
+    archived_at INTEGER
+);
+
+CREATE UNIQUE INDEX idx_fabric_threads_channel_slug ON fabric_threads(slug) WHERE type = 'channel' AND slug != '';
+CREATE INDEX idx_fabric_threads_type ON fabric_threads(type, seq);
+
+-- Dependencies: graph edges between threads
+CREATE TABLE fabric_dependencies (
+    thread_id TEXT NOT NULL,
+    depends_on_id TEXT NOT NULL,
+    relation TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (thread_id, depends_on_id, relation)
+);
+
+CREATE INDEX idx_fabric_dependencies_depends_on ON fabric_dependencies(depends_on_id);
+
+-- Subscriptions: agent subscriptions to channels
+CREATE TABLE fabric_subscriptions (
+    channel_id TEXT NOT NULL,
+    agent_id TEXT NOT NULL,
+    mode TEXT NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (channel_id, agent_id)
+);
+
+CREATE INDEX idx_fabric_subscriptions_agent ON fabric_subscriptions(agent_id);
+
+-- Acks: messages acknowledged by agents
+CREATE TABLE fabric_acks (
+    thread_id TEXT NOT NULL,
+    agent_id TEXT NOT NULL,
+    acked_at INTEGER NOT NULL,
+    PRIMARY KEY (thread_id, agent_id)
+);
+
+CREATE INDEX idx_fabric_acks_agent ON fabric_acks(agent_id);
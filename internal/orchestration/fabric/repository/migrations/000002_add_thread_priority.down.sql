@@ -0,0 +1 @@
+ALTER TABLE fabric_threads DROP COLUMN priority;
@@ -0,0 +1,2 @@
+-- Message priority: 'urgent', 'normal' or 'low' ('' is normal)
+ALTER TABLE fabric_threads ADD COLUMN priority TEXT NOT NULL DEFAULT '';
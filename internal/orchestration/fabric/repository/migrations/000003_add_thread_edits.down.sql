@@ -0,0 +1,2 @@
+ALTER TABLE fabric_threads DROP COLUMN deleted_at;
+ALTER TABLE fabric_threads DROP COLUMN edits;
@@ -0,0 +1,3 @@
+-- Message edit history and deletion tombstones
+ALTER TABLE fabric_threads ADD COLUMN edits TEXT;        -- JSON encoded []ThreadEdit
+ALTER TABLE fabric_threads ADD COLUMN deleted_at INTEGER;
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+// ReceiptRepository tracks per-recipient delivery and read state of messages.
+type ReceiptRepository interface {
+	// MarkDelivered records that the messages were delivered to an agent at the given time.
+	// Messages already delivered keep their first delivery time.
+	// Returns the receipts that changed.
+	MarkDelivered(agentID string, at time.Time, messageIDs ...string) ([]domain.Receipt, error)
+
+	// MarkRead records that an agent read the messages at the given time. A read
+	// message counts as delivered. Messages already read keep their first read time.
+	// Returns the receipts that changed.
+	MarkRead(agentID string, at time.Time, messageIDs ...string) ([]domain.Receipt, error)
+
+	// ListForMessage returns the receipts of a message, ordered by agent ID.
+	ListForMessage(messageID string) ([]domain.Receipt, error)
+}
+
+// InMemoryReceiptRepository is an in-memory implementation of ReceiptRepository.
+type InMemoryReceiptRepository struct {
+	mu       sync.RWMutex
+	receipts map[string]*domain.Receipt // key = receipt.Key()
+}
+
+// NewInMemoryReceiptRepository creates a new in-memory receipt repository.
+func NewInMemoryReceiptRepository() *InMemoryReceiptRepository {
+	return &InMemoryReceiptRepository{
+		receipts: make(map[string]*domain.Receipt),
+	}
+}
+
+// MarkDelivered records that the messages were delivered to an agent.
+func (r *InMemoryReceiptRepository) MarkDelivered(agentID string, at time.Time, messageIDs ...string) ([]domain.Receipt, error) {
+	return r.update(agentID, messageIDs, func(receipt *domain.Receipt) bool {
+		if !receipt.DeliveredAt.IsZero() {
+			return false
+		}
+		receipt.DeliveredAt = at
+		return true
+	})
+}
+
+// MarkRead records that an agent read the messages.
+func (r *InMemoryReceiptRepository) MarkRead(agentID string, at time.Time, messageIDs ...string) ([]domain.Receipt, error) {
+	return r.update(agentID, messageIDs, func(receipt *domain.Receipt) bool {
+		if receipt.IsRead() {
+			return false
+		}
+		if receipt.DeliveredAt.IsZero() {
+			receipt.DeliveredAt = at
+		}
+		receipt.ReadAt = at
+		return true
+	})
+}
+
+// update applies change to the agent's receipt of each message, creating
+// receipts as needed, and returns copies of the receipts it changed.
+func (r *InMemoryReceiptRepository) update(agentID string, messageIDs []string, change func(*domain.Receipt) bool) ([]domain.Receipt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var changed []domain.Receipt
+	for _, messageID := range messageIDs {
+		receipt := &domain.Receipt{MessageID: messageID, AgentID: agentID}
+		if existing, ok := r.receipts[receipt.Key()]; ok {
+			receipt = existing
+		}
+		if change(receipt) {
+			r.receipts[receipt.Key()] = receipt
+			changed = append(changed, *receipt)
+		}
+	}
+	return changed, nil
+}
+
+// ListForMessage returns the receipts of a message.
+func (r *InMemoryReceiptRepository) ListForMessage(messageID string) ([]domain.Receipt, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var receipts []domain.Receipt
+	for _, receipt := range r.receipts {
+		if receipt.MessageID == messageID {
+			receipts = append(receipts, *receipt)
+		}
+	}
+	slices.SortFunc(receipts, func(a, b domain.Receipt) int { return cmp.Compare(a.AgentID, b.AgentID) })
+	return receipts, nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryReceiptRepository_DeliveredThenRead(t *testing.T) {
+	repo := NewInMemoryReceiptRepository()
+	t1 := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+
+	changed, err := repo.MarkDelivered("worker-2", t1, "msg-1")
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	require.False(t, changed[0].IsRead())
+
+	// A second delivery keeps the first delivery time
+	changed, err = repo.MarkDelivered("worker-2", t2, "msg-1")
+	require.NoError(t, err)
+	require.Empty(t, changed)
+
+	changed, err = repo.MarkRead("worker-2", t2, "msg-1")
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	require.Equal(t, t1, changed[0].DeliveredAt)
+	require.Equal(t, t2, changed[0].ReadAt)
+
+	changed, err = repo.MarkRead("worker-2", t2.Add(time.Minute), "msg-1")
+	require.NoError(t, err)
+	require.Empty(t, changed)
+}
+
+func TestInMemoryReceiptRepository_ReadImpliesDelivered(t *testing.T) {
+	repo := NewInMemoryReceiptRepository()
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := repo.MarkRead("worker-1", at, "msg-1")
+	require.NoError(t, err)
+	_, err = repo.MarkDelivered("worker-3", at, "msg-1", "msg-2")
+	require.NoError(t, err)
+
+	receipts, err := repo.ListForMessage("msg-1")
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	require.Equal(t, "worker-1", receipts[0].AgentID)
+	require.Equal(t, at, receipts[0].DeliveredAt)
+	require.True(t, receipts[0].IsRead())
+	require.Equal(t, "worker-3", receipts[1].AgentID)
+	require.False(t, receipts[1].IsRead())
+
+	receipts, err = repo.ListForMessage("missing")
+	require.NoError(t, err)
+	require.Empty(t, receipts)
+}
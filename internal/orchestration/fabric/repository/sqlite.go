@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zjrosen/perles/internal/infrastructure/migrations"
+	"github.com/zjrosen/perles/internal/log"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+)
+
+// Fabric storage backends.
+const (
+	// StorageMemory keeps the message graph in memory; it is lost when perles exits.
+	StorageMemory = "memory"
+	// StorageSQLite keeps the message graph in a SQLite database in the session directory.
+	StorageSQLite = "sqlite"
+)
+
+// SQLiteFileName is the database file created in the session directory for StorageSQLite.
+const SQLiteFileName = "fabric.db"
+
+//go:embed migrations/*.sql
+var embeddedMigrations embed.FS
+
+// SQLiteStore owns the SQLite connection backing the fabric thread, dependency,
+// subscription and ack repositories of one session.
+type SQLiteStore struct {
+	db   *sql.DB
+	path string
+}
+
+// OpenSQLiteStore opens (or creates) the fabric database at path and applies pending migrations.
+func OpenSQLiteStore(path string) (*SQLiteStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("creating fabric database directory: %w", err)
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+path)
+	if err != nil {
+		return nil, fmt.Errorf("opening fabric database: %w", err)
+	}
+
+	// A single connection serializes writers, so fabric never sees SQLITE_BUSY
+	// from its own goroutines. Callers must fully read rows before issuing another query.
+	db.SetMaxOpenConns(1)
+
+	for _, pragma := range []string{"PRAGMA journal_mode=WAL", "PRAGMA busy_timeout=5000"} {
+		if _, err := db.Exec(pragma); err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("configuring fabric database (%s): %w", pragma, err)
+		}
+	}
+
+	migrationsFS, err := fs.Sub(embeddedMigrations, "migrations")
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("loading fabric migrations: %w", err)
+	}
+	if err := migrations.RunMigrationsFS(db, migrationsFS); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("migrating fabric database: %w", err)
+	}
+
+	log.Debug(log.CatDB, "Opened fabric database", "path", path)
+
+	return &SQLiteStore{db: db, path: path}, nil
+}
+
+// Close releases the database connection.
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+// Path returns the database file path.
+func (s *SQLiteStore) Path() string {
+	return s.path
+}
+
+// Empty reports whether the store holds no threads yet, e.g. when a session that
+// used memory storage is resumed with SQLite storage for the first time.
+func (s *SQLiteStore) Empty() (bool, error) {
+	var exists bool
+	if err := s.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM fabric_threads)`).Scan(&exists); err != nil {
+		return false, fmt.Errorf("checking fabric threads: %w", err)
+	}
+	return !exists, nil
+}
+
+// Threads returns the thread repository backed by this store.
+func (s *SQLiteStore) Threads() *SQLiteThreadRepository {
+	return &SQLiteThreadRepository{db: s.db}
+}
+
+// Dependencies returns the dependency repository backed by this store.
+func (s *SQLiteStore) Dependencies() *SQLiteDependencyRepository {
+	return &SQLiteDependencyRepository{db: s.db}
+}
+
+// Subscriptions returns the subscription repository backed by this store.
+func (s *SQLiteStore) Subscriptions() *SQLiteSubscriptionRepository {
+	return &SQLiteSubscriptionRepository{db: s.db}
+}
+
+// Acks returns the ack repository backed by this store.
+// The other repositories are used to resolve unacked messages, as in NewMemoryAckRepository.
+func (s *SQLiteStore) Acks(depRepo DependencyRepository, threadRepo ThreadRepository, subRepo SubscriptionRepository) *SQLiteAckRepository {
+	return &SQLiteAckRepository{
+		db: s.db,
+		unackedResolver: unackedResolver{
+			depRepo:    depRepo,
+			threadRepo: threadRepo,
+			subRepo:    subRepo,
+		},
+	}
+}
+
+// unixNano converts a time to its stored representation.
+func unixNano(t time.Time) int64 {
+	return t.UnixNano()
+}
+
+// fromUnixNano converts a stored timestamp back to a time.
+func fromUnixNano(n int64) time.Time {
+	return time.Unix(0, n)
+}
+
+// jsonColumn encodes a slice or map column. Empty values are stored as NULL.
+func jsonColumn(v any, empty bool) (sql.NullString, error) {
+	if empty {
+		return sql.NullString{}, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+// decodeJSONColumn decodes a column written by jsonColumn into dst. NULL leaves dst untouched.
+func decodeJSONColumn(col sql.NullString, dst any) error {
+	if !col.Valid {
+		return nil
+	}
+	return json.Unmarshal([]byte(col.String), dst)
+}
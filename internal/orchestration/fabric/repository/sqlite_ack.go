@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SQLiteAckRepository is a SQLite implementation of AckRepository.
+// Unacked messages are resolved from the thread graph the same way as MemoryAckRepository.
+type SQLiteAckRepository struct {
+	db *sql.DB
+
+	mu sync.RWMutex // guards participantRepo
+	unackedResolver
+}
+
+// SetParticipantRepository sets the participant repository for @here expansion.
+// This is optional - if not set, @here mentions won't be expanded in GetUnacked.
+func (r *SQLiteAckRepository) SetParticipantRepository(repo ParticipantRepository) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.participantRepo = repo
+}
+
+// Ack marks message threads as acknowledged by an agent.
+func (r *SQLiteAckRepository) Ack(agentID string, threadIDs ...string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := unixNano(time.Now())
+	for _, threadID := range threadIDs {
+		if _, err := tx.Exec(
+			`INSERT OR IGNORE INTO fabric_acks (thread_id, agent_id, acked_at) VALUES (?, ?, ?)`,
+			threadID, agentID, now,
+		); err != nil {
+			return fmt.Errorf("acking %s for %s: %w", threadID, agentID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing acks: %w", err)
+	}
+	return nil
+}
+
+// IsAcked checks if an agent has acknowledged a message.
+func (r *SQLiteAckRepository) IsAcked(threadID, agentID string) (bool, error) {
+	var acked bool
+	err := r.db.QueryRow(
+		`SELECT EXISTS (SELECT 1 FROM fabric_acks WHERE thread_id = ? AND agent_id = ?)`, threadID, agentID,
+	).Scan(&acked)
+	if err != nil {
+		return false, fmt.Errorf("checking ack: %w", err)
+	}
+	return acked, nil
+}
+
+// GetUnacked returns all unacked messages for an agent, grouped by channel.
+func (r *SQLiteAckRepository) GetUnacked(agentID string) (map[string]UnackedSummary, error) {
+	acked, err := r.GetAckedThreadIDs(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	ackedSet := make(map[string]bool, len(acked))
+	for _, id := range acked {
+		ackedSet[id] = true
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.unacked(agentID, ackedSet)
+}
+
+// GetAckedThreadIDs returns all thread IDs that an agent has acknowledged, in ack order.
+func (r *SQLiteAckRepository) GetAckedThreadIDs(agentID string) ([]string, error) {
+	rows, err := r.db.Query(`SELECT thread_id FROM fabric_acks WHERE agent_id = ? ORDER BY rowid`, agentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing acks for %s: %w", agentID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	result := make([]string, 0)
+	for rows.Next() {
+		var threadID string
+		if err := rows.Scan(&threadID); err != nil {
+			return nil, fmt.Errorf("scanning ack: %w", err)
+		}
+		result = append(result, threadID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing acks for %s: %w", agentID, err)
+	}
+	return result, nil
+}
+
+var _ AckRepository = (*SQLiteAckRepository)(nil)
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+// SQLiteDependencyRepository is a SQLite implementation of DependencyRepository.
+type SQLiteDependencyRepository struct {
+	db *sql.DB
+}
+
+// Add creates a dependency edge. Adding an existing edge is a no-op.
+func (r *SQLiteDependencyRepository) Add(dep domain.Dependency) error {
+	_, err := r.db.Exec(
+		`INSERT OR IGNORE INTO fabric_dependencies (thread_id, depends_on_id, relation, created_at) VALUES (?, ?, ?, ?)`,
+		dep.ThreadID, dep.DependsOnID, dep.Relation, unixNano(dep.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("adding dependency %s: %w", dep.Key(), err)
+	}
+	return nil
+}
+
+// Remove deletes all relations between two threads.
+func (r *SQLiteDependencyRepository) Remove(threadID, dependsOnID string) error {
+	_, err := r.db.Exec(`DELETE FROM fabric_dependencies WHERE thread_id = ? AND depends_on_id = ?`, threadID, dependsOnID)
+	if err != nil {
+		return fmt.Errorf("removing dependency %s -> %s: %w", threadID, dependsOnID, err)
+	}
+	return nil
+}
+
+// GetParents returns dependencies where this thread is the dependent, in insertion order.
+func (r *SQLiteDependencyRepository) GetParents(threadID string, relation *domain.RelationType) ([]domain.Dependency, error) {
+	return r.query("thread_id", threadID, relation)
+}
+
+// GetChildren returns dependencies where this thread is depended upon, in insertion order.
+func (r *SQLiteDependencyRepository) GetChildren(threadID string, relation *domain.RelationType) ([]domain.Dependency, error) {
+	return r.query("depends_on_id", threadID, relation)
+}
+
+// query lists edges whose column (thread_id or depends_on_id) matches id.
+func (r *SQLiteDependencyRepository) query(column, id string, relation *domain.RelationType) ([]domain.Dependency, error) {
+	query := `SELECT thread_id, depends_on_id, relation, created_at FROM fabric_dependencies WHERE ` + column + ` = ?`
+	args := []any{id}
+	if relation != nil {
+		query += ` AND relation = ?`
+		args = append(args, *relation)
+	}
+	query += ` ORDER BY rowid`
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing dependencies of %s: %w", id, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var results []domain.Dependency
+	for rows.Next() {
+		var (
+			dep       domain.Dependency
+			createdAt int64
+		)
+		if err := rows.Scan(&dep.ThreadID, &dep.DependsOnID, &dep.Relation, &createdAt); err != nil {
+			return nil, fmt.Errorf("scanning dependency: %w", err)
+		}
+		dep.CreatedAt = fromUnixNano(createdAt)
+		results = append(results, dep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing dependencies of %s: %w", id, err)
+	}
+	return results, nil
+}
+
+// GetRoots returns thread IDs with no child_of dependency.
+func (r *SQLiteDependencyRepository) GetRoots() ([]string, error) {
+	rows, err := r.db.Query(
+		`SELECT id FROM (
+			SELECT thread_id AS id FROM fabric_dependencies
+			UNION
+			SELECT depends_on_id AS id FROM fabric_dependencies
+		) WHERE id NOT IN (SELECT thread_id FROM fabric_dependencies WHERE relation = ?)`,
+		domain.RelationChildOf,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing root threads: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var roots []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning root thread: %w", err)
+		}
+		roots = append(roots, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing root threads: %w", err)
+	}
+	return roots, nil
+}
+
+var _ DependencyRepository = (*SQLiteDependencyRepository)(nil)
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+// SQLiteSubscriptionRepository is a SQLite implementation of SubscriptionRepository.
+type SQLiteSubscriptionRepository struct {
+	db *sql.DB
+}
+
+// Subscribe creates or updates a subscription. Updating keeps the original CreatedAt.
+func (r *SQLiteSubscriptionRepository) Subscribe(channelID, agentID string, mode domain.SubscriptionMode) (*domain.Subscription, error) {
+	_, err := r.db.Exec(
+		`INSERT INTO fabric_subscriptions (channel_id, agent_id, mode, created_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (channel_id, agent_id) DO UPDATE SET mode = excluded.mode`,
+		channelID, agentID, mode, unixNano(time.Now()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("subscribing %s to %s: %w", agentID, channelID, err)
+	}
+	return r.Get(channelID, agentID)
+}
+
+// Unsubscribe removes a subscription.
+func (r *SQLiteSubscriptionRepository) Unsubscribe(channelID, agentID string) error {
+	_, err := r.db.Exec(`DELETE FROM fabric_subscriptions WHERE channel_id = ? AND agent_id = ?`, channelID, agentID)
+	if err != nil {
+		return fmt.Errorf("unsubscribing %s from %s: %w", agentID, channelID, err)
+	}
+	return nil
+}
+
+// ListForAgent returns all subscriptions for an agent.
+func (r *SQLiteSubscriptionRepository) ListForAgent(agentID string) ([]domain.Subscription, error) {
+	return r.list(`agent_id = ?`, agentID)
+}
+
+// ListForChannel returns all subscriptions for a channel.
+func (r *SQLiteSubscriptionRepository) ListForChannel(channelID string) ([]domain.Subscription, error) {
+	return r.list(`channel_id = ?`, channelID)
+}
+
+// list returns subscriptions matching a single-column condition, in insertion order.
+func (r *SQLiteSubscriptionRepository) list(condition, value string) ([]domain.Subscription, error) {
+	rows, err := r.db.Query(
+		`SELECT channel_id, agent_id, mode, created_at FROM fabric_subscriptions WHERE `+condition+` ORDER BY rowid`, value)
+	if err != nil {
+		return nil, fmt.Errorf("listing subscriptions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	results := make([]domain.Subscription, 0)
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning subscription: %w", err)
+		}
+		results = append(results, *sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing subscriptions: %w", err)
+	}
+	return results, nil
+}
+
+// Get returns a specific subscription, or nil if it doesn't exist.
+func (r *SQLiteSubscriptionRepository) Get(channelID, agentID string) (*domain.Subscription, error) {
+	sub, err := scanSubscription(r.db.QueryRow(
+		`SELECT channel_id, agent_id, mode, created_at FROM fabric_subscriptions WHERE channel_id = ? AND agent_id = ?`,
+		channelID, agentID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// scanSubscription scans a subscription row.
+func scanSubscription(scanner interface{ Scan(...any) error }) (*domain.Subscription, error) {
+	var (
+		sub       domain.Subscription
+		createdAt int64
+	)
+	if err := scanner.Scan(&sub.ChannelID, &sub.AgentID, &sub.Mode, &createdAt); err != nil {
+		return nil, err
+	}
+	sub.CreatedAt = fromUnixNano(createdAt)
+	return &sub, nil
+}
+
+var _ SubscriptionRepository = (*SQLiteSubscriptionRepository)(nil)
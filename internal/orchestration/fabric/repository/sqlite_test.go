@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+func openTestSQLiteStore(t *testing.T, path string) *SQLiteStore {
+	t.Helper()
+	store, err := OpenSQLiteStore(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestSQLiteThreadRepository_CreateGetUpdate(t *testing.T) {
+	threads := openTestSQLiteStore(t, filepath.Join(t.TempDir(), SQLiteFileName)).Threads()
+
+	channel, err := threads.Create(domain.Thread{Type: domain.ThreadChannel, Slug: "tasks", Title: "Tasks", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	require.NotEmpty(t, channel.ID)
+	require.Equal(t, int64(1), channel.Seq)
+
+	msg, err := threads.Create(domain.Thread{
+		ID:        "msg-1",
+		Type:      domain.ThreadMessage,
+		Content:   "hello @worker-1",
+		Priority:  domain.PriorityUrgent,
+		CreatedBy: "coordinator",
+		Mentions:  []string{"worker-1"},
+		Meta:      map[string]string{"task_id": "perles-abc.1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), msg.Seq)
+
+	got, err := threads.Get("msg-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-1"}, got.Mentions)
+	require.Equal(t, domain.PriorityUrgent, got.Priority)
+	require.Equal(t, "perles-abc.1", got.Meta["task_id"])
+	require.Nil(t, got.Participants)
+	require.Equal(t, msg.CreatedAt.UnixNano(), got.CreatedAt.UnixNano())
+
+	bySlug, err := threads.GetBySlug("tasks")
+	require.NoError(t, err)
+	require.Equal(t, channel.ID, bySlug.ID)
+
+	_, err = threads.Create(domain.Thread{ID: "msg-1", Type: domain.ThreadMessage})
+	require.EqualError(t, err, "thread already exists: msg-1")
+	_, err = threads.Create(domain.Thread{Type: domain.ThreadChannel, Slug: "tasks"})
+	require.ErrorContains(t, err, "channel slug already exists: tasks")
+	_, err = threads.Get("missing")
+	require.EqualError(t, err, "thread not found: missing")
+	_, err = threads.GetBySlug("missing")
+	require.EqualError(t, err, "channel not found: missing")
+
+	got.AddParticipant("worker-1")
+	got.Seq = 99
+	updated, err := threads.Update(*got)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.Seq, "Seq is preserved")
+
+	got, err = threads.Get("msg-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-1"}, got.Participants)
+
+	require.NoError(t, threads.Archive("msg-1"))
+	got, err = threads.Get("msg-1")
+	require.NoError(t, err)
+	require.True(t, got.IsArchived())
+	require.EqualError(t, threads.Archive("missing"), "thread not found: missing")
+}
+
+func TestSQLiteThreadRepository_EditsAndTombstone(t *testing.T) {
+	threads := openTestSQLiteStore(t, filepath.Join(t.TempDir(), SQLiteFileName)).Threads()
+
+	msg, err := threads.Create(domain.Thread{ID: "msg-1", Type: domain.ThreadMessage, Content: "start perles-abc", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+
+	editedAt := time.Now()
+	msg.Revise("start perles-abd", editedAt)
+	_, err = threads.Update(*msg)
+	require.NoError(t, err)
+
+	got, err := threads.Get("msg-1")
+	require.NoError(t, err)
+	require.Equal(t, "start perles-abd", got.Content)
+	require.Len(t, got.Edits, 1)
+	require.Equal(t, "start perles-abc", got.Edits[0].Content)
+	require.True(t, got.Edits[0].EditedAt.Equal(editedAt))
+	require.Nil(t, got.DeletedAt)
+
+	deletedAt := time.Now()
+	got.Revise(domain.DeletedContent, deletedAt)
+	got.DeletedAt = &deletedAt
+	_, err = threads.Update(*got)
+	require.NoError(t, err)
+
+	got, err = threads.Get("msg-1")
+	require.NoError(t, err)
+	require.True(t, got.IsDeleted())
+	require.Equal(t, deletedAt.UnixNano(), got.DeletedAt.UnixNano())
+	require.Equal(t, domain.DeletedContent, got.Content)
+	require.Len(t, got.Edits, 2)
+}
+
+func TestSQLiteThreadRepository_List(t *testing.T) {
+	threads := openTestSQLiteStore(t, filepath.Join(t.TempDir(), SQLiteFileName)).Threads()
+
+	_, err := threads.Create(domain.Thread{ID: "ch", Type: domain.ThreadChannel, Slug: "general"})
+	require.NoError(t, err)
+	for _, th := range []domain.Thread{
+		{ID: "m1", Type: domain.ThreadMessage, CreatedBy: "worker-1", Mentions: []string{"coordinator"}},
+		{ID: "m2", Type: domain.ThreadMessage, CreatedBy: "worker-2"},
+		{ID: "m3", Type: domain.ThreadMessage, CreatedBy: "worker-1", Mentions: []string{"coordinator"}},
+	} {
+		_, err := threads.Create(th)
+		require.NoError(t, err)
+	}
+
+	ids := func(list []domain.Thread) []string {
+		var out []string
+		for _, th := range list {
+			out = append(out, th.ID)
+		}
+		return out
+	}
+
+	msgType := domain.ThreadMessage
+	list, err := threads.List(ListOptions{Type: &msgType})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m1", "m2", "m3"}, ids(list))
+
+	list, err = threads.List(ListOptions{AfterSeq: 2, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m2"}, ids(list))
+
+	creator := "worker-1"
+	list, err = threads.List(ListOptions{CreatedBy: &creator})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m1", "m3"}, ids(list))
+
+	mention := "coordinator"
+	list, err = threads.List(ListOptions{HasMention: &mention, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"m1"}, ids(list))
+}
+
+func TestSQLiteDependencyRepository(t *testing.T) {
+	deps := openTestSQLiteStore(t, filepath.Join(t.TempDir(), SQLiteFileName)).Dependencies()
+
+	require.NoError(t, deps.Add(domain.NewDependency("ch-tasks", "root", domain.RelationChildOf)))
+	require.NoError(t, deps.Add(domain.NewDependency("msg-1", "ch-tasks", domain.RelationChildOf)))
+	require.NoError(t, deps.Add(domain.NewDependency("reply-1", "msg-1", domain.RelationReplyTo)))
+	// Idempotent
+	require.NoError(t, deps.Add(domain.NewDependency("msg-1", "ch-tasks", domain.RelationChildOf)))
+
+	parents, err := deps.GetParents("msg-1", nil)
+	require.NoError(t, err)
+	require.Len(t, parents, 1)
+	require.Equal(t, "ch-tasks", parents[0].DependsOnID)
+
+	replyTo := domain.RelationReplyTo
+	children, err := deps.GetChildren("msg-1", &replyTo)
+	require.NoError(t, err)
+	require.Len(t, children, 1)
+	require.Equal(t, "reply-1", children[0].ThreadID)
+
+	roots, err := deps.GetRoots()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"root", "reply-1"}, roots)
+
+	require.NoError(t, deps.Remove("reply-1", "msg-1"))
+	children, err = deps.GetChildren("msg-1", nil)
+	require.NoError(t, err)
+	require.Empty(t, children)
+}
+
+func TestSQLiteSubscriptionRepository(t *testing.T) {
+	subs := openTestSQLiteStore(t, filepath.Join(t.TempDir(), SQLiteFileName)).Subscriptions()
+
+	sub, err := subs.Subscribe("ch-1", "agent-1", domain.ModeAll)
+	require.NoError(t, err)
+	require.Equal(t, domain.ModeAll, sub.Mode)
+
+	// Re-subscribing updates the mode and keeps CreatedAt
+	updated, err := subs.Subscribe("ch-1", "agent-1", domain.ModeMentions)
+	require.NoError(t, err)
+	require.Equal(t, domain.ModeMentions, updated.Mode)
+	require.Equal(t, sub.CreatedAt, updated.CreatedAt)
+
+	_, err = subs.Subscribe("ch-2", "agent-1", domain.ModeAll)
+	require.NoError(t, err)
+
+	list, err := subs.ListForAgent("agent-1")
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	list, err = subs.ListForChannel("ch-1")
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	require.NoError(t, subs.Unsubscribe("ch-1", "agent-1"))
+	got, err := subs.Get("ch-1", "agent-1")
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestSQLiteAckRepository_GetUnacked(t *testing.T) {
+	store := openTestSQLiteStore(t, filepath.Join(t.TempDir(), SQLiteFileName))
+	threads, deps, subs := store.Threads(), store.Dependencies(), store.Subscriptions()
+	acks := store.Acks(deps, threads, subs)
+
+	_, err := threads.Create(domain.Thread{ID: "ch-1", Type: domain.ThreadChannel, Slug: "tasks"})
+	require.NoError(t, err)
+	for _, id := range []string{"msg-1", "msg-2"} {
+		_, err := threads.Create(domain.Thread{ID: id, Type: domain.ThreadMessage, CreatedBy: "coordinator", Mentions: []string{"worker-1"}})
+		require.NoError(t, err)
+		require.NoError(t, deps.Add(domain.NewDependency(id, "ch-1", domain.RelationChildOf)))
+	}
+
+	require.NoError(t, acks.Ack("worker-1", "msg-1"))
+	require.NoError(t, acks.Ack("worker-1", "msg-1")) // Idempotent
+
+	acked, err := acks.IsAcked("msg-1", "worker-1")
+	require.NoError(t, err)
+	require.True(t, acked)
+
+	unacked, err := acks.GetUnacked("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]UnackedSummary{"ch-1": {Count: 1, ThreadIDs: []string{"msg-2"}}}, unacked)
+
+	ids, err := acks.GetAckedThreadIDs("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"msg-1"}, ids)
+}
+
+func TestSQLiteStore_PersistsAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), SQLiteFileName)
+
+	store, err := OpenSQLiteStore(path)
+	require.NoError(t, err)
+	empty, err := store.Empty()
+	require.NoError(t, err)
+	require.True(t, empty)
+
+	createdAt := time.Now()
+	_, err = store.Threads().Create(domain.Thread{ID: "ch-1", Type: domain.ThreadChannel, Slug: "tasks", CreatedAt: createdAt})
+	require.NoError(t, err)
+	_, err = store.Threads().Create(domain.Thread{ID: "msg-1", Type: domain.ThreadMessage, Content: "hi"})
+	require.NoError(t, err)
+	require.NoError(t, store.Dependencies().Add(domain.NewDependency("msg-1", "ch-1", domain.RelationChildOf)))
+	_, err = store.Subscriptions().Subscribe("ch-1", "worker-1", domain.ModeAll)
+	require.NoError(t, err)
+	require.NoError(t, store.Acks(nil, nil, nil).Ack("worker-1", "msg-1"))
+	require.NoError(t, store.Close())
+
+	// Reopening runs migrations again (no-op) and finds everything intact
+	store = openTestSQLiteStore(t, path)
+	empty, err = store.Empty()
+	require.NoError(t, err)
+	require.False(t, empty)
+
+	channel, err := store.Threads().GetBySlug("tasks")
+	require.NoError(t, err)
+	require.Equal(t, createdAt.UnixNano(), channel.CreatedAt.UnixNano())
+
+	parents, err := store.Dependencies().GetParents("msg-1", nil)
+	require.NoError(t, err)
+	require.Len(t, parents, 1)
+
+	sub, err := store.Subscriptions().Get("ch-1", "worker-1")
+	require.NoError(t, err)
+	require.NotNil(t, sub)
+
+	acked, err := store.Acks(nil, nil, nil).IsAcked("msg-1", "worker-1")
+	require.NoError(t, err)
+	require.True(t, acked)
+
+	// Seq keeps increasing after a restart
+	next, err := store.Threads().Create(domain.Thread{ID: "msg-2", Type: domain.ThreadMessage})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), next.Seq)
+}
@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+// threadColumns is the list of columns to select for thread queries.
+const threadColumns = `seq, id, type, created_at, created_by, content, kind, priority, slug, title, purpose,
+	name, media_type, size_bytes, storage_uri, mentions, participants, meta, archived_at, edits, deleted_at`
+
+// SQLiteThreadRepository is a SQLite implementation of ThreadRepository.
+// Seq is the table's autoincrement key, so it keeps increasing across restarts.
+type SQLiteThreadRepository struct {
+	db *sql.DB
+}
+
+// scanThread scans a row selected with threadColumns into a Thread.
+func scanThread(scanner interface{ Scan(...any) error }) (*domain.Thread, error) {
+	var (
+		t                            domain.Thread
+		createdAt                    int64
+		mentions, participants, meta sql.NullString
+		edits                        sql.NullString
+		archivedAt, deletedAt        sql.NullInt64
+	)
+	err := scanner.Scan(
+		&t.Seq, &t.ID, &t.Type, &createdAt, &t.CreatedBy, &t.Content, &t.Kind, &t.Priority,
+		&t.Slug, &t.Title, &t.Purpose,
+		&t.Name, &t.MediaType, &t.SizeBytes, &t.StorageURI,
+		&mentions, &participants, &meta, &archivedAt, &edits, &deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	t.CreatedAt = fromUnixNano(createdAt)
+	if archivedAt.Valid {
+		at := fromUnixNano(archivedAt.Int64)
+		t.ArchivedAt = &at
+	}
+	if deletedAt.Valid {
+		at := fromUnixNano(deletedAt.Int64)
+		t.DeletedAt = &at
+	}
+	if err := decodeJSONColumn(mentions, &t.Mentions); err != nil {
+		return nil, fmt.Errorf("decoding mentions of thread %s: %w", t.ID, err)
+	}
+	if err := decodeJSONColumn(participants, &t.Participants); err != nil {
+		return nil, fmt.Errorf("decoding participants of thread %s: %w", t.ID, err)
+	}
+	if err := decodeJSONColumn(meta, &t.Meta); err != nil {
+		return nil, fmt.Errorf("decoding meta of thread %s: %w", t.ID, err)
+	}
+	if err := decodeJSONColumn(edits, &t.Edits); err != nil {
+		return nil, fmt.Errorf("decoding edits of thread %s: %w", t.ID, err)
+	}
+	return &t, nil
+}
+
+// threadJSONColumns encodes the thread's JSON columns.
+func threadJSONColumns(t domain.Thread) (mentions, participants, meta, edits sql.NullString, err error) {
+	if mentions, err = jsonColumn(t.Mentions, len(t.Mentions) == 0); err != nil {
+		return
+	}
+	if participants, err = jsonColumn(t.Participants, len(t.Participants) == 0); err != nil {
+		return
+	}
+	if meta, err = jsonColumn(t.Meta, len(t.Meta) == 0); err != nil {
+		return
+	}
+	edits, err = jsonColumn(t.Edits, len(t.Edits) == 0)
+	return
+}
+
+// nullableTime converts an optional time to its stored representation.
+func nullableTime(t *time.Time) sql.NullInt64 {
+	if t == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: unixNano(*t), Valid: true}
+}
+
+// Create adds a new thread to the graph.
+func (r *SQLiteThreadRepository) Create(thread domain.Thread) (*domain.Thread, error) {
+	if thread.ID == "" {
+		thread.ID = uuid.New().String()
+	}
+	if thread.CreatedAt.IsZero() {
+		thread.CreatedAt = time.Now()
+	}
+
+	mentions, participants, meta, edits, err := threadJSONColumns(thread)
+	if err != nil {
+		return nil, fmt.Errorf("encoding thread %s: %w", thread.ID, err)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Check for conflicts first so errors match the memory repository
+	var exists bool
+	if err := tx.QueryRow(`SELECT EXISTS (SELECT 1 FROM fabric_threads WHERE id = ?)`, thread.ID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("checking thread %s: %w", thread.ID, err)
+	}
+	if exists {
+		return nil, fmt.Errorf("thread already exists: %s", thread.ID)
+	}
+	if thread.Type == domain.ThreadChannel && thread.Slug != "" {
+		var existingID string
+		err := tx.QueryRow(`SELECT id FROM fabric_threads WHERE type = 'channel' AND slug = ?`, thread.Slug).Scan(&existingID)
+		if err == nil {
+			return nil, fmt.Errorf("channel slug already exists: %s (id: %s)", thread.Slug, existingID)
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("checking channel slug %s: %w", thread.Slug, err)
+		}
+	}
+
+	result, err := tx.Exec(
+		`INSERT INTO fabric_threads (
+			id, type, created_at, created_by, content, kind, priority, slug, title, purpose,
+			name, media_type, size_bytes, storage_uri, mentions, participants, meta, archived_at, edits, deleted_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		thread.ID, thread.Type, unixNano(thread.CreatedAt), thread.CreatedBy, thread.Content, thread.Kind, thread.Priority,
+		thread.Slug, thread.Title, thread.Purpose,
+		thread.Name, thread.MediaType, thread.SizeBytes, thread.StorageURI,
+		mentions, participants, meta, nullableTime(thread.ArchivedAt), edits, nullableTime(thread.DeletedAt),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("inserting thread %s: %w", thread.ID, err)
+	}
+	if thread.Seq, err = result.LastInsertId(); err != nil {
+		return nil, fmt.Errorf("getting thread seq: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing thread %s: %w", thread.ID, err)
+	}
+	return &thread, nil
+}
+
+// Get retrieves a thread by ID.
+func (r *SQLiteThreadRepository) Get(id string) (*domain.Thread, error) {
+	thread, err := scanThread(r.db.QueryRow(`SELECT `+threadColumns+` FROM fabric_threads WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("thread not found: %s", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting thread %s: %w", id, err)
+	}
+	return thread, nil
+}
+
+// GetBySlug finds a channel thread by its slug.
+func (r *SQLiteThreadRepository) GetBySlug(slug string) (*domain.Thread, error) {
+	thread, err := scanThread(r.db.QueryRow(
+		`SELECT `+threadColumns+` FROM fabric_threads WHERE type = 'channel' AND slug = ?`, slug))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("channel not found: %s", slug)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting channel %s: %w", slug, err)
+	}
+	return thread, nil
+}
+
+// List returns threads matching the filter criteria, ordered by Seq.
+func (r *SQLiteThreadRepository) List(opts ListOptions) ([]domain.Thread, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if opts.Type != nil {
+		where = append(where, "type = ?")
+		args = append(args, *opts.Type)
+	}
+	if opts.AfterSeq > 0 {
+		where = append(where, "seq > ?")
+		args = append(args, opts.AfterSeq)
+	}
+	if opts.CreatedBy != nil {
+		where = append(where, "created_by = ?")
+		args = append(args, *opts.CreatedBy)
+	}
+
+	query := `SELECT ` + threadColumns + ` FROM fabric_threads`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY seq"
+	// Mentions are JSON encoded, so that filter runs after the query and the limit with it
+	if opts.Limit > 0 && opts.HasMention == nil {
+		query += " LIMIT ?"
+		args = append(args, opts.Limit)
+	}
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing threads: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var results []domain.Thread
+	for rows.Next() {
+		thread, err := scanThread(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning thread: %w", err)
+		}
+		if opts.HasMention != nil && !thread.HasMention(*opts.HasMention) {
+			continue
+		}
+		results = append(results, *thread)
+		if opts.Limit > 0 && len(results) == opts.Limit {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing threads: %w", err)
+	}
+
+	return results, nil
+}
+
+// Update modifies an existing thread. Seq and CreatedAt are preserved.
+func (r *SQLiteThreadRepository) Update(thread domain.Thread) (*domain.Thread, error) {
+	mentions, participants, meta, edits, err := threadJSONColumns(thread)
+	if err != nil {
+		return nil, fmt.Errorf("encoding thread %s: %w", thread.ID, err)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var createdAt int64
+	err = tx.QueryRow(`SELECT seq, created_at FROM fabric_threads WHERE id = ?`, thread.ID).Scan(&thread.Seq, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("thread not found: %s", thread.ID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting thread %s: %w", thread.ID, err)
+	}
+	thread.CreatedAt = fromUnixNano(createdAt)
+
+	_, err = tx.Exec(
+		`UPDATE fabric_threads SET
+			type = ?, created_by = ?, content = ?, kind = ?, priority = ?, slug = ?, title = ?, purpose = ?,
+			name = ?, media_type = ?, size_bytes = ?, storage_uri = ?,
+			mentions = ?, participants = ?, meta = ?, archived_at = ?, edits = ?, deleted_at = ?
+		WHERE id = ?`,
+		thread.Type, thread.CreatedBy, thread.Content, thread.Kind, thread.Priority, thread.Slug, thread.Title, thread.Purpose,
+		thread.Name, thread.MediaType, thread.SizeBytes, thread.StorageURI,
+		mentions, participants, meta, nullableTime(thread.ArchivedAt), edits, nullableTime(thread.DeletedAt),
+		thread.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("updating thread %s: %w", thread.ID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing thread %s: %w", thread.ID, err)
+	}
+	return &thread, nil
+}
+
+// Archive soft-deletes a thread.
+func (r *SQLiteThreadRepository) Archive(id string) error {
+	result, err := r.db.Exec(`UPDATE fabric_threads SET archived_at = ? WHERE id = ?`, unixNano(time.Now()), id)
+	if err != nil {
+		return fmt.Errorf("archiving thread %s: %w", id, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("archiving thread %s: %w", id, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("thread not found: %s", id)
+	}
+	return nil
+}
+
+var _ ThreadRepository = (*SQLiteThreadRepository)(nil)
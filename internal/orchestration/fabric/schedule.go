@@ -0,0 +1,166 @@
+package fabric
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+// MetaScheduledID is the message meta key linking a message to the scheduled
+// message it was posted from. Scheduled messages notify their sender when they
+// @mention it, so agents can schedule reminders to themselves.
+const MetaScheduledID = "scheduled_id"
+
+// MessageSender posts the messages the broker scheduled once they are due.
+// Implemented by Service.
+type MessageSender interface {
+	SendMessage(input SendMessageInput) (*domain.Thread, error)
+}
+
+// Schedule holds a message until deliverAt and then posts it with the sender.
+// Scheduled messages are kept in memory only; they are dropped when the broker stops.
+func (b *Broker) Schedule(input SendMessageInput, deliverAt time.Time) (domain.ScheduledMessage, error) {
+	if b.sender == nil {
+		return domain.ScheduledMessage{}, fmt.Errorf("scheduled messages are not enabled")
+	}
+	now := b.clock.Now()
+	if !deliverAt.After(now) {
+		return domain.ScheduledMessage{}, fmt.Errorf("delivery time %s is not in the future", deliverAt.Format(time.RFC3339))
+	}
+
+	b.mu.Lock()
+	b.scheduleSeq++
+	msg := &domain.ScheduledMessage{
+		ID:          fmt.Sprintf("sched-%d", b.scheduleSeq),
+		ChannelSlug: input.ChannelSlug,
+		Content:     input.Content,
+		Kind:        input.Kind,
+		Priority:    input.Priority,
+		CreatedBy:   input.CreatedBy,
+		CreatedAt:   now,
+		DeliverAt:   deliverAt,
+	}
+	b.scheduled[msg.ID] = msg
+	b.resetScheduleTimer()
+	b.mu.Unlock()
+
+	b.wakeLoop()
+	return *msg, nil
+}
+
+// CancelScheduled drops a scheduled message before it is delivered.
+// Only the agent that scheduled the message can cancel it.
+func (b *Broker) CancelScheduled(id, agentID string) (domain.ScheduledMessage, error) {
+	b.mu.Lock()
+	msg, ok := b.scheduled[id]
+	if !ok {
+		b.mu.Unlock()
+		return domain.ScheduledMessage{}, fmt.Errorf("scheduled message %s not found (already delivered or cancelled)", id)
+	}
+	if msg.CreatedBy != agentID {
+		b.mu.Unlock()
+		return domain.ScheduledMessage{}, fmt.Errorf("scheduled message %s was scheduled by %s", id, msg.CreatedBy)
+	}
+	delete(b.scheduled, id)
+	b.resetScheduleTimer()
+	b.mu.Unlock()
+
+	b.wakeLoop()
+	return *msg, nil
+}
+
+// Scheduled returns the messages waiting for delivery, earliest first.
+func (b *Broker) Scheduled() []domain.ScheduledMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	msgs := make([]domain.ScheduledMessage, 0, len(b.scheduled))
+	for _, msg := range b.scheduled {
+		msgs = append(msgs, *msg)
+	}
+	slices.SortFunc(msgs, compareScheduled)
+	return msgs
+}
+
+// compareScheduled orders scheduled messages by delivery time, then by
+// scheduling time.
+func compareScheduled(a, b domain.ScheduledMessage) int {
+	if c := a.DeliverAt.Compare(b.DeliverAt); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.CreatedAt.UnixNano(), b.CreatedAt.UnixNano())
+}
+
+// resetScheduleTimer points the schedule timer at the earliest scheduled
+// message, or clears it if nothing is scheduled. Caller must hold b.mu.
+func (b *Broker) resetScheduleTimer() {
+	if b.scheduleTimer != nil {
+		b.scheduleTimer.Stop()
+		b.scheduleTimer = nil
+	}
+
+	var next time.Time
+	for _, msg := range b.scheduled {
+		if next.IsZero() || msg.DeliverAt.Before(next) {
+			next = msg.DeliverAt
+		}
+	}
+	if !next.IsZero() {
+		b.scheduleTimer = b.clock.NewTimer(max(next.Sub(b.clock.Now()), 0))
+	}
+}
+
+// scheduleTimerChan returns the schedule timer's channel, or nil if nothing is scheduled.
+func (b *Broker) scheduleTimerChan() <-chan time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.scheduleTimer != nil {
+		return b.scheduleTimer.C()
+	}
+	return nil
+}
+
+// wakeLoop makes the event loop pick up a changed schedule timer.
+func (b *Broker) wakeLoop() {
+	select {
+	case b.wake <- struct{}{}:
+	default:
+		// A wake-up is already pending
+	}
+}
+
+// deliverDue posts the scheduled messages that are due.
+func (b *Broker) deliverDue() {
+	now := b.clock.Now()
+
+	b.mu.Lock()
+	var due []domain.ScheduledMessage
+	for id, msg := range b.scheduled {
+		if !msg.DeliverAt.After(now) {
+			due = append(due, *msg)
+			delete(b.scheduled, id)
+		}
+	}
+	b.resetScheduleTimer()
+	b.mu.Unlock()
+
+	slices.SortFunc(due, compareScheduled)
+	for _, msg := range due {
+		_, err := b.sender.SendMessage(SendMessageInput{
+			ChannelSlug: msg.ChannelSlug,
+			Content:     msg.Content,
+			Kind:        msg.Kind,
+			Priority:    msg.Priority,
+			CreatedBy:   msg.CreatedBy,
+			Meta:        map[string]string{MetaScheduledID: msg.ID},
+		})
+		if err != nil {
+			log.Warn(log.CatOrch, "Failed to deliver scheduled fabric message",
+				"id", msg.ID, "channel", msg.ChannelSlug, "error", err)
+		}
+	}
+}
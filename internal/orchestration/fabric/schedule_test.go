@@ -0,0 +1,152 @@
+package fabric
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// newScheduleTestBroker wires a broker to a service the way the supervisor does.
+func newScheduleTestBroker(t *testing.T) (*Broker, *Service, *mockCommandSubmitter) {
+	t.Helper()
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	submitter := &mockCommandSubmitter{}
+	broker := NewBroker(BrokerConfig{
+		CmdSubmitter:  submitter,
+		Subscriptions: svc.SubscriptionRepository(),
+		SlugLookup:    svc,
+		Sender:        svc,
+		Debounce:      10 * time.Millisecond,
+	})
+	svc.SetEventHandler(broker.HandleEvent)
+	svc.SetScheduler(broker)
+
+	broker.Start()
+	t.Cleanup(broker.Stop)
+	return broker, svc, submitter
+}
+
+// nudgedAgents returns the agents the submitted commands nudged.
+func nudgedAgents(submitter *mockCommandSubmitter) []string {
+	var agents []string
+	for _, cmd := range submitter.getCommands() {
+		if sendCmd, ok := cmd.(*command.SendToProcessCommand); ok {
+			agents = append(agents, sendCmd.ProcessID)
+		}
+	}
+	return agents
+}
+
+func TestBroker_ScheduledMessageDeliveredWhenDue(t *testing.T) {
+	broker, svc, submitter := newScheduleTestBroker(t)
+
+	later, err := svc.ScheduleMessage(SendMessageInput{
+		ChannelSlug: domain.SlugGeneral,
+		Content:     "later",
+		CreatedBy:   "coordinator",
+	}, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	soon, err := svc.ScheduleMessage(SendMessageInput{
+		ChannelSlug: domain.SlugGeneral,
+		Content:     "@worker-1 status?",
+		CreatedBy:   "coordinator",
+	}, time.Now().Add(20*time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, domain.KindInfo, soon.Kind)
+
+	scheduled := broker.Scheduled()
+	require.Len(t, scheduled, 2)
+	require.Equal(t, soon.ID, scheduled[0].ID, "earliest first")
+
+	// Nothing is posted before the delivery time
+	history, err := svc.ListMessages(domain.SlugGeneral, 0)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	require.Eventually(t, func() bool {
+		return slices.Contains(nudgedAgents(submitter), "worker-1")
+	}, time.Second, 5*time.Millisecond)
+
+	history, err = svc.ListMessages(domain.SlugGeneral, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, "@worker-1 status?", history[0].Content)
+	assert.Equal(t, "coordinator", history[0].CreatedBy)
+	assert.Equal(t, soon.ID, history[0].Meta[MetaScheduledID])
+
+	scheduled = broker.Scheduled()
+	require.Len(t, scheduled, 1)
+	assert.Equal(t, later.ID, scheduled[0].ID)
+}
+
+func TestBroker_ScheduledReminderNotifiesSender(t *testing.T) {
+	_, svc, submitter := newScheduleTestBroker(t)
+
+	_, err := svc.ScheduleMessage(SendMessageInput{
+		ChannelSlug: domain.SlugGeneral,
+		Content:     "@coordinator check on worker-3's migration",
+		CreatedBy:   "coordinator",
+	}, time.Now().Add(10*time.Millisecond))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return slices.Contains(nudgedAgents(submitter), "coordinator")
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestBroker_CancelScheduled(t *testing.T) {
+	broker, svc, submitter := newScheduleTestBroker(t)
+
+	msg, err := svc.ScheduleMessage(SendMessageInput{
+		ChannelSlug: domain.SlugGeneral,
+		Content:     "@worker-1 status?",
+		CreatedBy:   "coordinator",
+	}, time.Now().Add(30*time.Millisecond))
+	require.NoError(t, err)
+
+	// Only the sender can cancel
+	_, err = svc.CancelScheduledMessage(msg.ID, "worker-1")
+	require.ErrorContains(t, err, "was scheduled by coordinator")
+
+	cancelled, err := svc.CancelScheduledMessage(msg.ID, "coordinator")
+	require.NoError(t, err)
+	assert.Equal(t, "@worker-1 status?", cancelled.Content)
+	assert.Empty(t, broker.Scheduled())
+
+	_, err = svc.CancelScheduledMessage(msg.ID, "coordinator")
+	require.ErrorContains(t, err, "not found")
+
+	time.Sleep(60 * time.Millisecond)
+	assert.Empty(t, submitter.getCommands())
+	history, err := svc.ListMessages(domain.SlugGeneral, 0)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func TestService_ScheduleMessage_Validation(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	input := SendMessageInput{ChannelSlug: domain.SlugGeneral, Content: "hi", CreatedBy: "coordinator"}
+	_, err := svc.ScheduleMessage(input, time.Now().Add(time.Minute))
+	require.ErrorContains(t, err, "not enabled")
+	_, err = svc.CancelScheduledMessage("sched-1", "coordinator")
+	require.ErrorContains(t, err, "not enabled")
+
+	broker := NewBroker(BrokerConfig{Sender: svc})
+	svc.SetScheduler(broker)
+
+	_, err = svc.ScheduleMessage(SendMessageInput{ChannelSlug: "missing", Content: "hi"}, time.Now().Add(time.Minute))
+	require.ErrorContains(t, err, "unknown channel")
+	_, err = svc.ScheduleMessage(SendMessageInput{ChannelSlug: domain.SlugGeneral, Content: "hi", Priority: "asap"}, time.Now().Add(time.Minute))
+	require.ErrorContains(t, err, "invalid priority")
+	_, err = svc.ScheduleMessage(input, time.Now().Add(-time.Minute))
+	require.ErrorContains(t, err, "not in the future")
+}
@@ -2,12 +2,15 @@ package fabric
 
 import (
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
@@ -22,6 +25,7 @@ type Service struct {
 	acks          repository.AckRepository
 	participants  repository.ParticipantRepository
 	reactions     repository.ReactionRepository
+	receipts      repository.ReceiptRepository
 
 	// Channel IDs for the fixed structure
 	rootID     string
@@ -30,9 +34,26 @@ type Service struct {
 	planningID string
 	generalID  string
 	observerID string
+	memoryID   string // empty unless InitMemoryChannel was called
 
 	// Event handler (optional)
 	onEvent func(Event)
+
+	// Scheduler holding delayed messages (optional)
+	scheduler MessageScheduler
+
+	// Thread versions, bumped on every change a thread reader would see
+	versionMu sync.Mutex
+	versions  map[string]ThreadVersion
+}
+
+// ThreadVersion counts the changes to a message's thread since the service
+// started. Clients keeping a copy of a thread compare versions to tell which
+// parts of their copy are stale.
+type ThreadVersion struct {
+	Replies   uint64 // Replies posted to the message
+	Edits     uint64 // Edits and deletes of the message or one of its replies
+	Artifacts uint64 // Artifacts attached to the message
 }
 
 // NewService creates a new Fabric service.
@@ -50,6 +71,8 @@ func NewService(
 		acks:          acks,
 		participants:  participants,
 		reactions:     repository.NewInMemoryReactionRepository(),
+		receipts:      repository.NewInMemoryReceiptRepository(),
+		versions:      make(map[string]ThreadVersion),
 	}
 }
 
@@ -58,6 +81,18 @@ func (s *Service) SetEventHandler(handler func(Event)) {
 	s.onEvent = handler
 }
 
+// MessageScheduler holds messages until their delivery time.
+// Implemented by Broker.
+type MessageScheduler interface {
+	Schedule(input SendMessageInput, deliverAt time.Time) (domain.ScheduledMessage, error)
+	CancelScheduled(id, agentID string) (domain.ScheduledMessage, error)
+}
+
+// SetScheduler sets the scheduler that holds messages sent with ScheduleMessage.
+func (s *Service) SetScheduler(scheduler MessageScheduler) {
+	s.scheduler = scheduler
+}
+
 // SubscriptionRepository returns the subscription repository for external use (e.g., FabricBroker).
 func (s *Service) SubscriptionRepository() repository.SubscriptionRepository {
 	return s.subscriptions
@@ -146,6 +181,72 @@ func (s *Service) InitSession(createdBy string) error {
 	return nil
 }
 
+// InitMemoryChannel creates the optional #memory channel and seeds it with
+// entries recorded by earlier sessions. Must be called after InitSession.
+//
+// History threads keep their original IDs so pins and replies recorded across
+// sessions keep pointing at the same messages. Threads that already exist
+// (e.g., restored with the session) are skipped, and imported threads are
+// acked for createdBy so old entries don't show up as unread. No events are
+// emitted for imported history - it is already recorded outside the session.
+func (s *Service) InitMemoryChannel(createdBy string, history []domain.Thread, pins []domain.Reaction) error {
+	if s.rootID == "" {
+		return fmt.Errorf("init memory channel: session not initialized")
+	}
+
+	channel, err := s.threads.GetBySlug(domain.SlugMemory)
+	if err != nil || channel == nil {
+		ch := domain.MemoryChannel()
+		ch.CreatedBy = createdBy
+		ch.CreatedAt = time.Now()
+
+		channel, err = s.threads.Create(ch)
+		if err != nil {
+			return fmt.Errorf("create channel %s: %w", domain.SlugMemory, err)
+		}
+		s.emit(NewChannelCreatedEvent(channel))
+	}
+	s.memoryID = channel.ID
+
+	if err := s.dependencies.Add(domain.NewDependency(s.memoryID, s.rootID, domain.RelationChildOf)); err != nil {
+		return fmt.Errorf("link memory channel: %w", err)
+	}
+
+	for _, agentID := range []string{createdBy, "observer"} {
+		if _, err := s.subscriptions.Subscribe(s.memoryID, agentID, domain.ModeAll); err != nil {
+			return fmt.Errorf("subscribe %s to memory: %w", agentID, err)
+		}
+	}
+
+	var imported []string
+	for _, thread := range history {
+		if existing, err := s.threads.Get(thread.ID); err == nil && existing != nil {
+			continue
+		}
+		thread.Type = domain.ThreadMessage
+		if _, err := s.threads.Create(thread); err != nil {
+			return fmt.Errorf("import memory entry %s: %w", thread.ID, err)
+		}
+		if err := s.dependencies.Add(domain.NewDependency(thread.ID, s.memoryID, domain.RelationChildOf)); err != nil {
+			return fmt.Errorf("link memory entry %s: %w", thread.ID, err)
+		}
+		imported = append(imported, thread.ID)
+	}
+	if len(imported) > 0 {
+		if err := s.acks.Ack(createdBy, imported...); err != nil {
+			return fmt.Errorf("ack memory history: %w", err)
+		}
+	}
+
+	for _, pin := range pins {
+		if _, err := s.reactions.Add(pin.ThreadID, pin.AgentID, pin.Emoji); err != nil {
+			return fmt.Errorf("restore pin on %s: %w", pin.ThreadID, err)
+		}
+	}
+
+	return nil
+}
+
 // RestoreChannelIDs restores the cached channel IDs after session restoration.
 // This should be called after RestoreFabricState has populated the repositories.
 // It looks up each fixed channel by slug and caches its ID for fast access.
@@ -157,6 +258,7 @@ func (s *Service) RestoreChannelIDs() error {
 		domain.SlugPlanning,
 		domain.SlugGeneral,
 		domain.SlugObserver,
+		domain.SlugMemory,
 	}
 
 	for _, slug := range slugs {
@@ -179,6 +281,8 @@ func (s *Service) RestoreChannelIDs() error {
 			s.generalID = thread.ID
 		case domain.SlugObserver:
 			s.observerID = thread.ID
+		case domain.SlugMemory:
+			s.memoryID = thread.ID
 		}
 	}
 
@@ -269,8 +373,18 @@ func (s *Service) GetChannelID(slug string) string {
 		return s.generalID
 	case domain.SlugObserver:
 		return s.observerID
+	case domain.SlugMemory:
+		return s.memoryID
 	default:
-		return ""
+		// Channels created at runtime are looked up by slug; archived ones are gone
+		if slug == "" {
+			return ""
+		}
+		channel, err := s.threads.GetBySlug(slug)
+		if err != nil || channel == nil || channel.IsArchived() {
+			return ""
+		}
+		return channel.ID
 	}
 }
 
@@ -290,8 +404,154 @@ func (s *Service) GetChannelSlug(channelID string) string {
 	case s.observerID:
 		return domain.SlugObserver
 	default:
-		return ""
+		if channelID == "" {
+			return ""
+		}
+		if channelID == s.memoryID {
+			return domain.SlugMemory
+		}
+		channel, err := s.threads.Get(channelID)
+		if err != nil || channel == nil || channel.Type != domain.ThreadChannel {
+			return ""
+		}
+		return channel.Slug
+	}
+}
+
+// channelSlugPattern constrains the slugs of channels created at runtime.
+var channelSlugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,39}$`)
+
+// CreateChannelInput contains parameters for creating a channel.
+type CreateChannelInput struct {
+	Slug      string
+	Title     string // Optional - defaults to the slug
+	Purpose   string
+	CreatedBy string
+}
+
+// CreateChannel creates a channel at runtime, e.g. a per-epic or per-topic
+// channel. The channel becomes a child of #root; its creator and the observer
+// are subscribed to it. Slugs of archived channels cannot be reused.
+func (s *Service) CreateChannel(input CreateChannelInput) (*domain.Thread, error) {
+	if s.rootID == "" {
+		return nil, fmt.Errorf("create channel: session not initialized")
+	}
+	slug := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(input.Slug), "#"))
+	if !channelSlugPattern.MatchString(slug) {
+		return nil, fmt.Errorf("invalid channel slug: %q (use lowercase letters, digits and dashes)", input.Slug)
+	}
+	if existing, err := s.threads.GetBySlug(slug); err == nil && existing != nil {
+		if existing.IsArchived() {
+			return nil, fmt.Errorf("channel #%s was archived and cannot be recreated", slug)
+		}
+		return nil, fmt.Errorf("channel #%s already exists", slug)
+	}
+
+	title := input.Title
+	if title == "" {
+		title = slug
+	}
+	channel, err := s.threads.Create(domain.Thread{
+		Type:      domain.ThreadChannel,
+		Slug:      slug,
+		Title:     title,
+		Purpose:   input.Purpose,
+		CreatedBy: input.CreatedBy,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create channel %s: %w", slug, err)
+	}
+	if err := s.dependencies.Add(domain.NewDependency(channel.ID, s.rootID, domain.RelationChildOf)); err != nil {
+		return nil, fmt.Errorf("link channel %s: %w", slug, err)
+	}
+	s.emit(NewChannelCreatedEvent(channel))
+
+	for _, agentID := range []string{input.CreatedBy, "observer"} {
+		if _, err := s.Subscribe(slug, agentID, domain.ModeAll); err != nil {
+			return nil, fmt.Errorf("subscribe %s to %s: %w", agentID, slug, err)
+		}
 	}
+
+	return channel, nil
+}
+
+// ArchiveChannel archives a channel created at runtime. Its messages stay
+// readable by ID, but the channel no longer accepts messages or subscriptions.
+// The fixed session channels cannot be archived.
+func (s *Service) ArchiveChannel(slug, agentID string) (*domain.Thread, error) {
+	slug = strings.TrimPrefix(strings.TrimSpace(slug), "#")
+	if domain.IsFixedChannel(slug) {
+		return nil, fmt.Errorf("channel #%s is a session channel and cannot be archived", slug)
+	}
+	channelID := s.GetChannelID(slug)
+	if channelID == "" {
+		return nil, fmt.Errorf("unknown channel: %s", slug)
+	}
+
+	subs, err := s.subscriptions.ListForChannel(channelID)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions of %s: %w", slug, err)
+	}
+	if err := s.threads.Archive(channelID); err != nil {
+		return nil, fmt.Errorf("archive channel %s: %w", slug, err)
+	}
+	for _, sub := range subs {
+		if err := s.subscriptions.Unsubscribe(channelID, sub.AgentID); err == nil {
+			s.emit(NewUnsubscribedEvent(channelID, slug, sub.AgentID))
+		}
+	}
+
+	channel, err := s.threads.Get(channelID)
+	if err != nil {
+		return nil, fmt.Errorf("get channel %s: %w", slug, err)
+	}
+	event := NewChannelArchivedEvent(channelID, slug)
+	event.AgentID = agentID
+	s.emit(event)
+	return channel, nil
+}
+
+// ChannelInfo summarizes a channel for listing.
+type ChannelInfo struct {
+	Channel      domain.Thread
+	Fixed        bool // One of the session channels created by InitSession
+	MessageCount int
+	Subscribers  []string
+}
+
+// ListChannels returns the session's channels in creation order, #root
+// excluded. Archived channels are only included when includeArchived is set.
+func (s *Service) ListChannels(includeArchived bool) ([]ChannelInfo, error) {
+	channelType := domain.ThreadChannel
+	channels, err := s.threads.List(repository.ListOptions{Type: &channelType})
+	if err != nil {
+		return nil, fmt.Errorf("list channels: %w", err)
+	}
+
+	childOf := domain.RelationChildOf
+	infos := make([]ChannelInfo, 0, len(channels))
+	for _, channel := range channels {
+		if channel.Slug == domain.SlugRoot || (channel.IsArchived() && !includeArchived) {
+			continue
+		}
+		info := ChannelInfo{Channel: channel, Fixed: domain.IsFixedChannel(channel.Slug)}
+		if deps, err := s.dependencies.GetChildren(channel.ID, &childOf); err == nil {
+			for _, dep := range deps {
+				if thread, err := s.threads.Get(dep.ThreadID); err == nil && thread.Type == domain.ThreadMessage {
+					info.MessageCount++
+				}
+			}
+		}
+		if subs, err := s.subscriptions.ListForChannel(channel.ID); err == nil {
+			for _, sub := range subs {
+				info.Subscribers = append(info.Subscribers, sub.AgentID)
+			}
+			slices.Sort(info.Subscribers)
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
 }
 
 // SendMessageInput contains parameters for sending a message.
@@ -299,6 +559,7 @@ type SendMessageInput struct {
 	ChannelSlug string
 	Content     string
 	Kind        domain.MessageKind
+	Priority    domain.Priority // Optional - empty is normal
 	CreatedBy   string
 	Mentions    []string
 	Meta        map[string]string
@@ -314,6 +575,9 @@ func (s *Service) SendMessage(input SendMessageInput) (*domain.Thread, error) {
 	if input.Kind == "" {
 		input.Kind = domain.KindInfo
 	}
+	if !input.Priority.IsValid() {
+		return nil, fmt.Errorf("invalid priority: %s (must be urgent, normal or low)", input.Priority)
+	}
 
 	// Parse mentions from content if not provided
 	mentions := input.Mentions
@@ -336,6 +600,7 @@ func (s *Service) SendMessage(input SendMessageInput) (*domain.Thread, error) {
 		Type:         domain.ThreadMessage,
 		Content:      input.Content,
 		Kind:         string(input.Kind),
+		Priority:     input.Priority,
 		CreatedBy:    input.CreatedBy,
 		CreatedAt:    time.Now(),
 		Mentions:     mentions,
@@ -358,11 +623,72 @@ func (s *Service) SendMessage(input SendMessageInput) (*domain.Thread, error) {
 	return created, nil
 }
 
+// ScheduleMessage holds a message until deliverAt, when it is posted with
+// SendMessage. The channel and priority are checked now so mistakes surface
+// to the sender instead of being lost at delivery time.
+func (s *Service) ScheduleMessage(input SendMessageInput, deliverAt time.Time) (domain.ScheduledMessage, error) {
+	if s.scheduler == nil {
+		return domain.ScheduledMessage{}, fmt.Errorf("scheduled messages are not enabled")
+	}
+	if s.GetChannelID(input.ChannelSlug) == "" {
+		return domain.ScheduledMessage{}, fmt.Errorf("unknown channel: %s", input.ChannelSlug)
+	}
+	if input.Kind == "" {
+		input.Kind = domain.KindInfo
+	}
+	if !input.Priority.IsValid() {
+		return domain.ScheduledMessage{}, fmt.Errorf("invalid priority: %s (must be urgent, normal or low)", input.Priority)
+	}
+	return s.scheduler.Schedule(input, deliverAt)
+}
+
+// CancelScheduledMessage drops a message scheduled by agentID before it is delivered.
+func (s *Service) CancelScheduledMessage(id, agentID string) (domain.ScheduledMessage, error) {
+	if s.scheduler == nil {
+		return domain.ScheduledMessage{}, fmt.Errorf("scheduled messages are not enabled")
+	}
+	return s.scheduler.CancelScheduled(id, agentID)
+}
+
+// broadcastChannels lists the message channels that receive session-wide broadcasts.
+var broadcastChannels = []string{
+	domain.SlugSystem,
+	domain.SlugTasks,
+	domain.SlugPlanning,
+	domain.SlugGeneral,
+	domain.SlugObserver,
+}
+
+// Broadcast posts the same message to every message channel with an @here mention.
+// It is used for session-wide announcements such as emergency HALT notices.
+// Channels that fail to receive the message are reported in the returned error,
+// but delivery to the remaining channels still proceeds.
+func (s *Service) Broadcast(content, createdBy string, kind domain.MessageKind) ([]*domain.Thread, error) {
+	posted := make([]*domain.Thread, 0, len(broadcastChannels))
+	var errs []error
+	for _, slug := range broadcastChannels {
+		msg, err := s.SendMessage(SendMessageInput{
+			ChannelSlug: slug,
+			Content:     content,
+			Kind:        kind,
+			CreatedBy:   createdBy,
+			Mentions:    []string{domain.MentionHere},
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("broadcast to %s: %w", slug, err))
+			continue
+		}
+		posted = append(posted, msg)
+	}
+	return posted, errors.Join(errs...)
+}
+
 // ReplyInput contains parameters for replying to a message.
 type ReplyInput struct {
 	MessageID string
 	Content   string
 	Kind      domain.MessageKind
+	Priority  domain.Priority // Optional - empty is normal
 	CreatedBy string
 	Mentions  []string
 	Meta      map[string]string
@@ -372,6 +698,10 @@ type ReplyInput struct {
 // TODO: Currently all replies are flattened to point to the root message (single-level threading).
 // Future enhancement: support configurable nesting depth or true nested threading.
 func (s *Service) Reply(input ReplyInput) (*domain.Thread, error) {
+	if !input.Priority.IsValid() {
+		return nil, fmt.Errorf("invalid priority: %s (must be urgent, normal or low)", input.Priority)
+	}
+
 	parent, err := s.threads.Get(input.MessageID)
 	if err != nil {
 		return nil, fmt.Errorf("get parent message: %w", err)
@@ -409,6 +739,7 @@ func (s *Service) Reply(input ReplyInput) (*domain.Thread, error) {
 		Type:      domain.ThreadMessage,
 		Content:   input.Content,
 		Kind:      string(input.Kind),
+		Priority:  input.Priority,
 		CreatedBy: input.CreatedBy,
 		CreatedAt: time.Now(),
 		Mentions:  mentions,
@@ -441,12 +772,102 @@ func (s *Service) Reply(input ReplyInput) (*domain.Thread, error) {
 	channelID := s.findChannelForMessage(rootID)
 	channelSlug := s.GetChannelSlug(channelID)
 
+	s.bumpVersion(rootID, func(v *ThreadVersion) { v.Replies++ })
+
 	// Pass root's participants so broker can notify them of the reply
 	s.emit(NewReplyPostedEvent(created, channelID, channelSlug, rootID, root.Participants))
 
 	return created, nil
 }
 
+// EditMessage replaces the content of a message or reply. Only the author can
+// edit a message; the previous content is kept in the message's edit history.
+// Mentions are re-parsed from the new content.
+func (s *Service) EditMessage(messageID, agentID, content string) (*domain.Thread, error) {
+	if content == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+
+	msg, err := s.authoredMessage(messageID, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Revise(content, time.Now())
+	msg.Mentions = parseMentions(content)
+	for _, m := range msg.Mentions {
+		if m != domain.MentionHere && m != domain.AgentUser {
+			msg.AddParticipant(m)
+		}
+	}
+
+	updated, err := s.threads.Update(*msg)
+	if err != nil {
+		return nil, fmt.Errorf("update message: %w", err)
+	}
+
+	s.bumpEdits(messageID)
+	channelID, channelSlug := s.messageChannel(messageID)
+	s.emit(NewMessageEditedEvent(updated, channelID, channelSlug))
+
+	return updated, nil
+}
+
+// DeleteMessage soft-deletes a message or reply. Only the author can delete a
+// message. The thread stays in place as a tombstone so replies keep their
+// parent; its content moves to the edit history and its mentions are cleared,
+// so it no longer shows up in inboxes or expects a reply.
+func (s *Service) DeleteMessage(messageID, agentID string) (*domain.Thread, error) {
+	msg, err := s.authoredMessage(messageID, agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	msg.Revise(domain.DeletedContent, now)
+	msg.Mentions = nil
+	msg.DeletedAt = &now
+
+	updated, err := s.threads.Update(*msg)
+	if err != nil {
+		return nil, fmt.Errorf("update message: %w", err)
+	}
+
+	s.bumpEdits(messageID)
+	channelID, channelSlug := s.messageChannel(messageID)
+	s.emit(NewMessageDeletedEvent(updated, channelID, channelSlug))
+
+	return updated, nil
+}
+
+// authoredMessage returns a message that agentID may edit or delete.
+func (s *Service) authoredMessage(messageID, agentID string) (*domain.Thread, error) {
+	msg, err := s.threads.Get(messageID)
+	if err != nil {
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+	if msg.Type != domain.ThreadMessage {
+		return nil, fmt.Errorf("can only modify messages, got %s", msg.Type)
+	}
+	if msg.CreatedBy != agentID {
+		return nil, fmt.Errorf("message %s was posted by %s, only the author can modify it", messageID, msg.CreatedBy)
+	}
+	if msg.IsDeleted() {
+		return nil, fmt.Errorf("message %s is deleted", messageID)
+	}
+	return msg, nil
+}
+
+// messageChannel returns the channel of a message or reply.
+func (s *Service) messageChannel(messageID string) (channelID, channelSlug string) {
+	rootID := s.findThreadRoot(messageID)
+	if rootID == "" {
+		rootID = messageID
+	}
+	channelID = s.findChannelForMessage(rootID)
+	return channelID, s.GetChannelSlug(channelID)
+}
+
 // findThreadRoot traverses reply_to edges to find the root message of a thread.
 // Returns the root message ID, or empty string if messageID has no parent.
 func (s *Service) findThreadRoot(messageID string) string {
@@ -524,6 +945,7 @@ func (s *Service) AttachArtifact(input AttachArtifactInput) (*domain.Thread, err
 		return nil, fmt.Errorf("add artifact dependency: %w", err)
 	}
 
+	s.bumpVersion(input.TargetID, func(v *ThreadVersion) { v.Artifacts++ })
 	s.emit(NewArtifactAddedEvent(created, input.TargetID))
 
 	return created, nil
@@ -610,6 +1032,47 @@ func (s *Service) GetReplies(messageID string) ([]domain.Thread, error) {
 	return replies, nil
 }
 
+// GetReplyIDs returns the IDs of all replies to a message, in the order they
+// were posted. It is cheaper than GetReplies when only new replies are needed.
+func (s *Service) GetReplyIDs(messageID string) ([]string, error) {
+	relation := domain.RelationReplyTo
+	deps, err := s.dependencies.GetChildren(messageID, &relation)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(deps))
+	for i, dep := range deps {
+		ids[i] = dep.ThreadID
+	}
+	return ids, nil
+}
+
+// ThreadVersion returns the current version of a message's thread.
+func (s *Service) ThreadVersion(messageID string) ThreadVersion {
+	s.versionMu.Lock()
+	defer s.versionMu.Unlock()
+	return s.versions[messageID]
+}
+
+// bumpVersion applies a change to a thread's version.
+func (s *Service) bumpVersion(messageID string, change func(*ThreadVersion)) {
+	s.versionMu.Lock()
+	defer s.versionMu.Unlock()
+	v := s.versions[messageID]
+	change(&v)
+	s.versions[messageID] = v
+}
+
+// bumpEdits records an edit of a message, which changes both its own thread
+// and the thread of its root message if it is a reply.
+func (s *Service) bumpEdits(messageID string) {
+	s.bumpVersion(messageID, func(v *ThreadVersion) { v.Edits++ })
+	if rootID := s.findThreadRoot(messageID); rootID != "" {
+		s.bumpVersion(rootID, func(v *ThreadVersion) { v.Edits++ })
+	}
+}
+
 // GetArtifacts returns all artifacts attached to a channel or message.
 func (s *Service) GetArtifacts(targetID string) ([]domain.Thread, error) {
 	relation := domain.RelationReferences
@@ -710,6 +1173,11 @@ func (s *Service) Ack(agentID string, messageIDs ...string) error {
 	return nil
 }
 
+// IsAcked returns true if the agent has acknowledged the message.
+func (s *Service) IsAcked(agentID, messageID string) (bool, error) {
+	return s.acks.IsAcked(messageID, agentID)
+}
+
 // GetUnacked returns unacked message counts by channel for an agent.
 func (s *Service) GetUnacked(agentID string) (map[string]repository.UnackedSummary, error) {
 	return s.acks.GetUnacked(agentID)
@@ -826,6 +1294,176 @@ func (s *Service) GetReactions(threadID string) ([]domain.ReactionSummary, error
 	return s.reactions.GetSummary(threadID)
 }
 
+// GetThreadParticipants summarizes who has posted in, been mentioned in, and
+// read (acked or reacted to) a message thread. threadID may be the root message
+// or any reply; the summary always covers the whole thread.
+func (s *Service) GetThreadParticipants(threadID string) (*domain.ThreadParticipants, error) {
+	rootID := s.findThreadRoot(threadID)
+	if rootID == "" {
+		rootID = threadID
+	}
+
+	root, err := s.threads.Get(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread: %w", err)
+	}
+	if root.Type != domain.ThreadMessage {
+		return nil, fmt.Errorf("thread %s is not a message", rootID)
+	}
+
+	replies, err := s.GetReplies(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("get replies: %w", err)
+	}
+	messages := append([]domain.Thread{*root}, replies...)
+
+	summary := &domain.ThreadParticipants{
+		ThreadID:  rootID,
+		Posters:   []string{},
+		Mentioned: []string{},
+		Acked:     []string{},
+		Pending:   []string{},
+	}
+
+	posted := make(map[string]bool)
+	mentioned := make(map[string]bool)
+	expectsReply := make(map[string]bool) // mentioned by an agent, not just by the system
+	for _, msg := range messages {
+		if msg.CreatedBy != "" && !posted[msg.CreatedBy] {
+			posted[msg.CreatedBy] = true
+			summary.Posters = append(summary.Posters, msg.CreatedBy)
+		}
+		for _, m := range msg.Mentions {
+			if m == domain.MentionHere {
+				continue
+			}
+			if !mentioned[m] {
+				mentioned[m] = true
+				summary.Mentioned = append(summary.Mentioned, m)
+			}
+			if !msg.IsSystem() {
+				expectsReply[m] = true
+			}
+		}
+	}
+
+	// Acks are stored per agent, so check everyone who could plausibly have read
+	// the thread: posters, mentioned agents, thread participants, and registered agents.
+	candidates := slices.Concat(summary.Posters, summary.Mentioned, root.Participants)
+	if registered, err := s.participants.List(); err == nil {
+		for _, p := range registered {
+			candidates = append(candidates, p.AgentID)
+		}
+	}
+
+	acked := make(map[string]bool)
+	for _, agentID := range candidates {
+		if acked[agentID] {
+			continue
+		}
+		for _, msg := range messages {
+			if ok, err := s.acks.IsAcked(msg.ID, agentID); err == nil && ok {
+				acked[agentID] = true
+				summary.Acked = append(summary.Acked, agentID)
+				break
+			}
+		}
+	}
+
+	// Reactions count as reading the message (e.g., 👀 when starting work)
+	for _, msg := range messages {
+		reactions, err := s.reactions.GetSummary(msg.ID)
+		if err != nil {
+			continue
+		}
+		for _, r := range reactions {
+			for _, agentID := range r.AgentIDs {
+				if !acked[agentID] {
+					acked[agentID] = true
+					summary.Acked = append(summary.Acked, agentID)
+				}
+			}
+		}
+	}
+
+	for _, agentID := range summary.Mentioned {
+		if expectsReply[agentID] && !posted[agentID] && !acked[agentID] && agentID != domain.AgentUser {
+			summary.Pending = append(summary.Pending, agentID)
+		}
+	}
+
+	return summary, nil
+}
+
+// MarkDelivered records that the broker notified agentID of the messages.
+// Messages the agent posted itself are skipped.
+func (s *Service) MarkDelivered(agentID string, messageIDs ...string) error {
+	return s.markReceipts(agentID, messageIDs, s.receipts.MarkDelivered)
+}
+
+// MarkRead records that agentID read the messages (fabric_inbox or
+// fabric_read_thread returned them). Messages the agent posted itself are skipped.
+func (s *Service) MarkRead(agentID string, messageIDs ...string) error {
+	return s.markReceipts(agentID, messageIDs, s.receipts.MarkRead)
+}
+
+// markReceipts applies mark to the messages agentID did not post and emits
+// the receipts that changed.
+func (s *Service) markReceipts(agentID string, messageIDs []string,
+	mark func(agentID string, at time.Time, messageIDs ...string) ([]domain.Receipt, error)) error {
+	ids := make([]string, 0, len(messageIDs))
+	for _, id := range messageIDs {
+		if msg, err := s.threads.Get(id); err == nil && msg.CreatedBy != agentID {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	changed, err := mark(agentID, time.Now(), ids...)
+	if err != nil {
+		return fmt.Errorf("mark receipts: %w", err)
+	}
+	if len(changed) > 0 {
+		s.emit(NewReceiptsUpdatedEvent(agentID, changed))
+	}
+	return nil
+}
+
+// GetThreadReceipts returns the delivery and read receipts of every message in
+// a thread. threadID may be the root message or any reply; the receipts always
+// cover the whole thread.
+func (s *Service) GetThreadReceipts(threadID string) (*domain.ThreadReceipts, error) {
+	rootID := s.findThreadRoot(threadID)
+	if rootID == "" {
+		rootID = threadID
+	}
+
+	root, err := s.threads.Get(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread: %w", err)
+	}
+	if root.Type != domain.ThreadMessage {
+		return nil, fmt.Errorf("thread %s is not a message", rootID)
+	}
+
+	replyIDs, err := s.GetReplyIDs(rootID)
+	if err != nil {
+		return nil, fmt.Errorf("get replies: %w", err)
+	}
+
+	result := &domain.ThreadReceipts{ThreadID: rootID, Receipts: []domain.Receipt{}}
+	for _, id := range append([]string{rootID}, replyIDs...) {
+		receipts, err := s.receipts.ListForMessage(id)
+		if err != nil {
+			return nil, fmt.Errorf("list receipts: %w", err)
+		}
+		result.Receipts = append(result.Receipts, receipts...)
+	}
+	return result, nil
+}
+
 // ReactionRepository returns the reaction repository for external use (e.g., persistence).
 func (s *Service) ReactionRepository() repository.ReactionRepository {
 	return s.reactions
@@ -97,6 +97,64 @@ func TestService_SendMessage(t *testing.T) {
 	require.Equal(t, EventMessagePosted, events[0].Type)
 }
 
+func TestService_SendMessage_Priority(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	msg, err := svc.SendMessage(SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Build is broken @worker-1",
+		Priority:    domain.PriorityUrgent,
+		CreatedBy:   "COORDINATOR",
+	})
+	require.NoError(t, err)
+	require.Equal(t, domain.PriorityUrgent, msg.Priority)
+
+	reply, err := svc.Reply(ReplyInput{MessageID: msg.ID, Content: "Looking", Priority: domain.PriorityLow, CreatedBy: "worker-1"})
+	require.NoError(t, err)
+	require.Equal(t, domain.PriorityLow, reply.Priority)
+
+	_, err = svc.SendMessage(SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "hi", Priority: "asap", CreatedBy: "COORDINATOR"})
+	require.EqualError(t, err, "invalid priority: asap (must be urgent, normal or low)")
+	_, err = svc.Reply(ReplyInput{MessageID: msg.ID, Content: "hi", Priority: "asap", CreatedBy: "worker-1"})
+	require.ErrorContains(t, err, "invalid priority")
+}
+
+func TestService_Broadcast(t *testing.T) {
+	svc := newTestService()
+	err := svc.InitSession("system")
+	require.NoError(t, err)
+
+	var events []Event
+	svc.SetEventHandler(func(e Event) {
+		events = append(events, e)
+	})
+
+	posted, err := svc.Broadcast("HALT: emergency stop", "user", domain.KindHalt)
+	require.NoError(t, err)
+	require.Len(t, posted, 5)
+
+	slugs := make([]string, 0, len(events))
+	for i, e := range events {
+		require.Equal(t, EventMessagePosted, e.Type)
+		slugs = append(slugs, e.ChannelSlug)
+		require.Equal(t, string(domain.KindHalt), posted[i].Kind)
+		require.Equal(t, []string{domain.MentionHere}, posted[i].Mentions)
+	}
+	require.ElementsMatch(t, []string{
+		domain.SlugSystem, domain.SlugTasks, domain.SlugPlanning, domain.SlugGeneral, domain.SlugObserver,
+	}, slugs)
+}
+
+func TestService_Broadcast_NoSession(t *testing.T) {
+	svc := newTestService()
+
+	posted, err := svc.Broadcast("HALT", "user", domain.KindHalt)
+	require.Error(t, err)
+	require.Empty(t, posted)
+	require.Contains(t, err.Error(), "unknown channel")
+}
+
 func TestService_Reply(t *testing.T) {
 	svc := newTestService()
 	err := svc.InitSession("system")
@@ -160,6 +218,32 @@ func TestService_GetReplies(t *testing.T) {
 	require.Len(t, replies, 2)
 }
 
+func TestService_ThreadVersion(t *testing.T) {
+	svc := newTestService()
+	err := svc.InitSession("system")
+	require.NoError(t, err)
+
+	msg, err := svc.SendMessage(SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Task: Implement login",
+		CreatedBy:   "COORDINATOR",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ThreadVersion{}, svc.ThreadVersion(msg.ID))
+
+	reply, err := svc.Reply(ReplyInput{MessageID: msg.ID, Content: "Starting work", CreatedBy: "WORKER.1"})
+	require.NoError(t, err)
+	require.Equal(t, ThreadVersion{Replies: 1}, svc.ThreadVersion(msg.ID))
+
+	_, err = svc.EditMessage(reply.ID, "WORKER.1", "Starting work now")
+	require.NoError(t, err)
+	require.Equal(t, ThreadVersion{Replies: 1, Edits: 1}, svc.ThreadVersion(msg.ID), "edits to replies bump the root")
+
+	ids, err := svc.GetReplyIDs(msg.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{reply.ID}, ids)
+}
+
 func TestService_AttachArtifact(t *testing.T) {
 	svc := newTestService()
 	err := svc.InitSession("system")
@@ -380,6 +464,63 @@ func TestService_InitSession_ObserverSubscriptionsIdempotent(t *testing.T) {
 	require.Len(t, subs, 5, "Calling InitSession twice should not create duplicate observer subscriptions")
 }
 
+func TestService_InitMemoryChannel(t *testing.T) {
+	svc := newTestService()
+	require.Error(t, svc.InitMemoryChannel("coordinator", nil, nil), "requires InitSession first")
+	require.NoError(t, svc.InitSession("coordinator"))
+	require.Empty(t, svc.GetChannelID(domain.SlugMemory))
+
+	var events []Event
+	svc.SetEventHandler(func(e Event) {
+		events = append(events, e)
+	})
+
+	history := []domain.Thread{
+		{ID: "mem-1", Type: domain.ThreadMessage, Content: "Use sqlite for fabric", CreatedBy: "coordinator"},
+	}
+	pins := []domain.Reaction{{ThreadID: "mem-1", AgentID: "coordinator", Emoji: domain.PinEmoji}}
+	require.NoError(t, svc.InitMemoryChannel("coordinator", history, pins))
+
+	memoryID := svc.GetChannelID(domain.SlugMemory)
+	require.NotEmpty(t, memoryID)
+	require.Equal(t, domain.SlugMemory, svc.GetChannelSlug(memoryID))
+	require.Empty(t, svc.GetChannelSlug(""))
+
+	// Only the channel creation is emitted; imported history is not re-announced
+	require.Len(t, events, 1)
+	require.Equal(t, EventChannelCreated, events[0].Type)
+
+	// Coordinator and observer are subscribed
+	for _, agentID := range []string{"coordinator", "observer"} {
+		sub, err := svc.subscriptions.Get(memoryID, agentID)
+		require.NoError(t, err)
+		require.NotNil(t, sub)
+		require.Equal(t, domain.ModeAll, sub.Mode)
+	}
+
+	msgs, err := svc.ListMessages(domain.SlugMemory, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, "mem-1", msgs[0].ID)
+
+	reactions, err := svc.GetReactions("mem-1")
+	require.NoError(t, err)
+	require.Len(t, reactions, 1)
+	require.Equal(t, domain.PinEmoji, reactions[0].Emoji)
+
+	// Imported history doesn't count as unread for the coordinator
+	unacked, err := svc.GetUnacked("coordinator")
+	require.NoError(t, err)
+	require.NotContains(t, unacked, memoryID)
+
+	// Idempotent: the channel and existing entries are reused
+	require.NoError(t, svc.InitMemoryChannel("coordinator", history, pins))
+	require.Equal(t, memoryID, svc.GetChannelID(domain.SlugMemory))
+	msgs, err = svc.ListMessages(domain.SlugMemory, 10)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+}
+
 func TestParseMentions(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -813,3 +954,298 @@ func TestService_RestoreChannelIDs_NoChannels(t *testing.T) {
 	// Channel IDs should remain empty
 	require.Empty(t, svc.GetChannelID(domain.SlugRoot))
 }
+
+func TestService_GetThreadParticipants(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	root, err := svc.SendMessage(SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Task: implement login @worker-1 @worker-2 @worker-3 @user @here",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+
+	reply, err := svc.Reply(ReplyInput{
+		MessageID: root.ID,
+		Content:   "On it @coordinator",
+		CreatedBy: "worker-1",
+	})
+	require.NoError(t, err)
+
+	// worker-2 reads the root via ack, worker-4 reacts without being mentioned
+	require.NoError(t, svc.Ack("worker-2", root.ID))
+	_, err = svc.AddReaction(reply.ID, "worker-4", "👀")
+	require.NoError(t, err)
+
+	summary, err := svc.GetThreadParticipants(root.ID)
+	require.NoError(t, err)
+	require.Equal(t, root.ID, summary.ThreadID)
+	require.Equal(t, []string{"coordinator", "worker-1"}, summary.Posters)
+	require.Equal(t, []string{"worker-1", "worker-2", "worker-3", "user", "coordinator"}, summary.Mentioned)
+	require.Equal(t, []string{"worker-2", "worker-4"}, summary.Acked)
+	require.Equal(t, []string{"worker-3"}, summary.Pending, "user and agents who posted or acked are not pending")
+
+	// Looking up by reply ID resolves to the same thread
+	byReply, err := svc.GetThreadParticipants(reply.ID)
+	require.NoError(t, err)
+	require.Equal(t, summary, byReply)
+}
+
+func TestService_GetThreadParticipants_SystemMentionsExpectNoReply(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	root, err := svc.SendMessage(SendMessageInput{
+		ChannelSlug: domain.SlugSystem,
+		Content:     "Token budget at 80% @worker-1 @worker-2",
+		CreatedBy:   domain.AgentSystem,
+	})
+	require.NoError(t, err)
+	_, err = svc.Reply(ReplyInput{
+		MessageID: root.ID,
+		Content:   "@worker-2 please wrap up",
+		CreatedBy: "coordinator",
+	})
+	require.NoError(t, err)
+
+	summary, err := svc.GetThreadParticipants(root.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-1", "worker-2"}, summary.Mentioned)
+	require.Equal(t, []string{"worker-2"}, summary.Pending, "only the coordinator's mention expects a reply")
+}
+
+func TestService_GetThreadParticipants_Errors(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	_, err := svc.GetThreadParticipants("missing")
+	require.Error(t, err)
+
+	channel, err := svc.GetChannel(domain.SlugTasks)
+	require.NoError(t, err)
+	_, err = svc.GetThreadParticipants(channel.ID)
+	require.ErrorContains(t, err, "is not a message")
+}
+
+func TestService_ThreadReceipts(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	root, err := svc.SendMessage(SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "Review perles-abc @worker-1 @worker-2",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+	reply, err := svc.Reply(ReplyInput{
+		MessageID: root.ID,
+		Content:   "Looking now",
+		CreatedBy: "worker-1",
+	})
+	require.NoError(t, err)
+
+	var updates []Event
+	svc.SetEventHandler(func(e Event) {
+		if e.Type == EventReceiptsUpdated {
+			updates = append(updates, e)
+		}
+	})
+
+	// The author of a message gets no receipt for it
+	require.NoError(t, svc.MarkDelivered("worker-1", root.ID, reply.ID))
+	require.NoError(t, svc.MarkDelivered("worker-2", root.ID))
+	require.NoError(t, svc.MarkRead("worker-2", root.ID))
+	require.NoError(t, svc.MarkRead("coordinator", reply.ID))
+	require.Len(t, updates, 4)
+	require.Equal(t, "worker-1", updates[0].AgentID)
+	require.Len(t, updates[0].Receipts, 1)
+
+	// Unchanged receipts and unknown messages emit nothing
+	require.NoError(t, svc.MarkRead("worker-2", root.ID, "missing"))
+	require.Len(t, updates, 4)
+
+	// Looking up by reply ID covers the whole thread
+	receipts, err := svc.GetThreadReceipts(reply.ID)
+	require.NoError(t, err)
+	require.Equal(t, root.ID, receipts.ThreadID)
+	require.Len(t, receipts.Receipts, 3)
+
+	require.Equal(t, root.ID, receipts.Receipts[0].MessageID)
+	require.Equal(t, "worker-1", receipts.Receipts[0].AgentID)
+	require.False(t, receipts.Receipts[0].IsRead())
+	require.Equal(t, "worker-2", receipts.Receipts[1].AgentID)
+	require.True(t, receipts.Receipts[1].IsRead())
+	require.Equal(t, reply.ID, receipts.Receipts[2].MessageID)
+	require.Equal(t, "coordinator", receipts.Receipts[2].AgentID)
+	require.True(t, receipts.Receipts[2].IsRead())
+
+	channel, err := svc.GetChannel(domain.SlugTasks)
+	require.NoError(t, err)
+	_, err = svc.GetThreadReceipts(channel.ID)
+	require.ErrorContains(t, err, "is not a message")
+}
+
+func TestService_EditMessage(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	var events []Event
+	svc.SetEventHandler(func(e Event) {
+		events = append(events, e)
+	})
+
+	msg, err := svc.SendMessage(SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     "@worker-1 start perles-abc",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+
+	edited, err := svc.EditMessage(msg.ID, "coordinator", "@worker-2 start perles-abd")
+	require.NoError(t, err)
+	require.Equal(t, "@worker-2 start perles-abd", edited.Content)
+	require.Equal(t, []string{"worker-2"}, edited.Mentions)
+	require.Equal(t, []string{"coordinator", "worker-1", "worker-2"}, edited.Participants)
+	require.Len(t, edited.Edits, 1)
+	require.Equal(t, "@worker-1 start perles-abc", edited.Edits[0].Content)
+	require.True(t, edited.IsEdited())
+
+	got, err := svc.GetThread(msg.ID)
+	require.NoError(t, err)
+	require.Equal(t, edited.Content, got.Content)
+
+	last := events[len(events)-1]
+	require.Equal(t, EventMessageEdited, last.Type)
+	require.Equal(t, domain.SlugTasks, last.ChannelSlug)
+	require.Equal(t, msg.ID, last.Thread.ID)
+}
+
+func TestService_EditMessage_Errors(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	msg, err := svc.SendMessage(SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "hello", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+
+	_, err = svc.EditMessage(msg.ID, "worker-1", "hijacked")
+	require.ErrorContains(t, err, "only the author can modify it")
+	_, err = svc.EditMessage(msg.ID, "coordinator", "")
+	require.ErrorContains(t, err, "content is required")
+	_, err = svc.EditMessage("missing", "coordinator", "hello")
+	require.Error(t, err)
+
+	channel, err := svc.GetChannel(domain.SlugTasks)
+	require.NoError(t, err)
+	_, err = svc.EditMessage(channel.ID, "system", "renamed")
+	require.ErrorContains(t, err, "can only modify messages")
+
+	_, err = svc.DeleteMessage(msg.ID, "coordinator")
+	require.NoError(t, err)
+	_, err = svc.EditMessage(msg.ID, "coordinator", "back again")
+	require.ErrorContains(t, err, "is deleted")
+	_, err = svc.DeleteMessage(msg.ID, "coordinator")
+	require.ErrorContains(t, err, "is deleted")
+}
+
+func TestService_DeleteMessage(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("system"))
+
+	var events []Event
+	svc.SetEventHandler(func(e Event) {
+		events = append(events, e)
+	})
+
+	root, err := svc.SendMessage(SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "Task @worker-1", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	reply, err := svc.Reply(ReplyInput{MessageID: root.ID, Content: "wrong task id @coordinator", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+
+	deleted, err := svc.DeleteMessage(reply.ID, "worker-1")
+	require.NoError(t, err)
+	require.True(t, deleted.IsDeleted())
+	require.Equal(t, domain.DeletedContent, deleted.Content)
+	require.Empty(t, deleted.Mentions)
+	require.Equal(t, "wrong task id @coordinator", deleted.Edits[0].Content, "content is kept in the edit history")
+
+	// The tombstone stays in the thread
+	replies, err := svc.GetReplies(root.ID)
+	require.NoError(t, err)
+	require.Len(t, replies, 1)
+	require.True(t, replies[0].IsDeleted())
+
+	// Deleted messages leave inboxes and no longer expect a reply
+	unacked, err := svc.GetUnacked("coordinator")
+	require.NoError(t, err)
+	for _, summary := range unacked {
+		require.NotContains(t, summary.ThreadIDs, reply.ID)
+	}
+	summary, err := svc.GetThreadParticipants(root.ID)
+	require.NoError(t, err)
+	require.NotContains(t, summary.Pending, "coordinator")
+
+	last := events[len(events)-1]
+	require.Equal(t, EventMessageDeleted, last.Type)
+	require.Equal(t, domain.SlugTasks, last.ChannelSlug, "replies resolve the channel through their root")
+}
+
+func TestService_CreateChannel(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("coordinator"))
+
+	var events []Event
+	svc.SetEventHandler(func(e Event) { events = append(events, e) })
+
+	channel, err := svc.CreateChannel(CreateChannelInput{Slug: "#Epic-42", Purpose: "Epic 42", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+	require.Equal(t, "epic-42", channel.Slug)
+	require.Equal(t, "epic-42", channel.Title)
+	require.Equal(t, channel.ID, svc.GetChannelID("epic-42"))
+	require.Equal(t, "epic-42", svc.GetChannelSlug(channel.ID))
+	require.Equal(t, EventChannelCreated, events[0].Type)
+
+	_, err = svc.CreateChannel(CreateChannelInput{Slug: "epic-42", CreatedBy: "coordinator"})
+	require.ErrorContains(t, err, "already exists")
+	_, err = svc.CreateChannel(CreateChannelInput{Slug: "no spaces", CreatedBy: "coordinator"})
+	require.ErrorContains(t, err, "invalid channel slug")
+	_, err = svc.CreateChannel(CreateChannelInput{Slug: domain.SlugTasks, CreatedBy: "coordinator"})
+	require.Error(t, err)
+}
+
+func TestService_ArchiveChannel(t *testing.T) {
+	svc := newTestService()
+	require.NoError(t, svc.InitSession("coordinator"))
+
+	channel, err := svc.CreateChannel(CreateChannelInput{Slug: "topic", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+
+	_, err = svc.ArchiveChannel(domain.SlugGeneral, "coordinator")
+	require.ErrorContains(t, err, "cannot be archived")
+
+	archived, err := svc.ArchiveChannel("topic", "coordinator")
+	require.NoError(t, err)
+	require.True(t, archived.IsArchived())
+	require.Empty(t, svc.GetChannelID("topic"))
+
+	_, err = svc.SendMessage(SendMessageInput{ChannelSlug: "topic", Content: "hi", CreatedBy: "coordinator"})
+	require.ErrorContains(t, err, "unknown channel")
+	subs, err := svc.GetSubscriptions("coordinator")
+	require.NoError(t, err)
+	for _, sub := range subs {
+		require.NotEqual(t, channel.ID, sub.ChannelID)
+	}
+
+	_, err = svc.CreateChannel(CreateChannelInput{Slug: "topic", CreatedBy: "coordinator"})
+	require.ErrorContains(t, err, "archived")
+
+	infos, err := svc.ListChannels(false)
+	require.NoError(t, err)
+	for _, info := range infos {
+		require.NotEqual(t, "topic", info.Channel.Slug)
+		require.True(t, info.Fixed)
+	}
+	infos, err = svc.ListChannels(true)
+	require.NoError(t, err)
+	require.Equal(t, "topic", infos[len(infos)-1].Channel.Slug)
+}
@@ -0,0 +1,126 @@
+package mcp
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// Archive tuning.
+const (
+	// archivedLabel marks tasks hidden from day-to-day board views.
+	archivedLabel = "archived"
+	// archiveDefaultMinClosedDays is how long a task must have been closed before it is archived.
+	archiveDefaultMinClosedDays = 14
+	// archiveRollupPrefix starts the rollup line maintained in the epic's notes.
+	archiveRollupPrefix = "Archive rollup:"
+	// accountabilityCommentPrefix starts the comment report_implementation_complete
+	// leaves on a task with the worker's summary.
+	accountabilityCommentPrefix = "Implementation complete:"
+)
+
+// Archive skip reasons.
+const (
+	archiveSkipTooRecent = "closed too recently"
+	archiveSkipNoSummary = "no accountability summary"
+)
+
+// ArchiveSkip records a closed task that was not archived and why.
+type ArchiveSkip struct {
+	TaskID string `json:"task_id"`
+	Reason string `json:"reason"`
+}
+
+// ArchiveReport is the structured result of archive_completed_tasks.
+type ArchiveReport struct {
+	EpicID        string        `json:"epic_id"`
+	DryRun        bool          `json:"dry_run,omitempty"`
+	MinClosedDays int           `json:"min_closed_days"`
+	Archived      []string      `json:"archived"`
+	Skipped       []ArchiveSkip `json:"skipped,omitempty"`
+	// TotalArchived counts every archived task under the epic, including earlier runs.
+	TotalArchived int `json:"total_archived"`
+}
+
+// Summary returns a one-line human readable description of the cleanup.
+func (r ArchiveReport) Summary() string {
+	verb := "Archived"
+	if r.DryRun {
+		verb = "Would archive"
+	}
+	s := fmt.Sprintf("%s %d task(s) under %s", verb, len(r.Archived), r.EpicID)
+	if len(r.Archived) > 0 {
+		s += ": " + strings.Join(r.Archived, ", ")
+	}
+	if len(r.Skipped) > 0 {
+		s += fmt.Sprintf(" (%d closed task(s) skipped)", len(r.Skipped))
+	}
+	return s
+}
+
+// Markdown renders the cleanup report posted to fabric.
+func (r ArchiveReport) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**Cleanup report for %s**\n\n", r.EpicID)
+	fmt.Fprintf(&b, "Archived %d task(s) closed more than %d day(s) ago (%d archived in total).\n",
+		len(r.Archived), r.MinClosedDays, r.TotalArchived)
+	for _, id := range r.Archived {
+		fmt.Fprintf(&b, "- %s\n", id)
+	}
+	if len(r.Skipped) > 0 {
+		b.WriteString("\nSkipped:\n")
+		for _, skip := range r.Skipped {
+			fmt.Fprintf(&b, "- %s: %s\n", skip.TaskID, skip.Reason)
+		}
+	}
+	return b.String()
+}
+
+// isArchived reports whether a task already carries the archived label.
+func isArchived(issue beads.Issue) bool {
+	return slices.Contains(issue.Labels, archivedLabel)
+}
+
+// archiveSkipReason returns why a closed task can't be archived yet, or "" if it can.
+// comments is only consulted when requireSummary is set.
+func archiveSkipReason(issue beads.Issue, comments []beads.Comment, now time.Time, minClosed time.Duration, requireSummary bool) string {
+	if issue.ClosedAt.IsZero() || now.Sub(issue.ClosedAt) < minClosed {
+		return archiveSkipTooRecent
+	}
+	if requireSummary && !hasAccountabilitySummary(comments) {
+		return archiveSkipNoSummary
+	}
+	return ""
+}
+
+// hasAccountabilitySummary reports whether a task has the completion summary
+// recorded by report_implementation_complete.
+func hasAccountabilitySummary(comments []beads.Comment) bool {
+	for _, c := range comments {
+		if strings.HasPrefix(c.Text, accountabilityCommentPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateArchiveRollup replaces (or appends) the archive rollup line in an epic's notes.
+func updateArchiveRollup(notes string, total int, now time.Time) string {
+	rollup := fmt.Sprintf("%s %d task(s) archived, last cleanup %s", archiveRollupPrefix, total, now.Format(time.DateOnly))
+
+	lines := strings.Split(notes, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, archiveRollupPrefix) {
+			lines[i] = rollup
+			return strings.Join(lines, "\n")
+		}
+	}
+
+	if strings.TrimSpace(notes) == "" {
+		return rollup
+	}
+	return strings.TrimRight(notes, "\n") + "\n\n" + rollup
+}
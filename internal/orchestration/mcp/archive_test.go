@@ -0,0 +1,140 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+func TestArchiveSkipReason(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	minClosed := 14 * 24 * time.Hour
+	summary := []beads.Comment{{Text: "Implementation complete: added login form"}}
+	other := []beads.Comment{{Text: "Review APPROVED by worker-2"}}
+
+	old := beads.Issue{ID: "perles-ab1", ClosedAt: now.AddDate(0, 0, -20)}
+	recent := beads.Issue{ID: "perles-ab2", ClosedAt: now.AddDate(0, 0, -3)}
+
+	require.Empty(t, archiveSkipReason(old, summary, now, minClosed, true))
+	require.Equal(t, archiveSkipTooRecent, archiveSkipReason(recent, summary, now, minClosed, true))
+	require.Equal(t, archiveSkipTooRecent, archiveSkipReason(beads.Issue{ID: "perles-ab3"}, summary, now, minClosed, true))
+	require.Equal(t, archiveSkipNoSummary, archiveSkipReason(old, other, now, minClosed, true))
+	require.Empty(t, archiveSkipReason(old, nil, now, minClosed, false))
+}
+
+func TestUpdateArchiveRollup(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	require.Equal(t, "Archive rollup: 2 task(s) archived, last cleanup 2025-06-30",
+		updateArchiveRollup("", 2, now))
+	require.Equal(t, "Scope: auth\n\nArchive rollup: 2 task(s) archived, last cleanup 2025-06-30",
+		updateArchiveRollup("Scope: auth\n", 2, now))
+
+	// Existing rollup line is replaced in place
+	notes := "Scope: auth\n\nArchive rollup: 2 task(s) archived, last cleanup 2025-06-01\nOwner: team-a"
+	require.Equal(t, "Scope: auth\n\nArchive rollup: 5 task(s) archived, last cleanup 2025-06-30\nOwner: team-a",
+		updateArchiveRollup(notes, 5, now))
+}
+
+// archivingIssueExecutor adds ChildLister and CommentReader to the generated IssueExecutor mock.
+type archivingIssueExecutor struct {
+	*mocks.MockIssueExecutor
+	children []beads.Issue
+	comments map[string][]beads.Comment
+}
+
+func (a *archivingIssueExecutor) ListChildren(_ string, _ beads.Status) ([]beads.Issue, error) {
+	return a.children, nil
+}
+
+func (a *archivingIssueExecutor) GetComments(issueID string) ([]beads.Comment, error) {
+	return a.comments[issueID], nil
+}
+
+func newArchivingIssueExecutor(t *testing.T) *archivingIssueExecutor {
+	t.Helper()
+	old := time.Now().AddDate(0, 0, -30)
+	return &archivingIssueExecutor{
+		MockIssueExecutor: mocks.NewMockIssueExecutor(t),
+		children: []beads.Issue{
+			{ID: "perles-ep1.1", Status: beads.StatusClosed, ClosedAt: old, Labels: []string{"size:s"}},
+			{ID: "perles-ep1.2", Status: beads.StatusClosed, ClosedAt: old},
+			{ID: "perles-ep1.3", Status: beads.StatusClosed, ClosedAt: time.Now()},
+			{ID: "perles-ep1.4", Status: beads.StatusClosed, ClosedAt: old, Labels: []string{archivedLabel}},
+		},
+		comments: map[string][]beads.Comment{
+			"perles-ep1.1": {{Text: "Implementation complete: added login form"}},
+			"perles-ep1.3": {{Text: "Implementation complete: fixed typo"}},
+		},
+	}
+}
+
+func TestCoordinatorServer_ArchiveCompletedTasks(t *testing.T) {
+	exec := newArchivingIssueExecutor(t)
+	exec.EXPECT().SetLabels("perles-ep1.1", []string{"size:s", archivedLabel}).Return(nil).Once()
+	exec.EXPECT().ShowIssue("perles-ep1").Return(&beads.Issue{ID: "perles-ep1", Notes: "Scope: auth"}, nil).Once()
+	exec.EXPECT().UpdateNotes("perles-ep1", mock.MatchedBy(func(notes string) bool {
+		return strings.HasPrefix(notes, "Scope: auth\n\nArchive rollup: 2 task(s) archived")
+	})).Return(nil).Once()
+
+	svc := newTestFabricServiceForObserver()
+	cs := NewCoordinatorServer("/tmp/test", 8765, exec)
+	cs.SetFabricService(svc)
+
+	result, err := cs.handlers["archive_completed_tasks"](context.Background(), json.RawMessage(`{"epic_id":"perles-ep1"}`))
+	require.NoError(t, err)
+
+	report, ok := result.StructuredContent.(ArchiveReport)
+	require.True(t, ok)
+	require.Equal(t, []string{"perles-ep1.1"}, report.Archived)
+	require.Equal(t, 2, report.TotalArchived)
+	require.Equal(t, []ArchiveSkip{
+		{TaskID: "perles-ep1.2", Reason: archiveSkipNoSummary},
+		{TaskID: "perles-ep1.3", Reason: archiveSkipTooRecent},
+	}, report.Skipped)
+
+	messages, err := svc.ListMessages("general", 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.Contains(t, messages[0].Content, "Cleanup report for perles-ep1")
+	require.Contains(t, messages[0].Content, "- perles-ep1.2: no accountability summary")
+}
+
+func TestCoordinatorServer_ArchiveCompletedTasks_DryRun(t *testing.T) {
+	// No SetLabels/UpdateNotes expectations: the mock fails on any write
+	exec := newArchivingIssueExecutor(t)
+	cs := NewCoordinatorServer("/tmp/test", 8765, exec)
+
+	result, err := cs.handlers["archive_completed_tasks"](context.Background(),
+		json.RawMessage(`{"epic_id":"perles-ep1","dry_run":true,"require_summary":false,"min_closed_days":0}`))
+	require.NoError(t, err)
+
+	report := result.StructuredContent.(ArchiveReport)
+	require.Equal(t, []string{"perles-ep1.1", "perles-ep1.2", "perles-ep1.3"}, report.Archived)
+	require.Contains(t, report.Summary(), "Would archive 3 task(s)")
+}
+
+func TestCoordinatorServer_ArchiveCompletedTasks_Validation(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	handler := cs.handlers["archive_completed_tasks"]
+
+	_, err := handler(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "epic_id is required")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"../x"}`))
+	require.ErrorContains(t, err, "invalid epic_id format")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"perles-ep1","min_closed_days":-1}`))
+	require.EqualError(t, err, "min_closed_days must not be negative")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"perles-ep1"}`))
+	require.EqualError(t, err, "listing epic tasks is not available")
+}
@@ -0,0 +1,176 @@
+package mcp
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+// AssignmentTemplateDir is where assign_task looks up templates, relative to the work dir.
+// Each "<name>.md" file is a Go text/template referencing variables as {{.name}}.
+const AssignmentTemplateDir = ".perles/assignments"
+
+// assignmentTemplateExt is the file extension of assignment templates.
+const assignmentTemplateExt = ".md"
+
+// assignmentTemplateName restricts template names to plain file names.
+var assignmentTemplateName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// AssignmentTemplate is a named task assignment template.
+//
+// Example (.perles/assignments/bugfix.md):
+//
+//	Fix {{.task_id}}. Start with: {{.files}}
+//
+//	Done when: {{.acceptance}}
+//	{{if .notes}}Notes: {{.notes}}{{end}}
+//
+// Variables used only inside if/with/range blocks are optional.
+type AssignmentTemplate struct {
+	Name      string
+	Variables []string // Variables referenced by the template, sorted
+	Required  []string // Variables that must be non-empty, sorted
+	tmpl      *template.Template
+}
+
+// LoadAssignmentTemplate loads the template called name from dir.
+// If it doesn't exist, the error lists the available templates.
+func LoadAssignmentTemplate(dir, name string) (*AssignmentTemplate, error) {
+	if !assignmentTemplateName.MatchString(name) {
+		return nil, fmt.Errorf("invalid template name %q", name)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, name+assignmentTemplateExt))
+	if errors.Is(err, fs.ErrNotExist) {
+		names, _ := ListAssignmentTemplates(dir)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("template %q not found: no templates in %s", name, dir)
+		}
+		return nil, fmt.Errorf("template %q not found, available: %s", name, strings.Join(names, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading template %q: %w", name, err)
+	}
+
+	return ParseAssignmentTemplate(name, string(content))
+}
+
+// ParseAssignmentTemplate parses template content.
+func ParseAssignmentTemplate(name, content string) (*AssignmentTemplate, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("parsing template %q: %w", name, err)
+	}
+
+	vars := make(map[string]bool)
+	if tmpl.Tree != nil {
+		collectTemplateVariables(tmpl.Root, vars, false)
+	}
+	t := &AssignmentTemplate{Name: name, tmpl: tmpl}
+	for v, required := range vars {
+		t.Variables = append(t.Variables, v)
+		if required {
+			t.Required = append(t.Required, v)
+		}
+	}
+	sort.Strings(t.Variables)
+	sort.Strings(t.Required)
+
+	return t, nil
+}
+
+// ListAssignmentTemplates returns the names of the templates in dir, sorted.
+// A missing dir has no templates.
+func ListAssignmentTemplates(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading template dir: %w", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), assignmentTemplateExt)
+		if ok && !e.IsDir() && assignmentTemplateName.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Render executes the template with vars.
+// All missing (or empty) required variables are reported in a single error;
+// missing optional variables render as empty.
+func (t *AssignmentTemplate) Render(vars map[string]string) (string, error) {
+	var missing []string
+	for _, v := range t.Required {
+		if strings.TrimSpace(vars[v]) == "" {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) > 0 {
+		return "", fmt.Errorf("template %q: missing variables: %s", t.Name, strings.Join(missing, ", "))
+	}
+
+	data := make(map[string]string, len(t.Variables))
+	for _, v := range t.Variables {
+		data[v] = vars[v]
+	}
+
+	var sb strings.Builder
+	if err := t.tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("rendering template %q: %w", t.Name, err)
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
+
+// collectTemplateVariables records the top-level fields ({{.name}}) referenced under node.
+// vars maps each field to whether it is required: fields used only in (or under)
+// if/with/range conditions are optional.
+func collectTemplateVariables(node parse.Node, vars map[string]bool, optional bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectTemplateVariables(child, vars, optional)
+		}
+	case *parse.ActionNode:
+		collectTemplateVariables(n.Pipe, vars, optional)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				collectTemplateVariables(arg, vars, optional)
+			}
+		}
+	case *parse.FieldNode:
+		vars[n.Ident[0]] = vars[n.Ident[0]] || !optional
+	case *parse.ChainNode:
+		collectTemplateVariables(n.Node, vars, optional)
+	case *parse.IfNode:
+		collectBranchVariables(&n.BranchNode, vars)
+	case *parse.RangeNode:
+		collectBranchVariables(&n.BranchNode, vars)
+	case *parse.WithNode:
+		collectBranchVariables(&n.BranchNode, vars)
+	}
+}
+
+func collectBranchVariables(n *parse.BranchNode, vars map[string]bool) {
+	collectTemplateVariables(n.Pipe, vars, true)
+	collectTemplateVariables(n.List, vars, true)
+	collectTemplateVariables(n.ElseList, vars, true)
+}
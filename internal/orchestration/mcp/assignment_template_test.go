@@ -0,0 +1,96 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+const bugfixTemplate = `Fix {{.task_id}}. Start with: {{.files}}
+
+Done when: {{.acceptance}}
+{{if .notes}}Notes: {{.notes}}{{end}}
+`
+
+func writeAssignmentTemplate(t *testing.T, workDir, name, content string) {
+	t.Helper()
+	dir := filepath.Join(workDir, AssignmentTemplateDir)
+	require.NoError(t, os.MkdirAll(dir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".md"), []byte(content), 0o600))
+}
+
+func TestParseAssignmentTemplate_Variables(t *testing.T) {
+	tmpl, err := ParseAssignmentTemplate("bugfix", bugfixTemplate)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"acceptance", "files", "notes", "task_id"}, tmpl.Variables)
+	require.Equal(t, []string{"acceptance", "files", "task_id"}, tmpl.Required, "variables only used under if are optional")
+}
+
+func TestAssignmentTemplate_Render(t *testing.T) {
+	tmpl, err := ParseAssignmentTemplate("bugfix", bugfixTemplate)
+	require.NoError(t, err)
+
+	out, err := tmpl.Render(map[string]string{"task_id": "perles-abc", "files": "auth.go", "acceptance": "tests pass"})
+	require.NoError(t, err)
+	require.Equal(t, "Fix perles-abc. Start with: auth.go\n\nDone when: tests pass", out)
+
+	out, err = tmpl.Render(map[string]string{"task_id": "perles-abc", "files": "auth.go", "acceptance": "tests pass", "notes": "see #12"})
+	require.NoError(t, err)
+	require.Contains(t, out, "Notes: see #12")
+
+	_, err = tmpl.Render(map[string]string{"task_id": "perles-abc", "files": " "})
+	require.EqualError(t, err, `template "bugfix": missing variables: acceptance, files`)
+}
+
+func TestLoadAssignmentTemplate(t *testing.T) {
+	workDir := t.TempDir()
+	dir := filepath.Join(workDir, AssignmentTemplateDir)
+
+	_, err := LoadAssignmentTemplate(dir, "bugfix")
+	require.ErrorContains(t, err, "no templates in")
+
+	writeAssignmentTemplate(t, workDir, "bugfix", bugfixTemplate)
+	writeAssignmentTemplate(t, workDir, "feature", "Build {{.task_id}}")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.txt"), []byte("ignored"), 0o600))
+
+	names, err := ListAssignmentTemplates(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bugfix", "feature"}, names)
+
+	tmpl, err := LoadAssignmentTemplate(dir, "feature")
+	require.NoError(t, err)
+	require.Equal(t, []string{"task_id"}, tmpl.Required)
+
+	_, err = LoadAssignmentTemplate(dir, "refactor")
+	require.EqualError(t, err, `template "refactor" not found, available: bugfix, feature`)
+
+	_, err = LoadAssignmentTemplate(dir, "../secrets")
+	require.ErrorContains(t, err, "invalid template name")
+}
+
+func TestCoordinatorServer_AssignTaskTemplate(t *testing.T) {
+	workDir := t.TempDir()
+	writeAssignmentTemplate(t, workDir, "bugfix", bugfixTemplate)
+	cs := NewCoordinatorServer(workDir, 8765, mocks.NewMockIssueExecutor(t))
+
+	rendered, err := cs.renderAssignmentTemplate(assignTaskArgs{
+		WorkerID:  "worker-1",
+		TaskID:    "perles-abc",
+		Template:  "bugfix",
+		Variables: map[string]string{"files": "auth.go", "acceptance": "tests pass", "task_id": "ignored"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Fix perles-abc. Start with: auth.go\n\nDone when: tests pass", rendered)
+
+	// Missing variables fail the call before anything is assigned
+	_, err = cs.handlers["assign_task"](context.Background(), json.RawMessage(
+		`{"worker_id": "worker-1", "task_id": "perles-abc", "template": "bugfix", "variables": {"files": "auth.go"}}`))
+	require.EqualError(t, err, `template "bugfix": missing variables: acceptance`)
+}
@@ -0,0 +1,175 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// bdExecMaxTextLen caps free-text arguments passed through bd_exec.
+const bdExecMaxTextLen = 500
+
+// bdArgKind describes what a positional bd_exec argument must look like.
+type bdArgKind int
+
+const (
+	bdArgIssueID bdArgKind = iota // a valid bd issue ID
+	bdArgLabel                    // a label name
+	bdArgText                     // free text, e.g. a search query
+)
+
+// bdSubcommand is an allowlisted bd subcommand and the arguments it accepts.
+type bdSubcommand struct {
+	// positional lists the accepted positional arguments in order.
+	// The last kind repeats when variadic is set.
+	positional []bdArgKind
+	// minArgs is the minimum number of positional arguments.
+	minArgs int
+	// variadic allows the last positional kind to repeat.
+	variadic bool
+	// flags lists the accepted value flags (without the leading "--").
+	flags []string
+	// mutating marks subcommands that change the tracker.
+	mutating bool
+}
+
+// bdExecAllowlist is the set of bd subcommands bd_exec may run. Everything
+// else, including global flags like --db, is rejected so agents can't point bd
+// at another database or run destructive operations.
+var bdExecAllowlist = map[string]bdSubcommand{
+	"show":         {positional: []bdArgKind{bdArgIssueID}, minArgs: 1, variadic: true},
+	"list":         {flags: []string{"status", "type", "priority", "assignee", "label", "parent", "limit"}},
+	"ready":        {flags: []string{"assignee", "priority", "label", "limit"}},
+	"blocked":      {},
+	"stats":        {},
+	"search":       {positional: []bdArgKind{bdArgText}, minArgs: 1, flags: []string{"status", "limit"}},
+	"comments":     {positional: []bdArgKind{bdArgIssueID}, minArgs: 1},
+	"dep tree":     {positional: []bdArgKind{bdArgIssueID}, minArgs: 1, flags: []string{"direction", "max-depth"}},
+	"dep cycles":   {},
+	"dep add":      {positional: []bdArgKind{bdArgIssueID, bdArgIssueID}, minArgs: 2, flags: []string{"type"}, mutating: true},
+	"dep rm":       {positional: []bdArgKind{bdArgIssueID, bdArgIssueID}, minArgs: 2, mutating: true},
+	"label list":   {positional: []bdArgKind{bdArgIssueID}, minArgs: 1},
+	"label add":    {positional: []bdArgKind{bdArgIssueID, bdArgLabel}, minArgs: 2, mutating: true},
+	"label remove": {positional: []bdArgKind{bdArgIssueID, bdArgLabel}, minArgs: 2, mutating: true},
+}
+
+var (
+	// bdLabelPattern matches label names such as "size:s" or "area/ui".
+	bdLabelPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9:_./-]{0,63}$`)
+	// bdFlagValuePattern matches flag values: identifiers, numbers, IDs and labels.
+	bdFlagValuePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9:_.,/-]{0,63}$`)
+)
+
+// BDExecResult is the structured result of bd_exec.
+type BDExecResult struct {
+	Command string `json:"command"`
+	// Output is the parsed JSON output, or the raw text when bd didn't return JSON.
+	Output any `json:"output"`
+}
+
+// bdExecSubcommands returns the allowlisted subcommands, sorted, for tool docs and errors.
+func bdExecSubcommands() []string {
+	names := make([]string, 0, len(bdExecAllowlist))
+	for name := range bdExecAllowlist {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// parseBDExecArgs validates a bd_exec invocation against the allowlist and
+// returns the full bd argument list (with --json appended) and the matched subcommand.
+func parseBDExecArgs(args []string) ([]string, bdSubcommand, error) {
+	if len(args) == 0 {
+		return nil, bdSubcommand{}, fmt.Errorf("args is required")
+	}
+
+	// Two-word subcommands ("dep add") take precedence over one-word ones
+	name, rest := args[0], args[1:]
+	spec, ok := bdSubcommand{}, false
+	if len(args) > 1 {
+		spec, ok = bdExecAllowlist[args[0]+" "+args[1]]
+		if ok {
+			name, rest = args[0]+" "+args[1], args[2:]
+		}
+	}
+	if !ok {
+		spec, ok = bdExecAllowlist[name]
+	}
+	if !ok {
+		return nil, bdSubcommand{}, fmt.Errorf("bd subcommand %q is not allowed; allowed: %s", name, strings.Join(bdExecSubcommands(), ", "))
+	}
+
+	var positional, flags []string
+	for i := 0; i < len(rest); i++ {
+		arg := rest[i]
+		if !strings.HasPrefix(arg, "-") {
+			positional = append(positional, arg)
+			continue
+		}
+
+		flag, value, hasValue := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !strings.HasPrefix(arg, "--") || !slices.Contains(spec.flags, flag) {
+			return nil, bdSubcommand{}, fmt.Errorf("flag %q is not allowed for bd %s", arg, name)
+		}
+		if !hasValue {
+			if i+1 >= len(rest) {
+				return nil, bdSubcommand{}, fmt.Errorf("flag --%s requires a value", flag)
+			}
+			i++
+			value = rest[i]
+		}
+		if !bdFlagValuePattern.MatchString(value) {
+			return nil, bdSubcommand{}, fmt.Errorf("invalid value for --%s: %q", flag, value)
+		}
+		flags = append(flags, "--"+flag, value)
+	}
+
+	if len(positional) < spec.minArgs {
+		return nil, bdSubcommand{}, fmt.Errorf("bd %s requires at least %d argument(s)", name, spec.minArgs)
+	}
+	if len(positional) > len(spec.positional) && !spec.variadic {
+		return nil, bdSubcommand{}, fmt.Errorf("bd %s accepts at most %d argument(s)", name, len(spec.positional))
+	}
+	for i, arg := range positional {
+		kind := spec.positional[min(i, len(spec.positional)-1)]
+		if err := validateBDArg(kind, arg); err != nil {
+			return nil, bdSubcommand{}, err
+		}
+	}
+
+	cmd := strings.Fields(name)
+	cmd = append(cmd, positional...)
+	cmd = append(cmd, flags...)
+	return append(cmd, "--json"), spec, nil
+}
+
+// validateBDArg checks a positional argument against its expected kind.
+func validateBDArg(kind bdArgKind, arg string) error {
+	switch kind {
+	case bdArgIssueID:
+		if !isValidTaskID(arg) {
+			return fmt.Errorf("invalid issue ID: %q", arg)
+		}
+	case bdArgLabel:
+		if !bdLabelPattern.MatchString(arg) {
+			return fmt.Errorf("invalid label: %q", arg)
+		}
+	case bdArgText:
+		if strings.TrimSpace(arg) == "" || len(arg) > bdExecMaxTextLen || strings.ContainsAny(arg, "\n\r\x00") {
+			return fmt.Errorf("invalid text argument (must be a single line of at most %d characters)", bdExecMaxTextLen)
+		}
+	}
+	return nil
+}
+
+// parseBDOutput decodes bd's JSON output, falling back to the raw text.
+func parseBDOutput(output string) any {
+	var parsed any
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		return output
+	}
+	return parsed
+}
@@ -0,0 +1,104 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+func TestParseBDExecArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		wantErr string
+	}{
+		{"show", []string{"show", "perles-ab1", "perles-ab2"}, []string{"show", "perles-ab1", "perles-ab2", "--json"}, ""},
+		{"list with flags", []string{"list", "--status", "open", "--limit=20"}, []string{"list", "--status", "open", "--limit", "20", "--json"}, ""},
+		{"two-word subcommand", []string{"dep", "tree", "perles-ab1"}, []string{"dep", "tree", "perles-ab1", "--json"}, ""},
+		{"label add", []string{"label", "add", "perles-ab1", "size:s"}, []string{"label", "add", "perles-ab1", "size:s", "--json"}, ""},
+		{"search text", []string{"search", "login page"}, []string{"search", "login page", "--json"}, ""},
+		{"empty", nil, nil, "args is required"},
+		{"not allowlisted", []string{"delete", "perles-ab1"}, nil, `bd subcommand "delete" is not allowed`},
+		{"unknown two-word", []string{"dep", "purge"}, nil, `bd subcommand "dep" is not allowed`},
+		{"global flag", []string{"list", "--db", "/tmp/other.db"}, nil, `flag "--db" is not allowed`},
+		{"short flag", []string{"list", "-s", "open"}, nil, `flag "-s" is not allowed`},
+		{"flag missing value", []string{"list", "--limit"}, nil, "flag --limit requires a value"},
+		{"bad flag value", []string{"list", "--status", "open;rm"}, nil, "invalid value for --status"},
+		{"bad issue ID", []string{"show", "../etc"}, nil, "invalid issue ID"},
+		{"bad label", []string{"label", "add", "perles-ab1", "has space"}, nil, "invalid label"},
+		{"too few args", []string{"dep", "add", "perles-ab1"}, nil, "requires at least 2 argument(s)"},
+		{"too many args", []string{"comments", "perles-ab1", "perles-ab2"}, nil, "accepts at most 1 argument(s)"},
+		{"no args accepted", []string{"stats", "perles-ab1"}, nil, "accepts at most 0 argument(s)"},
+		{"multiline text", []string{"search", "a\nb"}, nil, "invalid text argument"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := parseBDExecArgs(tt.args)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// runningIssueExecutor adds CommandRunner to the generated IssueExecutor mock.
+type runningIssueExecutor struct {
+	*mocks.MockIssueExecutor
+	args   []string
+	output string
+	err    error
+}
+
+func (r *runningIssueExecutor) RunCommand(args ...string) (string, error) {
+	r.args = args
+	return r.output, r.err
+}
+
+func TestCoordinatorServer_BDExec(t *testing.T) {
+	exec := &runningIssueExecutor{
+		MockIssueExecutor: mocks.NewMockIssueExecutor(t),
+		output:            `[{"id":"perles-ab1","blocked_by":["perles-ab2"]}]`,
+	}
+	cs := NewCoordinatorServer("/tmp/test", 8765, exec)
+
+	result, err := cs.handlers["bd_exec"](context.Background(), json.RawMessage(`{"args":["blocked"]}`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"blocked", "--json"}, exec.args)
+
+	res, ok := result.StructuredContent.(BDExecResult)
+	require.True(t, ok)
+	require.Equal(t, "bd blocked --json", res.Command)
+	require.Equal(t, []any{map[string]any{"id": "perles-ab1", "blocked_by": []any{"perles-ab2"}}}, res.Output)
+
+	// Non-JSON output is returned as text
+	exec.output = "No cycles found"
+	result, err = cs.handlers["bd_exec"](context.Background(), json.RawMessage(`{"args":["dep","cycles"]}`))
+	require.NoError(t, err)
+	require.Equal(t, "No cycles found", result.StructuredContent.(BDExecResult).Output)
+
+	exec.err = errors.New("bd dep failed: issue not found")
+	_, err = cs.handlers["bd_exec"](context.Background(), json.RawMessage(`{"args":["dep","tree","perles-zz9"]}`))
+	require.ErrorContains(t, err, "bd dep tree perles-zz9 --json failed")
+}
+
+func TestCoordinatorServer_BDExecValidation(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	handler := cs.handlers["bd_exec"]
+
+	_, err := handler(context.Background(), json.RawMessage(`{"args":["init"]}`))
+	require.ErrorContains(t, err, "is not allowed")
+
+	// The plain mock cannot run raw commands.
+	_, err = handler(context.Background(), json.RawMessage(`{"args":["stats"]}`))
+	require.EqualError(t, err, "bd passthrough is not available")
+}
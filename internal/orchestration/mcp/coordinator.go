@@ -4,17 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-
-	"go.opentelemetry.io/otel/trace"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricmcp "github.com/zjrosen/perles/internal/orchestration/fabric/mcp"
+	"github.com/zjrosen/perles/internal/orchestration/planning"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
 )
 
 // CoordinatorServer is an MCP server that exposes orchestration tools to the coordinator agent.
@@ -25,15 +30,15 @@ type CoordinatorServer struct {
 	port          int                    // HTTP server port for MCP config generation
 	beadsExecutor appbeads.IssueExecutor // BD command executor
 
-	// dedup tracks recent messages to prevent duplicate sends to workers
-	dedup *MessageDeduplicator
-
 	// V2 adapter for command-based processing
 	// See docs/proposals/orchestration-v2-architecture.md for architecture details
 	v2Adapter *adapter.V2Adapter
 
 	// fabricService provides graph-based messaging for task assignments
 	fabricService *fabric.Service
+
+	// customFields are the project's custom issue fields, reported by get_task_status
+	customFields []beads.FieldDef
 }
 
 // NewCoordinatorServer creates a new coordinator MCP server.
@@ -60,7 +65,6 @@ func NewCoordinatorServerWithV2Adapter(
 		workDir:       workDir,
 		port:          port,
 		beadsExecutor: beadsExec,
-		dedup:         NewMessageDeduplicator(DefaultDeduplicationWindow),
 		v2Adapter:     v2Adapter,
 	}
 
@@ -78,10 +82,57 @@ func (cs *CoordinatorServer) SetV2Adapter(adapter *adapter.V2Adapter) {
 	cs.v2Adapter = adapter
 }
 
-// SetTracer sets the tracer for distributed tracing of MCP tool calls.
-// This delegates to the embedded Server's tracer field.
-func (cs *CoordinatorServer) SetTracer(tracer trace.Tracer) {
-	cs.tracer = tracer
+// SetCustomFields sets the project's custom issue field definitions.
+// get_task_status reports their values so the coordinator can route on them.
+func (cs *CoordinatorServer) SetCustomFields(fields []beads.FieldDef) {
+	cs.customFields = fields
+}
+
+// SetWorkflowDAG registers the advance_phase tool, which moves the workflow
+// through the phases of run's DAG. The tool description lists the phases.
+func (cs *CoordinatorServer) SetWorkflowDAG(run *dag.Run) {
+	cs.RegisterTool(Tool{
+		Name:        "advance_phase",
+		Description: advancePhaseDescription(run.Definition()),
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"phase": {
+					Type:        "string",
+					Description: "ID of the phase to enter; must be a next phase of the current one",
+				},
+				"reason": {
+					Type:        "string",
+					Description: "Optional: Why the workflow is ready to move on",
+				},
+			},
+			Required: []string{"phase"},
+		},
+	}, cs.handleAdvancePhase)
+}
+
+// advancePhaseDescription describes the advance_phase tool and the phases of def.
+func advancePhaseDescription(def *dag.Definition) string {
+	var b strings.Builder
+	b.WriteString("Move the workflow to its next phase. Each phase only allows some actions and worker roles; " +
+		"calls the current phase does not allow are rejected. Transitions with a condition are rejected until it holds, " +
+		"and a checkpoint phase cannot be left until the user approves it. Phases (the workflow starts in the first):")
+	for _, p := range def.Phases {
+		fmt.Fprintf(&b, "\n- %s", p.ID)
+		if p.Checkpoint {
+			b.WriteString(" [checkpoint]")
+		}
+		if p.Description != "" {
+			fmt.Fprintf(&b, ": %s", p.Description)
+		}
+		for _, t := range p.Next {
+			fmt.Fprintf(&b, " -> %s", t.To)
+			if t.When != "" && t.When != dag.WhenAlways {
+				fmt.Fprintf(&b, " (when %s)", t.When)
+			}
+		}
+	}
+	return b.String()
 }
 
 // SetFabricService registers Fabric messaging tools with the coordinator MCP server.
@@ -94,10 +145,11 @@ func (cs *CoordinatorServer) SetFabricService(svc *fabric.Service) {
 	registerFabricTools(cs.Server, handlers)
 }
 
-// registerFabricTools registers all Fabric MCP tools with an MCP server.
+// registerFabricTools registers all Fabric MCP tools, including the channel
+// administration tools, with an MCP server.
 // This bridges the fabric/mcp types to orchestration/mcp types.
 func registerFabricTools(server *Server, h *fabricmcp.Handlers) {
-	for _, tool := range fabricmcp.FabricTools() {
+	for _, tool := range append(fabricmcp.FabricTools(), fabricmcp.FabricChannelAdminTools()...) {
 		// Convert fabric/mcp.Tool to mcp.Tool
 		mcpTool := Tool{
 			Name:        tool.Name,
@@ -117,6 +169,8 @@ func registerFabricTools(server *Server, h *fabricmcp.Handlers) {
 			handler = h.HandleInbox
 		case "fabric_send":
 			handler = h.HandleSend
+		case "fabric_cancel_scheduled":
+			handler = h.HandleCancelScheduled
 		case "fabric_reply":
 			handler = h.HandleReply
 		case "fabric_ack":
@@ -131,8 +185,24 @@ func registerFabricTools(server *Server, h *fabricmcp.Handlers) {
 			handler = h.HandleHistory
 		case "fabric_read_thread":
 			handler = h.HandleReadThread
+		case "fabric_thread_participants":
+			handler = h.HandleThreadParticipants
+		case "fabric_receipts":
+			handler = h.HandleReceipts
 		case "fabric_react":
 			handler = h.HandleReact
+		case "fabric_edit":
+			handler = h.HandleEdit
+		case "fabric_delete":
+			handler = h.HandleDelete
+		case "fabric_list_channels":
+			handler = h.HandleListChannels
+		case "fabric_graph":
+			handler = h.HandleGraph
+		case "fabric_create_channel":
+			handler = h.HandleCreateChannel
+		case "fabric_archive_channel":
+			handler = h.HandleArchiveChannel
 		}
 
 		if handler != nil {
@@ -194,7 +264,7 @@ func convertPropertySchema(p *fabricmcp.PropertySchema) *PropertySchema {
 func (cs *CoordinatorServer) registerTools() {
 	cs.RegisterTool(Tool{
 		Name:        "spawn_worker",
-		Description: "Spawn a new idle worker. The worker starts in Ready state waiting for task assignment. Returns the new worker ID. Optionally specify agent_type for specialized agents.",
+		Description: "Spawn a new idle worker. The worker starts in Ready state waiting for task assignment. Returns the new worker ID. Optionally specify agent_type for specialized agents and backend to run the worker on a different agent CLI.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
@@ -203,6 +273,11 @@ func (cs *CoordinatorServer) registerTools() {
 					Description: "Optional agent specialization: 'implementer' (code implementation), 'reviewer' (code review), 'researcher' (codebase exploration). Defaults to generic if omitted.",
 					Enum:        []string{"implementer", "reviewer", "researcher"},
 				},
+				"backend": {
+					Type:        "string",
+					Description: "Optional agent CLI to run this worker with. Must be the default worker client or listed in orchestration.worker_backends. Defaults to the worker client if omitted.",
+					Enum:        []string{"claude", "amp", "codex", "gemini", "opencode"},
+				},
 			},
 			Required: []string{},
 		},
@@ -214,9 +289,13 @@ func (cs *CoordinatorServer) registerTools() {
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
-				"worker_id": {Type: "string", Description: "The worker ID to assign (e.g., 'worker-1')"},
-				"task_id":   {Type: "string", Description: "The bd task ID to work on (e.g., 'perles-abc.1')"},
-				"summary":   {Type: "string", Description: "Optional detailed instructions or context to include with the task assignment. Use for task-specific guidance, key files to modify, or implementation hints."},
+				"worker_id":       {Type: "string", Description: "The worker ID to assign (e.g., 'worker-1')"},
+				"task_id":         {Type: "string", Description: "The bd task ID to work on (e.g., 'perles-abc.1')"},
+				"summary":         {Type: "string", Description: "Optional detailed instructions or context to include with the task assignment. Use for task-specific guidance, key files to modify, or implementation hints."},
+				"template":        {Type: "string", Description: "Optional assignment template name from " + AssignmentTemplateDir + " (e.g., 'bugfix'). Rendered into the worker's instructions before the summary."},
+				"variables":       {Type: "object", Description: "Template variables as string values (e.g., {\"files\": \"auth.go\", \"acceptance\": \"tests pass\"}). task_id and worker_id are filled in automatically."},
+				"force":           {Type: "boolean", Description: "Send the assignment even if the same one was just sent to this worker. Only for intentional resends; duplicates are otherwise suppressed (default: false)"},
+				"idempotency_key": {Type: "string", Description: "Optional unique key for this request (e.g., 'assign-perles-abc.1-try1'). Reuse it when retrying after a timeout: a repeated call with the same key returns the original result instead of running again."},
 			},
 			Required: []string{"worker_id", "task_id"},
 		},
@@ -228,8 +307,9 @@ func (cs *CoordinatorServer) registerTools() {
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
-				"worker_id": {Type: "string", Description: "The worker ID to retire"},
-				"reason":    {Type: "string", Description: "Reason for replacement (e.g., 'token limit', 'stuck')"},
+				"worker_id":       {Type: "string", Description: "The worker ID to retire"},
+				"reason":          {Type: "string", Description: "Reason for replacement (e.g., 'token limit', 'stuck')"},
+				"idempotency_key": {Type: "string", Description: "Optional unique key for this request (e.g., 'replace-worker-1-try1'). Reuse it when retrying after a timeout: a repeated call with the same key returns the original result instead of running again."},
 			},
 			Required: []string{"worker_id"},
 		},
@@ -250,7 +330,7 @@ func (cs *CoordinatorServer) registerTools() {
 
 	cs.RegisterTool(Tool{
 		Name:        "get_task_status",
-		Description: "Get the current status of a task from the bd tracker.",
+		Description: "Get the current status of a task from the bd tracker. Includes custom_fields (e.g., component, owner) when the project defines them; use them to route work.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
@@ -260,6 +340,33 @@ func (cs *CoordinatorServer) registerTools() {
 		},
 	}, cs.handleGetTaskStatus)
 
+	cs.RegisterTool(Tool{
+		Name:        "estimate_task",
+		Description: "Estimate how long a task will take from historical cycle times of similar closed tasks (same epic, size label, labels, type). Returns a low/likely/high range in hours. Use it to sequence work and set realistic expectations.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"task_id": {Type: "string", Description: "The bd task ID to estimate"},
+			},
+			Required: []string{"task_id"},
+		},
+	}, cs.handleEstimateTask)
+
+	cs.RegisterTool(Tool{
+		Name: "suggest_assignment_plan",
+		Description: "Suggest the order in which to assign an epic's unfinished tasks. Groups tasks into waves that can run in parallel: " +
+			"a task never comes before its blockers, and tasks claiming the same files (\"" + planning.FileLabelPrefix + "<path>\" labels) never share a wave. " +
+			"Wave 1 lists in-progress tasks and the tasks to assign now. Pass max_parallel (e.g. the number of workers) to cap each wave.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"epic_id":      {Type: "string", Description: "The epic whose tasks to plan"},
+				"max_parallel": {Type: "number", Description: "Maximum tasks per wave (default: no limit)"},
+			},
+			Required: []string{"epic_id"},
+		},
+	}, cs.handleSuggestAssignmentPlan)
+
 	cs.RegisterTool(Tool{
 		Name:        "mark_task_complete",
 		Description: "Mark a task as completed in the bd tracker.",
@@ -285,9 +392,71 @@ func (cs *CoordinatorServer) registerTools() {
 		},
 	}, cs.handleMarkTaskFailed)
 
+	cs.RegisterTool(Tool{
+		Name:        "export_thread_to_issue",
+		Description: "Export a fabric thread (message + replies) as a formatted comment on a bd issue, so design discussions and decisions are persisted to the tracker. If issue_id is omitted, the task the thread is linked to (e.g. a #tasks assignment thread) is used.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"thread_id": {Type: "string", Description: "The fabric message ID of the thread root or any reply"},
+				"issue_id":  {Type: "string", Description: "The bd issue ID to comment on (optional, defaults to the thread's linked task)"},
+			},
+			Required: []string{"thread_id"},
+		},
+	}, cs.handleExportThreadToIssue)
+
+	cs.RegisterTool(Tool{
+		Name: "bd_exec",
+		Description: "Run a beads (bd) CLI subcommand that has no dedicated tool. Only an allowlisted subset is permitted (" +
+			strings.Join(bdExecSubcommands(), ", ") + "); issue IDs, labels and flags are validated and --json is added automatically. " +
+			"Prefer the dedicated tools (mark_task_complete, mark_task_failed, ...) when one exists.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"args": {
+					Type:        "array",
+					Description: `bd arguments without the leading "bd", e.g. ["dep", "tree", "perles-abc1"] or ["list", "--status", "open", "--limit", "20"]`,
+					Items:       &PropertySchema{Type: "string"},
+				},
+			},
+			Required: []string{"args"},
+		},
+	}, cs.handleBDExec)
+
+	cs.RegisterTool(Tool{
+		Name: "archive_completed_tasks",
+		Description: "Archive closed tasks under an epic to keep the board manageable. A task is archived (labeled \"" + archivedLabel + "\") when it has been closed " +
+			"for at least min_closed_days and, unless require_summary is false, has the implementation summary from report_implementation_complete. " +
+			"Updates the archive rollup in the epic's notes and posts a cleanup report to #general. Use dry_run to preview.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"epic_id":         {Type: "string", Description: "The epic whose tasks to archive"},
+				"min_closed_days": {Type: "number", Description: fmt.Sprintf("Minimum days since a task was closed (default: %d)", archiveDefaultMinClosedDays)},
+				"require_summary": {Type: "boolean", Description: "Only archive tasks with an implementation summary comment (default: true)"},
+				"dry_run":         {Type: "boolean", Description: "Report what would be archived without changing anything"},
+			},
+			Required: []string{"epic_id"},
+		},
+	}, cs.handleArchiveCompletedTasks)
+
+	cs.RegisterTool(Tool{
+		Name: "get_epic_status",
+		Description: "Get the progress of an epic: task counts by status, progress by count and weighted by size labels, blocked tasks, " +
+			"and a daily burndown of remaining tasks. Tasks of child epics are rolled up into the parent.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"epic_id":       {Type: "string", Description: "The epic to report on"},
+				"burndown_days": {Type: "number", Description: fmt.Sprintf("Days of burndown to return (default: %d, max: %d, 0 for none)", epicStatusDefaultBurndownDays, epicStatusMaxBurndownDays)},
+			},
+			Required: []string{"epic_id"},
+		},
+	}, cs.handleGetEpicStatus)
+
 	cs.RegisterTool(Tool{
 		Name:        "query_worker_state",
-		Description: "Query current state of workers with role/phase details. Use before assignments to check availability and prevent duplicates.",
+		Description: "Query current state of workers with role/phase details. Use before assignments to check availability and prevent duplicates. Lists files changed by more than one implementer as overlaps, and blocked workers' open assistance requests first as blocked_workers.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
@@ -299,6 +468,22 @@ func (cs *CoordinatorServer) registerTools() {
 		OutputSchema: &OutputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
+				"blocked_workers": {
+					Type:        "array",
+					Description: "Open request_assistance calls of blocked workers (omitted if none) - answer these first",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"worker_id":      {Type: "string", Description: "Blocked worker ID"},
+							"task_id":        {Type: "string", Description: "Task the worker is blocked on, if any"},
+							"reason":         {Type: "string", Description: "What the worker needs"},
+							"blocking_issue": {Type: "string", Description: "bd issue or other item blocking the worker"},
+							"needed_from":    {Type: "string", Description: "Who can unblock it: coordinator, user or a worker ID"},
+							"resume_phase":   {Type: "string", Description: "Phase the worker resumes once answered"},
+							"requested_at":   {Type: "string", Description: "When assistance was requested"},
+						},
+					},
+				},
 				"workers": {
 					Type:        "array",
 					Description: "Active workers with current state",
@@ -330,28 +515,128 @@ func (cs *CoordinatorServer) registerTools() {
 				},
 				"tasks": {
 					Type:        "object",
-					Description: "Map of task ID to assignment info",
+					Description: "Map of task ID to assignment info, including review_scores from the latest scored review",
 				},
 			},
 			Required: []string{"workers", "ready_workers", "retired_workers", "failed_workers", "tasks"},
 		},
 	}, cs.handleQueryWorkerState)
 
+	cs.RegisterTool(Tool{
+		Name:        "broadcast_to_workers",
+		Description: "Send the same message to every active worker, or to the workers matching all given filters, in one call. Busy workers get it after their current turn. Returns the delivery to each worker: sent, queued or failed.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"message": {Type: "string", Description: "The message to send to each worker"},
+				"phase": {
+					Type:        "string",
+					Description: "Only workers in this phase",
+					Enum:        []string{"idle", "implementing", "awaiting_review", "reviewing", "addressing_feedback", "committing", "blocked"},
+				},
+				"agent_type": {
+					Type:        "string",
+					Description: "Only workers of this agent specialization",
+					Enum:        []string{"implementer", "reviewer", "researcher"},
+				},
+				"task_id":         {Type: "string", Description: "Only workers assigned this bd task (e.g., 'perles-abc.1')"},
+				"exclude_workers": {Type: "array", Description: "Worker IDs to leave out", Items: &PropertySchema{Type: "string"}},
+			},
+			Required: []string{"message"},
+		},
+		OutputSchema: &OutputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"deliveries": {
+					Type:        "array",
+					Description: "One entry per targeted worker, ordered by worker ID",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"worker_id":  {Type: "string", Description: "Worker ID"},
+							"status":     {Type: "string", Description: "sent (worker was ready), queued (delivered after its current turn) or failed"},
+							"queue_size": {Type: "number", Description: "Messages waiting for the worker, including this one"},
+							"error":      {Type: "string", Description: "Why the message could not be queued (failed only)"},
+						},
+						Required: []string{"worker_id", "status"},
+					},
+				},
+				"sent":   {Type: "number", Description: "Workers the message was sent or queued for"},
+				"failed": {Type: "number", Description: "Workers the message could not be queued for"},
+			},
+			Required: []string{"deliveries", "sent", "failed"},
+		},
+	}, cs.handleBroadcastToWorkers)
+
 	cs.RegisterTool(Tool{
 		Name:        "assign_task_review",
 		Description: "Assign a worker to review completed implementation. Validates reviewer is ready and different from implementer.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
-				"reviewer_id":    {Type: "string", Description: "Worker ID to assign as reviewer (e.g., 'worker-2')"},
+				"reviewer_id":     {Type: "string", Description: "Worker ID to assign as reviewer (e.g., 'worker-2')"},
+				"task_id":         {Type: "string", Description: "The bd task ID being reviewed"},
+				"implementer_id":  {Type: "string", Description: "Worker ID who implemented the task"},
+				"summary":         {Type: "string", Description: "Brief summary of what was implemented"},
+				"review_type":     {Type: "string", Description: "Review complexity: 'simple' (reviewer checks all dimensions directly) or 'complex' (spawn sub-agents for thorough parallel review). Defaults to 'complex'."},
+				"idempotency_key": {Type: "string", Description: "Optional unique key for this request (e.g., 'review-perles-abc.1-try1'). Reuse it when retrying after a timeout: a repeated call with the same key returns the original result instead of running again."},
+			},
+			Required: []string{"reviewer_id", "task_id", "implementer_id", "summary"},
+		},
+	}, cs.handleAssignTaskReview)
+
+	cs.RegisterTool(Tool{
+		Name: "rotate_reviewer",
+		Description: "Assign a review like assign_task_review, but pick the reviewer automatically: never the implementer, not the implementer's previous reviewer " +
+			"when another ready worker is available, otherwise the ready worker with the fewest reviews. A re-review of the same task keeps its reviewer.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
 				"task_id":        {Type: "string", Description: "The bd task ID being reviewed"},
 				"implementer_id": {Type: "string", Description: "Worker ID who implemented the task"},
 				"summary":        {Type: "string", Description: "Brief summary of what was implemented"},
-				"review_type":    {Type: "string", Description: "Review complexity: 'simple' (reviewer checks all dimensions directly) or 'complex' (spawn sub-agents for thorough parallel review). Defaults to 'complex'."},
+				"review_type":    {Type: "string", Description: "Review complexity: 'simple' or 'complex' (see assign_task_review). Defaults to 'complex'."},
 			},
-			Required: []string{"reviewer_id", "task_id", "implementer_id", "summary"},
+			Required: []string{"task_id", "implementer_id", "summary"},
 		},
-	}, cs.handleAssignTaskReview)
+	}, cs.handleRotateReviewer)
+
+	cs.RegisterTool(Tool{
+		Name:        "query_review_history",
+		Description: "List reviewer/implementer pairings in assignment order and the number of reviews per reviewer. Use to check review balance before assigning reviews.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"worker_id": {Type: "string", Description: "Only list pairings where this worker implemented or reviewed (omit for all)"},
+			},
+			Required: []string{},
+		},
+	}, cs.handleQueryReviewHistory)
+
+	cs.RegisterTool(Tool{
+		Name:        "list_dead_letters",
+		Description: "List commands that failed (e.g., an assignment rejected because the worker was busy), oldest first, with their error and attempt count. Use to find lost assignments and retry them with retry_dead_letter.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"command_type": {Type: "string", Description: "Only list failed commands of this type (e.g., 'assign_task'; omit for all)"},
+			},
+			Required: []string{},
+		},
+	}, cs.handleListDeadLetters)
+
+	cs.RegisterTool(Tool{
+		Name:        "retry_dead_letter",
+		Description: "Resubmit a failed command from list_dead_letters once its cause is fixed (e.g., the worker is ready again). It leaves the list when the retry succeeds. Set discard to drop it without retrying.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"command_id": {Type: "string", Description: "The command_id from list_dead_letters"},
+				"discard":    {Type: "boolean", Description: "Drop the failed command instead of retrying it (default: false)"},
+			},
+			Required: []string{"command_id"},
+		},
+	}, cs.handleRetryDeadLetter)
 
 	cs.RegisterTool(Tool{
 		Name:        "assign_review_feedback",
@@ -397,13 +682,10 @@ func (cs *CoordinatorServer) registerTools() {
 
 	cs.RegisterTool(Tool{
 		Name:        "generate_accountability_summary",
-		Description: "Assign an aggregation task to a worker to collect and merge accountability summaries from all workers into a unified session summary.",
+		Description: "Merge the accountability summaries of all workers into a session report with per-worker and per-task metrics. Writes session_report.md and session_report.json to the session directory.",
 		InputSchema: &InputSchema{
-			Type: "object",
-			Properties: map[string]*PropertySchema{
-				"worker_id": {Type: "string", Description: "The worker ID to assign the aggregation task (e.g., 'worker-1')"},
-			},
-			Required: []string{"worker_id"},
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
 		},
 	}, cs.handleGenerateAccountabilitySummary)
 
@@ -457,6 +739,21 @@ func (cs *CoordinatorServer) registerTools() {
 			Required: []string{"message"},
 		},
 	}, cs.handleNotifyUser)
+
+	cs.RegisterTool(Tool{
+		Name:        "emergency_stop",
+		Description: "EMERGENCY ONLY: Immediately halt every worker mid-turn and post a HALT message to all channels. Use when a worker is doing something destructive (deleting files, force pushing, runaway loops). Workers stay paused until the user explicitly resumes them; you cannot resume them yourself.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"reason": {
+					Type:        "string",
+					Description: "Why the emergency stop is needed (shown to the user and all agents)",
+				},
+			},
+			Required: []string{"reason"},
+		},
+	}, cs.handleEmergencyStop)
 }
 
 // Tool argument structs for JSON parsing.
@@ -471,9 +768,29 @@ type stopWorkerArgs struct {
 }
 
 type assignTaskArgs struct {
-	WorkerID string `json:"worker_id"`
-	TaskID   string `json:"task_id"`
-	Summary  string `json:"summary,omitempty"`
+	WorkerID  string            `json:"worker_id"`
+	TaskID    string            `json:"task_id"`
+	Summary   string            `json:"summary,omitempty"`
+	Template  string            `json:"template,omitempty"`
+	Variables map[string]string `json:"variables,omitempty"`
+	// IdempotencyKey is passed through to the v2Adapter
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+// renderAssignmentTemplate renders the assign_task template from the work dir.
+// task_id and worker_id are always available to the template.
+func (cs *CoordinatorServer) renderAssignmentTemplate(args assignTaskArgs) (string, error) {
+	tmpl, err := LoadAssignmentTemplate(filepath.Join(cs.workDir, AssignmentTemplateDir), args.Template)
+	if err != nil {
+		return "", err
+	}
+
+	vars := make(map[string]string, len(args.Variables)+2)
+	maps.Copy(vars, args.Variables)
+	vars["task_id"] = args.TaskID
+	vars["worker_id"] = args.WorkerID
+
+	return tmpl.Render(vars)
 }
 
 // SpawnIdleWorker spawns a new idle worker via v2Adapter.
@@ -528,10 +845,23 @@ func (cs *CoordinatorServer) handleAssignTask(ctx context.Context, rawArgs json.
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
 
+	// Render the template before posting anything so missing variables fail the call cleanly
+	instructions := args.Summary
+	if args.Template != "" {
+		rendered, err := cs.renderAssignmentTemplate(args)
+		if err != nil {
+			return nil, err
+		}
+		instructions = strings.TrimSpace(rendered + "\n\n" + args.Summary)
+	}
+
 	// Post to Fabric first to create the task thread (no @mention - avoids double notification)
 	var threadID string
 	if cs.fabricService != nil {
 		summary := args.Summary
+		if summary == "" && args.Template != "" {
+			summary = fmt.Sprintf("Task assignment (template %s)", args.Template)
+		}
 		if summary == "" {
 			summary = "Task assignment"
 		}
@@ -541,6 +871,7 @@ func (cs *CoordinatorServer) handleAssignTask(ctx context.Context, rawArgs json.
 			ChannelSlug: "tasks",
 			Content:     content,
 			CreatedBy:   repository.CoordinatorID,
+			Meta:        map[string]string{fabric.MetaTaskID: args.TaskID},
 			// No mentions - worker gets notified via the v2 delivery mechanism
 		})
 		if postErr != nil {
@@ -555,15 +886,17 @@ func (cs *CoordinatorServer) handleAssignTask(ctx context.Context, rawArgs json.
 	// Inject threadID into the args for the v2Adapter
 	// Re-marshal with the threadID included
 	enrichedArgs := struct {
-		WorkerID string `json:"worker_id"`
-		TaskID   string `json:"task_id"`
-		Summary  string `json:"summary,omitempty"`
-		ThreadID string `json:"thread_id,omitempty"`
+		WorkerID       string `json:"worker_id"`
+		TaskID         string `json:"task_id"`
+		Summary        string `json:"summary,omitempty"`
+		ThreadID       string `json:"thread_id,omitempty"`
+		IdempotencyKey string `json:"idempotency_key,omitempty"`
 	}{
-		WorkerID: args.WorkerID,
-		TaskID:   args.TaskID,
-		Summary:  args.Summary,
-		ThreadID: threadID,
+		WorkerID:       args.WorkerID,
+		TaskID:         args.TaskID,
+		Summary:        instructions,
+		ThreadID:       threadID,
+		IdempotencyKey: args.IdempotencyKey,
 	}
 	enrichedRawArgs, err := json.Marshal(enrichedArgs)
 	if err != nil {
@@ -606,7 +939,11 @@ func (cs *CoordinatorServer) handleGetTaskStatus(_ context.Context, rawArgs json
 	}
 
 	// Return the issue as JSON wrapped in an array (for backward compatibility with bd show output)
-	data, err := json.MarshalIndent([]*beads.Issue{issue}, "", "  ")
+	status := taskStatus{Issue: issue}
+	if fields := beads.CustomFields(issue.Labels, cs.customFields); len(fields) > 0 {
+		status.CustomFields = fields
+	}
+	data, err := json.MarshalIndent([]taskStatus{status}, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("marshaling issue: %w", err)
 	}
@@ -614,6 +951,105 @@ func (cs *CoordinatorServer) handleGetTaskStatus(_ context.Context, rawArgs json
 	return SuccessResult(string(data)), nil
 }
 
+// taskStatus is a bd issue with its custom field values, as returned by get_task_status.
+type taskStatus struct {
+	*beads.Issue
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
+}
+
+// handleEstimateTask estimates a task's cycle time from closed bd history.
+func (cs *CoordinatorServer) handleEstimateTask(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args taskIDArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	if !isValidTaskID(args.TaskID) {
+		return nil, fmt.Errorf("invalid task_id format: %s", args.TaskID)
+	}
+
+	lister, ok := cs.beadsExecutor.(appbeads.IssueLister)
+	if !ok {
+		return nil, fmt.Errorf("issue history is not available")
+	}
+
+	issue, err := cs.beadsExecutor.ShowIssue(args.TaskID)
+	if err != nil {
+		log.Debug(log.CatMCP, "bd show failed", "taskID", args.TaskID, "error", err)
+		return nil, fmt.Errorf("bd show failed: %w", err)
+	}
+
+	history, err := lister.ListIssues(beads.StatusClosed, estimateHistoryLimit)
+	if err != nil {
+		log.Debug(log.CatMCP, "bd list failed", "error", err)
+		return nil, fmt.Errorf("bd list failed: %w", err)
+	}
+
+	// Cycle times come from the work log; issues without one use their lead time.
+	if commentReader, ok := cs.beadsExecutor.(appbeads.CommentReader); ok {
+		for i := range history {
+			if history[i].Type == beads.TypeEpic {
+				continue
+			}
+			if err := appbeads.LoadWorkLog(commentReader, &history[i]); err != nil {
+				log.Debug(log.CatMCP, "bd comments failed", "taskID", history[i].ID, "error", err)
+			}
+		}
+	}
+
+	est := estimateTask(*issue, history)
+	return StructuredResult(est.Summary(), est), nil
+}
+
+// suggestAssignmentPlanArgs are the arguments for suggest_assignment_plan.
+type suggestAssignmentPlanArgs struct {
+	EpicID      string `json:"epic_id"`
+	MaxParallel int    `json:"max_parallel,omitempty"`
+}
+
+// assignmentPlanStatuses are the child statuses suggest_assignment_plan reads.
+// Closed tasks are needed to tell satisfied blockers from blockers outside the epic.
+var assignmentPlanStatuses = []beads.Status{beads.StatusOpen, beads.StatusInProgress, beads.StatusBlocked, beads.StatusClosed}
+
+// handleSuggestAssignmentPlan orders an epic's unfinished tasks into parallel waves.
+func (cs *CoordinatorServer) handleSuggestAssignmentPlan(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args suggestAssignmentPlanArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.EpicID == "" {
+		return nil, fmt.Errorf("epic_id is required")
+	}
+	if !isValidTaskID(args.EpicID) {
+		return nil, fmt.Errorf("invalid epic_id format: %s", args.EpicID)
+	}
+	if args.MaxParallel < 0 {
+		return nil, fmt.Errorf("max_parallel must not be negative")
+	}
+
+	lister, ok := cs.beadsExecutor.(appbeads.ChildLister)
+	if !ok {
+		return nil, fmt.Errorf("listing epic tasks is not available")
+	}
+
+	var children []beads.Issue
+	for _, status := range assignmentPlanStatuses {
+		issues, err := lister.ListChildren(args.EpicID, status)
+		if err != nil {
+			log.Debug(log.CatMCP, "bd list failed", "epicID", args.EpicID, "status", status, "error", err)
+			return nil, fmt.Errorf("bd list failed: %w", err)
+		}
+		children = append(children, issues...)
+	}
+
+	plan := planning.Build(args.EpicID, children, planning.Options{MaxParallel: args.MaxParallel})
+	return StructuredResult(plan.Markdown(), plan), nil
+}
+
 // handleMarkTaskComplete marks a task as complete in bd.
 // Routes through v2Adapter which uses the command processor to update BD.
 func (cs *CoordinatorServer) handleMarkTaskComplete(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
@@ -626,17 +1062,270 @@ func (cs *CoordinatorServer) handleMarkTaskFailed(ctx context.Context, rawArgs j
 	return cs.v2Adapter.HandleMarkTaskFailed(ctx, rawArgs)
 }
 
+// exportThreadArgs are the arguments for export_thread_to_issue.
+type exportThreadArgs struct {
+	ThreadID string `json:"thread_id"`
+	IssueID  string `json:"issue_id,omitempty"`
+}
+
+// handleExportThreadToIssue posts a fabric thread as a comment on a bd issue.
+func (cs *CoordinatorServer) handleExportThreadToIssue(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args exportThreadArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.ThreadID == "" {
+		return nil, fmt.Errorf("thread_id is required")
+	}
+	if cs.fabricService == nil {
+		return nil, fmt.Errorf("fabric service is not available")
+	}
+
+	export, err := cs.fabricService.ExportThread(args.ThreadID)
+	if err != nil {
+		return nil, fmt.Errorf("export thread: %w", err)
+	}
+
+	issueID := args.IssueID
+	if issueID == "" {
+		issueID = export.IssueID
+	}
+	if issueID == "" {
+		return nil, fmt.Errorf("thread %s is not linked to a task; pass issue_id", export.RootID)
+	}
+	if !isValidTaskID(issueID) {
+		return nil, fmt.Errorf("invalid issue_id format: %s", issueID)
+	}
+
+	if err := cs.beadsExecutor.AddComment(issueID, repository.CoordinatorID, export.Markdown); err != nil {
+		log.Debug(log.CatMCP, "bd comment failed", "issueID", issueID, "threadID", export.RootID, "error", err)
+		return nil, fmt.Errorf("bd comment failed: %w", err)
+	}
+
+	return SuccessResult(fmt.Sprintf("Exported thread %s (%d messages) to %s", export.RootID, export.MessageCount, issueID)), nil
+}
+
+// bdExecArgs are the arguments for bd_exec.
+type bdExecArgs struct {
+	Args []string `json:"args"`
+}
+
+// handleBDExec runs an allowlisted bd subcommand and returns its parsed output.
+func (cs *CoordinatorServer) handleBDExec(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args bdExecArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	bdArgs, spec, err := parseBDExecArgs(args.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	runner, ok := cs.beadsExecutor.(appbeads.CommandRunner)
+	if !ok {
+		return nil, fmt.Errorf("bd passthrough is not available")
+	}
+
+	command := "bd " + strings.Join(bdArgs, " ")
+	if spec.mutating {
+		log.Info(log.CatMCP, "bd_exec modifying tracker", "command", command)
+	}
+
+	output, err := runner.RunCommand(bdArgs...)
+	if err != nil {
+		log.Debug(log.CatMCP, "bd_exec failed", "command", command, "error", err)
+		return nil, fmt.Errorf("%s failed: %w", command, err)
+	}
+
+	return StructuredResult(output, BDExecResult{Command: command, Output: parseBDOutput(output)}), nil
+}
+
+// getEpicStatusArgs are the arguments for get_epic_status.
+type getEpicStatusArgs struct {
+	EpicID       string `json:"epic_id"`
+	BurndownDays *int   `json:"burndown_days,omitempty"`
+}
+
+// handleGetEpicStatus rolls up the tasks of an epic into its progress and burndown.
+func (cs *CoordinatorServer) handleGetEpicStatus(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args getEpicStatusArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.EpicID == "" {
+		return nil, fmt.Errorf("epic_id is required")
+	}
+	if !isValidTaskID(args.EpicID) {
+		return nil, fmt.Errorf("invalid epic_id format: %s", args.EpicID)
+	}
+	days := epicStatusDefaultBurndownDays
+	if args.BurndownDays != nil {
+		if *args.BurndownDays < 0 || *args.BurndownDays > epicStatusMaxBurndownDays {
+			return nil, fmt.Errorf("burndown_days must be between 0 and %d", epicStatusMaxBurndownDays)
+		}
+		days = *args.BurndownDays
+	}
+
+	lister, ok := cs.beadsExecutor.(appbeads.ChildLister)
+	if !ok {
+		return nil, fmt.Errorf("listing epic tasks is not available")
+	}
+	tasks, childEpics, err := collectEpicTasks(lister, args.EpicID)
+	if err != nil {
+		log.Debug(log.CatMCP, "bd list failed", "epicID", args.EpicID, "error", err)
+		return nil, fmt.Errorf("bd list failed: %w", err)
+	}
+
+	status := buildEpicStatus(args.EpicID, tasks, childEpics, time.Now(), days)
+	return StructuredResult(status.Summary(), status), nil
+}
+
+// archiveCompletedTasksArgs are the arguments for archive_completed_tasks.
+type archiveCompletedTasksArgs struct {
+	EpicID         string `json:"epic_id"`
+	MinClosedDays  *int   `json:"min_closed_days,omitempty"`
+	RequireSummary *bool  `json:"require_summary,omitempty"`
+	DryRun         bool   `json:"dry_run,omitempty"`
+}
+
+// handleArchiveCompletedTasks labels an epic's long-closed tasks as archived,
+// refreshes the epic's archive rollup and posts a cleanup report to #general.
+func (cs *CoordinatorServer) handleArchiveCompletedTasks(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args archiveCompletedTasksArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	if args.EpicID == "" {
+		return nil, fmt.Errorf("epic_id is required")
+	}
+	if !isValidTaskID(args.EpicID) {
+		return nil, fmt.Errorf("invalid epic_id format: %s", args.EpicID)
+	}
+	minClosedDays := archiveDefaultMinClosedDays
+	if args.MinClosedDays != nil {
+		if *args.MinClosedDays < 0 {
+			return nil, fmt.Errorf("min_closed_days must not be negative")
+		}
+		minClosedDays = *args.MinClosedDays
+	}
+	requireSummary := args.RequireSummary == nil || *args.RequireSummary
+
+	lister, ok := cs.beadsExecutor.(appbeads.ChildLister)
+	if !ok {
+		return nil, fmt.Errorf("listing epic tasks is not available")
+	}
+	commentReader, ok := cs.beadsExecutor.(appbeads.CommentReader)
+	if requireSummary && !ok {
+		return nil, fmt.Errorf("reading task comments is not available; pass require_summary=false")
+	}
+
+	closed, err := lister.ListChildren(args.EpicID, beads.StatusClosed)
+	if err != nil {
+		log.Debug(log.CatMCP, "bd list failed", "epicID", args.EpicID, "error", err)
+		return nil, fmt.Errorf("bd list failed: %w", err)
+	}
+
+	now := time.Now()
+	minClosed := time.Duration(minClosedDays) * 24 * time.Hour
+	report := ArchiveReport{EpicID: args.EpicID, DryRun: args.DryRun, MinClosedDays: minClosedDays, Archived: []string{}}
+
+	for _, task := range closed {
+		if isArchived(task) {
+			report.TotalArchived++
+			continue
+		}
+
+		var comments []beads.Comment
+		if requireSummary {
+			comments, err = commentReader.GetComments(task.ID)
+			if err != nil {
+				report.Skipped = append(report.Skipped, ArchiveSkip{TaskID: task.ID, Reason: fmt.Sprintf("reading comments failed: %v", err)})
+				continue
+			}
+		}
+		if reason := archiveSkipReason(task, comments, now, minClosed, requireSummary); reason != "" {
+			report.Skipped = append(report.Skipped, ArchiveSkip{TaskID: task.ID, Reason: reason})
+			continue
+		}
+
+		if !args.DryRun {
+			if err := cs.beadsExecutor.SetLabels(task.ID, append(slices.Clone(task.Labels), archivedLabel)); err != nil {
+				log.Debug(log.CatMCP, "bd label failed", "taskID", task.ID, "error", err)
+				report.Skipped = append(report.Skipped, ArchiveSkip{TaskID: task.ID, Reason: fmt.Sprintf("labeling failed: %v", err)})
+				continue
+			}
+		}
+		report.Archived = append(report.Archived, task.ID)
+		report.TotalArchived++
+	}
+
+	if args.DryRun || len(report.Archived) == 0 {
+		return StructuredResult(report.Summary(), report), nil
+	}
+
+	// Rollup and report failures don't undo the archive; surface them in the logs only
+	epic, err := cs.beadsExecutor.ShowIssue(args.EpicID)
+	if err == nil {
+		err = cs.beadsExecutor.UpdateNotes(args.EpicID, updateArchiveRollup(epic.Notes, report.TotalArchived, now))
+	}
+	if err != nil {
+		log.Debug(log.CatMCP, "Failed to update epic archive rollup", "epicID", args.EpicID, "error", err)
+	}
+
+	if cs.fabricService != nil {
+		if _, err := cs.fabricService.SendMessage(fabric.SendMessageInput{
+			ChannelSlug: "general",
+			Content:     report.Markdown(),
+			CreatedBy:   repository.CoordinatorID,
+			Meta:        map[string]string{fabric.MetaTaskID: args.EpicID},
+		}); err != nil {
+			log.Debug(log.CatMCP, "Failed to post cleanup report to #general", "epicID", args.EpicID, "error", err)
+		}
+	}
+
+	return StructuredResult(report.Summary(), report), nil
+}
+
 // handleQueryWorkerState returns detailed worker state including phase.
 // Task assignment details are managed by v2 repositories.
 func (cs *CoordinatorServer) handleQueryWorkerState(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	return cs.v2Adapter.HandleQueryWorkerState(ctx, rawArgs)
 }
 
+// handleBroadcastToWorkers sends one message to all matching workers.
+func (cs *CoordinatorServer) handleBroadcastToWorkers(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleBroadcastToWorkers(ctx, rawArgs)
+}
+
 // handleAssignTaskReview assigns a reviewer to a completed implementation.
 func (cs *CoordinatorServer) handleAssignTaskReview(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	return cs.v2Adapter.HandleAssignTaskReview(ctx, rawArgs)
 }
 
+// handleRotateReviewer assigns a review to an automatically rotated reviewer.
+func (cs *CoordinatorServer) handleRotateReviewer(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleRotateReviewer(ctx, rawArgs)
+}
+
+// handleQueryReviewHistory returns reviewer/implementer pairings and review load.
+func (cs *CoordinatorServer) handleQueryReviewHistory(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleQueryReviewHistory(ctx, rawArgs)
+}
+
+// handleListDeadLetters lists failed commands.
+func (cs *CoordinatorServer) handleListDeadLetters(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleListDeadLetters(ctx, rawArgs)
+}
+
+// handleRetryDeadLetter retries or discards a failed command.
+func (cs *CoordinatorServer) handleRetryDeadLetter(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	return cs.v2Adapter.HandleRetryDeadLetter(ctx, rawArgs)
+}
+
 // handleAssignReviewFeedback sends review feedback to implementer requiring changes.
 func (cs *CoordinatorServer) handleAssignReviewFeedback(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	return cs.v2Adapter.HandleAssignReviewFeedback(ctx, rawArgs)
@@ -675,7 +1364,7 @@ func isValidTaskID(taskID string) bool {
 	return validation.IsValidTaskID(taskID)
 }
 
-// handleGenerateAccountabilitySummary assigns an aggregation task to a worker to merge accountability summaries.
+// handleGenerateAccountabilitySummary merges the workers' accountability summaries into a session report.
 func (cs *CoordinatorServer) handleGenerateAccountabilitySummary(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	if cs.v2Adapter == nil {
 		return nil, fmt.Errorf("v2Adapter required for generate_accountability_summary")
@@ -698,3 +1387,19 @@ func (cs *CoordinatorServer) handleNotifyUser(ctx context.Context, rawArgs json.
 	}
 	return cs.v2Adapter.HandleNotifyUser(ctx, rawArgs)
 }
+
+// handleAdvancePhase moves the workflow DAG to its next phase.
+func (cs *CoordinatorServer) handleAdvancePhase(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	if cs.v2Adapter == nil {
+		return nil, fmt.Errorf("v2Adapter required for advance_phase")
+	}
+	return cs.v2Adapter.HandleAdvancePhase(ctx, rawArgs)
+}
+
+// handleEmergencyStop halts all workers and broadcasts a HALT notice.
+func (cs *CoordinatorServer) handleEmergencyStop(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	if cs.v2Adapter == nil {
+		return nil, fmt.Errorf("v2Adapter required for emergency_stop")
+	}
+	return cs.v2Adapter.HandleEmergencyStop(ctx, rawArgs)
+}
@@ -7,15 +7,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/planning"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
 )
 
 // ptr returns a pointer to the given ProcessPhase value.
@@ -72,16 +77,28 @@ func TestCoordinatorServer_RegistersAllTools(t *testing.T) {
 		"replace_worker",
 		"retire_worker",
 		"get_task_status",
+		"estimate_task",
+		"get_epic_status",
+		"suggest_assignment_plan",
 		"mark_task_complete",
 		"mark_task_failed",
+		"export_thread_to_issue",
+		"bd_exec",
+		"archive_completed_tasks",
 		"query_worker_state",
+		"broadcast_to_workers",
 		"assign_task_review",
+		"rotate_reviewer",
+		"query_review_history",
+		"list_dead_letters",
+		"retry_dead_letter",
 		"assign_review_feedback",
 		"approve_commit",
 		"stop_worker",
 		"generate_accountability_summary",
 		"signal_workflow_complete",
 		"notify_user",
+		"emergency_stop",
 	}
 
 	for _, toolName := range expectedTools {
@@ -247,6 +264,32 @@ func TestCoordinatorServer_GetTaskStatusValidation(t *testing.T) {
 	}
 }
 
+// TestCoordinatorServer_GetTaskStatusCustomFields verifies custom field values are reported for routing.
+func TestCoordinatorServer_GetTaskStatusCustomFields(t *testing.T) {
+	mockExec := mocks.NewMockIssueExecutor(t)
+	mockExec.EXPECT().ShowIssue("perles-cf1").Return(&beads.Issue{
+		ID:     "perles-cf1",
+		Labels: []string{"bug", "component:api"},
+	}, nil)
+
+	cs := NewCoordinatorServer("/tmp/test", 8765, mockExec)
+	cs.SetCustomFields([]beads.FieldDef{{Key: "component", Type: beads.FieldEnum, Options: []string{"api", "ui"}}})
+
+	result, err := cs.handlers["get_task_status"](context.Background(), json.RawMessage(`{"task_id": "perles-cf1"}`))
+	require.NoError(t, err)
+
+	var statuses []struct {
+		ID           string            `json:"id"`
+		Labels       []string          `json:"labels"`
+		CustomFields map[string]string `json:"custom_fields"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &statuses))
+	require.Len(t, statuses, 1)
+	require.Equal(t, "perles-cf1", statuses[0].ID)
+	require.Equal(t, []string{"bug", "component:api"}, statuses[0].Labels)
+	require.Equal(t, map[string]string{"component": "api"}, statuses[0].CustomFields)
+}
+
 // TestCoordinatorServer_MarkTaskCompleteValidation tests input validation for mark_task_complete.
 func TestCoordinatorServer_MarkTaskCompleteValidation(t *testing.T) {
 	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
@@ -1183,3 +1226,153 @@ func TestSignalWorkflowComplete_WithOptionalFields(t *testing.T) {
 	require.Len(t, cmds, 1, "Expected one command")
 	require.Equal(t, command.CmdSignalWorkflowComplete, cmds[0].Type())
 }
+
+func TestCoordinatorServer_ExportThreadToIssue(t *testing.T) {
+	svc := newTestFabricServiceForObserver()
+	root, err := svc.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: "tasks",
+		Content:     "Task: Add login [perles-ab1] assigned to worker-1",
+		CreatedBy:   "coordinator",
+	})
+	require.NoError(t, err)
+	reply, err := svc.Reply(fabric.ReplyInput{MessageID: root.ID, Content: "Going with sessions", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+
+	mockExec := mocks.NewMockIssueExecutor(t)
+	var comment string
+	mockExec.EXPECT().AddComment("perles-ab1", "coordinator", mock.Anything).
+		RunAndReturn(func(_, _, text string) error {
+			comment = text
+			return nil
+		})
+
+	cs := NewCoordinatorServer("/tmp/test", 8765, mockExec)
+	cs.SetFabricService(svc)
+
+	// issue_id is inferred from the task assignment thread
+	result, err := cs.handlers["export_thread_to_issue"](context.Background(), json.RawMessage(`{"thread_id":"`+reply.ID+`"}`))
+	require.NoError(t, err)
+	require.Equal(t, "Exported thread "+root.ID+" (2 messages) to perles-ab1", result.Content[0].Text)
+	require.Contains(t, comment, "Task: Add login [perles-ab1]")
+	require.Contains(t, comment, "Going with sessions")
+}
+
+func TestCoordinatorServer_ExportThreadToIssueValidation(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	handler := cs.handlers["export_thread_to_issue"]
+
+	_, err := handler(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "thread_id is required")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"thread_id":"abc"}`))
+	require.EqualError(t, err, "fabric service is not available")
+
+	svc := newTestFabricServiceForObserver()
+	root, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: "general", Content: "Caching design", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+	cs.SetFabricService(svc)
+
+	_, err = handler(context.Background(), json.RawMessage(`{"thread_id":"`+root.ID+`"}`))
+	require.ErrorContains(t, err, "is not linked to a task")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"thread_id":"`+root.ID+`","issue_id":"bad id!"}`))
+	require.ErrorContains(t, err, "invalid issue_id format")
+}
+
+// statusChildLister serves an epic's children filtered by status.
+type statusChildLister struct {
+	*mocks.MockIssueExecutor
+	children []beads.Issue
+}
+
+func (l *statusChildLister) ListChildren(_ string, status beads.Status) ([]beads.Issue, error) {
+	var out []beads.Issue
+	for _, issue := range l.children {
+		if issue.Status == status {
+			out = append(out, issue)
+		}
+	}
+	return out, nil
+}
+
+func TestCoordinatorServer_SuggestAssignmentPlan(t *testing.T) {
+	exec := &statusChildLister{
+		MockIssueExecutor: mocks.NewMockIssueExecutor(t),
+		children: []beads.Issue{
+			{ID: "perles-ep1.1", TitleText: "Schema", Status: beads.StatusClosed},
+			{ID: "perles-ep1.2", TitleText: "API", Status: beads.StatusOpen, BlockedBy: []string{"perles-ep1.1"}},
+			{ID: "perles-ep1.3", TitleText: "UI", Status: beads.StatusOpen, BlockedBy: []string{"perles-ep1.2"}},
+			{ID: "perles-ep1.4", TitleText: "Docs", Status: beads.StatusInProgress},
+			{ID: "perles-ep1.5", TitleText: "Deferred", Status: beads.StatusDeferred},
+		},
+	}
+
+	cs := NewCoordinatorServer("/tmp/test", 8765, exec)
+	result, err := cs.handlers["suggest_assignment_plan"](context.Background(), json.RawMessage(`{"epic_id":"perles-ep1"}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	plan, ok := result.StructuredContent.(planning.Plan)
+	require.True(t, ok)
+	require.Len(t, plan.Waves, 2)
+	require.Equal(t, []string{"perles-ep1.2"}, plan.Ready())
+	require.Equal(t, "perles-ep1.3", plan.Waves[1].Tasks[0].ID)
+	require.Contains(t, result.Content[0].Text, "assign now: perles-ep1.2")
+}
+
+func TestCoordinatorServer_SuggestAssignmentPlanValidation(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	handler := cs.handlers["suggest_assignment_plan"]
+
+	_, err := handler(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "epic_id is required")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"bad id!"}`))
+	require.ErrorContains(t, err, "invalid epic_id format")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"perles-ep1","max_parallel":-1}`))
+	require.EqualError(t, err, "max_parallel must not be negative")
+
+	// The plain mock cannot list children.
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"perles-ep1"}`))
+	require.EqualError(t, err, "listing epic tasks is not available")
+}
+
+func TestCoordinatorServer_SetWorkflowDAG(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	_, ok := cs.tools["advance_phase"]
+	require.False(t, ok, "advance_phase is only registered with a workflow DAG")
+
+	def, err := dag.Parse([]byte(`
+phases:
+  - id: research
+    description: Explore the codebase
+    next:
+      - to: review
+  - id: review
+    checkpoint: true
+    next:
+      - to: implement
+        when: tasks_complete
+  - id: implement
+`))
+	require.NoError(t, err)
+	cs.SetWorkflowDAG(dag.NewRun(def))
+
+	tool, ok := cs.tools["advance_phase"]
+	require.True(t, ok)
+	require.Contains(t, tool.Description, "- research: Explore the codebase -> review")
+	require.Contains(t, tool.Description, "- review [checkpoint] -> implement (when tasks_complete)")
+	require.Equal(t, []string{"phase"}, tool.InputSchema.Required)
+
+	v2handler, cleanup := injectV2AdapterToCoordinator(t, cs)
+	defer cleanup()
+
+	result, err := cs.handlers["advance_phase"](context.Background(), json.RawMessage(`{"phase":"review"}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+
+	cmds := v2handler.GetCommands()
+	require.Len(t, cmds, 1)
+	require.Equal(t, command.CmdAdvancePhase, cmds[0].Type())
+}
@@ -1,49 +1,108 @@
 package mcp
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"hash/fnv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/zjrosen/perles/internal/log"
 )
 
 // DefaultDeduplicationWindow is the default time window for detecting duplicates.
-// Messages with identical content sent to the same worker within this window
-// are considered duplicates and silently dropped.
+// Messages with identical content sent to the same recipient within this window
+// are considered duplicates and are not sent again.
 const DefaultDeduplicationWindow = 5 * time.Second
 
+// DedupStrategy decides which messages count as duplicates of each other.
+type DedupStrategy string
+
+const (
+	// DedupPerRecipient treats identical content sent to the same recipient as
+	// a duplicate. This is the default.
+	DedupPerRecipient DedupStrategy = "per_recipient"
+	// DedupExact treats identical content as a duplicate regardless of the
+	// recipient, so an announcement sent to several places goes out once.
+	DedupExact DedupStrategy = "exact"
+	// DedupNormalized is like DedupExact but ignores case and whitespace
+	// differences, catching resends an agent reworded only cosmetically.
+	DedupNormalized DedupStrategy = "normalized"
+)
+
+// DedupPolicy configures a MessageDeduplicator.
+type DedupPolicy struct {
+	Window   time.Duration // Zero uses DefaultDeduplicationWindow
+	Strategy DedupStrategy // Empty uses DedupPerRecipient
+}
+
+// DedupStats counts the messages a deduplicator has checked and suppressed.
+type DedupStats struct {
+	Strategy   DedupStrategy
+	Window     time.Duration
+	Checked    int64
+	Suppressed int64
+	Bypassed   int64 // Sends that skipped the check with force
+}
+
 // MessageDeduplicator tracks recent messages to detect and prevent duplicates.
 // It uses hash-based tracking with time-windowed expiration.
 // Thread-safe for concurrent use.
 type MessageDeduplicator struct {
 	seen            map[uint64]time.Time // hash -> first seen timestamp
 	window          time.Duration        // deduplication time window
+	strategy        DedupStrategy        // what counts as a duplicate
 	mu              sync.Mutex           // protects seen map
+	checkedCount    atomic.Int64         // observability counter
 	suppressedCount atomic.Int64         // observability counter
+	bypassedCount   atomic.Int64         // observability counter
 }
 
 // NewMessageDeduplicator creates a new deduplicator with the given time window.
 // If window is zero or negative, DefaultDeduplicationWindow is used.
 func NewMessageDeduplicator(window time.Duration) *MessageDeduplicator {
-	if window <= 0 {
-		window = DefaultDeduplicationWindow
+	return NewMessageDeduplicatorWithPolicy(DedupPolicy{Window: window})
+}
+
+// NewMessageDeduplicatorWithPolicy creates a deduplicator for policy, applying
+// the default window and strategy to unset fields.
+func NewMessageDeduplicatorWithPolicy(policy DedupPolicy) *MessageDeduplicator {
+	if policy.Window <= 0 {
+		policy.Window = DefaultDeduplicationWindow
+	}
+	if policy.Strategy == "" {
+		policy.Strategy = DedupPerRecipient
 	}
 	return &MessageDeduplicator{
-		seen:   make(map[uint64]time.Time),
-		window: window,
+		seen:     make(map[uint64]time.Time),
+		window:   policy.Window,
+		strategy: policy.Strategy,
 	}
 }
 
 // IsDuplicate checks if a message is a duplicate within the time window.
-// Returns true if this exact workerID+message combination was seen recently.
+// Returns true if this workerID+message combination, as compared by the
+// deduplicator's strategy, was seen recently.
 // Thread-safe.
 func (d *MessageDeduplicator) IsDuplicate(workerID, message string) bool {
-	hash := computeHash(workerID, message)
+	return d.check("", workerID, message)
+}
+
+// check records a message from sender to recipient and reports whether it
+// duplicates one seen within the window. Messages of different senders never
+// duplicate each other.
+func (d *MessageDeduplicator) check(sender, recipient, message string) bool {
 	now := time.Now()
+	d.checkedCount.Add(1)
 
 	d.mu.Lock()
 	defer d.mu.Unlock()
 
+	hash := d.hash(sender, recipient, message)
+
 	// Lazy cleanup of expired entries
 	for h, ts := range d.seen {
 		if now.Sub(ts) >= d.window {
@@ -64,6 +123,27 @@ func (d *MessageDeduplicator) IsDuplicate(workerID, message string) bool {
 	return false
 }
 
+// hash returns the key a message is tracked under for the strategy.
+// Callers must hold d.mu.
+func (d *MessageDeduplicator) hash(sender, recipient, message string) uint64 {
+	switch d.strategy {
+	case DedupExact:
+		return computeHash(sender, message)
+	case DedupNormalized:
+		return computeHash(sender, normalizeContent(message))
+	default:
+		if sender != "" {
+			recipient = sender + ">" + recipient
+		}
+		return computeHash(recipient, message)
+	}
+}
+
+// normalizeContent lowercases a message and collapses all runs of whitespace.
+func normalizeContent(message string) string {
+	return strings.Join(strings.Fields(strings.ToLower(message)), " ")
+}
+
 // computeHash generates a 64-bit FNV-1a hash of workerID and message.
 func computeHash(workerID, message string) uint64 {
 	h := fnv.New64a()
@@ -74,6 +154,23 @@ func computeHash(workerID, message string) uint64 {
 	return h.Sum64()
 }
 
+// SetPolicy replaces the window and strategy and forgets all tracked messages.
+// Counters are kept.
+func (d *MessageDeduplicator) SetPolicy(policy DedupPolicy) {
+	if policy.Window <= 0 {
+		policy.Window = DefaultDeduplicationWindow
+	}
+	if policy.Strategy == "" {
+		policy.Strategy = DedupPerRecipient
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.window = policy.Window
+	d.strategy = policy.Strategy
+	d.seen = make(map[uint64]time.Time)
+}
+
 // Len returns the number of tracked messages (for testing/debugging).
 func (d *MessageDeduplicator) Len() int {
 	d.mu.Lock()
@@ -86,10 +183,87 @@ func (d *MessageDeduplicator) Clear() {
 	d.mu.Lock()
 	defer d.mu.Unlock()
 	d.seen = make(map[uint64]time.Time)
+	d.checkedCount.Store(0)
 	d.suppressedCount.Store(0)
+	d.bypassedCount.Store(0)
 }
 
 // SuppressedCount returns the number of duplicate messages that were suppressed.
 func (d *MessageDeduplicator) SuppressedCount() int64 {
 	return d.suppressedCount.Load()
 }
+
+// Stats returns the deduplicator's configuration and counters.
+func (d *MessageDeduplicator) Stats() DedupStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return DedupStats{
+		Strategy:   d.strategy,
+		Window:     d.window,
+		Checked:    d.checkedCount.Load(),
+		Suppressed: d.suppressedCount.Load(),
+		Bypassed:   d.bypassedCount.Load(),
+	}
+}
+
+// dedupArgs are the arguments of the message-sending tools that identify the
+// recipient and content of a message.
+type dedupArgs struct {
+	Channel   string `json:"channel"`
+	MessageID string `json:"message_id"`
+	WorkerID  string `json:"worker_id"`
+	TaskID    string `json:"task_id"`
+	Content   string `json:"content"`
+	Summary   string `json:"summary"`
+	Force     bool   `json:"force"`
+}
+
+// message returns the recipient and content of a call of tool, or false if
+// the tool does not send messages.
+func (a dedupArgs) message(tool string) (recipient, content string, ok bool) {
+	switch tool {
+	case "fabric_send":
+		return "#" + a.Channel, a.Content, true
+	case "fabric_reply":
+		return a.MessageID, a.Content, true
+	case "assign_task":
+		return a.WorkerID, a.TaskID + "\n" + a.Summary, true
+	}
+	return "", "", false
+}
+
+// dedupMessages suppresses calls of message-sending tools (fabric_send,
+// fabric_reply, assign_task) that repeat a message the caller sent within the
+// deduplication window. A suppressed call succeeds without sending and tells
+// the agent how to resend intentionally: calls with force set skip the check.
+// Calls of other tools pass through.
+func (s *Server) dedupMessages(next ToolHandler) ToolHandler {
+	return func(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+		s.mu.RLock()
+		d := s.dedup
+		s.mu.RUnlock()
+		if d == nil {
+			return next(ctx, rawArgs)
+		}
+
+		var args dedupArgs
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return next(ctx, rawArgs)
+		}
+		recipient, content, ok := args.message(ToolNameFromContext(ctx))
+		if !ok {
+			return next(ctx, rawArgs)
+		}
+		if args.Force {
+			d.bypassedCount.Add(1)
+			return next(ctx, rawArgs)
+		}
+		if d.check(s.processID(), recipient, content) {
+			log.Debug(log.CatMCP, "Duplicate message suppressed", "caller", s.processID(), "recipient", recipient)
+			return SuccessResult(fmt.Sprintf(
+				"Duplicate suppressed: you sent the same message less than %s ago, so it was not sent again. "+
+					"If you meant to resend it, call again with force=true.", d.Stats().Window)), nil
+		}
+		return next(ctx, rawArgs)
+	}
+}
@@ -276,3 +276,37 @@ func TestComputeHash_OrderMatters(t *testing.T) {
 	hash2 := computeHash("worker-1", "hello")
 	assert.NotEqual(t, hash1, hash2)
 }
+
+func TestMessageDeduplicator_Strategies(t *testing.T) {
+	perRecipient := NewMessageDeduplicatorWithPolicy(DedupPolicy{})
+	assert.False(t, perRecipient.check("coordinator", "#tasks", "hello"))
+	assert.False(t, perRecipient.check("coordinator", "#general", "hello"), "same content to another recipient is sent")
+	assert.True(t, perRecipient.check("coordinator", "#tasks", "hello"))
+	assert.False(t, perRecipient.check("worker-1", "#tasks", "hello"), "other senders never duplicate")
+
+	exact := NewMessageDeduplicatorWithPolicy(DedupPolicy{Strategy: DedupExact})
+	assert.False(t, exact.check("coordinator", "#tasks", "hello"))
+	assert.True(t, exact.check("coordinator", "#general", "hello"))
+	assert.False(t, exact.check("coordinator", "#general", "Hello"))
+
+	normalized := NewMessageDeduplicatorWithPolicy(DedupPolicy{Strategy: DedupNormalized})
+	assert.False(t, normalized.check("coordinator", "#tasks", "Task  done\n"))
+	assert.True(t, normalized.check("coordinator", "#general", "task done"))
+
+	stats := normalized.Stats()
+	assert.Equal(t, DedupNormalized, stats.Strategy)
+	assert.Equal(t, DefaultDeduplicationWindow, stats.Window)
+	assert.Equal(t, int64(2), stats.Checked)
+	assert.Equal(t, int64(1), stats.Suppressed)
+}
+
+func TestMessageDeduplicator_SetPolicy(t *testing.T) {
+	dedup := NewMessageDeduplicator(time.Minute)
+	assert.False(t, dedup.IsDuplicate("worker-1", "hello"))
+
+	dedup.SetPolicy(DedupPolicy{Window: time.Second, Strategy: DedupExact})
+	assert.Equal(t, 0, dedup.Len(), "tracked messages are forgotten")
+	assert.Equal(t, DedupExact, dedup.Stats().Strategy)
+	assert.Equal(t, time.Second, dedup.Stats().Window)
+	assert.Equal(t, int64(1), dedup.Stats().Checked, "counters are kept")
+}
@@ -0,0 +1,113 @@
+package mcp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// Epic status tuning.
+const (
+	// epicStatusDefaultBurndownDays is how many days of burndown get_epic_status returns.
+	epicStatusDefaultBurndownDays = 14
+	// epicStatusMaxBurndownDays caps the burndown_days argument.
+	epicStatusMaxBurndownDays = 90
+	// epicStatusMaxDepth caps how deep child epics are rolled up.
+	epicStatusMaxDepth = 5
+)
+
+// epicTaskStatuses are the statuses listed to collect all tasks of an epic.
+var epicTaskStatuses = []beads.Status{
+	beads.StatusOpen,
+	beads.StatusInProgress,
+	beads.StatusBlocked,
+	beads.StatusDeferred,
+	beads.StatusClosed,
+}
+
+// EpicStatus is the structured result of get_epic_status.
+type EpicStatus struct {
+	beads.EpicProgress
+	Percent         float64 `json:"percent"`
+	WeightedPercent float64 `json:"weighted_percent"`
+	// ChildEpics are the nested epics whose tasks are rolled up.
+	ChildEpics []string `json:"child_epics,omitempty"`
+	// BlockedTasks lists the blocked tasks, which usually need the coordinator's attention.
+	BlockedTasks []string `json:"blocked_tasks,omitempty"`
+}
+
+// Summary returns a one-line human readable description of the epic's progress.
+func (s EpicStatus) Summary() string {
+	if s.Total == 0 {
+		return fmt.Sprintf("%s has no tasks", s.EpicID)
+	}
+	summary := fmt.Sprintf("%s: %d/%d task(s) closed (%.0f%%, %.0f%% by size); %d open, %d in progress, %d blocked",
+		s.EpicID, s.Closed, s.Total, s.Percent, s.WeightedPercent, s.Open, s.InProgress, s.Blocked)
+	if s.Deferred > 0 {
+		summary += fmt.Sprintf(", %d deferred", s.Deferred)
+	}
+	if len(s.BlockedTasks) > 0 {
+		summary += " (blocked: " + strings.Join(s.BlockedTasks, ", ") + ")"
+	}
+	return summary
+}
+
+// collectEpicTasks lists the tasks of epicID, descending into child epics.
+// It returns the tasks and the IDs of the child epics.
+func collectEpicTasks(lister appbeads.ChildLister, epicID string) ([]beads.Issue, []string, error) {
+	var tasks []beads.Issue
+	var childEpics []string
+	visited := map[string]bool{epicID: true}
+
+	var collect func(parentID string, depth int) error
+	collect = func(parentID string, depth int) error {
+		for _, status := range epicTaskStatuses {
+			children, err := lister.ListChildren(parentID, status)
+			if err != nil {
+				return err
+			}
+			for _, child := range children {
+				if child.Type != beads.TypeEpic {
+					tasks = append(tasks, child)
+					continue
+				}
+				if visited[child.ID] || depth >= epicStatusMaxDepth {
+					continue
+				}
+				visited[child.ID] = true
+				childEpics = append(childEpics, child.ID)
+				if err := collect(child.ID, depth+1); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := collect(epicID, 1); err != nil {
+		return nil, nil, err
+	}
+	return tasks, childEpics, nil
+}
+
+// buildEpicStatus rolls up tasks into the status of epicID with days of burndown up to now.
+func buildEpicStatus(epicID string, tasks []beads.Issue, childEpics []string, now time.Time, days int) EpicStatus {
+	progress := beads.ComputeEpicProgress(epicID, tasks)
+	progress.Burndown = beads.ComputeBurndown(tasks, now, days)
+
+	status := EpicStatus{
+		EpicProgress:    progress,
+		Percent:         progress.Percent(),
+		WeightedPercent: progress.WeightedPercent(),
+		ChildEpics:      childEpics,
+	}
+	for _, task := range tasks {
+		if task.Status == beads.StatusBlocked {
+			status.BlockedTasks = append(status.BlockedTasks, task.ID)
+		}
+	}
+	return status
+}
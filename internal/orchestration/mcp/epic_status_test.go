@@ -0,0 +1,106 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+// epicListingIssueExecutor adds a ChildLister over parent -> status -> children to the generated IssueExecutor mock.
+type epicListingIssueExecutor struct {
+	*mocks.MockIssueExecutor
+	children map[string][]beads.Issue
+	err      error
+}
+
+func (e *epicListingIssueExecutor) ListChildren(parentID string, status beads.Status) ([]beads.Issue, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	var matching []beads.Issue
+	for _, child := range e.children[parentID] {
+		if child.Status == status {
+			matching = append(matching, child)
+		}
+	}
+	return matching, nil
+}
+
+func newEpicListingIssueExecutor(t *testing.T) *epicListingIssueExecutor {
+	t.Helper()
+	created := time.Now().AddDate(0, 0, -5)
+	return &epicListingIssueExecutor{
+		MockIssueExecutor: mocks.NewMockIssueExecutor(t),
+		children: map[string][]beads.Issue{
+			"perles-s157": {
+				{ID: "perles-s157.1", Status: beads.StatusClosed, CreatedAt: created, ClosedAt: time.Now(), Labels: []string{"size:l"}},
+				{ID: "perles-s157.2", Status: beads.StatusBlocked, CreatedAt: created},
+				{ID: "perles-s157.3", Status: beads.StatusOpen, Type: beads.TypeEpic},
+			},
+			"perles-s157.3": {
+				{ID: "perles-s157.3.1", Status: beads.StatusInProgress, CreatedAt: created, Labels: []string{"size:xs"}},
+				{ID: "perles-s157.3.2", Status: beads.StatusOpen, Type: beads.TypeEpic}, // loops back below
+			},
+			"perles-s157.3.2": {
+				{ID: "perles-s157.3", Status: beads.StatusOpen, Type: beads.TypeEpic},
+			},
+		},
+	}
+}
+
+func TestCoordinatorServer_GetEpicStatus(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, newEpicListingIssueExecutor(t))
+
+	result, err := cs.handlers["get_epic_status"](context.Background(), json.RawMessage(`{"epic_id":"perles-s157","burndown_days":3}`))
+	require.NoError(t, err)
+
+	status, ok := result.StructuredContent.(EpicStatus)
+	require.True(t, ok)
+	require.Equal(t, 3, status.Total)
+	require.Equal(t, 1, status.Closed)
+	require.Equal(t, 1, status.InProgress)
+	require.Equal(t, 1, status.Blocked)
+	require.Equal(t, 9.0, status.TotalPoints)
+	require.InDelta(t, 55.56, status.WeightedPercent, 0.01)
+	require.Equal(t, []string{"perles-s157.3", "perles-s157.3.2"}, status.ChildEpics)
+	require.Equal(t, []string{"perles-s157.2"}, status.BlockedTasks)
+
+	require.Len(t, status.Burndown, 3)
+	require.Equal(t, 3, status.Burndown[1].Remaining)
+	require.Equal(t, 2, status.Burndown[2].Remaining, "the task closed today is done at the end of today")
+	require.Equal(t, "perles-s157: 1/3 task(s) closed (33%, 56% by size); 0 open, 1 in progress, 1 blocked (blocked: perles-s157.2)",
+		result.Content[0].Text)
+}
+
+func TestCoordinatorServer_GetEpicStatus_Errors(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	handler := cs.handlers["get_epic_status"]
+
+	_, err := handler(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "epic_id is required")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"../x"}`))
+	require.ErrorContains(t, err, "invalid epic_id format")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"perles-s157","burndown_days":365}`))
+	require.EqualError(t, err, "burndown_days must be between 0 and 90")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"epic_id":"perles-s157"}`))
+	require.EqualError(t, err, "listing epic tasks is not available")
+
+	exec := newEpicListingIssueExecutor(t)
+	exec.err = errors.New("bd not found")
+	_, err = NewCoordinatorServer("/tmp/test", 8765, exec).handlers["get_epic_status"](context.Background(), json.RawMessage(`{"epic_id":"perles-s157"}`))
+	require.EqualError(t, err, "bd list failed: bd not found")
+}
+
+func TestEpicStatus_Summary(t *testing.T) {
+	require.Equal(t, "perles-x has no tasks", EpicStatus{EpicProgress: beads.EpicProgress{EpicID: "perles-x"}}.Summary())
+}
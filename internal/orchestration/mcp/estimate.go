@@ -0,0 +1,231 @@
+package mcp
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// Estimation tuning.
+const (
+	// estimateHistoryLimit caps how many closed issues are pulled from bd.
+	estimateHistoryLimit = 500
+	// estimateMaxSamples caps how many of the most similar tasks feed the estimate.
+	estimateMaxSamples = 20
+)
+
+// Similarity weights. A shared epic is the strongest signal, then an explicit
+// size label, then each shared label, then matching issue type.
+const (
+	weightSameEpic    = 4
+	weightSameSize    = 3
+	weightSharedLabel = 1
+	weightSameType    = 1
+)
+
+// Estimate confidence levels.
+const (
+	ConfidenceLow    = "low"
+	ConfidenceMedium = "medium"
+	ConfidenceHigh   = "high"
+)
+
+// TaskEstimate is the structured result of estimate_task.
+type TaskEstimate struct {
+	TaskID       string   `json:"task_id"`
+	SampleSize   int      `json:"sample_size"`
+	LowHours     float64  `json:"low_hours"`
+	LikelyHours  float64  `json:"likely_hours"`
+	HighHours    float64  `json:"high_hours"`
+	Confidence   string   `json:"confidence"`
+	Basis        []string `json:"basis,omitempty"`
+	SimilarTasks []string `json:"similar_tasks,omitempty"`
+}
+
+// Summary returns a one-line human readable description of the estimate.
+func (e TaskEstimate) Summary() string {
+	if e.SampleSize == 0 {
+		return fmt.Sprintf("No closed tasks with usable cycle times to estimate %s", e.TaskID)
+	}
+	return fmt.Sprintf("Estimate for %s: %s – %s (likely %s), %s confidence from %d similar task(s)",
+		e.TaskID,
+		formatEstimateHours(e.LowHours),
+		formatEstimateHours(e.HighHours),
+		formatEstimateHours(e.LikelyHours),
+		e.Confidence,
+		e.SampleSize)
+}
+
+// cycleTime returns how long a closed issue was worked on, from its work log:
+// the accumulated work time, or the time from start to completion. Issues
+// without a work log fall back to their lead time, from creation to close.
+func cycleTime(issue beads.Issue) (time.Duration, bool) {
+	if issue.WorkDuration > 0 {
+		return issue.WorkDuration, true
+	}
+	if !issue.StartedAt.IsZero() {
+		end := issue.CompletedAt
+		if end.IsZero() {
+			end = issue.ClosedAt
+		}
+		if end.After(issue.StartedAt) {
+			return end.Sub(issue.StartedAt), true
+		}
+		return 0, false
+	}
+	if issue.CreatedAt.IsZero() || issue.ClosedAt.IsZero() || !issue.ClosedAt.After(issue.CreatedAt) {
+		return 0, false
+	}
+	return issue.ClosedAt.Sub(issue.CreatedAt), true
+}
+
+// similarity scores how alike a historical issue is to the target and
+// returns which dimensions matched.
+func similarity(target, candidate beads.Issue) (int, []string) {
+	var score int
+	var basis []string
+
+	if target.ParentID != "" && target.ParentID == candidate.ParentID {
+		score += weightSameEpic
+		basis = append(basis, "epic")
+	}
+	if size := sizeLabel(target.Labels); size != "" && size == sizeLabel(candidate.Labels) {
+		score += weightSameSize
+		basis = append(basis, "size")
+	}
+	var shared int
+	for _, l := range target.Labels {
+		if !strings.HasPrefix(l, beads.SizeLabelPrefix) && slices.Contains(candidate.Labels, l) {
+			shared++
+		}
+	}
+	if shared > 0 {
+		score += shared * weightSharedLabel
+		basis = append(basis, "labels")
+	}
+	if target.Type != "" && target.Type == candidate.Type {
+		score += weightSameType
+		basis = append(basis, "type")
+	}
+	return score, basis
+}
+
+func sizeLabel(labels []string) string {
+	for _, l := range labels {
+		if strings.HasPrefix(l, beads.SizeLabelPrefix) {
+			return l
+		}
+	}
+	return ""
+}
+
+// estimateTask builds a cycle-time range for target from closed history.
+// The most similar tasks are used; if nothing matches on epic, size, labels
+// or type, every closed task with a cycle time is used at low confidence.
+func estimateTask(target beads.Issue, history []beads.Issue) TaskEstimate {
+	type sample struct {
+		id       string
+		duration time.Duration
+		score    int
+		basis    []string
+	}
+
+	var all, similar []sample
+	for _, issue := range history {
+		if issue.ID == target.ID || issue.Type == beads.TypeEpic {
+			continue
+		}
+		d, ok := cycleTime(issue)
+		if !ok {
+			continue
+		}
+		score, basis := similarity(target, issue)
+		s := sample{id: issue.ID, duration: d, score: score, basis: basis}
+		all = append(all, s)
+		if score > 0 {
+			similar = append(similar, s)
+		}
+	}
+
+	est := TaskEstimate{TaskID: target.ID}
+	samples := similar
+	if len(samples) == 0 {
+		samples = all
+	}
+	if len(samples) == 0 {
+		est.Confidence = ConfidenceLow
+		return est
+	}
+
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].score > samples[j].score })
+	if len(samples) > estimateMaxSamples {
+		samples = samples[:estimateMaxSamples]
+	}
+
+	durations := make([]time.Duration, len(samples))
+	basisSet := make(map[string]bool)
+	for i, s := range samples {
+		durations[i] = s.duration
+		for _, b := range s.basis {
+			basisSet[b] = true
+		}
+		if i < 5 {
+			est.SimilarTasks = append(est.SimilarTasks, s.id)
+		}
+	}
+	slices.Sort(durations)
+
+	est.SampleSize = len(samples)
+	est.LowHours = roundHours(percentile(durations, 0.25))
+	est.LikelyHours = roundHours(percentile(durations, 0.5))
+	est.HighHours = roundHours(percentile(durations, 0.75))
+
+	for _, b := range []string{"epic", "size", "labels", "type"} {
+		if basisSet[b] {
+			est.Basis = append(est.Basis, b)
+		}
+	}
+
+	switch {
+	case len(similar) == 0 || est.SampleSize < 3:
+		est.Confidence = ConfidenceLow
+	case est.SampleSize < 8:
+		est.Confidence = ConfidenceMedium
+	default:
+		est.Confidence = ConfidenceHigh
+	}
+	return est
+}
+
+// percentile returns the p-th percentile of sorted durations using linear interpolation.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	pos := p * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	frac := pos - float64(lo)
+	return sorted[lo] + time.Duration(frac*float64(sorted[hi]-sorted[lo]))
+}
+
+func roundHours(d time.Duration) float64 {
+	return math.Round(d.Hours()*10) / 10
+}
+
+// formatEstimateHours renders hours as minutes, hours or days for readability.
+func formatEstimateHours(h float64) string {
+	switch {
+	case h < 1:
+		return fmt.Sprintf("%dm", int(math.Round(h*60)))
+	case h < 48:
+		return fmt.Sprintf("%.1fh", h)
+	default:
+		return fmt.Sprintf("%.1fd", h/24)
+	}
+}
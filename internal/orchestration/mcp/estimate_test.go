@@ -0,0 +1,210 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+)
+
+var estimateBase = time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func closedIssue(id string, hours float64, parent string, typ beads.IssueType, labels ...string) beads.Issue {
+	return beads.Issue{
+		ID:        id,
+		Type:      typ,
+		ParentID:  parent,
+		Labels:    labels,
+		Status:    beads.StatusClosed,
+		CreatedAt: estimateBase,
+		ClosedAt:  estimateBase.Add(time.Duration(hours * float64(time.Hour))),
+	}
+}
+
+func TestEstimateTask_PrefersSimilarTasks(t *testing.T) {
+	target := beads.Issue{ID: "perles-ab9", Type: beads.TypeTask, ParentID: "perles-epic", Labels: []string{"ui", "size:s"}}
+
+	history := []beads.Issue{
+		closedIssue("perles-1", 1, "perles-epic", beads.TypeTask, "ui", "size:s"),
+		closedIssue("perles-2", 2, "perles-epic", beads.TypeTask, "ui"),
+		closedIssue("perles-3", 3, "", beads.TypeTask, "size:s"),
+		closedIssue("perles-4", 100, "", beads.TypeBug, "backend"),      // unrelated
+		closedIssue("perles-5", 500, "", beads.TypeEpic),                // epics never count
+		{ID: "perles-6", Type: beads.TypeTask, CreatedAt: estimateBase}, // no close time
+	}
+
+	est := estimateTask(target, history)
+	require.Equal(t, 3, est.SampleSize)
+	require.Equal(t, 1.5, est.LowHours)
+	require.Equal(t, 2.0, est.LikelyHours)
+	require.Equal(t, 2.5, est.HighHours)
+	require.Equal(t, ConfidenceMedium, est.Confidence)
+	require.Equal(t, []string{"epic", "size", "labels", "type"}, est.Basis)
+	require.Equal(t, []string{"perles-1", "perles-2", "perles-3"}, est.SimilarTasks)
+}
+
+func TestEstimateTask_FallsBackToAllHistory(t *testing.T) {
+	target := beads.Issue{ID: "perles-ab9"}
+	history := []beads.Issue{
+		closedIssue("perles-1", 4, "", beads.TypeBug),
+		closedIssue("perles-2", 8, "", beads.TypeChore),
+	}
+
+	est := estimateTask(target, history)
+	require.Equal(t, 2, est.SampleSize)
+	require.Equal(t, 6.0, est.LikelyHours)
+	require.Equal(t, ConfidenceLow, est.Confidence)
+	require.Empty(t, est.Basis)
+}
+
+func TestEstimateTask_CapsSamplesAndRaisesConfidence(t *testing.T) {
+	target := beads.Issue{ID: "perles-x", Type: beads.TypeTask}
+	var history []beads.Issue
+	for i := range 30 {
+		history = append(history, closedIssue(fmt.Sprintf("perles-%d", i), float64(i+1), "", beads.TypeTask))
+	}
+
+	est := estimateTask(target, history)
+	require.Equal(t, estimateMaxSamples, est.SampleSize)
+	require.Equal(t, ConfidenceHigh, est.Confidence)
+	require.Len(t, est.SimilarTasks, 5)
+}
+
+func TestCycleTime(t *testing.T) {
+	// Lead time when there is no work log
+	d, ok := cycleTime(closedIssue("perles-1", 48, "", beads.TypeTask))
+	require.True(t, ok)
+	require.Equal(t, 48*time.Hour, d)
+
+	// The work log's start to completion
+	issue := closedIssue("perles-2", 48, "", beads.TypeTask)
+	issue.StartedAt = estimateBase.Add(40 * time.Hour)
+	issue.CompletedAt = estimateBase.Add(42 * time.Hour)
+	d, ok = cycleTime(issue)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Hour, d)
+
+	// Accumulated work time wins over the span of several attempts
+	issue.WorkDuration = 90 * time.Minute
+	d, ok = cycleTime(issue)
+	require.True(t, ok)
+	require.Equal(t, 90*time.Minute, d)
+
+	// Started but never completed: until close
+	issue = closedIssue("perles-3", 48, "", beads.TypeTask)
+	issue.StartedAt = estimateBase.Add(47 * time.Hour)
+	d, ok = cycleTime(issue)
+	require.True(t, ok)
+	require.Equal(t, time.Hour, d)
+}
+
+func TestEstimateTask_NoHistory(t *testing.T) {
+	est := estimateTask(beads.Issue{ID: "perles-1"}, nil)
+	require.Zero(t, est.SampleSize)
+	require.Equal(t, ConfidenceLow, est.Confidence)
+	require.Equal(t, "No closed tasks with usable cycle times to estimate perles-1", est.Summary())
+}
+
+func TestTaskEstimate_Summary(t *testing.T) {
+	est := TaskEstimate{TaskID: "perles-1", SampleSize: 4, LowHours: 0.5, LikelyHours: 3, HighHours: 72, Confidence: ConfidenceMedium}
+	require.Equal(t, "Estimate for perles-1: 30m – 3.0d (likely 3.0h), medium confidence from 4 similar task(s)", est.Summary())
+}
+
+// listingIssueExecutor adds IssueLister to the generated IssueExecutor mock.
+type listingIssueExecutor struct {
+	*mocks.MockIssueExecutor
+	issues []beads.Issue
+	status beads.Status
+}
+
+func (l *listingIssueExecutor) ListIssues(status beads.Status, _ int) ([]beads.Issue, error) {
+	l.status = status
+	return l.issues, nil
+}
+
+func TestCoordinatorServer_EstimateTask(t *testing.T) {
+	mockExec := mocks.NewMockIssueExecutor(t)
+	mockExec.EXPECT().ShowIssue("perles-ab9").Return(&beads.Issue{ID: "perles-ab9", Type: beads.TypeTask, Labels: []string{"ui"}}, nil)
+	exec := &listingIssueExecutor{
+		MockIssueExecutor: mockExec,
+		issues: []beads.Issue{
+			closedIssue("perles-1", 2, "", beads.TypeTask, "ui"),
+			closedIssue("perles-2", 4, "", beads.TypeTask, "ui"),
+		},
+	}
+
+	cs := NewCoordinatorServer("/tmp/test", 8765, exec)
+	result, err := cs.handlers["estimate_task"](context.Background(), json.RawMessage(`{"task_id":"perles-ab9"}`))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Equal(t, beads.StatusClosed, exec.status)
+
+	est, ok := result.StructuredContent.(TaskEstimate)
+	require.True(t, ok)
+	require.Equal(t, 2, est.SampleSize)
+	require.Equal(t, 3.0, est.LikelyHours)
+	require.Contains(t, result.Content[0].Text, "Estimate for perles-ab9")
+}
+
+// workLogIssueExecutor adds CommentReader to listingIssueExecutor.
+type workLogIssueExecutor struct {
+	*listingIssueExecutor
+	comments map[string][]beads.Comment
+}
+
+func (w *workLogIssueExecutor) GetComments(issueID string) ([]beads.Comment, error) {
+	return w.comments[issueID], nil
+}
+
+func TestCoordinatorServer_EstimateTask_UsesWorkLog(t *testing.T) {
+	mockExec := mocks.NewMockIssueExecutor(t)
+	mockExec.EXPECT().ShowIssue("perles-ab9").Return(&beads.Issue{ID: "perles-ab9", Type: beads.TypeTask, Labels: []string{"ui"}}, nil)
+	exec := &workLogIssueExecutor{
+		listingIssueExecutor: &listingIssueExecutor{
+			MockIssueExecutor: mockExec,
+			issues: []beads.Issue{
+				closedIssue("perles-1", 48, "", beads.TypeTask, "ui"), // worked 1h
+				closedIssue("perles-2", 3, "", beads.TypeTask, "ui"),  // no work log: lead time
+			},
+		},
+		comments: map[string][]beads.Comment{
+			"perles-1": {
+				{Text: beads.FormatWorkStartedComment("worker-1"), CreatedAt: estimateBase.Add(46 * time.Hour)},
+				{Text: beads.FormatWorkCompletedComment("worker-1", time.Hour), CreatedAt: estimateBase.Add(47 * time.Hour)},
+			},
+		},
+	}
+
+	cs := NewCoordinatorServer("/tmp/test", 8765, exec)
+	result, err := cs.handlers["estimate_task"](context.Background(), json.RawMessage(`{"task_id":"perles-ab9"}`))
+	require.NoError(t, err)
+
+	est, ok := result.StructuredContent.(TaskEstimate)
+	require.True(t, ok)
+	require.Equal(t, 2, est.SampleSize)
+	// Samples of 1h (work log) and 3h (lead time), not 48h and 3h
+	require.Equal(t, 1.5, est.LowHours)
+	require.Equal(t, 2.0, est.LikelyHours)
+	require.Equal(t, 2.5, est.HighHours)
+}
+
+func TestCoordinatorServer_EstimateTaskValidation(t *testing.T) {
+	cs := NewCoordinatorServer("/tmp/test", 8765, mocks.NewMockIssueExecutor(t))
+	handler := cs.handlers["estimate_task"]
+
+	_, err := handler(context.Background(), json.RawMessage(`{}`))
+	require.EqualError(t, err, "task_id is required")
+
+	_, err = handler(context.Background(), json.RawMessage(`{"task_id":"bad id!"}`))
+	require.ErrorContains(t, err, "invalid task_id format")
+
+	// The plain mock cannot list history.
+	_, err = handler(context.Background(), json.RawMessage(`{"task_id":"perles-ab1"}`))
+	require.EqualError(t, err, "issue history is not available")
+}
@@ -0,0 +1,247 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/audit"
+	"github.com/zjrosen/perles/internal/orchestration/tracing"
+)
+
+// ToolMiddleware wraps a ToolHandler to add cross-cutting behavior such as
+// logging, validation, tracing or turn enforcement.
+// Middleware functions are composed using ChainToolMiddleware.
+type ToolMiddleware func(next ToolHandler) ToolHandler
+
+// ChainToolMiddleware applies middlewares to a handler in reverse order.
+// The first middleware in the list will be the outermost wrapper.
+// For example: ChainToolMiddleware(handler, logging, validate)
+// Results in: logging(validate(handler))
+func ChainToolMiddleware(handler ToolHandler, middlewares ...ToolMiddleware) ToolHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
+
+// toolNameKey is the context key for the name of the tool being called.
+type toolNameKey struct{}
+
+// contextWithToolName returns a context carrying the name of the called tool.
+func contextWithToolName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, toolNameKey{}, name)
+}
+
+// ToolNameFromContext returns the name of the tool being called, so middleware
+// shared by several tools knows which one it wraps. Empty outside a tool call.
+func ToolNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(toolNameKey{}).(string)
+	return name
+}
+
+// ===========================================================================
+// Server Middleware
+// ===========================================================================
+
+// defaultMiddleware is the chain every tool call of s passes through before
+// the middleware added with Use: logging, rate limiting, tracing, metrics,
+// auditing, argument validation and message deduplication. Rate limited calls
+// are rejected before a span is started; calls with invalid arguments are
+// still audited.
+func (s *Server) defaultMiddleware() []ToolMiddleware {
+	return []ToolMiddleware{s.logCalls, s.limitCalls, s.traceCalls, s.measureCalls, s.auditCalls, s.validateArguments, s.dedupMessages}
+}
+
+// logCalls logs each tool call and its failure.
+func (s *Server) logCalls(next ToolHandler) ToolHandler {
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		name := ToolNameFromContext(ctx)
+		log.Debug(log.CatMCP, "Calling tool", "name", name)
+		result, err := next(ctx, args)
+		if err != nil {
+			log.Debug(log.CatMCP, "Tool execution failed", "name", name, "error", err)
+		}
+		return result, err
+	}
+}
+
+// limitCalls rejects calls over the rate limit without running the tool.
+func (s *Server) limitCalls(next ToolHandler) ToolHandler {
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		s.mu.RLock()
+		limiter := s.rateLimiter
+		s.mu.RUnlock()
+		if limiter == nil {
+			return next(ctx, args)
+		}
+
+		name := ToolNameFromContext(ctx)
+		if err := limiter.Allow(s.processID(), name); err != nil {
+			log.Warn(log.CatMCP, "Tool call rate limited", "name", name, "caller", s.processID(), "error", err)
+			return nil, err
+		}
+		return next(ctx, args)
+	}
+}
+
+// traceCalls creates a span for each tool call if a tracer is configured.
+// The span joins the trace that delivered the caller's current turn, or the
+// trace named by a trace_id argument, so a worker's tool calls appear in the
+// trace of the command that woke it.
+func (s *Server) traceCalls(next ToolHandler) ToolHandler {
+	s.mu.RLock()
+	tracer, turns := s.tracer, s.turnTraces
+	s.mu.RUnlock()
+	if tracer == nil {
+		return next
+	}
+
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		name := ToolNameFromContext(ctx)
+		ctx = turns.ContextFor(ctx, s.processID())
+		ctx = tracing.ContextWithRemoteTraceID(ctx, tracing.TraceIDFromContext(ctx))
+		ctx, span := tracer.Start(ctx, tracing.SpanPrefixMCP+name, trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+
+		// Set span attributes using constants from tracing package
+		span.SetAttributes(attribute.String(tracing.AttrMCPToolName, name))
+
+		// Add caller info if available
+		if s.callerRole != "" {
+			span.SetAttributes(attribute.String(tracing.AttrMCPCallerRole, s.callerRole))
+		}
+		if s.callerID != "" {
+			span.SetAttributes(attribute.String(tracing.AttrMCPCallerID, s.callerID))
+		}
+
+		// Add trace ID to span attributes if available
+		if traceID := tracing.TraceIDFromContext(ctx); traceID != "" {
+			span.SetAttributes(attribute.String("trace_id", traceID))
+		}
+
+		result, err := next(ctx, args)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		} else {
+			span.SetStatus(codes.Ok, "")
+		}
+		return result, err
+	}
+}
+
+// measureCalls records the duration of each tool call if metrics are configured.
+func (s *Server) measureCalls(next ToolHandler) ToolHandler {
+	s.mu.RLock()
+	metrics := s.metrics
+	s.mu.RUnlock()
+	if metrics == nil {
+		return next
+	}
+
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		start := time.Now()
+		result, err := next(ctx, args)
+		failed := err != nil || (result != nil && result.IsError)
+		metrics.RecordToolCall(ctx, ToolNameFromContext(ctx), s.callerRole, time.Since(start), failed)
+		return result, err
+	}
+}
+
+// auditCalls records calls of audited tools in the audit log with their
+// arguments, result and caller. Failing to record a call is logged but does
+// not fail the call.
+func (s *Server) auditCalls(next ToolHandler) ToolHandler {
+	s.mu.RLock()
+	auditLog := s.auditLog
+	s.mu.RUnlock()
+	if auditLog == nil {
+		return next
+	}
+
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		name := ToolNameFromContext(ctx)
+		if !audit.IsAudited(name) {
+			return next(ctx, args)
+		}
+
+		start := time.Now()
+		result, err := next(ctx, args)
+
+		entry := audit.Entry{
+			Timestamp:  start.UTC(),
+			Action:     name,
+			ProcessID:  s.processID(),
+			Arguments:  args,
+			Success:    err == nil && (result == nil || !result.IsError),
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		if result != nil {
+			entry.Result = resultText(result)
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			entry.TraceID = sc.TraceID().String()
+		}
+		if recordErr := auditLog.Record(entry); recordErr != nil {
+			log.Warn(log.CatMCP, "Failed to record audit entry", "name", name, "caller", s.processID(), "error", recordErr)
+		}
+		return result, err
+	}
+}
+
+// resultText joins the text content of a tool result.
+func resultText(result *ToolCallResult) string {
+	var texts []string
+	for _, item := range result.Content {
+		if item.Text != "" {
+			texts = append(texts, item.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// validateArguments rejects calls whose arguments are not an object or miss
+// a property the tool's input schema requires.
+func (s *Server) validateArguments(next ToolHandler) ToolHandler {
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		s.mu.RLock()
+		tool := s.tools[ToolNameFromContext(ctx)]
+		s.mu.RUnlock()
+		if err := validateArguments(tool.InputSchema, args); err != nil {
+			return nil, err
+		}
+		return next(ctx, args)
+	}
+}
+
+// validateArguments checks args against the top level of schema.
+func validateArguments(schema *InputSchema, args json.RawMessage) error {
+	if schema == nil {
+		return nil
+	}
+
+	var props map[string]json.RawMessage
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &props); err != nil {
+			return errors.New("invalid arguments: expected an object")
+		}
+	}
+	for _, name := range schema.Required {
+		if v, ok := props[name]; !ok || string(v) == "null" {
+			return fmt.Errorf("%s is required", name)
+		}
+	}
+	return nil
+}
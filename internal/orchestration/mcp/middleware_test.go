@@ -0,0 +1,196 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zjrosen/perles/internal/orchestration/audit"
+	"github.com/zjrosen/perles/internal/orchestration/tracing"
+)
+
+// recordingMiddleware appends "<label>:<tool>" to calls before calling next.
+func recordingMiddleware(label string, calls *[]string) ToolMiddleware {
+	return func(next ToolHandler) ToolHandler {
+		return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+			*calls = append(*calls, label+":"+ToolNameFromContext(ctx))
+			return next(ctx, args)
+		}
+	}
+}
+
+func TestChainToolMiddleware(t *testing.T) {
+	var calls []string
+	handler := ChainToolMiddleware(func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+		calls = append(calls, "handler")
+		return SuccessResult("ok"), nil
+	}, recordingMiddleware("outer", &calls), recordingMiddleware("inner", &calls))
+
+	_, err := handler(contextWithToolName(context.Background(), "echo"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"outer:echo", "inner:echo", "handler"}, calls)
+}
+
+func TestServer_Middleware(t *testing.T) {
+	var calls []string
+	s := NewServer("test", "1.0.0", WithToolMiddleware(recordingMiddleware("option", &calls)))
+	s.RegisterTool(Tool{Name: "echo", InputSchema: &InputSchema{Type: "object"}},
+		func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+			calls = append(calls, "handler")
+			return SuccessResult("ok"), nil
+		}, recordingMiddleware("tool", &calls))
+	s.Use(recordingMiddleware("use", &calls))
+
+	result, rpcErr := s.handleToolsCall(json.RawMessage(`{"name": "echo", "arguments": {}}`))
+	require.Nil(t, rpcErr)
+	require.False(t, result.(*ToolCallResult).IsError)
+	require.Equal(t, []string{"option:echo", "use:echo", "tool:echo", "handler"}, calls,
+		"server middleware wraps tool middleware, including tools registered before Use")
+
+	// Tool middleware also runs when the registered handler is called directly
+	calls = nil
+	_, err := s.handlers["echo"](context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tool:echo", "handler"}, calls)
+}
+
+func TestServer_ValidatesArguments(t *testing.T) {
+	s := NewServer("test", "1.0.0")
+	called := false
+	s.RegisterTool(Tool{
+		Name: "send",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{"content": {Type: "string"}},
+			Required:   []string{"content"},
+		},
+	}, func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+		called = true
+		return SuccessResult("sent"), nil
+	})
+
+	tests := []struct {
+		name string
+		args string
+		want string
+	}{
+		{"missing", `{}`, "content is required"},
+		{"null", `{"content": null}`, "content is required"},
+		{"no arguments", `null`, "content is required"},
+		{"not an object", `["hi"]`, "invalid arguments: expected an object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, rpcErr := s.handleToolsCall(json.RawMessage(`{"name": "send", "arguments": ` + tt.args + `}`))
+			require.Nil(t, rpcErr)
+			require.True(t, result.(*ToolCallResult).IsError)
+			require.Equal(t, tt.want, result.(*ToolCallResult).Content[0].Text)
+		})
+	}
+	require.False(t, called, "invalid calls do not reach the handler")
+
+	result, _ := s.handleToolsCall(json.RawMessage(`{"name": "send", "arguments": {"content": "hi"}}`))
+	require.False(t, result.(*ToolCallResult).IsError)
+	require.True(t, called)
+}
+
+func TestServer_TracesCallsInTurn(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	tracer := tp.Tracer("test")
+
+	// The delivery span that started worker-1's turn
+	deliverCtx, deliver := tracer.Start(context.Background(), "command.process.deliver_process")
+	turns := tracing.NewTurnTraces()
+	turns.Begin(deliverCtx, "worker-1")
+	deliver.End()
+
+	s := NewServer("test", "1.0.0")
+	s.callerRole, s.callerID = "worker", "worker-1"
+	s.SetTracer(tracer)
+	s.SetTurnTraces(turns)
+	s.RegisterTool(Tool{Name: "echo", InputSchema: &InputSchema{Type: "object"}},
+		func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+			return SuccessResult("ok"), nil
+		})
+
+	_, rpcErr := s.handleToolsCall(json.RawMessage(`{"name": "echo", "arguments": {}}`))
+	require.Nil(t, rpcErr)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	call := spans[1]
+	require.Equal(t, tracing.SpanPrefixMCP+"echo", call.Name)
+	require.Equal(t, trace.SpanKindServer, call.SpanKind)
+	require.Equal(t, deliver.SpanContext().TraceID(), call.SpanContext.TraceID())
+	require.Equal(t, deliver.SpanContext().SpanID(), call.Parent.SpanID())
+}
+
+func TestServer_MeasuresCalls(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	metrics, err := tracing.NewMetrics(mp.Meter("test"))
+	require.NoError(t, err)
+
+	s := NewServer("test", "1.0.0")
+	s.SetMetrics(metrics)
+	s.RegisterTool(Tool{Name: "fail", InputSchema: &InputSchema{Type: "object"}},
+		func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+			return ErrorResult("nope"), nil
+		})
+
+	_, rpcErr := s.handleToolsCall(json.RawMessage(`{"name": "fail", "arguments": {}}`))
+	require.Nil(t, rpcErr)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	hist := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+	require.Len(t, hist.DataPoints, 1)
+	point := hist.DataPoints[0]
+	require.Equal(t, uint64(1), point.Count)
+	phase, _ := point.Attributes.Value(tracing.AttrPipelinePhase)
+	require.Equal(t, tracing.PhaseToolCall, phase.AsString())
+	tool, _ := point.Attributes.Value(tracing.AttrMCPToolName)
+	require.Equal(t, "fail", tool.AsString())
+	failed, _ := point.Attributes.Value(tracing.AttrMCPFailed)
+	require.True(t, failed.AsBool())
+}
+
+func TestServer_AuditsCalls(t *testing.T) {
+	dir := t.TempDir()
+	auditLog, err := audit.Open(dir, audit.Config{})
+	require.NoError(t, err)
+
+	s := NewServer("test", "1.0.0")
+	s.SetAuditLog(auditLog)
+	for _, name := range []string{"spawn_worker", "query_worker_state"} {
+		s.RegisterTool(Tool{Name: name, InputSchema: &InputSchema{Type: "object"}},
+			func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+				return SuccessResult("done"), nil
+			})
+	}
+
+	_, rpcErr := s.handleToolsCall(json.RawMessage(`{"name": "spawn_worker", "arguments": {"backend": "claude"}}`))
+	require.Nil(t, rpcErr)
+	_, rpcErr = s.handleToolsCall(json.RawMessage(`{"name": "query_worker_state", "arguments": {}}`))
+	require.Nil(t, rpcErr)
+	require.NoError(t, auditLog.Close())
+
+	entries, err := audit.Load(dir, audit.Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "only audited tools are recorded")
+	require.Equal(t, "spawn_worker", entries[0].Action)
+	require.Equal(t, "coordinator", entries[0].ProcessID)
+	require.JSONEq(t, `{"backend": "claude"}`, string(entries[0].Arguments))
+	require.True(t, entries[0].Success)
+	require.Equal(t, "done", entries[0].Result)
+}
@@ -54,10 +54,25 @@ func (os *ObserverServer) registerObserverFabricTools(h *fabricmcp.Handlers) {
 		"fabric_inbox",
 		"fabric_history",
 		"fabric_read_thread",
+		"fabric_list_channels",
+		"fabric_graph",
 		"fabric_subscribe",
 		"fabric_ack",
 	}
 
+	// Write tools - send and reply are restricted to #observer below; edits,
+	// deletes and cancelling scheduled messages are author-only, so they only
+	// reach the observer's own posts.
+	writeTools := []string{
+		"fabric_send",
+		"fabric_cancel_scheduled",
+		"fabric_reply",
+		"fabric_attach",
+		"fabric_react",
+		"fabric_edit",
+		"fabric_delete",
+	}
+
 	for _, tool := range fabricmcp.FabricTools() {
 		// Only register read-only tools and restricted write tools
 		isReadOnly := slices.Contains(readOnlyTools, tool.Name)
@@ -82,6 +97,8 @@ func (os *ObserverServer) registerObserverFabricTools(h *fabricmcp.Handlers) {
 		case "fabric_send":
 			// Wrap with channel restriction - only allow #observer
 			handler = os.wrapChannelRestriction(h.HandleSend)
+		case "fabric_cancel_scheduled":
+			handler = h.HandleCancelScheduled
 		case "fabric_reply":
 			// Wrap with reply restriction - only allow replies to #observer threads
 			handler = os.wrapReplyRestriction(h.HandleReply)
@@ -97,10 +114,18 @@ func (os *ObserverServer) registerObserverFabricTools(h *fabricmcp.Handlers) {
 			handler = h.HandleAttach
 		case "fabric_react":
 			handler = h.HandleReact
+		case "fabric_edit":
+			handler = h.HandleEdit
+		case "fabric_delete":
+			handler = h.HandleDelete
+		case "fabric_list_channels":
+			handler = h.HandleListChannels
+		case "fabric_graph":
+			handler = h.HandleGraph
 		}
 
 		// Register read-only tools and restricted write tools
-		if handler != nil && (isReadOnly || tool.Name == "fabric_send" || tool.Name == "fabric_reply" || tool.Name == "fabric_attach" || tool.Name == "fabric_react") {
+		if handler != nil && (isReadOnly || slices.Contains(writeTools, tool.Name)) {
 			os.RegisterTool(mcpTool, handler)
 		}
 	}
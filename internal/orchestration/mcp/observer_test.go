@@ -28,12 +28,17 @@ func TestObserverServer_ReadOnlyTools(t *testing.T) {
 		"fabric_inbox",
 		"fabric_history",
 		"fabric_read_thread",
+		"fabric_list_channels",
+		"fabric_graph",
 		"fabric_subscribe",
 		"fabric_ack",
 		"fabric_send",
+		"fabric_cancel_scheduled",
 		"fabric_reply",
 		"fabric_attach",
 		"fabric_react",
+		"fabric_edit",
+		"fabric_delete",
 	}
 
 	// Verify expected tools are registered
@@ -56,6 +61,8 @@ func TestObserverServer_ReadOnlyTools(t *testing.T) {
 		"report_implementation_complete",
 		"report_review_verdict",
 		"fabric_unsubscribe",
+		"fabric_create_channel",
+		"fabric_archive_channel",
 	}
 
 	for _, toolName := range forbiddenTools {
@@ -7,15 +7,16 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/audit"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/ratelimit"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
@@ -56,6 +57,25 @@ type Server struct {
 	// callerID identifies the specific caller (e.g., worker-1, coordinator).
 	// Used as the mcp.caller.id span attribute.
 	callerID string
+
+	// rateLimiter limits how often the caller may call tools (nil = unlimited).
+	rateLimiter *ratelimit.Limiter
+
+	// dedup suppresses messages the caller repeats within a short window.
+	dedup *MessageDeduplicator
+
+	// metrics records the duration of each tool call (nil = no metrics).
+	metrics *tracing.Metrics
+
+	// auditLog records the calls of audited coordinator tools (nil = no audit log).
+	auditLog *audit.Log
+
+	// turnTraces parents tool call spans on the span that delivered the
+	// caller's current turn (nil = spans start new traces).
+	turnTraces *tracing.TurnTraces
+
+	// middleware wraps every tool call, after the default middleware.
+	middleware []ToolMiddleware
 }
 
 // ServerOption configures a Server.
@@ -85,6 +105,14 @@ func WithCallerInfo(role, id string) ServerOption {
 	}
 }
 
+// WithToolMiddleware adds middleware applied to every tool call of the server.
+// Middleware is applied in order: first middleware wraps outermost.
+func WithToolMiddleware(middlewares ...ToolMiddleware) ServerOption {
+	return func(s *Server) {
+		s.middleware = append(s.middleware, middlewares...)
+	}
+}
+
 // NewServer creates a new MCP server.
 func NewServer(name, version string, opts ...ServerOption) *Server {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -98,6 +126,7 @@ func NewServer(name, version string, opts ...ServerOption) *Server {
 		ctx:      ctx,
 		cancel:   cancel,
 		broker:   pubsub.NewBrokerWithBuffer[events.MCPEvent](128),
+		dedup:    NewMessageDeduplicator(DefaultDeduplicationWindow),
 	}
 
 	for _, opt := range opts {
@@ -107,14 +136,102 @@ func NewServer(name, version string, opts ...ServerOption) *Server {
 	return s
 }
 
-// RegisterTool registers a tool with its handler.
-func (s *Server) RegisterTool(tool Tool, handler ToolHandler) {
+// RegisterTool registers a tool with its handler, wrapped in middlewares that
+// apply to this tool only. The first middleware wraps outermost.
+func (s *Server) RegisterTool(tool Tool, handler ToolHandler, middlewares ...ToolMiddleware) {
+	if len(middlewares) > 0 {
+		chain := ChainToolMiddleware(handler, middlewares...)
+		handler = func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+			return chain(contextWithToolName(ctx, tool.Name), args)
+		}
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.tools[tool.Name] = tool
 	s.handlers[tool.Name] = handler
 }
 
+// Use adds middleware applied to every tool call of the server, including
+// tools registered earlier. It runs inside the default logging, rate
+// limiting, tracing and validation middleware, and outside the middleware
+// passed to RegisterTool.
+func (s *Server) Use(middlewares ...ToolMiddleware) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.middleware = append(s.middleware, middlewares...)
+}
+
+// ToolNames returns the names of the registered tools, sorted.
+func (s *Server) ToolNames() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.tools))
+	for name := range s.tools {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// SetRateLimiter limits the tool calls of this server's caller. The limiter
+// may be shared by the servers of all processes of a workflow; each caller is
+// limited separately.
+func (s *Server) SetRateLimiter(limiter *ratelimit.Limiter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimiter = limiter
+}
+
+// SetDeduplicator replaces the deduplicator that suppresses repeated messages
+// of this server's caller. The deduplicator may be shared by the servers of all
+// processes of a workflow; messages of different callers never duplicate each
+// other.
+func (s *Server) SetDeduplicator(d *MessageDeduplicator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dedup = d
+}
+
+// SetTracer sets the tracer for distributed tracing of MCP tool calls.
+func (s *Server) SetTracer(tracer trace.Tracer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tracer = tracer
+}
+
+// SetMetrics sets the metrics the duration of each tool call is recorded in.
+func (s *Server) SetMetrics(metrics *tracing.Metrics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metrics = metrics
+}
+
+// SetAuditLog sets the log that calls of audited tools (see audit.IsAudited)
+// are recorded in.
+func (s *Server) SetAuditLog(auditLog *audit.Log) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.auditLog = auditLog
+}
+
+// SetTurnTraces makes the spans of this server's tool calls children of the
+// span that delivered the caller's current turn. The TurnTraces may be shared
+// by the servers of all processes of a workflow.
+func (s *Server) SetTurnTraces(turns *tracing.TurnTraces) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.turnTraces = turns
+}
+
+// processID returns the ID the caller is rate limited under.
+func (s *Server) processID() string {
+	if s.callerID == "" {
+		return "coordinator"
+	}
+	return s.callerID
+}
+
 // Broker returns the MCP event broker for session logging.
 func (s *Server) Broker() *pubsub.Broker[events.MCPEvent] {
 	return s.broker
@@ -375,72 +492,31 @@ func (s *Server) handleToolsCall(params json.RawMessage) (any, *RPCError) {
 
 	s.mu.RLock()
 	handler, ok := s.handlers[p.Name]
+	middleware := append(s.defaultMiddleware(), s.middleware...)
 	s.mu.RUnlock()
 
 	if !ok {
 		return nil, NewToolNotFound(p.Name)
 	}
 
-	log.Debug(log.CatMCP, "Calling tool", "name", p.Name)
-
 	// Extract trace context from arguments if present (backwards compatible)
 	traceID := s.extractTraceID(p.Arguments)
 
-	// Set up context with trace ID if available
-	ctx := s.ctx
+	// Set up context with tool name and trace ID if available
+	ctx := contextWithToolName(s.ctx, p.Name)
 	if traceID != "" {
 		ctx = tracing.ContextWithTraceID(ctx, traceID)
 	}
 
-	// Create span for tool execution if tracer is configured
-	var span trace.Span
-	if s.tracer != nil {
-		spanName := tracing.SpanPrefixMCP + p.Name
-		var spanOpts []trace.SpanStartOption
-		spanOpts = append(spanOpts, trace.WithSpanKind(trace.SpanKindServer))
-
-		ctx, span = s.tracer.Start(ctx, spanName, spanOpts...)
-		defer span.End()
-
-		// Set span attributes using constants from tracing package
-		span.SetAttributes(
-			attribute.String(tracing.AttrMCPToolName, p.Name),
-		)
-
-		// Add caller info if available
-		if s.callerRole != "" {
-			span.SetAttributes(attribute.String(tracing.AttrMCPCallerRole, s.callerRole))
-		}
-		if s.callerID != "" {
-			span.SetAttributes(attribute.String(tracing.AttrMCPCallerID, s.callerID))
-		}
-
-		// Add trace ID to span attributes if available
-		if traceID != "" {
-			span.SetAttributes(attribute.String("trace_id", traceID))
-		}
-	}
-
 	// Capture start time for duration calculation
 	startTime := time.Now()
-	result, err := handler(ctx, p.Arguments)
+	result, err := ChainToolMiddleware(handler, middleware...)(ctx, p.Arguments)
 	duration := time.Since(startTime)
 
-	// Record outcome in span if tracing is enabled
-	if span != nil {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		} else {
-			span.SetStatus(codes.Ok, "")
-		}
-	}
-
 	// Publish MCP event for session logging
 	s.publishToolEvent(p.Name, params, result, err, duration, traceID)
 
 	if err != nil {
-		log.Debug(log.CatMCP, "Tool execution failed", "name", p.Name, "error", err)
 		// Return the error as a tool result, not an RPC error
 		return ErrorResult(err.Error()), nil
 	}
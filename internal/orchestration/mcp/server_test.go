@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/ratelimit"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
 )
 
@@ -582,6 +583,83 @@ func TestServer_MCPBroker_CapturesError(t *testing.T) {
 	}
 }
 
+func TestServer_DedupMessages(t *testing.T) {
+	s := NewServer("test", "1.0.0", WithCallerInfo("worker", "worker-1"))
+	dedup := NewMessageDeduplicator(time.Minute)
+	s.SetDeduplicator(dedup)
+
+	calls := 0
+	s.RegisterTool(Tool{
+		Name:        "fabric_send",
+		Description: "Sends a message",
+		InputSchema: &InputSchema{Type: "object"},
+	}, func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+		calls++
+		return SuccessResult("sent"), nil
+	})
+
+	send := func(args string) *ToolCallResult {
+		result, rpcErr := s.handleToolsCall(json.RawMessage(`{"name": "fabric_send", "arguments": ` + args + `}`))
+		require.Nil(t, rpcErr)
+		return result.(*ToolCallResult)
+	}
+
+	require.Equal(t, "sent", send(`{"channel": "tasks", "content": "done"}`).Content[0].Text)
+	result := send(`{"channel": "tasks", "content": "done"}`)
+	require.False(t, result.IsError, "a suppressed send is not an error")
+	require.Contains(t, result.Content[0].Text, "Duplicate suppressed")
+	require.Contains(t, result.Content[0].Text, "force=true")
+	require.Equal(t, 1, calls)
+
+	require.Equal(t, "sent", send(`{"channel": "general", "content": "done"}`).Content[0].Text)
+	require.Equal(t, "sent", send(`{"channel": "tasks", "content": "done", "force": true}`).Content[0].Text)
+	require.Equal(t, 3, calls)
+
+	stats := dedup.Stats()
+	require.Equal(t, int64(3), stats.Checked)
+	require.Equal(t, int64(1), stats.Suppressed)
+	require.Equal(t, int64(1), stats.Bypassed)
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	s := NewServer("test", "1.0.0", WithCallerInfo("worker", "worker-1"))
+	s.SetRateLimiter(ratelimit.NewLimiter(ratelimit.Policy{Tools: map[string]ratelimit.Limit{"fabric_send": {Calls: 1, Per: time.Minute}}}))
+
+	calls := 0
+	s.RegisterTool(Tool{
+		Name:        "fabric_send",
+		Description: "Sends a message",
+		InputSchema: &InputSchema{Type: "object"},
+	}, func(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+		calls++
+		return SuccessResult("sent"), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	eventCh := s.Broker().Subscribe(ctx)
+
+	params := json.RawMessage(`{"name": "fabric_send", "arguments": {}}`)
+	_, rpcErr := s.handleToolsCall(params)
+	require.Nil(t, rpcErr)
+	<-eventCh
+
+	result, rpcErr := s.handleToolsCall(params)
+	require.Nil(t, rpcErr, "a limited call is a tool error, not an RPC error")
+	callResult := result.(*ToolCallResult)
+	require.True(t, callResult.IsError)
+	require.Equal(t, "rate limit exceeded: fabric_send is limited to 1 call per minute per process; retry in 1m0s instead of calling fabric_send in a loop", callResult.Content[0].Text)
+	require.Equal(t, 1, calls, "the tool does not run over the limit")
+
+	select {
+	case event := <-eventCh:
+		require.Equal(t, "error", string(event.Payload.Type))
+		require.Equal(t, "fabric_send", event.Payload.ToolName)
+	case <-time.After(100 * time.Millisecond):
+		require.FailNow(t, "Timeout waiting for MCP event")
+	}
+}
+
 func TestServer_Broker_ReturnsNonNil(t *testing.T) {
 	s := NewServer("test", "1.0.0")
 	require.NotNil(t, s.Broker(), "Broker should not be nil")
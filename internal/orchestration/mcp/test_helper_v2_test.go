@@ -103,6 +103,7 @@ func injectV2AdapterToCoordinator(t *testing.T, cs *CoordinatorServer) (*testV2H
 	proc.RegisterHandler(command.CmdAssignReviewFeedback, handler)
 	proc.RegisterHandler(command.CmdStopProcess, handler)
 	proc.RegisterHandler(command.CmdSignalWorkflowComplete, handler)
+	proc.RegisterHandler(command.CmdAdvancePhase, handler)
 
 	// Start processor in background
 	ctx, cancel := context.WithCancel(context.Background())
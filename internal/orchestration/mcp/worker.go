@@ -7,12 +7,18 @@ import (
 	"strings"
 	"time"
 
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
+	"github.com/zjrosen/perles/internal/orchestration/codesearch"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/client"
 	fabricmcp "github.com/zjrosen/perles/internal/orchestration/fabric/mcp"
 	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
@@ -25,10 +31,22 @@ const (
 // AccountabilityWriter defines the interface for writing worker accountability summaries.
 // This allows the session service to handle storage without tight coupling.
 type AccountabilityWriter interface {
-	// WriteWorkerAccountabilitySummary saves a worker's accountability summary to their session directory.
-	// Returns the file path where the summary was saved.
-	// Note: taskID is embedded in the YAML frontmatter of the content, not passed as parameter.
-	WriteWorkerAccountabilitySummary(workerID string, content []byte) (string, error)
+	// WriteWorkerAccountabilitySummary saves a worker's accountability summary for a task
+	// to their session directory. Returns the file path where the summary was saved.
+	WriteWorkerAccountabilitySummary(workerID, taskID string, content []byte) (string, error)
+}
+
+// DecisionWriter defines the interface for saving decision records (ADRs).
+type DecisionWriter interface {
+	// WriteDecision saves a decision record as the next numbered file in the
+	// session's decisions directory. Returns the file path where it was saved.
+	WriteDecision(slug string, content []byte) (string, error)
+}
+
+// TaskCommentStore reads and adds the bd comments of tasks.
+type TaskCommentStore interface {
+	appbeads.CommentReader
+	AddComment(issueID, author, text string) error
 }
 
 // ToolCallRecorder defines the interface for recording tool calls during worker turns.
@@ -47,9 +65,7 @@ type WorkerServer struct {
 	*Server
 	workerID             string
 	accountabilityWriter AccountabilityWriter
-	// dedup tracks recent messages to prevent duplicate sends to coordinator
-	dedup *MessageDeduplicator
-
+	decisionWriter       DecisionWriter
 	// V2 adapter for command-based processing
 	// See docs/proposals/orchestration-v2-architecture.md for architecture details
 	v2Adapter *adapter.V2Adapter
@@ -61,6 +77,12 @@ type WorkerServer struct {
 
 	// fabricService provides graph-based messaging for fabric_join
 	fabricService *fabric.Service
+
+	// codeSearcher backs search_codebase (nil = not available)
+	codeSearcher *codesearch.Searcher
+
+	// taskComments backs comment_on_task and read_task_comments (nil = not available)
+	taskComments TaskCommentStore
 }
 
 // NewWorkerServer creates a new worker MCP server.
@@ -75,7 +97,6 @@ func NewWorkerServer(workerID string) *WorkerServer {
 			WithCallerInfo("worker", workerID),
 		),
 		workerID: workerID,
-		dedup:    NewMessageDeduplicator(DefaultDeduplicationWindow),
 	}
 
 	ws.registerTools()
@@ -88,6 +109,11 @@ func (ws *WorkerServer) SetAccountabilityWriter(writer AccountabilityWriter) {
 	ws.accountabilityWriter = writer
 }
 
+// SetDecisionWriter sets the decision writer for record_decision.
+func (ws *WorkerServer) SetDecisionWriter(writer DecisionWriter) {
+	ws.decisionWriter = writer
+}
+
 // SetV2Adapter allows setting the v2 adapter after construction.
 func (ws *WorkerServer) SetV2Adapter(adapter *adapter.V2Adapter) {
 	ws.v2Adapter = adapter
@@ -102,19 +128,31 @@ func (ws *WorkerServer) SetTurnEnforcer(enforcer ToolCallRecorder) {
 	ws.enforcer = enforcer
 }
 
+// SetCodeSearcher sets the searcher behind the search_codebase tool.
+// Workers of a workflow share one searcher so they share its symbol index.
+func (ws *WorkerServer) SetCodeSearcher(searcher *codesearch.Searcher) {
+	ws.codeSearcher = searcher
+}
+
+// SetTaskComments sets the bd comment store behind comment_on_task and read_task_comments.
+func (ws *WorkerServer) SetTaskComments(store TaskCommentStore) {
+	ws.taskComments = store
+}
+
 // SetFabricService registers Fabric messaging tools with the worker MCP server.
 // This enables workers to use fabric_inbox, fabric_send, fabric_reply, etc.
 // The agentID is set to the worker's ID for proper message tracking.
+// Threads the worker reads are cached so re-reading a hot thread only fetches what changed.
 // Also stores the service reference for fabric_join to post to #system.
 func (ws *WorkerServer) SetFabricService(svc *fabric.Service) {
 	ws.fabricService = svc
-	handlers := fabricmcp.NewHandlers(svc, ws.workerID)
+	handlers := fabricmcp.NewHandlers(svc, ws.workerID).WithThreadCache(client.DefaultCapacity)
 	ws.registerFabricToolsWithEnforcement(handlers)
 }
 
 // registerFabricToolsWithEnforcement registers Fabric tools with turn enforcement tracking.
-// Unlike the shared registerFabricTools, this wraps handlers to record tool calls
-// for turn completion enforcement (fabric_send, fabric_reply, fabric_ack).
+// Unlike the shared registerFabricTools, the tools that satisfy turn completion
+// (fabric_join, fabric_send, fabric_reply, fabric_ack) are wrapped in recordTurnAttempt.
 func (ws *WorkerServer) registerFabricToolsWithEnforcement(h *fabricmcp.Handlers) {
 	// Tools that satisfy turn completion requirements
 	turnCompletionTools := map[string]bool{
@@ -146,6 +184,8 @@ func (ws *WorkerServer) registerFabricToolsWithEnforcement(h *fabricmcp.Handlers
 			handler = h.HandleInbox
 		case "fabric_send":
 			handler = h.HandleSend
+		case "fabric_cancel_scheduled":
+			handler = h.HandleCancelScheduled
 		case "fabric_reply":
 			handler = h.HandleReply
 		case "fabric_ack":
@@ -162,27 +202,52 @@ func (ws *WorkerServer) registerFabricToolsWithEnforcement(h *fabricmcp.Handlers
 			handler = h.HandleReadThread
 		case "fabric_react":
 			handler = h.HandleReact
+		case "fabric_edit":
+			handler = h.HandleEdit
+		case "fabric_delete":
+			handler = h.HandleDelete
+		case "fabric_list_channels":
+			handler = h.HandleListChannels
+		case "fabric_graph":
+			handler = h.HandleGraph
 		}
 
 		if handler != nil {
-			// Wrap handlers for turn completion tools to record the call
 			if turnCompletionTools[tool.Name] {
-				toolName := tool.Name // Capture for closure
-				originalHandler := handler
-				handler = func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
-					result, err := originalHandler(ctx, args)
-					// Record tool call on success (or even on error - the attempt counts)
-					if ws.enforcer != nil {
-						ws.enforcer.RecordToolCall(ws.workerID, toolName)
-					}
-					return result, err
-				}
+				ws.RegisterTool(mcpTool, handler, ws.recordTurnAttempt)
+			} else {
+				ws.RegisterTool(mcpTool, handler)
 			}
-			ws.RegisterTool(mcpTool, handler)
 		}
 	}
 }
 
+// recordTurnCompletion records calls of a tool that satisfies turn completion
+// with the turn enforcer. Calls that fail with an error are not recorded; a
+// result reporting a processor error still counts as the worker's answer.
+func (ws *WorkerServer) recordTurnCompletion(next ToolHandler) ToolHandler {
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		result, err := next(ctx, args)
+		if err == nil && ws.enforcer != nil {
+			ws.enforcer.RecordToolCall(ws.workerID, ToolNameFromContext(ctx))
+		}
+		return result, err
+	}
+}
+
+// recordTurnAttempt records every call of a fabric tool that satisfies turn
+// completion with the turn enforcer, even when it fails: the attempt shows
+// the worker tried to communicate.
+func (ws *WorkerServer) recordTurnAttempt(next ToolHandler) ToolHandler {
+	return func(ctx context.Context, args json.RawMessage) (*ToolCallResult, error) {
+		result, err := next(ctx, args)
+		if ws.enforcer != nil {
+			ws.enforcer.RecordToolCall(ws.workerID, ToolNameFromContext(ctx))
+		}
+		return result, err
+	}
+}
+
 // registerTools registers all worker tools with the MCP server.
 func (ws *WorkerServer) registerTools() {
 	// report_implementation_complete - Signal implementation is done
@@ -192,27 +257,134 @@ func (ws *WorkerServer) registerTools() {
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
-				"summary":  {Type: "string", Description: "Brief summary of what was implemented"},
+				"summary": {Type: "string", Description: "Brief summary of what was implemented"},
+				"self_assessment": {
+					Type:        "object",
+					Description: "Optional honest self-assessment shown to your reviewer so they know where to look hardest",
+					Properties: map[string]*PropertySchema{
+						"confidence": {Type: "number", Description: "Confidence the task is complete and correct, from 0.0 to 1.0"},
+						"known_gaps": {
+							Type:        "array",
+							Description: "Requirements or edge cases you know are not handled",
+							Items:       &PropertySchema{Type: "string"},
+						},
+						"untested_areas": {
+							Type:        "array",
+							Description: "Code paths or behaviors you did not verify with tests",
+							Items:       &PropertySchema{Type: "string"},
+						},
+					},
+					Required: []string{"confidence"},
+				},
+				"resolved_findings": {
+					Type:        "array",
+					Description: "Resolution for each finding from a DENIED review. Required when re-submitting after review feedback: every finding must be resolved",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"finding_id": {Type: "number", Description: "Finding number from the review checklist"},
+							"resolution": {Type: "string", Description: "How the finding was addressed"},
+						},
+						Required: []string{"finding_id", "resolution"},
+					},
+				},
 				"trace_id": {Type: "string", Description: "Optional trace ID for distributed tracing correlation"},
 			},
 			Required: []string{"summary"},
 		},
-	}, ws.handleReportImplementationComplete)
+	}, ws.handleReportImplementationComplete, ws.recordTurnCompletion)
 
 	// report_review_verdict - Report code review verdict
 	ws.RegisterTool(Tool{
 		Name:        "report_review_verdict",
-		Description: "Report your code review verdict. Use APPROVED if the implementation meets all criteria, DENIED if changes are required.",
+		Description: "Report your code review verdict. Use APPROVED if the implementation meets all criteria, DENIED if changes are required. DENIED verdicts must list structured findings; the implementer resolves each one before re-review.",
 		InputSchema: &InputSchema{
 			Type: "object",
 			Properties: map[string]*PropertySchema{
 				"verdict":  {Type: "string", Description: "Review verdict: 'APPROVED' or 'DENIED'"},
 				"comments": {Type: "string", Description: "Review comments explaining the verdict"},
+				"findings": {
+					Type:        "array",
+					Description: "Required for DENIED, not allowed for APPROVED: the issues that must be fixed",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"file":        {Type: "string", Description: "File the finding applies to"},
+							"location":    {Type: "string", Description: "Optional line, range or symbol (e.g. '42', '10-20', 'func Save')"},
+							"severity":    {Type: "string", Description: "One of: blocker, major, minor, info"},
+							"description": {Type: "string", Description: "What is wrong and what is expected"},
+						},
+						Required: []string{"file", "severity", "description"},
+					},
+				},
+				"scores": {
+					Type:        "object",
+					Description: "Rubric scores from 1 (poor) to 5 (excellent) for every dimension. APPROVED requires correctness >= 4, tests >= 3, style >= 3, security >= 4",
+					Properties: map[string]*PropertySchema{
+						"correctness": {Type: "integer", Description: "Does the change do what the task requires, without bugs"},
+						"tests":       {Type: "integer", Description: "Is the change adequately tested"},
+						"style":       {Type: "integer", Description: "Is the code readable and consistent with the codebase"},
+						"security":    {Type: "integer", Description: "Is the change free of vulnerabilities and unsafe handling"},
+					},
+					Required: []string{"correctness", "tests", "style", "security"},
+				},
 				"trace_id": {Type: "string", Description: "Optional trace ID for distributed tracing correlation"},
 			},
 			Required: []string{"verdict", "comments"},
 		},
-	}, ws.handleReportReviewVerdict)
+	}, ws.handleReportReviewVerdict, ws.recordTurnCompletion)
+
+	// request_assistance - Block until the coordinator or user helps
+	ws.RegisterTool(Tool{
+		Name: "request_assistance",
+		Description: "Ask for help when you are blocked and cannot continue your task or review without input " +
+			"(missing credentials, unclear requirements, a broken dependency, a decision outside your task). " +
+			"You move to the blocked phase and the coordinator is notified immediately. End your turn after calling it; " +
+			"you resume your task when the next message (the answer) is delivered to you. Ask again if it does not unblock you.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"reason":         {Type: "string", Description: "What you need and why you cannot continue without it"},
+				"blocking_issue": {Type: "string", Description: "Optional bd issue ID (or file, service, etc.) that blocks you"},
+				"needed_from":    {Type: "string", Description: "Who can unblock you: 'coordinator' (default), 'user', or a worker ID"},
+			},
+			Required: []string{"reason"},
+		},
+	}, ws.handleRequestAssistance, ws.recordTurnCompletion)
+
+	// propose_subtasks - Split a task that is too big into bd subtasks
+	ws.RegisterTool(Tool{
+		Name: "propose_subtasks",
+		Description: "Split your task when it turns out too big for one change. Creates the subtasks in bd under your task " +
+			"(with size labels and dependencies) and sends them to the coordinator for assignment. " +
+			"You keep your task: continue with the part the subtasks do not cover.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"subtasks": {
+					Type:        "array",
+					Description: "The subtasks to create, in the order they should be done",
+					Items: &PropertySchema{
+						Type: "object",
+						Properties: map[string]*PropertySchema{
+							"title":          {Type: "string", Description: "Short imperative title"},
+							"description":    {Type: "string", Description: "What the subtask covers and how to verify it"},
+							"estimated_size": {Type: "string", Description: "Optional size estimate: xs, s, m, l or xl"},
+							"depends_on": {
+								Type:        "array",
+								Description: "Optional titles of other subtasks in this list, or existing bd issue IDs, that must be done first",
+								Items:       &PropertySchema{Type: "string"},
+							},
+						},
+						Required: []string{"title", "description"},
+					},
+				},
+				"reason":    {Type: "string", Description: "Why the task needs to be split"},
+				"parent_id": {Type: "string", Description: "Optional bd task to split (default: your current task)"},
+			},
+			Required: []string{"subtasks"},
+		},
+	}, ws.handleProposeSubtasks)
 
 	// post_accountability_summary - Save worker accountability summary to session directory
 	ws.RegisterTool(Tool{
@@ -251,6 +423,201 @@ func (ws *WorkerServer) registerTools() {
 			Required: []string{"status", "message"},
 		},
 	}, ws.handlePostAccountabilitySummary)
+
+	// record_decision - Save an architectural decision record to the session directory
+	ws.RegisterTool(Tool{
+		Name: "record_decision",
+		Description: "Record an architectural or design decision you made (library choice, data model, API shape, trade-off) as a numbered decision record " +
+			"in the session's decisions directory. Record decisions other people will want to know the reasons for later, not routine implementation details.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"title":        {Type: "string", Description: "Short name of the decision, e.g. 'Use SQLite for the result cache'"},
+				"context":      {Type: "string", Description: "The problem and constraints that called for a decision"},
+				"decision":     {Type: "string", Description: "What was decided"},
+				"alternatives": {Type: "array", Description: "Options considered and why they were rejected (optional)", Items: &PropertySchema{Type: "string"}},
+				"consequences": {Type: "string", Description: "What follows from the decision: trade-offs, follow-up work, risks"},
+				"status":       {Type: "string", Description: "'accepted' (default), 'proposed' (needs sign-off) or 'superseded'"},
+				"task_id":      {Type: "string", Description: "Task the decision belongs to (default: your current task)"},
+			},
+			Required: []string{"title", "context", "decision", "consequences"},
+		},
+	}, ws.handleRecordDecision)
+
+	// search_codebase - Search the workflow's code
+	ws.RegisterTool(Tool{
+		Name: "search_codebase",
+		Description: "Search the code in your working directory. mode=text (default) finds lines containing the pattern (literal unless regex=true, " +
+			"case-insensitive unless the pattern has uppercase letters); mode=symbol finds definitions of functions, types, classes, etc. whose name contains the pattern. " +
+			"Results are paginated: pass the returned next_offset as offset to get the next page.",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"pattern":       {Type: "string", Description: "Text, regular expression or symbol name to search for"},
+				"mode":          {Type: "string", Description: "'text' (default) or 'symbol'"},
+				"regex":         {Type: "boolean", Description: "Treat pattern as a regular expression (text mode)"},
+				"glob":          {Type: "string", Description: "Only search matching files, e.g. '*.go' or 'internal/**'"},
+				"context_lines": {Type: "number", Description: fmt.Sprintf("Lines of context before and after each hit (default 0, max %d)", codesearch.MaxContextLines)},
+				"offset":        {Type: "number", Description: "Index of the first hit to return (default 0)"},
+				"limit":         {Type: "number", Description: fmt.Sprintf("Hits per page (default %d, max %d)", codesearch.DefaultLimit, codesearch.MaxLimit)},
+			},
+			Required: []string{"pattern"},
+		},
+	}, ws.handleSearchCodebase)
+
+	// comment_on_task - Add to the activity log of the assigned task
+	ws.RegisterTool(Tool{
+		Name: "comment_on_task",
+		Description: "Add a comment to the bd issue of the task you are implementing or reviewing. Comments are the task's permanent activity log " +
+			"shown to the user in the issue view: record findings, blockers and context the next person on the task needs. " +
+			"Pass reply_to to answer an existing comment (IDs from read_task_comments).",
+		InputSchema: &InputSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"text":     {Type: "string", Description: "The comment (markdown)"},
+				"reply_to": {Type: "integer", Description: "Optional ID of the comment this replies to"},
+			},
+			Required: []string{"text"},
+		},
+	}, ws.handleCommentOnTask)
+
+	// read_task_comments - Read the activity log of the assigned task
+	ws.RegisterTool(Tool{
+		Name:        "read_task_comments",
+		Description: "Read the comments on the bd issue of the task you are implementing or reviewing, grouped into threads of replies, oldest first.",
+		InputSchema: &InputSchema{
+			Type:       "object",
+			Properties: map[string]*PropertySchema{},
+		},
+	}, ws.handleReadTaskComments)
+}
+
+// commentOnTaskArgs are the arguments for comment_on_task.
+type commentOnTaskArgs struct {
+	Text    string `json:"text"`
+	ReplyTo int    `json:"reply_to,omitempty"`
+}
+
+// assignedTaskID returns the task the worker is implementing or reviewing.
+func (ws *WorkerServer) assignedTaskID() (string, error) {
+	if ws.v2Adapter != nil {
+		if taskID := ws.v2Adapter.WorkerTaskID(ws.workerID); taskID != "" {
+			return taskID, nil
+		}
+	}
+	return "", fmt.Errorf("you have no assigned task to comment on")
+}
+
+// handleCommentOnTask adds a comment by the worker to its assigned task.
+func (ws *WorkerServer) handleCommentOnTask(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args commentOnTaskArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	text := strings.TrimSpace(args.Text)
+	if text == "" {
+		return nil, fmt.Errorf("text is required")
+	}
+	if args.ReplyTo < 0 {
+		return nil, fmt.Errorf("reply_to must be a comment ID")
+	}
+	if ws.taskComments == nil {
+		return nil, fmt.Errorf("task comments are not available")
+	}
+	taskID, err := ws.assignedTaskID()
+	if err != nil {
+		return nil, err
+	}
+
+	if args.ReplyTo > 0 {
+		text = beads.FormatCommentReply(args.ReplyTo, text)
+	}
+	if err := ws.taskComments.AddComment(taskID, ws.workerID, text); err != nil {
+		log.Debug(log.CatMCP, "Failed to comment on task", "workerID", ws.workerID, "taskID", taskID, "error", err)
+		return nil, fmt.Errorf("failed to add comment: %w", err)
+	}
+	return mcptypes.SuccessResult(fmt.Sprintf("Comment added to %s", taskID)), nil
+}
+
+// taskCommentView is a comment as returned by read_task_comments.
+type taskCommentView struct {
+	ID        int               `json:"id"`
+	Author    string            `json:"author"`
+	Text      string            `json:"text"`
+	CreatedAt string            `json:"created_at"`
+	Replies   []taskCommentView `json:"replies,omitempty"`
+}
+
+func newTaskCommentView(c beads.Comment) taskCommentView {
+	return taskCommentView{ID: c.ID, Author: c.Author, Text: c.Text, CreatedAt: c.CreatedAt.Format(time.RFC3339)}
+}
+
+// handleReadTaskComments returns the threaded comments of the worker's assigned task.
+func (ws *WorkerServer) handleReadTaskComments(_ context.Context, _ json.RawMessage) (*ToolCallResult, error) {
+	if ws.taskComments == nil {
+		return nil, fmt.Errorf("task comments are not available")
+	}
+	taskID, err := ws.assignedTaskID()
+	if err != nil {
+		return nil, err
+	}
+	comments, err := ws.taskComments.GetComments(taskID)
+	if err != nil {
+		log.Debug(log.CatMCP, "Failed to read task comments", "workerID", ws.workerID, "taskID", taskID, "error", err)
+		return nil, fmt.Errorf("failed to read comments: %w", err)
+	}
+
+	threads := make([]taskCommentView, 0, len(comments))
+	for _, thread := range beads.ThreadComments(comments) {
+		view := newTaskCommentView(thread.Comment)
+		for _, reply := range thread.Replies {
+			view.Replies = append(view.Replies, newTaskCommentView(reply))
+		}
+		threads = append(threads, view)
+	}
+	response := map[string]any{
+		"task_id":  taskID,
+		"comments": threads,
+	}
+	data, _ := json.MarshalIndent(response, "", "  ")
+	return StructuredResult(string(data), response), nil
+}
+
+// searchCodebaseArgs are the arguments for search_codebase.
+type searchCodebaseArgs struct {
+	Pattern      string `json:"pattern"`
+	Mode         string `json:"mode,omitempty"`
+	Regex        bool   `json:"regex,omitempty"`
+	Glob         string `json:"glob,omitempty"`
+	ContextLines int    `json:"context_lines,omitempty"`
+	Offset       int    `json:"offset,omitempty"`
+	Limit        int    `json:"limit,omitempty"`
+}
+
+// handleSearchCodebase searches the workflow's code and returns one page of hits.
+func (ws *WorkerServer) handleSearchCodebase(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args searchCodebaseArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if ws.codeSearcher == nil {
+		return nil, fmt.Errorf("code search is not available")
+	}
+
+	result, err := ws.codeSearcher.Search(ctx, codesearch.Query{
+		Pattern:      args.Pattern,
+		Mode:         codesearch.Mode(args.Mode),
+		Regex:        args.Regex,
+		Glob:         args.Glob,
+		ContextLines: args.ContextLines,
+		Offset:       args.Offset,
+		Limit:        args.Limit,
+	})
+	if err != nil {
+		log.Debug(log.CatMCP, "search_codebase failed", "workerID", ws.workerID, "pattern", args.Pattern, "error", err)
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+	return StructuredResult(result.Summary(), result), nil
 }
 
 // RetroFeedback contains structured retrospective feedback for accountability summaries.
@@ -293,12 +660,6 @@ func (ws *WorkerServer) handleReportImplementationComplete(ctx context.Context,
 		return nil, err
 	}
 
-	// Record tool call for turn completion enforcement
-	// Always record even when result indicates an error (processor error, not adapter error)
-	if ws.enforcer != nil {
-		ws.enforcer.RecordToolCall(ws.workerID, "report_implementation_complete")
-	}
-
 	if !result.Success {
 		return mcptypes.ErrorResult(result.Message), nil
 	}
@@ -326,6 +687,85 @@ func (ws *WorkerServer) handleReportImplementationComplete(ctx context.Context,
 	return mcptypes.SuccessResult(result.Message), nil
 }
 
+// requestAssistanceArgs holds arguments for request_assistance tool.
+type requestAssistanceArgs struct {
+	Reason        string `json:"reason"`
+	BlockingIssue string `json:"blocking_issue,omitempty"`
+}
+
+// handleRequestAssistance blocks the worker until it gets the assistance it asks for.
+// Replies to the task's Fabric thread (if available) with @coordinator mention.
+func (ws *WorkerServer) handleRequestAssistance(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args requestAssistanceArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	result, err := ws.v2Adapter.HandleRequestAssistance(ctx, rawArgs, ws.workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Message), nil
+	}
+
+	// Reply to the task's Fabric thread (if available)
+	if ws.fabricService != nil && result.ThreadID != "" {
+		content := fmt.Sprintf("Blocked, requesting assistance: %s @coordinator", args.Reason)
+		if args.BlockingIssue != "" {
+			content = fmt.Sprintf("Blocked by %s, requesting assistance: %s @coordinator", args.BlockingIssue, args.Reason)
+		}
+
+		_, postErr := ws.fabricService.Reply(fabric.ReplyInput{
+			MessageID: result.ThreadID,
+			Content:   content,
+			CreatedBy: ws.workerID,
+			Mentions:  []string{"coordinator"},
+		})
+		if postErr != nil {
+			// Log but don't fail - the worker is blocked and the coordinator notified
+			log.Debug(log.CatMCP, "Failed to reply to task thread",
+				"error", postErr, "threadID", result.ThreadID, "workerID", ws.workerID)
+		}
+	}
+
+	return mcptypes.SuccessResult(result.Message), nil
+}
+
+// handleProposeSubtasks creates subtasks for a task that is too big and hands them to the coordinator.
+// Replies to the task's Fabric thread (if available) with @coordinator mention.
+func (ws *WorkerServer) handleProposeSubtasks(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	result, err := ws.v2Adapter.HandleProposeSubtasks(ctx, rawArgs, ws.workerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Message), nil
+	}
+
+	// Reply to the task's Fabric thread (if available)
+	if ws.fabricService != nil && result.ThreadID != "" {
+		content := fmt.Sprintf("Split off %d subtask(s) for assignment: %s @coordinator",
+			len(result.SubtaskIDs), strings.Join(result.SubtaskIDs, ", "))
+
+		_, postErr := ws.fabricService.Reply(fabric.ReplyInput{
+			MessageID: result.ThreadID,
+			Content:   content,
+			CreatedBy: ws.workerID,
+			Mentions:  []string{"coordinator"},
+		})
+		if postErr != nil {
+			// Log but don't fail - the subtasks exist and the coordinator was notified
+			log.Debug(log.CatMCP, "Failed to reply to task thread",
+				"error", postErr, "threadID", result.ThreadID, "workerID", ws.workerID)
+		}
+	}
+
+	return mcptypes.SuccessResult(result.Message), nil
+}
+
 // handleReportReviewVerdict reports the code review verdict (APPROVED or DENIED).
 // Replies to the task's Fabric thread (if available) with @coordinator mention.
 func (ws *WorkerServer) handleReportReviewVerdict(ctx context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
@@ -334,12 +774,6 @@ func (ws *WorkerServer) handleReportReviewVerdict(ctx context.Context, rawArgs j
 		return nil, err
 	}
 
-	// Record tool call for turn completion enforcement
-	// Always record even when result indicates an error (processor error, not adapter error)
-	if ws.enforcer != nil {
-		ws.enforcer.RecordToolCall(ws.workerID, "report_review_verdict")
-	}
-
 	if !result.Success {
 		return mcptypes.ErrorResult(result.Message), nil
 	}
@@ -350,6 +784,12 @@ func (ws *WorkerServer) handleReportReviewVerdict(ctx context.Context, rawArgs j
 		if result.Comments != "" {
 			content = fmt.Sprintf("Review verdict: %s - %s @coordinator", result.Verdict, result.Comments)
 		}
+		if len(result.Findings) > 0 {
+			content += "\n\nFindings:\n" + strings.Join(result.Findings, "\n")
+		}
+		if result.Scores != "" {
+			content += "\n\nScores: " + result.Scores
+		}
 
 		_, postErr := ws.fabricService.Reply(fabric.ReplyInput{
 			MessageID: result.ThreadID,
@@ -402,7 +842,8 @@ func validateAccountabilitySummaryArgs(args postAccountabilitySummaryArgs) error
 
 // buildAccountabilitySummaryMarkdown generates the markdown content for a worker accountability summary.
 // It includes YAML frontmatter for programmatic access and a markdown body for human readability.
-func buildAccountabilitySummaryMarkdown(workerID string, args postAccountabilitySummaryArgs) string {
+// scores are the task's review rubric scores, omitted when nil.
+func buildAccountabilitySummaryMarkdown(workerID string, args postAccountabilitySummaryArgs, scores repository.ReviewScores) string {
 	var b strings.Builder
 	timestamp := time.Now().Format(time.RFC3339)
 
@@ -431,6 +872,14 @@ func buildAccountabilitySummaryMarkdown(workerID string, args postAccountability
 			b.WriteString(fmt.Sprintf("  - %s\n", issue))
 		}
 	}
+	if scores != nil {
+		b.WriteString("review_scores:\n")
+		for _, dim := range repository.ReviewDimensions {
+			if score, ok := scores[dim]; ok {
+				b.WriteString(fmt.Sprintf("  %s: %d\n", dim, score))
+			}
+		}
+	}
 	b.WriteString("---\n\n")
 
 	// Markdown body - Header with metadata
@@ -444,6 +893,13 @@ func buildAccountabilitySummaryMarkdown(workerID string, args postAccountability
 	b.WriteString(args.Summary)
 	b.WriteString("\n\n")
 
+	// Review Scores section (optional)
+	if scores != nil {
+		b.WriteString("## Review Scores\n\n")
+		b.WriteString(scores.String())
+		b.WriteString("\n\n")
+	}
+
 	// Verification Points section (optional)
 	if len(args.VerificationPoints) > 0 {
 		b.WriteString("## Verification Points\n\n")
@@ -497,6 +953,102 @@ func buildAccountabilitySummaryMarkdown(workerID string, args postAccountability
 	return b.String()
 }
 
+// recordDecisionArgs defines the arguments for the record_decision tool.
+type recordDecisionArgs struct {
+	Title        string   `json:"title"`
+	Context      string   `json:"context"`
+	Decision     string   `json:"decision"`
+	Alternatives []string `json:"alternatives,omitempty"`
+	Consequences string   `json:"consequences"`
+	Status       string   `json:"status,omitempty"`
+	TaskID       string   `json:"task_id,omitempty"`
+}
+
+// validateRecordDecisionArgs checks the required fields, the status and the task_id format.
+func validateRecordDecisionArgs(args recordDecisionArgs) error {
+	required := []struct {
+		field string
+		value string
+	}{
+		{"title", args.Title},
+		{"context", args.Context},
+		{"decision", args.Decision},
+		{"consequences", args.Consequences},
+	}
+	for _, r := range required {
+		if strings.TrimSpace(r.value) == "" {
+			return fmt.Errorf("%s is required", r.field)
+		}
+	}
+	if strings.ContainsAny(args.Title, "\r\n") {
+		return fmt.Errorf("title must be a single line")
+	}
+	for i, alt := range args.Alternatives {
+		if strings.TrimSpace(alt) == "" {
+			return fmt.Errorf("alternatives[%d] is empty", i)
+		}
+	}
+	if args.Status != "" && !accountability.IsValidDecisionStatus(args.Status) {
+		return fmt.Errorf("status must be proposed, accepted or superseded, got: %q", args.Status)
+	}
+	if args.TaskID != "" && !validation.IsValidTaskID(args.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", args.TaskID)
+	}
+	return nil
+}
+
+// handleRecordDecision saves a decision record to the session's decisions directory.
+func (ws *WorkerServer) handleRecordDecision(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
+	var args recordDecisionArgs
+	if err := json.Unmarshal(rawArgs, &args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if err := validateRecordDecisionArgs(args); err != nil {
+		return nil, err
+	}
+	if ws.decisionWriter == nil {
+		return nil, fmt.Errorf("decision writer not configured")
+	}
+
+	decision := &accountability.Decision{
+		Title:        strings.TrimSpace(args.Title),
+		Status:       args.Status,
+		WorkerID:     ws.workerID,
+		TaskID:       args.TaskID,
+		Timestamp:    time.Now().Truncate(time.Second),
+		Context:      strings.TrimSpace(args.Context),
+		Decision:     strings.TrimSpace(args.Decision),
+		Alternatives: args.Alternatives,
+		Consequences: strings.TrimSpace(args.Consequences),
+	}
+	if decision.Status == "" {
+		decision.Status = accountability.DecisionAccepted
+	}
+	if decision.TaskID == "" && ws.v2Adapter != nil {
+		decision.TaskID = ws.v2Adapter.WorkerTaskID(ws.workerID)
+	}
+	content, err := decision.Markdown()
+	if err != nil {
+		return nil, fmt.Errorf("failed to render decision: %w", err)
+	}
+
+	filePath, err := ws.decisionWriter.WriteDecision(accountability.DecisionSlug(decision.Title), content)
+	if err != nil {
+		log.Debug(log.CatMCP, "Failed to write decision", "workerID", ws.workerID, "error", err)
+		return nil, fmt.Errorf("failed to save decision: %w", err)
+	}
+
+	log.Debug(log.CatMCP, "Worker recorded decision", "workerID", ws.workerID, "taskID", decision.TaskID, "path", filePath)
+
+	response := map[string]any{
+		"status":    "success",
+		"file_path": filePath,
+		"message":   fmt.Sprintf("Decision recorded in %s", filePath),
+	}
+	data, _ := json.MarshalIndent(response, "", "  ")
+	return StructuredResult(string(data), response), nil
+}
+
 // handlePostAccountabilitySummary saves a worker's accountability summary to their session directory.
 func (ws *WorkerServer) handlePostAccountabilitySummary(_ context.Context, rawArgs json.RawMessage) (*ToolCallResult, error) {
 	var args postAccountabilitySummaryArgs
@@ -515,10 +1067,14 @@ func (ws *WorkerServer) handlePostAccountabilitySummary(_ context.Context, rawAr
 	}
 
 	// Build markdown content with YAML frontmatter
-	content := buildAccountabilitySummaryMarkdown(ws.workerID, args)
+	var scores repository.ReviewScores
+	if ws.v2Adapter != nil {
+		scores = ws.v2Adapter.TaskReviewScores(args.TaskID)
+	}
+	content := buildAccountabilitySummaryMarkdown(ws.workerID, args, scores)
 
 	// Write to session directory
-	filePath, err := ws.accountabilityWriter.WriteWorkerAccountabilitySummary(ws.workerID, []byte(content))
+	filePath, err := ws.accountabilityWriter.WriteWorkerAccountabilitySummary(ws.workerID, args.TaskID, []byte(content))
 	if err != nil {
 		log.Debug(log.CatMCP, "Failed to write accountability summary", "workerID", ws.workerID, "error", err)
 		return nil, fmt.Errorf("failed to save accountability summary: %w", err)
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -12,10 +15,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
+	"github.com/zjrosen/perles/internal/orchestration/codesearch"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
 	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
 	"github.com/zjrosen/perles/internal/orchestration/message"
+	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
 // mockMessageStore implements MessageStore for testing.
@@ -115,7 +123,13 @@ func TestWorkerServer_RegistersAllTools(t *testing.T) {
 	workerTools := []string{
 		"report_implementation_complete",
 		"report_review_verdict",
+		"request_assistance",
+		"propose_subtasks",
 		"post_accountability_summary",
+		"record_decision",
+		"search_codebase",
+		"comment_on_task",
+		"read_task_comments",
 	}
 
 	// Fabric tools (registered via SetFabricService)
@@ -123,6 +137,7 @@ func TestWorkerServer_RegistersAllTools(t *testing.T) {
 		"fabric_join",
 		"fabric_inbox",
 		"fabric_send",
+		"fabric_cancel_scheduled",
 		"fabric_reply",
 		"fabric_ack",
 		"fabric_subscribe",
@@ -131,6 +146,10 @@ func TestWorkerServer_RegistersAllTools(t *testing.T) {
 		"fabric_history",
 		"fabric_read_thread",
 		"fabric_react",
+		"fabric_edit",
+		"fabric_delete",
+		"fabric_list_channels",
+		"fabric_graph",
 	}
 
 	expectedTools := append(workerTools, fabricTools...)
@@ -518,7 +537,7 @@ func TestWorkerServer_ReportReviewVerdict_Denied(t *testing.T) {
 		Data:    "Review verdict DENIED submitted",
 	})
 
-	result, err := handler(context.Background(), json.RawMessage(`{"verdict": "DENIED", "comments": "Missing error handling in line 50"}`))
+	result, err := handler(context.Background(), json.RawMessage(`{"verdict": "DENIED", "comments": "Missing error handling", "findings": [{"file": "store.go", "location": "50", "severity": "major", "description": "Save error is ignored"}]}`))
 	require.NoError(t, err, "Unexpected error")
 
 	// Verify command was submitted
@@ -736,7 +755,7 @@ func TestBuildAccountabilitySummaryMarkdown(t *testing.T) {
 		NextSteps: "Continue with next task",
 	}
 
-	md := buildAccountabilitySummaryMarkdown("WORKER.1", args)
+	md := buildAccountabilitySummaryMarkdown("WORKER.1", args, nil)
 
 	// Verify YAML frontmatter
 	assert.Contains(t, md, "---\n")
@@ -778,6 +797,26 @@ func TestBuildAccountabilitySummaryMarkdown(t *testing.T) {
 	assert.Contains(t, md, "Continue with next task")
 }
 
+// TestBuildAccountabilitySummaryMarkdown_ReviewScores tests that review rubric scores are included.
+func TestBuildAccountabilitySummaryMarkdown_ReviewScores(t *testing.T) {
+	args := postAccountabilitySummaryArgs{
+		TaskID:  "perles-abc123",
+		Summary: "Implemented user validation with regex patterns.",
+	}
+	scores := repository.ReviewScores{
+		repository.DimensionCorrectness: 5,
+		repository.DimensionTests:       4,
+		repository.DimensionStyle:       4,
+		repository.DimensionSecurity:    5,
+	}
+
+	md := buildAccountabilitySummaryMarkdown("WORKER.1", args, scores)
+
+	assert.Contains(t, md, "review_scores:\n  correctness: 5\n  tests: 4\n  style: 4\n  security: 5\n---")
+	assert.Contains(t, md, "## Review Scores\n\ncorrectness 5/5, tests 4/5, style 4/5, security 5/5")
+	assert.NotContains(t, buildAccountabilitySummaryMarkdown("WORKER.1", args, nil), "Review Scores")
+}
+
 // TestBuildAccountabilitySummaryMarkdown_OnlySummary tests with only required fields.
 func TestBuildAccountabilitySummaryMarkdown_OnlySummary(t *testing.T) {
 	args := postAccountabilitySummaryArgs{
@@ -785,7 +824,7 @@ func TestBuildAccountabilitySummaryMarkdown_OnlySummary(t *testing.T) {
 		Summary: "Fixed a critical bug in authentication flow.",
 	}
 
-	md := buildAccountabilitySummaryMarkdown("WORKER.2", args)
+	md := buildAccountabilitySummaryMarkdown("WORKER.2", args, nil)
 
 	// Verify YAML frontmatter
 	assert.Contains(t, md, "---\n")
@@ -854,7 +893,7 @@ func TestBuildAccountabilitySummaryMarkdown_PartialOptionalFields(t *testing.T)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			md := buildAccountabilitySummaryMarkdown("WORKER.1", tt.args)
+			md := buildAccountabilitySummaryMarkdown("WORKER.1", tt.args, nil)
 
 			for _, s := range tt.shouldHave {
 				assert.Contains(t, md, s, "Should contain %q", s)
@@ -873,7 +912,7 @@ func TestBuildAccountabilitySummaryMarkdown_DateFormat(t *testing.T) {
 		Summary: "Test summary for date format.",
 	}
 
-	md := buildAccountabilitySummaryMarkdown("WORKER.1", args)
+	md := buildAccountabilitySummaryMarkdown("WORKER.1", args, nil)
 
 	// Date format should be YYYY-MM-DD HH:MM:SS (e.g., 2025-12-30 01:23:45)
 	assert.Regexp(t, `\*\*Date:\*\* \d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}`, md, "Date should be in expected format")
@@ -889,7 +928,7 @@ func TestBuildAccountabilitySummaryMarkdown_PreservesNewlines(t *testing.T) {
 		Summary: "Line 1\nLine 2\nLine 3",
 	}
 
-	md := buildAccountabilitySummaryMarkdown("WORKER.1", args)
+	md := buildAccountabilitySummaryMarkdown("WORKER.1", args, nil)
 
 	assert.Contains(t, md, "Line 1\nLine 2\nLine 3", "Newlines in content should be preserved")
 }
@@ -908,20 +947,22 @@ type mockAccountabilityWriter struct {
 
 type accountabilityWriterCall struct {
 	WorkerID string
+	TaskID   string
 	Content  []byte
 }
 
 func newMockAccountabilityWriter() *mockAccountabilityWriter {
 	return &mockAccountabilityWriter{
-		returnPath: "/mock/path/accountability_summary.md",
+		returnPath: "/mock/path/tasks/perles-abc.1.md",
 	}
 }
 
-func (m *mockAccountabilityWriter) WriteWorkerAccountabilitySummary(workerID string, content []byte) (string, error) {
+func (m *mockAccountabilityWriter) WriteWorkerAccountabilitySummary(workerID, taskID string, content []byte) (string, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.calls = append(m.calls, accountabilityWriterCall{
 		WorkerID: workerID,
+		TaskID:   taskID,
 		Content:  content,
 	})
 	return m.returnPath, m.returnErr
@@ -930,7 +971,7 @@ func (m *mockAccountabilityWriter) WriteWorkerAccountabilitySummary(workerID str
 // TestHandlePostAccountabilitySummary tests valid summary saves and returns path.
 func TestHandlePostAccountabilitySummary(t *testing.T) {
 	writer := newMockAccountabilityWriter()
-	writer.returnPath = "/sessions/abc/workers/WORKER.1/accountability_summary.md"
+	writer.returnPath = "/sessions/abc/workers/WORKER.1/tasks/perles-abc.1.md"
 
 	ws := NewWorkerServer("WORKER.1")
 	ws.SetAccountabilityWriter(writer)
@@ -1274,6 +1315,24 @@ func TestWorkerServer_FabricJoin_RecordsToolCall(t *testing.T) {
 	require.Equal(t, "fabric_join", calls[0].ToolName, "Expected tool name 'fabric_join'")
 }
 
+// TestWorkerServer_FabricSend_ErrorRecordsToolCall tests that a failed fabric_send
+// still records the tool call: the attempt counts toward turn completion.
+func TestWorkerServer_FabricSend_ErrorRecordsToolCall(t *testing.T) {
+	recorder := newMockToolCallRecorder()
+
+	tws := NewTestWorkerServer(t, "WORKER.1")
+	defer tws.Close()
+	tws.SetTurnEnforcer(recorder)
+	handler := tws.handlers["fabric_send"]
+
+	_, err := handler(context.Background(), json.RawMessage(`{"channel": "general"}`))
+	require.Error(t, err, "Expected missing content error")
+
+	calls := recorder.GetCalls()
+	require.Len(t, calls, 1, "Expected 1 recorder call")
+	require.Equal(t, "fabric_send", calls[0].ToolName, "Expected tool name 'fabric_send'")
+}
+
 // TestWorkerServer_FabricJoin_NilEnforcer tests that fabric_join works when enforcer is nil.
 func TestWorkerServer_FabricJoin_NilEnforcer(t *testing.T) {
 	tws := NewTestWorkerServer(t, "WORKER.1")
@@ -1551,3 +1610,219 @@ func TestWorkerServer_FabricInbox_DoesNotRecordToolCall(t *testing.T) {
 	calls := recorder.GetCalls()
 	require.Len(t, calls, 0, "Expected no recorder calls for fabric_inbox")
 }
+
+func TestWorkerServer_SearchCodebase(t *testing.T) {
+	if _, err := exec.LookPath("rg"); err != nil {
+		t.Skip("ripgrep not installed")
+	}
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o644))
+
+	ws := NewWorkerServer("WORKER.1")
+	ws.SetCodeSearcher(codesearch.New(root))
+
+	result, err := ws.handlers["search_codebase"](context.Background(), json.RawMessage(`{"pattern": "main", "mode": "symbol", "context_lines": 1}`))
+	require.NoError(t, err)
+	require.Equal(t, "Showing 1-1 of 1 matches", result.Content[0].Text)
+	res, ok := result.StructuredContent.(codesearch.Result)
+	require.True(t, ok)
+	require.Equal(t, "main.go", res.Hits[0].File)
+	require.Equal(t, 3, res.Hits[0].Line)
+}
+
+func TestWorkerServer_SearchCodebaseUnavailable(t *testing.T) {
+	ws := NewWorkerServer("WORKER.1")
+
+	_, err := ws.handlers["search_codebase"](context.Background(), json.RawMessage(`{"pattern": "main"}`))
+	require.EqualError(t, err, "code search is not available")
+}
+
+// ============================================================================
+// Tests for handleRecordDecision
+// ============================================================================
+
+// mockDecisionWriter implements DecisionWriter for testing.
+type mockDecisionWriter struct {
+	slug    string
+	content []byte
+}
+
+func (m *mockDecisionWriter) WriteDecision(slug string, content []byte) (string, error) {
+	m.slug, m.content = slug, content
+	return "/sessions/abc/decisions/001-" + slug + ".md", nil
+}
+
+func TestHandleRecordDecision(t *testing.T) {
+	writer := &mockDecisionWriter{}
+	ws := NewWorkerServer("WORKER.1")
+	ws.SetDecisionWriter(writer)
+	handler := ws.handlers["record_decision"]
+
+	args := `{
+		"title": "Use SQLite for the result cache",
+		"context": "Results must survive restarts: the cache is rebuilt on every start today.",
+		"decision": "Store cached results in the existing SQLite database.",
+		"alternatives": ["Flat JSON files: no concurrent writers", "Redis: new runtime dependency"],
+		"consequences": "Cache reads go through the DB pool.",
+		"task_id": "perles-abc.1"
+	}`
+
+	result, err := handler(context.Background(), json.RawMessage(args))
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "/sessions/abc/decisions/001-use-sqlite-for-the-result-cache.md")
+	require.Equal(t, "use-sqlite-for-the-result-cache", writer.slug)
+
+	// The record round-trips through the parser used by the session report
+	decision, err := accountability.ParseDecision(writer.content)
+	require.NoError(t, err)
+	require.Equal(t, "Use SQLite for the result cache", decision.Title)
+	require.Equal(t, accountability.DecisionAccepted, decision.Status)
+	require.Equal(t, "WORKER.1", decision.WorkerID)
+	require.Equal(t, "perles-abc.1", decision.TaskID)
+	require.Equal(t, "Store cached results in the existing SQLite database.", decision.Decision)
+	require.Equal(t, []string{"Flat JSON files: no concurrent writers", "Redis: new runtime dependency"}, decision.Alternatives)
+	require.Equal(t, "Cache reads go through the DB pool.", decision.Consequences)
+}
+
+func TestHandleRecordDecision_Validation(t *testing.T) {
+	base := map[string]any{
+		"title":        "Use SQLite",
+		"context":      "Need persistence",
+		"decision":     "Use SQLite",
+		"consequences": "One more table",
+	}
+	tests := []struct {
+		name      string
+		override  map[string]any
+		errSubstr string
+	}{
+		{"missing title", map[string]any{"title": " "}, "title is required"},
+		{"missing context", map[string]any{"context": ""}, "context is required"},
+		{"missing decision", map[string]any{"decision": ""}, "decision is required"},
+		{"missing consequences", map[string]any{"consequences": ""}, "consequences is required"},
+		{"multi-line title", map[string]any{"title": "Use\nSQLite"}, "title must be a single line"},
+		{"empty alternative", map[string]any{"alternatives": []string{"Redis", " "}}, "alternatives[1] is empty"},
+		{"bad status", map[string]any{"status": "rejected"}, "status must be proposed, accepted or superseded"},
+		{"bad task id", map[string]any{"task_id": "../etc"}, "invalid task_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := &mockDecisionWriter{}
+			ws := NewWorkerServer("WORKER.1")
+			ws.SetDecisionWriter(writer)
+
+			args := make(map[string]any, len(base))
+			for k, v := range base {
+				args[k] = v
+			}
+			for k, v := range tt.override {
+				args[k] = v
+			}
+			raw, err := json.Marshal(args)
+			require.NoError(t, err)
+
+			_, err = ws.handlers["record_decision"](context.Background(), raw)
+			require.ErrorContains(t, err, tt.errSubstr)
+			require.Nil(t, writer.content, "nothing is written for invalid input")
+		})
+	}
+}
+
+func TestHandleRecordDecision_NoWriter(t *testing.T) {
+	ws := NewWorkerServer("WORKER.1")
+
+	_, err := ws.handlers["record_decision"](context.Background(),
+		json.RawMessage(`{"title": "T", "context": "C", "decision": "D", "consequences": "Q"}`))
+	require.ErrorContains(t, err, "decision writer not configured")
+}
+
+// fakeTaskComments implements TaskCommentStore in memory.
+type fakeTaskComments struct {
+	comments map[string][]beads.Comment
+	err      error
+}
+
+func (f *fakeTaskComments) GetComments(issueID string) ([]beads.Comment, error) {
+	return f.comments[issueID], f.err
+}
+
+func (f *fakeTaskComments) AddComment(issueID, author, text string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.comments == nil {
+		f.comments = make(map[string][]beads.Comment)
+	}
+	id := len(f.comments[issueID]) + 1
+	f.comments[issueID] = append(f.comments[issueID], beads.Comment{ID: id, Author: author, Text: text})
+	return nil
+}
+
+// newCommentingWorkerServer creates a worker server assigned to taskID (if not empty) with store.
+func newCommentingWorkerServer(t *testing.T, taskID string, store TaskCommentStore) *WorkerServer {
+	t.Helper()
+	taskRepo := repository.NewMemoryTaskRepository()
+	if taskID != "" {
+		require.NoError(t, taskRepo.Save(&repository.TaskAssignment{TaskID: taskID, Implementer: "WORKER.1"}))
+	}
+	ws := NewWorkerServer("WORKER.1")
+	ws.SetV2Adapter(adapter.NewV2Adapter(nil, adapter.WithTaskRepository(taskRepo)))
+	ws.SetTaskComments(store)
+	return ws
+}
+
+func TestHandleCommentOnTask(t *testing.T) {
+	store := &fakeTaskComments{}
+	ws := newCommentingWorkerServer(t, "perles-abc.1", store)
+
+	result, err := ws.handlers["comment_on_task"](context.Background(), json.RawMessage(`{"text": " The schema needs a migration. "}`))
+	require.NoError(t, err)
+	require.Contains(t, result.Content[0].Text, "Comment added to perles-abc.1")
+
+	_, err = ws.handlers["comment_on_task"](context.Background(), json.RawMessage(`{"text": "Migration added", "reply_to": 1}`))
+	require.NoError(t, err)
+
+	require.Equal(t, []beads.Comment{
+		{ID: 1, Author: "WORKER.1", Text: "The schema needs a migration."},
+		{ID: 2, Author: "WORKER.1", Text: beads.FormatCommentReply(1, "Migration added")},
+	}, store.comments["perles-abc.1"])
+}
+
+func TestHandleCommentOnTask_Errors(t *testing.T) {
+	ws := newCommentingWorkerServer(t, "", &fakeTaskComments{})
+	_, err := ws.handlers["comment_on_task"](context.Background(), json.RawMessage(`{"text": "Hi"}`))
+	require.ErrorContains(t, err, "no assigned task")
+
+	_, err = ws.handlers["comment_on_task"](context.Background(), json.RawMessage(`{"text": "  "}`))
+	require.ErrorContains(t, err, "text is required")
+
+	ws = newCommentingWorkerServer(t, "perles-abc.1", &fakeTaskComments{err: fmt.Errorf("bd failed")})
+	_, err = ws.handlers["comment_on_task"](context.Background(), json.RawMessage(`{"text": "Hi"}`))
+	require.ErrorContains(t, err, "failed to add comment: bd failed")
+
+	_, err = NewWorkerServer("WORKER.1").handlers["comment_on_task"](context.Background(), json.RawMessage(`{"text": "Hi"}`))
+	require.ErrorContains(t, err, "task comments are not available")
+}
+
+func TestHandleReadTaskComments(t *testing.T) {
+	store := &fakeTaskComments{comments: map[string][]beads.Comment{"perles-abc.1": {
+		{ID: 1, Author: "alice", Text: "Keep the API stable", CreatedAt: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{ID: 2, Author: "WORKER.2", Text: beads.FormatCommentReply(1, "Noted"), CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)},
+	}}}
+	ws := newCommentingWorkerServer(t, "perles-abc.1", store)
+
+	result, err := ws.handlers["read_task_comments"](context.Background(), json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	var decoded struct {
+		TaskID   string            `json:"task_id"`
+		Comments []taskCommentView `json:"comments"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &decoded))
+	require.Equal(t, "perles-abc.1", decoded.TaskID)
+	require.Equal(t, []taskCommentView{{
+		ID: 1, Author: "alice", Text: "Keep the API stable", CreatedAt: "2026-03-01T09:00:00Z",
+		Replies: []taskCommentView{{ID: 2, Author: "WORKER.2", Text: "Noted", CreatedAt: "2026-03-01T10:00:00Z"}},
+	}}, decoded.Comments)
+}
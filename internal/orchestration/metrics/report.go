@@ -0,0 +1,162 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Cost report file names, written to the session directory.
+const (
+	CostReportMarkdownFile = "cost_report.md"
+	CostReportJSONFile     = "cost_report.json"
+)
+
+// CostReport summarizes what a session spent and what it got done.
+// It is generated when the workflow completes and again when the session ends.
+type CostReport struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	// Status is the workflow completion status ("success", "partial", "aborted"),
+	// empty if the session ended without the workflow completing.
+	Status string `json:"status,omitempty"`
+	// Wallclock is the time from session start to the report.
+	Wallclock    time.Duration `json:"wallclock_ns"`
+	TasksClosed  int           `json:"tasks_closed"`
+	OutputTokens int           `json:"output_tokens"`
+	CostUSD      float64       `json:"cost_usd"`
+	// Processes lists the coordinator, the observer (if any) and every worker.
+	Processes []ProcessCost `json:"processes"`
+	// Phases is the wallclock workers spent in each workflow phase, summed over
+	// workers, in order of first appearance.
+	Phases []PhaseTime `json:"phases,omitempty"`
+}
+
+// ProcessCost is the token usage and estimated spend of one process.
+type ProcessCost struct {
+	ID            string  `json:"id"`
+	ContextTokens int     `json:"context_tokens"` // Latest context window usage
+	OutputTokens  int     `json:"output_tokens"`
+	CostUSD       float64 `json:"cost_usd"`
+}
+
+// PhaseTime is the total wallclock spent in a workflow phase.
+type PhaseTime struct {
+	Phase    string        `json:"phase"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// TasksPerDollar returns the tasks closed per dollar spent, or 0 if nothing was spent.
+func (r *CostReport) TasksPerDollar() float64 {
+	if r.CostUSD <= 0 {
+		return 0
+	}
+	return float64(r.TasksClosed) / r.CostUSD
+}
+
+// Headline returns a one-line summary, e.g.
+// "Workflow success in 1h23m: $4.12, 120.3k output tokens, 12 tasks closed (2.91 tasks/$)".
+func (r *CostReport) Headline() string {
+	outcome := "Session ended"
+	if r.Status != "" {
+		outcome = "Workflow " + r.Status
+	}
+	line := fmt.Sprintf("%s in %s: $%.2f, %s output tokens, %d tasks closed",
+		outcome, r.Wallclock.Round(time.Minute), r.CostUSD, formatTokens(r.OutputTokens), r.TasksClosed)
+	if perDollar := r.TasksPerDollar(); perDollar > 0 {
+		line += fmt.Sprintf(" (%.2f tasks/$)", perDollar)
+	}
+	return line
+}
+
+// Text renders the report as plain text for chat messages and dialogs:
+// the headline, one line per process and the time per phase.
+func (r *CostReport) Text() string {
+	var b strings.Builder
+	b.WriteString(r.Headline())
+	b.WriteString("\n")
+
+	width := 0
+	for _, p := range r.Processes {
+		width = max(width, len(p.ID))
+	}
+	if len(r.Processes) > 0 {
+		b.WriteString("\n")
+	}
+	for _, p := range r.Processes {
+		fmt.Fprintf(&b, "%-*s  $%.2f  %s out\n", width, p.ID, p.CostUSD, formatTokens(p.OutputTokens))
+	}
+
+	if len(r.Phases) > 0 {
+		parts := make([]string, len(r.Phases))
+		for i, p := range r.Phases {
+			parts[i] = fmt.Sprintf("%s %s", p.Phase, p.Duration.Round(time.Second))
+		}
+		fmt.Fprintf(&b, "\nPhases: %s\n", strings.Join(parts, ", "))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Markdown renders the report as the contents of CostReportMarkdownFile.
+func (r *CostReport) Markdown() string {
+	var b strings.Builder
+	b.WriteString("# Cost Report\n\n")
+	fmt.Fprintf(&b, "**Generated:** %s\n\n", r.GeneratedAt.Format("2006-01-02 15:04:05"))
+
+	b.WriteString("| Metric | Value |\n|---|---|\n")
+	if r.Status != "" {
+		fmt.Fprintf(&b, "| Status | %s |\n", r.Status)
+	}
+	fmt.Fprintf(&b, "| Wallclock | %s |\n", r.Wallclock.Round(time.Second))
+	fmt.Fprintf(&b, "| Estimated Spend | $%.2f |\n", r.CostUSD)
+	fmt.Fprintf(&b, "| Output Tokens | %d |\n", r.OutputTokens)
+	fmt.Fprintf(&b, "| Tasks Closed | %d |\n", r.TasksClosed)
+	if perDollar := r.TasksPerDollar(); perDollar > 0 {
+		fmt.Fprintf(&b, "| Tasks per Dollar | %.2f |\n", perDollar)
+	}
+	b.WriteString("\n")
+
+	if len(r.Processes) > 0 {
+		b.WriteString("## Processes\n\n")
+		b.WriteString("| Process | Context Tokens | Output Tokens | Estimated Spend |\n|---|---|---|---|\n")
+		for _, p := range r.Processes {
+			fmt.Fprintf(&b, "| %s | %d | %d | $%.2f |\n", p.ID, p.ContextTokens, p.OutputTokens, p.CostUSD)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(r.Phases) > 0 {
+		b.WriteString("## Time per Phase\n\n")
+		b.WriteString("| Phase | Wallclock |\n|---|---|\n")
+		for _, p := range r.Phases {
+			fmt.Fprintf(&b, "| %s | %s |\n", p.Phase, p.Duration.Round(time.Second))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Write saves the report to dir as CostReportMarkdownFile and CostReportJSONFile.
+func (r *CostReport) Write(dir string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cost report: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, CostReportJSONFile), append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("writing %s: %w", CostReportJSONFile, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, CostReportMarkdownFile), []byte(r.Markdown()), 0600); err != nil {
+		return fmt.Errorf("writing %s: %w", CostReportMarkdownFile, err)
+	}
+	return nil
+}
+
+// formatTokens abbreviates a token count, e.g. 950 or "120.3k".
+func formatTokens(n int) string {
+	if n < 1000 {
+		return fmt.Sprintf("%d", n)
+	}
+	return fmt.Sprintf("%.1fk", float64(n)/1000)
+}
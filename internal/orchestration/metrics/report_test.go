@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCostReport() *CostReport {
+	return &CostReport{
+		GeneratedAt:  time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+		Status:       "success",
+		Wallclock:    83*time.Minute + 20*time.Second,
+		TasksClosed:  12,
+		OutputTokens: 120300,
+		CostUSD:      4,
+		Processes: []ProcessCost{
+			{ID: "coordinator", ContextTokens: 50000, OutputTokens: 30100, CostUSD: 1},
+			{ID: "worker-1", ContextTokens: 80000, OutputTokens: 90200, CostUSD: 3},
+		},
+		Phases: []PhaseTime{
+			{Phase: "implementing", Duration: 40 * time.Minute},
+			{Phase: "reviewing", Duration: 12 * time.Minute},
+		},
+	}
+}
+
+func TestCostReport_TasksPerDollar(t *testing.T) {
+	require.InDelta(t, 3.0, testCostReport().TasksPerDollar(), 0.001)
+	require.Zero(t, (&CostReport{TasksClosed: 5}).TasksPerDollar(), "no spend")
+}
+
+func TestCostReport_Text(t *testing.T) {
+	require.Equal(t, `Workflow success in 1h23m0s: $4.00, 120.3k output tokens, 12 tasks closed (3.00 tasks/$)
+
+coordinator  $1.00  30.1k out
+worker-1     $3.00  90.2k out
+
+Phases: implementing 40m0s, reviewing 12m0s`, testCostReport().Text())
+
+	require.Equal(t, "Session ended in 0s: $0.00, 0 output tokens, 0 tasks closed", (&CostReport{}).Text())
+}
+
+func TestCostReport_Write(t *testing.T) {
+	dir := t.TempDir()
+	report := testCostReport()
+	require.NoError(t, report.Write(dir))
+
+	md, err := os.ReadFile(filepath.Join(dir, CostReportMarkdownFile))
+	require.NoError(t, err)
+	require.Contains(t, string(md), "| Tasks per Dollar | 3.00 |")
+	require.Contains(t, string(md), "| worker-1 | 80000 | 90200 | $3.00 |")
+	require.Contains(t, string(md), "| implementing | 40m0s |")
+
+	data, err := os.ReadFile(filepath.Join(dir, CostReportJSONFile))
+	require.NoError(t, err)
+	var decoded CostReport
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, *report, decoded)
+}
@@ -0,0 +1,311 @@
+// Package planning suggests the order in which an epic's tasks should be assigned.
+//
+// Tasks are layered into waves: every task in a wave can run at the same time,
+// and a task is never placed before the tasks that block it. Two tasks that
+// claim the same files (via "file:<path>" labels) never share a wave, so
+// parallel workers don't edit the same code. Within those constraints tasks
+// are placed as early as possible, highest priority first, which maximizes
+// parallelism.
+package planning
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+// FileLabelPrefix marks file claim labels (e.g., "file:internal/app/app.go" or
+// "file:internal/ui/" for a whole directory).
+const FileLabelPrefix = "file:"
+
+// Options tunes plan construction.
+type Options struct {
+	// MaxParallel caps how many tasks share a wave (e.g., the number of workers).
+	// Zero means no cap.
+	MaxParallel int
+}
+
+// Plan is a suggested assignment order for an epic's unfinished tasks.
+type Plan struct {
+	EpicID string `json:"epic_id"`
+	Waves  []Wave `json:"waves"`
+	// Cyclic lists tasks in a dependency cycle. They can't be ordered and are left out of the waves.
+	Cyclic []string `json:"cyclic,omitempty"`
+	// MaxParallel is the size of the widest wave.
+	MaxParallel int `json:"max_parallel"`
+}
+
+// Wave is a group of tasks that can run in parallel.
+// Wave 1 is the current round: it holds the tasks already in progress
+// and the tasks that can be assigned right away.
+type Wave struct {
+	Number int    `json:"number"`
+	Tasks  []Task `json:"tasks"`
+}
+
+// Task is a planned task.
+type Task struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	Priority   int    `json:"priority"`
+	InProgress bool   `json:"in_progress,omitempty"`
+	// BlockedBy lists the unfinished tasks of the epic this task waits for.
+	BlockedBy []string `json:"blocked_by,omitempty"`
+	// ExternalBlockers lists blockers outside the epic. Their status is unknown,
+	// so they are not reflected in the wave.
+	ExternalBlockers []string `json:"external_blockers,omitempty"`
+	Files            []string `json:"files,omitempty"`
+	// ConflictsWith lists planned tasks claiming overlapping files.
+	ConflictsWith []string `json:"conflicts_with,omitempty"`
+}
+
+// TaskCount returns the number of planned tasks.
+func (p Plan) TaskCount() int {
+	var n int
+	for _, w := range p.Waves {
+		n += len(w.Tasks)
+	}
+	return n
+}
+
+// Ready returns the IDs of the tasks that can be assigned now:
+// wave 1 tasks that are not already in progress.
+func (p Plan) Ready() []string {
+	var ids []string
+	if len(p.Waves) > 0 {
+		for _, t := range p.Waves[0].Tasks {
+			if !t.InProgress {
+				ids = append(ids, t.ID)
+			}
+		}
+	}
+	return ids
+}
+
+// Summary returns a one-line human readable description of the plan.
+func (p Plan) Summary() string {
+	if len(p.Waves) == 0 {
+		s := fmt.Sprintf("No unfinished tasks to plan under %s", p.EpicID)
+		if len(p.Cyclic) > 0 {
+			s += fmt.Sprintf(" (%d task(s) in a dependency cycle)", len(p.Cyclic))
+		}
+		return s
+	}
+	s := fmt.Sprintf("Plan for %s: %d task(s) in %d wave(s), up to %d in parallel",
+		p.EpicID, p.TaskCount(), len(p.Waves), p.MaxParallel)
+	if ready := p.Ready(); len(ready) > 0 {
+		s += "; assign now: " + strings.Join(ready, ", ")
+	}
+	if len(p.Cyclic) > 0 {
+		s += fmt.Sprintf("; %d task(s) in a dependency cycle", len(p.Cyclic))
+	}
+	return s
+}
+
+// Markdown renders the plan wave by wave.
+func (p Plan) Markdown() string {
+	var b strings.Builder
+	b.WriteString(p.Summary())
+	b.WriteString("\n")
+	for _, w := range p.Waves {
+		fmt.Fprintf(&b, "\n**Wave %d**\n", w.Number)
+		for _, t := range w.Tasks {
+			fmt.Fprintf(&b, "- %s [P%d] %s", t.ID, t.Priority, t.Title)
+			var notes []string
+			if t.InProgress {
+				notes = append(notes, "in progress")
+			}
+			if len(t.BlockedBy) > 0 {
+				notes = append(notes, "after "+strings.Join(t.BlockedBy, ", "))
+			}
+			if len(t.ConflictsWith) > 0 {
+				notes = append(notes, "shares files with "+strings.Join(t.ConflictsWith, ", "))
+			}
+			if len(t.ExternalBlockers) > 0 {
+				notes = append(notes, "check external blockers "+strings.Join(t.ExternalBlockers, ", "))
+			}
+			if len(notes) > 0 {
+				fmt.Fprintf(&b, " (%s)", strings.Join(notes, "; "))
+			}
+			b.WriteString("\n")
+		}
+	}
+	if len(p.Cyclic) > 0 {
+		fmt.Fprintf(&b, "\nDependency cycle, resolve before assigning: %s\n", strings.Join(p.Cyclic, ", "))
+	}
+	return b.String()
+}
+
+// Build plans the unfinished tasks among issues, typically the children of epicID.
+// The epic itself, nested epics, and closed, deferred or archived tasks are not
+// planned; closed tasks count as satisfied blockers.
+func Build(epicID string, issues []beads.Issue, opts Options) Plan {
+	plan := Plan{EpicID: epicID}
+
+	closed := make(map[string]bool)
+	tasks := make(map[string]*Task)
+	for _, issue := range issues {
+		if issue.ID == epicID || issue.Type == beads.TypeEpic {
+			continue
+		}
+		switch {
+		case issue.Status == beads.StatusClosed:
+			closed[issue.ID] = true
+		case issue.Status == beads.StatusDeferred, issue.IsArchived():
+		default:
+			tasks[issue.ID] = &Task{
+				ID:         issue.ID,
+				Title:      issue.TitleText,
+				Priority:   int(issue.Priority),
+				InProgress: issue.Status == beads.StatusInProgress,
+				Files:      FileClaims(issue.Labels),
+			}
+		}
+	}
+
+	// Split blockers into plan edges and external blockers
+	dependents := make(map[string][]string)
+	indegree := make(map[string]int, len(tasks))
+	for _, issue := range issues {
+		t, ok := tasks[issue.ID]
+		if !ok {
+			continue
+		}
+		for _, blocker := range issue.BlockedBy {
+			switch {
+			case tasks[blocker] != nil:
+				if slices.Contains(t.BlockedBy, blocker) {
+					continue
+				}
+				t.BlockedBy = append(t.BlockedBy, blocker)
+				dependents[blocker] = append(dependents[blocker], t.ID)
+				indegree[t.ID]++
+			case !closed[blocker] && blocker != epicID:
+				t.ExternalBlockers = append(t.ExternalBlockers, blocker)
+			}
+		}
+	}
+
+	for _, a := range tasks {
+		for _, b := range tasks {
+			if a.ID != b.ID && claimsOverlap(a.Files, b.Files) {
+				a.ConflictsWith = append(a.ConflictsWith, b.ID)
+			}
+		}
+		slices.Sort(a.ConflictsWith)
+	}
+
+	// Kahn's algorithm, taking in-progress tasks first, then by priority and ID.
+	// Each task lands in the earliest wave after its blockers that has room
+	// and holds no task with overlapping files.
+	var ready []*Task
+	for _, t := range tasks {
+		if indegree[t.ID] == 0 {
+			ready = append(ready, t)
+		}
+	}
+	wave := make(map[string]int, len(tasks))
+	var waves [][]*Task
+	for len(ready) > 0 {
+		slices.SortFunc(ready, compareTasks)
+		t := ready[0]
+		ready = ready[1:]
+
+		n := 1
+		for _, blocker := range t.BlockedBy {
+			n = max(n, wave[blocker]+1)
+		}
+		if !t.InProgress {
+			for n <= len(waves) && !fits(waves[n-1], t, opts.MaxParallel) {
+				n++
+			}
+		}
+		for len(waves) < n {
+			waves = append(waves, nil)
+		}
+		waves[n-1] = append(waves[n-1], t)
+		wave[t.ID] = n
+
+		for _, id := range dependents[t.ID] {
+			indegree[id]--
+			if indegree[id] == 0 {
+				ready = append(ready, tasks[id])
+			}
+		}
+	}
+
+	for i, group := range waves {
+		w := Wave{Number: i + 1, Tasks: make([]Task, len(group))}
+		for j, t := range group {
+			w.Tasks[j] = *t
+		}
+		plan.Waves = append(plan.Waves, w)
+		plan.MaxParallel = max(plan.MaxParallel, len(group))
+	}
+	for id := range tasks {
+		if _, ok := wave[id]; !ok {
+			plan.Cyclic = append(plan.Cyclic, id)
+		}
+	}
+	slices.Sort(plan.Cyclic)
+	return plan
+}
+
+// compareTasks orders in-progress tasks first, then by priority (P0 first), then by ID.
+func compareTasks(a, b *Task) int {
+	if a.InProgress != b.InProgress {
+		if a.InProgress {
+			return -1
+		}
+		return 1
+	}
+	return cmp.Or(cmp.Compare(a.Priority, b.Priority), cmp.Compare(a.ID, b.ID))
+}
+
+// fits reports whether t can join a wave: the wave has room and no task in it
+// claims overlapping files.
+func fits(group []*Task, t *Task, maxParallel int) bool {
+	if maxParallel > 0 && len(group) >= maxParallel {
+		return false
+	}
+	for _, other := range group {
+		if slices.Contains(t.ConflictsWith, other.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+// FileClaims returns the file paths claimed by "file:" labels.
+func FileClaims(labels []string) []string {
+	var files []string
+	for _, l := range labels {
+		if path, ok := strings.CutPrefix(l, FileLabelPrefix); ok && strings.TrimSpace(path) != "" {
+			files = append(files, strings.TrimSpace(path))
+		}
+	}
+	return files
+}
+
+// claimsOverlap reports whether two sets of file claims touch the same file.
+// A claim ending in "/" or naming a parent directory covers everything below it.
+func claimsOverlap(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if pathCovers(x, y) || pathCovers(y, x) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pathCovers reports whether claim equals path or is one of its parent directories.
+func pathCovers(claim, path string) bool {
+	claim = strings.TrimSuffix(claim, "/")
+	path = strings.TrimSuffix(path, "/")
+	return claim == path || strings.HasPrefix(path, claim+"/")
+}
@@ -0,0 +1,173 @@
+package planning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+)
+
+func task(id string, priority beads.Priority, blockedBy ...string) beads.Issue {
+	return beads.Issue{
+		ID:        id,
+		TitleText: "Task " + id,
+		Type:      beads.TypeTask,
+		Status:    beads.StatusOpen,
+		Priority:  priority,
+		BlockedBy: blockedBy,
+		ParentID:  "epic",
+	}
+}
+
+func withStatus(issue beads.Issue, status beads.Status) beads.Issue {
+	issue.Status = status
+	return issue
+}
+
+func withFiles(issue beads.Issue, files ...string) beads.Issue {
+	for _, f := range files {
+		issue.Labels = append(issue.Labels, FileLabelPrefix+f)
+	}
+	return issue
+}
+
+// waveIDs flattens a plan into task IDs per wave.
+func waveIDs(p Plan) [][]string {
+	var out [][]string
+	for _, w := range p.Waves {
+		var ids []string
+		for _, t := range w.Tasks {
+			ids = append(ids, t.ID)
+		}
+		out = append(out, ids)
+	}
+	return out
+}
+
+func TestBuild_LayersByDependencies(t *testing.T) {
+	issues := []beads.Issue{
+		{ID: "epic", Type: beads.TypeEpic, Status: beads.StatusOpen},
+		task("a", beads.PriorityMedium),
+		task("b", beads.PriorityHigh),
+		task("c", beads.PriorityMedium, "a", "b"),
+		task("d", beads.PriorityMedium, "c"),
+		task("e", beads.PriorityLow, "a"),
+	}
+
+	plan := Build("epic", issues, Options{})
+
+	// Higher priority first within a wave
+	require.Equal(t, [][]string{{"b", "a"}, {"c", "e"}, {"d"}}, waveIDs(plan))
+	require.Equal(t, 2, plan.MaxParallel)
+	require.Equal(t, 5, plan.TaskCount())
+	require.Equal(t, []string{"b", "a"}, plan.Ready())
+	require.Equal(t, []string{"a", "b"}, plan.Waves[1].Tasks[0].BlockedBy)
+	require.Empty(t, plan.Cyclic)
+}
+
+func TestBuild_FileConflictsSplitWaves(t *testing.T) {
+	issues := []beads.Issue{
+		withFiles(task("a", beads.PriorityHigh), "internal/ui/"),
+		withFiles(task("b", beads.PriorityMedium), "internal/ui/board/board.go"),
+		withFiles(task("c", beads.PriorityMedium), "internal/uikit/x.go"),
+		task("d", beads.PriorityLow),
+	}
+
+	plan := Build("epic", issues, Options{})
+
+	require.Equal(t, [][]string{{"a", "c", "d"}, {"b"}}, waveIDs(plan))
+	require.Equal(t, []string{"b"}, plan.Waves[0].Tasks[0].ConflictsWith)
+	require.Equal(t, []string{"a"}, plan.Waves[1].Tasks[0].ConflictsWith)
+	require.Equal(t, []string{"internal/ui/board/board.go"}, plan.Waves[1].Tasks[0].Files)
+}
+
+func TestBuild_InProgressAndClosedTasks(t *testing.T) {
+	issues := []beads.Issue{
+		withStatus(task("done", beads.PriorityMedium), beads.StatusClosed),
+		withFiles(withStatus(task("running", beads.PriorityLow), beads.StatusInProgress), "go.mod"),
+		task("next", beads.PriorityMedium, "done"),
+		task("after-running", beads.PriorityHigh, "running"),
+		withFiles(task("same-file", beads.PriorityHigh), "go.mod"),
+		withStatus(task("later", beads.PriorityHigh), beads.StatusDeferred),
+	}
+
+	plan := Build("epic", issues, Options{})
+
+	require.Equal(t, [][]string{{"running", "next"}, {"after-running", "same-file"}}, waveIDs(plan))
+	require.True(t, plan.Waves[0].Tasks[0].InProgress)
+	require.Empty(t, plan.Waves[0].Tasks[1].BlockedBy, "closed blockers are satisfied")
+	require.Equal(t, []string{"next"}, plan.Ready())
+}
+
+func TestBuild_SkipsArchivedTasks(t *testing.T) {
+	archived := task("archived", beads.PriorityHigh)
+	archived.Labels = append(archived.Labels, beads.ArchivedLabel)
+	issues := []beads.Issue{archived, task("a", beads.PriorityMedium)}
+
+	plan := Build("epic", issues, Options{})
+
+	require.Equal(t, [][]string{{"a"}}, waveIDs(plan))
+}
+
+func TestBuild_MaxParallel(t *testing.T) {
+	issues := []beads.Issue{
+		task("a", beads.PriorityMedium),
+		task("b", beads.PriorityMedium),
+		task("c", beads.PriorityMedium),
+	}
+
+	plan := Build("epic", issues, Options{MaxParallel: 2})
+
+	require.Equal(t, [][]string{{"a", "b"}, {"c"}}, waveIDs(plan))
+	require.Equal(t, 2, plan.MaxParallel)
+}
+
+func TestBuild_CyclesAndExternalBlockers(t *testing.T) {
+	issues := []beads.Issue{
+		task("a", beads.PriorityMedium, "b"),
+		task("b", beads.PriorityMedium, "a"),
+		task("c", beads.PriorityMedium, "other-1", "epic"),
+	}
+
+	plan := Build("epic", issues, Options{})
+
+	require.Equal(t, [][]string{{"c"}}, waveIDs(plan))
+	require.Equal(t, []string{"a", "b"}, plan.Cyclic)
+	require.Equal(t, []string{"other-1"}, plan.Waves[0].Tasks[0].ExternalBlockers)
+	require.Contains(t, plan.Summary(), "2 task(s) in a dependency cycle")
+	require.Contains(t, plan.Markdown(), "Dependency cycle, resolve before assigning: a, b")
+}
+
+func TestBuild_Empty(t *testing.T) {
+	plan := Build("epic", []beads.Issue{withStatus(task("a", beads.PriorityMedium), beads.StatusClosed)}, Options{})
+
+	require.Empty(t, plan.Waves)
+	require.Equal(t, "No unfinished tasks to plan under epic", plan.Summary())
+}
+
+func TestPlan_Markdown(t *testing.T) {
+	issues := []beads.Issue{
+		withStatus(task("a", beads.PriorityHigh), beads.StatusInProgress),
+		task("b", beads.PriorityMedium, "a"),
+	}
+
+	md := Build("epic", issues, Options{}).Markdown()
+
+	require.Contains(t, md, "Plan for epic: 2 task(s) in 2 wave(s), up to 1 in parallel")
+	require.Contains(t, md, "**Wave 1**\n- a [P1] Task a (in progress)")
+	require.Contains(t, md, "**Wave 2**\n- b [P2] Task b (after a)")
+}
+
+func TestFileClaims(t *testing.T) {
+	require.Equal(t, []string{"a.go", "dir/"}, FileClaims([]string{"bug", "file:a.go", "file: ", "file:dir/"}))
+	require.Nil(t, FileClaims(nil))
+}
+
+func TestClaimsOverlap(t *testing.T) {
+	require.True(t, claimsOverlap([]string{"a/b.go"}, []string{"a/b.go"}))
+	require.True(t, claimsOverlap([]string{"a/"}, []string{"a/b.go"}))
+	require.True(t, claimsOverlap([]string{"a/b/c.go"}, []string{"a"}))
+	require.False(t, claimsOverlap([]string{"a/b"}, []string{"a/bc.go"}))
+	require.False(t, claimsOverlap(nil, []string{"a"}))
+}
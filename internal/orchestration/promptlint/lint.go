@@ -0,0 +1,185 @@
+// Package promptlint validates the built-in orchestration prompts against the
+// code they describe: placeholders are filled in, referenced MCP tools are
+// registered on the server the agent talks to, and prompts stay within their
+// length budgets. It backs `perles prompts lint` and a test that fails the
+// build when prompts and code drift apart.
+package promptlint
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+	"github.com/zjrosen/perles/internal/orchestration/mcp"
+)
+
+// Server identifies the MCP server an agent calls tools on.
+type Server string
+
+const (
+	ServerCoordinator Server = "coordinator"
+	ServerWorker      Server = "worker"
+	ServerObserver    Server = "observer"
+)
+
+// Length budgets in characters, by kind of prompt.
+const (
+	// InstructionsBudget bounds MCP server instructions, sent on every initialize.
+	InstructionsBudget = 3000
+	// SystemPromptBudget bounds system prompts, which stay in context for the whole session.
+	SystemPromptBudget = 8000
+	// MessageBudget bounds initial prompts and the messages sent to agents at runtime.
+	MessageBudget = 8000
+)
+
+// Prompt is a rendered prompt to lint.
+type Prompt struct {
+	Name   string
+	Server Server
+	Text   string
+	// Placeholders are the values the prompt was rendered with. Each must
+	// appear in Text, or the template dropped an argument.
+	Placeholders []string
+	// Budget is the maximum length in characters.
+	Budget int
+}
+
+// Issue is a problem found in a prompt.
+type Issue struct {
+	Prompt  string
+	Message string
+}
+
+func (i Issue) String() string {
+	return i.Prompt + ": " + i.Message
+}
+
+var (
+	// snakeCase matches identifiers that may name a tool.
+	snakeCase = regexp.MustCompile(`[a-z][a-z0-9]*(?:_[a-z0-9]+)+`)
+
+	// toolCall matches the ways prompts refer to a tool that is not known to
+	// any server: calling it, or telling the agent to use it.
+	toolCall = []*regexp.Regexp{
+		regexp.MustCompile(`\b([a-z][a-z0-9]*(?:_[a-z0-9]+)+)\(`),
+		regexp.MustCompile("`([a-z][a-z0-9]*(?:_[a-z0-9]+)+)` tool"),
+		regexp.MustCompile("(?i:use|call|using|calling)\\s+`([a-z][a-z0-9]*(?:_[a-z0-9]+)+)`"),
+	}
+
+	// renderArtifacts are left behind by text/template and fmt when arguments are missing.
+	renderArtifacts = []string{"<no value>", "%!", "{{", "}}"}
+)
+
+// Lint checks prompts against the tools registered on each server.
+func Lint(prompts []Prompt, tools map[Server][]string) []Issue {
+	allTools := make(map[string][]Server)
+	for server, names := range tools {
+		for _, name := range names {
+			allTools[name] = append(allTools[name], server)
+		}
+	}
+
+	var issues []Issue
+	for _, p := range prompts {
+		report := func(format string, args ...any) {
+			issues = append(issues, Issue{Prompt: p.Name, Message: fmt.Sprintf(format, args...)})
+		}
+
+		for _, placeholder := range p.Placeholders {
+			if !strings.Contains(p.Text, placeholder) {
+				report("placeholder %q is not rendered", placeholder)
+			}
+		}
+		for _, artifact := range renderArtifacts {
+			if strings.Contains(p.Text, artifact) {
+				report("contains template artifact %q", artifact)
+			}
+		}
+		if n := utf8.RuneCountInString(p.Text); p.Budget > 0 && n > p.Budget {
+			report("length %d exceeds budget of %d characters", n, p.Budget)
+		}
+
+		for _, name := range referencedTools(p.Text, allTools) {
+			if slices.Contains(tools[p.Server], name) {
+				continue
+			}
+			if servers, ok := allTools[name]; ok {
+				report("tool %s is not registered on the %s server (only on %s)", name, p.Server, joinServers(servers))
+			} else {
+				report("tool %s is not registered on any server", name)
+			}
+		}
+	}
+	return issues
+}
+
+// referencedTools returns the tools text refers to: every known tool name it
+// mentions, and unknown names it calls or tells the agent to use.
+func referencedTools(text string, known map[string][]Server) []string {
+	var refs []string
+	for _, name := range snakeCase.FindAllString(text, -1) {
+		if _, ok := known[name]; ok && !slices.Contains(refs, name) {
+			refs = append(refs, name)
+		}
+	}
+	for _, re := range toolCall {
+		for _, m := range re.FindAllStringSubmatch(text, -1) {
+			if !slices.Contains(refs, m[1]) {
+				refs = append(refs, m[1])
+			}
+		}
+	}
+	slices.Sort(refs)
+	return refs
+}
+
+func joinServers(servers []Server) string {
+	names := make([]string, len(servers))
+	for i, s := range servers {
+		names[i] = string(s)
+	}
+	slices.Sort(names)
+	return strings.Join(names, ", ")
+}
+
+// ServerTools returns the tools registered on each MCP server, including the
+// fabric messaging tools they get once a fabric service is attached.
+func ServerTools() map[Server][]string {
+	svc := newFabricService()
+
+	coordinator := mcp.NewCoordinatorServer("", 0, nil)
+	coordinator.SetFabricService(svc)
+	worker := mcp.NewWorkerServer("worker-1")
+	worker.SetFabricService(svc)
+	observer := mcp.NewObserverServer("observer")
+	observer.SetFabricService(svc)
+
+	return map[Server][]string{
+		ServerCoordinator: coordinator.ToolNames(),
+		ServerWorker:      worker.ToolNames(),
+		ServerObserver:    observer.ToolNames(),
+	}
+}
+
+// newFabricService creates an in-memory fabric service to register tools with.
+func newFabricService() *fabric.Service {
+	threads := fabricrepo.NewMemoryThreadRepository()
+	deps := fabricrepo.NewMemoryDependencyRepository()
+	subs := fabricrepo.NewMemorySubscriptionRepository()
+	acks := fabricrepo.NewMemoryAckRepository(deps, threads, subs)
+	participants := fabricrepo.NewMemoryParticipantRepository()
+	return fabric.NewService(threads, deps, subs, acks, participants)
+}
+
+// Run lints the built-in prompts against the registered tools.
+func Run() ([]Prompt, []Issue, error) {
+	prompts, err := BuiltinPrompts()
+	if err != nil {
+		return nil, nil, err
+	}
+	return prompts, Lint(prompts, ServerTools()), nil
+}
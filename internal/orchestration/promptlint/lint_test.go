@@ -0,0 +1,92 @@
+package promptlint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	tools := map[Server][]string{
+		ServerCoordinator: {"assign_task", "fabric_send"},
+		ServerWorker:      {"fabric_send", "report_implementation_complete"},
+	}
+
+	tests := []struct {
+		name   string
+		prompt Prompt
+		want   []string
+	}{
+		{
+			name:   "clean prompt",
+			prompt: Prompt{Server: ServerWorker, Text: "Use `fabric_send` with task_id, then report_implementation_complete(summary=...)."},
+		},
+		{
+			name:   "tool from another server",
+			prompt: Prompt{Server: ServerWorker, Text: "Never call assign_task yourself."},
+			want:   []string{"tool assign_task is not registered on the worker server (only on coordinator)"},
+		},
+		{
+			name:   "unregistered tool call",
+			prompt: Prompt{Server: ServerCoordinator, Text: "Use `send_to_worker` or post_message(content=...)."},
+			want: []string{
+				"tool post_message is not registered on any server",
+				"tool send_to_worker is not registered on any server",
+			},
+		},
+		{
+			name:   "parameters are not tools",
+			prompt: Prompt{Server: ServerCoordinator, Text: "Pass `worker_id`; use dry_run to preview."},
+		},
+		{
+			name:   "missing placeholder",
+			prompt: Prompt{Server: ServerWorker, Text: "Task lint-task.42", Placeholders: []string{"lint-task.42", "lint-worker-7"}},
+			want:   []string{`placeholder "lint-worker-7" is not rendered`},
+		},
+		{
+			name:   "template artifacts",
+			prompt: Prompt{Server: ServerWorker, Text: "Task %!s(MISSING) in {{SESSION_DIR}}"},
+			want: []string{
+				`contains template artifact "%!"`,
+				`contains template artifact "{{"`,
+				`contains template artifact "}}"`,
+			},
+		},
+		{
+			name:   "over budget",
+			prompt: Prompt{Server: ServerWorker, Text: strings.Repeat("é", 11), Budget: 10},
+			want:   []string{"length 11 exceeds budget of 10 characters"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prompt.Name = "test/prompt"
+			var got []string
+			for _, issue := range Lint([]Prompt{tt.prompt}, tools) {
+				require.Equal(t, "test/prompt", issue.Prompt)
+				got = append(got, issue.Message)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServerTools(t *testing.T) {
+	tools := ServerTools()
+
+	require.Contains(t, tools[ServerCoordinator], "assign_task")
+	require.Contains(t, tools[ServerCoordinator], "fabric_send")
+	require.Contains(t, tools[ServerWorker], "report_implementation_complete")
+	require.NotContains(t, tools[ServerWorker], "assign_task")
+	require.Contains(t, tools[ServerObserver], "fabric_history")
+}
+
+// TestBuiltinPrompts fails when a prompt drifts from the code it describes.
+// Run `perles prompts lint` for the same report.
+func TestBuiltinPrompts(t *testing.T) {
+	prompts, issues, err := Run()
+	require.NoError(t, err)
+	require.NotEmpty(t, prompts)
+	require.Empty(t, issues)
+}
@@ -0,0 +1,134 @@
+package promptlint
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/workflow"
+)
+
+// Placeholder values prompts are rendered with, distinct enough not to occur
+// in prompt text by accident.
+const (
+	workerID      = "lint-worker-7"
+	taskID        = "lint-task.42"
+	taskTitle     = "Lint Task Title"
+	taskSummary   = "Lint task summary."
+	threadID      = "lint-thread-id"
+	implementerID = "lint-implementer-3"
+	feedback      = "Lint review feedback."
+	commitMessage = "Lint commit message"
+	sessionDir    = "/lint/session/dir"
+	workflowName  = "Lint Workflow"
+	workflowBody  = "Lint workflow content."
+)
+
+// BuiltinPrompts renders the coordinator, worker, agent type and observer prompts.
+func BuiltinPrompts() ([]Prompt, error) {
+	coordinatorSystem, err := prompt.BuildCoordinatorSystemPrompt()
+	if err != nil {
+		return nil, fmt.Errorf("building coordinator system prompt: %w", err)
+	}
+	coordinatorInitial, err := prompt.BuildCoordinatorInitialPrompt()
+	if err != nil {
+		return nil, fmt.Errorf("building coordinator initial prompt: %w", err)
+	}
+
+	prompts := []Prompt{
+		// Coordinator
+		{Name: "coordinator/system", Server: ServerCoordinator, Text: coordinatorSystem, Budget: SystemPromptBudget},
+		{Name: "coordinator/initial", Server: ServerCoordinator, Text: coordinatorInitial, Budget: MessageBudget},
+		{Name: "coordinator/solo-initial", Server: ServerCoordinator, Text: prompt.BuildSoloCoordinatorInitialPrompt(), Budget: MessageBudget},
+		{Name: "coordinator/replace", Server: ServerCoordinator, Text: prompt.BuildReplacePrompt(), Budget: MessageBudget},
+		{
+			Name: "coordinator/workflow-continuation", Server: ServerCoordinator, Budget: MessageBudget,
+			Text: prompt.BuildWorkflowContinuationPrompt(&workflow.WorkflowState{
+				WorkflowID: "lint", WorkflowName: workflowName, WorkflowContent: workflowBody,
+			}),
+			Placeholders: []string{workflowName, workflowBody},
+		},
+		{
+			Name: "coordinator/worker-out-of-context", Server: ServerCoordinator, Budget: MessageBudget,
+			Text:         prompt.BuildWorkerOutOfContextPrompt(workerID, taskID),
+			Placeholders: []string{workerID, taskID},
+		},
+		{
+			Name: "coordinator/worker-out-of-context-idle", Server: ServerCoordinator, Budget: MessageBudget,
+			Text:         prompt.BuildWorkerOutOfContextPrompt(workerID, ""),
+			Placeholders: []string{workerID},
+		},
+
+		// Worker
+		{
+			Name: "worker/mcp-instructions", Server: ServerWorker, Budget: InstructionsBudget,
+			Text:         prompt.WorkerMCPInstructions(workerID),
+			Placeholders: []string{workerID},
+		},
+		{
+			Name: "worker/task-assignment", Server: ServerWorker, Budget: MessageBudget,
+			Text:         prompt.TaskAssignmentPrompt(taskID, taskTitle, taskSummary, threadID),
+			Placeholders: []string{taskID, taskTitle, taskSummary, threadID},
+		},
+		{
+			Name: "worker/review-assignment", Server: ServerWorker, Budget: MessageBudget,
+			Text:         prompt.ReviewAssignmentPrompt(taskID, implementerID),
+			Placeholders: []string{taskID, implementerID},
+		},
+		{
+			Name: "worker/review-assignment-simple", Server: ServerWorker, Budget: MessageBudget,
+			Text:         prompt.ReviewAssignmentPromptSimple(taskID, implementerID),
+			Placeholders: []string{taskID, implementerID},
+		},
+		{
+			Name: "worker/review-feedback", Server: ServerWorker, Budget: MessageBudget,
+			Text:         prompt.ReviewFeedbackPrompt(taskID, feedback),
+			Placeholders: []string{taskID, feedback},
+		},
+		{
+			Name: "worker/commit-approval", Server: ServerWorker, Budget: MessageBudget,
+			Text:         prompt.CommitApprovalPrompt(taskID, commitMessage),
+			Placeholders: []string{taskID, commitMessage},
+		},
+
+		// Observer
+		{Name: "observer/mcp-instructions", Server: ServerObserver, Text: prompt.ObserverMCPInstructions(), Budget: InstructionsBudget},
+		{Name: "observer/system", Server: ServerObserver, Text: roles.ObserverSystemPrompt(), Budget: SystemPromptBudget},
+		{
+			Name: "observer/initial", Server: ServerObserver, Budget: MessageBudget,
+			Text:         strings.ReplaceAll(roles.ObserverIdlePrompt(), roles.SessionDirPlaceholder, sessionDir),
+			Placeholders: []string{sessionDir},
+		},
+		{
+			Name: "observer/resume", Server: ServerObserver, Budget: MessageBudget,
+			Text:         roles.ObserverResumePrompt(sessionDir),
+			Placeholders: []string{sessionDir},
+		},
+	}
+
+	// Agent types
+	agentTypes := make([]roles.AgentType, 0, len(roles.Registry))
+	for agentType := range roles.Registry {
+		agentTypes = append(agentTypes, agentType)
+	}
+	slices.Sort(agentTypes)
+	for _, agentType := range agentTypes {
+		rolePrompts := roles.Registry[agentType]
+		prompts = append(prompts,
+			Prompt{
+				Name: "agent/" + agentType.String() + "/system", Server: ServerWorker, Budget: SystemPromptBudget,
+				Text:         rolePrompts.SystemPrompt(workerID),
+				Placeholders: []string{workerID},
+			},
+			Prompt{
+				Name: "agent/" + agentType.String() + "/initial", Server: ServerWorker, Budget: MessageBudget,
+				Text:         rolePrompts.InitialPrompt(workerID),
+				Placeholders: []string{workerID},
+			},
+		)
+	}
+
+	return prompts, nil
+}
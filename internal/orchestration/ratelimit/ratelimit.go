@@ -0,0 +1,193 @@
+// Package ratelimit limits how often agent processes may call MCP tools.
+//
+// A Policy sets a limit on all tool calls of a process and optional limits on
+// single tools. Limits are token buckets: a process may burst up to Calls
+// calls, after which calls are refilled evenly over Per. Every process has
+// its own buckets, so one looping worker cannot starve the others. The
+// Limiter counts allowed and limited calls per process and tool for
+// observability.
+package ratelimit
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Limit allows Calls calls per Per. The zero Limit is unlimited.
+type Limit struct {
+	Calls int
+	Per   time.Duration
+}
+
+// IsZero reports whether the limit is unlimited.
+func (l Limit) IsZero() bool {
+	return l.Calls <= 0 || l.Per <= 0
+}
+
+// String describes the limit, e.g. "10 calls per minute".
+func (l Limit) String() string {
+	per := l.Per.String()
+	switch l.Per {
+	case time.Second:
+		per = "second"
+	case time.Minute:
+		per = "minute"
+	case time.Hour:
+		per = "hour"
+	}
+	calls := "calls"
+	if l.Calls == 1 {
+		calls = "call"
+	}
+	return fmt.Sprintf("%d %s per %s", l.Calls, calls, per)
+}
+
+// Policy holds the limits applied to each process.
+type Policy struct {
+	// Process limits all tool calls of a process combined.
+	Process Limit
+	// Tools limits calls of single tools, by tool name.
+	Tools map[string]Limit
+}
+
+// Error is returned for a call over a limit. Its message is shown to the
+// agent, so it says which limit was hit and when to retry.
+type Error struct {
+	Tool       string
+	ProcessID  string
+	Limit      Limit
+	PerTool    bool // The tool's own limit was hit rather than the process limit
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	scope := "all tool calls are"
+	if e.PerTool {
+		scope = e.Tool + " is"
+	}
+	return fmt.Sprintf("rate limit exceeded: %s limited to %s per process; retry in %s instead of calling %s in a loop",
+		scope, e.Limit, max(time.Second, e.RetryAfter.Round(time.Second)), e.Tool)
+}
+
+// Counter counts the calls of one tool by one process.
+type Counter struct {
+	ProcessID string `json:"process_id"`
+	Tool      string `json:"tool"`
+	Allowed   int64  `json:"allowed"`
+	Limited   int64  `json:"limited"`
+}
+
+// bucket is a token bucket.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens earned since the last call, up to the limit.
+func (b *bucket) refill(l Limit, now time.Time) {
+	rate := float64(l.Calls) / l.Per.Seconds()
+	b.tokens = min(float64(l.Calls), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+}
+
+// wait returns how long until the bucket holds a token again.
+func (b *bucket) wait(l Limit) time.Duration {
+	rate := float64(l.Calls) / l.Per.Seconds()
+	return time.Duration((1 - b.tokens) / rate * float64(time.Second))
+}
+
+type key struct {
+	processID string
+	tool      string // Empty for the process bucket
+}
+
+// Limiter enforces a Policy. It is safe for concurrent use and may be shared
+// by the MCP servers of all processes of a workflow.
+type Limiter struct {
+	policy Policy
+	now    func() time.Time
+
+	mu       sync.Mutex
+	buckets  map[key]*bucket
+	counters map[key]*Counter
+}
+
+// NewLimiter creates a limiter for policy.
+func NewLimiter(policy Policy) *Limiter {
+	return &Limiter{
+		policy:   policy,
+		now:      time.Now,
+		buckets:  make(map[key]*bucket),
+		counters: make(map[key]*Counter),
+	}
+}
+
+// Allow records a call of tool by a process and returns an *Error if it is
+// over a limit. Limited calls do not count against the limits.
+func (l *Limiter) Allow(processID, tool string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	counter := l.counters[key{processID, tool}]
+	if counter == nil {
+		counter = &Counter{ProcessID: processID, Tool: tool}
+		l.counters[key{processID, tool}] = counter
+	}
+
+	// Check both buckets before taking from either
+	var taken []*bucket
+	for _, c := range []struct {
+		key     key
+		limit   Limit
+		perTool bool
+	}{
+		{key{processID, tool}, l.policy.Tools[tool], true},
+		{key{processID, ""}, l.policy.Process, false},
+	} {
+		if c.limit.IsZero() {
+			continue
+		}
+		b := l.bucket(c.key, c.limit, now)
+		b.refill(c.limit, now)
+		if b.tokens < 1 {
+			counter.Limited++
+			return &Error{Tool: tool, ProcessID: processID, Limit: c.limit, PerTool: c.perTool, RetryAfter: b.wait(c.limit)}
+		}
+		taken = append(taken, b)
+	}
+	for _, b := range taken {
+		b.tokens--
+	}
+	counter.Allowed++
+	return nil
+}
+
+// bucket returns the bucket for k, creating a full one on first use.
+func (l *Limiter) bucket(k key, limit Limit, now time.Time) *bucket {
+	b := l.buckets[k]
+	if b == nil {
+		b = &bucket{tokens: float64(limit.Calls), last: now}
+		l.buckets[k] = b
+	}
+	return b
+}
+
+// Counters returns the call counts of every process and tool seen, sorted by
+// process and tool.
+func (l *Limiter) Counters() []Counter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	counters := make([]Counter, 0, len(l.counters))
+	for _, c := range l.counters {
+		counters = append(counters, *c)
+	}
+	slices.SortFunc(counters, func(a, b Counter) int {
+		return cmp.Or(cmp.Compare(a.ProcessID, b.ProcessID), cmp.Compare(a.Tool, b.Tool))
+	})
+	return counters
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestLimiter returns a limiter whose clock is advanced by the returned func.
+func newTestLimiter(policy Policy) (*Limiter, func(time.Duration)) {
+	l := NewLimiter(policy)
+	now := testNow
+	l.now = func() time.Time { return now }
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestLimiter_ToolLimit(t *testing.T) {
+	l, advance := newTestLimiter(Policy{Tools: map[string]Limit{"fabric_send": {Calls: 2, Per: time.Minute}}})
+
+	require.NoError(t, l.Allow("worker-1", "fabric_send"))
+	require.NoError(t, l.Allow("worker-1", "fabric_send"))
+	err := l.Allow("worker-1", "fabric_send")
+	require.EqualError(t, err, "rate limit exceeded: fabric_send is limited to 2 calls per minute per process; retry in 30s instead of calling fabric_send in a loop")
+	var limitErr *Error
+	require.ErrorAs(t, err, &limitErr)
+	require.True(t, limitErr.PerTool)
+
+	// Other tools and other processes have their own buckets
+	require.NoError(t, l.Allow("worker-1", "fabric_inbox"))
+	require.NoError(t, l.Allow("worker-2", "fabric_send"))
+
+	// One call is refilled every 30s
+	advance(30 * time.Second)
+	require.NoError(t, l.Allow("worker-1", "fabric_send"))
+	require.Error(t, l.Allow("worker-1", "fabric_send"))
+
+	require.Equal(t, []Counter{
+		{ProcessID: "worker-1", Tool: "fabric_inbox", Allowed: 1},
+		{ProcessID: "worker-1", Tool: "fabric_send", Allowed: 3, Limited: 2},
+		{ProcessID: "worker-2", Tool: "fabric_send", Allowed: 1},
+	}, l.Counters())
+}
+
+func TestLimiter_ProcessLimit(t *testing.T) {
+	l, advance := newTestLimiter(Policy{
+		Process: Limit{Calls: 3, Per: time.Second},
+		Tools:   map[string]Limit{"query_worker_state": {Calls: 1, Per: time.Hour}},
+	})
+
+	require.NoError(t, l.Allow("coordinator", "query_worker_state"))
+	// A call over the tool limit does not use up the process limit
+	require.Error(t, l.Allow("coordinator", "query_worker_state"))
+	require.NoError(t, l.Allow("coordinator", "fabric_send"))
+	require.NoError(t, l.Allow("coordinator", "fabric_inbox"))
+
+	err := l.Allow("coordinator", "fabric_send")
+	require.EqualError(t, err, "rate limit exceeded: all tool calls are limited to 3 calls per second per process; retry in 1s instead of calling fabric_send in a loop")
+
+	advance(time.Second)
+	require.NoError(t, l.Allow("coordinator", "fabric_send"))
+}
+
+func TestLimiter_Unlimited(t *testing.T) {
+	l, _ := newTestLimiter(Policy{Tools: map[string]Limit{"fabric_send": {}}})
+	for range 100 {
+		require.NoError(t, l.Allow("worker-1", "fabric_send"))
+	}
+	require.Equal(t, []Counter{{ProcessID: "worker-1", Tool: "fabric_send", Allowed: 100}}, l.Counters())
+}
+
+func TestLimit_String(t *testing.T) {
+	require.Equal(t, "5 calls per hour", Limit{Calls: 5, Per: time.Hour}.String())
+	require.Equal(t, "5 calls per 10s", Limit{Calls: 5, Per: 10 * time.Second}.String())
+}
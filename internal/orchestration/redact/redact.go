@@ -0,0 +1,247 @@
+// Package redact masks secrets in text before it is persisted.
+//
+// A Redactor applies an ordered list of regex rules (API keys, tokens,
+// private keys, emails, ...) followed by an optional entropy rule that
+// catches random-looking strings no regex knows about. Every match is
+// replaced with a "[REDACTED:<rule>]" marker and counted, so a session can
+// report how much was masked without ever storing the secrets themselves.
+package redact
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Defaults for the entropy rule.
+const (
+	DefaultEntropyMinLength = 32
+	DefaultEntropyThreshold = 4.5
+)
+
+// EntropyRuleName is the report key for matches of the entropy rule.
+const EntropyRuleName = "high_entropy"
+
+// Rule masks every match of Pattern. If the pattern has a capture group,
+// only the first group is masked, so context like "password=" survives.
+type Rule struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// EntropyRule masks tokens of at least MinLength base64/hex-like characters
+// that mix letters and digits and whose Shannon entropy (bits per character)
+// reaches Threshold.
+type EntropyRule struct {
+	MinLength int
+	Threshold float64
+}
+
+// Policy is the set of rules a Redactor applies. It is immutable and can be
+// shared by any number of Redactors.
+type Policy struct {
+	Rules   []Rule
+	Entropy *EntropyRule // nil disables entropy detection
+}
+
+// defaultRules are applied in order; more specific patterns come first so
+// their names show up in the report.
+var defaultRules = []Rule{
+	{"private_key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	{"api_key", regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{20,}`)},
+	{"github_token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})`)},
+	{"aws_access_key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"slack_token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"jwt", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+	{"bearer_token", regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9._~+/-]{16,}=*)`)},
+	{"secret_assignment", regexp.MustCompile(`(?i)(?:api[_-]?key|secret|token|password|passwd)\\?["']?\s*[:=]\s*\\?["']?([A-Za-z0-9_./+=-]{8,})`)},
+	{"email", regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)},
+}
+
+// DefaultRules returns the built-in rules.
+func DefaultRules() []Rule {
+	return append([]Rule(nil), defaultRules...)
+}
+
+// DefaultPolicy returns the built-in rules plus entropy detection with the default settings.
+func DefaultPolicy() Policy {
+	return Policy{
+		Rules:   DefaultRules(),
+		Entropy: &EntropyRule{MinLength: DefaultEntropyMinLength, Threshold: DefaultEntropyThreshold},
+	}
+}
+
+// Report counts redactions per rule name.
+type Report map[string]int
+
+// Total returns the number of redactions across all rules.
+func (r Report) Total() int {
+	total := 0
+	for _, n := range r {
+		total += n
+	}
+	return total
+}
+
+// String renders the report as "email=2, api_key=1", largest counts first.
+func (r Report) String() string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if r[names[i]] != r[names[j]] {
+			return r[names[i]] > r[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + "=" + strconv.Itoa(r[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Redactor applies a Policy and counts what it masked. It is safe for concurrent use.
+// A nil *Redactor passes text through unchanged.
+type Redactor struct {
+	policy     Policy
+	candidates *regexp.Regexp // entropy rule token pattern, nil when disabled
+
+	mu     sync.Mutex
+	counts Report
+}
+
+// New creates a Redactor for the given policy.
+func New(policy Policy) *Redactor {
+	r := &Redactor{policy: policy, counts: Report{}}
+	if policy.Entropy != nil {
+		minLen := policy.Entropy.MinLength
+		if minLen <= 0 {
+			minLen = DefaultEntropyMinLength
+		}
+		r.candidates = regexp.MustCompile(`[A-Za-z0-9+/_=-]{` + strconv.Itoa(minLen) + `,}`)
+	}
+	return r
+}
+
+// Marker returns the replacement text for a match of the named rule.
+func Marker(rule string) string {
+	return "[REDACTED:" + rule + "]"
+}
+
+// Redact returns s with every secret masked.
+func (r *Redactor) Redact(s string) string {
+	if r == nil || s == "" {
+		return s
+	}
+
+	found := Report{}
+	for _, rule := range r.policy.Rules {
+		s = replaceRule(s, rule, found)
+	}
+	if r.candidates != nil {
+		s = r.candidates.ReplaceAllStringFunc(s, func(token string) string {
+			if !isHighEntropy(token, r.policy.Entropy.Threshold) {
+				return token
+			}
+			found[EntropyRuleName]++
+			return Marker(EntropyRuleName)
+		})
+	}
+
+	if len(found) > 0 {
+		r.mu.Lock()
+		for name, n := range found {
+			r.counts[name] += n
+		}
+		r.mu.Unlock()
+	}
+	return s
+}
+
+// RedactBytes is Redact for byte slices. The input is returned as-is when nothing matched.
+func (r *Redactor) RedactBytes(b []byte) []byte {
+	if r == nil || len(b) == 0 {
+		return b
+	}
+	redacted := r.Redact(string(b))
+	if redacted == string(b) {
+		return b
+	}
+	return []byte(redacted)
+}
+
+// Report returns a copy of the redaction counts so far.
+func (r *Redactor) Report() Report {
+	if r == nil {
+		return Report{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := make(Report, len(r.counts))
+	for name, n := range r.counts {
+		report[name] = n
+	}
+	return report
+}
+
+// replaceRule masks all matches of rule in s, adding them to found.
+func replaceRule(s string, rule Rule, found Report) string {
+	matches := rule.Pattern.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	marker := Marker(rule.Name)
+	var sb strings.Builder
+	last := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		// Mask only the first capture group when the pattern has one
+		if len(m) >= 4 && m[2] >= 0 {
+			start, end = m[2], m[3]
+		}
+		sb.WriteString(s[last:start])
+		sb.WriteString(marker)
+		last = end
+		found[rule.Name]++
+	}
+	sb.WriteString(s[last:])
+	return sb.String()
+}
+
+// isHighEntropy reports whether token mixes letters and digits and its
+// Shannon entropy reaches threshold bits per character.
+func isHighEntropy(token string, threshold float64) bool {
+	var hasLetter, hasDigit bool
+	freq := make(map[rune]int)
+	for _, c := range token {
+		switch {
+		case c >= '0' && c <= '9':
+			hasDigit = true
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			hasLetter = true
+		}
+		freq[c]++
+	}
+	if !hasLetter || !hasDigit {
+		return false
+	}
+	return shannonEntropy(freq, len(token)) >= threshold
+}
+
+// shannonEntropy returns the entropy in bits per character of a string with the given rune frequencies.
+func shannonEntropy(freq map[rune]int, length int) float64 {
+	var entropy float64
+	for _, n := range freq {
+		p := float64(n) / float64(length)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron_Next(t *testing.T) {
+	// Wednesday
+	from := time.Date(2026, 3, 11, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2026, 3, 11, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 3, 11, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * 1", time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 1-5", time.Date(2026, 3, 12, 9, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2026, 3, 12, 10, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * 7", time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)},
+		{"0,45 10,11 * * *", time.Date(2026, 3, 11, 10, 45, 0, 0, time.UTC)},
+		{"0 0-12/6 * * *", time.Date(2026, 3, 11, 12, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either one matches
+		{"0 0 20 * 5", time.Date(2026, 3, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, 3, 11, 11, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.expr, c.String())
+			require.Equal(t, tt.want, c.Next(from))
+		})
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{"* * * *", `cron expression "* * * *" must have 5 fields (minute hour day-of-month month day-of-week), got 4`},
+		{"60 * * * *", `cron expression "60 * * * *": minute 60 out of range 0-59`},
+		{"* * 0 * *", `cron expression "* * 0 * *": day of month 0 out of range 1-31`},
+		{"*/0 * * * *", `cron expression "*/0 * * * *": invalid step in minute field "*/0"`},
+		{"5-1 * * * *", `cron expression "5-1 * * * *": invalid range in minute field "5-1"`},
+		{"* * * jan *", `cron expression "* * * jan *": invalid month "jan"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
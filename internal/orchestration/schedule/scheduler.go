@@ -0,0 +1,236 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/notify"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+)
+
+// NotifyUseCase is the notification event for scheduled runs finishing.
+const NotifyUseCase = "scheduled_run"
+
+// DefaultInterval is how often the scheduler checks for due schedules.
+const DefaultInterval = 30 * time.Second
+
+// Config configures a Scheduler.
+type Config struct {
+	// Store holds the schedules (required).
+	Store *Store
+	// ControlPlane creates and starts the scheduled workflows (required).
+	ControlPlane controlplane.ControlPlane
+	// Prompt builds the coordinator prompt for a template and epic (required).
+	Prompt func(templateID, epicID string) string
+	// WorkDir is the working directory of the started workflows.
+	WorkDir string
+	// Notifier announces finished and failed runs. Defaults to NoopNotifier.
+	Notifier notify.Notifier
+	// Out receives one line per run event. Optional.
+	Out io.Writer
+	// Interval between checks for due schedules. Defaults to DefaultInterval.
+	Interval time.Duration
+	// Now returns the current time. Defaults to time.Now (for tests).
+	Now func() time.Time
+}
+
+// Scheduler starts a workflow whenever one of the stored schedules is due and
+// records how the run ended. Missed runs (e.g. while the scheduler was not
+// running) are collapsed into a single run.
+type Scheduler struct {
+	cfg Config
+
+	mu      sync.Mutex
+	running map[string]controlplane.WorkflowID // schedule ID -> workflow of its active run
+	wg      sync.WaitGroup
+}
+
+// New creates a Scheduler.
+func New(cfg Config) *Scheduler {
+	if cfg.Notifier == nil {
+		cfg.Notifier = notify.NoopNotifier{}
+	}
+	if cfg.Out == nil {
+		cfg.Out = io.Discard
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &Scheduler{
+		cfg:     cfg,
+		running: make(map[string]controlplane.WorkflowID),
+	}
+}
+
+// Run checks for due schedules every interval until ctx is cancelled, then
+// waits for the watchers of active runs to exit.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.Tick(ctx)
+		select {
+		case <-ctx.Done():
+			s.wg.Wait()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick starts a run of every schedule that is due.
+func (s *Scheduler) Tick(ctx context.Context) {
+	schedules, err := s.cfg.Store.List()
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to load schedules", "path", s.cfg.Store.Path(), "error", err)
+		return
+	}
+
+	now := s.cfg.Now()
+	for _, sched := range schedules {
+		next, err := sched.Next()
+		if err != nil {
+			log.Warn(log.CatOrch, "Skipping invalid schedule", "schedule", sched.ID, "error", err)
+			continue
+		}
+		if next.IsZero() || next.After(now) {
+			continue
+		}
+		s.fire(ctx, sched, now)
+	}
+}
+
+// fire starts the run of a due schedule, or skips it while its previous run
+// is still going.
+func (s *Scheduler) fire(ctx context.Context, sched Schedule, now time.Time) {
+	s.mu.Lock()
+	active, busy := s.running[sched.ID]
+	s.mu.Unlock()
+	if busy {
+		s.printf("%s skipped: %s is still running", sched.ID, active)
+		s.record(sched.ID, func(r *Schedule) {
+			r.LastRunAt = now
+			r.LastStatus = StatusSkipped
+			r.LastError = ""
+		})
+		return
+	}
+
+	id, events, unsubscribe, err := s.start(ctx, sched)
+	if err != nil {
+		s.printf("%s failed to start: %v", sched.ID, err)
+		s.cfg.Notifier.Notify("Scheduled run failed", fmt.Sprintf("%s (%s): %v", sched.ID, sched.EpicID, err), NotifyUseCase)
+		s.record(sched.ID, func(r *Schedule) {
+			r.LastRunAt = now
+			r.LastWorkflowID = ""
+			r.LastStatus = StatusFailed
+			r.LastError = err.Error()
+		})
+		return
+	}
+
+	s.printf("%s started workflow %s for %s", sched.ID, id, sched.EpicID)
+	s.record(sched.ID, func(r *Schedule) {
+		r.LastRunAt = now
+		r.LastWorkflowID = string(id)
+		r.LastStatus = StatusRunning
+		r.LastError = ""
+	})
+
+	s.mu.Lock()
+	s.running[sched.ID] = id
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go s.watch(ctx, sched, id, events, unsubscribe)
+}
+
+// start creates and starts the workflow of a schedule. It subscribes to the
+// workflow's terminal events before starting it so none are missed.
+func (s *Scheduler) start(ctx context.Context, sched Schedule) (controlplane.WorkflowID, <-chan controlplane.ControlPlaneEvent, func(), error) {
+	cp := s.cfg.ControlPlane
+	id, err := cp.Create(ctx, controlplane.WorkflowSpec{
+		TemplateID:    sched.Template,
+		Name:          sched.Name,
+		InitialPrompt: s.cfg.Prompt(sched.Template, sched.EpicID),
+		WorkDir:       s.cfg.WorkDir,
+		EpicID:        sched.EpicID,
+		Labels:        map[string]string{"schedule": sched.ID},
+	})
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("creating workflow: %w", err)
+	}
+
+	events, unsubscribe := cp.SubscribeFiltered(ctx, controlplane.EventFilter{
+		Types:       []controlplane.EventType{controlplane.EventWorkflowCompleted, controlplane.EventWorkflowFailed},
+		WorkflowIDs: []controlplane.WorkflowID{id},
+	})
+	if err := cp.Start(ctx, id); err != nil {
+		unsubscribe()
+		return "", nil, nil, fmt.Errorf("starting workflow %s: %w", id, err)
+	}
+	return id, events, unsubscribe, nil
+}
+
+// watch waits for a scheduled workflow to complete or fail, then records the
+// outcome and notifies the user.
+func (s *Scheduler) watch(ctx context.Context, sched Schedule, id controlplane.WorkflowID, events <-chan controlplane.ControlPlaneEvent, unsubscribe func()) {
+	defer s.wg.Done()
+	defer unsubscribe()
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, sched.ID)
+		s.mu.Unlock()
+	}()
+
+	var event controlplane.ControlPlaneEvent
+	select {
+	case <-ctx.Done():
+		// The scheduler is shutting down; don't leave the run marked running
+		s.record(sched.ID, func(r *Schedule) {
+			if r.LastWorkflowID == string(id) && r.LastStatus == StatusRunning {
+				r.LastStatus = StatusInterrupted
+				r.LastError = "scheduler stopped before the run finished"
+			}
+		})
+		return
+	case e, ok := <-events:
+		if !ok {
+			return
+		}
+		event = e
+	}
+
+	status, title := StatusCompleted, "Scheduled run completed"
+	if event.Type == controlplane.EventWorkflowFailed {
+		status, title = StatusFailed, "Scheduled run failed"
+	}
+	s.printf("%s workflow %s %s", sched.ID, id, status)
+	s.cfg.Notifier.Notify(title, fmt.Sprintf("%s (%s): workflow %s %s", sched.ID, sched.EpicID, id, status), NotifyUseCase)
+	s.record(sched.ID, func(r *Schedule) {
+		// A newer run may have been recorded meanwhile; only update our own
+		if r.LastWorkflowID == string(id) {
+			r.LastStatus = status
+		}
+	})
+}
+
+// record updates a stored schedule, logging failures. The schedule may have
+// been removed while its run was going.
+func (s *Scheduler) record(id string, fn func(*Schedule)) {
+	if err := s.cfg.Store.Update(id, fn); err != nil {
+		log.Warn(log.CatOrch, "Failed to record schedule run", "schedule", id, "error", err)
+	}
+}
+
+func (s *Scheduler) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(s.cfg.Out, "%s "+format+"\n", append([]any{s.cfg.Now().Format("2006-01-02 15:04")}, args...)...)
+}
@@ -0,0 +1,165 @@
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/controlplane"
+	"github.com/zjrosen/perles/internal/orchestration/controlplane/mocks"
+)
+
+// recordingNotifier records notifications.
+type recordingNotifier struct {
+	mu    sync.Mutex
+	title []string
+}
+
+func (n *recordingNotifier) Notify(title, _, useCase string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.title = append(n.title, useCase+": "+title)
+}
+
+func (n *recordingNotifier) titles() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.title...)
+}
+
+func newTestScheduler(t *testing.T, cp controlplane.ControlPlane, now time.Time) (*Scheduler, *Store, *recordingNotifier) {
+	t.Helper()
+	store := NewStore(filepath.Join(t.TempDir(), StoreFile))
+	notifier := &recordingNotifier{}
+	s := New(Config{
+		Store:        store,
+		ControlPlane: cp,
+		Prompt:       func(templateID, epicID string) string { return templateID + ":" + epicID },
+		WorkDir:      "/work",
+		Notifier:     notifier,
+		Out:          &bytes.Buffer{},
+		Now:          func() time.Time { return now },
+	})
+	return s, store, notifier
+}
+
+func TestScheduler_RunsDueScheduleAndRecordsCompletion(t *testing.T) {
+	created := time.Date(2026, 3, 9, 8, 0, 0, 0, time.Local) // Monday
+	now := time.Date(2026, 3, 9, 9, 0, 30, 0, time.Local)
+
+	events := make(chan controlplane.ControlPlaneEvent, 1)
+	cp := mocks.NewMockControlPlane(t)
+	cp.EXPECT().Create(mock.Anything, controlplane.WorkflowSpec{
+		TemplateID:    "cook",
+		InitialPrompt: "cook:perles-abc",
+		WorkDir:       "/work",
+		EpicID:        "perles-abc",
+		Labels:        map[string]string{"schedule": "s1"},
+	}).Return("wf-1", nil).Once()
+	cp.EXPECT().SubscribeFiltered(mock.Anything, mock.Anything).Return(events, func() {}).Once()
+	cp.EXPECT().Start(mock.Anything, controlplane.WorkflowID("wf-1")).Return(nil).Once()
+
+	s, store, notifier := newTestScheduler(t, cp, now)
+	_, err := store.Add(Schedule{Cron: "0 9 * * 1", EpicID: "perles-abc", Template: "cook", CreatedAt: created})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Tick(ctx)
+
+	schedules, err := store.List()
+	require.NoError(t, err)
+	require.Equal(t, StatusRunning, schedules[0].LastStatus)
+	require.Equal(t, "wf-1", schedules[0].LastWorkflowID)
+	require.Equal(t, now, schedules[0].LastRunAt.Local())
+
+	// Not due again until next Monday
+	s.Tick(ctx)
+
+	events <- controlplane.ControlPlaneEvent{Type: controlplane.EventWorkflowCompleted, WorkflowID: "wf-1"}
+	require.Eventually(t, func() bool {
+		schedules, err := store.List()
+		return err == nil && schedules[0].LastStatus == StatusCompleted
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"scheduled_run: Scheduled run completed"}, notifier.titles())
+}
+
+func TestScheduler_SkipsWhilePreviousRunIsActive(t *testing.T) {
+	created := time.Date(2026, 3, 9, 8, 0, 0, 0, time.Local)
+	now := time.Date(2026, 3, 9, 9, 0, 0, 0, time.Local)
+
+	cp := mocks.NewMockControlPlane(t)
+	cp.EXPECT().Create(mock.Anything, mock.Anything).Return("wf-1", nil).Once()
+	cp.EXPECT().SubscribeFiltered(mock.Anything, mock.Anything).
+		Return(make(chan controlplane.ControlPlaneEvent), func() {}).Once()
+	cp.EXPECT().Start(mock.Anything, controlplane.WorkflowID("wf-1")).Return(nil).Once()
+
+	s, store, _ := newTestScheduler(t, cp, now)
+	_, err := store.Add(Schedule{Cron: "* * * * *", EpicID: "perles-abc", Template: "cook", CreatedAt: created})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Tick(ctx)
+
+	s.cfg.Now = func() time.Time { return now.Add(time.Minute) }
+	s.Tick(ctx)
+
+	schedules, err := store.List()
+	require.NoError(t, err)
+	require.Equal(t, StatusSkipped, schedules[0].LastStatus)
+
+	cancel()
+	s.wg.Wait()
+}
+
+func TestScheduler_RecordsInterruptedRunOnShutdown(t *testing.T) {
+	created := time.Date(2026, 3, 9, 8, 0, 0, 0, time.Local)
+	now := time.Date(2026, 3, 9, 9, 0, 0, 0, time.Local)
+
+	cp := mocks.NewMockControlPlane(t)
+	cp.EXPECT().Create(mock.Anything, mock.Anything).Return("wf-1", nil).Once()
+	cp.EXPECT().SubscribeFiltered(mock.Anything, mock.Anything).
+		Return(make(chan controlplane.ControlPlaneEvent), func() {}).Once()
+	cp.EXPECT().Start(mock.Anything, controlplane.WorkflowID("wf-1")).Return(nil).Once()
+
+	s, store, _ := newTestScheduler(t, cp, now)
+	_, err := store.Add(Schedule{Cron: "0 9 * * 1", EpicID: "perles-abc", Template: "cook", CreatedAt: created})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Tick(ctx)
+	cancel()
+	s.wg.Wait()
+
+	schedules, err := store.List()
+	require.NoError(t, err)
+	require.Equal(t, StatusInterrupted, schedules[0].LastStatus)
+	require.Equal(t, "scheduler stopped before the run finished", schedules[0].LastError)
+}
+
+func TestScheduler_RecordsStartFailure(t *testing.T) {
+	created := time.Date(2026, 3, 9, 8, 0, 0, 0, time.Local)
+	now := time.Date(2026, 3, 9, 9, 0, 0, 0, time.Local)
+
+	cp := mocks.NewMockControlPlane(t)
+	cp.EXPECT().Create(mock.Anything, mock.Anything).Return("", errors.New("unknown template")).Once()
+
+	s, store, notifier := newTestScheduler(t, cp, now)
+	_, err := store.Add(Schedule{Cron: "0 9 * * *", EpicID: "perles-abc", Template: "nope", CreatedAt: created})
+	require.NoError(t, err)
+
+	s.Tick(context.Background())
+
+	schedules, err := store.List()
+	require.NoError(t, err)
+	require.Equal(t, StatusFailed, schedules[0].LastStatus)
+	require.Equal(t, "creating workflow: unknown template", schedules[0].LastError)
+	require.Equal(t, []string{"scheduled_run: Scheduled run failed"}, notifier.titles())
+}
@@ -0,0 +1,246 @@
+// Package schedule starts orchestration sessions on cron schedules.
+//
+// Schedules are stored in the beads directory so that `perles schedule add`
+// and a running `perles schedule daemon` share them; the daemon re-reads the
+// file on every tick and picks up changes without a restart.
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StoreFile is the name of the schedule table, stored in the beads directory.
+const StoreFile = "schedules.json"
+
+// DefaultTemplate is the workflow template scheduled runs use when none is
+// given. It works through an existing epic.
+const DefaultTemplate = "cook"
+
+// Run outcomes recorded on a schedule.
+const (
+	StatusRunning     = "running"
+	StatusCompleted   = "completed"
+	StatusFailed      = "failed"
+	StatusSkipped     = "skipped"     // the previous run was still going
+	StatusInterrupted = "interrupted" // the scheduler stopped before the run finished
+)
+
+// Schedule starts a workflow for an epic whenever its cron expression fires.
+type Schedule struct {
+	ID       string `json:"id"`
+	Cron     string `json:"cron"`
+	EpicID   string `json:"epic_id"`
+	Template string `json:"template"`
+	Name     string `json:"name,omitempty"` // Workflow display name, defaults to the template name
+
+	CreatedAt time.Time `json:"created_at"`
+
+	// Last run, zero until the schedule first fires
+	LastRunAt      time.Time `json:"last_run_at,omitzero"`
+	LastWorkflowID string    `json:"last_workflow_id,omitempty"`
+	LastStatus     string    `json:"last_status,omitempty"`
+	LastError      string    `json:"last_error,omitempty"`
+}
+
+// Validate checks the cron expression and the required fields.
+func (s *Schedule) Validate() error {
+	if s.EpicID == "" {
+		return errors.New("epic is required")
+	}
+	if s.Template == "" {
+		return errors.New("template is required")
+	}
+	_, err := ParseCron(s.Cron)
+	return err
+}
+
+// Next returns when the schedule fires next after its last run (or its
+// creation), which may be in the past if a run was missed.
+func (s *Schedule) Next() (time.Time, error) {
+	c, err := ParseCron(s.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+	base := s.CreatedAt
+	if !s.LastRunAt.IsZero() {
+		base = s.LastRunAt
+	}
+	return c.Next(base.Local()), nil
+}
+
+// Store reads and writes the schedule table at a path. It holds no state of
+// its own, so every call sees changes made by other processes. Changes hold an
+// exclusive lock on a sibling ".lock" file so that `perles schedule add` and a
+// running daemon don't overwrite each other's updates.
+type Store struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewStore creates a store for the schedule table at path.
+func NewStore(path string) *Store {
+	return &Store{path: path}
+}
+
+// Path returns the schedule table's path.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// List returns all schedules. A missing file has no schedules.
+func (s *Store) List() ([]Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.load()
+}
+
+// Add validates sched, gives it the next free ID and stores it.
+func (s *Store) Add(sched Schedule) (Schedule, error) {
+	if err := sched.Validate(); err != nil {
+		return Schedule{}, err
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return Schedule{}, err
+	}
+	defer unlock()
+
+	schedules, err := s.load()
+	if err != nil {
+		return Schedule{}, err
+	}
+	sched.ID = nextID(schedules)
+	if sched.CreatedAt.IsZero() {
+		sched.CreatedAt = time.Now()
+	}
+	if err := s.save(append(schedules, sched)); err != nil {
+		return Schedule{}, err
+	}
+	return sched, nil
+}
+
+// Remove deletes the schedule with the given ID.
+func (s *Store) Remove(id string) error {
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	schedules, err := s.load()
+	if err != nil {
+		return err
+	}
+	for i := range schedules {
+		if schedules[i].ID == id {
+			return s.save(append(schedules[:i], schedules[i+1:]...))
+		}
+	}
+	return fmt.Errorf("schedule not found: %s", id)
+}
+
+// Update applies fn to the schedule with the given ID and stores the result.
+func (s *Store) Update(id string, fn func(*Schedule)) error {
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	schedules, err := s.load()
+	if err != nil {
+		return err
+	}
+	for i := range schedules {
+		if schedules[i].ID == id {
+			fn(&schedules[i])
+			return s.save(schedules)
+		}
+	}
+	return fmt.Errorf("schedule not found: %s", id)
+}
+
+// lock takes the in-process mutex and the exclusive file lock for a
+// read-modify-write of the table. The returned function releases both.
+func (s *Store) lock() (func(), error) {
+	s.mu.Lock()
+	f, err := os.OpenFile(s.path+".lock", os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("locking schedules: %w", err)
+	}
+	if err := lockFile(f); err != nil {
+		_ = f.Close()
+		s.mu.Unlock()
+		return nil, fmt.Errorf("locking schedules: %w", err)
+	}
+	return func() {
+		_ = unlockFile(f)
+		_ = f.Close()
+		s.mu.Unlock()
+	}, nil
+}
+
+func (s *Store) load() ([]Schedule, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading schedules: %w", err)
+	}
+
+	var schedules []Schedule
+	if err := json.Unmarshal(data, &schedules); err != nil {
+		return nil, fmt.Errorf("parsing schedules %s: %w", s.path, err)
+	}
+	return schedules, nil
+}
+
+// save writes the table, replacing it atomically.
+func (s *Store) save(schedules []Schedule) error {
+	if schedules == nil {
+		schedules = []Schedule{}
+	}
+	data, err := json.MarshalIndent(schedules, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), StoreFile+".*")
+	if err != nil {
+		return fmt.Errorf("writing schedules: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("writing schedules: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing schedules: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("writing schedules: %w", err)
+	}
+	return nil
+}
+
+// nextID returns "s<n>" with n one past the highest existing number.
+func nextID(schedules []Schedule) string {
+	highest := 0
+	for _, sched := range schedules {
+		if n, err := strconv.Atoi(strings.TrimPrefix(sched.ID, "s")); err == nil && n > highest {
+			highest = n
+		}
+	}
+	return "s" + strconv.Itoa(highest+1)
+}
@@ -0,0 +1,96 @@
+package schedule
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_AddListRemove(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), StoreFile))
+
+	schedules, err := store.List()
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+
+	first, err := store.Add(Schedule{Cron: "0 9 * * 1", EpicID: "perles-abc", Template: DefaultTemplate})
+	require.NoError(t, err)
+	require.Equal(t, "s1", first.ID)
+	require.False(t, first.CreatedAt.IsZero())
+
+	second, err := store.Add(Schedule{Cron: "@daily", EpicID: "perles-def", Template: DefaultTemplate})
+	require.NoError(t, err)
+	require.Equal(t, "s2", second.ID)
+
+	require.NoError(t, store.Update("s1", func(s *Schedule) { s.LastStatus = StatusCompleted }))
+	require.NoError(t, store.Remove("s2"))
+	require.EqualError(t, store.Remove("s2"), "schedule not found: s2")
+
+	schedules, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Equal(t, "s1", schedules[0].ID)
+	require.Equal(t, StatusCompleted, schedules[0].LastStatus)
+
+	// IDs are not reused after removal of the highest one
+	third, err := store.Add(Schedule{Cron: "@daily", EpicID: "perles-ghi", Template: DefaultTemplate})
+	require.NoError(t, err)
+	require.Equal(t, "s2", third.ID)
+}
+
+func TestStore_ConcurrentStoresKeepEveryUpdate(t *testing.T) {
+	// Separate stores on one path stand in for the CLI and a running daemon
+	path := filepath.Join(t.TempDir(), StoreFile)
+	cli, daemon := NewStore(path), NewStore(path)
+	sched, err := cli.Add(Schedule{Cron: "@daily", EpicID: "perles-abc", Template: DefaultTemplate})
+	require.NoError(t, err)
+
+	const n = 20
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := cli.Add(Schedule{Cron: "@daily", EpicID: fmt.Sprintf("perles-%d", i), Template: DefaultTemplate})
+			require.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			require.NoError(t, daemon.Update(sched.ID, func(s *Schedule) { s.LastError += "x" }))
+		}()
+	}
+	wg.Wait()
+
+	schedules, err := cli.List()
+	require.NoError(t, err)
+	require.Len(t, schedules, n+1)
+	require.Len(t, schedules[0].LastError, n)
+}
+
+func TestStore_AddValidates(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), StoreFile))
+
+	_, err := store.Add(Schedule{Cron: "0 9 * * 1", Template: DefaultTemplate})
+	require.EqualError(t, err, "epic is required")
+
+	_, err = store.Add(Schedule{Cron: "0 9 * *", EpicID: "perles-abc", Template: DefaultTemplate})
+	require.ErrorContains(t, err, "must have 5 fields")
+}
+
+func TestSchedule_Next(t *testing.T) {
+	created := time.Date(2026, 3, 11, 10, 30, 0, 0, time.Local)
+	sched := Schedule{Cron: "0 9 * * *", CreatedAt: created}
+
+	next, err := sched.Next()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 12, 9, 0, 0, 0, time.Local), next)
+
+	sched.LastRunAt = next
+	next, err = sched.Next()
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 13, 9, 0, 0, 0, time.Local), next)
+}
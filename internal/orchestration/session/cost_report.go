@@ -0,0 +1,112 @@
+package session
+
+import (
+	"os"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+)
+
+// phaseMark records which workflow phase a worker is in and since when.
+type phaseMark struct {
+	phase string
+	since time.Time
+}
+
+// enterPhaseLocked moves a worker into phase at the given time, adding the
+// time spent in its previous phase to the phase totals. An empty phase ends
+// tracking for the worker. Caller must hold s.mu.
+func (s *Session) enterPhaseLocked(workerID, phase string, at time.Time) {
+	prev, ok := s.workerPhases[workerID]
+	if ok && prev.phase == phase {
+		return
+	}
+	if ok {
+		s.phaseTimes = addPhaseTime(s.phaseTimes, prev.phase, at.Sub(prev.since))
+	}
+	if phase == "" {
+		delete(s.workerPhases, workerID)
+		return
+	}
+	s.workerPhases[workerID] = phaseMark{phase: phase, since: at}
+}
+
+// addPhaseTime adds d to the total of phase, appending the phase if it is new.
+func addPhaseTime(times []metrics.PhaseTime, phase string, d time.Duration) []metrics.PhaseTime {
+	for i := range times {
+		if times[i].Phase == phase {
+			times[i].Duration += d
+			return times
+		}
+	}
+	return append(times, metrics.PhaseTime{Phase: phase, Duration: d})
+}
+
+// WriteCostReport records the number of tasks closed, then writes the cost
+// report for the session so far to cost_report.md and cost_report.json.
+// status is the workflow completion status. The report is written again with
+// final numbers when the session closes.
+// Implements handler.CostReporter interface.
+func (s *Session) WriteCostReport(status string, tasksClosed int) (*metrics.CostReport, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, os.ErrClosed
+	}
+
+	if meta, err := Load(s.Dir); err == nil {
+		meta.WorkflowTasksClosed = tasksClosed
+		if err := meta.Save(s.Dir); err != nil {
+			return nil, err
+		}
+	}
+
+	report := s.costReportLocked(status, tasksClosed, time.Now())
+	if err := report.Write(s.Dir); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// costReportLocked builds the cost report from the session's token usage and
+// phase times, counting phases still in progress up to now.
+// Caller must hold s.mu.
+func (s *Session) costReportLocked(status string, tasksClosed int, now time.Time) *metrics.CostReport {
+	report := &metrics.CostReport{
+		GeneratedAt:  now,
+		Status:       status,
+		Wallclock:    now.Sub(s.StartTime),
+		TasksClosed:  tasksClosed,
+		OutputTokens: s.tokenUsage.TotalOutputTokens,
+		CostUSD:      s.tokenUsage.TotalCostUSD,
+	}
+
+	report.Processes = append(report.Processes, processCost("coordinator", s.coordinatorTokenUsage))
+	if s.observerTokenUsage != (TokenUsageSummary{}) {
+		report.Processes = append(report.Processes, processCost("observer", s.observerTokenUsage))
+	}
+	for _, w := range s.workers {
+		report.Processes = append(report.Processes, processCost(w.ID, w.TokenUsage))
+	}
+
+	phases := append([]metrics.PhaseTime(nil), s.phaseTimes...)
+	for _, w := range s.workers {
+		if mark, ok := s.workerPhases[w.ID]; ok {
+			phases = addPhaseTime(phases, mark.phase, now.Sub(mark.since))
+		}
+	}
+	report.Phases = phases
+
+	return report
+}
+
+// processCost converts a process's token usage into a cost report line.
+func processCost(id string, usage TokenUsageSummary) metrics.ProcessCost {
+	return metrics.ProcessCost{
+		ID:            id,
+		ContextTokens: usage.ContextTokens,
+		OutputTokens:  usage.TotalOutputTokens,
+		CostUSD:       usage.TotalCostUSD,
+	}
+}
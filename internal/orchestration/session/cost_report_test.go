@@ -0,0 +1,74 @@
+package session
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+)
+
+func TestSession_PhaseTimesAccumulateAcrossWorkers(t *testing.T) {
+	sess, err := New("test-phase-times", filepath.Join(t.TempDir(), "session"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sess.Close(StatusCompleted) })
+
+	start := time.Now()
+	sess.addWorker("worker-1", start, "", "")
+	sess.addWorker("worker-2", start, "", "")
+	sess.updateProcessPhase("worker-1", "implementing", start)
+	sess.updateProcessPhase("worker-2", "implementing", start.Add(time.Minute))
+	sess.updateProcessPhase("worker-1", "implementing", start.Add(2*time.Minute)) // Same phase, no-op
+	sess.updateProcessPhase("worker-1", "reviewing", start.Add(10*time.Minute))
+	sess.retireWorker("worker-2", start.Add(5*time.Minute), "implementing")
+
+	sess.mu.Lock()
+	report := sess.costReportLocked("success", 2, start.Add(12*time.Minute))
+	sess.mu.Unlock()
+
+	require.Equal(t, []metrics.PhaseTime{
+		{Phase: "implementing", Duration: 14 * time.Minute}, // 10m + 4m
+		{Phase: "reviewing", Duration: 2 * time.Minute},     // Still in progress
+	}, report.Phases)
+}
+
+func TestSession_WriteCostReport(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	sess, err := New("test-cost-report", sessionDir)
+	require.NoError(t, err)
+
+	sess.addWorker("worker-1", time.Now(), "", "")
+	sess.updateTokenUsage("coordinator", 1000, 400, 0.50)
+	sess.updateTokenUsage("worker-1", 2000, 1200, 1.50)
+
+	report, err := sess.WriteCostReport("success", 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.TasksClosed)
+	require.InDelta(t, 2.0, report.CostUSD, 0.001)
+	require.Equal(t, 1600, report.OutputTokens)
+	require.Equal(t, []metrics.ProcessCost{
+		{ID: "coordinator", ContextTokens: 1000, OutputTokens: 400, CostUSD: 0.50},
+		{ID: "worker-1", ContextTokens: 2000, OutputTokens: 1200, CostUSD: 1.50},
+	}, report.Processes)
+
+	require.FileExists(t, filepath.Join(sessionDir, metrics.CostReportMarkdownFile))
+
+	// Closing rewrites the report, keeping the completion status and tasks closed
+	require.NoError(t, sess.UpdateWorkflowCompletion("success", "done", time.Now()))
+	require.NoError(t, sess.Close(StatusCompleted))
+
+	data, err := os.ReadFile(filepath.Join(sessionDir, metrics.CostReportJSONFile))
+	require.NoError(t, err)
+	var final metrics.CostReport
+	require.NoError(t, json.Unmarshal(data, &final))
+	require.Equal(t, "success", final.Status)
+	require.Equal(t, 3, final.TasksClosed)
+	require.InDelta(t, 2.0, final.CostUSD, 0.001)
+
+	_, err = sess.WriteCostReport("success", 3)
+	require.ErrorIs(t, err, os.ErrClosed)
+}
@@ -3,6 +3,8 @@ package session
 import (
 	"fmt"
 	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/redact"
 )
 
 // Factory creates Session instances with consistent path building and configuration.
@@ -11,6 +13,7 @@ import (
 type Factory struct {
 	baseDir     string
 	gitExecutor GitRemoteGetter
+	redaction   *redact.Policy
 }
 
 // FactoryConfig holds configuration for creating a Factory.
@@ -22,6 +25,11 @@ type FactoryConfig struct {
 	// GitExecutor is used to derive the application name from git remote.
 	// If nil, the application name falls back to the work directory basename.
 	GitExecutor GitRemoteGetter
+
+	// Redaction is the policy used to mask secrets in session transcripts and logs.
+	// Each session gets its own redactor so counts are reported per session.
+	// If nil, sessions are written unredacted.
+	Redaction *redact.Policy
 }
 
 // NewFactory creates a new session Factory with the given configuration.
@@ -33,6 +41,7 @@ func NewFactory(cfg FactoryConfig) *Factory {
 	return &Factory{
 		baseDir:     baseDir,
 		gitExecutor: cfg.GitExecutor,
+		redaction:   cfg.Redaction,
 	}
 }
 
@@ -84,6 +93,7 @@ func (f *Factory) Create(opts CreateOptions) (*Session, error) {
 		WithDatePartition(datePartition),
 		WithPathBuilder(pathBuilder),
 		WithWorkflowID(opts.WorkflowID),
+		WithRedactor(f.newRedactor()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("creating session: %w", err)
@@ -121,6 +131,7 @@ func (f *Factory) Reopen(opts CreateOptions, existingDir string) (*Session, erro
 		WithApplicationName(appName),
 		WithDatePartition(datePartition),
 		WithPathBuilder(pathBuilder),
+		WithRedactor(f.newRedactor()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("reopening session: %w", err)
@@ -129,6 +140,20 @@ func (f *Factory) Reopen(opts CreateOptions, existingDir string) (*Session, erro
 	return sess, nil
 }
 
+// newRedactor returns a redactor for one session, or nil when redaction is disabled.
+func (f *Factory) newRedactor() *redact.Redactor {
+	if f.redaction == nil {
+		return nil
+	}
+	return redact.New(*f.redaction)
+}
+
+// ProjectMemoryPath returns the project memory log shared by all sessions of
+// the application that workDir belongs to.
+func (f *Factory) ProjectMemoryPath(workDir string) string {
+	return NewSessionPathBuilder(f.baseDir, DeriveApplicationName(workDir, f.gitExecutor)).ProjectMemoryPath()
+}
+
 // BaseDir returns the configured base directory.
 func (f *Factory) BaseDir() string {
 	return f.baseDir
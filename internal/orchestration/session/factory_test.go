@@ -7,6 +7,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/message"
+	"github.com/zjrosen/perles/internal/orchestration/redact"
 )
 
 func TestFactory_Create_Success(t *testing.T) {
@@ -38,6 +41,32 @@ func TestFactory_Create_Success(t *testing.T) {
 	_ = sess.Close(StatusCompleted)
 }
 
+func TestFactory_Create_WithRedaction(t *testing.T) {
+	policy := redact.DefaultPolicy()
+	factory := NewFactory(FactoryConfig{
+		BaseDir:   t.TempDir(),
+		Redaction: &policy,
+	})
+
+	first, err := factory.Create(CreateOptions{SessionID: "redact-1", WorkDir: t.TempDir()})
+	require.NoError(t, err)
+	second, err := factory.Create(CreateOptions{SessionID: "redact-2", WorkDir: t.TempDir()})
+	require.NoError(t, err)
+
+	require.NoError(t, first.WriteMessage(message.Entry{Content: "ping a@b.io"}))
+	require.NoError(t, first.Close(StatusCompleted))
+	require.NoError(t, second.Close(StatusCompleted))
+
+	// Each session reports only its own redactions
+	meta, err := Load(first.Dir)
+	require.NoError(t, err)
+	require.Equal(t, redact.Report{"email": 1}, meta.Redactions)
+
+	meta, err = Load(second.Dir)
+	require.NoError(t, err)
+	require.Empty(t, meta.Redactions)
+}
+
 func TestFactory_Create_WithApplicationName(t *testing.T) {
 	baseDir := t.TempDir()
 
@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/redact"
 )
 
 const metadataFilename = "metadata.json"
@@ -84,8 +86,14 @@ type Metadata struct {
 	// WorkflowSummary is the completion summary provided by the coordinator.
 	WorkflowSummary string `json:"workflow_summary,omitempty"`
 
+	// WorkflowTasksClosed is the number of tasks the coordinator reported closed on completion.
+	WorkflowTasksClosed int `json:"workflow_tasks_closed,omitempty"`
+
 	// Observer contains metadata for the observer agent (if enabled).
 	Observer *ObserverMetadata `json:"observer,omitempty"`
+
+	// Redactions counts secrets masked in the session's transcripts and logs, by rule.
+	Redactions redact.Report `json:"redactions,omitempty"`
 }
 
 // ObserverMetadata tracks the observer agent lifecycle.
@@ -121,6 +129,10 @@ type WorkerMetadata struct {
 	// Currently same as session WorkDir, but supports future per-worker worktrees.
 	WorkDir string `json:"work_dir,omitempty"`
 
+	// Backend is the agent backend the worker was spawned with (e.g., "codex").
+	// Empty means the default worker client.
+	Backend string `json:"backend,omitempty"`
+
 	// TokenUsage tracks cumulative token usage for this worker.
 	TokenUsage TokenUsageSummary `json:"token_usage,omitzero"`
 }
@@ -132,6 +132,12 @@ func (b *SessionPathBuilder) ApplicationIndexPath() string {
 	return filepath.Join(b.baseDir, b.applicationName, "sessions.json")
 }
 
+// ProjectMemoryPath returns the path to the per-application project memory log.
+// Format: {baseDir}/{applicationName}/project_memory.jsonl
+func (b *SessionPathBuilder) ProjectMemoryPath() string {
+	return filepath.Join(b.baseDir, b.applicationName, "project_memory.jsonl")
+}
+
 // DateDir returns the date partition directory for a given timestamp.
 // Format: {baseDir}/{applicationName}/{YYYY-MM-DD}
 func (b *SessionPathBuilder) DateDir(timestamp time.Time) string {
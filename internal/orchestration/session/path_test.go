@@ -110,6 +110,14 @@ func TestApplicationIndexPath(t *testing.T) {
 	require.Equal(t, expected, result, "ApplicationIndexPath should return {baseDir}/{app}/sessions.json")
 }
 
+func TestProjectMemoryPath(t *testing.T) {
+	baseDir := filepath.Join("home", "user", ".perles", "sessions")
+	builder := NewSessionPathBuilder(baseDir, "perles")
+	result := builder.ProjectMemoryPath()
+	expected := filepath.Join(baseDir, "perles", "project_memory.jsonl")
+	require.Equal(t, expected, result, "ProjectMemoryPath should return {baseDir}/{app}/project_memory.jsonl")
+}
+
 func TestDateDir(t *testing.T) {
 	baseDir := filepath.Join("home", "user", ".perles", "sessions")
 	builder := NewSessionPathBuilder(baseDir, "perles")
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/message"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
@@ -92,11 +93,16 @@ func RestoreProcessRepository(repo repository.ProcessRepository, session *Resuma
 //   - session: loaded session data containing coordinator and worker metadata
 //   - submitter: CommandSubmitter for processes to submit commands on state transitions
 //   - eventBus: event bus for processes to publish events
+//   - providers: agent providers used to resolve workers spawned with a non-default backend
+//
+// Returns an error if a worker's backend is no longer configured, since resuming
+// its session ref with another client would fail.
 func RestoreProcessRegistry(
 	registry *process.ProcessRegistry,
 	session *ResumableSession,
 	submitter process.CommandSubmitter,
 	eventBus *pubsub.Broker[any],
+	providers client.AgentProviders,
 ) error {
 	if session == nil {
 		return fmt.Errorf("session is nil")
@@ -132,6 +138,10 @@ func RestoreProcessRegistry(
 	// Restore active workers as dormant processes
 	// Retired workers are NOT added - they can't receive messages
 	for _, w := range session.ActiveWorkers {
+		backend, err := workerBackend(providers, w.Backend)
+		if err != nil {
+			return fmt.Errorf("restoring worker %s: %w", w.ID, err)
+		}
 		worker := process.NewDormant(
 			w.ID,
 			repository.RoleWorker,
@@ -139,12 +149,32 @@ func RestoreProcessRegistry(
 			submitter,
 			eventBus,
 		)
+		worker.Backend = backend
 		registry.Register(worker)
 	}
 
 	return nil
 }
 
+// workerBackend returns the provider of a worker's backend, or nil for the
+// default worker client.
+func workerBackend(providers client.AgentProviders, backend string) (client.AgentProvider, error) {
+	if backend == "" {
+		return nil, nil
+	}
+	if providers == nil {
+		return nil, fmt.Errorf("backend %s is not configured", backend)
+	}
+	provider, ok := providers.WorkerBackend(client.ClientType(backend))
+	if !ok {
+		return nil, fmt.Errorf("backend %s is not configured", backend)
+	}
+	if provider.Type() == providers.Worker().Type() {
+		return nil, nil
+	}
+	return provider, nil
+}
+
 // workerMetadataToProcess converts WorkerMetadata to a repository.Process entity.
 // The status parameter determines whether this is an active or retired worker.
 //
@@ -162,6 +192,7 @@ func workerMetadataToProcess(w WorkerMetadata, status repository.ProcessStatus)
 		LastActivityAt:   w.SpawnedAt,
 		HasCompletedTurn: true, // Had previous turns
 		RetiredAt:        w.RetiredAt,
+		Backend:          w.Backend, // resumed with the client the session ref belongs to
 	}
 
 	// Restore phase if available
@@ -1,11 +1,13 @@
 package session
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/message"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
@@ -648,7 +650,7 @@ func TestRestoreProcessRegistry_CoordinatorAndActiveWorkers(t *testing.T) {
 		},
 	}
 
-	err := RestoreProcessRegistry(registry, session, nil, eventBus)
+	err := RestoreProcessRegistry(registry, session, nil, eventBus, nil)
 	require.NoError(t, err)
 
 	// Verify coordinator was registered with session ID
@@ -687,7 +689,7 @@ func TestRestoreProcessRegistry_DormantProcessesCanBeResumed(t *testing.T) {
 		RetiredWorkers: []WorkerMetadata{},
 	}
 
-	err := RestoreProcessRegistry(registry, session, nil, eventBus)
+	err := RestoreProcessRegistry(registry, session, nil, eventBus, nil)
 	require.NoError(t, err)
 
 	// Get the dormant coordinator
@@ -702,7 +704,7 @@ func TestRestoreProcessRegistry_DormantProcessesCanBeResumed(t *testing.T) {
 func TestRestoreProcessRegistry_NilSession(t *testing.T) {
 	registry := process.NewProcessRegistry()
 
-	err := RestoreProcessRegistry(registry, nil, nil, nil)
+	err := RestoreProcessRegistry(registry, nil, nil, nil, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "session is nil")
 }
@@ -714,7 +716,7 @@ func TestRestoreProcessRegistry_NilMetadata(t *testing.T) {
 		Metadata: nil,
 	}
 
-	err := RestoreProcessRegistry(registry, session, nil, nil)
+	err := RestoreProcessRegistry(registry, session, nil, nil, nil)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "metadata is nil")
 }
@@ -734,7 +736,7 @@ func TestRestoreProcessRegistry_EmptyActiveWorkers(t *testing.T) {
 		RetiredWorkers: []WorkerMetadata{},
 	}
 
-	err := RestoreProcessRegistry(registry, session, nil, nil)
+	err := RestoreProcessRegistry(registry, session, nil, nil, nil)
 	require.NoError(t, err)
 
 	// Should only have coordinator
@@ -765,7 +767,7 @@ func TestRestoreProcessRegistry_WithObserver(t *testing.T) {
 		RetiredWorkers: []WorkerMetadata{},
 	}
 
-	err := RestoreProcessRegistry(registry, session, nil, eventBus)
+	err := RestoreProcessRegistry(registry, session, nil, eventBus, nil)
 	require.NoError(t, err)
 
 	// Should have coordinator and observer
@@ -795,7 +797,7 @@ func TestRestoreProcessRegistry_NoObserver(t *testing.T) {
 		RetiredWorkers: []WorkerMetadata{},
 	}
 
-	err := RestoreProcessRegistry(registry, session, nil, nil)
+	err := RestoreProcessRegistry(registry, session, nil, nil, nil)
 	require.NoError(t, err)
 
 	// Should only have coordinator (no observer)
@@ -806,3 +808,46 @@ func TestRestoreProcessRegistry_NoObserver(t *testing.T) {
 	observer := registry.Get(repository.ObserverID)
 	require.Nil(t, observer, "Observer should NOT be registered when not in session")
 }
+
+func TestRestore_WorkerBackendSurvivesColdResume(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	sess, err := New("test-backend", sessionDir)
+	require.NoError(t, err)
+
+	sess.handleProcessEvent(events.NewProcessEvent(events.ProcessSpawned, "worker-1", events.RoleWorker))
+	sess.handleProcessEvent(events.NewProcessEvent(events.ProcessSpawned, "worker-2", events.RoleWorker).
+		WithBackend(string(client.ClientCodex)))
+	require.NoError(t, sess.SetCoordinatorSessionRef("coord-ref"))
+	require.NoError(t, sess.SetWorkerSessionRef("worker-2", "codex-thread-1", sessionDir))
+	require.NoError(t, sess.MarkResumable())
+	require.NoError(t, sess.Close(StatusCompleted))
+
+	resumable, err := LoadResumableSession(sessionDir)
+	require.NoError(t, err)
+
+	repo := repository.NewMemoryProcessRepository()
+	require.NoError(t, RestoreProcessRepository(repo, resumable))
+	proc, err := repo.Get("worker-2")
+	require.NoError(t, err)
+	require.Equal(t, "codex", proc.Backend)
+	require.Equal(t, "codex-thread-1", proc.SessionID)
+	proc, err = repo.Get("worker-1")
+	require.NoError(t, err)
+	require.Empty(t, proc.Backend)
+
+	providers := client.AgentProviders{
+		client.RoleCoordinator:                       client.NewAgentProvider(client.ClientClaude, nil),
+		client.RoleWorker:                            client.NewAgentProvider(client.ClientClaude, nil),
+		client.WorkerBackendRole(client.ClientCodex): client.NewAgentProvider(client.ClientCodex, nil),
+	}
+	registry := process.NewProcessRegistry()
+	require.NoError(t, RestoreProcessRegistry(registry, resumable, nil, nil, providers))
+	require.Nil(t, registry.Get("worker-1").Backend, "default workers resume with the worker client")
+	require.NotNil(t, registry.Get("worker-2").Backend)
+	require.Equal(t, client.ClientCodex, registry.Get("worker-2").Backend.Type())
+
+	// A backend that is no longer configured can't be resumed
+	delete(providers, client.WorkerBackendRole(client.ClientCodex))
+	err = RestoreProcessRegistry(process.NewProcessRegistry(), resumable, nil, nil, providers)
+	require.ErrorContains(t, err, "restoring worker worker-2: backend codex is not configured")
+}
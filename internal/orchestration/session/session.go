@@ -14,8 +14,11 @@ import (
 	"time"
 
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/message"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/redact"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/pubsub"
@@ -65,6 +68,7 @@ type Session struct {
 	messageLog       *BufferedWriter            // messages.jsonl (inter-agent messages)
 	mcpLog           *BufferedWriter            // mcp_requests.jsonl
 	commandLog       *BufferedWriter            // commands.jsonl (V2 command processor events)
+	timelineLog      *BufferedWriter            // timeline.jsonl (commands and fabric events interleaved)
 
 	// Metadata for tracking workers and token usage.
 	workers               []WorkerMetadata
@@ -72,6 +76,10 @@ type Session struct {
 	coordinatorTokenUsage TokenUsageSummary // Coordinator's cumulative usage
 	observerTokenUsage    TokenUsageSummary // Observer's cumulative usage
 
+	// Wallclock per workflow phase, for the cost report.
+	workerPhases map[string]phaseMark // workerID -> current phase and when it began
+	phaseTimes   []metrics.PhaseTime  // Completed phase time, in order of first appearance
+
 	// Session resumption fields.
 	coordinatorSessionRef string
 	observerSessionRef    string
@@ -91,6 +99,10 @@ type Session struct {
 	// Workflow state for persistence across coordinator refresh cycles.
 	activeWorkflowState *workflow.WorkflowState
 
+	// redactor masks secrets in every JSONL line before it reaches disk.
+	// Nil disables redaction. Set via WithRedactor option.
+	redactor *redact.Redactor
+
 	// Synchronization.
 	mu     sync.Mutex
 	closed bool
@@ -139,12 +151,21 @@ func WithPathBuilder(pb *SessionPathBuilder) SessionOption {
 	}
 }
 
+// WithRedactor masks secrets in transcripts and logs before they are written.
+// The redactor's counts are recorded in metadata.json and summary.md on Close.
+func WithRedactor(r *redact.Redactor) SessionOption {
+	return func(s *Session) {
+		s.redactor = r
+	}
+}
+
 // Directory and file constants for session folder structure.
 const (
 	// Directory names.
 	coordinatorDir = "coordinator"
 	observerDir    = "observer"
 	workersDir     = "workers"
+	workerTasksDir = "tasks" // Per-task accountability summaries (worker directories)
 
 	// File names.
 	rawJSONLFile              = "raw.jsonl"
@@ -152,6 +173,7 @@ const (
 	chatMessagesFile          = "messages.jsonl" // Chat messages (coordinator/worker directories)
 	mcpRequestsFile           = "mcp_requests.jsonl"
 	commandsFile              = "commands.jsonl"
+	timelineFile              = "timeline.jsonl"
 	summaryFile               = "summary.md"
 	accountabilitySummaryFile = "accountability_summary.md"
 )
@@ -172,6 +194,10 @@ const (
 //	├── workers/                     # Worker directories created on demand
 //	├── messages.jsonl               # Inter-agent message log
 //	├── mcp_requests.jsonl           # MCP tool call requests/responses
+//	├── commands.jsonl               # V2 command processor events
+//	├── timeline.jsonl               # Commands and fabric events in processing order
+//	├── audit.jsonl                  # Coordinator decisions (written by the audit package)
+//	├── cost_report.md/.json         # Spend and effort report (created on completion and close)
 //	└── summary.md                   # Post-session summary (created on close)
 func New(id, dir string, opts ...SessionOption) (*Session, error) {
 	// Create the main session directory
@@ -267,6 +293,20 @@ func New(id, dir string, opts ...SessionOption) (*Session, error) {
 	}
 	commandLog := NewBufferedWriter(commandsLogFile)
 
+	// Create timeline.jsonl with BufferedWriter
+	timelinePath := filepath.Join(dir, timelineFile)
+	timelineLogFile, err := os.OpenFile(timelinePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec // G304: path is constructed from trusted dir parameter
+	if err != nil {
+		_ = coordRaw.Close()
+		_ = coordMessages.Close()
+		_ = observerMessages.Close()
+		_ = messageLog.Close()
+		_ = mcpLog.Close()
+		_ = commandLog.Close()
+		return nil, fmt.Errorf("creating timeline.jsonl: %w", err)
+	}
+	timelineLog := NewBufferedWriter(timelineLogFile)
+
 	startTime := time.Now()
 
 	// Create session struct first so we can apply options.
@@ -286,9 +326,11 @@ func New(id, dir string, opts ...SessionOption) (*Session, error) {
 		observerMessages: observerMessages,
 		workerRaws:       make(map[string]*BufferedWriter),
 		workerMessages:   make(map[string]*BufferedWriter),
+		workerPhases:     make(map[string]phaseMark),
 		messageLog:       messageLog,
 		mcpLog:           mcpLog,
 		commandLog:       commandLog,
+		timelineLog:      timelineLog,
 		workers:          []WorkerMetadata{},
 		tokenUsage:       TokenUsageSummary{},
 		closed:           false,
@@ -318,6 +360,7 @@ func New(id, dir string, opts ...SessionOption) (*Session, error) {
 		_ = messageLog.Close()
 		_ = mcpLog.Close()
 		_ = commandLog.Close()
+		_ = timelineLog.Close()
 		return nil, fmt.Errorf("saving initial metadata: %w", err)
 	}
 
@@ -429,6 +472,20 @@ func Reopen(sessionID, sessionDir string, opts ...SessionOption) (*Session, erro
 	}
 	commandLog := NewBufferedWriter(commandsLogFile)
 
+	// Open timeline.jsonl in append mode (created for sessions that predate the timeline)
+	timelinePath := filepath.Join(sessionDir, timelineFile)
+	timelineLogFile, err := os.OpenFile(timelinePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) //nolint:gosec // G304: path is constructed from trusted sessionDir parameter
+	if err != nil {
+		_ = coordRaw.Close()
+		_ = coordMessages.Close()
+		_ = observerMessages.Close()
+		_ = messageLog.Close()
+		_ = mcpLog.Close()
+		_ = commandLog.Close()
+		return nil, fmt.Errorf("reopening timeline.jsonl: %w", err)
+	}
+	timelineLog := NewBufferedWriter(timelineLogFile)
+
 	// Create session with current time as start time for this resumed session.
 	// Token usage metrics ARE loaded from prior metadata (meta.TokenUsage) to preserve
 	// accumulated totals from before the session was paused. This does NOT cause
@@ -448,9 +505,11 @@ func Reopen(sessionID, sessionDir string, opts ...SessionOption) (*Session, erro
 		observerMessages: observerMessages,
 		workerRaws:       make(map[string]*BufferedWriter),
 		workerMessages:   make(map[string]*BufferedWriter),
+		workerPhases:     make(map[string]phaseMark),
 		messageLog:       messageLog,
 		mcpLog:           mcpLog,
 		commandLog:       commandLog,
+		timelineLog:      timelineLog,
 		// Restore workers from metadata to preserve existing worker list
 		workers:               meta.Workers,
 		tokenUsage:            meta.TokenUsage,            // Load prior aggregate - see comment above for why this is safe
@@ -489,7 +548,7 @@ func (s *Session) WriteCoordinatorMessage(msg chatrender.Message) error {
 
 	// Append newline for JSONL format
 	data = append(data, '\n')
-	return s.coordMessages.Write(data)
+	return s.coordMessages.Write(s.redactor.RedactBytes(data))
 }
 
 // WriteObserverMessage writes a structured chat message to observer/messages.jsonl.
@@ -509,7 +568,7 @@ func (s *Session) WriteObserverMessage(msg chatrender.Message) error {
 
 	// Append newline for JSONL format
 	data = append(data, '\n')
-	return s.observerMessages.Write(data)
+	return s.observerMessages.Write(s.redactor.RedactBytes(data))
 }
 
 // WriteWorkerMessage writes a structured chat message to workers/{workerID}/messages.jsonl.
@@ -535,7 +594,7 @@ func (s *Session) WriteWorkerMessage(workerID string, msg chatrender.Message) er
 
 	// Append newline for JSONL format
 	data = append(data, '\n')
-	return writer.Write(data)
+	return writer.Write(s.redactor.RedactBytes(data))
 }
 
 // WriteCoordinatorRawJSON appends raw JSON to coordinator/raw.jsonl.
@@ -554,7 +613,7 @@ func (s *Session) WriteCoordinatorRawJSON(timestamp time.Time, rawJSON []byte) e
 		data = append(data, '\n')
 	}
 
-	return s.coordRaw.Write(data)
+	return s.coordRaw.Write(s.redactor.RedactBytes(data))
 }
 
 // WriteWorkerRawJSON appends raw JSON to workers/{workerID}/raw.jsonl.
@@ -579,7 +638,7 @@ func (s *Session) WriteWorkerRawJSON(workerID string, timestamp time.Time, rawJS
 		data = append(data, '\n')
 	}
 
-	return writer.Write(data)
+	return writer.Write(s.redactor.RedactBytes(data))
 }
 
 // WriteMessage appends a message entry to messages.jsonl in JSONL format.
@@ -598,7 +657,7 @@ func (s *Session) WriteMessage(entry message.Entry) error {
 
 	// Append newline for JSONL format
 	data = append(data, '\n')
-	return s.messageLog.Write(data)
+	return s.messageLog.Write(s.redactor.RedactBytes(data))
 }
 
 // WriteMCPEvent appends an MCP event to mcp_requests.jsonl in JSONL format.
@@ -617,14 +676,15 @@ func (s *Session) WriteMCPEvent(event events.MCPEvent) error {
 
 	// Append newline for JSONL format
 	data = append(data, '\n')
-	return s.mcpLog.Write(data)
+	return s.mcpLog.Write(s.redactor.RedactBytes(data))
 }
 
 // CommandEvent is an alias for processor.CommandEvent to avoid import cycles in tests.
 // The canonical definition is in internal/orchestration/v2/processor/middleware.go.
 type CommandEvent = processor.CommandEvent
 
-// WriteCommandEvent appends a V2 command event to commands.jsonl in JSONL format.
+// WriteCommandEvent appends a V2 command event to commands.jsonl in JSONL format
+// and records it on the session timeline.
 // This method implements processor.CommandWriter interface.
 func (s *Session) WriteCommandEvent(event processor.CommandEvent) error {
 	s.mu.Lock()
@@ -641,7 +701,10 @@ func (s *Session) WriteCommandEvent(event processor.CommandEvent) error {
 
 	// Append newline for JSONL format
 	data = append(data, '\n')
-	return s.commandLog.Write(data)
+	if err := s.commandLog.Write(s.redactor.RedactBytes(data)); err != nil {
+		return err
+	}
+	return s.writeTimelineEntry(newCommandTimelineEntry(event))
 }
 
 // Close finalizes the session, flushes all BufferedWriters, updates metadata, and closes file handles.
@@ -687,7 +750,7 @@ func (s *Session) Close(status Status) error {
 		}
 	}
 
-	// Close message, MCP, and command logs
+	// Close message, MCP, command, and timeline logs
 	if err := s.messageLog.Close(); err != nil && firstErr == nil {
 		firstErr = err
 	}
@@ -697,6 +760,9 @@ func (s *Session) Close(status Status) error {
 	if err := s.commandLog.Close(); err != nil && firstErr == nil {
 		firstErr = err
 	}
+	if err := s.timelineLog.Close(); err != nil && firstErr == nil {
+		firstErr = err
+	}
 
 	// Update metadata with end time, final status, workers, and token usage
 	meta, err := Load(s.Dir)
@@ -721,6 +787,14 @@ func (s *Session) Close(status Status) error {
 	meta.CoordinatorSessionRef = s.coordinatorSessionRef
 	meta.CoordinatorTokenUsage = s.coordinatorTokenUsage
 
+	// Add this run's redactions to any recorded before the session was reopened
+	for rule, n := range s.redactor.Report() {
+		if meta.Redactions == nil {
+			meta.Redactions = redact.Report{}
+		}
+		meta.Redactions[rule] += n
+	}
+
 	// Update observer metadata if observer was spawned
 	if s.observerSessionRef != "" || !s.observerSpawnedAt.IsZero() || s.observerTokenUsage != (TokenUsageSummary{}) {
 		meta.Observer = &ObserverMetadata{
@@ -741,6 +815,11 @@ func (s *Session) Close(status Status) error {
 		firstErr = err
 	}
 
+	// Refresh the cost report with the final numbers
+	if err := s.costReportLocked(meta.WorkflowCompletionStatus, meta.WorkflowTasksClosed, meta.EndTime).Write(s.Dir); err != nil && firstErr == nil {
+		firstErr = err
+	}
+
 	// Update sessions.json index
 	if err := s.updateSessionIndex(meta); err != nil && firstErr == nil {
 		firstErr = err
@@ -839,6 +918,11 @@ func (s *Session) generateSummary(meta *Metadata) error {
 		content += "\n"
 	}
 
+	if total := meta.Redactions.Total(); total > 0 {
+		content += "## Redactions\n\n"
+		content += fmt.Sprintf("%d secrets masked in transcripts and logs (%s)\n\n", total, meta.Redactions)
+	}
+
 	return os.WriteFile(summaryPath, []byte(content), 0600)
 }
 
@@ -853,11 +937,15 @@ func (s *Session) generateSummary(meta *Metadata) error {
 //
 // Uses atomic rename to avoid race conditions on both files.
 func (s *Session) updateSessionIndex(meta *Metadata) error {
-	// Build accountability summary path relative to session dir if it exists
+	// Point at the aggregated session report if one was generated, falling back
+	// to the accountability summary of sessions from before native aggregation
 	var accountabilitySummaryPath string
-	summaryPath := filepath.Join(s.Dir, accountabilitySummaryFile)
-	if _, statErr := os.Stat(summaryPath); statErr == nil {
-		accountabilitySummaryPath = summaryPath
+	for _, name := range []string{accountability.ReportMarkdownFile, accountabilitySummaryFile} {
+		summaryPath := filepath.Join(s.Dir, name)
+		if _, statErr := os.Stat(summaryPath); statErr == nil {
+			accountabilitySummaryPath = summaryPath
+			break
+		}
 	}
 
 	// Create entry for this session with all metadata fields
@@ -1202,7 +1290,7 @@ func (s *Session) handleProcessEvent(event events.ProcessEvent) {
 	switch event.Type {
 	case events.ProcessSpawned:
 		// Add worker to metadata - use session's workDir (same for all processes currently)
-		s.addWorker(workerID, now, s.workDir)
+		s.addWorker(workerID, now, s.workDir, event.Backend)
 		// Log the spawn event as a system message
 		msg := chatrender.Message{
 			Role:      "system",
@@ -1238,7 +1326,7 @@ func (s *Session) handleProcessEvent(event events.ProcessEvent) {
 		if event.Phase != nil {
 			phaseStr = string(*event.Phase)
 		}
-		s.updateProcessPhase(workerID, phaseStr)
+		s.updateProcessPhase(workerID, phaseStr, now)
 		// If worker is retired, record retirement time
 		if event.Status == events.ProcessStatusRetired {
 			s.retireWorker(workerID, now, phaseStr)
@@ -1430,8 +1518,8 @@ func (s *Session) recomputeTokenUsageLocked() {
 }
 
 // addWorker adds a new worker to the session's metadata.
-// workDir is captured at spawn time; sessionRef is set later via SetWorkerSessionRef.
-func (s *Session) addWorker(workerID string, spawnedAt time.Time, workDir string) {
+// workDir and backend are captured at spawn time; sessionRef is set later via SetWorkerSessionRef.
+func (s *Session) addWorker(workerID string, spawnedAt time.Time, workDir, backend string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -1446,14 +1534,17 @@ func (s *Session) addWorker(workerID string, spawnedAt time.Time, workDir string
 		ID:        workerID,
 		SpawnedAt: spawnedAt,
 		WorkDir:   workDir,
+		Backend:   backend,
 	})
 }
 
-// updateProcessPhase updates a worker's current phase in the metadata.
-func (s *Session) updateProcessPhase(workerID, phase string) {
+// updateProcessPhase updates a worker's current phase in the metadata
+// and accounts the time spent in the previous phase.
+func (s *Session) updateProcessPhase(workerID, phase string, at time.Time) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	s.enterPhaseLocked(workerID, phase, at)
 	for i := range s.workers {
 		if s.workers[i].ID == workerID {
 			s.workers[i].FinalPhase = phase
@@ -1467,6 +1558,8 @@ func (s *Session) retireWorker(workerID string, retiredAt time.Time, finalPhase
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	s.enterPhaseLocked(workerID, "", retiredAt)
+
 	for i := range s.workers {
 		if s.workers[i].ID == workerID {
 			s.workers[i].RetiredAt = retiredAt
@@ -1476,11 +1569,15 @@ func (s *Session) retireWorker(workerID string, retiredAt time.Time, finalPhase
 	}
 }
 
-// WriteWorkerAccountabilitySummary writes a worker's accountability summary to their session directory.
-// Creates the worker directory if it doesn't exist (follows getOrCreateWorkerLog pattern).
+// WriteWorkerAccountabilitySummary writes a worker's accountability summary for a task
+// to workers/{workerID}/tasks/{taskID}.md in the session directory.
+// Creates the directory if it doesn't exist (follows getOrCreateWorkerLog pattern).
 // Returns the full path where the summary was saved.
-// Note: taskID is embedded in the YAML frontmatter of the content, not passed as parameter.
-func (s *Session) WriteWorkerAccountabilitySummary(workerID string, content []byte) (string, error) {
+func (s *Session) WriteWorkerAccountabilitySummary(workerID, taskID string, content []byte) (string, error) {
+	if taskID == "" || filepath.Base(taskID) != taskID || strings.HasPrefix(taskID, ".") {
+		return "", fmt.Errorf("invalid task ID %q", taskID)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -1488,23 +1585,69 @@ func (s *Session) WriteWorkerAccountabilitySummary(workerID string, content []by
 		return "", os.ErrClosed
 	}
 
-	// Ensure worker directory exists (lazy creation)
-	workerPath := filepath.Join(s.Dir, workersDir, workerID)
-	if err := os.MkdirAll(workerPath, 0750); err != nil {
+	// Ensure the worker's task directory exists (lazy creation)
+	tasksPath := filepath.Join(s.Dir, workersDir, workerID, workerTasksDir)
+	if err := os.MkdirAll(tasksPath, 0750); err != nil {
 		return "", fmt.Errorf("creating worker directory: %w", err)
 	}
 
-	// Write accountability summary file (overwrites if exists - latest summary wins)
-	summaryPath := filepath.Join(workerPath, accountabilitySummaryFile)
-	if err := os.WriteFile(summaryPath, content, 0600); err != nil {
+	// Write accountability summary file (a repeated summary for the same task replaces it)
+	summaryPath := filepath.Join(tasksPath, taskID+".md")
+	if err := os.WriteFile(summaryPath, s.redactor.RedactBytes(content), 0600); err != nil {
 		return "", fmt.Errorf("writing accountability summary file: %w", err)
 	}
 
-	log.Debug(log.CatOrch, "Wrote worker accountability summary", "workerID", workerID, "path", summaryPath)
+	log.Debug(log.CatOrch, "Wrote worker accountability summary", "workerID", workerID, "taskID", taskID, "path", summaryPath)
 
 	return summaryPath, nil
 }
 
+// WriteDecision saves a decision record to the session's decisions directory as
+// {NNN}-{slug}.md, numbered after the highest existing record.
+// Returns the file path where the record was saved.
+func (s *Session) WriteDecision(slug string, content []byte) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return "", os.ErrClosed
+	}
+
+	// Ensure decisions directory exists (lazy creation)
+	decisionsPath := filepath.Join(s.Dir, accountability.DecisionsDir)
+	if err := os.MkdirAll(decisionsPath, 0750); err != nil {
+		return "", fmt.Errorf("creating decisions directory: %w", err)
+	}
+	entries, err := os.ReadDir(decisionsPath)
+	if err != nil {
+		return "", fmt.Errorf("reading decisions directory: %w", err)
+	}
+	next := 1
+	for _, entry := range entries {
+		if n, ok := accountability.DecisionNumber(entry.Name()); ok && n >= next {
+			next = n + 1
+		}
+	}
+
+	// Never overwrite a record, even one written by another process
+	decisionPath := filepath.Join(decisionsPath, fmt.Sprintf("%03d-%s.md", next, slug))
+	f, err := os.OpenFile(decisionPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600) //nolint:gosec // G304: path is under the session directory
+	if err != nil {
+		return "", fmt.Errorf("creating decision file: %w", err)
+	}
+	if _, err := f.Write(s.redactor.RedactBytes(content)); err != nil {
+		_ = f.Close()
+		return "", fmt.Errorf("writing decision file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("writing decision file: %w", err)
+	}
+
+	log.Debug(log.CatOrch, "Wrote decision record", "path", decisionPath)
+
+	return decisionPath, nil
+}
+
 // SetCoordinatorSessionRef sets the coordinator's headless session reference.
 // This should be called after the coordinator's first successful turn.
 // Immediately persists metadata to ensure crash resilience.
@@ -1561,6 +1704,12 @@ func (s *Session) SetWorkerSessionRef(workerID, ref, workDir string) error {
 	return fmt.Errorf("worker not found: %s", workerID)
 }
 
+// Redactor returns the redactor that masks secrets in the session's logs (nil when redaction is off).
+// Other logs kept in the session directory use it so their secrets are counted in the same report.
+func (s *Session) Redactor() *redact.Redactor {
+	return s.redactor
+}
+
 // MarkResumable marks the session as resumable.
 // Called after coordinator session ref is captured.
 func (s *Session) MarkResumable() error {
@@ -263,8 +263,8 @@ func TestMultiProcessCostAggregation(t *testing.T) {
 
 	// Add workers to the session first (workers must exist before their token usage can be tracked)
 	now := time.Now()
-	session.addWorker("worker-1", now, "/project")
-	session.addWorker("worker-2", now, "/project")
+	session.addWorker("worker-1", now, "/project", "")
+	session.addWorker("worker-2", now, "/project", "")
 
 	// Define costs for each process
 	coordinatorCost := 0.05
@@ -16,6 +16,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/message"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/redact"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
 	"github.com/zjrosen/perles/internal/pubsub"
@@ -1879,6 +1880,76 @@ func TestSession_Close_GeneratesSummary(t *testing.T) {
 	require.Contains(t, content, "**Duration:**")
 }
 
+func TestSession_Redaction(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+
+	session, err := New("test-redaction", sessionDir, WithRedactor(redact.New(redact.DefaultPolicy())))
+	require.NoError(t, err)
+
+	secret := "sk-ant-REDACTED"
+	require.NoError(t, session.WriteCoordinatorMessage(chatrender.Message{Role: "assistant", Content: "key is " + secret}))
+	require.NoError(t, session.WriteWorkerRawJSON("worker-1", time.Now(), []byte(`{"text":"mail ops@example.com"}`)))
+	require.NoError(t, session.Close(StatusCompleted))
+
+	coordMsgs, err := os.ReadFile(filepath.Join(sessionDir, "coordinator", "messages.jsonl"))
+	require.NoError(t, err)
+	require.NotContains(t, string(coordMsgs), secret)
+	require.Contains(t, string(coordMsgs), "[REDACTED:api_key]")
+
+	var msg chatrender.Message
+	require.NoError(t, json.Unmarshal(coordMsgs, &msg), "redacted line stays valid JSON")
+	require.Equal(t, "key is [REDACTED:api_key]", msg.Content)
+
+	workerRaw, err := os.ReadFile(filepath.Join(sessionDir, "workers", "worker-1", "raw.jsonl"))
+	require.NoError(t, err)
+	require.Equal(t, `{"text":"mail [REDACTED:email]"}`+"\n", string(workerRaw))
+
+	meta, err := Load(sessionDir)
+	require.NoError(t, err)
+	require.Equal(t, redact.Report{"api_key": 1, "email": 1}, meta.Redactions)
+
+	summary, err := os.ReadFile(filepath.Join(sessionDir, "summary.md"))
+	require.NoError(t, err)
+	require.Contains(t, string(summary), "## Redactions")
+	require.Contains(t, string(summary), "2 secrets masked")
+}
+
+func TestSession_Redaction_AccumulatesAcrossReopen(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	policy := redact.DefaultPolicy()
+
+	session, err := New("test-redaction-reopen", sessionDir, WithRedactor(redact.New(policy)))
+	require.NoError(t, err)
+	require.NoError(t, session.WriteMessage(message.Entry{Content: "ping a@b.io"}))
+	require.NoError(t, session.Close(StatusCompleted))
+
+	session, err = Reopen("test-redaction-reopen", sessionDir, WithRedactor(redact.New(policy)))
+	require.NoError(t, err)
+	require.NoError(t, session.WriteMessage(message.Entry{Content: "ping c@d.io"}))
+	require.NoError(t, session.Close(StatusCompleted))
+
+	meta, err := Load(sessionDir)
+	require.NoError(t, err)
+	require.Equal(t, redact.Report{"email": 2}, meta.Redactions)
+}
+
+func TestSession_NoRedactorWritesVerbatim(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+
+	session, err := New("test-no-redaction", sessionDir)
+	require.NoError(t, err)
+	require.NoError(t, session.WriteCoordinatorRawJSON(time.Now(), []byte(`{"text":"mail ops@example.com"}`)))
+	require.NoError(t, session.Close(StatusCompleted))
+
+	raw, err := os.ReadFile(filepath.Join(sessionDir, "coordinator", "raw.jsonl"))
+	require.NoError(t, err)
+	require.Contains(t, string(raw), "ops@example.com")
+
+	meta, err := Load(sessionDir)
+	require.NoError(t, err)
+	require.Empty(t, meta.Redactions)
+}
+
 // Tests for AttachToBrokers
 
 func TestSession_AttachToBrokers(t *testing.T) {
@@ -2325,8 +2396,8 @@ func TestSession_TokenUsageAggregation(t *testing.T) {
 
 	// Add workers to session first (required for per-worker token tracking)
 	now := time.Now()
-	session.addWorker("worker-1", now, "/project")
-	session.addWorker("worker-2", now, "/project")
+	session.addWorker("worker-1", now, "/project", "")
+	session.addWorker("worker-2", now, "/project", "")
 
 	// Publish multiple token usage events from coordinator and workers
 	v2EventBus.Publish(pubsub.UpdatedEvent, events.NewProcessEvent(events.ProcessTokenUsage, "coordinator", events.RoleCoordinator).
@@ -2724,12 +2795,12 @@ func TestWriteWorkerAccountabilitySummary_Success(t *testing.T) {
 
 	// Write an accountability summary (taskID is now in YAML frontmatter)
 	content := []byte("---\ntask_id: perles-abc.1\nworker_id: worker-1\n---\n\n# Worker Accountability Summary\n\n**Worker:** worker-1\n**Task:** perles-abc.1\n\n## Summary\n\nImplemented user validation with regex patterns.\n")
-	filePath, err := session.WriteWorkerAccountabilitySummary("worker-1", content)
+	filePath, err := session.WriteWorkerAccountabilitySummary("worker-1", "perles-abc.1", content)
 	require.NoError(t, err)
 	require.NotEmpty(t, filePath)
 
 	// Verify file path
-	expectedPath := filepath.Join(sessionDir, "workers", "worker-1", "accountability_summary.md")
+	expectedPath := filepath.Join(sessionDir, "workers", "worker-1", "tasks", "perles-abc.1.md")
 	require.Equal(t, expectedPath, filePath)
 
 	// Verify file exists and has correct content
@@ -2756,7 +2827,7 @@ func TestWriteWorkerAccountabilitySummary_CreatesWorkerDirectory(t *testing.T) {
 
 	// Write accountability summary - should create directory
 	content := []byte("---\ntask_id: task-123\n---\n\n# Accountability Summary")
-	filePath, err := session.WriteWorkerAccountabilitySummary("worker-new", content)
+	filePath, err := session.WriteWorkerAccountabilitySummary("worker-new", "task-123", content)
 	require.NoError(t, err)
 	require.NotEmpty(t, filePath)
 
@@ -2765,8 +2836,8 @@ func TestWriteWorkerAccountabilitySummary_CreatesWorkerDirectory(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, info.IsDir())
 
-	// accountability_summary.md should exist
-	summaryPath := filepath.Join(workerPath, "accountability_summary.md")
+	// tasks/task-123.md should exist
+	summaryPath := filepath.Join(workerPath, "tasks", "task-123.md")
 	_, err = os.Stat(summaryPath)
 	require.NoError(t, err)
 
@@ -2788,12 +2859,38 @@ func TestWriteWorkerAccountabilitySummary_SessionClosed(t *testing.T) {
 
 	// Writing after close should fail
 	content := []byte("---\ntask_id: task-123\n---\n\n# Accountability Summary")
-	_, err = session.WriteWorkerAccountabilitySummary("worker-1", content)
+	_, err = session.WriteWorkerAccountabilitySummary("worker-1", "task-123", content)
 	require.Error(t, err)
 	require.Equal(t, os.ErrClosed, err)
 }
 
-func TestWriteWorkerAccountabilitySummary_OverwritesExisting(t *testing.T) {
+func TestWriteDecision_NumbersRecordsInOrder(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	session, err := New("test-decisions", sessionDir)
+	require.NoError(t, err)
+
+	first, err := session.WriteDecision("use-sqlite", []byte("first"))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(sessionDir, "decisions", "001-use-sqlite.md"), first)
+
+	// A record added outside this session (e.g. by hand) is never overwritten
+	require.NoError(t, os.WriteFile(filepath.Join(sessionDir, "decisions", "007-manual.md"), []byte("manual"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(sessionDir, "decisions", "README.md"), []byte("notes"), 0600))
+
+	second, err := session.WriteDecision("use-sqlite", []byte("second"))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(sessionDir, "decisions", "008-use-sqlite.md"), second)
+
+	data, err := os.ReadFile(first)
+	require.NoError(t, err)
+	require.Equal(t, "first", string(data))
+
+	require.NoError(t, session.Close(StatusCompleted))
+	_, err = session.WriteDecision("late", []byte("late"))
+	require.Equal(t, os.ErrClosed, err)
+}
+
+func TestWriteWorkerAccountabilitySummary_KeepsEachTask(t *testing.T) {
 	baseDir := t.TempDir()
 	sessionID := "test-accountability-overwrite"
 	sessionDir := filepath.Join(baseDir, "session")
@@ -2803,7 +2900,7 @@ func TestWriteWorkerAccountabilitySummary_OverwritesExisting(t *testing.T) {
 
 	// Write first accountability summary
 	content1 := []byte("---\ntask_id: task-1\n---\n\n# First Summary\n\nOriginal content")
-	filePath1, err := session.WriteWorkerAccountabilitySummary("worker-1", content1)
+	filePath1, err := session.WriteWorkerAccountabilitySummary("worker-1", "task-1", content1)
 	require.NoError(t, err)
 
 	// Verify first content
@@ -2811,19 +2908,31 @@ func TestWriteWorkerAccountabilitySummary_OverwritesExisting(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, content1, data1)
 
-	// Write second accountability summary (different task) - should overwrite
+	// Write second accountability summary (different task) - should not overwrite the first
 	content2 := []byte("---\ntask_id: task-2\n---\n\n# Second Summary\n\nUpdated content for different task")
-	filePath2, err := session.WriteWorkerAccountabilitySummary("worker-1", content2)
+	filePath2, err := session.WriteWorkerAccountabilitySummary("worker-1", "task-2", content2)
 	require.NoError(t, err)
+	require.NotEqual(t, filePath1, filePath2)
 
-	// Paths should be the same (same worker, single accountability_summary.md file)
-	require.Equal(t, filePath1, filePath2)
-
-	// Verify content was overwritten
+	data1, err = os.ReadFile(filePath1)
+	require.NoError(t, err)
+	require.Equal(t, content1, data1)
 	data2, err := os.ReadFile(filePath2)
 	require.NoError(t, err)
 	require.Equal(t, content2, data2)
 
+	// A repeated summary for the same task replaces it
+	content3 := []byte("---\ntask_id: task-1\n---\n\n# Revised Summary")
+	filePath3, err := session.WriteWorkerAccountabilitySummary("worker-1", "task-1", content3)
+	require.NoError(t, err)
+	require.Equal(t, filePath1, filePath3)
+	data3, err := os.ReadFile(filePath3)
+	require.NoError(t, err)
+	require.Equal(t, content3, data3)
+
+	_, err = session.WriteWorkerAccountabilitySummary("worker-1", "../escape", content3)
+	require.Error(t, err)
+
 	err = session.Close(StatusCompleted)
 	require.NoError(t, err)
 }
@@ -2843,7 +2952,7 @@ func TestWriteWorkerAccountabilitySummary_FilePermissions(t *testing.T) {
 
 	// Write an accountability summary
 	content := []byte("---\ntask_id: task-123\n---\n\n# Accountability Summary\n\nTest content for permission check")
-	filePath, err := session.WriteWorkerAccountabilitySummary("worker-1", content)
+	filePath, err := session.WriteWorkerAccountabilitySummary("worker-1", "task-123", content)
 	require.NoError(t, err)
 
 	// Verify file permissions are 0600 (owner read/write only)
@@ -2866,7 +2975,7 @@ func TestWriteWorkerAccountabilitySummary_EmptyContent(t *testing.T) {
 
 	// Write empty content
 	content := []byte{}
-	filePath, err := session.WriteWorkerAccountabilitySummary("worker-1", content)
+	filePath, err := session.WriteWorkerAccountabilitySummary("worker-1", "task-123", content)
 	require.NoError(t, err)
 	require.NotEmpty(t, filePath)
 
@@ -2880,7 +2989,7 @@ func TestWriteWorkerAccountabilitySummary_EmptyContent(t *testing.T) {
 }
 
 func TestWriteWorkerAccountabilitySummary_MultipleWorkers(t *testing.T) {
-	// Verify each worker gets their own accountability_summary.md file with no cross-contamination
+	// Verify each worker gets their own summary file with no cross-contamination
 	baseDir := t.TempDir()
 	sessionID := "test-accountability-multiple"
 	sessionDir := filepath.Join(baseDir, "session")
@@ -2893,13 +3002,13 @@ func TestWriteWorkerAccountabilitySummary_MultipleWorkers(t *testing.T) {
 	content2 := []byte("---\ntask_id: task-2\n---\n\n# Summary Worker 2\n\nWorker-2 specific content")
 	content3 := []byte("---\ntask_id: task-3\n---\n\n# Summary Worker 3\n\nWorker-3 specific content")
 
-	path1, err := session.WriteWorkerAccountabilitySummary("worker-1", content1)
+	path1, err := session.WriteWorkerAccountabilitySummary("worker-1", "task-1", content1)
 	require.NoError(t, err)
 
-	path2, err := session.WriteWorkerAccountabilitySummary("worker-2", content2)
+	path2, err := session.WriteWorkerAccountabilitySummary("worker-2", "task-2", content2)
 	require.NoError(t, err)
 
-	path3, err := session.WriteWorkerAccountabilitySummary("worker-3", content3)
+	path3, err := session.WriteWorkerAccountabilitySummary("worker-3", "task-3", content3)
 	require.NoError(t, err)
 
 	// Verify paths are different
@@ -2958,6 +3067,25 @@ func TestUpdateSessionIndex_NewIndex(t *testing.T) {
 	require.False(t, index.Sessions[0].EndTime.IsZero())
 }
 
+func TestUpdateSessionIndex_LinksSessionReport(t *testing.T) {
+	baseDir := t.TempDir()
+	sessionsDir := filepath.Join(baseDir, "sessions")
+	require.NoError(t, os.MkdirAll(sessionsDir, 0750))
+
+	sessionDir := filepath.Join(sessionsDir, "test-report")
+	session, err := New("test-report", sessionDir)
+	require.NoError(t, err)
+
+	reportPath := filepath.Join(sessionDir, "session_report.md")
+	require.NoError(t, os.WriteFile(reportPath, []byte("# Session Report\n"), 0600))
+	require.NoError(t, session.Close(StatusCompleted))
+
+	index, err := LoadSessionIndex(filepath.Join(sessionsDir, "sessions.json"))
+	require.NoError(t, err)
+	require.Len(t, index.Sessions, 1)
+	require.Equal(t, reportPath, index.Sessions[0].AccountabilitySummaryPath)
+}
+
 func TestUpdateSessionIndex_AppendToExisting(t *testing.T) {
 	// When sessions.json already has entries, updateSessionIndex should append
 	baseDir := t.TempDir()
@@ -3012,7 +3140,7 @@ func TestClose_UpdatesSessionIndex(t *testing.T) {
 
 	// Write an accountability summary so the path gets included
 	content := []byte("---\ntask_id: task-123\n---\n\n# Test Summary")
-	_, err = session.WriteWorkerAccountabilitySummary("worker-1", content)
+	_, err = session.WriteWorkerAccountabilitySummary("worker-1", "task-123", content)
 	require.NoError(t, err)
 
 	// Also create the session-level accountability summary
@@ -3436,7 +3564,7 @@ func TestClose_IndexEntryContainsAllMetadataFields(t *testing.T) {
 	require.NoError(t, err)
 
 	// Add a worker to verify worker count
-	sess.addWorker("worker-1", time.Now(), workDir)
+	sess.addWorker("worker-1", time.Now(), workDir, "")
 
 	err = sess.Close(StatusFailed)
 	require.NoError(t, err)
@@ -3947,8 +4075,8 @@ func TestSession_SetWorkerSessionRef_UpdatesCorrectWorker(t *testing.T) {
 	t.Cleanup(func() { _ = sess.Close(StatusCompleted) })
 
 	// Add workers
-	sess.addWorker("worker-1", time.Now(), workDir)
-	sess.addWorker("worker-2", time.Now(), workDir)
+	sess.addWorker("worker-1", time.Now(), workDir, "")
+	sess.addWorker("worker-2", time.Now(), workDir, "")
 
 	// Set session ref for worker-2
 	err = sess.SetWorkerSessionRef("worker-2", "worker-2-session-abc", "/project/worktree-2")
@@ -4008,7 +4136,7 @@ func TestSession_SetWorkerSessionRef_ReturnsErrClosedWhenClosed(t *testing.T) {
 	require.NoError(t, err)
 
 	// Add a worker before closing
-	sess.addWorker("worker-1", time.Now(), "/path")
+	sess.addWorker("worker-1", time.Now(), "/path", "")
 
 	// Close the session
 	err = sess.Close(StatusCompleted)
@@ -4091,7 +4219,7 @@ func TestSession_NotifySessionRef_RoutesWorkerCorrectly(t *testing.T) {
 	t.Cleanup(func() { _ = sess.Close(StatusCompleted) })
 
 	// First add the worker
-	sess.addWorker("worker-1", time.Now(), workDir)
+	sess.addWorker("worker-1", time.Now(), workDir, "")
 
 	// Notify worker session ref
 	err = sess.NotifySessionRef("worker-1", "worker-session-456", "/project/worktree-1")
@@ -4155,7 +4283,7 @@ func TestSession_AddWorkerWithWorkDir(t *testing.T) {
 
 	// Add worker with workDir
 	spawnTime := time.Now().Truncate(time.Second)
-	sess.addWorker("worker-test", spawnTime, workDir)
+	sess.addWorker("worker-test", spawnTime, workDir, "")
 
 	// Close to persist metadata
 	err = sess.Close(StatusCompleted)
@@ -4442,7 +4570,7 @@ func TestReopen_ThenWriteMessages(t *testing.T) {
 
 	// Add workers and messages
 	now := time.Now()
-	origSess.addWorker("worker-1", now, "/project")
+	origSess.addWorker("worker-1", now, "/project", "")
 
 	msg1 := chatrender.Message{Role: "user", Content: "User question", Timestamp: now}
 	err = origSess.WriteCoordinatorMessage(msg1)
@@ -4517,8 +4645,8 @@ func TestReopen_RestoresWorkersFromMetadata(t *testing.T) {
 	require.NoError(t, err)
 
 	now := time.Now().Truncate(time.Second)
-	origSess.addWorker("worker-1", now, "/project")
-	origSess.addWorker("worker-2", now.Add(time.Minute), "/project")
+	origSess.addWorker("worker-1", now, "/project", "")
+	origSess.addWorker("worker-2", now.Add(time.Minute), "/project", "")
 
 	// Set session refs
 	err = origSess.SetWorkerSessionRef("worker-1", "session-ref-1", "/project")
@@ -4561,7 +4689,7 @@ func TestReopen_CanAddNewWorkersAfterReopen(t *testing.T) {
 	require.NoError(t, err)
 
 	now := time.Now().Truncate(time.Second)
-	origSess.addWorker("worker-1", now, "/project")
+	origSess.addWorker("worker-1", now, "/project", "")
 
 	err = origSess.Close(StatusCompleted)
 	require.NoError(t, err)
@@ -4571,7 +4699,7 @@ func TestReopen_CanAddNewWorkersAfterReopen(t *testing.T) {
 	require.NoError(t, err)
 
 	// Add new worker
-	sess.addWorker("worker-2", now.Add(time.Hour), "/project")
+	sess.addWorker("worker-2", now.Add(time.Hour), "/project", "")
 
 	err = sess.Close(StatusCompleted)
 	require.NoError(t, err)
@@ -4781,7 +4909,7 @@ func TestSession_UpdateWorkflowCompletion_PreservesOtherMetadata(t *testing.T) {
 	t.Cleanup(func() { _ = sess.Close(StatusCompleted) })
 
 	// Add a worker
-	sess.addWorker("worker-1", time.Now(), workDir)
+	sess.addWorker("worker-1", time.Now(), workDir, "")
 
 	// Set coordinator session ref
 	err = sess.SetCoordinatorSessionRef("coord-session-abc")
@@ -5130,7 +5258,7 @@ func TestSessionResume_PriorMetadataPreserved(t *testing.T) {
 	// Parameters: processID, contextTokens, outputTokens, costUSD
 	origSess.updateTokenUsage("coordinator", 20000, 800, 2.50)
 	now := time.Now().Truncate(time.Second)
-	origSess.addWorker("worker-1", now, "/project")
+	origSess.addWorker("worker-1", now, "/project", "")
 
 	err = origSess.Close(StatusCompleted)
 	require.NoError(t, err)
@@ -5185,7 +5313,7 @@ func TestUpdateTokenUsage_CostOnlyEventDoesNotResetContext(t *testing.T) {
 	defer func() { _ = sess.Close(StatusCompleted) }()
 
 	// Add a worker
-	sess.addWorker("worker-1", time.Now(), "/project")
+	sess.addWorker("worker-1", time.Now(), "/project", "")
 
 	// First event: normal token usage with context
 	sess.updateTokenUsage("coordinator", 50000, 100, 0.50)
@@ -0,0 +1,305 @@
+package session
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+)
+
+// TimelineKind identifies the source of a timeline entry.
+type TimelineKind string
+
+const (
+	// TimelineCommand is a v2 command processed by the command processor.
+	TimelineCommand TimelineKind = "command"
+	// TimelineFabric is a fabric event (message, reply, subscription, ...).
+	TimelineFabric TimelineKind = "fabric"
+)
+
+// timelineSummaryWidth caps the message excerpt shown by FormatTimeline.
+const timelineSummaryWidth = 80
+
+// TimelineEntry is a single line of timeline.jsonl.
+// The timeline interleaves every processed command and fabric event of a session
+// in the order they happened, so a run can be analyzed after the fact without
+// correlating commands.jsonl and fabric.jsonl by hand.
+type TimelineEntry struct {
+	Timestamp time.Time    `json:"timestamp"`
+	Kind      TimelineKind `json:"kind"`
+	Type      string       `json:"type"` // Command type or fabric event type
+
+	// Actor is the command source or the agent behind the fabric event.
+	Actor string `json:"actor,omitempty"`
+
+	// Command fields.
+	CommandID  string `json:"command_id,omitempty"`
+	Failed     bool   `json:"failed,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms,omitempty"`
+
+	// Fabric fields.
+	Channel  string `json:"channel,omitempty"`
+	ThreadID string `json:"thread_id,omitempty"`
+	Content  string `json:"content,omitempty"`
+
+	// Payload is the command payload. Empty for fabric events.
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// timelinePayload holds the command payload fields shown by Summary.
+// Commands are serialized without JSON tags, so the keys are the Go field names.
+type timelinePayload struct {
+	WorkerID      string
+	ProcessID     string
+	ImplementerID string
+	ReviewerID    string
+	TaskID        string
+	Verdict       string
+	Phase         string
+	NewPhase      string
+}
+
+// payload decodes the entry's command payload. Unparseable payloads yield the zero value.
+func (e TimelineEntry) payload() timelinePayload {
+	var p timelinePayload
+	if len(e.Payload) > 0 {
+		_ = json.Unmarshal(e.Payload, &p)
+	}
+	return p
+}
+
+// worker returns the worker the command targets.
+func (p timelinePayload) worker() string {
+	for _, id := range []string{p.WorkerID, p.ProcessID, p.ImplementerID} {
+		if id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// newCommandTimelineEntry converts a command event into a timeline entry.
+func newCommandTimelineEntry(event CommandEvent) TimelineEntry {
+	return TimelineEntry{
+		Timestamp:  event.Timestamp,
+		Kind:       TimelineCommand,
+		Type:       event.CommandType,
+		Actor:      event.Source,
+		CommandID:  event.CommandID,
+		Failed:     !event.Success,
+		Error:      event.Error,
+		DurationMs: event.DurationMs,
+		Payload:    event.Payload,
+	}
+}
+
+// newFabricTimelineEntry converts a fabric event into a timeline entry.
+func newFabricTimelineEntry(event fabric.Event) TimelineEntry {
+	entry := TimelineEntry{
+		Timestamp: event.Timestamp,
+		Kind:      TimelineFabric,
+		Type:      string(event.Type),
+		Actor:     event.AgentID,
+		Channel:   event.ChannelSlug,
+	}
+	switch {
+	case event.Thread != nil:
+		entry.ThreadID = event.Thread.ID
+		entry.Content = event.Thread.Content
+		if entry.Actor == "" {
+			entry.Actor = event.Thread.CreatedBy
+		}
+	case event.Reaction != nil:
+		entry.ThreadID = event.Reaction.ThreadID
+		entry.Content = event.Reaction.Emoji
+	}
+	return entry
+}
+
+// HandleFabricEvent appends a fabric event to timeline.jsonl.
+// It has the fabric event handler signature so it can be chained next to the
+// fabric.jsonl logger. Write errors are logged, never returned to the fabric service.
+func (s *Session) HandleFabricEvent(event fabric.Event) {
+	if err := s.WriteTimelineEntry(newFabricTimelineEntry(event)); err != nil && err != os.ErrClosed {
+		log.Warn(log.CatOrch, "Failed to write timeline entry", "subsystem", "session",
+			"sessionID", s.ID, "type", event.Type, "error", err)
+	}
+}
+
+// WriteTimelineEntry appends an entry to timeline.jsonl in JSONL format.
+func (s *Session) WriteTimelineEntry(entry TimelineEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return os.ErrClosed
+	}
+	return s.writeTimelineEntry(entry)
+}
+
+// writeTimelineEntry writes an entry without locking. The caller must hold s.mu.
+func (s *Session) writeTimelineEntry(entry TimelineEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshaling timeline entry: %w", err)
+	}
+
+	// Append newline for JSONL format
+	data = append(data, '\n')
+	return s.timelineLog.Write(s.redactor.RedactBytes(data))
+}
+
+// LoadTimeline reads timeline.jsonl from a session directory.
+// Lines that cannot be parsed (e.g. a partial line from a crashed session) are skipped.
+func LoadTimeline(sessionDir string) ([]TimelineEntry, error) {
+	f, err := os.Open(filepath.Join(sessionDir, timelineFile)) //nolint:gosec // G304: path is constructed from trusted sessionDir parameter
+	if err != nil {
+		return nil, fmt.Errorf("opening timeline: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var entries []TimelineEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var entry TimelineEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading timeline: %w", err)
+	}
+	return entries, nil
+}
+
+// FormatTimeline writes entries as one human-readable line each:
+// the offset from the first entry, the kind, the type, the actor and a short summary.
+// Phase transitions also show how long the worker spent in its previous phase.
+func FormatTimeline(w io.Writer, entries []TimelineEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	start := entries[0].Timestamp
+	phases := timelinePhaseDurations(entries)
+	for i, e := range entries {
+		summary := e.Summary()
+		if phase, ok := phases[i]; ok {
+			summary += "; " + phase
+		}
+		line := fmt.Sprintf("%s  +%-9s  %-7s  %-22s  %-16s  %s",
+			e.Timestamp.Local().Format("15:04:05.000"),
+			formatTimelineOffset(e.Timestamp.Sub(start)),
+			e.Kind, e.Type, e.Actor, summary)
+		if _, err := fmt.Fprintln(w, strings.TrimRight(line, " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Summary returns a one-line description of the entry.
+func (e TimelineEntry) Summary() string {
+	switch e.Kind {
+	case TimelineCommand:
+		status := "ok"
+		if e.Failed {
+			status = "FAILED"
+			if e.Error != "" {
+				status += ": " + e.Error
+			}
+		}
+		status = fmt.Sprintf("%s (%dms)", status, e.DurationMs)
+
+		p := e.payload()
+		var parts []string
+		if worker := p.worker(); worker != "" {
+			parts = append(parts, "worker="+worker)
+		}
+		if p.ReviewerID != "" {
+			parts = append(parts, "reviewer="+p.ReviewerID)
+		}
+		if p.TaskID != "" {
+			parts = append(parts, "task="+p.TaskID)
+		}
+		if p.Verdict != "" {
+			parts = append(parts, "verdict="+p.Verdict)
+		}
+		if phase := cmp.Or(p.NewPhase, p.Phase); phase != "" {
+			parts = append(parts, "phase="+phase)
+		}
+		return strings.Join(append(parts, status), " ")
+	case TimelineFabric:
+		var parts []string
+		if e.Channel != "" {
+			parts = append(parts, "#"+e.Channel)
+		}
+		if e.Content != "" {
+			parts = append(parts, truncateTimelineContent(e.Content))
+		}
+		return strings.Join(parts, " ")
+	}
+	return ""
+}
+
+// timelinePhaseDurations derives how long each worker stayed in a phase from
+// consecutive successful phase transitions. The result maps the index of the
+// transition that ended a phase to a description such as "implementing for 4m2s".
+func timelinePhaseDurations(entries []TimelineEntry) map[int]string {
+	type phaseStart struct {
+		phase string
+		at    time.Time
+	}
+	current := make(map[string]phaseStart)
+	durations := make(map[int]string)
+	for i, e := range entries {
+		if e.Kind != TimelineCommand || e.Failed {
+			continue
+		}
+		p := e.payload()
+		worker := p.worker()
+		if p.NewPhase == "" || worker == "" {
+			continue
+		}
+		if prev, ok := current[worker]; ok {
+			durations[i] = fmt.Sprintf("%s for %s", prev.phase, formatTimelineOffset(e.Timestamp.Sub(prev.at)))
+		}
+		current[worker] = phaseStart{phase: p.NewPhase, at: e.Timestamp}
+	}
+	return durations
+}
+
+// formatTimelineOffset renders a duration since the start of the timeline.
+func formatTimelineOffset(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return d.Truncate(time.Millisecond).String()
+}
+
+// truncateTimelineContent flattens content to its first line, capped at timelineSummaryWidth runes.
+func truncateTimelineContent(content string) string {
+	first, _, multiline := strings.Cut(strings.TrimSpace(content), "\n")
+	runes := []rune(first)
+	if len(runes) > timelineSummaryWidth {
+		return string(runes[:timelineSummaryWidth-1]) + "…"
+	}
+	if multiline {
+		return first + " …"
+	}
+	return first
+}
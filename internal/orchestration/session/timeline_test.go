@@ -0,0 +1,155 @@
+package session
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+)
+
+func TestSession_Timeline_InterleavesCommandsAndFabricEvents(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	sess, err := New("test-timeline", sessionDir)
+	require.NoError(t, err)
+
+	start := time.Date(2025, 1, 15, 10, 30, 45, 0, time.UTC)
+	require.NoError(t, sess.WriteCommandEvent(processor.CommandEvent{
+		CommandID:   "cmd-1",
+		CommandType: "assign_task",
+		Source:      "mcp_tool",
+		Success:     true,
+		DurationMs:  12,
+		Timestamp:   start,
+		Payload:     []byte(`{"worker_id":"worker-1"}`),
+	}))
+	sess.HandleFabricEvent(fabric.NewMessagePostedEvent(&domain.Thread{
+		ID:        "msg-1",
+		Type:      domain.ThreadMessage,
+		Content:   "Starting task\nwith details",
+		CreatedBy: "worker-1",
+	}, "ch-1", "tasks"))
+	require.NoError(t, sess.WriteCommandEvent(processor.CommandEvent{
+		CommandID:   "cmd-2",
+		CommandType: "report_complete",
+		Source:      "mcp_tool",
+		Success:     false,
+		Error:       "task not found",
+		DurationMs:  3,
+		Timestamp:   start.Add(2 * time.Second),
+	}))
+	require.NoError(t, sess.Close(StatusCompleted))
+
+	// Writes after close are dropped without error
+	sess.HandleFabricEvent(fabric.NewMessagePostedEvent(&domain.Thread{ID: "msg-2"}, "ch-1", "tasks"))
+
+	entries, err := LoadTimeline(sessionDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, TimelineCommand, entries[0].Kind)
+	require.Equal(t, "assign_task", entries[0].Type)
+	require.Equal(t, "mcp_tool", entries[0].Actor)
+	require.Equal(t, "cmd-1", entries[0].CommandID)
+	require.False(t, entries[0].Failed)
+	require.JSONEq(t, `{"worker_id":"worker-1"}`, string(entries[0].Payload))
+
+	require.Equal(t, TimelineFabric, entries[1].Kind)
+	require.Equal(t, "message.posted", entries[1].Type)
+	require.Equal(t, "worker-1", entries[1].Actor)
+	require.Equal(t, "tasks", entries[1].Channel)
+	require.Equal(t, "msg-1", entries[1].ThreadID)
+	require.False(t, entries[1].Timestamp.IsZero())
+
+	require.True(t, entries[2].Failed)
+	require.Equal(t, "task not found", entries[2].Error)
+}
+
+func TestLoadTimeline_SkipsMalformedLines(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"timestamp":"2025-01-15T10:30:45Z","kind":"command","type":"spawn_process"}
+{"timestamp":"2025-01-15T10:30:46Z","kind":"fab`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, timelineFile), []byte(content), 0600))
+
+	entries, err := LoadTimeline(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "spawn_process", entries[0].Type)
+}
+
+func TestLoadTimeline_MissingFile(t *testing.T) {
+	_, err := LoadTimeline(t.TempDir())
+	require.Error(t, err)
+}
+
+func TestFormatTimeline(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 30, 45, 0, time.UTC)
+	entries := []TimelineEntry{
+		{Timestamp: start, Kind: TimelineCommand, Type: "assign_task", Actor: "mcp_tool", DurationMs: 12},
+		{Timestamp: start.Add(1500 * time.Millisecond), Kind: TimelineFabric, Type: "message.posted",
+			Actor: "worker-1", Channel: "tasks", Content: "Starting task\nwith details"},
+		{Timestamp: start.Add(2 * time.Second), Kind: TimelineCommand, Type: "report_complete",
+			Failed: true, Error: "task not found", DurationMs: 3},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, FormatTimeline(&buf, entries))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Contains(t, lines[0], "+0s")
+	require.Contains(t, lines[0], "assign_task")
+	require.Contains(t, lines[0], "ok (12ms)")
+	require.Contains(t, lines[1], "+1.5s")
+	require.Contains(t, lines[1], "#tasks Starting task …")
+	require.NotContains(t, lines[1], "with details")
+	require.Contains(t, lines[2], "FAILED: task not found (3ms)")
+}
+
+func TestFormatTimeline_CommandPayload(t *testing.T) {
+	start := time.Date(2025, 1, 15, 10, 30, 45, 0, time.UTC)
+	entries := []TimelineEntry{
+		{Timestamp: start, Kind: TimelineCommand, Type: "assign_task", DurationMs: 12,
+			Payload: json.RawMessage(`{"WorkerID":"worker-1","TaskID":"perles-abc.1"}`)},
+		{Timestamp: start.Add(time.Second), Kind: TimelineCommand, Type: "transition_phase", DurationMs: 1,
+			Payload: json.RawMessage(`{"WorkerID":"worker-1","NewPhase":"implementing"}`)},
+		{Timestamp: start.Add(2 * time.Second), Kind: TimelineCommand, Type: "transition_phase", DurationMs: 1,
+			Payload: json.RawMessage(`{"WorkerID":"worker-2","NewPhase":"idle"}`)},
+		{Timestamp: start.Add(3 * time.Second), Kind: TimelineCommand, Type: "transition_phase", Failed: true,
+			Payload: json.RawMessage(`{"WorkerID":"worker-1","NewPhase":"committing"}`)},
+		{Timestamp: start.Add(4*time.Minute + 3*time.Second), Kind: TimelineCommand, Type: "transition_phase", DurationMs: 1,
+			Payload: json.RawMessage(`{"WorkerID":"worker-1","NewPhase":"awaiting_review"}`)},
+		{Timestamp: start.Add(5 * time.Minute), Kind: TimelineCommand, Type: "report_verdict", DurationMs: 4,
+			Payload: json.RawMessage(`{"WorkerID":"worker-2","Verdict":"APPROVED"}`)},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, FormatTimeline(&buf, entries))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 6)
+	require.Contains(t, lines[0], "worker=worker-1 task=perles-abc.1 ok (12ms)")
+	require.Contains(t, lines[1], "worker=worker-1 phase=implementing ok (1ms)")
+	require.NotContains(t, lines[1], " for ")
+	require.NotContains(t, lines[2], " for ", "the first transition of another worker has no previous phase")
+	require.NotContains(t, lines[3], " for ", "failed transitions are ignored")
+	require.Contains(t, lines[4], "phase=awaiting_review ok (1ms); implementing for 4m2s")
+	require.Contains(t, lines[5], "worker=worker-2 verdict=APPROVED ok (4ms)")
+}
+
+func TestTruncateTimelineContent(t *testing.T) {
+	require.Equal(t, "short", truncateTimelineContent("  short  "))
+
+	long := strings.Repeat("x", timelineSummaryWidth+10)
+	got := truncateTimelineContent(long)
+	require.Len(t, []rune(got), timelineSummaryWidth)
+	require.True(t, strings.HasSuffix(got, "…"))
+}
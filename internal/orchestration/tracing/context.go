@@ -7,6 +7,8 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 // contextKey is a private type for context keys to avoid collisions.
@@ -38,6 +40,30 @@ func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, traceIDKey, traceID)
 }
 
+// ContextWithRemoteTraceID returns ctx with a remote parent in the trace
+// traceID, so spans started from it join that trace. The parent's span ID is
+// random, as only the trace ID travels through tool arguments. ctx is returned
+// unchanged if it already carries a span or traceID is not a valid W3C trace ID.
+func ContextWithRemoteTraceID(ctx context.Context, traceID string) context.Context {
+	if traceID == "" || trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	tid, err := trace.TraceIDFromHex(traceID)
+	if err != nil {
+		return ctx
+	}
+	sid, err := trace.SpanIDFromHex(GenerateSpanID())
+	if err != nil {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+}
+
 // GenerateTraceID creates a new random 32-character hex trace ID.
 // This follows the W3C Trace Context format for trace-id (16 bytes = 32 hex chars).
 func GenerateTraceID() string {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestTraceIDFromContext_EmptyContext(t *testing.T) {
@@ -52,6 +53,26 @@ func TestContextWithTraceID_Overwrite(t *testing.T) {
 		"should be able to overwrite trace ID")
 }
 
+func TestContextWithRemoteTraceID(t *testing.T) {
+	traceID := GenerateTraceID()
+	ctx := ContextWithRemoteTraceID(context.Background(), traceID)
+
+	sc := trace.SpanContextFromContext(ctx)
+	require.True(t, sc.IsValid())
+	require.True(t, sc.IsRemote())
+	require.Equal(t, traceID, sc.TraceID().String())
+
+	// An existing span is kept
+	require.Equal(t, sc, trace.SpanContextFromContext(ContextWithRemoteTraceID(ctx, GenerateTraceID())))
+}
+
+func TestContextWithRemoteTraceID_InvalidTraceID(t *testing.T) {
+	for _, traceID := range []string{"", "not-a-trace-id", "00000000000000000000000000000000"} {
+		ctx := ContextWithRemoteTraceID(context.Background(), traceID)
+		require.False(t, trace.SpanContextFromContext(ctx).IsValid(), traceID)
+	}
+}
+
 func TestGenerateTraceID_ValidFormat(t *testing.T) {
 	traceID := GenerateTraceID()
 
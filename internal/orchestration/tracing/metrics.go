@@ -0,0 +1,141 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+)
+
+// Metric names for orchestration metrics.
+const (
+	// MetricCommands counts processed commands by type and outcome.
+	// Its rate is the command throughput.
+	MetricCommands = "perles.commands"
+	// MetricPhaseDuration records how long each phase of the command pipeline took.
+	MetricPhaseDuration = "perles.pipeline.phase.duration"
+	// MetricQueueDepth reports the number of commands waiting in a session's queue.
+	MetricQueueDepth = "perles.command.queue.depth"
+)
+
+// Pipeline phases recorded in MetricPhaseDuration.
+const (
+	// PhaseQueued is the time from a command's creation until its handler starts.
+	PhaseQueued = "queued"
+	// PhaseHandled is the time a command's handler ran. For deliver_process
+	// commands this is the delivery of a message to a worker.
+	PhaseHandled = "handled"
+	// PhaseToolCall is the time an MCP tool call took, including any command
+	// it submitted and waited for.
+	PhaseToolCall = "tool_call"
+)
+
+// Metrics holds the OpenTelemetry instruments for the command pipeline.
+// A nil *Metrics is valid and records nothing.
+type Metrics struct {
+	meter      metric.Meter
+	commands   metric.Int64Counter
+	phases     metric.Float64Histogram
+	queueDepth metric.Int64ObservableGauge
+}
+
+// NewMetrics creates the pipeline instruments on meter.
+func NewMetrics(meter metric.Meter) (*Metrics, error) {
+	commands, err := meter.Int64Counter(MetricCommands,
+		metric.WithDescription("Commands processed, by type and outcome"),
+		metric.WithUnit("{command}"))
+	if err != nil {
+		return nil, fmt.Errorf("create %s counter: %w", MetricCommands, err)
+	}
+
+	phases, err := meter.Float64Histogram(MetricPhaseDuration,
+		metric.WithDescription("Duration of each phase of the command pipeline"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return nil, fmt.Errorf("create %s histogram: %w", MetricPhaseDuration, err)
+	}
+
+	queueDepth, err := meter.Int64ObservableGauge(MetricQueueDepth,
+		metric.WithDescription("Commands waiting in the command queue"),
+		metric.WithUnit("{command}"))
+	if err != nil {
+		return nil, fmt.Errorf("create %s gauge: %w", MetricQueueDepth, err)
+	}
+
+	return &Metrics{
+		meter:      meter,
+		commands:   commands,
+		phases:     phases,
+		queueDepth: queueDepth,
+	}, nil
+}
+
+// Middleware returns command processor middleware that counts commands and
+// records their queued and handled durations. A nil Metrics returns a
+// pass-through.
+func (m *Metrics) Middleware() processor.Middleware {
+	if m == nil {
+		return func(next processor.CommandHandler) processor.CommandHandler {
+			return next
+		}
+	}
+
+	return func(next processor.CommandHandler) processor.CommandHandler {
+		return processor.HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			cmdType := attribute.String(AttrCommandType, string(cmd.Type()))
+
+			start := time.Now()
+			if created := cmd.CreatedAt(); !created.IsZero() {
+				m.recordPhase(ctx, PhaseQueued, start.Sub(created), cmdType)
+			}
+
+			result, err := next.Handle(ctx, cmd)
+
+			m.recordPhase(ctx, PhaseHandled, time.Since(start), cmdType)
+			success := err == nil && (result == nil || result.Success)
+			m.commands.Add(ctx, 1, metric.WithAttributes(cmdType, attribute.Bool(AttrCommandSuccess, success)))
+
+			return result, err
+		})
+	}
+}
+
+// RecordToolCall records the duration of an MCP tool call.
+func (m *Metrics) RecordToolCall(ctx context.Context, tool, callerRole string, duration time.Duration, failed bool) {
+	if m == nil {
+		return
+	}
+	m.recordPhase(ctx, PhaseToolCall, duration,
+		attribute.String(AttrMCPToolName, tool),
+		attribute.String(AttrMCPCallerRole, callerRole),
+		attribute.Bool(AttrMCPFailed, failed))
+}
+
+// ObserveQueueDepth reports depth as the queue depth of session until the
+// returned function is called.
+func (m *Metrics) ObserveQueueDepth(sessionID string, depth func() int) (func(), error) {
+	if m == nil {
+		return func() {}, nil
+	}
+
+	session := metric.WithAttributes(attribute.String(AttrSessionID, sessionID))
+	registration, err := m.meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(m.queueDepth, int64(depth()), session)
+		return nil
+	}, m.queueDepth)
+	if err != nil {
+		return nil, fmt.Errorf("observe queue depth: %w", err)
+	}
+	return func() { _ = registration.Unregister() }, nil
+}
+
+// recordPhase records the duration of a pipeline phase in milliseconds.
+func (m *Metrics) recordPhase(ctx context.Context, phase string, duration time.Duration, attrs ...attribute.KeyValue) {
+	attrs = append(attrs, attribute.String(AttrPipelinePhase, phase))
+	m.phases.Record(ctx, float64(duration.Microseconds())/1000.0, metric.WithAttributes(attrs...))
+}
@@ -0,0 +1,134 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// setupTestMetrics creates metrics backed by a manual reader.
+func setupTestMetrics(t *testing.T) (*Metrics, *sdkmetric.ManualReader) {
+	t.Helper()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	metrics, err := NewMetrics(provider.Meter("test-meter"))
+	require.NoError(t, err)
+	return metrics, reader
+}
+
+// collectMetric returns the aggregation of the metric named name.
+func collectMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m.Data
+			}
+		}
+	}
+	require.FailNow(t, "metric not found", name)
+	return nil
+}
+
+// phaseCounts returns the number of recorded durations per pipeline phase.
+func phaseCounts(t *testing.T, reader *sdkmetric.ManualReader) map[string]uint64 {
+	t.Helper()
+	hist := collectMetric(t, reader, MetricPhaseDuration).(metricdata.Histogram[float64])
+	counts := map[string]uint64{}
+	for _, dp := range hist.DataPoints {
+		phase, _ := dp.Attributes.Value(AttrPipelinePhase)
+		counts[phase.AsString()] += dp.Count
+	}
+	return counts
+}
+
+func TestMetrics_Middleware(t *testing.T) {
+	metrics, reader := setupTestMetrics(t)
+
+	_, err := metrics.Middleware()(successHandler()).Handle(context.Background(), newTestCommand(1))
+	require.NoError(t, err)
+	_, err = metrics.Middleware()(errorHandler("boom")).Handle(context.Background(), newTestCommand(2))
+	require.Error(t, err)
+
+	sum := collectMetric(t, reader, MetricCommands).(metricdata.Sum[int64])
+	outcomes := map[bool]int64{}
+	for _, dp := range sum.DataPoints {
+		success, _ := dp.Attributes.Value(AttrCommandSuccess)
+		cmdType, _ := dp.Attributes.Value(AttrCommandType)
+		require.Equal(t, "test_command", cmdType.AsString())
+		outcomes[success.AsBool()] += dp.Value
+	}
+	require.Equal(t, map[bool]int64{true: 1, false: 1}, outcomes)
+
+	require.Equal(t, map[string]uint64{PhaseQueued: 2, PhaseHandled: 2}, phaseCounts(t, reader))
+}
+
+func TestMetrics_RecordToolCall(t *testing.T) {
+	metrics, reader := setupTestMetrics(t)
+
+	metrics.RecordToolCall(context.Background(), "fabric_send", "worker", 20*time.Millisecond, false)
+
+	hist := collectMetric(t, reader, MetricPhaseDuration).(metricdata.Histogram[float64])
+	require.Len(t, hist.DataPoints, 1)
+	dp := hist.DataPoints[0]
+	require.Equal(t, 20.0, dp.Sum)
+	tool, _ := dp.Attributes.Value(AttrMCPToolName)
+	require.Equal(t, "fabric_send", tool.AsString())
+	phase, _ := dp.Attributes.Value(AttrPipelinePhase)
+	require.Equal(t, PhaseToolCall, phase.AsString())
+}
+
+func TestMetrics_ObserveQueueDepth(t *testing.T) {
+	metrics, reader := setupTestMetrics(t)
+
+	stop, err := metrics.ObserveQueueDepth("session-1", func() int { return 7 })
+	require.NoError(t, err)
+
+	gauge := collectMetric(t, reader, MetricQueueDepth).(metricdata.Gauge[int64])
+	require.Len(t, gauge.DataPoints, 1)
+	require.Equal(t, int64(7), gauge.DataPoints[0].Value)
+	require.True(t, gauge.DataPoints[0].Attributes.HasValue(attribute.Key(AttrSessionID)))
+
+	stop()
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == MetricQueueDepth {
+				require.Empty(t, m.Data.(metricdata.Gauge[int64]).DataPoints, "stopped sessions are no longer observed")
+			}
+		}
+	}
+}
+
+func TestMetrics_Nil(t *testing.T) {
+	var metrics *Metrics
+
+	result, err := metrics.Middleware()(successHandler()).Handle(context.Background(), newTestCommand(1))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	metrics.RecordToolCall(context.Background(), "fabric_send", "worker", time.Millisecond, true)
+	stop, err := metrics.ObserveQueueDepth("session-1", func() int { return 0 })
+	require.NoError(t, err)
+	stop()
+}
+
+func TestMetrics_FailedResultCountsAsFailure(t *testing.T) {
+	metrics, reader := setupTestMetrics(t)
+
+	_, err := metrics.Middleware()(failureResultHandler("nope")).Handle(context.Background(), newTestCommand(1))
+	require.NoError(t, err)
+
+	sum := collectMetric(t, reader, MetricCommands).(metricdata.Sum[int64])
+	require.Len(t, sum.DataPoints, 1)
+	success, _ := sum.DataPoints[0].Attributes.Value(AttrCommandSuccess)
+	require.False(t, success.AsBool())
+}
@@ -9,6 +9,10 @@ const (
 	AttrCommandType     = "command.type"
 	AttrCommandPriority = "command.priority"
 	AttrCommandSource   = "command.source"
+	AttrCommandSuccess  = "command.success"
+
+	// Pipeline attributes
+	AttrPipelinePhase = "pipeline.phase"
 
 	// Process attributes
 	AttrProcessID   = "process.id"
@@ -26,6 +30,7 @@ const (
 	AttrMCPRequestID  = "mcp.request.id"
 	AttrMCPCallerRole = "mcp.caller.role"
 	AttrMCPCallerID   = "mcp.caller.id"
+	AttrMCPFailed     = "mcp.failed"
 
 	// Session attributes
 	AttrSessionID    = "session.id"
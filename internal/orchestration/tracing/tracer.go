@@ -2,12 +2,18 @@ package tracing
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
@@ -41,6 +47,15 @@ type Config struct {
 	// ServiceName identifies this service in traces.
 	// Default: "perles-orchestrator"
 	ServiceName string `yaml:"service_name"`
+
+	// Metrics enables command pipeline metrics (see Metrics), exported to
+	// OTLPEndpoint independently of Enabled and Exporter.
+	// Default: false
+	Metrics bool `yaml:"metrics"`
+
+	// MetricsInterval is how often metrics are exported.
+	// Default: 15s
+	MetricsInterval time.Duration `yaml:"metrics_interval"`
 }
 
 // DefaultConfig returns sensible defaults for development.
@@ -55,29 +70,103 @@ func DefaultConfig() Config {
 	}
 }
 
-// Provider manages the OpenTelemetry tracer provider.
-// It wraps the underlying TracerProvider and provides convenient methods
-// for getting tracers and shutting down cleanly.
+// DefaultMetricsInterval is the metrics export interval used when none is configured.
+const DefaultMetricsInterval = 15 * time.Second
+
+// Provider manages the OpenTelemetry tracer and meter providers.
+// It wraps the underlying providers and provides convenient methods
+// for getting tracers and meters and shutting down cleanly.
 type Provider struct {
-	provider *sdktrace.TracerProvider
-	tracer   trace.Tracer
-	enabled  bool
+	provider      *sdktrace.TracerProvider
+	tracer        trace.Tracer
+	enabled       bool
+	meterProvider *sdkmetric.MeterProvider
+	meter         metric.Meter
+	metrics       *Metrics
 }
 
-// NewProvider creates and configures the trace provider.
-// If tracing is disabled in the config, a no-op provider is returned
-// that has zero overhead.
+// NewProvider creates and configures the trace and meter providers.
+// If tracing or metrics are disabled in the config, no-op implementations
+// with zero overhead are used in their place.
 func NewProvider(cfg Config) (*Provider, error) {
-	if !cfg.Enabled {
-		// Return no-op provider for zero overhead when disabled
-		noopProvider := noop.NewTracerProvider()
-		return &Provider{
-			provider: nil,
-			tracer:   noopProvider.Tracer("noop"),
-			enabled:  false,
-		}, nil
+	p := &Provider{
+		tracer: noop.NewTracerProvider().Tracer("noop"),
+		meter:  metricnoop.NewMeterProvider().Meter("noop"),
+	}
+
+	if cfg.Enabled {
+		provider, err := newTracerProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+		p.provider = provider
+		p.tracer = provider.Tracer(serviceName(cfg))
+		p.enabled = true
+	}
+
+	if cfg.Metrics {
+		meterProvider, err := newMeterProvider(cfg)
+		if err != nil {
+			if p.provider != nil {
+				_ = p.provider.Shutdown(context.Background())
+			}
+			return nil, err
+		}
+		p.meterProvider = meterProvider
+		p.meter = meterProvider.Meter(serviceName(cfg))
+		if p.metrics, err = NewMetrics(p.meter); err != nil {
+			_ = p.Shutdown(context.Background())
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// serviceName returns the configured service name or its default.
+func serviceName(cfg Config) string {
+	if cfg.ServiceName == "" {
+		return "perles-orchestrator"
+	}
+	return cfg.ServiceName
+}
+
+// otlpEndpoint returns the configured OTLP endpoint or its default.
+func otlpEndpoint(cfg Config) string {
+	if cfg.OTLPEndpoint == "" {
+		return "localhost:4317"
+	}
+	return cfg.OTLPEndpoint
+}
+
+// newMeterProvider creates a meter provider that periodically exports to the
+// OTLP endpoint and sets it as the global meter provider.
+func newMeterProvider(cfg Config) (*sdkmetric.MeterProvider, error) {
+	exporter, err := otlpmetricgrpc.New(
+		context.Background(),
+		otlpmetricgrpc.WithEndpoint(otlpEndpoint(cfg)),
+		otlpmetricgrpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create otlp metric exporter: %w", err)
+	}
+
+	interval := cfg.MetricsInterval
+	if interval <= 0 {
+		interval = DefaultMetricsInterval
 	}
 
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName(cfg)))),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(interval))),
+	)
+	otel.SetMeterProvider(provider)
+	return provider, nil
+}
+
+// newTracerProvider creates a tracer provider for the configured exporter and
+// sets it as the global tracer provider.
+func newTracerProvider(cfg Config) (*sdktrace.TracerProvider, error) {
 	// Create exporter based on config
 	var exporter sdktrace.SpanExporter
 	var err error
@@ -97,13 +186,9 @@ func NewProvider(cfg Config) (*Provider, error) {
 			return nil, fmt.Errorf("create stdout exporter: %w", err)
 		}
 	case "otlp":
-		endpoint := cfg.OTLPEndpoint
-		if endpoint == "" {
-			endpoint = "localhost:4317"
-		}
 		exporter, err = otlptracegrpc.New(
 			context.Background(),
-			otlptracegrpc.WithEndpoint(endpoint),
+			otlptracegrpc.WithEndpoint(otlpEndpoint(cfg)),
 			otlptracegrpc.WithInsecure(),
 		)
 		if err != nil {
@@ -116,16 +201,10 @@ func NewProvider(cfg Config) (*Provider, error) {
 		return nil, fmt.Errorf("unsupported exporter type: %s", cfg.Exporter)
 	}
 
-	// Ensure service name has a default
-	serviceName := cfg.ServiceName
-	if serviceName == "" {
-		serviceName = "perles-orchestrator"
-	}
-
 	// Create resource with service info
 	// We use resource.NewSchemaless to avoid schema version conflicts with resource.Default()
 	res := resource.NewSchemaless(
-		attribute.String("service.name", serviceName),
+		attribute.String("service.name", serviceName(cfg)),
 	)
 
 	// Create sampler - use parent-based sampling with ratio
@@ -151,11 +230,7 @@ func NewProvider(cfg Config) (*Provider, error) {
 	// Set as global provider
 	otel.SetTracerProvider(provider)
 
-	return &Provider{
-		provider: provider,
-		tracer:   provider.Tracer(serviceName),
-		enabled:  true,
-	}, nil
+	return provider, nil
 }
 
 // Tracer returns the configured tracer for creating spans.
@@ -165,6 +240,27 @@ func (p *Provider) Tracer() trace.Tracer {
 	return p.tracer
 }
 
+// EnabledTracer returns the tracer, or nil if tracing is disabled, for
+// components that skip tracing entirely when they have no tracer.
+func (p *Provider) EnabledTracer() trace.Tracer {
+	if !p.enabled {
+		return nil
+	}
+	return p.tracer
+}
+
+// Meter returns the configured meter for creating instruments.
+// The returned meter is safe to use even if metrics are disabled
+// (it will be a no-op meter in that case).
+func (p *Provider) Meter() metric.Meter {
+	return p.meter
+}
+
+// Metrics returns the command pipeline metrics, or nil if metrics are disabled.
+func (p *Provider) Metrics() *Metrics {
+	return p.metrics
+}
+
 // Enabled returns whether tracing is enabled.
 func (p *Provider) Enabled() bool {
 	return p.enabled
@@ -174,8 +270,12 @@ func (p *Provider) Enabled() bool {
 // It should be called when the application is shutting down to ensure
 // all spans are exported before exit.
 func (p *Provider) Shutdown(ctx context.Context) error {
+	var errs []error
 	if p.provider != nil {
-		return p.provider.Shutdown(ctx)
+		errs = append(errs, p.provider.Shutdown(ctx))
+	}
+	if p.meterProvider != nil {
+		errs = append(errs, p.meterProvider.Shutdown(ctx))
 	}
-	return nil
+	return errors.Join(errs...)
 }
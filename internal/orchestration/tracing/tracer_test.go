@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/trace"
@@ -46,6 +47,28 @@ func TestNewProvider_Disabled(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewProvider_Metrics(t *testing.T) {
+	provider, err := NewProvider(Config{Metrics: true, MetricsInterval: time.Hour})
+	require.NoError(t, err)
+	require.False(t, provider.Enabled(), "metrics do not enable tracing")
+	require.Nil(t, provider.EnabledTracer())
+	require.NotNil(t, provider.Metrics())
+	require.NotNil(t, provider.Meter())
+
+	// Nothing listens on the endpoint; the final export gives up with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_ = provider.Shutdown(ctx)
+}
+
+func TestNewProvider_MetricsDisabled(t *testing.T) {
+	provider, err := NewProvider(Config{Enabled: true, Exporter: "none"})
+	require.NoError(t, err)
+	require.NotNil(t, provider.EnabledTracer())
+	require.Nil(t, provider.Metrics(), "metrics are off unless enabled")
+	require.NoError(t, provider.Shutdown(context.Background()))
+}
+
 func TestNewProvider_Enabled_WithFileExporter(t *testing.T) {
 	tmpDir := t.TempDir()
 	tracePath := filepath.Join(tmpDir, "traces.jsonl")
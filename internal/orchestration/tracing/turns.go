@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TurnTraces remembers the span that delivered each process's current turn,
+// so the MCP tool calls a process makes during the turn join the trace of the
+// command that started it: command submit → processor → worker delivery →
+// tool result.
+// Thread-safe. A nil *TurnTraces tracks nothing.
+type TurnTraces struct {
+	mu    sync.RWMutex
+	turns map[string]trace.SpanContext // process ID -> delivering span
+}
+
+// NewTurnTraces creates an empty TurnTraces.
+func NewTurnTraces() *TurnTraces {
+	return &TurnTraces{turns: make(map[string]trace.SpanContext)}
+}
+
+// Begin records that the span in ctx delivered a new turn to processID.
+// Contexts without a valid span are ignored, keeping the previous turn's span.
+func (t *TurnTraces) Begin(ctx context.Context, processID string) {
+	if t == nil {
+		return
+	}
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.turns[processID] = sc
+}
+
+// ContextFor returns ctx with the span that delivered processID's current turn
+// as parent. ctx is returned unchanged if it already carries a span or the
+// process has no traced turn.
+func (t *TurnTraces) ContextFor(ctx context.Context, processID string) context.Context {
+	if t == nil || trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	t.mu.RLock()
+	sc, ok := t.turns[processID]
+	t.mu.RUnlock()
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTurnTraces_ParentsToolCallsOnDeliveringSpan(t *testing.T) {
+	tracer, exporter := setupTestTracer(t)
+	turns := NewTurnTraces()
+
+	// The deliver command's span starts worker-1's turn
+	deliverCtx, deliver := tracer.Start(context.Background(), "command.process.deliver_process_queued")
+	turns.Begin(deliverCtx, "worker-1")
+	deliver.End()
+
+	// A tool call of worker-1 joins the trace as a child of the delivery
+	_, call := tracer.Start(turns.ContextFor(context.Background(), "worker-1"), "mcp.tool.fabric_reply")
+	call.End()
+
+	delivered, ok := getSpanByName(exporter, "command.process.deliver_process_queued")
+	require.True(t, ok)
+	tool, ok := getSpanByName(exporter, "mcp.tool.fabric_reply")
+	require.True(t, ok)
+	require.Equal(t, delivered.SpanContext.TraceID(), tool.SpanContext.TraceID())
+	require.Equal(t, delivered.SpanContext.SpanID(), tool.Parent.SpanID())
+
+	// Other processes start their own traces
+	require.False(t, trace.SpanContextFromContext(turns.ContextFor(context.Background(), "worker-2")).IsValid())
+}
+
+func TestTurnTraces_KeepsExistingSpan(t *testing.T) {
+	tracer, _ := setupTestTracer(t)
+	turns := NewTurnTraces()
+
+	deliverCtx, deliver := tracer.Start(context.Background(), "deliver")
+	defer deliver.End()
+	turns.Begin(deliverCtx, "worker-1")
+
+	// Contexts without a span do not replace the turn
+	turns.Begin(context.Background(), "worker-1")
+
+	callerCtx, caller := tracer.Start(context.Background(), "caller")
+	defer caller.End()
+	require.Equal(t, caller.SpanContext(), trace.SpanContextFromContext(turns.ContextFor(callerCtx, "worker-1")))
+	require.Equal(t, deliver.SpanContext().SpanID(), trace.SpanContextFromContext(turns.ContextFor(context.Background(), "worker-1")).SpanID())
+}
+
+func TestTurnTraces_Nil(t *testing.T) {
+	var turns *TurnTraces
+	turns.Begin(context.Background(), "worker-1")
+	ctx := context.Background()
+	require.Equal(t, ctx, turns.ContextFor(ctx, "worker-1"))
+}
@@ -0,0 +1,175 @@
+// Package turnpolicy decides what happens when a worker ends a turn without
+// calling a tool that completes it.
+//
+// A Policy names the tools that complete a turn, optionally per worker phase,
+// and an escalation: one action per consecutive incomplete turn, such as
+// nudge, nudge, warn, replace. Once a phase's grace timeout has passed since
+// the first incomplete turn, the remaining nudges are skipped. Every decision
+// is published on the workflow event bus so the dashboard can show it.
+package turnpolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Action is what happens to a worker that ended a turn without a required tool call.
+type Action string
+
+const (
+	// ActionNudge reminds the worker to call a required tool.
+	ActionNudge Action = "nudge"
+	// ActionWarn reminds the worker and warns the coordinator about it.
+	ActionWarn Action = "warn"
+	// ActionReplace replaces the worker with a fresh one.
+	ActionReplace Action = "replace"
+	// ActionAllow lets the turn end; the escalation is exhausted.
+	ActionAllow Action = "allow"
+)
+
+// Actions are the actions an escalation may list.
+var Actions = []Action{ActionNudge, ActionWarn, ActionReplace}
+
+// DefaultTools are the tools that complete a worker's turn unless the policy
+// names others.
+var DefaultTools = []string{
+	"fabric_send",
+	"fabric_reply",
+	"fabric_ack",
+	"report_implementation_complete",
+	"report_review_verdict",
+	"request_assistance",
+	"fabric_join",
+}
+
+// DefaultEscalation nudges a worker twice, then lets its turn end.
+var DefaultEscalation = []Action{ActionNudge, ActionNudge}
+
+// Rule overrides the policy for one worker phase.
+type Rule struct {
+	// Tools complete a turn in the phase. Empty means Policy.Tools.
+	Tools []string
+	// Timeout is the phase's grace period. Zero means Policy.Timeout.
+	Timeout time.Duration
+}
+
+// Policy configures turn completion enforcement. The zero Policy nudges twice
+// with DefaultTools and no grace timeout.
+type Policy struct {
+	// Tools complete a turn in phases without a rule. Empty means DefaultTools.
+	Tools []string
+	// Phases overrides Tools and Timeout by worker phase, e.g. "implementing".
+	Phases map[string]Rule
+	// Escalation lists the action for each consecutive incomplete turn.
+	// Empty means DefaultEscalation.
+	Escalation []Action
+	// Timeout is how long after the first incomplete turn nudges are still
+	// sent; later turns skip to the next warn or replace. Zero means no limit.
+	Timeout time.Duration
+}
+
+// RequiredTools returns the tools that complete a turn in phase.
+func (p Policy) RequiredTools(phase string) []string {
+	if rule, ok := p.Phases[phase]; ok && len(rule.Tools) > 0 {
+		return rule.Tools
+	}
+	if len(p.Tools) > 0 {
+		return p.Tools
+	}
+	return DefaultTools
+}
+
+// GraceTimeout returns the grace period of phase, zero if there is none.
+func (p Policy) GraceTimeout(phase string) time.Duration {
+	if rule, ok := p.Phases[phase]; ok && rule.Timeout > 0 {
+		return rule.Timeout
+	}
+	return p.Timeout
+}
+
+// Steps returns the number of escalation steps.
+func (p Policy) Steps() int {
+	return len(p.escalation())
+}
+
+// Next returns the action for an incomplete turn in phase, given the
+// escalation step reached so far and the time since the first incomplete
+// turn. It also returns the step to continue from on the next incomplete turn.
+func (p Policy) Next(phase string, step int, elapsed time.Duration) (Action, int) {
+	escalation := p.escalation()
+	if step >= len(escalation) {
+		return ActionAllow, step
+	}
+
+	if timeout := p.GraceTimeout(phase); timeout > 0 && elapsed >= timeout && escalation[step] == ActionNudge {
+		for i := step; i < len(escalation); i++ {
+			if escalation[i] != ActionNudge {
+				return escalation[i], i + 1
+			}
+		}
+		return ActionAllow, len(escalation)
+	}
+	return escalation[step], step + 1
+}
+
+func (p Policy) escalation() []Action {
+	if len(p.Escalation) > 0 {
+		return p.Escalation
+	}
+	return DefaultEscalation
+}
+
+// Validate reports unknown escalation actions and negative timeouts.
+func (p Policy) Validate() error {
+	for _, a := range p.Escalation {
+		if !slices.Contains(Actions, a) {
+			return fmt.Errorf("unknown escalation action %q (want nudge, warn or replace)", a)
+		}
+	}
+	if p.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative, got %s", p.Timeout)
+	}
+	for phase, rule := range p.Phases {
+		if rule.Timeout < 0 {
+			return fmt.Errorf("phase %s timeout must not be negative, got %s", phase, rule.Timeout)
+		}
+	}
+	return nil
+}
+
+// Decision is the outcome of one incomplete turn.
+type Decision struct {
+	ProcessID string
+	Phase     string
+	Action    Action
+	// Turns is the number of consecutive incomplete turns, including this one.
+	Turns int
+	// Steps is the length of the escalation.
+	Steps int
+	// Elapsed is the time since the first incomplete turn.
+	Elapsed time.Duration
+	// RequiredTools are the tools the worker should have called.
+	RequiredTools []string
+	Timestamp     time.Time
+}
+
+// Summary returns a one-line description for logs and the dashboard,
+// e.g. "worker-1 ended 2 turns in implementing without a required tool call: nudge".
+func (d Decision) Summary() string {
+	turns := "a turn"
+	if d.Turns > 1 {
+		turns = fmt.Sprintf("%d turns", d.Turns)
+	}
+	phase := ""
+	if d.Phase != "" {
+		phase = " in " + d.Phase
+	}
+	return fmt.Sprintf("%s ended %s%s without a required tool call: %s", d.ProcessID, turns, phase, d.Action)
+}
+
+// ToolList returns the required tools as a comma-separated list.
+func (d Decision) ToolList() string {
+	return strings.Join(d.RequiredTools, ", ")
+}
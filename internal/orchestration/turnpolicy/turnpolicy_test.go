@@ -0,0 +1,84 @@
+package turnpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_ZeroValueNudgesTwiceWithDefaultTools(t *testing.T) {
+	var p Policy
+
+	require.Equal(t, DefaultTools, p.RequiredTools("implementing"))
+	require.Equal(t, 2, p.Steps())
+
+	action, step := p.Next("", 0, 0)
+	require.Equal(t, ActionNudge, action)
+	action, step = p.Next("", step, 0)
+	require.Equal(t, ActionNudge, action)
+	action, _ = p.Next("", step, 0)
+	require.Equal(t, ActionAllow, action)
+}
+
+func TestPolicy_PhaseRulesOverrideTools(t *testing.T) {
+	p := Policy{
+		Tools:  []string{"fabric_send"},
+		Phases: map[string]Rule{"reviewing": {Tools: []string{"report_review_verdict"}, Timeout: time.Minute}},
+	}
+
+	require.Equal(t, []string{"report_review_verdict"}, p.RequiredTools("reviewing"))
+	require.Equal(t, []string{"fabric_send"}, p.RequiredTools("implementing"))
+	require.Equal(t, time.Minute, p.GraceTimeout("reviewing"))
+	require.Zero(t, p.GraceTimeout("implementing"))
+}
+
+func TestPolicy_Escalation(t *testing.T) {
+	p := Policy{Escalation: []Action{ActionNudge, ActionWarn, ActionReplace}}
+
+	var actions []Action
+	step := 0
+	for range 4 {
+		var action Action
+		action, step = p.Next("", step, 0)
+		actions = append(actions, action)
+	}
+	require.Equal(t, []Action{ActionNudge, ActionWarn, ActionReplace, ActionAllow}, actions)
+}
+
+func TestPolicy_TimeoutSkipsRemainingNudges(t *testing.T) {
+	p := Policy{Escalation: []Action{ActionNudge, ActionNudge, ActionNudge, ActionWarn}, Timeout: 5 * time.Minute}
+
+	action, step := p.Next("", 0, 0)
+	require.Equal(t, ActionNudge, action)
+	require.Equal(t, 1, step)
+
+	action, step = p.Next("", step, 5*time.Minute)
+	require.Equal(t, ActionWarn, action)
+	require.Equal(t, 4, step)
+
+	// Without a step other than nudge the escalation is exhausted
+	p.Escalation = []Action{ActionNudge, ActionNudge}
+	action, step = p.Next("", 1, 10*time.Minute)
+	require.Equal(t, ActionAllow, action)
+	require.Equal(t, 2, step)
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	require.NoError(t, Policy{}.Validate())
+	require.NoError(t, Policy{Escalation: []Action{ActionNudge, ActionWarn, ActionReplace}}.Validate())
+
+	require.EqualError(t, Policy{Escalation: []Action{"kill"}}.Validate(),
+		`unknown escalation action "kill" (want nudge, warn or replace)`)
+	require.EqualError(t, Policy{Timeout: -time.Second}.Validate(), "timeout must not be negative, got -1s")
+	require.EqualError(t, Policy{Phases: map[string]Rule{"reviewing": {Timeout: -time.Second}}}.Validate(),
+		"phase reviewing timeout must not be negative, got -1s")
+}
+
+func TestDecision_Summary(t *testing.T) {
+	d := Decision{ProcessID: "worker-1", Phase: "implementing", Action: ActionNudge, Turns: 2}
+	require.Equal(t, "worker-1 ended 2 turns in implementing without a required tool call: nudge", d.Summary())
+
+	d = Decision{ProcessID: "worker-1", Action: ActionReplace, Turns: 1}
+	require.Equal(t, "worker-1 ended a turn without a required tool call: replace", d.Summary())
+}
@@ -8,14 +8,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
+	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	mcptypes "github.com/zjrosen/perles/internal/orchestration/mcp/types"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
 )
 
 // DefaultTimeout is the default timeout for command execution.
@@ -39,11 +46,13 @@ type V2Adapter struct {
 	processRepo      repository.ProcessRepository
 	taskRepo         repository.TaskRepository
 	queueRepo        repository.QueueRepository
+	reviewHistory    repository.ReviewHistoryRepository
 	workflowProvider WorkflowConfigProvider
 	timeout          time.Duration
 	sessionID        string // Session ID for accountability summary generation
 	workDir          string // Working directory (project root or worktree path)
 	sessionDir       string // Session directory for accountability summaries
+	conflicts        *processor.ConflictScanner
 }
 
 // Option configures the V2Adapter.
@@ -77,6 +86,13 @@ func WithQueueRepository(repo repository.QueueRepository) Option {
 	}
 }
 
+// WithReviewHistoryRepository sets the review history used for reviewer rotation.
+func WithReviewHistoryRepository(repo repository.ReviewHistoryRepository) Option {
+	return func(a *V2Adapter) {
+		a.reviewHistory = repo
+	}
+}
+
 // WithSessionID sets the session ID, work directory, and session directory for accountability
 // summary generation. The sessionDir is the actual path where session files are stored
 // (e.g., ~/.perles/sessions/{app}/{date}/{id}/ for centralized storage).
@@ -88,6 +104,14 @@ func WithSessionID(sessionID, workDir, sessionDir string) Option {
 	}
 }
 
+// WithConflictScanner sets the conflict scanner whose overlapping edits
+// query_worker_state reports. Nil reports none.
+func WithConflictScanner(scanner *processor.ConflictScanner) Option {
+	return func(a *V2Adapter) {
+		a.conflicts = scanner
+	}
+}
+
 // NewV2Adapter creates a new V2Adapter with the given processor.
 func NewV2Adapter(proc *processor.CommandProcessor, opts ...Option) *V2Adapter {
 	a := &V2Adapter{
@@ -119,8 +143,9 @@ type retireWorkerArgs struct {
 
 // replaceWorkerArgs holds arguments for replace_worker tool.
 type replaceWorkerArgs struct {
-	WorkerID string `json:"worker_id"`
-	Reason   string `json:"reason,omitempty"`
+	WorkerID       string `json:"worker_id"`
+	Reason         string `json:"reason,omitempty"`
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 // sendToWorkerArgs holds arguments for send_to_worker tool.
@@ -129,23 +154,58 @@ type sendToWorkerArgs struct {
 	Message  string `json:"message"`
 }
 
+// broadcastToWorkersArgs holds arguments for broadcast_to_workers tool.
+type broadcastToWorkersArgs struct {
+	Message        string   `json:"message"`
+	Phase          string   `json:"phase,omitempty"`
+	AgentType      string   `json:"agent_type,omitempty"`
+	TaskID         string   `json:"task_id,omitempty"`
+	ExcludeWorkers []string `json:"exclude_workers,omitempty"`
+}
+
 // assignTaskArgs holds arguments for assign_task tool.
 type assignTaskArgs struct {
-	WorkerID string `json:"worker_id"`
-	TaskID   string `json:"task_id"`
-	Summary  string `json:"summary,omitempty"`
-	ThreadID string `json:"thread_id,omitempty"`
+	WorkerID       string `json:"worker_id"`
+	TaskID         string `json:"task_id"`
+	Summary        string `json:"summary,omitempty"`
+	ThreadID       string `json:"thread_id,omitempty"`
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 // assignTaskReviewArgs holds arguments for assign_task_review tool.
 type assignTaskReviewArgs struct {
-	ReviewerID    string `json:"reviewer_id"`
+	ReviewerID     string `json:"reviewer_id"`
+	TaskID         string `json:"task_id"`
+	ImplementerID  string `json:"implementer_id"`
+	Summary        string `json:"summary,omitempty"`
+	ReviewType     string `json:"review_type,omitempty"`
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+// rotateReviewerArgs holds arguments for rotate_reviewer tool.
+type rotateReviewerArgs struct {
 	TaskID        string `json:"task_id"`
 	ImplementerID string `json:"implementer_id"`
 	Summary       string `json:"summary,omitempty"`
 	ReviewType    string `json:"review_type,omitempty"`
 }
 
+// queryReviewHistoryArgs holds arguments for query_review_history tool.
+type queryReviewHistoryArgs struct {
+	WorkerID string `json:"worker_id,omitempty"`
+}
+
+// listDeadLettersArgs holds arguments for list_dead_letters tool.
+type listDeadLettersArgs struct {
+	CommandType string `json:"command_type,omitempty"`
+}
+
+// retryDeadLetterArgs holds arguments for retry_dead_letter tool.
+type retryDeadLetterArgs struct {
+	CommandID string `json:"command_id"`
+	Discard   bool   `json:"discard,omitempty"`
+}
+
 // assignReviewFeedbackArgs holds arguments for assign_review_feedback tool.
 type assignReviewFeedbackArgs struct {
 	ImplementerID string `json:"implementer_id"`
@@ -162,18 +222,89 @@ type approveCommitArgs struct {
 
 // reportImplementationCompleteArgs holds arguments for report_implementation_complete tool.
 type reportImplementationCompleteArgs struct {
-	Summary string `json:"summary"`
+	Summary          string                  `json:"summary"`
+	SelfAssessment   *selfAssessmentArgs     `json:"self_assessment,omitempty"`
+	ResolvedFindings []findingResolutionArgs `json:"resolved_findings,omitempty"`
+}
+
+// findingResolutionArgs records how an implementer addressed a review finding.
+type findingResolutionArgs struct {
+	FindingID  int    `json:"finding_id"`
+	Resolution string `json:"resolution"`
+}
+
+// selfAssessmentArgs holds the optional structured self-assessment for report_implementation_complete.
+type selfAssessmentArgs struct {
+	Confidence    *float64 `json:"confidence"`
+	KnownGaps     []string `json:"known_gaps,omitempty"`
+	UntestedAreas []string `json:"untested_areas,omitempty"`
+}
+
+// toSelfAssessment converts the MCP args into the repository entity.
+// Returns an error if confidence is missing, since an assessment without it is meaningless.
+func (s *selfAssessmentArgs) toSelfAssessment() (*repository.SelfAssessment, error) {
+	if s.Confidence == nil {
+		return nil, fmt.Errorf("self_assessment.confidence is required")
+	}
+	return &repository.SelfAssessment{
+		Confidence:    *s.Confidence,
+		KnownGaps:     s.KnownGaps,
+		UntestedAreas: s.UntestedAreas,
+	}, nil
+}
+
+// requestAssistanceArgs holds arguments for request_assistance tool.
+type requestAssistanceArgs struct {
+	Reason        string `json:"reason"`
+	BlockingIssue string `json:"blocking_issue,omitempty"`
+	NeededFrom    string `json:"needed_from,omitempty"`
+}
+
+// proposeSubtasksArgs holds arguments for propose_subtasks tool.
+type proposeSubtasksArgs struct {
+	ParentID string                    `json:"parent_id,omitempty"`
+	Reason   string                    `json:"reason,omitempty"`
+	Subtasks []command.ProposedSubtask `json:"subtasks"`
 }
 
 // reportReviewVerdictArgs holds arguments for report_review_verdict tool.
 type reportReviewVerdictArgs struct {
-	Verdict  string `json:"verdict"`
-	Comments string `json:"comments,omitempty"`
+	Verdict  string              `json:"verdict"`
+	Comments string              `json:"comments,omitempty"`
+	Findings []reviewFindingArgs `json:"findings,omitempty"`
+	Scores   map[string]int      `json:"scores,omitempty"`
+}
+
+// reviewFindingArgs holds a single structured finding for a DENIED verdict.
+type reviewFindingArgs struct {
+	File        string `json:"file"`
+	Location    string `json:"location,omitempty"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+}
+
+// toReviewFindings converts the MCP args into repository entities numbered from 1.
+func toReviewFindings(args []reviewFindingArgs) []repository.ReviewFinding {
+	if len(args) == 0 {
+		return nil
+	}
+	findings := make([]repository.ReviewFinding, len(args))
+	for i, f := range args {
+		findings[i] = repository.ReviewFinding{
+			ID:          i + 1,
+			File:        f.File,
+			Location:    f.Location,
+			Severity:    repository.FindingSeverity(strings.ToLower(f.Severity)),
+			Description: f.Description,
+		}
+	}
+	return findings
 }
 
 // spawnWorkerArgs holds arguments for spawn_worker tool.
 type spawnWorkerArgs struct {
 	AgentType string `json:"agent_type,omitempty"`
+	Backend   string `json:"backend,omitempty"`
 }
 
 // signalWorkflowCompleteArgs holds arguments for signal_workflow_complete tool.
@@ -213,6 +344,9 @@ func (a *V2Adapter) HandleSpawnProcess(ctx context.Context, args json.RawMessage
 
 	// Build command options
 	opts := []command.SpawnProcessOption{command.WithAgentType(agentType)}
+	if parsed.Backend != "" {
+		opts = append(opts, command.WithBackend(client.ClientType(parsed.Backend)))
+	}
 
 	// Get workflow config if provider is configured
 	if a.workflowProvider != nil {
@@ -237,6 +371,9 @@ func (a *V2Adapter) HandleSpawnProcess(ctx context.Context, args json.RawMessage
 
 	// Extract ProcessID from result
 	processID := extractProcessID(result.Data)
+	if parsed.Backend != "" {
+		processID = fmt.Sprintf("%s (backend: %s)", processID, parsed.Backend)
+	}
 	return mcptypes.SuccessResult(fmt.Sprintf("Process %s spawned the process will notify you when they are ready. DO NOT assign work until they have sent you a ready signal", processID)), nil
 }
 
@@ -272,6 +409,7 @@ func (a *V2Adapter) HandleReplaceProcess(ctx context.Context, args json.RawMessa
 	}
 
 	cmd := command.NewReplaceProcessCommand(command.SourceMCPTool, parsed.WorkerID, parsed.Reason)
+	cmd.SetIdempotencyKey(parsed.IdempotencyKey)
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("replace_process command validation failed: %w", err)
 	}
@@ -326,6 +464,7 @@ type workerStateInfo struct {
 	Status       string `json:"status"`
 	Phase        string `json:"phase"`
 	AgentType    string `json:"agent_type,omitempty"`
+	Backend      string `json:"backend,omitempty"`
 	TaskID       string `json:"task_id,omitempty"`
 	SessionID    string `json:"session_id,omitempty"`
 	QueueSize    int    `json:"queue_size,omitempty"`
@@ -337,6 +476,21 @@ type workerStateInfo struct {
 	TaskStatus  string `json:"task_status,omitempty"`
 	TaskStarted string `json:"task_started,omitempty"`
 	ReviewerID  string `json:"reviewer_id,omitempty"`
+	// Overlaps are the files this worker changed that other implementers changed too
+	Overlaps []processor.FileOverlap `json:"overlaps,omitempty"`
+	// Assistance is the worker's open request_assistance call
+	Assistance *assistanceInfo `json:"assistance,omitempty"`
+}
+
+// assistanceInfo describes a blocked worker's assistance request in the query_worker_state response.
+type assistanceInfo struct {
+	WorkerID      string `json:"worker_id"`
+	TaskID        string `json:"task_id,omitempty"`
+	Reason        string `json:"reason"`
+	BlockingIssue string `json:"blocking_issue,omitempty"`
+	NeededFrom    string `json:"needed_from"`
+	ResumePhase   string `json:"resume_phase"`
+	RequestedAt   string `json:"requested_at"`
 }
 
 // taskAssignmentInfo represents a task assignment in the query_worker_state response.
@@ -347,15 +501,21 @@ type taskAssignmentInfo struct {
 	Status          string `json:"status"`
 	StartedAt       string `json:"started_at,omitempty"`
 	ReviewStartedAt string `json:"review_started_at,omitempty"`
+	// ReviewScores are the rubric scores from the most recent review
+	ReviewScores repository.ReviewScores `json:"review_scores,omitempty"`
 }
 
 // workerStateResponse is the response format for query_worker_state tool.
 type workerStateResponse struct {
+	// BlockedWorkers lists the open assistance requests first so they are not missed
+	BlockedWorkers []assistanceInfo              `json:"blocked_workers,omitempty"`
 	Workers        []workerStateInfo             `json:"workers"`
 	ReadyWorkers   []string                      `json:"ready_workers"`
 	RetiredWorkers []string                      `json:"retired_workers"`
 	FailedWorkers  []string                      `json:"failed_workers"`
 	Tasks          map[string]taskAssignmentInfo `json:"tasks"`
+	// Overlaps are the files changed by more than one implementer (worker worktrees only)
+	Overlaps []processor.FileOverlap `json:"overlaps,omitempty"`
 }
 
 // HandleQueryWorkerState handles the query_worker_state MCP tool call.
@@ -369,6 +529,7 @@ type workerStateResponse struct {
 // Returns a response with:
 //   - workers: array of worker state info
 //   - ready_workers: array of worker IDs that are Ready status with no assigned task
+//   - blocked_workers: open assistance requests of blocked workers (omitted if none)
 func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
 	if a.processRepo == nil {
 		return nil, fmt.Errorf("process repository not configured for read-only operations")
@@ -410,10 +571,11 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 		allTasks := a.taskRepo.All()
 		for _, task := range allTasks {
 			info := taskAssignmentInfo{
-				TaskID:      task.TaskID,
-				Implementer: task.Implementer,
-				Reviewer:    task.Reviewer,
-				Status:      string(task.Status),
+				TaskID:       task.TaskID,
+				Implementer:  task.Implementer,
+				Reviewer:     task.Reviewer,
+				Status:       string(task.Status),
+				ReviewScores: task.ReviewScores,
 			}
 			if !task.StartedAt.IsZero() {
 				info.StartedAt = task.StartedAt.Format("2006-01-02T15:04:05Z07:00")
@@ -452,6 +614,7 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 			Status:    processStatusToWorkerStatus(p.Status),
 			Phase:     phase,
 			AgentType: p.AgentType.String(),
+			Backend:   p.Backend,
 			TaskID:    p.TaskID,
 			SessionID: p.SessionID,
 			QueueSize: queueSize,
@@ -480,6 +643,23 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 			}
 		}
 
+		if a.conflicts != nil {
+			info.Overlaps = a.conflicts.Overlaps(p.ID)
+		}
+
+		if p.Assistance != nil {
+			info.Assistance = &assistanceInfo{
+				WorkerID:      p.ID,
+				TaskID:        p.TaskID,
+				Reason:        p.Assistance.Reason,
+				BlockingIssue: p.Assistance.BlockingIssue,
+				NeededFrom:    p.Assistance.NeededFrom,
+				ResumePhase:   string(p.Assistance.ResumePhase),
+				RequestedAt:   p.Assistance.RequestedAt.Format("2006-01-02T15:04:05Z07:00"),
+			}
+			response.BlockedWorkers = append(response.BlockedWorkers, *info.Assistance)
+		}
+
 		response.Workers = append(response.Workers, info)
 
 		// Track ready workers (Ready status with no task)
@@ -488,6 +668,10 @@ func (a *V2Adapter) HandleQueryWorkerState(_ context.Context, args json.RawMessa
 		}
 	}
 
+	if a.conflicts != nil {
+		response.Overlaps = a.conflicts.Overlaps("")
+	}
+
 	jsonBytes, err := json.MarshalIndent(response, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal worker state: %w", err)
@@ -524,6 +708,90 @@ func (a *V2Adapter) HandleSendToWorker(ctx context.Context, args json.RawMessage
 	return mcptypes.SuccessResult(fmt.Sprintf("Message sent to worker %s", parsed.WorkerID)), nil
 }
 
+// Broadcast delivery statuses reported by broadcast_to_workers.
+const (
+	broadcastStatusSent   = "sent"
+	broadcastStatusQueued = "queued"
+	broadcastStatusFailed = "failed"
+)
+
+// broadcastDeliveryResponse is one worker's entry in the broadcast_to_workers result.
+type broadcastDeliveryResponse struct {
+	WorkerID  string `json:"worker_id"`
+	Status    string `json:"status"`
+	QueueSize int    `json:"queue_size,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// broadcastToWorkersResponse is the structured result of broadcast_to_workers.
+type broadcastToWorkersResponse struct {
+	Deliveries []broadcastDeliveryResponse `json:"deliveries"`
+	Sent       int                         `json:"sent"`
+	Failed     int                         `json:"failed"`
+}
+
+// HandleBroadcastToWorkers handles the broadcast_to_workers MCP tool call.
+// One broadcast command fans the message out to every matching worker, so
+// the result lists how it reached each of them.
+func (a *V2Adapter) HandleBroadcastToWorkers(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed broadcastToWorkersArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewBroadcastCommand(command.SourceMCPTool, parsed.Message, parsed.ExcludeWorkers)
+	cmd.Filter = command.BroadcastFilter{
+		Phase:     events.ProcessPhase(parsed.Phase),
+		AgentType: roles.AgentType(parsed.AgentType),
+		TaskID:    parsed.TaskID,
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("broadcast_to_workers command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("broadcast_to_workers command failed: %w", err)
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Error.Error()), nil
+	}
+
+	response := broadcastToWorkersResponse{Deliveries: []broadcastDeliveryResponse{}}
+	if v, ok := result.Data.(deliveriesExtractor); ok {
+		for _, d := range v.GetDeliveries() {
+			entry := broadcastDeliveryResponse{WorkerID: d.WorkerID, Status: broadcastStatusSent, QueueSize: d.QueueSize}
+			switch {
+			case d.Err != nil:
+				entry.Status = broadcastStatusFailed
+				entry.Error = d.Err.Error()
+				response.Failed++
+			case d.Queued:
+				entry.Status = broadcastStatusQueued
+				response.Sent++
+			default:
+				response.Sent++
+			}
+			response.Deliveries = append(response.Deliveries, entry)
+		}
+	}
+
+	if len(response.Deliveries) == 0 {
+		return mcptypes.StructuredResult("No active workers matched the broadcast", response), nil
+	}
+	parts := make([]string, 0, len(response.Deliveries))
+	for _, d := range response.Deliveries {
+		part := fmt.Sprintf("%s (%s)", d.WorkerID, d.Status)
+		if d.Error != "" {
+			part = fmt.Sprintf("%s (%s: %s)", d.WorkerID, d.Status, d.Error)
+		}
+		parts = append(parts, part)
+	}
+	msg := fmt.Sprintf("Broadcast to %d worker(s): %s", len(response.Deliveries), strings.Join(parts, ", "))
+	return mcptypes.StructuredResult(msg, response), nil
+}
+
 // ===========================================================================
 // Task Assignment Handlers (Batch 3-4)
 // ===========================================================================
@@ -536,6 +804,7 @@ func (a *V2Adapter) HandleAssignTask(ctx context.Context, args json.RawMessage)
 	}
 
 	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, parsed.WorkerID, parsed.TaskID, parsed.Summary, parsed.ThreadID)
+	cmd.SetIdempotencyKey(parsed.IdempotencyKey)
 	err := cmd.Validate()
 	if err != nil {
 		return nil, fmt.Errorf("assign_task command validation failed: %w", err)
@@ -560,6 +829,45 @@ func (a *V2Adapter) HandleAssignTaskReview(ctx context.Context, args json.RawMes
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
 
+	return a.assignReview(ctx, "assign_task_review", parsed)
+}
+
+// HandleRotateReviewer handles the rotate_reviewer MCP tool call.
+// It picks the reviewer with repository.NextReviewer among the ready workers,
+// then assigns the review like assign_task_review.
+func (a *V2Adapter) HandleRotateReviewer(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.processRepo == nil || a.reviewHistory == nil {
+		return nil, fmt.Errorf("process and review history repositories not configured for rotate_reviewer")
+	}
+
+	var parsed rotateReviewerArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	ready := a.processRepo.ReadyWorkers()
+	candidates := make([]string, 0, len(ready))
+	for _, p := range ready {
+		candidates = append(candidates, p.ID)
+	}
+	slices.Sort(candidates)
+
+	reviewerID, ok := repository.NextReviewer(candidates, parsed.TaskID, parsed.ImplementerID, a.reviewHistory.All())
+	if !ok {
+		return mcptypes.ErrorResult(fmt.Sprintf("no ready worker available to review task %s; spawn a worker or wait for one to finish", parsed.TaskID)), nil
+	}
+
+	return a.assignReview(ctx, "rotate_reviewer", assignTaskReviewArgs{
+		ReviewerID:    reviewerID,
+		TaskID:        parsed.TaskID,
+		ImplementerID: parsed.ImplementerID,
+		Summary:       parsed.Summary,
+		ReviewType:    parsed.ReviewType,
+	})
+}
+
+// assignReview submits an AssignReviewCommand on behalf of the named tool.
+func (a *V2Adapter) assignReview(ctx context.Context, tool string, parsed assignTaskReviewArgs) (*mcptypes.ToolCallResult, error) {
 	// Parse review type with default to complex
 	reviewType := command.ReviewTypeComplex
 	if parsed.ReviewType == string(command.ReviewTypeSimple) {
@@ -567,13 +875,14 @@ func (a *V2Adapter) HandleAssignTaskReview(ctx context.Context, args json.RawMes
 	}
 
 	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, parsed.ReviewerID, parsed.TaskID, parsed.ImplementerID, reviewType)
+	cmd.SetIdempotencyKey(parsed.IdempotencyKey)
 	if err := cmd.Validate(); err != nil {
-		return nil, fmt.Errorf("assign_task_review command validation failed: %w", err)
+		return nil, fmt.Errorf("%s command validation failed: %w", tool, err)
 	}
 
 	result, err := a.submitWithTimeout(ctx, cmd)
 	if err != nil {
-		return nil, fmt.Errorf("assign_task_review command failed: %w", err)
+		return nil, fmt.Errorf("%s command failed: %w", tool, err)
 	}
 
 	if !result.Success {
@@ -583,6 +892,151 @@ func (a *V2Adapter) HandleAssignTaskReview(ctx context.Context, args json.RawMes
 	return mcptypes.SuccessResult(fmt.Sprintf("Review of task %s assigned to worker %s", parsed.TaskID, parsed.ReviewerID)), nil
 }
 
+// reviewPairingInfo represents a pairing in the query_review_history response.
+type reviewPairingInfo struct {
+	TaskID        string `json:"task_id"`
+	ImplementerID string `json:"implementer_id"`
+	ReviewerID    string `json:"reviewer_id"`
+	AssignedAt    string `json:"assigned_at"`
+}
+
+// reviewHistoryResponse is the response format for query_review_history tool.
+type reviewHistoryResponse struct {
+	Pairings   []reviewPairingInfo `json:"pairings"`
+	ReviewLoad map[string]int      `json:"review_load"`
+}
+
+// HandleQueryReviewHistory handles the query_review_history MCP tool call.
+// This is a read-only operation that reads directly from the review history.
+// With worker_id, only pairings where the worker implemented or reviewed are listed;
+// review_load always covers all reviewers.
+func (a *V2Adapter) HandleQueryReviewHistory(_ context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	if a.reviewHistory == nil {
+		return nil, fmt.Errorf("review history repository not configured for read-only operations")
+	}
+
+	var parsed queryReviewHistoryArgs
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &parsed); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	history := a.reviewHistory.All()
+	response := reviewHistoryResponse{
+		Pairings:   make([]reviewPairingInfo, 0, len(history)),
+		ReviewLoad: repository.ReviewLoad(history),
+	}
+	for _, p := range history {
+		if parsed.WorkerID != "" && p.ImplementerID != parsed.WorkerID && p.ReviewerID != parsed.WorkerID {
+			continue
+		}
+		response.Pairings = append(response.Pairings, reviewPairingInfo{
+			TaskID:        p.TaskID,
+			ImplementerID: p.ImplementerID,
+			ReviewerID:    p.ReviewerID,
+			AssignedAt:    p.AssignedAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal review history: %w", err)
+	}
+
+	return mcptypes.StructuredResult(string(jsonBytes), response), nil
+}
+
+// deadLetterInfo represents a failed command in the list_dead_letters response.
+type deadLetterInfo struct {
+	CommandID   string `json:"command_id"`
+	CommandType string `json:"command_type"`
+	Source      string `json:"source,omitempty"`
+	Command     string `json:"command,omitempty"`
+	Error       string `json:"error"`
+	Attempts    int    `json:"attempts"`
+	FirstFailed string `json:"first_failed"`
+	LastFailed  string `json:"last_failed"`
+}
+
+// deadLettersResponse is the response format for list_dead_letters tool.
+type deadLettersResponse struct {
+	DeadLetters []deadLetterInfo `json:"dead_letters"`
+}
+
+// HandleListDeadLetters handles the list_dead_letters MCP tool call.
+// This is a read-only operation listing failed commands, oldest first,
+// optionally filtered by command type.
+func (a *V2Adapter) HandleListDeadLetters(_ context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed listDeadLettersArgs
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &parsed); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %w", err)
+		}
+	}
+
+	letters := a.processor.DeadLetters()
+	response := deadLettersResponse{DeadLetters: make([]deadLetterInfo, 0, len(letters))}
+	for _, l := range letters {
+		if parsed.CommandType != "" && l.CommandType != parsed.CommandType {
+			continue
+		}
+		response.DeadLetters = append(response.DeadLetters, deadLetterInfo{
+			CommandID:   l.CommandID,
+			CommandType: l.CommandType,
+			Source:      l.Source,
+			Command:     l.Summary,
+			Error:       l.Error,
+			Attempts:    l.Attempts,
+			FirstFailed: l.FirstFailed.Format("2006-01-02T15:04:05Z07:00"),
+			LastFailed:  l.LastFailed.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dead letters: %w", err)
+	}
+
+	return mcptypes.StructuredResult(string(jsonBytes), response), nil
+}
+
+// HandleRetryDeadLetter handles the retry_dead_letter MCP tool call.
+// It resubmits a failed command, or drops it with discard set.
+func (a *V2Adapter) HandleRetryDeadLetter(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed retryDeadLetterArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	if parsed.CommandID == "" {
+		return nil, fmt.Errorf("command_id is required")
+	}
+
+	if parsed.Discard {
+		if err := a.processor.DiscardDeadLetter(parsed.CommandID); err != nil {
+			return mcptypes.ErrorResult(fmt.Sprintf("cannot discard %s: %v", parsed.CommandID, err)), nil
+		}
+		return mcptypes.SuccessResult(fmt.Sprintf("Discarded failed command %s", parsed.CommandID)), nil
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	result, err := a.processor.RetryDeadLetter(timeoutCtx, parsed.CommandID)
+	if err != nil {
+		if errors.Is(err, repository.ErrDeadLetterNotFound) || errors.Is(err, processor.ErrDeadLettersDisabled) {
+			return mcptypes.ErrorResult(fmt.Sprintf("cannot retry %s: %v", parsed.CommandID, err)), nil
+		}
+		return nil, fmt.Errorf("retry_dead_letter command failed: %w", err)
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(fmt.Sprintf("Retry of %s failed again: %v", parsed.CommandID, result.Error)), nil
+	}
+
+	return mcptypes.SuccessResult(fmt.Sprintf("Retried command %s successfully", parsed.CommandID)), nil
+}
+
 // HandleAssignReviewFeedback handles the assign_review_feedback MCP tool call.
 // This transitions an implementer to the AddressingFeedback phase with a message.
 func (a *V2Adapter) HandleAssignReviewFeedback(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
@@ -653,6 +1107,16 @@ func (a *V2Adapter) HandleReportImplementationComplete(ctx context.Context, args
 	}
 
 	cmd := command.NewReportCompleteCommand(command.SourceMCPTool, workerID, parsed.Summary)
+	if parsed.SelfAssessment != nil {
+		sa, err := parsed.SelfAssessment.toSelfAssessment()
+		if err != nil {
+			return nil, fmt.Errorf("report_implementation_complete command validation failed: %w", err)
+		}
+		cmd.SelfAssessment = sa
+	}
+	for _, r := range parsed.ResolvedFindings {
+		cmd.ResolvedFindings = append(cmd.ResolvedFindings, command.FindingResolution{FindingID: r.FindingID, Resolution: r.Resolution})
+	}
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("report_implementation_complete command validation failed: %w", err)
 	}
@@ -685,13 +1149,121 @@ func (a *V2Adapter) HandleReportImplementationComplete(ctx context.Context, args
 	}, nil
 }
 
+// RequestAssistanceResult contains the result of request_assistance.
+// This allows the MCP layer to access the task's ThreadID for Fabric replies.
+type RequestAssistanceResult struct {
+	Success  bool
+	ThreadID string // Fabric thread ID for the task conversation (empty without a task)
+	Message  string
+}
+
+// HandleRequestAssistance handles the request_assistance MCP tool call.
+// Blocks the worker and notifies the coordinator; returns the task's ThreadID for Fabric integration.
+func (a *V2Adapter) HandleRequestAssistance(ctx context.Context, args json.RawMessage, workerID string) (*RequestAssistanceResult, error) {
+	var parsed requestAssistanceArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewRequestAssistanceCommand(command.SourceMCPTool, workerID, parsed.Reason, parsed.BlockingIssue, parsed.NeededFrom)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("request_assistance command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("request_assistance command failed: %w", err)
+	}
+
+	if !result.Success {
+		return &RequestAssistanceResult{
+			Success: false,
+			Message: result.Error.Error(),
+		}, nil
+	}
+
+	var threadID string
+	if a.taskRepo != nil {
+		task, err := a.taskRepo.GetByWorker(workerID)
+		if err == nil && task != nil {
+			threadID = task.ThreadID
+		}
+	}
+
+	return &RequestAssistanceResult{
+		Success:  true,
+		ThreadID: threadID,
+		Message: fmt.Sprintf("Assistance requested from %s. End your turn now; you resume your work when the answer is delivered to you.",
+			cmd.NeededFrom),
+	}, nil
+}
+
+// ProposeSubtasksResult contains the result of propose_subtasks.
+// This allows the MCP layer to access the task's ThreadID for Fabric replies.
+type ProposeSubtasksResult struct {
+	Success    bool
+	ThreadID   string   // Fabric thread ID for the task conversation (empty without a task)
+	SubtaskIDs []string // bd IDs of the created subtasks, in proposal order
+	Message    string
+}
+
+// HandleProposeSubtasks handles the propose_subtasks MCP tool call.
+// Creates the subtasks in bd and notifies the coordinator; returns the task's ThreadID for Fabric integration.
+func (a *V2Adapter) HandleProposeSubtasks(ctx context.Context, args json.RawMessage, workerID string) (*ProposeSubtasksResult, error) {
+	var parsed proposeSubtasksArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewProposeSubtasksCommand(command.SourceMCPTool, workerID, parsed.ParentID, parsed.Reason, parsed.Subtasks)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("propose_subtasks command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("propose_subtasks command failed: %w", err)
+	}
+
+	if !result.Success {
+		return &ProposeSubtasksResult{
+			Success: false,
+			Message: result.Error.Error(),
+		}, nil
+	}
+
+	var subtaskIDs []string
+	if v, ok := result.Data.(subtaskIDsExtractor); ok {
+		subtaskIDs = v.GetSubtaskIDs()
+	}
+
+	var threadID string
+	if a.taskRepo != nil {
+		task, err := a.taskRepo.GetByWorker(workerID)
+		if err == nil && task != nil {
+			threadID = task.ThreadID
+		}
+	}
+
+	return &ProposeSubtasksResult{
+		Success:    true,
+		ThreadID:   threadID,
+		SubtaskIDs: subtaskIDs,
+		Message: fmt.Sprintf("Created %d subtask(s): %s. The coordinator will assign them; "+
+			"continue with the part of your task they do not cover, or report_implementation_complete if nothing is left.",
+			len(subtaskIDs), strings.Join(subtaskIDs, ", ")),
+	}, nil
+}
+
 // ReportReviewVerdictResult contains the result of report_review_verdict.
 // This allows the MCP layer to access the task's ThreadID for Fabric replies.
 type ReportReviewVerdictResult struct {
 	Success  bool
-	ThreadID string // Fabric thread ID for the task conversation
-	Verdict  string // "APPROVED" or "DENIED"
-	Comments string // Review comments
+	ThreadID string   // Fabric thread ID for the task conversation
+	Verdict  string   // "APPROVED" or "DENIED"
+	Comments string   // Review comments
+	Findings []string // Checklist lines for DENIED findings
+	Scores   string   // Rendered rubric scores (empty if none were given)
 	Message  string
 }
 
@@ -719,6 +1291,13 @@ func (a *V2Adapter) HandleReportReviewVerdict(ctx context.Context, args json.Raw
 	}
 
 	cmd := command.NewReportVerdictCommand(command.SourceMCPTool, workerID, verdict, parsed.Comments)
+	cmd.Findings = toReviewFindings(parsed.Findings)
+	if parsed.Scores != nil {
+		cmd.Scores = make(repository.ReviewScores, len(parsed.Scores))
+		for dim, score := range parsed.Scores {
+			cmd.Scores[repository.ReviewDimension(dim)] = score
+		}
+	}
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("report_review_verdict command validation failed: %w", err)
 	}
@@ -749,6 +1328,8 @@ func (a *V2Adapter) HandleReportReviewVerdict(ctx context.Context, args json.Raw
 		ThreadID: threadID,
 		Verdict:  parsed.Verdict,
 		Comments: parsed.Comments,
+		Findings: repository.FindingsChecklist(cmd.Findings),
+		Scores:   cmd.Scores.String(),
 		Message:  fmt.Sprintf("Review verdict %s submitted", parsed.Verdict),
 	}, nil
 }
@@ -768,6 +1349,32 @@ type markTaskFailedArgs struct {
 	Reason string `json:"reason"`
 }
 
+// TaskReviewScores returns the rubric scores from the most recent review of
+// taskID, or nil if the task is unknown or was not scored.
+func (a *V2Adapter) TaskReviewScores(taskID string) repository.ReviewScores {
+	if a.taskRepo == nil {
+		return nil
+	}
+	task, err := a.taskRepo.Get(taskID)
+	if err != nil {
+		return nil
+	}
+	return task.ReviewScores
+}
+
+// WorkerTaskID returns the ID of the task workerID is implementing or
+// reviewing, or "" if it has none.
+func (a *V2Adapter) WorkerTaskID(workerID string) string {
+	if a.taskRepo == nil {
+		return ""
+	}
+	task, err := a.taskRepo.GetByWorker(workerID)
+	if err != nil || task == nil {
+		return ""
+	}
+	return task.TaskID
+}
+
 // HandleMarkTaskComplete handles the mark_task_complete MCP tool call.
 // Routes through the v2 command processor using CmdMarkTaskComplete.
 func (a *V2Adapter) HandleMarkTaskComplete(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
@@ -844,25 +1451,15 @@ func (a *V2Adapter) HandleStopProcess(processID string, force bool, reason strin
 // Aggregation Handlers
 // ===========================================================================
 
-// generateAccountabilitySummaryArgs holds arguments for generate_accountability_summary tool.
-type generateAccountabilitySummaryArgs struct {
-	WorkerID string `json:"worker_id"`
-}
-
 // HandleGenerateAccountabilitySummary handles the generate_accountability_summary MCP tool call.
-// It sends the AggregationWorkerPrompt to the specified worker.
+// It aggregates the worker summaries into the session report and reports its totals.
 // Uses the adapter's stored sessionDir for centralized session storage support.
-func (a *V2Adapter) HandleGenerateAccountabilitySummary(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
-	var parsed generateAccountabilitySummaryArgs
-	if err := json.Unmarshal(args, &parsed); err != nil {
-		return nil, fmt.Errorf("invalid arguments: %w", err)
-	}
-
+func (a *V2Adapter) HandleGenerateAccountabilitySummary(ctx context.Context, _ json.RawMessage) (*mcptypes.ToolCallResult, error) {
 	if a.sessionDir == "" {
 		return nil, fmt.Errorf("session directory not configured on adapter")
 	}
 
-	cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, parsed.WorkerID, a.sessionDir)
+	cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, a.sessionDir)
 	if err := cmd.Validate(); err != nil {
 		return nil, fmt.Errorf("generate_accountability_summary command validation failed: %w", err)
 	}
@@ -876,7 +1473,19 @@ func (a *V2Adapter) HandleGenerateAccountabilitySummary(ctx context.Context, arg
 		return mcptypes.ErrorResult(result.Error.Error()), nil
 	}
 
-	return mcptypes.SuccessResult(fmt.Sprintf("Accountability summary task assigned to worker %s", parsed.WorkerID)), nil
+	msg := fmt.Sprintf("Session report written to %s (JSON: %s)",
+		filepath.Join(a.sessionDir, accountability.ReportMarkdownFile), filepath.Join(a.sessionDir, accountability.ReportJSONFile))
+	if v, ok := result.Data.(sessionReportExtractor); ok && v.GetReport() != nil {
+		report := v.GetReport()
+		metrics := report.Metrics
+		msg += fmt.Sprintf("\n%d workers, %d tasks, %d commits, %d issues closed, %d issues discovered",
+			metrics.Workers, metrics.Tasks, metrics.Commits, metrics.IssuesClosed, metrics.IssuesDiscovered)
+		if skipped := len(report.Skipped); skipped > 0 {
+			msg += fmt.Sprintf("\n%d summaries could not be parsed (listed in the report)", skipped)
+		}
+	}
+
+	return mcptypes.SuccessResult(msg), nil
 }
 
 // ===========================================================================
@@ -977,6 +1586,104 @@ type notifyUserArgs struct {
 	TaskID  string `json:"task_id,omitempty"`
 }
 
+// HandleAdvancePhase handles the advance_phase MCP tool call.
+// Moves the workflow DAG to the next phase and describes what the phase allows.
+// Routes through the v2 command processor using CmdAdvancePhase.
+func (a *V2Adapter) HandleAdvancePhase(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed advancePhaseArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewAdvancePhaseCommand(command.SourceMCPTool, parsed.Phase, parsed.Reason)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("advance_phase command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("advance_phase command failed: %w", err)
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Error.Error()), nil
+	}
+
+	msg := fmt.Sprintf("Workflow moved to phase %s", parsed.Phase)
+	if v, ok := result.Data.(phaseExtractor); ok {
+		msg = "Workflow moved to " + describePhase(v.GetPhase())
+	}
+
+	return mcptypes.SuccessResult(msg), nil
+}
+
+// describePhase renders what a workflow DAG phase allows for the coordinator.
+func describePhase(p dag.Phase) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "phase %s", p.ID)
+	if p.Description != "" {
+		fmt.Fprintf(&b, ": %s", p.Description)
+	}
+	if len(p.Roles) > 0 {
+		fmt.Fprintf(&b, "\nRoles: %s (and generic workers)", strings.Join(p.Roles, ", "))
+	}
+	if len(p.Actions) > 0 {
+		fmt.Fprintf(&b, "\nAllowed actions: %s", strings.Join(p.Actions, ", "))
+	}
+	if len(p.Next) > 0 {
+		fmt.Fprintf(&b, "\nNext: %s", strings.Join(p.Targets(), ", "))
+	} else {
+		b.WriteString("\nThis is the last phase.")
+	}
+	if p.Checkpoint {
+		b.WriteString("\nThis is a human checkpoint: the user has been notified. Wait for their approval before continuing.")
+	}
+	return b.String()
+}
+
+// advancePhaseArgs represents arguments for the advance_phase MCP tool.
+type advancePhaseArgs struct {
+	Phase  string `json:"phase"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// HandleEmergencyStop handles the emergency_stop MCP tool call.
+// Halts all workers immediately and broadcasts a HALT notice to every fabric channel.
+// Only registered on the coordinator server; workers cannot issue an emergency stop.
+func (a *V2Adapter) HandleEmergencyStop(ctx context.Context, args json.RawMessage) (*mcptypes.ToolCallResult, error) {
+	var parsed emergencyStopArgs
+	if err := json.Unmarshal(args, &parsed); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	cmd := command.NewEmergencyStopCommand(command.SourceMCPTool, repository.CoordinatorID, parsed.Reason)
+	if err := cmd.Validate(); err != nil {
+		return nil, fmt.Errorf("emergency_stop command validation failed: %w", err)
+	}
+
+	result, err := a.submitWithTimeout(ctx, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("emergency_stop command failed: %w", err)
+	}
+
+	if !result.Success {
+		return mcptypes.ErrorResult(result.Error.Error()), nil
+	}
+
+	msg := "Emergency stop issued. All workers are halted until the user resumes them."
+	if v, ok := result.Data.(haltedWorkersExtractor); ok && len(v.GetHaltedWorkers()) > 0 {
+		msg = fmt.Sprintf("Emergency stop issued. Halted %s. Workers stay paused until the user resumes them.",
+			strings.Join(v.GetHaltedWorkers(), ", "))
+	}
+
+	return mcptypes.SuccessResult(msg), nil
+}
+
+// emergencyStopArgs represents arguments for the emergency_stop MCP tool.
+type emergencyStopArgs struct {
+	Reason string `json:"reason"`
+}
+
 // ===========================================================================
 // Helper Methods
 // ===========================================================================
@@ -1005,6 +1712,31 @@ type processIDExtractor interface {
 	GetProcessID() string
 }
 
+// sessionReportExtractor is an interface for accountability aggregation results.
+type sessionReportExtractor interface {
+	GetReport() *accountability.Report
+}
+
+// phaseExtractor is an interface for advance_phase results that report the entered phase.
+type phaseExtractor interface {
+	GetPhase() dag.Phase
+}
+
+// subtaskIDsExtractor is an interface for propose_subtasks results that report created subtasks.
+type subtaskIDsExtractor interface {
+	GetSubtaskIDs() []string
+}
+
+// deliveriesExtractor is an interface for broadcast results that report per-worker deliveries.
+type deliveriesExtractor interface {
+	GetDeliveries() []command.BroadcastDelivery
+}
+
+// haltedWorkersExtractor is an interface for emergency stop results that report halted workers.
+type haltedWorkersExtractor interface {
+	GetHaltedWorkers() []string
+}
+
 // extractProcessID extracts a process ID from command result data.
 // Supports SpawnProcessResult structs and raw string values.
 func extractProcessID(data any) string {
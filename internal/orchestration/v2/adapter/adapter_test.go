@@ -12,12 +12,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
 )
 
 // ===========================================================================
@@ -96,11 +99,15 @@ func testAdapter(t *testing.T, opts ...Option) (*V2Adapter, *mockHandler, func()
 		command.CmdReportComplete,
 		command.CmdReportVerdict,
 		command.CmdTransitionPhase,
+		command.CmdRequestAssistance,
+		command.CmdProposeSubtasks,
 		command.CmdMarkTaskComplete,
 		command.CmdMarkTaskFailed,
 		command.CmdStopProcess,
 		command.CmdSignalWorkflowComplete,
 		command.CmdNotifyUser,
+		command.CmdEmergencyStop,
+		command.CmdAdvancePhase,
 	} {
 		p.RegisterHandler(cmdType, handler)
 	}
@@ -362,6 +369,85 @@ func TestHandleSendToWorker(t *testing.T) {
 	})
 }
 
+// broadcastResult stands in for the handler's broadcast result.
+type broadcastResult struct {
+	deliveries []command.BroadcastDelivery
+}
+
+func (r broadcastResult) GetDeliveries() []command.BroadcastDelivery {
+	return r.deliveries
+}
+
+func TestHandleBroadcastToWorkers(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: broadcastResult{deliveries: []command.BroadcastDelivery{
+			{WorkerID: "worker-1", QueueSize: 1},
+			{WorkerID: "worker-2", Queued: true, QueueSize: 2},
+			{WorkerID: "worker-3", Err: errors.New("message queue is full")},
+		}}}
+
+		args := toJSON(t, map[string]any{
+			"message":         "Rebase on main",
+			"phase":           "implementing",
+			"agent_type":      "implementer",
+			"task_id":         "perles-abc.1",
+			"exclude_workers": []string{"worker-9"},
+		})
+		result, err := adapter.HandleBroadcastToWorkers(context.Background(), args)
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		require.Equal(t, "Broadcast to 3 worker(s): worker-1 (sent), worker-2 (queued), worker-3 (failed: message queue is full)",
+			result.Content[0].Text)
+		require.Equal(t, broadcastToWorkersResponse{
+			Deliveries: []broadcastDeliveryResponse{
+				{WorkerID: "worker-1", Status: "sent", QueueSize: 1},
+				{WorkerID: "worker-2", Status: "queued", QueueSize: 2},
+				{WorkerID: "worker-3", Status: "failed", Error: "message queue is full"},
+			},
+			Sent:   2,
+			Failed: 1,
+		}, result.StructuredContent)
+
+		// Verify command
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		broadcastCmd, ok := cmds[0].(*command.BroadcastCommand)
+		require.True(t, ok)
+		assert.Equal(t, "Rebase on main", broadcastCmd.Content)
+		assert.Equal(t, []string{"worker-9"}, broadcastCmd.ExcludeWorkers)
+		assert.Equal(t, command.BroadcastFilter{
+			Phase:     events.ProcessPhaseImplementing,
+			AgentType: roles.AgentTypeImplementer,
+			TaskID:    "perles-abc.1",
+		}, broadcastCmd.Filter)
+	})
+
+	t.Run("no_matching_workers", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+		handler.returnResult = &command.CommandResult{Success: true, Data: broadcastResult{}}
+
+		result, err := adapter.HandleBroadcastToWorkers(context.Background(), toJSON(t, map[string]string{"message": "hi"}))
+
+		require.NoError(t, err)
+		require.Equal(t, "No active workers matched the broadcast", result.Content[0].Text)
+	})
+
+	t.Run("invalid_filter", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		_, err := adapter.HandleBroadcastToWorkers(context.Background(), toJSON(t, map[string]string{"message": "hi", "phase": "sleeping"}))
+		require.ErrorContains(t, err, "invalid worker phase: sleeping")
+
+		_, err = adapter.HandleBroadcastToWorkers(context.Background(), toJSON(t, map[string]string{}))
+		require.ErrorContains(t, err, "content is required")
+	})
+}
+
 // ===========================================================================
 // Task Assignment Tests (Batch 3-4)
 // ===========================================================================
@@ -424,6 +510,28 @@ func TestHandleAssignTask(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "task_id is required")
 	})
+
+	t.Run("retry_with_idempotency_key", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"worker_id":       "worker-123",
+			"task_id":         "perles-abc1",
+			"idempotency_key": "assign-perles-abc1",
+		})
+
+		for range 2 {
+			result, err := adapter.HandleAssignTask(context.Background(), args)
+			require.NoError(t, err)
+			assert.False(t, result.IsError)
+		}
+
+		// The retry is answered with the original result, the command runs once
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		assert.Equal(t, "assign-perles-abc1", cmds[0].(*command.AssignTaskCommand).IdempotencyKey())
+	})
 }
 
 func TestHandleAssignTaskReview(t *testing.T) {
@@ -755,6 +863,66 @@ func TestHandleReportImplementationComplete(t *testing.T) {
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "invalid arguments")
 	})
+
+	t.Run("with_self_assessment", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"summary": "Added cache",
+			"self_assessment": map[string]any{
+				"confidence":     0.75,
+				"known_gaps":     []string{"no eviction"},
+				"untested_areas": []string{"concurrent writes"},
+			},
+		})
+
+		result, err := adapter.HandleReportImplementationComplete(context.Background(), args, "worker-456")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		reportCmd := cmds[0].(*command.ReportCompleteCommand)
+		require.NotNil(t, reportCmd.SelfAssessment)
+		assert.InDelta(t, 0.75, reportCmd.SelfAssessment.Confidence, 0.0001)
+		assert.Equal(t, []string{"no eviction"}, reportCmd.SelfAssessment.KnownGaps)
+		assert.Equal(t, []string{"concurrent writes"}, reportCmd.SelfAssessment.UntestedAreas)
+	})
+
+	t.Run("self_assessment_missing_confidence", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"summary":         "Added cache",
+			"self_assessment": map[string]any{"known_gaps": []string{"no eviction"}},
+		})
+
+		result, err := adapter.HandleReportImplementationComplete(context.Background(), args, "worker-456")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "self_assessment.confidence is required")
+		assert.Empty(t, handler.getCommands())
+	})
+
+	t.Run("self_assessment_out_of_range", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"summary":         "Added cache",
+			"self_assessment": map[string]any{"confidence": 7},
+		})
+
+		result, err := adapter.HandleReportImplementationComplete(context.Background(), args, "worker-456")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "confidence must be between 0 and 1")
+	})
 }
 
 func TestHandleReportReviewVerdict(t *testing.T) {
@@ -790,9 +958,13 @@ func TestHandleReportReviewVerdict(t *testing.T) {
 		adapter, handler, cleanup := testAdapter(t)
 		defer cleanup()
 
-		args := toJSON(t, map[string]string{
+		args := toJSON(t, map[string]any{
 			"verdict":  "DENIED",
 			"comments": "Needs more tests",
+			"findings": []map[string]string{
+				{"file": "auth.go", "location": "42", "severity": "Major", "description": "Token expiry not checked"},
+				{"file": "auth_test.go", "severity": "minor", "description": "No test for expired tokens"},
+			},
 		})
 
 		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
@@ -803,6 +975,10 @@ func TestHandleReportReviewVerdict(t *testing.T) {
 		assert.Equal(t, "DENIED", result.Verdict)
 		assert.Equal(t, "Needs more tests", result.Comments)
 		assert.Contains(t, result.Message, "DENIED")
+		assert.Equal(t, []string{
+			"- [ ] #1 [major] auth.go:42 - Token expiry not checked",
+			"- [ ] #2 [minor] auth_test.go - No test for expired tokens",
+		}, result.Findings)
 
 		// Verify command
 		cmds := handler.getCommands()
@@ -811,6 +987,66 @@ func TestHandleReportReviewVerdict(t *testing.T) {
 		require.True(t, ok)
 		assert.Equal(t, command.VerdictDenied, reportCmd.Verdict)
 		assert.Equal(t, "Needs more tests", reportCmd.Comments)
+		require.Len(t, reportCmd.Findings, 2)
+		assert.Equal(t, 2, reportCmd.Findings[1].ID)
+		assert.Equal(t, repository.SeverityMajor, reportCmd.Findings[0].Severity)
+	})
+
+	t.Run("approved_with_scores", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"verdict":  "APPROVED",
+			"comments": "LGTM",
+			"scores":   map[string]int{"correctness": 5, "tests": 4, "style": 3, "security": 4},
+		})
+
+		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Equal(t, "correctness 5/5, tests 4/5, style 3/5, security 4/5", result.Scores)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		reportCmd := cmds[0].(*command.ReportVerdictCommand)
+		assert.Equal(t, 4, reportCmd.Scores[repository.DimensionTests])
+	})
+
+	t.Run("approved_below_minimum_scores", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"verdict":  "APPROVED",
+			"comments": "LGTM",
+			"scores":   map[string]int{"correctness": 5, "tests": 1, "style": 3, "security": 4},
+		})
+
+		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "below minimum: tests (min 3)")
+		assert.Empty(t, handler.getCommands())
+	})
+
+	t.Run("denied_requires_findings", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"verdict":  "DENIED",
+			"comments": "Needs more tests",
+		})
+
+		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "DENIED verdicts require at least one finding")
+		assert.Empty(t, handler.getCommands())
 	})
 
 	t.Run("missing_verdict", func(t *testing.T) {
@@ -865,8 +1101,9 @@ func TestHandleReportReviewVerdict(t *testing.T) {
 		adapter, _, cleanup := testAdapter(t)
 		defer cleanup()
 
-		args := toJSON(t, map[string]string{
-			"verdict": "DENIED",
+		args := toJSON(t, map[string]any{
+			"verdict":  "DENIED",
+			"findings": []map[string]string{{"file": "main.go", "severity": "blocker", "description": "Does not compile"}},
 		})
 
 		result, err := adapter.HandleReportReviewVerdict(context.Background(), args, "worker-reviewer")
@@ -1244,6 +1481,97 @@ func TestAdapter_IntegrationMultipleTools(t *testing.T) {
 // Repository Read Tests (Read-Only Operations)
 // ===========================================================================
 
+func TestHandleRotateReviewer(t *testing.T) {
+	t.Run("picks_least_loaded_reviewer_other_than_previous", func(t *testing.T) {
+		processRepo := repository.NewMemoryProcessRepository()
+		history := repository.NewMemoryReviewHistoryRepository()
+		adapter, handler, cleanup := testAdapter(t,
+			WithProcessRepository(processRepo),
+			WithReviewHistoryRepository(history),
+		)
+		defer cleanup()
+
+		for _, id := range []string{"worker-2", "worker-3", "worker-4"} {
+			_ = processRepo.Save(&repository.Process{
+				ID:     id,
+				Role:   repository.RoleWorker,
+				Status: repository.StatusReady,
+				Phase:  ptr(events.ProcessPhaseIdle),
+			})
+		}
+		_ = history.Record(repository.ReviewPairing{TaskID: "perles-xyz1", ImplementerID: "worker-1", ReviewerID: "worker-2"})
+		_ = history.Record(repository.ReviewPairing{TaskID: "perles-xyz2", ImplementerID: "worker-5", ReviewerID: "worker-3"})
+
+		args := toJSON(t, map[string]string{
+			"task_id":        "perles-xyz9",
+			"implementer_id": "worker-1",
+			"summary":        "Added rotation",
+			"review_type":    "simple",
+		})
+		result, err := adapter.HandleRotateReviewer(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "worker-4")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		assignCmd, ok := cmds[0].(*command.AssignReviewCommand)
+		require.True(t, ok)
+		assert.Equal(t, "worker-4", assignCmd.ReviewerID)
+		assert.Equal(t, "worker-1", assignCmd.ImplementerID)
+		assert.Equal(t, command.ReviewTypeSimple, assignCmd.ReviewType)
+	})
+
+	t.Run("no_ready_reviewer", func(t *testing.T) {
+		processRepo := repository.NewMemoryProcessRepository()
+		adapter, handler, cleanup := testAdapter(t,
+			WithProcessRepository(processRepo),
+			WithReviewHistoryRepository(repository.NewMemoryReviewHistoryRepository()),
+		)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{"task_id": "perles-xyz9", "implementer_id": "worker-1"})
+		result, err := adapter.HandleRotateReviewer(context.Background(), args)
+
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "no ready worker available")
+		assert.Empty(t, handler.getCommands())
+	})
+
+	t.Run("no_repository_configured", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		_, err := adapter.HandleRotateReviewer(context.Background(), toJSON(t, map[string]string{"task_id": "perles-xyz9"}))
+		require.ErrorContains(t, err, "not configured")
+	})
+}
+
+func TestHandleQueryReviewHistory(t *testing.T) {
+	history := repository.NewMemoryReviewHistoryRepository()
+	adapter, _, cleanup := testAdapter(t, WithReviewHistoryRepository(history))
+	defer cleanup()
+
+	assignedAt := time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC)
+	_ = history.Record(repository.ReviewPairing{TaskID: "perles-xyz1", ImplementerID: "worker-1", ReviewerID: "worker-2", AssignedAt: assignedAt})
+	_ = history.Record(repository.ReviewPairing{TaskID: "perles-xyz2", ImplementerID: "worker-3", ReviewerID: "worker-2", AssignedAt: assignedAt})
+
+	result, err := adapter.HandleQueryReviewHistory(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1"}))
+	require.NoError(t, err)
+
+	var response reviewHistoryResponse
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+	require.Equal(t, []reviewPairingInfo{{
+		TaskID:        "perles-xyz1",
+		ImplementerID: "worker-1",
+		ReviewerID:    "worker-2",
+		AssignedAt:    "2026-05-01T09:30:00Z",
+	}}, response.Pairings)
+	require.Equal(t, map[string]int{"worker-2": 2}, response.ReviewLoad)
+}
+
 func TestHandleQueryWorkerState(t *testing.T) {
 	t.Run("no_repository_configured", func(t *testing.T) {
 		adapter, _, cleanup := testAdapter(t)
@@ -1724,6 +2052,86 @@ func TestHandleQueryWorkerState_IncludesTaskAssignmentDetails(t *testing.T) {
 	assert.Equal(t, taskStarted.Format("2006-01-02T15:04:05Z07:00"), w["task_started"]) // task started timestamp
 }
 
+func TestHandleQueryWorkerState_IncludesReviewScores(t *testing.T) {
+	taskRepo := repository.NewMemoryTaskRepository()
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:       "task-123",
+		Implementer:  "worker-1",
+		Status:       repository.TaskApproved,
+		ReviewScores: repository.ReviewScores{repository.DimensionCorrectness: 5, repository.DimensionTests: 4, repository.DimensionStyle: 4, repository.DimensionSecurity: 5},
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "task-456", Implementer: "worker-2", Status: repository.TaskImplementing})
+
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(repository.NewMemoryProcessRepository()),
+		WithTaskRepository(taskRepo),
+	)
+	defer cleanup()
+
+	result, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var response struct {
+		Tasks map[string]map[string]any `json:"tasks"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+
+	assert.Equal(t, map[string]any{"correctness": 5.0, "tests": 4.0, "style": 4.0, "security": 5.0}, response.Tasks["task-123"]["review_scores"])
+	assert.NotContains(t, response.Tasks["task-456"], "review_scores")
+	assert.Equal(t, repository.ReviewScores{repository.DimensionCorrectness: 5, repository.DimensionTests: 4, repository.DimensionStyle: 4, repository.DimensionSecurity: 5}, adapter.TaskReviewScores("task-123"))
+	assert.Nil(t, adapter.TaskReviewScores("task-456"))
+}
+
+// staticChangedFiles returns fixed changed files per worker.
+type staticChangedFiles map[string][]string
+
+func (f staticChangedFiles) ChangedFiles(workerID string) ([]string, error) {
+	return f[workerID], nil
+}
+
+func TestHandleQueryWorkerState_IncludesOverlaps(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	for _, id := range []string{"worker-1", "worker-2", "worker-3"} {
+		_ = processRepo.Save(&repository.Process{ID: id, Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "task-" + id, CreatedAt: time.Now()})
+		_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "task-" + id, Implementer: id, Status: repository.TaskImplementing})
+	}
+	scanner := processor.NewConflictScanner(processor.ConflictScannerConfig{
+		Files: staticChangedFiles{"worker-1": {"main.go"}, "worker-2": {"main.go", "go.mod"}, "worker-3": {"README.md"}},
+		Tasks: taskRepo,
+	})
+	scanner.Scan()
+
+	adapter, _, cleanup := testAdapter(t,
+		WithProcessRepository(processRepo),
+		WithTaskRepository(taskRepo),
+		WithConflictScanner(scanner),
+	)
+	defer cleanup()
+
+	result, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var response struct {
+		Workers []struct {
+			WorkerID string                  `json:"worker_id"`
+			Overlaps []processor.FileOverlap `json:"overlaps"`
+		} `json:"workers"`
+		Overlaps []processor.FileOverlap `json:"overlaps"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+
+	overlap := processor.FileOverlap{Path: "main.go", Workers: []string{"worker-1", "worker-2"}}
+	assert.Equal(t, []processor.FileOverlap{overlap}, response.Overlaps)
+	for _, w := range response.Workers {
+		if w.WorkerID == "worker-3" {
+			assert.Empty(t, w.Overlaps)
+		} else {
+			assert.Equal(t, []processor.FileOverlap{overlap}, w.Overlaps, w.WorkerID)
+		}
+	}
+}
+
 func TestHandleQueryWorkerState_IncludesRetiredAt(t *testing.T) {
 	// Verify that retired_at is included when worker is retired
 	processRepo := repository.NewMemoryProcessRepository()
@@ -1812,6 +2220,144 @@ func TestHandleQueryWorkerState_IncludesQueueSize(t *testing.T) {
 	assert.Equal(t, float64(3), w["queue_size"]) // JSON numbers are float64
 }
 
+func TestHandleQueryWorkerState_IncludesBlockedWorkers(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	requestedAt := time.Date(2026, 10, 17, 9, 30, 0, 0, time.UTC)
+
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     ptr(events.ProcessPhaseBlocked),
+		TaskID:    "perles-abc.1",
+		CreatedAt: time.Now(),
+		Assistance: &repository.AssistanceRequest{
+			Reason:        "Need the staging credentials",
+			BlockingIssue: "perles-xyz",
+			NeededFrom:    "user",
+			ResumePhase:   events.ProcessPhaseImplementing,
+			RequestedAt:   requestedAt,
+		},
+	})
+	_ = processRepo.Save(&repository.Process{
+		ID:        "worker-2",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     ptr(events.ProcessPhaseImplementing),
+		CreatedAt: time.Now(),
+	})
+
+	adapter, _, cleanup := testAdapter(t, WithProcessRepository(processRepo))
+	defer cleanup()
+
+	result, err := adapter.HandleQueryWorkerState(context.Background(), nil)
+	require.NoError(t, err)
+
+	var response struct {
+		BlockedWorkers []map[string]any `json:"blocked_workers"`
+		Workers        []map[string]any `json:"workers"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result.Content[0].Text), &response))
+
+	require.Len(t, response.BlockedWorkers, 1)
+	blocked := response.BlockedWorkers[0]
+	assert.Equal(t, "worker-1", blocked["worker_id"])
+	assert.Equal(t, "perles-abc.1", blocked["task_id"])
+	assert.Equal(t, "Need the staging credentials", blocked["reason"])
+	assert.Equal(t, "perles-xyz", blocked["blocking_issue"])
+	assert.Equal(t, "user", blocked["needed_from"])
+	assert.Equal(t, "implementing", blocked["resume_phase"])
+
+	for _, w := range response.Workers {
+		if w["worker_id"] == "worker-1" {
+			assert.NotNil(t, w["assistance"])
+		} else {
+			assert.Nil(t, w["assistance"])
+		}
+	}
+}
+
+func TestHandleRequestAssistance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]string{
+			"reason":         "Migration conflicts with perles-xyz",
+			"blocking_issue": "perles-xyz",
+		})
+
+		result, err := adapter.HandleRequestAssistance(context.Background(), args, "worker-456")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Contains(t, result.Message, "Assistance requested from coordinator")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		assistCmd, ok := cmds[0].(*command.RequestAssistanceCommand)
+		require.True(t, ok)
+		assert.Equal(t, "worker-456", assistCmd.WorkerID)
+		assert.Equal(t, "Migration conflicts with perles-xyz", assistCmd.Reason)
+		assert.Equal(t, "perles-xyz", assistCmd.BlockingIssue)
+		assert.Equal(t, command.AssistanceFromCoordinator, assistCmd.NeededFrom)
+	})
+
+	t.Run("missing_reason", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleRequestAssistance(context.Background(), toJSON(t, map[string]string{}), "worker-456")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "reason is required")
+		assert.Empty(t, handler.getCommands())
+	})
+}
+
+func TestHandleProposeSubtasks(t *testing.T) {
+	t.Run("submits_command", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		args := toJSON(t, map[string]any{
+			"reason": "Too big for one change",
+			"subtasks": []map[string]any{
+				{"title": "Add schema", "description": "Create the migration", "estimated_size": "s"},
+				{"title": "Add API", "description": "Expose the endpoint", "depends_on": []string{"Add schema"}},
+			},
+		})
+
+		result, err := adapter.HandleProposeSubtasks(context.Background(), args, "worker-456")
+
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		proposeCmd, ok := cmds[0].(*command.ProposeSubtasksCommand)
+		require.True(t, ok)
+		assert.Equal(t, "worker-456", proposeCmd.WorkerID)
+		assert.Equal(t, "Too big for one change", proposeCmd.Reason)
+		require.Len(t, proposeCmd.Subtasks, 2)
+		assert.Equal(t, command.SubtaskSizeS, proposeCmd.Subtasks[0].EstimatedSize)
+		assert.Equal(t, []string{"Add schema"}, proposeCmd.Subtasks[1].DependsOn)
+	})
+
+	t.Run("invalid_subtasks", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleProposeSubtasks(context.Background(), toJSON(t, map[string]any{"subtasks": []any{}}), "worker-456")
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "at least one subtask is required")
+		assert.Empty(t, handler.getCommands())
+	})
+}
+
 // ===========================================================================
 // Worker Control Tests
 // ===========================================================================
@@ -1984,6 +2530,34 @@ func TestHandleSpawnProcess_InvalidAgentType_ReturnsError(t *testing.T) {
 	assert.Len(t, cmds, 0)
 }
 
+func TestHandleSpawnProcess_WithBackend(t *testing.T) {
+	adapter, handler, cleanup := testAdapter(t)
+	defer cleanup()
+
+	handler.returnResult = &command.CommandResult{
+		Success: true,
+		Data:    "worker-7",
+	}
+
+	args := toJSON(t, map[string]string{
+		"agent_type": "reviewer",
+		"backend":    "codex",
+	})
+
+	result, err := adapter.HandleSpawnProcess(context.Background(), args)
+
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Contains(t, result.Content[0].Text, "worker-7 (backend: codex)")
+
+	cmds := handler.getCommands()
+	require.Len(t, cmds, 1)
+	spawnCmd, ok := cmds[0].(*command.SpawnProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, client.ClientCodex, spawnCmd.Backend)
+	require.Equal(t, "reviewer", string(spawnCmd.AgentType))
+}
+
 func TestHandleSpawnProcess_NoAgentType_UsesGeneric(t *testing.T) {
 	adapter, handler, cleanup := testAdapter(t)
 	defer cleanup()
@@ -2254,6 +2828,15 @@ func TestAdapter_StoresSessionDirectory(t *testing.T) {
 	assert.Equal(t, "/Users/test/.perles/sessions/myapp/2026-01-11/session-abc", adapter.sessionDir)
 }
 
+// stubReportResult implements sessionReportExtractor.
+type stubReportResult struct {
+	report *accountability.Report
+}
+
+func (r stubReportResult) GetReport() *accountability.Report {
+	return r.report
+}
+
 func TestHandleGenerateAccountabilitySummary_UsesStoredSessionDir(t *testing.T) {
 	t.Run("uses stored sessionDir directly", func(t *testing.T) {
 		proc := processor.NewCommandProcessor()
@@ -2276,7 +2859,7 @@ func TestHandleGenerateAccountabilitySummary_UsesStoredSessionDir(t *testing.T)
 			WithSessionID("test-session", "/work/dir", expectedSessionDir),
 		)
 
-		args := json.RawMessage(`{"worker_id": "worker-1"}`)
+		args := json.RawMessage(`{}`)
 		_, err := adapter.HandleGenerateAccountabilitySummary(context.Background(), args)
 		require.NoError(t, err)
 
@@ -2296,12 +2879,38 @@ func TestHandleGenerateAccountabilitySummary_UsesStoredSessionDir(t *testing.T)
 		assert.Equal(t, expectedSessionDir, summaryCmd.SessionDir)
 	})
 
+	t.Run("reports session report metrics", func(t *testing.T) {
+		proc := processor.NewCommandProcessor()
+		report := &accountability.Report{
+			Metrics: accountability.SessionMetrics{Workers: 2, Tasks: 3, Commits: 4, IssuesClosed: 3, IssuesDiscovered: 1},
+			Skipped: []accountability.SkippedSummary{{Path: "workers/worker-3/tasks/perles-abc.3.md", Error: "bad"}},
+		}
+		proc.RegisterHandler(command.CmdGenerateAccountabilitySummary, &mockHandler{
+			returnResult: &command.CommandResult{Success: true, Data: stubReportResult{report: report}},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go proc.Run(ctx)
+		require.NoError(t, proc.WaitForReady(ctx))
+
+		adapter := NewV2Adapter(proc, WithSessionID("sess-1", "/work", "/sessions/sess-1"))
+		result, err := adapter.HandleGenerateAccountabilitySummary(context.Background(), json.RawMessage(`{}`))
+		require.NoError(t, err)
+
+		text := result.Content[0].Text
+		assert.Contains(t, text, "/sessions/sess-1/session_report.md")
+		assert.Contains(t, text, "/sessions/sess-1/session_report.json")
+		assert.Contains(t, text, "2 workers, 3 tasks, 4 commits, 3 issues closed, 1 issues discovered")
+		assert.Contains(t, text, "1 summaries could not be parsed")
+	})
+
 	t.Run("returns error when sessionDir not configured", func(t *testing.T) {
 		proc := processor.NewCommandProcessor()
 		// No sessionDir configured
 		adapter := NewV2Adapter(proc)
 
-		args := json.RawMessage(`{"worker_id": "worker-1"}`)
+		args := json.RawMessage(`{}`)
 		_, err := adapter.HandleGenerateAccountabilitySummary(context.Background(), args)
 
 		require.Error(t, err)
@@ -2330,7 +2939,7 @@ func TestHandleGenerateAccountabilitySummary_UsesStoredSessionDir(t *testing.T)
 			WithSessionID("sess-123", "/work", centralizedSessionDir),
 		)
 
-		args := json.RawMessage(`{"worker_id": "worker-1"}`)
+		args := json.RawMessage(`{}`)
 		_, err := adapter.HandleGenerateAccountabilitySummary(context.Background(), args)
 		require.NoError(t, err)
 
@@ -2733,3 +3342,201 @@ func TestHandleNotifyUser(t *testing.T) {
 		assert.Contains(t, result.Content[0].Text, "notification failed")
 	})
 }
+
+// ===========================================================================
+// HandleEmergencyStop Tests
+// ===========================================================================
+
+// haltedResult is a stub result reporting halted workers.
+type haltedResult struct{ workers []string }
+
+func (r haltedResult) GetHaltedWorkers() []string { return r.workers }
+
+func TestHandleEmergencyStop(t *testing.T) {
+	t.Run("success_issued_by_coordinator", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data:    haltedResult{workers: []string{"worker-1", "worker-2"}},
+		}
+
+		args := toJSON(t, map[string]any{
+			"reason": "worker-2 is force pushing to main",
+		})
+
+		result, err := adapter.HandleEmergencyStop(context.Background(), args)
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.False(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "Halted worker-1, worker-2")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		stopCmd, ok := cmds[0].(*command.EmergencyStopCommand)
+		require.True(t, ok)
+		assert.Equal(t, "coordinator", stopCmd.IssuedBy)
+		assert.Equal(t, "worker-2 is force pushing to main", stopCmd.Reason)
+		assert.Equal(t, command.SourceMCPTool, stopCmd.Source())
+	})
+
+	t.Run("invalid_json", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		result, err := adapter.HandleEmergencyStop(context.Background(), []byte("invalid"))
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "invalid arguments")
+	})
+
+	t.Run("handler_error", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnErr = errors.New("stop failed")
+
+		result, err := adapter.HandleEmergencyStop(context.Background(), toJSON(t, map[string]any{}))
+
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		assert.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "stop failed")
+	})
+}
+
+// ===========================================================================
+// HandleAdvancePhase Tests
+// ===========================================================================
+
+// phaseResult is a stub result reporting the entered phase.
+type phaseResult struct{ phase dag.Phase }
+
+func (r phaseResult) GetPhase() dag.Phase { return r.phase }
+
+func TestHandleAdvancePhase(t *testing.T) {
+	t.Run("success_describes_phase", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnResult = &command.CommandResult{
+			Success: true,
+			Data: phaseResult{phase: dag.Phase{
+				ID:         "review",
+				Roles:      []string{"reviewer"},
+				Actions:    []string{"assign_task_review"},
+				Checkpoint: true,
+				Next:       []dag.Transition{{To: "ship"}},
+			}},
+		}
+
+		result, err := adapter.HandleAdvancePhase(context.Background(), toJSON(t, map[string]any{
+			"phase":  "review",
+			"reason": "all tasks implemented",
+		}))
+
+		require.NoError(t, err)
+		require.False(t, result.IsError)
+		text := result.Content[0].Text
+		assert.Contains(t, text, "Workflow moved to phase review")
+		assert.Contains(t, text, "Roles: reviewer")
+		assert.Contains(t, text, "Allowed actions: assign_task_review")
+		assert.Contains(t, text, "Next: ship")
+		assert.Contains(t, text, "human checkpoint")
+
+		cmds := handler.getCommands()
+		require.Len(t, cmds, 1)
+		advanceCmd, ok := cmds[0].(*command.AdvancePhaseCommand)
+		require.True(t, ok)
+		assert.Equal(t, "review", advanceCmd.Phase)
+		assert.Equal(t, "all tasks implemented", advanceCmd.Reason)
+	})
+
+	t.Run("rejected_transition", func(t *testing.T) {
+		adapter, handler, cleanup := testAdapter(t)
+		defer cleanup()
+
+		handler.returnErr = errors.New(`phase "plan" cannot move to "ship" (next: review)`)
+
+		result, err := adapter.HandleAdvancePhase(context.Background(), toJSON(t, map[string]any{"phase": "ship"}))
+
+		require.NoError(t, err)
+		require.True(t, result.IsError)
+		assert.Contains(t, result.Content[0].Text, "cannot move to")
+	})
+
+	t.Run("missing_phase", func(t *testing.T) {
+		adapter, _, cleanup := testAdapter(t)
+		defer cleanup()
+
+		_, err := adapter.HandleAdvancePhase(context.Background(), toJSON(t, map[string]any{}))
+		require.ErrorContains(t, err, "phase is required")
+	})
+}
+
+// ===========================================================================
+// Dead Letter Tests
+// ===========================================================================
+
+func TestHandleDeadLetters_ListRetryDiscard(t *testing.T) {
+	handler := newMockHandler()
+	p := processor.NewCommandProcessor(processor.WithDeadLetterRepository(repository.NewMemoryDeadLetterRepository(0)))
+	p.RegisterHandler(command.CmdAssignTask, handler)
+	p.RegisterHandler(command.CmdRetireProcess, handler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go p.Run(ctx)
+	defer p.Stop()
+	require.Eventually(t, p.IsRunning, time.Second, 10*time.Millisecond)
+	adapter := NewV2Adapter(p)
+
+	// Two commands fail while the handler is broken
+	handler.mu.Lock()
+	handler.returnErr = errors.New("worker-1 is not ready")
+	handler.mu.Unlock()
+	result, err := adapter.HandleAssignTask(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-1", "task_id": "perles-abc1"}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	result, err = adapter.HandleRetireProcess(context.Background(), toJSON(t, map[string]string{"worker_id": "worker-2"}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+
+	result, err = adapter.HandleListDeadLetters(context.Background(), nil)
+	require.NoError(t, err)
+	all := result.StructuredContent.(deadLettersResponse).DeadLetters
+	require.Len(t, all, 2)
+	assert.Equal(t, "assign_task", all[0].CommandType)
+	assert.Equal(t, "worker-1 is not ready", all[0].Error)
+	assert.Equal(t, 1, all[0].Attempts)
+
+	result, err = adapter.HandleListDeadLetters(context.Background(), toJSON(t, map[string]string{"command_type": "retire_process"}))
+	require.NoError(t, err)
+	require.Len(t, result.StructuredContent.(deadLettersResponse).DeadLetters, 1)
+
+	// The retry succeeds once the cause is fixed and the dead letter is gone
+	handler.mu.Lock()
+	handler.returnErr = nil
+	handler.mu.Unlock()
+	result, err = adapter.HandleRetryDeadLetter(context.Background(), toJSON(t, map[string]string{"command_id": all[0].CommandID}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, result.Content[0].Text)
+	require.Len(t, p.DeadLetters(), 1)
+
+	result, err = adapter.HandleRetryDeadLetter(context.Background(), toJSON(t, map[string]any{"command_id": all[1].CommandID, "discard": true}))
+	require.NoError(t, err)
+	require.False(t, result.IsError)
+	require.Empty(t, p.DeadLetters())
+
+	// Unknown IDs are reported to the agent, not returned as errors
+	result, err = adapter.HandleRetryDeadLetter(context.Background(), toJSON(t, map[string]string{"command_id": "missing"}))
+	require.NoError(t, err)
+	require.True(t, result.IsError)
+	assert.Contains(t, result.Content[0].Text, "dead letter not found")
+
+	_, err = adapter.HandleRetryDeadLetter(context.Background(), toJSON(t, map[string]string{}))
+	require.ErrorContains(t, err, "command_id is required")
+}
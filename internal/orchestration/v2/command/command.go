@@ -54,6 +54,10 @@ const (
 	CmdReportVerdict CommandType = "report_verdict"
 	// CmdTransitionPhase is an internal command for phase changes.
 	CmdTransitionPhase CommandType = "transition_phase"
+	// CmdRequestAssistance blocks a worker until the coordinator or user answers its request.
+	CmdRequestAssistance CommandType = "request_assistance"
+	// CmdProposeSubtasks creates subtasks under a worker's task and hands them to the coordinator.
+	CmdProposeSubtasks CommandType = "propose_subtasks"
 	// BD Task Status Commands
 
 	// CmdMarkTaskComplete marks a BD task as completed.
@@ -80,9 +84,16 @@ const (
 	// CmdResumeProcess resumes a paused coordinator/process (Paused → Ready).
 	CmdResumeProcess CommandType = "resume_process"
 
+	// Emergency Control Commands
+
+	// CmdEmergencyStop halts all workers immediately and broadcasts a HALT message.
+	CmdEmergencyStop CommandType = "emergency_stop"
+	// CmdEmergencyResume resumes workers halted by an emergency stop.
+	CmdEmergencyResume CommandType = "emergency_resume"
+
 	// Aggregation Commands
 
-	// CmdGenerateAccountabilitySummary spawns a worker to aggregate accountability summaries.
+	// CmdGenerateAccountabilitySummary aggregates worker accountability summaries into a session report.
 	CmdGenerateAccountabilitySummary CommandType = "generate_accountability_summary"
 
 	// Process Control Commands
@@ -99,6 +110,13 @@ const (
 
 	// CmdNotifyUser requests user attention (e.g., for human review checkpoints).
 	CmdNotifyUser CommandType = "notify_user"
+
+	// Workflow DAG Commands
+
+	// CmdAdvancePhase moves a DAG workflow to its next phase.
+	CmdAdvancePhase CommandType = "advance_phase"
+	// CmdApproveCheckpoint records the user's approval of a human checkpoint phase.
+	CmdApproveCheckpoint CommandType = "approve_checkpoint"
 )
 
 // String returns the string representation of the CommandType.
@@ -135,6 +153,9 @@ type BaseCommand struct {
 	source      CommandSource
 	traceID     string
 	spanContext trace.SpanContext // For OpenTelemetry trace propagation
+
+	// idempotencyKey identifies retries of the same request; see SetIdempotencyKey.
+	idempotencyKey string
 }
 
 // NewBaseCommand creates a BaseCommand with a generated UUID and current timestamp.
@@ -202,6 +223,20 @@ func (b *BaseCommand) SetSpanContext(sc trace.SpanContext) {
 	b.spanContext = sc
 }
 
+// IdempotencyKey returns the caller-supplied key identifying retries of the
+// same request, or "" if the command has none.
+func (b *BaseCommand) IdempotencyKey() string {
+	return b.idempotencyKey
+}
+
+// SetIdempotencyKey sets the caller-supplied key identifying retries of the
+// same request. The processor handles the first command of a type with a
+// given key and answers later ones with the original result while the key
+// is remembered.
+func (b *BaseCommand) SetIdempotencyKey(key string) {
+	b.idempotencyKey = key
+}
+
 // SetPriority sets the execution priority.
 func (b *BaseCommand) SetPriority(priority int) {
 	b.priority = priority
@@ -224,6 +259,9 @@ type CommandResult struct {
 	Error error
 	// Data contains optional result data for the caller.
 	Data any
+	// Replayed indicates the command was a duplicate of an earlier one with the
+	// same idempotency key and this is the original result, not a new execution.
+	Replayed bool
 }
 
 // ErrQueueFull is returned when the command queue has reached capacity.
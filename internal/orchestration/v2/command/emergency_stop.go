@@ -0,0 +1,72 @@
+// Package command provides concrete command types for the v2 orchestration architecture.
+package command
+
+import "fmt"
+
+// ===========================================================================
+// Emergency Control Commands
+// ===========================================================================
+
+// EmergencyStopCommand halts every worker immediately and posts a HALT message
+// to all fabric channels. Only the user (TUI) or the coordinator (MCP tool) may
+// issue it; workers stay paused until an explicit EmergencyResumeCommand.
+type EmergencyStopCommand struct {
+	*BaseCommand
+	IssuedBy string // Required: "user" or "coordinator"
+	Reason   string // Optional: why the stop was triggered
+}
+
+// NewEmergencyStopCommand creates a new EmergencyStopCommand.
+func NewEmergencyStopCommand(source CommandSource, issuedBy, reason string) *EmergencyStopCommand {
+	base := NewBaseCommand(CmdEmergencyStop, source)
+	base.SetPriority(1)
+	return &EmergencyStopCommand{
+		BaseCommand: &base,
+		IssuedBy:    issuedBy,
+		Reason:      reason,
+	}
+}
+
+// Validate checks that the command was issued by the user or the coordinator.
+func (c *EmergencyStopCommand) Validate() error {
+	if c.IssuedBy == "" {
+		return fmt.Errorf("issued_by is required")
+	}
+	if c.IssuedBy != "user" && c.IssuedBy != "coordinator" {
+		return fmt.Errorf("emergency stop can only be issued by user or coordinator, got %q", c.IssuedBy)
+	}
+	if c.Source() != SourceUser && c.Source() != SourceMCPTool {
+		return fmt.Errorf("emergency stop cannot be issued from source %s", c.Source())
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *EmergencyStopCommand) String() string {
+	if c.Reason != "" {
+		return fmt.Sprintf("EmergencyStop{by=%s, reason=%q}", c.IssuedBy, truncate(c.Reason, 50))
+	}
+	return fmt.Sprintf("EmergencyStop{by=%s}", c.IssuedBy)
+}
+
+// EmergencyResumeCommand resumes workers halted by an emergency stop.
+// Resuming is deliberately restricted to the user so an agent cannot undo a halt.
+type EmergencyResumeCommand struct {
+	*BaseCommand
+}
+
+// NewEmergencyResumeCommand creates a new EmergencyResumeCommand.
+func NewEmergencyResumeCommand(source CommandSource) *EmergencyResumeCommand {
+	base := NewBaseCommand(CmdEmergencyResume, source)
+	return &EmergencyResumeCommand{
+		BaseCommand: &base,
+	}
+}
+
+// Validate checks that the resume was requested by the user.
+func (c *EmergencyResumeCommand) Validate() error {
+	if c.Source() != SourceUser {
+		return fmt.Errorf("emergency resume must be issued by the user, got source %s", c.Source())
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// EmergencyStopCommand Tests
+// ===========================================================================
+
+func TestEmergencyStopCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		source    CommandSource
+		issuedBy  string
+		wantErr   bool
+		errSubstr string
+	}{
+		{name: "user from TUI", source: SourceUser, issuedBy: "user"},
+		{name: "coordinator via MCP", source: SourceMCPTool, issuedBy: "coordinator"},
+		{name: "missing issuer", source: SourceUser, issuedBy: "", wantErr: true, errSubstr: "issued_by is required"},
+		{name: "worker rejected", source: SourceMCPTool, issuedBy: "worker-1", wantErr: true, errSubstr: "only be issued by user or coordinator"},
+		{name: "internal source rejected", source: SourceInternal, issuedBy: "coordinator", wantErr: true, errSubstr: "cannot be issued from source"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewEmergencyStopCommand(tt.source, tt.issuedBy, "runaway rm -rf")
+			err := cmd.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEmergencyStopCommand_TypeAndPriority(t *testing.T) {
+	cmd := NewEmergencyStopCommand(SourceUser, "user", "")
+	require.Equal(t, CmdEmergencyStop, cmd.Type())
+	require.Equal(t, 1, cmd.Priority())
+}
+
+func TestEmergencyResumeCommand_Validate(t *testing.T) {
+	require.NoError(t, NewEmergencyResumeCommand(SourceUser).Validate())
+
+	err := NewEmergencyResumeCommand(SourceMCPTool).Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be issued by the user")
+}
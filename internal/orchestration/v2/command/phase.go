@@ -0,0 +1,72 @@
+// Package command provides concrete command types for the v2 orchestration architecture.
+package command
+
+import "fmt"
+
+// ===========================================================================
+// Workflow DAG Commands
+// ===========================================================================
+
+// AdvancePhaseCommand moves a DAG workflow from its active phase to the next.
+// The move must follow a transition of the active phase whose condition holds.
+type AdvancePhaseCommand struct {
+	*BaseCommand
+	Phase  string // Required: ID of the phase to enter
+	Reason string // Optional: why the coordinator is moving on
+}
+
+// NewAdvancePhaseCommand creates a new AdvancePhaseCommand.
+func NewAdvancePhaseCommand(source CommandSource, phase, reason string) *AdvancePhaseCommand {
+	base := NewBaseCommand(CmdAdvancePhase, source)
+	return &AdvancePhaseCommand{
+		BaseCommand: &base,
+		Phase:       phase,
+		Reason:      reason,
+	}
+}
+
+// Validate checks that Phase is provided.
+func (c *AdvancePhaseCommand) Validate() error {
+	if c.Phase == "" {
+		return fmt.Errorf("phase is required")
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *AdvancePhaseCommand) String() string {
+	return fmt.Sprintf("AdvancePhase{phase=%s}", c.Phase)
+}
+
+// ApproveCheckpointCommand records the user's approval of the active human
+// checkpoint phase so the coordinator can move the workflow on.
+// Approving is restricted to the user so an agent cannot skip a checkpoint.
+type ApproveCheckpointCommand struct {
+	*BaseCommand
+	Note string // Optional: feedback passed on to the coordinator
+}
+
+// NewApproveCheckpointCommand creates a new ApproveCheckpointCommand.
+func NewApproveCheckpointCommand(source CommandSource, note string) *ApproveCheckpointCommand {
+	base := NewBaseCommand(CmdApproveCheckpoint, source)
+	return &ApproveCheckpointCommand{
+		BaseCommand: &base,
+		Note:        note,
+	}
+}
+
+// Validate checks that the approval came from the user.
+func (c *ApproveCheckpointCommand) Validate() error {
+	if c.Source() != SourceUser {
+		return fmt.Errorf("checkpoints must be approved by the user, got source %s", c.Source())
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *ApproveCheckpointCommand) String() string {
+	if c.Note != "" {
+		return fmt.Sprintf("ApproveCheckpoint{note=%q}", truncate(c.Note, 50))
+	}
+	return "ApproveCheckpoint{}"
+}
@@ -0,0 +1,31 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// Workflow DAG Command Tests
+// ===========================================================================
+
+func TestAdvancePhaseCommand_Validate(t *testing.T) {
+	cmd := NewAdvancePhaseCommand(SourceMCPTool, "implement", "research done")
+	require.NoError(t, cmd.Validate())
+	require.Equal(t, CmdAdvancePhase, cmd.Type())
+	require.Equal(t, "AdvancePhase{phase=implement}", cmd.String())
+
+	err := NewAdvancePhaseCommand(SourceMCPTool, "", "").Validate()
+	require.ErrorContains(t, err, "phase is required")
+}
+
+func TestApproveCheckpointCommand_Validate(t *testing.T) {
+	cmd := NewApproveCheckpointCommand(SourceUser, "looks good")
+	require.NoError(t, cmd.Validate())
+	require.Equal(t, CmdApproveCheckpoint, cmd.Type())
+	require.Equal(t, `ApproveCheckpoint{note="looks good"}`, cmd.String())
+
+	err := NewApproveCheckpointCommand(SourceMCPTool, "").Validate()
+	require.ErrorContains(t, err, "must be approved by the user")
+}
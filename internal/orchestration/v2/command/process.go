@@ -5,6 +5,7 @@ package command
 import (
 	"fmt"
 
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
@@ -23,6 +24,7 @@ type SpawnProcessCommand struct {
 	ProcessID      string                 // Optional: specific ID (auto-generated for workers if empty)
 	AgentType      roles.AgentType        // Optional: agent specialization (default: generic)
 	WorkflowConfig *roles.WorkflowConfig  // Optional: workflow-specific prompt customizations
+	Backend        client.ClientType      // Optional: worker agent backend (default: worker client)
 }
 
 // SpawnProcessOption configures a SpawnProcessCommand.
@@ -44,6 +46,14 @@ func WithWorkflowConfig(config *roles.WorkflowConfig) SpawnProcessOption {
 	}
 }
 
+// WithBackend sets the agent backend (e.g., codex, gemini) the worker is spawned with.
+// Empty uses the default worker client.
+func WithBackend(backend client.ClientType) SpawnProcessOption {
+	return func(cmd *SpawnProcessCommand) {
+		cmd.Backend = backend
+	}
+}
+
 // NewSpawnProcessCommand creates a new SpawnProcessCommand.
 // Options can be provided to configure optional fields like AgentType.
 func NewSpawnProcessCommand(source CommandSource, role repository.ProcessRole, opts ...SpawnProcessOption) *SpawnProcessCommand {
@@ -67,6 +77,14 @@ func (c *SpawnProcessCommand) Validate() error {
 	return nil
 }
 
+// ProgressLabel describes the spawn for progress reporting (e.g., "Spawning worker").
+func (c *SpawnProcessCommand) ProgressLabel() string {
+	if c.ProcessID != "" {
+		return "Spawning " + c.ProcessID
+	}
+	return "Spawning " + string(c.Role)
+}
+
 // RetireProcessCommand terminates a process gracefully.
 type RetireProcessCommand struct {
 	*BaseCommand
@@ -117,6 +135,11 @@ func (c *ReplaceProcessCommand) Validate() error {
 	return nil
 }
 
+// ProgressLabel describes the replacement for progress reporting (e.g., "Replacing worker-2").
+func (c *ReplaceProcessCommand) ProgressLabel() string {
+	return "Replacing " + c.ProcessID
+}
+
 // ===========================================================================
 // Unified Process Message Commands
 // ===========================================================================
@@ -0,0 +1,162 @@
+// Package command provides concrete command types for the v2 orchestration architecture.
+package command
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ===========================================================================
+// Subtask Proposal Commands
+// ===========================================================================
+
+// SubtaskSize is a worker's rough estimate of how much work a subtask is.
+// Sizes become "size:<size>" labels, the labels estimate_task compares.
+type SubtaskSize string
+
+const (
+	SubtaskSizeXS SubtaskSize = "xs"
+	SubtaskSizeS  SubtaskSize = "s"
+	SubtaskSizeM  SubtaskSize = "m"
+	SubtaskSizeL  SubtaskSize = "l"
+	SubtaskSizeXL SubtaskSize = "xl"
+)
+
+// IsValid returns true if this is a known subtask size.
+func (s SubtaskSize) IsValid() bool {
+	switch s {
+	case SubtaskSizeXS, SubtaskSizeS, SubtaskSizeM, SubtaskSizeL, SubtaskSizeXL:
+		return true
+	}
+	return false
+}
+
+// MaxProposedSubtasks caps the number of subtasks a worker may propose at once.
+const MaxProposedSubtasks = 20
+
+// ProposedSubtask is one piece of a task a worker proposes to split off.
+type ProposedSubtask struct {
+	Title         string      `json:"title"`
+	Description   string      `json:"description"`
+	EstimatedSize SubtaskSize `json:"estimated_size,omitempty"`
+	// DependsOn lists the titles of other subtasks in the same proposal, or
+	// existing bd issue IDs, that must be done before this subtask.
+	DependsOn []string `json:"depends_on,omitempty"`
+}
+
+// ProposeSubtasksCommand creates subtasks under a worker's task in bd and
+// hands them to the coordinator for assignment.
+type ProposeSubtasksCommand struct {
+	*BaseCommand
+	WorkerID string            // Required: ID of the worker proposing the split
+	ParentID string            // Optional: task to split; defaults to the worker's current task
+	Reason   string            // Optional: why the task should be split
+	Subtasks []ProposedSubtask // Required: the subtasks to create, in suggested order
+}
+
+// NewProposeSubtasksCommand creates a new ProposeSubtasksCommand.
+func NewProposeSubtasksCommand(source CommandSource, workerID, parentID, reason string, subtasks []ProposedSubtask) *ProposeSubtasksCommand {
+	base := NewBaseCommand(CmdProposeSubtasks, source)
+	return &ProposeSubtasksCommand{
+		BaseCommand: &base,
+		WorkerID:    workerID,
+		ParentID:    parentID,
+		Reason:      reason,
+		Subtasks:    subtasks,
+	}
+}
+
+// Validate checks that WorkerID and at least one well-formed subtask are
+// provided, that titles are unique, and that dependencies between the
+// proposed subtasks do not form a cycle.
+func (c *ProposeSubtasksCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	if len(c.Subtasks) == 0 {
+		return fmt.Errorf("at least one subtask is required")
+	}
+	if len(c.Subtasks) > MaxProposedSubtasks {
+		return fmt.Errorf("subtasks cannot exceed %d entries", MaxProposedSubtasks)
+	}
+
+	titles := make(map[string]int, len(c.Subtasks))
+	for i, s := range c.Subtasks {
+		if strings.TrimSpace(s.Title) == "" {
+			return fmt.Errorf("subtasks[%d]: title is required", i)
+		}
+		if strings.TrimSpace(s.Description) == "" {
+			return fmt.Errorf("subtasks[%d]: description is required", i)
+		}
+		if s.EstimatedSize != "" && !s.EstimatedSize.IsValid() {
+			return fmt.Errorf("subtasks[%d]: estimated_size must be xs, s, m, l or xl, got: %q", i, s.EstimatedSize)
+		}
+		if _, dup := titles[s.Title]; dup {
+			return fmt.Errorf("subtasks[%d]: duplicate title %q", i, s.Title)
+		}
+		titles[s.Title] = i
+	}
+
+	for i, s := range c.Subtasks {
+		for _, dep := range s.DependsOn {
+			if strings.TrimSpace(dep) == "" {
+				return fmt.Errorf("subtasks[%d]: depends_on entries cannot be empty", i)
+			}
+			if dep == s.Title {
+				return fmt.Errorf("subtasks[%d]: cannot depend on itself", i)
+			}
+		}
+	}
+	if cycle := subtaskCycle(c.Subtasks, titles); cycle != "" {
+		return fmt.Errorf("subtask dependencies form a cycle: %s", cycle)
+	}
+	return nil
+}
+
+// subtaskCycle returns a readable dependency cycle between proposed subtasks,
+// or "" if there is none. Dependencies on existing issues cannot form a cycle
+// within the proposal and are ignored.
+func subtaskCycle(subtasks []ProposedSubtask, titles map[string]int) string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make([]int, len(subtasks))
+	var path []string
+
+	var visit func(i int) bool
+	visit = func(i int) bool {
+		state[i] = visiting
+		path = append(path, subtasks[i].Title)
+		for _, dep := range subtasks[i].DependsOn {
+			j, ok := titles[dep]
+			if !ok {
+				continue
+			}
+			if state[j] == visiting {
+				path = append(path[slices.Index(path, dep):], dep)
+				return true
+			}
+			if state[j] == unvisited && visit(j) {
+				return true
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = done
+		return false
+	}
+
+	for i := range subtasks {
+		if state[i] == unvisited && visit(i) {
+			return strings.Join(path, " -> ")
+		}
+	}
+	return ""
+}
+
+// String returns a readable representation of the command.
+func (c *ProposeSubtasksCommand) String() string {
+	return fmt.Sprintf("ProposeSubtasks{worker=%s, parent=%s, subtasks=%d}", c.WorkerID, c.ParentID, len(c.Subtasks))
+}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// ProposeSubtasksCommand Tests
+// ===========================================================================
+
+func TestProposeSubtasksCommand_Valid(t *testing.T) {
+	cmd := NewProposeSubtasksCommand(SourceMCPTool, "worker-1", "", "Touches three packages", []ProposedSubtask{
+		{Title: "Add schema", Description: "Create the migration", EstimatedSize: SubtaskSizeS},
+		{Title: "Add API", Description: "Expose the endpoint", EstimatedSize: SubtaskSizeM, DependsOn: []string{"Add schema", "perles-xyz"}},
+	})
+
+	require.Equal(t, CmdProposeSubtasks, cmd.Type())
+	require.NoError(t, cmd.Validate())
+	require.Equal(t, "ProposeSubtasks{worker=worker-1, parent=, subtasks=2}", cmd.String())
+}
+
+func TestProposeSubtasksCommand_Validate(t *testing.T) {
+	valid := ProposedSubtask{Title: "A", Description: "do A"}
+	tooMany := make([]ProposedSubtask, MaxProposedSubtasks+1)
+	for i := range tooMany {
+		tooMany[i] = ProposedSubtask{Title: fmt.Sprintf("T%d", i), Description: "d"}
+	}
+
+	tests := []struct {
+		name      string
+		workerID  string
+		subtasks  []ProposedSubtask
+		errSubstr string
+	}{
+		{name: "missing worker", subtasks: []ProposedSubtask{valid}, errSubstr: "worker_id is required"},
+		{name: "no subtasks", workerID: "worker-1", errSubstr: "at least one subtask is required"},
+		{name: "too many", workerID: "worker-1", subtasks: tooMany, errSubstr: "cannot exceed"},
+		{name: "missing title", workerID: "worker-1", subtasks: []ProposedSubtask{{Description: "d"}}, errSubstr: "subtasks[0]: title is required"},
+		{name: "missing description", workerID: "worker-1", subtasks: []ProposedSubtask{{Title: "A"}}, errSubstr: "subtasks[0]: description is required"},
+		{
+			name:      "bad size",
+			workerID:  "worker-1",
+			subtasks:  []ProposedSubtask{{Title: "A", Description: "d", EstimatedSize: "huge"}},
+			errSubstr: "estimated_size must be xs, s, m, l or xl",
+		},
+		{name: "duplicate title", workerID: "worker-1", subtasks: []ProposedSubtask{valid, valid}, errSubstr: `subtasks[1]: duplicate title "A"`},
+		{
+			name:      "self dependency",
+			workerID:  "worker-1",
+			subtasks:  []ProposedSubtask{{Title: "A", Description: "d", DependsOn: []string{"A"}}},
+			errSubstr: "cannot depend on itself",
+		},
+		{
+			name:     "cycle",
+			workerID: "worker-1",
+			subtasks: []ProposedSubtask{
+				{Title: "A", Description: "d", DependsOn: []string{"B"}},
+				{Title: "B", Description: "d", DependsOn: []string{"C"}},
+				{Title: "C", Description: "d", DependsOn: []string{"B"}},
+			},
+			errSubstr: "subtask dependencies form a cycle: B -> C -> B",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewProposeSubtasksCommand(SourceMCPTool, tt.workerID, "", "", tt.subtasks).Validate()
+			require.ErrorContains(t, err, tt.errSubstr)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package command provides concrete command types for the v2 orchestration architecture.
+package command
+
+import "fmt"
+
+// ===========================================================================
+// Worker Assistance Commands
+// ===========================================================================
+
+// Who a worker can ask for assistance, besides another worker by ID.
+const (
+	AssistanceFromCoordinator = "coordinator"
+	AssistanceFromUser        = "user"
+)
+
+// RequestAssistanceCommand blocks a worker that cannot continue without input.
+// The worker moves to the blocked phase and the coordinator is notified urgently.
+type RequestAssistanceCommand struct {
+	*BaseCommand
+	WorkerID      string // Required: ID of the blocked worker
+	Reason        string // Required: why the worker cannot continue
+	BlockingIssue string // Optional: bd issue or other item blocking the worker
+	NeededFrom    string // Optional: "coordinator" (default), "user" or a worker ID
+}
+
+// NewRequestAssistanceCommand creates a new RequestAssistanceCommand.
+// An empty neededFrom defaults to the coordinator.
+func NewRequestAssistanceCommand(source CommandSource, workerID, reason, blockingIssue, neededFrom string) *RequestAssistanceCommand {
+	if neededFrom == "" {
+		neededFrom = AssistanceFromCoordinator
+	}
+	base := NewBaseCommand(CmdRequestAssistance, source)
+	base.SetPriority(1)
+	return &RequestAssistanceCommand{
+		BaseCommand:   &base,
+		WorkerID:      workerID,
+		Reason:        reason,
+		BlockingIssue: blockingIssue,
+		NeededFrom:    neededFrom,
+	}
+}
+
+// Validate checks that WorkerID and Reason are provided and that the worker
+// does not ask itself for assistance.
+func (c *RequestAssistanceCommand) Validate() error {
+	if c.WorkerID == "" {
+		return fmt.Errorf("worker_id is required")
+	}
+	if c.Reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	if c.NeededFrom == c.WorkerID {
+		return fmt.Errorf("needed_from cannot be the requesting worker")
+	}
+	return nil
+}
+
+// String returns a readable representation of the command.
+func (c *RequestAssistanceCommand) String() string {
+	return fmt.Sprintf("RequestAssistance{worker=%s, from=%s, reason=%q}", c.WorkerID, c.NeededFrom, truncate(c.Reason, 50))
+}
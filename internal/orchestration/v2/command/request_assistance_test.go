@@ -0,0 +1,48 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================================================================
+// RequestAssistanceCommand Tests
+// ===========================================================================
+
+func TestRequestAssistanceCommand_Defaults(t *testing.T) {
+	cmd := NewRequestAssistanceCommand(SourceMCPTool, "worker-1", "Need the staging API key", "", "")
+
+	require.Equal(t, CmdRequestAssistance, cmd.Type())
+	require.Equal(t, AssistanceFromCoordinator, cmd.NeededFrom)
+	require.Equal(t, 1, cmd.Priority(), "assistance requests are urgent")
+	require.NoError(t, cmd.Validate())
+	require.Equal(t, `RequestAssistance{worker=worker-1, from=coordinator, reason="Need the staging API key"}`, cmd.String())
+}
+
+func TestRequestAssistanceCommand_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		workerID   string
+		reason     string
+		neededFrom string
+		errSubstr  string
+	}{
+		{name: "missing worker", reason: "blocked", errSubstr: "worker_id is required"},
+		{name: "missing reason", workerID: "worker-1", errSubstr: "reason is required"},
+		{name: "asks itself", workerID: "worker-1", reason: "blocked", neededFrom: "worker-1", errSubstr: "cannot be the requesting worker"},
+		{name: "asks user", workerID: "worker-1", reason: "blocked", neededFrom: AssistanceFromUser},
+		{name: "asks another worker", workerID: "worker-1", reason: "blocked", neededFrom: "worker-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRequestAssistanceCommand(SourceMCPTool, tt.workerID, tt.reason, "perles-abc", tt.neededFrom).Validate()
+			if tt.errSubstr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errSubstr)
+		})
+	}
+}
@@ -4,8 +4,11 @@ package command
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
@@ -187,8 +190,24 @@ func (c *AssignReviewFeedbackCommand) Validate() error {
 // BroadcastCommand broadcasts a message to all workers.
 type BroadcastCommand struct {
 	*BaseCommand
-	Content        string   // Required: message content to broadcast
-	ExcludeWorkers []string // Optional: worker IDs to exclude from the broadcast
+	Content        string          // Required: message content to broadcast
+	ExcludeWorkers []string        // Optional: worker IDs to exclude from the broadcast
+	Filter         BroadcastFilter // Optional: limits the broadcast to matching workers
+}
+
+// BroadcastFilter limits a broadcast to the workers matching all of its set fields.
+type BroadcastFilter struct {
+	Phase     events.ProcessPhase // Optional: only workers in this phase
+	AgentType roles.AgentType     // Optional: only workers of this specialization
+	TaskID    string              // Optional: only workers assigned this task
+}
+
+// BroadcastDelivery is the outcome of a broadcast for one worker.
+type BroadcastDelivery struct {
+	WorkerID  string
+	Queued    bool  // True if the worker is busy and gets the message after its turn
+	QueueSize int   // Messages waiting for the worker, including this one
+	Err       error // Set if the message could not be queued
 }
 
 // NewBroadcastCommand creates a new BroadcastCommand.
@@ -201,11 +220,20 @@ func NewBroadcastCommand(source CommandSource, content string, excludeWorkers []
 	}
 }
 
-// Validate checks that Content is provided.
+// Validate checks that Content is provided and that the set filter fields are valid.
 func (c *BroadcastCommand) Validate() error {
 	if c.Content == "" {
 		return fmt.Errorf("content is required")
 	}
+	if c.Filter.Phase != "" && !isValidProcessPhase(c.Filter.Phase) {
+		return fmt.Errorf("invalid worker phase: %s", c.Filter.Phase)
+	}
+	if c.Filter.AgentType != roles.AgentTypeGeneric && !c.Filter.AgentType.IsValid() {
+		return fmt.Errorf("invalid agent type: %s", c.Filter.AgentType)
+	}
+	if c.Filter.TaskID != "" && !validation.IsValidTaskID(c.Filter.TaskID) {
+		return fmt.Errorf("invalid task_id format: %s", c.Filter.TaskID)
+	}
 	return nil
 }
 
@@ -216,8 +244,16 @@ func (c *BroadcastCommand) Validate() error {
 // ReportCompleteCommand signals that a worker's implementation is done.
 type ReportCompleteCommand struct {
 	*BaseCommand
-	WorkerID string // Required: ID of the worker reporting completion
-	Summary  string // Optional: summary of what was implemented
+	WorkerID         string                     // Required: ID of the worker reporting completion
+	Summary          string                     // Optional: summary of what was implemented
+	SelfAssessment   *repository.SelfAssessment // Optional: implementer's structured self-evaluation
+	ResolvedFindings []FindingResolution        // Required when re-submitting after a DENIED review with findings
+}
+
+// FindingResolution records how the implementer addressed a review finding.
+type FindingResolution struct {
+	FindingID  int    // Required: the finding's ID from the DENIED review
+	Resolution string // Required: how the finding was addressed (or why it was not applicable)
 }
 
 // NewReportCompleteCommand creates a new ReportCompleteCommand.
@@ -230,22 +266,74 @@ func NewReportCompleteCommand(source CommandSource, workerID, summary string) *R
 	}
 }
 
-// Validate checks that WorkerID is provided.
+// MaxSelfAssessmentItems caps the number of known gaps or untested areas a worker may report.
+const MaxSelfAssessmentItems = 20
+
+// Validate checks that WorkerID is provided and the optional self-assessment is well-formed.
 func (c *ReportCompleteCommand) Validate() error {
 	if c.WorkerID == "" {
 		return fmt.Errorf("worker_id is required")
 	}
+	if c.SelfAssessment != nil {
+		if err := validateSelfAssessment(c.SelfAssessment); err != nil {
+			return fmt.Errorf("invalid self_assessment: %w", err)
+		}
+	}
+	seen := make(map[int]bool, len(c.ResolvedFindings))
+	for i, r := range c.ResolvedFindings {
+		if r.FindingID < 1 {
+			return fmt.Errorf("resolved_findings[%d]: finding_id must be positive", i)
+		}
+		if seen[r.FindingID] {
+			return fmt.Errorf("resolved_findings[%d]: duplicate finding_id %d", i, r.FindingID)
+		}
+		seen[r.FindingID] = true
+		if strings.TrimSpace(r.Resolution) == "" {
+			return fmt.Errorf("resolved_findings[%d]: resolution is required", i)
+		}
+	}
+	return nil
+}
+
+// validateSelfAssessment checks confidence bounds and list entries of a self-assessment.
+func validateSelfAssessment(sa *repository.SelfAssessment) error {
+	if sa.Confidence < 0 || sa.Confidence > 1 {
+		return fmt.Errorf("confidence must be between 0 and 1, got %v", sa.Confidence)
+	}
+	lists := []struct {
+		field string
+		items []string
+	}{
+		{"known_gaps", sa.KnownGaps},
+		{"untested_areas", sa.UntestedAreas},
+	}
+	for _, l := range lists {
+		field, items := l.field, l.items
+		if len(items) > MaxSelfAssessmentItems {
+			return fmt.Errorf("%s cannot exceed %d entries", field, MaxSelfAssessmentItems)
+		}
+		for i, item := range items {
+			if strings.TrimSpace(item) == "" {
+				return fmt.Errorf("%s[%d] is empty", field, i)
+			}
+		}
+	}
 	return nil
 }
 
 // ReportVerdictCommand signals a reviewer's approval or denial verdict.
 type ReportVerdictCommand struct {
 	*BaseCommand
-	WorkerID string  // Required: ID of the reviewer reporting the verdict
-	Verdict  Verdict // Required: APPROVED or DENIED
-	Comments string  // Optional: review comments
+	WorkerID string                     // Required: ID of the reviewer reporting the verdict
+	Verdict  Verdict                    // Required: APPROVED or DENIED
+	Comments string                     // Optional: review comments
+	Findings []repository.ReviewFinding // Required for DENIED: the issues the implementer must resolve
+	Scores   repository.ReviewScores    // Optional: rubric scores; APPROVED requires every minimum to be met
 }
 
+// MaxReviewFindings caps the number of findings a reviewer may report in one verdict.
+const MaxReviewFindings = 50
+
 // NewReportVerdictCommand creates a new ReportVerdictCommand.
 func NewReportVerdictCommand(source CommandSource, workerID string, verdict Verdict, comments string) *ReportVerdictCommand {
 	base := NewBaseCommand(CmdReportVerdict, source)
@@ -257,7 +345,9 @@ func NewReportVerdictCommand(source CommandSource, workerID string, verdict Verd
 	}
 }
 
-// Validate checks that WorkerID and a valid Verdict are provided.
+// Validate checks that WorkerID and a valid Verdict are provided, that
+// DENIED verdicts carry well-formed findings, and that rubric scores, when
+// given, are complete and meet the minimums for an APPROVED verdict.
 func (c *ReportVerdictCommand) Validate() error {
 	if c.WorkerID == "" {
 		return fmt.Errorf("worker_id is required")
@@ -265,6 +355,57 @@ func (c *ReportVerdictCommand) Validate() error {
 	if !c.Verdict.IsValid() {
 		return fmt.Errorf("verdict must be APPROVED or DENIED, got: %s", c.Verdict)
 	}
+	if c.Scores != nil {
+		if err := c.Scores.Validate(); err != nil {
+			return fmt.Errorf("scores: %w", err)
+		}
+	}
+	if c.Verdict == VerdictApproved {
+		if len(c.Findings) > 0 {
+			return fmt.Errorf("findings are only accepted with a DENIED verdict")
+		}
+		if c.Scores == nil {
+			return nil
+		}
+		if below := c.Scores.BelowMinimum(); len(below) > 0 {
+			return fmt.Errorf("APPROVED verdicts require minimum scores; below minimum: %s", belowMinimumList(below))
+		}
+		return nil
+	}
+	if len(c.Findings) == 0 {
+		return fmt.Errorf("DENIED verdicts require at least one finding")
+	}
+	if len(c.Findings) > MaxReviewFindings {
+		return fmt.Errorf("findings cannot exceed %d entries", MaxReviewFindings)
+	}
+	for i, f := range c.Findings {
+		if err := validateReviewFinding(f); err != nil {
+			return fmt.Errorf("findings[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// belowMinimumList renders dimensions with their required minimum, e.g. "tests (min 3)".
+func belowMinimumList(dims []repository.ReviewDimension) string {
+	parts := make([]string, len(dims))
+	for i, dim := range dims {
+		parts[i] = fmt.Sprintf("%s (min %d)", dim, repository.ReviewMinimums[dim])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// validateReviewFinding checks the required fields of a review finding.
+func validateReviewFinding(f repository.ReviewFinding) error {
+	if strings.TrimSpace(f.File) == "" {
+		return fmt.Errorf("file is required")
+	}
+	if !f.Severity.IsValid() {
+		return fmt.Errorf("severity must be blocker, major, minor or info, got: %q", f.Severity)
+	}
+	if strings.TrimSpace(f.Description) == "" {
+		return fmt.Errorf("description is required")
+	}
 	return nil
 }
 
@@ -304,7 +445,8 @@ func isValidProcessPhase(phase events.ProcessPhase) bool {
 		events.ProcessPhaseAwaitingReview,
 		events.ProcessPhaseReviewing,
 		events.ProcessPhaseAddressingFeedback,
-		events.ProcessPhaseCommitting:
+		events.ProcessPhaseCommitting,
+		events.ProcessPhaseBlocked:
 		return true
 	default:
 		return false
@@ -462,29 +604,24 @@ func (c *StopProcessCommand) Validate() error {
 // Aggregation Commands
 // ===========================================================================
 
-// GenerateAccountabilitySummaryCommand sends an aggregation task to an existing worker.
-// The worker reads individual worker summaries and produces a unified session summary.
+// GenerateAccountabilitySummaryCommand aggregates the individual worker summaries
+// of a session into a session report.
 type GenerateAccountabilitySummaryCommand struct {
 	*BaseCommand
-	WorkerID   string // Required: ID of the worker to assign the aggregation task
 	SessionDir string // Required: path to the session directory containing worker summaries
 }
 
 // NewGenerateAccountabilitySummaryCommand creates a new GenerateAccountabilitySummaryCommand.
-func NewGenerateAccountabilitySummaryCommand(source CommandSource, workerID, sessionDir string) *GenerateAccountabilitySummaryCommand {
+func NewGenerateAccountabilitySummaryCommand(source CommandSource, sessionDir string) *GenerateAccountabilitySummaryCommand {
 	base := NewBaseCommand(CmdGenerateAccountabilitySummary, source)
 	return &GenerateAccountabilitySummaryCommand{
 		BaseCommand: &base,
-		WorkerID:    workerID,
 		SessionDir:  sessionDir,
 	}
 }
 
-// Validate checks that WorkerID and SessionDir are provided.
+// Validate checks that SessionDir is provided.
 func (c *GenerateAccountabilitySummaryCommand) Validate() error {
-	if c.WorkerID == "" {
-		return fmt.Errorf("worker_id is required")
-	}
 	if c.SessionDir == "" {
 		return fmt.Errorf("session_dir is required")
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/validation"
 )
 
@@ -431,6 +432,32 @@ func TestBroadcastCommand_Validate(t *testing.T) {
 	}
 }
 
+func TestBroadcastCommand_Validate_Filter(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    BroadcastFilter
+		errSubstr string
+	}{
+		{name: "all filters", filter: BroadcastFilter{Phase: events.ProcessPhaseReviewing, AgentType: "reviewer", TaskID: "perles-abc.1"}},
+		{name: "invalid phase", filter: BroadcastFilter{Phase: "sleeping"}, errSubstr: "invalid worker phase: sleeping"},
+		{name: "invalid agent type", filter: BroadcastFilter{AgentType: "wizard"}, errSubstr: "invalid agent type: wizard"},
+		{name: "invalid task id", filter: BroadcastFilter{TaskID: "../etc"}, errSubstr: "invalid task_id format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewBroadcastCommand(SourceMCPTool, "content", nil)
+			cmd.Filter = tt.filter
+			err := cmd.Validate()
+			if tt.errSubstr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errSubstr)
+		})
+	}
+}
+
 func TestBroadcastCommand_Type(t *testing.T) {
 	cmd := NewBroadcastCommand(SourceMCPTool, "content", nil)
 	require.Equal(t, CmdBroadcast, cmd.Type())
@@ -489,6 +516,87 @@ func TestReportCompleteCommand_Validate(t *testing.T) {
 	}
 }
 
+func TestReportCompleteCommand_ValidateSelfAssessment(t *testing.T) {
+	tests := []struct {
+		name      string
+		sa        *repository.SelfAssessment
+		errSubstr string
+	}{
+		{
+			name: "valid full assessment",
+			sa: &repository.SelfAssessment{
+				Confidence:    0.8,
+				KnownGaps:     []string{"no retry on network error"},
+				UntestedAreas: []string{"windows path handling"},
+			},
+		},
+		{
+			name: "valid confidence only",
+			sa:   &repository.SelfAssessment{Confidence: 1},
+		},
+		{
+			name:      "confidence above one",
+			sa:        &repository.SelfAssessment{Confidence: 1.5},
+			errSubstr: "confidence must be between 0 and 1",
+		},
+		{
+			name:      "negative confidence",
+			sa:        &repository.SelfAssessment{Confidence: -0.1},
+			errSubstr: "confidence must be between 0 and 1",
+		},
+		{
+			name:      "blank known gap",
+			sa:        &repository.SelfAssessment{Confidence: 0.5, KnownGaps: []string{"ok", "  "}},
+			errSubstr: "known_gaps[1] is empty",
+		},
+		{
+			name:      "too many untested areas",
+			sa:        &repository.SelfAssessment{Confidence: 0.5, UntestedAreas: make([]string, MaxSelfAssessmentItems+1)},
+			errSubstr: "untested_areas cannot exceed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewReportCompleteCommand(SourceMCPTool, "worker-1", "done")
+			cmd.SelfAssessment = tt.sa
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReportCompleteCommand_ValidateResolvedFindings(t *testing.T) {
+	tests := []struct {
+		name      string
+		resolved  []FindingResolution
+		errSubstr string
+	}{
+		{name: "valid", resolved: []FindingResolution{{FindingID: 1, Resolution: "added nil check"}, {FindingID: 2, Resolution: "not applicable"}}},
+		{name: "non-positive id", resolved: []FindingResolution{{FindingID: 0, Resolution: "fixed"}}, errSubstr: "finding_id must be positive"},
+		{name: "duplicate id", resolved: []FindingResolution{{FindingID: 1, Resolution: "a"}, {FindingID: 1, Resolution: "b"}}, errSubstr: "duplicate finding_id 1"},
+		{name: "blank resolution", resolved: []FindingResolution{{FindingID: 1, Resolution: " "}}, errSubstr: "resolved_findings[0]: resolution is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewReportCompleteCommand(SourceMCPTool, "worker-1", "done")
+			cmd.ResolvedFindings = tt.resolved
+			err := cmd.Validate()
+			if tt.errSubstr != "" {
+				require.ErrorContains(t, err, tt.errSubstr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestReportCompleteCommand_Type(t *testing.T) {
 	cmd := NewReportCompleteCommand(SourceCallback, "worker-1", "")
 	require.Equal(t, CmdReportComplete, cmd.Type())
@@ -508,6 +616,8 @@ func TestReportVerdictCommand_Validate(t *testing.T) {
 		workerID  string
 		verdict   Verdict
 		comments  string
+		findings  []repository.ReviewFinding
+		scores    repository.ReviewScores
 		wantErr   bool
 		errSubstr string
 	}{
@@ -519,12 +629,91 @@ func TestReportVerdictCommand_Validate(t *testing.T) {
 			wantErr:  false,
 		},
 		{
-			name:     "valid DENIED with comments",
+			name:     "valid DENIED with findings",
 			workerID: "worker-2",
 			verdict:  VerdictDenied,
 			comments: "Needs more tests",
+			findings: []repository.ReviewFinding{{File: "parser.go", Location: "42", Severity: repository.SeverityMajor, Description: "nil input panics"}},
 			wantErr:  false,
 		},
+		{
+			name:      "DENIED without findings",
+			workerID:  "worker-2",
+			verdict:   VerdictDenied,
+			comments:  "Needs more tests",
+			wantErr:   true,
+			errSubstr: "DENIED verdicts require at least one finding",
+		},
+		{
+			name:      "APPROVED with findings",
+			workerID:  "worker-2",
+			verdict:   VerdictApproved,
+			findings:  []repository.ReviewFinding{{File: "parser.go", Severity: repository.SeverityInfo, Description: "nit"}},
+			wantErr:   true,
+			errSubstr: "findings are only accepted with a DENIED verdict",
+		},
+		{
+			name:      "finding without file",
+			workerID:  "worker-2",
+			verdict:   VerdictDenied,
+			findings:  []repository.ReviewFinding{{Severity: repository.SeverityMajor, Description: "nil input panics"}},
+			wantErr:   true,
+			errSubstr: "findings[0]: file is required",
+		},
+		{
+			name:      "finding with unknown severity",
+			workerID:  "worker-2",
+			verdict:   VerdictDenied,
+			findings:  []repository.ReviewFinding{{File: "parser.go", Severity: "critical", Description: "nil input panics"}},
+			wantErr:   true,
+			errSubstr: "findings[0]: severity must be blocker, major, minor or info",
+		},
+		{
+			name:      "finding without description",
+			workerID:  "worker-2",
+			verdict:   VerdictDenied,
+			findings:  []repository.ReviewFinding{{File: "parser.go", Severity: repository.SeverityMinor, Description: " "}},
+			wantErr:   true,
+			errSubstr: "findings[0]: description is required",
+		},
+		{
+			name:      "too many findings",
+			workerID:  "worker-2",
+			verdict:   VerdictDenied,
+			findings:  make([]repository.ReviewFinding, MaxReviewFindings+1),
+			wantErr:   true,
+			errSubstr: "findings cannot exceed",
+		},
+		{
+			name:     "APPROVED with passing scores",
+			workerID: "worker-2",
+			verdict:  VerdictApproved,
+			scores:   repository.ReviewScores{"correctness": 5, "tests": 3, "style": 4, "security": 4},
+		},
+		{
+			name:      "APPROVED below minimum scores",
+			workerID:  "worker-2",
+			verdict:   VerdictApproved,
+			scores:    repository.ReviewScores{"correctness": 3, "tests": 2, "style": 4, "security": 4},
+			wantErr:   true,
+			errSubstr: "below minimum: correctness (min 4), tests (min 3)",
+		},
+		{
+			name:      "incomplete scores",
+			workerID:  "worker-2",
+			verdict:   VerdictDenied,
+			findings:  []repository.ReviewFinding{{File: "parser.go", Severity: repository.SeverityMajor, Description: "nil input panics"}},
+			scores:    repository.ReviewScores{"correctness": 2},
+			wantErr:   true,
+			errSubstr: "scores: tests score is required",
+		},
+		{
+			name:     "DENIED with low scores",
+			workerID: "worker-2",
+			verdict:  VerdictDenied,
+			findings: []repository.ReviewFinding{{File: "parser.go", Severity: repository.SeverityMajor, Description: "nil input panics"}},
+			scores:   repository.ReviewScores{"correctness": 2, "tests": 1, "style": 3, "security": 4},
+		},
 		{
 			name:     "valid without comments",
 			workerID: "worker-2",
@@ -569,6 +758,8 @@ func TestReportVerdictCommand_Validate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cmd := NewReportVerdictCommand(SourceCallback, tt.workerID, tt.verdict, tt.comments)
+			cmd.Findings = tt.findings
+			cmd.Scores = tt.scores
 			err := cmd.Validate()
 			if tt.wantErr {
 				require.Error(t, err)
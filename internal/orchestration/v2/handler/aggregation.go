@@ -4,13 +4,13 @@ package handler
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"path/filepath"
+	"time"
 
-	"github.com/zjrosen/perles/internal/orchestration/events"
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
-	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
-	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
 // ===========================================================================
@@ -18,76 +18,46 @@ import (
 // ===========================================================================
 
 // GenerateAccountabilitySummaryHandler handles CmdGenerateAccountabilitySummary commands.
-// It sends the AggregationWorkerPrompt to an existing worker to aggregate accountability
-// summaries from all workers into a unified session summary.
+// It merges the accountability summaries of all workers into a session report
+// (session_report.md and session_report.json) in the session directory.
 type GenerateAccountabilitySummaryHandler struct {
-	processRepo repository.ProcessRepository
-	queueRepo   repository.QueueRepository
+	comments appbeads.CommentReader
 }
 
 // NewGenerateAccountabilitySummaryHandler creates a new GenerateAccountabilitySummaryHandler.
-func NewGenerateAccountabilitySummaryHandler(
-	processRepo repository.ProcessRepository,
-	queueRepo repository.QueueRepository,
-) *GenerateAccountabilitySummaryHandler {
-	return &GenerateAccountabilitySummaryHandler{
-		processRepo: processRepo,
-		queueRepo:   queueRepo,
-	}
+// comments reads the tasks' work logs for time tracking; it can be nil to
+// leave work time out of the report.
+func NewGenerateAccountabilitySummaryHandler(comments appbeads.CommentReader) *GenerateAccountabilitySummaryHandler {
+	return &GenerateAccountabilitySummaryHandler{comments: comments}
 }
 
 // Handle processes a GenerateAccountabilitySummaryCommand.
-// It queues the AggregationWorkerPrompt to the specified worker and creates a follow-up to deliver it.
-func (h *GenerateAccountabilitySummaryHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+// It parses every worker summary in the session directory and writes the aggregated report.
+func (h *GenerateAccountabilitySummaryHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
 	aggCmd := cmd.(*command.GenerateAccountabilitySummaryCommand)
 
-	// Step 1: Get worker from repository
-	proc, err := h.processRepo.Get(aggCmd.WorkerID)
+	report, err := accountability.WriteReport(aggCmd.SessionDir, time.Now(), h.comments)
 	if err != nil {
-		if errors.Is(err, repository.ErrProcessNotFound) {
-			return nil, ErrProcessNotFound
-		}
-		return nil, fmt.Errorf("failed to get worker: %w", err)
-	}
-
-	// Step 2: Check if worker is retired
-	if proc.Status == repository.StatusRetired {
-		return nil, ErrProcessRetired
-	}
-
-	// Step 3: Queue the aggregation prompt to the worker
-	aggPrompt := prompt.AggregationWorkerPrompt(aggCmd.SessionDir)
-	queue := h.queueRepo.GetOrCreate(aggCmd.WorkerID)
-	if err := queue.Enqueue(aggPrompt, repository.SenderCoordinator); err != nil {
-		return nil, fmt.Errorf("failed to queue aggregation prompt: %w", err)
-	}
-
-	// Step 4: Build result
-	result := &GenerateAccountabilitySummaryResult{
-		WorkerID:   aggCmd.WorkerID,
-		SessionDir: aggCmd.SessionDir,
-		Queued:     proc.Status == repository.StatusWorking,
-		QueueSize:  queue.Size(),
-	}
-
-	// Step 5: If worker is Working, just emit queue changed event
-	if proc.Status == repository.StatusWorking {
-		event := events.NewProcessEvent(events.ProcessQueueChanged, proc.ID, proc.Role).
-			WithStatus(proc.Status).
-			WithQueueCount(queue.Size())
-		return SuccessWithEvents(result, event), nil
+		return nil, fmt.Errorf("failed to aggregate accountability summaries: %w", err)
 	}
 
-	// Step 6: Worker is Ready - create follow-up to deliver from queue
-	deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, aggCmd.WorkerID)
-
-	return SuccessWithFollowUp(result, deliverCmd), nil
+	return SuccessResult(&GenerateAccountabilitySummaryResult{
+		SessionDir:   aggCmd.SessionDir,
+		MarkdownPath: filepath.Join(aggCmd.SessionDir, accountability.ReportMarkdownFile),
+		JSONPath:     filepath.Join(aggCmd.SessionDir, accountability.ReportJSONFile),
+		Report:       report,
+	}), nil
 }
 
-// GenerateAccountabilitySummaryResult contains the result of assigning an aggregation task.
+// GenerateAccountabilitySummaryResult contains the result of aggregating accountability summaries.
 type GenerateAccountabilitySummaryResult struct {
-	WorkerID   string
-	SessionDir string
-	Queued     bool
-	QueueSize  int
+	SessionDir   string
+	MarkdownPath string
+	JSONPath     string
+	Report       *accountability.Report
+}
+
+// GetReport returns the aggregated report.
+func (r *GenerateAccountabilitySummaryResult) GetReport() *accountability.Report {
+	return r.Report
 }
@@ -2,133 +2,64 @@ package handler
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/accountability"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
-	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
 func TestGenerateAccountabilitySummaryHandler_Handle(t *testing.T) {
-	t.Run("success - queues prompt to ready worker", func(t *testing.T) {
-		processRepo := repository.NewMemoryProcessRepository()
-		queueRepo := repository.NewMemoryQueueRepository(100)
-
-		// Create a ready worker
-		worker := &repository.Process{
-			ID:     "worker-1",
-			Role:   repository.RoleWorker,
-			Status: repository.StatusReady,
-		}
-		err := processRepo.Save(worker)
-		require.NoError(t, err)
+	t.Run("success - writes session report", func(t *testing.T) {
+		sessionDir := t.TempDir()
+		tasksDir := filepath.Join(sessionDir, "workers", "worker-1", "tasks")
+		require.NoError(t, os.MkdirAll(tasksDir, 0750))
+		summary := "---\ntask_id: perles-abc.1\nworker_id: worker-1\ncommits:\n  - abc1234\n---\n\n## What I Accomplished\n\nDone.\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tasksDir, "perles-abc.1.md"), []byte(summary), 0600))
 
-		handler := NewGenerateAccountabilitySummaryHandler(processRepo, queueRepo)
-		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "worker-1", "/tmp/session")
+		handler := NewGenerateAccountabilitySummaryHandler(nil)
+		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, sessionDir)
 
 		result, err := handler.Handle(context.Background(), cmd)
 
 		require.NoError(t, err)
 		require.True(t, result.Success)
-		require.NotNil(t, result.Data)
-
-		// Verify follow-up command is created for delivery
-		require.Len(t, result.FollowUp, 1)
-		deliverCmd, ok := result.FollowUp[0].(*command.DeliverProcessQueuedCommand)
-		require.True(t, ok)
-		require.Equal(t, "worker-1", deliverCmd.ProcessID)
-
-		// Verify message was queued
-		queue := queueRepo.GetOrCreate("worker-1")
-		require.Equal(t, 1, queue.Size())
-	})
-
-	t.Run("success - queues prompt to working worker", func(t *testing.T) {
-		processRepo := repository.NewMemoryProcessRepository()
-		queueRepo := repository.NewMemoryQueueRepository(100)
-
-		// Create a working worker
-		worker := &repository.Process{
-			ID:     "worker-1",
-			Role:   repository.RoleWorker,
-			Status: repository.StatusWorking,
-		}
-		err := processRepo.Save(worker)
-		require.NoError(t, err)
-
-		handler := NewGenerateAccountabilitySummaryHandler(processRepo, queueRepo)
-		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "worker-1", "/tmp/session")
-
-		result, err := handler.Handle(context.Background(), cmd)
-
-		require.NoError(t, err)
-		require.True(t, result.Success)
-
-		// Verify no follow-up (message is queued, delivery happens when turn completes)
 		require.Empty(t, result.FollowUp)
 
-		// Verify event was emitted
-		require.Len(t, result.Events, 1)
+		aggResult, ok := result.Data.(*GenerateAccountabilitySummaryResult)
+		require.True(t, ok)
+		require.Equal(t, filepath.Join(sessionDir, accountability.ReportMarkdownFile), aggResult.MarkdownPath)
+		require.Equal(t, filepath.Join(sessionDir, accountability.ReportJSONFile), aggResult.JSONPath)
+		require.Equal(t, 1, aggResult.GetReport().Metrics.Tasks)
+		require.Equal(t, 1, aggResult.GetReport().Metrics.Commits)
 
-		// Verify message was queued
-		queue := queueRepo.GetOrCreate("worker-1")
-		require.Equal(t, 1, queue.Size())
+		require.FileExists(t, aggResult.MarkdownPath)
+		require.FileExists(t, aggResult.JSONPath)
 	})
 
-	t.Run("error - worker not found", func(t *testing.T) {
-		processRepo := repository.NewMemoryProcessRepository()
-		queueRepo := repository.NewMemoryQueueRepository(100)
-
-		handler := NewGenerateAccountabilitySummaryHandler(processRepo, queueRepo)
-		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "worker-999", "/tmp/session")
+	t.Run("error - no worker summaries", func(t *testing.T) {
+		handler := NewGenerateAccountabilitySummaryHandler(nil)
+		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, t.TempDir())
 
 		result, err := handler.Handle(context.Background(), cmd)
 
-		require.Error(t, err)
 		require.Nil(t, result)
-		require.ErrorIs(t, err, ErrProcessNotFound)
-	})
-
-	t.Run("error - worker retired", func(t *testing.T) {
-		processRepo := repository.NewMemoryProcessRepository()
-		queueRepo := repository.NewMemoryQueueRepository(100)
-
-		// Create a retired worker
-		worker := &repository.Process{
-			ID:     "worker-1",
-			Role:   repository.RoleWorker,
-			Status: repository.StatusRetired,
-		}
-		err := processRepo.Save(worker)
-		require.NoError(t, err)
-
-		handler := NewGenerateAccountabilitySummaryHandler(processRepo, queueRepo)
-		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "worker-1", "/tmp/session")
-
-		result, err := handler.Handle(context.Background(), cmd)
-
-		require.Error(t, err)
-		require.Nil(t, result)
-		require.ErrorIs(t, err, ErrProcessRetired)
+		require.ErrorIs(t, err, accountability.ErrNoSummaries)
 	})
 }
 
 func TestGenerateAccountabilitySummaryCommand_Validate(t *testing.T) {
 	t.Run("valid command", func(t *testing.T) {
-		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "worker-1", "/tmp/session")
+		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "/tmp/session")
 		err := cmd.Validate()
 		require.NoError(t, err)
 	})
 
-	t.Run("missing worker_id", func(t *testing.T) {
-		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "", "/tmp/session")
-		err := cmd.Validate()
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "worker_id is required")
-	})
-
 	t.Run("missing session_dir", func(t *testing.T) {
-		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "worker-1", "")
+		cmd := command.NewGenerateAccountabilitySummaryCommand(command.SourceMCPTool, "")
 		err := cmd.Validate()
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "session_dir is required")
@@ -6,6 +6,7 @@ package handler
 import (
 	"context"
 	"fmt"
+	"time"
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
@@ -19,7 +20,8 @@ import (
 
 // MarkTaskCompleteHandler handles CmdMarkTaskComplete commands.
 // It marks a BD task as completed by updating its status to "closed" and adding a completion comment.
-// It also deletes the in-memory task assignment if taskRepo is provided.
+// If taskRepo is provided, it stamps the implementer's work time on the task and
+// deletes the in-memory task assignment.
 type MarkTaskCompleteHandler struct {
 	bdExecutor appbeads.IssueExecutor
 	taskRepo   repository.TaskRepository
@@ -54,9 +56,13 @@ func (h *MarkTaskCompleteHandler) Handle(ctx context.Context, cmd command.Comman
 		return nil, fmt.Errorf("failed to add BD comment: %w", err)
 	}
 
-	// 3. Remove task from in-memory tracking
+	// 3. Stamp the work time and remove the task from in-memory tracking
 	// This is best-effort - task may not exist in memory if workflow was restarted
 	if h.taskRepo != nil {
+		if task, err := h.taskRepo.Get(markCmd.TaskID); err == nil && task.Implementer != "" && !task.StartedAt.IsZero() {
+			worked := beads.FormatWorkCompletedComment(task.Implementer, time.Since(task.StartedAt))
+			_ = h.bdExecutor.AddComment(markCmd.TaskID, "coordinator", worked)
+		}
 		_ = h.taskRepo.Delete(markCmd.TaskID)
 	}
 
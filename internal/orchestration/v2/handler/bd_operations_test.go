@@ -3,7 +3,9 @@ package handler
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -115,6 +117,31 @@ func TestMarkTaskCompleteHandler_DeletesTaskFromRepository(t *testing.T) {
 	require.ErrorIs(t, err, repository.ErrTaskNotFound, "task should be deleted after handle")
 }
 
+func TestMarkTaskCompleteHandler_StampsWorkTime(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusClosed).Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Task completed").Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", mock.MatchedBy(func(text string) bool {
+		return strings.HasPrefix(text, "Work completed by worker-1 after 1h0m")
+	})).Return(nil)
+
+	taskRepo := repository.NewMemoryTaskRepository()
+	require.NoError(t, taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskCommitting,
+		StartedAt:   time.Now().Add(-time.Hour),
+	}))
+
+	handler := NewMarkTaskCompleteHandler(bdExecutor, taskRepo)
+
+	cmd := command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc1.2")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
 func TestMarkTaskCompleteHandler_SucceedsWhenTaskNotInRepo(t *testing.T) {
 	bdExecutor := mocks.NewMockIssueExecutor(t)
 	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusClosed).Return(nil)
@@ -0,0 +1,246 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains handlers for emergency control commands: EmergencyStop and EmergencyResume.
+package handler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// FabricBroadcaster defines the interface for posting a message to every fabric channel.
+// This is used to make emergency HALT and RESUME notices visible everywhere.
+type FabricBroadcaster interface {
+	// Broadcast posts content to all message channels on behalf of createdBy.
+	Broadcast(content, createdBy string, kind domain.MessageKind) ([]*domain.Thread, error)
+}
+
+// ===========================================================================
+// EmergencyStopHandler
+// ===========================================================================
+
+// EmergencyStopHandler handles CmdEmergencyStop commands.
+// It pauses every live worker in place, kills their AI subprocesses, discards
+// their pending queues, and broadcasts a HALT notice to all fabric channels.
+// The coordinator is left running so it can report to the user, but workers
+// stay paused until the user issues an EmergencyResumeCommand.
+type EmergencyStopHandler struct {
+	processRepo repository.ProcessRepository
+	queueRepo   repository.QueueRepository
+	registry    *process.ProcessRegistry
+	broadcaster FabricBroadcaster
+}
+
+// EmergencyStopHandlerOption configures EmergencyStopHandler.
+type EmergencyStopHandlerOption func(*EmergencyStopHandler)
+
+// WithEmergencyStopRegistry sets the process registry for stopping live processes.
+func WithEmergencyStopRegistry(registry *process.ProcessRegistry) EmergencyStopHandlerOption {
+	return func(h *EmergencyStopHandler) {
+		h.registry = registry
+	}
+}
+
+// WithEmergencyStopBroadcaster sets the fabric broadcaster used to post the HALT notice.
+func WithEmergencyStopBroadcaster(broadcaster FabricBroadcaster) EmergencyStopHandlerOption {
+	return func(h *EmergencyStopHandler) {
+		h.broadcaster = broadcaster
+	}
+}
+
+// NewEmergencyStopHandler creates a new EmergencyStopHandler.
+func NewEmergencyStopHandler(
+	processRepo repository.ProcessRepository,
+	queueRepo repository.QueueRepository,
+	opts ...EmergencyStopHandlerOption,
+) *EmergencyStopHandler {
+	h := &EmergencyStopHandler{
+		processRepo: processRepo,
+		queueRepo:   queueRepo,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes an EmergencyStopCommand.
+// 1. Validates the command (only user or coordinator may issue it)
+// 2. Pauses every Ready/Working/Starting worker and kills its live process
+// 3. Drains the halted workers' message queues so nothing is delivered on resume
+// 4. Broadcasts a HALT message to every fabric channel
+func (h *EmergencyStopHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	stopCmd := cmd.(*command.EmergencyStopCommand)
+
+	// 1. Validate the command
+	if err := stopCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. Pause all live workers
+	var halted []string
+	var evts []any
+	for _, proc := range h.processRepo.Workers() {
+		if proc.Status.IsTerminal() || proc.Status == repository.StatusPaused {
+			continue
+		}
+
+		proc.Status = repository.StatusPaused
+		proc.EmergencyStopped = true
+		if err := h.processRepo.Save(proc); err != nil {
+			return nil, fmt.Errorf("failed to save process %s: %w", proc.ID, err)
+		}
+
+		if h.registry != nil {
+			if liveProcess := h.registry.Get(proc.ID); liveProcess != nil {
+				liveProcess.Stop()
+			}
+		}
+
+		// 3. Discard pending messages; the instructions that led here may be queued
+		h.queueRepo.GetOrCreate(proc.ID).Drain()
+
+		halted = append(halted, proc.ID)
+		evts = append(evts, events.NewProcessEvent(events.ProcessStatusChange, proc.ID, proc.Role).
+			WithStatus(events.ProcessStatusPaused).
+			WithTaskID(proc.TaskID))
+	}
+	slices.Sort(halted)
+
+	// 4. Broadcast HALT notice (best effort - workers are already stopped)
+	broadcast := false
+	if h.broadcaster != nil {
+		_, err := h.broadcaster.Broadcast(buildHaltMessage(stopCmd, halted), stopCmd.IssuedBy, domain.KindHalt)
+		if err != nil {
+			log.Warn(log.CatOrch, "Failed to broadcast emergency HALT", "error", err)
+		} else {
+			broadcast = true
+		}
+	}
+
+	result := &EmergencyStopResult{
+		IssuedBy:      stopCmd.IssuedBy,
+		Reason:        stopCmd.Reason,
+		HaltedWorkers: halted,
+		HaltBroadcast: broadcast,
+	}
+
+	return SuccessWithEvents(result, evts...), nil
+}
+
+// buildHaltMessage formats the HALT notice posted to every channel.
+func buildHaltMessage(cmd *command.EmergencyStopCommand, halted []string) string {
+	var sb strings.Builder
+	sb.WriteString("🛑 HALT — EMERGENCY STOP issued by ")
+	sb.WriteString(cmd.IssuedBy)
+	sb.WriteString(".\n")
+	if cmd.Reason != "" {
+		sb.WriteString("Reason: ")
+		sb.WriteString(cmd.Reason)
+		sb.WriteString("\n")
+	}
+	if len(halted) > 0 {
+		sb.WriteString("Halted workers: ")
+		sb.WriteString(strings.Join(halted, ", "))
+		sb.WriteString("\n")
+	}
+	sb.WriteString("All work must stop immediately. Do not run commands or modify files until the user explicitly resumes.")
+	return sb.String()
+}
+
+// EmergencyStopResult contains the result of an emergency stop.
+type EmergencyStopResult struct {
+	IssuedBy      string
+	Reason        string
+	HaltedWorkers []string // Worker IDs paused by this stop, sorted
+	HaltBroadcast bool     // true if the HALT notice was posted to fabric
+}
+
+// GetHaltedWorkers returns the halted worker IDs for interface compatibility.
+func (r *EmergencyStopResult) GetHaltedWorkers() []string {
+	return r.HaltedWorkers
+}
+
+// ===========================================================================
+// EmergencyResumeHandler
+// ===========================================================================
+
+// EmergencyResumeHandler handles CmdEmergencyResume commands.
+// It broadcasts a RESUME notice and issues ResumeProcess follow-ups for every
+// worker the emergency stop paused, reusing the standard resume flow (including
+// queue delivery). Workers paused by other means stay paused.
+type EmergencyResumeHandler struct {
+	processRepo repository.ProcessRepository
+	broadcaster FabricBroadcaster
+}
+
+// EmergencyResumeHandlerOption configures EmergencyResumeHandler.
+type EmergencyResumeHandlerOption func(*EmergencyResumeHandler)
+
+// WithEmergencyResumeBroadcaster sets the fabric broadcaster used to post the RESUME notice.
+func WithEmergencyResumeBroadcaster(broadcaster FabricBroadcaster) EmergencyResumeHandlerOption {
+	return func(h *EmergencyResumeHandler) {
+		h.broadcaster = broadcaster
+	}
+}
+
+// NewEmergencyResumeHandler creates a new EmergencyResumeHandler.
+func NewEmergencyResumeHandler(processRepo repository.ProcessRepository, opts ...EmergencyResumeHandlerOption) *EmergencyResumeHandler {
+	h := &EmergencyResumeHandler{
+		processRepo: processRepo,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle processes an EmergencyResumeCommand.
+func (h *EmergencyResumeHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	resumeCmd := cmd.(*command.EmergencyResumeCommand)
+
+	if err := resumeCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	var resumed []string
+	var followUps []command.Command
+	for _, proc := range h.processRepo.Workers() {
+		if proc.Status != repository.StatusPaused || !proc.EmergencyStopped {
+			continue
+		}
+		resumeProcCmd := command.NewResumeProcessCommand(command.SourceUser, proc.ID)
+		if resumeCmd.TraceID() != "" {
+			resumeProcCmd.SetTraceID(resumeCmd.TraceID())
+		}
+		followUps = append(followUps, resumeProcCmd)
+		resumed = append(resumed, proc.ID)
+	}
+	slices.Sort(resumed)
+
+	if h.broadcaster != nil {
+		msg := "✅ RESUME — the user lifted the emergency stop. Work may continue."
+		if _, err := h.broadcaster.Broadcast(msg, domain.AgentUser, domain.KindInfo); err != nil {
+			log.Warn(log.CatOrch, "Failed to broadcast emergency RESUME", "error", err)
+		}
+	}
+
+	result := &EmergencyResumeResult{
+		ResumedWorkers: resumed,
+	}
+
+	return SuccessWithFollowUp(result, followUps...), nil
+}
+
+// EmergencyResumeResult contains the result of lifting an emergency stop.
+type EmergencyResumeResult struct {
+	ResumedWorkers []string // Worker IDs scheduled for resume, sorted
+}
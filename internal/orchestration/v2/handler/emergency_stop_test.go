@@ -0,0 +1,198 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// fakeBroadcaster records fabric broadcasts for assertions.
+type fakeBroadcaster struct {
+	contents []string
+	senders  []string
+	kinds    []domain.MessageKind
+	err      error
+}
+
+func (f *fakeBroadcaster) Broadcast(content, createdBy string, kind domain.MessageKind) ([]*domain.Thread, error) {
+	f.contents = append(f.contents, content)
+	f.senders = append(f.senders, createdBy)
+	f.kinds = append(f.kinds, kind)
+	return nil, f.err
+}
+
+func setupEmergencyProcesses(processRepo *repository.MemoryProcessRepository) {
+	processRepo.AddProcess(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusWorking})
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, TaskID: "perles-abc.1"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady})
+	processRepo.AddProcess(&repository.Process{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusRetired})
+}
+
+// ===========================================================================
+// EmergencyStopHandler Tests
+// ===========================================================================
+
+func TestEmergencyStopHandler_PausesAllLiveWorkers(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	setupEmergencyProcesses(processRepo)
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").Enqueue("delete everything", repository.SenderCoordinator))
+
+	broadcaster := &fakeBroadcaster{}
+	h := handler.NewEmergencyStopHandler(processRepo, queueRepo,
+		handler.WithEmergencyStopBroadcaster(broadcaster))
+
+	cmd := command.NewEmergencyStopCommand(command.SourceUser, "user", "worker deleting files")
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	stopResult := result.Data.(*handler.EmergencyStopResult)
+	assert.Equal(t, []string{"worker-1", "worker-2"}, stopResult.HaltedWorkers)
+	assert.True(t, stopResult.HaltBroadcast)
+
+	for _, id := range []string{"worker-1", "worker-2"} {
+		proc, _ := processRepo.Get(id)
+		assert.Equal(t, repository.StatusPaused, proc.Status, id)
+		assert.True(t, proc.EmergencyStopped, id)
+	}
+
+	// Coordinator and retired workers are untouched
+	coord, _ := processRepo.Get(repository.CoordinatorID)
+	assert.Equal(t, repository.StatusWorking, coord.Status)
+	retired, _ := processRepo.Get("worker-3")
+	assert.Equal(t, repository.StatusRetired, retired.Status)
+
+	// Pending messages are discarded
+	assert.Equal(t, 0, queueRepo.Size("worker-1"))
+
+	// One status event per halted worker
+	require.Len(t, result.Events, 2)
+	for _, e := range result.Events {
+		assert.Equal(t, events.ProcessStatusPaused, e.(events.ProcessEvent).Status)
+	}
+
+	// HALT notice broadcast once, attributed to the issuer
+	require.Len(t, broadcaster.contents, 1)
+	assert.Equal(t, "user", broadcaster.senders[0])
+	assert.Equal(t, domain.KindHalt, broadcaster.kinds[0])
+	assert.Contains(t, broadcaster.contents[0], "HALT")
+	assert.Contains(t, broadcaster.contents[0], "worker deleting files")
+	assert.Contains(t, broadcaster.contents[0], "worker-1, worker-2")
+}
+
+func TestEmergencyStopHandler_BroadcastFailureStillHalts(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	setupEmergencyProcesses(processRepo)
+
+	broadcaster := &fakeBroadcaster{err: errors.New("fabric unavailable")}
+	h := handler.NewEmergencyStopHandler(processRepo, queueRepo,
+		handler.WithEmergencyStopBroadcaster(broadcaster))
+
+	result, err := h.Handle(context.Background(), command.NewEmergencyStopCommand(command.SourceMCPTool, "coordinator", ""))
+
+	require.NoError(t, err)
+	stopResult := result.Data.(*handler.EmergencyStopResult)
+	assert.False(t, stopResult.HaltBroadcast)
+	assert.Len(t, stopResult.HaltedWorkers, 2)
+}
+
+func TestEmergencyStopHandler_RejectsWorkerIssuer(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	setupEmergencyProcesses(processRepo)
+
+	h := handler.NewEmergencyStopHandler(processRepo, queueRepo)
+
+	_, err := h.Handle(context.Background(), command.NewEmergencyStopCommand(command.SourceMCPTool, "worker-1", ""))
+
+	require.Error(t, err)
+	proc, _ := processRepo.Get("worker-2")
+	assert.Equal(t, repository.StatusReady, proc.Status)
+}
+
+func TestEmergencyStopHandler_AlreadyPausedIsNoOp(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusPaused})
+
+	h := handler.NewEmergencyStopHandler(processRepo, queueRepo)
+
+	result, err := h.Handle(context.Background(), command.NewEmergencyStopCommand(command.SourceUser, "user", ""))
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Data.(*handler.EmergencyStopResult).HaltedWorkers)
+	assert.Empty(t, result.Events)
+}
+
+// ===========================================================================
+// EmergencyResumeHandler Tests
+// ===========================================================================
+
+func TestEmergencyResumeHandler_ResumesPausedWorkers(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady})
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusPaused, EmergencyStopped: true})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusPaused, EmergencyStopped: true})
+	processRepo.AddProcess(&repository.Process{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusReady})
+	processRepo.AddProcess(&repository.Process{ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusPaused})
+
+	broadcaster := &fakeBroadcaster{}
+	h := handler.NewEmergencyResumeHandler(processRepo,
+		handler.WithEmergencyResumeBroadcaster(broadcaster))
+
+	result, err := h.Handle(context.Background(), command.NewEmergencyResumeCommand(command.SourceUser))
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	assert.Equal(t, []string{"worker-1", "worker-2"}, result.Data.(*handler.EmergencyResumeResult).ResumedWorkers)
+
+	require.Len(t, result.FollowUp, 2)
+	for _, fu := range result.FollowUp {
+		assert.Equal(t, command.CmdResumeProcess, fu.Type())
+	}
+
+	require.Len(t, broadcaster.contents, 1)
+	assert.Equal(t, domain.AgentUser, broadcaster.senders[0])
+	assert.Contains(t, broadcaster.contents[0], "RESUME")
+}
+
+func TestEmergencyResumeHandler_KeepsManuallyPausedWorkersPaused(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusPaused})
+
+	_, err := handler.NewEmergencyStopHandler(processRepo, queueRepo).
+		Handle(context.Background(), command.NewEmergencyStopCommand(command.SourceUser, "user", ""))
+	require.NoError(t, err)
+
+	result, err := handler.NewEmergencyResumeHandler(processRepo).
+		Handle(context.Background(), command.NewEmergencyResumeCommand(command.SourceUser))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"worker-1"}, result.Data.(*handler.EmergencyResumeResult).ResumedWorkers)
+	require.Len(t, result.FollowUp, 1)
+
+	// Resuming the worker clears the emergency stop mark
+	_, err = handler.NewResumeProcessHandler(processRepo, queueRepo).Handle(context.Background(), result.FollowUp[0])
+	require.NoError(t, err)
+	proc, _ := processRepo.Get("worker-1")
+	assert.Equal(t, repository.StatusReady, proc.Status)
+	assert.False(t, proc.EmergencyStopped)
+}
+
+func TestEmergencyResumeHandler_RejectsNonUserSource(t *testing.T) {
+	processRepo, _ := setupProcessRepos()
+	h := handler.NewEmergencyResumeHandler(processRepo)
+
+	_, err := h.Handle(context.Background(), command.NewEmergencyResumeCommand(command.SourceMCPTool))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be issued by the user")
+}
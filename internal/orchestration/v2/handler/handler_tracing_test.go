@@ -92,6 +92,7 @@ func TestAssignTaskHandler_Tracing_CreatesSpan(t *testing.T) {
 	bdExecutor := mocks.NewMockIssueExecutor(t)
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "task-123", Status: beads.StatusOpen}, nil)
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+	bdExecutor.EXPECT().AddComment("task-123", "coordinator", "Work started by worker-1").Return(nil)
 
 	proc := &repository.Process{
 		ID:        "worker-1",
@@ -137,6 +138,7 @@ func TestAssignTaskHandler_Tracing_RecordsEvents(t *testing.T) {
 	bdExecutor := mocks.NewMockIssueExecutor(t)
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "task-123", Status: beads.StatusOpen}, nil)
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+	bdExecutor.EXPECT().AddComment("task-123", "coordinator", "Work started by worker-1").Return(nil)
 
 	proc := &repository.Process{
 		ID:        "worker-1",
@@ -198,6 +200,7 @@ func TestAssignTaskHandler_Tracing_WorksWithNilTracer(t *testing.T) {
 	bdExecutor := mocks.NewMockIssueExecutor(t)
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "task-123", Status: beads.StatusOpen}, nil)
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+	bdExecutor.EXPECT().AddComment("task-123", "coordinator", "Work started by worker-1").Return(nil)
 
 	proc := &repository.Process{
 		ID:        "worker-1",
@@ -577,6 +580,7 @@ func TestAssignTaskHandler_Tracing_SpanEndsOnSuccess(t *testing.T) {
 	bdExecutor := mocks.NewMockIssueExecutor(t)
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "task-123", Status: beads.StatusOpen}, nil)
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+	bdExecutor.EXPECT().AddComment("task-123", "coordinator", "Work started by worker-1").Return(nil)
 
 	proc := &repository.Process{
 		ID:        "worker-1",
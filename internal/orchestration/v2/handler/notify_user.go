@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/zjrosen/perles/internal/notify"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/sound"
@@ -16,9 +17,11 @@ import (
 // ===========================================================================
 
 // NotifyUserHandler handles CmdNotifyUser commands.
-// It plays a notification sound and emits a ProcessUserNotification event.
+// It plays a notification sound, shows a desktop notification and emits a
+// ProcessUserNotification event.
 type NotifyUserHandler struct {
 	soundService sound.SoundService
+	notifier     notify.Notifier
 }
 
 // NotifyUserHandlerOption configures NotifyUserHandler.
@@ -34,10 +37,21 @@ func WithNotifyUserSoundService(svc sound.SoundService) NotifyUserHandlerOption
 	}
 }
 
+// WithNotifyUserNotifier sets the desktop notifier for user notifications.
+// If n is nil, the handler keeps its default NoopNotifier.
+func WithNotifyUserNotifier(n notify.Notifier) NotifyUserHandlerOption {
+	return func(h *NotifyUserHandler) {
+		if n != nil {
+			h.notifier = n
+		}
+	}
+}
+
 // NewNotifyUserHandler creates a new NotifyUserHandler.
 func NewNotifyUserHandler(opts ...NotifyUserHandlerOption) *NotifyUserHandler {
 	h := &NotifyUserHandler{
 		soundService: sound.NoopSoundService{},
+		notifier:     notify.NoopNotifier{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -47,7 +61,7 @@ func NewNotifyUserHandler(opts ...NotifyUserHandlerOption) *NotifyUserHandler {
 
 // Handle processes a NotifyUserCommand.
 // 1. Validates the command
-// 2. Plays the user_notification sound
+// 2. Plays the user_notification sound and shows a desktop notification
 // 3. Emits ProcessUserNotification event for the TUI to display
 func (h *NotifyUserHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
 	notifyCmd := cmd.(*command.NotifyUserCommand)
@@ -57,8 +71,9 @@ func (h *NotifyUserHandler) Handle(_ context.Context, cmd command.Command) (*com
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
-	// 2. Play notification sound
+	// 2. Play notification sound and show desktop notification
 	h.soundService.Play("notification", "user_notification")
+	h.notifier.Notify(notifyUserTitle(notifyCmd.TaskID), notifyCmd.Message, "user_notification")
 
 	// 3. Build ProcessUserNotification event
 	event := events.NewProcessEvent(events.ProcessUserNotification, "coordinator", events.RoleCoordinator).
@@ -74,6 +89,14 @@ func (h *NotifyUserHandler) Handle(_ context.Context, cmd command.Command) (*com
 	return SuccessWithEvents(result, event), nil
 }
 
+// notifyUserTitle returns the desktop notification title for a user notification.
+func notifyUserTitle(taskID string) string {
+	if taskID == "" {
+		return "Perles: coordinator needs you"
+	}
+	return "Perles: " + taskID
+}
+
 // NotifyUserResult contains the result of notifying the user.
 type NotifyUserResult struct {
 	Message string
@@ -118,6 +118,27 @@ func TestNotifyUserHandler_PlaysNotificationSound(t *testing.T) {
 	// Sound service mock expectations are automatically verified on cleanup
 }
 
+func TestNotifyUserHandler_ShowsDesktopNotification(t *testing.T) {
+	notifier := mocks.NewMockNotifier(t)
+	notifier.EXPECT().Notify("Perles: perles-abc1", "Review required", "user_notification").Once()
+
+	h := handler.NewNotifyUserHandler(
+		handler.WithNotifyUserNotifier(notifier),
+	)
+
+	cmd := command.NewNotifyUserCommand(
+		command.SourceMCPTool,
+		"Review required",
+		"phase-1",
+		"perles-abc1",
+	)
+
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
 func TestNotifyUserHandler_DefaultNoopSoundService(t *testing.T) {
 	// Create handler WITHOUT sound service option - should use NoopSoundService
 	h := handler.NewNotifyUserHandler()
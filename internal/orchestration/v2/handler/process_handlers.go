@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -15,8 +17,10 @@ import (
 	"go.opentelemetry.io/otel/trace/noop"
 
 	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/client"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
+	"github.com/zjrosen/perles/internal/orchestration/turnpolicy"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
@@ -153,20 +157,16 @@ func (h *SendToProcessHandler) handleWithSpan(_ context.Context, sendCmd *comman
 		return nil, ErrProcessRetired
 	}
 
-	// Determine sender based on command source
-	var sender repository.SenderType
-	switch sendCmd.Source() {
-	case command.SourceMCPTool:
-		sender = repository.SenderCoordinator
-	case command.SourceInternal:
-		sender = repository.SenderSystem
-	default:
-		sender = repository.SenderUser
-	}
+	sender := messageSender(sendCmd.Source())
 
-	// Always enqueue the message first - queue is the single path for all messages
+	// Always enqueue the message first - queue is the single path for all messages.
+	// Urgent messages (e.g. assistance requests) jump ahead of normal ones.
 	queue := h.queueRepo.GetOrCreate(sendCmd.ProcessID)
-	if err := queue.Enqueue(sendCmd.Content, sender); err != nil {
+	enqueue := queue.Enqueue
+	if sendCmd.Priority() > 0 {
+		enqueue = queue.EnqueueUrgent
+	}
+	if err := enqueue(sendCmd.Content, sender); err != nil {
 		return nil, fmt.Errorf("failed to enqueue message: %w", err)
 	}
 
@@ -205,6 +205,18 @@ func (h *SendToProcessHandler) handleWithSpan(_ context.Context, sendCmd *comman
 	return SuccessWithFollowUp(result, deliverCmd), nil
 }
 
+// messageSender returns who sent a message from the source of its command.
+func messageSender(source command.CommandSource) repository.SenderType {
+	switch source {
+	case command.SourceMCPTool:
+		return repository.SenderCoordinator
+	case command.SourceInternal:
+		return repository.SenderSystem
+	default:
+		return repository.SenderUser
+	}
+}
+
 // SendToProcessResult contains the result of sending a message to a process.
 type SendToProcessResult struct {
 	ProcessID string
@@ -225,6 +237,7 @@ type DeliverProcessQueuedHandler struct {
 	registry    *process.ProcessRegistry
 	deliverer   MessageDeliverer
 	enforcer    TurnCompletionEnforcer
+	turnTraces  *tracing.TurnTraces
 }
 
 // DeliverProcessQueuedHandlerOption configures DeliverProcessQueuedHandler.
@@ -245,6 +258,14 @@ func WithDeliverTurnEnforcer(enforcer TurnCompletionEnforcer) DeliverProcessQueu
 	}
 }
 
+// WithDeliverTurnTraces records the delivering span as the parent of the tool
+// calls the process makes during its new turn.
+func WithDeliverTurnTraces(turns *tracing.TurnTraces) DeliverProcessQueuedHandlerOption {
+	return func(h *DeliverProcessQueuedHandler) {
+		h.turnTraces = turns
+	}
+}
+
 // NewDeliverProcessQueuedHandler creates a new DeliverProcessQueuedHandler.
 func NewDeliverProcessQueuedHandler(
 	processRepo repository.ProcessRepository,
@@ -296,7 +317,7 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 	// Process must be Ready to receive delivery
 	// If Working, re-enqueue and return (shouldn't happen in normal operation)
 	if proc.Status == repository.StatusWorking {
-		_ = queue.Enqueue(entry.Content, entry.Sender)
+		requeue(queue, entry)
 		result := &DeliverProcessQueuedResult{
 			ProcessID:  proc.ID,
 			Delivered:  false,
@@ -306,11 +327,18 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 		return SuccessResult(result), nil
 	}
 
-	// Update process status to Working
+	// Update process status to Working. A blocked worker receiving a message
+	// has been answered and resumes the phase it requested assistance in.
+	prevPhase, assistance := proc.Phase, proc.Assistance
 	proc.Status = repository.StatusWorking
+	if assistance != nil {
+		resume := assistance.ResumePhase
+		proc.Phase = &resume
+		proc.Assistance = nil
+	}
 	if err := h.processRepo.Save(proc); err != nil {
 		// Re-enqueue on failure (preserve sender)
-		_ = queue.Enqueue(entry.Content, entry.Sender)
+		requeue(queue, entry)
 		return nil, fmt.Errorf("failed to update process status: %w", err)
 	}
 
@@ -319,8 +347,9 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 		if err := h.deliverer.Deliver(ctx, proc.ID, entry.Content); err != nil {
 			// Revert process status on delivery failure (preserve sender)
 			proc.Status = repository.StatusReady
+			proc.Phase, proc.Assistance = prevPhase, assistance
 			_ = h.processRepo.Save(proc)
-			_ = queue.Enqueue(entry.Content, entry.Sender)
+			requeue(queue, entry)
 			return nil, fmt.Errorf("failed to deliver message: %w", err)
 		}
 	}
@@ -332,6 +361,7 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 	if h.enforcer != nil && entry.Sender != repository.SenderSystem {
 		h.enforcer.ResetTurn(proc.ID)
 	}
+	h.turnTraces.Begin(ctx, proc.ID)
 
 	// Build events
 	var resultEvents []any
@@ -342,6 +372,13 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 		WithTaskID(proc.TaskID)
 	resultEvents = append(resultEvents, workingEvent)
 
+	// Emit the phase change of a worker leaving the blocked phase
+	if assistance != nil {
+		resultEvents = append(resultEvents, events.NewProcessEvent(events.ProcessStatusChange, proc.ID, proc.Role).
+			WithStatus(events.ProcessStatusWorking).
+			WithPhase(*proc.Phase))
+	}
+
 	// Emit ProcessIncoming event with the message
 	incomingEvent := events.NewProcessEvent(events.ProcessIncoming, proc.ID, proc.Role).
 		WithMessage(entry.Content).
@@ -366,6 +403,15 @@ func (h *DeliverProcessQueuedHandler) Handle(ctx context.Context, cmd command.Co
 	return SuccessWithEvents(result, resultEvents...), nil
 }
 
+// requeue puts back an entry that could not be delivered, keeping its urgency.
+func requeue(queue *repository.MessageQueue, entry *repository.QueueEntry) {
+	if entry.Urgent {
+		_ = queue.EnqueueUrgent(entry.Content, entry.Sender)
+		return
+	}
+	_ = queue.Enqueue(entry.Content, entry.Sender)
+}
+
 // DeliverProcessQueuedResult contains the result of delivering queued messages.
 type DeliverProcessQueuedResult struct {
 	ProcessID  string
@@ -616,22 +662,37 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	// Turn completion enforcement for workers
 	// ===========================================================================
 	// Check exemptions FIRST (workers only, coordinators are never enforced)
+	var enforcementEvents []any
+	var replaceCmd command.Command
 	if proc.Role == repository.RoleWorker && h.enforcer != nil {
 		// Skip if turn failed (crash, error, context exceeded)
 		if !turnCmd.Succeeded {
 			// Skip enforcement - process had an error
 		} else if h.enforcer.IsNewlySpawned(proc.ID) {
 			// Skip enforcement - startup turn (workers call fabric_join on first turn)
+		} else if proc.Phase != nil && *proc.Phase == events.ProcessPhaseBlocked {
+			// Skip enforcement - worker is waiting for the assistance it requested
 		} else {
-			// Check tool calls
-			missingTools := h.enforcer.CheckTurnCompletion(proc.ID, proc.Role)
+			// Check tool calls against the tools required in the worker's phase
+			phase := ""
+			if proc.Phase != nil {
+				phase = string(*proc.Phase)
+			}
+			missingTools := h.enforcer.CheckPhaseCompletion(proc.ID, phase)
 			if len(missingTools) > 0 {
-				if h.enforcer.ShouldRetry(proc.ID) {
-					// Increment retry counter
-					h.enforcer.IncrementRetry(proc.ID)
+				decision := h.enforcer.Escalate(proc.ID, phase, missingTools)
+				enforcementEvents = append(enforcementEvents, decision)
+				log.Info(log.CatOrch, "Turn policy decision", "processID", proc.ID, "phase", phase,
+					"action", decision.Action, "turns", decision.Turns)
 
+				switch decision.Action {
+				case turnpolicy.ActionNudge, turnpolicy.ActionWarn:
 					// Generate reminder message
 					reminder := h.enforcer.GetReminderMessage(proc.ID, missingTools)
+					if decision.Action == turnpolicy.ActionWarn {
+						reminder = fmt.Sprintf("[WARNING] You ended %d turns in a row without a required tool call; "+
+							"the coordinator has been told.\n\n%s", decision.Turns, reminder)
+					}
 
 					// Enqueue the reminder directly to the queue
 					queue := h.queueRepo.GetOrCreate(proc.ID)
@@ -644,9 +705,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 					proc.LastActivityAt = time.Now()
 
 					// Update metrics if provided
-					if turnCmd.Metrics != nil {
-						proc.Metrics = turnCmd.Metrics
-					}
+					proc.RecordTurnMetrics(turnCmd.Metrics)
 
 					if err := h.processRepo.Save(proc); err != nil {
 						return nil, fmt.Errorf("failed to save process: %w", err)
@@ -657,6 +716,15 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 					if turnCmd.TraceID() != "" {
 						deliverCmd.SetTraceID(turnCmd.TraceID())
 					}
+					followUps := []command.Command{deliverCmd}
+
+					// Warn the coordinator, if the workflow has one
+					if decision.Action == turnpolicy.ActionWarn {
+						if _, err := h.processRepo.GetCoordinator(); err == nil {
+							followUps = append(followUps, command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID,
+								fmt.Sprintf("[TURN POLICY] %s. It was reminded to call one of: %s.", decision.Summary(), decision.ToolList())))
+						}
+					}
 
 					result := &ProcessTurnCompleteResult{
 						ProcessID:            proc.ID,
@@ -666,10 +734,15 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 						EnforcementTriggered: true,
 					}
 
-					return SuccessWithEventsAndFollowUp(result, nil, []command.Command{deliverCmd}), nil
+					return SuccessWithEventsAndFollowUp(result, enforcementEvents, followUps), nil
+				case turnpolicy.ActionReplace:
+					// Complete the turn, then replace the worker with a fresh one
+					replaceCmd = command.NewReplaceProcessCommand(command.SourceInternal, proc.ID,
+						"turn policy: "+decision.Summary())
+				default:
+					// Escalation exhausted - log warning and allow turn to complete
+					h.enforcer.OnMaxRetriesExceeded(proc.ID, missingTools)
 				}
-				// Max retries exceeded - log warning and allow turn to complete
-				h.enforcer.OnMaxRetriesExceeded(proc.ID, missingTools)
 			}
 		}
 	}
@@ -794,9 +867,7 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 	proc.LastActivityAt = time.Now()
 
 	// Update metrics if provided
-	if turnCmd.Metrics != nil {
-		proc.Metrics = turnCmd.Metrics
-	}
+	proc.RecordTurnMetrics(turnCmd.Metrics)
 
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to save process: %w", err)
@@ -817,14 +888,20 @@ func (h *ProcessTurnCompleteHandler) Handle(ctx context.Context, cmd command.Com
 		followUps = append(followUps, deliverCmd)
 	}
 
+	// A worker replaced by the turn policy gets no further messages
+	if replaceCmd != nil {
+		followUps = []command.Command{replaceCmd}
+	}
+
 	result := &ProcessTurnCompleteResult{
-		ProcessID:      proc.ID,
-		NewStatus:      repository.StatusReady,
-		QueuedDelivery: len(followUps) > 0,
-		WasNoOp:        false,
+		ProcessID:            proc.ID,
+		NewStatus:            repository.StatusReady,
+		QueuedDelivery:       replaceCmd == nil && len(followUps) > 0,
+		WasNoOp:              false,
+		EnforcementTriggered: replaceCmd != nil,
 	}
 
-	return SuccessWithEventsAndFollowUp(result, []any{readyEvent}, followUps), nil
+	return SuccessWithEventsAndFollowUp(result, append([]any{readyEvent}, enforcementEvents...), followUps), nil
 }
 
 // ProcessTurnCompleteResult contains the result of handling turn completion.
@@ -944,20 +1021,27 @@ type RetireProcessResult struct {
 // ===========================================================================
 
 // BroadcastHandler handles CmdBroadcast commands.
-// It sends a message to all active processes by creating SendToWorker follow-up commands.
+// It queues a message for every active worker matching the broadcast's filter,
+// with the same queue-or-deliver logic as SendToProcessHandler, and reports
+// the delivery to each worker.
 type BroadcastHandler struct {
 	processRepo repository.ProcessRepository
+	queueRepo   repository.QueueRepository
 }
 
 // NewBroadcastHandler creates a new BroadcastHandler.
-func NewBroadcastHandler(processRepo repository.ProcessRepository) *BroadcastHandler {
+func NewBroadcastHandler(processRepo repository.ProcessRepository, queueRepo repository.QueueRepository) *BroadcastHandler {
 	return &BroadcastHandler{
 		processRepo: processRepo,
+		queueRepo:   queueRepo,
 	}
 }
 
 // Handle processes a BroadcastCommand.
-// Creates SendToProcessCommand follow-ups for each active process not in exclude list.
+// Enqueues the message for each matching active worker not in the exclude list.
+// Ready workers get a DeliverProcessQueuedCommand follow-up; working workers
+// receive it when their turn completes. A worker whose queue rejects the
+// message is reported without failing the broadcast.
 func (h *BroadcastHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	broadcastCmd := cmd.(*command.BroadcastCommand)
 
@@ -967,45 +1051,75 @@ func (h *BroadcastHandler) Handle(ctx context.Context, cmd command.Command) (*co
 		excludeSet[workerID] = true
 	}
 
-	// Get all active processes
-	activeProcesses := h.processRepo.ActiveWorkers()
+	// Sorted so deliveries are reported in a stable order
+	workers := h.processRepo.ActiveWorkers()
+	slices.SortFunc(workers, func(a, b *repository.Process) int { return strings.Compare(a.ID, b.ID) })
 
-	// Filter excluded processes and create follow-up commands
+	sender := messageSender(broadcastCmd.Source())
+	result := &BroadcastResult{ExcludedWorkers: broadcastCmd.ExcludeWorkers}
+	var evts []any
 	var followUps []command.Command
-	var targetWorkerIDs []string
 
-	for _, proc := range activeProcesses {
-		// Skip excluded processes
-		if excludeSet[proc.ID] {
+	for _, proc := range workers {
+		if excludeSet[proc.ID] || !matchesBroadcastFilter(proc, broadcastCmd.Filter) {
 			continue
 		}
 
-		// Create SendToProcessCommand for each target process
-		sendCmd := command.NewSendToProcessCommand(command.SourceInternal, proc.ID, broadcastCmd.Content)
-		if broadcastCmd.TraceID() != "" {
-			sendCmd.SetTraceID(broadcastCmd.TraceID())
+		delivery := command.BroadcastDelivery{WorkerID: proc.ID}
+		queue := h.queueRepo.GetOrCreate(proc.ID)
+		if err := queue.Enqueue(broadcastCmd.Content, sender); err != nil {
+			delivery.Err = fmt.Errorf("failed to enqueue message: %w", err)
+			result.Deliveries = append(result.Deliveries, delivery)
+			continue
 		}
-
-		followUps = append(followUps, sendCmd)
-		targetWorkerIDs = append(targetWorkerIDs, proc.ID)
+		delivery.QueueSize = queue.Size()
+		result.MessagesSent++
+
+		if proc.Status == repository.StatusWorking {
+			delivery.Queued = true
+			evts = append(evts, events.NewProcessEvent(events.ProcessQueueChanged, proc.ID, proc.Role).
+				WithStatus(proc.Status).
+				WithQueueCount(queue.Size()))
+		} else {
+			deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, proc.ID)
+			if broadcastCmd.TraceID() != "" {
+				deliverCmd.SetTraceID(broadcastCmd.TraceID())
+			}
+			followUps = append(followUps, deliverCmd)
+		}
+		result.Deliveries = append(result.Deliveries, delivery)
 	}
 
-	result := &BroadcastResult{
-		TargetWorkers:   targetWorkerIDs,
-		ExcludedWorkers: broadcastCmd.ExcludeWorkers,
-		MessagesSent:    len(followUps),
-	}
+	return SuccessWithEventsAndFollowUp(result, evts, followUps), nil
+}
 
-	return SuccessWithFollowUp(result, followUps...), nil
+// matchesBroadcastFilter reports whether proc matches all set fields of filter.
+func matchesBroadcastFilter(proc *repository.Process, filter command.BroadcastFilter) bool {
+	if filter.Phase != "" && (proc.Phase == nil || *proc.Phase != filter.Phase) {
+		return false
+	}
+	if filter.AgentType != roles.AgentTypeGeneric && proc.AgentType != filter.AgentType {
+		return false
+	}
+	if filter.TaskID != "" && proc.TaskID != filter.TaskID {
+		return false
+	}
+	return true
 }
 
 // BroadcastResult contains the result of broadcasting a message.
 type BroadcastResult struct {
-	TargetWorkers   []string
+	// Deliveries has one entry per targeted worker, ordered by worker ID.
+	Deliveries      []command.BroadcastDelivery
 	ExcludedWorkers []string
 	MessagesSent    int
 }
 
+// GetDeliveries returns the per-worker deliveries for interface compatibility.
+func (r *BroadcastResult) GetDeliveries() []command.BroadcastDelivery {
+	return r.Deliveries
+}
+
 // ===========================================================================
 // SpawnProcessHandler
 // ===========================================================================
@@ -1139,6 +1253,7 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 		CreatedAt:      time.Now(),
 		LastActivityAt: time.Now(),
 		AgentType:      spawnCmd.AgentType,
+		Backend:        string(spawnCmd.Backend),
 	}
 
 	// Save to repository
@@ -1153,6 +1268,7 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 		opts := SpawnOptions{
 			AgentType:      spawnCmd.AgentType,
 			WorkflowConfig: spawnCmd.WorkflowConfig,
+			Backend:        spawnCmd.Backend,
 		}
 
 		var err error
@@ -1186,7 +1302,8 @@ func (h *SpawnProcessHandler) handleSpawn(ctx context.Context, spawnCmd *command
 
 	// Emit ProcessSpawned event
 	event := events.NewProcessEvent(events.ProcessSpawned, processID, spawnCmd.Role).
-		WithStatus(proc.Status)
+		WithStatus(proc.Status).
+		WithBackend(proc.Backend)
 
 	result := &SpawnProcessResult{
 		ProcessID: processID,
@@ -1589,6 +1706,7 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 		Status:         repository.StatusPending,
 		CreatedAt:      time.Now(),
 		LastActivityAt: time.Now(),
+		Backend:        proc.Backend,
 	}
 
 	if err := h.processRepo.Save(newProc); err != nil {
@@ -1597,8 +1715,10 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 
 	// Spawn new worker process
 	if h.spawner != nil {
-		// Replacement workers use generic agent type (agent type is not preserved across replacements)
-		newLiveProcess, err := h.spawner.SpawnProcess(ctx, newWorkerID, repository.RoleWorker, SpawnOptions{})
+		// Replacement workers use generic agent type (agent type is not preserved across replacements),
+		// but keep the backend so the coordinator's model choice survives context exhaustion
+		opts := SpawnOptions{Backend: client.ClientType(proc.Backend)}
+		newLiveProcess, err := h.spawner.SpawnProcess(ctx, newWorkerID, repository.RoleWorker, opts)
 		if err != nil {
 			return nil, fmt.Errorf("failed to spawn new worker: %w", err)
 		}
@@ -1624,7 +1744,8 @@ func (h *ReplaceProcessHandler) replaceWorker(ctx context.Context, proc *reposit
 
 	// New worker spawned
 	spawnedEvent := events.NewProcessEvent(events.ProcessSpawned, newWorkerID, events.RoleWorker).
-		WithStatus(newProc.Status)
+		WithStatus(newProc.Status).
+		WithBackend(newProc.Backend)
 	resultEvents = append(resultEvents, spawnedEvent)
 
 	result := &ReplaceProcessResult{
@@ -1819,6 +1940,7 @@ func (h *ResumeProcessHandler) Handle(ctx context.Context, cmd command.Command)
 
 	// Update process status to Ready
 	proc.Status = repository.StatusReady
+	proc.EmergencyStopped = false
 	proc.LastActivityAt = time.Now()
 	if err := h.processRepo.Save(proc); err != nil {
 		return nil, fmt.Errorf("failed to save process: %w", err)
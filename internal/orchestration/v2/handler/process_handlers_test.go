@@ -12,6 +12,7 @@ import (
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/metrics"
+	"github.com/zjrosen/perles/internal/orchestration/turnpolicy"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
@@ -204,6 +205,104 @@ func TestSendToProcessHandler_SendToRetiredProcess_ReturnsError(t *testing.T) {
 	assert.ErrorIs(t, err, handler.ErrProcessRetired)
 }
 
+// ===========================================================================
+// BroadcastHandler Tests
+// ===========================================================================
+
+// addBroadcastWorkers adds a ready idle implementer, a working reviewer, an
+// implementer on perles-abc.1 and a retired worker.
+func addBroadcastWorkers(processRepo *repository.MemoryProcessRepository) {
+	idle, reviewing, implementing := events.ProcessPhaseIdle, events.ProcessPhaseReviewing, events.ProcessPhaseImplementing
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle, AgentType: roles.AgentTypeImplementer})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &reviewing, AgentType: roles.AgentTypeReviewer})
+	processRepo.AddProcess(&repository.Process{ID: "worker-3", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &implementing, AgentType: roles.AgentTypeImplementer, TaskID: "perles-abc.1"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-4", Role: repository.RoleWorker, Status: repository.StatusRetired})
+	processRepo.AddProcess(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady})
+}
+
+func TestBroadcastHandler_DeliversToActiveWorkers(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	addBroadcastWorkers(processRepo)
+	h := handler.NewBroadcastHandler(processRepo, queueRepo)
+
+	cmd := command.NewBroadcastCommand(command.SourceMCPTool, "Rebase on main", nil)
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	broadcastResult := result.Data.(*handler.BroadcastResult)
+	require.Equal(t, []command.BroadcastDelivery{
+		{WorkerID: "worker-1", QueueSize: 1},
+		{WorkerID: "worker-2", Queued: true, QueueSize: 1},
+		{WorkerID: "worker-3", Queued: true, QueueSize: 1},
+	}, broadcastResult.Deliveries)
+	require.Equal(t, 3, broadcastResult.MessagesSent)
+
+	// The ready worker gets a delivery follow-up, the busy ones a queue change event
+	require.Len(t, result.FollowUp, 1)
+	require.Equal(t, "worker-1", result.FollowUp[0].(*command.DeliverProcessQueuedCommand).ProcessID)
+	require.Len(t, result.Events, 2)
+	require.Equal(t, events.ProcessQueueChanged, result.Events[0].(events.ProcessEvent).Type)
+
+	msg, ok := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.True(t, ok)
+	require.Equal(t, "Rebase on main", msg.Content)
+	require.Equal(t, repository.SenderCoordinator, msg.Sender)
+	require.Zero(t, queueRepo.GetOrCreate(repository.CoordinatorID).Size(), "the coordinator is not a worker")
+}
+
+func TestBroadcastHandler_Filters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  command.BroadcastFilter
+		exclude []string
+		want    []string
+	}{
+		{name: "phase", filter: command.BroadcastFilter{Phase: events.ProcessPhaseReviewing}, want: []string{"worker-2"}},
+		{name: "agent type", filter: command.BroadcastFilter{AgentType: roles.AgentTypeImplementer}, want: []string{"worker-1", "worker-3"}},
+		{name: "task", filter: command.BroadcastFilter{TaskID: "perles-abc.1"}, want: []string{"worker-3"}},
+		{name: "all filters must match", filter: command.BroadcastFilter{Phase: events.ProcessPhaseIdle, AgentType: roles.AgentTypeReviewer}},
+		{name: "exclusions", filter: command.BroadcastFilter{AgentType: roles.AgentTypeImplementer}, exclude: []string{"worker-1"}, want: []string{"worker-3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processRepo, queueRepo := setupProcessRepos()
+			addBroadcastWorkers(processRepo)
+			h := handler.NewBroadcastHandler(processRepo, queueRepo)
+
+			cmd := command.NewBroadcastCommand(command.SourceMCPTool, "message", tt.exclude)
+			cmd.Filter = tt.filter
+			result, err := h.Handle(context.Background(), cmd)
+			require.NoError(t, err)
+
+			var got []string
+			for _, d := range result.Data.(*handler.BroadcastResult).Deliveries {
+				got = append(got, d.WorkerID)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBroadcastHandler_FullQueue_ReportsFailedDelivery(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	queueRepo := repository.NewMemoryQueueRepository(1)
+	addBroadcastWorkers(processRepo)
+	require.NoError(t, queueRepo.GetOrCreate("worker-2").Enqueue("earlier", repository.SenderCoordinator))
+	h := handler.NewBroadcastHandler(processRepo, queueRepo)
+
+	result, err := h.Handle(context.Background(), command.NewBroadcastCommand(command.SourceMCPTool, "message", nil))
+
+	require.NoError(t, err)
+	require.True(t, result.Success, "one full queue does not fail the broadcast")
+	broadcastResult := result.Data.(*handler.BroadcastResult)
+	require.Len(t, broadcastResult.Deliveries, 3)
+	require.ErrorIs(t, broadcastResult.Deliveries[1].Err, repository.ErrQueueFull)
+	require.Equal(t, 2, broadcastResult.MessagesSent)
+	require.Len(t, result.Events, 1)
+}
+
 // ===========================================================================
 // DeliverProcessQueuedHandler Tests
 // ===========================================================================
@@ -1561,6 +1660,47 @@ func TestProcessTurnCompleteHandler_MaxRetriesThenOnMaxRetriesExceededCalled(t *
 	assert.NotEmpty(t, maxRetriesMissingTools)
 }
 
+func TestProcessTurnCompleteHandler_TurnPolicyWarnsCoordinatorThenReplaces(t *testing.T) {
+	processRepo, queueRepo := setupProcessRepos()
+	enforcer := handler.NewTurnCompletionTrackerWithOptions(handler.WithPolicy(turnpolicy.Policy{
+		Phases:     map[string]turnpolicy.Rule{"reviewing": {Tools: []string{"report_review_verdict"}}},
+		Escalation: []turnpolicy.Action{turnpolicy.ActionWarn, turnpolicy.ActionReplace},
+	}))
+
+	reviewing := events.ProcessPhaseReviewing
+	processRepo.AddProcess(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady})
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: &reviewing})
+
+	// fabric_send does not complete a turn in the reviewing phase
+	enforcer.RecordToolCall("worker-1", "fabric_send")
+
+	h := handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
+		handler.WithProcessTurnEnforcer(enforcer))
+
+	// First incomplete turn: the worker is reminded and the coordinator warned
+	result, err := h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.True(t, result.Data.(*handler.ProcessTurnCompleteResult).EnforcementTriggered)
+	require.Len(t, result.FollowUp, 2)
+	warning := result.FollowUp[1].(*command.SendToProcessCommand)
+	assert.Equal(t, repository.CoordinatorID, warning.ProcessID)
+	assert.Contains(t, warning.Content, "report_review_verdict")
+	decision := result.Events[0].(turnpolicy.Decision)
+	assert.Equal(t, turnpolicy.ActionWarn, decision.Action)
+	assert.Equal(t, "reviewing", decision.Phase)
+
+	// Second incomplete turn: the turn completes and the worker is replaced
+	result, err = h.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	turnResult := result.Data.(*handler.ProcessTurnCompleteResult)
+	assert.Equal(t, repository.StatusReady, turnResult.NewStatus)
+	require.Len(t, result.FollowUp, 1)
+	replace := result.FollowUp[0].(*command.ReplaceProcessCommand)
+	assert.Equal(t, "worker-1", replace.ProcessID)
+	require.Len(t, result.Events, 2)
+	assert.Equal(t, turnpolicy.ActionReplace, result.Events[1].(turnpolicy.Decision).Action)
+}
+
 func TestProcessTurnCompleteHandler_AfterMaxRetries_TurnCompletesWithReadyEvent(t *testing.T) {
 	processRepo, queueRepo := setupProcessRepos()
 	enforcer := handler.NewTurnCompletionTracker()
@@ -0,0 +1,195 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the handler for worker subtask proposals.
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ===========================================================================
+// ProposeSubtasksHandler
+// ===========================================================================
+
+// ProposeSubtasksHandler handles CmdProposeSubtasks commands.
+// It creates the proposed subtasks in bd as children of the worker's task,
+// wires up their dependencies, comments on the parent task and sends the
+// coordinator the new task IDs for assignment. The worker's phase is unchanged.
+type ProposeSubtasksHandler struct {
+	bdExecutor  appbeads.IssueExecutor
+	processRepo repository.ProcessRepository
+}
+
+// NewProposeSubtasksHandler creates a new ProposeSubtasksHandler.
+// Panics if bdExecutor is nil.
+func NewProposeSubtasksHandler(bdExecutor appbeads.IssueExecutor, processRepo repository.ProcessRepository) *ProposeSubtasksHandler {
+	if bdExecutor == nil {
+		panic("bdExecutor is required for ProposeSubtasksHandler")
+	}
+	return &ProposeSubtasksHandler{
+		bdExecutor:  bdExecutor,
+		processRepo: processRepo,
+	}
+}
+
+// Handle processes a ProposeSubtasksCommand.
+// Subtasks created before a bd failure are kept and listed in the error.
+func (h *ProposeSubtasksHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	proposeCmd := cmd.(*command.ProposeSubtasksCommand)
+
+	// 1. Get process and resolve the parent task
+	proc, err := h.processRepo.Get(proposeCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+	if proc.Status == repository.StatusRetired {
+		return nil, types.ErrProcessRetired
+	}
+	if !proc.IsWorker() {
+		return nil, fmt.Errorf("only workers can propose subtasks")
+	}
+	parentID := proposeCmd.ParentID
+	if parentID == "" {
+		parentID = proc.TaskID
+	}
+	if parentID == "" {
+		return nil, fmt.Errorf("%s has no task to split; pass parent_id", proc.ID)
+	}
+
+	// 2. Create the subtasks under the parent
+	created := make([]CreatedSubtask, 0, len(proposeCmd.Subtasks))
+	idsByTitle := make(map[string]string, len(proposeCmd.Subtasks))
+	for _, s := range proposeCmd.Subtasks {
+		var labels []string
+		if s.EstimatedSize != "" {
+			labels = append(labels, "size:"+string(s.EstimatedSize))
+		}
+		res, err := h.bdExecutor.CreateTask(s.Title, s.Description, parentID, "", labels)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create subtask %q%s: %w", s.Title, createdSoFar(created), err)
+		}
+		idsByTitle[s.Title] = res.ID
+		created = append(created, CreatedSubtask{ID: res.ID, Title: s.Title, EstimatedSize: s.EstimatedSize})
+	}
+
+	// 3. Add dependencies, resolving titles of proposed subtasks to their new IDs
+	for i, s := range proposeCmd.Subtasks {
+		for _, dep := range s.DependsOn {
+			depID, ok := idsByTitle[dep]
+			if !ok {
+				depID = dep
+			}
+			if err := h.bdExecutor.AddDependency(created[i].ID, depID); err != nil {
+				return nil, fmt.Errorf("failed to add dependency %s -> %s%s: %w", created[i].ID, depID, createdSoFar(created), err)
+			}
+			created[i].DependsOn = append(created[i].DependsOn, depID)
+		}
+	}
+
+	// 4. Record the split on the parent task (best-effort, the subtasks exist)
+	_ = h.bdExecutor.AddComment(parentID, proc.ID, subtasksComment(proposeCmd.Reason, created))
+
+	// 5. Hand the subtasks to the coordinator for assignment
+	var followUps []command.Command
+	if _, err := h.processRepo.GetCoordinator(); err == nil {
+		sendCmd := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID,
+			subtasksMessage(proc.ID, parentID, proposeCmd.Reason, created))
+		if proposeCmd.TraceID() != "" {
+			sendCmd.SetTraceID(proposeCmd.TraceID())
+		}
+		followUps = append(followUps, sendCmd)
+	}
+
+	result := &ProposeSubtasksResult{
+		WorkerID: proc.ID,
+		ParentID: parentID,
+		Subtasks: created,
+	}
+	return SuccessWithFollowUp(result, followUps...), nil
+}
+
+// createdSoFar lists already created subtasks for error messages, so a
+// partial failure never leaves untracked issues behind.
+func createdSoFar(created []CreatedSubtask) string {
+	if len(created) == 0 {
+		return ""
+	}
+	ids := make([]string, len(created))
+	for i, c := range created {
+		ids[i] = c.ID
+	}
+	return fmt.Sprintf(" (already created: %s)", strings.Join(ids, ", "))
+}
+
+// subtaskLines renders one line per subtask with its size and dependencies.
+func subtaskLines(sb *strings.Builder, created []CreatedSubtask) {
+	for _, c := range created {
+		fmt.Fprintf(sb, "- %s: %s", c.ID, c.Title)
+		if c.EstimatedSize != "" {
+			fmt.Fprintf(sb, " [%s]", c.EstimatedSize)
+		}
+		if len(c.DependsOn) > 0 {
+			fmt.Fprintf(sb, " (after %s)", strings.Join(c.DependsOn, ", "))
+		}
+		sb.WriteString("\n")
+	}
+}
+
+// subtasksComment builds the bd comment recording the split on the parent task.
+func subtasksComment(reason string, created []CreatedSubtask) string {
+	var sb strings.Builder
+	sb.WriteString("Split into subtasks")
+	if reason != "" {
+		fmt.Fprintf(&sb, ": %s", reason)
+	}
+	sb.WriteString("\n")
+	subtaskLines(&sb, created)
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// subtasksMessage builds the coordinator notification for a subtask proposal.
+func subtasksMessage(workerID, parentID, reason string, created []CreatedSubtask) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[SUBTASKS PROPOSED] %s split %s into %d subtask(s)", workerID, parentID, len(created))
+	if reason != "" {
+		fmt.Fprintf(&sb, ": %s", reason)
+	}
+	sb.WriteString("\n")
+	subtaskLines(&sb, created)
+	fmt.Fprintf(&sb, "Assign them with assign_task once their dependencies are done. %s is still assigned to %s.", parentID, workerID)
+	return sb.String()
+}
+
+// CreatedSubtask is a subtask created in bd from a proposal.
+type CreatedSubtask struct {
+	ID            string
+	Title         string
+	EstimatedSize command.SubtaskSize
+	DependsOn     []string // bd IDs this subtask depends on
+}
+
+// ProposeSubtasksResult contains the result of a subtask proposal.
+type ProposeSubtasksResult struct {
+	WorkerID string
+	ParentID string
+	Subtasks []CreatedSubtask
+}
+
+// GetSubtaskIDs returns the created subtask IDs for interface compatibility.
+func (r *ProposeSubtasksResult) GetSubtaskIDs() []string {
+	ids := make([]string, len(r.Subtasks))
+	for i, c := range r.Subtasks {
+		ids[i] = c.ID
+	}
+	return ids
+}
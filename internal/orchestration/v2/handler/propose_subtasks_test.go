@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ===========================================================================
+// ProposeSubtasksHandler Tests
+// ===========================================================================
+
+func newProposeSubtasksRepo(withCoordinator bool) *repository.MemoryProcessRepository {
+	processRepo := repository.NewMemoryProcessRepository()
+	if withCoordinator {
+		processRepo.AddProcess(&repository.Process{
+			ID:     repository.CoordinatorID,
+			Role:   repository.RoleCoordinator,
+			Status: repository.StatusWorking,
+		})
+	}
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		TaskID: "perles-abc.1",
+	})
+	return processRepo
+}
+
+func TestProposeSubtasksHandler_CreatesSubtasksAndNotifiesCoordinator(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().CreateTask("Add schema", "Create the migration", "perles-abc.1", "", []string{"size:s"}).
+		Return(beads.CreateResult{ID: "perles-abc.1.1", Title: "Add schema"}, nil)
+	bdExecutor.EXPECT().CreateTask("Add API", "Expose the endpoint", "perles-abc.1", "", []string(nil)).
+		Return(beads.CreateResult{ID: "perles-abc.1.2", Title: "Add API"}, nil)
+	bdExecutor.EXPECT().AddDependency("perles-abc.1.2", "perles-abc.1.1").Return(nil)
+	bdExecutor.EXPECT().AddDependency("perles-abc.1.2", "perles-xyz").Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc.1", "worker-1",
+		"Split into subtasks: Touches three packages\n- perles-abc.1.1: Add schema [s]\n- perles-abc.1.2: Add API (after perles-abc.1.1, perles-xyz)").
+		Return(nil)
+
+	h := NewProposeSubtasksHandler(bdExecutor, newProposeSubtasksRepo(true))
+	cmd := command.NewProposeSubtasksCommand(command.SourceMCPTool, "worker-1", "", "Touches three packages", []command.ProposedSubtask{
+		{Title: "Add schema", Description: "Create the migration", EstimatedSize: command.SubtaskSizeS},
+		{Title: "Add API", Description: "Expose the endpoint", DependsOn: []string{"Add schema", "perles-xyz"}},
+	})
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	proposeResult := result.Data.(*ProposeSubtasksResult)
+	require.Equal(t, "perles-abc.1", proposeResult.ParentID)
+	require.Equal(t, []string{"perles-abc.1.1", "perles-abc.1.2"}, proposeResult.GetSubtaskIDs())
+
+	require.Len(t, result.FollowUp, 1)
+	send := result.FollowUp[0].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, send.ProcessID)
+	require.Contains(t, send.Content, "[SUBTASKS PROPOSED] worker-1 split perles-abc.1 into 2 subtask(s): Touches three packages")
+	require.Contains(t, send.Content, "- perles-abc.1.2: Add API (after perles-abc.1.1, perles-xyz)")
+	require.Contains(t, send.Content, "assign_task")
+}
+
+func TestProposeSubtasksHandler_ExplicitParentWithoutCoordinator(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().CreateTask("Docs", "Write docs", "perles-def", "", []string{"size:xs"}).
+		Return(beads.CreateResult{ID: "perles-def.3"}, nil)
+	bdExecutor.EXPECT().AddComment("perles-def", "worker-1", mock.Anything).Return(errors.New("comment failed"))
+
+	h := NewProposeSubtasksHandler(bdExecutor, newProposeSubtasksRepo(false))
+	cmd := command.NewProposeSubtasksCommand(command.SourceMCPTool, "worker-1", "perles-def", "", []command.ProposedSubtask{
+		{Title: "Docs", Description: "Write docs", EstimatedSize: command.SubtaskSizeXS},
+	})
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err, "the comment is best-effort")
+	require.Empty(t, result.FollowUp)
+	require.Equal(t, "perles-def", result.Data.(*ProposeSubtasksResult).ParentID)
+}
+
+func TestProposeSubtasksHandler_ReportsPartiallyCreatedSubtasks(t *testing.T) {
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().CreateTask("A", "do A", "perles-abc.1", "", []string(nil)).
+		Return(beads.CreateResult{ID: "perles-abc.1.1"}, nil)
+	bdExecutor.EXPECT().CreateTask("B", "do B", "perles-abc.1", "", []string(nil)).
+		Return(beads.CreateResult{}, errors.New("bd database locked"))
+
+	h := NewProposeSubtasksHandler(bdExecutor, newProposeSubtasksRepo(true))
+	cmd := command.NewProposeSubtasksCommand(command.SourceMCPTool, "worker-1", "", "", []command.ProposedSubtask{
+		{Title: "A", Description: "do A"},
+		{Title: "B", Description: "do B"},
+	})
+	_, err := h.Handle(context.Background(), cmd)
+
+	require.ErrorContains(t, err, `failed to create subtask "B" (already created: perles-abc.1.1): bd database locked`)
+}
+
+func TestProposeSubtasksHandler_RejectsWorkerWithoutTask(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusReady})
+
+	h := NewProposeSubtasksHandler(mocks.NewMockIssueExecutor(t), processRepo)
+	cmd := command.NewProposeSubtasksCommand(command.SourceMCPTool, "worker-2", "", "", []command.ProposedSubtask{
+		{Title: "A", Description: "do A"},
+	})
+	_, err := h.Handle(context.Background(), cmd)
+
+	require.ErrorContains(t, err, "worker-2 has no task to split; pass parent_id")
+}
@@ -0,0 +1,146 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains the handler for worker assistance requests.
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ===========================================================================
+// RequestAssistanceHandler
+// ===========================================================================
+
+// RequestAssistanceHandler handles CmdRequestAssistance commands.
+// It moves the worker to the blocked phase, records the request on the process
+// and notifies the coordinator with an urgent message. Requests for the user
+// (or workflows without a coordinator) also notify the user. The worker leaves
+// the blocked phase when the next message is delivered to it (see
+// DeliverProcessQueuedHandler).
+type RequestAssistanceHandler struct {
+	processRepo repository.ProcessRepository
+}
+
+// NewRequestAssistanceHandler creates a new RequestAssistanceHandler.
+func NewRequestAssistanceHandler(processRepo repository.ProcessRepository) *RequestAssistanceHandler {
+	return &RequestAssistanceHandler{processRepo: processRepo}
+}
+
+// Handle processes a RequestAssistanceCommand.
+// Phase transition: Implementing/Reviewing/AddressingFeedback/Committing -> Blocked.
+// A blocked worker asking again updates its request and notifies again.
+func (h *RequestAssistanceHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	assistCmd := cmd.(*command.RequestAssistanceCommand)
+
+	// 1. Get process and validate it can block
+	proc, err := h.processRepo.Get(assistCmd.WorkerID)
+	if err != nil {
+		if errors.Is(err, repository.ErrProcessNotFound) {
+			return nil, ErrProcessNotFound
+		}
+		return nil, fmt.Errorf("failed to get process: %w", err)
+	}
+	if proc.Status == repository.StatusRetired {
+		return nil, types.ErrProcessRetired
+	}
+	if !proc.IsWorker() || proc.Phase == nil {
+		return nil, fmt.Errorf("only workers can request assistance")
+	}
+
+	resumePhase := *proc.Phase
+	if proc.Assistance != nil {
+		resumePhase = proc.Assistance.ResumePhase
+	} else if !IsValidTransition(resumePhase, events.ProcessPhaseBlocked) {
+		return nil, fmt.Errorf("%w: %s -> %s (request assistance while working on a task or review)",
+			types.ErrInvalidPhaseTransition, resumePhase, events.ProcessPhaseBlocked)
+	}
+
+	// 2. Block the worker and record the request
+	request := &repository.AssistanceRequest{
+		Reason:        assistCmd.Reason,
+		BlockingIssue: assistCmd.BlockingIssue,
+		NeededFrom:    assistCmd.NeededFrom,
+		ResumePhase:   resumePhase,
+		RequestedAt:   time.Now(),
+	}
+	blocked := events.ProcessPhaseBlocked
+	proc.Phase = &blocked
+	proc.Assistance = request
+
+	if err := h.processRepo.Save(proc); err != nil {
+		return nil, fmt.Errorf("failed to save process: %w", err)
+	}
+
+	// 3. Notify the coordinator first, then the user if they are asked or nobody else can answer
+	var followUps []command.Command
+	_, coordErr := h.processRepo.GetCoordinator()
+	hasCoordinator := coordErr == nil
+	if hasCoordinator {
+		sendCmd := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID,
+			assistanceMessage(proc, request))
+		sendCmd.SetPriority(1)
+		if assistCmd.TraceID() != "" {
+			sendCmd.SetTraceID(assistCmd.TraceID())
+		}
+		followUps = append(followUps, sendCmd)
+	}
+	if request.NeededFrom == command.AssistanceFromUser || !hasCoordinator {
+		notifyCmd := command.NewNotifyUserCommand(command.SourceInternal,
+			fmt.Sprintf("%s needs assistance: %s", proc.ID, request.Reason), "", proc.TaskID)
+		if assistCmd.TraceID() != "" {
+			notifyCmd.SetTraceID(assistCmd.TraceID())
+		}
+		followUps = append(followUps, notifyCmd)
+	}
+
+	// 4. Emit status change event so the TUI shows the blocked phase
+	event := events.NewProcessEvent(events.ProcessStatusChange, proc.ID, proc.Role).
+		WithStatus(proc.Status).
+		WithPhase(blocked).
+		WithTaskID(proc.TaskID)
+
+	result := &RequestAssistanceResult{
+		WorkerID:    proc.ID,
+		TaskID:      proc.TaskID,
+		ResumePhase: resumePhase,
+	}
+	return SuccessWithEventsAndFollowUp(result, []any{event}, followUps), nil
+}
+
+// assistanceMessage builds the coordinator notification for an assistance request.
+func assistanceMessage(proc *repository.Process, request *repository.AssistanceRequest) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[ASSISTANCE REQUESTED] %s is blocked", proc.ID)
+	if proc.TaskID != "" {
+		fmt.Fprintf(&sb, " on %s", proc.TaskID)
+	}
+	fmt.Fprintf(&sb, " (was %s) and needs help from %s.\n", request.ResumePhase, request.NeededFrom)
+	fmt.Fprintf(&sb, "Reason: %s\n", request.Reason)
+	if request.BlockingIssue != "" {
+		fmt.Fprintf(&sb, "Blocking issue: %s\n", request.BlockingIssue)
+	}
+	switch request.NeededFrom {
+	case command.AssistanceFromCoordinator:
+		fmt.Fprintf(&sb, "Answer it with a fabric message mentioning @%s; it resumes when your message reaches it.", proc.ID)
+	case command.AssistanceFromUser:
+		fmt.Fprintf(&sb, "The user has been notified. Relay their answer (or your own, if you can answer) with a fabric message mentioning @%s.", proc.ID)
+	default:
+		fmt.Fprintf(&sb, "Get the answer from %s and relay it with a fabric message mentioning @%s.", request.NeededFrom, proc.ID)
+	}
+	return sb.String()
+}
+
+// RequestAssistanceResult contains the result of a worker requesting assistance.
+type RequestAssistanceResult struct {
+	WorkerID    string
+	TaskID      string
+	ResumePhase events.ProcessPhase // phase the worker returns to once answered
+}
@@ -0,0 +1,192 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/process"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ===========================================================================
+// RequestAssistanceHandler Tests
+// ===========================================================================
+
+func newAssistanceFixture(t *testing.T, phase events.ProcessPhase, withCoordinator bool) *repository.MemoryProcessRepository {
+	t.Helper()
+	processRepo := repository.NewMemoryProcessRepository()
+	if withCoordinator {
+		processRepo.AddProcess(&repository.Process{
+			ID:     repository.CoordinatorID,
+			Role:   repository.RoleCoordinator,
+			Status: repository.StatusWorking,
+		})
+	}
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		Phase:     &phase,
+		TaskID:    "perles-abc.1",
+		CreatedAt: time.Now(),
+	})
+	return processRepo
+}
+
+func TestRequestAssistanceHandler_BlocksWorkerAndNotifiesCoordinator(t *testing.T) {
+	processRepo := newAssistanceFixture(t, events.ProcessPhaseImplementing, true)
+	h := handler.NewRequestAssistanceHandler(processRepo)
+
+	cmd := command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1",
+		"Tests need a database URL", "perles-xyz", "")
+	result, err := h.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	worker, err := processRepo.Get("worker-1")
+	require.NoError(t, err)
+	require.Equal(t, events.ProcessPhaseBlocked, *worker.Phase)
+	require.NotNil(t, worker.Assistance)
+	require.Equal(t, "Tests need a database URL", worker.Assistance.Reason)
+	require.Equal(t, "perles-xyz", worker.Assistance.BlockingIssue)
+	require.Equal(t, command.AssistanceFromCoordinator, worker.Assistance.NeededFrom)
+	require.Equal(t, events.ProcessPhaseImplementing, worker.Assistance.ResumePhase)
+
+	require.Len(t, result.FollowUp, 1)
+	send := result.FollowUp[0].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, send.ProcessID)
+	require.Equal(t, 1, send.Priority())
+	require.Contains(t, send.Content, "[ASSISTANCE REQUESTED] worker-1 is blocked on perles-abc.1 (was implementing)")
+	require.Contains(t, send.Content, "Reason: Tests need a database URL")
+	require.Contains(t, send.Content, "Blocking issue: perles-xyz")
+	require.Contains(t, send.Content, "@worker-1")
+
+	require.Len(t, result.Events, 1)
+	event := result.Events[0].(events.ProcessEvent)
+	require.Equal(t, events.ProcessStatusChange, event.Type)
+	require.Equal(t, events.ProcessPhaseBlocked, *event.Phase)
+}
+
+func TestRequestAssistanceHandler_NotifiesUser(t *testing.T) {
+	t.Run("needed from user", func(t *testing.T) {
+		processRepo := newAssistanceFixture(t, events.ProcessPhaseReviewing, true)
+		result, err := handler.NewRequestAssistanceHandler(processRepo).Handle(context.Background(),
+			command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1", "Which API version is canonical?", "", command.AssistanceFromUser))
+
+		require.NoError(t, err)
+		require.Len(t, result.FollowUp, 2)
+		require.IsType(t, &command.SendToProcessCommand{}, result.FollowUp[0])
+		notify := result.FollowUp[1].(*command.NotifyUserCommand)
+		require.Equal(t, "worker-1 needs assistance: Which API version is canonical?", notify.Message)
+		require.Equal(t, "perles-abc.1", notify.TaskID)
+	})
+
+	t.Run("no coordinator", func(t *testing.T) {
+		processRepo := newAssistanceFixture(t, events.ProcessPhaseImplementing, false)
+		result, err := handler.NewRequestAssistanceHandler(processRepo).Handle(context.Background(),
+			command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1", "Blocked", "", ""))
+
+		require.NoError(t, err)
+		require.Len(t, result.FollowUp, 1)
+		require.IsType(t, &command.NotifyUserCommand{}, result.FollowUp[0])
+	})
+}
+
+func TestRequestAssistanceHandler_RejectsIdleWorker(t *testing.T) {
+	processRepo := newAssistanceFixture(t, events.ProcessPhaseIdle, true)
+
+	_, err := handler.NewRequestAssistanceHandler(processRepo).Handle(context.Background(),
+		command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1", "Blocked", "", ""))
+
+	require.ErrorIs(t, err, types.ErrInvalidPhaseTransition)
+	worker, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseIdle, *worker.Phase)
+	require.Nil(t, worker.Assistance)
+}
+
+func TestRequestAssistanceHandler_RepeatKeepsResumePhase(t *testing.T) {
+	processRepo := newAssistanceFixture(t, events.ProcessPhaseAddressingFeedback, true)
+	h := handler.NewRequestAssistanceHandler(processRepo)
+
+	_, err := h.Handle(context.Background(), command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1", "First", "", ""))
+	require.NoError(t, err)
+	_, err = h.Handle(context.Background(), command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1", "Still blocked", "", "user"))
+	require.NoError(t, err)
+
+	worker, _ := processRepo.Get("worker-1")
+	require.Equal(t, "Still blocked", worker.Assistance.Reason)
+	require.Equal(t, events.ProcessPhaseAddressingFeedback, worker.Assistance.ResumePhase)
+}
+
+func TestDeliverProcessQueued_ResumesBlockedWorker(t *testing.T) {
+	processRepo := newAssistanceFixture(t, events.ProcessPhaseImplementing, true)
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	_, err := handler.NewRequestAssistanceHandler(processRepo).Handle(context.Background(),
+		command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1", "Blocked", "", ""))
+	require.NoError(t, err)
+
+	// The worker's turn ends, then the coordinator's answer arrives
+	worker, _ := processRepo.Get("worker-1")
+	worker.Status = repository.StatusReady
+	require.NoError(t, processRepo.Save(worker))
+	require.NoError(t, queueRepo.GetOrCreate("worker-1").Enqueue("Use postgres://localhost/test", repository.SenderSystem))
+
+	result, err := handler.NewDeliverProcessQueuedHandler(processRepo, queueRepo, process.NewProcessRegistry()).Handle(context.Background(),
+		command.NewDeliverProcessQueuedCommand(command.SourceInternal, "worker-1"))
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	worker, _ = processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseImplementing, *worker.Phase)
+	require.Nil(t, worker.Assistance)
+
+	var phaseEvents []events.ProcessEvent
+	for _, e := range result.Events {
+		if pe := e.(events.ProcessEvent); pe.Type == events.ProcessStatusChange {
+			phaseEvents = append(phaseEvents, pe)
+		}
+	}
+	require.Len(t, phaseEvents, 1)
+	require.Equal(t, events.ProcessPhaseImplementing, *phaseEvents[0].Phase)
+}
+
+func TestSendToProcess_UrgentMessagesJumpTheQueue(t *testing.T) {
+	processRepo := newAssistanceFixture(t, events.ProcessPhaseImplementing, true)
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	h := handler.NewSendToProcessHandler(processRepo, queueRepo)
+
+	_, err := h.Handle(context.Background(), command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, "worker-2 finished"))
+	require.NoError(t, err)
+	urgent := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, "[ASSISTANCE REQUESTED] worker-1")
+	urgent.SetPriority(1)
+	_, err = h.Handle(context.Background(), urgent)
+	require.NoError(t, err)
+
+	entry, ok := queueRepo.GetOrCreate(repository.CoordinatorID).Dequeue()
+	require.True(t, ok)
+	require.Equal(t, "[ASSISTANCE REQUESTED] worker-1", entry.Content)
+}
+
+func TestTransitionPhase_LeavingBlockedClearsAssistance(t *testing.T) {
+	processRepo := newAssistanceFixture(t, events.ProcessPhaseImplementing, true)
+	_, err := handler.NewRequestAssistanceHandler(processRepo).Handle(context.Background(),
+		command.NewRequestAssistanceCommand(command.SourceMCPTool, "worker-1", "Blocked", "", ""))
+	require.NoError(t, err)
+
+	// The coordinator gives up on the task instead of answering
+	_, err = handler.NewTransitionPhaseHandler(processRepo, repository.NewMemoryQueueRepository(0)).Handle(context.Background(),
+		command.NewTransitionPhaseCommand(command.SourceInternal, "worker-1", events.ProcessPhaseIdle))
+	require.NoError(t, err)
+
+	worker, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseIdle, *worker.Phase)
+	require.Nil(t, worker.Assistance)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/zjrosen/perles/internal/orchestration/client"
-	"github.com/zjrosen/perles/internal/orchestration/mcp"
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
@@ -19,6 +18,7 @@ type ProcessRegistrySessionProvider struct {
 	observerClient    client.HeadlessClient
 	workDir           string
 	port              int
+	workDirs          func(processID string) string
 }
 
 // NewProcessRegistrySessionProvider creates a new ProcessRegistrySessionProvider.
@@ -73,73 +73,47 @@ func (p *ProcessRegistrySessionProvider) GetProcessSessionID(processID string) (
 func (p *ProcessRegistrySessionProvider) GenerateProcessMCPConfig(processID string) (string, error) {
 	// Check if this is the coordinator
 	if processID == repository.CoordinatorID {
-		return p.generateCoordinatorMCPConfig()
+		return coordinatorMCPConfig(clientTypeOf(p.coordinatorClient), p.port)
 	}
 	// Check if this is the observer
 	if processID == repository.ObserverID {
-		return p.generateObserverMCPConfig()
+		return observerMCPConfig(clientTypeOf(p.observerClient), p.port)
 	}
-	return p.generateWorkerMCPConfig(processID)
+	// Workers spawned with a non-default backend keep its config format
+	if backend := p.GetProcessBackend(processID); backend != nil {
+		return workerMCPConfig(backend.Type(), p.port, processID)
+	}
+	return workerMCPConfig(clientTypeOf(p.workerClient), p.port, processID)
 }
 
-// generateCoordinatorMCPConfig generates the coordinator-specific MCP config.
-func (p *ProcessRegistrySessionProvider) generateCoordinatorMCPConfig() (string, error) {
-	if p.coordinatorClient == nil {
-		return mcp.GenerateCoordinatorConfigHTTP(p.port)
-	}
-	switch p.coordinatorClient.Type() {
-	case client.ClientAmp:
-		return mcp.GenerateCoordinatorConfigAmp(p.port)
-	case client.ClientCodex:
-		return mcp.GenerateCoordinatorConfigCodex(p.port), nil
-	case client.ClientGemini:
-		return mcp.GenerateCoordinatorConfigGemini(p.port)
-	case client.ClientOpenCode:
-		return mcp.GenerateCoordinatorConfigOpenCode(p.port)
-	default:
-		return mcp.GenerateCoordinatorConfigHTTP(p.port)
+// GetProcessBackend returns the agent provider a process was spawned with,
+// or nil if it uses its role's default client.
+func (p *ProcessRegistrySessionProvider) GetProcessBackend(processID string) client.AgentProvider {
+	proc := p.registry.Get(processID)
+	if proc == nil {
+		return nil
 	}
+	return proc.Backend
 }
 
-// generateWorkerMCPConfig generates the worker-specific MCP config.
-func (p *ProcessRegistrySessionProvider) generateWorkerMCPConfig(workerID string) (string, error) {
-	if p.workerClient == nil {
-		return mcp.GenerateWorkerConfigHTTP(p.port, workerID)
-	}
-	switch p.workerClient.Type() {
-	case client.ClientAmp:
-		return mcp.GenerateWorkerConfigAmp(p.port, workerID)
-	case client.ClientCodex:
-		return mcp.GenerateWorkerConfigCodex(p.port, workerID), nil
-	case client.ClientGemini:
-		return mcp.GenerateWorkerConfigGemini(p.port, workerID)
-	case client.ClientOpenCode:
-		return mcp.GenerateWorkerConfigOpenCode(p.port, workerID)
-	default:
-		return mcp.GenerateWorkerConfigHTTP(p.port, workerID)
-	}
+// GetWorkDir returns the working directory for processes.
+func (p *ProcessRegistrySessionProvider) GetWorkDir() string {
+	return p.workDir
 }
 
-// generateObserverMCPConfig generates the observer-specific MCP config.
-func (p *ProcessRegistrySessionProvider) generateObserverMCPConfig() (string, error) {
-	if p.observerClient == nil {
-		return mcp.GenerateObserverConfigHTTP(p.port)
-	}
-	switch p.observerClient.Type() {
-	case client.ClientAmp:
-		return mcp.GenerateObserverConfigAmp(p.port)
-	case client.ClientCodex:
-		return mcp.GenerateObserverConfigCodex(p.port), nil
-	case client.ClientGemini:
-		return mcp.GenerateObserverConfigGemini(p.port)
-	case client.ClientOpenCode:
-		return mcp.GenerateObserverConfigOpenCode(p.port)
-	default:
-		return mcp.GenerateObserverConfigHTTP(p.port)
-	}
+// SetWorkDirResolver sets the function returning the working directory of a
+// process that does not work in the shared one, such as a worker in its own
+// git worktree. The function returns "" for the shared working directory.
+func (p *ProcessRegistrySessionProvider) SetWorkDirResolver(resolve func(processID string) string) {
+	p.workDirs = resolve
 }
 
-// GetWorkDir returns the working directory for processes.
-func (p *ProcessRegistrySessionProvider) GetWorkDir() string {
+// GetProcessWorkDir returns the working directory of a process.
+func (p *ProcessRegistrySessionProvider) GetProcessWorkDir(processID string) string {
+	if p.workDirs != nil {
+		if dir := p.workDirs(processID); dir != "" {
+			return dir
+		}
+	}
 	return p.workDir
 }
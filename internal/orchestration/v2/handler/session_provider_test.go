@@ -204,3 +204,25 @@ func TestProcessRegistrySessionProvider_SessionCorrectlyWrapsProcess(t *testing.
 	// Work dir should be consistent
 	require.Equal(t, "/project", provider.GetWorkDir())
 }
+
+func TestProcessRegistrySessionProvider_GenerateProcessMCPConfig_WorkerBackend(t *testing.T) {
+	registry := process.NewProcessRegistry()
+	aiClient := &mockHeadlessClient{clientType: client.ClientClaude}
+	provider := NewProcessRegistrySessionProvider(registry, aiClient, aiClient, aiClient, "/work/dir", 9999)
+
+	proc := process.New("worker-3", repository.RoleWorker, newMockHeadlessProcess("session-ref"), nil, nil)
+	proc.Backend = client.NewAgentProvider(client.ClientAmp, nil)
+	registry.Register(proc)
+
+	require.Equal(t, client.ClientAmp, provider.GetProcessBackend("worker-3").Type())
+	require.Nil(t, provider.GetProcessBackend("worker-1"))
+
+	config, err := provider.GenerateProcessMCPConfig("worker-3")
+	require.NoError(t, err)
+	require.Contains(t, config, "http://localhost:9999/worker/worker-3")
+	require.NotContains(t, config, "mcpServers", "worker uses its backend's config format")
+
+	config, err = provider.GenerateProcessMCPConfig("worker-1")
+	require.NoError(t, err)
+	require.Contains(t, config, "mcpServers", "other workers keep the default format")
+}
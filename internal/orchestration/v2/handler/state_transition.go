@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -33,11 +34,14 @@ import (
 // Map key is the "from" phase, value is a slice of valid "to" phases.
 var ValidTransitions = map[events.ProcessPhase][]events.ProcessPhase{
 	events.ProcessPhaseIdle:               {events.ProcessPhaseImplementing, events.ProcessPhaseReviewing},
-	events.ProcessPhaseImplementing:       {events.ProcessPhaseAwaitingReview, events.ProcessPhaseIdle}, // idle on cancel/error
+	events.ProcessPhaseImplementing:       {events.ProcessPhaseAwaitingReview, events.ProcessPhaseIdle, events.ProcessPhaseBlocked}, // idle on cancel/error
 	events.ProcessPhaseAwaitingReview:     {events.ProcessPhaseCommitting, events.ProcessPhaseAddressingFeedback, events.ProcessPhaseIdle},
-	events.ProcessPhaseReviewing:          {events.ProcessPhaseIdle},
-	events.ProcessPhaseAddressingFeedback: {events.ProcessPhaseAwaitingReview, events.ProcessPhaseIdle},
-	events.ProcessPhaseCommitting:         {events.ProcessPhaseIdle},
+	events.ProcessPhaseReviewing:          {events.ProcessPhaseIdle, events.ProcessPhaseBlocked},
+	events.ProcessPhaseAddressingFeedback: {events.ProcessPhaseAwaitingReview, events.ProcessPhaseIdle, events.ProcessPhaseBlocked},
+	events.ProcessPhaseCommitting:         {events.ProcessPhaseIdle, events.ProcessPhaseBlocked},
+	// Blocked resumes the phase it was entered from once the worker is answered
+	events.ProcessPhaseBlocked: {events.ProcessPhaseImplementing, events.ProcessPhaseReviewing,
+		events.ProcessPhaseAddressingFeedback, events.ProcessPhaseCommitting, events.ProcessPhaseIdle},
 }
 
 // IsValidTransition checks if transitioning from one phase to another is valid.
@@ -141,14 +145,24 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 		return nil, types.ErrProcessNotImplementer
 	}
 
+	// Apply finding resolutions; every finding from a DENIED review must be resolved
+	findings, err := resolveFindings(task.Findings, reportCmd.ResolvedFindings)
+	if err != nil {
+		return nil, err
+	}
+
 	// 3. Update process: Phase = PhaseAwaitingReview, Status = StatusReady
 	awaitingReview := events.ProcessPhaseAwaitingReview
 	proc.Phase = &awaitingReview
 	proc.Status = repository.StatusReady
 
-	// 4. Update task: Status = TaskInReview
+	// 4. Update task: Status = TaskInReview, record the implementer's report for the reviewer
+	prevSummary, prevAssessment, prevFindings := task.Summary, task.SelfAssessment, task.Findings
 	task.Status = repository.TaskInReview
 	task.ReviewStartedAt = time.Now()
+	task.Summary = reportCmd.Summary
+	task.SelfAssessment = reportCmd.SelfAssessment
+	task.Findings = findings
 
 	// 5. Save to repositories
 	if err := h.taskRepo.Save(task); err != nil {
@@ -159,6 +173,7 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 		// Revert task changes on failure
 		task.Status = repository.TaskImplementing
 		task.ReviewStartedAt = time.Time{}
+		task.Summary, task.SelfAssessment, task.Findings = prevSummary, prevAssessment, prevFindings
 		_ = h.taskRepo.Save(task)
 		return nil, fmt.Errorf("failed to save process: %w", err)
 	}
@@ -175,9 +190,15 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 		followUps = append(followUps, deliverCmd)
 	}
 
-	// 7. Add comment to bd task synchronously (only if summary provided)
-	if reportCmd.Summary != "" {
+	// 7. Add comment to bd task synchronously (only if summary, self-assessment or resolutions provided)
+	if reportCmd.Summary != "" || reportCmd.SelfAssessment != nil || len(reportCmd.ResolvedFindings) > 0 {
 		comment := fmt.Sprintf("Implementation complete: %s", reportCmd.Summary)
+		if reportCmd.SelfAssessment != nil {
+			comment += formatSelfAssessmentComment(reportCmd.SelfAssessment)
+		}
+		if len(reportCmd.ResolvedFindings) > 0 {
+			comment += "\nResolved findings:\n" + strings.Join(repository.FindingsChecklist(findings), "\n")
+		}
 		if err := h.bdExecutor.AddComment(task.TaskID, "coordinator", comment); err != nil {
 			return nil, fmt.Errorf("failed to add BD comment: %w", err)
 		}
@@ -192,9 +213,10 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 	}
 
 	result := &ReportCompleteResult{
-		WorkerID: proc.ID,
-		TaskID:   task.TaskID,
-		Summary:  reportCmd.Summary,
+		WorkerID:       proc.ID,
+		TaskID:         task.TaskID,
+		Summary:        reportCmd.Summary,
+		SelfAssessment: reportCmd.SelfAssessment,
 	}
 
 	return SuccessWithEventsAndFollowUp(result, []any{event}, followUps), nil
@@ -202,9 +224,56 @@ func (h *ReportCompleteHandler) Handle(ctx context.Context, cmd command.Command)
 
 // ReportCompleteResult contains the result of reporting implementation complete.
 type ReportCompleteResult struct {
-	WorkerID string
-	TaskID   string
-	Summary  string
+	WorkerID       string
+	TaskID         string
+	Summary        string
+	SelfAssessment *repository.SelfAssessment // nil if the worker did not self-assess
+}
+
+// resolveFindings applies the implementer's resolutions to a copy of the task's findings.
+// Returns an error if a resolution refers to an unknown finding or any finding stays open.
+func resolveFindings(findings []repository.ReviewFinding, resolutions []command.FindingResolution) ([]repository.ReviewFinding, error) {
+	if len(findings) == 0 && len(resolutions) == 0 {
+		return nil, nil
+	}
+
+	resolved := slices.Clone(findings)
+	for _, r := range resolutions {
+		i := slices.IndexFunc(resolved, func(f repository.ReviewFinding) bool { return f.ID == r.FindingID })
+		if i < 0 {
+			return nil, fmt.Errorf("unknown review finding #%d", r.FindingID)
+		}
+		resolved[i].Resolution = r.Resolution
+	}
+
+	task := repository.TaskAssignment{Findings: resolved}
+	if open := task.OpenFindings(); len(open) > 0 {
+		return nil, fmt.Errorf("%w: %s; report a resolution for each via resolved_findings", types.ErrUnresolvedFindings, findingIDs(open))
+	}
+	return resolved, nil
+}
+
+// findingIDs formats finding numbers for error messages, e.g. "#1, #3".
+func findingIDs(findings []repository.ReviewFinding) string {
+	ids := make([]string, len(findings))
+	for i, f := range findings {
+		ids[i] = fmt.Sprintf("#%d", f.ID)
+	}
+	return strings.Join(ids, ", ")
+}
+
+// formatSelfAssessmentComment renders a self-assessment as a BD comment suffix.
+// The fixed field labels keep comments machine-readable for later analysis.
+func formatSelfAssessmentComment(sa *repository.SelfAssessment) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\nSelf-assessment: confidence=%.2f", sa.Confidence)
+	if len(sa.KnownGaps) > 0 {
+		fmt.Fprintf(&sb, "\nKnown gaps: %s", strings.Join(sa.KnownGaps, "; "))
+	}
+	if len(sa.UntestedAreas) > 0 {
+		fmt.Fprintf(&sb, "\nUntested areas: %s", strings.Join(sa.UntestedAreas, "; "))
+	}
+	return sb.String()
 }
 
 // ===========================================================================
@@ -363,6 +432,11 @@ func (h *ReportVerdictHandler) handleVerdict(_ context.Context, verdictCmd *comm
 
 	// 4. Handle verdict
 	idle := events.ProcessPhaseIdle
+	prevFindings := task.Findings
+	prevScores := task.ReviewScores
+	if verdictCmd.Scores != nil {
+		task.ReviewScores = verdictCmd.Scores
+	}
 	if verdictCmd.Verdict == command.VerdictApproved {
 		// APPROVED: task -> Approved, reviewer -> Idle/Ready
 		task.Status = repository.TaskApproved
@@ -372,7 +446,9 @@ func (h *ReportVerdictHandler) handleVerdict(_ context.Context, verdictCmd *comm
 		reviewer.TaskID = ""
 	} else {
 		// DENIED: task -> Denied, reviewer -> Idle/Ready, implementer -> AddressingFeedback
+		// The findings replace any from earlier reviews and must be resolved before re-review
 		task.Status = repository.TaskDenied
+		task.Findings = verdictCmd.Findings
 		h.soundService.Play("deny", "review_verdict_deny")
 		task.Reviewer = "" // Clear reviewer so a new one can be assigned for re-review
 		reviewer.Phase = &idle
@@ -411,6 +487,8 @@ func (h *ReportVerdictHandler) handleVerdict(_ context.Context, verdictCmd *comm
 		} else {
 			task.Status = repository.TaskInReview
 		}
+		task.Findings = prevFindings
+		task.ReviewScores = prevScores
 		_ = h.taskRepo.Save(task)
 		return nil, fmt.Errorf("failed to save reviewer: %w", err)
 	}
@@ -441,6 +519,12 @@ func (h *ReportVerdictHandler) handleVerdict(_ context.Context, verdictCmd *comm
 		comment = fmt.Sprintf("Review APPROVED by %s", verdictCmd.WorkerID)
 	} else {
 		comment = fmt.Sprintf("Review DENIED by %s: %s", verdictCmd.WorkerID, verdictCmd.Comments)
+		if len(verdictCmd.Findings) > 0 {
+			comment += "\nFindings:\n" + strings.Join(repository.FindingsChecklist(verdictCmd.Findings), "\n")
+		}
+	}
+	if verdictCmd.Scores != nil {
+		comment += "\nScores: " + verdictCmd.Scores.String()
 	}
 	if err := h.bdExecutor.AddComment(task.TaskID, "coordinator", comment); err != nil {
 		return nil, fmt.Errorf("failed to add BD comment: %w", err)
@@ -451,6 +535,7 @@ func (h *ReportVerdictHandler) handleVerdict(_ context.Context, verdictCmd *comm
 		TaskID:        task.TaskID,
 		Verdict:       verdictCmd.Verdict,
 		ImplementerID: task.Implementer,
+		Findings:      verdictCmd.Findings,
 	}
 
 	return SuccessWithEventsAndFollowUp(result, resultEvents, followUps), nil
@@ -462,6 +547,12 @@ type ReportVerdictResult struct {
 	TaskID        string
 	Verdict       command.Verdict
 	ImplementerID string
+	Findings      []repository.ReviewFinding
+}
+
+// GetTaskID returns the reviewed task's ID.
+func (r *ReportVerdictResult) GetTaskID() string {
+	return r.TaskID
 }
 
 // ===========================================================================
@@ -512,9 +603,10 @@ func (h *TransitionPhaseHandler) Handle(ctx context.Context, cmd command.Command
 			types.ErrInvalidPhaseTransition, oldPhase, transitionCmd.NewPhase)
 	}
 
-	// 3. Update process phase
+	// 3. Update process phase (leaving blocked answers any assistance request)
 	newPhase := transitionCmd.NewPhase
 	proc.Phase = &newPhase
+	proc.Assistance = nil
 
 	// Determine new status based on phase
 	if transitionCmd.NewPhase == events.ProcessPhaseIdle {
@@ -178,6 +178,48 @@ func TestReportCompleteHandler_TransitionsToAwaitingReview(t *testing.T) {
 	require.Equal(t, repository.TaskInReview, updatedTask.Status)
 }
 
+func TestReportCompleteHandler_StoresSelfAssessment(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator",
+		"Implementation complete: Added cache\nSelf-assessment: confidence=0.70\nKnown gaps: no eviction\nUntested areas: concurrent writes; cold start").
+		Return(nil)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseImplementing),
+		TaskID: "perles-abc1.2",
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskImplementing,
+	})
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo, WithReportCompleteBDExecutor(bdExecutor))
+
+	sa := &repository.SelfAssessment{
+		Confidence:    0.7,
+		KnownGaps:     []string{"no eviction"},
+		UntestedAreas: []string{"concurrent writes", "cold start"},
+	}
+	cmd := command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", "Added cache")
+	cmd.SelfAssessment = sa
+	result, err := handler.Handle(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Equal(t, sa, result.Data.(*ReportCompleteResult).SelfAssessment)
+
+	updatedTask, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, "Added cache", updatedTask.Summary)
+	require.Equal(t, sa, updatedTask.SelfAssessment)
+}
+
 func TestReportCompleteHandler_FailsIfNotImplementingPhase(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -439,6 +481,86 @@ func TestReportCompleteHandler_WorksFromAddressingFeedback(t *testing.T) {
 	require.Equal(t, events.ProcessPhaseAwaitingReview, *updated.Phase)
 }
 
+func TestReportCompleteHandler_RequiresFindingResolutions(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseAddressingFeedback),
+		TaskID: "perles-abc1.2",
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskDenied,
+		Findings: []repository.ReviewFinding{
+			{ID: 1, File: "auth.go", Severity: repository.SeverityMajor, Description: "Token expiry not checked"},
+			{ID: 2, File: "auth_test.go", Severity: repository.SeverityMinor, Description: "No expiry test"},
+		},
+	})
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo, WithReportCompleteBDExecutor(bdExecutor))
+
+	cmd := command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", "Fixed expiry")
+	cmd.ResolvedFindings = []command.FindingResolution{{FindingID: 1, Resolution: "Added expiry check"}}
+	_, err := handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, types.ErrUnresolvedFindings)
+	require.ErrorContains(t, err, "#2")
+
+	cmd.ResolvedFindings = []command.FindingResolution{{FindingID: 7, Resolution: "n/a"}}
+	_, err = handler.Handle(context.Background(), cmd)
+	require.EqualError(t, err, "unknown review finding #7")
+
+	// Nothing changed: the implementer is still addressing feedback and findings stay open
+	worker, _ := processRepo.Get("worker-1")
+	require.Equal(t, events.ProcessPhaseAddressingFeedback, *worker.Phase)
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Len(t, task.OpenFindings(), 2)
+}
+
+func TestReportCompleteHandler_RecordsFindingResolutions(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator",
+		"Implementation complete: Fixed expiry\nResolved findings:\n- [x] #1 [major] auth.go - Token expiry not checked (resolved: Added expiry check)").
+		Return(nil)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseAddressingFeedback),
+		TaskID: "perles-abc1.2",
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskDenied,
+		Findings: []repository.ReviewFinding{
+			{ID: 1, File: "auth.go", Severity: repository.SeverityMajor, Description: "Token expiry not checked"},
+		},
+	})
+
+	handler := NewReportCompleteHandler(processRepo, taskRepo, queueRepo, WithReportCompleteBDExecutor(bdExecutor))
+
+	cmd := command.NewReportCompleteCommand(command.SourceMCPTool, "worker-1", "Fixed expiry")
+	cmd.ResolvedFindings = []command.FindingResolution{{FindingID: 1, Resolution: "Added expiry check"}}
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Empty(t, task.OpenFindings())
+	require.Equal(t, "Added expiry check", task.Findings[0].Resolution)
+}
+
 // ===========================================================================
 // ReportVerdictHandler Tests
 // ===========================================================================
@@ -502,6 +624,36 @@ func TestReportVerdictHandler_ApprovedTransitionsCorrectly(t *testing.T) {
 	require.Empty(t, updatedReviewer.TaskID)
 }
 
+func TestReportVerdictHandler_StoresReviewScores(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", mock.Anything,
+		"Review APPROVED by worker-2\nScores: correctness 5/5, tests 4/5, style 3/5, security 4/5").Return(nil)
+
+	processRepo.AddProcess(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: phasePtr(events.ProcessPhaseAwaitingReview), TaskID: "perles-abc1.2"})
+	processRepo.AddProcess(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, Status: repository.StatusWorking, Phase: phasePtr(events.ProcessPhaseReviewing), TaskID: "perles-abc1.2"})
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Reviewer: "worker-2", Status: repository.TaskInReview})
+
+	handler := NewReportVerdictHandler(processRepo, taskRepo, queueRepo, WithReportVerdictBDExecutor(bdExecutor))
+
+	scores := repository.ReviewScores{
+		repository.DimensionCorrectness: 5,
+		repository.DimensionTests:       4,
+		repository.DimensionStyle:       3,
+		repository.DimensionSecurity:    4,
+	}
+	cmd := command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictApproved, "LGTM")
+	cmd.Scores = scores
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, scores, task.ReviewScores)
+}
+
 func TestReportVerdictHandler_DeniedTransitionsImplementerToAddressingFeedback(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -564,6 +716,55 @@ func TestReportVerdictHandler_DeniedTransitionsImplementerToAddressingFeedback(t
 	require.Equal(t, events.ProcessPhaseAddressingFeedback, *updatedImplementer.Phase)
 }
 
+func TestReportVerdictHandler_DeniedStoresFindings(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", mock.Anything,
+		"Review DENIED by worker-2: Expiry handling missing\nFindings:\n- [ ] #1 [blocker] auth.go:42 - Token expiry not checked").
+		Return(nil)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseAwaitingReview),
+		TaskID: "perles-abc1.2",
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  phasePtr(events.ProcessPhaseReviewing),
+		TaskID: "perles-abc1.2",
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Reviewer:    "worker-2",
+		Status:      repository.TaskInReview,
+		// Resolved findings from an earlier round are replaced by the new review
+		Findings: []repository.ReviewFinding{{ID: 1, File: "old.go", Severity: repository.SeverityMinor, Description: "old", Resolution: "done"}},
+	})
+
+	handler := NewReportVerdictHandler(processRepo, taskRepo, queueRepo, WithReportVerdictBDExecutor(bdExecutor))
+
+	findings := []repository.ReviewFinding{
+		{ID: 1, File: "auth.go", Location: "42", Severity: repository.SeverityBlocker, Description: "Token expiry not checked"},
+	}
+	cmd := command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictDenied, "Expiry handling missing")
+	cmd.Findings = findings
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Equal(t, findings, result.Data.(*ReportVerdictResult).Findings)
+
+	task, _ := taskRepo.Get("perles-abc1.2")
+	require.Equal(t, findings, task.Findings)
+	require.Len(t, task.OpenFindings(), 1)
+}
+
 func TestReportVerdictHandler_FailsForInvalidVerdict(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -1030,9 +1231,10 @@ func TestReportVerdict_RaceWithNewTask(t *testing.T) {
 			taskRepo := repository.NewMemoryTaskRepository()
 			queueRepo := repository.NewMemoryQueueRepository(0)
 			bdExecutor := mocks.NewMockIssueExecutor(t)
-			// Mock for AssignTaskHandler: ShowIssue and UpdateStatus
+			// Mock for AssignTaskHandler: ShowIssue, UpdateStatus and the work log comment
 			bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-xyz9.1", Status: beads.StatusOpen}, nil).Maybe()
 			bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+			bdExecutor.EXPECT().AddComment(mock.Anything, "coordinator", mock.Anything).Return(nil).Maybe()
 
 			// Create a separate mock for verdict handler
 			verdictBDExecutor := mocks.NewMockIssueExecutor(t)
@@ -1461,7 +1663,8 @@ func isValidPhase(phase events.ProcessPhase) bool {
 		events.ProcessPhaseAwaitingReview,
 		events.ProcessPhaseReviewing,
 		events.ProcessPhaseAddressingFeedback,
-		events.ProcessPhaseCommitting:
+		events.ProcessPhaseCommitting,
+		events.ProcessPhaseBlocked:
 		return true
 	default:
 		return false
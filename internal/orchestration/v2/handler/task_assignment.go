@@ -16,6 +16,7 @@ import (
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
@@ -157,10 +158,13 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 
 	issue, err := h.bdExecutor.ShowIssue(assignCmd.TaskID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get bd issue: %w. did you mean to use send_to_worker", err)
+		return nil, fmt.Errorf("failed to get bd issue: %w. did you mean to use fabric_send", err)
 	}
 	if issue == nil {
-		return nil, fmt.Errorf("bd issue not found: %s. did you mean to use send_to_worker", proc.TaskID)
+		return nil, fmt.Errorf("bd issue not found: %s. did you mean to use fabric_send", proc.TaskID)
+	}
+	if issue.IsArchived() {
+		return nil, fmt.Errorf("%w: %s. restore it with 'perles restore %s' before assigning", types.ErrTaskArchived, assignCmd.TaskID, assignCmd.TaskID)
 	}
 
 	// Also check task repo for any task where this process is implementer
@@ -210,6 +214,8 @@ func (h *AssignTaskHandler) handleAssign(_ context.Context, assignCmd *command.A
 	if err := h.bdExecutor.UpdateStatus(assignCmd.TaskID, beads.StatusInProgress); err != nil {
 		return nil, fmt.Errorf("failed to update BD task status: %w", err)
 	}
+	// Stamp the work start for time tracking (best-effort)
+	_ = h.bdExecutor.AddComment(assignCmd.TaskID, "coordinator", beads.FormatWorkStartedComment(assignCmd.WorkerID))
 
 	// Record task assigned event
 	if span != nil {
@@ -266,9 +272,22 @@ type AssignTaskResult struct {
 // It assigns a reviewer to an implemented task.
 // After updating state, it queues a ReviewAssignmentPrompt message to the reviewer.
 type AssignReviewHandler struct {
-	processRepo repository.ProcessRepository
-	taskRepo    repository.TaskRepository
-	queueRepo   repository.QueueRepository
+	processRepo   repository.ProcessRepository
+	taskRepo      repository.TaskRepository
+	queueRepo     repository.QueueRepository
+	reviewHistory repository.ReviewHistoryRepository
+}
+
+// AssignReviewHandlerOption configures AssignReviewHandler.
+type AssignReviewHandlerOption func(*AssignReviewHandler)
+
+// WithReviewHistory enables reviewer rotation. Each assignment is recorded, and
+// a reviewer is rejected if they reviewed the implementer's previous task while
+// another ready worker could review instead.
+func WithReviewHistory(repo repository.ReviewHistoryRepository) AssignReviewHandlerOption {
+	return func(h *AssignReviewHandler) {
+		h.reviewHistory = repo
+	}
 }
 
 // NewAssignReviewHandler creates a new AssignReviewHandler.
@@ -277,15 +296,20 @@ func NewAssignReviewHandler(
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	opts ...AssignReviewHandlerOption,
 ) *AssignReviewHandler {
 	if queueRepo == nil {
 		panic("queueRepo is required for AssignReviewHandler")
 	}
-	return &AssignReviewHandler{
+	h := &AssignReviewHandler{
 		processRepo: processRepo,
 		taskRepo:    taskRepo,
 		queueRepo:   queueRepo,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // Handle processes an AssignReviewCommand.
@@ -331,6 +355,16 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 		return nil, types.ErrProcessNotImplementer
 	}
 
+	// Findings from a DENIED review must be resolved before re-review
+	if open := task.OpenFindings(); len(open) > 0 {
+		return nil, fmt.Errorf("%w: %s", types.ErrUnresolvedFindings, findingIDs(open))
+	}
+
+	// Rotate reviewers: the same pair must not repeat on consecutive tasks
+	if err := h.checkRotation(reviewCmd); err != nil {
+		return nil, err
+	}
+
 	// 4. Update task with Reviewer = reviewerID
 	task.Reviewer = reviewCmd.ReviewerID
 	task.Status = repository.TaskInReview
@@ -356,19 +390,41 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 		return nil, fmt.Errorf("failed to save reviewer: %w", err)
 	}
 
-	// 7. Queue the appropriate review prompt based on review type
-	// Note: Summary is not stored in TaskAssignment yet, so we use a placeholder
+	// 7. Queue the appropriate review prompt based on review type,
+	// followed by the implementer's summary and self-assessment when reported
 	var reviewPrompt string
 	if reviewCmd.ReviewType == command.ReviewTypeSimple {
 		reviewPrompt = prompt.ReviewAssignmentPromptSimple(reviewCmd.TaskID, reviewCmd.ImplementerID)
 	} else {
 		reviewPrompt = prompt.ReviewAssignmentPrompt(reviewCmd.TaskID, reviewCmd.ImplementerID)
 	}
+	if sa := task.SelfAssessment; sa != nil {
+		reviewPrompt += prompt.ImplementerReportSection(task.Summary, true, sa.Confidence, sa.KnownGaps, sa.UntestedAreas)
+	} else if task.Summary != "" {
+		reviewPrompt += prompt.ImplementerReportSection(task.Summary, false, 0, nil, nil)
+	}
+	if len(task.Findings) > 0 {
+		reviewPrompt += prompt.PreviousFindingsSection(repository.FindingsChecklist(task.Findings))
+	}
 	queue := h.queueRepo.GetOrCreate(reviewCmd.ReviewerID)
 	if err := queue.Enqueue(reviewPrompt, repository.SenderCoordinator); err != nil {
 		return nil, fmt.Errorf("failed to queue review prompt: %w", err)
 	}
 
+	// Record the pairing only once the review is assigned, so a failed
+	// assignment does not count against the pair in reviewer rotation
+	if h.reviewHistory != nil {
+		pairing := repository.ReviewPairing{
+			TaskID:        reviewCmd.TaskID,
+			ImplementerID: reviewCmd.ImplementerID,
+			ReviewerID:    reviewCmd.ReviewerID,
+			AssignedAt:    task.ReviewStartedAt,
+		}
+		if err := h.reviewHistory.Record(pairing); err != nil {
+			log.Warn(log.CatOrch, "Failed to record review pairing", "taskID", reviewCmd.TaskID, "error", err)
+		}
+	}
+
 	// 8. Create follow-up command to deliver the queued message
 	// DeliverProcessQueuedHandler will set StatusWorking and actually deliver
 	deliverCmd := command.NewDeliverProcessQueuedCommand(command.SourceInternal, reviewCmd.ReviewerID)
@@ -390,6 +446,28 @@ func (h *AssignReviewHandler) Handle(ctx context.Context, cmd command.Command) (
 	return SuccessWithEventsAndFollowUp(result, []any{event}, []command.Command{deliverCmd}), nil
 }
 
+// checkRotation rejects a reviewer who reviewed the implementer's previous task,
+// unless no other ready worker could take the review. Re-reviews of the same
+// task may keep their reviewer.
+func (h *AssignReviewHandler) checkRotation(reviewCmd *command.AssignReviewCommand) error {
+	if h.reviewHistory == nil {
+		return nil
+	}
+
+	last, ok := repository.LastPairing(h.reviewHistory.All(), reviewCmd.ImplementerID)
+	if !ok || last.TaskID == reviewCmd.TaskID || last.ReviewerID != reviewCmd.ReviewerID {
+		return nil
+	}
+
+	for _, p := range h.processRepo.ReadyWorkers() {
+		if p.ID != reviewCmd.ImplementerID && p.ID != reviewCmd.ReviewerID {
+			return fmt.Errorf("%w (%s reviewed %s for %s); use rotate_reviewer or pick another ready worker",
+				types.ErrReviewerPairRepeated, last.ReviewerID, last.ImplementerID, last.TaskID)
+		}
+	}
+	return nil
+}
+
 // AssignReviewResult contains the result of assigning a reviewer to a task.
 type AssignReviewResult struct {
 	ReviewerID    string
@@ -601,8 +679,12 @@ func (h *AssignReviewFeedbackHandler) Handle(ctx context.Context, cmd command.Co
 		return nil, fmt.Errorf("failed to save implementer: %w", err)
 	}
 
-	// 6. Queue ReviewFeedbackPrompt to the implementer (from coordinator)
+	// 6. Queue ReviewFeedbackPrompt to the implementer (from coordinator),
+	// followed by the reviewer's findings checklist when the denial carried findings
 	feedbackPrompt := prompt.ReviewFeedbackPrompt(feedbackCmd.TaskID, feedbackCmd.Feedback)
+	if open := task.OpenFindings(); len(open) > 0 {
+		feedbackPrompt += prompt.ReviewFindingsSection(repository.FindingsChecklist(open))
+	}
 	queue := h.queueRepo.GetOrCreate(feedbackCmd.ImplementerID)
 	if err := queue.Enqueue(feedbackPrompt, repository.SenderCoordinator); err != nil {
 		return nil, fmt.Errorf("failed to queue feedback prompt: %w", err)
@@ -34,9 +34,10 @@ func TestAssignTaskHandler_AssignsToReadyWorker(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
 	bdExecutor := mocks.NewMockIssueExecutor(t)
-	// Mock for AssignTaskHandler: ShowIssue and UpdateStatus
+	// Mock for AssignTaskHandler: ShowIssue, UpdateStatus and the work log comment
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	bdExecutor.EXPECT().AddComment(mock.Anything, "coordinator", mock.Anything).Return(nil).Maybe()
 
 	// Add ready worker process
 	proc := &repository.Process{
@@ -81,6 +82,32 @@ func TestAssignTaskHandler_AssignsToReadyWorker(t *testing.T) {
 	require.Equal(t, repository.TaskImplementing, task.Status)
 }
 
+func TestAssignTaskHandler_StampsWorkStarted(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil)
+	bdExecutor.EXPECT().UpdateStatus("perles-abc1.2", beads.StatusInProgress).Return(nil)
+	bdExecutor.EXPECT().AddComment("perles-abc1.2", "coordinator", "Work started by worker-1").Return(errors.New("bd busy"))
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(repository.NewMemoryQueueRepository(0)))
+
+	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "Implement feature X", "")
+	result, err := handler.Handle(context.Background(), cmd)
+
+	// The work log stamp is best-effort and doesn't fail the assignment
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
 func TestAssignTaskHandler_FailsIfWorkerNotReady(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -157,6 +184,37 @@ func TestAssignTaskHandler_FailsIfWorkerAlreadyHasTask(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrProcessAlreadyAssigned)
 }
 
+func TestAssignTaskHandler_FailsIfTaskArchived(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	bdExecutor := mocks.NewMockIssueExecutor(t)
+	bdExecutor.EXPECT().ShowIssue("perles-abc1.2").Return(&beads.Issue{
+		ID:     "perles-abc1.2",
+		Status: beads.StatusOpen,
+		Labels: []string{beads.ArchivedLabel},
+	}, nil)
+
+	processRepo.AddProcess(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		Phase:     phasePtr(events.ProcessPhaseIdle),
+		CreatedAt: time.Now(),
+	})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignTaskHandler(processRepo, taskRepo, WithBDExecutor(bdExecutor), WithQueueRepository(queueRepo))
+
+	cmd := command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc1.2", "", "")
+	_, err := handler.Handle(context.Background(), cmd)
+
+	require.ErrorIs(t, err, types.ErrTaskArchived)
+	require.ErrorContains(t, err, "perles restore perles-abc1.2")
+
+	proc, _ := processRepo.Get("worker-1")
+	require.Empty(t, proc.TaskID, "archived task must not be assigned")
+}
+
 func TestAssignTaskHandler_FailsIfWorkerAlreadyImplementer(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -197,9 +255,10 @@ func TestAssignTaskHandler_QueuesPromptAndCreatesDeliveryFollowUp(t *testing.T)
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
 	bdExecutor := mocks.NewMockIssueExecutor(t)
-	// Mock for AssignTaskHandler: ShowIssue and UpdateStatus
+	// Mock for AssignTaskHandler: ShowIssue, UpdateStatus and the work log comment
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	bdExecutor.EXPECT().AddComment(mock.Anything, "coordinator", mock.Anything).Return(nil).Maybe()
 
 	proc := &repository.Process{
 		ID:        "worker-1",
@@ -241,9 +300,10 @@ func TestAssignTaskHandler_CreatesTaskAssignment(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
 	bdExecutor := mocks.NewMockIssueExecutor(t)
-	// Mock for AssignTaskHandler: ShowIssue and UpdateStatus
+	// Mock for AssignTaskHandler: ShowIssue, UpdateStatus and the work log comment
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	bdExecutor.EXPECT().AddComment(mock.Anything, "coordinator", mock.Anything).Return(nil).Maybe()
 
 	proc := &repository.Process{
 		ID:        "worker-1",
@@ -274,9 +334,10 @@ func TestAssignTaskHandler_EmitsStatusChangeEvent(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
 	bdExecutor := mocks.NewMockIssueExecutor(t)
-	// Mock for AssignTaskHandler: ShowIssue and UpdateStatus
+	// Mock for AssignTaskHandler: ShowIssue, UpdateStatus and the work log comment
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	bdExecutor.EXPECT().AddComment(mock.Anything, "coordinator", mock.Anything).Return(nil).Maybe()
 
 	proc := &repository.Process{
 		ID:        "worker-1",
@@ -327,9 +388,10 @@ func TestAssignTaskHandler_ReturnsAssignTaskResult(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
 	bdExecutor := mocks.NewMockIssueExecutor(t)
-	// Mock for AssignTaskHandler: ShowIssue and UpdateStatus
+	// Mock for AssignTaskHandler: ShowIssue, UpdateStatus and the work log comment
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	bdExecutor.EXPECT().AddComment(mock.Anything, "coordinator", mock.Anything).Return(nil).Maybe()
 
 	proc := &repository.Process{
 		ID:        "worker-1",
@@ -426,6 +488,186 @@ func TestAssignReviewHandler_AssignsReviewer(t *testing.T) {
 	require.Equal(t, repository.TaskInReview, updatedTask.Status)
 }
 
+func TestAssignReviewHandler_IncludesImplementerSelfAssessment(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  phasePtr(events.ProcessPhaseAwaitingReview),
+		TaskID: "perles-abc1.2",
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  phasePtr(events.ProcessPhaseIdle),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskInReview,
+		Summary:     "Added cache layer",
+		SelfAssessment: &repository.SelfAssessment{
+			Confidence:    0.4,
+			KnownGaps:     []string{"no eviction policy"},
+			UntestedAreas: []string{"concurrent writes"},
+		},
+	})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignReviewHandler(processRepo, taskRepo, queueRepo)
+
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "worker-1", command.ReviewTypeSimple)
+	_, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	entry, ok := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "## Implementer's Report")
+	require.Contains(t, entry.Content, "Added cache layer")
+	require.Contains(t, entry.Content, "40%")
+	require.Contains(t, entry.Content, "no eviction policy")
+	require.Contains(t, entry.Content, "concurrent writes")
+}
+
+func TestAssignReviewHandler_FindingsGateReReview(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-1",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  phasePtr(events.ProcessPhaseAwaitingReview),
+		TaskID: "perles-abc1.2",
+	})
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  phasePtr(events.ProcessPhaseIdle),
+	})
+	task := &repository.TaskAssignment{
+		TaskID:      "perles-abc1.2",
+		Implementer: "worker-1",
+		Status:      repository.TaskInReview,
+		Findings: []repository.ReviewFinding{
+			{ID: 1, File: "auth.go", Severity: repository.SeverityMajor, Description: "Token expiry not checked"},
+		},
+	}
+	_ = taskRepo.Save(task)
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignReviewHandler(processRepo, taskRepo, queueRepo)
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "worker-1", command.ReviewTypeSimple)
+
+	_, err := handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, types.ErrUnresolvedFindings)
+	require.ErrorContains(t, err, "#1")
+
+	task.Findings[0].Resolution = "Added expiry check"
+	_ = taskRepo.Save(task)
+
+	_, err = handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	entry, ok := queueRepo.GetOrCreate("worker-2").Dequeue()
+	require.True(t, ok)
+	require.Contains(t, entry.Content, "## Findings From Previous Review")
+	require.Contains(t, entry.Content, "(resolved: Added expiry check)")
+}
+
+func TestAssignReviewHandler_RotatesReviewers(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	history := repository.NewMemoryReviewHistoryRepository()
+	for _, id := range []string{"worker-2", "worker-3"} {
+		processRepo.AddProcess(&repository.Process{
+			ID:     id,
+			Role:   repository.RoleWorker,
+			Status: repository.StatusReady,
+			Phase:  phasePtr(events.ProcessPhaseIdle),
+		})
+	}
+	for _, id := range []string{"perles-abc1.1", "perles-abc1.2"} {
+		_ = taskRepo.Save(&repository.TaskAssignment{TaskID: id, Implementer: "worker-1", Status: repository.TaskImplementing})
+	}
+	_ = history.Record(repository.ReviewPairing{TaskID: "perles-abc1.1", ImplementerID: "worker-1", ReviewerID: "worker-2"})
+
+	queueRepo := repository.NewMemoryQueueRepository(0)
+	handler := NewAssignReviewHandler(processRepo, taskRepo, queueRepo, WithReviewHistory(history))
+
+	// worker-2 reviewed worker-1's previous task and worker-3 is ready
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "worker-1", command.ReviewTypeComplex)
+	_, err := handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, types.ErrReviewerPairRepeated)
+
+	// Re-reviewing the same task keeps its reviewer
+	cmd = command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.1", "worker-1", command.ReviewTypeComplex)
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	// A different reviewer is recorded
+	cmd = command.NewAssignReviewCommand(command.SourceMCPTool, "worker-3", "perles-abc1.2", "worker-1", command.ReviewTypeComplex)
+	result, err = handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	pairings := history.All()
+	require.Len(t, pairings, 3)
+	require.Equal(t, "perles-abc1.1", pairings[1].TaskID)
+	require.Equal(t, "worker-3", pairings[2].ReviewerID)
+	require.False(t, pairings[2].AssignedAt.IsZero())
+}
+
+func TestAssignReviewHandler_AllowsRepeatedPairWithoutAlternative(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	history := repository.NewMemoryReviewHistoryRepository()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  phasePtr(events.ProcessPhaseIdle),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.2", Implementer: "worker-1", Status: repository.TaskImplementing})
+	_ = history.Record(repository.ReviewPairing{TaskID: "perles-abc1.1", ImplementerID: "worker-1", ReviewerID: "worker-2"})
+
+	handler := NewAssignReviewHandler(processRepo, taskRepo, repository.NewMemoryQueueRepository(0), WithReviewHistory(history))
+
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.2", "worker-1", command.ReviewTypeComplex)
+	result, err := handler.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
+
+func TestAssignReviewHandler_FailedAssignmentIsNotRecorded(t *testing.T) {
+	processRepo := repository.NewMemoryProcessRepository()
+	taskRepo := repository.NewMemoryTaskRepository()
+	history := repository.NewMemoryReviewHistoryRepository()
+	processRepo.AddProcess(&repository.Process{
+		ID:     "worker-2",
+		Role:   repository.RoleWorker,
+		Status: repository.StatusReady,
+		Phase:  phasePtr(events.ProcessPhaseIdle),
+	})
+	_ = taskRepo.Save(&repository.TaskAssignment{TaskID: "perles-abc1.1", Implementer: "worker-1", Status: repository.TaskImplementing})
+
+	// The reviewer's queue is full, so the review prompt cannot be queued
+	queueRepo := repository.NewMemoryQueueRepository(1)
+	require.NoError(t, queueRepo.GetOrCreate("worker-2").Enqueue("pending", repository.SenderCoordinator))
+	handler := NewAssignReviewHandler(processRepo, taskRepo, queueRepo, WithReviewHistory(history))
+
+	cmd := command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc1.1", "worker-1", command.ReviewTypeComplex)
+	_, err := handler.Handle(context.Background(), cmd)
+	require.ErrorIs(t, err, repository.ErrQueueFull)
+	require.Empty(t, history.All())
+}
+
 func TestAssignReviewHandler_FailsIfReviewerIsImplementer(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
@@ -1047,9 +1289,10 @@ func TestFullAssignReviewApproveWorkflow(t *testing.T) {
 	processRepo := repository.NewMemoryProcessRepository()
 	taskRepo := repository.NewMemoryTaskRepository()
 	bdExecutor := mocks.NewMockIssueExecutor(t)
-	// Mock for AssignTaskHandler: ShowIssue and UpdateStatus
+	// Mock for AssignTaskHandler: ShowIssue, UpdateStatus and the work log comment
 	bdExecutor.EXPECT().ShowIssue(mock.Anything).Return(&beads.Issue{ID: "perles-abc1.2", Status: beads.StatusOpen}, nil).Maybe()
 	bdExecutor.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil).Maybe()
+	bdExecutor.EXPECT().AddComment(mock.Anything, "coordinator", mock.Anything).Return(nil).Maybe()
 
 	// Add two processes
 	implementer := &repository.Process{
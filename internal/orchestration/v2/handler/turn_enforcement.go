@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/zjrosen/perles/internal/orchestration/turnpolicy"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 )
 
@@ -14,22 +16,10 @@ import (
 // Constants
 // ===========================================================================
 
-// RequiredTools defines which MCP tools satisfy turn completion for workers.
-// A worker must call at least one of these tools to complete their turn without
-// triggering an enforcement reminder.
-var RequiredTools = []string{
-	"fabric_send",
-	"fabric_reply",
-	"fabric_ack",
-	"report_implementation_complete",
-	"report_review_verdict",
-	"fabric_join",
-}
-
-// maxEnforcementAttempts is the maximum number of enforcement reminders to send
-// before allowing a turn to complete without compliance. This prevents infinite
-// loops from persistent non-compliance.
-const maxEnforcementAttempts = 2
+// RequiredTools defines which MCP tools satisfy turn completion for workers
+// under the default policy. A worker must call at least one of these tools to
+// complete their turn without triggering an enforcement reminder.
+var RequiredTools = turnpolicy.DefaultTools
 
 // ===========================================================================
 // TurnCompletionEnforcer Interface
@@ -58,6 +48,14 @@ type TurnCompletionEnforcer interface {
 	// Only enforces for workers, not coordinator.
 	CheckTurnCompletion(processID string, role repository.ProcessRole) []string
 
+	// CheckPhaseCompletion checks if a tool required in the worker's phase was called.
+	// Returns the phase's required tools if none was (empty if compliant).
+	CheckPhaseCompletion(processID, phase string) []string
+
+	// Escalate decides the action for a turn that ended in phase without a
+	// required tool call and advances the process's escalation.
+	Escalate(processID, phase string, missingTools []string) turnpolicy.Decision
+
 	// IsNewlySpawned returns true if this is the process's first turn after spawn.
 	// First turns are exempt from enforcement (workers call fabric_join).
 	IsNewlySpawned(processID string) bool
@@ -101,6 +99,19 @@ type TurnCompletionTracker struct {
 	// True if this is the process's first turn after spawn.
 	newlySpawned map[string]bool
 
+	// firstMiss maps processID → time of the first incomplete turn of the
+	// current escalation, for the policy's grace timeout.
+	firstMiss map[string]time.Time
+
+	// misses maps processID → number of consecutive incomplete turns.
+	misses map[string]int
+
+	// policy decides the required tools and the escalation.
+	policy turnpolicy.Policy
+
+	// now returns the current time. Defaults to time.Now.
+	now func() time.Time
+
 	// mu protects all map operations.
 	mu sync.RWMutex
 
@@ -115,6 +126,9 @@ func NewTurnCompletionTracker() *TurnCompletionTracker {
 		callsThisTurn: make(map[string]map[string]bool),
 		retryCount:    make(map[string]int),
 		newlySpawned:  make(map[string]bool),
+		firstMiss:     make(map[string]time.Time),
+		misses:        make(map[string]int),
+		now:           time.Now,
 	}
 }
 
@@ -128,6 +142,21 @@ func WithLogger(logger func(format string, args ...any)) TurnCompletionTrackerOp
 	}
 }
 
+// WithPolicy sets the turn completion policy. Defaults to the zero policy,
+// which nudges twice with RequiredTools.
+func WithPolicy(policy turnpolicy.Policy) TurnCompletionTrackerOption {
+	return func(t *TurnCompletionTracker) {
+		t.policy = policy
+	}
+}
+
+// WithClock sets the time source for the policy's grace timeout.
+func WithClock(now func() time.Time) TurnCompletionTrackerOption {
+	return func(t *TurnCompletionTracker) {
+		t.now = now
+	}
+}
+
 // NewTurnCompletionTrackerWithOptions creates a new TurnCompletionTracker with options.
 func NewTurnCompletionTrackerWithOptions(opts ...TurnCompletionTrackerOption) *TurnCompletionTracker {
 	t := NewTurnCompletionTracker()
@@ -158,6 +187,8 @@ func (t *TurnCompletionTracker) ResetTurn(processID string) {
 
 	// Reset retry count for new turn
 	delete(t.retryCount, processID)
+	delete(t.firstMiss, processID)
+	delete(t.misses, processID)
 
 	// Clear newly spawned flag after first turn
 	delete(t.newlySpawned, processID)
@@ -180,13 +211,19 @@ func (t *TurnCompletionTracker) CheckTurnCompletion(processID string, role repos
 		return nil
 	}
 
+	return t.CheckPhaseCompletion(processID, "")
+}
+
+// CheckPhaseCompletion checks if a tool required in the worker's phase was called.
+func (t *TurnCompletionTracker) CheckPhaseCompletion(processID, phase string) []string {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
 	calls := t.callsThisTurn[processID]
+	required := t.policy.RequiredTools(phase)
 
 	// Check if any required tool was called
-	for _, tool := range RequiredTools {
+	for _, tool := range required {
 		if calls != nil && calls[tool] {
 			// At least one required tool was called - compliant
 			return nil
@@ -194,7 +231,37 @@ func (t *TurnCompletionTracker) CheckTurnCompletion(processID string, role repos
 	}
 
 	// No required tool was called - return all as missing
-	return RequiredTools
+	return required
+}
+
+// Escalate decides the action for a turn that ended in phase without a required
+// tool call. The first incomplete turn starts the grace timeout.
+func (t *TurnCompletionTracker) Escalate(processID, phase string, missingTools []string) turnpolicy.Decision {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	first, ok := t.firstMiss[processID]
+	if !ok {
+		first = now
+		t.firstMiss[processID] = now
+	}
+
+	action, next := t.policy.Next(phase, t.retryCount[processID], now.Sub(first))
+	t.retryCount[processID] = next
+	t.misses[processID]++
+
+	decision := turnpolicy.Decision{
+		ProcessID:     processID,
+		Phase:         phase,
+		Action:        action,
+		Turns:         t.misses[processID],
+		Steps:         t.policy.Steps(),
+		Elapsed:       now.Sub(first),
+		RequiredTools: missingTools,
+		Timestamp:     now,
+	}
+	return decision
 }
 
 // IsNewlySpawned returns true if this is the process's first turn after spawn.
@@ -210,7 +277,7 @@ func (t *TurnCompletionTracker) ShouldRetry(processID string) bool {
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
-	return t.retryCount[processID] < maxEnforcementAttempts
+	return t.retryCount[processID] < t.policy.Steps()
 }
 
 // IncrementRetry increments the retry counter for a process.
@@ -251,6 +318,8 @@ func (t *TurnCompletionTracker) CleanupProcess(processID string) {
 	delete(t.callsThisTurn, processID)
 	delete(t.retryCount, processID)
 	delete(t.newlySpawned, processID)
+	delete(t.firstMiss, processID)
+	delete(t.misses, processID)
 }
 
 // Ensure TurnCompletionTracker implements TurnCompletionEnforcer.
@@ -493,8 +493,9 @@ func TestRequiredTools_ContainsExpectedTools(t *testing.T) {
 	assert.Contains(t, handler.RequiredTools, "fabric_ack")
 	assert.Contains(t, handler.RequiredTools, "report_implementation_complete")
 	assert.Contains(t, handler.RequiredTools, "report_review_verdict")
+	assert.Contains(t, handler.RequiredTools, "request_assistance")
 	assert.Contains(t, handler.RequiredTools, "fabric_join")
-	assert.Len(t, handler.RequiredTools, 6)
+	assert.Len(t, handler.RequiredTools, 7)
 }
 
 // ===========================================================================
@@ -14,6 +14,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
 
@@ -38,6 +39,11 @@ type SpawnOptions struct {
 	// SystemPromptOverride overrides the system prompt for the process.
 	// Empty string means use the default prompt.
 	SystemPromptOverride string
+
+	// Backend selects the agent backend for a worker (e.g., codex, gemini).
+	// Empty means the default worker client. Non-default backends must be
+	// configured in the spawner's AgentProviders (orchestration.worker_backends).
+	Backend client.ClientType
 }
 
 // UnifiedProcessSpawnerImpl implements UnifiedProcessSpawner for spawning real AI processes.
@@ -50,12 +56,14 @@ type UnifiedProcessSpawnerImpl struct {
 	coordinatorExtensions map[string]any
 	workerExtensions      map[string]any
 	observerExtensions    map[string]any
+	agentProviders        client.AgentProviders
 	workDir               string
 	port                  int
 	submitter             process.CommandSubmitter
 	eventBus              *pubsub.Broker[any]
 	beadsDir              string
 	sessionDir            string
+	workDirs              func(processID string) string
 }
 
 // UnifiedSpawnerConfig holds configuration for creating a UnifiedProcessSpawnerImpl.
@@ -74,16 +82,22 @@ type UnifiedSpawnerConfig struct {
 	WorkerExtensions map[string]any
 	// ObserverExtensions holds provider-specific config for observer.
 	ObserverExtensions map[string]any
-	WorkDir            string
-	Port               int
-	Submitter          process.CommandSubmitter
-	EventBus           *pubsub.Broker[any]
+	// AgentProviders resolves SpawnOptions.Backend to a worker backend.
+	// If nil, workers can only be spawned with the default worker client.
+	AgentProviders client.AgentProviders
+	WorkDir        string
+	Port           int
+	Submitter      process.CommandSubmitter
+	EventBus       *pubsub.Broker[any]
 	// BeadsDir is the path to the beads database directory.
 	// When set, spawned processes receive BEADS_DIR environment variable.
 	BeadsDir string
 	// SessionDir is the path to the session directory.
 	// Used for template replacement in Observer prompts ({{SESSION_DIR}}).
 	SessionDir string
+	// WorkDirs returns the working directory of a worker that does not work in
+	// WorkDir, such as one in its own git worktree, or "" for WorkDir.
+	WorkDirs func(processID string) string
 }
 
 // NewUnifiedProcessSpawner creates a new UnifiedProcessSpawnerImpl.
@@ -115,12 +129,14 @@ func NewUnifiedProcessSpawner(cfg UnifiedSpawnerConfig) *UnifiedProcessSpawnerIm
 		coordinatorExtensions: cfg.CoordinatorExtensions,
 		workerExtensions:      workerExtensions,
 		observerExtensions:    observerExtensions,
+		agentProviders:        cfg.AgentProviders,
 		workDir:               cfg.WorkDir,
 		port:                  cfg.Port,
 		submitter:             cfg.Submitter,
 		eventBus:              cfg.EventBus,
 		beadsDir:              cfg.BeadsDir,
 		sessionDir:            cfg.SessionDir,
+		workDirs:              cfg.WorkDirs,
 	}
 }
 
@@ -145,6 +161,22 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		extensions = s.workerExtensions
 	}
 
+	// Resolve a non-default worker backend
+	var backend client.AgentProvider
+	if role == repository.RoleWorker && opts.Backend != "" && (s.workerClient == nil || opts.Backend != s.workerClient.Type()) {
+		provider, ok := s.agentProviders[client.WorkerBackendRole(opts.Backend)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", types.ErrBackendNotConfigured, opts.Backend)
+		}
+		backendClient, err := provider.Client()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s client: %w", opts.Backend, err)
+		}
+		backend = provider
+		aiClient = backendClient
+		extensions = provider.Extensions()
+	}
+
 	if aiClient == nil {
 		return nil, fmt.Errorf("client is nil for role %s", role)
 	}
@@ -154,7 +186,7 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 	switch role {
 	case repository.RoleCoordinator:
 		// Coordinator uses coordinator system prompt and MCP config
-		mcpConfig, err := s.generateCoordinatorMCPConfig()
+		mcpConfig, err := coordinatorMCPConfig(clientTypeOf(s.coordinatorClient), s.port)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate coordinator MCP config: %w", err)
 		}
@@ -201,7 +233,7 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		}
 	case repository.RoleObserver:
 		// Observer uses observer-specific system prompt and MCP config
-		mcpConfig, err := s.generateObserverMCPConfig()
+		mcpConfig, err := observerMCPConfig(clientTypeOf(s.observerClient), s.port)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate observer MCP config: %w", err)
 		}
@@ -216,7 +248,7 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 
 		// Replace {{SESSION_DIR}} placeholder in Observer prompt
 		// This occurs after override so both default and override prompts get substituted
-		initialPrompt = strings.ReplaceAll(initialPrompt, "{{SESSION_DIR}}", s.sessionDir)
+		initialPrompt = strings.ReplaceAll(initialPrompt, roles.SessionDirPlaceholder, s.sessionDir)
 
 		cfg = client.Config{
 			WorkDir:         s.workDir,
@@ -230,7 +262,7 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		}
 	default:
 		// Worker uses role-specific prompts based on AgentType
-		mcpConfig, err := s.generateWorkerMCPConfig(id)
+		mcpConfig, err := workerMCPConfig(clientTypeOf(aiClient), s.port, id)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate MCP config: %w", err)
 		}
@@ -242,8 +274,15 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		systemPrompt := roles.ComposeSystemPrompt(id, opts.AgentType, opts.WorkflowConfig)
 		initialPrompt := roles.ComposeInitialPrompt(id, opts.AgentType, opts.WorkflowConfig)
 
+		workDir := s.workDir
+		if s.workDirs != nil {
+			if dir := s.workDirs(id); dir != "" {
+				workDir = dir
+			}
+		}
+
 		cfg = client.Config{
-			WorkDir:         s.workDir,
+			WorkDir:         workDir,
 			BeadsDir:        s.beadsDir,
 			Prompt:          initialPrompt,
 			SystemPrompt:    systemPrompt,
@@ -254,14 +293,21 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 		}
 	}
 
-	// Spawn the underlying AI process
-	headlessProc, err := aiClient.Spawn(ctx, cfg)
+	// Spawn the underlying AI process. It outlives the command spawning it, so
+	// it must not inherit the command's cancellation; a command canceled while
+	// spawning stops the process instead.
+	headlessProc, err := aiClient.Spawn(context.WithoutCancel(ctx), cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to spawn AI process: %w", err)
 	}
+	if ctx.Err() != nil {
+		_ = headlessProc.Cancel()
+		return nil, fmt.Errorf("spawn canceled: %w", context.Cause(ctx))
+	}
 
 	// Create process.Process wrapper that manages event loop
 	proc := process.New(id, role, headlessProc, s.submitter, s.eventBus)
+	proc.Backend = backend
 
 	// Start the event loop
 	proc.Start()
@@ -269,59 +315,58 @@ func (s *UnifiedProcessSpawnerImpl) SpawnProcess(ctx context.Context, id string,
 	return proc, nil
 }
 
-// generateCoordinatorMCPConfig returns the appropriate MCP config for the coordinator.
-func (s *UnifiedProcessSpawnerImpl) generateCoordinatorMCPConfig() (string, error) {
-	if s.coordinatorClient == nil {
-		return mcp.GenerateCoordinatorConfigHTTP(s.port)
+// clientTypeOf returns the client's type, or "" for a nil client.
+func clientTypeOf(c client.HeadlessClient) client.ClientType {
+	if c == nil {
+		return ""
 	}
-	switch s.coordinatorClient.Type() {
+	return c.Type()
+}
+
+// coordinatorMCPConfig returns the coordinator MCP config in the format the client type expects.
+func coordinatorMCPConfig(clientType client.ClientType, port int) (string, error) {
+	switch clientType {
 	case client.ClientAmp:
-		return mcp.GenerateCoordinatorConfigAmp(s.port)
+		return mcp.GenerateCoordinatorConfigAmp(port)
 	case client.ClientCodex:
-		return mcp.GenerateCoordinatorConfigCodex(s.port), nil
+		return mcp.GenerateCoordinatorConfigCodex(port), nil
 	case client.ClientGemini:
-		return mcp.GenerateCoordinatorConfigGemini(s.port)
+		return mcp.GenerateCoordinatorConfigGemini(port)
 	case client.ClientOpenCode:
-		return mcp.GenerateCoordinatorConfigOpenCode(s.port)
+		return mcp.GenerateCoordinatorConfigOpenCode(port)
 	default:
-		return mcp.GenerateCoordinatorConfigHTTP(s.port)
+		return mcp.GenerateCoordinatorConfigHTTP(port)
 	}
 }
 
-// generateWorkerMCPConfig returns the appropriate MCP config format for workers based on client type.
-func (s *UnifiedProcessSpawnerImpl) generateWorkerMCPConfig(processID string) (string, error) {
-	if s.workerClient == nil {
-		return mcp.GenerateWorkerConfigHTTP(s.port, processID)
-	}
-	switch s.workerClient.Type() {
+// workerMCPConfig returns a worker's MCP config in the format the client type expects.
+func workerMCPConfig(clientType client.ClientType, port int, processID string) (string, error) {
+	switch clientType {
 	case client.ClientAmp:
-		return mcp.GenerateWorkerConfigAmp(s.port, processID)
+		return mcp.GenerateWorkerConfigAmp(port, processID)
 	case client.ClientCodex:
-		return mcp.GenerateWorkerConfigCodex(s.port, processID), nil
+		return mcp.GenerateWorkerConfigCodex(port, processID), nil
 	case client.ClientGemini:
-		return mcp.GenerateWorkerConfigGemini(s.port, processID)
+		return mcp.GenerateWorkerConfigGemini(port, processID)
 	case client.ClientOpenCode:
-		return mcp.GenerateWorkerConfigOpenCode(s.port, processID)
+		return mcp.GenerateWorkerConfigOpenCode(port, processID)
 	default:
-		return mcp.GenerateWorkerConfigHTTP(s.port, processID)
+		return mcp.GenerateWorkerConfigHTTP(port, processID)
 	}
 }
 
-// generateObserverMCPConfig returns the appropriate MCP config format for the observer based on client type.
-func (s *UnifiedProcessSpawnerImpl) generateObserverMCPConfig() (string, error) {
-	if s.observerClient == nil {
-		return mcp.GenerateObserverConfigHTTP(s.port)
-	}
-	switch s.observerClient.Type() {
+// observerMCPConfig returns the observer MCP config in the format the client type expects.
+func observerMCPConfig(clientType client.ClientType, port int) (string, error) {
+	switch clientType {
 	case client.ClientAmp:
-		return mcp.GenerateObserverConfigAmp(s.port)
+		return mcp.GenerateObserverConfigAmp(port)
 	case client.ClientCodex:
-		return mcp.GenerateObserverConfigCodex(s.port), nil
+		return mcp.GenerateObserverConfigCodex(port), nil
 	case client.ClientGemini:
-		return mcp.GenerateObserverConfigGemini(s.port)
+		return mcp.GenerateObserverConfigGemini(port)
 	case client.ClientOpenCode:
-		return mcp.GenerateObserverConfigOpenCode(s.port)
+		return mcp.GenerateObserverConfigOpenCode(port)
 	default:
-		return mcp.GenerateObserverConfigHTTP(s.port)
+		return mcp.GenerateObserverConfigHTTP(port)
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
 	"github.com/zjrosen/perles/internal/pubsub"
 )
 
@@ -48,6 +49,48 @@ func TestUnifiedProcessSpawner_SpawnProcess_Worker(t *testing.T) {
 	proc.Stop()
 }
 
+func TestUnifiedProcessSpawner_SpawnProcess_OutlivesCommandContext(t *testing.T) {
+	mockClient := mock.NewClient()
+	var spawnCtx context.Context
+	mockClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		spawnCtx = ctx
+		return mock.NewProcess(), nil
+	}
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		WorkerClient: mockClient,
+		Submitter:    &mockCommandSubmitter{},
+		EventBus:     pubsub.NewBroker[any](),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	proc, err := spawner.SpawnProcess(ctx, "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.NoError(t, err)
+	defer proc.Stop()
+
+	cancel()
+	require.NoError(t, spawnCtx.Err(), "the AI process must not inherit the command's cancellation")
+}
+
+func TestUnifiedProcessSpawner_SpawnProcess_CanceledWhileSpawning(t *testing.T) {
+	mockClient := mock.NewClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	headless := mock.NewProcess()
+	mockClient.SpawnFunc = func(context.Context, client.Config) (client.HeadlessProcess, error) {
+		cancel()
+		return headless, nil
+	}
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		WorkerClient: mockClient,
+		Submitter:    &mockCommandSubmitter{},
+		EventBus:     pubsub.NewBroker[any](),
+	})
+
+	proc, err := spawner.SpawnProcess(ctx, "worker-1", repository.RoleWorker, SpawnOptions{})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Nil(t, proc)
+	require.False(t, headless.IsRunning(), "the spawned process is stopped")
+}
+
 func TestUnifiedProcessSpawner_SpawnProcess_Coordinator(t *testing.T) {
 	mockClient := mock.NewClient()
 	eventBus := pubsub.NewBroker[any]()
@@ -145,13 +188,8 @@ func TestUnifiedProcessSpawner_SpawnProcess_WithAgentType(t *testing.T) {
 
 func TestUnifiedProcessSpawner_GenerateMCPConfig_HTTP(t *testing.T) {
 	mockClient := mock.NewClient()
-	spawner := &UnifiedProcessSpawnerImpl{
-		workerClient: mockClient,
-		port:         9999,
-		workDir:      "/test",
-	}
 
-	config, err := spawner.generateWorkerMCPConfig("worker-1")
+	config, err := workerMCPConfig(mockClient.Type(), 9999, "worker-1")
 	require.NoError(t, err)
 	assert.Contains(t, config, "9999")
 	assert.Contains(t, config, "worker-1")
@@ -168,13 +206,8 @@ func (c *openCodeMockClient) Type() client.ClientType {
 
 func TestUnifiedProcessSpawner_GenerateMCPConfig_OpenCode(t *testing.T) {
 	mockClient := &openCodeMockClient{Client: mock.NewClient()}
-	spawner := &UnifiedProcessSpawnerImpl{
-		workerClient: mockClient,
-		port:         9999,
-		workDir:      "/test",
-	}
 
-	config, err := spawner.generateWorkerMCPConfig("worker-1")
+	config, err := workerMCPConfig(mockClient.Type(), 9999, "worker-1")
 	require.NoError(t, err)
 	// OpenCode format uses {"mcp": {...}} wrapper, not {"mcpServers": {...}}
 	assert.Contains(t, config, `"mcp"`)
@@ -188,13 +221,8 @@ func TestUnifiedProcessSpawner_GenerateMCPConfig_OpenCode(t *testing.T) {
 
 func TestUnifiedProcessSpawner_GenerateCoordinatorMCPConfig_OpenCode(t *testing.T) {
 	mockClient := &openCodeMockClient{Client: mock.NewClient()}
-	spawner := &UnifiedProcessSpawnerImpl{
-		coordinatorClient: mockClient,
-		port:              9999,
-		workDir:           "/test",
-	}
 
-	config, err := spawner.generateCoordinatorMCPConfig()
+	config, err := coordinatorMCPConfig(mockClient.Type(), 9999)
 	require.NoError(t, err)
 	// OpenCode format uses {"mcp": {...}} wrapper
 	assert.Contains(t, config, `"mcp"`)
@@ -205,6 +233,72 @@ func TestUnifiedProcessSpawner_GenerateCoordinatorMCPConfig_OpenCode(t *testing.
 	assert.NotContains(t, config, "mcpServers")
 }
 
+// fakeAgentProvider is an AgentProvider backed by a fixed client.
+type fakeAgentProvider struct {
+	client     client.HeadlessClient
+	extensions map[string]any
+}
+
+func (p *fakeAgentProvider) Type() client.ClientType                { return p.client.Type() }
+func (p *fakeAgentProvider) Client() (client.HeadlessClient, error) { return p.client, nil }
+func (p *fakeAgentProvider) Extensions() map[string]any             { return p.extensions }
+
+func TestUnifiedProcessSpawner_SpawnProcess_WorkerBackend(t *testing.T) {
+	defaultClient := mock.NewClient()
+	defaultClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		t.Fatal("default worker client must not be used for a backend worker")
+		return nil, nil
+	}
+
+	var capturedConfig client.Config
+	backendClient := &openCodeMockClient{Client: mock.NewClient()}
+	backendClient.SpawnFunc = func(ctx context.Context, cfg client.Config) (client.HeadlessProcess, error) {
+		capturedConfig = cfg
+		return mock.NewProcess(), nil
+	}
+	backend := &fakeAgentProvider{client: backendClient, extensions: map[string]any{"opencode.model": "qwen3"}}
+
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: defaultClient,
+		WorkerClient:      defaultClient,
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator:                          client.NewAgentProvider(client.ClientMock, nil),
+			client.WorkerBackendRole(client.ClientOpenCode): backend,
+		},
+		Port:      9999,
+		Submitter: &mockCommandSubmitter{},
+		EventBus:  pubsub.NewBroker[any](),
+	})
+
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{Backend: client.ClientOpenCode})
+	require.NoError(t, err)
+	defer proc.Stop()
+
+	require.Same(t, backend, proc.Backend)
+	require.Equal(t, "qwen3", capturedConfig.Extensions["opencode.model"])
+	require.Contains(t, capturedConfig.MCPConfig, `"perles-worker"`)
+	require.NotContains(t, capturedConfig.MCPConfig, "mcpServers", "MCP config uses the backend's format")
+}
+
+func TestUnifiedProcessSpawner_SpawnProcess_UnconfiguredBackend(t *testing.T) {
+	mockClient := mock.NewClient()
+	spawner := NewUnifiedProcessSpawner(UnifiedSpawnerConfig{
+		CoordinatorClient: mockClient,
+		WorkerClient:      mockClient,
+		Submitter:         &mockCommandSubmitter{},
+		EventBus:          pubsub.NewBroker[any](),
+	})
+
+	_, err := spawner.SpawnProcess(context.Background(), "worker-1", repository.RoleWorker, SpawnOptions{Backend: client.ClientGemini})
+	require.ErrorIs(t, err, types.ErrBackendNotConfigured)
+
+	// The default worker client's own type needs no configuration
+	proc, err := spawner.SpawnProcess(context.Background(), "worker-2", repository.RoleWorker, SpawnOptions{Backend: client.ClientMock})
+	require.NoError(t, err)
+	defer proc.Stop()
+	require.Nil(t, proc.Backend)
+}
+
 func TestUnifiedProcessSpawner_SpawnCoordinator_UsesSystemPromptOverride(t *testing.T) {
 	var capturedConfig client.Config
 	mockClient := mock.NewClient()
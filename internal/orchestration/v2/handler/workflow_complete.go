@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/sound"
 )
@@ -28,6 +30,18 @@ type SessionMetadataProvider interface {
 	UpdateWorkflowCompletion(status, summary string, completedAt time.Time) error
 }
 
+// CostReporter is implemented by session metadata providers that can write a
+// cost report. Checked by type assertion on the SessionMetadataProvider.
+type CostReporter interface {
+	// WriteCostReport writes the session's cost report and returns it.
+	WriteCostReport(status string, tasksClosed int) (*metrics.CostReport, error)
+}
+
+// CompletionPoster posts the workflow completion report to the agents' chat.
+type CompletionPoster interface {
+	PostCompletion(content string) error
+}
+
 // ===========================================================================
 // SignalWorkflowCompleteHandler
 // ===========================================================================
@@ -37,6 +51,7 @@ type SessionMetadataProvider interface {
 type SignalWorkflowCompleteHandler struct {
 	sessionProvider SessionMetadataProvider
 	soundService    sound.SoundService
+	poster          CompletionPoster
 }
 
 // SignalWorkflowCompleteHandlerOption configures SignalWorkflowCompleteHandler.
@@ -59,6 +74,13 @@ func WithWorkflowSoundService(svc sound.SoundService) SignalWorkflowCompleteHand
 	}
 }
 
+// WithCompletionPoster sets where the cost report is posted on workflow completion.
+func WithCompletionPoster(poster CompletionPoster) SignalWorkflowCompleteHandlerOption {
+	return func(h *SignalWorkflowCompleteHandler) {
+		h.poster = poster
+	}
+}
+
 // NewSignalWorkflowCompleteHandler creates a new SignalWorkflowCompleteHandler.
 func NewSignalWorkflowCompleteHandler(opts ...SignalWorkflowCompleteHandlerOption) *SignalWorkflowCompleteHandler {
 	h := &SignalWorkflowCompleteHandler{
@@ -74,6 +96,7 @@ func NewSignalWorkflowCompleteHandler(opts ...SignalWorkflowCompleteHandlerOptio
 // 1. Validates status is one of "success", "partial", or "aborted"
 // 2. Updates session metadata with completion fields (preserving original timestamp for idempotency)
 // 3. Publishes ProcessWorkflowComplete event to event bus
+// 4. On the first call, plays the completion sound and writes and posts the cost report
 func (h *SignalWorkflowCompleteHandler) Handle(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
 	workflowCmd := cmd.(*command.SignalWorkflowCompleteCommand)
 
@@ -121,9 +144,11 @@ func (h *SignalWorkflowCompleteHandler) Handle(ctx context.Context, cmd command.
 		IsFirstCall: isFirstCall,
 	}
 
-	// 4. Play completion sound only on first call (not on duplicate signals)
+	// 4. Play completion sound and report costs only on first call (not on duplicate signals)
 	if isFirstCall {
 		h.soundService.Play("complete", "workflow_complete")
+		event.CostReport = h.reportCosts(workflowCmd)
+		result.CostReport = event.CostReport
 	}
 
 	return SuccessWithEvents(result, event), nil
@@ -134,5 +159,26 @@ type SignalWorkflowCompleteResult struct {
 	Status      command.WorkflowStatus
 	Summary     string
 	CompletedAt time.Time
-	IsFirstCall bool // True if this is the first completion signal (timestamp was set)
+	IsFirstCall bool                // True if this is the first completion signal (timestamp was set)
+	CostReport  *metrics.CostReport // Cost report written on the first call (nil if unavailable)
+}
+
+// reportCosts writes the cost report and posts it to the agents' chat.
+// Failures are logged, not returned: completion must not fail over the report.
+func (h *SignalWorkflowCompleteHandler) reportCosts(cmd *command.SignalWorkflowCompleteCommand) *metrics.CostReport {
+	reporter, ok := h.sessionProvider.(CostReporter)
+	if !ok {
+		return nil
+	}
+	report, err := reporter.WriteCostReport(string(cmd.Status), cmd.TasksClosed)
+	if err != nil {
+		log.Warn(log.CatOrch, "Failed to write cost report", "error", err)
+		return nil
+	}
+	if h.poster != nil {
+		if err := h.poster.PostCompletion(report.Text()); err != nil {
+			log.Warn(log.CatOrch, "Failed to post cost report", "error", err)
+		}
+	}
+	return report
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
 	"github.com/zjrosen/perles/internal/sound"
@@ -45,6 +46,30 @@ func (m *mockSessionMetadataProvider) UpdateWorkflowCompletion(status, summary s
 	return nil
 }
 
+// reportingSessionProvider also implements handler.CostReporter.
+type reportingSessionProvider struct {
+	mockSessionMetadataProvider
+	reportStatus      string
+	reportTasksClosed int
+	reportCalls       int
+}
+
+func (m *reportingSessionProvider) WriteCostReport(status string, tasksClosed int) (*metrics.CostReport, error) {
+	m.reportCalls++
+	m.reportStatus = status
+	m.reportTasksClosed = tasksClosed
+	return &metrics.CostReport{Status: status, TasksClosed: tasksClosed, CostUSD: 2}, nil
+}
+
+type recordingPoster struct {
+	posts []string
+}
+
+func (p *recordingPoster) PostCompletion(content string) error {
+	p.posts = append(p.posts, content)
+	return nil
+}
+
 // ===========================================================================
 // SignalWorkflowCompleteHandler Tests
 // ===========================================================================
@@ -563,3 +588,34 @@ func TestNewSignalWorkflowCompleteHandler_DefaultSoundService(t *testing.T) {
 
 // Ensure the sound package import is used to satisfy LSP
 var _ = sound.NoopSoundService{}
+
+func TestSignalWorkflowCompleteHandler_WritesAndPostsCostReportOnce(t *testing.T) {
+	sessionProvider := &reportingSessionProvider{}
+	poster := &recordingPoster{}
+
+	h := handler.NewSignalWorkflowCompleteHandler(
+		handler.WithSessionMetadataProvider(sessionProvider),
+		handler.WithCompletionPoster(poster),
+	)
+
+	cmd := command.NewSignalWorkflowCompleteCommand(command.SourceMCPTool, command.WorkflowStatusPartial, "Most done", "", 4)
+	result, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, sessionProvider.reportCalls)
+	require.Equal(t, "partial", sessionProvider.reportStatus)
+	require.Equal(t, 4, sessionProvider.reportTasksClosed)
+	require.Len(t, poster.posts, 1)
+	require.Contains(t, poster.posts[0], "4 tasks closed (2.00 tasks/$)")
+
+	event := result.Events[0].(events.ProcessEvent)
+	require.NotNil(t, event.CostReport)
+	require.Same(t, event.CostReport, result.Data.(*handler.SignalWorkflowCompleteResult).CostReport)
+
+	// A duplicate signal neither rewrites nor reposts the report
+	result, err = h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.Equal(t, 1, sessionProvider.reportCalls)
+	require.Len(t, poster.posts, 1)
+	require.Nil(t, result.Events[0].(events.ProcessEvent).CostReport)
+}
@@ -0,0 +1,156 @@
+// Package handler provides command handlers for the v2 orchestration architecture.
+// This file contains handlers for workflow DAG commands: AdvancePhase and ApproveCheckpoint.
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
+)
+
+// ===========================================================================
+// AdvancePhaseHandler
+// ===========================================================================
+
+// AdvancePhaseHandler handles CmdAdvancePhase commands.
+// It moves the workflow along a transition of the active DAG phase, checking
+// the transition's condition against the session's task assignments.
+type AdvancePhaseHandler struct {
+	run      *dag.Run
+	taskRepo repository.TaskRepository
+}
+
+// NewAdvancePhaseHandler creates a new AdvancePhaseHandler.
+func NewAdvancePhaseHandler(run *dag.Run, taskRepo repository.TaskRepository) *AdvancePhaseHandler {
+	return &AdvancePhaseHandler{
+		run:      run,
+		taskRepo: taskRepo,
+	}
+}
+
+// Handle processes an AdvancePhaseCommand.
+// 1. Validates the command
+// 2. Moves the DAG run to the requested phase if the transition's condition holds
+// 3. Notifies the user when the entered phase is a human checkpoint
+func (h *AdvancePhaseHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	advanceCmd := cmd.(*command.AdvancePhaseCommand)
+
+	// 1. Validate the command
+	if err := advanceCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. Move the run
+	from := h.run.Current()
+	entered, err := h.run.Advance(advanceCmd.Phase, h.conditionMet)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AdvancePhaseResult{From: from.ID, Phase: entered}
+
+	// 3. A checkpoint phase waits for the user
+	if entered.Checkpoint {
+		msg := fmt.Sprintf("Workflow reached checkpoint %q and needs your approval (/approve) to continue.", entered.ID)
+		if entered.Description != "" {
+			msg += " " + entered.Description
+		}
+		notify := command.NewNotifyUserCommand(command.SourceInternal, msg, entered.ID, "")
+		return SuccessWithFollowUp(result, notify), nil
+	}
+
+	return SuccessResult(result), nil
+}
+
+// conditionMet reports whether a transition condition holds for the session's task assignments.
+func (h *AdvancePhaseHandler) conditionMet(condition string) bool {
+	tasks := h.taskRepo.All()
+	active := 0
+	for _, task := range tasks {
+		if task.Status != repository.TaskCompleted {
+			active++
+		}
+	}
+
+	switch condition {
+	case dag.WhenTasksComplete:
+		return len(tasks) > 0 && active == 0
+	case dag.WhenNoActiveTasks:
+		return active == 0
+	default:
+		return false
+	}
+}
+
+// AdvancePhaseResult contains the result of advancing the workflow.
+type AdvancePhaseResult struct {
+	From  string    // ID of the phase that was left
+	Phase dag.Phase // The entered phase
+}
+
+// GetPhase returns the entered phase.
+func (r *AdvancePhaseResult) GetPhase() dag.Phase {
+	return r.Phase
+}
+
+// ===========================================================================
+// ApproveCheckpointHandler
+// ===========================================================================
+
+// ApproveCheckpointHandler handles CmdApproveCheckpoint commands.
+// It records the user's approval of the active checkpoint phase and tells the
+// coordinator it may move the workflow on.
+type ApproveCheckpointHandler struct {
+	run *dag.Run
+}
+
+// NewApproveCheckpointHandler creates a new ApproveCheckpointHandler.
+func NewApproveCheckpointHandler(run *dag.Run) *ApproveCheckpointHandler {
+	return &ApproveCheckpointHandler{run: run}
+}
+
+// Handle processes an ApproveCheckpointCommand.
+// 1. Validates the command (only the user may approve)
+// 2. Records the approval on the DAG run
+// 3. Sends the coordinator the approval and the user's note
+func (h *ApproveCheckpointHandler) Handle(_ context.Context, cmd command.Command) (*command.CommandResult, error) {
+	approveCmd := cmd.(*command.ApproveCheckpointCommand)
+
+	// 1. Validate the command
+	if err := approveCmd.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. Record the approval
+	phase, err := h.run.Approve()
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Tell the coordinator
+	msg := fmt.Sprintf("[CHECKPOINT APPROVED] The user approved phase %q. You may now call advance_phase (next: %s).",
+		phase.ID, formatTargets(phase.Targets()))
+	if approveCmd.Note != "" {
+		msg += "\n\nUser note: " + approveCmd.Note
+	}
+	send := command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, msg)
+
+	return SuccessWithFollowUp(&ApproveCheckpointResult{Phase: phase.ID}, send), nil
+}
+
+// formatTargets renders the phases a workflow may move to for messages.
+func formatTargets(targets []string) string {
+	if len(targets) == 0 {
+		return "none, this is the last phase"
+	}
+	return strings.Join(targets, ", ")
+}
+
+// ApproveCheckpointResult contains the result of approving a checkpoint.
+type ApproveCheckpointResult struct {
+	Phase string // ID of the approved phase
+}
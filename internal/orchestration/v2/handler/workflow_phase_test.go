@@ -0,0 +1,107 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
+)
+
+// ===========================================================================
+// Workflow DAG Handler Tests
+// ===========================================================================
+
+func newTestDAGRun(t *testing.T) *dag.Run {
+	t.Helper()
+	def, err := dag.Parse([]byte(`
+phases:
+  - id: implement
+    next:
+      - to: review
+        when: tasks_complete
+  - id: review
+    checkpoint: true
+    description: Check the changes before they ship.
+    next:
+      - to: ship
+  - id: ship
+`))
+	require.NoError(t, err)
+	return dag.NewRun(def)
+}
+
+func TestAdvancePhaseHandler_Conditions(t *testing.T) {
+	run := newTestDAGRun(t)
+	taskRepo := repository.NewMemoryTaskRepository()
+	h := handler.NewAdvancePhaseHandler(run, taskRepo)
+	ctx := context.Background()
+
+	// No tasks yet
+	_, err := h.Handle(ctx, command.NewAdvancePhaseCommand(command.SourceMCPTool, "review", ""))
+	require.ErrorContains(t, err, "until every task is completed")
+
+	// An open task
+	task := &repository.TaskAssignment{TaskID: "perles-abc.1", Implementer: "worker-1", Status: repository.TaskInReview}
+	require.NoError(t, taskRepo.Save(task))
+	_, err = h.Handle(ctx, command.NewAdvancePhaseCommand(command.SourceMCPTool, "review", ""))
+	require.Error(t, err)
+	require.Equal(t, "implement", run.Current().ID)
+
+	// All tasks completed: entering the checkpoint notifies the user
+	task.Status = repository.TaskCompleted
+	require.NoError(t, taskRepo.Save(task))
+	result, err := h.Handle(ctx, command.NewAdvancePhaseCommand(command.SourceMCPTool, "review", "all done"))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+
+	advanced := result.Data.(*handler.AdvancePhaseResult)
+	require.Equal(t, "implement", advanced.From)
+	require.Equal(t, "review", advanced.Phase.ID)
+
+	require.Len(t, result.FollowUp, 1)
+	notify := result.FollowUp[0].(*command.NotifyUserCommand)
+	require.Equal(t, "review", notify.Phase)
+	require.Contains(t, notify.Message, "Check the changes before they ship.")
+
+	// The checkpoint holds until the user approves
+	_, err = h.Handle(ctx, command.NewAdvancePhaseCommand(command.SourceMCPTool, "ship", ""))
+	require.ErrorContains(t, err, "awaiting the user's approval")
+}
+
+func TestApproveCheckpointHandler(t *testing.T) {
+	run := newTestDAGRun(t)
+	advance := handler.NewAdvancePhaseHandler(run, repository.NewMemoryTaskRepository())
+	approve := handler.NewApproveCheckpointHandler(run)
+	ctx := context.Background()
+
+	// Not at a checkpoint
+	_, err := approve.Handle(ctx, command.NewApproveCheckpointCommand(command.SourceUser, ""))
+	require.ErrorContains(t, err, "not a checkpoint")
+
+	// Only the user may approve
+	_, err = approve.Handle(ctx, command.NewApproveCheckpointCommand(command.SourceMCPTool, ""))
+	require.ErrorContains(t, err, "must be approved by the user")
+
+	_, err = run.Advance("review", func(string) bool { return true })
+	require.NoError(t, err)
+
+	result, err := approve.Handle(ctx, command.NewApproveCheckpointCommand(command.SourceUser, "ship it"))
+	require.NoError(t, err)
+	require.Equal(t, "review", result.Data.(*handler.ApproveCheckpointResult).Phase)
+
+	require.Len(t, result.FollowUp, 1)
+	send := result.FollowUp[0].(*command.SendToProcessCommand)
+	require.Equal(t, repository.CoordinatorID, send.ProcessID)
+	require.Contains(t, send.Content, "next: ship")
+	require.Contains(t, send.Content, "User note: ship it")
+
+	result, err = advance.Handle(ctx, command.NewAdvancePhaseCommand(command.SourceMCPTool, "ship", ""))
+	require.NoError(t, err)
+	require.Empty(t, result.FollowUp)
+	require.Equal(t, "ship", run.Current().ID)
+}
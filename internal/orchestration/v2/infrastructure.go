@@ -5,17 +5,31 @@ package v2
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
 
 	appbeads "github.com/zjrosen/perles/internal/beads/application"
+	beads "github.com/zjrosen/perles/internal/beads/domain"
 	infrabeads "github.com/zjrosen/perles/internal/beads/infrastructure"
+	appgit "github.com/zjrosen/perles/internal/git/application"
+	domaingit "github.com/zjrosen/perles/internal/git/domain"
+	infragit "github.com/zjrosen/perles/internal/git/infrastructure"
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/notify"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
 	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
 	"github.com/zjrosen/perles/internal/orchestration/tracing"
+	"github.com/zjrosen/perles/internal/orchestration/turnpolicy"
 	"github.com/zjrosen/perles/internal/orchestration/v2/adapter"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/handler"
@@ -23,6 +37,9 @@ import (
 	"github.com/zjrosen/perles/internal/orchestration/v2/process"
 	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
+	"github.com/zjrosen/perles/internal/paths"
 	"github.com/zjrosen/perles/internal/pubsub"
 	"github.com/zjrosen/perles/internal/sound"
 )
@@ -39,6 +56,435 @@ func (a *eventBusAdapter) Publish(eventType string, payload any) {
 	a.broker.Publish(pubsub.EventType(eventType), payload)
 }
 
+// eventingIssueExecutor publishes an events.IssueEvent after each successful
+// status, comment or field change, so issue panes can update rows live
+// instead of waiting for a full reload.
+type eventingIssueExecutor struct {
+	appbeads.IssueExecutor
+	eventBus *pubsub.Broker[any]
+}
+
+// UpdateStatus updates the issue status and publishes the change.
+func (e *eventingIssueExecutor) UpdateStatus(issueID string, status beads.Status) error {
+	if err := e.IssueExecutor.UpdateStatus(issueID, status); err != nil {
+		return err
+	}
+	e.publish(events.IssueEvent{Type: events.IssueStatusChanged, IssueID: issueID, Status: status})
+	return nil
+}
+
+// CloseIssue closes the issue and publishes the status change.
+func (e *eventingIssueExecutor) CloseIssue(issueID, reason string) error {
+	if err := e.IssueExecutor.CloseIssue(issueID, reason); err != nil {
+		return err
+	}
+	e.publish(events.IssueEvent{Type: events.IssueStatusChanged, IssueID: issueID, Status: beads.StatusClosed})
+	return nil
+}
+
+// ReopenIssue reopens the issue and publishes the status change.
+func (e *eventingIssueExecutor) ReopenIssue(issueID string) error {
+	if err := e.IssueExecutor.ReopenIssue(issueID); err != nil {
+		return err
+	}
+	e.publish(events.IssueEvent{Type: events.IssueStatusChanged, IssueID: issueID, Status: beads.StatusOpen})
+	return nil
+}
+
+// AddComment adds the comment and publishes it.
+func (e *eventingIssueExecutor) AddComment(issueID, author, text string) error {
+	if err := e.IssueExecutor.AddComment(issueID, author, text); err != nil {
+		return err
+	}
+	e.publish(events.IssueEvent{Type: events.IssueCommented, IssueID: issueID, Author: author})
+	return nil
+}
+
+// GetComments reads the issue's comments if the wrapped executor can.
+func (e *eventingIssueExecutor) GetComments(issueID string) ([]beads.Comment, error) {
+	reader, ok := e.IssueExecutor.(appbeads.CommentReader)
+	if !ok {
+		return nil, fmt.Errorf("reading comments is not supported")
+	}
+	return reader.GetComments(issueID)
+}
+
+// UpdateIssue updates the issue fields and publishes the change.
+func (e *eventingIssueExecutor) UpdateIssue(issueID string, opts beads.UpdateIssueOptions) error {
+	if err := e.IssueExecutor.UpdateIssue(issueID, opts); err != nil {
+		return err
+	}
+	event := events.IssueEvent{Type: events.IssueUpdated, IssueID: issueID}
+	if opts.Status != nil {
+		event.Status = *opts.Status
+	}
+	e.publish(event)
+	return nil
+}
+
+func (e *eventingIssueExecutor) publish(event events.IssueEvent) {
+	e.eventBus.Publish(pubsub.UpdatedEvent, event)
+}
+
+// fabricBudgetNotifier implements processor.BudgetNotifier by posting to #system.
+type fabricBudgetNotifier struct {
+	service *fabric.Service
+}
+
+// NotifyBudget posts a budget warning mentioning the affected processes.
+func (n *fabricBudgetNotifier) NotifyBudget(content string, mentions []string) error {
+	_, err := n.service.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugSystem,
+		Content:     content,
+		Kind:        domain.KindError,
+		CreatedBy:   domain.AgentSystem,
+		Mentions:    mentions,
+	})
+	return err
+}
+
+// fabricCompletionPoster implements handler.CompletionPoster by posting to #general.
+type fabricCompletionPoster struct {
+	service *fabric.Service
+}
+
+// PostCompletion posts the workflow completion report.
+func (p *fabricCompletionPoster) PostCompletion(content string) error {
+	_, err := p.service.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugGeneral,
+		Content:     content,
+		CreatedBy:   domain.AgentSystem,
+	})
+	return err
+}
+
+// maxTaskCommits bounds the commits listed per check of a committing task.
+const maxTaskCommits = 20
+
+// gitCommitSource implements processor.CommitSource on top of the git executor.
+type gitCommitSource struct {
+	git appgit.GitExecutor
+}
+
+// Head returns the SHA of HEAD.
+func (s *gitCommitSource) Head() (string, error) {
+	commits, err := s.git.GetCommitLog(1)
+	if err != nil || len(commits) == 0 {
+		return "", err
+	}
+	return commits[0].Hash, nil
+}
+
+// CommitsSince returns the commits after base, oldest first.
+func (s *gitCommitSource) CommitsSince(base string) ([]processor.TaskCommit, error) {
+	ref := "HEAD"
+	if base != "" {
+		ref = base + "..HEAD"
+	}
+	history, err := s.git.GetCommitLogForRef(ref, maxTaskCommits)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]processor.TaskCommit, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		c := history[i]
+		numstat, _ := s.git.GetDiffStat(c.Hash + "^!")
+		commits = append(commits, processor.TaskCommit{
+			SHA:      c.Hash,
+			ShortSHA: c.ShortHash,
+			Subject:  c.Subject,
+			DiffStat: summarizeNumstat(numstat),
+			Tasks:    c.Tasks,
+		})
+	}
+	return commits, nil
+}
+
+// worktreeTimeout bounds the creation of a worker worktree.
+const worktreeTimeout = 30 * time.Second
+
+// worktreeBranchPrefix returns the prefix of the worker branches of a session,
+// e.g. "perles-1a2b3c4d-" for worker branch "perles-1a2b3c4d-worker-1".
+func worktreeBranchPrefix(sessionID string) string {
+	if len(sessionID) > 8 {
+		sessionID = sessionID[:8]
+	}
+	return "perles-" + sessionID + "-"
+}
+
+// gitWorktrees implements processor.WorktreeGit on top of the git executor of
+// the session's work directory.
+type gitWorktrees struct {
+	git appgit.GitExecutor
+}
+
+// CurrentBranch returns the branch checked out in the work directory.
+func (g *gitWorktrees) CurrentBranch() (string, error) {
+	return g.git.GetCurrentBranch()
+}
+
+// CreateWorktree creates a worktree at path on a new branch from HEAD.
+func (g *gitWorktrees) CreateWorktree(path, branch string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), worktreeTimeout)
+	defer cancel()
+	return g.git.CreateWorktreeWithContext(ctx, path, branch, "")
+}
+
+// Dirty reports whether the worktree at path has uncommitted changes.
+func (g *gitWorktrees) Dirty(path string) (bool, error) {
+	return infragit.NewRealExecutor(path).HasUncommittedChanges()
+}
+
+// Merge merges branch into the work directory's current branch.
+func (g *gitWorktrees) Merge(branch string) error {
+	err := g.git.MergeBranch(branch)
+	if errors.Is(err, domaingit.ErrMergeConflict) {
+		return fmt.Errorf("%w: %w", processor.ErrMergeConflict, err)
+	}
+	return err
+}
+
+// Sync merges the work directory's HEAD commit into the worktree at path.
+func (g *gitWorktrees) Sync(path string) error {
+	head, err := g.git.GetCommitLog(1)
+	if err != nil {
+		return fmt.Errorf("reading HEAD: %w", err)
+	}
+	if len(head) == 0 {
+		return nil
+	}
+	err = infragit.NewRealExecutor(path).MergeBranch(head[0].Hash)
+	if errors.Is(err, domaingit.ErrMergeConflict) {
+		return fmt.Errorf("%w: %w", processor.ErrMergeConflict, err)
+	}
+	return err
+}
+
+// RemoveWorktree removes the worktree at path.
+func (g *gitWorktrees) RemoveWorktree(path string) error {
+	return g.git.RemoveWorktree(path)
+}
+
+// DeleteBranch deletes branch if it is merged.
+func (g *gitWorktrees) DeleteBranch(branch string) error {
+	return g.git.DeleteBranch(branch)
+}
+
+// gitChangedFiles implements processor.ChangedFileSource on top of the worker
+// worktrees: a worker's changes are its branch's commits since it forked from
+// the session's HEAD, plus its uncommitted and untracked files.
+type gitChangedFiles struct {
+	git       appgit.GitExecutor
+	worktrees *processor.WorkerWorktrees
+}
+
+// ChangedFiles returns the files workerID changed in its worktree, or none if it has no worktree.
+func (f *gitChangedFiles) ChangedFiles(workerID string) ([]string, error) {
+	wt, ok := f.worktrees.Worktree(workerID)
+	if !ok {
+		return nil, nil
+	}
+	head, err := f.git.GetCommitLog(1)
+	if err != nil || len(head) == 0 {
+		return nil, err
+	}
+
+	worktree := infragit.NewRealExecutor(wt.Path)
+	committed, err := worktree.GetDiffStat(head[0].Hash + "...HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("diffing branch %s: %w", wt.Branch, err)
+	}
+	uncommitted, err := worktree.GetDiffStat("HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("diffing worktree %s: %w", wt.Path, err)
+	}
+	files, err := worktree.GetUntrackedFiles()
+	if err != nil {
+		return nil, fmt.Errorf("listing untracked files in %s: %w", wt.Path, err)
+	}
+
+	for _, line := range strings.Split(committed+"\n"+uncommitted, "\n") {
+		if fields := strings.SplitN(line, "\t", 3); len(fields) == 3 && !slices.Contains(files, fields[2]) {
+			files = append(files, fields[2])
+		}
+	}
+	return files, nil
+}
+
+// fabricConflictNotifier implements processor.ConflictNotifier by posting to #tasks.
+type fabricConflictNotifier struct {
+	service *fabric.Service
+}
+
+// NotifyConflicts posts an overlapping edits warning mentioning the affected processes.
+func (n *fabricConflictNotifier) NotifyConflicts(content string, mentions []string) error {
+	_, err := n.service.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: domain.SlugTasks,
+		Content:     content,
+		CreatedBy:   domain.AgentSystem,
+		Mentions:    mentions,
+	})
+	return err
+}
+
+// summarizeNumstat condenses git --numstat output to "N files changed, +A -D".
+// Binary files count as changed without line counts.
+func summarizeNumstat(numstat string) string {
+	var files, added, deleted int
+	for _, line := range strings.Split(strings.TrimSpace(numstat), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		files++
+		if n, err := strconv.Atoi(fields[0]); err == nil {
+			added += n
+		}
+		if n, err := strconv.Atoi(fields[1]); err == nil {
+			deleted += n
+		}
+	}
+	if files == 0 {
+		return ""
+	}
+	noun := "files"
+	if files == 1 {
+		noun = "file"
+	}
+	return fmt.Sprintf("%d %s changed, +%d -%d", files, noun, added, deleted)
+}
+
+// taskCommitPublisher implements processor.CommitPublisher. It replies in the
+// task's Fabric thread and records the commit on the bd issue, linking both ways.
+type taskCommitPublisher struct {
+	fabric *fabric.Service
+	beads  appbeads.IssueExecutor
+}
+
+// PublishCommit posts the commit to the task thread and comments on the issue.
+func (p *taskCommitPublisher) PublishCommit(task *repository.TaskAssignment, commit processor.TaskCommit) error {
+	threadID := task.ThreadID
+	if threadID != "" {
+		content := fmt.Sprintf("Committed %s: %s", commit.ShortSHA, commit.Subject)
+		if commit.DiffStat != "" {
+			content += "\n" + commit.DiffStat
+		}
+		_, err := p.fabric.Reply(fabric.ReplyInput{
+			MessageID: threadID,
+			Content:   content,
+			Kind:      domain.KindInfo,
+			CreatedBy: domain.AgentSystem,
+			Meta: map[string]string{
+				fabric.MetaCommitSHA: commit.SHA,
+				fabric.MetaTaskID:    task.TaskID,
+			},
+		})
+		if err != nil {
+			log.Debug(log.CatOrch, "failed to post commit to task thread", "taskID", task.TaskID, "error", err)
+			threadID = ""
+		}
+	}
+
+	link := beads.CommitLink{SHA: commit.SHA, Subject: commit.Subject, ThreadID: threadID}
+	if err := p.beads.AddComment(task.TaskID, repository.CoordinatorID, beads.FormatCommitComment(link, commit.DiffStat)); err != nil {
+		return fmt.Errorf("comment commit on %s: %w", task.TaskID, err)
+	}
+	return nil
+}
+
+// soloReadyLimit caps the ready tasks listed per solo mode dispatch.
+const soloReadyLimit = 50
+
+// bdReadyTasks implements processor.ReadyTaskSource on top of bd ready.
+type bdReadyTasks struct {
+	beads appbeads.ReadyLister
+}
+
+// ReadyTasks returns the ready issues, skipping epics (they are closed by their children)
+// and archived issues (they can't be assigned).
+func (s *bdReadyTasks) ReadyTasks() ([]processor.ReadyTask, error) {
+	issues, err := s.beads.ReadyIssues(soloReadyLimit)
+	if err != nil {
+		return nil, fmt.Errorf("list ready issues: %w", err)
+	}
+	tasks := make([]processor.ReadyTask, 0, len(issues))
+	for _, issue := range issues {
+		if issue.Type == beads.TypeEpic || issue.IsArchived() {
+			continue
+		}
+		tasks = append(tasks, processor.ReadyTask{ID: issue.ID, Title: issue.TitleText})
+	}
+	return tasks, nil
+}
+
+// fabricTaskThreads implements processor.TaskThreadStarter, posting assignments to #tasks
+// the way the coordinator's assign_task does.
+type fabricTaskThreads struct {
+	fabric *fabric.Service
+}
+
+// StartTaskThread posts the assignment to #tasks and returns the thread ID.
+func (t *fabricTaskThreads) StartTaskThread(task processor.ReadyTask, workerID string) (string, error) {
+	thread, err := t.fabric.SendMessage(fabric.SendMessageInput{
+		ChannelSlug: "tasks",
+		Content:     fmt.Sprintf("Task: %s [%s] assigned to %s", task.Title, task.ID, workerID),
+		CreatedBy:   domain.AgentSystem,
+		Meta:        map[string]string{fabric.MetaTaskID: task.ID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("post assignment of %s: %w", task.ID, err)
+	}
+	return thread.ID, nil
+}
+
+// threadExcerptLen caps the message excerpts in pruning hints.
+const threadExcerptLen = 80
+
+// fabricThreadGraph implements processor.ThreadGraph on top of Fabric's
+// reply dependencies and acks.
+type fabricThreadGraph struct {
+	fabric *fabric.Service
+}
+
+// ReadMessages returns the thread root and replies agentID acknowledged or authored, oldest first.
+func (g *fabricThreadGraph) ReadMessages(threadID, agentID string) ([]processor.ThreadMessage, error) {
+	root, err := g.fabric.GetThread(threadID)
+	if err != nil {
+		return nil, fmt.Errorf("get thread %s: %w", threadID, err)
+	}
+	replies, err := g.fabric.GetReplies(threadID)
+	if err != nil {
+		return nil, fmt.Errorf("get replies of %s: %w", threadID, err)
+	}
+
+	var msgs []processor.ThreadMessage
+	for _, t := range append([]domain.Thread{*root}, replies...) {
+		read := t.CreatedBy == agentID
+		if !read {
+			if read, err = g.fabric.IsAcked(agentID, t.ID); err != nil {
+				return nil, fmt.Errorf("check ack of %s: %w", t.ID, err)
+			}
+		}
+		if !read {
+			continue
+		}
+		msgs = append(msgs, processor.ThreadMessage{ID: t.ID, CreatedBy: t.CreatedBy, CreatedAt: t.CreatedAt, Excerpt: threadExcerpt(t.Content)})
+	}
+	slices.SortStableFunc(msgs, func(a, b processor.ThreadMessage) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return msgs, nil
+}
+
+// threadExcerpt returns the first line of content, capped at threadExcerptLen runes.
+func threadExcerpt(content string) string {
+	first, _, _ := strings.Cut(content, "\n")
+	runes := []rune(first)
+	if len(runes) > threadExcerptLen {
+		return string(runes[:threadExcerptLen-3]) + "..."
+	}
+	return first
+}
+
 // sessionDirProvider implements handler.SessionDirProvider.
 // It wraps a static session directory path.
 type sessionDirProvider struct {
@@ -70,6 +516,9 @@ type InfrastructureConfig struct {
 	// Tracer is the OpenTelemetry tracer for distributed tracing (optional).
 	// When provided, TracingMiddleware will be registered in the command processor.
 	Tracer trace.Tracer
+	// Metrics records command throughput, queue depth and per-phase latency
+	// (optional). When provided, its middleware is registered in the command processor.
+	Metrics *tracing.Metrics
 	// SessionRefNotifier is called when a process's session reference is captured.
 	// Used to persist session refs for crash-resilient session resumption.
 	// Optional - if nil, session ref capture is skipped.
@@ -77,6 +526,9 @@ type InfrastructureConfig struct {
 	// SoundService provides audio feedback for orchestration events.
 	// Optional - if nil, uses NoopSoundService (no audio).
 	SoundService sound.SoundService
+	// Notifier shows desktop notifications for events that need the user's attention.
+	// Optional - if nil, uses NoopNotifier (no desktop notifications).
+	Notifier notify.Notifier
 	// SessionMetadataProvider provides access to session metadata for workflow completion.
 	// Optional - if nil, workflow completion status is not persisted to session metadata.
 	SessionMetadataProvider handler.SessionMetadataProvider
@@ -86,6 +538,50 @@ type InfrastructureConfig struct {
 	// CommandPersistenceProvider returns the current CommandWriter for persisting commands.
 	// Optional - if nil, commands are not persisted to commands.jsonl.
 	CommandPersistenceProvider func() processor.CommandWriter
+	// FabricStorage selects where Fabric threads, dependencies, subscriptions and acks
+	// are kept: "memory" (default) or "sqlite" ({SessionDir}/fabric.db, survives restarts).
+	FabricStorage string
+	// WorkerBudget caps each worker's tokens and wall-clock time (zero = unlimited).
+	// Workers are warned at 80% and replaced at 100%.
+	WorkerBudget repository.Budget
+	// SessionBudget caps the tokens and wall-clock time of the whole session (zero = unlimited).
+	// The coordinator is warned at 80% and 100%.
+	SessionBudget repository.Budget
+	// SoloMode runs the workflow without a coordinator agent: the processor assigns
+	// ready bd tasks to idle workers and reviewers itself (see processor.SoloDispatcher).
+	SoloMode bool
+	// SoloCoordinator sends solo mode escalations (review denials, failures) to a
+	// coordinator as well as the user. Only set when a coordinator is spawned.
+	SoloCoordinator bool
+	// PruningHints sends workers context pruning hints on phase transitions: the
+	// task thread messages they have read that the transition settled
+	// (see processor.PruningHinter).
+	PruningHints bool
+	// WorkerWorktrees gives each worker its own git worktree and branch under
+	// {SessionDir}/worktrees, merged after approve_commit (see processor.WorkerWorktrees).
+	// The worktrees are scanned for files changed by several implementers, which
+	// are posted to #tasks (see processor.ConflictScanner).
+	WorkerWorktrees bool
+	// PhaseTimeouts limits how long workers may stay in each phase; workers over
+	// the limit are nudged, then reported to the coordinator, then replaced
+	// (see processor.PhaseTimeoutWatcher). Zero disables the watcher.
+	PhaseTimeouts processor.PhaseTimeouts
+	// TurnPolicy decides which tools complete a worker's turn, per phase, and the
+	// escalation (nudge, warn, replace) when none is called. Decisions are
+	// published on the event bus as turnpolicy.Decision.
+	TurnPolicy turnpolicy.Policy
+	// DAG splits the workflow into phases (see dag.Definition). Coordinator
+	// commands the active phase does not allow are rejected by processor.PhaseGate,
+	// and the coordinator moves between phases with advance_phase.
+	// Optional - if nil, the workflow has no phases.
+	DAG *dag.Definition
+	// Webhooks delivers lifecycle events (workflow completed, worker failed,
+	// review denied, ...) to the configured endpoints (see processor.WebhookPublisher).
+	// Optional - if nil, no webhooks are sent.
+	Webhooks *webhook.Dispatcher
+	// WorkflowID and WorkflowName identify the workflow in webhook payloads.
+	WorkflowID   string
+	WorkflowName string
 }
 
 // Validate checks that all required configuration is provided.
@@ -102,6 +598,18 @@ func (c *InfrastructureConfig) Validate() error {
 	if c.WorkDir == "" {
 		return fmt.Errorf("work directory is required")
 	}
+	switch c.FabricStorage {
+	case "", fabricrepo.StorageMemory:
+	case fabricrepo.StorageSQLite:
+		if c.SessionDir == "" {
+			return fmt.Errorf("sqlite fabric storage requires a session directory")
+		}
+	default:
+		return fmt.Errorf("unknown fabric storage %q", c.FabricStorage)
+	}
+	if c.WorkerWorktrees && c.SessionDir == "" {
+		return fmt.Errorf("worker worktrees require a session directory")
+	}
 	return nil
 }
 
@@ -118,6 +626,9 @@ type Infrastructure struct {
 
 	// config holds the original configuration for lifecycle operations
 	config InfrastructureConfig
+
+	// stopObservingQueue ends the queue depth metric of the session
+	stopObservingQueue func()
 }
 
 // CoreComponents holds the core v2 infrastructure pieces.
@@ -133,6 +644,11 @@ type CoreComponents struct {
 	// FabricService provides the Fabric messaging layer for inter-agent communication.
 	// Used by MCP servers to expose fabric_* tools to coordinator and workers.
 	FabricService *fabric.Service
+	// Progress reports long-running commands and cancels them on request.
+	Progress *processor.ProgressTracker
+	// BeadsExecutor syncs tasks to the bd tracker and publishes the issue
+	// changes. It also reads comments (appbeads.CommentReader).
+	BeadsExecutor appbeads.IssueExecutor
 }
 
 // RepositoryComponents holds all repository instances.
@@ -143,6 +659,10 @@ type RepositoryComponents struct {
 	TaskRepo repository.TaskRepository
 	// QueueRepo tracks per-worker message queues.
 	QueueRepo repository.QueueRepository
+	// ReviewHistory records reviewer/implementer pairings for reviewer rotation.
+	ReviewHistory repository.ReviewHistoryRepository
+	// DeadLetters keeps failed commands for inspection and retry.
+	DeadLetters repository.DeadLetterRepository
 }
 
 // InternalComponents holds internal infrastructure not exposed externally.
@@ -151,6 +671,26 @@ type InternalComponents struct {
 	ProcessRegistry *process.ProcessRegistry
 	// TurnEnforcer tracks MCP tool calls during worker turns for enforcement.
 	TurnEnforcer handler.TurnCompletionEnforcer
+	// FabricStore is the database behind the Fabric repositories when FabricStorage
+	// is "sqlite", nil for in-memory storage. Closed by Shutdown.
+	FabricStore *fabricrepo.SQLiteStore
+	// WorkerWorktrees gives each worker its own git worktree, nil without
+	// worker worktrees.
+	WorkerWorktrees *processor.WorkerWorktrees
+	// ConflictScanner reports files changed by several implementers, nil
+	// without worker worktrees. Started by Start, stopped by Shutdown.
+	ConflictScanner *processor.ConflictScanner
+	// PhaseTimeouts escalates workers stuck in a phase, nil without phase
+	// timeouts. Started by Start, stopped by Shutdown.
+	PhaseTimeouts *processor.PhaseTimeoutWatcher
+	// Budget enforces the worker and session budgets, nil without budgets.
+	// Its wall-clock checks are started by Start and stopped by Shutdown.
+	Budget *processor.BudgetEnforcer
+	// TurnTraces links the tool calls of each process's turn to the span that
+	// delivered the turn. MCP servers use it to parent their tool call spans.
+	TurnTraces *tracing.TurnTraces
+	// DAGRun tracks the active phase of the workflow DAG, nil without a DAG.
+	DAGRun *dag.Run
 }
 
 // NewInfrastructure creates all v2 orchestration infrastructure components.
@@ -190,17 +730,17 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	taskRepo := repository.NewMemoryTaskRepository()
 	queueRepo := repository.NewMemoryQueueRepository(repository.DefaultQueueMaxSize)
 	processRepo := repository.NewMemoryProcessRepository()
+	reviewHistory := repository.NewMemoryReviewHistoryRepository()
+	deadLetters := repository.NewMemoryDeadLetterRepository(repository.DefaultMaxDeadLetters)
 
 	// Create Fabric messaging layer repositories and service
 	// Fabric provides graph-based messaging ("Slack for Agents") with channels, threads, and artifacts.
-	fabricThreads := fabricrepo.NewMemoryThreadRepository()
-	fabricDeps := fabricrepo.NewMemoryDependencyRepository()
-	fabricSubs := fabricrepo.NewMemorySubscriptionRepository()
-	fabricAcks := fabricrepo.NewMemoryAckRepository(fabricDeps, fabricThreads, fabricSubs)
 	fabricParticipants := fabricrepo.NewMemoryParticipantRepository()
-	// Wire participant repo to ack repo for @here inbox expansion
-	fabricAcks.SetParticipantRepository(fabricParticipants)
-	fabricService := fabric.NewService(fabricThreads, fabricDeps, fabricSubs, fabricAcks, fabricParticipants)
+	fabricRepos, err := newFabricRepositories(cfg.FabricStorage, cfg.SessionDir, fabricParticipants)
+	if err != nil {
+		return nil, err
+	}
+	fabricService := fabric.NewService(fabricRepos.threads, fabricRepos.deps, fabricRepos.subs, fabricRepos.acks, fabricParticipants)
 
 	// Create event bus for v2 command events (TUI subscribes via GetV2EventBus())
 	eventBus := pubsub.NewBroker[any]()
@@ -216,27 +756,134 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 	timeoutMiddleware := processor.NewTimeoutMiddleware(processor.TimeoutMiddlewareConfig{
 		WarningThreshold: 500 * time.Millisecond,
 	})
+	progressTracker := processor.NewProgressTracker(processor.ProgressTrackerConfig{
+		EventBus: &eventBusAdapter{broker: eventBus},
+	})
 	tracingMiddleware := tracing.NewTracingMiddleware(tracing.TracingMiddlewareConfig{
 		Tracer: cfg.Tracer,
 	})
 
+	middlewares := []processor.Middleware{tracingMiddleware, cfg.Metrics.Middleware(), loggingMiddleware, commandLogMiddleware, commandPersistenceMiddleware, timeoutMiddleware, progressTracker.Middleware()}
+	var budgetEnforcer *processor.BudgetEnforcer
+	if !cfg.WorkerBudget.IsZero() || !cfg.SessionBudget.IsZero() {
+		budgetEnforcer = processor.NewBudgetEnforcer(processor.BudgetEnforcerConfig{
+			Worker:    cfg.WorkerBudget,
+			Session:   cfg.SessionBudget,
+			Processes: processRepo,
+			Notifier:  &fabricBudgetNotifier{service: fabricService},
+		})
+		middlewares = append(middlewares, budgetEnforcer.Middleware())
+	}
+
+	// Post lifecycle events to the configured webhooks
+	if cfg.Webhooks != nil {
+		webhookPublisher := processor.NewWebhookPublisher(processor.WebhookPublisherConfig{
+			Dispatcher:   cfg.Webhooks,
+			Processes:    processRepo,
+			Tasks:        taskRepo,
+			WorkflowID:   cfg.WorkflowID,
+			WorkflowName: cfg.WorkflowName,
+		})
+		middlewares = append(middlewares, webhookPublisher.Middleware())
+	}
+
+	// Reject coordinator commands the active phase of the workflow DAG does not allow
+	var dagRun *dag.Run
+	if cfg.DAG != nil {
+		dagRun = dag.NewRun(cfg.DAG)
+		middlewares = append(middlewares, processor.NewPhaseGate(dagRun, processRepo).Middleware())
+	}
+
+	// Workers in worktrees must use the session's beads database, not the
+	// worktree's checkout of .beads
+	if cfg.WorkerWorktrees && cfg.BeadsDir == "" {
+		cfg.BeadsDir = paths.ResolveBeadsDir(cfg.WorkDir)
+	}
+
+	// Create BDTaskExecutor for syncing v2 state changes to BD tracker.
+	// Changes are published so issue panes update live.
+	bdExec := infrabeads.NewBDExecutor(cfg.WorkDir, cfg.BeadsDir)
+	beadsExec := &eventingIssueExecutor{IssueExecutor: bdExec, eventBus: eventBus}
+
+	// Link commits made after approve_commit to the task's thread and issue
+	var workerWorktrees *processor.WorkerWorktrees
+	var conflictScanner *processor.ConflictScanner
+	if gitExec := infragit.NewRealExecutor(cfg.WorkDir); gitExec.IsGitRepo() {
+		commitLinker := processor.NewCommitLinker(processor.CommitLinkerConfig{
+			Commits:   &gitCommitSource{git: gitExec},
+			Publisher: &taskCommitPublisher{fabric: fabricService, beads: beadsExec},
+			Tasks:     taskRepo,
+		})
+		middlewares = append(middlewares, commitLinker.Middleware())
+
+		// Isolate workers in worktrees and watch them for overlapping edits. Listed
+		// after the commit linker so a branch is merged before the linker looks
+		// for the task's commits.
+		if cfg.WorkerWorktrees {
+			workerWorktrees = processor.NewWorkerWorktrees(processor.WorkerWorktreesConfig{
+				Git:          &gitWorktrees{git: gitExec},
+				Dir:          filepath.Join(cfg.SessionDir, "worktrees"),
+				BranchPrefix: worktreeBranchPrefix(cfg.SessionID),
+				Tasks:        taskRepo,
+			})
+			middlewares = append(middlewares, workerWorktrees.Middleware())
+
+			conflictScanner = processor.NewConflictScanner(processor.ConflictScannerConfig{
+				Files:    &gitChangedFiles{git: gitExec, worktrees: workerWorktrees},
+				Tasks:    taskRepo,
+				Notifier: &fabricConflictNotifier{service: fabricService},
+			})
+		}
+	}
+
+	// Tell workers which task thread messages their phase transitions settled
+	if cfg.PruningHints {
+		pruningHinter := processor.NewPruningHinter(processor.PruningHinterConfig{
+			Threads: &fabricThreadGraph{fabric: fabricService},
+			Tasks:   taskRepo,
+		})
+		middlewares = append(middlewares, pruningHinter.Middleware())
+	}
+
+	// In solo mode the processor drives task assignment instead of a coordinator
+	if cfg.SoloMode {
+		soloDispatcher := processor.NewSoloDispatcher(processor.SoloDispatcherConfig{
+			Ready:                 &bdReadyTasks{beads: bdExec},
+			Processes:             processRepo,
+			Tasks:                 taskRepo,
+			Threads:               &fabricTaskThreads{fabric: fabricService},
+			EscalateToCoordinator: cfg.SoloCoordinator,
+		})
+		middlewares = append(middlewares, soloDispatcher.Middleware())
+	}
+
 	// Create command processor with event bus for TUI event propagation
 	cmdProcessor := processor.NewCommandProcessor(
 		processor.WithQueueCapacity(1000),
 		processor.WithTaskRepository(taskRepo),
 		processor.WithQueueRepository(queueRepo),
 		processor.WithEventBus(eventBus),
-		processor.WithMiddleware(tracingMiddleware, loggingMiddleware, commandLogMiddleware, commandPersistenceMiddleware, timeoutMiddleware),
+		processor.WithDeadLetterRepository(deadLetters),
+		processor.WithMiddleware(middlewares...),
 	)
 
+	// Escalate workers that stay in a phase longer than its timeout
+	var phaseTimeouts *processor.PhaseTimeoutWatcher
+	if !cfg.PhaseTimeouts.IsZero() {
+		phaseTimeouts = processor.NewPhaseTimeoutWatcher(processor.PhaseTimeoutWatcherConfig{
+			Timeouts:  cfg.PhaseTimeouts,
+			Processes: processRepo,
+			Submitter: cmdProcessor,
+			EventBus:  &eventBusAdapter{broker: eventBus},
+		})
+	}
+
 	// Create unified ProcessRegistry for coordinator and workers
 	processRegistry := process.NewProcessRegistry()
 
 	// Create turn completion enforcer for tracking worker tool calls
-	turnEnforcer := handler.NewTurnCompletionTracker()
-
-	// Create BDTaskExecutor for syncing v2 state changes to BD tracker
-	beadsExec := infrabeads.NewBDExecutor(cfg.WorkDir, cfg.BeadsDir)
+	turnEnforcer := handler.NewTurnCompletionTrackerWithOptions(handler.WithPolicy(cfg.TurnPolicy))
+	turnTraces := tracing.NewTurnTraces()
 
 	// Register all command handlers
 	registerHandlers(
@@ -244,14 +891,17 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		processRepo,
 		taskRepo,
 		queueRepo,
+		reviewHistory,
 		processRegistry,
 		turnEnforcer,
+		turnTraces,
 		coordinatorClient,
 		workerClient,
 		observerClient,
 		coordinatorExtensions,
 		workerExtensions,
 		observerExtensions,
+		cfg.AgentProviders,
 		beadsExec,
 		cfg.Port,
 		eventBus,
@@ -261,10 +911,16 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		cfg.Tracer,
 		cfg.SessionRefNotifier,
 		cfg.SoundService,
+		cfg.Notifier,
 		cfg.SessionMetadataProvider,
 		cfg.WorkflowStateProvider,
 		fabricService,
+		workerWorktrees,
 	)
+	if dagRun != nil {
+		cmdProcessor.RegisterHandler(command.CmdAdvancePhase, handler.NewAdvancePhaseHandler(dagRun, taskRepo))
+		cmdProcessor.RegisterHandler(command.CmdApproveCheckpoint, handler.NewApproveCheckpointHandler(dagRun))
+	}
 
 	// Create command submitter adapter
 	cmdSubmitter := handler.NewProcessorSubmitterAdapter(cmdProcessor)
@@ -274,7 +930,9 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 		adapter.WithProcessRepository(processRepo),
 		adapter.WithTaskRepository(taskRepo),
 		adapter.WithQueueRepository(queueRepo),
+		adapter.WithReviewHistoryRepository(reviewHistory),
 		adapter.WithSessionID(cfg.SessionID, cfg.WorkDir, cfg.SessionDir),
+		adapter.WithConflictScanner(conflictScanner),
 	)
 
 	// NOTE: CoordinatorNudger removed - FabricBroker handles @mention notifications
@@ -286,15 +944,26 @@ func NewInfrastructure(cfg InfrastructureConfig) (*Infrastructure, error) {
 			EventBus:      eventBus,
 			CmdSubmitter:  cmdSubmitter,
 			FabricService: fabricService,
+			Progress:      progressTracker,
+			BeadsExecutor: beadsExec,
 		},
 		Repositories: RepositoryComponents{
-			ProcessRepo: processRepo,
-			TaskRepo:    taskRepo,
-			QueueRepo:   queueRepo,
+			ProcessRepo:   processRepo,
+			TaskRepo:      taskRepo,
+			QueueRepo:     queueRepo,
+			ReviewHistory: reviewHistory,
+			DeadLetters:   deadLetters,
 		},
 		Internal: InternalComponents{
 			ProcessRegistry: processRegistry,
 			TurnEnforcer:    turnEnforcer,
+			FabricStore:     fabricRepos.store,
+			WorkerWorktrees: workerWorktrees,
+			ConflictScanner: conflictScanner,
+			PhaseTimeouts:   phaseTimeouts,
+			Budget:          budgetEnforcer,
+			TurnTraces:      turnTraces,
+			DAGRun:          dagRun,
 		},
 		config: cfg,
 	}, nil
@@ -320,11 +989,61 @@ func (i *Infrastructure) Start(ctx context.Context) error {
 		}
 	}
 
+	if i.Internal.ConflictScanner != nil {
+		i.Internal.ConflictScanner.Start(ctx)
+	}
+	if i.Internal.PhaseTimeouts != nil {
+		i.Internal.PhaseTimeouts.Start(ctx)
+	}
+	if i.Internal.Budget != nil {
+		i.Internal.Budget.Start(ctx, i.Core.Processor)
+	}
+
+	stop, err := i.config.Metrics.ObserveQueueDepth(i.config.SessionID, i.Core.Processor.QueueLength)
+	if err != nil {
+		// Non-critical - the session runs without the queue depth metric
+		log.Debug(log.CatOrch, "Failed to observe command queue depth", "error", err)
+	} else {
+		i.stopObservingQueue = stop
+	}
+
 	// NOTE: CoordinatorNudger.Start() removed - FabricBroker.Start() is called by Supervisor
 
 	return nil
 }
 
+// fabricRepositories holds the Fabric repositories selected by FabricStorage.
+type fabricRepositories struct {
+	threads fabricrepo.ThreadRepository
+	deps    fabricrepo.DependencyRepository
+	subs    fabricrepo.SubscriptionRepository
+	acks    fabricrepo.AckRepository
+	store   *fabricrepo.SQLiteStore // nil for in-memory storage
+}
+
+// newFabricRepositories creates the thread, dependency, subscription and ack repositories
+// for the given storage. Participants stay in memory; they're wired to the ack repository
+// for @here inbox expansion.
+func newFabricRepositories(storage, sessionDir string, participants fabricrepo.ParticipantRepository) (*fabricRepositories, error) {
+	if storage == fabricrepo.StorageSQLite {
+		store, err := fabricrepo.OpenSQLiteStore(filepath.Join(sessionDir, fabricrepo.SQLiteFileName))
+		if err != nil {
+			return nil, fmt.Errorf("opening fabric store: %w", err)
+		}
+		threads, deps, subs := store.Threads(), store.Dependencies(), store.Subscriptions()
+		acks := store.Acks(deps, threads, subs)
+		acks.SetParticipantRepository(participants)
+		return &fabricRepositories{threads: threads, deps: deps, subs: subs, acks: acks, store: store}, nil
+	}
+
+	threads := fabricrepo.NewMemoryThreadRepository()
+	deps := fabricrepo.NewMemoryDependencyRepository()
+	subs := fabricrepo.NewMemorySubscriptionRepository()
+	acks := fabricrepo.NewMemoryAckRepository(deps, threads, subs)
+	acks.SetParticipantRepository(participants)
+	return &fabricRepositories{threads: threads, deps: deps, subs: subs, acks: acks}, nil
+}
+
 // Drain gracefully shuts down the command processor, processing all remaining
 // commands in the queue before stopping.
 func (i *Infrastructure) Drain() {
@@ -337,12 +1056,30 @@ func (i *Infrastructure) Drain() {
 // This is the recommended way to cleanly shut down the infrastructure.
 // NOTE: FabricBroker.Stop() is called by Supervisor before this.
 func (i *Infrastructure) Shutdown() {
+	if i.Internal.ConflictScanner != nil {
+		i.Internal.ConflictScanner.Stop()
+	}
+	if i.Internal.PhaseTimeouts != nil {
+		i.Internal.PhaseTimeouts.Stop()
+	}
+	if i.Internal.Budget != nil {
+		i.Internal.Budget.Stop()
+	}
+	if i.stopObservingQueue != nil {
+		i.stopObservingQueue()
+	}
 	// Stop all processes (coordinator and workers)
 	if i.Internal.ProcessRegistry != nil {
 		i.Internal.ProcessRegistry.StopAll()
 	}
 	// Then drain processor to complete in-flight commands
 	i.Drain()
+	// Finally close the Fabric store; nothing writes to it once the processor is drained
+	if i.Internal.FabricStore != nil {
+		if err := i.Internal.FabricStore.Close(); err != nil {
+			log.Debug(log.CatOrch, "Failed to close fabric store", "error", err)
+		}
+	}
 }
 
 // registerHandlers registers all command handlers with the command processor.
@@ -359,14 +1096,17 @@ func registerHandlers(
 	processRepo repository.ProcessRepository,
 	taskRepo repository.TaskRepository,
 	queueRepo repository.QueueRepository,
+	reviewHistory repository.ReviewHistoryRepository,
 	processRegistry *process.ProcessRegistry,
 	turnEnforcer handler.TurnCompletionEnforcer,
+	turnTraces *tracing.TurnTraces,
 	coordinatorClient client.HeadlessClient,
 	workerClient client.HeadlessClient,
 	observerClient client.HeadlessClient,
 	coordinatorExtensions map[string]any,
 	workerExtensions map[string]any,
 	observerExtensions map[string]any,
+	agentProviders client.AgentProviders,
 	beadsExec appbeads.IssueExecutor,
 	port int,
 	eventBus *pubsub.Broker[any],
@@ -376,9 +1116,11 @@ func registerHandlers(
 	tracer trace.Tracer,
 	sessionRefNotifier handler.SessionRefNotifier,
 	soundService sound.SoundService,
+	notifier notify.Notifier,
 	sessionMetadataProvider handler.SessionMetadataProvider,
 	workflowStateProvider handler.WorkflowStateProvider,
 	fabricService *fabric.Service,
+	workerWorktrees *processor.WorkerWorktrees,
 ) {
 	// Create shared infrastructure components
 	cmdSubmitter := handler.NewProcessorSubmitterAdapter(cmdProcessor)
@@ -397,14 +1139,15 @@ func registerHandlers(
 			handler.WithQueueRepository(queueRepo),
 			handler.WithAssignTaskTracer(tracer)))
 	cmdProcessor.RegisterHandler(command.CmdAssignReview,
-		handler.NewAssignReviewHandler(processRepo, taskRepo, queueRepo))
+		handler.NewAssignReviewHandler(processRepo, taskRepo, queueRepo,
+			handler.WithReviewHistory(reviewHistory)))
 	cmdProcessor.RegisterHandler(command.CmdApproveCommit,
 		handler.NewApproveCommitHandler(processRepo, taskRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdAssignReviewFeedback,
 		handler.NewAssignReviewFeedbackHandler(processRepo, taskRepo, queueRepo))
 
 	// ============================================================
-	// State Transition handlers (4)
+	// State Transition handlers (5)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdReportComplete,
 		handler.NewReportCompleteHandler(processRepo, taskRepo, queueRepo,
@@ -417,6 +1160,8 @@ func registerHandlers(
 			handler.WithReportVerdictSoundService(soundService)))
 	cmdProcessor.RegisterHandler(command.CmdTransitionPhase,
 		handler.NewTransitionPhaseHandler(processRepo, queueRepo))
+	cmdProcessor.RegisterHandler(command.CmdRequestAssistance,
+		handler.NewRequestAssistanceHandler(processRepo))
 	cmdProcessor.RegisterHandler(command.CmdProcessTurnComplete,
 		handler.NewProcessTurnCompleteHandler(processRepo, queueRepo,
 			handler.WithProcessTurnEnforcer(turnEnforcer),
@@ -425,34 +1170,48 @@ func registerHandlers(
 			handler.WithProcessTurnSoundService(soundService)))
 
 	// ============================================================
-	// BD Task Status handlers (2)
+	// BD Task Status handlers (3)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdMarkTaskComplete,
 		handler.NewMarkTaskCompleteHandler(beadsExec, taskRepo))
 	cmdProcessor.RegisterHandler(command.CmdMarkTaskFailed,
 		handler.NewMarkTaskFailedHandler(beadsExec))
+	cmdProcessor.RegisterHandler(command.CmdProposeSubtasks,
+		handler.NewProposeSubtasksHandler(beadsExec, processRepo))
 
 	// ============================================================
 	// Process Management handlers (7)
 	// ============================================================
 
+	// Workers in their own git worktrees run there from their first turn on,
+	// since sessions resume only from the directory they started in.
+	var workDirs func(processID string) string
+	if workerWorktrees != nil {
+		workDirs = workerWorktrees.WorkDir
+	}
+
 	// Create process spawner with separate coordinator/worker clients
 	processSpawner := handler.NewUnifiedProcessSpawner(handler.UnifiedSpawnerConfig{
 		CoordinatorClient:     coordinatorClient,
 		WorkerClient:          workerClient,
 		CoordinatorExtensions: coordinatorExtensions,
 		WorkerExtensions:      workerExtensions,
+		AgentProviders:        agentProviders,
 		WorkDir:               workDir,
 		Port:                  port,
 		Submitter:             cmdSubmitter,
 		EventBus:              eventBus,
 		BeadsDir:              beadsDir,
 		SessionDir:            sessionDir,
+		WorkDirs:              workDirs,
 	})
 
 	// MessageDeliverer for delivering messages to processes via session resume
 	// Uses role-based client selection (coordinator vs worker vs observer)
 	sessionProvider := handler.NewProcessRegistrySessionProvider(processRegistry, coordinatorClient, workerClient, observerClient, workDir, port)
+	if workDirs != nil {
+		sessionProvider.SetWorkDirResolver(workDirs)
+	}
 
 	messageDeliverer := integration.NewProcessSessionDeliverer(
 		sessionProvider,
@@ -474,10 +1233,13 @@ func registerHandlers(
 	cmdProcessor.RegisterHandler(command.CmdSendToProcess,
 		handler.NewSendToProcessHandler(processRepo, queueRepo,
 			handler.WithSendToProcessTracer(tracer)))
+	cmdProcessor.RegisterHandler(command.CmdBroadcast,
+		handler.NewBroadcastHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdDeliverProcessQueued,
 		handler.NewDeliverProcessQueuedHandler(processRepo, queueRepo, processRegistry,
 			handler.WithProcessDeliverer(messageDeliverer),
-			handler.WithDeliverTurnEnforcer(turnEnforcer)))
+			handler.WithDeliverTurnEnforcer(turnEnforcer),
+			handler.WithDeliverTurnTraces(turnTraces)))
 	cmdProcessor.RegisterHandler(command.CmdRetireProcess,
 		handler.NewRetireProcessHandler(processRepo, processRegistry,
 			handler.WithRetireTurnEnforcer(turnEnforcer)))
@@ -495,11 +1257,25 @@ func registerHandlers(
 	cmdProcessor.RegisterHandler(command.CmdResumeProcess,
 		handler.NewResumeProcessHandler(processRepo, queueRepo))
 
+	// ============================================================
+	// Emergency Control handlers (2)
+	// ============================================================
+	cmdProcessor.RegisterHandler(command.CmdEmergencyStop,
+		handler.NewEmergencyStopHandler(processRepo, queueRepo,
+			handler.WithEmergencyStopRegistry(processRegistry),
+			handler.WithEmergencyStopBroadcaster(fabricService)))
+	cmdProcessor.RegisterHandler(command.CmdEmergencyResume,
+		handler.NewEmergencyResumeHandler(processRepo,
+			handler.WithEmergencyResumeBroadcaster(fabricService)))
+
+	// Work time in the session report comes from the tasks' work log comments
+	comments, _ := beadsExec.(appbeads.CommentReader)
+
 	// ============================================================
 	// Aggregation handlers (1)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdGenerateAccountabilitySummary,
-		handler.NewGenerateAccountabilitySummaryHandler(processRepo, queueRepo))
+		handler.NewGenerateAccountabilitySummaryHandler(comments))
 
 	// ============================================================
 	// Workflow Completion handlers (1)
@@ -507,12 +1283,14 @@ func registerHandlers(
 	cmdProcessor.RegisterHandler(command.CmdSignalWorkflowComplete,
 		handler.NewSignalWorkflowCompleteHandler(
 			handler.WithSessionMetadataProvider(sessionMetadataProvider),
-			handler.WithWorkflowSoundService(soundService)))
+			handler.WithWorkflowSoundService(soundService),
+			handler.WithCompletionPoster(&fabricCompletionPoster{service: fabricService})))
 
 	// ============================================================
 	// User Interaction handlers (1)
 	// ============================================================
 	cmdProcessor.RegisterHandler(command.CmdNotifyUser,
 		handler.NewNotifyUserHandler(
-			handler.WithNotifyUserSoundService(soundService)))
+			handler.WithNotifyUserSoundService(soundService),
+			handler.WithNotifyUserNotifier(notifier)))
 }
@@ -2,16 +2,34 @@ package v2
 
 import (
 	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	gitdomain "github.com/zjrosen/perles/internal/git/domain"
+	infragit "github.com/zjrosen/perles/internal/git/infrastructure"
 	"github.com/zjrosen/perles/internal/mocks"
 	"github.com/zjrosen/perles/internal/orchestration/client"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	fabricrepo "github.com/zjrosen/perles/internal/orchestration/fabric/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/processor"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/workflow"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
+	"github.com/zjrosen/perles/internal/pubsub"
 )
 
 // createTestAgentProvider creates an AgentProvider mock for testing.
@@ -79,6 +97,34 @@ func TestInfrastructureConfig_Validate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "work directory is required")
 	})
+
+	t.Run("sqlite fabric storage requires session dir", func(t *testing.T) {
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:       "/tmp/test",
+			FabricStorage: "sqlite",
+		}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "requires a session directory")
+	})
+
+	t.Run("unknown fabric storage returns error", func(t *testing.T) {
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:       "/tmp/test",
+			FabricStorage: "postgres",
+		}
+		err := cfg.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown fabric storage "postgres"`)
+	})
 }
 
 // ===========================================================================
@@ -114,6 +160,26 @@ func TestNewInfrastructure(t *testing.T) {
 		assert.NotNil(t, infra.Internal.ProcessRegistry)
 	})
 
+	t.Run("opens sqlite fabric store in session dir", func(t *testing.T) {
+		sessionDir := t.TempDir()
+		cfg := InfrastructureConfig{
+			Port: 8080,
+			AgentProviders: client.AgentProviders{
+				client.RoleCoordinator: createTestAgentProvider(t),
+			},
+			WorkDir:       "/tmp/test",
+			SessionDir:    sessionDir,
+			FabricStorage: "sqlite",
+		}
+
+		infra, err := NewInfrastructure(cfg)
+		require.NoError(t, err)
+		require.NotNil(t, infra.Internal.FabricStore)
+		assert.FileExists(t, filepath.Join(sessionDir, fabricrepo.SQLiteFileName))
+
+		infra.Shutdown()
+	})
+
 	t.Run("returns error for invalid config", func(t *testing.T) {
 		cfg := InfrastructureConfig{} // All fields empty - invalid
 
@@ -358,6 +424,45 @@ func TestAllHandlersRegistered(t *testing.T) {
 	assert.NotNil(t, infra.Internal.ProcessRegistry)
 }
 
+func TestInfrastructure_WorkflowDAG(t *testing.T) {
+	def, err := dag.Parse([]byte(`
+phases:
+  - id: research
+    actions: [spawn_worker]
+    next:
+      - to: implement
+  - id: implement
+`))
+	require.NoError(t, err)
+
+	infra, err := NewInfrastructure(InfrastructureConfig{
+		Port: 8080,
+		AgentProviders: client.AgentProviders{
+			client.RoleCoordinator: createTestAgentProvider(t),
+		},
+		WorkDir: t.TempDir(),
+		DAG:     def,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, infra.Internal.DAGRun)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, infra.Start(ctx))
+	defer infra.Drain()
+
+	// The research phase does not allow marking tasks complete
+	result, err := infra.Core.Processor.SubmitAndWait(ctx, command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc.1"))
+	require.NoError(t, err)
+	require.False(t, result.Success)
+	require.ErrorIs(t, result.Error, processor.ErrNotAllowedInPhase)
+
+	result, err = infra.Core.Processor.SubmitAndWait(ctx, command.NewAdvancePhaseCommand(command.SourceMCPTool, "implement", ""))
+	require.NoError(t, err)
+	require.True(t, result.Success, "advance failed: %v", result.Error)
+	require.Equal(t, "implement", infra.Internal.DAGRun.Current().ID)
+}
+
 // ===========================================================================
 // Integration Tests
 // ===========================================================================
@@ -443,6 +548,230 @@ func TestInfrastructure_Integration(t *testing.T) {
 	})
 }
 
+func TestSummarizeNumstat(t *testing.T) {
+	require.Equal(t, "2 files changed, +42 -12", summarizeNumstat("40\t10\tmain.go\n2\t2\tREADME.md\n"))
+	require.Equal(t, "1 file changed, +0 -0", summarizeNumstat("-\t-\tlogo.png"))
+	require.Empty(t, summarizeNumstat(""))
+}
+
+func TestThreadExcerpt(t *testing.T) {
+	require.Equal(t, "first line", threadExcerpt("first line\nsecond line"))
+
+	long := strings.Repeat("é", threadExcerptLen+10)
+	excerpt := threadExcerpt(long)
+	require.True(t, utf8.ValidString(excerpt))
+	require.Equal(t, strings.Repeat("é", threadExcerptLen-3)+"...", excerpt)
+	require.Equal(t, strings.Repeat("é", threadExcerptLen), threadExcerpt(strings.Repeat("é", threadExcerptLen)))
+}
+
+func TestGitCommitSource_CommitsSince(t *testing.T) {
+	gitExec := mocks.NewMockGitExecutor(t)
+	gitExec.EXPECT().GetCommitLogForRef("base..HEAD", maxTaskCommits).Return([]gitdomain.CommitInfo{
+		{Hash: "c2full", ShortHash: "c2", Subject: "Second"},
+		{Hash: "c1full", ShortHash: "c1", Subject: "First"},
+	}, nil)
+	gitExec.EXPECT().GetDiffStat("c1full^!").Return("3\t1\ta.go", nil)
+	gitExec.EXPECT().GetDiffStat("c2full^!").Return("", nil)
+
+	commits, err := (&gitCommitSource{git: gitExec}).CommitsSince("base")
+	require.NoError(t, err)
+	require.Equal(t, []processor.TaskCommit{
+		{SHA: "c1full", ShortSHA: "c1", Subject: "First", DiffStat: "1 file changed, +3 -1"},
+		{SHA: "c2full", ShortSHA: "c2", Subject: "Second"},
+	}, commits)
+}
+
+// gitRun runs git in dir, failing the test on error.
+func gitRun(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestGitWorktrees_ChangedFilesAndMerge(t *testing.T) {
+	repo := t.TempDir()
+	gitRun(t, repo, "init", "-b", "main")
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "main.go"), []byte("package main\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "README.md"), []byte("# demo\n"), 0o600))
+	gitRun(t, repo, "add", ".")
+	gitRun(t, repo, "commit", "-m", "init")
+
+	gitExec := infragit.NewRealExecutor(repo)
+	worktrees := processor.NewWorkerWorktrees(processor.WorkerWorktreesConfig{
+		Git:          &gitWorktrees{git: gitExec},
+		Dir:          filepath.Join(t.TempDir(), "worktrees"),
+		BranchPrefix: worktreeBranchPrefix("session-123456"),
+		Tasks:        repository.NewMemoryTaskRepository(),
+	})
+	wt := worktrees.WorkDir("worker-1")
+	require.DirExists(t, wt)
+
+	// Committed, modified and untracked files all count as changed
+	require.NoError(t, os.WriteFile(filepath.Join(wt, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0o600))
+	gitRun(t, wt, "commit", "-am", "Add main")
+	require.NoError(t, os.WriteFile(filepath.Join(wt, "README.md"), []byte("# demo app\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(wt, "notes.txt"), []byte("todo\n"), 0o600))
+
+	files := &gitChangedFiles{git: gitExec, worktrees: worktrees}
+	changed, err := files.ChangedFiles("worker-1")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"main.go", "README.md", "notes.txt"}, changed)
+
+	changed, err = files.ChangedFiles("worker-2")
+	require.NoError(t, err)
+	require.Empty(t, changed, "workers without a worktree changed nothing")
+
+	// Once merged, nothing is left to conflict
+	gitRun(t, wt, "checkout", "README.md")
+	require.NoError(t, os.Remove(filepath.Join(wt, "notes.txt")))
+	require.NoError(t, worktrees.Merge("worker-1"))
+	content, err := os.ReadFile(filepath.Join(repo, "main.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(content), "func main()")
+	changed, err = files.ChangedFiles("worker-1")
+	require.NoError(t, err)
+	require.Empty(t, changed)
+}
+
+func TestTaskCommitPublisher_LinksThreadAndIssue(t *testing.T) {
+	threads := fabricrepo.NewMemoryThreadRepository()
+	deps := fabricrepo.NewMemoryDependencyRepository()
+	subs := fabricrepo.NewMemorySubscriptionRepository()
+	svc := fabric.NewService(threads, deps, subs, fabricrepo.NewMemoryAckRepository(deps, threads, subs), fabricrepo.NewMemoryParticipantRepository())
+	require.NoError(t, svc.InitSession("system"))
+	root, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: domain.SlugTasks, Content: "Task: Add login [perles-abc1]", CreatedBy: "coordinator"})
+	require.NoError(t, err)
+
+	beadsExec := mocks.NewMockIssueExecutor(t)
+	beadsExec.EXPECT().AddComment("perles-abc1", repository.CoordinatorID,
+		"Commit 3f2a9c1e8d: Add login\nFabric thread: "+root.ID+"\n\n1 file changed, +3 -1").Return(nil)
+
+	publisher := &taskCommitPublisher{fabric: svc, beads: beadsExec}
+	task := &repository.TaskAssignment{TaskID: "perles-abc1", ThreadID: root.ID}
+	commit := processor.TaskCommit{SHA: "3f2a9c1e8d", ShortSHA: "3f2a9c1", Subject: "Add login", DiffStat: "1 file changed, +3 -1"}
+	require.NoError(t, publisher.PublishCommit(task, commit))
+
+	replies, err := svc.GetReplies(root.ID)
+	require.NoError(t, err)
+	require.Len(t, replies, 1)
+	require.Equal(t, "Committed 3f2a9c1: Add login\n1 file changed, +3 -1", replies[0].Content)
+	require.Equal(t, []string{"3f2a9c1e8d"}, svc.ThreadCommits(root.ID))
+}
+
+func TestEventingIssueExecutor_PublishesChanges(t *testing.T) {
+	bus := pubsub.NewBroker[any]()
+	defer bus.Close()
+	ch := bus.Subscribe(context.Background())
+
+	bdExec := mocks.NewMockIssueExecutor(t)
+	bdExec.EXPECT().UpdateStatus("perles-abc1", beads.StatusInProgress).Return(nil)
+	bdExec.EXPECT().AddComment("perles-abc1", "worker-1", "Done").Return(nil)
+	bdExec.EXPECT().AddComment("perles-abc2", "worker-1", "Done").Return(errors.New("bd failed"))
+	bdExec.EXPECT().CloseIssue("perles-abc1", "done").Return(nil)
+	exec := &eventingIssueExecutor{IssueExecutor: bdExec, eventBus: bus}
+
+	require.NoError(t, exec.UpdateStatus("perles-abc1", beads.StatusInProgress))
+	require.NoError(t, exec.AddComment("perles-abc1", "worker-1", "Done"))
+	require.Error(t, exec.AddComment("perles-abc2", "worker-1", "Done"), "failed changes are not published")
+	require.NoError(t, exec.CloseIssue("perles-abc1", "done"))
+
+	want := []events.IssueEvent{
+		{Type: events.IssueStatusChanged, IssueID: "perles-abc1", Status: beads.StatusInProgress},
+		{Type: events.IssueCommented, IssueID: "perles-abc1", Author: "worker-1"},
+		{Type: events.IssueStatusChanged, IssueID: "perles-abc1", Status: beads.StatusClosed},
+	}
+	for _, w := range want {
+		select {
+		case event := <-ch:
+			require.Equal(t, w, event.Payload)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %v", w)
+		}
+	}
+	select {
+	case event := <-ch:
+		t.Fatalf("unexpected event %v", event.Payload)
+	default:
+	}
+}
+
+type commentingIssueExecutor struct {
+	*mocks.MockIssueExecutor
+	*mocks.MockCommentReader
+}
+
+func TestEventingIssueExecutor_GetComments(t *testing.T) {
+	exec := &eventingIssueExecutor{IssueExecutor: mocks.NewMockIssueExecutor(t)}
+	_, err := exec.GetComments("perles-abc1")
+	require.EqualError(t, err, "reading comments is not supported")
+
+	reader := mocks.NewMockCommentReader(t)
+	reader.EXPECT().GetComments("perles-abc1").Return([]beads.Comment{{ID: 1, Text: "Found it"}}, nil)
+	exec = &eventingIssueExecutor{IssueExecutor: commentingIssueExecutor{mocks.NewMockIssueExecutor(t), reader}}
+	comments, err := exec.GetComments("perles-abc1")
+	require.NoError(t, err)
+	require.Equal(t, []beads.Comment{{ID: 1, Text: "Found it"}}, comments)
+}
+
+type fakeReadyLister []beads.Issue
+
+func (l fakeReadyLister) ReadyIssues(int) ([]beads.Issue, error) {
+	return l, nil
+}
+
+func TestBDReadyTasks_SkipsEpics(t *testing.T) {
+	source := &bdReadyTasks{beads: fakeReadyLister{
+		{ID: "perles-epic", TitleText: "Auth", Type: beads.TypeEpic},
+		{ID: "perles-abc1", TitleText: "Add login", Type: beads.TypeTask},
+	}}
+	tasks, err := source.ReadyTasks()
+	require.NoError(t, err)
+	require.Equal(t, []processor.ReadyTask{{ID: "perles-abc1", Title: "Add login"}}, tasks)
+}
+
+func TestFabricTaskThreads_PostsToTasks(t *testing.T) {
+	threads := fabricrepo.NewMemoryThreadRepository()
+	deps := fabricrepo.NewMemoryDependencyRepository()
+	subs := fabricrepo.NewMemorySubscriptionRepository()
+	svc := fabric.NewService(threads, deps, subs, fabricrepo.NewMemoryAckRepository(deps, threads, subs), fabricrepo.NewMemoryParticipantRepository())
+	require.NoError(t, svc.InitSession("system"))
+
+	threadID, err := (&fabricTaskThreads{fabric: svc}).StartTaskThread(processor.ReadyTask{ID: "perles-abc1", Title: "Add login"}, "worker-1")
+	require.NoError(t, err)
+	thread, err := threads.Get(threadID)
+	require.NoError(t, err)
+	require.Equal(t, "Task: Add login [perles-abc1] assigned to worker-1", thread.Content)
+	require.Equal(t, "perles-abc1", thread.Meta[fabric.MetaTaskID])
+}
+
+func TestFabricThreadGraph_ReadMessages(t *testing.T) {
+	threads := fabricrepo.NewMemoryThreadRepository()
+	deps := fabricrepo.NewMemoryDependencyRepository()
+	subs := fabricrepo.NewMemorySubscriptionRepository()
+	svc := fabric.NewService(threads, deps, subs, fabricrepo.NewMemoryAckRepository(deps, threads, subs), fabricrepo.NewMemoryParticipantRepository())
+	require.NoError(t, svc.InitSession("system"))
+
+	root, err := svc.SendMessage(fabric.SendMessageInput{ChannelSlug: "tasks", Content: "Task: Add login", CreatedBy: "system"})
+	require.NoError(t, err)
+	feedback, err := svc.Reply(fabric.ReplyInput{MessageID: root.ID, Content: "Missing tests\nSee auth_test.go", CreatedBy: "worker-2"})
+	require.NoError(t, err)
+	answer, err := svc.Reply(fabric.ReplyInput{MessageID: root.ID, Content: "Tests added", CreatedBy: "worker-1"})
+	require.NoError(t, err)
+	_, err = svc.Reply(fabric.ReplyInput{MessageID: root.ID, Content: "Unread", CreatedBy: "worker-2"})
+	require.NoError(t, err)
+	require.NoError(t, svc.Ack("worker-1", root.ID, feedback.ID))
+
+	msgs, err := (&fabricThreadGraph{fabric: svc}).ReadMessages(root.ID, "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, []processor.ThreadMessage{
+		{ID: root.ID, CreatedBy: "system", CreatedAt: root.CreatedAt, Excerpt: "Task: Add login"},
+		{ID: feedback.ID, CreatedBy: "worker-2", CreatedAt: feedback.CreatedAt, Excerpt: "Missing tests"},
+		{ID: answer.ID, CreatedBy: "worker-1", CreatedAt: answer.CreatedAt, Excerpt: "Tests added"},
+	}, msgs, "acked and authored messages, oldest first")
+}
+
 // mockWorkflowStateProvider implements handler.WorkflowStateProvider for testing.
 type mockWorkflowStateProvider struct{}
 
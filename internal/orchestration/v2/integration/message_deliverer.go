@@ -37,6 +37,27 @@ type SessionProvider interface {
 	GetWorkDir() string
 }
 
+// ProcessBackendProvider is optionally implemented by a SessionProvider that
+// tracks workers spawned with a non-default agent backend. Those workers are
+// resumed with their own backend's client and extensions.
+type ProcessBackendProvider interface {
+	// GetProcessBackend returns the process's backend, or nil for the role default.
+	GetProcessBackend(processID string) client.AgentProvider
+}
+
+// Compile-time check that ProcessRegistrySessionProvider tracks worker backends.
+var _ ProcessBackendProvider = (*handler.ProcessRegistrySessionProvider)(nil)
+
+// ProcessWorkDirProvider is optionally implemented by a SessionProvider whose
+// processes may work in their own directory, such as a worker's git worktree.
+type ProcessWorkDirProvider interface {
+	// GetProcessWorkDir returns the working directory of the process.
+	GetProcessWorkDir(processID string) string
+}
+
+// Compile-time check that ProcessRegistrySessionProvider resolves per-process work directories.
+var _ ProcessWorkDirProvider = (*handler.ProcessRegistrySessionProvider)(nil)
+
 // ProcessResumer abstracts process resume functionality for message delivery.
 type ProcessResumer interface {
 	// ResumeProcess resumes a process (coordinator or worker) by providing a new AI process.
@@ -204,12 +225,29 @@ func (d *ProcessSessionDeliverer) Deliver(ctx context.Context, processID, conten
 		extensions = d.workerExtensions
 	}
 
+	if bp, ok := d.sessionProvider.(ProcessBackendProvider); ok {
+		if backend := bp.GetProcessBackend(processID); backend != nil {
+			log.Debug(log.CatOrch, "selecting worker backend client", "processId", processID, "backend", backend.Type())
+			backendClient, err := backend.Client()
+			if err != nil {
+				return fmt.Errorf("failed to create %s client for process %s: %w", backend.Type(), processID, err)
+			}
+			aiClient = backendClient
+			extensions = backend.Extensions()
+		}
+	}
+
+	workDir := d.sessionProvider.GetWorkDir()
+	if wp, ok := d.sessionProvider.(ProcessWorkDirProvider); ok {
+		workDir = wp.GetProcessWorkDir(processID)
+	}
+
 	// 4. Spawn/resume the session with the message as prompt
 	// IMPORTANT: Use context.Background() here because the claude process lifetime
 	// is managed by the Process struct, not by this function's context.
 	// If we used the parent context, the process would be killed when Deliver() returns.
 	proc, err := aiClient.Spawn(context.Background(), client.Config{
-		WorkDir:         d.sessionProvider.GetWorkDir(),
+		WorkDir:         workDir,
 		BeadsDir:        d.beadsDir,
 		SessionID:       sessionID,
 		Prompt:          content,
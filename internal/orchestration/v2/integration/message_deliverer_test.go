@@ -176,6 +176,53 @@ func TestProcessSessionDeliverer_Deliver_Success(t *testing.T) {
 	mockResumer.AssertExpectations(t)
 }
 
+// backendSessionProvider is a mockSessionProvider that also tracks worker backends.
+type backendSessionProvider struct {
+	mockSessionProvider
+	backends map[string]client.AgentProvider
+}
+
+func (m *backendSessionProvider) GetProcessBackend(processID string) client.AgentProvider {
+	return m.backends[processID]
+}
+
+// fixedAgentProvider is an AgentProvider backed by a fixed client.
+type fixedAgentProvider struct {
+	client     client.HeadlessClient
+	extensions map[string]any
+}
+
+func (p *fixedAgentProvider) Type() client.ClientType                { return client.ClientCodex }
+func (p *fixedAgentProvider) Client() (client.HeadlessClient, error) { return p.client, nil }
+func (p *fixedAgentProvider) Extensions() map[string]any             { return p.extensions }
+
+func TestProcessSessionDeliverer_Deliver_WorkerBackend(t *testing.T) {
+	backendClient := &mockHeadlessClient{}
+	sessionProvider := &backendSessionProvider{
+		mockSessionProvider: mockSessionProvider{sessionID: "session-123", workDir: "/test/workdir"},
+		backends: map[string]client.AgentProvider{
+			"worker-2": &fixedAgentProvider{client: backendClient, extensions: map[string]any{"codex.model": "o4-mini"}},
+		},
+	}
+
+	defaultClient := &mockHeadlessClient{}
+	mockProc := &mockHeadlessProcess{}
+	mockResumer := &mockProcessResumer{}
+
+	backendClient.On("Spawn", mock.Anything, mock.MatchedBy(func(cfg client.Config) bool {
+		return cfg.Extensions["codex.model"] == "o4-mini"
+	})).Return(mockProc, nil)
+	mockResumer.On("ResumeProcess", "worker-2", mockProc).Return(nil)
+
+	extensions := map[string]any{"claude.model": "haiku"}
+	deliverer := NewProcessSessionDeliverer(sessionProvider, defaultClient, defaultClient, defaultClient, mockResumer, extensions, extensions, extensions)
+
+	require.NoError(t, deliverer.Deliver(context.Background(), "worker-2", "Hello worker!"))
+	backendClient.AssertExpectations(t)
+	defaultClient.AssertNotCalled(t, "Spawn", mock.Anything, mock.Anything)
+	mockResumer.AssertExpectations(t)
+}
+
 func TestProcessSessionDeliverer_Deliver_SessionNotFound(t *testing.T) {
 	// Setup
 	sessionProvider := &mockSessionProvider{
@@ -145,7 +145,7 @@ func (s *testV2Stack) registerHandlers(
 	cmdProcessor.RegisterHandler(command.CmdSendToProcess,
 		handler.NewSendToProcessHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdBroadcast,
-		handler.NewBroadcastHandler(processRepo))
+		handler.NewBroadcastHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdDeliverProcessQueued,
 		handler.NewDeliverProcessQueuedHandler(processRepo, queueRepo, nil))
 
@@ -1008,7 +1008,7 @@ func registerHandlersWithWiring(
 	cmdProcessor.RegisterHandler(command.CmdSendToProcess,
 		handler.NewSendToProcessHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdBroadcast,
-		handler.NewBroadcastHandler(processRepo))
+		handler.NewBroadcastHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdDeliverProcessQueued,
 		handler.NewDeliverProcessQueuedHandler(processRepo, queueRepo, nil,
 			handler.WithProcessDeliverer(deliverer)))
@@ -1229,18 +1229,19 @@ func TestV2E2E_BDSync_ReportComplete(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, result.Success)
 
-	// Step 3: Wait for async BD comment to be added
+	// Step 3: Wait for async BD comment to be added (after the work started stamp)
 	require.Eventually(t, func() bool {
 		comments := getComments(stack.mockBDExecutor)
-		return len(comments) > 0
+		return len(comments) > 1
 	}, time.Second, 10*time.Millisecond, "BD comment should be added")
 
 	// Step 4: Verify comment content
 	comments := getComments(stack.mockBDExecutor)
-	require.Len(t, comments, 1)
-	assert.Equal(t, taskID, comments[0].TaskID)
-	assert.Contains(t, comments[0].Comment, "Implementation complete")
-	assert.Contains(t, comments[0].Comment, completeSummary)
+	require.Len(t, comments, 2)
+	assert.Equal(t, "Work started by "+workerID, comments[0].Comment)
+	assert.Equal(t, taskID, comments[1].TaskID)
+	assert.Contains(t, comments[1].Comment, "Implementation complete")
+	assert.Contains(t, comments[1].Comment, completeSummary)
 }
 
 // TestV2E2E_BDSync_ReportVerdict verifies BD comment is added on review verdict.
@@ -2030,7 +2031,7 @@ func registerHandlersWithSessionRefNotifier(
 	cmdProcessor.RegisterHandler(command.CmdSendToProcess,
 		handler.NewSendToProcessHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdBroadcast,
-		handler.NewBroadcastHandler(processRepo))
+		handler.NewBroadcastHandler(processRepo, queueRepo))
 	cmdProcessor.RegisterHandler(command.CmdDeliverProcessQueued,
 		handler.NewDeliverProcessQueuedHandler(processRepo, queueRepo, nil))
 
@@ -36,6 +36,10 @@ type Process struct {
 	ID string
 	// Role identifies whether this is coordinator or worker.
 	Role repository.ProcessRole
+	// Backend is the agent provider a worker was spawned with when it differs from
+	// the default worker provider (e.g., a codex worker alongside claude workers).
+	// Nil means the role's default provider.
+	Backend client.AgentProvider
 
 	proc         client.HeadlessProcess
 	output       *OutputBuffer
@@ -226,6 +230,12 @@ func (p *Process) handleOutputEvent(event *client.OutputEvent) {
 		p.setSessionID(event.SessionID)
 	}
 
+	// Structured telemetry carries no conversation content; publish and stop.
+	if event.IsTelemetry() {
+		p.publishTelemetryEvent(event.Telemetry)
+		return
+	}
+
 	if event.Usage != nil {
 		// Build TokenMetrics from simplified event usage
 		m := &metrics.TokenMetrics{
@@ -442,6 +452,17 @@ func (p *Process) publishOutputEvent(text string, rawJSON []byte, delta bool) {
 		WithRawJSON(rawJSON))
 }
 
+// publishTelemetryEvent publishes a structured telemetry event.
+func (p *Process) publishTelemetryEvent(tel *client.TelemetryEvent) {
+	if p.eventBus == nil {
+		return
+	}
+
+	p.eventBus.Publish(pubsub.UpdatedEvent, events.NewProcessEvent(events.ProcessTelemetry, p.ID, p.Role).
+		WithTaskID(p.GetTaskID()).
+		WithTelemetry(tel))
+}
+
 // publishTokenUsageEvent publishes a token usage event.
 func (p *Process) publishTokenUsageEvent(m *metrics.TokenMetrics) {
 	if p.eventBus == nil {
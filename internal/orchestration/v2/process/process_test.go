@@ -1840,6 +1840,34 @@ func TestHandleInFlightError_DoesNotOverwriteContextExceededError(t *testing.T)
 // Cost Extraction Tests
 // ===========================================================================
 
+func TestHandleOutputEvent_PublishesTelemetry(t *testing.T) {
+	proc := newMockHeadlessProcess()
+	eventBus := pubsub.NewBroker[any]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sub := eventBus.Subscribe(ctx)
+
+	p := New("worker-1", repository.RoleWorker, proc, nil, eventBus)
+	p.Start()
+
+	tel := &client.TelemetryEvent{Type: client.TelemetryFileEdit, Path: "main.go", LinesAdded: 4}
+	proc.events <- client.OutputEvent{Type: client.EventTelemetry, Telemetry: tel}
+
+	select {
+	case evt := <-sub:
+		processEvent, ok := evt.Payload.(events.ProcessEvent)
+		require.True(t, ok, "expected ProcessEvent")
+		require.Equal(t, events.ProcessTelemetry, processEvent.Type)
+		require.Equal(t, "worker-1", processEvent.ProcessID)
+		require.Equal(t, tel, processEvent.Telemetry)
+	case <-time.After(500 * time.Millisecond):
+		require.FailNow(t, "did not receive telemetry event")
+	}
+
+	// Telemetry is not conversation output and must not land in the buffer.
+	require.Zero(t, p.output.Len())
+}
+
 func TestHandleOutputEvent_ExtractsCostFromResult(t *testing.T) {
 	// Test that result events with TotalCostUSD > 0 trigger cost extraction
 	// and a cost event is published, even when event.Usage is nil.
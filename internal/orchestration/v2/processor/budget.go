@@ -0,0 +1,272 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// BudgetWarningThreshold is the fraction of a budget at which a warning is posted.
+const BudgetWarningThreshold = 0.8
+
+// DefaultBudgetCheckInterval is how often the budget enforcer checks the
+// wall-clock budgets between turns.
+const DefaultBudgetCheckInterval = 30 * time.Second
+
+// BudgetSubmitter submits the replacements of workers that exhausted their
+// budget between turns. Implemented by CommandProcessor.
+type BudgetSubmitter interface {
+	Submit(cmd command.Command) error
+}
+
+// BudgetNotifier posts budget warnings to the agents they concern.
+// Implemented in v2 on top of Fabric so warnings land in #system.
+type BudgetNotifier interface {
+	NotifyBudget(content string, mentions []string) error
+}
+
+// BudgetEnforcerConfig configures the budget enforcer.
+type BudgetEnforcerConfig struct {
+	// Worker is the budget of each worker, measured from its spawn.
+	Worker repository.Budget
+	// Session is the budget of all processes together, measured from enforcer creation.
+	Session repository.Budget
+	// Processes provides token usage and spawn times.
+	// Required.
+	Processes repository.ProcessRepository
+	// Notifier receives budget warnings. Optional - if nil, warnings are only logged.
+	Notifier BudgetNotifier
+	// Interval between the checks of Start. Defaults to DefaultBudgetCheckInterval.
+	Interval time.Duration
+	// Now returns the current time. Defaults to time.Now if nil.
+	Now func() time.Time
+}
+
+// BudgetEnforcer checks token and wall-clock budgets after every completed turn,
+// and every interval once started, so a worker stuck in one long turn still
+// runs out of time.
+//
+// At BudgetWarningThreshold it warns the worker and the coordinator. When a worker
+// exhausts its budget, a ReplaceProcess command is submitted as a follow-up so a
+// fresh worker takes over. An exhausted session budget is reported to the
+// coordinator; replacing workers would not reduce it.
+type BudgetEnforcer struct {
+	worker    repository.Budget
+	session   repository.Budget
+	processes repository.ProcessRepository
+	notifier  BudgetNotifier
+	interval  time.Duration
+	now       func() time.Time
+	startedAt time.Time
+
+	mu            sync.Mutex
+	workerWarned  map[string]bool // processID -> warning posted
+	workerReplace map[string]bool // processID -> replacement submitted
+	sessionWarned bool
+	sessionSpent  bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewBudgetEnforcer creates a budget enforcer. The session clock starts now.
+func NewBudgetEnforcer(cfg BudgetEnforcerConfig) *BudgetEnforcer {
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = DefaultBudgetCheckInterval
+	}
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
+	return &BudgetEnforcer{
+		worker:        cfg.Worker,
+		session:       cfg.Session,
+		processes:     cfg.Processes,
+		notifier:      cfg.Notifier,
+		interval:      interval,
+		now:           now,
+		startedAt:     now(),
+		workerWarned:  make(map[string]bool),
+		workerReplace: make(map[string]bool),
+	}
+}
+
+// Middleware returns the middleware function. It only acts on successful
+// ProcessTurnComplete commands, after the handler has recorded the turn's tokens.
+func (e *BudgetEnforcer) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			result, err := next.Handle(ctx, cmd)
+			if err != nil || result == nil || !result.Success {
+				return result, err
+			}
+
+			turnCmd, ok := cmd.(*command.ProcessTurnCompleteCommand)
+			if !ok {
+				return result, err
+			}
+
+			result.FollowUp = append(result.FollowUp, e.Check(turnCmd.ProcessID)...)
+			return result, err
+		})
+	}
+}
+
+// Start begins checking the budgets of all active workers every interval,
+// submitting the replacements of exhausted workers to submitter. It stops when
+// ctx is cancelled or Stop is called. Safe to call only once.
+func (e *BudgetEnforcer) Start(ctx context.Context, submitter BudgetSubmitter) {
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.done = make(chan struct{})
+
+	log.SafeGo("budget-enforcer.loop", func() {
+		defer close(e.done)
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, cmd := range e.CheckAll() {
+					if err := submitter.Submit(cmd); err != nil {
+						log.Debug(log.CatOrch, "Failed to submit budget command", "error", err)
+					}
+				}
+			}
+		}
+	})
+}
+
+// Stop terminates the check loop and waits for it to exit.
+// Safe to call multiple times or before Start.
+func (e *BudgetEnforcer) Stop() {
+	if e.cancel == nil {
+		return
+	}
+	e.cancel()
+	<-e.done
+}
+
+// Check evaluates the worker and session budgets after processID completed a turn.
+// It returns the commands to submit (a ReplaceProcess for an exhausted worker).
+func (e *BudgetEnforcer) Check(processID string) []command.Command {
+	e.checkSession()
+
+	if e.worker.IsZero() {
+		return nil
+	}
+	proc, err := e.processes.Get(processID)
+	if err != nil {
+		return nil
+	}
+	return e.checkWorker(proc)
+}
+
+// CheckAll evaluates the session budget and the budget of every active worker.
+// It returns the commands to submit.
+func (e *BudgetEnforcer) CheckAll() []command.Command {
+	e.checkSession()
+
+	if e.worker.IsZero() {
+		return nil
+	}
+	var cmds []command.Command
+	for _, proc := range e.processes.List() {
+		cmds = append(cmds, e.checkWorker(proc)...)
+	}
+	return cmds
+}
+
+// checkWorker warns or replaces an active worker nearing or over its budget.
+func (e *BudgetEnforcer) checkWorker(proc *repository.Process) []command.Command {
+	if !proc.IsWorker() || !proc.IsActive() {
+		return nil
+	}
+
+	elapsed := e.now().Sub(proc.CreatedAt)
+	used := e.worker.Used(proc.TokensSpent, elapsed)
+	usage := formatBudgetUsage(e.worker, proc.TokensSpent, elapsed)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch {
+	case used >= 1 && !e.workerReplace[proc.ID]:
+		e.workerReplace[proc.ID] = true
+		e.workerWarned[proc.ID] = true
+		e.notify(fmt.Sprintf("%s exhausted its budget (%s) and is being replaced with a fresh worker. "+
+			"Reassign its task to the replacement.", proc.ID, usage),
+			repository.CoordinatorID, proc.ID)
+		return []command.Command{
+			command.NewReplaceProcessCommand(command.SourceInternal, proc.ID, "budget exhausted: "+usage),
+		}
+	case used >= BudgetWarningThreshold && !e.workerWarned[proc.ID]:
+		e.workerWarned[proc.ID] = true
+		e.notify(fmt.Sprintf("%s has used %.0f%% of its budget (%s). It will be replaced at 100%%; "+
+			"wrap up and report progress.", proc.ID, used*100, usage),
+			repository.CoordinatorID, proc.ID)
+	}
+	return nil
+}
+
+// checkSession warns the coordinator when the session budget nears or reaches its limit.
+func (e *BudgetEnforcer) checkSession() {
+	if e.session.IsZero() {
+		return
+	}
+
+	spent := repository.TotalTokensSpent(e.processes.List())
+	elapsed := e.now().Sub(e.startedAt)
+	used := e.session.Used(spent, elapsed)
+	usage := formatBudgetUsage(e.session, spent, elapsed)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch {
+	case used >= 1 && !e.sessionSpent:
+		e.sessionSpent = true
+		e.sessionWarned = true
+		e.notify(fmt.Sprintf("Session budget exhausted (%s). Do not assign new work; "+
+			"wrap up and report to the user.", usage), repository.CoordinatorID)
+	case used >= BudgetWarningThreshold && !e.sessionWarned:
+		e.sessionWarned = true
+		e.notify(fmt.Sprintf("Session has used %.0f%% of its budget (%s). "+
+			"Prioritize finishing in-flight work.", used*100, usage), repository.CoordinatorID)
+	}
+}
+
+// notify posts a budget warning. Must be called with e.mu held.
+func (e *BudgetEnforcer) notify(content string, mentions ...string) {
+	log.Warn(log.CatOrch, "budget warning", "message", content)
+	if e.notifier == nil {
+		return
+	}
+	if err := e.notifier.NotifyBudget(content, mentions); err != nil {
+		log.Debug(log.CatOrch, "failed to post budget warning", "error", err)
+	}
+}
+
+// formatBudgetUsage describes usage against the limited dimensions of a budget,
+// e.g. "164k/200k tokens, 24m0s/30m0s".
+func formatBudgetUsage(b repository.Budget, tokens int, elapsed time.Duration) string {
+	var usage string
+	if b.Tokens > 0 {
+		usage = fmt.Sprintf("%dk/%dk tokens", tokens/1000, b.Tokens/1000)
+	}
+	if b.Duration > 0 {
+		if usage != "" {
+			usage += ", "
+		}
+		usage += fmt.Sprintf("%s/%s", elapsed.Round(time.Second), b.Duration)
+	}
+	return usage
+}
@@ -0,0 +1,188 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+type budgetNotice struct {
+	content  string
+	mentions []string
+}
+
+type recordingBudgetNotifier struct {
+	notices []budgetNotice
+}
+
+func (n *recordingBudgetNotifier) NotifyBudget(content string, mentions []string) error {
+	n.notices = append(n.notices, budgetNotice{content: content, mentions: mentions})
+	return nil
+}
+
+func newBudgetTestEnforcer(t *testing.T, worker, session repository.Budget) (*BudgetEnforcer, *repository.MemoryProcessRepository, *recordingBudgetNotifier, *time.Time) {
+	t.Helper()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo := repository.NewMemoryProcessRepository()
+	notifier := &recordingBudgetNotifier{}
+	e := NewBudgetEnforcer(BudgetEnforcerConfig{
+		Worker:    worker,
+		Session:   session,
+		Processes: repo,
+		Notifier:  notifier,
+		Now:       func() time.Time { return now },
+	})
+	require.NoError(t, repo.Save(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusReady,
+		CreatedAt: now,
+	}))
+	return e, repo, notifier, &now
+}
+
+func spendTokens(t *testing.T, repo *repository.MemoryProcessRepository, id string, tokens int) {
+	t.Helper()
+	proc, err := repo.Get(id)
+	require.NoError(t, err)
+	proc.TokensSpent = tokens
+	require.NoError(t, repo.Save(proc))
+}
+
+func TestBudgetEnforcer_WarnsThenReplacesWorker(t *testing.T) {
+	e, repo, notifier, _ := newBudgetTestEnforcer(t, repository.Budget{Tokens: 100_000}, repository.Budget{})
+
+	spendTokens(t, repo, "worker-1", 50_000)
+	require.Empty(t, e.Check("worker-1"))
+	require.Empty(t, notifier.notices)
+
+	spendTokens(t, repo, "worker-1", 85_000)
+	require.Empty(t, e.Check("worker-1"))
+	require.Len(t, notifier.notices, 1)
+	require.Contains(t, notifier.notices[0].content, "worker-1 has used 85% of its budget (85k/100k tokens)")
+	require.Equal(t, []string{repository.CoordinatorID, "worker-1"}, notifier.notices[0].mentions)
+
+	// The warning is only posted once
+	require.Empty(t, e.Check("worker-1"))
+	require.Len(t, notifier.notices, 1)
+
+	spendTokens(t, repo, "worker-1", 100_000)
+	followUps := e.Check("worker-1")
+	require.Len(t, followUps, 1)
+	replace, ok := followUps[0].(*command.ReplaceProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, "worker-1", replace.ProcessID)
+	require.Equal(t, "budget exhausted: 100k/100k tokens", replace.Reason)
+	require.Len(t, notifier.notices, 2)
+
+	// Replacement is only submitted once
+	require.Empty(t, e.Check("worker-1"))
+}
+
+func TestBudgetEnforcer_WorkerWallClock(t *testing.T) {
+	e, _, notifier, now := newBudgetTestEnforcer(t, repository.Budget{Duration: 10 * time.Minute}, repository.Budget{})
+
+	*now = now.Add(11 * time.Minute)
+	followUps := e.Check("worker-1")
+	require.Len(t, followUps, 1)
+	require.Contains(t, notifier.notices[0].content, "11m0s/10m0s")
+}
+
+func TestBudgetEnforcer_CheckAllReplacesWorkerMidTurn(t *testing.T) {
+	e, repo, _, now := newBudgetTestEnforcer(t, repository.Budget{Duration: 10 * time.Minute}, repository.Budget{})
+	proc, err := repo.Get("worker-1")
+	require.NoError(t, err)
+	proc.Status = repository.StatusWorking
+	require.NoError(t, repo.Save(proc))
+
+	require.Empty(t, e.CheckAll())
+	*now = now.Add(11 * time.Minute)
+	cmds := e.CheckAll()
+	require.Len(t, cmds, 1)
+	require.Equal(t, command.CmdReplaceProcess, cmds[0].Type())
+	require.Empty(t, e.CheckAll(), "replaced once")
+}
+
+func TestBudgetEnforcer_StartSubmitsReplacements(t *testing.T) {
+	started := time.Now()
+	repo := repository.NewMemoryProcessRepository()
+	require.NoError(t, repo.Save(&repository.Process{
+		ID:        "worker-1",
+		Role:      repository.RoleWorker,
+		Status:    repository.StatusWorking,
+		CreatedAt: started.Add(-time.Hour),
+	}))
+	e := NewBudgetEnforcer(BudgetEnforcerConfig{
+		Worker:    repository.Budget{Duration: 30 * time.Minute},
+		Processes: repo,
+		Interval:  time.Millisecond,
+	})
+	submitter := &recordingSubmitter{}
+	e.Stop() // before Start is a no-op
+
+	e.Start(context.Background(), submitter)
+	var cmds []command.Command
+	require.Eventually(t, func() bool {
+		cmds = append(cmds, submitter.take()...)
+		return len(cmds) > 0
+	}, time.Second, time.Millisecond)
+	e.Stop()
+	e.Stop()
+
+	require.Len(t, cmds, 1)
+	require.Equal(t, command.CmdReplaceProcess, cmds[0].Type())
+}
+
+func TestBudgetEnforcer_IgnoresCoordinator(t *testing.T) {
+	e, repo, notifier, _ := newBudgetTestEnforcer(t, repository.Budget{Tokens: 10}, repository.Budget{})
+	require.NoError(t, repo.Save(&repository.Process{
+		ID:          repository.CoordinatorID,
+		Role:        repository.RoleCoordinator,
+		Status:      repository.StatusReady,
+		TokensSpent: 1_000,
+	}))
+
+	require.Empty(t, e.Check(repository.CoordinatorID))
+	require.Empty(t, notifier.notices)
+}
+
+func TestBudgetEnforcer_SessionWarnsCoordinator(t *testing.T) {
+	e, repo, notifier, _ := newBudgetTestEnforcer(t, repository.Budget{}, repository.Budget{Tokens: 100_000})
+	require.NoError(t, repo.Save(&repository.Process{
+		ID:          "worker-2",
+		Role:        repository.RoleWorker,
+		Status:      repository.StatusRetired,
+		TokensSpent: 60_000,
+	}))
+
+	spendTokens(t, repo, "worker-1", 30_000)
+	require.Empty(t, e.Check("worker-1"), "session budget never replaces workers")
+	require.Len(t, notifier.notices, 1)
+	require.Contains(t, notifier.notices[0].content, "Session has used 90% of its budget")
+	require.Equal(t, []string{repository.CoordinatorID}, notifier.notices[0].mentions)
+
+	spendTokens(t, repo, "worker-1", 40_000)
+	require.Empty(t, e.Check("worker-1"))
+	require.Len(t, notifier.notices, 2)
+	require.Contains(t, notifier.notices[1].content, "Session budget exhausted")
+}
+
+func TestBudgetEnforcer_MiddlewareAddsFollowUp(t *testing.T) {
+	e, repo, _, _ := newBudgetTestEnforcer(t, repository.Budget{Tokens: 100}, repository.Budget{})
+	spendTokens(t, repo, "worker-1", 200)
+
+	// Other commands pass through untouched
+	result, err := e.Middleware()(successHandler()).Handle(context.Background(), newTestCommand(1))
+	require.NoError(t, err)
+	require.Empty(t, result.FollowUp)
+
+	cmd := command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil)
+	result, err = e.Middleware()(successHandler()).Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	require.Len(t, result.FollowUp, 1)
+	require.Equal(t, command.CmdReplaceProcess, result.FollowUp[0].Type())
+}
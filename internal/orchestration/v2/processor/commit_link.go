@@ -0,0 +1,163 @@
+package processor
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// TaskCommit is a git commit made by a worker for a task.
+type TaskCommit struct {
+	SHA      string
+	ShortSHA string
+	Subject  string
+	// DiffStat summarizes the change, e.g. "3 files changed, +40 -12".
+	DiffStat string
+	// Tasks are the task IDs named by the commit's Perles-Task trailers.
+	Tasks []string
+}
+
+// CommitSource reads commits from the session's work directory.
+// Implemented in v2 on top of git.
+type CommitSource interface {
+	// Head returns the SHA of HEAD (empty for a repository without commits).
+	Head() (string, error)
+	// CommitsSince returns the commits reachable from HEAD but not from base, oldest first.
+	CommitsSince(base string) ([]TaskCommit, error)
+}
+
+// CommitPublisher links a task's commit to the task: a reply in its Fabric
+// thread and a link on its bd issue. Implemented in v2.
+type CommitPublisher interface {
+	PublishCommit(task *repository.TaskAssignment, commit TaskCommit) error
+}
+
+// CommitLinkerConfig configures the commit linker.
+type CommitLinkerConfig struct {
+	// Commits reads the work directory's git history.
+	// Required.
+	Commits CommitSource
+	// Publisher posts and links detected commits.
+	// Required.
+	Publisher CommitPublisher
+	// Tasks provides the task a committing worker is working on.
+	// Required.
+	Tasks repository.TaskRepository
+}
+
+// CommitLinker links the commits workers make after approve_commit to their tasks.
+//
+// When approve_commit succeeds, HEAD is recorded as the task's commit base. After
+// every turn of the task's implementer while the task is committing, commits
+// since the base whose Perles-Task trailer names the task are published, each
+// once. Commits other workers make in the meantime are not attributed to it.
+type CommitLinker struct {
+	commits   CommitSource
+	publisher CommitPublisher
+	tasks     repository.TaskRepository
+
+	mu        sync.Mutex
+	bases     map[string]string // taskID -> HEAD when the commit was approved
+	published map[string]bool   // commit SHA -> already published
+}
+
+// NewCommitLinker creates a commit linker.
+func NewCommitLinker(cfg CommitLinkerConfig) *CommitLinker {
+	return &CommitLinker{
+		commits:   cfg.Commits,
+		publisher: cfg.Publisher,
+		tasks:     cfg.Tasks,
+		bases:     make(map[string]string),
+		published: make(map[string]bool),
+	}
+}
+
+// Middleware returns the middleware function. It acts on successful ApproveCommit
+// commands (recording the base) and ProcessTurnComplete commands (publishing commits).
+func (l *CommitLinker) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			result, err := next.Handle(ctx, cmd)
+			if err != nil || result == nil || !result.Success {
+				return result, err
+			}
+
+			switch c := cmd.(type) {
+			case *command.ApproveCommitCommand:
+				l.RecordBase(c.TaskID)
+			case *command.ProcessTurnCompleteCommand:
+				l.Check(c.ProcessID)
+			}
+			return result, err
+		})
+	}
+}
+
+// RecordBase remembers HEAD as the commit base of taskID.
+func (l *CommitLinker) RecordBase(taskID string) {
+	head, err := l.commits.Head()
+	if err != nil {
+		log.Debug(log.CatOrch, "failed to read HEAD for commit linking", "taskID", taskID, "error", err)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.bases[taskID] = head
+}
+
+// Check publishes new commits for the committing task implemented by processID.
+// Returns the published commits.
+func (l *CommitLinker) Check(processID string) []TaskCommit {
+	tasks, err := l.tasks.GetByImplementer(processID)
+	if err != nil {
+		return nil
+	}
+
+	var published []TaskCommit
+	for _, task := range tasks {
+		if task.Status != repository.TaskCommitting {
+			continue
+		}
+		published = append(published, l.publishTask(task)...)
+	}
+	return published
+}
+
+// publishTask publishes the commits of task made since its base that were not published yet.
+func (l *CommitLinker) publishTask(task *repository.TaskAssignment) []TaskCommit {
+	l.mu.Lock()
+	base, ok := l.bases[task.TaskID]
+	l.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	commits, err := l.commits.CommitsSince(base)
+	if err != nil {
+		log.Debug(log.CatOrch, "failed to list task commits", "taskID", task.TaskID, "error", err)
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var published []TaskCommit
+	for _, c := range commits {
+		if l.published[c.SHA] || !slices.Contains(c.Tasks, task.TaskID) {
+			continue
+		}
+		if err := l.publisher.PublishCommit(task, c); err != nil {
+			// Left unmarked so the next check retries it
+			log.Debug(log.CatOrch, "failed to publish task commit", "taskID", task.TaskID, "sha", c.ShortSHA, "error", err)
+			continue
+		}
+		l.published[c.SHA] = true
+		published = append(published, c)
+	}
+	return published
+}
@@ -0,0 +1,134 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// fakeCommitSource is a linear history; commits after base are returned oldest first.
+type fakeCommitSource struct {
+	history []TaskCommit
+}
+
+func (s *fakeCommitSource) Head() (string, error) {
+	if len(s.history) == 0 {
+		return "", nil
+	}
+	return s.history[len(s.history)-1].SHA, nil
+}
+
+func (s *fakeCommitSource) CommitsSince(base string) ([]TaskCommit, error) {
+	for i, c := range s.history {
+		if c.SHA == base {
+			return s.history[i+1:], nil
+		}
+	}
+	return s.history, nil
+}
+
+type recordingCommitPublisher struct {
+	published []string // "taskID sha"
+	err       error    // returned instead of publishing when set
+}
+
+func (p *recordingCommitPublisher) PublishCommit(task *repository.TaskAssignment, c TaskCommit) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, task.TaskID+" "+c.SHA)
+	return nil
+}
+
+func TestCommitLinker_PublishesCommitsAfterApproval(t *testing.T) {
+	source := &fakeCommitSource{history: []TaskCommit{{SHA: "base"}}}
+	publisher := &recordingCommitPublisher{}
+	tasks := repository.NewMemoryTaskRepository()
+	l := NewCommitLinker(CommitLinkerConfig{Commits: source, Publisher: publisher, Tasks: tasks})
+
+	task := &repository.TaskAssignment{TaskID: "perles-abc", Implementer: "worker-1", Status: repository.TaskCommitting}
+	require.NoError(t, tasks.Save(task))
+
+	// No base recorded yet - nothing is published
+	require.Empty(t, l.Check("worker-1"))
+
+	l.RecordBase("perles-abc")
+	require.Empty(t, l.Check("worker-1"), "no commits yet")
+
+	c1 := TaskCommit{SHA: "c1", Tasks: []string{"perles-abc"}}
+	source.history = append(source.history, c1)
+	require.Equal(t, []TaskCommit{c1}, l.Check("worker-1"))
+
+	c2 := TaskCommit{SHA: "c2", Tasks: []string{"perles-abc"}}
+	source.history = append(source.history, c2)
+	require.Equal(t, []TaskCommit{c2}, l.Check("worker-1"), "each commit is published once")
+	require.Equal(t, []string{"perles-abc c1", "perles-abc c2"}, publisher.published)
+
+	// Commits are only linked while the task is committing
+	task.Status = repository.TaskCompleted
+	source.history = append(source.history, TaskCommit{SHA: "c3", Tasks: []string{"perles-abc"}})
+	require.Empty(t, l.Check("worker-1"))
+	require.Empty(t, l.Check("worker-2"))
+}
+
+func TestCommitLinker_OnlyPublishesCommitsOfTheTask(t *testing.T) {
+	source := &fakeCommitSource{history: []TaskCommit{{SHA: "base"}}}
+	publisher := &recordingCommitPublisher{}
+	tasks := repository.NewMemoryTaskRepository()
+	l := NewCommitLinker(CommitLinkerConfig{Commits: source, Publisher: publisher, Tasks: tasks})
+
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc", Implementer: "worker-1", Status: repository.TaskCommitting}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-def", Implementer: "worker-2", Status: repository.TaskCommitting}))
+	l.RecordBase("perles-abc")
+	l.RecordBase("perles-def")
+
+	// worker-2 commits first, then worker-1; a commit without a trailer belongs to no task
+	source.history = append(source.history,
+		TaskCommit{SHA: "c1", Tasks: []string{"perles-def"}},
+		TaskCommit{SHA: "c2", Tasks: []string{"perles-abc"}},
+		TaskCommit{SHA: "c3"},
+	)
+
+	require.Equal(t, []TaskCommit{{SHA: "c2", Tasks: []string{"perles-abc"}}}, l.Check("worker-1"))
+	require.Equal(t, []TaskCommit{{SHA: "c1", Tasks: []string{"perles-def"}}}, l.Check("worker-2"))
+	require.Equal(t, []string{"perles-abc c2", "perles-def c1"}, publisher.published)
+}
+
+func TestCommitLinker_RetriesFailedPublish(t *testing.T) {
+	source := &fakeCommitSource{history: []TaskCommit{{SHA: "base"}}}
+	publisher := &recordingCommitPublisher{err: errors.New("fabric unavailable")}
+	tasks := repository.NewMemoryTaskRepository()
+	l := NewCommitLinker(CommitLinkerConfig{Commits: source, Publisher: publisher, Tasks: tasks})
+
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc", Implementer: "worker-1", Status: repository.TaskCommitting}))
+	l.RecordBase("perles-abc")
+	c1 := TaskCommit{SHA: "c1", Tasks: []string{"perles-abc"}}
+	source.history = append(source.history, c1)
+
+	require.Empty(t, l.Check("worker-1"), "a failed publish is not reported")
+
+	publisher.err = nil
+	require.Equal(t, []TaskCommit{c1}, l.Check("worker-1"), "the next check retries it")
+	require.Empty(t, l.Check("worker-1"))
+	require.Equal(t, []string{"perles-abc c1"}, publisher.published)
+}
+
+func TestCommitLinker_Middleware(t *testing.T) {
+	source := &fakeCommitSource{history: []TaskCommit{{SHA: "base"}}}
+	publisher := &recordingCommitPublisher{}
+	tasks := repository.NewMemoryTaskRepository()
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc", Implementer: "worker-1", Status: repository.TaskCommitting}))
+	handler := NewCommitLinker(CommitLinkerConfig{Commits: source, Publisher: publisher, Tasks: tasks}).Middleware()(successHandler())
+
+	_, err := handler.Handle(context.Background(), command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc"))
+	require.NoError(t, err)
+
+	source.history = append(source.history, TaskCommit{SHA: "c1", Tasks: []string{"perles-abc"}})
+	_, err = handler.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, []string{"perles-abc c1"}, publisher.published)
+}
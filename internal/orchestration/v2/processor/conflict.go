@@ -0,0 +1,213 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// DefaultConflictScanInterval is how often the conflict scanner diffs the workers' changes.
+const DefaultConflictScanInterval = time.Minute
+
+// ChangedFileSource lists the files a worker changed and has not merged yet.
+type ChangedFileSource interface {
+	ChangedFiles(workerID string) ([]string, error)
+}
+
+// ConflictNotifier posts overlap warnings to the agents they concern.
+// Implemented in v2 on top of Fabric so warnings land in #tasks.
+type ConflictNotifier interface {
+	NotifyConflicts(content string, mentions []string) error
+}
+
+// FileOverlap is a file changed by more than one active implementer.
+type FileOverlap struct {
+	Path    string   `json:"path"`
+	Workers []string `json:"workers"` // sorted
+}
+
+// ConflictScannerConfig configures the conflict scanner.
+type ConflictScannerConfig struct {
+	// Files lists the files each implementer changed.
+	// Required.
+	Files ChangedFileSource
+	// Tasks provides the active implementers.
+	// Required.
+	Tasks repository.TaskRepository
+	// Notifier posts a warning for each new overlap.
+	// Optional - if nil, overlaps are only reported by Overlaps.
+	Notifier ConflictNotifier
+	// Interval between scans. Defaults to DefaultConflictScanInterval.
+	Interval time.Duration
+}
+
+// ConflictScanner periodically compares the files changed by the implementers
+// of unfinished tasks, so conflicting edits surface while the work is in
+// progress instead of when the branches are merged. Each new overlap is posted
+// once; an overlap that goes away and comes back is posted again.
+type ConflictScanner struct {
+	files    ChangedFileSource
+	tasks    repository.TaskRepository
+	notifier ConflictNotifier
+	interval time.Duration
+
+	mu       sync.Mutex
+	overlaps []FileOverlap
+	posted   map[string]bool // see overlapKey
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewConflictScanner creates a conflict scanner. Call Start to begin scanning.
+func NewConflictScanner(cfg ConflictScannerConfig) *ConflictScanner {
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = DefaultConflictScanInterval
+	}
+	return &ConflictScanner{
+		files:    cfg.Files,
+		tasks:    cfg.Tasks,
+		notifier: cfg.Notifier,
+		interval: interval,
+		posted:   make(map[string]bool),
+	}
+}
+
+// Start begins the scan loop. It stops when ctx is cancelled or Stop is called.
+// Safe to call only once.
+func (s *ConflictScanner) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.done = make(chan struct{})
+
+	log.SafeGo("conflict-scanner.loop", func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Scan()
+			}
+		}
+	})
+}
+
+// Stop terminates the scan loop and waits for it to exit.
+// Safe to call multiple times or before Start.
+func (s *ConflictScanner) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+}
+
+// Scan diffs the changes of the active implementers, posts the overlaps not
+// posted before and returns all current overlaps sorted by path.
+func (s *ConflictScanner) Scan() []FileOverlap {
+	changedBy := make(map[string][]string) // path -> workers
+	for _, workerID := range s.implementers() {
+		files, err := s.files.ChangedFiles(workerID)
+		if err != nil {
+			log.Debug(log.CatOrch, "Conflict scanner failed to list changed files", "workerID", workerID, "error", err)
+			continue
+		}
+		for _, path := range files {
+			changedBy[path] = append(changedBy[path], workerID)
+		}
+	}
+
+	var overlaps []FileOverlap
+	for path, workers := range changedBy {
+		if len(workers) > 1 {
+			overlaps = append(overlaps, FileOverlap{Path: path, Workers: workers})
+		}
+	}
+	slices.SortFunc(overlaps, func(a, b FileOverlap) int { return strings.Compare(a.Path, b.Path) })
+
+	s.mu.Lock()
+	var fresh []FileOverlap
+	posted := make(map[string]bool, len(overlaps))
+	for _, o := range overlaps {
+		key := overlapKey(o)
+		if !s.posted[key] {
+			fresh = append(fresh, o)
+		}
+		posted[key] = true
+	}
+	s.overlaps = overlaps
+	s.posted = posted
+	s.mu.Unlock()
+
+	s.notify(fresh)
+	return overlaps
+}
+
+// Overlaps returns the overlaps found by the last scan. If workerID is not
+// empty, only the overlaps involving that worker are returned.
+func (s *ConflictScanner) Overlaps(workerID string) []FileOverlap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var overlaps []FileOverlap
+	for _, o := range s.overlaps {
+		if workerID == "" || slices.Contains(o.Workers, workerID) {
+			overlaps = append(overlaps, o)
+		}
+	}
+	return overlaps
+}
+
+// implementers returns the implementers of unfinished tasks, sorted.
+func (s *ConflictScanner) implementers() []string {
+	var workers []string
+	for _, task := range s.tasks.All() {
+		if task.Status != repository.TaskCompleted && task.Implementer != "" && !slices.Contains(workers, task.Implementer) {
+			workers = append(workers, task.Implementer)
+		}
+	}
+	slices.Sort(workers)
+	return workers
+}
+
+// notify posts one warning per group of workers sharing files, mentioning
+// them and the coordinator.
+func (s *ConflictScanner) notify(overlaps []FileOverlap) {
+	if s.notifier == nil || len(overlaps) == 0 {
+		return
+	}
+
+	var groups []string
+	paths := make(map[string][]string) // "worker-1, worker-2" -> paths
+	for _, o := range overlaps {
+		group := strings.Join(o.Workers, ", ")
+		if _, ok := paths[group]; !ok {
+			groups = append(groups, group)
+		}
+		paths[group] = append(paths[group], o.Path)
+	}
+
+	for _, group := range groups {
+		content := fmt.Sprintf("Overlapping edits: %s changed the same files, which may conflict when merged:\n- %s\n"+
+			"Coordinate in the task threads before committing.", group, strings.Join(paths[group], "\n- "))
+		mentions := append(strings.Split(group, ", "), repository.CoordinatorID)
+		if err := s.notifier.NotifyConflicts(content, mentions); err != nil {
+			log.Debug(log.CatOrch, "Failed to post overlapping edits", "workers", group, "error", err)
+		}
+	}
+}
+
+// overlapKey identifies an overlap across scans: the file and the workers changing it.
+func overlapKey(o FileOverlap) string {
+	return o.Path + "\x00" + strings.Join(o.Workers, ",")
+}
@@ -0,0 +1,88 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// fakeChangedFiles returns fixed changed files per worker.
+type fakeChangedFiles map[string][]string
+
+func (f fakeChangedFiles) ChangedFiles(workerID string) ([]string, error) {
+	return f[workerID], nil
+}
+
+// fakeConflictNotifier records posted warnings.
+type fakeConflictNotifier struct {
+	contents []string
+	mentions [][]string
+}
+
+func (n *fakeConflictNotifier) NotifyConflicts(content string, mentions []string) error {
+	n.contents = append(n.contents, content)
+	n.mentions = append(n.mentions, mentions)
+	return nil
+}
+
+func TestConflictScanner_Scan(t *testing.T) {
+	files := fakeChangedFiles{
+		"worker-1": {"auth/login.go", "auth/session.go", "README.md"},
+		"worker-2": {"auth/login.go", "auth/session.go"},
+		"worker-3": {"README.md", "docs/api.md"},
+		"worker-4": {"auth/login.go"}, // task completed
+	}
+	tasks := repository.NewMemoryTaskRepository()
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Status: repository.TaskImplementing}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-b", Implementer: "worker-2", Status: repository.TaskInReview}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-c", Implementer: "worker-3", Status: repository.TaskImplementing}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-d", Implementer: "worker-4", Status: repository.TaskCompleted}))
+	notifier := &fakeConflictNotifier{}
+	scanner := NewConflictScanner(ConflictScannerConfig{Files: files, Tasks: tasks, Notifier: notifier})
+
+	want := []FileOverlap{
+		{Path: "README.md", Workers: []string{"worker-1", "worker-3"}},
+		{Path: "auth/login.go", Workers: []string{"worker-1", "worker-2"}},
+		{Path: "auth/session.go", Workers: []string{"worker-1", "worker-2"}},
+	}
+	require.Equal(t, want, scanner.Scan())
+	require.Equal(t, want, scanner.Overlaps(""))
+	require.Equal(t, want[1:], scanner.Overlaps("worker-2"))
+	require.Empty(t, scanner.Overlaps("worker-4"))
+
+	// One warning per group of workers, mentioning them and the coordinator
+	require.Len(t, notifier.contents, 2)
+	require.Contains(t, notifier.contents[0], "worker-1, worker-3")
+	require.Contains(t, notifier.contents[0], "- README.md")
+	require.Equal(t, []string{"worker-1", "worker-3", repository.CoordinatorID}, notifier.mentions[0])
+	require.Contains(t, notifier.contents[1], "- auth/login.go\n- auth/session.go")
+
+	// Known overlaps are not posted again
+	scanner.Scan()
+	require.Len(t, notifier.contents, 2)
+
+	// An overlap that went away and came back is posted again
+	files["worker-3"] = []string{"docs/api.md"}
+	require.Len(t, scanner.Scan(), 2)
+	files["worker-3"] = []string{"README.md"}
+	scanner.Scan()
+	require.Len(t, notifier.contents, 3)
+	require.Contains(t, notifier.contents[2], "- README.md")
+}
+
+func TestConflictScanner_StartStop(t *testing.T) {
+	tasks := repository.NewMemoryTaskRepository()
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Status: repository.TaskImplementing}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-b", Implementer: "worker-2", Status: repository.TaskImplementing}))
+	files := fakeChangedFiles{"worker-1": {"main.go"}, "worker-2": {"main.go"}}
+	scanner := NewConflictScanner(ConflictScannerConfig{Files: files, Tasks: tasks, Interval: time.Millisecond})
+
+	scanner.Stop() // before Start is a no-op
+	scanner.Start(context.Background())
+	require.Eventually(t, func() bool { return len(scanner.Overlaps("")) == 1 }, time.Second, time.Millisecond)
+	scanner.Stop()
+	scanner.Stop()
+}
@@ -0,0 +1,105 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+// ErrDeadLettersDisabled is returned by the dead letter methods of a processor
+// created without WithDeadLetterRepository.
+var ErrDeadLettersDisabled = errors.New("dead letter queue is not enabled")
+
+// WithDeadLetterRepository records every failed command in repo so it can be
+// inspected and retried with RetryDeadLetter.
+func WithDeadLetterRepository(repo repository.DeadLetterRepository) Option {
+	return func(p *CommandProcessor) {
+		p.deadLetters = repo
+	}
+}
+
+// recordDeadLetter stores a failed command in the dead letter repository.
+// Commands rejected as duplicates did not fail and are not recorded.
+func (p *CommandProcessor) recordDeadLetter(cmd command.Command, result *command.CommandResult) {
+	if p.deadLetters == nil || result == nil || result.Success {
+		return
+	}
+	if errors.Is(result.Error, types.ErrDuplicateCommand) {
+		return
+	}
+
+	letter := repository.DeadLetter{
+		CommandID:   cmd.ID(),
+		CommandType: cmd.Type().String(),
+		LastFailed:  time.Now(),
+		Command:     cmd,
+	}
+	if result.Error != nil {
+		letter.Error = result.Error.Error()
+	}
+	if s, ok := cmd.(fmt.Stringer); ok {
+		letter.Summary = s.String()
+	}
+	if b, ok := cmd.(interface {
+		Source() command.CommandSource
+		TraceID() string
+	}); ok {
+		letter.Source = string(b.Source())
+		letter.TraceID = b.TraceID()
+	}
+	p.deadLetters.Record(letter)
+}
+
+// DeadLetters returns the failed commands, oldest first.
+// Returns nil if the dead letter queue is not enabled.
+func (p *CommandProcessor) DeadLetters() []*repository.DeadLetter {
+	if p.deadLetters == nil {
+		return nil
+	}
+	return p.deadLetters.List()
+}
+
+// RetryDeadLetter resubmits a failed command and waits for its result.
+// The dead letter is removed if the retry succeeds; if it fails again its
+// error is updated and its attempt count incremented.
+func (p *CommandProcessor) RetryDeadLetter(ctx context.Context, commandID string) (*command.CommandResult, error) {
+	if p.deadLetters == nil {
+		return nil, ErrDeadLettersDisabled
+	}
+	letter, err := p.deadLetters.Get(commandID)
+	if err != nil {
+		return nil, err
+	}
+	cmd, ok := letter.Command.(command.Command)
+	if !ok {
+		return nil, fmt.Errorf("dead letter %s has no command to retry", commandID)
+	}
+
+	log.Info(log.CatOrch, "retrying dead letter",
+		"command_id", commandID,
+		"command_type", letter.CommandType,
+		"attempts", letter.Attempts,
+	)
+	result, err := p.SubmitAndWait(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success {
+		_ = p.deadLetters.Remove(commandID)
+	}
+	return result, nil
+}
+
+// DiscardDeadLetter drops a failed command without retrying it.
+func (p *CommandProcessor) DiscardDeadLetter(commandID string) error {
+	if p.deadLetters == nil {
+		return ErrDeadLettersDisabled
+	}
+	return p.deadLetters.Remove(commandID)
+}
@@ -0,0 +1,113 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+)
+
+func TestProcessor_DeadLetters_RecordsFailedCommands(t *testing.T) {
+	p, _, cleanup := startProcessor(t, WithDeadLetterRepository(repository.NewMemoryDeadLetterRepository(0)))
+	defer cleanup()
+
+	failing := newTestCommand(1)
+	failing.shouldFail = true
+	_, err := p.SubmitAndWait(context.Background(), failing)
+	require.NoError(t, err)
+
+	invalid := newTestCommand(2)
+	invalid.validateErr = errors.New("bad input")
+	_, err = p.SubmitAndWait(context.Background(), invalid)
+	require.NoError(t, err)
+
+	_, err = p.SubmitAndWait(context.Background(), newTestCommand(3))
+	require.NoError(t, err)
+
+	letters := p.DeadLetters()
+	require.Len(t, letters, 2)
+	assert.Equal(t, failing.ID(), letters[0].CommandID)
+	assert.Equal(t, "test_command", letters[0].CommandType)
+	assert.Equal(t, string(command.SourceInternal), letters[0].Source)
+	assert.Equal(t, "handler failure", letters[0].Error)
+	assert.Equal(t, 1, letters[0].Attempts)
+	assert.Same(t, failing, letters[0].Command)
+	assert.Equal(t, "bad input", letters[1].Error)
+}
+
+func TestProcessor_DeadLetters_IgnoresDuplicates(t *testing.T) {
+	p, _, cleanup := startProcessor(t, WithDeadLetterRepository(repository.NewMemoryDeadLetterRepository(0)))
+	defer cleanup()
+
+	p.RegisterHandler("dup_command", HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		return &command.CommandResult{Success: false, Error: types.ErrDuplicateCommand}, nil
+	}))
+
+	_, err := p.SubmitAndWait(context.Background(), &simpleCommand{BaseCommand: baseCmd("dup_command")})
+	require.NoError(t, err)
+	assert.Empty(t, p.DeadLetters())
+}
+
+func TestProcessor_RetryDeadLetter(t *testing.T) {
+	p, handler, cleanup := startProcessor(t, WithDeadLetterRepository(repository.NewMemoryDeadLetterRepository(0)))
+	defer cleanup()
+
+	failing := newTestCommand(7)
+	failing.shouldFail = true
+	_, err := p.SubmitAndWait(context.Background(), failing)
+	require.NoError(t, err)
+
+	// Still failing: the attempt is counted and the dead letter kept
+	result, err := p.RetryDeadLetter(context.Background(), failing.ID())
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	letters := p.DeadLetters()
+	require.Len(t, letters, 1)
+	assert.Equal(t, 2, letters[0].Attempts)
+
+	// Cause fixed: the retry succeeds and the dead letter is removed
+	failing.shouldFail = false
+	result, err = p.RetryDeadLetter(context.Background(), failing.ID())
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Empty(t, p.DeadLetters())
+	assert.Equal(t, []int{7}, handler.getProcessed())
+
+	_, err = p.RetryDeadLetter(context.Background(), failing.ID())
+	require.ErrorIs(t, err, repository.ErrDeadLetterNotFound)
+}
+
+func TestProcessor_DiscardDeadLetter(t *testing.T) {
+	p, handler, cleanup := startProcessor(t, WithDeadLetterRepository(repository.NewMemoryDeadLetterRepository(0)))
+	defer cleanup()
+
+	failing := newTestCommand(1)
+	failing.shouldFail = true
+	_, err := p.SubmitAndWait(context.Background(), failing)
+	require.NoError(t, err)
+
+	require.NoError(t, p.DiscardDeadLetter(failing.ID()))
+	assert.Empty(t, p.DeadLetters())
+	assert.Empty(t, handler.getProcessed())
+	require.ErrorIs(t, p.DiscardDeadLetter(failing.ID()), repository.ErrDeadLetterNotFound)
+}
+
+func TestProcessor_DeadLetters_Disabled(t *testing.T) {
+	p, _, cleanup := startProcessor(t)
+	defer cleanup()
+
+	failing := newTestCommand(1)
+	failing.shouldFail = true
+	_, err := p.SubmitAndWait(context.Background(), failing)
+	require.NoError(t, err)
+
+	assert.Nil(t, p.DeadLetters())
+	_, err = p.RetryDeadLetter(context.Background(), failing.ID())
+	require.ErrorIs(t, err, ErrDeadLettersDisabled)
+	require.ErrorIs(t, p.DiscardDeadLetter(failing.ID()), ErrDeadLettersDisabled)
+}
@@ -0,0 +1,101 @@
+package processor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// DefaultIdempotencyTTL is how long the processor remembers the result of a
+// command with an idempotency key. Agents retry after a perceived timeout
+// within minutes, so this comfortably covers the retry window.
+const DefaultIdempotencyTTL = 10 * time.Minute
+
+// idempotentCommand is implemented by commands that carry an idempotency key.
+// All commands embedding *command.BaseCommand implement it.
+type idempotentCommand interface {
+	IdempotencyKey() string
+}
+
+// idempotencyKeyOf returns the store key for cmd, or "" if cmd has no
+// idempotency key. Keys are scoped by command type so the same key used for
+// different tools does not collide.
+func idempotencyKeyOf(cmd command.Command) string {
+	ic, ok := cmd.(idempotentCommand)
+	if !ok || ic.IdempotencyKey() == "" {
+		return ""
+	}
+	return cmd.Type().String() + ":" + ic.IdempotencyKey()
+}
+
+// idempotencyEntry is a remembered result and when it is forgotten.
+type idempotencyEntry struct {
+	result *command.CommandResult
+	expiry time.Time
+}
+
+// IdempotencyStore remembers the results of commands by idempotency key for a
+// TTL. Expired entries are pruned lazily on Put, so no cleanup goroutine is
+// needed. Thread-safe for concurrent use.
+type IdempotencyStore struct {
+	entries map[string]idempotencyEntry
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+// NewIdempotencyStore creates an IdempotencyStore. A ttl of 0 uses DefaultIdempotencyTTL.
+func NewIdempotencyStore(ttl time.Duration) *IdempotencyStore {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyTTL
+	}
+	return &IdempotencyStore{
+		entries: make(map[string]idempotencyEntry),
+		ttl:     ttl,
+		now:     time.Now,
+	}
+}
+
+// Get returns the result remembered for key, or false if there is none or it expired.
+func (s *IdempotencyStore) Get(key string) (*command.CommandResult, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok || !s.now().Before(entry.expiry) {
+		return nil, false
+	}
+	return entry.result, true
+}
+
+// Put remembers result for key. Events and follow-up commands are dropped:
+// they belong to the original execution and must not be replayed.
+func (s *IdempotencyStore) Put(key string, result *command.CommandResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for k, entry := range s.entries {
+		if !now.Before(entry.expiry) {
+			delete(s.entries, k)
+		}
+	}
+
+	s.entries[key] = idempotencyEntry{
+		result: &command.CommandResult{
+			Success: result.Success,
+			Error:   result.Error,
+			Data:    result.Data,
+		},
+		expiry: now.Add(s.ttl),
+	}
+}
+
+// Len returns the number of remembered results, including expired ones not yet pruned.
+// This is primarily for testing.
+func (s *IdempotencyStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
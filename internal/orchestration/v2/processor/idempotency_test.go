@@ -0,0 +1,147 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+func newKeyedTestCommand(value int, key string) *testCommand {
+	cmd := newTestCommand(value)
+	cmd.SetIdempotencyKey(key)
+	return cmd
+}
+
+func TestProcessor_Idempotency_DuplicateReturnsOriginalResult(t *testing.T) {
+	p, handler, cleanup := startProcessor(t)
+	defer cleanup()
+
+	first, err := p.SubmitAndWait(context.Background(), newKeyedTestCommand(1, "assign-1"))
+	require.NoError(t, err)
+	require.True(t, first.Success)
+	assert.False(t, first.Replayed)
+
+	// The retry carries different content but the same key: the original result wins
+	second, err := p.SubmitAndWait(context.Background(), newKeyedTestCommand(2, "assign-1"))
+	require.NoError(t, err)
+	require.True(t, second.Success)
+	assert.True(t, second.Replayed)
+	assert.Equal(t, 1, second.Data)
+
+	assert.Equal(t, []int{1}, handler.getProcessed(), "handler must run once")
+}
+
+func TestProcessor_Idempotency_WithoutKeyOrWithOtherKey(t *testing.T) {
+	p, handler, cleanup := startProcessor(t)
+	defer cleanup()
+
+	for _, cmd := range []*testCommand{
+		newTestCommand(1),
+		newTestCommand(2),
+		newKeyedTestCommand(3, "a"),
+		newKeyedTestCommand(4, "b"),
+	} {
+		result, err := p.SubmitAndWait(context.Background(), cmd)
+		require.NoError(t, err)
+		assert.False(t, result.Replayed)
+	}
+
+	assert.Equal(t, []int{1, 2, 3, 4}, handler.getProcessed())
+}
+
+func TestProcessor_Idempotency_KeysAreScopedByCommandType(t *testing.T) {
+	p, handler, cleanup := startProcessor(t)
+	defer cleanup()
+
+	var otherRuns int
+	p.RegisterHandler("other_command", HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		otherRuns++
+		return &command.CommandResult{Success: true}, nil
+	}))
+
+	_, err := p.SubmitAndWait(context.Background(), newKeyedTestCommand(1, "same"))
+	require.NoError(t, err)
+
+	other := &simpleCommand{BaseCommand: baseCmd("other_command")}
+	other.SetIdempotencyKey("same")
+	result, err := p.SubmitAndWait(context.Background(), other)
+	require.NoError(t, err)
+
+	assert.False(t, result.Replayed)
+	assert.Equal(t, 1, otherRuns)
+	assert.Equal(t, []int{1}, handler.getProcessed())
+}
+
+func TestProcessor_Idempotency_FailedCommandCanBeRetried(t *testing.T) {
+	p, handler, cleanup := startProcessor(t)
+	defer cleanup()
+
+	failing := newKeyedTestCommand(1, "retry-me")
+	failing.shouldFail = true
+	result, err := p.SubmitAndWait(context.Background(), failing)
+	require.NoError(t, err)
+	require.False(t, result.Success)
+
+	result, err = p.SubmitAndWait(context.Background(), newKeyedTestCommand(1, "retry-me"))
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.False(t, result.Replayed)
+	assert.Equal(t, []int{1}, handler.getProcessed())
+}
+
+func TestProcessor_Idempotency_DuplicateDoesNotReplayFollowUps(t *testing.T) {
+	p, handler, cleanup := startProcessor(t)
+	defer cleanup()
+
+	p.handlers["test_command"] = HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		tc := cmd.(*testCommand)
+		handler.mu.Lock()
+		handler.processed = append(handler.processed, tc.value)
+		handler.mu.Unlock()
+		if tc.value == 1 {
+			return &command.CommandResult{Success: true, FollowUp: []command.Command{newTestCommand(2)}}, nil
+		}
+		return &command.CommandResult{Success: true}, nil
+	})
+
+	_, err := p.SubmitAndWait(context.Background(), newKeyedTestCommand(1, "k"))
+	require.NoError(t, err)
+	result, err := p.SubmitAndWait(context.Background(), newKeyedTestCommand(1, "k"))
+	require.NoError(t, err)
+	assert.True(t, result.Replayed)
+	assert.Empty(t, result.FollowUp)
+
+	// Flush the queue: the follow-up of the original runs once, the duplicate adds none
+	_, err = p.SubmitAndWait(context.Background(), newTestCommand(3))
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, handler.getProcessed())
+}
+
+func TestIdempotencyStore_ExpiresAfterTTL(t *testing.T) {
+	now := time.Now()
+	s := NewIdempotencyStore(time.Minute)
+	s.now = func() time.Time { return now }
+
+	s.Put("k", &command.CommandResult{Success: true, Data: "original", Events: []any{"event"}})
+	got, ok := s.Get("k")
+	require.True(t, ok)
+	assert.Equal(t, "original", got.Data)
+	assert.Empty(t, got.Events, "events belong to the original execution")
+
+	now = now.Add(time.Minute)
+	_, ok = s.Get("k")
+	assert.False(t, ok)
+
+	// Expired entries are pruned on the next Put
+	s.Put("other", &command.CommandResult{Success: true})
+	assert.Equal(t, 1, s.Len())
+}
+
+func TestNewIdempotencyStore_DefaultTTL(t *testing.T) {
+	assert.Equal(t, DefaultIdempotencyTTL, NewIdempotencyStore(0).ttl)
+	assert.Equal(t, 2*time.Second, NewIdempotencyStore(2*time.Second).ttl)
+}
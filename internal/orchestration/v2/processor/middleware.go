@@ -309,6 +309,9 @@ func (m *DeduplicationMiddleware) computeContentHash(cmd command.Command) string
 		for _, w := range c.ExcludeWorkers {
 			h.Write([]byte(w))
 		}
+		h.Write([]byte(c.Filter.Phase))
+		h.Write([]byte(c.Filter.AgentType))
+		h.Write([]byte(c.Filter.TaskID))
 	case *command.DeliverProcessQueuedCommand:
 		h.Write([]byte(c.ProcessID))
 	case *command.ReportCompleteCommand:
@@ -0,0 +1,128 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/v2/types"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
+)
+
+// ErrNotAllowedInPhase is returned when the active DAG phase rejects a command.
+var ErrNotAllowedInPhase = types.ErrNotAllowedInPhase
+
+// gatedActions maps the command types the phase gate checks to the coordinator
+// tools (dag.Actions) that submit them.
+var gatedActions = map[command.CommandType]string{
+	command.CmdSpawnProcess:           "spawn_worker",
+	command.CmdAssignTask:             "assign_task",
+	command.CmdAssignReview:           "assign_task_review",
+	command.CmdAssignReviewFeedback:   "assign_review_feedback",
+	command.CmdApproveCommit:          "approve_commit",
+	command.CmdMarkTaskComplete:       "mark_task_complete",
+	command.CmdMarkTaskFailed:         "mark_task_failed",
+	command.CmdSignalWorkflowComplete: "signal_workflow_complete",
+}
+
+// PhaseGate validates coordinator commands against the active phase of a
+// workflow DAG. A command is rejected when the phase does not allow its action,
+// when it spawns or gives work to a worker whose agent type the phase does not
+// allow, or when the workflow is waiting at an unapproved human checkpoint.
+//
+// Only commands from MCP tool calls are gated; internal follow-ups, callbacks
+// and user commands always pass.
+type PhaseGate struct {
+	run       *dag.Run
+	processes repository.ProcessRepository
+}
+
+// NewPhaseGate creates a phase gate for a DAG run. processes provides the
+// agent types of workers; if nil, roles are only checked on spawn.
+func NewPhaseGate(run *dag.Run, processes repository.ProcessRepository) *PhaseGate {
+	return &PhaseGate{run: run, processes: processes}
+}
+
+// Middleware returns the middleware function.
+func (g *PhaseGate) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			if err := g.Check(cmd); err != nil {
+				log.Debug(log.CatOrch, "Command rejected by workflow phase",
+					"subsystem", "phase_gate",
+					"command_type", cmd.Type(),
+					"error", err)
+				return &command.CommandResult{
+					Success: false,
+					Error:   err,
+				}, nil
+			}
+			return next.Handle(ctx, cmd)
+		})
+	}
+}
+
+// Check returns an error wrapping ErrNotAllowedInPhase if the active phase rejects cmd.
+func (g *PhaseGate) Check(cmd command.Command) error {
+	action, gated := gatedActions[cmd.Type()]
+	if !gated {
+		return nil
+	}
+	if hasSource, ok := cmd.(interface{ Source() command.CommandSource }); !ok || hasSource.Source() != command.SourceMCPTool {
+		return nil
+	}
+	if spawn, ok := cmd.(*command.SpawnProcessCommand); ok && spawn.Role != repository.RoleWorker {
+		return nil
+	}
+
+	phase := g.run.Current()
+	if g.run.AwaitingApproval() {
+		return fmt.Errorf("%w: %s is a human checkpoint, wait for the user to approve it", ErrNotAllowedInPhase, phase.ID)
+	}
+	if !phase.AllowsAction(action) {
+		return fmt.Errorf("%w: %s is not allowed in phase %s (allowed: %s)",
+			ErrNotAllowedInPhase, action, phase.ID, strings.Join(phase.Actions, ", "))
+	}
+
+	workerID, agentType := g.target(cmd)
+	if !phase.AllowsRole(agentType) {
+		who := agentType.String()
+		if workerID != "" {
+			who = fmt.Sprintf("%s (%s)", workerID, agentType)
+		}
+		return fmt.Errorf("%w: phase %s only allows %s workers, not %s",
+			ErrNotAllowedInPhase, phase.ID, strings.Join(phase.Roles, ", "), who)
+	}
+	return nil
+}
+
+// target returns the worker a command spawns or gives work to and its agent type.
+func (g *PhaseGate) target(cmd command.Command) (string, roles.AgentType) {
+	var workerID string
+	switch c := cmd.(type) {
+	case *command.SpawnProcessCommand:
+		return "", c.AgentType
+	case *command.AssignTaskCommand:
+		workerID = c.WorkerID
+	case *command.AssignReviewCommand:
+		workerID = c.ReviewerID
+	case *command.AssignReviewFeedbackCommand:
+		workerID = c.ImplementerID
+	default:
+		return "", roles.AgentTypeGeneric
+	}
+
+	if g.processes == nil {
+		return workerID, roles.AgentTypeGeneric
+	}
+	proc, err := g.processes.Get(workerID)
+	if err != nil {
+		// Let the handler report the unknown worker
+		return workerID, roles.AgentTypeGeneric
+	}
+	return workerID, proc.AgentType
+}
@@ -0,0 +1,94 @@
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/workflow/dag"
+)
+
+func newPhaseGateFixture(t *testing.T) (*PhaseGate, *dag.Run) {
+	t.Helper()
+	def, err := dag.Parse([]byte(`
+phases:
+  - id: research
+    roles: [researcher]
+    actions: [spawn_worker, assign_task]
+    next:
+      - to: review
+  - id: review
+    checkpoint: true
+    next:
+      - to: implement
+  - id: implement
+    roles: [implementer]
+`))
+	require.NoError(t, err)
+
+	processes := repository.NewMemoryProcessRepository()
+	require.NoError(t, processes.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, AgentType: roles.AgentTypeResearcher}))
+	require.NoError(t, processes.Save(&repository.Process{ID: "worker-2", Role: repository.RoleWorker, AgentType: roles.AgentTypeImplementer}))
+	require.NoError(t, processes.Save(&repository.Process{ID: "worker-3", Role: repository.RoleWorker}))
+
+	run := dag.NewRun(def)
+	return NewPhaseGate(run, processes), run
+}
+
+func TestPhaseGate_Actions(t *testing.T) {
+	gate, _ := newPhaseGateFixture(t)
+
+	require.NoError(t, gate.Check(command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc.1", "", "")))
+	require.NoError(t, gate.Check(command.NewSendToProcessCommand(command.SourceMCPTool, "worker-1", "hi")), "ungated commands pass")
+
+	err := gate.Check(command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc.1"))
+	require.ErrorIs(t, err, ErrNotAllowedInPhase)
+	require.ErrorContains(t, err, "mark_task_complete is not allowed in phase research (allowed: spawn_worker, assign_task)")
+
+	require.NoError(t, gate.Check(command.NewMarkTaskCompleteCommand(command.SourceInternal, "perles-abc.1")), "only MCP tool calls are gated")
+}
+
+func TestPhaseGate_Roles(t *testing.T) {
+	gate, _ := newPhaseGateFixture(t)
+
+	err := gate.Check(command.NewAssignTaskCommand(command.SourceMCPTool, "worker-2", "perles-abc.1", "", ""))
+	require.ErrorIs(t, err, ErrNotAllowedInPhase)
+	require.ErrorContains(t, err, "only allows researcher workers, not worker-2 (implementer)")
+
+	require.NoError(t, gate.Check(command.NewAssignTaskCommand(command.SourceMCPTool, "worker-3", "perles-abc.1", "", "")), "generic workers are allowed")
+
+	err = gate.Check(command.NewSpawnProcessCommand(command.SourceMCPTool, repository.RoleWorker, command.WithAgentType(roles.AgentTypeReviewer)))
+	require.ErrorIs(t, err, ErrNotAllowedInPhase)
+	require.NoError(t, gate.Check(command.NewSpawnProcessCommand(command.SourceMCPTool, repository.RoleWorker, command.WithAgentType(roles.AgentTypeResearcher))))
+}
+
+func TestPhaseGate_Checkpoint(t *testing.T) {
+	gate, run := newPhaseGateFixture(t)
+	_, err := run.Advance("review", func(string) bool { return true })
+	require.NoError(t, err)
+
+	err = gate.Check(command.NewAssignTaskCommand(command.SourceMCPTool, "worker-2", "perles-abc.1", "", ""))
+	require.ErrorContains(t, err, "review is a human checkpoint")
+
+	_, err = run.Approve()
+	require.NoError(t, err)
+	require.NoError(t, gate.Check(command.NewAssignTaskCommand(command.SourceMCPTool, "worker-2", "perles-abc.1", "", "")))
+}
+
+func TestPhaseGate_Middleware(t *testing.T) {
+	gate, _ := newPhaseGateFixture(t)
+
+	result, err := gate.Middleware()(successHandler()).Handle(context.Background(),
+		command.NewApproveCommitCommand(command.SourceMCPTool, "worker-2", "perles-abc.1"))
+	require.NoError(t, err)
+	require.False(t, result.Success)
+	require.ErrorIs(t, result.Error, ErrNotAllowedInPhase)
+
+	result, err = gate.Middleware()(successHandler()).Handle(context.Background(), newTestCommand(1))
+	require.NoError(t, err)
+	require.True(t, result.Success)
+}
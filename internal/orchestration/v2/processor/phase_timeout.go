@@ -0,0 +1,301 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// DefaultPhaseTimeoutInterval is how often the phase timeout watcher checks the workers.
+const DefaultPhaseTimeoutInterval = 30 * time.Second
+
+// PhaseTimeoutAction is an escalation step taken against a worker that stayed
+// in a phase longer than its timeout.
+type PhaseTimeoutAction string
+
+const (
+	// PhaseTimeoutNudge reminds the worker to finish the phase (at 1x the timeout).
+	PhaseTimeoutNudge PhaseTimeoutAction = "nudge"
+	// PhaseTimeoutNotifyCoordinator tells the coordinator the worker is stuck (at 2x).
+	PhaseTimeoutNotifyCoordinator PhaseTimeoutAction = "notify_coordinator"
+	// PhaseTimeoutReplace replaces the worker with a fresh one (at 3x).
+	PhaseTimeoutReplace PhaseTimeoutAction = "replace"
+	// PhaseTimeoutResolved records that an escalated worker left the phase.
+	PhaseTimeoutResolved PhaseTimeoutAction = "resolved"
+)
+
+// phaseTimeoutEscalation lists the escalation steps in order. Step i is taken
+// once the worker has spent i+1 times its timeout in the phase.
+var phaseTimeoutEscalation = []PhaseTimeoutAction{
+	PhaseTimeoutNudge,
+	PhaseTimeoutNotifyCoordinator,
+	PhaseTimeoutReplace,
+}
+
+// PhaseTimeouts configures how long workers may stay in each phase.
+// Phases without a timeout are not watched.
+type PhaseTimeouts struct {
+	// Phases is the timeout of each phase, e.g. reviewing: 1h.
+	Phases map[events.ProcessPhase]time.Duration
+	// Workers overrides Phases by worker ID. An override of zero disables the
+	// timeout of that phase for the worker.
+	Workers map[string]map[events.ProcessPhase]time.Duration
+}
+
+// IsZero returns true if no timeouts are configured.
+func (t PhaseTimeouts) IsZero() bool {
+	return len(t.Phases) == 0 && len(t.Workers) == 0
+}
+
+// For returns the timeout of phase for workerID, or 0 if the phase is not watched.
+func (t PhaseTimeouts) For(workerID string, phase events.ProcessPhase) time.Duration {
+	if override, ok := t.Workers[workerID][phase]; ok {
+		return override
+	}
+	return t.Phases[phase]
+}
+
+// PhaseTimeoutEvent records an escalation step taken against a worker.
+// Published on the event bus so the dashboard can show it.
+type PhaseTimeoutEvent struct {
+	WorkerID  string              `json:"worker_id"`
+	TaskID    string              `json:"task_id,omitempty"`
+	Phase     events.ProcessPhase `json:"phase"`
+	Action    PhaseTimeoutAction  `json:"action"`
+	Elapsed   time.Duration       `json:"elapsed"`
+	Timeout   time.Duration       `json:"timeout"`
+	Timestamp time.Time           `json:"timestamp"`
+}
+
+// Summary describes the event in one line.
+func (e PhaseTimeoutEvent) Summary() string {
+	elapsed := e.Elapsed.Round(time.Second)
+	switch e.Action {
+	case PhaseTimeoutNudge:
+		return fmt.Sprintf("%s has been %s for %s (limit %s), nudged", e.WorkerID, e.Phase, elapsed, e.Timeout)
+	case PhaseTimeoutNotifyCoordinator:
+		return fmt.Sprintf("%s has been %s for %s (limit %s), coordinator notified", e.WorkerID, e.Phase, elapsed, e.Timeout)
+	case PhaseTimeoutReplace:
+		return fmt.Sprintf("%s has been %s for %s (limit %s), replacing it", e.WorkerID, e.Phase, elapsed, e.Timeout)
+	case PhaseTimeoutResolved:
+		return fmt.Sprintf("%s left %s after %s", e.WorkerID, e.Phase, elapsed)
+	default:
+		return fmt.Sprintf("%s: %s in %s", e.WorkerID, e.Action, e.Phase)
+	}
+}
+
+// PhaseTimeoutSubmitter submits the escalation commands.
+// Implemented by CommandProcessor.
+type PhaseTimeoutSubmitter interface {
+	Submit(cmd command.Command) error
+}
+
+// PhaseTimeoutWatcherConfig configures the phase timeout watcher.
+type PhaseTimeoutWatcherConfig struct {
+	// Timeouts are the phase timeouts and per-worker overrides.
+	Timeouts PhaseTimeouts
+	// Processes provides the workers and their phases.
+	// Required.
+	Processes repository.ProcessRepository
+	// Submitter receives the nudge, notify and replace commands.
+	// Required.
+	Submitter PhaseTimeoutSubmitter
+	// EventBus receives a PhaseTimeoutEvent for every escalation step.
+	// Optional - if nil, steps are only logged.
+	EventBus EventPublisher
+	// Interval between checks. Defaults to DefaultPhaseTimeoutInterval.
+	Interval time.Duration
+	// Now returns the current time. Defaults to time.Now if nil.
+	Now func() time.Time
+}
+
+// phaseTimer tracks how long a worker has been in its current phase.
+type phaseTimer struct {
+	phase  events.ProcessPhase
+	taskID string
+	since  time.Time
+	steps  int // escalation steps taken in this phase
+}
+
+// PhaseTimeoutWatcher escalates workers that stay in a phase longer than its
+// timeout: at 1x the timeout the worker is nudged, at 2x the coordinator is
+// notified and at 3x the worker is replaced. The clock restarts whenever the
+// worker changes phase or task. Phase changes are noticed on the next check,
+// so timings are accurate to the check interval.
+type PhaseTimeoutWatcher struct {
+	timeouts  PhaseTimeouts
+	processes repository.ProcessRepository
+	submitter PhaseTimeoutSubmitter
+	eventBus  EventPublisher
+	interval  time.Duration
+	now       func() time.Time
+
+	mu     sync.Mutex
+	timers map[string]*phaseTimer // workerID -> timer
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPhaseTimeoutWatcher creates a phase timeout watcher. Call Start to begin checking.
+func NewPhaseTimeoutWatcher(cfg PhaseTimeoutWatcherConfig) *PhaseTimeoutWatcher {
+	interval := cfg.Interval
+	if interval == 0 {
+		interval = DefaultPhaseTimeoutInterval
+	}
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
+	return &PhaseTimeoutWatcher{
+		timeouts:  cfg.Timeouts,
+		processes: cfg.Processes,
+		submitter: cfg.Submitter,
+		eventBus:  cfg.EventBus,
+		interval:  interval,
+		now:       now,
+		timers:    make(map[string]*phaseTimer),
+	}
+}
+
+// Start begins the check loop. It stops when ctx is cancelled or Stop is called.
+// Safe to call only once.
+func (w *PhaseTimeoutWatcher) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+
+	log.SafeGo("phase-timeout-watcher.loop", func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				w.Check()
+			}
+		}
+	})
+}
+
+// Stop terminates the check loop and waits for it to exit.
+// Safe to call multiple times or before Start.
+func (w *PhaseTimeoutWatcher) Stop() {
+	if w.cancel == nil {
+		return
+	}
+	w.cancel()
+	<-w.done
+}
+
+// Check updates the phase timers of the active workers and takes the
+// escalation steps that are due. It returns the steps taken.
+func (w *PhaseTimeoutWatcher) Check() []PhaseTimeoutEvent {
+	now := w.now()
+
+	w.mu.Lock()
+	var taken []PhaseTimeoutEvent
+	seen := make(map[string]bool)
+	for _, proc := range w.processes.ActiveWorkers() {
+		if !proc.IsActive() || proc.Phase == nil {
+			continue
+		}
+		seen[proc.ID] = true
+
+		timer, ok := w.timers[proc.ID]
+		if !ok || timer.phase != *proc.Phase || timer.taskID != proc.TaskID {
+			if ok && timer.steps > 0 {
+				taken = append(taken, w.event(proc.ID, timer, PhaseTimeoutResolved, 0, now))
+			}
+			timer = &phaseTimer{phase: *proc.Phase, taskID: proc.TaskID, since: now}
+			w.timers[proc.ID] = timer
+		}
+
+		timeout := w.timeouts.For(proc.ID, timer.phase)
+		if timeout <= 0 || timer.steps >= len(phaseTimeoutEscalation) {
+			continue
+		}
+		// Take at most one step per check so every step is delivered in order
+		if now.Sub(timer.since) >= timeout*time.Duration(timer.steps+1) {
+			action := phaseTimeoutEscalation[timer.steps]
+			timer.steps++
+			taken = append(taken, w.event(proc.ID, timer, action, timeout, now))
+		}
+	}
+	// Forget workers that retired, failed or were replaced
+	for id := range w.timers {
+		if !seen[id] {
+			delete(w.timers, id)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, e := range taken {
+		w.escalate(e)
+	}
+	return taken
+}
+
+// event builds the PhaseTimeoutEvent for a step taken against workerID.
+func (w *PhaseTimeoutWatcher) event(workerID string, timer *phaseTimer, action PhaseTimeoutAction, timeout time.Duration, now time.Time) PhaseTimeoutEvent {
+	return PhaseTimeoutEvent{
+		WorkerID:  workerID,
+		TaskID:    timer.taskID,
+		Phase:     timer.phase,
+		Action:    action,
+		Elapsed:   now.Sub(timer.since),
+		Timeout:   timeout,
+		Timestamp: now,
+	}
+}
+
+// escalate submits the commands of an escalation step and publishes it.
+func (w *PhaseTimeoutWatcher) escalate(e PhaseTimeoutEvent) {
+	log.Info(log.CatOrch, "Phase timeout", "workerID", e.WorkerID, "phase", e.Phase,
+		"action", e.Action, "elapsed", e.Elapsed.Round(time.Second), "timeout", e.Timeout)
+
+	var cmds []command.Command
+	switch e.Action {
+	case PhaseTimeoutNudge:
+		cmds = append(cmds, command.NewSendToProcessCommand(command.SourceInternal, e.WorkerID,
+			fmt.Sprintf("[PHASE TIMEOUT] You have been %s for %s, longer than the %s allowed. "+
+				"Finish the phase now, or call request_assistance if something is blocking you.",
+				e.Phase, e.Elapsed.Round(time.Second), e.Timeout)))
+	case PhaseTimeoutNotifyCoordinator:
+		// Replacement happens at 3x the timeout
+		untilReplace := max(e.Timeout*3-e.Elapsed, 0).Round(time.Second)
+		cmds = append(cmds, w.notifyCoordinator(fmt.Sprintf("[PHASE TIMEOUT] %s. "+
+			"It will be replaced in %s unless it moves on.", e.Summary(), untilReplace))...)
+	case PhaseTimeoutReplace:
+		cmds = append(cmds, command.NewReplaceProcessCommand(command.SourceInternal, e.WorkerID,
+			"phase timeout: "+e.Summary()))
+		cmds = append(cmds, w.notifyCoordinator(fmt.Sprintf("[PHASE TIMEOUT] %s. "+
+			"Reassign its task to the replacement.", e.Summary()))...)
+	}
+
+	for _, cmd := range cmds {
+		if err := w.submitter.Submit(cmd); err != nil {
+			log.Debug(log.CatOrch, "Failed to submit phase timeout command", "workerID", e.WorkerID, "error", err)
+		}
+	}
+	if w.eventBus != nil {
+		w.eventBus.Publish("updated", e)
+	}
+}
+
+// notifyCoordinator returns the command sending content to the coordinator,
+// or nothing if the workflow has none (solo mode).
+func (w *PhaseTimeoutWatcher) notifyCoordinator(content string) []command.Command {
+	if _, err := w.processes.GetCoordinator(); err != nil {
+		return nil
+	}
+	return []command.Command{command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID, content)}
+}
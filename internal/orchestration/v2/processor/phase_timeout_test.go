@@ -0,0 +1,210 @@
+package processor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+type recordingSubmitter struct {
+	mu   sync.Mutex
+	cmds []command.Command
+}
+
+func (s *recordingSubmitter) Submit(cmd command.Command) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cmds = append(s.cmds, cmd)
+	return nil
+}
+
+func (s *recordingSubmitter) take() []command.Command {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cmds := s.cmds
+	s.cmds = nil
+	return cmds
+}
+
+func newPhaseTimeoutTestWatcher(t *testing.T, timeouts PhaseTimeouts) (*PhaseTimeoutWatcher, *repository.MemoryProcessRepository, *recordingSubmitter, *mockEventPublisher, *time.Time) {
+	t.Helper()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo := repository.NewMemoryProcessRepository()
+	submitter := &recordingSubmitter{}
+	publisher := newMockEventPublisher()
+	w := NewPhaseTimeoutWatcher(PhaseTimeoutWatcherConfig{
+		Timeouts:  timeouts,
+		Processes: repo,
+		Submitter: submitter,
+		EventBus:  publisher,
+		Now:       func() time.Time { return now },
+	})
+	require.NoError(t, repo.Save(&repository.Process{ID: repository.CoordinatorID, Role: repository.RoleCoordinator, Status: repository.StatusReady}))
+	return w, repo, submitter, publisher, &now
+}
+
+func saveWorker(t *testing.T, repo *repository.MemoryProcessRepository, id string, phase events.ProcessPhase, taskID string) {
+	t.Helper()
+	require.NoError(t, repo.Save(&repository.Process{
+		ID:     id,
+		Role:   repository.RoleWorker,
+		Status: repository.StatusWorking,
+		Phase:  &phase,
+		TaskID: taskID,
+	}))
+}
+
+func TestPhaseTimeoutWatcher_EscalatesNudgeNotifyReplace(t *testing.T) {
+	w, repo, submitter, publisher, now := newPhaseTimeoutTestWatcher(t, PhaseTimeouts{
+		Phases: map[events.ProcessPhase]time.Duration{events.ProcessPhaseReviewing: time.Hour},
+	})
+	saveWorker(t, repo, "worker-1", events.ProcessPhaseReviewing, "perles-abc")
+
+	require.Empty(t, w.Check()) // starts the clock
+	*now = now.Add(59 * time.Minute)
+	require.Empty(t, w.Check())
+
+	*now = now.Add(time.Minute)
+	taken := w.Check()
+	require.Len(t, taken, 1)
+	assert.Equal(t, PhaseTimeoutNudge, taken[0].Action)
+	assert.Equal(t, "perles-abc", taken[0].TaskID)
+	assert.Equal(t, time.Hour, taken[0].Elapsed)
+	cmds := submitter.take()
+	require.Len(t, cmds, 1)
+	nudge := cmds[0].(*command.SendToProcessCommand)
+	assert.Equal(t, "worker-1", nudge.ProcessID)
+	assert.Contains(t, nudge.Content, "[PHASE TIMEOUT]")
+
+	*now = now.Add(75 * time.Minute)
+	taken = w.Check()
+	require.Len(t, taken, 1)
+	assert.Equal(t, PhaseTimeoutNotifyCoordinator, taken[0].Action)
+	cmds = submitter.take()
+	require.Len(t, cmds, 1)
+	notify := cmds[0].(*command.SendToProcessCommand)
+	assert.Equal(t, repository.CoordinatorID, notify.ProcessID)
+	assert.Contains(t, notify.Content, "It will be replaced in 45m0s unless it moves on.")
+
+	*now = now.Add(45 * time.Minute)
+	taken = w.Check()
+	require.Len(t, taken, 1)
+	assert.Equal(t, PhaseTimeoutReplace, taken[0].Action)
+	cmds = submitter.take()
+	require.Len(t, cmds, 2)
+	replace := cmds[0].(*command.ReplaceProcessCommand)
+	assert.Equal(t, "worker-1", replace.ProcessID)
+	assert.Contains(t, replace.Reason, "phase timeout")
+	assert.Equal(t, repository.CoordinatorID, cmds[1].(*command.SendToProcessCommand).ProcessID)
+
+	// The escalation is exhausted
+	*now = now.Add(10 * time.Hour)
+	assert.Empty(t, w.Check())
+	assert.Empty(t, submitter.take())
+
+	published := publisher.Events()
+	require.Len(t, published, 3)
+	for i, action := range []PhaseTimeoutAction{PhaseTimeoutNudge, PhaseTimeoutNotifyCoordinator, PhaseTimeoutReplace} {
+		assert.Equal(t, action, published[i].(PhaseTimeoutEvent).Action)
+	}
+}
+
+func TestPhaseTimeoutWatcher_PhaseChangeResetsClock(t *testing.T) {
+	w, repo, submitter, publisher, now := newPhaseTimeoutTestWatcher(t, PhaseTimeouts{
+		Phases: map[events.ProcessPhase]time.Duration{
+			events.ProcessPhaseImplementing: time.Hour,
+			events.ProcessPhaseCommitting:   time.Hour,
+		},
+	})
+	saveWorker(t, repo, "worker-1", events.ProcessPhaseImplementing, "perles-abc")
+	w.Check()
+
+	*now = now.Add(time.Hour)
+	require.Len(t, w.Check(), 1)
+	submitter.take()
+
+	// Moving on resolves the escalation and restarts the clock
+	saveWorker(t, repo, "worker-1", events.ProcessPhaseCommitting, "perles-abc")
+	*now = now.Add(30 * time.Minute)
+	taken := w.Check()
+	require.Len(t, taken, 1)
+	assert.Equal(t, PhaseTimeoutResolved, taken[0].Action)
+	assert.Equal(t, events.ProcessPhaseImplementing, taken[0].Phase)
+	assert.Empty(t, submitter.take())
+
+	*now = now.Add(59 * time.Minute)
+	assert.Empty(t, w.Check())
+	assert.Len(t, publisher.Events(), 2)
+}
+
+func TestPhaseTimeoutWatcher_WorkerOverridesAndUnwatchedPhases(t *testing.T) {
+	w, repo, submitter, _, now := newPhaseTimeoutTestWatcher(t, PhaseTimeouts{
+		Phases: map[events.ProcessPhase]time.Duration{events.ProcessPhaseImplementing: time.Hour},
+		Workers: map[string]map[events.ProcessPhase]time.Duration{
+			"worker-2": {events.ProcessPhaseImplementing: 4 * time.Hour},
+			"worker-3": {events.ProcessPhaseImplementing: 0},
+		},
+	})
+	saveWorker(t, repo, "worker-1", events.ProcessPhaseImplementing, "a")
+	saveWorker(t, repo, "worker-2", events.ProcessPhaseImplementing, "b")
+	saveWorker(t, repo, "worker-3", events.ProcessPhaseImplementing, "c")
+	saveWorker(t, repo, "worker-4", events.ProcessPhaseReviewing, "d")
+	w.Check()
+
+	*now = now.Add(2 * time.Hour)
+	taken := w.Check()
+	require.Len(t, taken, 1)
+	assert.Equal(t, "worker-1", taken[0].WorkerID)
+	submitter.take()
+
+	*now = now.Add(2 * time.Hour)
+	var workers []string
+	for _, e := range w.Check() {
+		workers = append(workers, e.WorkerID)
+	}
+	assert.ElementsMatch(t, []string{"worker-1", "worker-2"}, workers)
+}
+
+func TestPhaseTimeoutWatcher_SoloModeSkipsCoordinator(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo := repository.NewMemoryProcessRepository()
+	submitter := &recordingSubmitter{}
+	w := NewPhaseTimeoutWatcher(PhaseTimeoutWatcherConfig{
+		Timeouts:  PhaseTimeouts{Phases: map[events.ProcessPhase]time.Duration{events.ProcessPhaseReviewing: time.Minute}},
+		Processes: repo,
+		Submitter: submitter,
+		Now:       func() time.Time { return now },
+	})
+	saveWorker(t, repo, "worker-1", events.ProcessPhaseReviewing, "a")
+	w.Check()
+
+	now = now.Add(time.Minute)
+	w.Check()
+	now = now.Add(time.Minute)
+	taken := w.Check()
+	require.Len(t, taken, 1)
+	assert.Equal(t, PhaseTimeoutNotifyCoordinator, taken[0].Action)
+	assert.Len(t, submitter.take(), 1, "only the nudge; there is no coordinator to notify")
+}
+
+func TestPhaseTimeoutWatcher_StartStop(t *testing.T) {
+	repo := repository.NewMemoryProcessRepository()
+	w := NewPhaseTimeoutWatcher(PhaseTimeoutWatcherConfig{
+		Processes: repo,
+		Submitter: &recordingSubmitter{},
+		Interval:  time.Millisecond,
+	})
+	w.Stop() // before Start is a no-op
+
+	w.Start(context.Background())
+	time.Sleep(5 * time.Millisecond)
+	w.Stop()
+	w.Stop()
+}
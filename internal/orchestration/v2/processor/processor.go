@@ -7,7 +7,11 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zjrosen/perles/internal/log"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
 	"github.com/zjrosen/perles/internal/orchestration/v2/types"
@@ -64,6 +68,14 @@ func WithMiddleware(middlewares ...Middleware) Option {
 	}
 }
 
+// WithIdempotencyTTL sets how long results of commands with an idempotency
+// key are remembered. Zero or less uses DefaultIdempotencyTTL.
+func WithIdempotencyTTL(ttl time.Duration) Option {
+	return func(p *CommandProcessor) {
+		p.idempotency = NewIdempotencyStore(ttl)
+	}
+}
+
 // CommandProcessor processes commands sequentially in FIFO order.
 // This is the heart of the v2 architecture - single-threaded processing
 // eliminates most lock operations while maintaining deterministic execution.
@@ -82,6 +94,12 @@ type CommandProcessor struct {
 	// Middleware chain applied to all handlers
 	middlewares []Middleware
 
+	// Results of commands with an idempotency key, for answering retries
+	idempotency *IdempotencyStore
+
+	// Failed commands kept for inspection and retry (nil = disabled)
+	deadLetters repository.DeadLetterRepository
+
 	// Event publishing
 	eventBus *pubsub.Broker[any]
 
@@ -126,6 +144,10 @@ func NewCommandProcessor(opts ...Option) *CommandProcessor {
 		opt(p)
 	}
 
+	if p.idempotency == nil {
+		p.idempotency = NewIdempotencyStore(DefaultIdempotencyTTL)
+	}
+
 	return p
 }
 
@@ -222,6 +244,9 @@ func (p *CommandProcessor) SubmitAndWait(ctx context.Context, cmd command.Comman
 		return nil, command.ErrQueueFull
 	}
 
+	// Commands submitted from within a span (e.g. an MCP tool call) join its trace
+	joinTrace(ctx, cmd)
+
 	resultCh := make(chan *commandResponse, 1)
 	item := queueItem{
 		cmd:      cmd,
@@ -250,6 +275,22 @@ func (p *CommandProcessor) SubmitAndWait(ctx context.Context, cmd command.Comman
 	}
 }
 
+// joinTrace makes cmd a child of the span in ctx, unless cmd already carries a
+// span context (e.g. a follow-up of a traced command).
+func joinTrace(ctx context.Context, cmd command.Command) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	traced, ok := cmd.(interface {
+		SpanContext() trace.SpanContext
+		SetSpanContext(trace.SpanContext)
+	})
+	if ok && !traced.SpanContext().IsValid() {
+		traced.SetSpanContext(sc)
+	}
+}
+
 // Stop cancels the processing context and waits for shutdown.
 // Any pending commands in the queue are NOT processed.
 func (p *CommandProcessor) Stop() {
@@ -307,6 +348,7 @@ func (p *CommandProcessor) processItem(item queueItem) {
 	p.processedCount.Add(1)
 	if result != nil && !result.Success {
 		p.errorCount.Add(1)
+		p.recordDeadLetter(item.cmd, result)
 	}
 
 	// Send result if caller is waiting
@@ -322,6 +364,23 @@ func (p *CommandProcessor) processItem(item queueItem) {
 // processCommand executes the command processing pipeline.
 // Errors are wrapped in the CommandResult, not returned separately.
 func (p *CommandProcessor) processCommand(cmd command.Command) *command.CommandResult {
+	// Step 0: Answer retries of an already handled command with its original result.
+	// Commands are processed one at a time, so a retry queued while the original
+	// is still pending is only looked up after the original has completed.
+	key := idempotencyKeyOf(cmd)
+	if key != "" {
+		if original, ok := p.idempotency.Get(key); ok {
+			log.Debug(log.CatOrch, "duplicate command answered with original result",
+				"command_id", cmd.ID(),
+				"command_type", cmd.Type().String(),
+				"idempotency_key", key,
+			)
+			replayed := *original
+			replayed.Replayed = true
+			return &replayed
+		}
+	}
+
 	// Step 1: Validate the command
 	if err := cmd.Validate(); err != nil {
 		result := &command.CommandResult{
@@ -355,6 +414,11 @@ func (p *CommandProcessor) processCommand(cmd command.Command) *command.CommandR
 		return result
 	}
 
+	// Remember successful results only, so a failed command can be retried with the same key
+	if key != "" && result != nil && result.Success {
+		p.idempotency.Put(key, result)
+	}
+
 	// Step 4: Emit events from result
 	if result != nil && len(result.Events) > 0 {
 		p.emitEvents(result.Events)
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zjrosen/perles/internal/orchestration/v2/command"
 	"github.com/zjrosen/perles/internal/pubsub"
+	"go.opentelemetry.io/otel/trace"
 	"pgregory.net/rapid"
 )
 
@@ -236,6 +237,34 @@ func TestProcessor_SubmitAndWait(t *testing.T) {
 	assert.Equal(t, 123, result.Data)
 }
 
+func TestProcessor_SubmitAndWait_JoinsContextTrace(t *testing.T) {
+	p, _, cleanup := startProcessor(t)
+	defer cleanup()
+
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), parent)
+
+	cmd := newTestCommand(1)
+	_, err := p.SubmitAndWait(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, parent, cmd.SpanContext())
+
+	// A command that already carries a span context keeps it
+	own := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x03},
+		SpanID:  trace.SpanID{0x04},
+	})
+	cmd = newTestCommand(2)
+	cmd.SetSpanContext(own)
+	_, err = p.SubmitAndWait(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, own, cmd.SpanContext())
+}
+
 func TestProcessor_SubmitAndWait_Timeout(t *testing.T) {
 	p, _, cleanup := startProcessor(t)
 	defer cleanup()
@@ -0,0 +1,203 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+)
+
+// ErrCommandCanceled is the cancellation cause for commands canceled via ProgressTracker.Cancel.
+var ErrCommandCanceled = errors.New("command canceled")
+
+// ProgressPhase identifies where a long-running command is in its lifecycle.
+type ProgressPhase string
+
+const (
+	// ProgressStarted is emitted when the handler starts executing.
+	ProgressStarted ProgressPhase = "started"
+	// ProgressSucceeded is emitted when the handler finished successfully.
+	ProgressSucceeded ProgressPhase = "succeeded"
+	// ProgressFailed is emitted when the handler returned an error or a failed result.
+	ProgressFailed ProgressPhase = "failed"
+	// ProgressCanceled is emitted when the command was canceled before it finished.
+	ProgressCanceled ProgressPhase = "canceled"
+)
+
+// IsTerminal reports whether the phase ends the command's progress.
+func (p ProgressPhase) IsTerminal() bool {
+	return p != ProgressStarted
+}
+
+// CommandProgressEvent is emitted at the start and end of long-running commands
+// so the UI can show progress without blocking on the command.
+type CommandProgressEvent struct {
+	// CommandID is the unique identifier of the command.
+	CommandID string
+	// CommandType indicates the type of command.
+	CommandType command.CommandType
+	// Label is a short human-readable description (e.g., "Spawning worker").
+	Label string
+	// Phase is the lifecycle phase this event reports.
+	Phase ProgressPhase
+	// Cancelable is true if the command can be canceled via ProgressTracker.Cancel.
+	Cancelable bool
+	// StartedAt is when the handler started executing.
+	StartedAt time.Time
+	// Elapsed is the time since StartedAt (zero for ProgressStarted).
+	Elapsed time.Duration
+	// Error is set for ProgressFailed and ProgressCanceled.
+	Error error
+}
+
+// ProgressSpec describes how a command type is reported as a long-running operation.
+type ProgressSpec struct {
+	// Label is used when the command doesn't provide its own via ProgressLabel().
+	Label string
+	// Cancelable commands run with a context that ProgressTracker.Cancel can cancel.
+	// Only set this for handlers that honor context cancellation.
+	Cancelable bool
+}
+
+// DefaultProgressSpecs returns the command types reported as long-running by default.
+func DefaultProgressSpecs() map[command.CommandType]ProgressSpec {
+	return map[command.CommandType]ProgressSpec{
+		command.CmdSpawnProcess:   {Label: "Spawning process", Cancelable: true},
+		command.CmdReplaceProcess: {Label: "Replacing process", Cancelable: true},
+		command.CmdStopProcess:    {Label: "Stopping process"},
+		command.CmdApproveCommit:  {Label: "Committing approved work"},
+	}
+}
+
+// progressLabeler is implemented by commands that describe themselves more
+// precisely than their ProgressSpec label (e.g., "Spawning worker").
+type progressLabeler interface {
+	ProgressLabel() string
+}
+
+// ProgressTrackerConfig configures the progress tracker.
+type ProgressTrackerConfig struct {
+	// EventBus receives CommandProgressEvents. If nil, the middleware only tracks cancellation.
+	EventBus EventPublisher
+	// Specs selects which command types are tracked. If nil, DefaultProgressSpecs is used.
+	Specs map[command.CommandType]ProgressSpec
+}
+
+// ProgressTracker reports long-running commands as CommandProgressEvents and
+// lets callers cancel the ones that support it.
+type ProgressTracker struct {
+	eventBus EventPublisher
+	specs    map[command.CommandType]ProgressSpec
+
+	mu      sync.Mutex
+	cancels map[string]context.CancelCauseFunc // commandID -> cancel, for in-flight cancelable commands
+}
+
+// NewProgressTracker creates a new progress tracker.
+func NewProgressTracker(cfg ProgressTrackerConfig) *ProgressTracker {
+	specs := cfg.Specs
+	if specs == nil {
+		specs = DefaultProgressSpecs()
+	}
+	return &ProgressTracker{
+		eventBus: cfg.EventBus,
+		specs:    specs,
+		cancels:  make(map[string]context.CancelCauseFunc),
+	}
+}
+
+// Cancel cancels an in-flight cancelable command. Returns false if the command
+// is not running or doesn't support cancellation.
+func (t *ProgressTracker) Cancel(commandID string) bool {
+	t.mu.Lock()
+	cancel, ok := t.cancels[commandID]
+	delete(t.cancels, commandID)
+	t.mu.Unlock()
+
+	if ok {
+		cancel(ErrCommandCanceled)
+	}
+	return ok
+}
+
+// InFlight returns the number of cancelable commands currently running.
+// This is primarily for testing.
+func (t *ProgressTracker) InFlight() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.cancels)
+}
+
+// Middleware returns the middleware function.
+//
+// Cancelable commands get a child context that Cancel cancels and that is
+// released when the command finishes. Processes spawned by the command must
+// not inherit it, since they outlive the command.
+func (t *ProgressTracker) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			spec, ok := t.specs[cmd.Type()]
+			if !ok {
+				return next.Handle(ctx, cmd)
+			}
+
+			label := spec.Label
+			if l, ok := cmd.(progressLabeler); ok && l.ProgressLabel() != "" {
+				label = l.ProgressLabel()
+			}
+
+			if spec.Cancelable {
+				var cancel context.CancelCauseFunc
+				ctx, cancel = context.WithCancelCause(ctx)
+				defer cancel(nil)
+				t.mu.Lock()
+				t.cancels[cmd.ID()] = cancel
+				t.mu.Unlock()
+			}
+
+			event := CommandProgressEvent{
+				CommandID:   cmd.ID(),
+				CommandType: cmd.Type(),
+				Label:       label,
+				Phase:       ProgressStarted,
+				Cancelable:  spec.Cancelable,
+				StartedAt:   time.Now(),
+			}
+			t.publish(event)
+
+			result, err := next.Handle(ctx, cmd)
+
+			if spec.Cancelable {
+				t.mu.Lock()
+				delete(t.cancels, cmd.ID())
+				t.mu.Unlock()
+			}
+
+			event.Elapsed = time.Since(event.StartedAt)
+			switch {
+			case errors.Is(context.Cause(ctx), ErrCommandCanceled):
+				event.Phase = ProgressCanceled
+				event.Error = ErrCommandCanceled
+			case err != nil:
+				event.Phase = ProgressFailed
+				event.Error = err
+			case result != nil && !result.Success:
+				event.Phase = ProgressFailed
+				event.Error = result.Error
+			default:
+				event.Phase = ProgressSucceeded
+			}
+			t.publish(event)
+
+			return result, err
+		})
+	}
+}
+
+func (t *ProgressTracker) publish(event CommandProgressEvent) {
+	if t.eventBus != nil {
+		t.eventBus.Publish("updated", event)
+	}
+}
@@ -0,0 +1,131 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+func progressEvents(t *testing.T, publisher *mockEventPublisher) []CommandProgressEvent {
+	t.Helper()
+	var out []CommandProgressEvent
+	for _, e := range publisher.Events() {
+		if pe, ok := e.(CommandProgressEvent); ok {
+			out = append(out, pe)
+		}
+	}
+	return out
+}
+
+func TestProgressTracker_IgnoresUntrackedCommands(t *testing.T) {
+	publisher := newMockEventPublisher()
+	tracker := NewProgressTracker(ProgressTrackerConfig{EventBus: publisher})
+
+	_, err := tracker.Middleware()(successHandler()).Handle(context.Background(), newTestCommand(1))
+	require.NoError(t, err)
+	require.Empty(t, publisher.Events())
+}
+
+func TestProgressTracker_EmitsStartedAndSucceeded(t *testing.T) {
+	publisher := newMockEventPublisher()
+	tracker := NewProgressTracker(ProgressTrackerConfig{EventBus: publisher})
+
+	cmd := command.NewSpawnProcessCommand(command.SourceUser, repository.RoleWorker)
+	_, err := tracker.Middleware()(successHandler()).Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	events := progressEvents(t, publisher)
+	require.Len(t, events, 2)
+	require.Equal(t, ProgressStarted, events[0].Phase)
+	require.Equal(t, "Spawning worker", events[0].Label)
+	require.True(t, events[0].Cancelable)
+	require.Equal(t, cmd.ID(), events[0].CommandID)
+	require.False(t, events[0].Phase.IsTerminal())
+
+	require.Equal(t, ProgressSucceeded, events[1].Phase)
+	require.True(t, events[1].Phase.IsTerminal())
+	require.Equal(t, events[0].StartedAt, events[1].StartedAt)
+	require.Nil(t, events[1].Error)
+	require.Zero(t, tracker.InFlight())
+}
+
+func TestProgressTracker_ReportsFailures(t *testing.T) {
+	publisher := newMockEventPublisher()
+	tracker := NewProgressTracker(ProgressTrackerConfig{
+		EventBus: publisher,
+		Specs:    map[command.CommandType]ProgressSpec{"test_command": {Label: "Testing"}},
+	})
+
+	_, err := tracker.Middleware()(errorHandler("boom")).Handle(context.Background(), newTestCommand(1))
+	require.Error(t, err)
+
+	failedResult := HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		return &command.CommandResult{Success: false, Error: errors.New("bad result")}, nil
+	})
+	_, err = tracker.Middleware()(failedResult).Handle(context.Background(), newTestCommand(2))
+	require.NoError(t, err)
+
+	events := progressEvents(t, publisher)
+	require.Len(t, events, 4)
+	require.Equal(t, "Testing", events[0].Label)
+	require.False(t, events[0].Cancelable)
+	require.Equal(t, ProgressFailed, events[1].Phase)
+	require.EqualError(t, events[1].Error, "boom")
+	require.Equal(t, ProgressFailed, events[3].Phase)
+	require.EqualError(t, events[3].Error, "bad result")
+}
+
+func TestProgressTracker_Cancel(t *testing.T) {
+	publisher := newMockEventPublisher()
+	tracker := NewProgressTracker(ProgressTrackerConfig{EventBus: publisher})
+
+	started := make(chan struct{})
+	blocking := HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	cmd := command.NewReplaceProcessCommand(command.SourceUser, "worker-2", "")
+	done := make(chan error, 1)
+	go func() {
+		_, err := tracker.Middleware()(blocking).Handle(context.Background(), cmd)
+		done <- err
+	}()
+
+	<-started
+	require.Equal(t, 1, tracker.InFlight())
+	require.False(t, tracker.Cancel("unknown"))
+	require.True(t, tracker.Cancel(cmd.ID()))
+	require.ErrorIs(t, <-done, context.Canceled)
+	require.False(t, tracker.Cancel(cmd.ID()), "already canceled")
+
+	events := progressEvents(t, publisher)
+	require.Len(t, events, 2)
+	require.Equal(t, "Replacing worker-2", events[0].Label)
+	require.Equal(t, ProgressCanceled, events[1].Phase)
+	require.ErrorIs(t, events[1].Error, ErrCommandCanceled)
+	require.Zero(t, tracker.InFlight())
+}
+
+func TestProgressTracker_SucceededCommandReleasesContext(t *testing.T) {
+	tracker := NewProgressTracker(ProgressTrackerConfig{})
+
+	var handlerCtx context.Context
+	capture := HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		handlerCtx = ctx
+		return &command.CommandResult{Success: true}, nil
+	})
+
+	cmd := command.NewSpawnProcessCommand(command.SourceUser, repository.RoleWorker)
+	_, err := tracker.Middleware()(capture).Handle(context.Background(), cmd)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, handlerCtx.Err(), context.Canceled, "the child context is released")
+	require.NotErrorIs(t, context.Cause(handlerCtx), ErrCommandCanceled)
+	require.False(t, tracker.Cancel(cmd.ID()))
+}
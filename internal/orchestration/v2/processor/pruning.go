@@ -0,0 +1,223 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ThreadMessage is a message of a task's Fabric thread.
+type ThreadMessage struct {
+	ID        string
+	CreatedBy string
+	CreatedAt time.Time
+	// Excerpt is the start of the message content, for the worker to recognize it.
+	Excerpt string
+}
+
+// ThreadGraph reads task threads. Implemented in v2 on top of Fabric's
+// dependency graph (a thread's replies) and ack graph (what an agent has read).
+type ThreadGraph interface {
+	// ReadMessages returns the root message of threadID and its replies that
+	// agentID has acknowledged or authored, oldest first.
+	ReadMessages(threadID, agentID string) ([]ThreadMessage, error)
+}
+
+// PruningHinterConfig configures the pruning hinter.
+type PruningHinterConfig struct {
+	// Threads reads task threads.
+	// Required.
+	Threads ThreadGraph
+	// Tasks provides the task a worker transitions on.
+	// Required.
+	Tasks repository.TaskRepository
+}
+
+// PruningHinter tells workers which Fabric messages they can drop from their
+// working context when they change phase, so long-running workers stay lean.
+//
+// Hints list the messages a worker has already read that the transition settled:
+//   - assign_review_feedback: the discussion before the review that denied the task
+//     (earlier feedback rounds were addressed by the re-submission)
+//   - approve_commit: the whole review discussion of the task
+//   - assign_task and assign_review: the threads of the worker's completed tasks
+//
+// Each message is hinted once per worker. Hints are sent as follow-up messages,
+// so they queue behind the prompt of the transition.
+type PruningHinter struct {
+	threads ThreadGraph
+	tasks   repository.TaskRepository
+
+	mu       sync.Mutex
+	finished map[string][]finishedTask  // workerID -> completed tasks not hinted yet
+	hinted   map[string]map[string]bool // workerID -> message ID -> already hinted
+}
+
+// finishedTask is a completed task whose thread a worker took part in.
+type finishedTask struct {
+	taskID   string
+	threadID string
+}
+
+// NewPruningHinter creates a pruning hinter.
+func NewPruningHinter(cfg PruningHinterConfig) *PruningHinter {
+	return &PruningHinter{
+		threads:  cfg.Threads,
+		tasks:    cfg.Tasks,
+		finished: make(map[string][]finishedTask),
+		hinted:   make(map[string]map[string]bool),
+	}
+}
+
+// Middleware returns the middleware function. It acts on successful phase
+// transitions of workers and records completed tasks before they are deleted.
+func (p *PruningHinter) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			// The task is deleted on completion, so capture it first
+			var completed *repository.TaskAssignment
+			if c, ok := cmd.(*command.MarkTaskCompleteCommand); ok {
+				completed, _ = p.tasks.Get(c.TaskID)
+			}
+
+			result, err := next.Handle(ctx, cmd)
+			if err != nil || result == nil || !result.Success {
+				return result, err
+			}
+
+			var hint command.Command
+			switch c := cmd.(type) {
+			case *command.MarkTaskCompleteCommand:
+				p.recordCompleted(completed)
+			case *command.AssignReviewFeedbackCommand:
+				hint = p.feedbackHint(c.ImplementerID, c.TaskID)
+			case *command.ApproveCommitCommand:
+				hint = p.approvedHint(c.ImplementerID, c.TaskID)
+			case *command.AssignTaskCommand:
+				hint = p.finishedHint(c.WorkerID)
+			case *command.AssignReviewCommand:
+				hint = p.finishedHint(c.ReviewerID)
+			}
+			if hint != nil {
+				result.FollowUp = append(result.FollowUp, hint)
+			}
+			return result, err
+		})
+	}
+}
+
+// recordCompleted remembers a completed task for its implementer and reviewer.
+func (p *PruningHinter) recordCompleted(task *repository.TaskAssignment) {
+	if task == nil || task.ThreadID == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, workerID := range []string{task.Implementer, task.Reviewer} {
+		if workerID != "" {
+			p.finished[workerID] = append(p.finished[workerID], finishedTask{taskID: task.TaskID, threadID: task.ThreadID})
+		}
+	}
+}
+
+// feedbackHint hints the discussion that preceded the review which denied the task.
+func (p *PruningHinter) feedbackHint(workerID, taskID string) command.Command {
+	task, err := p.tasks.Get(taskID)
+	if err != nil || task.ThreadID == "" || task.ReviewStartedAt.IsZero() {
+		return nil
+	}
+
+	msgs := p.settled(workerID, task.ThreadID, func(m ThreadMessage) bool {
+		return m.ID != task.ThreadID && m.CreatedAt.Before(task.ReviewStartedAt)
+	})
+	return p.hint(workerID, fmt.Sprintf("Earlier review feedback on %s has been addressed; only the new feedback is open.", taskID), msgs)
+}
+
+// approvedHint hints the review discussion of an approved task.
+func (p *PruningHinter) approvedHint(workerID, taskID string) command.Command {
+	task, err := p.tasks.Get(taskID)
+	if err != nil || task.ThreadID == "" {
+		return nil
+	}
+
+	msgs := p.settled(workerID, task.ThreadID, func(m ThreadMessage) bool {
+		return m.ID != task.ThreadID
+	})
+	return p.hint(workerID, fmt.Sprintf("Review of %s passed; all review feedback has been addressed.", taskID), msgs)
+}
+
+// finishedHint hints the threads of the worker's completed tasks, root included.
+func (p *PruningHinter) finishedHint(workerID string) command.Command {
+	p.mu.Lock()
+	finished := p.finished[workerID]
+	delete(p.finished, workerID)
+	p.mu.Unlock()
+	if len(finished) == 0 {
+		return nil
+	}
+
+	var msgs []ThreadMessage
+	taskIDs := make([]string, 0, len(finished))
+	for _, task := range finished {
+		taskIDs = append(taskIDs, task.taskID)
+		msgs = append(msgs, p.settled(workerID, task.threadID, func(ThreadMessage) bool { return true })...)
+	}
+	return p.hint(workerID, fmt.Sprintf("Your earlier work on %s is complete.", strings.Join(taskIDs, ", ")), msgs)
+}
+
+// settled returns the messages of threadID read by workerID that match keep
+// and were not hinted to the worker before.
+func (p *PruningHinter) settled(workerID, threadID string, keep func(ThreadMessage) bool) []ThreadMessage {
+	read, err := p.threads.ReadMessages(threadID, workerID)
+	if err != nil {
+		log.Debug(log.CatOrch, "failed to read task thread for pruning hints", "threadID", threadID, "workerID", workerID, "error", err)
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var msgs []ThreadMessage
+	for _, m := range read {
+		if keep(m) && !p.hinted[workerID][m.ID] {
+			msgs = append(msgs, m)
+		}
+	}
+	return msgs
+}
+
+// hint marks msgs as hinted and returns the message telling workerID to drop them.
+// Returns nil if there is nothing to prune.
+func (p *PruningHinter) hint(workerID, reason string, msgs []ThreadMessage) command.Command {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	if p.hinted[workerID] == nil {
+		p.hinted[workerID] = make(map[string]bool)
+	}
+	for _, m := range msgs {
+		p.hinted[workerID][m.ID] = true
+	}
+	p.mu.Unlock()
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[CONTEXT HINT] %s\n\n", reason)
+	sb.WriteString("These Fabric messages are settled; you may ignore them and drop them from your working context:\n")
+	for _, m := range msgs {
+		author := m.CreatedBy
+		if author == workerID {
+			author = "you"
+		}
+		fmt.Fprintf(&sb, "- %s (%s): %q\n", m.ID, author, m.Excerpt)
+	}
+	sb.WriteString("\nNo reply is needed. Use fabric_read_thread if you need one of them again.")
+	return command.NewSendToProcessCommand(command.SourceInternal, workerID, sb.String())
+}
@@ -0,0 +1,111 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// fakeThreadGraph returns the same read messages for every agent.
+type fakeThreadGraph struct {
+	threads map[string][]ThreadMessage // threadID -> read messages
+}
+
+func (g *fakeThreadGraph) ReadMessages(threadID, _ string) ([]ThreadMessage, error) {
+	return g.threads[threadID], nil
+}
+
+// requireHint asserts that result carries one hint to workerID listing ids, or no hint if ids is empty.
+func requireHint(t *testing.T, result *command.CommandResult, workerID string, ids ...string) {
+	t.Helper()
+	var hints []*command.SendToProcessCommand
+	for _, cmd := range result.FollowUp {
+		if c, ok := cmd.(*command.SendToProcessCommand); ok {
+			hints = append(hints, c)
+		}
+	}
+	if len(ids) == 0 {
+		require.Empty(t, hints)
+		return
+	}
+	require.Len(t, hints, 1)
+	require.Equal(t, workerID, hints[0].ProcessID)
+	require.Contains(t, hints[0].Content, "[CONTEXT HINT]")
+	for _, id := range ids {
+		require.Contains(t, hints[0].Content, "- "+id+" ")
+	}
+}
+
+func TestPruningHinter_ReviewCycle(t *testing.T) {
+	reviewStart := time.Now()
+	graph := &fakeThreadGraph{threads: map[string][]ThreadMessage{
+		"thread-1": {
+			{ID: "thread-1", CreatedBy: "system", CreatedAt: reviewStart.Add(-3 * time.Hour), Excerpt: "Task: Add login"},
+			{ID: "msg-1", CreatedBy: "worker-2", CreatedAt: reviewStart.Add(-2 * time.Hour), Excerpt: "Missing tests"},
+			{ID: "msg-2", CreatedBy: "worker-1", CreatedAt: reviewStart.Add(-time.Hour), Excerpt: "Tests added"},
+			{ID: "msg-3", CreatedBy: "worker-2", CreatedAt: reviewStart.Add(time.Minute), Excerpt: "Handle empty password"},
+		},
+	}}
+	tasks := repository.NewMemoryTaskRepository()
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{
+		TaskID: "perles-abc", Implementer: "worker-1", Reviewer: "worker-2", ThreadID: "thread-1", ReviewStartedAt: reviewStart,
+	}))
+	handler := NewPruningHinter(PruningHinterConfig{Threads: graph, Tasks: tasks}).Middleware()(successHandler())
+	ctx := context.Background()
+
+	// Denied again: the previous round is settled, the current review's feedback is not
+	result, err := handler.Handle(ctx, command.NewAssignReviewFeedbackCommand(command.SourceMCPTool, "worker-1", "perles-abc", "Handle empty password"))
+	require.NoError(t, err)
+	requireHint(t, result, "worker-1", "msg-1", "msg-2")
+	require.NotContains(t, result.FollowUp[0].(*command.SendToProcessCommand).Content, "msg-3")
+	require.Contains(t, result.FollowUp[0].(*command.SendToProcessCommand).Content, `msg-2 (you): "Tests added"`)
+
+	// Approved: the rest of the review discussion is settled, the task root is kept
+	result, err = handler.Handle(ctx, command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc"))
+	require.NoError(t, err)
+	requireHint(t, result, "worker-1", "msg-3")
+	require.NotContains(t, result.FollowUp[0].(*command.SendToProcessCommand).Content, "msg-1")
+	require.NotContains(t, result.FollowUp[0].(*command.SendToProcessCommand).Content, "thread-1")
+
+	// Nothing new to prune
+	result, err = handler.Handle(ctx, command.NewApproveCommitCommand(command.SourceMCPTool, "worker-1", "perles-abc"))
+	require.NoError(t, err)
+	requireHint(t, result, "worker-1")
+}
+
+func TestPruningHinter_CompletedTaskHintedOnNextAssignment(t *testing.T) {
+	graph := &fakeThreadGraph{threads: map[string][]ThreadMessage{
+		"thread-1": {{ID: "thread-1", CreatedBy: "system", Excerpt: "Task: Add login"}},
+	}}
+	tasks := repository.NewMemoryTaskRepository()
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc", Implementer: "worker-1", Reviewer: "worker-2", ThreadID: "thread-1"}))
+	// The completion handler deletes the task
+	complete := HandlerFunc(func(context.Context, command.Command) (*command.CommandResult, error) {
+		require.NoError(t, tasks.Delete("perles-abc"))
+		return &command.CommandResult{Success: true}, nil
+	})
+	hinter := NewPruningHinter(PruningHinterConfig{Threads: graph, Tasks: tasks})
+	ctx := context.Background()
+
+	_, err := hinter.Middleware()(complete).Handle(ctx, command.NewMarkTaskCompleteCommand(command.SourceMCPTool, "perles-abc"))
+	require.NoError(t, err)
+
+	handler := hinter.Middleware()(successHandler())
+	result, err := handler.Handle(ctx, command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-def", "", ""))
+	require.NoError(t, err)
+	requireHint(t, result, "worker-1", "thread-1")
+	require.Contains(t, result.FollowUp[0].(*command.SendToProcessCommand).Content, "perles-abc is complete")
+
+	result, err = handler.Handle(ctx, command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-def", "worker-1", command.ReviewTypeComplex))
+	require.NoError(t, err)
+	requireHint(t, result, "worker-2", "thread-1")
+
+	// Failed transitions send no hints
+	result, err = hinter.Middleware()(errorHandler("busy")).Handle(ctx, command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-ghi", "", ""))
+	require.Error(t, err)
+	require.Nil(t, result)
+}
@@ -0,0 +1,355 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ReadyTask is a bd task with no open blockers.
+type ReadyTask struct {
+	ID    string
+	Title string
+}
+
+// ReadyTaskSource lists the bd tasks ready to be worked on, in priority order.
+// Implemented in v2 on top of bd ready.
+type ReadyTaskSource interface {
+	ReadyTasks() ([]ReadyTask, error)
+}
+
+// TaskThreadStarter opens the Fabric #tasks thread for a task assignment and
+// returns its ID. Implemented in v2 on top of Fabric.
+type TaskThreadStarter interface {
+	StartTaskThread(task ReadyTask, workerID string) (string, error)
+}
+
+// SoloDispatcherConfig configures the solo dispatcher.
+type SoloDispatcherConfig struct {
+	// Ready lists the bd tasks that can be assigned.
+	// Required.
+	Ready ReadyTaskSource
+	// Processes provides the worker pool state.
+	// Required.
+	Processes repository.ProcessRepository
+	// Tasks provides the in-flight task assignments.
+	// Required.
+	Tasks repository.TaskRepository
+	// Threads opens a Fabric thread per assignment.
+	// Optional - if nil, tasks are assigned without a thread.
+	Threads TaskThreadStarter
+	// EscalateToCoordinator sends escalations to the coordinator as well as the user.
+	// Only set when a coordinator is running alongside the dispatcher.
+	EscalateToCoordinator bool
+}
+
+// SoloDispatcher runs a workflow without a coordinator agent.
+//
+// It assigns ready bd tasks to idle workers round-robin, assigns an idle worker
+// to review each implementation, approves the commit of approved tasks and marks
+// them complete once the implementer's commit turn ends. Review denials and
+// worker failures are escalated to the user (and optionally the coordinator)
+// instead of being handled automatically. When no work is left the workflow is
+// signalled complete.
+//
+// All decisions are returned as follow-up commands, so they go through the
+// same handlers and validation as coordinator tool calls.
+type SoloDispatcher struct {
+	ready         ReadyTaskSource
+	processes     repository.ProcessRepository
+	tasks         repository.TaskRepository
+	threads       TaskThreadStarter
+	toCoordinator bool
+
+	mu             sync.Mutex
+	claimedWorkers map[string]bool // workerID -> assignment submitted, not yet handled
+	claimedTasks   map[string]bool // taskID -> assignment submitted, not yet handled
+	lastWorker     string          // most recently assigned worker, for round-robin
+	completed      int             // tasks marked complete
+	finished       bool            // workflow complete signalled
+}
+
+// NewSoloDispatcher creates a solo dispatcher.
+func NewSoloDispatcher(cfg SoloDispatcherConfig) *SoloDispatcher {
+	return &SoloDispatcher{
+		ready:          cfg.Ready,
+		processes:      cfg.Processes,
+		tasks:          cfg.Tasks,
+		threads:        cfg.Threads,
+		toCoordinator:  cfg.EscalateToCoordinator,
+		claimedWorkers: make(map[string]bool),
+		claimedTasks:   make(map[string]bool),
+	}
+}
+
+// Middleware returns the middleware function. It reacts to the commands that
+// move a task through its lifecycle and appends the next steps as follow-ups.
+func (d *SoloDispatcher) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			// The verdict handler clears the reviewer on denial, so look up the
+			// reviewed task before it runs
+			var reviewed *repository.TaskAssignment
+			if c, ok := cmd.(*command.ReportVerdictCommand); ok {
+				reviewed = d.reviewedTask(c.WorkerID)
+			}
+
+			result, err := next.Handle(ctx, cmd)
+
+			// Release claims whatever the outcome, so a failed assignment is retried
+			switch c := cmd.(type) {
+			case *command.AssignTaskCommand:
+				d.release(c.WorkerID, c.TaskID)
+			case *command.AssignReviewCommand:
+				d.release(c.ReviewerID, c.TaskID)
+			}
+
+			if err != nil || result == nil || !result.Success {
+				return result, err
+			}
+
+			var followUps []command.Command
+			switch c := cmd.(type) {
+			case *command.ReportVerdictCommand:
+				followUps = d.onVerdict(c, reviewed)
+			case *command.ProcessTurnCompleteCommand:
+				followUps = d.onTurnComplete(c.ProcessID)
+			case *command.MarkTaskCompleteCommand:
+				d.mu.Lock()
+				d.completed++
+				d.mu.Unlock()
+			}
+			switch cmd.(type) {
+			case *command.ReportCompleteCommand, *command.ReportVerdictCommand, *command.ProcessTurnCompleteCommand,
+				*command.TransitionPhaseCommand, *command.AssignTaskCommand, *command.AssignReviewCommand:
+				followUps = append(followUps, d.Dispatch()...)
+			}
+
+			result.FollowUp = append(result.FollowUp, followUps...)
+			return result, err
+		})
+	}
+}
+
+// onVerdict approves the commit of an approved task and escalates a denied one.
+func (d *SoloDispatcher) onVerdict(c *command.ReportVerdictCommand, task *repository.TaskAssignment) []command.Command {
+	if task == nil {
+		return nil
+	}
+	if c.Verdict == command.VerdictApproved {
+		return []command.Command{command.NewApproveCommitCommand(command.SourceInternal, task.Implementer, task.TaskID)}
+	}
+
+	reason := fmt.Sprintf("Review of %s (implemented by %s) was denied by %s", task.TaskID, task.Implementer, c.WorkerID)
+	if c.Comments != "" {
+		reason += ": " + c.Comments
+	}
+	return d.escalate(task.TaskID, reason)
+}
+
+// reviewedTask returns a copy of the task the reviewer is giving a verdict on,
+// or nil if the worker is not reviewing one.
+func (d *SoloDispatcher) reviewedTask(reviewerID string) *repository.TaskAssignment {
+	task, err := d.tasks.GetByWorker(reviewerID)
+	if err != nil || task.Reviewer != reviewerID {
+		return nil
+	}
+	reviewed := *task
+	return &reviewed
+}
+
+// onTurnComplete completes committed tasks and escalates failed workers.
+func (d *SoloDispatcher) onTurnComplete(processID string) []command.Command {
+	proc, err := d.processes.Get(processID)
+	if err != nil || !proc.IsWorker() {
+		return nil
+	}
+
+	if proc.Status == repository.StatusFailed {
+		task, err := d.tasks.GetByWorker(proc.ID)
+		if err != nil {
+			return d.escalate("", fmt.Sprintf("%s failed", proc.ID))
+		}
+		reason := fmt.Sprintf("%s failed while working on %s", proc.ID, task.TaskID)
+		if task.Implementer != proc.ID {
+			// A failed reviewer leaves the implementation intact
+			return d.escalate(task.TaskID, reason)
+		}
+		return append(d.escalate(task.TaskID, reason),
+			command.NewMarkTaskFailedCommand(command.SourceInternal, task.TaskID, reason))
+	}
+
+	if proc.Status != repository.StatusReady {
+		return nil
+	}
+	tasks, err := d.tasks.GetByImplementer(proc.ID)
+	if err != nil {
+		return nil
+	}
+	var followUps []command.Command
+	for _, task := range tasks {
+		if task.Status != repository.TaskCommitting {
+			continue
+		}
+		followUps = append(followUps,
+			command.NewMarkTaskCompleteCommand(command.SourceInternal, task.TaskID),
+			command.NewTransitionPhaseCommand(command.SourceInternal, proc.ID, events.ProcessPhaseIdle),
+		)
+	}
+	return followUps
+}
+
+// escalate notifies the user, and the coordinator when configured.
+func (d *SoloDispatcher) escalate(taskID, reason string) []command.Command {
+	log.Info(log.CatOrch, "Solo mode escalation", "taskID", taskID, "reason", reason)
+	followUps := []command.Command{
+		command.NewNotifyUserCommand(command.SourceInternal, reason, "solo_escalation", taskID),
+	}
+	if d.toCoordinator {
+		followUps = append(followUps, command.NewSendToProcessCommand(command.SourceInternal, repository.CoordinatorID,
+			"[SOLO MODE ESCALATION] "+reason+". Decide how to proceed; the dispatcher will not act on this task."))
+	}
+	return followUps
+}
+
+// Dispatch assigns idle workers: first as reviewers of implementations awaiting
+// review, then to ready bd tasks. Returns the assignment commands.
+func (d *SoloDispatcher) Dispatch() []command.Command {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	idle := d.idleWorkers()
+	var cmds []command.Command
+
+	// Reviews first - they unblock work that is already done
+	inFlight := d.tasks.All()
+	sort.Slice(inFlight, func(i, j int) bool { return inFlight[i].TaskID < inFlight[j].TaskID })
+	for _, task := range inFlight {
+		if task.Status != repository.TaskInReview || task.Reviewer != "" || d.claimedTasks[task.TaskID] {
+			continue
+		}
+		reviewer := d.nextWorker(idle, task.Implementer)
+		if reviewer == "" {
+			break
+		}
+		idle = without(idle, reviewer)
+		d.claim(reviewer, task.TaskID)
+		cmds = append(cmds, command.NewAssignReviewCommand(command.SourceInternal, reviewer, task.TaskID, task.Implementer, command.ReviewTypeComplex))
+	}
+
+	assigned := make(map[string]bool, len(inFlight))
+	for _, task := range inFlight {
+		assigned[task.TaskID] = true
+	}
+
+	var ready []ReadyTask
+	if len(idle) > 0 || len(inFlight) == 0 {
+		var err error
+		ready, err = d.ready.ReadyTasks()
+		if err != nil {
+			log.Debug(log.CatOrch, "Solo mode failed to list ready tasks", "error", err)
+			return cmds
+		}
+	}
+
+	pending := 0
+	for _, task := range ready {
+		if assigned[task.ID] || d.claimedTasks[task.ID] {
+			continue
+		}
+		pending++
+		worker := d.nextWorker(idle, "")
+		if worker == "" {
+			continue
+		}
+		idle = without(idle, worker)
+		d.claim(worker, task.ID)
+		cmds = append(cmds, d.assignTask(task, worker))
+	}
+
+	if pending == 0 && len(inFlight) == 0 && len(d.claimedTasks) == 0 && !d.finished && d.completed > 0 {
+		d.finished = true
+		summary := fmt.Sprintf("Solo mode completed %d tasks; no ready tasks remain", d.completed)
+		cmds = append(cmds, command.NewSignalWorkflowCompleteCommand(command.SourceInternal, command.WorkflowStatusSuccess, summary, "", d.completed))
+	}
+	return cmds
+}
+
+// assignTask builds the assignment command for task, opening its thread first.
+func (d *SoloDispatcher) assignTask(task ReadyTask, workerID string) command.Command {
+	var threadID string
+	if d.threads != nil {
+		id, err := d.threads.StartTaskThread(task, workerID)
+		if err != nil {
+			log.Debug(log.CatOrch, "Solo mode failed to open task thread", "taskID", task.ID, "error", err)
+		}
+		threadID = id
+	}
+	return command.NewAssignTaskCommand(command.SourceInternal, workerID, task.ID, task.Title, threadID)
+}
+
+// idleWorkers returns the unclaimed workers ready for an assignment, sorted by ID.
+// Workers that have not finished their startup turn are skipped. Must hold d.mu.
+func (d *SoloDispatcher) idleWorkers() []string {
+	var ids []string
+	for _, w := range d.processes.ReadyWorkers() {
+		if w.TaskID == "" && w.HasCompletedTurn && !d.claimedWorkers[w.ID] {
+			ids = append(ids, w.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// nextWorker picks the idle worker after the last assigned one (round-robin),
+// skipping exclude. Returns "" if none is available. Must hold d.mu.
+func (d *SoloDispatcher) nextWorker(idle []string, exclude string) string {
+	var first string
+	for _, id := range idle {
+		if id == exclude {
+			continue
+		}
+		if first == "" {
+			first = id
+		}
+		if id > d.lastWorker {
+			d.lastWorker = id
+			return id
+		}
+	}
+	if first != "" {
+		d.lastWorker = first
+	}
+	return first
+}
+
+// claim marks a worker and task as having an assignment in flight. Must hold d.mu.
+func (d *SoloDispatcher) claim(workerID, taskID string) {
+	d.claimedWorkers[workerID] = true
+	d.claimedTasks[taskID] = true
+}
+
+// release clears the claims of a handled assignment.
+func (d *SoloDispatcher) release(workerID, taskID string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.claimedWorkers, workerID)
+	delete(d.claimedTasks, taskID)
+}
+
+// without returns ids without id.
+func without(ids []string, id string) []string {
+	out := make([]string, 0, len(ids))
+	for _, other := range ids {
+		if other != id {
+			out = append(out, other)
+		}
+	}
+	return out
+}
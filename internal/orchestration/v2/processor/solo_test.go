@@ -0,0 +1,183 @@
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/events"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+type fakeReadyTasks struct {
+	tasks []ReadyTask
+}
+
+func (s *fakeReadyTasks) ReadyTasks() ([]ReadyTask, error) {
+	return s.tasks, nil
+}
+
+type recordingThreadStarter struct {
+	started []string // "taskID workerID"
+}
+
+func (s *recordingThreadStarter) StartTaskThread(task ReadyTask, workerID string) (string, error) {
+	s.started = append(s.started, task.ID+" "+workerID)
+	return "thread-" + task.ID, nil
+}
+
+func newSoloFixture(t *testing.T, workers ...string) (*SoloDispatcher, *fakeReadyTasks, *repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+	ready := &fakeReadyTasks{}
+	processes := repository.NewMemoryProcessRepository()
+	tasks := repository.NewMemoryTaskRepository()
+	idle := events.ProcessPhaseIdle
+	for _, id := range workers {
+		require.NoError(t, processes.Save(&repository.Process{
+			ID: id, Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &idle, HasCompletedTurn: true,
+		}))
+	}
+	d := NewSoloDispatcher(SoloDispatcherConfig{Ready: ready, Processes: processes, Tasks: tasks, Threads: &recordingThreadStarter{}})
+	return d, ready, processes, tasks
+}
+
+func TestSoloDispatcher_AssignsReadyTasksRoundRobin(t *testing.T) {
+	d, ready, _, tasks := newSoloFixture(t, "worker-2", "worker-1", "worker-3")
+	ready.tasks = []ReadyTask{{ID: "perles-a", Title: "A"}, {ID: "perles-b", Title: "B"}, {ID: "perles-c", Title: "C"}, {ID: "perles-d", Title: "D"}}
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-c", Implementer: "worker-9", Status: repository.TaskImplementing}))
+
+	cmds := d.Dispatch()
+	require.Len(t, cmds, 3)
+	want := [][2]string{{"worker-1", "perles-a"}, {"worker-2", "perles-b"}, {"worker-3", "perles-d"}}
+	for i, cmd := range cmds {
+		assign := cmd.(*command.AssignTaskCommand)
+		require.Equal(t, want[i][0], assign.WorkerID)
+		require.Equal(t, want[i][1], assign.TaskID)
+		require.Equal(t, "thread-"+assign.TaskID, assign.ThreadID)
+	}
+
+	// Claimed workers and tasks are not assigned again until the assignment is handled
+	require.Empty(t, d.Dispatch())
+}
+
+func TestSoloDispatcher_ReleasesFailedAssignments(t *testing.T) {
+	d, ready, _, _ := newSoloFixture(t, "worker-1")
+	ready.tasks = []ReadyTask{{ID: "perles-a"}}
+	handler := d.Middleware()(errorHandler("worker busy"))
+
+	cmds := d.Dispatch()
+	require.Len(t, cmds, 1)
+	_, err := handler.Handle(context.Background(), cmds[0])
+	require.Error(t, err)
+
+	cmds = d.Dispatch()
+	require.Len(t, cmds, 1, "failed assignment is retried")
+	require.Equal(t, "worker-1", cmds[0].(*command.AssignTaskCommand).WorkerID)
+}
+
+func TestSoloDispatcher_AssignsReviewerOtherThanImplementer(t *testing.T) {
+	d, _, _, tasks := newSoloFixture(t, "worker-1", "worker-2")
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Status: repository.TaskInReview}))
+
+	cmds := d.Dispatch()
+	require.Len(t, cmds, 1)
+	review := cmds[0].(*command.AssignReviewCommand)
+	require.Equal(t, "worker-2", review.ReviewerID)
+	require.Equal(t, "worker-1", review.ImplementerID)
+}
+
+func TestSoloDispatcher_ApprovedVerdictApprovesCommit(t *testing.T) {
+	d, _, _, tasks := newSoloFixture(t)
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Reviewer: "worker-2", Status: repository.TaskApproved}))
+	handler := d.Middleware()(successHandler())
+
+	result, err := handler.Handle(context.Background(), command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictApproved, "LGTM"))
+	require.NoError(t, err)
+	require.Len(t, result.FollowUp, 1)
+	approve := result.FollowUp[0].(*command.ApproveCommitCommand)
+	require.Equal(t, "worker-1", approve.ImplementerID)
+	require.Equal(t, "perles-a", approve.TaskID)
+}
+
+// denyingHandler mimics the verdict handler, which clears the reviewer of a
+// denied task.
+func denyingHandler(tasks *repository.MemoryTaskRepository) CommandHandler {
+	return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		task, err := tasks.GetByWorker(cmd.(*command.ReportVerdictCommand).WorkerID)
+		if err != nil {
+			return nil, err
+		}
+		task.Status = repository.TaskDenied
+		task.Reviewer = ""
+		return &command.CommandResult{Success: true}, tasks.Save(task)
+	})
+}
+
+func TestSoloDispatcher_DeniedVerdictEscalates(t *testing.T) {
+	d, _, _, tasks := newSoloFixture(t)
+	d.toCoordinator = true
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Reviewer: "worker-2", Status: repository.TaskInReview}))
+	handler := d.Middleware()(denyingHandler(tasks))
+
+	result, err := handler.Handle(context.Background(), command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictDenied, "missing tests"))
+	require.NoError(t, err)
+	require.Len(t, result.FollowUp, 2)
+	notify := result.FollowUp[0].(*command.NotifyUserCommand)
+	require.Equal(t, "perles-a", notify.TaskID)
+	require.Contains(t, notify.Message, "missing tests")
+	require.Equal(t, repository.CoordinatorID, result.FollowUp[1].(*command.SendToProcessCommand).ProcessID)
+}
+
+func TestSoloDispatcher_DeniedVerdictEscalatesReviewedTask(t *testing.T) {
+	d, _, _, tasks := newSoloFixture(t)
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Status: repository.TaskDenied}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-b", Implementer: "worker-3", Reviewer: "worker-2", Status: repository.TaskInReview}))
+	handler := d.Middleware()(denyingHandler(tasks))
+
+	result, err := handler.Handle(context.Background(), command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictDenied, "missing tests"))
+	require.NoError(t, err)
+	notify := result.FollowUp[0].(*command.NotifyUserCommand)
+	require.Equal(t, "perles-b", notify.TaskID, "the reviewer's task, not the first denied one")
+	require.Contains(t, notify.Message, "implemented by worker-3")
+}
+
+func TestSoloDispatcher_CompletesCommittedTaskAndSignalsWorkflow(t *testing.T) {
+	d, _, processes, tasks := newSoloFixture(t)
+	committing := events.ProcessPhaseCommitting
+	require.NoError(t, processes.Save(&repository.Process{
+		ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusReady, Phase: &committing, TaskID: "perles-a", HasCompletedTurn: true,
+	}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Status: repository.TaskCommitting}))
+	handler := d.Middleware()(successHandler())
+
+	result, err := handler.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.Len(t, result.FollowUp, 2)
+	require.IsType(t, &command.MarkTaskCompleteCommand{}, result.FollowUp[0])
+	require.Equal(t, events.ProcessPhaseIdle, result.FollowUp[1].(*command.TransitionPhaseCommand).NewPhase)
+
+	// The follow-ups run through the middleware as well
+	require.NoError(t, tasks.Delete("perles-a"))
+	_, err = handler.Handle(context.Background(), result.FollowUp[0])
+	require.NoError(t, err)
+	result, err = handler.Handle(context.Background(), result.FollowUp[1])
+	require.NoError(t, err)
+	require.Len(t, result.FollowUp, 1)
+	require.Equal(t, 1, result.FollowUp[0].(*command.SignalWorkflowCompleteCommand).TasksClosed)
+
+	require.Empty(t, d.Dispatch(), "workflow completion is signalled once")
+}
+
+func TestSoloDispatcher_FailedWorkerEscalates(t *testing.T) {
+	d, _, processes, tasks := newSoloFixture(t)
+	require.NoError(t, processes.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusFailed, TaskID: "perles-a"}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-a", Implementer: "worker-1", Status: repository.TaskImplementing}))
+	handler := d.Middleware()(successHandler())
+
+	result, err := handler.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", false, nil, nil))
+	require.NoError(t, err)
+	require.Len(t, result.FollowUp, 2)
+	require.IsType(t, &command.NotifyUserCommand{}, result.FollowUp[0])
+	require.Equal(t, "perles-a", result.FollowUp[1].(*command.MarkTaskFailedCommand).TaskID)
+}
@@ -0,0 +1,155 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
+)
+
+// WebhookDispatcher delivers webhook events. Implemented by *webhook.Dispatcher.
+type WebhookDispatcher interface {
+	Dispatch(event webhook.Event)
+}
+
+// WebhookPublisherConfig configures the webhook publisher.
+type WebhookPublisherConfig struct {
+	// Dispatcher delivers the events.
+	// Required.
+	Dispatcher WebhookDispatcher
+	// Processes is used to detect workers that failed during a turn.
+	// Required.
+	Processes repository.ProcessRepository
+	// Tasks is used to find the task of a failed worker.
+	// Required.
+	Tasks repository.TaskRepository
+	// WorkflowID and WorkflowName identify the workflow in the payloads.
+	WorkflowID   string
+	WorkflowName string
+}
+
+// WebhookPublisher turns the outcome of lifecycle commands into webhook events:
+// the workflow completing or being aborted, a review being denied, a task being
+// marked failed and a worker failing during a turn. Only successful commands
+// publish events.
+type WebhookPublisher struct {
+	dispatcher   WebhookDispatcher
+	processes    repository.ProcessRepository
+	tasks        repository.TaskRepository
+	workflowID   string
+	workflowName string
+}
+
+// NewWebhookPublisher creates a webhook publisher.
+func NewWebhookPublisher(cfg WebhookPublisherConfig) *WebhookPublisher {
+	return &WebhookPublisher{
+		dispatcher:   cfg.Dispatcher,
+		processes:    cfg.Processes,
+		tasks:        cfg.Tasks,
+		workflowID:   cfg.WorkflowID,
+		workflowName: cfg.WorkflowName,
+	}
+}
+
+// Middleware returns the middleware function.
+func (p *WebhookPublisher) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			// A worker that was already failed does not fail again
+			wasFailed := false
+			if turn, ok := cmd.(*command.ProcessTurnCompleteCommand); ok {
+				if proc, err := p.processes.Get(turn.ProcessID); err == nil {
+					wasFailed = proc.Status == repository.StatusFailed
+				}
+			}
+
+			result, err := next.Handle(ctx, cmd)
+			if err != nil || result == nil || !result.Success {
+				return result, err
+			}
+
+			for _, event := range p.events(cmd, result, wasFailed) {
+				event.WorkflowID = p.workflowID
+				event.WorkflowName = p.workflowName
+				p.dispatcher.Dispatch(event)
+			}
+			return result, err
+		})
+	}
+}
+
+// events returns the webhook events for a successful command.
+func (p *WebhookPublisher) events(cmd command.Command, result *command.CommandResult, wasFailed bool) []webhook.Event {
+	switch c := cmd.(type) {
+	case *command.SignalWorkflowCompleteCommand:
+		eventType, verb := webhook.EventWorkflowCompleted, "completed"
+		if c.Status == command.WorkflowStatusAborted {
+			eventType, verb = webhook.EventWorkflowFailed, "was aborted"
+		}
+		event := webhook.NewEvent(eventType, fmt.Sprintf("Workflow %s %s (%s): %s", p.name(), verb, c.Status, c.Summary))
+		event.Data = map[string]any{
+			"status":       string(c.Status),
+			"summary":      c.Summary,
+			"epic_id":      c.EpicID,
+			"tasks_closed": c.TasksClosed,
+		}
+		return []webhook.Event{event}
+
+	case *command.ReportVerdictCommand:
+		if c.Verdict != command.VerdictDenied {
+			return nil
+		}
+		var taskID string
+		if r, ok := result.Data.(interface{ GetTaskID() string }); ok {
+			taskID = r.GetTaskID()
+		}
+		text := fmt.Sprintf("Review of %s denied by %s", taskID, c.WorkerID)
+		if c.Comments != "" {
+			text += ": " + c.Comments
+		}
+		event := webhook.NewEvent(webhook.EventReviewDenied, text)
+		event.ProcessID = c.WorkerID
+		event.TaskID = taskID
+		event.Data = map[string]any{
+			"comments": c.Comments,
+			"findings": len(c.Findings),
+		}
+		return []webhook.Event{event}
+
+	case *command.MarkTaskFailedCommand:
+		event := webhook.NewEvent(webhook.EventTaskFailed, fmt.Sprintf("Task %s failed: %s", c.TaskID, c.Reason))
+		event.TaskID = c.TaskID
+		event.Data = map[string]any{"reason": c.Reason}
+		return []webhook.Event{event}
+
+	case *command.ProcessTurnCompleteCommand:
+		if wasFailed {
+			return nil
+		}
+		proc, err := p.processes.Get(c.ProcessID)
+		if err != nil || !proc.IsWorker() || proc.Status != repository.StatusFailed {
+			return nil
+		}
+		event := webhook.NewEvent(webhook.EventWorkerFailed, fmt.Sprintf("Worker %s failed", proc.ID))
+		event.ProcessID = proc.ID
+		if task, err := p.tasks.GetByWorker(proc.ID); err == nil {
+			event.TaskID = task.TaskID
+			event.Text += " while working on " + task.TaskID
+		}
+		if c.Error != nil {
+			event.Data = map[string]any{"error": c.Error.Error()}
+		}
+		return []webhook.Event{event}
+	}
+	return nil
+}
+
+// name returns the workflow's display name for messages.
+func (p *WebhookPublisher) name() string {
+	if p.workflowName != "" {
+		return p.workflowName
+	}
+	return p.workflowID
+}
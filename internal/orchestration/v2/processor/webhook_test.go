@@ -0,0 +1,134 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+	"github.com/zjrosen/perles/internal/orchestration/webhook"
+)
+
+type recordingWebhooks struct {
+	events []webhook.Event
+}
+
+func (r *recordingWebhooks) Dispatch(event webhook.Event) {
+	r.events = append(r.events, event)
+}
+
+type taskIDResult struct{ taskID string }
+
+func (r taskIDResult) GetTaskID() string { return r.taskID }
+
+func newWebhookFixture(t *testing.T) (*WebhookPublisher, *recordingWebhooks, *repository.MemoryProcessRepository, *repository.MemoryTaskRepository) {
+	t.Helper()
+	hooks := &recordingWebhooks{}
+	processes := repository.NewMemoryProcessRepository()
+	tasks := repository.NewMemoryTaskRepository()
+	p := NewWebhookPublisher(WebhookPublisherConfig{
+		Dispatcher:   hooks,
+		Processes:    processes,
+		Tasks:        tasks,
+		WorkflowID:   "wf-1",
+		WorkflowName: "Nightly",
+	})
+	return p, hooks, processes, tasks
+}
+
+func resultHandler(data any) CommandHandler {
+	return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		return &command.CommandResult{Success: true, Data: data}, nil
+	})
+}
+
+func TestWebhookPublisher_WorkflowComplete(t *testing.T) {
+	p, hooks, _, _ := newWebhookFixture(t)
+	handler := p.Middleware()(successHandler())
+
+	_, err := handler.Handle(context.Background(),
+		command.NewSignalWorkflowCompleteCommand(command.SourceMCPTool, command.WorkflowStatusSuccess, "All tasks done", "perles-abc", 4))
+	require.NoError(t, err)
+	_, err = handler.Handle(context.Background(),
+		command.NewSignalWorkflowCompleteCommand(command.SourceMCPTool, command.WorkflowStatusAborted, "Blocked on credentials", "", 0))
+	require.NoError(t, err)
+
+	require.Len(t, hooks.events, 2)
+	require.Equal(t, webhook.EventWorkflowCompleted, hooks.events[0].Type)
+	require.Equal(t, "Workflow Nightly completed (success): All tasks done", hooks.events[0].Text)
+	require.Equal(t, "wf-1", hooks.events[0].WorkflowID)
+	require.Equal(t, "Nightly", hooks.events[0].WorkflowName)
+	require.Equal(t, 4, hooks.events[0].Data["tasks_closed"])
+	require.Equal(t, webhook.EventWorkflowFailed, hooks.events[1].Type)
+	require.Equal(t, "Workflow Nightly was aborted (aborted): Blocked on credentials", hooks.events[1].Text)
+}
+
+func TestWebhookPublisher_ReviewDenied(t *testing.T) {
+	p, hooks, _, _ := newWebhookFixture(t)
+	handler := p.Middleware()(resultHandler(taskIDResult{taskID: "perles-abc.1"}))
+
+	_, err := handler.Handle(context.Background(),
+		command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictApproved, "LGTM"))
+	require.NoError(t, err)
+	require.Empty(t, hooks.events, "approvals are not published")
+
+	_, err = handler.Handle(context.Background(),
+		command.NewReportVerdictCommand(command.SourceMCPTool, "worker-2", command.VerdictDenied, "Missing tests"))
+	require.NoError(t, err)
+	require.Len(t, hooks.events, 1)
+	require.Equal(t, webhook.EventReviewDenied, hooks.events[0].Type)
+	require.Equal(t, "Review of perles-abc.1 denied by worker-2: Missing tests", hooks.events[0].Text)
+	require.Equal(t, "worker-2", hooks.events[0].ProcessID)
+	require.Equal(t, "perles-abc.1", hooks.events[0].TaskID)
+}
+
+func TestWebhookPublisher_TaskFailed(t *testing.T) {
+	p, hooks, _, _ := newWebhookFixture(t)
+
+	// Failed commands publish nothing
+	_, err := p.Middleware()(errorHandler("unknown task")).Handle(context.Background(),
+		command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc.2", "Flaky build"))
+	require.Error(t, err)
+	require.Empty(t, hooks.events)
+
+	_, err = p.Middleware()(successHandler()).Handle(context.Background(),
+		command.NewMarkTaskFailedCommand(command.SourceMCPTool, "perles-abc.2", "Flaky build"))
+	require.NoError(t, err)
+	require.Len(t, hooks.events, 1)
+	require.Equal(t, webhook.EventTaskFailed, hooks.events[0].Type)
+	require.Equal(t, "Task perles-abc.2 failed: Flaky build", hooks.events[0].Text)
+	require.Equal(t, "perles-abc.2", hooks.events[0].TaskID)
+}
+
+func TestWebhookPublisher_WorkerFailed(t *testing.T) {
+	p, hooks, processes, tasks := newWebhookFixture(t)
+	require.NoError(t, processes.Save(&repository.Process{ID: "worker-1", Role: repository.RoleWorker, Status: repository.StatusWorking}))
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc.3", Implementer: "worker-1", Status: repository.TaskImplementing}))
+
+	// The handler marks the worker failed, as ProcessTurnCompleteHandler does
+	failing := HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+		proc, err := processes.Get("worker-1")
+		require.NoError(t, err)
+		proc.Status = repository.StatusFailed
+		require.NoError(t, processes.Save(proc))
+		return &command.CommandResult{Success: true}, nil
+	})
+	handler := p.Middleware()(failing)
+
+	turn := command.NewProcessTurnCompleteCommand("worker-1", false, nil, errors.New("context exceeded"))
+	_, err := handler.Handle(context.Background(), turn)
+	require.NoError(t, err)
+	require.Len(t, hooks.events, 1)
+	require.Equal(t, webhook.EventWorkerFailed, hooks.events[0].Type)
+	require.Equal(t, "Worker worker-1 failed while working on perles-abc.3", hooks.events[0].Text)
+	require.Equal(t, "perles-abc.3", hooks.events[0].TaskID)
+	require.Equal(t, "context exceeded", hooks.events[0].Data["error"])
+
+	// A worker that was already failed is not reported again
+	_, err = handler.Handle(context.Background(), turn)
+	require.NoError(t, err)
+	require.Len(t, hooks.events, 1)
+}
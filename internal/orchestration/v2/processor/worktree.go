@@ -0,0 +1,286 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/zjrosen/perles/internal/log"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// ErrMergeConflict is returned by WorktreeGit when a merge conflicted and was aborted.
+var ErrMergeConflict = errors.New("merge conflict")
+
+// WorktreeGit manages the git worktrees of workers. Implemented in v2 on top of git.
+type WorktreeGit interface {
+	// CurrentBranch returns the branch checked out in the session's work directory.
+	CurrentBranch() (string, error)
+	// CreateWorktree creates a worktree at path on a new branch started from the
+	// work directory's HEAD.
+	CreateWorktree(path, branch string) error
+	// Dirty reports whether the worktree at path has uncommitted changes.
+	Dirty(path string) (bool, error)
+	// Merge merges branch into the work directory's current branch.
+	// Returns ErrMergeConflict if the merge conflicted and was aborted.
+	Merge(branch string) error
+	// Sync merges the work directory's HEAD into the branch of the worktree at path.
+	// Returns ErrMergeConflict if the merge conflicted and was aborted.
+	Sync(path string) error
+	// RemoveWorktree removes the worktree at path.
+	RemoveWorktree(path string) error
+	// DeleteBranch deletes branch if it is merged.
+	DeleteBranch(branch string) error
+}
+
+// WorkerWorktreesConfig configures worker worktrees.
+type WorkerWorktreesConfig struct {
+	// Git creates, merges and removes the worktrees.
+	// Required.
+	Git WorktreeGit
+	// Dir is the directory the worktrees are created in, one per worker.
+	// Required.
+	Dir string
+	// BranchPrefix is prepended to the worker ID to name a worker's branch.
+	BranchPrefix string
+	// Tasks provides the tasks workers implement and review.
+	// Required.
+	Tasks repository.TaskRepository
+}
+
+// WorkerWorktree is the git worktree of a worker.
+type WorkerWorktree struct {
+	Path   string
+	Branch string
+}
+
+// WorkerWorktrees gives every worker its own git worktree and branch, so
+// workers implementing tasks in parallel cannot clobber each other's edits.
+//
+// A worker's worktree is created when its agent first runs (see WorkDir) and
+// kept until the worker retires: agent sessions can only be resumed from the
+// directory they were started in.
+//   - assign_task merges the session's branch into the worker's branch, so the
+//     worker starts from the work merged so far.
+//   - assign_review tells the reviewer where the implementer's changes are.
+//   - After approve_commit, every turn the implementer ends with a clean
+//     worktree merges its branch into the session's branch. A conflicting merge
+//     is aborted and the implementer is asked to resolve the conflicts.
+//   - retire_process removes the worker's worktree and branch. A worktree with
+//     uncommitted changes and a branch with unmerged commits are kept.
+type WorkerWorktrees struct {
+	git          WorktreeGit
+	dir          string
+	branchPrefix string
+	tasks        repository.TaskRepository
+
+	mu        sync.Mutex
+	worktrees map[string]WorkerWorktree // workerID -> worktree
+}
+
+// NewWorkerWorktrees creates the worker worktree manager.
+func NewWorkerWorktrees(cfg WorkerWorktreesConfig) *WorkerWorktrees {
+	return &WorkerWorktrees{
+		git:          cfg.Git,
+		dir:          cfg.Dir,
+		branchPrefix: cfg.BranchPrefix,
+		tasks:        cfg.Tasks,
+		worktrees:    make(map[string]WorkerWorktree),
+	}
+}
+
+// Middleware returns the middleware function. It acts on successful AssignTask,
+// AssignReview, ProcessTurnComplete and RetireProcess commands.
+func (w *WorkerWorktrees) Middleware() Middleware {
+	return func(next CommandHandler) CommandHandler {
+		return HandlerFunc(func(ctx context.Context, cmd command.Command) (*command.CommandResult, error) {
+			result, err := next.Handle(ctx, cmd)
+			if err != nil || result == nil || !result.Success {
+				return result, err
+			}
+
+			var followUp command.Command
+			switch c := cmd.(type) {
+			case *command.AssignTaskCommand:
+				followUp = w.assigned(c.WorkerID)
+			case *command.AssignReviewCommand:
+				followUp = w.reviewing(c.ReviewerID, c.ImplementerID)
+			case *command.ProcessTurnCompleteCommand:
+				followUp = w.mergeCommitted(c.ProcessID)
+			case *command.RetireProcessCommand:
+				w.Release(c.ProcessID)
+			}
+			if followUp != nil {
+				result.FollowUp = append(result.FollowUp, followUp)
+			}
+			return result, err
+		})
+	}
+}
+
+// WorkDir returns the worktree processID's agent runs in, creating it on
+// first use. Returns "" for the coordinator and observer, and for a worker
+// whose worktree could not be created; they work in the session's directory.
+func (w *WorkerWorktrees) WorkDir(processID string) string {
+	if processID == repository.CoordinatorID || processID == repository.ObserverID {
+		return ""
+	}
+	wt, err := w.Acquire(processID)
+	if err != nil {
+		log.Warn(log.CatOrch, "Worker works in the shared work directory", "workerID", processID, "error", err)
+		return ""
+	}
+	return wt.Path
+}
+
+// Acquire returns the worktree of workerID, creating it on first use. A
+// worktree left at the worker's path by an earlier run is reused.
+func (w *WorkerWorktrees) Acquire(workerID string) (WorkerWorktree, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if wt, ok := w.worktrees[workerID]; ok {
+		return wt, nil
+	}
+
+	wt := WorkerWorktree{Path: filepath.Join(w.dir, workerID), Branch: w.branchPrefix + workerID}
+	if _, err := os.Stat(wt.Path); err != nil {
+		if err := w.git.CreateWorktree(wt.Path, wt.Branch); err != nil {
+			return WorkerWorktree{}, fmt.Errorf("creating worktree for %s: %w", workerID, err)
+		}
+	}
+	w.worktrees[workerID] = wt
+	return wt, nil
+}
+
+// Worktree returns the worktree of workerID if it has one.
+func (w *WorkerWorktrees) Worktree(workerID string) (WorkerWorktree, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	wt, ok := w.worktrees[workerID]
+	return wt, ok
+}
+
+// Merge merges the branch of workerID into the session's branch, then the
+// session's branch back into the worker's, so both are level. A worktree with
+// uncommitted changes is not merged.
+func (w *WorkerWorktrees) Merge(workerID string) error {
+	wt, ok := w.Worktree(workerID)
+	if !ok {
+		return nil
+	}
+
+	dirty, err := w.git.Dirty(wt.Path)
+	if err != nil {
+		return fmt.Errorf("checking worktree of %s: %w", workerID, err)
+	}
+	if dirty {
+		return fmt.Errorf("worktree of %s has uncommitted changes", workerID)
+	}
+	if err := w.git.Merge(wt.Branch); err != nil {
+		return fmt.Errorf("merging %s: %w", wt.Branch, err)
+	}
+	if err := w.git.Sync(wt.Path); err != nil {
+		return fmt.Errorf("updating %s: %w", wt.Branch, err)
+	}
+	return nil
+}
+
+// Release removes the worktree of workerID and deletes its branch. A worktree
+// with uncommitted changes and a branch with unmerged commits are kept on disk.
+func (w *WorkerWorktrees) Release(workerID string) {
+	w.mu.Lock()
+	wt, ok := w.worktrees[workerID]
+	delete(w.worktrees, workerID)
+	w.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	if dirty, err := w.git.Dirty(wt.Path); err != nil || dirty {
+		log.Warn(log.CatOrch, "Keeping worker worktree with uncommitted changes", "workerID", workerID, "path", wt.Path)
+		return
+	}
+	if err := w.git.RemoveWorktree(wt.Path); err != nil {
+		log.Warn(log.CatOrch, "Failed to remove worker worktree", "workerID", workerID, "path", wt.Path, "error", err)
+		return
+	}
+	if err := w.git.DeleteBranch(wt.Branch); err != nil {
+		log.Warn(log.CatOrch, "Keeping unmerged worker branch", "workerID", workerID, "branch", wt.Branch, "error", err)
+	}
+}
+
+// assigned brings the worktree of a worker that was assigned a task up to date
+// with the session's branch and tells the worker where it works.
+func (w *WorkerWorktrees) assigned(workerID string) command.Command {
+	wt, err := w.Acquire(workerID)
+	if err != nil {
+		log.Warn(log.CatOrch, "Worker works in the shared work directory", "workerID", workerID, "error", err)
+		return nil
+	}
+
+	if dirty, err := w.git.Dirty(wt.Path); err == nil && !dirty {
+		if err := w.git.Sync(wt.Path); err != nil {
+			log.Warn(log.CatOrch, "Failed to update worker worktree", "workerID", workerID, "error", err)
+		}
+	}
+	return command.NewSendToProcessCommand(command.SourceInternal, workerID, fmt.Sprintf(
+		"[WORKTREE] You work in your own git worktree at %s on branch %s; other workers cannot see your edits there. "+
+			"When your commit is approved, commit on this branch; it is merged into %s when your turn ends.",
+		wt.Path, wt.Branch, w.baseBranch()))
+}
+
+// reviewing tells a reviewer where the changes it reviews are.
+func (w *WorkerWorktrees) reviewing(reviewerID, implementerID string) command.Command {
+	wt, ok := w.Worktree(implementerID)
+	if !ok {
+		return nil
+	}
+	return command.NewSendToProcessCommand(command.SourceInternal, reviewerID, fmt.Sprintf(
+		"[WORKTREE] %s implemented the task in its git worktree at %s on branch %s. "+
+			"Read the changed files there; uncommitted changes are not visible in your own worktree.",
+		implementerID, wt.Path, wt.Branch))
+}
+
+// mergeCommitted merges the branch of an implementer whose task is committing.
+// On a conflict, the implementer is asked to resolve it.
+func (w *WorkerWorktrees) mergeCommitted(processID string) command.Command {
+	tasks, err := w.tasks.GetByImplementer(processID)
+	if err != nil {
+		return nil
+	}
+	committing := false
+	for _, task := range tasks {
+		committing = committing || task.Status == repository.TaskCommitting
+	}
+	if !committing {
+		return nil
+	}
+
+	err = w.Merge(processID)
+	if !errors.Is(err, ErrMergeConflict) {
+		if err != nil {
+			log.Debug(log.CatOrch, "Worker branch not merged yet", "workerID", processID, "error", err)
+		}
+		return nil
+	}
+
+	base := w.baseBranch()
+	return command.NewSendToProcessCommand(command.SourceInternal, processID, fmt.Sprintf(
+		"[WORKTREE] Your branch conflicts with %s and was not merged. Run `git merge %s` in your worktree, "+
+			"resolve the conflicts and commit; your branch is merged again when your turn ends.",
+		base, base))
+}
+
+// baseBranch returns the session's branch, or HEAD when it is detached.
+func (w *WorkerWorktrees) baseBranch() string {
+	branch, err := w.git.CurrentBranch()
+	if err != nil || branch == "" {
+		return "HEAD"
+	}
+	return branch
+}
@@ -0,0 +1,155 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zjrosen/perles/internal/orchestration/v2/command"
+	"github.com/zjrosen/perles/internal/orchestration/v2/repository"
+)
+
+// fakeWorktreeGit creates worktrees as plain directories and records the git operations.
+type fakeWorktreeGit struct {
+	dirty    map[string]bool // path -> has uncommitted changes
+	mergeErr error
+	calls    []string
+}
+
+func (g *fakeWorktreeGit) CurrentBranch() (string, error) { return "main", nil }
+
+func (g *fakeWorktreeGit) CreateWorktree(path, branch string) error {
+	g.calls = append(g.calls, "create "+branch)
+	return os.MkdirAll(path, 0o750)
+}
+
+func (g *fakeWorktreeGit) Dirty(path string) (bool, error) { return g.dirty[path], nil }
+
+func (g *fakeWorktreeGit) Merge(branch string) error {
+	g.calls = append(g.calls, "merge "+branch)
+	return g.mergeErr
+}
+
+func (g *fakeWorktreeGit) Sync(path string) error {
+	g.calls = append(g.calls, "sync "+filepath.Base(path))
+	return nil
+}
+
+func (g *fakeWorktreeGit) RemoveWorktree(path string) error {
+	g.calls = append(g.calls, "remove "+filepath.Base(path))
+	return nil
+}
+
+func (g *fakeWorktreeGit) DeleteBranch(branch string) error {
+	g.calls = append(g.calls, "delete "+branch)
+	return nil
+}
+
+// worktreeMessage returns the content of the single message result sends to processID.
+func worktreeMessage(t *testing.T, result *command.CommandResult, processID string) string {
+	t.Helper()
+	require.Len(t, result.FollowUp, 1)
+	msg, ok := result.FollowUp[0].(*command.SendToProcessCommand)
+	require.True(t, ok)
+	require.Equal(t, processID, msg.ProcessID)
+	require.Contains(t, msg.Content, "[WORKTREE]")
+	return msg.Content
+}
+
+func TestWorkerWorktrees_Lifecycle(t *testing.T) {
+	dir := t.TempDir()
+	git := &fakeWorktreeGit{dirty: map[string]bool{}}
+	tasks := repository.NewMemoryTaskRepository()
+	task := &repository.TaskAssignment{TaskID: "perles-abc", Implementer: "worker-1", Reviewer: "worker-2", Status: repository.TaskImplementing}
+	require.NoError(t, tasks.Save(task))
+	worktrees := NewWorkerWorktrees(WorkerWorktreesConfig{Git: git, Dir: dir, BranchPrefix: "perles-s1-", Tasks: tasks})
+	handler := worktrees.Middleware()(successHandler())
+	ctx := context.Background()
+
+	// Workers run in their own worktree from their first turn, the coordinator does not
+	require.Equal(t, filepath.Join(dir, "worker-1"), worktrees.WorkDir("worker-1"))
+	require.Equal(t, filepath.Join(dir, "worker-1"), worktrees.WorkDir("worker-1"))
+	require.Empty(t, worktrees.WorkDir(repository.CoordinatorID))
+	require.Equal(t, []string{"create perles-s1-worker-1"}, git.calls)
+
+	// Assigned: the worktree is brought up to date and the worker told where it works
+	git.calls = nil
+	result, err := handler.Handle(ctx, command.NewAssignTaskCommand(command.SourceMCPTool, "worker-1", "perles-abc", "Add login", ""))
+	require.NoError(t, err)
+	content := worktreeMessage(t, result, "worker-1")
+	require.Contains(t, content, filepath.Join(dir, "worker-1"))
+	require.Contains(t, content, "perles-s1-worker-1")
+	require.Equal(t, []string{"sync worker-1"}, git.calls)
+
+	// Reviewer is told where the implementer's changes are
+	result, err = handler.Handle(ctx, command.NewAssignReviewCommand(command.SourceMCPTool, "worker-2", "perles-abc", "worker-1", command.ReviewTypeSimple))
+	require.NoError(t, err)
+	require.Contains(t, worktreeMessage(t, result, "worker-2"), filepath.Join(dir, "worker-1"))
+
+	// Turns before approval do not merge
+	git.calls = nil
+	result, err = handler.Handle(ctx, command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.Empty(t, result.FollowUp)
+	require.Empty(t, git.calls)
+
+	// Committing with uncommitted changes: not merged yet
+	task.Status = repository.TaskCommitting
+	require.NoError(t, tasks.Save(task))
+	git.dirty[filepath.Join(dir, "worker-1")] = true
+	_, err = handler.Handle(ctx, command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.Empty(t, git.calls)
+
+	// Committed: merged into the session's branch and brought level with it
+	git.dirty[filepath.Join(dir, "worker-1")] = false
+	result, err = handler.Handle(ctx, command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.Empty(t, result.FollowUp)
+	require.Equal(t, []string{"merge perles-s1-worker-1", "sync worker-1"}, git.calls)
+
+	// Retired: the worktree and branch are removed
+	git.calls = nil
+	_, err = handler.Handle(ctx, command.NewRetireProcessCommand(command.SourceMCPTool, "worker-1", "done"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"remove worker-1", "delete perles-s1-worker-1"}, git.calls)
+}
+
+func TestWorkerWorktrees_MergeConflict(t *testing.T) {
+	dir := t.TempDir()
+	git := &fakeWorktreeGit{mergeErr: fmt.Errorf("%w: CONFLICT (content)", ErrMergeConflict)}
+	tasks := repository.NewMemoryTaskRepository()
+	require.NoError(t, tasks.Save(&repository.TaskAssignment{TaskID: "perles-abc", Implementer: "worker-1", Status: repository.TaskCommitting}))
+	worktrees := NewWorkerWorktrees(WorkerWorktreesConfig{Git: git, Dir: dir, Tasks: tasks})
+	handler := worktrees.Middleware()(successHandler())
+	worktrees.WorkDir("worker-1")
+
+	result, err := handler.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.Contains(t, worktreeMessage(t, result, "worker-1"), "git merge main")
+
+	// Other merge failures are retried on the next turn without bothering the worker
+	git.mergeErr = errors.New("index.lock exists")
+	result, err = handler.Handle(context.Background(), command.NewProcessTurnCompleteCommand("worker-1", true, nil, nil))
+	require.NoError(t, err)
+	require.Empty(t, result.FollowUp)
+}
+
+func TestWorkerWorktrees_ReleaseKeepsUncommittedChanges(t *testing.T) {
+	dir := t.TempDir()
+	git := &fakeWorktreeGit{dirty: map[string]bool{filepath.Join(dir, "worker-1"): true}}
+	worktrees := NewWorkerWorktrees(WorkerWorktreesConfig{Git: git, Dir: dir, Tasks: repository.NewMemoryTaskRepository()})
+	worktrees.WorkDir("worker-1")
+
+	worktrees.Release("worker-1")
+	require.Equal(t, []string{"create worker-1"}, git.calls)
+
+	// A worktree left by an earlier run is reused
+	git.calls = nil
+	require.Equal(t, filepath.Join(dir, "worker-1"), worktrees.WorkDir("worker-1"))
+	require.Empty(t, git.calls)
+}
@@ -29,16 +29,33 @@ var systemPromptTemplate = template.Must(template.New("prompt-mode").Parse(`
 - query_worker_state: view worker status (use ONLY when user asks, NEVER to poll)
 - assign_task: assign a bd task to exactly ONE ready worker
 - assign_task_review: assign a review task to exactly ONE ready worker
+- rotate_reviewer: like assign_task_review, but picks the reviewer so pairs don't repeat and review load stays balanced (prefer it)
+- query_review_history: reviewer/implementer pairings and reviews per worker
+- list_dead_letters / retry_dead_letter: find commands that failed (e.g., an assignment to a busy worker) and resubmit or discard them
 - assign_review_feedback: assign feedback incorporation to exactly ONE ready worker
 - approve_commit: approve and instruct a worker to commit its output
+- broadcast_to_workers: send the same instruction to all workers, or to those in a phase, of an agent type or on a task, in one call
 - fabric_send: send a message to a channel with @mentions (e.g., "@worker-1 please clarify...")
+  - Pass delay (e.g., "20m") or deliver_at to schedule a reminder, e.g. "@coordinator check on worker-3's migration"; cancel it with fabric_cancel_scheduled if it is no longer needed
 - fabric_reply: reply to an existing thread
 - fabric_react: add/remove emoji reaction to a message (e.g., 👍 to acknowledge, ✅ for approval)
   - Use fabric_react to acknowledge worker messages (👀 when noting, ✅ when acknowledging completion)
+- fabric_edit / fabric_delete: fix a wrong task ID or broken instructions in a message you posted, instead of sending a correction
 - fabric_inbox: check for unread messages across channels (use ONLY after context refresh, NEVER to poll)
 - fabric_history: read channel message history
+- fabric_thread_participants: see who posted, was mentioned, and acked in a thread; "pending" lists whom to nudge
+- fabric_receipts: see which recipients a thread's messages were delivered to and who actually read them
+- fabric_create_channel / fabric_archive_channel: open a channel per epic or topic when #general gets crowded, archive it when the work is done
+- fabric_list_channels: list channels with their purpose and subscribers
+- fabric_graph: inspect the dependency tree below a channel or thread (what each thread blocks or is blocked by)
+- export_thread_to_issue: persist an important fabric thread (design decisions, review outcomes) as a comment on its bd issue
 - get_task_status / mark_task_complete / mark_task_failed: bd task tracking
-- spawn_worker: starts a new worker, **YOU MUST** wait for "ready" message before delegating work
+- bd_exec: run an allowlisted bd subcommand (dep tree, label add, search, ...) when no dedicated tool covers it
+- archive_completed_tasks: archive an epic's long-closed tasks to keep the board manageable (use dry_run to preview)
+- get_epic_status: an epic's task counts, progress weighted by size, blocked tasks and burndown
+- estimate_task: low/likely/high cycle-time range for a task from similar closed tasks; use it to sequence work
+- suggest_assignment_plan: waves of an epic's tasks that can run in parallel (respects blockers and file: labels); assign wave 1 first
+- spawn_worker: starts a new worker, **YOU MUST** wait for "ready" message before delegating work. Pass backend (e.g. codex) to run it on another configured agent CLI
 - replace_worker: replace a worker with a new worker
 - retire_worker: retires a worker that is no longer needed
 - stop_worker: stops a worker from working
@@ -51,6 +68,9 @@ After you delegate work to a worker, you MUST end your turn IMMEDIATELY. Workers
 - Workers will message you when they complete - you will receive their message automatically when they are done.
 - Every poll wastes tokens and slows down the system
 
+When a worker cannot continue without help, you receive an urgent "[ASSISTANCE REQUESTED]" message and the worker shows as blocked in query_worker_state. Answer it with a fabric message mentioning the worker (ask the user first with notify_user if only they can answer); the worker resumes when your message reaches it.
+When a worker finds its task too big, you receive a "[SUBTASKS PROPOSED]" message listing the bd subtasks it created; assign them with assign_task like any other ready task.
+
 **Correct pattern:** fabric_send (with @mention) or assign_task → end turn
 **Wrong pattern:** assign_task → query_worker_state → query_worker_state → fabric_inbox (NEVER DO THIS)`))
 
@@ -90,7 +110,7 @@ After receiving a workflow or user instructions, determine required workers:
 Use the ` + "`" + `spawn_worker` + "`" + ` tool to spawn each required worker.
 - **YOU MUST** end your turn after calling spawn_worker. The workers will send "ready" messages when they become available.
 - **CRITICAL** workers are not automatically ready after spawning, they will message you when ready.
-- **NEVER** Call spawn_worker then immediately send_to_worker or assign_task without the worker telling you they are "ready".
+- **NEVER** Call spawn_worker then immediately fabric_send or assign_task without the worker telling you they are "ready".
 
 **IMPORTANT**:
 - Wait for all spawned workers to send "ready" messages before proceeding after using spawn_worker.
@@ -126,6 +146,11 @@ Use assign_task when:
 - Work needs to be tracked in the issue tracker
 - The task is part of a planned epic or workflow
 
+If the project has assignment templates in .perles/assignments/, reference one by name with its variables:
+` + "`" + `` + "`" + `` + "`" + `
+assign_task(worker_id: "worker-1", task_id: "bd-42", template: "bugfix", variables: {files: "auth.go", acceptance: "login tests pass"})
+` + "`" + `` + "`" + `` + "`" + `
+
 ### ✅ DO: Use fabric_send with @mentions for non-beads tasks
 When sending ad-hoc work or follow-up messages not tracked in bd:
 ` + "`" + `` + "`" + `` + "`" + `
@@ -191,6 +216,24 @@ func BuildCoordinatorInitialPrompt() (string, error) {
 	return initialPrompt, nil
 }
 
+// BuildSoloCoordinatorInitialPrompt builds the initial prompt for the optional
+// coordinator of a solo mode workflow. The orchestration processor assigns tasks
+// and reviews itself; the coordinator only handles what is escalated to it.
+func BuildSoloCoordinatorInitialPrompt() string {
+	var prompt strings.Builder
+
+	prompt.WriteString("[SOLO MODE]\n\n")
+	prompt.WriteString("This workflow runs in solo mode: the orchestrator assigns ready bd tasks to idle workers, ")
+	prompt.WriteString("assigns reviewers, approves commits of approved tasks and closes them without your involvement.\n\n")
+	prompt.WriteString("Your role is to handle escalations only:\n")
+	prompt.WriteString("- Review denials: decide whether the implementer should address the feedback, the task should be reassigned, or the user must decide\n")
+	prompt.WriteString("- Worker failures: decide whether to replace the worker and retry the task\n\n")
+	prompt.WriteString("Do NOT use `assign_task` or `assign_task_review` for tasks the orchestrator has not escalated to you. ")
+	prompt.WriteString("Wait for a [SOLO MODE ESCALATION] message before taking any action.\n")
+
+	return prompt.String()
+}
+
 func BuildWorkerOutOfContextPrompt(workerID string, taskID string) string {
 	var prompt strings.Builder
 
@@ -204,7 +247,7 @@ func BuildWorkerOutOfContextPrompt(workerID string, taskID string) string {
 	if taskID != "" {
 		prompt.WriteString(fmt.Sprintf("3. The previous worker was working on task `%s`. Use `assign_task` to assign this task to the new worker and include in the summary they need to check for existing work since they are taking over from a previous worker.\n", taskID))
 	} else {
-		prompt.WriteString("3. The previous worker had no assigned task. Use `send_to_worker` to send the new worker instructions for what to work on and include in the summary they need to check for existing work since they are taking over from a previous worker.\n")
+		prompt.WriteString("3. The previous worker had no assigned task. Use `fabric_send` with an @mention to send the new worker instructions for what to work on and include in the summary they need to check for existing work since they are taking over from a previous worker.\n")
 	}
 
 	prompt.WriteString("\nDo NOT attempt to send messages to the old worker - it cannot process them.\n")
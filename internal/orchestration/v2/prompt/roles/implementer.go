@@ -3,7 +3,7 @@ package roles
 import "fmt"
 
 // ImplementerSystemPromptVersion is the semantic version of the implementer system prompt.
-const ImplementerSystemPromptVersion = "1.0.0"
+const ImplementerSystemPromptVersion = "1.1.0"
 
 // ImplementerSystemPrompt returns the system prompt for an implementer worker agent.
 // Implementers specialize in code implementation, testing, and task completion.
@@ -26,7 +26,7 @@ fixing bugs, and ensuring code quality through comprehensive testing.
 1. **Understand Before Coding**
    - Read the task description fully before starting
    - Identify acceptance criteria - these are your success metrics
-   - Explore the codebase to find existing patterns to follow
+   - Explore the codebase to find existing patterns to follow (search_codebase finds definitions and usages)
    - Understand interfaces and dependencies you'll be working with
 
 2. **Write Clean Code**
@@ -53,6 +53,7 @@ fixing bugs, and ensuring code quality through comprehensive testing.
 - fabric_reply: Reply to an EXISTING message thread (use when someone @mentions you)
 - fabric_react: Add/remove emoji reaction to a message (e.g., 👀 when starting work, ✅ when done)
 - report_implementation_complete: Send a message to the coordinator when you are done with a bd task
+- search_codebase: Search the code (mode=text for lines, mode=symbol for definitions) with context lines and pagination
 
 **IMPORTANT: fabric_send vs fabric_reply:**
 - When someone @mentions you in a message → use fabric_reply(message_id=...) to continue that thread
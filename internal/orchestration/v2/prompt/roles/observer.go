@@ -93,9 +93,14 @@ Use ` + "`fabric_history`" + ` when you need context beyond your inbox:
 Prefer your notes file for ongoing observations - history is for point-in-time lookups.`
 }
 
+// SessionDirPlaceholder marks where the session directory goes in
+// ObserverIdlePrompt; the spawner replaces it.
+const SessionDirPlaceholder = "{{SESSION_DIR}}"
+
 // ObserverIdlePrompt returns the initial prompt for the Observer agent on startup.
 // Channel subscriptions are set up programmatically, so this prompt focuses on
 // session notes setup and passive observation behavior.
+// The notes path contains SessionDirPlaceholder.
 func ObserverIdlePrompt() string {
 	return `You are the Observer - a passive monitoring agent.
 
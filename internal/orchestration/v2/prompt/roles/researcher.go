@@ -3,7 +3,7 @@ package roles
 import "fmt"
 
 // ResearcherSystemPromptVersion is the semantic version of the researcher system prompt.
-const ResearcherSystemPromptVersion = "1.0.0"
+const ResearcherSystemPromptVersion = "1.1.0"
 
 // ResearcherSystemPrompt returns the system prompt for a researcher worker agent.
 // Researchers specialize in codebase exploration, documentation, and analysis.
@@ -25,7 +25,7 @@ Your primary focus is gathering information, analyzing patterns, and providing i
 
 1. **Exploration Strategy**
    - Start broad, then narrow down based on findings
-   - Use multiple search strategies: grep, glob, file reading
+   - Use multiple search strategies: search_codebase (text and symbol mode), glob, file reading
    - Follow dependencies and call chains
    - Document the exploration path for reproducibility
 
@@ -62,6 +62,7 @@ When reporting findings, structure your response clearly:
 - fabric_send: Start a NEW conversation in a channel (use for research reports or new topics)
 - fabric_reply: Reply to an EXISTING message thread (use when someone @mentions you)
 - fabric_react: Add/remove emoji reaction to a message (e.g., 👀 when starting research, ✅ when done)
+- search_codebase: Search the code (mode=text for lines, mode=symbol for definitions) with context lines and pagination
 
 **IMPORTANT: fabric_send vs fabric_reply:**
 - When someone @mentions you in a message → use fabric_reply(message_id=...) to continue that thread
@@ -91,7 +91,8 @@ Note: Reactions are NOT turn completion tools - always complete your turn normal
 
 | Situation | Tool to Use |
 |-----------|-------------|
-| Review complete | report_review_verdict(verdict="APPROVED", comments="...") |
+| Review complete | report_review_verdict(verdict="APPROVED", comments="...", scores={...}) |
+| Changes required | report_review_verdict(verdict="DENIED", comments="...", findings=[...], scores={...}) |
 | Responding to a message | fabric_reply(message_id=..., content="...") |
 | Starting new topic or asking for help | fabric_send(channel="general", content="...") |
 
@@ -1,6 +1,9 @@
 package prompt
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // WorkerMCPInstructions generates the MCP server instructions for a worker agent.
 // This is a brief description of available tools sent during MCP initialization.
@@ -13,9 +16,15 @@ Available tools:
 - fabric_send: Start NEW conversation in a channel (#general, #planning, #tasks, #system)
 - fabric_reply: Reply to an EXISTING message thread (use the message_id from the message you're responding to)
 - fabric_react: Add/remove emoji reaction to a message (e.g., 👀 when starting work, ✅ when done)
+- fabric_edit / fabric_delete: Correct or remove a message you posted instead of posting a correction
 - report_implementation_complete: Report bd task completion with summary
 - report_review_verdict: Report code review verdict (APPROVED/DENIED)
+- request_assistance: Report that you are blocked and need input (reason, blocking_issue, needed_from), then end your turn
+- propose_subtasks: Split a task that is too big into bd subtasks for the coordinator to assign
 - post_accountability_summary: Save accountability summary for session tracking
+- record_decision: Record an architectural decision (context, decision, alternatives, consequences) for the team
+- search_codebase: Search the code by text or symbol name, with context lines and pagination
+- comment_on_task / read_task_comments: Add to or read the comment log on your task's bd issue (reply_to answers a comment)
 
 **IMPORTANT: fabric_send vs fabric_reply:**
 - When someone @mentions you in a message: use fabric_reply with that message's ID to continue the thread
@@ -27,7 +36,11 @@ Available tools:
 Workers receive tasks via messages and must report completion:
 - For bd tasks: use report_implementation_complete (falls back to fabric_reply if tool errors)
 - For task completions: use fabric_reply to the task assignment thread
-- For new topics or asking for help: use fabric_send`, workerID)
+- For new topics or asking for help: use fabric_send
+- When you cannot continue without input: use request_assistance instead of guessing
+- When a task is too big for one change: use propose_subtasks instead of describing the split in a message
+- When you make a design choice others will ask "why?" about: use record_decision
+- When you find something the next person on the task needs to know: use comment_on_task`, workerID)
 }
 
 // TaskAssignmentPrompt generates the prompt sent to a worker when assigning a task.
@@ -190,10 +203,17 @@ verification commands. Return: JSON with each criterion, status, and evidence."
 **Report using EXACTLY ONE tool call:**
 `+"```"+`
 report_implementation_complete(
-    summary="[What you implemented]. Tests: [X passing]. Acceptance: [Y/Y criteria met]. Files changed: [list key files]."
+    summary="[What you implemented]. Tests: [X passing]. Acceptance: [Y/Y criteria met]. Files changed: [list key files].",
+    self_assessment={
+        "confidence": [0.0-1.0],
+        "known_gaps": ["[anything you know is not handled]"],
+        "untested_areas": ["[code paths you did not test]"]
+    }
 )
 `+"```"+`
 
+The self_assessment is optional but strongly encouraged. Be honest: it is shown to your reviewer to target the review, and understating gaps only makes a denial more likely.
+
 ⚠️ This is your ONLY completion action. Do NOT also call fabric_send - the tool already notifies the coordinator.
 
 **Example:**
@@ -406,8 +426,14 @@ After you call this tool your turn ends and your in an idle state until the coor
 
 Use report_review_verdict with structured comments:
 
+For a DENIED verdict you must also pass structured findings. The implementer receives them as a checklist and must resolve every one before re-review:
+
+Score the change from 1 (poor) to 5 (excellent) on each rubric dimension. APPROVED requires correctness >= 4, tests >= 3, style >= 3 and security >= 4:
+
 report_review_verdict(
     verdict="APPROVED|DENIED",
+    findings=[{"file": "path/to/file.go", "location": "42", "severity": "blocker|major|minor|info", "description": "[problem and expected fix]"}],
+    scores={"correctness": 1-5, "tests": 1-5, "style": 1-5, "security": 1-5},
     comments="## Summary\n[1-2 sentence overview]\n\n## Sub-Reviewer Results\n| Reviewer | Verdict | Confidence | Summary |\n|----------|---------|------------|----------|\n| Correctness | PASS | 0.85 | ... |\n| Tests | PASS | 0.90 | ... |\n| Dead Code | PASS | 0.80 | ... |\n| Acceptance | PASS | 0.95 | 6/6 met |\n\n## Aggregate Findings\nBlockers: 0 | Majors: 0 | Minors: 2 | Info: 3\n\n## Issues (if any)\n[List issues by severity with location and fix]\n\n## Required Changes (if DENIED)\n1. [specific actionable feedback]\n2. [specific actionable feedback]"
 )`, implementerID, taskID, taskID)
 }
@@ -484,11 +510,45 @@ After you call this tool your turn ends and your in an idle state until the coor
 `+"```"+`
 report_review_verdict(
     verdict="APPROVED|DENIED",
+    findings=[{"file": "path/to/file.go", "location": "42", "severity": "major", "description": "[problem and expected fix]"}],  # required when DENIED
+    scores={"correctness": 1-5, "tests": 1-5, "style": 1-5, "security": 1-5},  # APPROVED requires correctness/security >= 4, tests/style >= 3
     comments="Quick review: [1-2 sentence summary]. Tests: PASS/FAIL. Acceptance: X/X met. [If DENIED: specific issues to fix]"
 )
 `+"```"+``, implementerID, taskID, taskID)
 }
 
+// ImplementerReportSection generates the section appended to a review assignment that
+// relays the implementer's completion summary and optional self-assessment.
+// When hasAssessment is false, only the summary is included.
+func ImplementerReportSection(summary string, hasAssessment bool, confidence float64, knownGaps, untestedAreas []string) string {
+	var sb strings.Builder
+	sb.WriteString("\n\n---\n\n## Implementer's Report\n")
+	if summary != "" {
+		fmt.Fprintf(&sb, "\n**Summary:** %s\n", summary)
+	}
+	if !hasAssessment {
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "\n**Self-assessed confidence:** %.0f%%\n", confidence*100)
+	writeList := func(title string, items []string) {
+		fmt.Fprintf(&sb, "\n**%s:**\n", title)
+		if len(items) == 0 {
+			sb.WriteString("- (none reported)\n")
+			return
+		}
+		for _, item := range items {
+			fmt.Fprintf(&sb, "- %s\n", item)
+		}
+	}
+	writeList("Known gaps", knownGaps)
+	writeList("Untested areas", untestedAreas)
+
+	sb.WriteString("\nTreat this as a starting point, not a substitute for review: verify the known gaps are acceptable, " +
+		"exercise the untested areas yourself, and scrutinize the work more closely when confidence is low.\n")
+	return sb.String()
+}
+
 // ReviewFeedbackPrompt generates the prompt sent to an implementer when their code was denied.
 func ReviewFeedbackPrompt(taskID, feedback string) string {
 	return fmt.Sprintf(`[REVIEW FEEDBACK]
@@ -503,13 +563,45 @@ Please address the feedback above and make the necessary changes.
 When you have addressed all feedback, report via fabric_reply(content="Ready for re-review on task %s").`, taskID, feedback, taskID)
 }
 
+// ReviewFindingsSection generates the section appended to review feedback that lists the
+// reviewer's open findings as a checklist the implementer must resolve before re-review.
+func ReviewFindingsSection(checklist []string) string {
+	var sb strings.Builder
+	sb.WriteString("\n\n---\n\n## Review Findings\n\n")
+	for _, line := range checklist {
+		sb.WriteString(line + "\n")
+	}
+	sb.WriteString(`
+Every finding must be resolved before the task can be re-reviewed. When you are done, report each resolution by finding number:
+
+report_implementation_complete(
+    summary="[what you changed]",
+    resolved_findings=[{"finding_id": 1, "resolution": "[how it was fixed, or why it does not apply]"}]
+)
+`)
+	return sb.String()
+}
+
+// PreviousFindingsSection generates the section appended to a re-review assignment that
+// lists the findings from the previous DENIED review with the implementer's resolutions.
+func PreviousFindingsSection(checklist []string) string {
+	var sb strings.Builder
+	sb.WriteString("\n\n---\n\n## Findings From Previous Review\n\n")
+	for _, line := range checklist {
+		sb.WriteString(line + "\n")
+	}
+	sb.WriteString("\nVerify each resolution against the code. Raise a finding again if it was not actually addressed.\n")
+	return sb.String()
+}
+
 // CommitApprovalPrompt generates the prompt sent to an implementer when their code is approved.
 func CommitApprovalPrompt(taskID, commitMessage string) string {
 	prompt := fmt.Sprintf(`[COMMIT APPROVED]
 
-Your implementation of task **%s** has been **APPROVED** by the reviewer.
+Your implementation of task **%[1]s** has been **APPROVED** by the reviewer.
 
-Please create a git commit for your changes.`, taskID)
+Please create a git commit for your changes. End the commit message with the trailer
+line "Perles-Task: %[1]s" so the commit is linked to the task.`, taskID)
 
 	if commitMessage != "" {
 		prompt += fmt.Sprintf(`
@@ -551,59 +643,3 @@ Then report via fabric_reply(content="Committed: [hash]").`, taskID)
 
 	return prompt
 }
-
-// AggregationWorkerPrompt generates the prompt for a worker assigned to aggregate
-// accountability summaries from all workers into a unified session summary.
-func AggregationWorkerPrompt(sessionDir string) string {
-	return fmt.Sprintf(`[AGGREGATION TASK]
-
-You are assigned to aggregate accountability summaries from all workers into a unified session summary.
-
-## Your Task
-
-1. Read all worker accountability summaries from: %s/workers/*/accountability_summary.md
-2. Generate an aggregated summary with all sections A-H
-3. Write the result to: %s/accountability_summary.md
-
-## Aggregated Summary Format (Sections A-H)
-
-### A. Session Metrics
-- Total Commits Made: [aggregate count with descriptions from all workers]
-- Issues Closed: [combined count with verification]
-- Issues Discovered: [combined count with bd IDs]
-
-### B. Needs Your Attention
-- Any decisions required or human verification items from workers
-
-### C. Progress
-- Visual progress tree showing epic/task completion status
-
-### D. What Was Accomplished This Session
-- Narrative combining all worker accomplishments, framed by epic progress
-- Attribute contributions to specific workers where relevant
-
-### E. Issues Discovered
-- Combined list of bugs/blockers found by workers during execution
-
-### F. Issues Closed (Verification)
-- Key verification points for each closed issue from all workers
-
-### G. Retro Feedback
-- **What Went Well**: Combined insights from all workers
-- **Friction**: Problems encountered during execution
-- **Patterns Noticed**: Recurring themes or approaches
-- **Takeaways**: Key learnings for future sessions
-
-### H. Next Steps
-- Combined recommendations from workers for follow-up work
-
-## Instructions
-
-1. Read each worker's accountability_summary.md file
-2. Merge metrics (deduplicate commits/issues lists)
-3. Combine narratives with worker attribution
-4. Preserve all verification points
-5. Write the aggregated summary in markdown format
-
-When complete, report via fabric_reply(content="Aggregation complete").`, sessionDir, sessionDir)
-}
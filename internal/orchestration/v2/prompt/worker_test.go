@@ -99,6 +99,14 @@ func TestCommitApprovalPrompt_UsesAccountabilitySummary(t *testing.T) {
 		"Prompt should NOT include post_reflections (deprecated)")
 }
 
+// TestCommitApprovalPrompt_AsksForTaskTrailer verifies the commit is tagged with the task ID.
+func TestCommitApprovalPrompt_AsksForTaskTrailer(t *testing.T) {
+	prompt := CommitApprovalPrompt("perles-abc.1", "")
+
+	require.Contains(t, prompt, "**perles-abc.1**")
+	require.Contains(t, prompt, `"Perles-Task: perles-abc.1"`)
+}
+
 // TestCommitApprovalPrompt_IncludesAccountabilityFields verifies all new fields are documented.
 func TestCommitApprovalPrompt_IncludesAccountabilityFields(t *testing.T) {
 	prompt := CommitApprovalPrompt("test-task", "")
@@ -143,3 +151,50 @@ func TestWorkerMCPInstructions_ContainsToolDescriptions(t *testing.T) {
 	require.Contains(t, instructions, "report_implementation_complete",
 		"Instructions should mention report_implementation_complete tool")
 }
+
+// ============================================================================
+// ImplementerReportSection Tests
+// ============================================================================
+
+// TestImplementerReportSection_WithAssessment verifies all self-assessment fields are rendered.
+func TestImplementerReportSection_WithAssessment(t *testing.T) {
+	section := ImplementerReportSection("Added retry logic", true, 0.65,
+		[]string{"no jitter on backoff"}, []string{"timeout path", "context cancellation"})
+
+	require.Contains(t, section, "## Implementer's Report")
+	require.Contains(t, section, "**Summary:** Added retry logic")
+	require.Contains(t, section, "**Self-assessed confidence:** 65%")
+	require.Contains(t, section, "- no jitter on backoff")
+	require.Contains(t, section, "- timeout path")
+	require.Contains(t, section, "- context cancellation")
+}
+
+// TestImplementerReportSection_EmptyLists verifies empty lists are shown explicitly.
+func TestImplementerReportSection_EmptyLists(t *testing.T) {
+	section := ImplementerReportSection("", true, 1, nil, nil)
+
+	require.NotContains(t, section, "**Summary:**")
+	require.Equal(t, 2, strings.Count(section, "(none reported)"))
+}
+
+// TestImplementerReportSection_SummaryOnly verifies no assessment fields without an assessment.
+func TestImplementerReportSection_SummaryOnly(t *testing.T) {
+	section := ImplementerReportSection("Fixed the bug", false, 0, nil, nil)
+
+	require.Contains(t, section, "**Summary:** Fixed the bug")
+	require.NotContains(t, section, "confidence")
+	require.NotContains(t, section, "Known gaps")
+}
+
+func TestReviewFindingsSection(t *testing.T) {
+	section := ReviewFindingsSection([]string{"- [ ] #1 [major] auth.go:42 - Token expiry not checked"})
+	require.Contains(t, section, "## Review Findings")
+	require.Contains(t, section, "- [ ] #1 [major] auth.go:42 - Token expiry not checked")
+	require.Contains(t, section, "resolved_findings=")
+}
+
+func TestPreviousFindingsSection(t *testing.T) {
+	section := PreviousFindingsSection([]string{"- [x] #1 [major] auth.go - Token expiry not checked (resolved: added check)"})
+	require.Contains(t, section, "## Findings From Previous Review")
+	require.Contains(t, section, "(resolved: added check)")
+}
@@ -5,6 +5,9 @@ package repository
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
@@ -25,6 +28,9 @@ var ErrQueueFull = errors.New("message queue is full")
 // ErrProcessNotFound is returned when a process ID does not exist in the repository.
 var ErrProcessNotFound = errors.New("process not found")
 
+// ErrDeadLetterNotFound is returned when a command ID has no dead letter.
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
 // ===========================================================================
 // Process Constants and Types (Unified Coordinator/Worker Model)
 // ===========================================================================
@@ -103,6 +109,40 @@ type Process struct {
 	// AgentType is the worker's specialization (generic, implementer, reviewer, researcher).
 	// Empty string represents generic (default). Only relevant for workers.
 	AgentType roles.AgentType
+	// Backend is the agent backend the worker was spawned with (e.g., codex).
+	// Empty string represents the default worker client. Only relevant for workers.
+	Backend string
+	// TokensSpent is the running total of tokens (context + output) across all turns.
+	// Unlike Metrics, which reflects the latest turn, it only grows. Used for budgets.
+	TokensSpent int
+	// Assistance is the worker's open request_assistance call (nil unless Phase is blocked).
+	Assistance *AssistanceRequest
+	// EmergencyStopped is true while the worker is paused by an emergency stop.
+	// EmergencyResume only resumes these workers.
+	EmergencyStopped bool
+}
+
+// AssistanceRequest records why a worker is blocked and what it needs.
+type AssistanceRequest struct {
+	// Reason is why the worker cannot continue.
+	Reason string
+	// BlockingIssue is the bd issue (or other item) blocking the worker, if any.
+	BlockingIssue string
+	// NeededFrom is who can unblock the worker: "coordinator", "user" or a worker ID.
+	NeededFrom string
+	// ResumePhase is the phase the worker returns to once it is answered.
+	ResumePhase events.ProcessPhase
+	// RequestedAt is when the worker asked for assistance.
+	RequestedAt time.Time
+}
+
+// RecordTurnMetrics stores the metrics of a completed turn and adds its tokens to TokensSpent.
+func (p *Process) RecordTurnMetrics(m *metrics.TokenMetrics) {
+	if m == nil {
+		return
+	}
+	p.Metrics = m
+	p.TokensSpent += m.TokensUsed + m.OutputTokens
 }
 
 // IsCoordinator returns true if this is the coordinator process.
@@ -126,6 +166,42 @@ func (p *Process) IsActive() bool {
 	return p.Status == StatusReady || p.Status == StatusWorking
 }
 
+// Budget caps the tokens and wall-clock time a worker or session may use.
+// Zero fields are unlimited.
+type Budget struct {
+	// Tokens is the maximum number of tokens spent (see Process.TokensSpent).
+	Tokens int
+	// Duration is the maximum wall-clock time since the worker or session started.
+	Duration time.Duration
+}
+
+// IsZero returns true if the budget has no limits.
+func (b Budget) IsZero() bool {
+	return b.Tokens <= 0 && b.Duration <= 0
+}
+
+// Used returns the fraction of the budget consumed (1.0 = exhausted), taking
+// whichever of tokens and time is closer to its limit. Returns 0 for a zero budget.
+func (b Budget) Used(tokens int, elapsed time.Duration) float64 {
+	var used float64
+	if b.Tokens > 0 {
+		used = float64(tokens) / float64(b.Tokens)
+	}
+	if b.Duration > 0 {
+		used = max(used, float64(elapsed)/float64(b.Duration))
+	}
+	return used
+}
+
+// TotalTokensSpent sums TokensSpent across processes, including retired ones.
+func TotalTokensSpent(processes []*Process) int {
+	total := 0
+	for _, p := range processes {
+		total += p.TokensSpent
+	}
+	return total
+}
+
 // TaskStatus represents the status of a task assignment.
 type TaskStatus string
 
@@ -162,6 +238,274 @@ type TaskAssignment struct {
 	// ThreadID is the Fabric thread ID for this task's conversation.
 	// All task-related messages should reply to this thread.
 	ThreadID string
+	// Summary is the implementer's most recent completion summary (empty until reported).
+	Summary string
+	// SelfAssessment is the implementer's structured self-evaluation (nil if not provided).
+	// Shown to the reviewer to focus review effort.
+	SelfAssessment *SelfAssessment
+	// Findings are the structured findings from the most recent DENIED review.
+	// Each must be resolved by the implementer before the task goes back to review.
+	Findings []ReviewFinding
+	// ReviewScores are the rubric scores from the most recent review (nil if none were given).
+	ReviewScores ReviewScores
+}
+
+// OpenFindings returns the review findings the implementer has not yet resolved.
+func (t *TaskAssignment) OpenFindings() []ReviewFinding {
+	var open []ReviewFinding
+	for _, f := range t.Findings {
+		if !f.Resolved() {
+			open = append(open, f)
+		}
+	}
+	return open
+}
+
+// SelfAssessment is a worker's structured evaluation of its own implementation,
+// optionally reported alongside report_implementation_complete.
+type SelfAssessment struct {
+	// Confidence is how confident the implementer is that the task is complete and correct (0.0-1.0).
+	Confidence float64
+	// KnownGaps lists requirements or edge cases the implementer knows are not handled.
+	KnownGaps []string
+	// UntestedAreas lists code paths or behaviors the implementer did not verify with tests.
+	UntestedAreas []string
+}
+
+// FindingSeverity ranks how serious a review finding is.
+type FindingSeverity string
+
+const (
+	// SeverityBlocker must be fixed; the change is incorrect or unsafe as written.
+	SeverityBlocker FindingSeverity = "blocker"
+	// SeverityMajor must be fixed before approval.
+	SeverityMajor FindingSeverity = "major"
+	// SeverityMinor should be fixed but would not block approval on its own.
+	SeverityMinor FindingSeverity = "minor"
+	// SeverityInfo is an observation or suggestion.
+	SeverityInfo FindingSeverity = "info"
+)
+
+// IsValid returns true if the severity is a known value.
+func (s FindingSeverity) IsValid() bool {
+	switch s {
+	case SeverityBlocker, SeverityMajor, SeverityMinor, SeverityInfo:
+		return true
+	}
+	return false
+}
+
+// ReviewFinding is a single structured issue raised by a reviewer in a DENIED verdict.
+type ReviewFinding struct {
+	// ID is the 1-based finding number within the review, used to report resolutions.
+	ID int
+	// File is the path of the file the finding refers to.
+	File string
+	// Location narrows the finding within the file (e.g., "42", "120-135", "func Parse").
+	Location string
+	// Severity ranks how serious the finding is.
+	Severity FindingSeverity
+	// Description explains the problem and, ideally, the expected fix.
+	Description string
+	// Resolution is the implementer's note on how the finding was addressed (empty while open).
+	Resolution string
+}
+
+// Resolved returns true once the implementer has reported a resolution.
+func (f ReviewFinding) Resolved() bool {
+	return f.Resolution != ""
+}
+
+// ChecklistLine renders the finding as a markdown checklist item,
+// e.g. "- [ ] #1 [major] parser.go:42 - nil input panics".
+func (f ReviewFinding) ChecklistLine() string {
+	box := "[ ]"
+	if f.Resolved() {
+		box = "[x]"
+	}
+	where := f.File
+	if f.Location != "" {
+		where += ":" + f.Location
+	}
+	line := fmt.Sprintf("- %s #%d [%s] %s - %s", box, f.ID, f.Severity, where, f.Description)
+	if f.Resolved() {
+		line += fmt.Sprintf(" (resolved: %s)", f.Resolution)
+	}
+	return line
+}
+
+// FindingsChecklist renders findings as markdown checklist lines.
+func FindingsChecklist(findings []ReviewFinding) []string {
+	lines := make([]string, len(findings))
+	for i, f := range findings {
+		lines[i] = f.ChecklistLine()
+	}
+	return lines
+}
+
+// ReviewDimension is an aspect of a change scored by the review rubric.
+type ReviewDimension string
+
+const (
+	// DimensionCorrectness scores whether the change does what the task requires without bugs.
+	DimensionCorrectness ReviewDimension = "correctness"
+	// DimensionTests scores whether the change is adequately tested.
+	DimensionTests ReviewDimension = "tests"
+	// DimensionStyle scores readability and consistency with the codebase.
+	DimensionStyle ReviewDimension = "style"
+	// DimensionSecurity scores the absence of vulnerabilities and unsafe handling.
+	DimensionSecurity ReviewDimension = "security"
+)
+
+// ReviewDimensions lists the rubric dimensions in display order.
+var ReviewDimensions = []ReviewDimension{DimensionCorrectness, DimensionTests, DimensionStyle, DimensionSecurity}
+
+const (
+	// MinReviewScore is the lowest rubric score.
+	MinReviewScore = 1
+	// MaxReviewScore is the highest rubric score.
+	MaxReviewScore = 5
+)
+
+// ReviewMinimums are the scores an APPROVED verdict must reach on each dimension.
+var ReviewMinimums = ReviewScores{
+	DimensionCorrectness: 4,
+	DimensionTests:       3,
+	DimensionStyle:       3,
+	DimensionSecurity:    4,
+}
+
+// ReviewScores holds a rubric score (MinReviewScore-MaxReviewScore) per dimension.
+type ReviewScores map[ReviewDimension]int
+
+// Validate checks that every dimension is scored within range and that no unknown
+// dimensions are present.
+func (s ReviewScores) Validate() error {
+	for dim := range s {
+		if _, ok := ReviewMinimums[dim]; !ok {
+			return fmt.Errorf("unknown review dimension %q", dim)
+		}
+	}
+	for _, dim := range ReviewDimensions {
+		score, ok := s[dim]
+		if !ok {
+			return fmt.Errorf("%s score is required", dim)
+		}
+		if score < MinReviewScore || score > MaxReviewScore {
+			return fmt.Errorf("%s score must be between %d and %d, got %d", dim, MinReviewScore, MaxReviewScore, score)
+		}
+	}
+	return nil
+}
+
+// BelowMinimum returns the dimensions scored below ReviewMinimums, in display order.
+func (s ReviewScores) BelowMinimum() []ReviewDimension {
+	var below []ReviewDimension
+	for _, dim := range ReviewDimensions {
+		if s[dim] < ReviewMinimums[dim] {
+			below = append(below, dim)
+		}
+	}
+	return below
+}
+
+// String renders the scores, e.g. "correctness 4/5, tests 3/5, style 5/5, security 4/5".
+func (s ReviewScores) String() string {
+	parts := make([]string, 0, len(ReviewDimensions))
+	for _, dim := range ReviewDimensions {
+		if score, ok := s[dim]; ok {
+			parts = append(parts, fmt.Sprintf("%s %d/%d", dim, score, MaxReviewScore))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ReviewPairing records that a reviewer was assigned to review an implementer's task.
+type ReviewPairing struct {
+	TaskID        string
+	ImplementerID string
+	ReviewerID    string
+	AssignedAt    time.Time
+}
+
+// DeadLetter records a command that failed, with its error context, so it can
+// be inspected and retried later.
+type DeadLetter struct {
+	CommandID   string
+	CommandType string
+	Source      string
+	TraceID     string
+	Summary     string // Readable form of the command, e.g. "AssignTask{worker=worker-1, ...}"
+	Error       string // Error of the latest attempt
+	Attempts    int    // Number of failed attempts, including retries
+	FirstFailed time.Time
+	LastFailed  time.Time
+
+	// Command is the failed command.Command, resubmitted on retry. It is typed
+	// any because the command package depends on this one.
+	Command any
+}
+
+// LastPairing returns the most recent pairing for the implementer, if any.
+// history must be in assignment order, as returned by ReviewHistoryRepository.All.
+func LastPairing(history []ReviewPairing, implementerID string) (ReviewPairing, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ImplementerID == implementerID {
+			return history[i], true
+		}
+	}
+	return ReviewPairing{}, false
+}
+
+// ReviewLoad returns the number of reviews assigned to each reviewer.
+func ReviewLoad(history []ReviewPairing) map[string]int {
+	load := make(map[string]int)
+	for _, p := range history {
+		load[p.ReviewerID]++
+	}
+	return load
+}
+
+// NextReviewer picks a reviewer for taskID from candidates (worker IDs, in
+// preference order for ties). It never picks the implementer, avoids repeating
+// the implementer's previous reviewer on a different task when another candidate
+// is available, and otherwise picks the candidate with the lowest review load.
+// A re-review of the same task keeps its previous reviewer when available, since
+// they already know the findings. Returns false if no candidate is eligible.
+func NextReviewer(candidates []string, taskID, implementerID string, history []ReviewPairing) (string, bool) {
+	last, hasLast := LastPairing(history, implementerID)
+
+	eligible := make([]string, 0, len(candidates))
+	for _, id := range candidates {
+		if id == implementerID {
+			continue
+		}
+		if hasLast && last.TaskID == taskID && last.ReviewerID == id {
+			return id, true
+		}
+		eligible = append(eligible, id)
+	}
+	if len(eligible) == 0 {
+		return "", false
+	}
+
+	if hasLast && last.TaskID != taskID && len(eligible) > 1 {
+		for i, id := range eligible {
+			if id == last.ReviewerID {
+				eligible = append(eligible[:i], eligible[i+1:]...)
+				break
+			}
+		}
+	}
+
+	load := ReviewLoad(history)
+	best := eligible[0]
+	for _, id := range eligible[1:] {
+		if load[id] < load[best] {
+			best = id
+		}
+	}
+	return best, true
 }
 
 // SenderType identifies who sent a message.
@@ -184,6 +528,8 @@ type QueueEntry struct {
 	Sender SenderType
 	// Timestamp is when this entry was enqueued.
 	Timestamp time.Time
+	// Urgent entries are delivered before non-urgent ones.
+	Urgent bool
 }
 
 // ===========================================================================
@@ -226,6 +572,25 @@ func (q *MessageQueue) Enqueue(content string, sender SenderType) error {
 	return nil
 }
 
+// EnqueueUrgent adds a message ahead of all non-urgent messages, after any
+// urgent messages already queued. Returns ErrQueueFull like Enqueue.
+func (q *MessageQueue) EnqueueUrgent(content string, sender SenderType) error {
+	if q.maxSize > 0 && len(q.entries) >= q.maxSize {
+		return ErrQueueFull
+	}
+	i := 0
+	for i < len(q.entries) && q.entries[i].Urgent {
+		i++
+	}
+	q.entries = slices.Insert(q.entries, i, QueueEntry{
+		Content:   content,
+		Sender:    sender,
+		Timestamp: time.Now(),
+		Urgent:    true,
+	})
+	return nil
+}
+
 // Dequeue removes and returns the first message from the queue.
 // Returns the entry and true if the queue had a message, or an empty entry and false if empty.
 func (q *MessageQueue) Dequeue() (*QueueEntry, bool) {
@@ -307,6 +672,36 @@ type QueueRepository interface {
 	ClearAll()
 }
 
+// ReviewHistoryRepository records reviewer/implementer pairings so reviews can
+// be rotated across workers. Implementations must be thread-safe.
+type ReviewHistoryRepository interface {
+	// Record appends a pairing to the history.
+	Record(pairing ReviewPairing) error
+
+	// All returns all pairings in assignment order.
+	All() []ReviewPairing
+}
+
+// DeadLetterRepository stores failed commands for inspection and retry.
+// Implementations must be thread-safe.
+type DeadLetterRepository interface {
+	// Record stores a failed command. A command that already has a dead letter
+	// (a failed retry) updates it: the error and failure time are replaced and
+	// Attempts is incremented.
+	Record(letter DeadLetter)
+
+	// Get retrieves the dead letter of a command.
+	// Returns ErrDeadLetterNotFound if the command has none.
+	Get(commandID string) (*DeadLetter, error)
+
+	// List returns all dead letters, oldest first.
+	List() []*DeadLetter
+
+	// Remove deletes the dead letter of a command.
+	// Returns ErrDeadLetterNotFound if the command has none.
+	Remove(commandID string) error
+}
+
 // ProcessRepository provides aggregate access for Process entities.
 // This is the unified repository for both coordinator and worker processes.
 // Implementations must be thread-safe.
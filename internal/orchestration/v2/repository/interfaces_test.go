@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/metrics"
 )
 
 // ===========================================================================
@@ -143,6 +145,29 @@ func TestMessageQueue_Dequeue_ReturnsFIFOOrder(t *testing.T) {
 	assert.Nil(t, entry)
 }
 
+func TestMessageQueue_EnqueueUrgent_JumpsAheadOfNormalEntries(t *testing.T) {
+	q := NewMessageQueue("coordinator", 10)
+
+	require.NoError(t, q.Enqueue("normal-1", SenderSystem))
+	require.NoError(t, q.EnqueueUrgent("urgent-1", SenderSystem))
+	require.NoError(t, q.Enqueue("normal-2", SenderSystem))
+	require.NoError(t, q.EnqueueUrgent("urgent-2", SenderSystem))
+
+	// Urgent entries keep their own FIFO order ahead of normal entries
+	var order []string
+	for entry, ok := q.Dequeue(); ok; entry, ok = q.Dequeue() {
+		order = append(order, entry.Content)
+	}
+	assert.Equal(t, []string{"urgent-1", "urgent-2", "normal-1", "normal-2"}, order)
+}
+
+func TestMessageQueue_EnqueueUrgent_RespectsMaxSize(t *testing.T) {
+	q := NewMessageQueue("coordinator", 1)
+
+	require.NoError(t, q.Enqueue("normal", SenderSystem))
+	assert.ErrorIs(t, q.EnqueueUrgent("urgent", SenderSystem), ErrQueueFull)
+}
+
 func TestMessageQueue_Dequeue_EmptyQueue(t *testing.T) {
 	q := NewMessageQueue("worker-1", 10)
 
@@ -412,3 +437,99 @@ func TestIsObserver_ReturnsFalse_ForOtherRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestReviewFinding_ChecklistLine(t *testing.T) {
+	f := ReviewFinding{ID: 2, File: "auth.go", Location: "42", Severity: SeverityMajor, Description: "Token expiry not checked"}
+	require.Equal(t, "- [ ] #2 [major] auth.go:42 - Token expiry not checked", f.ChecklistLine())
+
+	f.Location = ""
+	f.Resolution = "Added expiry check"
+	require.Equal(t, "- [x] #2 [major] auth.go - Token expiry not checked (resolved: Added expiry check)", f.ChecklistLine())
+}
+
+func TestTaskAssignment_OpenFindings(t *testing.T) {
+	task := &TaskAssignment{Findings: []ReviewFinding{
+		{ID: 1, Resolution: "fixed"},
+		{ID: 2},
+		{ID: 3},
+	}}
+
+	open := task.OpenFindings()
+	require.Len(t, open, 2)
+	require.Equal(t, 2, open[0].ID)
+	require.Equal(t, 3, open[1].ID)
+}
+
+func TestNextReviewer(t *testing.T) {
+	history := []ReviewPairing{
+		{TaskID: "t-1", ImplementerID: "worker-1", ReviewerID: "worker-2"},
+		{TaskID: "t-2", ImplementerID: "worker-3", ReviewerID: "worker-2"},
+		{TaskID: "t-3", ImplementerID: "worker-2", ReviewerID: "worker-4"},
+	}
+
+	tests := []struct {
+		name        string
+		candidates  []string
+		taskID      string
+		implementer string
+		want        string
+		ok          bool
+	}{
+		{"lowest load wins", []string{"worker-2", "worker-3", "worker-4"}, "t-9", "worker-5", "worker-3", true},
+		{"never the implementer", []string{"worker-1"}, "t-9", "worker-1", "", false},
+		{"previous reviewer skipped", []string{"worker-2", "worker-4"}, "t-9", "worker-1", "worker-4", true},
+		{"previous reviewer when only option", []string{"worker-1", "worker-2"}, "t-9", "worker-1", "worker-2", true},
+		{"re-review keeps reviewer", []string{"worker-3", "worker-2"}, "t-1", "worker-1", "worker-2", true},
+		{"no candidates", nil, "t-9", "worker-1", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NextReviewer(tt.candidates, tt.taskID, tt.implementer, history)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	require.Equal(t, map[string]int{"worker-2": 2, "worker-4": 1}, ReviewLoad(history))
+}
+
+// ===========================================================================
+// Budget Tests
+// ===========================================================================
+
+func TestProcess_RecordTurnMetrics(t *testing.T) {
+	proc := &Process{ID: "worker-1", Role: RoleWorker}
+
+	proc.RecordTurnMetrics(&metrics.TokenMetrics{TokensUsed: 30_000, OutputTokens: 2_000})
+	proc.RecordTurnMetrics(&metrics.TokenMetrics{TokensUsed: 40_000, OutputTokens: 1_000})
+	proc.RecordTurnMetrics(nil)
+
+	require.Equal(t, 73_000, proc.TokensSpent)
+	require.Equal(t, 40_000, proc.Metrics.TokensUsed, "Metrics reflects the latest turn")
+	require.Equal(t, 73_000, TotalTokensSpent([]*Process{proc, {TokensSpent: 0}}))
+}
+
+func TestBudget_Used(t *testing.T) {
+	require.True(t, Budget{}.IsZero())
+	require.Zero(t, Budget{}.Used(1_000, time.Hour))
+
+	b := Budget{Tokens: 100_000, Duration: time.Hour}
+	require.False(t, b.IsZero())
+	require.InDelta(t, 0.5, b.Used(50_000, 10*time.Minute), 0.001)
+	require.InDelta(t, 0.75, b.Used(10_000, 45*time.Minute), 0.001, "closest limit wins")
+	require.InDelta(t, 1.2, Budget{Tokens: 100}.Used(120, 0), 0.001)
+}
+
+func TestReviewScores_Validate(t *testing.T) {
+	scores := ReviewScores{DimensionCorrectness: 5, DimensionTests: 3, DimensionStyle: 4, DimensionSecurity: 4}
+	require.NoError(t, scores.Validate())
+	require.Empty(t, scores.BelowMinimum())
+	require.Equal(t, "correctness 5/5, tests 3/5, style 4/5, security 4/5", scores.String())
+
+	require.ErrorContains(t, ReviewScores{DimensionCorrectness: 5}.Validate(), "tests score is required")
+	require.ErrorContains(t, ReviewScores{DimensionCorrectness: 6, DimensionTests: 3, DimensionStyle: 3, DimensionSecurity: 3}.Validate(), "between 1 and 5")
+	require.ErrorContains(t, ReviewScores{"speed": 3}.Validate(), `unknown review dimension "speed"`)
+
+	low := ReviewScores{DimensionCorrectness: 3, DimensionTests: 2, DimensionStyle: 3, DimensionSecurity: 5}
+	require.Equal(t, []ReviewDimension{DimensionCorrectness, DimensionTests}, low.BelowMinimum())
+}
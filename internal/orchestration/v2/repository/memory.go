@@ -3,6 +3,7 @@
 package repository
 
 import (
+	"slices"
 	"sync"
 
 	"github.com/zjrosen/perles/internal/orchestration/events"
@@ -358,3 +359,133 @@ func (r *MemoryProcessRepository) AddProcess(process *Process) {
 
 	r.processes[process.ID] = process
 }
+
+// ===========================================================================
+// MemoryReviewHistoryRepository
+// ===========================================================================
+
+// MemoryReviewHistoryRepository is an in-memory implementation of ReviewHistoryRepository.
+// It is thread-safe using sync.RWMutex for concurrent access.
+type MemoryReviewHistoryRepository struct {
+	mu       sync.RWMutex
+	pairings []ReviewPairing
+}
+
+// NewMemoryReviewHistoryRepository creates a new in-memory review history repository.
+func NewMemoryReviewHistoryRepository() *MemoryReviewHistoryRepository {
+	return &MemoryReviewHistoryRepository{}
+}
+
+// Record appends a pairing to the history.
+func (r *MemoryReviewHistoryRepository) Record(pairing ReviewPairing) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pairings = append(r.pairings, pairing)
+	return nil
+}
+
+// All returns all pairings in assignment order.
+func (r *MemoryReviewHistoryRepository) All() []ReviewPairing {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]ReviewPairing, len(r.pairings))
+	copy(result, r.pairings)
+	return result
+}
+
+// ===========================================================================
+// MemoryDeadLetterRepository
+// ===========================================================================
+
+// DefaultMaxDeadLetters is the default number of dead letters kept. When full,
+// the oldest dead letter is dropped to make room.
+const DefaultMaxDeadLetters = 200
+
+// MemoryDeadLetterRepository is an in-memory implementation of DeadLetterRepository.
+// It is thread-safe using sync.RWMutex for concurrent access.
+type MemoryDeadLetterRepository struct {
+	mu         sync.RWMutex
+	letters    map[string]*DeadLetter
+	order      []string // Command IDs, oldest first
+	maxLetters int
+}
+
+// NewMemoryDeadLetterRepository creates a new in-memory dead letter repository
+// holding up to maxLetters dead letters. Zero or less uses DefaultMaxDeadLetters.
+func NewMemoryDeadLetterRepository(maxLetters int) *MemoryDeadLetterRepository {
+	if maxLetters <= 0 {
+		maxLetters = DefaultMaxDeadLetters
+	}
+	return &MemoryDeadLetterRepository{
+		letters:    make(map[string]*DeadLetter),
+		maxLetters: maxLetters,
+	}
+}
+
+// Record stores a failed command, or updates the dead letter of a failed retry.
+func (r *MemoryDeadLetterRepository) Record(letter DeadLetter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.letters[letter.CommandID]; ok {
+		existing.Error = letter.Error
+		existing.LastFailed = letter.LastFailed
+		existing.Attempts++
+		return
+	}
+
+	if len(r.order) >= r.maxLetters {
+		delete(r.letters, r.order[0])
+		r.order = r.order[1:]
+	}
+	if letter.Attempts == 0 {
+		letter.Attempts = 1
+	}
+	if letter.FirstFailed.IsZero() {
+		letter.FirstFailed = letter.LastFailed
+	}
+	r.letters[letter.CommandID] = &letter
+	r.order = append(r.order, letter.CommandID)
+}
+
+// Get retrieves the dead letter of a command.
+// Returns a copy to prevent external mutation.
+func (r *MemoryDeadLetterRepository) Get(commandID string) (*DeadLetter, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	letter, ok := r.letters[commandID]
+	if !ok {
+		return nil, ErrDeadLetterNotFound
+	}
+	letterCopy := *letter
+	return &letterCopy, nil
+}
+
+// List returns copies of all dead letters, oldest first.
+func (r *MemoryDeadLetterRepository) List() []*DeadLetter {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*DeadLetter, 0, len(r.order))
+	for _, id := range r.order {
+		letterCopy := *r.letters[id]
+		result = append(result, &letterCopy)
+	}
+	return result
+}
+
+// Remove deletes the dead letter of a command.
+func (r *MemoryDeadLetterRepository) Remove(commandID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.letters[commandID]; !ok {
+		return ErrDeadLetterNotFound
+	}
+	delete(r.letters, commandID)
+	r.order = slices.DeleteFunc(r.order, func(id string) bool { return id == commandID })
+	return nil
+}
@@ -615,3 +615,74 @@ func workerID(n int) string {
 func taskID(n int) string {
 	return fmt.Sprintf("perles-test.%d", n)
 }
+
+func TestMemoryReviewHistoryRepository_RecordAndAll(t *testing.T) {
+	repo := NewMemoryReviewHistoryRepository()
+	require.Empty(t, repo.All())
+
+	require.NoError(t, repo.Record(ReviewPairing{TaskID: "t-1", ImplementerID: "worker-1", ReviewerID: "worker-2"}))
+	require.NoError(t, repo.Record(ReviewPairing{TaskID: "t-2", ImplementerID: "worker-1", ReviewerID: "worker-3"}))
+
+	all := repo.All()
+	require.Len(t, all, 2)
+	require.Equal(t, "t-2", all[1].TaskID, "pairings are kept in assignment order")
+
+	// All returns a copy
+	all[0].ReviewerID = "changed"
+	require.Equal(t, "worker-2", repo.All()[0].ReviewerID)
+
+	last, ok := LastPairing(repo.All(), "worker-1")
+	require.True(t, ok)
+	require.Equal(t, "worker-3", last.ReviewerID)
+}
+
+func TestMemoryDeadLetterRepository_RecordRetryAndRemove(t *testing.T) {
+	repo := NewMemoryDeadLetterRepository(0)
+	require.Empty(t, repo.List())
+
+	first := time.Now()
+	repo.Record(DeadLetter{CommandID: "cmd-1", CommandType: "assign_task", Error: "worker busy", LastFailed: first})
+	repo.Record(DeadLetter{CommandID: "cmd-2", CommandType: "send_to_process", Error: "not found", LastFailed: first})
+
+	letter, err := repo.Get("cmd-1")
+	require.NoError(t, err)
+	require.Equal(t, 1, letter.Attempts)
+	require.Equal(t, first, letter.FirstFailed)
+
+	// A failed retry updates the existing dead letter
+	retried := first.Add(time.Minute)
+	repo.Record(DeadLetter{CommandID: "cmd-1", Error: "worker still busy", LastFailed: retried})
+	letter, err = repo.Get("cmd-1")
+	require.NoError(t, err)
+	require.Equal(t, 2, letter.Attempts)
+	require.Equal(t, "worker still busy", letter.Error)
+	require.Equal(t, first, letter.FirstFailed)
+	require.Equal(t, retried, letter.LastFailed)
+
+	// List returns copies, oldest first
+	list := repo.List()
+	require.Len(t, list, 2)
+	require.Equal(t, "cmd-1", list[0].CommandID)
+	list[0].Error = "changed"
+	letter, _ = repo.Get("cmd-1")
+	require.Equal(t, "worker still busy", letter.Error)
+
+	require.NoError(t, repo.Remove("cmd-1"))
+	require.ErrorIs(t, repo.Remove("cmd-1"), ErrDeadLetterNotFound)
+	_, err = repo.Get("cmd-1")
+	require.ErrorIs(t, err, ErrDeadLetterNotFound)
+	require.Len(t, repo.List(), 1)
+}
+
+func TestMemoryDeadLetterRepository_DropsOldestWhenFull(t *testing.T) {
+	repo := NewMemoryDeadLetterRepository(2)
+
+	repo.Record(DeadLetter{CommandID: "cmd-1"})
+	repo.Record(DeadLetter{CommandID: "cmd-2"})
+	repo.Record(DeadLetter{CommandID: "cmd-3"})
+
+	list := repo.List()
+	require.Len(t, list, 2)
+	require.Equal(t, "cmd-2", list[0].CommandID)
+	require.Equal(t, "cmd-3", list[1].CommandID)
+}
@@ -25,6 +25,10 @@ var ErrObserverExists = errors.New("observer already exists")
 // ErrMaxProcessesReached is returned when trying to spawn at max capacity.
 var ErrMaxProcessesReached = errors.New("maximum processes reached")
 
+// ErrBackendNotConfigured is returned when a worker is spawned with a backend
+// that isn't listed in orchestration.worker_backends.
+var ErrBackendNotConfigured = errors.New("agent backend is not configured")
+
 // ErrNotSpawning is returned when WorkerSpawned is called for a process not in spawning state.
 var ErrNotSpawning = errors.New("process is not in spawning state")
 
@@ -51,6 +55,9 @@ var ErrQueueEmpty = errors.New("message queue is empty")
 // ErrTaskNotApproved is returned when trying to commit a task that hasn't been approved.
 var ErrTaskNotApproved = errors.New("task has not been approved")
 
+// ErrTaskArchived is returned when trying to assign an archived task.
+var ErrTaskArchived = errors.New("task is archived")
+
 // ErrNoTaskAssigned is returned when trying to transition a process with no assigned task.
 var ErrNoTaskAssigned = errors.New("process has no task assigned")
 
@@ -79,6 +86,9 @@ var ErrProcessNotAwaitingReview = errors.New("process is not awaiting review")
 // ErrProcessNotImplementer is returned when a process is not the implementer of the task.
 var ErrProcessNotImplementer = errors.New("process is not the implementer of the task")
 
+// ErrUnresolvedFindings is returned when a task goes back to review with open review findings.
+var ErrUnresolvedFindings = errors.New("task has unresolved review findings")
+
 // ===========================================================================
 // Validation Errors
 // ===========================================================================
@@ -92,6 +102,10 @@ var ErrInvalidPhaseTransition = errors.New("invalid phase transition")
 // ErrReviewerIsImplementer is returned when trying to assign a reviewer who is also the implementer.
 var ErrReviewerIsImplementer = errors.New("reviewer cannot be the same as implementer")
 
+// ErrReviewerPairRepeated is returned when a reviewer would review the same implementer
+// twice in a row while another reviewer is available.
+var ErrReviewerPairRepeated = errors.New("reviewer reviewed this implementer's previous task")
+
 // ===========================================================================
 // Processor Errors
 // ===========================================================================
@@ -107,3 +121,7 @@ var ErrHandlerNotFound = errors.New("handler not found for command type")
 
 // ErrDuplicateCommand is returned when a duplicate command is detected within the TTL window.
 var ErrDuplicateCommand = fmt.Errorf("duplicate command detected within TTL window")
+
+// ErrNotAllowedInPhase is returned when the active workflow DAG phase does not
+// allow a coordinator action or the agent type of the worker it targets.
+var ErrNotAllowedInPhase = errors.New("not allowed in the current workflow phase")
@@ -0,0 +1,365 @@
+// Package webhook posts orchestration lifecycle events (a workflow completing,
+// a worker failing, a review being denied, ...) as JSON to HTTP endpoints such
+// as Slack incoming webhooks or CI triggers.
+//
+// Each endpoint subscribes to a set of event types and may sign its payloads
+// with an HMAC-SHA256 secret. Deliveries run in the background and are retried
+// with exponential backoff; a delivery that still fails is appended to the
+// dead-letter log so it can be inspected or replayed.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zjrosen/perles/internal/log"
+)
+
+// Event types that can be delivered.
+const (
+	EventWorkflowCompleted = "workflow.completed" // Coordinator signalled success or partial completion
+	EventWorkflowFailed    = "workflow.failed"    // Coordinator aborted the workflow
+	EventWorkerFailed      = "worker.failed"      // A worker process failed
+	EventReviewDenied      = "review.denied"      // A reviewer denied an implementation
+	EventTaskFailed        = "task.failed"        // A task was marked failed
+)
+
+// Events lists every event type, in the order they are documented.
+var Events = []string{
+	EventWorkflowCompleted,
+	EventWorkflowFailed,
+	EventWorkerFailed,
+	EventReviewDenied,
+	EventTaskFailed,
+}
+
+// Request headers set on every delivery.
+const (
+	HeaderEvent     = "X-Perles-Event"         // Event type
+	HeaderDelivery  = "X-Perles-Delivery"      // Event ID, the same for every retry
+	HeaderSignature = "X-Perles-Signature-256" // "sha256=" + hex HMAC of the body, when the endpoint has a secret
+)
+
+// Defaults for Config fields left zero.
+const (
+	DefaultMaxAttempts    = 5
+	DefaultInitialBackoff = time.Second
+	DefaultMaxBackoff     = time.Minute
+	DefaultTimeout        = 10 * time.Second
+)
+
+// Event is the JSON payload posted to endpoints.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Text is a one-line summary. Slack incoming webhooks display it as the message.
+	Text string `json:"text"`
+
+	WorkflowID   string `json:"workflow_id,omitempty"`
+	WorkflowName string `json:"workflow_name,omitempty"`
+	ProcessID    string `json:"process_id,omitempty"`
+	TaskID       string `json:"task_id,omitempty"`
+
+	// Data holds event-specific details (status, summary, reason, ...).
+	Data map[string]any `json:"data,omitempty"`
+}
+
+// NewEvent creates an event of the given type with a fresh ID and the current time.
+func NewEvent(eventType, text string) Event {
+	return Event{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Text:      text,
+	}
+}
+
+// Endpoint is a URL that receives a subset of events.
+type Endpoint struct {
+	URL    string
+	Events []string // Event types to deliver; empty delivers every type
+	Secret string   // HMAC-SHA256 signing key; empty sends unsigned payloads
+}
+
+// Wants reports whether the endpoint subscribes to the event type.
+func (e Endpoint) Wants(eventType string) bool {
+	return len(e.Events) == 0 || slices.Contains(e.Events, eventType)
+}
+
+// Config configures a Dispatcher.
+type Config struct {
+	Endpoints []Endpoint
+
+	MaxAttempts    int           // Attempts per delivery (0 = DefaultMaxAttempts)
+	InitialBackoff time.Duration // Wait before the first retry, doubled each time (0 = DefaultInitialBackoff)
+	MaxBackoff     time.Duration // Longest wait between retries (0 = DefaultMaxBackoff)
+	Timeout        time.Duration // Per-request timeout (0 = DefaultTimeout)
+
+	// DeadLetterPath is the JSONL file failed deliveries are appended to.
+	// Optional - if empty, failed deliveries are only logged.
+	DeadLetterPath string
+
+	// Client sends the requests. Optional - defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// Validate checks the endpoints.
+func (c Config) Validate() error {
+	for i, e := range c.Endpoints {
+		if e.URL == "" {
+			return fmt.Errorf("webhook endpoint %d has no url", i)
+		}
+		for _, t := range e.Events {
+			if !slices.Contains(Events, t) {
+				return fmt.Errorf("webhook endpoint %d: unknown event %q (valid: %v)", i, t, Events)
+			}
+		}
+	}
+	if c.MaxAttempts < 0 || c.InitialBackoff < 0 || c.MaxBackoff < 0 || c.Timeout < 0 {
+		return errors.New("webhook retry settings must not be negative")
+	}
+	return nil
+}
+
+// DeadLetter is a line of the dead-letter log.
+type DeadLetter struct {
+	Timestamp time.Time `json:"timestamp"`
+	URL       string    `json:"url"`
+	Attempts  int       `json:"attempts"`
+	Error     string    `json:"error"`
+	Event     Event     `json:"event"`
+}
+
+// Dispatcher delivers events to the configured endpoints in the background.
+// Thread-safe. A nil *Dispatcher drops every event.
+type Dispatcher struct {
+	cfg Config
+
+	ctx    context.Context // Cancelled by Close to abandon retries
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.Mutex // Guards closed and dead-letter writes
+	closed bool
+
+	sleep func(ctx context.Context, d time.Duration) error // Replaced in tests
+}
+
+// New creates a Dispatcher. Returns nil when no endpoints are configured.
+func New(cfg Config) *Dispatcher {
+	if len(cfg.Endpoints) == 0 {
+		return nil
+	}
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.InitialBackoff == 0 {
+		cfg.InitialBackoff = DefaultInitialBackoff
+	}
+	if cfg.MaxBackoff == 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Dispatcher{cfg: cfg, ctx: ctx, cancel: cancel, sleep: sleepContext}
+}
+
+// Dispatch queues the event for every endpoint that subscribes to its type.
+// It never blocks on the network.
+func (d *Dispatcher) Dispatch(event Event) {
+	if d == nil {
+		return
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to marshal webhook event", "type", event.Type, "error", err)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.closed {
+		return
+	}
+	for _, endpoint := range d.cfg.Endpoints {
+		if !endpoint.Wants(event.Type) {
+			continue
+		}
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.deliver(endpoint, event, body)
+		}()
+	}
+}
+
+// Close stops accepting events and waits for pending deliveries until ctx is
+// done. Deliveries still retrying then are abandoned and dead-lettered.
+func (d *Dispatcher) Close(ctx context.Context) error {
+	if d == nil {
+		return nil
+	}
+
+	d.mu.Lock()
+	d.closed = true
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		d.cancel()
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		<-done
+		return ctx.Err()
+	}
+}
+
+// deliver posts the body to the endpoint, retrying failures with backoff, and
+// dead-letters the event if every attempt fails.
+func (d *Dispatcher) deliver(endpoint Endpoint, event Event, body []byte) {
+	backoff := d.cfg.InitialBackoff
+	var err error
+	attempts := 0
+	for attempts < d.cfg.MaxAttempts {
+		attempts++
+		var retry bool
+		retry, err = d.post(endpoint, event, body)
+		if err == nil {
+			log.Debug(log.CatOrch, "Delivered webhook", "url", endpoint.URL, "type", event.Type, "attempts", attempts)
+			return
+		}
+		if !retry || attempts == d.cfg.MaxAttempts {
+			break
+		}
+
+		log.Debug(log.CatOrch, "Webhook delivery failed, retrying",
+			"url", endpoint.URL, "type", event.Type, "attempt", attempts, "backoff", backoff, "error", err)
+		if sleepErr := d.sleep(d.ctx, backoff); sleepErr != nil {
+			err = fmt.Errorf("%w (abandoned at shutdown)", err)
+			break
+		}
+		backoff = min(backoff*2, d.cfg.MaxBackoff)
+	}
+
+	log.Warn(log.CatOrch, "Webhook delivery failed", "url", endpoint.URL, "type", event.Type, "attempts", attempts, "error", err)
+	d.deadLetter(DeadLetter{
+		Timestamp: time.Now(),
+		URL:       endpoint.URL,
+		Attempts:  attempts,
+		Error:     err.Error(),
+		Event:     event,
+	})
+}
+
+// post sends one request. It reports whether a failure is worth retrying:
+// network errors, 429 and 5xx responses are; other responses are not.
+func (d *Dispatcher) post(endpoint Endpoint, event Event, body []byte) (retry bool, err error) {
+	ctx, cancel := context.WithTimeout(d.ctx, d.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "perles-webhook")
+	req.Header.Set(HeaderEvent, event.Type)
+	req.Header.Set(HeaderDelivery, event.ID)
+	if endpoint.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(endpoint.Secret, body))
+	}
+
+	resp, err := d.cfg.Client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("unexpected status %s", resp.Status)
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
+
+// deadLetter appends a failed delivery to the dead-letter log.
+func (d *Dispatcher) deadLetter(entry DeadLetter) {
+	if d.cfg.DeadLetterPath == "" {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to marshal webhook dead letter", "error", err)
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(d.cfg.DeadLetterPath), 0o750); err != nil {
+		log.Error(log.CatOrch, "Failed to create webhook dead-letter directory", "error", err)
+		return
+	}
+	f, err := os.OpenFile(d.cfg.DeadLetterPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		log.Error(log.CatOrch, "Failed to open webhook dead-letter log", "path", d.cfg.DeadLetterPath, "error", err)
+		return
+	}
+	defer func() { _ = f.Close() }()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		log.Error(log.CatOrch, "Failed to write webhook dead letter", "path", d.cfg.DeadLetterPath, "error", err)
+	}
+}
+
+// Sign returns the signature header value for a body: "sha256=" followed by
+// the hex HMAC-SHA256 of the body keyed with secret. Receivers recompute it
+// over the raw request body and compare in constant time.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
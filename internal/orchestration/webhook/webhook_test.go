@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// noSleep makes retries immediate.
+func noSleep(ctx context.Context, _ time.Duration) error {
+	return ctx.Err()
+}
+
+func readDeadLetters(t *testing.T, path string) []DeadLetter {
+	t.Helper()
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+
+	var letters []DeadLetter
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var dl DeadLetter
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &dl))
+		letters = append(letters, dl)
+	}
+	return letters
+}
+
+func TestDispatcher_DeliversSignedPayloadToSubscribedEndpoints(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	var mu sync.Mutex
+	got := map[string][]received{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		got[r.URL.Path] = append(got[r.URL.Path], received{header: r.Header.Clone(), body: body})
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	d := New(Config{Endpoints: []Endpoint{
+		{URL: srv.URL + "/slack", Events: []string{EventReviewDenied}, Secret: "s3cret"},
+		{URL: srv.URL + "/ci", Events: []string{EventWorkflowCompleted}},
+	}})
+
+	event := NewEvent(EventReviewDenied, "Review of perles-abc.1 denied")
+	event.WorkflowID = "wf-1"
+	event.TaskID = "perles-abc.1"
+	d.Dispatch(event)
+	require.NoError(t, d.Close(context.Background()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Empty(t, got["/ci"])
+	require.Len(t, got["/slack"], 1)
+
+	req := got["/slack"][0]
+	require.Equal(t, EventReviewDenied, req.header.Get(HeaderEvent))
+	require.Equal(t, event.ID, req.header.Get(HeaderDelivery))
+	require.Equal(t, "application/json", req.header.Get("Content-Type"))
+	require.Equal(t, Sign("s3cret", req.body), req.header.Get(HeaderSignature))
+
+	var payload Event
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+	require.Equal(t, "Review of perles-abc.1 denied", payload.Text)
+	require.Equal(t, "wf-1", payload.WorkflowID)
+	require.Equal(t, "perles-abc.1", payload.TaskID)
+}
+
+func TestDispatcher_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	deadLetters := filepath.Join(t.TempDir(), "webhooks.dead.jsonl")
+	d := New(Config{Endpoints: []Endpoint{{URL: srv.URL}}, DeadLetterPath: deadLetters})
+	d.sleep = noSleep
+
+	d.Dispatch(NewEvent(EventWorkerFailed, "worker-1 failed"))
+	require.NoError(t, d.Close(context.Background()))
+
+	require.Equal(t, int32(3), calls.Load())
+	require.Empty(t, readDeadLetters(t, deadLetters))
+}
+
+func TestDispatcher_DeadLettersFailedDeliveries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	deadLetters := filepath.Join(t.TempDir(), "webhooks.dead.jsonl")
+	d := New(Config{
+		Endpoints:      []Endpoint{{URL: srv.URL + "/gone"}, {URL: srv.URL + "/down"}},
+		MaxAttempts:    3,
+		DeadLetterPath: deadLetters,
+	})
+	d.sleep = noSleep
+
+	event := NewEvent(EventTaskFailed, "perles-abc.2 failed")
+	d.Dispatch(event)
+	require.NoError(t, d.Close(context.Background()))
+
+	// 404 is not retried, 500 is retried up to MaxAttempts
+	require.Equal(t, int32(4), calls.Load())
+
+	letters := readDeadLetters(t, deadLetters)
+	require.Len(t, letters, 2)
+	byURL := map[string]DeadLetter{}
+	for _, dl := range letters {
+		require.Equal(t, event.ID, dl.Event.ID)
+		byURL[dl.URL] = dl
+	}
+	require.Equal(t, 1, byURL[srv.URL+"/gone"].Attempts)
+	require.Equal(t, "unexpected status 404 Not Found", byURL[srv.URL+"/gone"].Error)
+	require.Equal(t, 3, byURL[srv.URL+"/down"].Attempts)
+}
+
+func TestDispatcher_CloseAbandonsRetries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	deadLetters := filepath.Join(t.TempDir(), "webhooks.dead.jsonl")
+	d := New(Config{Endpoints: []Endpoint{{URL: srv.URL}}, InitialBackoff: time.Hour, DeadLetterPath: deadLetters})
+
+	d.Dispatch(NewEvent(EventWorkflowFailed, "workflow aborted"))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, d.Close(ctx), context.DeadlineExceeded)
+
+	letters := readDeadLetters(t, deadLetters)
+	require.Len(t, letters, 1)
+	require.Equal(t, 1, letters[0].Attempts)
+	require.Contains(t, letters[0].Error, "abandoned at shutdown")
+
+	// Events after Close are dropped
+	d.Dispatch(NewEvent(EventWorkflowFailed, "late"))
+	require.Len(t, readDeadLetters(t, deadLetters), 1)
+}
+
+func TestNew_NoEndpoints(t *testing.T) {
+	d := New(Config{})
+	require.Nil(t, d)
+	d.Dispatch(NewEvent(EventWorkflowCompleted, "done")) // nil-safe
+	require.NoError(t, d.Close(context.Background()))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, Config{Endpoints: []Endpoint{{URL: "https://hooks.example.com", Events: []string{EventReviewDenied}}}}.Validate())
+	require.EqualError(t, Config{Endpoints: []Endpoint{{}}}.Validate(), "webhook endpoint 0 has no url")
+	require.ErrorContains(t, Config{Endpoints: []Endpoint{{URL: "https://x", Events: []string{"review.approved"}}}}.Validate(),
+		`webhook endpoint 0: unknown event "review.approved"`)
+}
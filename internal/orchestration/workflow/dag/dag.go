@@ -0,0 +1,370 @@
+// Package dag defines workflow DAGs: YAML files that split a workflow into
+// phases, each with the agent roles and coordinator actions it allows, the
+// transitions to the next phases and optional human checkpoints.
+package dag
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+)
+
+// Transition conditions, see Transition.When.
+const (
+	// WhenAlways lets the coordinator take the transition at any time (the default).
+	WhenAlways = "always"
+	// WhenTasksComplete requires at least one task assignment, all of them completed.
+	WhenTasksComplete = "tasks_complete"
+	// WhenNoActiveTasks requires that no task assignment is still open.
+	WhenNoActiveTasks = "no_active_tasks"
+)
+
+// Actions are the coordinator tools a phase can allow (see Phase.Actions).
+// Tools not listed here (messaging, queries, bd) are always allowed.
+var Actions = []string{
+	"spawn_worker",
+	"assign_task",
+	"assign_task_review",
+	"assign_review_feedback",
+	"approve_commit",
+	"mark_task_complete",
+	"mark_task_failed",
+	"signal_workflow_complete",
+}
+
+// Definition is a workflow DAG: the phases a workflow moves through, what
+// may happen in each, and the transitions between them. The coordinator moves
+// the workflow along with advance_phase; the command processor rejects the
+// coordinator actions the active phase does not allow.
+//
+// Example YAML:
+//
+//	name: feature
+//	phases:
+//	  - id: plan
+//	    roles: [researcher]
+//	    actions: [spawn_worker, assign_task]
+//	    next:
+//	      - to: clarification-review
+//	  - id: clarification-review
+//	    checkpoint: true
+//	    next:
+//	      - to: implement
+//	  - id: implement
+//	    roles: [implementer, reviewer]
+//	    next:
+//	      - to: done
+//	        when: tasks_complete
+//	  - id: done
+//	    actions: [signal_workflow_complete]
+type Definition struct {
+	Name        string  `yaml:"name"`
+	Description string  `yaml:"description"`
+	Phases      []Phase `yaml:"phases"` // The first phase is where the workflow starts
+}
+
+// Phase is a step of a DAG workflow.
+type Phase struct {
+	// ID names the phase, e.g. "clarification-review". Unique within the DAG.
+	ID string `yaml:"id"`
+
+	// Description tells the coordinator what the phase is for.
+	Description string `yaml:"description"`
+
+	// Roles are the agent types (implementer, reviewer, researcher) that may be
+	// given work in this phase. Generic workers may always be given work.
+	// Empty allows every agent type.
+	Roles []string `yaml:"roles"`
+
+	// Actions are the coordinator tools (see Actions) allowed in this phase.
+	// Empty allows all of them.
+	Actions []string `yaml:"actions"`
+
+	// Checkpoint makes the phase a human checkpoint: the user is notified when
+	// the workflow enters it, and it cannot be left until the user approves.
+	Checkpoint bool `yaml:"checkpoint"`
+
+	// Next are the phases the workflow may move to from this one.
+	// A phase without transitions ends the workflow.
+	Next []Transition `yaml:"next"`
+}
+
+// Transition is an edge of the DAG.
+type Transition struct {
+	To   string `yaml:"to"`   // Target phase ID
+	When string `yaml:"when"` // Condition: always (default), tasks_complete or no_active_tasks
+}
+
+// Load reads and validates a DAG definition file.
+func Load(path string) (*Definition, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: path comes from the user's configuration
+	if err != nil {
+		return nil, fmt.Errorf("reading workflow DAG: %w", err)
+	}
+	def, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("workflow DAG %s: %w", path, err)
+	}
+	return def, nil
+}
+
+// Parse parses and validates a DAG definition. Unknown fields are rejected
+// so typos do not silently loosen the workflow.
+func Parse(data []byte) (*Definition, error) {
+	var def Definition
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&def); err != nil {
+		return nil, fmt.Errorf("parsing YAML: %w", err)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// Validate checks that phase IDs are unique, roles, actions and conditions are
+// known, transitions lead to existing phases and the phases form no cycle.
+func (d *Definition) Validate() error {
+	if len(d.Phases) == 0 {
+		return fmt.Errorf("at least one phase is required")
+	}
+
+	seen := make(map[string]bool, len(d.Phases))
+	for i, p := range d.Phases {
+		if p.ID == "" {
+			return fmt.Errorf("phases[%d]: id is required", i)
+		}
+		if seen[p.ID] {
+			return fmt.Errorf("phase %q is defined twice", p.ID)
+		}
+		seen[p.ID] = true
+
+		for _, role := range p.Roles {
+			if role == "" || !roles.AgentType(role).IsValid() {
+				return fmt.Errorf("phase %q: unknown role %q (expected implementer, reviewer or researcher)", p.ID, role)
+			}
+		}
+		for _, action := range p.Actions {
+			if !slices.Contains(Actions, action) {
+				return fmt.Errorf("phase %q: unknown action %q (expected one of %s)", p.ID, action, strings.Join(Actions, ", "))
+			}
+		}
+	}
+
+	for _, p := range d.Phases {
+		for _, t := range p.Next {
+			if !seen[t.To] {
+				return fmt.Errorf("phase %q: transition to unknown phase %q", p.ID, t.To)
+			}
+			switch t.When {
+			case "", WhenAlways, WhenTasksComplete, WhenNoActiveTasks:
+			default:
+				return fmt.Errorf("phase %q: unknown condition %q on transition to %q (expected %s, %s or %s)",
+					p.ID, t.When, t.To, WhenAlways, WhenTasksComplete, WhenNoActiveTasks)
+			}
+		}
+	}
+
+	if cycle := d.findCycle(); cycle != nil {
+		return fmt.Errorf("phases form a cycle: %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// Phase returns the phase with the given ID.
+func (d *Definition) Phase(id string) (*Phase, bool) {
+	for i := range d.Phases {
+		if d.Phases[i].ID == id {
+			return &d.Phases[i], true
+		}
+	}
+	return nil, false
+}
+
+// findCycle returns the phase IDs of a cycle, first phase repeated at the end,
+// or nil if the phases form a DAG.
+func (d *Definition) findCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(d.Phases))
+	var path []string
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		state[id] = visiting
+		path = append(path, id)
+		p, _ := d.Phase(id)
+		for _, t := range p.Next {
+			switch state[t.To] {
+			case visiting:
+				start := slices.Index(path, t.To)
+				return append(slices.Clone(path[start:]), t.To)
+			case unvisited:
+				if cycle := visit(t.To); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[id] = done
+		return nil
+	}
+
+	for _, p := range d.Phases {
+		if state[p.ID] == unvisited {
+			if cycle := visit(p.ID); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
+
+// AllowsAction reports whether the coordinator may use the given tool in the phase.
+// Tools that are not phase-gated (see Actions) are always allowed.
+func (p *Phase) AllowsAction(action string) bool {
+	if len(p.Actions) == 0 || !slices.Contains(Actions, action) {
+		return true
+	}
+	return slices.Contains(p.Actions, action)
+}
+
+// AllowsRole reports whether a worker of the given agent type may be given work in the phase.
+func (p *Phase) AllowsRole(agentType roles.AgentType) bool {
+	if len(p.Roles) == 0 || agentType == roles.AgentTypeGeneric {
+		return true
+	}
+	return slices.Contains(p.Roles, string(agentType))
+}
+
+// Transition returns the transition from the phase to the phase with the given ID.
+func (p *Phase) Transition(to string) (Transition, bool) {
+	for _, t := range p.Next {
+		if t.To == to {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// Targets returns the IDs of the phases the workflow may move to from the phase.
+func (p *Phase) Targets() []string {
+	targets := make([]string, len(p.Next))
+	for i, t := range p.Next {
+		targets[i] = t.To
+	}
+	return targets
+}
+
+// Run tracks the progress of a workflow through its DAG: the active phase
+// and whether the user approved it when it is a checkpoint. Thread-safe.
+type Run struct {
+	def *Definition
+
+	mu       sync.RWMutex
+	current  string
+	approved bool // the user approved the current checkpoint phase
+	history  []string
+}
+
+// NewRun starts a run of def in its first phase.
+func NewRun(def *Definition) *Run {
+	start := def.Phases[0].ID
+	return &Run{def: def, current: start, history: []string{start}}
+}
+
+// Definition returns the DAG being run.
+func (r *Run) Definition() *Definition {
+	return r.def
+}
+
+// Current returns the active phase.
+func (r *Run) Current() Phase {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, _ := r.def.Phase(r.current)
+	return *p
+}
+
+// History returns the IDs of the phases entered so far, oldest first.
+func (r *Run) History() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Clone(r.history)
+}
+
+// AwaitingApproval reports whether the active phase is a checkpoint the user has not approved yet.
+func (r *Run) AwaitingApproval() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, _ := r.def.Phase(r.current)
+	return p.Checkpoint && !r.approved
+}
+
+// Approve records the user's approval of the active checkpoint phase.
+func (r *Run) Approve() (Phase, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p, _ := r.def.Phase(r.current)
+	if !p.Checkpoint {
+		return Phase{}, fmt.Errorf("phase %q is not a checkpoint", p.ID)
+	}
+	if r.approved {
+		return Phase{}, fmt.Errorf("phase %q is already approved", p.ID)
+	}
+	r.approved = true
+	return *p, nil
+}
+
+// Advance moves the workflow to the phase with the given ID. The move must
+// follow a transition of the active phase whose condition met reports as
+// satisfied, and a checkpoint phase must have been approved.
+// It returns the entered phase.
+func (r *Run) Advance(to string, met func(condition string) bool) (Phase, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	from, _ := r.def.Phase(r.current)
+	t, ok := from.Transition(to)
+	if !ok {
+		if len(from.Next) == 0 {
+			return Phase{}, fmt.Errorf("phase %q is the last phase of the workflow", from.ID)
+		}
+		return Phase{}, fmt.Errorf("phase %q cannot move to %q (next: %s)", from.ID, to, strings.Join(from.Targets(), ", "))
+	}
+	if from.Checkpoint && !r.approved {
+		return Phase{}, fmt.Errorf("phase %q is a human checkpoint awaiting the user's approval", from.ID)
+	}
+	if t.When != "" && t.When != WhenAlways && !met(t.When) {
+		return Phase{}, fmt.Errorf("cannot move from %q to %q until %s", from.ID, to, describeCondition(t.When))
+	}
+
+	r.current = to
+	r.approved = false
+	r.history = append(r.history, to)
+	p, _ := r.def.Phase(to)
+	return *p, nil
+}
+
+// describeCondition renders a transition condition for error messages.
+func describeCondition(condition string) string {
+	switch condition {
+	case WhenTasksComplete:
+		return "every task is completed"
+	case WhenNoActiveTasks:
+		return "no task is in progress"
+	default:
+		return condition
+	}
+}
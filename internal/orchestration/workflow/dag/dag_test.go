@@ -0,0 +1,140 @@
+package dag
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/v2/prompt/roles"
+)
+
+const featureDAG = `
+name: feature
+phases:
+  - id: plan
+    roles: [researcher]
+    actions: [spawn_worker, assign_task]
+    next:
+      - to: clarification-review
+  - id: clarification-review
+    checkpoint: true
+    next:
+      - to: implement
+  - id: implement
+    roles: [implementer, reviewer]
+    next:
+      - to: done
+        when: tasks_complete
+  - id: done
+    actions: [signal_workflow_complete]
+`
+
+func TestParse(t *testing.T) {
+	def, err := Parse([]byte(featureDAG))
+	require.NoError(t, err)
+	require.Equal(t, "feature", def.Name)
+	require.Len(t, def.Phases, 4)
+
+	p, ok := def.Phase("implement")
+	require.True(t, ok)
+	require.Equal(t, []string{"done"}, p.Targets())
+	_, ok = def.Phase("missing")
+	require.False(t, ok)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want string
+	}{
+		{"no phases", "name: x\n", "at least one phase"},
+		{"unknown field", "phases:\n  - id: a\n    role: [implementer]\n", "field role not found"},
+		{"missing id", "phases:\n  - description: a\n", "id is required"},
+		{"duplicate id", "phases:\n  - id: a\n  - id: a\n", "defined twice"},
+		{"unknown role", "phases:\n  - id: a\n    roles: [tester]\n", `unknown role "tester"`},
+		{"unknown action", "phases:\n  - id: a\n    actions: [fabric_send]\n", `unknown action "fabric_send"`},
+		{"unknown target", "phases:\n  - id: a\n    next: [{to: b}]\n", `unknown phase "b"`},
+		{"unknown condition", "phases:\n  - id: a\n    next: [{to: b, when: later}]\n  - id: b\n", `unknown condition "later"`},
+		{"cycle", "phases:\n  - id: a\n    next: [{to: b}]\n  - id: b\n    next: [{to: c}]\n  - id: c\n    next: [{to: b}]\n", "cycle: b -> c -> b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.yaml))
+			require.ErrorContains(t, err, tt.want)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feature.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(featureDAG), 0600))
+
+	def, err := Load(path)
+	require.NoError(t, err)
+	require.Equal(t, "plan", def.Phases[0].ID)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "reading workflow DAG")
+}
+
+func TestPhase_Allows(t *testing.T) {
+	def, err := Parse([]byte(featureDAG))
+	require.NoError(t, err)
+	plan, _ := def.Phase("plan")
+	review, _ := def.Phase("clarification-review")
+
+	require.True(t, plan.AllowsAction("assign_task"))
+	require.False(t, plan.AllowsAction("approve_commit"))
+	require.True(t, plan.AllowsAction("fabric_send"), "tools that are not phase-gated are always allowed")
+	require.True(t, review.AllowsAction("approve_commit"), "no actions allows all of them")
+
+	require.True(t, plan.AllowsRole(roles.AgentTypeResearcher))
+	require.True(t, plan.AllowsRole(roles.AgentTypeGeneric))
+	require.False(t, plan.AllowsRole(roles.AgentTypeImplementer))
+	require.True(t, review.AllowsRole(roles.AgentTypeImplementer), "no roles allows every agent type")
+}
+
+func TestRun_Advance(t *testing.T) {
+	def, err := Parse([]byte(featureDAG))
+	require.NoError(t, err)
+	run := NewRun(def)
+	always := func(string) bool { return true }
+	require.Equal(t, "plan", run.Current().ID)
+
+	_, err = run.Advance("implement", always)
+	require.ErrorContains(t, err, "cannot move to \"implement\" (next: clarification-review)")
+
+	entered, err := run.Advance("clarification-review", always)
+	require.NoError(t, err)
+	require.True(t, entered.Checkpoint)
+	require.True(t, run.AwaitingApproval())
+
+	_, err = run.Advance("implement", always)
+	require.ErrorContains(t, err, "awaiting the user's approval")
+
+	_, err = run.Approve()
+	require.NoError(t, err)
+	require.False(t, run.AwaitingApproval())
+	_, err = run.Approve()
+	require.ErrorContains(t, err, "already approved")
+
+	_, err = run.Advance("implement", always)
+	require.NoError(t, err)
+	_, err = run.Approve()
+	require.ErrorContains(t, err, "not a checkpoint")
+
+	var asked string
+	_, err = run.Advance("done", func(condition string) bool { asked = condition; return false })
+	require.ErrorContains(t, err, "until every task is completed")
+	require.Equal(t, WhenTasksComplete, asked)
+
+	_, err = run.Advance("done", always)
+	require.NoError(t, err)
+	_, err = run.Advance("plan", always)
+	require.ErrorContains(t, err, "last phase")
+
+	require.Equal(t, []string{"plan", "clarification-review", "implement", "done"}, run.History())
+}
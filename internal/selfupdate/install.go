@@ -0,0 +1,168 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// BinaryName is the name of the executable inside release archives.
+// Windows archives hold BinaryName + ".exe".
+const BinaryName = "perles"
+
+// binaryName returns the executable name for a platform.
+func binaryName(goos string) string {
+	if goos == "windows" {
+		return BinaryName + ".exe"
+	}
+	return BinaryName
+}
+
+// Updater downloads and verifies the binary of a release.
+type Updater struct {
+	Client    *Client
+	Signature Signature
+	// GOOS and GOARCH select the release archive, normally runtime.GOOS and runtime.GOARCH.
+	GOOS   string
+	GOARCH string
+}
+
+// Fetch downloads the release archive for the updater's platform, verifies it
+// against the checksums file (and the checksums file against its signatures,
+// when keys are configured) and returns the binary it contains.
+func (u *Updater) Fetch(ctx context.Context, release *Release) ([]byte, error) {
+	archiveName := ArchiveName(release.TagName, u.GOOS, u.GOARCH)
+
+	files := make(map[string][]byte)
+	for _, name := range append([]string{archiveName, ChecksumsAsset}, u.Signature.Assets()...) {
+		asset, err := release.Asset(name)
+		if err != nil {
+			return nil, err
+		}
+		data, err := u.Client.Download(ctx, asset)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = data
+	}
+
+	if err := u.Signature.Verify(ctx, files); err != nil {
+		return nil, err
+	}
+	if err := VerifyChecksum(files[ChecksumsAsset], archiveName, files[archiveName]); err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(archiveName, ".zip") {
+		return ExtractZipBinary(files[archiveName], binaryName(u.GOOS))
+	}
+	return ExtractBinary(files[archiveName], binaryName(u.GOOS))
+}
+
+// ExtractZipBinary returns the regular file called name from a .zip archive.
+func ExtractZipBinary(archive []byte, name string) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	for _, f := range zr.File {
+		if !f.Mode().IsRegular() || path.Base(f.Name) != name {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		defer func() { _ = rc.Close() }()
+		return io.ReadAll(io.LimitReader(rc, maxDownloadSize))
+	}
+	return nil, fmt.Errorf("%s not found in archive", name)
+}
+
+// ExtractBinary returns the regular file called name from a .tar.gz archive.
+func ExtractBinary(archive []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s not found in archive", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg || path.Base(hdr.Name) != name {
+			continue
+		}
+		return io.ReadAll(io.LimitReader(tr, maxDownloadSize))
+	}
+}
+
+// Replace atomically replaces the executable at target with binary.
+//
+// The new binary is written next to target and must run "--version"
+// successfully before it is swapped in. The previous binary is kept as
+// target+".old" during the swap and restored if the swap fails. Windows
+// cannot delete a running executable, so there the backup stays behind
+// until the next update removes it.
+func Replace(ctx context.Context, target string, binary []byte) error {
+	if resolved, err := filepath.EvalSymlinks(target); err == nil {
+		target = resolved
+	}
+	info, err := os.Stat(target)
+	if err != nil {
+		return fmt.Errorf("reading current binary: %w", err)
+	}
+
+	backup := target + ".old"
+	_ = os.Remove(backup)
+
+	// Keep the extension so Windows can run the new binary
+	ext := filepath.Ext(target)
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+strings.TrimSuffix(filepath.Base(target), ext)+".new-*"+ext)
+	if err != nil {
+		return fmt.Errorf("writing new binary (is %s writable?): %w", filepath.Dir(target), err)
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	_, err = tmp.Write(binary)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, info.Mode().Perm()|0o111)
+	}
+	if err != nil {
+		return fmt.Errorf("writing new binary: %w", err)
+	}
+
+	if out, err := runCommand(ctx, tmpPath, "--version"); err != nil {
+		return fmt.Errorf("new binary does not run, keeping the current one: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	if err := os.Rename(target, backup); err != nil {
+		return fmt.Errorf("backing up current binary: %w", err)
+	}
+	if err := os.Rename(tmpPath, target); err != nil {
+		if restoreErr := os.Rename(backup, target); restoreErr != nil {
+			return fmt.Errorf("installing new binary: %w (restoring %s failed: %v)", err, backup, restoreErr)
+		}
+		return fmt.Errorf("installing new binary, restored the current one: %w", err)
+	}
+	_ = os.Remove(backup)
+	return nil
+}
@@ -0,0 +1,184 @@
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestExtractBinary(t *testing.T) {
+	archive := tarGz(t, map[string]string{"README.md": "docs", "perles": "binary"})
+
+	binary, err := ExtractBinary(archive, BinaryName)
+	require.NoError(t, err)
+	require.Equal(t, []byte("binary"), binary)
+
+	_, err = ExtractBinary(archive, "missing")
+	require.ErrorContains(t, err, "missing not found in archive")
+
+	_, err = ExtractBinary([]byte("not gzip"), BinaryName)
+	require.ErrorContains(t, err, "reading archive")
+}
+
+func TestExtractZipBinary(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{"LICENSE": "MIT", "perles.exe": "binary"} {
+		f, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	binary, err := ExtractZipBinary(buf.Bytes(), binaryName("windows"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("binary"), binary)
+
+	_, err = ExtractZipBinary(buf.Bytes(), binaryName("linux"))
+	require.ErrorContains(t, err, "perles not found in archive")
+}
+
+func TestArchiveName(t *testing.T) {
+	require.Equal(t, "perles_1.2.0_darwin_arm64.tar.gz", ArchiveName("v1.2.0", "darwin", "arm64"))
+	require.Equal(t, "perles_1.2.0_windows_amd64.zip", ArchiveName("v1.2.0", "windows", "amd64"))
+}
+
+func TestUpdater_Fetch(t *testing.T) {
+	archive := tarGz(t, map[string]string{"perles": "new binary"})
+	sum := sha256.Sum256(archive)
+	archiveName := ArchiveName("v1.2.0", "linux", "amd64")
+	require.Equal(t, "perles_1.2.0_linux_amd64.tar.gz", archiveName)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases/latest":
+			_ = json.NewEncoder(w).Encode(Release{TagName: "v1.2.0", Assets: []Asset{
+				{Name: archiveName, URL: server.URL + "/archive", Size: int64(len(archive))},
+				{Name: ChecksumsAsset, URL: server.URL + "/checksums"},
+			}})
+		case "/archive":
+			_, _ = w.Write(archive)
+		case "/checksums":
+			_, _ = w.Write([]byte(hex.EncodeToString(sum[:]) + "  " + archiveName + "\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := &Client{APIURL: server.URL, HTTP: server.Client()}
+	release, err := client.Latest(context.Background(), ChannelStable)
+	require.NoError(t, err)
+
+	binary, err := (&Updater{Client: client, GOOS: "linux", GOARCH: "amd64"}).Fetch(context.Background(), release)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new binary"), binary)
+
+	// No archive for the platform
+	_, err = (&Updater{Client: client, GOOS: "plan9", GOARCH: "amd64"}).Fetch(context.Background(), release)
+	require.ErrorIs(t, err, ErrAssetNotFound)
+}
+
+func TestReplace(t *testing.T) {
+	stubTools(t, func(name string, args ...string) ([]byte, error) {
+		require.Equal(t, []string{"--version"}, args)
+		return []byte("perles v1.2.0"), nil
+	})
+	target := filepath.Join(t.TempDir(), "perles")
+	require.NoError(t, os.WriteFile(target, []byte("old"), 0o755))
+
+	require.NoError(t, Replace(context.Background(), target, []byte("new")))
+
+	data, err := os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), data)
+	info, err := os.Stat(target)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+	entries, err := os.ReadDir(filepath.Dir(target))
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temp and backup files are removed")
+}
+
+func TestReplace_BrokenBinaryKeepsCurrent(t *testing.T) {
+	stubTools(t, func(name string, args ...string) ([]byte, error) {
+		return []byte("exec format error"), errors.New("exit status 126")
+	})
+	target := filepath.Join(t.TempDir(), "perles")
+	require.NoError(t, os.WriteFile(target, []byte("old"), 0o755))
+
+	err := Replace(context.Background(), target, []byte("garbage"))
+
+	require.ErrorContains(t, err, "new binary does not run")
+	data, readErr := os.ReadFile(target)
+	require.NoError(t, readErr)
+	require.Equal(t, []byte("old"), data)
+	entries, readErr := os.ReadDir(filepath.Dir(target))
+	require.NoError(t, readErr)
+	require.Len(t, entries, 1)
+}
+
+func TestReplace_KeepsExtension(t *testing.T) {
+	var ran string
+	stubTools(t, func(name string, args ...string) ([]byte, error) {
+		ran = name
+		return nil, nil
+	})
+	target := filepath.Join(t.TempDir(), "perles.exe")
+	require.NoError(t, os.WriteFile(target, []byte("old"), 0o755))
+	require.NoError(t, os.WriteFile(target+".old", []byte("left by a previous update"), 0o755))
+
+	require.NoError(t, Replace(context.Background(), target, []byte("new")))
+
+	require.Equal(t, ".exe", filepath.Ext(ran), "Windows only runs files with an executable extension")
+	_, err := os.Stat(target + ".old")
+	require.True(t, os.IsNotExist(err), "stale backups are removed")
+}
+
+func TestReplace_FollowsSymlink(t *testing.T) {
+	stubTools(t, func(name string, args ...string) ([]byte, error) { return nil, nil })
+	dir := t.TempDir()
+	target := filepath.Join(dir, "perles-1.1.0")
+	link := filepath.Join(dir, "perles")
+	require.NoError(t, os.WriteFile(target, []byte("old"), 0o755))
+	require.NoError(t, os.Symlink(target, link))
+
+	require.NoError(t, Replace(context.Background(), link, []byte("new")))
+
+	data, err := os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, []byte("new"), data)
+	resolved, err := os.Readlink(link)
+	require.NoError(t, err)
+	require.Equal(t, target, resolved)
+}
@@ -0,0 +1,196 @@
+// Package selfupdate downloads perles releases from GitHub, verifies them and
+// replaces the running binary.
+//
+// A release archive is only installed when its SHA256 matches the release's
+// checksums file. When a minisign or cosign public key is configured, the
+// checksums file must also carry a valid signature. The binary is swapped
+// atomically and the previous one is restored if the swap fails.
+package selfupdate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Release asset names, as published by GoReleaser.
+const (
+	ChecksumsAsset         = "checksums.txt"
+	MinisignSignatureAsset = "checksums.txt.minisig" // minisign -Sm checksums.txt
+	CosignSignatureAsset   = "checksums.txt.sig"     // cosign sign-blob --key ... checksums.txt
+)
+
+// DefaultAPIURL is the GitHub API endpoint of the perles repository.
+const DefaultAPIURL = "https://api.github.com/repos/zjrosen/perles"
+
+// maxDownloadSize caps release downloads.
+const maxDownloadSize = 256 << 20
+
+// ErrAssetNotFound is returned when a release has no asset with the requested name.
+var ErrAssetNotFound = errors.New("release asset not found")
+
+// Channel selects which releases count as the latest one.
+type Channel string
+
+// Release channels.
+const (
+	// ChannelStable follows GitHub's latest release, which excludes prereleases.
+	ChannelStable Channel = "stable"
+	// ChannelPrerelease follows the newest published release, prerelease or not.
+	ChannelPrerelease Channel = "prerelease"
+)
+
+// ParseChannel validates a channel name. Empty selects ChannelStable.
+func ParseChannel(name string) (Channel, error) {
+	switch Channel(name) {
+	case "", ChannelStable:
+		return ChannelStable, nil
+	case ChannelPrerelease:
+		return ChannelPrerelease, nil
+	}
+	return "", fmt.Errorf("unknown release channel %q (valid: %s, %s)", name, ChannelStable, ChannelPrerelease)
+}
+
+// Release is a GitHub release.
+type Release struct {
+	TagName    string  `json:"tag_name"`
+	Draft      bool    `json:"draft"`
+	Prerelease bool    `json:"prerelease"`
+	Assets     []Asset `json:"assets"`
+}
+
+// Asset is a file attached to a release.
+type Asset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+	Size int64  `json:"size"`
+}
+
+// Asset returns the asset with the given name.
+func (r *Release) Asset(name string) (Asset, error) {
+	for _, a := range r.Assets {
+		if a.Name == name {
+			return a, nil
+		}
+	}
+	return Asset{}, fmt.Errorf("%w: %s has no %s", ErrAssetNotFound, r.TagName, name)
+}
+
+// ArchiveName returns the name of the release archive for a platform,
+// e.g. "perles_1.2.0_linux_amd64.tar.gz" for tag "v1.2.0". Windows
+// archives are zip files.
+func ArchiveName(tag, goos, goarch string) string {
+	ext := ".tar.gz"
+	if goos == "windows" {
+		ext = ".zip"
+	}
+	return fmt.Sprintf("perles_%s_%s_%s%s", strings.TrimPrefix(tag, "v"), goos, goarch, ext)
+}
+
+// Client fetches releases and their assets.
+type Client struct {
+	// APIURL is the repository's API endpoint (default: DefaultAPIURL).
+	APIURL string
+	// HTTP sends the requests (default: http.DefaultClient).
+	HTTP *http.Client
+}
+
+// Latest returns the latest release of a channel.
+func (c *Client) Latest(ctx context.Context, channel Channel) (*Release, error) {
+	if channel != ChannelPrerelease {
+		return c.release(ctx, "/releases/latest")
+	}
+
+	// GitHub lists releases newest first
+	body, err := c.get(ctx, c.apiURL()+"/releases?per_page=30", 4<<20)
+	if err != nil {
+		return nil, fmt.Errorf("fetching releases: %w", err)
+	}
+	var releases []Release
+	if err := json.Unmarshal(body, &releases); err != nil {
+		return nil, fmt.Errorf("decoding releases: %w", err)
+	}
+	for i := range releases {
+		if !releases[i].Draft && releases[i].TagName != "" {
+			return &releases[i], nil
+		}
+	}
+	return nil, errors.New("fetching releases: no published release found")
+}
+
+// Tag returns the release with the given tag, e.g. "v1.2.0".
+func (c *Client) Tag(ctx context.Context, tag string) (*Release, error) {
+	return c.release(ctx, "/releases/tags/"+url.PathEscape(tag))
+}
+
+func (c *Client) apiURL() string {
+	if c.APIURL == "" {
+		return DefaultAPIURL
+	}
+	return strings.TrimRight(c.APIURL, "/")
+}
+
+func (c *Client) release(ctx context.Context, path string) (*Release, error) {
+	body, err := c.get(ctx, c.apiURL()+path, 1<<20)
+	if err != nil {
+		return nil, fmt.Errorf("fetching release: %w", err)
+	}
+
+	var release Release
+	if err := json.Unmarshal(body, &release); err != nil {
+		return nil, fmt.Errorf("decoding release: %w", err)
+	}
+	if release.TagName == "" {
+		return nil, errors.New("decoding release: missing tag_name")
+	}
+	return &release, nil
+}
+
+// Download returns the content of a release asset.
+func (c *Client) Download(ctx context.Context, asset Asset) ([]byte, error) {
+	data, err := c.get(ctx, asset.URL, maxDownloadSize)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", asset.Name, err)
+	}
+	if asset.Size > 0 && int64(len(data)) != asset.Size {
+		return nil, fmt.Errorf("downloading %s: got %d bytes, expected %d", asset.Name, len(data), asset.Size)
+	}
+	return data, nil
+}
+
+// get fetches a URL, failing for non-200 responses and bodies over limit bytes.
+func (c *Client) get(ctx context.Context, rawURL string, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "perles-update")
+
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s returned status %d", rawURL, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("GET %s: response exceeds %d bytes", rawURL, limit)
+	}
+	return data, nil
+}
@@ -0,0 +1,53 @@
+package selfupdate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChannel(t *testing.T) {
+	for name, want := range map[string]Channel{"": ChannelStable, "stable": ChannelStable, "prerelease": ChannelPrerelease} {
+		got, err := ParseChannel(name)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	_, err := ParseChannel("beta")
+	require.ErrorContains(t, err, "unknown release channel")
+}
+
+func TestClient_Latest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "perles-update", r.Header.Get("User-Agent"))
+		switch r.URL.Path {
+		case "/releases/latest":
+			_, _ = w.Write([]byte(`{"tag_name": "v1.0.0"}`))
+		case "/releases":
+			_, _ = w.Write([]byte(`[
+				{"tag_name": "v1.2.0-rc.1", "draft": true},
+				{"tag_name": "v1.1.0-rc.2", "prerelease": true},
+				{"tag_name": "v1.0.0"}
+			]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client := &Client{APIURL: server.URL, HTTP: server.Client()}
+
+	stable, err := client.Latest(context.Background(), ChannelStable)
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", stable.TagName)
+
+	pre, err := client.Latest(context.Background(), ChannelPrerelease)
+	require.NoError(t, err)
+	require.Equal(t, "v1.1.0-rc.2", pre.TagName, "drafts are skipped")
+	require.True(t, pre.Prerelease)
+
+	_, err = client.Tag(context.Background(), "v0.0.1")
+	require.ErrorContains(t, err, "status 404")
+}
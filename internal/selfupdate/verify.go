@@ -0,0 +1,137 @@
+package selfupdate
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when a download does not match its published checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ErrSignatureInvalid is returned when the checksums file's signature does not verify.
+var ErrSignatureInvalid = errors.New("signature verification failed")
+
+// runCommand runs a verification tool and returns its combined output.
+// Replaced in tests.
+var runCommand = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, name, args...).CombinedOutput()
+}
+
+// lookPath finds a verification tool. Replaced in tests.
+var lookPath = exec.LookPath
+
+// VerifyChecksum checks data against the SHA256 listed for name in a
+// sha256sum-style checksums file ("<hex>  <name>" per line).
+func VerifyChecksum(checksums []byte, name string, data []byte) error {
+	var want string
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			want = strings.ToLower(fields[0])
+			break
+		}
+	}
+	if want == "" {
+		return fmt.Errorf("%w: %s is not listed in %s", ErrChecksumMismatch, name, ChecksumsAsset)
+	}
+
+	sum := sha256.Sum256(data)
+	got := hex.EncodeToString(sum[:])
+	if subtle.ConstantTimeCompare([]byte(got), []byte(want)) != 1 {
+		return fmt.Errorf("%w: %s has sha256 %s, expected %s", ErrChecksumMismatch, name, got, want)
+	}
+	return nil
+}
+
+// Signature holds the public keys the checksums file must be signed with.
+// Each configured key requires its signature asset; with no keys, signatures
+// are not checked. Verification uses the minisign and cosign tools, which must
+// be installed when their key is set.
+type Signature struct {
+	// Minisign is a minisign public key: the base64 key ("RW...") or a path to a .pub file.
+	Minisign string
+	// Cosign is a cosign public key reference: a path to a PEM file, env://VAR, or a KMS URI.
+	Cosign string
+}
+
+// Enabled reports whether any signature must be verified.
+func (s Signature) Enabled() bool {
+	return s.Minisign != "" || s.Cosign != ""
+}
+
+// Assets returns the names of the signature assets to download.
+func (s Signature) Assets() []string {
+	var assets []string
+	if s.Minisign != "" {
+		assets = append(assets, MinisignSignatureAsset)
+	}
+	if s.Cosign != "" {
+		assets = append(assets, CosignSignatureAsset)
+	}
+	return assets
+}
+
+// Verify checks the signatures of the checksums file. files maps asset names
+// to their content and must hold ChecksumsAsset and every asset of Assets.
+func (s Signature) Verify(ctx context.Context, files map[string][]byte) error {
+	if !s.Enabled() {
+		return nil
+	}
+
+	dir, err := os.MkdirTemp("", "perles-verify-*")
+	if err != nil {
+		return fmt.Errorf("creating verification directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	for _, name := range append([]string{ChecksumsAsset}, s.Assets()...) {
+		data, ok := files[name]
+		if !ok {
+			return fmt.Errorf("%w: missing %s", ErrSignatureInvalid, name)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			return fmt.Errorf("writing %s: %w", name, err)
+		}
+	}
+	checksums := filepath.Join(dir, ChecksumsAsset)
+
+	if s.Minisign != "" {
+		keyArgs := []string{"-P", s.Minisign}
+		if strings.HasSuffix(s.Minisign, ".pub") {
+			keyArgs = []string{"-p", s.Minisign}
+		}
+		args := append([]string{"-V", "-m", checksums, "-x", filepath.Join(dir, MinisignSignatureAsset)}, keyArgs...)
+		if err := verifyWith(ctx, "minisign", args...); err != nil {
+			return err
+		}
+	}
+	if s.Cosign != "" {
+		if err := verifyWith(ctx, "cosign", "verify-blob", "--key", s.Cosign,
+			"--signature", filepath.Join(dir, CosignSignatureAsset), checksums); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyWith runs a verification tool, failing if it is missing or rejects the signature.
+func verifyWith(ctx context.Context, tool string, args ...string) error {
+	if _, err := lookPath(tool); err != nil {
+		return fmt.Errorf("%s is required to verify the release signature but was not found in PATH", tool)
+	}
+	if out, err := runCommand(ctx, tool, args...); err != nil {
+		return fmt.Errorf("%w (%s): %s", ErrSignatureInvalid, tool, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
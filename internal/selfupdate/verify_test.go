@@ -0,0 +1,98 @@
+package selfupdate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func stubTools(t *testing.T, run func(name string, args ...string) ([]byte, error)) {
+	t.Helper()
+	originalRun, originalLookPath := runCommand, lookPath
+	t.Cleanup(func() { runCommand, lookPath = originalRun, originalLookPath })
+	runCommand = func(_ context.Context, name string, args ...string) ([]byte, error) {
+		return run(name, args...)
+	}
+	lookPath = func(file string) (string, error) { return "/usr/bin/" + file, nil }
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("archive")
+	sum := sha256.Sum256(data)
+	checksums := []byte("abc123  perles_1.0.0_darwin_arm64.tar.gz\n" +
+		hex.EncodeToString(sum[:]) + "  perles_1.0.0_linux_amd64.tar.gz\n")
+
+	require.NoError(t, VerifyChecksum(checksums, "perles_1.0.0_linux_amd64.tar.gz", data))
+	require.ErrorIs(t, VerifyChecksum(checksums, "perles_1.0.0_linux_amd64.tar.gz", []byte("tampered")), ErrChecksumMismatch)
+	require.ErrorIs(t, VerifyChecksum(checksums, "perles_1.0.0_windows_amd64.tar.gz", data), ErrChecksumMismatch)
+}
+
+func TestSignature_Disabled(t *testing.T) {
+	var s Signature
+	require.False(t, s.Enabled())
+	require.Empty(t, s.Assets())
+	require.NoError(t, s.Verify(context.Background(), nil))
+}
+
+func TestSignature_Verify(t *testing.T) {
+	var calls [][]string
+	stubTools(t, func(name string, args ...string) ([]byte, error) {
+		calls = append(calls, append([]string{name}, args...))
+		// The tools read the files written to the verification directory
+		_, err := os.Stat(args[len(args)-1])
+		if name == "minisign" {
+			_, err = os.Stat(args[2])
+		}
+		return nil, err
+	})
+
+	s := Signature{Minisign: "RWQkey", Cosign: "cosign.pub"}
+	require.Equal(t, []string{MinisignSignatureAsset, CosignSignatureAsset}, s.Assets())
+	err := s.Verify(context.Background(), map[string][]byte{
+		ChecksumsAsset:         []byte("sums"),
+		MinisignSignatureAsset: []byte("minisig"),
+		CosignSignatureAsset:   []byte("sig"),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+	require.Equal(t, "minisign", calls[0][0])
+	require.Equal(t, []string{"-P", "RWQkey"}, calls[0][len(calls[0])-2:])
+	require.Equal(t, []string{"cosign", "verify-blob", "--key", "cosign.pub"}, calls[1][:4])
+	require.Equal(t, ChecksumsAsset, filepath.Base(calls[1][len(calls[1])-1]))
+}
+
+func TestSignature_Verify_Rejected(t *testing.T) {
+	stubTools(t, func(name string, args ...string) ([]byte, error) {
+		return []byte("Signature verification failed\n"), errors.New("exit status 1")
+	})
+
+	err := Signature{Minisign: "minisign.pub"}.Verify(context.Background(), map[string][]byte{
+		ChecksumsAsset:         []byte("sums"),
+		MinisignSignatureAsset: []byte("minisig"),
+	})
+
+	require.ErrorIs(t, err, ErrSignatureInvalid)
+	require.ErrorContains(t, err, "minisign")
+}
+
+func TestSignature_Verify_MissingTool(t *testing.T) {
+	stubTools(t, func(name string, args ...string) ([]byte, error) {
+		t.Fatal("should not run a missing tool")
+		return nil, nil
+	})
+	lookPath = func(file string) (string, error) { return "", errors.New("not found") }
+
+	err := Signature{Cosign: "cosign.pub"}.Verify(context.Background(), map[string][]byte{
+		ChecksumsAsset:       []byte("sums"),
+		CosignSignatureAsset: []byte("sig"),
+	})
+
+	require.ErrorContains(t, err, "cosign is required")
+}
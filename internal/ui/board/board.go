@@ -2,6 +2,10 @@
 package board
 
 import (
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -12,6 +16,7 @@ import (
 	"github.com/zjrosen/perles/internal/config"
 	"github.com/zjrosen/perles/internal/keys"
 	"github.com/zjrosen/perles/internal/mode/shared"
+	"github.com/zjrosen/perles/internal/ui/shared/flash"
 	"github.com/zjrosen/perles/internal/ui/shared/panes"
 	"github.com/zjrosen/perles/internal/ui/styles"
 )
@@ -23,6 +28,15 @@ type IssueClickedMsg struct {
 	IssueID string
 }
 
+// WIPLimitExceededMsg is emitted when a column configured with wip_notify
+// grows past its WIP limit. It is sent once per crossing, not on every reload.
+type WIPLimitExceededMsg struct {
+	ViewName   string
+	ColumnName string
+	Count      int
+	Limit      int
+}
+
 // ColumnIndex identifies kanban columns (backward compatibility).
 // Deprecated: Use int directly with NewFromConfig for custom columns.
 type ColumnIndex = int
@@ -35,6 +49,9 @@ const (
 	ColClosed     ColumnIndex = 3
 )
 
+// collapsedColumnWidth is the width of a collapsed column, including its border.
+const collapsedColumnWidth = 5
+
 // View represents a named collection of columns.
 type View struct {
 	name    string
@@ -54,6 +71,7 @@ type Model struct {
 	configs  []config.ColumnConfig
 	executor bql.BQLExecutor // BQL executor for column loading
 	clock    shared.Clock    // Clock for timestamp formatting
+	flashed  *flash.Set      // Issues highlighted after a live update (shared by all columns)
 	focused  int
 	width    int
 	height   int
@@ -66,6 +84,7 @@ type Model struct {
 // NewFromViews creates a board from multiple view configurations.
 func NewFromViews(viewConfigs []config.ViewConfig, executor bql.BQLExecutor, clock shared.Clock) Model {
 	views := make([]View, len(viewConfigs))
+	flashed := flash.New(clock)
 
 	for i, vc := range viewConfigs {
 		columns := make([]BoardColumn, len(vc.Columns))
@@ -85,6 +104,9 @@ func NewFromViews(viewConfigs []config.ViewConfig, executor bql.BQLExecutor, clo
 				if cc.Color != "" {
 					col = col.SetColor(lipgloss.Color(cc.Color))
 				}
+				col = col.SetWIPLimit(cc.WIPLimit)
+				col = col.SetFields(vc.Fields).SetSort(vc.Sort)
+				col = col.SetFlash(flashed)
 				// Set clock for timestamp formatting
 				columns[j] = col.SetClock(clock)
 			}
@@ -116,6 +138,7 @@ func NewFromViews(viewConfigs []config.ViewConfig, executor bql.BQLExecutor, clo
 		configs:      configs,
 		executor:     executor,
 		clock:        clock,
+		flashed:      flashed,
 		focused:      focusIdx,
 		boardFocused: true, // Board has focus by default
 	}
@@ -136,17 +159,35 @@ func (m Model) SetSize(width, height int) Model {
 		return m
 	}
 
+	// Collapsed columns get a fixed narrow strip, the rest share what's left
+	expanded := colCount
+	for i := range m.columns {
+		if m.IsCollapsed(i) {
+			expanded--
+		}
+	}
+	available := max(width-(colCount-expanded)*collapsedColumnWidth, 0)
+
 	// Distribute width evenly, giving remainder to the last columns
-	baseWidth := width / colCount
-	remainder := width % colCount
+	baseWidth, remainder := 0, 0
+	if expanded > 0 {
+		baseWidth = available / expanded
+		remainder = available % expanded
+	}
 	contentHeight := height
 
+	seen := 0
 	for i := range m.columns {
+		if m.IsCollapsed(i) {
+			m.columns[i] = m.columns[i].SetSize(collapsedColumnWidth, contentHeight)
+			continue
+		}
 		colWidth := baseWidth
 		// Give extra width to the last 'remainder' columns
-		if i >= colCount-remainder {
+		if seen >= expanded-remainder {
 			colWidth++
 		}
+		seen++
 		m.columns[i] = m.columns[i].SetSize(colWidth, contentHeight)
 	}
 	return m
@@ -161,8 +202,9 @@ func (m Model) SetShowCounts(show bool) Model {
 }
 
 // SelectedIssue returns the currently selected issue.
+// Collapsed columns hide their issues, so nothing is selected in them.
 func (m Model) SelectedIssue() *beads.Issue {
-	if m.focused < 0 || m.focused >= len(m.columns) {
+	if m.focused < 0 || m.focused >= len(m.columns) || m.IsCollapsed(m.focused) {
 		return nil
 	}
 	return m.columns[m.focused].SelectedIssue()
@@ -181,6 +223,51 @@ func (m Model) SetFocus(col int) Model {
 	return m
 }
 
+// IsCollapsed reports whether the column at idx is collapsed.
+func (m Model) IsCollapsed(idx int) bool {
+	return idx >= 0 && idx < len(m.configs) && m.configs[idx].Collapsed
+}
+
+// SetCollapsed collapses or expands the column at idx and resizes the board.
+// The change is kept on the board only; callers persist it to the config.
+func (m Model) SetCollapsed(idx int, collapsed bool) Model {
+	if idx < 0 || idx >= len(m.configs) {
+		return m
+	}
+	// Copy so the caller's column configs aren't modified
+	m.configs = slices.Clone(m.configs)
+	m.configs[idx].Collapsed = collapsed
+	if m.currentView < len(m.views) {
+		m.views[m.currentView].configs = m.configs
+	}
+	if m.width > 0 && m.height > 0 {
+		m = m.SetSize(m.width, m.height)
+		if m.currentView < len(m.views) {
+			m.views[m.currentView].columns = m.columns
+		}
+	}
+	return m
+}
+
+// ColumnStatus returns the status an issue needs to belong to the column at
+// idx, taken from a "status = X" condition (or status:X filter term) in its
+// query. Tree columns and queries that don't pin a single status return false.
+func (m Model) ColumnStatus(idx int) (beads.Status, bool) {
+	col, ok := m.BoardColumn(idx).(Column)
+	if !ok || col.Query() == "" {
+		return "", false
+	}
+	query, err := bql.ParseQuery(col.Query())
+	if err != nil {
+		return "", false
+	}
+	value, ok := query.EqualityValue("status")
+	if !ok || !bql.ValidStatusValues[value.String] {
+		return "", false
+	}
+	return beads.Status(value.String), true
+}
+
 // SetBoardFocused sets whether the board has focus.
 // When true, the selected column border is highlighted.
 // When false (e.g., chat panel has focus), no highlighting is shown.
@@ -238,6 +325,41 @@ func (m Model) SelectByID(id string) (Model, bool) {
 	return m, false
 }
 
+// SelectInColumn selects the issue with the given ID in the column at idx and
+// focuses that column. Returns false if the column doesn't hold the issue.
+func (m Model) SelectInColumn(idx int, id string) (Model, bool) {
+	col, ok := m.BoardColumn(idx).(Column)
+	if !ok {
+		return m, false
+	}
+	col, found := col.SelectByID(id)
+	if !found {
+		return m, false
+	}
+	m.columns[idx] = col
+	m.focused = idx
+	return m, true
+}
+
+// UpdateIssue applies update to the issue with the given ID in every column of
+// the current view, keeping focus and selection, and highlights the issue.
+// Only BQL columns are patched; tree columns pick the change up on their next load.
+// The highlight is kept by ID, so it survives reloads that move the issue to
+// another column (e.g. into Closed).
+func (m Model) UpdateIssue(id string, update func(*beads.Issue)) (Model, tea.Cmd) {
+	for i := range m.columns {
+		if col, ok := m.columns[i].(Column); ok {
+			if col, found := col.UpdateIssue(id, update); found {
+				m.columns[i] = col
+			}
+		}
+	}
+	if m.currentView < len(m.views) {
+		m.views[m.currentView].columns = m.columns
+	}
+	return m, m.flashed.Flash(id)
+}
+
 // Column returns the column at the given index (type asserted to Column).
 // Returns empty Column if index is out of range or column is not a BQL column.
 func (m Model) Column(idx int) Column {
@@ -404,12 +526,19 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 
 		// Find the column by title and update it using HandleLoaded
+		var cmds []tea.Cmd
 		for i := range m.columns {
 			// Use Title() but strip count suffix for comparison
 			// BQL columns include count in Title(), so compare with msg.ColumnTitle
 			col := m.columns[i]
+			wasExceeded := exceedsWIPLimit(col)
 			// HandleLoaded will only update if it's the right message type
 			m.columns[i] = col.HandleLoaded(msg)
+			if !wasExceeded && exceedsWIPLimit(m.columns[i]) {
+				if cmd := m.wipLimitExceededCmd(i); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
 		}
 
 		// Mark view as loaded
@@ -419,7 +548,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			m.views[m.currentView].columns = m.columns
 		}
 
-		return m, nil
+		return m, tea.Batch(cmds...)
 
 	case TreeColumnLoadedMsg:
 		// Only update if this message is for our current view (or no views configured)
@@ -444,6 +573,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, nil
 
 	case tea.MouseMsg:
+		// Wheel moves the selection in the column under the pointer
+		if msg.Button == tea.MouseButtonWheelUp || msg.Button == tea.MouseButtonWheelDown {
+			return m.handleWheel(msg)
+		}
+
 		// Only handle left-click release events
 		if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionRelease {
 			return m, nil
@@ -452,6 +586,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		// Check if click is within any registered issue zone
 		// Iterate through all columns and their items to find the clicked zone
 		for colIdx, col := range m.columns {
+			// Collapsed columns don't show their issues
+			if m.IsCollapsed(colIdx) {
+				continue
+			}
+
 			// Handle BQL columns (Column type)
 			if c, ok := col.(Column); ok {
 				for _, issue := range c.Items() {
@@ -499,7 +638,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 		case key.Matches(msg, keys.Common.Down), key.Matches(msg, keys.Common.Up), key.Matches(msg, keys.Component.ModeToggle):
 			// Pass navigation and mode toggle keys to focused column
-			if m.focused >= 0 && m.focused < len(m.columns) {
+			if m.focused >= 0 && m.focused < len(m.columns) && !m.IsCollapsed(m.focused) {
 				col, cmd := m.columns[m.focused].Update(msg)
 				m.columns[m.focused] = col
 				return m, cmd
@@ -533,24 +672,115 @@ func (m Model) View() string {
 		// Use column's own color
 		colColor := col.Color()
 
+		if m.IsCollapsed(i) {
+			rendered := panes.BorderedPane(panes.BorderConfig{
+				Content:            m.renderCollapsed(i, col, contentHeight-2),
+				Width:              collapsedColumnWidth,
+				Height:             contentHeight,
+				Focused:            showFocusHighlight,
+				FocusedBorderColor: colColor,
+			})
+			cols = append(cols, zone.Mark(makeColumnZoneID(i), rendered))
+			continue
+		}
+
+		// Highlight the header of columns over their WIP limit
+		titleColor := colColor
+		topRight := col.RightTitle()
+		if exceedsWIPLimit(col) {
+			titleColor = styles.StatusErrorColor
+			topRight = "⚠ WIP"
+		}
+
 		// Render column with bordered title
 		rendered := panes.BorderedPane(panes.BorderConfig{
 			Content:            col.View(),
 			Width:              col.Width(),
 			Height:             contentHeight,
 			TopLeft:            col.Title(),
-			TopRight:           col.RightTitle(),
+			TopRight:           topRight,
 			Focused:            showFocusHighlight,
-			TitleColor:         colColor,
+			TitleColor:         titleColor,
 			FocusedBorderColor: colColor,
 		})
-		cols = append(cols, rendered)
+		cols = append(cols, zone.Mark(makeColumnZoneID(i), rendered))
 	}
 
 	// Scan for zone markers and register positions for mouse click detection
 	return zone.Scan(lipgloss.JoinHorizontal(lipgloss.Top, cols...))
 }
 
+// handleWheel focuses the column under the pointer and moves its selection
+// one item, the same as pressing j/k in that column.
+func (m Model) handleWheel(msg tea.MouseMsg) (Model, tea.Cmd) {
+	for colIdx := range m.columns {
+		if z := zone.Get(makeColumnZoneID(colIdx)); z != nil && z.InBounds(msg) {
+			keyMsg := tea.KeyMsg{Type: tea.KeyDown}
+			if msg.Button == tea.MouseButtonWheelUp {
+				keyMsg = tea.KeyMsg{Type: tea.KeyUp}
+			}
+			m.focused = colIdx
+			if m.IsCollapsed(colIdx) {
+				return m, nil
+			}
+			col, cmd := m.columns[colIdx].Update(keyMsg)
+			m.columns[colIdx] = col
+			return m, cmd
+		}
+	}
+	return m, nil
+}
+
+// renderCollapsed renders a collapsed column as its issue count followed by
+// its name written top to bottom, clipped to height lines.
+func (m Model) renderCollapsed(idx int, col BoardColumn, height int) string {
+	innerWidth := collapsedColumnWidth - 2
+	center := lipgloss.NewStyle().Width(innerWidth).Align(lipgloss.Center)
+	nameStyle := center.Foreground(col.Color()).Bold(true)
+
+	var lines []string
+	if c, ok := col.(Column); ok {
+		countStyle := center.Foreground(styles.TextMutedColor)
+		if c.ExceedsWIPLimit() {
+			countStyle = center.Foreground(styles.StatusErrorColor)
+		}
+		lines = append(lines, countStyle.Render(strconv.Itoa(len(c.Items()))), "")
+	}
+	for _, r := range m.configs[idx].Name {
+		lines = append(lines, nameStyle.Render(string(r)))
+	}
+	if height > 0 && len(lines) > height {
+		lines = lines[:height]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// exceedsWIPLimit reports whether a column is over its configured WIP limit.
+// Only BQL columns support WIP limits.
+func exceedsWIPLimit(col BoardColumn) bool {
+	c, ok := col.(Column)
+	return ok && c.ExceedsWIPLimit()
+}
+
+// wipLimitExceededCmd returns a command emitting WIPLimitExceededMsg for the column
+// at idx, or nil if the column is not configured to notify.
+func (m Model) wipLimitExceededCmd(idx int) tea.Cmd {
+	if idx >= len(m.configs) || !m.configs[idx].WIPNotify {
+		return nil
+	}
+	c, ok := m.columns[idx].(Column)
+	if !ok {
+		return nil
+	}
+	msg := WIPLimitExceededMsg{
+		ViewName:   m.CurrentViewName(),
+		ColumnName: m.configs[idx].Name,
+		Count:      len(c.Items()),
+		Limit:      c.WIPLimit(),
+	}
+	return func() tea.Msg { return msg }
+}
+
 // renderEmptyState renders a centered message when no columns are configured.
 func (m Model) renderEmptyState() string {
 	emptyStyle := lipgloss.NewStyle().
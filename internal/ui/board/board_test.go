@@ -375,6 +375,127 @@ func TestBoard_ColumnLoadedMsg_UpdatesCorrectView(t *testing.T) {
 	require.Equal(t, 0, m.CurrentViewIndex())
 }
 
+func TestBoard_ColumnLoadedMsg_WIPLimitExceeded(t *testing.T) {
+	views := []config.ViewConfig{
+		{Name: "Work", Columns: []config.ColumnConfig{
+			{Name: "Ready", Query: "ready = true", WIPLimit: 1},
+			{Name: "Doing", Query: "status = in_progress", WIPLimit: 1, WIPNotify: true},
+		}},
+	}
+	m := NewFromViews(views, nil, nil)
+	m = m.SetSize(80, 20)
+
+	twoIssues := []beads.Issue{{ID: "bd-1", TitleText: "One"}, {ID: "bd-2", TitleText: "Two"}}
+
+	// Column without wip_notify is highlighted but emits nothing
+	m, cmd := m.Update(ColumnLoadedMsg{ColumnIndex: 0, Issues: twoIssues})
+	require.Nil(t, cmd)
+	require.Contains(t, m.View(), "Ready (2/1)")
+	require.Contains(t, m.View(), "⚠ WIP")
+
+	// Column with wip_notify emits once when it crosses the limit
+	m, cmd = m.Update(ColumnLoadedMsg{ColumnIndex: 1, Issues: twoIssues})
+	require.NotNil(t, cmd)
+	require.Equal(t, WIPLimitExceededMsg{ViewName: "Work", ColumnName: "Doing", Count: 2, Limit: 1}, cmd())
+
+	// Reloading while still over the limit does not re-notify
+	_, cmd = m.Update(ColumnLoadedMsg{ColumnIndex: 1, Issues: twoIssues})
+	require.Nil(t, cmd)
+}
+
+func TestBoard_ColumnStatus(t *testing.T) {
+	views := []config.ViewConfig{
+		{Name: "Work", Columns: []config.ColumnConfig{
+			{Name: "Blocked", Query: "status = open and blocked = true"},
+			{Name: "Ready", Query: "ready = true"},
+			{Name: "Doing", Query: "status = in_progress order by priority"},
+			{Name: "Done", Query: "status = closed or status = deferred"},
+			{Name: "Tree", Type: "tree", IssueID: "bd-1"},
+			{Name: "Review", Query: "status:in_progress label:review sort:-updated"},
+			{Name: "Mixed", Query: "status:open,closed"},
+		}},
+	}
+	m := NewFromViews(views, nil, nil)
+
+	status, ok := m.ColumnStatus(5)
+	require.True(t, ok, "filter syntax columns are parsed too")
+	require.Equal(t, beads.StatusInProgress, status)
+
+	status, ok = m.ColumnStatus(0)
+	require.True(t, ok)
+	require.Equal(t, beads.StatusOpen, status)
+
+	status, ok = m.ColumnStatus(2)
+	require.True(t, ok)
+	require.Equal(t, beads.StatusInProgress, status)
+
+	for _, idx := range []int{1, 3, 4, 6, 7} {
+		_, ok = m.ColumnStatus(idx)
+		require.False(t, ok, "column %d has no single status", idx)
+	}
+}
+
+func TestBoard_SetCollapsed(t *testing.T) {
+	views := []config.ViewConfig{
+		{Name: "Work", Columns: []config.ColumnConfig{
+			{Name: "Ready", Query: "ready = true"},
+			{Name: "Closed", Query: "status = closed"},
+		}},
+	}
+	m := NewFromViews(views, nil, nil).SetSize(80, 20).SetFocus(1)
+	m, _ = m.Update(ColumnLoadedMsg{ColumnIndex: 1, Issues: []beads.Issue{{ID: "bd-1", TitleText: "One"}}})
+	require.Equal(t, "bd-1", m.SelectedIssue().ID)
+
+	m = m.SetCollapsed(1, true)
+	require.True(t, m.IsCollapsed(1))
+	require.False(t, views[0].Columns[1].Collapsed, "caller config is not modified")
+	require.Equal(t, collapsedColumnWidth, m.BoardColumn(1).Width())
+	require.Equal(t, 80-collapsedColumnWidth, m.BoardColumn(0).Width())
+	require.Nil(t, m.SelectedIssue(), "collapsed columns hide their issues")
+	require.NotContains(t, m.View(), "bd-1")
+
+	m = m.SetCollapsed(1, false)
+	require.False(t, m.IsCollapsed(1))
+	require.Equal(t, 40, m.BoardColumn(1).Width())
+	require.Equal(t, "bd-1", m.SelectedIssue().ID)
+}
+
+func TestBoard_View_Collapsed_Golden(t *testing.T) {
+	views := config.DefaultViews()
+	views[0].Columns[3].Collapsed = true
+	m := NewFromViews(views, nil, nil).SetSize(120, 20)
+	m, _ = m.Update(ColumnLoadedMsg{ColumnIndex: 3, Issues: []beads.Issue{{ID: "bd-1", TitleText: "One"}}})
+	view := m.View()
+	teatest.RequireEqualOutput(t, []byte(view))
+}
+
+func TestBoard_UpdateIssue_HighlightSurvivesReload(t *testing.T) {
+	views := []config.ViewConfig{
+		{Name: "Work", Columns: []config.ColumnConfig{
+			{Name: "Doing", Query: "status = in_progress"},
+			{Name: "Closed", Query: "status = closed"},
+		}},
+	}
+	m := NewFromViews(views, nil, nil)
+	m = m.SetSize(80, 20).SetFocus(0)
+	m, _ = m.Update(ColumnLoadedMsg{ColumnIndex: 0, Issues: []beads.Issue{
+		{ID: "bd-2", TitleText: "Two", Status: beads.StatusInProgress},
+		{ID: "bd-1", TitleText: "One", Status: beads.StatusInProgress},
+	}})
+	require.NotContains(t, m.View(), "•")
+
+	m, cmd := m.UpdateIssue("bd-1", func(issue *beads.Issue) { issue.Status = beads.StatusClosed })
+	require.NotNil(t, cmd, "expected a command to end the highlight")
+	require.Equal(t, beads.StatusClosed, m.Column(0).Items()[1].Status)
+	require.Equal(t, "bd-2", m.SelectedIssue().ID, "selection is kept")
+	require.Contains(t, m.View(), "•[?][P0][bd-1]")
+
+	// The reload that follows moves the issue to Closed; it stays highlighted there
+	m, _ = m.Update(ColumnLoadedMsg{ColumnIndex: 0, Issues: []beads.Issue{{ID: "bd-2", TitleText: "Two", Status: beads.StatusInProgress}}})
+	m, _ = m.Update(ColumnLoadedMsg{ColumnIndex: 1, Issues: []beads.Issue{{ID: "bd-1", TitleText: "One", Status: beads.StatusClosed}}})
+	require.Contains(t, m.View(), "•[?][P0][bd-1]")
+}
+
 func TestBoard_SwitchToView(t *testing.T) {
 	views := []config.ViewConfig{
 		{Name: "View0", Columns: []config.ColumnConfig{{Name: "C0", Query: "q"}}},
@@ -1469,3 +1590,57 @@ func TestBoard_MouseClick_PerformanceWithManyIssues(t *testing.T) {
 	require.True(t, ok, "should emit IssueClickedMsg")
 	require.Equal(t, targetIssueID, clickedMsg.IssueID, "correct issue should be clicked")
 }
+
+func TestBoard_MouseWheel_MovesSelectionInHoveredColumn(t *testing.T) {
+	views := []config.ViewConfig{
+		{
+			Name: "Test",
+			Columns: []config.ColumnConfig{
+				{Name: "Todo", Query: "status = open"},
+				{Name: "Done", Query: "status = closed"},
+			},
+		},
+	}
+
+	m := NewFromViews(views, nil, nil)
+	m = m.SetSize(120, 40)
+	m, _ = m.Update(ColumnLoadedMsg{
+		ViewIndex:   0,
+		ColumnIndex: 1,
+		ColumnTitle: "Done",
+		Issues: []beads.Issue{
+			{ID: "wheel-test-issue-1", TitleText: "First Issue", Type: beads.TypeTask, Status: beads.StatusClosed},
+			{ID: "wheel-test-issue-2", TitleText: "Second Issue", Type: beads.TypeTask, Status: beads.StatusClosed},
+		},
+	})
+	m, _ = m.Update(ColumnLoadedMsg{
+		ViewIndex:   0,
+		ColumnIndex: 0,
+		ColumnTitle: "Todo",
+		Issues: []beads.Issue{
+			{ID: "wheel-test-issue-0", TitleText: "Open Issue", Type: beads.TypeTask, Status: beads.StatusOpen},
+		},
+	})
+	m = m.SetFocus(0)
+
+	var z *zone.ZoneInfo
+	for retries := 0; retries < 10; retries++ {
+		_ = m.View()
+		time.Sleep(time.Millisecond)
+		z = zone.Get(makeColumnZoneID(1))
+		if z != nil && !z.IsZero() {
+			break
+		}
+	}
+	require.NotNil(t, z, "column zone should be registered after View()")
+	require.False(t, z.IsZero(), "column zone should not be zero")
+
+	wheel := tea.MouseMsg{X: z.StartX + 2, Y: z.StartY + 2, Button: tea.MouseButtonWheelDown}
+	m, _ = m.Update(wheel)
+	require.Equal(t, 1, m.FocusedColumn(), "wheel should focus the hovered column")
+	require.Equal(t, "wheel-test-issue-2", m.SelectedIssue().ID)
+
+	wheel.Button = tea.MouseButtonWheelUp
+	m, _ = m.Update(wheel)
+	require.Equal(t, "wheel-test-issue-1", m.SelectedIssue().ID)
+}
@@ -3,13 +3,15 @@ package board
 import (
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 
 	zone "github.com/lrstanley/bubblezone"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/mode/shared"
-	"github.com/zjrosen/perles/internal/ui/shared/issuebadge"
+	"github.com/zjrosen/perles/internal/ui/shared/flash"
 	"github.com/zjrosen/perles/internal/ui/styles"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -66,15 +68,19 @@ type BoardColumn interface {
 
 // issueDelegate is a custom delegate for rendering issues with priority colors and type indicators.
 type issueDelegate struct {
-	focused     *bool // pointer to column's focused state
-	columnIndex *int  // pointer to column index for zone ID construction (survives value copies)
+	focused     *bool      // pointer to column's focused state
+	columnIndex *int       // pointer to column index for zone ID construction (survives value copies)
+	flashed     *flash.Set // issues highlighted after a live update
+	format      rowFormat  // fields shown on each issue row
 }
 
 // newIssueDelegate creates a new issue delegate.
-func newIssueDelegate(focused *bool, columnIndex *int) issueDelegate {
+func newIssueDelegate(focused *bool, columnIndex *int, flashed *flash.Set, format rowFormat) issueDelegate {
 	return issueDelegate{
 		focused:     focused,
 		columnIndex: columnIndex,
+		flashed:     flashed,
+		format:      format,
 	}
 }
 
@@ -93,25 +99,6 @@ func (d issueDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
 	return nil
 }
 
-// renderIssueLine returns the rendered line for an issue (used by both Render and width calculation).
-func renderIssueLine(issue beads.Issue, isSelected bool) string {
-	return issuebadge.Render(issue, issuebadge.Config{
-		ShowSelection: true,
-		Selected:      isSelected,
-	})
-}
-
-// itemRenderedLines returns how many lines an issue takes when rendered at the given width.
-func itemRenderedLines(issue beads.Issue, width int) int {
-	line := renderIssueLine(issue, false)
-	lineWidth := lipgloss.Width(line)
-	if lineWidth <= width || width <= 0 {
-		return 1
-	}
-
-	return (lineWidth + width - 1) / width
-}
-
 // Render renders an issue item with priority colors and type indicator.
 func (d issueDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
 	issueItem, ok := item.(IssueItem)
@@ -121,7 +108,7 @@ func (d issueDelegate) Render(w io.Writer, m list.Model, index int, item list.It
 	issue := *issueItem.Issue
 
 	isSelected := index == m.Index() && d.focused != nil && *d.focused
-	line := renderIssueLine(issue, isSelected)
+	line := d.format.render(issue, isSelected, d.flashed.Active(issue.ID), m.Width())
 
 	// Constrain to list width so lines wrap properly within column bounds
 	if m.Width() > 0 {
@@ -147,8 +134,12 @@ type Column struct {
 	items          []beads.Issue
 	width          int
 	height         int
-	focused        *bool // pointer so it survives value copies
-	showCounts     *bool // pointer so it survives value copies (nil = default true)
+	focused        *bool      // pointer so it survives value copies
+	showCounts     *bool      // pointer so it survives value copies (nil = default true)
+	flashed        *flash.Set // issues highlighted after a live update (shared with the delegate)
+	wipLimit       int        // max issues before the header is highlighted (0 = no limit)
+	format         rowFormat  // fields shown on each issue row
+	sort           string     // ORDER BY applied when the query has none
 
 	// BQL self-loading fields
 	executor  bql.BQLExecutor // BQL executor for loading issues
@@ -161,9 +152,10 @@ func NewColumn(title string) Column {
 	// Allocate state on heap so pointers survive value copies
 	focused := new(bool)
 	columnIndexPtr := new(int)
+	flashed := flash.New(nil)
 
 	// Create delegate with pointers to column state
-	delegate := newIssueDelegate(focused, columnIndexPtr)
+	delegate := newIssueDelegate(focused, columnIndexPtr, flashed, rowFormat{})
 
 	l := list.New([]list.Item{}, delegate, 0, 0)
 	l.SetShowTitle(false)
@@ -177,6 +169,7 @@ func NewColumn(title string) Column {
 		list:           l,
 		focused:        focused,
 		columnIndexPtr: columnIndexPtr,
+		flashed:        flashed,
 	}
 }
 
@@ -204,7 +197,7 @@ func (c Column) LoadIssues() Column {
 		return c
 	}
 
-	issues, err := c.executor.Execute(c.query)
+	issues, err := c.executor.Execute(c.loadQuery())
 	if err != nil {
 		c.loadError = err
 		return c
@@ -230,7 +223,7 @@ func (c Column) LoadCmd(viewIndex, columnIndex int) tea.Cmd {
 
 	// Capture values for closure
 	executor := c.executor
-	query := c.query
+	query := c.loadQuery()
 	title := c.title
 
 	return func() tea.Msg {
@@ -357,7 +350,7 @@ func (c *Column) updatePerPage() {
 	usedLines := 0
 	itemsThatFit := 0
 	for _, issue := range c.items {
-		lines := itemRenderedLines(issue, innerWidth)
+		lines := c.format.renderedLines(issue, innerWidth)
 		if usedLines+lines > availableLines {
 			break
 		}
@@ -422,6 +415,23 @@ func (c Column) SelectByID(id string) (Column, bool) {
 	return c, false
 }
 
+// UpdateIssue applies update to the issue with the given ID, keeping the
+// selection. Returns false if the column does not hold the issue.
+// Items are copied first since loaded issues may be shared with the BQL cache.
+func (c Column) UpdateIssue(id string, update func(*beads.Issue)) (Column, bool) {
+	idx := slices.IndexFunc(c.items, func(issue beads.Issue) bool { return issue.ID == id })
+	if idx < 0 {
+		return c, false
+	}
+
+	items := slices.Clone(c.items)
+	update(&items[idx])
+	selected := c.list.Index()
+	c = c.SetItems(items)
+	c.list.Select(selected)
+	return c, true
+}
+
 // Update handles messages.
 func (c Column) Update(msg tea.Msg) (BoardColumn, tea.Cmd) {
 	var cmd tea.Cmd
@@ -433,14 +443,34 @@ func (c Column) Update(msg tea.Msg) (BoardColumn, tea.Cmd) {
 
 // Title returns the formatted title with optional count for border rendering.
 // If showCounts is false, returns just the title without count.
+// Columns with a WIP limit show the count against the limit (e.g., "In Progress (4/3)").
 func (c Column) Title() string {
 	// Default to showing counts if not explicitly set
 	if c.showCounts != nil && !*c.showCounts {
 		return c.title
 	}
+	if c.wipLimit > 0 {
+		return fmt.Sprintf("%s (%d/%d)", c.title, len(c.items), c.wipLimit)
+	}
 	return fmt.Sprintf("%s (%d)", c.title, len(c.items))
 }
 
+// SetWIPLimit sets the column's work-in-progress limit (0 disables the limit).
+func (c Column) SetWIPLimit(limit int) Column {
+	c.wipLimit = max(limit, 0)
+	return c
+}
+
+// WIPLimit returns the column's work-in-progress limit (0 = no limit).
+func (c Column) WIPLimit() int {
+	return c.wipLimit
+}
+
+// ExceedsWIPLimit returns true if the column holds more issues than its WIP limit.
+func (c Column) ExceedsWIPLimit() bool {
+	return c.wipLimit > 0 && len(c.items) > c.wipLimit
+}
+
 // RightTitle returns an optional right-aligned title.
 // BQL columns don't use a right title, so this returns empty string.
 func (c Column) RightTitle() string {
@@ -479,8 +509,60 @@ func (c Column) Width() int {
 	return c.width
 }
 
-// SetClock sets the clock for timestamp formatting. The board column does not
-// show timestamps, so this is a no-op for now.
-func (c Column) SetClock(_ shared.Clock) BoardColumn {
+// SetClock sets the clock used for the updated field of issue rows.
+func (c Column) SetClock(clock shared.Clock) BoardColumn {
+	c.format.clock = clock
+	c.list.SetDelegate(newIssueDelegate(c.focused, c.columnIndexPtr, c.flashed, c.format))
 	return c
 }
+
+// SetFlash sets the set of highlighted issues. The board shares one set across
+// its columns so a row stays highlighted when its issue moves to another column.
+func (c Column) SetFlash(flashed *flash.Set) Column {
+	c.flashed = flashed
+	c.list.SetDelegate(newIssueDelegate(c.focused, c.columnIndexPtr, flashed, c.format))
+	return c
+}
+
+// SetFields sets the issue fields shown on each row (see config.IssueFields).
+// Empty fields keep the default badge with a wrapping title.
+func (c Column) SetFields(fields []string) Column {
+	c.format.fields = fields
+	c.list.SetDelegate(newIssueDelegate(c.focused, c.columnIndexPtr, c.flashed, c.format))
+	c.updatePerPage()
+	return c
+}
+
+// SetSort sets the ORDER BY list used when the column's query has none.
+func (c Column) SetSort(sort string) Column {
+	c.sort = sort
+	return c
+}
+
+// loadQuery returns the query the column loads with: its own query, plus the
+// view's sort when the query doesn't order its results itself. Filter syntax
+// queries get the sort as a sort: term.
+func (c Column) loadQuery() string {
+	if c.sort == "" {
+		return c.query
+	}
+	query, err := bql.ParseQuery(c.query)
+	if err != nil || len(query.OrderBy) > 0 {
+		return c.query
+	}
+	if !bql.IsFilter(c.query) {
+		return c.query + " order by " + c.sort
+	}
+	order, err := bql.NewParser("order by " + c.sort).Parse()
+	if err != nil || len(order.OrderBy) == 0 {
+		return c.query
+	}
+	fields := make([]string, len(order.OrderBy))
+	for i, term := range order.OrderBy {
+		fields[i] = term.Field
+		if term.Desc {
+			fields[i] = "-" + term.Field
+		}
+	}
+	return c.query + " sort:" + strings.Join(fields, ",")
+}
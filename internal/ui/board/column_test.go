@@ -3,13 +3,16 @@ package board
 import (
 	"errors"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/exp/teatest"
 	zone "github.com/lrstanley/bubblezone"
 	"github.com/stretchr/testify/require"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
 )
 
 func TestColumn_NewColumn(t *testing.T) {
@@ -75,6 +78,25 @@ func TestColumn_SelectByID_NotFound(t *testing.T) {
 	require.False(t, found, "expected not to find nonexistent issue")
 }
 
+func TestColumn_UpdateIssue(t *testing.T) {
+	c := NewColumn("Test")
+	issues := []beads.Issue{
+		{ID: "bd-1", TitleText: "Issue 1", Status: beads.StatusInProgress},
+		{ID: "bd-2", TitleText: "Issue 2", Status: beads.StatusInProgress},
+	}
+	c = c.SetItems(issues)
+	c, _ = c.SelectByID("bd-2")
+
+	c, found := c.UpdateIssue("bd-1", func(issue *beads.Issue) { issue.Status = beads.StatusClosed })
+	require.True(t, found)
+	require.Equal(t, beads.StatusClosed, c.Items()[0].Status)
+	require.Equal(t, beads.StatusInProgress, issues[0].Status, "loaded issues are not mutated")
+	require.Equal(t, "bd-2", c.SelectedItem().ID, "selection is kept")
+
+	_, found = c.UpdateIssue("nonexistent", func(*beads.Issue) {})
+	require.False(t, found)
+}
+
 func TestColumn_SetFocused(t *testing.T) {
 	c := NewColumn("Test")
 	c = c.SetFocused(true).(Column)
@@ -114,6 +136,25 @@ func TestColumn_Title_WithItems(t *testing.T) {
 	require.Equal(t, "Ready (2)", title)
 }
 
+func TestColumn_WIPLimit(t *testing.T) {
+	issues := []beads.Issue{
+		{ID: "bd-1", TitleText: "Issue 1", Priority: beads.PriorityHigh, Type: beads.TypeTask},
+		{ID: "bd-2", TitleText: "Issue 2", Priority: beads.PriorityMedium, Type: beads.TypeBug},
+	}
+
+	c := NewColumn("In Progress").SetWIPLimit(2).SetItems(issues)
+	require.Equal(t, "In Progress (2/2)", c.Title())
+	require.False(t, c.ExceedsWIPLimit(), "at the limit is not over it")
+
+	c = c.SetItems(append(issues, beads.Issue{ID: "bd-3", TitleText: "Issue 3", Type: beads.TypeTask}))
+	require.Equal(t, "In Progress (3/2)", c.Title())
+	require.True(t, c.ExceedsWIPLimit())
+
+	c = c.SetWIPLimit(0)
+	require.Equal(t, "In Progress (3)", c.Title())
+	require.False(t, c.ExceedsWIPLimit(), "no limit is never exceeded")
+}
+
 func TestColumn_View_WithItems(t *testing.T) {
 	c := NewColumn("Ready")
 	c = c.SetSize(50, 20).(Column)
@@ -333,3 +374,80 @@ func TestColumnLoadedMsg_WithError(t *testing.T) {
 	require.Nil(t, msg.Issues)
 	require.Error(t, msg.Err)
 }
+
+func TestColumn_LoadCmd_AppliesViewSort(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+	executor.EXPECT().Execute("status = open order by priority asc, updated desc").Return(nil, nil).Once()
+	executor.EXPECT().Execute("status = closed order by updated").Return(nil, nil).Once()
+
+	// A query without ORDER BY gets the view's sort
+	c := NewColumnWithExecutor("Open", "status = open", executor).SetSort("priority asc, updated desc")
+	c.LoadCmd(0, 0)()
+	require.Equal(t, "status = open", c.Query(), "the column's own query is unchanged")
+
+	// A query's own ORDER BY wins
+	c = NewColumnWithExecutor("Closed", "status = closed order by updated", executor).SetSort("priority")
+	c.LoadCmd(0, 0)()
+}
+
+func TestColumn_LoadCmd_AppliesViewSortToFilter(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+	executor.EXPECT().Execute("status:open label:bug sort:priority,-updated").Return(nil, nil).Once()
+	executor.EXPECT().Execute("status:closed sort:-updated").Return(nil, nil).Once()
+
+	// A filter without sort: gets the view's sort as a sort: term
+	c := NewColumnWithExecutor("Open", "status:open label:bug", executor).SetSort("priority asc, updated desc")
+	c.LoadCmd(0, 0)()
+
+	// A filter's own sort: wins
+	c = NewColumnWithExecutor("Closed", "status:closed sort:-updated", executor).SetSort("priority")
+	c.LoadCmd(0, 0)()
+}
+
+func TestColumn_SetFields_SingleLineRows(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	clock := mocks.NewMockClock(t)
+	clock.EXPECT().Now().Return(now).Maybe()
+
+	issue := beads.Issue{
+		ID:        "bd-1",
+		TitleText: "A fairly long issue title that needs truncating",
+		Priority:  beads.PriorityHigh,
+		Type:      beads.TypeBug,
+		Status:    beads.StatusInProgress,
+		Assignee:  "worker-2",
+		Labels:    []string{"ui", "board", "urgent"},
+		UpdatedAt: now.Add(-3 * time.Hour),
+	}
+	c := NewColumn("Doing").SetFields([]string{"id", "status", "assignee", "labels", "updated"})
+	c = c.SetClock(clock).(Column).SetSize(80, 10).(Column).SetItems([]beads.Issue{issue})
+
+	view := ansi.Strip(zone.Scan(c.View()))
+	require.Contains(t, view, "[bd-1] A fairly long")
+	require.Contains(t, view, "in_progress @worker-2 #ui #board +1 3h ago")
+	require.NotContains(t, view, "[P1]", "unselected fields are hidden")
+
+	// Narrow columns drop trailing fields, last first, and truncate the title
+	c = c.SetSize(40, 10).(Column)
+	view = ansi.Strip(zone.Scan(c.View()))
+	require.Contains(t, view, "in_progress")
+	require.NotContains(t, view, "3h ago")
+	require.NotContains(t, view, "#ui")
+	require.Contains(t, view, "A fairly long i...")
+	require.Equal(t, 1, c.format.renderedLines(issue, 38), "rows with fields never wrap")
+}
+
+func TestColumn_View_WithFields_Golden(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	clock := mocks.NewMockClock(t)
+	clock.EXPECT().Now().Return(now).Maybe()
+
+	c := NewColumn("Ready").SetFields([]string{"priority", "id", "status", "updated"})
+	c = c.SetClock(clock).(Column).SetSize(50, 8).(Column).SetFocused(true).(Column)
+	c = c.SetItems([]beads.Issue{
+		{ID: "bd-1", TitleText: "First Issue", Priority: beads.PriorityHigh, Status: beads.StatusOpen, UpdatedAt: now.Add(-2 * time.Hour)},
+		{ID: "bd-2", TitleText: "Second Issue", Priority: beads.PriorityLow, Status: beads.StatusBlocked, UpdatedAt: now.Add(-72 * time.Hour)},
+	})
+	view := zone.Scan(c.View())
+	teatest.RequireEqualOutput(t, []byte(view))
+}
@@ -0,0 +1,163 @@
+package board
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/config"
+	"github.com/zjrosen/perles/internal/mode/shared"
+	"github.com/zjrosen/perles/internal/ui/shared/issuebadge"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// minTitleWidth is the title width kept before trailing fields are dropped.
+const minTitleWidth = 10
+
+// rowFormat controls which fields an issue row shows.
+type rowFormat struct {
+	fields []string     // config.IssueFields to show; empty = default badge with wrapping title
+	clock  shared.Clock // clock for the updated field (nil = real time)
+}
+
+// has reports whether the row shows field.
+func (f rowFormat) has(field string) bool {
+	return slices.Contains(f.fields, field)
+}
+
+// render returns the issue row. Without configured fields it is the default
+// badge and a title that wraps; with fields it is a single line where the
+// title is truncated and trailing fields are dropped (last first) to fit width.
+func (f rowFormat) render(issue beads.Issue, selected, flashed bool, width int) string {
+	if len(f.fields) == 0 {
+		return issuebadge.Render(issue, issuebadge.Config{
+			ShowSelection: true,
+			Selected:      selected,
+			Flash:         flashed,
+		})
+	}
+
+	prefix := " "
+	if selected {
+		prefix = styles.SelectionIndicatorStyle.Render(">")
+	} else if flashed {
+		prefix = styles.IssueFlashStyle.Render("•")
+	}
+	left := prefix + f.renderBadge(issue)
+	if lipgloss.Width(left) > 1 {
+		left += " "
+	}
+
+	trailing := f.renderTrailing(issue)
+	meta := strings.Join(trailing, " ")
+	if width > 0 {
+		// Drop trailing fields until the title keeps a readable width
+		for len(trailing) > 0 && lipgloss.Width(left)+minTitleWidth+1+lipgloss.Width(meta) > width {
+			trailing = trailing[:len(trailing)-1]
+			meta = strings.Join(trailing, " ")
+		}
+	}
+
+	title := issue.TitleText
+	if width > 0 {
+		available := width - lipgloss.Width(left)
+		if meta != "" {
+			available -= lipgloss.Width(meta) + 1
+		}
+		if available > 0 {
+			title = styles.TruncateString(title, available)
+		} else {
+			title = ""
+		}
+	}
+
+	padding := 1
+	if width > 0 {
+		padding = max(1, width-lipgloss.Width(left)-lipgloss.Width(title)-lipgloss.Width(meta))
+	}
+	if flashed {
+		title = styles.IssueFlashStyle.Render(title)
+	}
+	if meta == "" {
+		return left + title
+	}
+	return left + title + strings.Repeat(" ", padding) + meta
+}
+
+// renderBadge renders the type, priority and ID fields: [T][Pn][id]
+func (f rowFormat) renderBadge(issue beads.Issue) string {
+	var parts []string
+	if issue.Pinned != nil && *issue.Pinned {
+		parts = append(parts, "📌")
+	}
+	if f.has(config.IssueFieldType) {
+		parts = append(parts, styles.GetTypeStyle(issue.Type).Render(styles.GetTypeIndicator(issue.Type)))
+	}
+	if f.has(config.IssueFieldPriority) {
+		parts = append(parts, styles.GetPriorityStyle(issue.Priority).Render(fmt.Sprintf("[P%d]", issue.Priority)))
+	}
+	if f.has(config.IssueFieldID) {
+		parts = append(parts, lipgloss.NewStyle().Foreground(styles.TextSecondaryColor).Render("["+issue.ID+"]"))
+	}
+	return strings.Join(parts, "")
+}
+
+// renderTrailing renders the fields shown after the title, in display order.
+func (f rowFormat) renderTrailing(issue beads.Issue) []string {
+	muted := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+
+	var parts []string
+	if f.has(config.IssueFieldStatus) && issue.Status != "" {
+		parts = append(parts, lipgloss.NewStyle().Foreground(statusColor(issue.Status)).Render(string(issue.Status)))
+	}
+	if f.has(config.IssueFieldAssignee) && issue.Assignee != "" {
+		parts = append(parts, muted.Render("@"+issue.Assignee))
+	}
+	if f.has(config.IssueFieldLabels) && len(issue.Labels) > 0 {
+		labels := issue.Labels
+		more := ""
+		if len(labels) > 2 {
+			more = fmt.Sprintf(" +%d", len(labels)-2)
+			labels = labels[:2]
+		}
+		parts = append(parts, muted.Render("#"+strings.Join(labels, " #")+more))
+	}
+	if f.has(config.IssueFieldUpdated) && !issue.UpdatedAt.IsZero() {
+		clock := f.clock
+		if clock == nil {
+			clock = shared.RealClock{}
+		}
+		parts = append(parts, muted.Render(shared.FormatRelativeTimeWithClock(issue.UpdatedAt, clock)))
+	}
+	return parts
+}
+
+// renderedLines returns how many lines an issue row takes at the given width.
+func (f rowFormat) renderedLines(issue beads.Issue, width int) int {
+	line := f.render(issue, false, false, width)
+	lineWidth := lipgloss.Width(line)
+	if lineWidth <= width || width <= 0 {
+		return 1
+	}
+
+	return (lineWidth + width - 1) / width
+}
+
+// statusColor returns the color used for an issue status.
+func statusColor(status beads.Status) lipgloss.TerminalColor {
+	switch status {
+	case beads.StatusInProgress:
+		return styles.StatusInProgressColor
+	case beads.StatusClosed:
+		return styles.StatusClosedColor
+	case beads.StatusDeferred:
+		return styles.StatusDeferredColor
+	case beads.StatusBlocked:
+		return styles.StatusBlockedColor
+	default:
+		return styles.StatusOpenColor
+	}
+}
@@ -284,12 +284,13 @@ func (m Model) SetSize(width, height int) Model {
 }
 
 // CurrentConfig builds a ColumnConfig from current form state.
+// Settings the form doesn't edit (WIP limit, collapsed) are kept from the original column.
 func (m Model) CurrentConfig() config.ColumnConfig {
-	cfg := config.ColumnConfig{
-		Name:  m.nameInput.Value(),
-		Type:  m.columnType,
-		Color: m.colorValue,
-	}
+	cfg := m.original
+	cfg.Name = m.nameInput.Value()
+	cfg.Type = m.columnType
+	cfg.Color = m.colorValue
+	cfg.Query, cfg.IssueID, cfg.TreeMode = "", "", ""
 
 	if m.columnType == "tree" {
 		cfg.IssueID = m.issueIDInput.Value()
@@ -368,7 +369,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			// User cancelled - return to editor
 			m.showDeleteModal = false
 			return m, nil
-		case tea.KeyMsg:
+		case tea.KeyMsg, tea.MouseMsg:
 			var cmd tea.Cmd
 			m.deleteModal, cmd = m.deleteModal.Update(msg)
 			return m, cmd
@@ -387,7 +388,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		case colorpicker.CancelMsg:
 			m.showColorPicker = false
 			return m, nil
-		case tea.KeyMsg:
+		case tea.KeyMsg, tea.MouseMsg:
 			var cmd tea.Cmd
 			m.colorPicker, cmd = m.colorPicker.Update(msg)
 			return m, cmd
@@ -213,6 +213,19 @@ func TestCurrentConfig_BuildsCorrectly(t *testing.T) {
 	require.Equal(t, "#FF0000", cfg.Color)
 }
 
+func TestCurrentConfig_KeepsSettingsNotInForm(t *testing.T) {
+	columns := []config.ColumnConfig{
+		{Name: "In Progress", Query: "status = in_progress", WIPLimit: 3, WIPNotify: true, Collapsed: true},
+	}
+	ed := New(0, columns, nil, false, nil)
+
+	cfg := ed.CurrentConfig()
+
+	require.Equal(t, 3, cfg.WIPLimit)
+	require.True(t, cfg.WIPNotify)
+	require.True(t, cfg.Collapsed)
+}
+
 func TestLivePreview_FiltersOnQuery(t *testing.T) {
 	columns := []config.ColumnConfig{
 		{Name: "Open", Query: "status = open", Color: "#FF0000"},
@@ -3,6 +3,7 @@
 package commandpalette
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/zjrosen/perles/internal/keys"
@@ -13,14 +14,22 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/sahilm/fuzzy"
 )
 
+// itemZoneID returns the bubblezone ID for the filtered item at index i.
+func itemZoneID(i int) string {
+	return fmt.Sprintf("commandpalette-item-%d", i)
+}
+
 // Item represents a selectable item in the command palette.
 type Item struct {
 	ID          string                 // Unique identifier
 	Name        string                 // Display name (shown bold on first line)
 	Description string                 // Description (shown muted on second line)
 	Color       lipgloss.TerminalColor // Optional color for the name
+	Hint        string                 // Optional keybinding hint (shown muted right of the name)
 }
 
 // Config defines command palette configuration.
@@ -33,6 +42,7 @@ type Config struct {
 	MinWidth        int                // Minimum width (default 45)
 	MaxWidth        int                // Maximum width (default 80)
 	MaxVisibleItems int                // Max items visible before scrolling (default 5)
+	Fuzzy           bool               // Fuzzy-match names ranked by score (default substring)
 }
 
 // SelectMsg is sent when an item is selected (if OnSelect is nil).
@@ -123,7 +133,18 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 
 	case tea.MouseMsg:
-		// Only handle wheel events for scrolling
+		// Left-click selects the clicked item (same as Enter)
+		if msg.Button == tea.MouseButtonLeft && msg.Action == tea.MouseActionRelease {
+			endIdx := min(m.scrollOffset+m.maxVisibleItems(), len(m.filtered))
+			for i := m.scrollOffset; i < endIdx; i++ {
+				if z := zone.Get(itemZoneID(i)); z != nil && z.InBounds(msg) {
+					m.cursor = i
+					return m, m.selectCmd()
+				}
+			}
+			return m, nil
+		}
+		// Wheel events scroll the list
 		if msg.Button != tea.MouseButtonWheelUp && msg.Button != tea.MouseButtonWheelDown {
 			return m, nil
 		}
@@ -152,9 +173,15 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 func (m Model) updateFilter() Model {
 	query := strings.ToLower(m.textInput.Value())
 
-	if query == "" {
+	switch {
+	case query == "":
 		m.filtered = m.config.Items
-	} else {
+	case m.config.Fuzzy:
+		m.filtered = m.fuzzyFilter(query)
+		// Best match first, so keep the cursor on it while typing
+		m.cursor = 0
+		m.scrollOffset = 0
+	default:
 		var nameMatches []Item
 		var descMatches []Item
 
@@ -182,6 +209,29 @@ func (m Model) updateFilter() Model {
 	return m
 }
 
+// fuzzyFilter returns items whose name fuzzy-matches query, best match first,
+// followed by items whose description contains query as a substring.
+// Descriptions are not fuzzy-matched since long text matches almost anything.
+func (m Model) fuzzyFilter(query string) []Item {
+	names := make([]string, len(m.config.Items))
+	for i, item := range m.config.Items {
+		names[i] = item.Name
+	}
+
+	var result []Item
+	matched := make(map[int]bool)
+	for _, match := range fuzzy.Find(query, names) {
+		matched[match.Index] = true
+		result = append(result, m.config.Items[match.Index])
+	}
+	for i, item := range m.config.Items {
+		if !matched[i] && strings.Contains(strings.ToLower(item.Description), query) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // maxVisibleItems returns the max visible items.
 // Uses configured value or default, only shrinks if viewport is too small.
 func (m Model) maxVisibleItems() int {
@@ -348,7 +398,7 @@ func (m Model) View() string {
 		for i := m.scrollOffset; i < endIdx; i++ {
 			item := m.filtered[i]
 			content.WriteString("\n")
-			content.WriteString(m.renderItem(item, i == m.cursor, contentWidth))
+			content.WriteString(zone.Mark(itemZoneID(i), m.renderItem(item, i == m.cursor, contentWidth)))
 			content.WriteString("\n") // Empty line after each item for spacing
 			renderedCount++
 		}
@@ -402,8 +452,17 @@ func (m Model) renderItem(item Item, selected bool, width int) string {
 		indicator = " "
 	}
 
+	// Key hint on the right of the name line
+	var hint string
+	if item.Hint != "" {
+		hint = lipgloss.NewStyle().Foreground(styles.TextMutedColor).Render(item.Hint)
+	}
+
 	// Calculate available width for name
 	nameWidth := width - 2
+	if hint != "" {
+		nameWidth -= lipgloss.Width(hint) + 1
+	}
 
 	// Truncate name if needed
 	name := item.Name
@@ -412,6 +471,10 @@ func (m Model) renderItem(item Item, selected bool, width int) string {
 	}
 
 	result.WriteString(indicator + nameStyle.Render(name))
+	if hint != "" {
+		padding := max(width-1-lipgloss.Width(name)-lipgloss.Width(hint), 1)
+		result.WriteString(strings.Repeat(" ", padding) + hint)
+	}
 
 	// Description with word wrapping
 	if item.Description != "" {
@@ -430,17 +493,20 @@ func (m Model) renderItem(item Item, selected bool, width int) string {
 func (m Model) Overlay(background string) string {
 	paletteBox := m.View()
 
+	var result string
 	if background == "" {
-		return lipgloss.Place(
+		result = lipgloss.Place(
 			m.viewportWidth, m.viewportHeight,
 			lipgloss.Center, lipgloss.Center,
 			paletteBox,
 		)
+	} else {
+		result = overlay.Place(overlay.Config{
+			Width:    m.viewportWidth,
+			Height:   m.viewportHeight,
+			Position: overlay.Center,
+		}, paletteBox, background)
 	}
-
-	return overlay.Place(overlay.Config{
-		Width:    m.viewportWidth,
-		Height:   m.viewportHeight,
-		Position: overlay.Center,
-	}, paletteBox, background)
+	// Scan for zone markers to enable mouse click detection
+	return zone.Scan(result)
 }
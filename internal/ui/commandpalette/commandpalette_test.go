@@ -1,13 +1,21 @@
 package commandpalette
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/exp/teatest"
+	zone "github.com/lrstanley/bubblezone"
 	"github.com/stretchr/testify/require"
 )
 
+func TestMain(m *testing.M) {
+	zone.NewGlobal()
+	os.Exit(m.Run())
+}
+
 func testItems() []Item {
 	return []Item{
 		{ID: "debate", Name: "Technical Debate", Description: "Structured multi-perspective debate"},
@@ -181,6 +189,49 @@ func TestCommandPalette_Filter_CursorReset(t *testing.T) {
 	require.Equal(t, 0, m.cursor)
 }
 
+func TestCommandPalette_Filter_Fuzzy(t *testing.T) {
+	m := New(Config{Items: testItems(), Fuzzy: true})
+
+	// Characters in order but not contiguous
+	m.textInput.SetValue("cdrv")
+	m = m.updateFilter()
+
+	require.Len(t, m.filtered, 1)
+	require.Equal(t, "review", m.filtered[0].ID)
+}
+
+func TestCommandPalette_Filter_FuzzyRanksBestMatchFirst(t *testing.T) {
+	items := []Item{
+		{ID: "toggle", Name: "Toggle status bar"},
+		{ID: "theme", Name: "Theme: dracula"},
+	}
+	m := New(Config{Items: items, Fuzzy: true})
+	m.cursor = 1
+
+	m.textInput.SetValue("theme")
+	m = m.updateFilter()
+
+	require.NotEmpty(t, m.filtered)
+	require.Equal(t, "theme", m.filtered[0].ID)
+	// Cursor follows the best match
+	require.Equal(t, 0, m.cursor)
+}
+
+func TestCommandPalette_Filter_FuzzyDescriptionIsSubstring(t *testing.T) {
+	m := New(Config{Items: testItems(), Fuzzy: true})
+
+	// Substring of the Research description only
+	m.textInput.SetValue("synthesis")
+	m = m.updateFilter()
+	require.Len(t, m.filtered, 1)
+	require.Equal(t, "research", m.filtered[0].ID)
+
+	// Scattered letters do not match descriptions
+	m.textInput.SetValue("smvw")
+	m = m.updateFilter()
+	require.Empty(t, m.filtered)
+}
+
 func TestCommandPalette_ClearSearch(t *testing.T) {
 	m := New(Config{Items: testItems()})
 
@@ -403,6 +454,21 @@ func TestCommandPalette_View_Golden_NoTitle(t *testing.T) {
 	teatest.RequireEqualOutput(t, []byte(view))
 }
 
+func TestCommandPalette_View_Golden_WithHints(t *testing.T) {
+	m := New(Config{
+		Title: "Commands",
+		Items: []Item{
+			{ID: "edit", Name: "Edit issue", Description: "Open the issue editor", Hint: "ctrl+e"},
+			{ID: "theme", Name: "Switch theme", Hint: "ctrl+y"},
+			{ID: "plain", Name: "No hint"},
+		},
+		MaxWidth: 50,
+	}).SetSize(80, 24)
+
+	view := m.View()
+	teatest.RequireEqualOutput(t, []byte(view))
+}
+
 func TestCommandPalette_View_Golden_WithScrollIndicator(t *testing.T) {
 	// More items than maxVisible (5) to trigger scroll indicator
 	items := []Item{
@@ -532,3 +598,57 @@ func TestCommandPalette_Update_MouseIgnoresNonWheelEvents(t *testing.T) {
 	require.Equal(t, initialCursor, m.cursor)
 	require.Equal(t, initialOffset, m.scrollOffset)
 }
+
+// Mouse click tests
+
+// waitForZone renders the overlay until the zone is registered.
+// Zone registration in bubblezone is asynchronous via a channel worker.
+func waitForZone(t *testing.T, m Model, zoneID string) *zone.ZoneInfo {
+	t.Helper()
+	var z *zone.ZoneInfo
+	for retries := 0; retries < 10; retries++ {
+		_ = m.Overlay("")
+		time.Sleep(time.Millisecond)
+		z = zone.Get(zoneID)
+		if z != nil && !z.IsZero() {
+			break
+		}
+	}
+	require.NotNil(t, z, "zone %s should be registered after Overlay()", zoneID)
+	require.False(t, z.IsZero(), "zone %s should not be zero", zoneID)
+	return z
+}
+
+func TestCommandPalette_Update_MouseClickSelectsItem(t *testing.T) {
+	m := New(Config{Items: manyItems()}).SetSize(80, 40)
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+
+	z := waitForZone(t, m, itemZoneID(3))
+	m, cmd := m.Update(tea.MouseMsg{
+		X:      z.StartX + 1,
+		Y:      z.StartY,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionRelease,
+	})
+
+	require.Equal(t, 3, m.cursor)
+	require.NotNil(t, cmd, "expected click to select like Enter")
+	msg := cmd()
+	require.IsType(t, SelectMsg{}, msg)
+	require.Equal(t, "4", msg.(SelectMsg).Item.ID)
+}
+
+func TestCommandPalette_Update_MouseClickOutsideItems(t *testing.T) {
+	m := New(Config{Items: manyItems()}).SetSize(80, 40)
+	_ = waitForZone(t, m, itemZoneID(0))
+
+	m, cmd := m.Update(tea.MouseMsg{
+		X:      0,
+		Y:      0,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionRelease,
+	})
+
+	require.Nil(t, cmd)
+	require.Equal(t, 0, m.cursor)
+}
@@ -73,7 +73,7 @@ const (
 type Model struct {
 	issue              beads.Issue
 	viewport           viewport.Model
-	mdRenderer         *markdown.Renderer
+	mdRenderer         markdown.Renderer
 	markdownStyle      string // "dark" or "light"
 	width              int
 	height             int
@@ -86,7 +86,8 @@ type Model struct {
 	commentLoader      appbeads.CommentReader
 	commentsLoaded     bool
 	commentsError      error
-	hideFooter         bool // When true, footer is not rendered (e.g., in dashboard mode)
+	hideFooter         bool             // When true, footer is not rendered (e.g., in dashboard mode)
+	fields             []beads.FieldDef // Custom field definitions; their labels render as fields
 
 	// Cached renders to avoid recomputing on every scroll
 	cachedHeader   string
@@ -118,6 +119,14 @@ func (m Model) SetMarkdownStyle(style string) Model {
 	return m
 }
 
+// SetCustomFields sets the project's custom field definitions. Labels that store
+// a field value are shown as that field instead of in the labels list.
+func (m Model) SetCustomFields(fields []beads.FieldDef) Model {
+	m.fields = fields
+	m.cacheValid = false
+	return m
+}
+
 // SetHideFooter configures whether the footer is hidden (e.g., in dashboard mode).
 func (m Model) SetHideFooter(hide bool) Model {
 	m.hideFooter = hide
@@ -142,9 +151,7 @@ func (m Model) SetSize(width, height int) Model {
 
 	// Initialize or update markdown renderer (uses left column width)
 	if m.mdRenderer == nil || m.mdRenderer.Width() != leftColWidth {
-		if r, err := markdown.New(leftColWidth, m.markdownStyle); err == nil {
-			m.mdRenderer = r
-		}
+		m.mdRenderer = markdown.NewOrPlain(leftColWidth, m.markdownStyle)
 	}
 
 	// Viewport width matches left column width
@@ -217,9 +224,18 @@ func (m Model) calculateHeaderHeight(colWidth int) int {
 	// Single-column: title + meta line + optional labels line
 	// Meta line is roughly fixed width, labels vary
 	lines := titleLines + 1 // title + meta
-	if len(m.issue.Labels) > 0 {
+	if len(beads.PlainLabels(m.issue.Labels, m.fields)) > 0 {
 		lines++ // labels line
 	}
+	if len(beads.CustomFields(m.issue.Labels, m.fields)) > 0 {
+		lines++ // fields line
+	}
+	if len(m.issue.Attachments()) > 0 {
+		lines++ // attachments line
+	}
+	if _, ok := m.issue.Recurrence(); ok {
+		lines++ // repeats line
+	}
 	return lines
 }
 
@@ -473,9 +489,30 @@ func (m Model) renderHeader() string {
 		lines = append(lines, "Close Reason: "+issue.CloseReason)
 	}
 
+	// Custom fields line
+	if values := beads.CustomFields(issue.Labels, m.fields); len(values) > 0 {
+		var parts []string
+		for _, f := range m.fields {
+			if v, ok := values[f.Key]; ok {
+				parts = append(parts, f.DisplayLabel()+": "+v)
+			}
+		}
+		lines = append(lines, "Fields: "+strings.Join(parts, ", "))
+	}
+
 	// Labels line
-	if len(issue.Labels) > 0 {
-		lines = append(lines, "Labels: "+strings.Join(issue.Labels, ", "))
+	if labels := beads.PlainLabels(issue.Labels, m.fields); len(labels) > 0 {
+		lines = append(lines, "Labels: "+strings.Join(labels, ", "))
+	}
+
+	// Attachments line
+	if attachments := issue.Attachments(); len(attachments) > 0 {
+		lines = append(lines, "Attachments: "+strings.Join(attachments, ", "))
+	}
+
+	// Recurrence line
+	if rule, ok := issue.Recurrence(); ok {
+		lines = append(lines, "Repeats: "+rule.String())
 	}
 
 	return strings.Join(lines, "\n") + "\n"
@@ -516,8 +553,34 @@ func (m Model) renderLeftColumn() string {
 		sb.WriteString("\n")
 	}
 
+	// Commits section - commit links are stored as comments by orchestration
+	if links := beads.CommitLinks(m.comments); len(links) > 0 {
+		sb.WriteString("\n")
+		headerStyle := lipgloss.NewStyle().Bold(true)
+		sb.WriteString(headerStyle.Render("Commits"))
+		sb.WriteString("\n\n")
+
+		shaStyle := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+		threadStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+		for _, link := range links {
+			sb.WriteString(shaStyle.Render(link.ShortSHA()))
+			sb.WriteString(" " + link.Subject)
+			if link.ThreadID != "" {
+				sb.WriteString(threadStyle.Render(" (thread " + link.ThreadID + ")"))
+			}
+			sb.WriteString("\n")
+		}
+	}
+
 	// Comments section
-	if len(m.comments) > 0 {
+	var comments []beads.Comment
+	for _, c := range m.comments {
+		// Commit links and the work log are shown in their own sections
+		if _, isCommit := beads.ParseCommitComment(c.Text); !isCommit && !beads.IsWorkLogComment(c.Text) {
+			comments = append(comments, c)
+		}
+	}
+	if len(comments) > 0 {
 		sb.WriteString("\n")
 		headerStyle := lipgloss.NewStyle().Bold(true)
 		sb.WriteString(headerStyle.Render("Comments"))
@@ -531,7 +594,8 @@ func (m Model) renderLeftColumn() string {
 
 		commentHeaderStyle := lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
 
-		for _, c := range m.comments {
+		for _, thread := range beads.ThreadComments(comments) {
+			c := thread.Comment
 			// [author] timestamp - styled with secondary color
 			// Use same format as metadata timestamps for consistency
 			header := fmt.Sprintf("[%s] %s",
@@ -543,12 +607,53 @@ func (m Model) renderLeftColumn() string {
 			wrappedText := wordwrap.String(c.Text, wrapWidth)
 			sb.WriteString(wrappedText)
 			sb.WriteString("\n\n")
+
+			// Replies are indented under the comment they answer
+			for _, reply := range thread.Replies {
+				header := fmt.Sprintf("%s↳ [%s] %s",
+					commentReplyIndent,
+					reply.Author,
+					reply.CreatedAt.Format("2006-01-02 15:04:05"))
+				sb.WriteString(commentHeaderStyle.Render(header))
+				sb.WriteString("\n")
+				wrapped := wordwrap.String(reply.Text, wrapWidth-2*len(commentReplyIndent))
+				for line := range strings.SplitSeq(wrapped, "\n") {
+					sb.WriteString(commentReplyIndent + commentReplyIndent + line)
+					sb.WriteString("\n")
+				}
+				sb.WriteString("\n")
+			}
 		}
 	}
 
 	return sb.String()
 }
 
+// renderChildProgress renders a progress bar over the loaded child issues,
+// weighted by their size labels, with the closed/total count.
+func renderChildProgress(children []DependencyItem) string {
+	var issues []beads.Issue
+	for _, child := range children {
+		if child.Issue != nil {
+			issues = append(issues, *child.Issue)
+		}
+	}
+	progress := beads.ComputeEpicProgress("", issues)
+	if progress.Total == 0 {
+		return ""
+	}
+
+	const barWidth = 10
+	percent := progress.WeightedPercent()
+	filledWidth := int(barWidth * percent / 100)
+	barStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	bar := barStyle.Render(strings.Repeat("█", filledWidth) + strings.Repeat("░", barWidth-filledWidth))
+	return fmt.Sprintf("%s %.0f%% (%d/%d)", bar, percent, progress.Closed, progress.Total)
+}
+
+// commentReplyIndent indents replies under the comment they answer.
+const commentReplyIndent = "  "
+
 // renderMetadataColumn renders the right column metadata panel.
 // This will be used as the static right column in the two-column layout.
 func (m Model) renderMetadataColumn() string {
@@ -589,6 +694,39 @@ func (m Model) renderMetadataColumn() string {
 	sb.WriteString(indentedDivider)
 	sb.WriteString("\n")
 
+	// Custom fields (only those with a value)
+	if values := beads.CustomFields(issue.Labels, m.fields); len(values) > 0 {
+		labelWidth := 10
+		maxValueWidth := metadataContentWidth() - labelWidth
+		for _, f := range m.fields {
+			value, ok := values[f.Key]
+			if !ok {
+				continue
+			}
+			name := f.DisplayLabel()
+			if len(name) < labelWidth && len(value) <= maxValueWidth {
+				sb.WriteString(indent)
+				sb.WriteString(labelStyle.Render(name))
+				sb.WriteString(valueStyle.Render(value))
+				sb.WriteString("\n")
+				continue
+			}
+			// Wrap: name on its own line, value wrapped below with indent
+			sb.WriteString(indent)
+			sb.WriteString(labelStyle.UnsetWidth().Render(name))
+			sb.WriteString("\n")
+			valueIndent := indent + " "
+			wrapWidth := metadataContentWidth() - 1
+			for len(value) > 0 {
+				lineLen := min(len(value), wrapWidth)
+				sb.WriteString(valueIndent + value[:lineLen] + "\n")
+				value = value[lineLen:]
+			}
+		}
+		sb.WriteString(indentedDivider)
+		sb.WriteString("\n")
+	}
+
 	// Assignee (only show if non-empty)
 	if issue.Assignee != "" {
 		contentWidth := metadataContentWidth()
@@ -662,6 +800,37 @@ func (m Model) renderMetadataColumn() string {
 		sb.WriteString("\n")
 	}
 
+	if rule, ok := issue.Recurrence(); ok {
+		sb.WriteString(indent)
+		sb.WriteString(labelStyle.Render("Repeats"))
+		sb.WriteString(valueStyle.Render(rule.String()))
+		sb.WriteString("\n")
+	}
+
+	// Time tracking stamped by the orchestrator when workers start and complete
+	if !issue.StartedAt.IsZero() {
+		sb.WriteString(indent)
+		sb.WriteString(labelStyle.Render("Started"))
+		sb.WriteString(valueStyle.Render(issue.StartedAt.Format("2006-01-02 15:04:05")))
+		sb.WriteString("\n")
+		if !issue.CompletedAt.IsZero() {
+			sb.WriteString(indent)
+			sb.WriteString(labelStyle.Render("Completed"))
+			sb.WriteString(valueStyle.Render(issue.CompletedAt.Format("2006-01-02 15:04:05")))
+			sb.WriteString("\n")
+		}
+		if issue.WorkDuration > 0 {
+			sb.WriteString(indent)
+			sb.WriteString(labelStyle.Render("Worked"))
+			sb.WriteString(valueStyle.Render(formatDuration(issue.WorkDuration)))
+			sb.WriteString("\n")
+		}
+		sb.WriteString(indent)
+		sb.WriteString(labelStyle.Render("Workers"))
+		sb.WriteString(valueStyle.Render(strings.Join(issue.WorkedBy, ", ")))
+		sb.WriteString("\n")
+	}
+
 	// Closed timestamp and Duration (only for closed issues)
 	if !issue.ClosedAt.IsZero() {
 		sb.WriteString(indent)
@@ -700,7 +869,7 @@ func (m Model) renderMetadataColumn() string {
 	}
 
 	// Labels section
-	if len(issue.Labels) > 0 {
+	if labels := beads.PlainLabels(issue.Labels, m.fields); len(labels) > 0 {
 		sb.WriteString(indentedDivider)
 		sb.WriteString("\n")
 		sb.WriteString(indent)
@@ -709,7 +878,7 @@ func (m Model) renderMetadataColumn() string {
 
 		labelIndent := indent + " "
 		maxLabelWidth := metadataContentWidth() - 1 // -1 for extra indent
-		for _, label := range issue.Labels {
+		for _, label := range labels {
 			// Split long labels across multiple lines, each properly indented
 			for len(label) > 0 {
 				lineLen := min(len(label), maxLabelWidth)
@@ -719,6 +888,21 @@ func (m Model) renderMetadataColumn() string {
 		}
 	}
 
+	// Attachments section ("Files" fits the label column)
+	if attachments := issue.Attachments(); len(attachments) > 0 {
+		sb.WriteString(indentedDivider)
+		sb.WriteString("\n")
+		sb.WriteString(indent)
+		sb.WriteString(labelStyle.Render("Files"))
+		sb.WriteString("\n")
+
+		attachmentIndent := indent + " "
+		maxPathWidth := metadataContentWidth() - 1 // -1 for extra indent
+		for _, path := range attachments {
+			sb.WriteString(attachmentIndent + styles.TruncateString(path, maxPathWidth) + "\n")
+		}
+	}
+
 	// Dependencies section (rendered with board-style formatting)
 	depSection := m.renderDependenciesSection()
 	if depSection != "" {
@@ -877,6 +1061,11 @@ func (m Model) renderDependenciesSection() string {
 		sb.WriteString(indent)
 		sb.WriteString(labelStyle.Render("Children"))
 		sb.WriteString("\n")
+		if bar := renderChildProgress(children); bar != "" {
+			sb.WriteString(indent + " ")
+			sb.WriteString(bar)
+			sb.WriteString("\n")
+		}
 		for _, dep := range children {
 			sb.WriteString(m.renderDependencyItem(dep, depIndex == m.selectedDependency))
 			sb.WriteString("\n")
@@ -1132,6 +1321,9 @@ func (m *Model) loadComments() {
 	m.comments = comments
 	m.commentsError = err
 	m.commentsLoaded = true
+	if err == nil {
+		m.issue.ApplyWorkLog(comments)
+	}
 }
 
 // formatDuration returns a human-readable duration string.
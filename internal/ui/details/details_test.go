@@ -426,6 +426,72 @@ func TestDetails_EmptyDescription(t *testing.T) {
 	require.Contains(t, view, "test-1", "expected view to contain issue ID")
 }
 
+func TestDetails_CustomFields(t *testing.T) {
+	issue := beads.Issue{
+		ID:        "test-1",
+		TitleText: "Test Issue",
+		Labels:    []string{"bug", "component:api", "spec:https://example.com/specs/authentication-flow"},
+		CreatedAt: time.Now(),
+	}
+	fields := []beads.FieldDef{
+		{Key: "component", Label: "Component", Type: beads.FieldEnum, Options: []string{"api"}},
+		{Key: "spec", Label: "Design Spec", Type: beads.FieldURL},
+	}
+
+	// Two-column layout: fields in the metadata column, not in the labels list
+	view := createTestModel(t, issue).SetCustomFields(fields).SetSize(100, 40).View()
+	require.Regexp(t, `Component +api`, view)
+	require.Contains(t, view, "Design Spec")
+	require.Contains(t, view, "https://example.com/specs/auth", "long values wrap")
+	require.NotContains(t, view, "component:api")
+	require.Contains(t, view, "bug")
+
+	// Single-column layout: fields line in the header
+	view = createTestModel(t, issue).SetCustomFields(fields).SetSize(60, 40).View()
+	require.Contains(t, view, "Fields: Component: api")
+	require.Contains(t, view, "Labels: bug")
+}
+
+func TestDetails_Attachments(t *testing.T) {
+	issue := beads.Issue{
+		ID:        "test-1",
+		TitleText: "Test Issue",
+		Labels:    []string{"bug", "attachment:docs/design.md"},
+		CreatedAt: time.Now(),
+	}
+
+	// Two-column layout: attachments section in the metadata column
+	view := createTestModel(t, issue).SetSize(100, 40).View()
+	require.Contains(t, view, "Files")
+	require.Contains(t, view, "docs/design.md")
+	require.NotContains(t, view, "attachment:docs/design.md")
+
+	// Single-column layout: attachments line in the header
+	view = createTestModel(t, issue).SetSize(60, 40).View()
+	require.Contains(t, view, "Attachments: docs/design.md")
+	require.Contains(t, view, "Labels: bug")
+}
+
+func TestDetails_Recurrence(t *testing.T) {
+	issue := beads.Issue{
+		ID:        "test-1",
+		TitleText: "Test Issue",
+		Labels:    []string{"deps", "recur:every-2w"},
+		CreatedAt: time.Now(),
+	}
+
+	// Two-column layout: repeats row in the metadata column
+	view := createTestModel(t, issue).SetSize(100, 40).View()
+	require.Contains(t, view, "Repeats")
+	require.Contains(t, view, "every-2w")
+	require.NotContains(t, view, "recur:every-2w")
+
+	// Single-column layout: repeats line in the header
+	view = createTestModel(t, issue).SetSize(60, 40).View()
+	require.Contains(t, view, "Repeats: every-2w")
+	require.Contains(t, view, "Labels: deps")
+}
+
 func TestDetails_NoLabels(t *testing.T) {
 	issue := beads.Issue{
 		ID:        "test-1",
@@ -1038,6 +1104,83 @@ func TestDetails_View_Golden_WithComments(t *testing.T) {
 	teatest.RequireEqualOutput(t, []byte(view))
 }
 
+// TestDetails_View_Golden_WithCommits tests that commit links are listed in
+// their own section instead of as comments.
+// Run with -update flag to update golden files: go test -update ./internal/ui/details/...
+func TestDetails_View_Golden_WithCommits(t *testing.T) {
+	commentLoader := mocks.NewMockBeadsClient(t)
+	commentLoader.EXPECT().GetComments("committed-task").Return([]beads.Comment{
+		{
+			ID:        1,
+			Author:    "alice",
+			Text:      "Please keep the API stable.",
+			CreatedAt: time.Date(2024, 4, 2, 14, 30, 0, 0, time.UTC),
+		},
+		{
+			ID:        2,
+			Author:    "coordinator",
+			Text:      "Commit 3f2a9c1e8d7b6a5f: Add login form validation\nFabric thread: msg-42\n\n2 files changed, +40 -12",
+			CreatedAt: time.Date(2024, 4, 2, 15, 45, 0, 0, time.UTC),
+		},
+	}, nil)
+
+	issue := beads.Issue{
+		ID:              "committed-task",
+		TitleText:       "Task with Commits",
+		DescriptionText: "This task was committed by a worker.",
+		Type:            beads.TypeTask,
+		Priority:        beads.PriorityMedium,
+		Status:          beads.StatusOpen,
+		CreatedAt:       time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+	m := New(issue, nil, commentLoader).SetSize(120, 30)
+
+	view := m.View()
+	teatest.RequireEqualOutput(t, []byte(view))
+}
+
+func TestDetails_View_ThreadedComments(t *testing.T) {
+	commentLoader := mocks.NewMockBeadsClient(t)
+	commentLoader.EXPECT().GetComments("threaded-task").Return([]beads.Comment{
+		{ID: 1, Author: "alice", Text: "Which API version?", CreatedAt: time.Date(2024, 4, 2, 14, 0, 0, 0, time.UTC)},
+		{ID: 2, Author: "bob", Text: "Unrelated note", CreatedAt: time.Date(2024, 4, 2, 14, 5, 0, 0, time.UTC)},
+		{ID: 3, Author: "worker-1", Text: beads.FormatCommentReply(1, "v2, see the spec"), CreatedAt: time.Date(2024, 4, 2, 14, 30, 0, 0, time.UTC)},
+	}, nil)
+
+	issue := beads.Issue{ID: "threaded-task", TitleText: "Task with Replies", Type: beads.TypeTask, Status: beads.StatusOpen}
+	view := stripANSI(New(issue, nil, commentLoader).SetSize(120, 40).View())
+
+	question := strings.Index(view, "Which API version?")
+	reply := strings.Index(view, "  ↳ [worker-1] 2024-04-02 14:30:00")
+	note := strings.Index(view, "Unrelated note")
+	require.True(t, question >= 0 && reply > question && note > reply, "the reply is shown under its comment")
+	require.Contains(t, view, "    v2, see the spec")
+	require.NotContains(t, view, "Re #1")
+}
+
+func TestDetails_View_WorkLog(t *testing.T) {
+	commentLoader := mocks.NewMockBeadsClient(t)
+	commentLoader.EXPECT().GetComments("worked-task").Return([]beads.Comment{
+		{ID: 1, Author: "coordinator", Text: beads.FormatWorkStartedComment("worker-1"), CreatedAt: time.Date(2024, 4, 2, 14, 0, 0, 0, time.UTC)},
+		{ID: 2, Author: "coordinator", Text: beads.FormatWorkCompletedComment("worker-1", 95*time.Minute), CreatedAt: time.Date(2024, 4, 2, 15, 35, 0, 0, time.UTC)},
+	}, nil)
+
+	issue := beads.Issue{
+		ID:        "worked-task",
+		TitleText: "Task with Work Log",
+		Type:      beads.TypeTask,
+		Status:    beads.StatusOpen,
+		CreatedAt: time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+	view := New(issue, nil, commentLoader).SetSize(120, 30).View()
+
+	require.Contains(t, view, "2024-04-02 14:00:00")
+	require.Contains(t, view, "2024-04-02 15:35:00")
+	require.Contains(t, view, "1h 35m")
+	require.Contains(t, view, "worker-1")
+	require.NotContains(t, view, "Work started by", "work log comments are not listed as comments")
+}
+
 // TestDetails_View_Golden_WithAssigneeAndComments tests rendering with both assignee and comments.
 // Run with -update flag to update golden files: go test -update ./internal/ui/details/...
 func TestDetails_View_Golden_WithAssigneeAndComments(t *testing.T) {
@@ -1328,3 +1471,13 @@ func TestDetails_View_Golden_LongLabels(t *testing.T) {
 	view := m.View()
 	teatest.RequireEqualOutput(t, []byte(view))
 }
+
+func TestRenderChildProgress(t *testing.T) {
+	children := []DependencyItem{
+		{ID: "a", Category: "children", Issue: &beads.Issue{ID: "a", Status: beads.StatusClosed, Labels: []string{"size:l"}}},
+		{ID: "b", Category: "children", Issue: &beads.Issue{ID: "b", Status: beads.StatusOpen, Labels: []string{"size:xs"}}},
+		{ID: "c", Category: "children"}, // not loaded
+	}
+	require.Equal(t, "████████░░ 83% (1/2)", stripANSI(renderChildProgress(children)))
+	require.Empty(t, renderChildProgress([]DependencyItem{{ID: "c", Category: "children"}}))
+}
@@ -79,6 +79,7 @@ func BQLExamples() []string {
 		"type = epic expand down",
 		"type = epic expand down depth *",
 		"id = x expand up",
+		`status:open label:bug priority<=P1 text:"auth"`,
 	}
 }
 
@@ -237,6 +238,7 @@ func (m Model) renderKanbanContent() string {
 	navCol.WriteString(renderBinding(keys.App.ChatNextSession))
 	navCol.WriteString(renderBinding(keys.App.ChatPrevSession))
 	navCol.WriteString(renderBinding(keys.Kanban.Dashboard))
+	navCol.WriteString(renderBinding(keys.App.ThemeSwitcher))
 
 	// Actions column
 	var actionsCol strings.Builder
@@ -250,6 +252,9 @@ func (m Model) renderKanbanContent() string {
 	actionsCol.WriteString(renderBinding(keys.Kanban.DeleteColumn))
 	actionsCol.WriteString(renderBinding(keys.Kanban.MoveColumnLeft))
 	actionsCol.WriteString(renderBinding(keys.Kanban.MoveColumnRight))
+	actionsCol.WriteString(renderBinding(keys.Kanban.CollapseColumn))
+	actionsCol.WriteString(renderBinding(keys.Kanban.MoveIssueLeft))
+	actionsCol.WriteString(renderBinding(keys.Kanban.MoveIssueRight))
 	actionsCol.WriteString(renderBinding(keys.Component.EditAction))
 	actionsCol.WriteString(renderBinding(keys.Component.DelAction))
 
@@ -267,6 +272,7 @@ func (m Model) renderKanbanContent() string {
 	generalCol.WriteString(sectionStyle.Render("General"))
 	generalCol.WriteString("\n")
 	generalCol.WriteString(renderBinding(keys.Common.Help))
+	generalCol.WriteString(renderBinding(keys.App.CommandPalette))
 	generalCol.WriteString(renderBinding(keys.Kanban.ToggleStatus))
 	generalCol.WriteString(renderBinding(keys.Kanban.Escape))
 	generalCol.WriteString(renderBinding(keys.Kanban.QuitConfirm))
@@ -342,6 +348,8 @@ func (m Model) renderSearchContent() string {
 	actionsCol.WriteString("\n")
 	actionsCol.WriteString(renderBinding(keys.Search.OpenTree))
 	actionsCol.WriteString(renderBinding(keys.Search.Yank))
+	actionsCol.WriteString(renderBinding(keys.Search.Mark))
+	actionsCol.WriteString(renderBinding(keys.Search.BulkEdit))
 	actionsCol.WriteString(renderBinding(keys.Search.SaveColumn))
 
 	// General column
@@ -350,6 +358,7 @@ func (m Model) renderSearchContent() string {
 	generalCol.WriteString("\n")
 	generalCol.WriteString(renderBinding(keys.Search.SwitchMode))
 	generalCol.WriteString(renderBinding(keys.Search.Help))
+	generalCol.WriteString(renderBinding(keys.App.CommandPalette))
 	generalCol.WriteString(renderBinding(keys.Search.QuitConfirm))
 
 	// User Actions column (only if user has configured actions)
@@ -448,10 +457,11 @@ func (m Model) renderSearchContent() string {
 	examples := BQLExamples()
 	// Show only a few examples in compact overlay (skip simple ones)
 	compactExamples := []string{
-		examples[1], // "status = open and ready = true"
-		examples[3], // "type in (bug, task) and status != closed"
-		examples[5], // "created >= -7d order by priority"
-		examples[7], // "type = epic expand down"
+		examples[1],  // "status = open and ready = true"
+		examples[3],  // "type in (bug, task) and status != closed"
+		examples[5],  // "created >= -7d order by priority"
+		examples[7],  // "type = epic expand down"
+		examples[10], // filter syntax
 	}
 	for _, ex := range compactExamples {
 		examplesCol.WriteString(bqlStyle.Render(ex) + "\n")
@@ -558,6 +568,7 @@ func (m Model) renderDashboardContent() string {
 	navCol.WriteString(renderBinding(keys.Dashboard.Down))
 	navCol.WriteString(renderBinding(keys.Dashboard.GotoTop))
 	navCol.WriteString(renderBinding(keys.Dashboard.GotoBottom))
+	navCol.WriteString(renderBinding(keys.Dashboard.NextAttention))
 	navCol.WriteString(renderKeyDesc("Tab", "cycle focus zone"))
 
 	// Workflow Actions column
@@ -566,6 +577,7 @@ func (m Model) renderDashboardContent() string {
 	actionsCol.WriteString("\n")
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Start))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Stop))
+	actionsCol.WriteString(renderBinding(keys.Dashboard.EmergencyStop))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.New))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Help))
 	actionsCol.WriteString(renderBinding(keys.Dashboard.Quit))
@@ -579,6 +591,10 @@ func (m Model) renderDashboardContent() string {
 	treeCol.WriteString(renderKeyDesc("h/l", "tree ↔ details"))
 	treeCol.WriteString(renderKeyDesc("d", "toggle direction"))
 	treeCol.WriteString(renderKeyDesc("m", "toggle mode"))
+	treeCol.WriteString(renderKeyDesc("p", "assignment plan"))
+	treeCol.WriteString(renderBinding(keys.Dashboard.WorkerGrid))
+	treeCol.WriteString(renderKeyDesc("enter", "worker transcript"))
+	treeCol.WriteString(renderKeyDesc("t", "full transcript"))
 
 	// Join columns horizontally, aligned at top
 	columns := lipgloss.JoinHorizontal(
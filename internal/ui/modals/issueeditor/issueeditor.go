@@ -2,13 +2,23 @@
 //
 // This modal combines priority, status, and labels editing into a single form,
 // replacing the previous three-modal architecture with a streamlined interface.
+// Projects with custom fields get one extra field each, stored as "key:value" labels.
+// Dependencies (blocked by / blocks) are picked from open issues loaded asynchronously.
+// Attachments link files to the issue and are stored as "attachment:path" labels.
 package issueeditor
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
 	"slices"
 	"strconv"
+	"strings"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/bql"
 	"github.com/zjrosen/perles/internal/mode/shared"
 	"github.com/zjrosen/perles/internal/ui/shared/formmodal"
 	"github.com/zjrosen/perles/internal/ui/shared/issuebadge"
@@ -16,10 +26,23 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// Config configures the issue editor.
+type Config struct {
+	// Fields are the project's custom field definitions.
+	Fields []beads.FieldDef
+	// Executor finds the open issues offered in the dependency pickers. With a
+	// nil executor they only offer the issue's current dependencies.
+	Executor bql.BQLExecutor
+	// WorkDir is the directory attachment paths are relative to
+	// (default: the current directory).
+	WorkDir string
+}
+
 // Model holds the issue editor state.
 type Model struct {
-	issue beads.Issue
-	form  formmodal.Model
+	issue  beads.Issue
+	fields []beads.FieldDef
+	form   formmodal.Model
 }
 
 // SaveMsg is sent when the user confirms issue changes.
@@ -31,6 +54,15 @@ type SaveMsg struct {
 	Priority    beads.Priority
 	Status      beads.Status
 	Labels      []string
+	BlockedBy   []string // IDs of issues blocking this one
+	Blocks      []string // IDs of issues this one blocks
+	Attachments []string // Linked file paths, also stored in Labels
+
+	// ChangedFields lists the fields the user modified, in form order:
+	// title, priority, status, labels, custom field keys, repeat, description, notes,
+	// blocked_by, blocks, attachments.
+	// Toggling a value back to its original does not count as a change.
+	ChangedFields []string
 }
 
 // CancelMsg is sent when the user cancels the editor.
@@ -38,7 +70,10 @@ type CancelMsg struct{}
 
 // BuildUpdateOptions compares the SaveMsg fields against the original issue
 // snapshot and returns an UpdateIssueOptions with only changed fields set (non-nil).
-// If original is nil (safety fallback), all fields are populated from the SaveMsg.
+// Dependency edits become add/remove operations against the original's BlockedBy
+// and Blocks.
+// If original is nil (safety fallback), all fields are populated from the SaveMsg,
+// except dependencies, which can't be diffed.
 func (m SaveMsg) BuildUpdateOptions(original *beads.Issue) beads.UpdateIssueOptions {
 	var opts beads.UpdateIssueOptions
 	if original == nil {
@@ -74,14 +109,46 @@ func (m SaveMsg) BuildUpdateOptions(original *beads.Issue) beads.UpdateIssueOpti
 		labels := m.Labels
 		opts.Labels = &labels
 	}
+	for _, id := range added(original.BlockedBy, m.BlockedBy) {
+		opts.Dependencies = append(opts.Dependencies, beads.DependencyChange{IssueID: m.IssueID, DependsOnID: id})
+	}
+	for _, id := range added(m.BlockedBy, original.BlockedBy) {
+		opts.Dependencies = append(opts.Dependencies, beads.DependencyChange{IssueID: m.IssueID, DependsOnID: id, Remove: true})
+	}
+	for _, id := range added(original.Blocks, m.Blocks) {
+		opts.Dependencies = append(opts.Dependencies, beads.DependencyChange{IssueID: id, DependsOnID: m.IssueID})
+	}
+	for _, id := range added(m.Blocks, original.Blocks) {
+		opts.Dependencies = append(opts.Dependencies, beads.DependencyChange{IssueID: id, DependsOnID: m.IssueID, Remove: true})
+	}
 	return opts
 }
 
+// added returns the IDs in after that are not in before, in order.
+func added(before, after []string) []string {
+	var ids []string
+	for _, id := range after {
+		if !slices.Contains(before, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// fieldKeyPrefix namespaces custom field form keys so they can't collide with built-in fields.
+const fieldKeyPrefix = "field:"
+
+// dependencyQuery selects the issues offered in the dependency pickers.
+const dependencyQuery = "status != closed order by updated desc"
+
 // New creates a new issue editor with the given issue.
-func New(issue beads.Issue) Model {
-	m := Model{issue: issue}
+// Custom field definitions add one field each below the labels; their values are
+// saved as "key:value" labels and hidden from the labels list, as are attachments
+// and the recurrence rule.
+func New(issue beads.Issue, cfg Config) Model {
+	m := Model{issue: issue, fields: cfg.Fields}
 
-	cfg := formmodal.FormConfig{
+	formCfg := formmodal.FormConfig{
 		Title: "Edit Issue",
 		TitleContent: func(width int) string {
 			return issuebadge.RenderBadge(m.issue)
@@ -121,13 +188,13 @@ func New(issue beads.Issue) Model {
 				Type:             formmodal.FieldTypeEditableList,
 				Label:            "Labels",
 				Hint:             "Space to toggle",
-				Options:          labelsListOptions(issue.Labels),
+				Options:          labelsListOptions(editableLabels(issue.Labels, cfg.Fields)),
 				InputLabel:       "Add Label",
 				InputHint:        "Enter to add",
 				InputPlaceholder: "Enter label name...",
 				Column:           0,
 			},
-			// Column 1 (right/content): description, notes
+			// Column 1 (right/content): description, notes, dependencies, attachments
 			{
 				Key:          "description",
 				Type:         formmodal.FieldTypeTextArea,
@@ -136,6 +203,7 @@ func New(issue beads.Issue) Model {
 				Placeholder:  "Issue description...",
 				InitialValue: issue.DescriptionText,
 				VimEnabled:   true,
+				SpellCheck:   true,
 				MaxHeight:    8,
 				Column:       1,
 			},
@@ -147,30 +215,324 @@ func New(issue beads.Issue) Model {
 				Placeholder:  "Issue notes...",
 				InitialValue: issue.Notes,
 				VimEnabled:   true,
+				SpellCheck:   true,
 				MaxHeight:    8,
 				Column:       1,
 			},
 		},
 		SubmitLabel: "Save",
 		MinWidth:    52,
-		OnSubmit: func(values map[string]any) tea.Msg {
+		Validate: func(values map[string]any) error {
+			var errs []error
+			for _, f := range m.fields {
+				errs = append(errs, f.Validate(fieldValue(values, f.Key)))
+			}
+			// Saving replaces every label of a field with the field's value,
+			// so a field label left in the labels list would be lost
+			for _, label := range values["labels"].([]string) {
+				if f, ok := labelField(label, m.fields); ok {
+					errs = append(errs, fmt.Errorf("labels: %q is a %s value; set it in the %s field or remove the label",
+						label, f.Key, f.DisplayLabel()))
+				}
+			}
+			return errors.Join(errs...)
+		},
+		OnSubmitChanges: func(values map[string]any, changed []string) tea.Msg {
 			return SaveMsg{
-				IssueID:     m.issue.ID,
-				Title:       values["title"].(string),
-				Description: values["description"].(string),
-				Notes:       values["notes"].(string),
-				Priority:    parsePriority(values["priority"].(string)),
-				Status:      beads.Status(values["status"].(string)),
-				Labels:      values["labels"].([]string),
+				IssueID:       m.issue.ID,
+				Title:         values["title"].(string),
+				Description:   values["description"].(string),
+				Notes:         values["notes"].(string),
+				Priority:      parsePriority(values["priority"].(string)),
+				Status:        beads.Status(values["status"].(string)),
+				Labels:        m.labelsWithFields(values),
+				BlockedBy:     values["blocked_by"].([]string),
+				Blocks:        values["blocks"].([]string),
+				Attachments:   values["attachments"].([]string),
+				ChangedFields: changedFieldKeys(changed),
 			}
 		},
-		OnCancel: func() tea.Msg { return CancelMsg{} },
+		OnCancel:       func() tea.Msg { return CancelMsg{} },
+		ConfirmDiscard: true,
 	}
 
-	m.form = formmodal.New(cfg)
+	// Custom fields go in the metadata column, right after labels
+	labelsIdx := slices.IndexFunc(formCfg.Fields, func(f formmodal.FieldConfig) bool { return f.Key == "labels" })
+	formCfg.Fields = slices.Insert(formCfg.Fields, labelsIdx+1, customFieldConfigs(issue.Labels, cfg.Fields)...)
+	// The recurrence rule ends the metadata column
+	formCfg.Fields = slices.Insert(formCfg.Fields, labelsIdx+1+len(cfg.Fields), formmodal.FieldConfig{
+		Key:     "repeat",
+		Type:    formmodal.FieldTypeSelect,
+		Label:   "Repeat",
+		Hint:    "Space to toggle",
+		Options: repeatListOptions(issue),
+		Column:  0,
+	})
+	// Dependency pickers and attachments go in the content column, below notes
+	formCfg.Fields = append(formCfg.Fields, dependencyFieldConfigs(issue, cfg.Executor)...)
+	formCfg.Fields = append(formCfg.Fields, formmodal.FieldConfig{
+		Key:              "attachments",
+		Type:             formmodal.FieldTypeEditableList,
+		Label:            "Attachments",
+		Hint:             "Space to toggle",
+		Options:          labelsListOptions(issue.Attachments()),
+		InputLabel:       "Add Attachment",
+		InputHint:        "Enter to add",
+		InputPlaceholder: "File path...",
+		NormalizeItem:    attachmentNormalizer(cfg.WorkDir),
+		Column:           1,
+	})
+
+	m.form = formmodal.New(formCfg)
 	return m
 }
 
+// editableLabels returns the labels shown in the labels list: the plain labels,
+// plus the values of fields that have several, which the field itself can't show.
+func editableLabels(labels []string, fields []beads.FieldDef) []string {
+	editable := beads.PlainLabels(labels, fields)
+	shown := beads.CustomFields(labels, fields)
+	for _, label := range labels {
+		if f, ok := labelField(label, fields); ok && label != beads.FieldLabel(f.Key, shown[f.Key]) {
+			editable = append(editable, label)
+		}
+	}
+	return editable
+}
+
+// labelField returns the custom field that stores its value in label.
+func labelField(label string, fields []beads.FieldDef) (beads.FieldDef, bool) {
+	for _, f := range fields {
+		if strings.HasPrefix(label, f.Key+":") {
+			return f, true
+		}
+	}
+	return beads.FieldDef{}, false
+}
+
+// labelsWithFields merges the custom field values, attachments and recurrence
+// rule into the submitted labels. The original labels are kept when nothing
+// changed, so reordering alone doesn't count as a labels update.
+func (m Model) labelsWithFields(values map[string]any) []string {
+	labels := values["labels"].([]string)
+	if len(m.fields) > 0 {
+		fieldValues := make(map[string]string, len(m.fields))
+		for _, f := range m.fields {
+			fieldValues[f.Key] = fieldValue(values, f.Key)
+		}
+		labels = beads.SetCustomFields(labels, m.fields, fieldValues)
+	}
+	labels = beads.SetAttachments(labels, values["attachments"].([]string))
+	var rule *beads.RecurrenceRule
+	if r, err := beads.ParseRecurrenceRule(values["repeat"].(string)); err == nil {
+		rule = &r
+	}
+	labels = beads.SetRecurrence(labels, rule)
+
+	if len(labels) == len(m.issue.Labels) && !slices.ContainsFunc(labels, func(l string) bool { return !slices.Contains(m.issue.Labels, l) }) {
+		return m.issue.Labels
+	}
+	return labels
+}
+
+// repeatListOptions returns the recurrence choices, selecting the issue's
+// current rule. A rule without a named choice (e.g. every-2w) is offered as is.
+func repeatListOptions(issue beads.Issue) []formmodal.ListOption {
+	current := ""
+	if rule, ok := issue.Recurrence(); ok {
+		current = rule.String()
+	}
+	opts := []formmodal.ListOption{
+		{Label: "Never", Value: ""},
+		{Label: "When closed", Value: "on-close"},
+		{Label: "Daily", Value: "daily"},
+		{Label: "Weekly", Value: "weekly"},
+		{Label: "Monthly", Value: "monthly"},
+	}
+	if !slices.ContainsFunc(opts, func(o formmodal.ListOption) bool { return o.Value == current }) {
+		opts = append(opts, formmodal.ListOption{Label: "Every " + strings.TrimPrefix(current, "every-"), Value: current})
+	}
+	for i := range opts {
+		opts[i].Selected = opts[i].Value == current
+	}
+	return opts
+}
+
+// changedFieldKeys strips the custom field prefix from changed form keys.
+func changedFieldKeys(changed []string) []string {
+	if len(changed) == 0 {
+		return nil
+	}
+	keys := make([]string, len(changed))
+	for i, key := range changed {
+		keys[i] = strings.TrimPrefix(key, fieldKeyPrefix)
+	}
+	return keys
+}
+
+// fieldValue returns the trimmed form value of a custom field.
+func fieldValue(values map[string]any, key string) string {
+	v, _ := values[fieldKeyPrefix+key].(string)
+	return strings.TrimSpace(v)
+}
+
+// customFieldConfigs builds one form field per custom field definition,
+// pre-filled from the issue's labels.
+func customFieldConfigs(labels []string, fields []beads.FieldDef) []formmodal.FieldConfig {
+	current := beads.CustomFields(labels, fields)
+	configs := make([]formmodal.FieldConfig, 0, len(fields))
+	for _, f := range fields {
+		cfg := formmodal.FieldConfig{
+			Key:    fieldKeyPrefix + f.Key,
+			Label:  f.DisplayLabel(),
+			Column: 0,
+		}
+		switch f.Type {
+		case beads.FieldEnum:
+			cfg.Type = formmodal.FieldTypeSelect
+			cfg.Hint = "Space to toggle"
+			cfg.Options = []formmodal.ListOption{{Label: "(none)", Value: "", Selected: current[f.Key] == ""}}
+			for _, opt := range f.Options {
+				cfg.Options = append(cfg.Options, formmodal.ListOption{Label: opt, Value: opt, Selected: opt == current[f.Key]})
+			}
+		default:
+			cfg.Type = formmodal.FieldTypeText
+			cfg.Hint = string(f.Type)
+			cfg.InitialValue = current[f.Key]
+			cfg.MaxLength = 200
+			if f.Type == beads.FieldURL {
+				cfg.Placeholder = "https://..."
+			}
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
+// attachmentNormalizer returns the NormalizeItem function of the attachments
+// field. It accepts paths as typed or pasted (quoted, with escaped spaces, or
+// as file:// URLs), checks that the file exists, and stores paths inside
+// workDir relative to it with forward slashes. Paths outside workDir stay absolute.
+func attachmentNormalizer(workDir string) func(string) (string, error) {
+	return func(value string) (string, error) {
+		path := unquotePath(value)
+		if home, err := os.UserHomeDir(); err == nil {
+			if rest, ok := strings.CutPrefix(path, "~/"); ok {
+				path = filepath.Join(home, rest)
+			}
+		}
+
+		root := workDir
+		if root == "" {
+			if wd, err := os.Getwd(); err == nil {
+				root = wd
+			}
+		}
+		abs := path
+		if !filepath.IsAbs(abs) {
+			abs = filepath.Join(root, abs)
+		}
+		abs = filepath.Clean(abs)
+
+		info, err := os.Stat(abs)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return "", fmt.Errorf("attachment %s: file not found", value)
+			}
+			return "", fmt.Errorf("attachment %s: %w", value, err)
+		}
+		if info.IsDir() {
+			return "", fmt.Errorf("attachment %s: is a directory", value)
+		}
+
+		stored := abs
+		if rel, err := filepath.Rel(root, abs); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			stored = filepath.ToSlash(rel)
+		}
+		if err := beads.ValidateAttachmentPath(stored); err != nil {
+			return "", err
+		}
+		return stored, nil
+	}
+}
+
+// unquotePath undoes the quoting terminals apply to dropped or pasted paths.
+func unquotePath(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	if rest, ok := strings.CutPrefix(value, "file://"); ok {
+		if path, err := url.PathUnescape(rest); err == nil {
+			return path
+		}
+		return rest
+	}
+	return strings.ReplaceAll(value, `\ `, " ")
+}
+
+// dependencyFieldConfigs builds the "blocked by" and "blocks" pickers, which
+// offer the same candidate issues.
+func dependencyFieldConfigs(issue beads.Issue, executor bql.BQLExecutor) []formmodal.FieldConfig {
+	load := func() ([]formmodal.ListOption, error) {
+		var candidates []beads.Issue
+		if executor != nil {
+			issues, err := executor.Execute(dependencyQuery)
+			if err != nil {
+				return nil, err
+			}
+			candidates = issues
+		}
+		return dependencyListOptions(issue, candidates), nil
+	}
+	return []formmodal.FieldConfig{
+		{
+			Key:               "blocked_by",
+			Type:              formmodal.FieldTypeAsyncSelect,
+			Label:             "Blocked By",
+			Hint:              "Enter to toggle",
+			MultiSelect:       true,
+			LoadOptions:       load,
+			InitialValues:     issue.BlockedBy,
+			SearchPlaceholder: "Search issues...",
+			Column:            1,
+		},
+		{
+			Key:               "blocks",
+			Type:              formmodal.FieldTypeAsyncSelect,
+			Label:             "Blocks",
+			Hint:              "Enter to toggle",
+			MultiSelect:       true,
+			LoadOptions:       load,
+			InitialValues:     issue.Blocks,
+			SearchPlaceholder: "Search issues...",
+			Column:            1,
+		},
+	}
+}
+
+// dependencyListOptions converts candidate issues to dependency options,
+// leaving out the issue itself. Current dependencies missing from the
+// candidates (e.g. closed ones) are appended so they can still be removed.
+func dependencyListOptions(issue beads.Issue, candidates []beads.Issue) []formmodal.ListOption {
+	var result []formmodal.ListOption
+	seen := map[string]bool{issue.ID: true}
+	for _, c := range candidates {
+		if seen[c.ID] {
+			continue
+		}
+		seen[c.ID] = true
+		result = append(result, formmodal.ListOption{Label: c.ID + " " + c.TitleText, Value: c.ID})
+	}
+	for _, id := range slices.Concat(issue.BlockedBy, issue.Blocks) {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, formmodal.ListOption{Label: id, Value: id})
+		}
+	}
+	return result
+}
+
 // priorityListOptions converts shared.PriorityOptions to formmodal.ListOption
 // with the current priority pre-selected, preserving colors.
 func priorityListOptions(current beads.Priority) []formmodal.ListOption {
@@ -233,9 +595,9 @@ func (m Model) SetSize(width, height int) Model {
 	return m
 }
 
-// Init initializes the model.
+// Init initializes the model, starting the dependency pickers' load.
 func (m Model) Init() tea.Cmd {
-	return nil
+	return m.form.Init()
 }
 
 // Update handles messages.
@@ -2,12 +2,14 @@ package issueeditor
 
 import (
 	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
 	zone "github.com/lrstanley/bubblezone"
 
 	beads "github.com/zjrosen/perles/internal/beads/domain"
+	"github.com/zjrosen/perles/internal/mocks"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/exp/teatest"
@@ -186,6 +188,40 @@ func TestBuildUpdateOptions_LabelsEmptyVsPopulated(t *testing.T) {
 	require.Equal(t, []string{}, *opts.Labels)
 }
 
+func TestBuildUpdateOptions_DependenciesDiffed(t *testing.T) {
+	original := &beads.Issue{
+		ID:        "test-1",
+		TitleText: "T",
+		BlockedBy: []string{"test-2", "test-3"},
+		Blocks:    []string{"test-4"},
+	}
+	msg := SaveMsg{
+		IssueID:   "test-1",
+		Title:     "T",
+		BlockedBy: []string{"test-3", "test-5"},
+		Blocks:    []string{"test-6"},
+	}
+
+	opts := msg.BuildUpdateOptions(original)
+
+	require.Equal(t, []beads.DependencyChange{
+		{IssueID: "test-1", DependsOnID: "test-5"},
+		{IssueID: "test-1", DependsOnID: "test-2", Remove: true},
+		{IssueID: "test-6", DependsOnID: "test-1"},
+		{IssueID: "test-4", DependsOnID: "test-1", Remove: true},
+	}, opts.Dependencies)
+	require.Nil(t, opts.Title, "Title unchanged")
+}
+
+func TestBuildUpdateOptions_DependenciesUnchanged(t *testing.T) {
+	original := &beads.Issue{ID: "test-1", BlockedBy: []string{"test-2", "test-3"}}
+	msg := SaveMsg{IssueID: "test-1", BlockedBy: []string{"test-3", "test-2"}}
+
+	opts := msg.BuildUpdateOptions(original)
+
+	require.Empty(t, opts.Dependencies, "reordering alone is not a dependency change")
+}
+
 func TestBuildUpdateOptions_ValueTypesUseAddressOfCopy(t *testing.T) {
 	original := &beads.Issue{
 		TitleText: "T",
@@ -253,7 +289,7 @@ func testIssueWithNotes(id, title, description, notes string, labels []string, p
 func TestNew_InitializesFormModalWithCorrectFields(t *testing.T) {
 	labels := []string{"bug", "feature"}
 	issue := testIssue("test-123", labels, beads.PriorityHigh, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	require.Equal(t, "test-123", m.issue.ID, "expected issue ID to be set")
 
@@ -384,16 +420,21 @@ func TestLabelsListOptions_EmptyLabels(t *testing.T) {
 
 func TestSaveMsg_ContainsCorrectParsedValues(t *testing.T) {
 	issue := testIssue("test-123", []string{"existing"}, beads.PriorityHigh, beads.StatusInProgress)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Navigate to submit button and press Enter
-	// Tab through Title -> Priority -> Status -> Labels -> Add Label input -> Description -> Notes -> Submit button
+	// Tab through Title -> Priority -> Status -> Labels -> Add Label input -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit button
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Add Label input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Repeat
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Notes
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Blocked By
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Blocks
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Attachments
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Add Attachment input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // to Submit button
 
 	// Press Enter to save
@@ -413,7 +454,7 @@ func TestSaveMsg_ContainsCorrectParsedValues(t *testing.T) {
 
 func TestCancelMsg_ProducedOnEsc(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Press Esc
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
@@ -424,6 +465,63 @@ func TestCancelMsg_ProducedOnEsc(t *testing.T) {
 	require.True(t, ok, "expected CancelMsg, got %T", msg)
 }
 
+func TestCancelMsg_EscWithUnsavedChangesAsksFirst(t *testing.T) {
+	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
+	m := New(issue, Config{}).SetSize(120, 40)
+
+	// Edit the title, then Esc
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.Nil(t, cmd, "Esc with unsaved changes must not cancel")
+	require.Contains(t, m.Overlay(""), "Unsaved Changes")
+
+	// Keep editing is the default
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Nil(t, cmd)
+	require.NotContains(t, m.Overlay(""), "Unsaved Changes")
+}
+
+func TestSaveMsg_ChangedFields(t *testing.T) {
+	issue := testIssue("test-123", []string{}, beads.PriorityCritical, beads.StatusOpen)
+	m := New(issue, Config{})
+
+	// Save without changes
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	saveMsg, ok := cmd().(SaveMsg)
+	require.True(t, ok)
+	require.Nil(t, saveMsg.ChangedFields)
+
+	// Change the title and the priority
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	saveMsg, ok = cmd().(SaveMsg)
+	require.True(t, ok)
+	require.Equal(t, []string{"title", "priority"}, saveMsg.ChangedFields)
+}
+
+func TestSaveMsg_ChangedFields_CustomFieldKeys(t *testing.T) {
+	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
+	m := New(issue, Config{Fields: []beads.FieldDef{{Key: "team", Type: beads.FieldText}}})
+
+	// Title -> Priority -> Status -> Labels -> Add Label input -> team
+	for range 5 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("core")})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	saveMsg, ok := cmd().(SaveMsg)
+	require.True(t, ok)
+	require.Equal(t, []string{"team"}, saveMsg.ChangedFields)
+}
+
 func TestParsePriority(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -448,7 +546,7 @@ func TestParsePriority(t *testing.T) {
 
 func TestNew_EmptyLabels_ProducesValidConfig(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// View should still render without errors
 	view := m.View()
@@ -461,23 +559,23 @@ func TestNew_EmptyLabels_ProducesValidConfig(t *testing.T) {
 func TestNew_LabelsWithSpaces(t *testing.T) {
 	labels := []string{"hello world", "multi word label"}
 	issue := testIssue("test-123", labels, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	view := m.View()
 	require.Contains(t, view, "hello world", "expected label with spaces")
 	require.Contains(t, view, "multi word label", "expected multi-word label")
 }
 
-func TestInit_ReturnsNil(t *testing.T) {
+func TestInit_LoadsDependencyOptions(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	cmd := m.Init()
-	require.Nil(t, cmd, "expected Init to return nil")
+	require.NotNil(t, cmd, "expected Init to start loading the dependency pickers")
 }
 
 func TestSetSize_ReturnsNewModel(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	m = m.SetSize(120, 40)
 	// Verify it doesn't panic and returns a model
@@ -487,7 +585,7 @@ func TestSetSize_ReturnsNewModel(t *testing.T) {
 
 func TestOverlay_RendersOverBackground(t *testing.T) {
 	issue := testIssue("test-123", []string{"bug"}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(80, 24)
 
 	background := "This is the background content"
@@ -498,7 +596,7 @@ func TestOverlay_RendersOverBackground(t *testing.T) {
 
 func TestView_ContainsAllPriorityOptions(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityCritical, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	view := m.View()
 
 	// All priority options should be visible
@@ -511,7 +609,7 @@ func TestView_ContainsAllPriorityOptions(t *testing.T) {
 
 func TestView_ContainsAllStatusOptions(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	view := m.View()
 
 	// All status options should be visible
@@ -523,7 +621,7 @@ func TestView_ContainsAllStatusOptions(t *testing.T) {
 func TestSaveMsg_PriorityChange(t *testing.T) {
 	// Start with P0 (Critical)
 	issue := testIssue("test-123", []string{}, beads.PriorityCritical, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab to Priority field first (starts on Title)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -534,7 +632,12 @@ func TestSaveMsg_PriorityChange(t *testing.T) {
 	// Press Space to confirm selection
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
 
-	// Tab to Status -> Labels -> Add Label input -> Description -> Notes -> Submit
+	// Tab to Status -> Labels -> Add Label input -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -555,7 +658,7 @@ func TestSaveMsg_PriorityChange(t *testing.T) {
 func TestSaveMsg_StatusChange(t *testing.T) {
 	// Start with Open status
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab to Status field (Title -> Priority -> Status)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -566,7 +669,12 @@ func TestSaveMsg_StatusChange(t *testing.T) {
 	// Press Space to confirm selection
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
 
-	// Tab to Labels -> Add Label input -> Description -> Notes -> Submit
+	// Tab to Labels -> Add Label input -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -586,7 +694,7 @@ func TestSaveMsg_StatusChange(t *testing.T) {
 func TestSaveMsg_LabelsToggle(t *testing.T) {
 	labels := []string{"bug", "feature", "ui"}
 	issue := testIssue("test-123", labels, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab to Labels (Title -> Priority -> Status -> Labels)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
@@ -596,7 +704,12 @@ func TestSaveMsg_LabelsToggle(t *testing.T) {
 	// Toggle off "bug" (first label) with space
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
 
-	// Tab to Add Label input -> Description -> Notes -> Submit
+	// Tab to Add Label input -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -616,7 +729,7 @@ func TestSaveMsg_LabelsToggle(t *testing.T) {
 
 func TestSaveMsg_AddNewLabel(t *testing.T) {
 	issue := testIssue("test-123", []string{"existing"}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab to Add Label input (Title -> Priority -> Status -> Labels -> Add Label input)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
@@ -632,7 +745,12 @@ func TestSaveMsg_AddNewLabel(t *testing.T) {
 	// Press Enter to add the label
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
 
-	// Tab to Description -> Notes -> Submit
+	// Tab to Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
@@ -652,7 +770,7 @@ func TestSaveMsg_AddNewLabel(t *testing.T) {
 
 func TestNew_InitializesTitleField(t *testing.T) {
 	issue := testIssueWithDescription("test-123", "My Custom Title", "", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	view := m.View()
 	require.Contains(t, view, "Title", "expected Title field label")
@@ -661,7 +779,7 @@ func TestNew_InitializesTitleField(t *testing.T) {
 
 func TestNew_InitializesDescriptionField(t *testing.T) {
 	issue := testIssueWithDescription("test-123", "Title", "This is the description", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	view := m.View()
 	require.Contains(t, view, "Description", "expected Description field label")
@@ -670,16 +788,21 @@ func TestNew_InitializesDescriptionField(t *testing.T) {
 
 func TestSaveMsg_ContainsTitleValue(t *testing.T) {
 	issue := testIssueWithDescription("test-123", "Original Title", "", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab through all fields to Submit button
-	// Title -> Priority -> Status -> Labels -> Add Label -> Description -> Notes -> Submit
+	// Title -> Priority -> Status -> Labels -> Add Label -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Repeat
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Notes
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocked By
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocks
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Attachments
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Attachment input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Submit button
 
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -693,16 +816,21 @@ func TestSaveMsg_ContainsTitleValue(t *testing.T) {
 
 func TestSaveMsg_ContainsDescriptionValue(t *testing.T) {
 	issue := testIssueWithDescription("test-123", "Title", "Original Description", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab through all fields to Submit button
-	// Title -> Priority -> Status -> Labels -> Add Label -> Description -> Notes -> Submit
+	// Title -> Priority -> Status -> Labels -> Add Label -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Repeat
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Notes
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocked By
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocks
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Attachments
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Attachment input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Submit button
 
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -716,7 +844,7 @@ func TestSaveMsg_ContainsDescriptionValue(t *testing.T) {
 
 func TestView_FieldOrder(t *testing.T) {
 	issue := testIssueWithNotes("test-123", "My Title", "My Description", "My Notes", []string{"label1"}, beads.PriorityHigh, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(80, 50)
 
 	view := m.View()
@@ -748,7 +876,7 @@ func findIndex(s, substr string) int {
 
 func TestView_ContainsTitleField(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	view := m.View()
 
 	require.Contains(t, view, "Title", "expected Title field in view")
@@ -756,7 +884,7 @@ func TestView_ContainsTitleField(t *testing.T) {
 
 func TestView_ContainsDescriptionField(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	view := m.View()
 
 	require.Contains(t, view, "Description", "expected Description field in view")
@@ -767,7 +895,7 @@ func TestView_ContainsDescriptionField(t *testing.T) {
 
 func TestNew_InitializesNotesField(t *testing.T) {
 	issue := testIssueWithNotes("test-123", "Title", "Description", "My notes here", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	view := m.View()
 	require.Contains(t, view, "Notes", "expected Notes field label")
@@ -776,7 +904,7 @@ func TestNew_InitializesNotesField(t *testing.T) {
 
 func TestView_ContainsNotesField(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	view := m.View()
 
 	require.Contains(t, view, "Notes", "expected Notes field in view")
@@ -785,16 +913,21 @@ func TestView_ContainsNotesField(t *testing.T) {
 
 func TestSaveMsg_ContainsNotesValue(t *testing.T) {
 	issue := testIssueWithNotes("test-123", "Title", "Description", "Original Notes", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab through all fields to Submit button
-	// Title -> Priority -> Status -> Labels -> Add Label input -> Description -> Notes -> Submit
+	// Title -> Priority -> Status -> Labels -> Add Label input -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Repeat
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Notes
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocked By
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocks
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Attachments
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Attachment input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Submit button
 
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -808,15 +941,20 @@ func TestSaveMsg_ContainsNotesValue(t *testing.T) {
 
 func TestIssueeditor_SaveMsg_IncludesNotes(t *testing.T) {
 	issue := testIssueWithNotes("test-123", "Title", "Desc", "Test notes content", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	// Tab through all fields to Submit button
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Repeat
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Notes
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocked By
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Blocks
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Attachments
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Attachment input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Submit button
 
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -831,13 +969,14 @@ func TestIssueeditor_SaveMsg_IncludesNotes(t *testing.T) {
 func TestIssueeditor_NotesField_VimEnabled(t *testing.T) {
 	// VimEnabled starts in insert mode by default, so we can type directly
 	issue := testIssueWithNotes("test-123", "Title", "Desc", "", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
-	// Tab to Notes field (Title -> Priority -> Status -> Labels -> Add Label input -> Description -> Notes)
+	// Tab to Notes field (Title -> Priority -> Status -> Labels -> Add Label input -> Repeat -> Description -> Notes)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Priority
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Status
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Add Label input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Repeat
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}) // Notes
 
@@ -849,7 +988,11 @@ func TestIssueeditor_NotesField_VimEnabled(t *testing.T) {
 	// Press Esc to exit insert mode (verifies vim mode is active)
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
 
-	// Tab to Submit button
+	// Tab to Blocked By -> Blocks -> Attachments -> Add Attachment input -> Submit button
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 
 	// Save
@@ -864,7 +1007,7 @@ func TestIssueeditor_NotesField_VimEnabled(t *testing.T) {
 
 func TestIssueeditor_EmptyNotes_DisplaysPlaceholder(t *testing.T) {
 	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 
 	view := m.View()
 	require.Contains(t, view, "Issue notes...", "expected placeholder for empty notes field")
@@ -875,7 +1018,7 @@ func TestIssueeditor_EmptyNotes_DisplaysPlaceholder(t *testing.T) {
 
 func TestIssueEditor_View_Golden(t *testing.T) {
 	issue := testIssue("test-123", []string{"bug", "feature"}, beads.PriorityHigh, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(80, 50) // Large enough to avoid scrolling
 	view := stripZoneMarkers(m.View())
 
@@ -884,7 +1027,7 @@ func TestIssueEditor_View_Golden(t *testing.T) {
 
 func TestIssueEditor_View_EmptyLabels_Golden(t *testing.T) {
 	issue := testIssue("test-456", []string{}, beads.PriorityMedium, beads.StatusInProgress)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(80, 50) // Large enough to avoid scrolling
 	view := stripZoneMarkers(m.View())
 
@@ -894,7 +1037,7 @@ func TestIssueEditor_View_EmptyLabels_Golden(t *testing.T) {
 func TestIssueEditor_View_ManyLabels_Golden(t *testing.T) {
 	labels := []string{"bug", "feature", "ui", "backend", "api", "database"}
 	issue := testIssue("test-789", labels, beads.PriorityCritical, beads.StatusClosed)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(80, 50) // Large enough to avoid scrolling
 	view := stripZoneMarkers(m.View())
 
@@ -913,7 +1056,7 @@ func stripZoneMarkers(s string) string {
 func TestIssueEditor_TwoColumn_120x40_Golden(t *testing.T) {
 	// Two-column layout is enabled when width >= 100
 	issue := testIssueWithNotes("test-layout", "Multi-Column Issue", "This description appears in column 1", "Internal notes here", []string{"bug", "feature"}, beads.PriorityHigh, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(120, 40) // Wide enough for two columns
 	view := stripZoneMarkers(m.View())
 
@@ -923,7 +1066,7 @@ func TestIssueEditor_TwoColumn_120x40_Golden(t *testing.T) {
 func TestIssueEditor_SingleColumn_80x40_Golden(t *testing.T) {
 	// Single-column fallback when width < 100
 	issue := testIssueWithNotes("test-narrow", "Narrow Issue", "Description in single column", "Notes in single column", []string{"bug"}, beads.PriorityMedium, beads.StatusInProgress)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(80, 40) // Narrow: single column fallback
 	view := stripZoneMarkers(m.View())
 
@@ -933,9 +1076,9 @@ func TestIssueEditor_SingleColumn_80x40_Golden(t *testing.T) {
 // Tab order tests verify that Tab/Shift-Tab traverse fields in array order regardless of column
 
 func TestTabOrder_TraversesFieldsInArrayOrder(t *testing.T) {
-	// Tab order should be: title -> priority -> status -> labels -> add-label-input -> description -> notes -> submit
+	// Tab order should be: title -> priority -> status -> labels -> add-label-input -> repeat -> description -> notes -> blocked-by -> blocks -> attachments -> add-attachment-input -> submit
 	issue := testIssueWithNotes("test-tab", "Tab Order Test", "Description", "Notes", []string{"label1"}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(120, 40) // Two-column mode
 
 	// Starting position: title field is focused
@@ -948,10 +1091,20 @@ func TestTabOrder_TraversesFieldsInArrayOrder(t *testing.T) {
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	// Tab to add label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	// Tab to repeat
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	// Tab to description
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	// Tab to notes
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	// Tab to blocked by
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	// Tab to blocks
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	// Tab to attachments
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	// Tab to add attachment input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	// Tab to submit button
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 
@@ -966,17 +1119,22 @@ func TestTabOrder_TraversesFieldsInArrayOrder(t *testing.T) {
 func TestShiftTabOrder_ReversesCorrectly(t *testing.T) {
 	// Shift-Tab from submit should go back through fields in reverse order
 	issue := testIssueWithNotes("test-shift-tab", "Shift-Tab Test", "Description", "Notes", []string{"label1"}, beads.PriorityMedium, beads.StatusOpen)
-	m := New(issue)
+	m := New(issue, Config{})
 	m = m.SetSize(120, 40) // Two-column mode
 
 	// Navigate to submit button first
-	for i := 0; i < 7; i++ {
+	for i := 0; i < 12; i++ {
 		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	}
 
-	// Now Shift-Tab should go back: notes -> description -> add-label -> labels -> status -> priority -> title
+	// Now Shift-Tab should go back: add-attachment -> attachments -> blocks -> blocked-by -> notes -> description -> repeat -> add-label -> labels -> status -> priority -> title
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to add-attachment input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to attachments
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to blocks
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to blocked-by
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to notes
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to description
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to repeat
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to add-label input
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to labels
 	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}) // to status
@@ -989,7 +1147,7 @@ func TestShiftTabOrder_ReversesCorrectly(t *testing.T) {
 	}
 
 	// Tab forward to submit and save
-	for i := 0; i < 7; i++ {
+	for i := 0; i < 12; i++ {
 		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
 	}
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
@@ -1006,16 +1164,16 @@ func TestTabOrder_ConsistentBetweenSingleAndTwoColumn(t *testing.T) {
 	issue := testIssueWithNotes("test-consistent", "Consistent Tab", "Desc", "Notes", []string{"label1"}, beads.PriorityLow, beads.StatusClosed)
 
 	// Test narrow width (single column)
-	mNarrow := New(issue)
+	mNarrow := New(issue, Config{})
 	mNarrow = mNarrow.SetSize(80, 40)
 
 	// Test wide width (two column)
-	mWide := New(issue)
+	mWide := New(issue, Config{})
 	mWide = mWide.SetSize(120, 40)
 
 	// Both should take the same number of tabs to reach submit
-	// title -> priority -> status -> labels -> add-label-input -> description -> notes -> submit
-	tabsToSubmit := 7
+	// title -> priority -> status -> labels -> add-label-input -> repeat -> description -> notes -> blocked-by -> blocks -> attachments -> add-attachment-input -> submit
+	tabsToSubmit := 12
 
 	// Navigate narrow version to submit
 	for i := 0; i < tabsToSubmit; i++ {
@@ -1037,3 +1195,241 @@ func TestTabOrder_ConsistentBetweenSingleAndTwoColumn(t *testing.T) {
 	_, okWide := msgWide.(SaveMsg)
 	require.True(t, okWide, "wide: expected SaveMsg at submit position")
 }
+
+// --- Custom field tests ---
+
+var testFields = []beads.FieldDef{
+	{Key: "component", Label: "Component", Type: beads.FieldEnum, Options: []string{"api", "ui"}},
+	{Key: "estimate", Type: beads.FieldNumber},
+}
+
+func saveWithCtrlS(t *testing.T, m Model) (Model, tea.Msg) {
+	t.Helper()
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if cmd == nil {
+		return m, nil
+	}
+	return m, cmd()
+}
+
+func TestCustomFields_RenderedAndHiddenFromLabels(t *testing.T) {
+	issue := testIssue("test-123", []string{"bug", "component:api"}, beads.PriorityMedium, beads.StatusOpen)
+	view := New(issue, Config{Fields: testFields}).SetSize(120, 50).View()
+
+	require.Contains(t, view, "Component")
+	require.Contains(t, view, "estimate")
+	require.Contains(t, view, "(none)")
+	require.NotContains(t, view, "component:api", "field labels are edited through their field, not the labels list")
+}
+
+func TestCustomFields_UnchangedKeepsOriginalLabels(t *testing.T) {
+	original := testIssue("test-123", []string{"component:api", "bug"}, beads.PriorityMedium, beads.StatusOpen)
+
+	_, msg := saveWithCtrlS(t, New(original, Config{Fields: testFields}))
+	saveMsg, ok := msg.(SaveMsg)
+	require.True(t, ok, "expected SaveMsg, got %T", msg)
+	require.Equal(t, []string{"component:api", "bug"}, saveMsg.Labels)
+	require.Nil(t, saveMsg.BuildUpdateOptions(&original).Labels)
+}
+
+func TestCustomFields_SavesValidatedValues(t *testing.T) {
+	issue := testIssue("test-123", []string{"bug"}, beads.PriorityMedium, beads.StatusOpen)
+	m := New(issue, Config{Fields: testFields})
+
+	// Title -> Priority -> Status -> Labels -> Add Label input -> Component -> Estimate
+	for range 6 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("abc")})
+	m, msg := saveWithCtrlS(t, m)
+	require.Nil(t, msg, "invalid number blocks save")
+	require.Contains(t, m.View(), "must be a number")
+
+	for range 3 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+
+	_, msg = saveWithCtrlS(t, m)
+	saveMsg, ok := msg.(SaveMsg)
+	require.True(t, ok)
+	require.Equal(t, []string{"bug", "estimate:3"}, saveMsg.Labels)
+}
+
+func TestCustomFields_DuplicateValuesAreNotDropped(t *testing.T) {
+	original := testIssue("test-123", []string{"bug", "component:api", "component:ui"}, beads.PriorityMedium, beads.StatusOpen)
+	m := New(original, Config{Fields: testFields}).SetSize(120, 50)
+	require.Contains(t, m.View(), "component:ui", "the value the field can't show stays in the labels list")
+
+	// Saving would replace both values with the field's, so it is rejected
+	m, msg := saveWithCtrlS(t, m)
+	require.Nil(t, msg)
+	require.Contains(t, m.View(), `"component:ui" is a component value`)
+
+	// Removing the extra label saves the field's value
+	// Title -> Priority -> Status -> Labels
+	for range 3 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	_, msg = saveWithCtrlS(t, m)
+	saveMsg, ok := msg.(SaveMsg)
+	require.True(t, ok, "expected SaveMsg, got %T", msg)
+	require.Equal(t, []string{"bug", "component:api"}, saveMsg.Labels)
+}
+
+// --- Dependency tests ---
+
+// deliver runs cmd and feeds the messages it produces back into the model,
+// descending into batches. Commands returned by Update are not followed.
+func deliver(m Model, cmd tea.Cmd) Model {
+	if cmd == nil {
+		return m
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			m = deliver(m, c)
+		}
+		return m
+	}
+	m, _ = m.Update(msg)
+	return m
+}
+
+func TestDependencies_PickBlockerFromLoadedIssues(t *testing.T) {
+	executor := mocks.NewMockBQLExecutor(t)
+	executor.EXPECT().Execute(dependencyQuery).Return([]beads.Issue{
+		{ID: "test-123", TitleText: "Self"},
+		{ID: "test-2", TitleText: "Schema migration"},
+		{ID: "test-3", TitleText: "API design"},
+	}, nil).Twice()
+
+	issue := testIssue("test-123", []string{}, beads.PriorityMedium, beads.StatusOpen)
+	issue.Blocks = []string{"test-9"}
+	m := New(issue, Config{Executor: executor}).SetSize(120, 50)
+	m = deliver(m, m.Init())
+
+	view := m.View()
+	require.Contains(t, view, "test-9", "current dependency missing from the candidates is still shown")
+	require.NotContains(t, view, "Loading...")
+
+	// Title -> Priority -> Status -> Labels -> Add Label input -> Repeat -> Description -> Notes -> Blocked By
+	for range 8 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // expand
+	require.NotContains(t, m.View(), "Self", "the issue itself is not offered")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // toggle test-3
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})   // collapse
+
+	_, msg := saveWithCtrlS(t, m)
+	saveMsg, ok := msg.(SaveMsg)
+	require.True(t, ok, "expected SaveMsg, got %T", msg)
+	require.Equal(t, []string{"test-3"}, saveMsg.BlockedBy)
+	require.Equal(t, []string{"test-9"}, saveMsg.Blocks)
+	require.Equal(t, []string{"blocked_by"}, saveMsg.ChangedFields)
+	require.Equal(t, []beads.DependencyChange{{IssueID: "test-123", DependsOnID: "test-3"}},
+		saveMsg.BuildUpdateOptions(&issue).Dependencies)
+}
+
+// --- Attachment tests ---
+
+func TestAttachmentNormalizer(t *testing.T) {
+	workDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(workDir, "docs"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "docs", "my design.md"), nil, 0o600))
+	outside := filepath.Join(t.TempDir(), "shot.png")
+	require.NoError(t, os.WriteFile(outside, nil, 0o600))
+	normalize := attachmentNormalizer(workDir)
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+		err   string
+	}{
+		{"relative", "docs/my design.md", "docs/my design.md", ""},
+		{"cleaned", "./docs/../docs/my design.md", "docs/my design.md", ""},
+		{"absolute inside work dir", filepath.Join(workDir, "docs", "my design.md"), "docs/my design.md", ""},
+		{"escaped spaces", `docs/my\ design.md`, "docs/my design.md", ""},
+		{"quoted", "'docs/my design.md'", "docs/my design.md", ""},
+		{"file URL", "file://" + filepath.ToSlash(filepath.Join(workDir, "docs", "my%20design.md")), "docs/my design.md", ""},
+		{"outside work dir stays absolute", outside, outside, ""},
+		{"missing", "docs/nope.md", "", "file not found"},
+		{"directory", "docs", "", "is a directory"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalize(tt.value)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAttachments_AddAndRemove(t *testing.T) {
+	workDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "trace.log"), nil, 0o600))
+	issue := testIssue("test-123", []string{"bug", "attachment:old.png"}, beads.PriorityMedium, beads.StatusOpen)
+	m := New(issue, Config{WorkDir: workDir}).SetSize(120, 50)
+
+	view := m.View()
+	require.Contains(t, view, "Attachments")
+	require.Contains(t, view, "old.png")
+	require.NotContains(t, view, "attachment:old.png", "attachment labels are hidden from the labels list")
+
+	// Title -> Priority -> Status -> Labels -> Add Label input -> Repeat -> Description -> Notes -> Blocked By -> Blocks -> Attachments
+	for range 10 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace}) // remove old.png
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})   // Add Attachment input
+	for _, r := range filepath.Join(workDir, "trace.log") {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	_, msg := saveWithCtrlS(t, m)
+	saveMsg, ok := msg.(SaveMsg)
+	require.True(t, ok, "expected SaveMsg, got %T", msg)
+	require.Equal(t, []string{"trace.log"}, saveMsg.Attachments)
+	require.Equal(t, []string{"bug", "attachment:trace.log"}, saveMsg.Labels)
+	require.Equal(t, []string{"attachments"}, saveMsg.ChangedFields)
+}
+
+func TestRepeat_HiddenFromLabelsAndSaved(t *testing.T) {
+	issue := testIssue("test-123", []string{"deps", "recur:every-2w"}, beads.PriorityMedium, beads.StatusOpen)
+	m := New(issue, Config{}).SetSize(120, 60)
+
+	view := m.View()
+	require.Contains(t, view, "Repeat")
+	require.Contains(t, view, "Every 2w")
+	require.NotContains(t, view, "recur:every-2w", "the recurrence label is hidden from the labels list")
+
+	_, msg := saveWithCtrlS(t, m)
+	saveMsg, ok := msg.(SaveMsg)
+	require.True(t, ok)
+	require.Equal(t, issue.Labels, saveMsg.Labels, "an unchanged rule keeps the labels")
+
+	// Title -> Priority -> Status -> Labels -> Add Label input -> Repeat
+	for range 5 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	for range 3 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace}) // Weekly
+
+	_, msg = saveWithCtrlS(t, m)
+	saveMsg, ok = msg.(SaveMsg)
+	require.True(t, ok)
+	require.Equal(t, []string{"deps", "recur:weekly"}, saveMsg.Labels)
+	require.Contains(t, saveMsg.ChangedFields, "repeat")
+}
@@ -0,0 +1,143 @@
+// Package themeswitcher provides a modal for switching color themes with live preview.
+//
+// Moving the selection applies the highlighted theme immediately so the whole
+// UI (including the modal itself) previews it. Enter keeps the theme, Esc or q
+// restores the theme that was active when the switcher opened.
+package themeswitcher
+
+import (
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/ui/shared/picker"
+	"github.com/zjrosen/perles/internal/ui/styles"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SelectMsg is sent when the user confirms a theme. The theme is already applied.
+type SelectMsg struct {
+	Preset string
+}
+
+// CancelMsg is sent when the switcher is dismissed. The previous theme is already restored.
+type CancelMsg struct{}
+
+// Model holds the theme switcher state.
+type Model struct {
+	picker   picker.Model
+	original string // Theme active when the switcher was opened
+	visible  bool
+	width    int
+	height   int
+}
+
+// New creates a hidden theme switcher. Call Show to open it.
+func New() Model {
+	return Model{}
+}
+
+// Show opens the switcher listing all presets, with the active theme selected.
+func (m Model) Show() Model {
+	names := styles.PresetNames()
+	options := make([]picker.Option, len(names))
+	boxWidth := 34 // Fits the title
+	for i, name := range names {
+		options[i] = picker.Option{Label: name, Value: name}
+		boxWidth = max(boxWidth, len(name)+4)
+	}
+
+	m.original = styles.ActiveTheme()
+	m.picker = picker.NewWithConfig(picker.Config{
+		Title:    "Theme (enter apply, esc revert)",
+		Options:  options,
+		Selected: picker.FindIndexByValue(options, m.original),
+	}).SetBoxWidth(boxWidth).SetSize(m.width, m.height)
+	m.visible = true
+	return m
+}
+
+// Hide closes the switcher without changing the theme.
+func (m Model) Hide() Model {
+	m.visible = false
+	return m
+}
+
+// Visible returns whether the switcher is displayed.
+func (m Model) Visible() bool {
+	return m.visible
+}
+
+// SetSize sets the viewport dimensions for overlay rendering.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.picker = m.picker.SetSize(width, height)
+	return m
+}
+
+// Selected returns the name of the highlighted theme.
+func (m Model) Selected() string {
+	return m.picker.Selected().Value
+}
+
+// Update handles navigation, previewing the highlighted theme as the selection moves.
+// The scroll wheel moves the selection and clicking a theme applies it like Enter.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.visible {
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Common.Enter):
+			return m.confirm()
+		case key.Matches(msg, keys.Common.Escape), key.Matches(msg, keys.Common.Quit):
+			m.visible = false
+			m.preview(m.original)
+			return m, func() tea.Msg { return CancelMsg{} }
+		}
+		m, _ = m.updatePicker(msg)
+	case tea.MouseMsg:
+		var cmd tea.Cmd
+		m, cmd = m.updatePicker(msg)
+		if cmd != nil {
+			// The picker only returns a command for a click on an option
+			return m.confirm()
+		}
+	}
+	return m, nil
+}
+
+// updatePicker forwards a message to the picker and previews the new selection.
+func (m Model) updatePicker(msg tea.Msg) (Model, tea.Cmd) {
+	before := m.Selected()
+	var cmd tea.Cmd
+	m.picker, cmd = m.picker.Update(msg)
+	if selected := m.Selected(); selected != before {
+		m.preview(selected)
+	}
+	return m, cmd
+}
+
+// confirm closes the switcher, keeping the highlighted theme.
+func (m Model) confirm() (Model, tea.Cmd) {
+	m.visible = false
+	preset := m.Selected()
+	return m, func() tea.Msg { return SelectMsg{Preset: preset} }
+}
+
+// preview applies a theme, keeping the user's individual color overrides.
+// Names come from styles.Presets, so the only failure is a theme that was
+// removed while the switcher was open; the current theme stays in that case.
+func (m Model) preview(name string) {
+	_ = styles.SetPreset(name)
+}
+
+// Overlay renders the switcher on top of a background view.
+func (m Model) Overlay(background string) string {
+	if !m.visible {
+		return background
+	}
+	return m.picker.Overlay(background)
+}
@@ -0,0 +1,186 @@
+package themeswitcher
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/ui/shared/picker"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+func TestMain(m *testing.M) {
+	zone.NewGlobal()
+	os.Exit(m.Run())
+}
+
+func keyRunes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// startTheme applies a preset for the test and restores the default theme afterwards.
+func startTheme(t *testing.T, preset string) {
+	t.Helper()
+	require.NoError(t, styles.ApplyTheme(styles.ThemeConfig{Preset: preset}))
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+}
+
+// indexOf returns the position of a preset in the switcher list.
+func indexOf(t *testing.T, name string) int {
+	t.Helper()
+	for i, n := range styles.PresetNames() {
+		if n == name {
+			return i
+		}
+	}
+	t.Fatalf("preset %q not found", name)
+	return -1
+}
+
+func TestShow_SelectsActiveTheme(t *testing.T) {
+	startTheme(t, "nord")
+
+	m := New()
+	require.False(t, m.Visible())
+
+	m = m.Show()
+	require.True(t, m.Visible())
+	require.Equal(t, "nord", m.Selected())
+}
+
+func TestUpdate_PreviewsOnMove(t *testing.T) {
+	startTheme(t, "default")
+	m := New().Show()
+	require.Equal(t, "default", m.Selected())
+
+	m, cmd := m.Update(keyRunes("j"))
+	require.Nil(t, cmd)
+
+	next := styles.PresetNames()[1]
+	require.Equal(t, next, m.Selected())
+	require.Equal(t, next, styles.ActiveTheme(), "moving the selection should preview the theme")
+	require.Equal(t, styles.Presets[next].Colors[styles.TokenTextPrimary], styles.TextPrimaryColor.Dark)
+}
+
+func TestUpdate_EnterKeepsTheme(t *testing.T) {
+	startTheme(t, "default")
+	m := New().Show()
+
+	for range indexOf(t, "light") {
+		m, _ = m.Update(keyRunes("j"))
+	}
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.False(t, m.Visible())
+	require.NotNil(t, cmd)
+	require.Equal(t, SelectMsg{Preset: "light"}, cmd())
+	require.Equal(t, "light", styles.ActiveTheme())
+}
+
+func TestUpdate_EscRestoresTheme(t *testing.T) {
+	for _, k := range []tea.KeyMsg{{Type: tea.KeyEsc}, keyRunes("q")} {
+		t.Run(k.String(), func(t *testing.T) {
+			startTheme(t, "dracula")
+			m := New().Show()
+
+			m, _ = m.Update(keyRunes("k"))
+			require.NotEqual(t, "dracula", styles.ActiveTheme())
+
+			m, cmd := m.Update(k)
+			require.False(t, m.Visible())
+			require.Equal(t, CancelMsg{}, cmd())
+			require.Equal(t, "dracula", styles.ActiveTheme())
+			require.Equal(t, styles.DraculaPreset.Colors[styles.TokenTextPrimary], styles.TextPrimaryColor.Dark)
+		})
+	}
+}
+
+func TestUpdate_PreviewKeepsColorOverrides(t *testing.T) {
+	require.NoError(t, styles.ApplyTheme(styles.ThemeConfig{
+		Colors: map[string]string{"status.error": "#123456"},
+	}))
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+
+	m := New().Show()
+	m, _ = m.Update(keyRunes("j"))
+
+	require.NotEqual(t, "default", styles.ActiveTheme())
+	require.Equal(t, "#123456", styles.StatusErrorColor.Dark)
+}
+
+func TestUpdate_IgnoredWhenHidden(t *testing.T) {
+	startTheme(t, "default")
+
+	m, cmd := New().Update(keyRunes("j"))
+	require.Nil(t, cmd)
+	require.False(t, m.Visible())
+	require.Equal(t, "default", styles.ActiveTheme())
+}
+
+func TestUpdate_MouseWheelPreviews(t *testing.T) {
+	startTheme(t, "default")
+	m := New().Show()
+
+	m, cmd := m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	require.Nil(t, cmd)
+	require.True(t, m.Visible())
+	require.Equal(t, styles.PresetNames()[1], styles.ActiveTheme())
+}
+
+func TestUpdate_MouseClickAppliesTheme(t *testing.T) {
+	startTheme(t, "default")
+	m := New().SetSize(80, 40).Show()
+
+	idx := indexOf(t, "light")
+	var z *zone.ZoneInfo
+	for retries := 0; retries < 10; retries++ {
+		_ = m.Overlay("")
+		time.Sleep(time.Millisecond)
+		z = zone.Get(picker.OptionZoneID(idx))
+		if z != nil && !z.IsZero() {
+			break
+		}
+	}
+	require.NotNil(t, z)
+	require.False(t, z.IsZero())
+
+	m, cmd := m.Update(tea.MouseMsg{
+		X:      z.StartX + 1,
+		Y:      z.StartY,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionRelease,
+	})
+
+	require.False(t, m.Visible())
+	require.NotNil(t, cmd)
+	require.Equal(t, SelectMsg{Preset: "light"}, cmd())
+	require.Equal(t, "light", styles.ActiveTheme())
+}
+
+func TestShow_ListsUserThemes(t *testing.T) {
+	startTheme(t, "default")
+	require.NoError(t, styles.RegisterTheme(styles.UserTheme{Name: "zz-mine", Base: "nord"}))
+	t.Cleanup(func() { delete(styles.Presets, "zz-mine") })
+
+	m := New().Show()
+	for range indexOf(t, "zz-mine") {
+		m, _ = m.Update(keyRunes("j"))
+	}
+	require.Equal(t, "zz-mine", m.Selected())
+	require.Equal(t, styles.NordPreset.Colors[styles.TokenTextPrimary], styles.TextPrimaryColor.Dark)
+}
+
+func TestOverlay(t *testing.T) {
+	m := New().SetSize(80, 24)
+	require.Equal(t, "background", m.Overlay("background"), "hidden switcher leaves background untouched")
+
+	m = m.Show()
+	view := m.Overlay("")
+	require.Contains(t, view, "Theme")
+	require.Contains(t, view, "light")
+	require.Contains(t, view, "high-contrast")
+}
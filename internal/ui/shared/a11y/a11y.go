@@ -0,0 +1,95 @@
+// Package a11y implements the screen-reader-friendly accessibility mode.
+//
+// In accessibility mode frames are linearized to plain text (box-drawing
+// characters become spaces, so borders are not read aloud) and focus changes
+// and important events are announced as single plain-text lines. The latest
+// announcement is shown on the last screen line and, optionally, appended to
+// a file that a screen reader can follow from another terminal.
+package a11y
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Describer is implemented by views that can describe what has focus in
+// plain text, e.g. "Board Default, column Ready (3), bd-12 Fix login, open".
+type Describer interface {
+	FocusDescription() string
+}
+
+// Announcer records announcements. Consecutive duplicates are dropped.
+// Safe for concurrent use.
+type Announcer struct {
+	mu     sync.Mutex
+	w      io.Writer // Optional sink, one announcement per line (nil = none)
+	latest string
+}
+
+// NewAnnouncer creates an announcer that also writes each announcement to w.
+// w may be nil.
+func NewAnnouncer(w io.Writer) *Announcer {
+	return &Announcer{w: w}
+}
+
+// Announce records a line. Styling and line breaks are stripped.
+func (a *Announcer) Announce(line string) {
+	line = strings.Join(strings.Fields(ansi.Strip(line)), " ")
+	if line == "" {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if line == a.latest {
+		return
+	}
+	a.latest = line
+	if a.w != nil {
+		_, _ = fmt.Fprintln(a.w, line)
+	}
+}
+
+// Latest returns the most recent announcement, or "" if there was none.
+func (a *Announcer) Latest() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.latest
+}
+
+// Linearize replaces box-drawing characters with spaces so borders and
+// separators are not read aloud. Layout and styling are preserved.
+func Linearize(view string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= boxDrawingFirst && r <= boxDrawingLast {
+			return ' '
+		}
+		return r
+	}, view)
+}
+
+// The Unicode Box Drawing block (─ │ ╭ ┤ ...).
+const (
+	boxDrawingFirst = '\u2500'
+	boxDrawingLast  = '\u257F'
+)
+
+// WithAnnouncement replaces the last line of view with the announcement,
+// padded to width. Returns view unchanged if there is no announcement.
+func WithAnnouncement(view, announcement string, width int) string {
+	if announcement == "" {
+		return view
+	}
+	line := "» " + announcement
+	if width > 0 {
+		line = ansi.Truncate(line, width, "…")
+		line += strings.Repeat(" ", max(width-ansi.StringWidth(line), 0))
+	}
+	lines := strings.Split(view, "\n")
+	lines[len(lines)-1] = line
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,44 @@
+package a11y
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnouncer_DropsConsecutiveDuplicates(t *testing.T) {
+	var buf bytes.Buffer
+	a := NewAnnouncer(&buf)
+
+	a.Announce("Board Default")
+	a.Announce("Board Default")
+	a.Announce("Search")
+	a.Announce("Board Default")
+
+	require.Equal(t, "Board Default\nSearch\nBoard Default\n", buf.String())
+	require.Equal(t, "Board Default", a.Latest())
+}
+
+func TestAnnouncer_StripsStylingAndLineBreaks(t *testing.T) {
+	a := NewAnnouncer(nil)
+
+	a.Announce("\x1b[1mDone:\x1b[0m  saved\n bd-1")
+	require.Equal(t, "Done: saved bd-1", a.Latest())
+
+	a.Announce("  \n")
+	require.Equal(t, "Done: saved bd-1", a.Latest(), "blank announcements are ignored")
+}
+
+func TestLinearize_RemovesBoxDrawing(t *testing.T) {
+	view := "╭─ Ready ─╮\n│ bd-1    │\n╰─────────╯"
+	require.Equal(t, "   Ready   \n  bd-1     \n           ", Linearize(view))
+}
+
+func TestWithAnnouncement(t *testing.T) {
+	view := "line one\nline two\nstatus"
+
+	require.Equal(t, view, WithAnnouncement(view, "", 20))
+	require.Equal(t, "line one\nline two\n» Search    ", WithAnnouncement(view, "Search", 12))
+	require.Equal(t, "line one\nline two\n» Board, c…", WithAnnouncement(view, "Board, column Ready", 11))
+}
@@ -16,39 +16,40 @@ import (
 
 // Status indicator styles (shared across all agent types)
 var (
-	statusReadyStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#43BF6D", Dark: "#73F59F"}) // Green - ready/available
-
-	statusWorkingStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#54A0FF", Dark: "#54A0FF"}) // Blue - actively working
-
-	statusPausedStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#FECA57", Dark: "#FECA57"}). // Yellow/amber - paused
-				Bold(true)
-
-	statusStoppedStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#F0A500", Dark: "#FFD93D"}) // Yellow/amber - stopped (caution)
-
-	statusRetiredStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.AdaptiveColor{Light: "#FF6B6B", Dark: "#FF8787"}) // Red - retired/failed
-
-	statusPendingStyle = lipgloss.NewStyle().
-				Foreground(styles.TextSecondaryColor) // Muted - pending/starting
+	statusReadyStyle   lipgloss.Style // Green - ready/available
+	statusWorkingStyle lipgloss.Style // Blue - actively working
+	statusPausedStyle  lipgloss.Style // Yellow/amber - paused
+	statusStoppedStyle lipgloss.Style // Yellow/amber - stopped (caution)
+	statusRetiredStyle lipgloss.Style // Red - retired/failed
+	statusPendingStyle lipgloss.Style // Muted - pending/starting
 
 	// QueueCountStyle is the style for queue count display.
 	// Uses orange color to draw attention to pending queued messages.
-	queueCountStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.AdaptiveColor{Light: "#FFA500", Dark: "#FFB347"})
+	queueCountStyle lipgloss.Style
 )
 
 // Border colors for different process statuses (exported for callers that need direct access)
 var (
 	// StatusWorkingBorderColor is blue for actively working processes.
-	StatusWorkingBorderColor = lipgloss.AdaptiveColor{Light: "#54A0FF", Dark: "#54A0FF"}
+	StatusWorkingBorderColor lipgloss.AdaptiveColor
 	// StatusStoppedBorderColor is red for stopped/retired/failed processes.
-	StatusStoppedBorderColor = lipgloss.AdaptiveColor{Light: "#FF6B6B", Dark: "#FF8787"}
+	StatusStoppedBorderColor lipgloss.AdaptiveColor
 )
 
+// rebuildStatusStyles recreates the status indicator styles from the active theme.
+func rebuildStatusStyles() {
+	statusReadyStyle = lipgloss.NewStyle().Foreground(styles.StatusSuccessColor)
+	statusWorkingStyle = lipgloss.NewStyle().Foreground(styles.BorderHighlightFocusColor)
+	statusPausedStyle = lipgloss.NewStyle().Foreground(styles.StatusWarningColor).Bold(true)
+	statusStoppedStyle = lipgloss.NewStyle().Foreground(styles.StatusWarningColor)
+	statusRetiredStyle = lipgloss.NewStyle().Foreground(styles.StatusErrorColor)
+	statusPendingStyle = lipgloss.NewStyle().Foreground(styles.TextSecondaryColor)
+	queueCountStyle = lipgloss.NewStyle().Foreground(styles.PriorityHighColor)
+
+	StatusWorkingBorderColor = styles.BorderHighlightFocusColor
+	StatusStoppedBorderColor = styles.StatusErrorColor
+}
+
 // StatusIndicator returns the indicator character and style for a process status.
 // Used to show visual status in pane titles (●/○/⏸/⚠/✗).
 //
@@ -7,47 +7,80 @@ import (
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/zjrosen/perles/internal/ui/styles"
 )
 
 // Agent colors - consistent colors for each agent type across all panes.
+// These are package-level vars that get rebuilt when the theme changes.
 var (
-	CoordinatorColor = lipgloss.AdaptiveColor{Light: "#179299", Dark: "#179299"}
-	WorkerColor      = lipgloss.AdaptiveColor{Light: "#43BF6D", Dark: "#43BF6D"}
-	ObserverColor    = lipgloss.AdaptiveColor{Light: "#A066D3", Dark: "#A066D3"} // Purple for observer
-	UserColor        = lipgloss.AdaptiveColor{Light: "#FB923C", Dark: "#FB923C"}
-	SystemColor      = lipgloss.AdaptiveColor{Light: "#FF6B6B", Dark: "#FF8787"}
+	CoordinatorColor lipgloss.AdaptiveColor
+	WorkerColor      lipgloss.AdaptiveColor
+	ObserverColor    lipgloss.AdaptiveColor
+	UserColor        lipgloss.AdaptiveColor
+	SystemColor      lipgloss.AdaptiveColor
 	// AssistantColor is an alias for CoordinatorColor, used by the chat panel.
-	AssistantColor = CoordinatorColor
+	AssistantColor lipgloss.AdaptiveColor
 )
 
 // Channel colors - consistent colors for fabric channel names.
 var (
-	ChannelGeneralColor  = lipgloss.AdaptiveColor{Light: "#43BF6D", Dark: "#43BF6D"} // Green
-	ChannelTasksColor    = lipgloss.AdaptiveColor{Light: "#E09B24", Dark: "#E09B24"} // Orange
-	ChannelPlanningColor = lipgloss.AdaptiveColor{Light: "#3B82F6", Dark: "#60A5FA"} // Blue
-	ChannelSystemColor   = lipgloss.AdaptiveColor{Light: "#FF6B6B", Dark: "#FF8787"} // Red
-	ChannelObserverColor = ObserverColor                                             // Purple (matches observer agent)
+	ChannelGeneralColor  lipgloss.AdaptiveColor // Green (matches worker agent)
+	ChannelTasksColor    lipgloss.AdaptiveColor // Orange
+	ChannelPlanningColor lipgloss.AdaptiveColor // Blue
+	ChannelSystemColor   lipgloss.AdaptiveColor // Red (matches system agent)
+	ChannelObserverColor lipgloss.AdaptiveColor // Purple (matches observer agent)
 )
 
 // Chat rendering styles.
 var (
 	// RoleStyle applies bold formatting to role labels.
-	RoleStyle = lipgloss.NewStyle().Bold(true)
+	RoleStyle lipgloss.Style
 
 	// UserMessageStyle is for user message content.
-	UserMessageStyle = lipgloss.NewStyle().Foreground(UserColor)
+	UserMessageStyle lipgloss.Style
 
 	// ToolCallStyle is for tool call display (muted).
-	ToolCallStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.AdaptiveColor{Light: "#999999", Dark: "#666666"})
+	ToolCallStyle lipgloss.Style
 
 	// TimestampColor is the muted color for HH:MM timestamps.
-	TimestampColor = lipgloss.AdaptiveColor{Light: "#666666", Dark: "#696969"}
+	TimestampColor lipgloss.AdaptiveColor
 
 	// TimestampStyle is the style for HH:MM timestamps in chat headers.
-	TimestampStyle = lipgloss.NewStyle().Foreground(TimestampColor)
+	TimestampStyle lipgloss.Style
 )
 
+func init() {
+	RebuildStyles()
+	// Register with styles package so chat colors follow theme changes
+	styles.RegisterStyleRebuilder(RebuildStyles)
+}
+
+// RebuildStyles recreates all chat colors and styles from the active theme.
+// Called by styles.ApplyTheme after colors are updated.
+func RebuildStyles() {
+	CoordinatorColor = styles.AgentCoordinatorColor
+	WorkerColor = styles.AgentWorkerColor
+	ObserverColor = styles.AgentObserverColor
+	UserColor = styles.AgentUserColor
+	SystemColor = styles.AgentSystemColor
+	AssistantColor = CoordinatorColor
+
+	ChannelGeneralColor = WorkerColor
+	ChannelTasksColor = styles.PriorityHighColor
+	ChannelPlanningColor = styles.BorderHighlightFocusColor
+	ChannelSystemColor = SystemColor
+	ChannelObserverColor = ObserverColor
+
+	RoleStyle = lipgloss.NewStyle().Bold(true)
+	UserMessageStyle = lipgloss.NewStyle().Foreground(UserColor)
+	ToolCallStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	TimestampColor = styles.TextMutedColor
+	TimestampStyle = lipgloss.NewStyle().Foreground(TimestampColor)
+
+	rebuildStatusStyles()
+}
+
 // Message represents a single message in chat history.
 type Message struct {
 	Role       string    `json:"role"`
@@ -190,6 +223,6 @@ func ChannelColor(slug string) lipgloss.AdaptiveColor {
 	case "observer":
 		return ChannelObserverColor
 	default:
-		return lipgloss.AdaptiveColor{Light: "#888888", Dark: "#777777"} // Muted fallback
+		return styles.TextPlaceholderColor // Muted fallback
 	}
 }
@@ -2,6 +2,7 @@
 package colorpicker
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -13,8 +14,22 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
 )
 
+// Zone IDs for mouse click detection.
+const (
+	zoneCustom       = "colorpicker-custom"
+	zoneCustomInput  = "colorpicker-custom-input"
+	zoneCustomSave   = "colorpicker-custom-save"
+	zoneCustomCancel = "colorpicker-custom-cancel"
+)
+
+// swatchZoneID returns the bubblezone ID for the preset at the given column and row.
+func swatchZoneID(col, row int) string {
+	return fmt.Sprintf("colorpicker-swatch-%d-%d", col, row)
+}
+
 // PresetColor represents a named color option.
 type PresetColor struct {
 	Name string
@@ -77,6 +92,34 @@ var GrayscalePresets = []PresetColor{
 	{Name: "Black", Hex: "#000000"},
 }
 
+// themePresetTokens maps DefaultPresets names to theme tokens so the first
+// column follows the active theme. Colors without a token keep their fixed hex.
+var themePresetTokens = map[string]styles.ColorToken{
+	"Red":    styles.TokenStatusError,
+	"Green":  styles.TokenStatusSuccess,
+	"Blue":   styles.TokenBorderHighlight,
+	"Purple": styles.TokenTypeEpic,
+	"Yellow": styles.TokenStatusWarning,
+	"Orange": styles.TokenPriorityHigh,
+	"Gray":   styles.TokenIssueClosed,
+	"Pink":   styles.TokenBQLKeyword,
+}
+
+// ThemePresets returns DefaultPresets with colors taken from the active theme.
+// With the default theme the result equals DefaultPresets.
+func ThemePresets() []PresetColor {
+	presets := make([]PresetColor, len(DefaultPresets))
+	for i, preset := range DefaultPresets {
+		if token, ok := themePresetTokens[preset.Name]; ok {
+			if hex := styles.Hex(token); hex != "" {
+				preset.Hex = hex
+			}
+		}
+		presets[i] = preset
+	}
+	return presets
+}
+
 // Custom mode focus fields.
 const (
 	customFocusInput = iota
@@ -107,7 +150,7 @@ type SelectMsg struct {
 // CancelMsg is sent when the picker is cancelled.
 type CancelMsg struct{}
 
-// New creates a new color picker with default presets.
+// New creates a new color picker. The first column holds the active theme's palette.
 func New() Model {
 	ti := textinput.New()
 	ti.Placeholder = "#RRGGBB"
@@ -117,7 +160,7 @@ func New() Model {
 
 	return Model{
 		columns: [][]PresetColor{
-			DefaultPresets,
+			ThemePresets(),
 			Column2Presets,
 			Column3Presets,
 			GrayscalePresets,
@@ -195,6 +238,8 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 func (m Model) updateNormalMode(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		return m.handleNormalMouse(msg)
 	case tea.KeyMsg:
 		currentColumn := m.columns[m.column]
 		switch {
@@ -242,6 +287,8 @@ func (m Model) updateNormalMode(msg tea.Msg) (Model, tea.Cmd) {
 
 func (m Model) updateCustomMode(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		return m.handleCustomMouse(msg)
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, keys.Common.Enter):
@@ -354,6 +401,75 @@ func (m Model) updateCustomMode(msg tea.Msg) (Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleNormalMouse selects a swatch on left-click (same as Enter) and moves
+// the selection within the current column with the scroll wheel.
+func (m Model) handleNormalMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		if m.selected < len(m.columns[m.column])-1 {
+			m.selected++
+		}
+	case tea.MouseButtonWheelUp:
+		if m.selected > 0 {
+			m.selected--
+		}
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionRelease {
+			return m, nil
+		}
+		for col, presets := range m.columns {
+			for row, preset := range presets {
+				if z := zone.Get(swatchZoneID(col, row)); z != nil && z.InBounds(msg) {
+					m.column = col
+					m.selected = row
+					return m, selectCmd(preset.Hex)
+				}
+			}
+		}
+		if z := zone.Get(zoneCustom); m.customEnabled && z != nil && z.InBounds(msg) {
+			m.inCustomMode = true
+			m.customInput.SetValue("")
+			m.customInput.Focus()
+			return m, textinput.Blink
+		}
+	}
+	return m, nil
+}
+
+// handleCustomMouse handles left-clicks on the hex input and the Save/Cancel buttons.
+func (m Model) handleCustomMouse(msg tea.MouseMsg) (Model, tea.Cmd) {
+	if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionRelease {
+		return m, nil
+	}
+
+	if z := zone.Get(zoneCustomSave); z != nil && z.InBounds(msg) {
+		m.customFocus = customFocusSave
+		m.customInput.Blur()
+		hex := m.customInput.Value()
+		if isValidHex(hex) {
+			return m, selectCmd(hex)
+		}
+		m.showCustomError = true
+		return m, nil
+	}
+
+	if z := zone.Get(zoneCustomCancel); z != nil && z.InBounds(msg) {
+		m.inCustomMode = false
+		m.customFocus = customFocusInput
+		m.showCustomError = false
+		m.customInput.Blur()
+		return m, nil
+	}
+
+	if z := zone.Get(zoneCustomInput); z != nil && z.InBounds(msg) {
+		m.customFocus = customFocusInput
+		m.customInput.Focus()
+		return m, textinput.Blink
+	}
+
+	return m, nil
+}
+
 // View renders the picker box.
 func (m Model) View() string {
 	titleStyle := lipgloss.NewStyle().
@@ -391,7 +507,7 @@ func (m Model) View() string {
 			Focused:            m.customFocus == customFocusInput,
 			FocusedBorderColor: styles.BorderHighlightFocusColor,
 		})
-		content.WriteString(lipgloss.NewStyle().PaddingLeft(1).Render(inputSection))
+		content.WriteString(lipgloss.NewStyle().PaddingLeft(1).Render(zone.Mark(zoneCustomInput, inputSection)))
 		content.WriteString("\n")
 
 		// Error message (only shown after clicking Save with invalid hex)
@@ -410,8 +526,8 @@ func (m Model) View() string {
 		if m.customFocus == customFocusCancel {
 			cancelStyle = styles.PrimaryButtonFocusedStyle
 		}
-		saveBtn := saveStyle.Render("Save")
-		cancelBtn := cancelStyle.Render("Cancel")
+		saveBtn := zone.Mark(zoneCustomSave, saveStyle.Render("Save"))
+		cancelBtn := zone.Mark(zoneCustomCancel, cancelStyle.Render("Cancel"))
 		content.WriteString(lipgloss.NewStyle().PaddingLeft(1).Render(saveBtn + "  " + cancelBtn))
 	} else {
 		content.WriteString(titleStyle.Render("Select Color"))
@@ -448,7 +564,7 @@ func (m Model) View() string {
 						// Not selected: space prefix
 						line = " " + swatch + " " + preset.Name
 					}
-					colContent.WriteString(lipgloss.NewStyle().Width(columnWidth).Render(line))
+					colContent.WriteString(zone.Mark(swatchZoneID(colIdx, rowIdx), lipgloss.NewStyle().Width(columnWidth).Render(line)))
 				} else {
 					// Empty row to maintain alignment
 					colContent.WriteString(strings.Repeat(" ", columnWidth))
@@ -463,7 +579,8 @@ func (m Model) View() string {
 
 		if m.customEnabled {
 			content.WriteString("\n")
-			content.WriteString(lipgloss.NewStyle().PaddingLeft(1).Foreground(styles.TextPrimaryColor).Render("'c' custom  h/l column"))
+			custom := zone.Mark(zoneCustom, "'c' custom")
+			content.WriteString(lipgloss.NewStyle().PaddingLeft(1).Foreground(styles.TextPrimaryColor).Render(custom + "  h/l column"))
 		}
 	}
 
@@ -479,19 +596,22 @@ func (m Model) View() string {
 func (m Model) Overlay(background string) string {
 	pickerBox := m.View()
 
+	var result string
 	if background == "" {
-		return lipgloss.Place(
+		result = lipgloss.Place(
 			m.viewportWidth, m.viewportHeight,
 			lipgloss.Center, lipgloss.Center,
 			pickerBox,
 		)
+	} else {
+		result = overlay.Place(overlay.Config{
+			Width:    m.viewportWidth,
+			Height:   m.viewportHeight,
+			Position: overlay.Center,
+		}, pickerBox, background)
 	}
-
-	return overlay.Place(overlay.Config{
-		Width:    m.viewportWidth,
-		Height:   m.viewportHeight,
-		Position: overlay.Center,
-	}, pickerBox, background)
+	// Scan for zone markers to enable mouse click detection
+	return zone.Scan(result)
 }
 
 // selectCmd returns a command that sends a SelectMsg.
@@ -1,13 +1,23 @@
 package colorpicker
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/exp/teatest"
+	zone "github.com/lrstanley/bubblezone"
 	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/ui/styles"
 )
 
+func TestMain(m *testing.M) {
+	zone.NewGlobal()
+	os.Exit(m.Run())
+}
+
 func TestNew(t *testing.T) {
 	m := New()
 
@@ -19,6 +29,21 @@ func TestNew(t *testing.T) {
 	require.False(t, m.inCustomMode)
 }
 
+func TestThemePresets(t *testing.T) {
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+
+	require.NoError(t, styles.ApplyTheme(styles.ThemeConfig{}))
+	require.Equal(t, DefaultPresets, ThemePresets(), "default theme matches the curated palette")
+
+	require.NoError(t, styles.ApplyTheme(styles.ThemeConfig{Preset: "dracula"}))
+	presets := ThemePresets()
+	require.Len(t, presets, len(DefaultPresets))
+	require.Equal(t, "Red", presets[0].Name)
+	require.Equal(t, styles.DraculaPreset.Colors[styles.TokenStatusError], presets[0].Hex)
+	require.Equal(t, "#89DCEB", presets[6].Hex, "colors without a token keep their hex")
+	require.Equal(t, presets, New().columns[0])
+}
+
 func TestDefaultPresets(t *testing.T) {
 	expected := []struct {
 		name string
@@ -471,3 +496,83 @@ func TestColorPicker_View_CustomMode_Golden(t *testing.T) {
 	view := m.View()
 	teatest.RequireEqualOutput(t, []byte(view))
 }
+
+// waitForZone renders the overlay until the zone is registered.
+// Zone registration in bubblezone is asynchronous via a channel worker.
+func waitForZone(t *testing.T, m Model, zoneID string) *zone.ZoneInfo {
+	t.Helper()
+	var z *zone.ZoneInfo
+	for retries := 0; retries < 10; retries++ {
+		_ = m.Overlay("")
+		time.Sleep(time.Millisecond)
+		z = zone.Get(zoneID)
+		if z != nil && !z.IsZero() {
+			break
+		}
+	}
+	require.NotNil(t, z, "zone %s should be registered after Overlay()", zoneID)
+	require.False(t, z.IsZero(), "zone %s should not be zero", zoneID)
+	return z
+}
+
+func click(z *zone.ZoneInfo) tea.MouseMsg {
+	return tea.MouseMsg{
+		X:      z.StartX + 1,
+		Y:      z.StartY,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionRelease,
+	}
+}
+
+func TestMouseClickSelectsSwatch(t *testing.T) {
+	m := New().SetSize(100, 30)
+
+	z := waitForZone(t, m, swatchZoneID(2, 3))
+	m, cmd := m.Update(click(z))
+
+	require.Equal(t, 2, m.column)
+	require.Equal(t, 3, m.selected)
+	require.NotNil(t, cmd)
+	require.Equal(t, SelectMsg{Hex: Column3Presets[3].Hex}, cmd())
+}
+
+func TestMouseWheelMovesWithinColumn(t *testing.T) {
+	m := New()
+
+	m, cmd := m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	require.Nil(t, cmd)
+	require.Equal(t, 1, m.selected)
+
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp})
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp})
+	require.Equal(t, 0, m.selected, "expected wheel to stop at first row")
+}
+
+func TestMouseClickCustomButtons(t *testing.T) {
+	m := New().SetSize(100, 30)
+
+	z := waitForZone(t, m, zoneCustom)
+	m, _ = m.Update(click(z))
+	require.True(t, m.InCustomMode(), "clicking the custom hint enters custom mode")
+
+	// Save with an invalid hex shows the error instead of selecting
+	z = waitForZone(t, m, zoneCustomSave)
+	m, cmd := m.Update(click(z))
+	require.Nil(t, cmd)
+	require.True(t, m.showCustomError)
+
+	// Clicking the input focuses it again for typing
+	z = waitForZone(t, m, zoneCustomInput)
+	m, _ = m.Update(click(z))
+	require.Equal(t, customFocusInput, m.customFocus)
+	m.customInput.SetValue("#123456")
+
+	z = waitForZone(t, m, zoneCustomSave)
+	m, cmd = m.Update(click(z))
+	require.NotNil(t, cmd)
+	require.Equal(t, SelectMsg{Hex: "#123456"}, cmd())
+
+	z = waitForZone(t, m, zoneCustomCancel)
+	m, _ = m.Update(click(z))
+	require.False(t, m.InCustomMode(), "cancel returns to the preset grid")
+}
@@ -0,0 +1,57 @@
+// Package flash tracks items that changed recently so views can highlight them
+// briefly, e.g. issue rows updated live by orchestration.
+package flash
+
+import (
+	"time"
+
+	"github.com/zjrosen/perles/internal/mode/shared"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Duration is how long an item stays highlighted after it changes.
+const Duration = 3 * time.Second
+
+// ExpiredMsg is delivered when a highlight ends, so the view re-renders without it.
+type ExpiredMsg struct{}
+
+// Set tracks highlighted item IDs. It is shared by pointer so the state
+// survives value copies of the models and list delegates that render it.
+type Set struct {
+	clock shared.Clock
+	until map[string]time.Time // item ID -> end of highlight
+}
+
+// New creates an empty set. A nil clock uses the real clock.
+func New(clock shared.Clock) *Set {
+	if clock == nil {
+		clock = shared.RealClock{}
+	}
+	return &Set{clock: clock, until: make(map[string]time.Time)}
+}
+
+// Flash highlights id for Duration and returns a command that delivers
+// ExpiredMsg once the highlight is over. Flashing a nil set does nothing.
+func (s *Set) Flash(id string) tea.Cmd {
+	if s == nil {
+		return nil
+	}
+	now := s.clock.Now()
+	for itemID, until := range s.until {
+		if !now.Before(until) {
+			delete(s.until, itemID)
+		}
+	}
+	s.until[id] = now.Add(Duration)
+	return tea.Tick(Duration, func(time.Time) tea.Msg { return ExpiredMsg{} })
+}
+
+// Active reports whether id is highlighted. A nil set has no highlights.
+func (s *Set) Active(id string) bool {
+	if s == nil {
+		return false
+	}
+	until, ok := s.until[id]
+	return ok && s.clock.Now().Before(until)
+}
@@ -0,0 +1,39 @@
+package flash
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestSet_FlashExpires(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	s := New(clock)
+
+	require.NotNil(t, s.Flash("perles-abc1"))
+	require.True(t, s.Active("perles-abc1"))
+	require.False(t, s.Active("perles-abc2"))
+
+	clock.now = clock.now.Add(Duration - time.Millisecond)
+	s.Flash("perles-abc2")
+	require.True(t, s.Active("perles-abc1"))
+
+	clock.now = clock.now.Add(time.Millisecond)
+	require.False(t, s.Active("perles-abc1"), "highlight ends after Duration")
+	require.True(t, s.Active("perles-abc2"))
+
+	s.Flash("perles-abc3")
+	require.NotContains(t, s.until, "perles-abc1", "expired highlights are pruned")
+}
+
+func TestSet_NilHasNoHighlights(t *testing.T) {
+	var s *Set
+	require.False(t, s.Active("perles-abc1"))
+}
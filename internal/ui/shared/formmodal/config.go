@@ -33,7 +33,7 @@
 //	Shift+Tab, Ctrl+P - Previous field/button
 //	Enter            - Confirm (submit on button, open picker on color)
 //	Ctrl+S           - Save form (from any field)
-//	Esc              - Cancel modal
+//	Esc              - Cancel modal (confirms first with ConfirmDiscard and unsaved changes)
 //	j/k              - Navigate within list fields
 //	Space            - Toggle selection in list fields
 //	h/l              - Navigate between buttons
@@ -79,6 +79,8 @@
 package formmodal
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
@@ -97,7 +99,7 @@ const (
 
 	// FieldTypeColor shows a color swatch with hex value.
 	// Press Enter to open the colorpicker overlay.
-	// Supports InitialColor option (default: "#73F59F").
+	// Supports InitialColor option (default: the theme's status.success color).
 	FieldTypeColor
 
 	// FieldTypeList is a checkable list with multi-select support.
@@ -112,7 +114,8 @@ const (
 
 	// FieldTypeEditableList is a list with an embedded input for adding items.
 	// Navigate with j/k within the list, Tab between list and input.
-	// Supports Options, MultiSelect, InputPlaceholder, InputHint, InputLabel, AllowDuplicates.
+	// Supports Options, MultiSelect, InputPlaceholder, InputHint, InputLabel, AllowDuplicates,
+	// NormalizeItem.
 	FieldTypeEditableList
 
 	// FieldTypeToggle is a binary toggle selector (radio button style).
@@ -142,6 +145,28 @@ const (
 	// Supports EpicSearchExecutor (required), DebounceMs, SearchPlaceholder, MaxVisibleItems.
 	// Returns the selected epic's ID as a string.
 	FieldTypeEpicSearch
+
+	// FieldTypeAsyncSelect is a searchable single-select list whose options are
+	// loaded asynchronously when the form starts (see Model.Init).
+	// Shows a spinner while loading and the error inline if loading fails;
+	// Enter on a failed field retries. Typing filters the loaded options.
+	// Supports LoadOptions (required), InitialValue, SearchPlaceholder, MaxVisibleItems.
+	// Returns the selected option's Value (string).
+	// With MultiSelect, Enter toggles options and leaves the list open,
+	// InitialValues replaces InitialValue and the value is a sorted []string.
+	FieldTypeAsyncSelect
+
+	// FieldTypeDate is a date picker edited one segment (year, month, day) at a time.
+	// Use ←/→ (or h/l) to pick a segment, ↑/↓ (or k/j) to step it, or type digits.
+	// Supports InitialDate (default: today), MinDate, MaxDate.
+	// Returns the date as a time.Time at local midnight.
+	FieldTypeDate
+
+	// FieldTypeDuration is a duration input edited as hours and minutes segments.
+	// Uses the same keys as FieldTypeDate; minutes step by DurationStep.
+	// Supports InitialDuration, MinDuration, MaxDuration, DurationStep.
+	// Returns a time.Duration.
+	FieldTypeDuration
 )
 
 // FieldConfig defines a single form field.
@@ -158,11 +183,12 @@ const (
 //   - InitialValue: Pre-filled text value
 //
 // Color field options (FieldTypeColor):
-//   - InitialColor: Starting hex color (default: "#73F59F")
+//   - InitialColor: Starting hex color (default: the theme's status.success color)
 //
 // List field options (FieldTypeList, FieldTypeSelect):
 //   - Options: Slice of ListOption defining available choices
-//   - MultiSelect: If true, allows multiple selections (FieldTypeList only)
+//   - MultiSelect: If true, allows multiple selections (FieldTypeList only;
+//     FieldTypeSearchSelect and FieldTypeAsyncSelect also support it)
 //
 // EditableList field options (FieldTypeEditableList):
 //   - Options: Slice of ListOption defining initial list items
@@ -171,6 +197,7 @@ const (
 //   - InputHint: Hint shown for the input section (e.g., "Enter to add")
 //   - InputLabel: Label for the input section (e.g., "Add Label")
 //   - AllowDuplicates: Whether duplicate values are allowed (default: false)
+//   - NormalizeItem: Validates and rewrites each value before it is added
 type FieldConfig struct {
 	Key   string    // Unique identifier for this field (used in SubmitMsg.Values)
 	Type  FieldType // Type of field
@@ -183,17 +210,20 @@ type FieldConfig struct {
 	InitialValue string // Pre-filled value
 
 	// Color field options
-	InitialColor string // Initial hex color (default: "#73F59F")
+	InitialColor string // Initial hex color (default: theme's status.success color)
 
 	// List/Select field options
 	Options     []ListOption // Available options for list/select fields
-	MultiSelect bool         // For FieldTypeList: allow multiple selections
+	MultiSelect bool         // For FieldTypeList, FieldTypeSearchSelect, FieldTypeAsyncSelect: allow multiple selections
 
 	// EditableList field options (FieldTypeEditableList)
 	InputPlaceholder string // Placeholder for the add-item input
 	InputHint        string // Hint shown below input (e.g., "Enter to add")
 	InputLabel       string // Label for input section (e.g., "Add Label")
 	AllowDuplicates  bool   // Whether duplicate values are allowed (default: false)
+	// NormalizeItem validates a trimmed value before it is added and returns
+	// the value to store. An error is shown and the input is kept for fixing.
+	NormalizeItem func(value string) (string, error)
 
 	// Toggle field options (FieldTypeToggle)
 	InitialToggleIndex int // 0 or 1 - which option is initially selected (default: 0)
@@ -205,11 +235,32 @@ type FieldConfig struct {
 	// TextArea field options (FieldTypeTextArea)
 	MaxHeight  int  // Max display height in lines (default: 3)
 	VimEnabled bool // Enable vim mode for textarea (default: false, starts in Insert mode)
+	SpellCheck bool // Underline misspellings and offer suggestions (z=, Ctrl+L) - for prose fields
 
 	// EpicSearch field options (FieldTypeEpicSearch)
 	EpicSearchExecutor bql.BQLExecutor // Required: injected for query execution
 	DebounceMs         int             // Debounce delay in milliseconds (default: 200ms)
 
+	// AsyncSelect field options (FieldTypeAsyncSelect)
+	// LoadOptions fetches the options. It runs in a tea.Cmd, off the UI
+	// goroutine, so it may block on I/O. The option whose Value equals
+	// InitialValue is preselected once loaded.
+	LoadOptions func() ([]ListOption, error)
+	// InitialValues are preselected once loaded when MultiSelect is set,
+	// and stand in for the value while loading.
+	InitialValues []string
+
+	// Date field options (FieldTypeDate)
+	InitialDate time.Time // Initial date (default: today)
+	MinDate     time.Time // Earliest allowed date (zero = no limit)
+	MaxDate     time.Time // Latest allowed date (zero = no limit)
+
+	// Duration field options (FieldTypeDuration)
+	InitialDuration time.Duration // Initial duration (default: 0)
+	MinDuration     time.Duration // Shortest allowed duration (default: 0)
+	MaxDuration     time.Duration // Longest allowed duration (0 = no limit)
+	DurationStep    time.Duration // Step for the minutes segment (default: 5m)
+
 	// Conditional visibility
 	// VisibleWhen determines if this field is visible based on current form values.
 	// If nil, the field is always visible. If the function returns false, the field
@@ -224,6 +275,23 @@ type FieldConfig struct {
 	//	}
 	VisibleWhen func(values map[string]any) bool
 
+	// OptionsFrom computes the options of a list, select, search-select,
+	// editable-list or toggle field from the current form values, replacing
+	// Options. It is called when the form opens and after every change, so a
+	// field's choices can follow another field. Selected options that remain
+	// available stay selected. Fields are evaluated in order, so a field
+	// should only depend on fields declared before it.
+	//
+	// Example - offer review types that depend on the "priority" select:
+	//
+	//	OptionsFrom: func(values map[string]any) []ListOption {
+	//	    if values["priority"] == "high" {
+	//	        return []ListOption{{Label: "Pair review", Value: "pair"}}
+	//	    }
+	//	    return []ListOption{{Label: "Async review", Value: "async"}}
+	//	}
+	OptionsFrom func(values map[string]any) []ListOption
+
 	// Column specifies which column this field belongs to (0-indexed).
 	// Default: 0 (first column). Fields are rendered in array order within each column.
 	Column int
@@ -281,11 +349,20 @@ type FormConfig struct {
 	// Example: func(values map[string]any) tea.Msg { return MySubmitMsg{...} }
 	OnSubmit func(values map[string]any) tea.Msg
 
+	// OnSubmitChanges is like OnSubmit but also receives the keys of the fields
+	// the user changed (see Model.ChangedFields). Takes precedence over OnSubmit.
+	OnSubmitChanges func(values map[string]any, changed []string) tea.Msg
+
 	// OnCancel produces a custom message when the form is cancelled.
 	// If nil, formmodal produces CancelMsg{}.
 	// Example: func() tea.Msg { return MyCancelMsg{} }
 	OnCancel func() tea.Msg
 
+	// ConfirmDiscard asks for confirmation before Esc closes a form with unsaved
+	// changes. The dialog defaults to "Keep Editing"; Esc also keeps editing.
+	// The Cancel button always closes without asking.
+	ConfirmDiscard bool
+
 	// HeaderContent renders optional display-only content between the title
 	// separator and the first form field.
 	//
@@ -1,10 +1,16 @@
 package formmodal
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
 
 	"github.com/zjrosen/perles/internal/ui/shared/vimtextarea"
+	"github.com/zjrosen/perles/internal/ui/styles"
 )
 
 // subFocus tracks which part of a composite field has focus.
@@ -55,8 +61,38 @@ type fieldState struct {
 	epicQueryID        int    // For discarding stale results
 	epicSearchExpanded bool   // Whether search popup is expanded
 	epicHasLoaded      bool   // True after first query results received (prevents flash of "no results")
+
+	// AsyncSelect field state (also uses the SearchSelect state)
+	asyncLoading bool  // Whether LoadOptions is running
+	asyncError   error // Last load error
+	asyncLoadID  int   // For discarding stale results
+	spinnerFrame int   // Loading spinner animation frame
+
+	// Date/Duration field state
+	segment  int           // Focused segment (date: year, month, day; duration: hours, minutes)
+	typed    string        // Digits typed into the focused segment so far
+	date     time.Time     // Current date (local midnight)
+	duration time.Duration // Current duration
 }
 
+// Date and duration segments, in display order.
+const (
+	segmentYear = iota
+	segmentMonth
+	segmentDay
+)
+
+const (
+	segmentHours = iota
+	segmentMinutes
+)
+
+// dateLayout is the display and error message format for date fields.
+const dateLayout = "2006-01-02"
+
+// defaultDurationStep is the minutes step used when DurationStep is unset.
+const defaultDurationStep = 5 * time.Minute
+
 // listItem tracks selection state for list items.
 type listItem struct {
 	label    string
@@ -89,7 +125,7 @@ func newFieldState(cfg FieldConfig) fieldState {
 	case FieldTypeColor:
 		fs.selectedColor = cfg.InitialColor
 		if fs.selectedColor == "" {
-			fs.selectedColor = "#73F59F" // Default green
+			fs.selectedColor = styles.Hex(styles.TokenStatusSuccess) // Theme success color
 		}
 
 	case FieldTypeList, FieldTypeSelect:
@@ -139,7 +175,7 @@ func newFieldState(cfg FieldConfig) fieldState {
 			fs.toggleIndex = 1
 		}
 
-	case FieldTypeSearchSelect:
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 		// Initialize list items from options
 		fs.listItems = make([]listItem, len(cfg.Options))
 		selectedIdx := -1
@@ -156,6 +192,9 @@ func newFieldState(cfg FieldConfig) fieldState {
 			}
 		}
 
+		// Async options arrive later (see Model.Init)
+		fs.asyncLoading = cfg.Type == FieldTypeAsyncSelect && cfg.LoadOptions != nil
+
 		// Initialize search input
 		ti := textinput.New()
 		ti.Placeholder = cfg.SearchPlaceholder
@@ -185,6 +224,7 @@ func newFieldState(cfg FieldConfig) fieldState {
 			Placeholder: cfg.Placeholder,
 			CharLimit:   cfg.MaxLength,
 			MaxHeight:   cfg.MaxHeight,
+			SpellCheck:  cfg.SpellCheck,
 		})
 		if cfg.InitialValue != "" {
 			ta.SetValue(cfg.InitialValue)
@@ -210,11 +250,134 @@ func newFieldState(cfg FieldConfig) fieldState {
 		if cfg.InitialValue != "" {
 			fs.epicSelectedID = cfg.InitialValue
 		}
+
+	case FieldTypeDate:
+		d := cfg.InitialDate
+		if d.IsZero() {
+			d = time.Now()
+		}
+		fs.date = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.Local)
+
+	case FieldTypeDuration:
+		fs.duration = max(cfg.InitialDuration, 0)
 	}
 
 	return fs
 }
 
+// setOptions replaces the options of a list-type field with opts. Options
+// that were selected and are still present stay selected; a select field
+// whose selection disappeared falls back to the options' Selected flags.
+// Selected items the user added to an editable list are kept.
+// Returns false if the options did not change.
+func (fs *fieldState) setOptions(opts []ListOption) bool {
+	if slices.EqualFunc(fs.config.Options, opts, sameOption) {
+		return false
+	}
+
+	if fs.config.Type == FieldTypeToggle {
+		var current string
+		if fs.toggleIndex < len(fs.config.Options) {
+			current = fs.config.Options[fs.toggleIndex].Value
+		}
+		fs.config.Options = opts
+		fs.toggleIndex = 0
+		for i, opt := range opts {
+			if opt.Value == current {
+				fs.toggleIndex = i
+			}
+		}
+		return true
+	}
+
+	selected := make(map[string]bool)
+	var added []listItem
+	for _, item := range fs.listItems {
+		if !item.selected {
+			continue
+		}
+		selected[item.value] = true
+		if fs.config.Type == FieldTypeEditableList && !slices.ContainsFunc(opts, func(o ListOption) bool { return o.Value == item.value }) {
+			added = append(added, item)
+		}
+	}
+	singleSelect := fs.config.Type == FieldTypeSelect || (fs.isSearchSelect() && !fs.config.MultiSelect)
+	// Search selects (including multi-select ones) keep the current selection
+	// only if it survives; otherwise the new options' Selected flags apply
+	keepSelection := (!singleSelect && !fs.isSearchSelect()) || slices.ContainsFunc(opts, func(o ListOption) bool { return selected[o.Value] })
+
+	fs.config.Options = opts
+	fs.listItems = make([]listItem, 0, len(opts)+len(added))
+	fs.listCursor = 0
+	for i, opt := range opts {
+		isSelected := opt.Selected
+		if keepSelection {
+			isSelected = selected[opt.Value]
+		}
+		fs.listItems = append(fs.listItems, listItem{
+			label:    opt.Label,
+			subtext:  opt.Subtext,
+			value:    opt.Value,
+			selected: isSelected,
+			color:    opt.Color,
+		})
+		if singleSelect && isSelected {
+			fs.listCursor = i
+		}
+	}
+	fs.listItems = append(fs.listItems, added...)
+	fs.scrollOffset = 0
+	return true
+}
+
+// isSearchSelect reports whether the field uses the search-select state and
+// behavior, which async selects share.
+func (fs *fieldState) isSearchSelect() bool {
+	return fs.config.Type == FieldTypeSearchSelect || fs.config.Type == FieldTypeAsyncSelect
+}
+
+// chooseSearchItem picks the list item at idx in a search select. A
+// single-select field selects only that item; a multi-select one toggles it.
+func (fs *fieldState) chooseSearchItem(idx int) {
+	if fs.config.MultiSelect {
+		fs.listItems[idx].selected = !fs.listItems[idx].selected
+		return
+	}
+	for i := range fs.listItems {
+		fs.listItems[i].selected = i == idx
+	}
+}
+
+// setLoadedOptions applies options loaded for an async select, preselecting
+// the options matching InitialValue (InitialValues for multi-select) when
+// nothing is selected yet.
+func (fs *fieldState) setLoadedOptions(opts []ListOption) {
+	initial := fs.initialValues()
+	if len(initial) > 0 && !slices.ContainsFunc(opts, func(o ListOption) bool { return o.Selected }) {
+		opts = slices.Clone(opts)
+		for i := range opts {
+			opts[i].Selected = slices.Contains(initial, opts[i].Value)
+		}
+	}
+	fs.setOptions(opts)
+}
+
+// initialValues returns the values an async select starts with.
+func (fs *fieldState) initialValues() []string {
+	if fs.config.MultiSelect {
+		return fs.config.InitialValues
+	}
+	if fs.config.InitialValue != "" {
+		return []string{fs.config.InitialValue}
+	}
+	return nil
+}
+
+// sameOption reports whether two options display and submit the same choice.
+func sameOption(a, b ListOption) bool {
+	return a.Label == b.Label && a.Subtext == b.Subtext && a.Value == b.Value
+}
+
 // value extracts the current value from the field state.
 func (fs *fieldState) value() any {
 	switch fs.config.Type {
@@ -260,13 +423,32 @@ func (fs *fieldState) value() any {
 		}
 		return ""
 
-	case FieldTypeSearchSelect:
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
+		if fs.config.MultiSelect {
+			// Sorted, so the value doesn't depend on the order options load in.
+			// Until async options arrive, the initial values stand in for the selection
+			if fs.asyncLoading || fs.asyncError != nil {
+				return slices.Sorted(slices.Values(fs.config.InitialValues))
+			}
+			var selected []string
+			for _, item := range fs.listItems {
+				if item.selected {
+					selected = append(selected, item.value)
+				}
+			}
+			slices.Sort(selected)
+			return selected
+		}
 		// Return the selected item's value (same as FieldTypeSelect)
 		for _, item := range fs.listItems {
 			if item.selected {
 				return item.value
 			}
 		}
+		// Until async options arrive, the initial value stands in for the selection
+		if fs.asyncLoading || fs.asyncError != nil {
+			return fs.config.InitialValue
+		}
 		return ""
 
 	case FieldTypeTextArea:
@@ -274,6 +456,167 @@ func (fs *fieldState) value() any {
 
 	case FieldTypeEpicSearch:
 		return fs.epicSelectedID
+
+	case FieldTypeDate:
+		return fs.date
+
+	case FieldTypeDuration:
+		return fs.duration
 	}
 	return nil
 }
+
+// segmentCount returns the number of editable segments of a date or duration field.
+func (fs *fieldState) segmentCount() int {
+	if fs.config.Type == FieldTypeDate {
+		return 3
+	}
+	return 2
+}
+
+// segmentWidth returns how many digits the segment takes before typing moves on.
+func (fs *fieldState) segmentWidth(segment int) int {
+	switch {
+	case fs.config.Type == FieldTypeDate && segment == segmentYear:
+		return 4
+	case fs.config.Type == FieldTypeDuration && segment == segmentHours:
+		return 3
+	}
+	return 2
+}
+
+// moveSegment moves the segment focus by delta, staying within the field.
+func (fs *fieldState) moveSegment(delta int) {
+	fs.segment = min(max(fs.segment+delta, 0), fs.segmentCount()-1)
+	fs.typed = ""
+}
+
+// stepSegment increments (delta > 0) or decrements the focused segment,
+// keeping the value within the field's configured bounds.
+func (fs *fieldState) stepSegment(delta int) {
+	fs.typed = ""
+	if fs.config.Type == FieldTypeDuration {
+		step := time.Hour
+		if fs.segment == segmentMinutes {
+			step = fs.config.DurationStep
+			if step <= 0 {
+				step = defaultDurationStep
+			}
+		}
+		fs.duration = fs.clampDuration(fs.duration + time.Duration(delta)*step)
+		return
+	}
+
+	y, mo, d := fs.date.Date()
+	switch fs.segment {
+	case segmentYear:
+		fs.date = dateClamped(y+delta, mo, d)
+	case segmentMonth:
+		months := int(mo) - 1 + delta
+		y += months / 12
+		if months %= 12; months < 0 {
+			months += 12
+			y--
+		}
+		fs.date = dateClamped(y, time.Month(months+1), d)
+	case segmentDay:
+		fs.date = fs.date.AddDate(0, 0, delta)
+	}
+	fs.date = fs.clampDate(fs.date)
+}
+
+// typeDigit enters a digit into the focused segment. Month, day and minutes
+// apply as they are typed; the year applies once all four digits are in.
+// A full segment moves focus to the next one. Typed values are not clamped
+// to the configured bounds, so validation reports them on submit.
+func (fs *fieldState) typeDigit(r rune) {
+	fs.typed += string(r)
+	n, _ := strconv.Atoi(fs.typed)
+	full := len(fs.typed) >= fs.segmentWidth(fs.segment)
+
+	if fs.config.Type == FieldTypeDuration {
+		hours, minutes := fs.duration/time.Hour, fs.duration%time.Hour/time.Minute
+		if fs.segment == segmentHours {
+			hours = time.Duration(n)
+		} else {
+			minutes = time.Duration(min(n, 59))
+		}
+		fs.duration = hours*time.Hour + minutes*time.Minute
+	} else {
+		y, mo, d := fs.date.Date()
+		switch fs.segment {
+		case segmentYear:
+			if full {
+				y = max(n, 1)
+			}
+		case segmentMonth:
+			mo = time.Month(min(max(n, 1), 12))
+		case segmentDay:
+			d = max(n, 1)
+		}
+		fs.date = dateClamped(y, mo, d)
+	}
+
+	if full {
+		fs.moveSegment(1)
+	}
+}
+
+// clampDate limits d to the field's MinDate and MaxDate.
+func (fs *fieldState) clampDate(d time.Time) time.Time {
+	if lo := fs.config.MinDate; !lo.IsZero() && d.Before(startOfDay(lo)) {
+		return startOfDay(lo)
+	}
+	if hi := fs.config.MaxDate; !hi.IsZero() && d.After(startOfDay(hi)) {
+		return startOfDay(hi)
+	}
+	return d
+}
+
+// clampDuration limits d to the field's MinDuration and MaxDuration, and never below zero.
+func (fs *fieldState) clampDuration(d time.Duration) time.Duration {
+	d = max(d, fs.config.MinDuration, 0)
+	if fs.config.MaxDuration > 0 {
+		d = min(d, fs.config.MaxDuration)
+	}
+	return d
+}
+
+// validate checks a date or duration field against its configured bounds.
+func (fs *fieldState) validate() error {
+	name := fieldDisplayName(fs.config)
+	switch fs.config.Type {
+	case FieldTypeDate:
+		if lo := fs.config.MinDate; !lo.IsZero() && fs.date.Before(startOfDay(lo)) {
+			return fmt.Errorf("%s must be on or after %s", name, lo.Format(dateLayout))
+		}
+		if hi := fs.config.MaxDate; !hi.IsZero() && fs.date.After(startOfDay(hi)) {
+			return fmt.Errorf("%s must be on or before %s", name, hi.Format(dateLayout))
+		}
+	case FieldTypeDuration:
+		if fs.duration < fs.config.MinDuration {
+			return fmt.Errorf("%s must be at least %s", name, formatDuration(fs.config.MinDuration))
+		}
+		if fs.config.MaxDuration > 0 && fs.duration > fs.config.MaxDuration {
+			return fmt.Errorf("%s must be at most %s", name, formatDuration(fs.config.MaxDuration))
+		}
+	}
+	return nil
+}
+
+// startOfDay returns local midnight of t's calendar day.
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// dateClamped returns local midnight of the given date, limiting the day to
+// the length of the month so that Jan 31 plus one month is Feb 28 (or 29).
+func dateClamped(year int, month time.Month, day int) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.Local).Day()
+	return time.Date(year, month, min(day, lastDay), 0, 0, 0, 0, time.Local)
+}
+
+// formatDuration renders a duration as hours and minutes, e.g. "2h 05m".
+func formatDuration(d time.Duration) string {
+	return fmt.Sprintf("%dh %02dm", d/time.Hour, d%time.Hour/time.Minute)
+}
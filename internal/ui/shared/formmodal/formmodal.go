@@ -2,6 +2,7 @@ package formmodal
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/keys"
 	"github.com/zjrosen/perles/internal/ui/shared/colorpicker"
+	"github.com/zjrosen/perles/internal/ui/shared/modal"
 	"github.com/zjrosen/perles/internal/ui/shared/vimtextarea"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -26,6 +28,8 @@ import (
 //   - FieldTypeColor: string (hex color, e.g., "#73F59F")
 //   - FieldTypeList: []string (selected values)
 //   - FieldTypeSelect: string (single selected value)
+//   - FieldTypeDate: time.Time (local midnight)
+//   - FieldTypeDuration: time.Duration
 //
 // Example:
 //
@@ -36,7 +40,8 @@ import (
 //	    views := msg.Values["views"].([]string)
 //	}
 type SubmitMsg struct {
-	Values map[string]any // Field values keyed by FieldConfig.Key
+	Values        map[string]any // Field values keyed by FieldConfig.Key
+	ChangedFields []string       // Keys of visible fields whose value differs from the initial value, in field order
 }
 
 // CancelMsg is sent when the form is cancelled (via Esc key or Cancel button).
@@ -57,6 +62,23 @@ type epicSearchDebounceMsg struct {
 	queryID    int    // Version ID to detect stale results
 }
 
+// asyncOptionsMsg carries the result of an async select's LoadOptions.
+type asyncOptionsMsg struct {
+	fieldIndex int          // Which field this result is for
+	options    []ListOption // Loaded options
+	err        error        // Load error (nil on success)
+	loadID     int          // Version ID to detect stale results
+}
+
+// asyncSpinnerTickMsg advances the loading spinner of an async select.
+type asyncSpinnerTickMsg struct {
+	fieldIndex int // Which field this is for
+	loadID     int // Version ID; ticking stops once this load finishes
+}
+
+// spinnerFrames defines the braille spinner animation sequence.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
 // Model is the form modal state.
 //
 // Create a new Model with New(cfg). The Model implements the Bubble Tea
@@ -80,6 +102,13 @@ type Model struct {
 	colorPicker     colorpicker.Model
 	showColorPicker bool
 
+	// Unsaved-changes guard (FormConfig.ConfirmDiscard)
+	discardModal     modal.Model
+	showDiscardModal bool
+
+	// initialValues is the snapshot of all field values taken by New, used for dirty tracking.
+	initialValues map[string]any
+
 	// Validation error
 	validationError string
 
@@ -106,10 +135,17 @@ func New(cfg FormConfig) Model {
 		bodyViewport: viewport.New(0, 0),
 	}
 
-	// Initialize field states
+	// Initialize field states. Text areas share one register set so text
+	// yanked in one field can be pasted into another.
+	registers := vimtextarea.NewRegisters()
 	for i, fieldCfg := range cfg.Fields {
 		m.fields[i] = newFieldState(fieldCfg)
+		if fieldCfg.Type == FieldTypeTextArea {
+			m.fields[i].textArea.SetRegisters(registers)
+		}
 	}
+	m = m.syncDependentFields()
+	m.initialValues = m.currentValues()
 
 	// Find the first visible field to focus
 	firstVisible := m.firstVisibleFieldIndex()
@@ -122,7 +158,7 @@ func New(cfg FormConfig) Model {
 			fs.textInput.Focus()
 		case FieldTypeTextArea:
 			fs.textArea.Focus()
-		case FieldTypeSearchSelect:
+		case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 			// Start collapsed - don't focus search input yet
 			fs.searchExpanded = false
 		case FieldTypeEpicSearch:
@@ -143,27 +179,42 @@ func New(cfg FormConfig) Model {
 }
 
 // Init returns the initial command for the Bubble Tea model.
-// Returns a cursor blink command if the first focused field has a text input.
+// Returns a cursor blink command if the first focused field has a text input,
+// and starts loading the options of async select fields.
 func (m Model) Init() tea.Cmd {
+	var cmds []tea.Cmd
 	if m.focusedIndex >= 0 && m.focusedIndex < len(m.fields) {
 		fs := &m.fields[m.focusedIndex]
 		switch fs.config.Type {
 		case FieldTypeText:
-			return textinput.Blink
-		case FieldTypeSearchSelect:
+			cmds = append(cmds, textinput.Blink)
+		case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 			if fs.searchExpanded {
-				return textinput.Blink
+				cmds = append(cmds, textinput.Blink)
 			}
 		}
 	}
-	return nil
+	for i := range m.fields {
+		if m.fields[i].asyncLoading {
+			cmds = append(cmds, m.loadOptionsCmd(i))
+		}
+	}
+	return tea.Batch(cmds...)
 }
 
 // Update handles messages for the form modal.
 //
 // Returns SubmitMsg when form is submitted successfully, CancelMsg when
 // cancelled. Returns nil commands for internal state changes.
+// After each message, options computed by OptionsFrom are refreshed and focus
+// leaves a field that VisibleWhen just hid.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	m, cmd := m.update(msg)
+	return m.syncDependentFields(), cmd
+}
+
+// update handles a message.
+func (m Model) update(msg tea.Msg) (Model, tea.Cmd) {
 	// Handle colorpicker result messages first
 	switch msg := msg.(type) {
 	case colorpicker.SelectMsg:
@@ -234,6 +285,27 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Async select results and spinner ticks
+	switch msg := msg.(type) {
+	case asyncOptionsMsg:
+		return m.handleAsyncOptions(msg), nil
+
+	case asyncSpinnerTickMsg:
+		if msg.fieldIndex >= 0 && msg.fieldIndex < len(m.fields) {
+			fs := &m.fields[msg.fieldIndex]
+			if fs.asyncLoading && fs.asyncLoadID == msg.loadID {
+				fs.spinnerFrame = (fs.spinnerFrame + 1) % len(spinnerFrames)
+				return m, asyncSpinnerTick(msg.fieldIndex, msg.loadID)
+			}
+		}
+		return m, nil
+	}
+
+	// The discard confirmation takes all input while it's open
+	if m.showDiscardModal {
+		return m.updateDiscardModal(msg)
+	}
+
 	// Forward all messages to colorpicker when it's open
 	if m.showColorPicker {
 		var cmd tea.Cmd
@@ -300,12 +372,17 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 // handleKeyMsg processes keyboard input.
 func (m Model) handleKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	// Pasted text is content, never navigation
+	if msg.Paste {
+		return m.handlePaste(msg)
+	}
+
 	// Handle Esc - check if it should be consumed by the current field first
 	if key.Matches(msg, keys.Common.Escape) {
 		if m.focusedIndex >= 0 && m.focusedIndex < len(m.fields) {
 			fs := &m.fields[m.focusedIndex]
 			// If a SearchSelect field is expanded, collapse it instead of closing modal
-			if fs.config.Type == FieldTypeSearchSelect && fs.searchExpanded {
+			if fs.isSearchSelect() && fs.searchExpanded {
 				fs.searchExpanded = false
 				fs.searchInput.Blur()
 				return m, nil
@@ -316,18 +393,19 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 				fs.searchInput.Blur()
 				return m, nil
 			}
-			// If a TextArea field has vim enabled and is in Insert mode, let Esc switch to Normal mode
-			if fs.config.Type == FieldTypeTextArea && fs.config.VimEnabled && fs.textArea.Mode() == vimtextarea.ModeInsert {
+			// If a TextArea field has vim enabled and is in Insert mode, let Esc switch to Normal mode.
+			// An open spelling suggestions menu also takes Esc to close itself.
+			if fs.config.Type == FieldTypeTextArea && (fs.textArea.SpellMenuOpen() || fs.config.VimEnabled && fs.textArea.Mode() == vimtextarea.ModeInsert) {
 				var cmd tea.Cmd
 				fs.textArea, cmd = fs.textArea.Update(msg)
 				return m, cmd
 			}
 		}
-		// Otherwise, cancel the modal
-		if m.config.OnCancel != nil {
-			return m, func() tea.Msg { return m.config.OnCancel() }
+		// Otherwise, cancel the modal (asking first if there are unsaved changes)
+		if m.config.ConfirmDiscard && m.IsDirty() {
+			return m.showDiscardConfirm(), nil
 		}
-		return m, func() tea.Msg { return CancelMsg{} }
+		return m, m.cancelCmd()
 	}
 
 	// Handle Ctrl+S globally (save from any field)
@@ -341,12 +419,14 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 		switch fs.config.Type {
 		case FieldTypeEditableList:
 			return m.handleKeyForEditableList(msg, fs)
-		case FieldTypeSearchSelect:
+		case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 			return m.handleKeyForSearchSelect(msg, fs)
 		case FieldTypeEpicSearch:
 			return m.handleKeyForEpicSearch(msg, fs)
 		case FieldTypeTextArea:
 			return m.handleKeyForTextArea(msg, fs)
+		case FieldTypeDate, FieldTypeDuration:
+			return m.handleKeyForDateTime(msg, fs)
 		}
 	}
 
@@ -583,10 +663,7 @@ func (m Model) handleEnter() (Model, tea.Cmd) {
 	case 0: // Submit
 		return m.submit()
 	case 1: // Cancel
-		if m.config.OnCancel != nil {
-			return m, func() tea.Msg { return m.config.OnCancel() }
-		}
-		return m, func() tea.Msg { return CancelMsg{} }
+		return m, m.cancelCmd()
 	}
 
 	return m, nil
@@ -605,6 +682,17 @@ func (m Model) submit() (Model, tea.Cmd) {
 		}
 	}
 
+	// Date and duration fields check their own bounds first
+	for i := range m.fields {
+		if !m.isFieldVisible(i) {
+			continue
+		}
+		if err := m.fields[i].validate(); err != nil {
+			m.validationError = err.Error()
+			return m, nil
+		}
+	}
+
 	// Run validation if provided
 	if m.config.Validate != nil {
 		if err := m.config.Validate(values); err != nil {
@@ -614,10 +702,123 @@ func (m Model) submit() (Model, tea.Cmd) {
 	}
 
 	// Use factory if provided, otherwise default SubmitMsg
+	changed := m.ChangedFields()
+	if m.config.OnSubmitChanges != nil {
+		return m, func() tea.Msg { return m.config.OnSubmitChanges(values, changed) }
+	}
 	if m.config.OnSubmit != nil {
 		return m, func() tea.Msg { return m.config.OnSubmit(values) }
 	}
-	return m, func() tea.Msg { return SubmitMsg{Values: values} }
+	return m, func() tea.Msg { return SubmitMsg{Values: values, ChangedFields: changed} }
+}
+
+// cancelCmd produces the cancel message, using the OnCancel factory if provided.
+func (m Model) cancelCmd() tea.Cmd {
+	if m.config.OnCancel != nil {
+		return func() tea.Msg { return m.config.OnCancel() }
+	}
+	return func() tea.Msg { return CancelMsg{} }
+}
+
+// ChangedFields returns the keys of the visible fields whose value differs from
+// the value the form was created with, in field order.
+// Returns nil when nothing changed.
+func (m Model) ChangedFields() []string {
+	var changed []string
+	for i := range m.fields {
+		key := m.fields[i].config.Key
+		if m.isFieldVisible(i) && !valuesEqual(m.initialValues[key], m.fields[i].value()) {
+			changed = append(changed, key)
+		}
+	}
+	return changed
+}
+
+// IsDirty returns whether any visible field was changed since the form was created.
+func (m Model) IsDirty() bool {
+	return len(m.ChangedFields()) > 0
+}
+
+// valuesEqual compares two field values. A nil and an empty list are equal,
+// so deselecting every item of an initially empty list is not a change.
+func valuesEqual(a, b any) bool {
+	if as, ok := a.([]string); ok {
+		bs, _ := b.([]string)
+		return slices.Equal(as, bs)
+	}
+	if bs, ok := b.([]string); ok {
+		return a == nil && len(bs) == 0
+	}
+	return a == b
+}
+
+// showDiscardConfirm opens the unsaved-changes confirmation.
+// "Keep Editing" is the focused (default) button, so an accidental Enter keeps the changes.
+func (m Model) showDiscardConfirm() Model {
+	labels := make([]string, 0, len(m.fields))
+	for _, key := range m.ChangedFields() {
+		for i := range m.fields {
+			if m.fields[i].config.Key == key {
+				labels = append(labels, fieldDisplayName(m.fields[i].config))
+				break
+			}
+		}
+	}
+	m.discardModal = modal.New(modal.Config{
+		Title:          "Unsaved Changes",
+		Message:        "You changed " + strings.Join(labels, ", ") + ". Keep editing?",
+		ConfirmVariant: modal.ButtonPrimary,
+		ConfirmText:    "Keep Editing",
+		CancelText:     "Discard",
+	})
+	m.discardModal.SetSize(m.width, m.height)
+	m.showDiscardModal = true
+	return m
+}
+
+// updateDiscardModal routes input to the unsaved-changes confirmation.
+//
+// The inner modal's result is resolved synchronously instead of round-tripping
+// modal.SubmitMsg/CancelMsg through the host, because host modes handle those
+// messages for their own dialogs. Esc keeps editing.
+func (m Model) updateDiscardModal(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, keys.Common.Escape) {
+			m.showDiscardModal = false
+			return m, nil
+		}
+	case tea.MouseMsg:
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.discardModal.SetSize(msg.Width, msg.Height)
+		return m, nil
+	default:
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.discardModal, cmd = m.discardModal.Update(msg)
+	if cmd == nil {
+		return m, nil
+	}
+	switch cmd().(type) {
+	case modal.SubmitMsg: // Keep Editing
+		m.showDiscardModal = false
+	case modal.CancelMsg: // Discard
+		m.showDiscardModal = false
+		return m, m.cancelCmd()
+	}
+	return m, nil
+}
+
+// fieldDisplayName returns the label of a field for messages, falling back to its key.
+func fieldDisplayName(cfg FieldConfig) string {
+	if cfg.Label != "" {
+		return cfg.Label
+	}
+	return cfg.Key
 }
 
 // nextField moves focus to the next visible field or button.
@@ -633,7 +834,7 @@ func (m Model) nextField() Model {
 		case FieldTypeEditableList:
 			fs.addInput.Blur()
 			fs.subFocus = SubFocusList // Reset for next time
-		case FieldTypeSearchSelect:
+		case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 			fs.searchInput.Blur()
 			fs.searchExpanded = false // Collapse when leaving field
 		case FieldTypeEpicSearch:
@@ -709,9 +910,13 @@ func (m *Model) focusNextFieldByType() {
 	case FieldTypeList, FieldTypeSelect:
 		// Position cursor at first item when entering from above
 		fs.listCursor = 0
-	case FieldTypeSearchSelect:
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 		// Start collapsed - user must press Enter to expand
 		fs.searchExpanded = false
+	case FieldTypeDate, FieldTypeDuration:
+		// Start editing at the first segment
+		fs.segment = 0
+		fs.typed = ""
 	case FieldTypeEpicSearch:
 		// Auto-expand if no selection, otherwise stay collapsed
 		if fs.epicSelectedID == "" {
@@ -736,7 +941,7 @@ func (m Model) prevField() Model {
 		case FieldTypeEditableList:
 			fs.addInput.Blur()
 			fs.subFocus = SubFocusList // Reset for next time
-		case FieldTypeSearchSelect:
+		case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 			fs.searchInput.Blur()
 			fs.searchExpanded = false // Collapse when leaving field
 		case FieldTypeEpicSearch:
@@ -796,9 +1001,13 @@ func (m *Model) focusPrevFieldByType() {
 		if len(fs.listItems) > 0 {
 			fs.listCursor = len(fs.listItems) - 1
 		}
-	case FieldTypeSearchSelect:
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 		// Start collapsed - user must press Enter to expand
 		fs.searchExpanded = false
+	case FieldTypeDate, FieldTypeDuration:
+		// Start editing at the first segment
+		fs.segment = 0
+		fs.typed = ""
 	case FieldTypeEpicSearch:
 		// Auto-expand if no selection, otherwise stay collapsed
 		if fs.epicSelectedID == "" {
@@ -822,7 +1031,7 @@ func (m Model) blinkCmd() tea.Cmd {
 			if fs.subFocus == SubFocusInput {
 				return textinput.Blink
 			}
-		case FieldTypeSearchSelect:
+		case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 			if fs.searchExpanded {
 				return textinput.Blink
 			}
@@ -893,6 +1102,21 @@ func (m Model) listContains(fs *fieldState, value string) bool {
 	return false
 }
 
+// addEditableListItem appends a trimmed value to an editable list as a selected item.
+// Returns false if the value is empty or a disallowed duplicate.
+func (m Model) addEditableListItem(fs *fieldState, value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" || (!fs.config.AllowDuplicates && m.listContains(fs, value)) {
+		return false
+	}
+	fs.listItems = append(fs.listItems, listItem{
+		label:    value,
+		value:    value,
+		selected: true, // New items start selected
+	})
+	return true
+}
+
 // currentValues returns a map of all current field values.
 // Used by VisibleWhen callbacks to check other field states.
 func (m Model) currentValues() map[string]any {
@@ -903,6 +1127,47 @@ func (m Model) currentValues() map[string]any {
 	return values
 }
 
+// syncDependentFields re-evaluates fields that depend on other fields' values.
+// OptionsFrom results replace field options, and if the focused field is no
+// longer visible, focus moves to the next visible field, the previous one, or
+// the submit button.
+func (m Model) syncDependentFields() Model {
+	for i := range m.fields {
+		fs := &m.fields[i]
+		if fs.config.OptionsFrom == nil {
+			continue
+		}
+		if fs.setOptions(fs.config.OptionsFrom(m.currentValues())) && fs.isSearchSelect() {
+			m = m.updateSearchFilter(fs)
+		}
+	}
+
+	if m.focusedIndex < 0 || m.isFieldVisible(m.focusedIndex) {
+		return m
+	}
+	m.blurCurrentField()
+	next := -1
+	for i := m.focusedIndex + 1; i < len(m.fields) && next < 0; i++ {
+		if m.isFieldVisible(i) {
+			next = i
+		}
+	}
+	for i := m.focusedIndex - 1; i >= 0 && next < 0; i-- {
+		if m.isFieldVisible(i) {
+			next = i
+		}
+	}
+	if next >= 0 {
+		m.focusedIndex = next
+		m.focusField(next)
+	} else {
+		m.focusedIndex = -1
+		m.focusedButton = 0
+	}
+	m.ensureFocusedFieldVisible()
+	return m
+}
+
 // isFieldVisible returns whether a field should be visible based on its VisibleWhen callback.
 // If no callback is set, the field is always visible.
 func (m Model) isFieldVisible(index int) bool {
@@ -916,6 +1181,85 @@ func (m Model) isFieldVisible(index int) bool {
 	return fs.config.VisibleWhen(m.currentValues())
 }
 
+// handlePaste processes a bracketed paste. The pasted text is inserted into the
+// focused field's input as a single edit, so characters like j/k, spaces or
+// newlines inside it never navigate or toggle. Fields without a text input
+// ignore pastes.
+//
+//   - Text/search inputs: newlines are flattened to spaces by the input
+//   - TextArea: inserted verbatim (switching to Insert mode first)
+//   - EditableList: one line goes to the add-item input, several lines each become an item
+func (m Model) handlePaste(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.focusedIndex < 0 || m.focusedIndex >= len(m.fields) {
+		return m, nil
+	}
+	fs := &m.fields[m.focusedIndex]
+
+	var cmd tea.Cmd
+	switch fs.config.Type {
+	case FieldTypeText:
+		fs.textInput, cmd = fs.textInput.Update(msg)
+		return m, cmd
+
+	case FieldTypeTextArea:
+		if fs.textArea.Mode() != vimtextarea.ModeInsert {
+			fs.textArea.SetMode(vimtextarea.ModeInsert)
+		}
+		fs.textArea, cmd = fs.textArea.Update(msg)
+		return m, cmd
+
+	case FieldTypeEditableList:
+		lines := pastedLines(msg.Runes)
+		if len(lines) > 1 {
+			for _, line := range lines {
+				m.addEditableListItem(fs, line)
+			}
+			return m, nil
+		}
+		if len(lines) == 0 {
+			return m, nil
+		}
+		if fs.subFocus != SubFocusInput {
+			fs.subFocus = SubFocusInput
+			fs.addInput.Focus()
+		}
+		fs.addInput, cmd = fs.addInput.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(lines[0]), Paste: true})
+		return m, cmd
+
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
+		if !fs.searchExpanded {
+			fs.searchExpanded = true
+			fs.searchInput.SetValue("")
+			fs.searchInput.Focus()
+			fs.listCursor = 0
+			fs.scrollOffset = 0
+		}
+		fs.searchInput, cmd = fs.searchInput.Update(msg)
+		m = m.updateSearchFilter(fs)
+		return m, cmd
+
+	case FieldTypeEpicSearch:
+		if !fs.epicSearchExpanded {
+			return m.expandEpicSearch(fs, strings.Join(pastedLines(msg.Runes), " "))
+		}
+		return m.handleKeyForEpicSearch(msg, fs)
+	}
+
+	return m, nil
+}
+
+// pastedLines splits pasted text into its non-blank lines, trimmed.
+func pastedLines(runes []rune) []string {
+	text := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(string(runes))
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
 // handleKeyForEditableList processes keyboard input for editable list fields.
 // The editable list has two sub-sections: list and input.
 // Navigation rules:
@@ -1023,13 +1367,17 @@ func (m Model) handleKeyForEditableList(msg tea.KeyMsg, fs *fieldState) (Model,
 		if fs.subFocus == SubFocusInput {
 			// Add item to list
 			value := strings.TrimSpace(fs.addInput.Value())
-			if value != "" && (fs.config.AllowDuplicates || !m.listContains(fs, value)) {
-				fs.listItems = append(fs.listItems, listItem{
-					label:    value,
-					value:    value,
-					selected: true, // New items start selected
-				})
+			if normalize := fs.config.NormalizeItem; normalize != nil && value != "" {
+				normalized, err := normalize(value)
+				if err != nil {
+					m.validationError = err.Error()
+					return m, nil
+				}
+				value = normalized
+			}
+			if m.addEditableListItem(fs, value) {
 				fs.addInput.SetValue("")
+				m.validationError = ""
 			}
 			return m, nil
 		}
@@ -1060,6 +1408,10 @@ func (m Model) handleKeyForSearchSelect(msg tea.KeyMsg, fs *fieldState) (Model,
 		case key.Matches(msg, keys.Component.ShiftTab), msg.Type == tea.KeyUp, key.Matches(msg, keys.Component.Prev), msg.String() == "k":
 			return m.prevField(), m.blinkCmd()
 		case key.Matches(msg, keys.Common.Enter):
+			// Retry a failed async load instead of expanding an empty list
+			if fs.asyncError != nil {
+				return m, m.reloadOptions(m.focusedIndex)
+			}
 			// Expand to show search + list
 			fs.searchExpanded = true
 			fs.searchInput.SetValue("")
@@ -1113,15 +1465,13 @@ func (m Model) handleKeyForSearchSelect(msg tea.KeyMsg, fs *fieldState) (Model,
 		return m, nil
 
 	case key.Matches(msg, keys.Common.Enter):
-		// Enter selects current item and collapses
+		// Enter selects current item and collapses; multi-select toggles it
+		// and stays open so several items can be picked
 		if len(fs.searchFiltered) > 0 {
-			// Deselect all first
-			for i := range fs.listItems {
-				fs.listItems[i].selected = false
+			fs.chooseSearchItem(fs.searchFiltered[fs.listCursor])
+			if fs.config.MultiSelect {
+				return m, nil
 			}
-			// Select current
-			actualIdx := fs.searchFiltered[fs.listCursor]
-			fs.listItems[actualIdx].selected = true
 		}
 		// Collapse back to showing selected value
 		fs.searchExpanded = false
@@ -1137,6 +1487,60 @@ func (m Model) handleKeyForSearchSelect(msg tea.KeyMsg, fs *fieldState) (Model,
 	}
 }
 
+// loadOptionsCmd returns a command that runs the LoadOptions of the async
+// select at fieldIndex and animates its spinner until the result arrives.
+func (m Model) loadOptionsCmd(fieldIndex int) tea.Cmd {
+	fs := &m.fields[fieldIndex]
+	load := fs.config.LoadOptions
+	loadID := fs.asyncLoadID
+	return tea.Batch(
+		func() tea.Msg {
+			opts, err := load()
+			return asyncOptionsMsg{fieldIndex: fieldIndex, options: opts, err: err, loadID: loadID}
+		},
+		asyncSpinnerTick(fieldIndex, loadID),
+	)
+}
+
+// reloadOptions starts loading the options of the async select at fieldIndex
+// again, discarding the results of any load still running.
+func (m *Model) reloadOptions(fieldIndex int) tea.Cmd {
+	fs := &m.fields[fieldIndex]
+	if fs.config.LoadOptions == nil {
+		return nil
+	}
+	fs.asyncLoadID++
+	fs.asyncLoading = true
+	fs.asyncError = nil
+	return m.loadOptionsCmd(fieldIndex)
+}
+
+// handleAsyncOptions applies the result of an async select load. Stale
+// results from an earlier load are discarded.
+func (m Model) handleAsyncOptions(msg asyncOptionsMsg) Model {
+	if msg.fieldIndex < 0 || msg.fieldIndex >= len(m.fields) {
+		return m
+	}
+	fs := &m.fields[msg.fieldIndex]
+	if fs.config.Type != FieldTypeAsyncSelect || fs.asyncLoadID != msg.loadID {
+		return m
+	}
+	fs.asyncLoading = false
+	if msg.err != nil {
+		fs.asyncError = msg.err
+		return m
+	}
+	fs.setLoadedOptions(msg.options)
+	return m.updateSearchFilter(fs)
+}
+
+// asyncSpinnerTick returns a command that advances an async select's spinner.
+func asyncSpinnerTick(fieldIndex, loadID int) tea.Cmd {
+	return tea.Tick(80*time.Millisecond, func(time.Time) tea.Msg {
+		return asyncSpinnerTickMsg{fieldIndex: fieldIndex, loadID: loadID}
+	})
+}
+
 // handleKeyForEpicSearch processes keyboard input for epic search fields.
 // The field has two states:
 //   - Collapsed (with selection): Shows selected epic, Enter clears selection and expands
@@ -1158,6 +1562,10 @@ func (m Model) handleKeyForEpicSearch(msg tea.KeyMsg, fs *fieldState) (Model, te
 		default:
 			// If no selection, typing immediately expands and starts search with that character
 			if fs.epicSelectedID == "" && msg.Type == tea.KeyRunes && len(msg.Runes) > 0 {
+				if msg.Paste {
+					// Pasted text may span lines; search for it as one line
+					return m.expandEpicSearch(fs, strings.Join(pastedLines(msg.Runes), " "))
+				}
 				return m.expandEpicSearch(fs, string(msg.Runes))
 			}
 		}
@@ -1396,6 +1804,39 @@ func (m Model) handleKeyForTextArea(msg tea.KeyMsg, fs *fieldState) (Model, tea.
 	return m, cmd
 }
 
+// handleKeyForDateTime handles keyboard input for date and duration fields.
+// Left/Right (h/l) pick a segment, Up/Down (k/j) step it, and digits type into it.
+func (m Model) handleKeyForDateTime(msg tea.KeyMsg, fs *fieldState) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Component.Tab), key.Matches(msg, keys.Component.Next):
+		m = m.nextField()
+		return m, m.blinkCmd()
+
+	case key.Matches(msg, keys.Component.ShiftTab), key.Matches(msg, keys.Component.Prev):
+		m = m.prevField()
+		return m, m.blinkCmd()
+
+	case key.Matches(msg, keys.Common.Enter):
+		return m.handleEnter()
+
+	case key.Matches(msg, keys.Common.Left):
+		fs.moveSegment(-1)
+
+	case key.Matches(msg, keys.Common.Right):
+		fs.moveSegment(1)
+
+	case key.Matches(msg, keys.Common.Up):
+		fs.stepSegment(1)
+
+	case key.Matches(msg, keys.Common.Down):
+		fs.stepSegment(-1)
+
+	case msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && msg.Runes[0] >= '0' && msg.Runes[0] <= '9':
+		fs.typeDigit(msg.Runes[0])
+	}
+	return m, nil
+}
+
 // handleMouseMsg processes mouse events for scrolling.
 // calculateMaxBodyHeight returns the maximum height available for the body content.
 // This accounts for title, header, buttons, and modal chrome.
@@ -1477,7 +1918,7 @@ func (m *Model) handleFieldClick(msg tea.MouseMsg) bool {
 			m.focusField(i)
 
 			// Special handling for SearchSelect: toggle expanded state on click
-			if fs.config.Type == FieldTypeSearchSelect {
+			if fs.isSearchSelect() {
 				if fs.searchExpanded {
 					// Clicking when expanded collapses it
 					fs.searchExpanded = false
@@ -1550,7 +1991,7 @@ func (m *Model) handleItemClick(msg tea.MouseMsg) bool {
 				}
 			}
 
-		case FieldTypeSearchSelect:
+		case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 			// Only handle clicks when expanded
 			if fs.searchExpanded {
 				for itemIdx := range fs.listItems {
@@ -1560,11 +2001,10 @@ func (m *Model) handleItemClick(msg tea.MouseMsg) bool {
 					for rowIdx := range 10 {
 						zoneID := makeItemRowZoneID(fieldIdx, itemIdx, rowIdx)
 						if z := zone.Get(zoneID); z != nil && z.InBounds(msg) {
-							// Deselect all and select clicked item
-							for i := range fs.listItems {
-								fs.listItems[i].selected = false
+							fs.chooseSearchItem(itemIdx)
+							if fs.config.MultiSelect {
+								return true
 							}
-							fs.listItems[itemIdx].selected = true
 							// Collapse the search
 							fs.searchExpanded = false
 							fs.searchInput.Blur()
@@ -1603,7 +2043,7 @@ func (m *Model) blurCurrentField() {
 		fs.textInput.Blur()
 	case FieldTypeTextArea:
 		fs.textArea.Blur()
-	case FieldTypeSearchSelect:
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 		fs.searchInput.Blur()
 	case FieldTypeEditableList:
 		fs.addInput.Blur()
@@ -1621,7 +2061,7 @@ func (m *Model) focusField(index int) {
 		fs.textInput.Focus()
 	case FieldTypeTextArea:
 		fs.textArea.Focus()
-	case FieldTypeSearchSelect:
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 		// Don't auto-expand, just focus
 		if fs.searchExpanded {
 			fs.searchInput.Focus()
@@ -1629,6 +2069,8 @@ func (m *Model) focusField(index int) {
 	case FieldTypeEditableList:
 		// Focus list section by default
 		fs.subFocus = SubFocusList
+	case FieldTypeDate, FieldTypeDuration:
+		fs.typed = ""
 	}
 }
 
@@ -1654,10 +2096,7 @@ func (m *Model) handleButtonClick(msg tea.MouseMsg) tea.Cmd {
 		m.focusedIndex = -1
 		m.focusedButton = 1
 		// Trigger cancel
-		if m.config.OnCancel != nil {
-			return func() tea.Msg { return m.config.OnCancel() }
-		}
-		return func() tea.Msg { return CancelMsg{} }
+		return m.cancelCmd()
 	}
 
 	return nil
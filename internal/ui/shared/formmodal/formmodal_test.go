@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	zone "github.com/lrstanley/bubblezone"
@@ -15,6 +16,8 @@ import (
 	beads "github.com/zjrosen/perles/internal/beads/domain"
 	"github.com/zjrosen/perles/internal/ui/shared/colorpicker"
 	"github.com/zjrosen/perles/internal/ui/shared/editor"
+	"github.com/zjrosen/perles/internal/ui/shared/vimtextarea"
+	"github.com/zjrosen/perles/internal/ui/styles"
 )
 
 func TestMain(m *testing.M) {
@@ -1643,6 +1646,19 @@ func TestColorField_DefaultColor(t *testing.T) {
 	require.Equal(t, "#73F59F", values["color"], "expected default color")
 }
 
+func TestColorField_DefaultColorFollowsTheme(t *testing.T) {
+	require.NoError(t, styles.ApplyTheme(styles.ThemeConfig{Preset: "light"}))
+	t.Cleanup(func() { _ = styles.ApplyTheme(styles.ThemeConfig{}) })
+
+	m := New(FormConfig{
+		Title:  "Test Form",
+		Fields: []FieldConfig{{Key: "color", Type: FieldTypeColor, Label: "Color"}},
+	})
+
+	values := getValues(m)
+	require.Equal(t, styles.LightPreset.Colors[styles.TokenStatusSuccess], values["color"])
+}
+
 func TestColorField_SubmitIncludesColor(t *testing.T) {
 	cfg := FormConfig{
 		Title: "Test Form",
@@ -2128,6 +2144,42 @@ func TestEditableListField_AddItem(t *testing.T) {
 	require.Empty(t, m.fields[0].addInput.Value())
 }
 
+func TestEditableListField_AddItem_NormalizeItem(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:  "tags",
+				Type: FieldTypeEditableList,
+				NormalizeItem: func(value string) (string, error) {
+					if strings.Contains(value, " ") {
+						return "", errors.New("no spaces allowed")
+					}
+					return strings.ToLower(value), nil
+				},
+			},
+		},
+	}
+	m := New(cfg).SetSize(80, 24)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	// Rejected values stay in the input and show the error
+	for _, r := range "a b" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Empty(t, m.fields[0].listItems)
+	require.Equal(t, "a b", m.fields[0].addInput.Value())
+	require.Contains(t, m.View(), "no spaces allowed")
+
+	// Accepted values are stored normalized and clear the error
+	m.fields[0].addInput.SetValue("NewItem")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Len(t, m.fields[0].listItems, 1)
+	require.Equal(t, "newitem", m.fields[0].listItems[0].value)
+	require.NotContains(t, m.View(), "no spaces allowed")
+}
+
 func TestEditableListField_AddItem_TrimWhitespace(t *testing.T) {
 	cfg := FormConfig{
 		Title: "Test Form",
@@ -2739,6 +2791,23 @@ func TestTextAreaField_TabMovesToNextField(t *testing.T) {
 	require.Equal(t, 1, m.focusedIndex, "Tab should move to next field")
 }
 
+func TestTextAreaField_SharesRegistersAcrossFields(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "summary", Type: FieldTypeTextArea, Label: "Summary"},
+			{Key: "name", Type: FieldTypeText, Label: "Name"},
+			{Key: "description", Type: FieldTypeTextArea, Label: "Description"},
+		},
+	}
+	m := New(cfg)
+
+	registers := m.fields[0].textArea.Registers()
+	require.NotNil(t, registers)
+	require.Same(t, registers, m.fields[2].textArea.Registers(), "text areas of one form share registers")
+	require.NotSame(t, registers, New(cfg).fields[0].textArea.Registers(), "forms don't share registers")
+}
+
 func TestTextAreaField_ShiftTabMovesToPrevField(t *testing.T) {
 	cfg := FormConfig{
 		Title: "Test Form",
@@ -5255,3 +5324,604 @@ func TestGolden_SingleColumn_80x40(t *testing.T) {
 	m := New(cfg).SetSize(80, 40) // Below threshold, should collapse to single-column
 	compareGolden(t, "singlecolumn_80x40", m.View())
 }
+
+// --- Paste Tests ---
+
+// pasteMsg builds a bracketed paste key message.
+func pasteMsg(text string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text), Paste: true}
+}
+
+func TestPaste_TextFieldInsertsWithoutNavigating(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "name", Type: FieldTypeText, Label: "Name"},
+			{Key: "other", Type: FieldTypeText, Label: "Other"},
+		},
+	}
+	m := New(cfg)
+
+	m, _ = m.Update(pasteMsg("j\tk line one\nline two"))
+
+	require.Equal(t, 0, m.focusedIndex, "paste should not move focus")
+	require.Equal(t, "j k line one line two", m.fields[0].textInput.Value())
+}
+
+func TestPaste_TextAreaKeepsNewlines(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "desc", Type: FieldTypeTextArea, Label: "Description", VimEnabled: true},
+			{Key: "other", Type: FieldTypeText, Label: "Other"},
+		},
+	}
+	m := New(cfg)
+
+	// Switch to Normal mode so a plain keystroke would run a vim command
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	require.Equal(t, vimtextarea.ModeNormal, m.fields[0].textArea.Mode())
+
+	m, _ = m.Update(pasteMsg("dd\r\nsecond"))
+
+	require.Equal(t, 0, m.focusedIndex)
+	require.Equal(t, "dd\nsecond", m.fields[0].textArea.Value())
+}
+
+func TestPaste_EditableListSplitsLinesIntoItems(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:     "tags",
+				Type:    FieldTypeEditableList,
+				Options: []ListOption{{Label: "one", Value: "one", Selected: true}},
+			},
+		},
+	}
+	m := New(cfg)
+
+	m, _ = m.Update(pasteMsg("two\n\n  three \r\none\nfour\n"))
+
+	require.Equal(t, 0, m.focusedIndex)
+	require.Equal(t, SubFocusList, m.fields[0].subFocus)
+	require.Equal(t, []string{"one", "two", "three", "four"}, getValues(m)["tags"], "blank lines and duplicates are skipped")
+	require.Empty(t, m.fields[0].addInput.Value())
+}
+
+func TestPaste_EditableListSingleLineGoesToInput(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:     "tags",
+				Type:    FieldTypeEditableList,
+				Options: []ListOption{{Label: "one", Value: "one"}},
+			},
+		},
+	}
+	m := New(cfg)
+	require.Equal(t, SubFocusList, m.fields[0].subFocus)
+
+	m, _ = m.Update(pasteMsg("j k\n"))
+
+	require.Equal(t, SubFocusInput, m.fields[0].subFocus, "paste should focus the add-item input")
+	require.Equal(t, "j k", m.fields[0].addInput.Value())
+	require.Len(t, m.fields[0].listItems, 1)
+	require.False(t, m.fields[0].listItems[0].selected, "paste should not toggle list items")
+}
+
+func TestPaste_SearchSelectExpandsAndFilters(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:  "model",
+				Type: FieldTypeSearchSelect,
+				Options: []ListOption{
+					{Label: "alpha", Value: "a"},
+					{Label: "beta", Value: "b"},
+				},
+			},
+		},
+	}
+	m := New(cfg)
+	require.False(t, m.fields[0].searchExpanded)
+
+	m, _ = m.Update(pasteMsg("bet"))
+
+	require.True(t, m.fields[0].searchExpanded)
+	require.Equal(t, "bet", m.fields[0].searchInput.Value())
+	require.Equal(t, []int{1}, m.fields[0].searchFiltered)
+}
+
+func TestPaste_EpicSearchJoinsLinesButKeepsTypedRunes(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:                "epic",
+				Type:               FieldTypeEpicSearch,
+				Label:              "Epic",
+				EpicSearchExecutor: &mockBQLExecutor{},
+			},
+		},
+	}
+	m := New(cfg)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.False(t, m.fields[0].epicSearchExpanded, "precondition: should be collapsed")
+
+	// A typed rune goes through the plain key path, whitespace included
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	require.True(t, m.fields[0].epicSearchExpanded)
+	require.Equal(t, " ", m.fields[0].searchInput.Value())
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.False(t, m.fields[0].epicSearchExpanded, "precondition: should be collapsed")
+
+	m, _ = m.Update(pasteMsg("auth\n  rework\n"))
+	require.True(t, m.fields[0].epicSearchExpanded)
+	require.Equal(t, "auth rework", m.fields[0].searchInput.Value())
+}
+
+func TestPaste_IgnoredOnNonTextFields(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{
+				Key:  "items",
+				Type: FieldTypeList,
+				Options: []ListOption{
+					{Label: "one", Value: "1"},
+					{Label: "two", Value: "2"},
+				},
+			},
+		},
+	}
+	m := New(cfg)
+
+	m, _ = m.Update(pasteMsg("j \n"))
+
+	require.Equal(t, 0, m.focusedIndex)
+	require.Equal(t, 0, m.fields[0].listCursor)
+	require.Empty(t, getValues(m)["items"])
+}
+
+// --- Dirty Tracking Tests ---
+
+func dirtyTrackingConfig() FormConfig {
+	return FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "name", Type: FieldTypeText, Label: "Name", InitialValue: "test"},
+			{
+				Key:         "tags",
+				Type:        FieldTypeList,
+				Label:       "Tags",
+				MultiSelect: true,
+				Options:     []ListOption{{Label: "a", Value: "a"}, {Label: "b", Value: "b"}},
+			},
+		},
+		ConfirmDiscard: true,
+	}
+}
+
+func TestChangedFields_TracksModifiedFields(t *testing.T) {
+	m := New(dirtyTrackingConfig())
+	require.False(t, m.IsDirty())
+	require.Nil(t, m.ChangedFields())
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	require.Equal(t, []string{"name"}, m.ChangedFields())
+
+	// Toggle a tag on and off again - back to the initial (empty) selection
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	require.Equal(t, []string{"name", "tags"}, m.ChangedFields())
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	require.Equal(t, []string{"name"}, m.ChangedFields())
+
+	// Restoring the text clears the name change
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	require.False(t, m.IsDirty())
+}
+
+func TestSubmit_IncludesChangedFields(t *testing.T) {
+	cfg := dirtyTrackingConfig()
+	m := New(cfg)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	submitMsg, ok := cmd().(SubmitMsg)
+	require.True(t, ok)
+	require.Equal(t, []string{"name"}, submitMsg.ChangedFields)
+
+	// OnSubmitChanges takes precedence over OnSubmit
+	type changesMsg struct{ changed []string }
+	cfg.OnSubmit = func(map[string]any) tea.Msg { return CancelMsg{} }
+	cfg.OnSubmitChanges = func(_ map[string]any, changed []string) tea.Msg { return changesMsg{changed} }
+	m = New(cfg)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.Equal(t, changesMsg{[]string{"name"}}, cmd())
+}
+
+func TestConfirmDiscard_CleanFormCancelsImmediately(t *testing.T) {
+	m := New(dirtyTrackingConfig())
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.False(t, m.showDiscardModal)
+	require.NotNil(t, cmd)
+	require.IsType(t, CancelMsg{}, cmd())
+}
+
+func TestConfirmDiscard_EscWithChangesAsksFirst(t *testing.T) {
+	m := New(dirtyTrackingConfig()).SetSize(100, 40)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.Nil(t, cmd, "Esc with unsaved changes must not cancel")
+	require.True(t, m.showDiscardModal)
+	require.Contains(t, m.Overlay(""), "Unsaved Changes")
+
+	// Enter on the default button keeps editing
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Nil(t, cmd)
+	require.False(t, m.showDiscardModal)
+	require.Equal(t, "test!", getValues(m)["name"])
+
+	// Esc in the dialog also keeps editing
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.Nil(t, cmd)
+	require.False(t, m.showDiscardModal)
+
+	// Discard closes the form
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.False(t, m.showDiscardModal)
+	require.NotNil(t, cmd)
+	require.IsType(t, CancelMsg{}, cmd())
+}
+
+func TestConfirmDiscard_DisabledByDefault(t *testing.T) {
+	cfg := dirtyTrackingConfig()
+	cfg.ConfirmDiscard = false
+	m := New(cfg)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	require.IsType(t, CancelMsg{}, cmd())
+}
+
+// --- Dependent Field Tests ---
+
+func reviewOptionsConfig() FormConfig {
+	return FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "scope", Type: FieldTypeToggle, Label: "Scope", Options: []ListOption{
+				{Label: "Small", Value: "small"},
+				{Label: "Large", Value: "large"},
+			}},
+			{Key: "review", Type: FieldTypeSelect, Label: "Review", OptionsFrom: func(values map[string]any) []ListOption {
+				opts := []ListOption{{Label: "Async", Value: "async", Selected: true}}
+				if values["scope"] == "large" {
+					opts = append(opts, ListOption{Label: "Pair", Value: "pair"})
+				}
+				return opts
+			}},
+		},
+	}
+}
+
+func TestOptionsFrom_InitialOptions(t *testing.T) {
+	m := New(reviewOptionsConfig()).SetSize(80, 24)
+
+	require.Equal(t, "async", getValues(m)["review"])
+	require.Contains(t, m.View(), "Async")
+	require.NotContains(t, m.View(), "Pair")
+	require.False(t, m.IsDirty(), "computed options are part of the initial state")
+}
+
+func TestOptionsFrom_FollowsOtherField(t *testing.T) {
+	m := New(reviewOptionsConfig()).SetSize(80, 24)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	require.Contains(t, m.View(), "Pair")
+	require.Equal(t, "async", getValues(m)["review"], "selection survives while still offered")
+
+	// Select "Pair", then shrink the scope so it is no longer offered
+	m.fields[1].listItems[0].selected = false
+	m.fields[1].listItems[1].selected = true
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	require.NotContains(t, m.View(), "Pair")
+	require.Equal(t, "async", getValues(m)["review"], "falls back to the default selection")
+}
+
+func TestOptionsFrom_KeepsEditableListAdditions(t *testing.T) {
+	fs := newFieldState(FieldConfig{Key: "labels", Type: FieldTypeEditableList, Options: []ListOption{{Label: "a", Value: "a"}}})
+	fs.listItems = append(fs.listItems, listItem{label: "custom", value: "custom", selected: true})
+
+	require.True(t, fs.setOptions([]ListOption{{Label: "b", Value: "b"}}))
+	require.Equal(t, []string{"custom"}, fs.value())
+	require.False(t, fs.setOptions([]ListOption{{Label: "b", Value: "b"}}), "unchanged options are a no-op")
+}
+
+func TestOptionsFrom_ToggleKeepsSelectedValue(t *testing.T) {
+	fs := newFieldState(FieldConfig{Key: "mode", Type: FieldTypeToggle, InitialToggleIndex: 1, Options: []ListOption{
+		{Label: "A", Value: "a"}, {Label: "B", Value: "b"},
+	}})
+
+	fs.setOptions([]ListOption{{Label: "B", Value: "b"}, {Label: "C", Value: "c"}})
+	require.Equal(t, "b", fs.value())
+}
+
+func TestVisibleWhen_FocusLeavesHiddenField(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "first", Type: FieldTypeText, Label: "First"},
+			// Hides itself once it contains "!", so the focused field disappears
+			{Key: "code", Type: FieldTypeText, Label: "Code", VisibleWhen: func(values map[string]any) bool {
+				v, _ := values["code"].(string)
+				return !strings.Contains(v, "!")
+			}},
+			{Key: "third", Type: FieldTypeText, Label: "Third"},
+		},
+	}
+	m := New(cfg).SetSize(80, 24)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab})
+	require.Equal(t, 1, m.focusedIndex)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	require.Equal(t, 2, m.focusedIndex)
+	require.True(t, m.fields[2].textInput.Focused())
+	require.False(t, m.fields[1].textInput.Focused())
+	require.NotContains(t, m.View(), "Code")
+}
+
+func TestVisibleWhen_FocusFallsBackToButtons(t *testing.T) {
+	cfg := FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "code", Type: FieldTypeText, Label: "Code", VisibleWhen: func(values map[string]any) bool {
+				v, _ := values["code"].(string)
+				return v != "x"
+			}},
+		},
+	}
+	m := New(cfg).SetSize(80, 24)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	require.Equal(t, -1, m.focusedIndex)
+	require.Equal(t, 0, m.focusedButton)
+}
+
+// --- Async Select Tests ---
+
+func asyncSelectConfig(load func() ([]ListOption, error)) FormConfig {
+	return FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "worker", Type: FieldTypeAsyncSelect, Label: "Worker", LoadOptions: load, InitialValue: "w2"},
+		},
+	}
+}
+
+// deliver runs cmd and feeds the messages it produces back into the model,
+// descending into batches. Commands returned by Update are not followed.
+func deliver(m Model, cmd tea.Cmd) Model {
+	if cmd == nil {
+		return m
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			m = deliver(m, c)
+		}
+		return m
+	}
+	m, _ = m.Update(msg)
+	return m
+}
+
+func TestAsyncSelect_LoadsOptionsOnInit(t *testing.T) {
+	m := New(asyncSelectConfig(func() ([]ListOption, error) {
+		return []ListOption{{Label: "Worker 1", Value: "w1"}, {Label: "Worker 2", Value: "w2"}}, nil
+	})).SetSize(80, 24)
+
+	require.Contains(t, m.View(), "Loading...")
+	require.Equal(t, "w2", getValues(m)["worker"], "initial value stands in while loading")
+
+	m = deliver(m, m.Init())
+	require.NotContains(t, m.View(), "Loading...")
+	require.Contains(t, m.View(), "Worker 2", "option matching InitialValue is preselected")
+	require.Equal(t, "w2", getValues(m)["worker"])
+	require.False(t, m.IsDirty())
+}
+
+func TestAsyncSelect_TypeaheadFilters(t *testing.T) {
+	m := New(asyncSelectConfig(func() ([]ListOption, error) {
+		return []ListOption{{Label: "Alpha", Value: "a"}, {Label: "Beta", Value: "b"}, {Label: "Gamma", Value: "g"}}, nil
+	})).SetSize(80, 24)
+	m = deliver(m, m.Init())
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	for _, r := range "ga" {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	require.Equal(t, []int{2}, m.fields[0].searchFiltered)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, "g", getValues(m)["worker"])
+}
+
+func TestAsyncSelect_ErrorShownInlineAndRetried(t *testing.T) {
+	calls := 0
+	m := New(asyncSelectConfig(func() ([]ListOption, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("connection refused")
+		}
+		return []ListOption{{Label: "Worker 2", Value: "w2"}}, nil
+	})).SetSize(80, 24)
+
+	m = deliver(m, m.Init())
+	view := m.View()
+	require.Contains(t, view, "Error: connection refused")
+	require.Contains(t, view, "enter to retry")
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Contains(t, m.View(), "Loading...")
+	m = deliver(m, cmd)
+	require.Equal(t, 2, calls)
+	require.NotContains(t, m.View(), "Error:")
+	require.Contains(t, m.View(), "Worker 2")
+}
+
+func TestAsyncSelect_DiscardsStaleResults(t *testing.T) {
+	m := New(asyncSelectConfig(func() ([]ListOption, error) { return nil, nil })).SetSize(80, 24)
+	m.fields[0].asyncLoadID = 2
+
+	m, _ = m.Update(asyncOptionsMsg{fieldIndex: 0, loadID: 1, options: []ListOption{{Label: "Old", Value: "old"}}})
+	require.True(t, m.fields[0].asyncLoading)
+	require.Empty(t, m.fields[0].listItems)
+}
+
+func TestAsyncSelect_MultiSelectTogglesAndStaysOpen(t *testing.T) {
+	m := New(FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{{
+			Key: "deps", Type: FieldTypeAsyncSelect, Label: "Deps", MultiSelect: true,
+			InitialValues: []string{"b"},
+			LoadOptions: func() ([]ListOption, error) {
+				return []ListOption{{Label: "Alpha", Value: "a"}, {Label: "Beta", Value: "b"}, {Label: "Gamma", Value: "g"}}, nil
+			},
+		}},
+	}).SetSize(80, 24)
+
+	require.Equal(t, []string{"b"}, getValues(m)["deps"], "initial values stand in while loading")
+	m = deliver(m, m.Init())
+	require.Equal(t, []string{"b"}, getValues(m)["deps"])
+	require.False(t, m.IsDirty())
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // expand, cursor on Beta
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // deselect Beta
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // select Gamma
+	require.True(t, m.fields[0].searchExpanded, "multi-select stays open after Enter")
+	require.Contains(t, m.View(), "[x] Gamma")
+	require.Contains(t, m.View(), "[ ] Beta")
+	require.Equal(t, []string{"g"}, getValues(m)["deps"])
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.False(t, m.fields[0].searchExpanded)
+	require.Contains(t, m.View(), "Gamma")
+	require.NotContains(t, m.View(), "Beta")
+}
+
+// --- Date/Duration Field Tests ---
+
+func keyRune(r rune) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}}
+}
+
+func TestDateField_StepsSegments(t *testing.T) {
+	m := New(FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "due", Type: FieldTypeDate, Label: "Due", InitialDate: time.Date(2026, time.January, 31, 15, 4, 0, 0, time.Local)},
+		},
+	})
+	require.Equal(t, time.Date(2026, time.January, 31, 0, 0, 0, 0, time.Local), getValues(m)["due"], "time of day is dropped")
+
+	// Month up clamps the day to the end of February
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m, _ = m.Update(keyRune('k'))
+	require.Equal(t, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.Local), getValues(m)["due"])
+
+	// Month down from January carries into the year
+	m, _ = m.Update(keyRune('j'))
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	require.Equal(t, time.Date(2025, time.December, 28, 0, 0, 0, 0, time.Local), getValues(m)["due"])
+
+	// Day up rolls into the next month
+	m, _ = m.Update(keyRune('l'))
+	for range 4 {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	}
+	require.Equal(t, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local), getValues(m)["due"])
+}
+
+func TestDateField_TypedDigits(t *testing.T) {
+	m := New(FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "due", Type: FieldTypeDate, Label: "Due", InitialDate: time.Date(2026, time.March, 15, 0, 0, 0, 0, time.Local)},
+		},
+	})
+
+	for _, r := range "20270230" {
+		m, _ = m.Update(keyRune(r))
+	}
+	require.Equal(t, time.Date(2027, time.February, 28, 0, 0, 0, 0, time.Local), getValues(m)["due"], "day is clamped to the month")
+	require.Equal(t, segmentDay, m.fields[0].segment, "focus stays on the last segment")
+}
+
+func TestDateField_BoundsClampStepsAndFailValidation(t *testing.T) {
+	minDate := time.Date(2026, time.October, 17, 0, 0, 0, 0, time.Local)
+	m := New(FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "due", Type: FieldTypeDate, Label: "Due", InitialDate: minDate, MinDate: minDate},
+		},
+	})
+
+	m, _ = m.Update(keyRune('j'))
+	require.Equal(t, minDate, getValues(m)["due"], "stepping stops at MinDate")
+
+	for _, r := range "2025" {
+		m, _ = m.Update(keyRune(r))
+	}
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.Nil(t, cmd)
+	require.Equal(t, "Due must be on or after 2026-10-17", m.validationError)
+}
+
+func TestDurationField_EditsAndSubmits(t *testing.T) {
+	m := New(FormConfig{
+		Title: "Test Form",
+		Fields: []FieldConfig{
+			{Key: "estimate", Type: FieldTypeDuration, Label: "Estimate", InitialDuration: 90 * time.Minute, DurationStep: 15 * time.Minute, MaxDuration: 8 * time.Hour},
+		},
+	}).SetSize(80, 24)
+	require.Contains(t, m.View(), "1h 30m")
+
+	m, _ = m.Update(keyRune('k'))
+	m, _ = m.Update(keyRune('l'))
+	m, _ = m.Update(keyRune('k'))
+	require.Equal(t, 2*time.Hour+45*time.Minute, getValues(m)["estimate"])
+
+	m, _ = m.Update(keyRune('h'))
+	for range 5 {
+		m, _ = m.Update(keyRune('j'))
+	}
+	require.Equal(t, time.Duration(0), getValues(m)["estimate"], "never goes below zero")
+
+	m, _ = m.Update(keyRune('9'))
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.Nil(t, cmd)
+
+	m, _ = m.Update(keyRune('h'))
+	m, _ = m.Update(keyRune('k'))
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd, "typed 9h fails MaxDuration, but stepping clamps to 8h")
+	submit, ok := cmd().(SubmitMsg)
+	require.True(t, ok)
+	require.Equal(t, 8*time.Hour, submit.Values["estimate"])
+}
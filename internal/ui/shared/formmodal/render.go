@@ -3,6 +3,7 @@ package formmodal
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	zone "github.com/lrstanley/bubblezone"
@@ -433,7 +434,7 @@ func (m Model) renderField(index int, width int) string {
 		rendered = m.renderToggleField(fs, index, width, focused)
 		return zone.Mark(fieldZoneID, rendered)
 
-	case FieldTypeSearchSelect:
+	case FieldTypeSearchSelect, FieldTypeAsyncSelect:
 		rendered = m.renderSearchSelectField(fs, index, width, focused)
 		return zone.Mark(fieldZoneID, rendered)
 
@@ -444,6 +445,10 @@ func (m Model) renderField(index int, width int) string {
 	case FieldTypeEpicSearch:
 		rendered = m.renderEpicSearchField(fs, index, width, focused)
 		return zone.Mark(fieldZoneID, rendered)
+
+	case FieldTypeDate, FieldTypeDuration:
+		rendered = m.renderDateTimeField(fs, width, focused)
+		return zone.Mark(fieldZoneID, rendered)
 	}
 
 	return ""
@@ -559,6 +564,49 @@ func (m Model) renderToggleField(fs *fieldState, fieldIndex int, width int, focu
 	})
 }
 
+// renderDateTimeField renders a date ("2026-10-17") or duration ("2h 05m") field.
+// When focused, the segment being edited is highlighted.
+func (m Model) renderDateTimeField(fs *fieldState, width int, focused bool) string {
+	cfg := fs.config
+
+	var segments, separators []string
+	if cfg.Type == FieldTypeDate {
+		y, mo, d := fs.date.Date()
+		segments = []string{fmt.Sprintf("%04d", y), fmt.Sprintf("%02d", int(mo)), fmt.Sprintf("%02d", d)}
+		separators = []string{"-", "-", ""}
+	} else {
+		segments = []string{fmt.Sprintf("%d", fs.duration/time.Hour), fmt.Sprintf("%02d", fs.duration%time.Hour/time.Minute)}
+		separators = []string{"h ", "m"}
+	}
+
+	valueStyle := lipgloss.NewStyle().Foreground(styles.TextPrimaryColor)
+	segmentStyle := valueStyle.Background(styles.SelectionBackgroundColor).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+
+	var row strings.Builder
+	row.WriteString(" ")
+	for i, seg := range segments {
+		if focused && i == fs.segment {
+			row.WriteString(segmentStyle.Render(seg))
+		} else {
+			row.WriteString(valueStyle.Render(seg))
+		}
+		row.WriteString(valueStyle.Render(separators[i]))
+	}
+	if focused {
+		row.WriteString(hintStyle.Render(" [←/→ ↑/↓]"))
+	}
+
+	return styles.FormSection(styles.FormSectionConfig{
+		Content:            []string{row.String()},
+		Width:              width,
+		TopLeft:            cfg.Label,
+		TopLeftHint:        cfg.Hint,
+		Focused:            focused,
+		FocusedBorderColor: styles.BorderHighlightFocusColor,
+	})
+}
+
 // renderButtons renders the submit and cancel buttons.
 func (m Model) renderButtons() string {
 	onButtons := m.focusedIndex == -1
@@ -632,6 +680,11 @@ func (m *Model) Overlay(bg string) string {
 		result = m.colorPicker.Overlay(result)
 	}
 
+	// The unsaved-changes confirmation sits above everything else
+	if m.showDiscardModal {
+		result = m.discardModal.Overlay(result)
+	}
+
 	// Scan for zone markers to enable mouse click detection
 	return zone.Scan(result)
 }
@@ -654,15 +707,21 @@ func (m Model) renderSearchSelectField(fs *fieldState, fieldIndex int, width int
 func (m Model) renderSearchSelectCollapsed(fs *fieldState, width int, focused bool) string {
 	cfg := fs.config
 
-	// Find selected item
+	// Find selected item; multi-select lists every selected label instead
 	selectedLabel := "(none)"
 	selectedSubtext := ""
+	var selectedLabels []string
 	for _, item := range fs.listItems {
-		if item.selected {
-			selectedLabel = item.label
-			selectedSubtext = item.subtext
-			break
+		if !item.selected {
+			continue
 		}
+		if cfg.MultiSelect {
+			selectedLabels = append(selectedLabels, item.label)
+			continue
+		}
+		selectedLabel = item.label
+		selectedSubtext = item.subtext
+		break
 	}
 
 	// Calculate available width for label
@@ -677,7 +736,21 @@ func (m Model) renderSearchSelectCollapsed(fs *fieldState, width int, focused bo
 
 	// Build content rows
 	var rows []string
-	rows = append(rows, " "+displayLabel)
+	if status := renderAsyncStatus(fs, innerWidth); status != nil {
+		// Async options are still loading or failed to load
+		rows = append(rows, status...)
+		if fs.asyncError != nil {
+			hintStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor).Italic(true)
+			rows = append(rows, hintStyle.Render(" (enter to retry)"))
+		}
+		selectedSubtext = ""
+	} else if len(selectedLabels) > 0 {
+		for _, label := range selectedLabels {
+			rows = append(rows, " "+styles.TruncateString(label, availableWidth))
+		}
+	} else {
+		rows = append(rows, " "+displayLabel)
+	}
 
 	// Add wrapped subtext if present
 	if selectedSubtext != "" {
@@ -701,6 +774,22 @@ func (m Model) renderSearchSelectCollapsed(fs *fieldState, width int, focused bo
 	})
 }
 
+// renderAsyncStatus renders the loading spinner or load error of an async
+// select, or returns nil once its options are loaded.
+func renderAsyncStatus(fs *fieldState, innerWidth int) []string {
+	switch {
+	case fs.asyncLoading:
+		spinnerStyle := lipgloss.NewStyle().Foreground(styles.SpinnerColor)
+		loadingStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor).Italic(true)
+		return []string{" " + spinnerStyle.Render(spinnerFrames[fs.spinnerFrame]) + loadingStyle.Render(" Loading...")}
+	case fs.asyncError != nil:
+		errorStyle := lipgloss.NewStyle().Foreground(styles.StatusErrorColor)
+		msg := styles.TruncateString("Error: "+fs.asyncError.Error(), innerWidth-1)
+		return []string{errorStyle.Render(" " + msg)}
+	}
+	return nil
+}
+
 // renderSearchSelectExpanded renders the expanded state with search input and list.
 func (m Model) renderSearchSelectExpanded(fs *fieldState, fieldIndex int, width int, focused bool) string {
 	cfg := fs.config
@@ -724,7 +813,9 @@ func (m Model) renderSearchSelectExpanded(fs *fieldState, fieldIndex int, width
 	rows = append(rows, searchRow)
 	rows = append(rows, divider)
 
-	if len(fs.searchFiltered) == 0 {
+	if status := renderAsyncStatus(fs, innerWidth); status != nil {
+		rows = append(rows, status...)
+	} else if len(fs.searchFiltered) == 0 {
 		noMatchStyle := lipgloss.NewStyle().
 			Foreground(styles.TextMutedColor).
 			Italic(true)
@@ -742,7 +833,15 @@ func (m Model) renderSearchSelectExpanded(fs *fieldState, fieldIndex int, width
 			rowIdx := 0
 
 			// Label row - padded to full width for larger click target
-			displayLabel := styles.TruncateString(item.label, innerWidth-1)
+			label := item.label
+			if cfg.MultiSelect {
+				checkbox := "[ ] "
+				if item.selected {
+					checkbox = "[x] "
+				}
+				label = checkbox + label
+			}
+			displayLabel := styles.TruncateString(label, innerWidth-1)
 			labelRow := " " + displayLabel
 			// Always pad to full width so the entire row is clickable
 			if lipgloss.Width(labelRow) < innerWidth {
@@ -24,6 +24,10 @@ type Config struct {
 	// Selected indicates whether this item is currently selected.
 	// Only has effect when ShowSelection is true.
 	Selected bool
+
+	// Flash highlights an issue that just changed: the title is rendered with
+	// IssueFlashStyle and, when not selected, the selection slot shows "•".
+	Flash bool
 }
 
 // RenderBadge returns the issue badge without the title: [T][Pn][id]
@@ -67,6 +71,8 @@ func Render(issue beads.Issue, cfg Config) string {
 	if cfg.ShowSelection {
 		if cfg.Selected {
 			parts = append(parts, styles.SelectionIndicatorStyle.Render(">"))
+		} else if cfg.Flash {
+			parts = append(parts, styles.IssueFlashStyle.Render("•"))
 		} else {
 			parts = append(parts, " ")
 		}
@@ -93,6 +99,9 @@ func Render(issue beads.Issue, cfg Config) string {
 	}
 
 	if title != "" {
+		if cfg.Flash {
+			title = styles.IssueFlashStyle.Render(title)
+		}
 		parts = append(parts, " "+title)
 	}
 
@@ -191,6 +191,16 @@ func TestRender_SelectionIndicator(t *testing.T) {
 			cfg:        Config{ShowSelection: true, Selected: true},
 			wantPrefix: ">[T]", // > + badge
 		},
+		{
+			name:       "flash - not selected",
+			cfg:        Config{ShowSelection: true, Flash: true},
+			wantPrefix: "•[T]", // flash marker + badge
+		},
+		{
+			name:       "flash - selected",
+			cfg:        Config{ShowSelection: true, Selected: true, Flash: true},
+			wantPrefix: ">[T]", // selection wins over flash
+		},
 	}
 
 	for _, tt := range tests {
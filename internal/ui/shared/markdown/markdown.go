@@ -2,7 +2,10 @@
 package markdown
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // noMarginStyle is a JSON style that removes document margins.
@@ -14,8 +17,28 @@ const noMarginStyle = `{
 	}
 }`
 
-// Renderer wraps glamour with perles-specific configuration.
-type Renderer struct {
+// inlineStyle also drops the document text color, so plain text keeps the
+// foreground of the layout the markdown is embedded in.
+const inlineStyle = `{
+	"document": {
+		"margin": 0,
+		"block_prefix": "",
+		"block_suffix": "",
+		"color": null
+	}
+}`
+
+// Renderer turns markdown into terminal output wrapped to Width.
+type Renderer interface {
+	// Render transforms markdown to terminal output.
+	Render(markdown string) (string, error)
+	// Width returns the configured word wrap width.
+	Width() int
+}
+
+// glamourRenderer renders headings, lists, links and syntax highlighted
+// code blocks with glamour.
+type glamourRenderer struct {
 	renderer *glamour.TermRenderer
 	width    int
 }
@@ -26,28 +49,89 @@ type Renderer struct {
 // WithAutoStyle() creates a new lipgloss renderer that detects light/dark
 // background by querying the terminal, which causes escape sequence responses
 // to leak into the input stream.
-func New(width int, style string) (*Renderer, error) {
+func New(width int, style string) (Renderer, error) {
+	return newGlamour(width, style, noMarginStyle)
+}
+
+// NewInline creates a markdown renderer for text embedded in other styled
+// layouts, like chat messages: plain text isn't colored and lines aren't
+// padded with styled spaces.
+func NewInline(width int, style string) (Renderer, error) {
+	return newGlamour(width, style, inlineStyle)
+}
+
+func newGlamour(width int, style, overrides string) (Renderer, error) {
 	if style == "" {
 		style = "dark"
 	}
 
 	r, err := glamour.NewTermRenderer(
 		glamour.WithStylePath(style),
-		glamour.WithStylesFromJSONBytes([]byte(noMarginStyle)),
+		glamour.WithStylesFromJSONBytes([]byte(overrides)),
 		glamour.WithWordWrap(width),
 	)
 	if err != nil {
 		return nil, err
 	}
-	return &Renderer{renderer: r, width: width}, nil
+	return &glamourRenderer{renderer: r, width: width}, nil
+}
+
+// NewOrPlain creates a markdown renderer, falling back to NewPlain when the
+// style can't be loaded.
+func NewOrPlain(width int, style string) Renderer {
+	if r, err := New(width, style); err == nil {
+		return r
+	}
+	return NewPlain(width)
 }
 
 // Width returns the configured word wrap width.
-func (r *Renderer) Width() int {
+func (r *glamourRenderer) Width() int {
 	return r.width
 }
 
 // Render transforms markdown to styled terminal output.
-func (r *Renderer) Render(markdown string) (string, error) {
+func (r *glamourRenderer) Render(markdown string) (string, error) {
 	return r.renderer.Render(markdown)
 }
+
+// plainRenderer shows markdown as-is, only word wrapped.
+type plainRenderer struct {
+	width int
+}
+
+// NewPlain creates a renderer that word wraps the raw markdown text.
+func NewPlain(width int) Renderer {
+	return plainRenderer{width: width}
+}
+
+// Width returns the configured word wrap width.
+func (r plainRenderer) Width() int {
+	return r.width
+}
+
+// Render word wraps text to the renderer width. It never fails.
+func (r plainRenderer) Render(text string) (string, error) {
+	if r.width <= 0 {
+		return text, nil
+	}
+	return ansi.Wrap(text, r.width, ""), nil
+}
+
+// RenderOrPlain renders markdown with r, returning text unchanged when r is
+// nil or rendering fails. Surrounding blank lines and trailing spaces on each
+// line are trimmed so the result can be embedded in other layouts.
+func RenderOrPlain(r Renderer, text string) string {
+	if r == nil {
+		return text
+	}
+	rendered, err := r.Render(text)
+	if err != nil {
+		return text
+	}
+	lines := strings.Split(strings.Trim(rendered, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
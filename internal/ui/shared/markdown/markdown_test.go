@@ -96,3 +96,37 @@ func TestRenderer_Render_PlainText(t *testing.T) {
 
 	require.True(t, strings.Contains(result, "plain text"), "expected result to contain 'plain text'")
 }
+
+func TestNewPlain_WrapsRawText(t *testing.T) {
+	r := NewPlain(10)
+	require.Equal(t, 10, r.Width())
+
+	result, err := r.Render("# Title with **bold** words")
+	require.NoError(t, err)
+	require.Equal(t, "# Title\nwith\n**bold**\nwords", result)
+}
+
+func TestNewOrPlain_FallsBackOnUnknownStyle(t *testing.T) {
+	_, err := New(40, "no-such-style")
+	require.Error(t, err)
+
+	r := NewOrPlain(40, "no-such-style")
+	require.IsType(t, plainRenderer{}, r)
+
+	r = NewOrPlain(40, "dark")
+	require.IsType(t, &glamourRenderer{}, r)
+}
+
+func TestRenderOrPlain(t *testing.T) {
+	require.Equal(t, "**raw**", RenderOrPlain(nil, "**raw**"))
+
+	r, err := NewInline(40, "")
+	require.NoError(t, err)
+
+	result := RenderOrPlain(r, "Some **bold** text\n\n- item")
+	require.Equal(t, "Some bold text\n\n• item", stripANSI(result))
+	require.True(t, strings.HasPrefix(result, "Some "), "plain text isn't colored")
+	for _, line := range strings.Split(result, "\n") {
+		require.Equal(t, strings.TrimRight(line, " "), line, "lines aren't padded")
+	}
+}
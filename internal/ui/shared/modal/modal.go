@@ -3,6 +3,7 @@
 package modal
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/zjrosen/perles/internal/keys"
@@ -22,6 +23,11 @@ const (
 	zoneModalCancel = "modal-cancel"
 )
 
+// inputZoneID returns the zone ID for the input field at index i.
+func inputZoneID(i int) string {
+	return fmt.Sprintf("modal-input-%d", i)
+}
+
 // ButtonVariant controls the styling of the confirm/save button.
 type ButtonVariant int
 
@@ -136,6 +142,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		if cmd := m.handleMouseMsg(msg); cmd != nil {
 			return m, cmd
 		}
+		if i := m.clickedInput(msg); i >= 0 {
+			m = m.focusInput(i)
+			return m, textinput.Blink
+		}
 
 	case tea.KeyMsg:
 		switch {
@@ -367,13 +377,13 @@ func (m Model) renderInputSection(index int, label string, width int) string {
 	isFocused := m.focusedInput == index
 
 	inputView := m.inputs[index].View()
-	return styles.FormSection(styles.FormSectionConfig{
+	return zone.Mark(inputZoneID(index), styles.FormSection(styles.FormSectionConfig{
 		Content:            []string{inputView},
 		Width:              width,
 		TopLeft:            label,
 		Focused:            isFocused,
 		FocusedBorderColor: styles.BorderHighlightFocusColor,
-	})
+	}))
 }
 
 // renderButtons renders Save and Cancel buttons styled like coleditor.
@@ -476,6 +486,30 @@ func (m Model) handleMouseMsg(msg tea.MouseMsg) tea.Cmd {
 	return nil
 }
 
+// clickedInput returns the index of the input field under a left-click release,
+// or -1 if the click missed every input.
+func (m Model) clickedInput(msg tea.MouseMsg) int {
+	if msg.Button != tea.MouseButtonLeft || msg.Action != tea.MouseActionRelease {
+		return -1
+	}
+	for i := range m.inputs {
+		if z := zone.Get(inputZoneID(i)); z != nil && z.InBounds(msg) {
+			return i
+		}
+	}
+	return -1
+}
+
+// focusInput moves focus to the input at index i.
+func (m Model) focusInput(i int) Model {
+	if m.focusedInput >= 0 {
+		m.inputs[m.focusedInput].Blur()
+	}
+	m.focusedInput = i
+	m.inputs[i].Focus()
+	return m
+}
+
 // FocusedInput returns the currently focused input index (-1 if on buttons).
 func (m Model) FocusedInput() int {
 	return m.focusedInput
@@ -703,6 +703,35 @@ func TestUpdate_MouseClickIgnoredWhenRequired(t *testing.T) {
 	require.Nil(t, cmd, "expected no command when clicking submit with empty required input")
 }
 
+func TestUpdate_MouseClickFocusesInput(t *testing.T) {
+	m := New(Config{
+		Title: "Create Item",
+		Inputs: []InputConfig{
+			{Key: "name", Label: "Name", Placeholder: "Enter name..."},
+			{Key: "color", Label: "Color", Placeholder: "#RRGGBB"},
+		},
+	})
+	m.SetSize(80, 24)
+	require.Equal(t, 0, m.FocusedInput())
+
+	bg := createBackground(80, 24)
+	z := waitForZone(t, &m, inputZoneID(1), bg)
+
+	m, cmd := m.Update(tea.MouseMsg{
+		X:      z.StartX + 2,
+		Y:      z.StartY + 1,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionRelease,
+	})
+	require.NotNil(t, cmd, "expected blink command after focusing input")
+	require.Equal(t, 1, m.FocusedInput())
+
+	// Typing goes to the clicked input
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	require.Equal(t, "", m.inputs[0].Value())
+	require.Equal(t, "x", m.inputs[1].Value())
+}
+
 // createBackground creates a simple background string of the given dimensions.
 func createBackground(width, height int) string {
 	var bg string
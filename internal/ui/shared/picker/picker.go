@@ -2,6 +2,7 @@
 package picker
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/zjrosen/perles/internal/keys"
@@ -11,8 +12,14 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
 )
 
+// OptionZoneID returns the bubblezone ID for the option at index i.
+func OptionZoneID(i int) string {
+	return fmt.Sprintf("picker-option-%d", i)
+}
+
 // Option represents a picker option with label and value.
 type Option struct {
 	Label string
@@ -100,9 +107,11 @@ func (m Model) Selected() Option {
 	return Option{}
 }
 
-// Update handles messages including enter/esc.
+// Update handles messages including enter/esc and mouse clicks/wheel.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		return m.handleMouseMsg(msg)
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, keys.Common.Down), key.Matches(msg, keys.Component.Next):
@@ -122,6 +131,32 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleMouseMsg selects an option on left-click (same as Enter) and moves
+// the selection with the scroll wheel.
+func (m Model) handleMouseMsg(msg tea.MouseMsg) (Model, tea.Cmd) {
+	switch msg.Button {
+	case tea.MouseButtonWheelDown:
+		if m.selected < len(m.config.Options)-1 {
+			m.selected++
+		}
+	case tea.MouseButtonWheelUp:
+		if m.selected > 0 {
+			m.selected--
+		}
+	case tea.MouseButtonLeft:
+		if msg.Action != tea.MouseActionRelease {
+			return m, nil
+		}
+		for i := range m.config.Options {
+			if z := zone.Get(OptionZoneID(i)); z != nil && z.InBounds(msg) {
+				m.selected = i
+				return m, m.selectCmd()
+			}
+		}
+	}
+	return m, nil
+}
+
 // selectCmd returns the appropriate select command.
 func (m Model) selectCmd() tea.Cmd {
 	selected := m.Selected()
@@ -171,7 +206,7 @@ func (m Model) View() string {
 			}
 			line = " " + labelStyle.Render(opt.Label)
 		}
-		options.WriteString(line)
+		options.WriteString(zone.Mark(OptionZoneID(i), line))
 		if i < len(m.config.Options)-1 {
 			options.WriteString("\n")
 		}
@@ -196,19 +231,22 @@ func (m Model) View() string {
 func (m Model) Overlay(background string) string {
 	pickerBox := m.View()
 
+	var result string
 	if background == "" {
-		return lipgloss.Place(
+		result = lipgloss.Place(
 			m.viewportWidth, m.viewportHeight,
 			lipgloss.Center, lipgloss.Center,
 			pickerBox,
 		)
+	} else {
+		result = overlay.Place(overlay.Config{
+			Width:    m.viewportWidth,
+			Height:   m.viewportHeight,
+			Position: overlay.Center,
+		}, pickerBox, background)
 	}
-
-	return overlay.Place(overlay.Config{
-		Width:    m.viewportWidth,
-		Height:   m.viewportHeight,
-		Position: overlay.Center,
-	}, pickerBox, background)
+	// Scan for zone markers to enable mouse click detection
+	return zone.Scan(result)
 }
 
 // CancelMsg is sent when the picker is cancelled.
@@ -1,13 +1,21 @@
 package picker
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/exp/teatest"
+	zone "github.com/lrstanley/bubblezone"
 	"github.com/stretchr/testify/require"
 )
 
+func TestMain(m *testing.M) {
+	zone.NewGlobal()
+	os.Exit(m.Run())
+}
+
 func testOptions() []Option {
 	return []Option{
 		{Label: "Option 1", Value: "1"},
@@ -296,3 +304,69 @@ func TestPicker_OnCancel_QKey(t *testing.T) {
 	msg := cmd()
 	require.IsType(t, CancelMsg{}, msg, "expected CancelMsg from 'q' key")
 }
+
+// waitForZone renders the overlay until the zone is registered.
+// Zone registration in bubblezone is asynchronous via a channel worker.
+func waitForZone(t *testing.T, m Model, zoneID string) *zone.ZoneInfo {
+	t.Helper()
+	var z *zone.ZoneInfo
+	for retries := 0; retries < 10; retries++ {
+		_ = m.Overlay("")
+		time.Sleep(time.Millisecond)
+		z = zone.Get(zoneID)
+		if z != nil && !z.IsZero() {
+			break
+		}
+	}
+	require.NotNil(t, z, "zone %s should be registered after Overlay()", zoneID)
+	require.False(t, z.IsZero(), "zone %s should not be zero", zoneID)
+	return z
+}
+
+func TestPicker_MouseClickSelectsOption(t *testing.T) {
+	m := New("Test", testOptions()).SetSize(80, 24)
+
+	z := waitForZone(t, m, OptionZoneID(2))
+	m, cmd := m.Update(tea.MouseMsg{
+		X:      z.StartX + 1,
+		Y:      z.StartY,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionRelease,
+	})
+
+	require.Equal(t, 2, m.selected, "expected click to move selection")
+	require.NotNil(t, cmd, "expected click to select like Enter")
+	msg := cmd()
+	require.IsType(t, SelectMsg{}, msg)
+	require.Equal(t, "3", msg.(SelectMsg).Option.Value)
+}
+
+func TestPicker_MouseClickOutsideIgnored(t *testing.T) {
+	m := New("Test", testOptions()).SetSize(80, 24)
+	_ = waitForZone(t, m, OptionZoneID(0))
+
+	m, cmd := m.Update(tea.MouseMsg{
+		X:      0,
+		Y:      0,
+		Button: tea.MouseButtonLeft,
+		Action: tea.MouseActionRelease,
+	})
+
+	require.Nil(t, cmd)
+	require.Equal(t, 0, m.selected)
+}
+
+func TestPicker_MouseWheelMovesSelection(t *testing.T) {
+	m := New("Test", testOptions())
+
+	m, cmd := m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	require.Nil(t, cmd, "wheel should not select")
+	require.Equal(t, 1, m.selected)
+
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelDown})
+	require.Equal(t, 2, m.selected, "expected wheel to stop at last option")
+
+	m, _ = m.Update(tea.MouseMsg{Button: tea.MouseButtonWheelUp})
+	require.Equal(t, 1, m.selected)
+}
@@ -0,0 +1,226 @@
+// Package threadgraph provides a read-only overlay that renders a fabric
+// thread dependency graph as an indented tree.
+package threadgraph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+	"github.com/zjrosen/perles/internal/ui/shared/overlay"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+const (
+	boxMaxWidth       = 140 // Maximum box width in characters
+	boxMinWidth       = 40  // Minimum box width in characters
+	viewportMinHeight = 5   // Minimum viewport height for very small screens
+)
+
+var (
+	branchStyle   = lipgloss.NewStyle().Foreground(styles.BorderDefaultColor)
+	relationStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	hintStyle     = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	idStyle       = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	channelStyle  = lipgloss.NewStyle().Foreground(styles.StatusInProgressColor).Bold(true)
+	artifactStyle = lipgloss.NewStyle().Foreground(styles.StatusSuccessColor)
+	goneStyle     = lipgloss.NewStyle().Foreground(styles.TextMutedColor).Strikethrough(true)
+)
+
+// CloseMsg is sent when the overlay should be closed.
+type CloseMsg struct{}
+
+// Model is the thread graph overlay state.
+type Model struct {
+	graph    *fabric.ThreadGraph
+	width    int
+	height   int
+	viewport viewport.Model
+}
+
+// New creates a thread graph overlay for graph.
+func New(graph *fabric.ThreadGraph) Model {
+	return Model{graph: graph}
+}
+
+// Graph returns the graph being shown.
+func (m Model) Graph() *fabric.ThreadGraph {
+	return m.graph
+}
+
+// SetSize updates the overlay's knowledge of the screen size.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+
+	contentWidth := m.boxWidth() - 2
+	m.viewport.Width = contentWidth
+	// Header (2 lines), footer (2 lines) and borders (2 lines)
+	m.viewport.Height = max(height-8, viewportMinHeight)
+	m.viewport.SetContent(m.renderTree(contentWidth))
+	return m
+}
+
+// Init implements tea.Model.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key and mouse input.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Common.Escape), key.Matches(msg, keys.Component.Close), msg.String() == "q":
+			return m, func() tea.Msg { return CloseMsg{} }
+		case key.Matches(msg, keys.Common.Down):
+			m.viewport.ScrollDown(1)
+		case key.Matches(msg, keys.Common.Up):
+			m.viewport.ScrollUp(1)
+		case key.Matches(msg, keys.Component.GotoTop):
+			m.viewport.GotoTop()
+		case key.Matches(msg, keys.Component.GotoBottom):
+			m.viewport.GotoBottom()
+		}
+
+	case tea.MouseMsg:
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.viewport.ScrollUp(1)
+		case tea.MouseButtonWheelDown:
+			m.viewport.ScrollDown(1)
+		}
+	}
+	return m, nil
+}
+
+// View renders the overlay box.
+func (m Model) View() string {
+	boxWidth := m.boxWidth()
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.OverlayTitleColor).
+		PaddingLeft(1)
+	divider := lipgloss.NewStyle().
+		Foreground(styles.OverlayBorderColor).
+		Render(strings.Repeat("─", boxWidth))
+
+	title := titleStyle.Render("Thread Graph")
+	if root := m.rootNode(); root != nil {
+		title = titleStyle.Render("Thread Graph · " + root.Label)
+	}
+	escHint := hintStyle.Render("[ESC] Close ")
+	padding := max(boxWidth-lipgloss.Width(title)-lipgloss.Width(escHint), 1)
+
+	var result strings.Builder
+	result.WriteString(title + strings.Repeat(" ", padding) + escHint)
+	result.WriteString("\n")
+	result.WriteString(divider)
+	result.WriteString("\n")
+	result.WriteString(m.viewport.View())
+	result.WriteString("\n")
+	result.WriteString(divider)
+	result.WriteString("\n")
+	result.WriteString(m.buildFooter())
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.OverlayBorderColor).
+		Width(boxWidth).
+		Render(result.String())
+}
+
+// Overlay renders the overlay centered on the given background.
+func (m Model) Overlay(bg string) string {
+	return overlay.Place(overlay.Config{
+		Width:    m.width,
+		Height:   m.height,
+		Position: overlay.Center,
+	}, m.View(), bg)
+}
+
+// rootNode returns the graph's root node, if any.
+func (m Model) rootNode() *fabric.GraphNode {
+	if m.graph == nil {
+		return nil
+	}
+	return m.graph.Node(m.graph.RootID)
+}
+
+// buildFooter renders the node count and scroll hints.
+func (m Model) buildFooter() string {
+	count := 0
+	if m.graph != nil {
+		count = len(m.graph.Nodes)
+	}
+	footer := hintStyle.Render(fmt.Sprintf("%d threads  [j/k] Scroll  [g/G] Top/Bottom", count))
+	if m.viewport.TotalLineCount() > m.viewport.Height {
+		footer += "    " + hintStyle.Render(fmt.Sprintf("%.0f%%", m.viewport.ScrollPercent()*100))
+	}
+	return footer
+}
+
+// renderTree renders the graph as styled tree lines truncated to width.
+func (m Model) renderTree(width int) string {
+	lines := fabric.GraphTree(m.graph)
+	if len(lines) == 0 {
+		return lipgloss.NewStyle().Foreground(styles.TextMutedColor).Italic(true).Render("No threads")
+	}
+
+	rendered := make([]string, 0, len(lines)+1)
+	for _, line := range lines {
+		rendered = append(rendered, lipgloss.NewStyle().MaxWidth(width).Render(renderLine(line)))
+	}
+	if m.graph.Truncated {
+		rendered = append(rendered, hintStyle.Render(fabric.GraphTruncatedNote))
+	}
+	return strings.Join(rendered, "\n")
+}
+
+// renderLine styles a single tree line.
+func renderLine(line fabric.GraphTreeLine) string {
+	node := line.Node
+
+	var sb strings.Builder
+	sb.WriteString(branchStyle.Render(line.Prefix))
+	if line.Relation != "" && line.Relation != domain.RelationChildOf {
+		sb.WriteString(relationStyle.Render(string(line.Relation) + " "))
+	}
+
+	label := node.Label
+	switch node.Type {
+	case domain.ThreadChannel:
+		label = channelStyle.Render(label)
+	case domain.ThreadArtifact:
+		label = artifactStyle.Render("📎 " + label)
+	default:
+		if node.CreatedBy != "" {
+			label = node.CreatedBy + ": " + label
+		}
+	}
+	if node.Deleted || node.Archived {
+		label = goneStyle.Render(label)
+	}
+	sb.WriteString(label)
+	sb.WriteString(" " + idStyle.Render(node.ID))
+
+	if line.Repeat {
+		sb.WriteString(hintStyle.Render(" (see above)"))
+	} else if len(node.Blocks) > 0 {
+		sb.WriteString(hintStyle.Render(fmt.Sprintf(" · blocks %d", len(node.Blocks))))
+	}
+	return sb.String()
+}
+
+// boxWidth returns the box width based on screen size.
+func (m Model) boxWidth() int {
+	return max(min(m.width-4, boxMaxWidth), boxMinWidth)
+}
@@ -0,0 +1,58 @@
+package threadgraph
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/orchestration/fabric"
+	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
+)
+
+func testGraph() *fabric.ThreadGraph {
+	return &fabric.ThreadGraph{
+		RootID: "ch-1",
+		Nodes: []fabric.GraphNode{
+			{ID: "ch-1", Type: domain.ThreadChannel, Label: "#tasks", Blocks: []string{"msg-1"}},
+			{ID: "msg-1", Type: domain.ThreadMessage, Label: "Implement parser", CreatedBy: "coordinator", Depth: 1, Blocks: []string{"msg-2", "art-1"}},
+			{ID: "msg-2", Type: domain.ThreadMessage, Label: "On it", CreatedBy: "worker-1", Depth: 2},
+			{ID: "art-1", Type: domain.ThreadArtifact, Label: "plan.md", CreatedBy: "worker-1", Depth: 2},
+		},
+		Edges: []fabric.GraphEdge{
+			{From: "msg-1", To: "ch-1", Relation: domain.RelationChildOf},
+			{From: "msg-2", To: "msg-1", Relation: domain.RelationReplyTo},
+			{From: "art-1", To: "msg-1", Relation: domain.RelationReferences},
+		},
+		Truncated: true,
+	}
+}
+
+func TestThreadGraph_RendersIndentedTree(t *testing.T) {
+	m := New(testGraph()).SetSize(120, 30)
+	view := ansi.Strip(m.View())
+
+	require.Contains(t, view, "Thread Graph · #tasks")
+	require.Contains(t, view, "└── coordinator: Implement parser msg-1 · blocks 2")
+	require.Contains(t, view, "    ├── reply_to worker-1: On it msg-2")
+	require.Contains(t, view, "    └── references 📎 plan.md art-1")
+	require.Contains(t, view, "truncated")
+	require.Contains(t, view, "4 threads")
+}
+
+func TestThreadGraph_EmptyGraph(t *testing.T) {
+	m := New(&fabric.ThreadGraph{}).SetSize(80, 20)
+	require.Contains(t, ansi.Strip(m.View()), "No threads")
+}
+
+func TestThreadGraph_Close(t *testing.T) {
+	m := New(testGraph()).SetSize(80, 20)
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.NotNil(t, cmd)
+	require.IsType(t, CloseMsg{}, cmd())
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	require.Nil(t, cmd)
+}
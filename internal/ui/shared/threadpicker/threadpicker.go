@@ -3,10 +3,12 @@ package threadpicker
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/rivo/uniseg"
 
 	"github.com/zjrosen/perles/internal/orchestration/fabric/domain"
 	"github.com/zjrosen/perles/internal/ui/styles"
@@ -17,6 +19,9 @@ type Model struct {
 	// Available threads in current channel
 	threads []domain.Thread
 
+	// Participant summaries keyed by thread ID (optional, shown for the selected thread)
+	participants map[string]*domain.ThreadParticipants
+
 	// Current state
 	active       bool   // Whether picker is showing
 	query        string // Filter query
@@ -44,6 +49,13 @@ func (m Model) SetThreads(threads []domain.Thread) Model {
 	return m
 }
 
+// SetParticipants sets the participant summaries shown under the selected thread.
+// Keys are thread IDs; threads without a summary show no participants line.
+func (m Model) SetParticipants(participants map[string]*domain.ThreadParticipants) Model {
+	m.participants = participants
+	return m
+}
+
 // IsActive returns whether the picker is currently showing.
 func (m Model) IsActive() bool {
 	return m.active
@@ -240,6 +252,13 @@ func (m Model) View(maxWidth int) string {
 		}
 	}
 
+	// Show who is involved in the selected thread
+	if selected := m.Selected(); selected != nil {
+		if line := ParticipantsLine(m.participants[selected.ID]); line != "" {
+			lines = append(lines, mutedStyle.Render(" "+line))
+		}
+	}
+
 	// Add scroll indicator if needed
 	if len(m.filtered) > m.maxVisible {
 		scrollInfo := fmt.Sprintf(" %d-%d of %d threads",
@@ -258,3 +277,68 @@ func (m Model) View(maxWidth int) string {
 
 	return borderStyle.Render(content)
 }
+
+// ParticipantsLine renders a compact avatar-style summary of a thread's participants:
+// posters first, then agents who acked (✓), then mentioned agents still pending (⏳).
+// Returns empty string if p is nil or has no participants.
+func ParticipantsLine(p *domain.ThreadParticipants) string {
+	if p == nil {
+		return ""
+	}
+
+	var segments []string
+	if len(p.Posters) > 0 {
+		segments = append(segments, "👥 "+avatars(p.Posters))
+	}
+
+	// Posters have obviously read the thread, so only list other readers
+	var readers []string
+	for _, id := range p.Acked {
+		if !slices.Contains(p.Posters, id) {
+			readers = append(readers, id)
+		}
+	}
+	if len(readers) > 0 {
+		segments = append(segments, "✓ "+avatars(readers))
+	}
+	if len(p.Pending) > 0 {
+		segments = append(segments, "⏳ "+avatars(p.Pending))
+	}
+
+	return strings.Join(segments, " · ")
+}
+
+// avatars joins short avatar labels for the given agent IDs.
+func avatars(agentIDs []string) string {
+	labels := make([]string, len(agentIDs))
+	for i, id := range agentIDs {
+		labels[i] = Avatar(id)
+	}
+	return strings.Join(labels, " ")
+}
+
+// Avatar returns a short label for an agent ID (e.g., "coordinator" -> "CO", "worker-3" -> "W3").
+func Avatar(agentID string) string {
+	lower := strings.ToLower(agentID)
+	switch lower {
+	case "coordinator":
+		return "CO"
+	case "observer":
+		return "OB"
+	case domain.AgentUser:
+		return "U"
+	}
+
+	if rest, ok := strings.CutPrefix(lower, "worker"); ok {
+		return "W" + strings.TrimLeft(rest, "-._")
+	}
+
+	// Take the first two graphemes so multi-byte IDs are never split.
+	label, rest, state := "", agentID, -1
+	for i := 0; i < 2 && rest != ""; i++ {
+		var cluster string
+		cluster, rest, _, state = uniseg.StepString(rest, state)
+		label += cluster
+	}
+	return strings.ToUpper(label)
+}
@@ -219,3 +219,51 @@ func TestView_WithThreads(t *testing.T) {
 	assert.Contains(t, view, "coord")
 	assert.Contains(t, view, "worker-1")
 }
+
+func TestAvatar(t *testing.T) {
+	tests := map[string]string{
+		"coordinator": "CO",
+		"COORDINATOR": "CO",
+		"observer":    "OB",
+		"user":        "U",
+		"worker-3":    "W3",
+		"WORKER.12":   "W12",
+		"system":      "SY",
+		"x":           "X",
+		"élan":        "ÉL",
+		"日本語":         "日本",
+		"e\u0301tude": "E\u0301T",
+	}
+	for id, want := range tests {
+		assert.Equal(t, want, Avatar(id), id)
+	}
+}
+
+func TestParticipantsLine(t *testing.T) {
+	assert.Empty(t, ParticipantsLine(nil))
+	assert.Empty(t, ParticipantsLine(&domain.ThreadParticipants{}))
+
+	line := ParticipantsLine(&domain.ThreadParticipants{
+		Posters:   []string{"coordinator", "worker-1"},
+		Mentioned: []string{"worker-1", "worker-2", "worker-3"},
+		Acked:     []string{"worker-1", "worker-2"},
+		Pending:   []string{"worker-3"},
+	})
+	assert.Equal(t, "👥 CO W1 · ✓ W2 · ⏳ W3", line)
+}
+
+func TestView_ShowsParticipantsForSelectedThread(t *testing.T) {
+	m := New().Activate([]domain.Thread{
+		makeThread("t1", "coordinator", "First thread"),
+		makeThread("t2", "worker-1", "Second thread"),
+	}).SetParticipants(map[string]*domain.ThreadParticipants{
+		"t1": {Posters: []string{"coordinator"}, Pending: []string{"worker-2"}},
+	})
+
+	view := m.View(80)
+	require.Contains(t, view, "👥 CO · ⏳ W2")
+
+	// Second thread has no summary, so no participants line
+	m = m.Next()
+	require.NotContains(t, m.View(80), "👥")
+}
@@ -0,0 +1,137 @@
+package toaster
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/ui/styles"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// progressTickInterval is how often the spinner advances while operations are running.
+const progressTickInterval = 100 * time.Millisecond
+
+// progressSpinnerFrames defines the braille spinner animation sequence.
+var progressSpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Progress is a long-running operation shown with a spinner and elapsed time
+// until it finishes.
+type Progress struct {
+	ID        string
+	Label     string
+	StartedAt time.Time
+	// Cancel requests cancellation of the operation. Nil if it can't be canceled.
+	Cancel func() bool
+}
+
+// Cancelable reports whether the operation supports cancellation.
+func (p Progress) Cancelable() bool {
+	return p.Cancel != nil
+}
+
+// ProgressTickMsg advances the progress spinner and elapsed times.
+type ProgressTickMsg struct {
+	Time time.Time
+}
+
+// ScheduleProgressTick returns a command that sends ProgressTickMsg after the tick interval.
+func ScheduleProgressTick() tea.Cmd {
+	return tea.Tick(progressTickInterval, func(t time.Time) tea.Msg {
+		return ProgressTickMsg{Time: t}
+	})
+}
+
+// StartProgress shows a progress indicator for an operation.
+// Starting an ID that is already shown replaces it.
+func (m Model) StartProgress(p Progress) Model {
+	if p.StartedAt.IsZero() {
+		p.StartedAt = time.Now()
+	}
+	if m.now.Before(p.StartedAt) {
+		m.now = p.StartedAt
+	}
+
+	list := make([]Progress, 0, len(m.progress)+1)
+	for _, existing := range m.progress {
+		if existing.ID != p.ID {
+			list = append(list, existing)
+		}
+	}
+	m.progress = append(list, p)
+	return m
+}
+
+// FinishProgress removes the progress indicator for an operation.
+func (m Model) FinishProgress(id string) Model {
+	list := make([]Progress, 0, len(m.progress))
+	for _, p := range m.progress {
+		if p.ID != id {
+			list = append(list, p)
+		}
+	}
+	m.progress = list
+	return m
+}
+
+// HasProgress returns whether any operation is in progress.
+func (m Model) HasProgress() bool {
+	return len(m.progress) > 0
+}
+
+// LatestCancelable returns the most recently started operation that can be canceled.
+func (m Model) LatestCancelable() (Progress, bool) {
+	for i := len(m.progress) - 1; i >= 0; i-- {
+		if m.progress[i].Cancelable() {
+			return m.progress[i], true
+		}
+	}
+	return Progress{}, false
+}
+
+// Tick advances the spinner and updates elapsed times to now.
+func (m Model) Tick(now time.Time) Model {
+	m.frame = (m.frame + 1) % len(progressSpinnerFrames)
+	m.now = now
+	return m
+}
+
+// progressView renders one line per in-flight operation inside a single box.
+// The most recently started cancelable operation shows the cancel hint.
+func (m Model) progressView() string {
+	if len(m.progress) == 0 {
+		return ""
+	}
+
+	latest, hasCancelable := m.LatestCancelable()
+	spinner := progressSpinnerFrames[m.frame%len(progressSpinnerFrames)]
+	hintStyle := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+
+	lines := make([]string, 0, len(m.progress))
+	for _, p := range m.progress {
+		elapsed := max(m.now.Sub(p.StartedAt), 0)
+		line := fmt.Sprintf("%s %s… %s", spinner, p.Label, formatElapsed(elapsed))
+		if hasCancelable && p.ID == latest.ID {
+			line += " " + hintStyle.Render("("+keys.App.CancelProgress.Help().Key+" to cancel)")
+		}
+		lines = append(lines, line)
+	}
+
+	return lipgloss.NewStyle().
+		Padding(0, 1).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ToastBorderInfoColor).
+		Render(strings.Join(lines, "\n"))
+}
+
+// formatElapsed formats an elapsed duration as "4s" or "1m05s".
+func formatElapsed(d time.Duration) string {
+	d = d.Truncate(time.Second)
+	if d < time.Minute {
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+	return fmt.Sprintf("%dm%02ds", int(d.Minutes()), int(d.Seconds())%60)
+}
@@ -0,0 +1,107 @@
+package toaster
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartProgress_ShowsSpinnerAndElapsed(t *testing.T) {
+	startedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := New().StartProgress(Progress{ID: "p1", Label: "Spawning worker", StartedAt: startedAt})
+
+	require.True(t, m.Visible())
+	require.True(t, m.HasProgress())
+	require.Contains(t, m.View(), "⠋ Spawning worker… 0s")
+
+	m = m.Tick(startedAt.Add(65 * time.Second))
+	require.Contains(t, m.View(), "⠙ Spawning worker… 1m05s")
+}
+
+func TestStartProgress_ReplacesSameID(t *testing.T) {
+	m := New().
+		StartProgress(Progress{ID: "p1", Label: "First"}).
+		StartProgress(Progress{ID: "p1", Label: "Second"})
+
+	require.Len(t, m.progress, 1)
+	require.Contains(t, m.View(), "Second")
+	require.NotContains(t, m.View(), "First")
+}
+
+func TestFinishProgress(t *testing.T) {
+	m := New().
+		StartProgress(Progress{ID: "p1", Label: "Spawning worker"}).
+		StartProgress(Progress{ID: "p2", Label: "Stopping process"}).
+		FinishProgress("p1")
+
+	require.True(t, m.HasProgress())
+	require.NotContains(t, m.View(), "Spawning worker")
+
+	m = m.FinishProgress("p2").FinishProgress("unknown")
+	require.False(t, m.HasProgress())
+	require.False(t, m.Visible())
+	require.Empty(t, m.View())
+}
+
+func TestLatestCancelable(t *testing.T) {
+	canceled := ""
+	cancel := func(id string) func() bool {
+		return func() bool { canceled = id; return true }
+	}
+
+	m := New()
+	_, ok := m.LatestCancelable()
+	require.False(t, ok)
+
+	m = m.
+		StartProgress(Progress{ID: "p1", Label: "Spawning worker", Cancel: cancel("p1")}).
+		StartProgress(Progress{ID: "p2", Label: "Replacing worker-2", Cancel: cancel("p2")}).
+		StartProgress(Progress{ID: "p3", Label: "Stopping process"})
+
+	p, ok := m.LatestCancelable()
+	require.True(t, ok)
+	require.Equal(t, "p2", p.ID)
+	require.True(t, p.Cancel())
+	require.Equal(t, "p2", canceled)
+
+	// Only the latest cancelable operation shows the hint
+	lines := strings.Split(m.View(), "\n")
+	var hinted []string
+	for _, line := range lines {
+		if strings.Contains(line, "to cancel") {
+			hinted = append(hinted, line)
+		}
+	}
+	require.Len(t, hinted, 1)
+	require.Contains(t, hinted[0], "Replacing worker-2")
+	require.Contains(t, hinted[0], "ctrl+b")
+}
+
+func TestView_ProgressAboveToast(t *testing.T) {
+	m := New().
+		StartProgress(Progress{ID: "p1", Label: "Spawning worker"}).
+		Show("Worker ready", StyleSuccess)
+
+	view := m.View()
+	require.Less(t, strings.Index(view, "Spawning worker"), strings.Index(view, "Worker ready"))
+
+	// Hiding the toast keeps the progress indicator
+	m = m.Hide()
+	require.True(t, m.Visible())
+	require.Contains(t, m.View(), "Spawning worker")
+	require.NotContains(t, m.View(), "Worker ready")
+}
+
+func TestOverlay_ProgressOnly(t *testing.T) {
+	m := New().StartProgress(Progress{ID: "p1", Label: "Busy"})
+	bg := strings.Repeat(strings.Repeat(".", 30)+"\n", 12)
+	bg = strings.TrimSuffix(bg, "\n")
+
+	require.Contains(t, m.Overlay(bg, 30, 12), "Busy")
+}
+
+func TestScheduleProgressTick(t *testing.T) {
+	require.NotNil(t, ScheduleProgressTick())
+}
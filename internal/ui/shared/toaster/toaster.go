@@ -32,6 +32,11 @@ type Model struct {
 	visible bool
 	width   int
 	height  int
+
+	// Long-running operations shown above the toast until they finish
+	progress []Progress
+	frame    int
+	now      time.Time
 }
 
 // New creates a new toaster model.
@@ -56,9 +61,9 @@ func (m Model) Hide() Model {
 	return m
 }
 
-// Visible returns whether the toast is currently showing.
+// Visible returns whether the toast or any progress indicator is currently showing.
 func (m Model) Visible() bool {
-	return m.visible
+	return (m.visible && m.message != "") || len(m.progress) > 0
 }
 
 // SetSize updates the viewport dimensions for overlay positioning.
@@ -68,8 +73,22 @@ func (m Model) SetSize(width, height int) Model {
 	return m
 }
 
-// View renders the toast box.
+// View renders the progress indicators stacked above the toast box.
 func (m Model) View() string {
+	progress := m.progressView()
+	toast := m.toastView()
+	switch {
+	case progress == "":
+		return toast
+	case toast == "":
+		return progress
+	default:
+		return lipgloss.JoinVertical(lipgloss.Center, progress, toast)
+	}
+}
+
+// toastView renders the toast box.
+func (m Model) toastView() string {
 	if !m.visible || m.message == "" {
 		return ""
 	}
@@ -100,7 +119,7 @@ func (m Model) View() string {
 // Overlay renders the toast on top of a background view.
 // Uses bottom-center positioning with padding from the bottom edge.
 func (m Model) Overlay(bg string, width, height int) string {
-	if !m.visible || m.message == "" {
+	if !m.Visible() {
 		return bg
 	}
 
@@ -0,0 +1,397 @@
+// Package transcript provides a full-screen viewer for a worker's transcript
+// (messages and tool calls) that follows new output as it streams in.
+package transcript
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/zjrosen/perles/internal/keys"
+	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
+	"github.com/zjrosen/perles/internal/ui/shared/overlay"
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+const (
+	boxMaxWidth       = 160 // Maximum box width in characters
+	boxMinWidth       = 40  // Minimum box width in characters
+	viewportMinHeight = 5   // Minimum viewport height for very small screens
+)
+
+var (
+	followIndicatorStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.AdaptiveColor{Light: "#FECA57", Dark: "#FECA57"}).
+				Bold(true)
+
+	matchLineStyle = lipgloss.NewStyle().
+			Background(lipgloss.AdaptiveColor{Light: "#FECA57", Dark: "#5C4A00"})
+
+	hintStyle = lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+)
+
+// CloseMsg is sent when the viewer should be closed.
+type CloseMsg struct{}
+
+// ExportedMsg reports the result of exporting the transcript to a file.
+type ExportedMsg struct {
+	Path string
+	Err  error
+}
+
+// Model is the transcript viewer state.
+type Model struct {
+	workerID  string
+	messages  []chatrender.Message
+	exportDir string
+	width     int
+	height    int
+	viewport  viewport.Model
+
+	follow bool // Keep the viewport pinned to the newest output
+
+	searching bool // Search input is active
+	search    textinput.Model
+	query     string
+	matches   []int // Line indices of the rendered transcript matching query
+	match     int   // Index into matches of the current match
+}
+
+// New creates a transcript viewer for workerID. Exports are written to exportDir.
+// The viewer starts in follow-tail mode.
+func New(workerID string, messages []chatrender.Message, exportDir string) Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.CharLimit = 100
+	return Model{
+		workerID:  workerID,
+		messages:  messages,
+		exportDir: exportDir,
+		follow:    true,
+		search:    ti,
+	}
+}
+
+// WorkerID returns the ID of the worker whose transcript is shown.
+func (m Model) WorkerID() string {
+	return m.workerID
+}
+
+// Following returns whether the viewer follows new output.
+func (m Model) Following() bool {
+	return m.follow
+}
+
+// SetSize updates the viewer's knowledge of the screen size.
+func (m Model) SetSize(width, height int) Model {
+	m.width = width
+	m.height = height
+	m.refresh()
+	return m
+}
+
+// SetMessages replaces the transcript with the latest messages of the worker.
+// In follow-tail mode the viewport jumps to the newest output.
+func (m Model) SetMessages(messages []chatrender.Message) Model {
+	m.messages = messages
+	m.refresh()
+	return m
+}
+
+// Init implements tea.Model.
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles key and mouse input.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+
+		switch {
+		case key.Matches(msg, keys.Common.Escape), key.Matches(msg, keys.Component.Close), msg.String() == "q":
+			if m.query != "" && key.Matches(msg, keys.Common.Escape) {
+				m.setQuery("")
+				return m, nil
+			}
+			return m, func() tea.Msg { return CloseMsg{} }
+
+		case key.Matches(msg, keys.Transcript.Search):
+			m.searching = true
+			m.search.SetValue(m.query)
+			m.search.CursorEnd()
+			return m, m.search.Focus()
+
+		case key.Matches(msg, keys.Transcript.NextMatch):
+			m.jumpToMatch(m.match + 1)
+			return m, nil
+
+		case key.Matches(msg, keys.Transcript.PrevMatch):
+			m.jumpToMatch(m.match - 1)
+			return m, nil
+
+		case key.Matches(msg, keys.Transcript.FollowTail):
+			m.follow = !m.follow
+			if m.follow {
+				m.viewport.GotoBottom()
+			}
+			return m, nil
+
+		case key.Matches(msg, keys.Transcript.Export):
+			return m, m.exportCmd()
+
+		case key.Matches(msg, keys.Common.Down):
+			m.viewport.ScrollDown(1)
+			return m, nil
+
+		case key.Matches(msg, keys.Common.Up):
+			m.viewport.ScrollUp(1)
+			m.follow = false
+			return m, nil
+
+		case key.Matches(msg, keys.Component.GotoTop):
+			m.viewport.GotoTop()
+			m.follow = false
+			return m, nil
+
+		case key.Matches(msg, keys.Component.GotoBottom):
+			m.viewport.GotoBottom()
+			m.follow = true
+			return m, nil
+		}
+
+	case tea.MouseMsg:
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.viewport.ScrollUp(1)
+			m.follow = false
+		case tea.MouseButtonWheelDown:
+			m.viewport.ScrollDown(1)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// updateSearch handles keys while the search input is active.
+func (m Model) updateSearch(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.searching = false
+		m.search.Blur()
+		m.setQuery(m.search.Value())
+		return m, nil
+	case tea.KeyEsc:
+		m.searching = false
+		m.search.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	return m, cmd
+}
+
+// setQuery sets the search query and jumps to the first match.
+func (m *Model) setQuery(query string) {
+	m.query = strings.TrimSpace(query)
+	m.match = 0
+	m.refresh()
+	if len(m.matches) > 0 {
+		m.jumpToMatch(0)
+	}
+}
+
+// jumpToMatch scrolls to match i, wrapping around at both ends.
+// Jumping to a match leaves follow-tail mode.
+func (m *Model) jumpToMatch(i int) {
+	if len(m.matches) == 0 {
+		return
+	}
+	m.match = (i + len(m.matches)) % len(m.matches)
+	m.follow = false
+	m.refresh()
+	m.viewport.SetYOffset(max(m.matches[m.match]-m.viewport.Height/2, 0))
+}
+
+// View renders the transcript viewer.
+func (m Model) View() string {
+	boxWidth := m.boxWidth()
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(styles.OverlayTitleColor).
+		PaddingLeft(1)
+	divider := lipgloss.NewStyle().
+		Foreground(styles.OverlayBorderColor).
+		Render(strings.Repeat("─", boxWidth))
+
+	title := titleStyle.Render("Transcript · " + m.workerID)
+	escHint := hintStyle.Render("[ESC] Close ")
+	padding := max(boxWidth-lipgloss.Width(title)-lipgloss.Width(escHint), 1)
+	header := title + strings.Repeat(" ", padding) + escHint
+
+	var result strings.Builder
+	result.WriteString(header)
+	result.WriteString("\n")
+	result.WriteString(divider)
+	result.WriteString("\n")
+	result.WriteString(m.viewport.View())
+	result.WriteString("\n")
+	result.WriteString(divider)
+	result.WriteString("\n")
+	result.WriteString(m.buildFooter())
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.OverlayBorderColor).
+		Width(boxWidth).
+		Render(result.String())
+}
+
+// Overlay renders the viewer centered on the given background.
+func (m Model) Overlay(bg string) string {
+	return overlay.Place(overlay.Config{
+		Width:    m.width,
+		Height:   m.height,
+		Position: overlay.Center,
+	}, m.View(), bg)
+}
+
+// buildFooter renders the search input or the key hints with the follow and
+// match indicators.
+func (m Model) buildFooter() string {
+	if m.searching {
+		return m.search.View()
+	}
+
+	hints := []string{
+		hintStyle.Render("[/] Search"),
+		hintStyle.Render("[n/N] Match"),
+		hintStyle.Render("[f] Follow"),
+		hintStyle.Render("[e] Export"),
+	}
+	footer := strings.Join(hints, "  ")
+
+	if m.query != "" {
+		if len(m.matches) == 0 {
+			footer += "    " + hintStyle.Render(fmt.Sprintf("no matches for %q", m.query))
+		} else {
+			footer += "    " + hintStyle.Render(fmt.Sprintf("%d/%d %q", m.match+1, len(m.matches), m.query))
+		}
+	}
+	if m.follow {
+		footer += "    " + followIndicatorStyle.Render("↓Follow")
+	} else if m.viewport.TotalLineCount() > m.viewport.Height {
+		footer += "    " + hintStyle.Render(fmt.Sprintf("↑%.0f%%", m.viewport.ScrollPercent()*100))
+	}
+	return footer
+}
+
+// refresh re-renders the transcript into the viewport and recomputes matches.
+func (m *Model) refresh() {
+	if m.width == 0 || m.height == 0 {
+		return
+	}
+
+	contentWidth := m.boxWidth() - 2
+	m.viewport.Width = contentWidth
+	// Header (2 lines), footer (2 lines) and borders (2 lines)
+	m.viewport.Height = max(m.height-8, viewportMinHeight)
+
+	lines := m.renderLines(contentWidth)
+	m.matches = nil
+	if m.query != "" {
+		needle := strings.ToLower(m.query)
+		for i, line := range lines {
+			if strings.Contains(strings.ToLower(ansi.Strip(line)), needle) {
+				m.matches = append(m.matches, i)
+			}
+		}
+		if m.match >= len(m.matches) {
+			m.match = 0
+		}
+		if len(m.matches) > 0 {
+			i := m.matches[m.match]
+			lines[i] = matchLineStyle.Render(ansi.Strip(lines[i]))
+		}
+	}
+
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+	if m.follow {
+		m.viewport.GotoBottom()
+	}
+}
+
+// renderLines renders the transcript as display lines.
+func (m Model) renderLines(width int) []string {
+	if len(m.messages) == 0 {
+		return []string{lipgloss.NewStyle().Foreground(styles.TextMutedColor).Italic(true).
+			Render("No output yet")}
+	}
+	content := chatrender.RenderContent(m.messages, width, chatrender.RenderConfig{
+		AgentLabel:              m.workerID,
+		AgentColor:              chatrender.WorkerColor,
+		ShowCoordinatorInWorker: true,
+	})
+	return strings.Split(content, "\n")
+}
+
+// boxWidth returns the box width based on screen size.
+func (m Model) boxWidth() int {
+	return max(min(m.width-4, boxMaxWidth), boxMinWidth)
+}
+
+// exportCmd writes the transcript to a file in the background.
+func (m Model) exportCmd() tea.Cmd {
+	workerID, messages, dir := m.workerID, m.messages, m.exportDir
+	return func() tea.Msg {
+		path, err := Export(dir, workerID, messages, time.Now())
+		return ExportedMsg{Path: path, Err: err}
+	}
+}
+
+// Export writes the transcript as plain text to
+// <dir>/<workerID>-transcript-<timestamp>.md and returns the file path.
+func Export(dir, workerID string, messages []chatrender.Message, now time.Time) (string, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return "", fmt.Errorf("creating export directory: %w", err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Transcript: %s\n\n", workerID)
+	for _, msg := range messages {
+		role := msg.Role
+		if role == "assistant" || role == "" {
+			role = workerID
+		}
+		ts := ""
+		if !msg.Timestamp.IsZero() {
+			ts = msg.Timestamp.Format("15:04:05") + " "
+		}
+		if msg.IsToolCall {
+			fmt.Fprintf(&b, "%s%s: %s\n\n", ts, role, msg.Content)
+			continue
+		}
+		fmt.Fprintf(&b, "%s**%s**\n\n%s\n\n", ts, role, strings.TrimSpace(msg.Content))
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%s-transcript-%s.md", workerID, now.Format("20060102-150405")))
+	if err := os.WriteFile(path, []byte(b.String()), 0o600); err != nil {
+		return "", fmt.Errorf("writing transcript: %w", err)
+	}
+	return path, nil
+}
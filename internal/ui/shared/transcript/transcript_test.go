@@ -0,0 +1,104 @@
+package transcript
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/ui/shared/chatrender"
+)
+
+func testMessages(count int) []chatrender.Message {
+	messages := make([]chatrender.Message, 0, count)
+	for i := range count {
+		messages = append(messages, chatrender.Message{Role: "assistant", Content: fmt.Sprintf("line %d", i)})
+	}
+	return messages
+}
+
+func keyMsg(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestTranscript_FollowTail(t *testing.T) {
+	m := New("worker-1", testMessages(50), t.TempDir()).SetSize(100, 30)
+	require.True(t, m.Following())
+	require.True(t, m.viewport.AtBottom())
+
+	// Scrolling up leaves follow mode; new output no longer moves the viewport
+	m, _ = m.Update(keyMsg("k"))
+	require.False(t, m.Following())
+	offset := m.viewport.YOffset
+	m = m.SetMessages(testMessages(60))
+	require.Equal(t, offset, m.viewport.YOffset)
+
+	// f re-enables follow mode and jumps to the newest output
+	m, _ = m.Update(keyMsg("f"))
+	require.True(t, m.Following())
+	m = m.SetMessages(testMessages(70))
+	require.True(t, m.viewport.AtBottom())
+	require.Contains(t, m.View(), "line 69")
+}
+
+func TestTranscript_Search(t *testing.T) {
+	m := New("worker-1", testMessages(50), t.TempDir()).SetSize(100, 30)
+
+	m, _ = m.Update(keyMsg("/"))
+	require.True(t, m.searching)
+	for _, r := range "line 1" {
+		m, _ = m.Update(keyMsg(string(r)))
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.False(t, m.searching)
+	require.Equal(t, "line 1", m.query)
+	require.Len(t, m.matches, 11) // line 1, line 10..19
+	require.False(t, m.Following())
+	require.Contains(t, m.View(), `1/11 "line 1"`)
+
+	m, _ = m.Update(keyMsg("N"))
+	require.Equal(t, 10, m.match)
+	m, _ = m.Update(keyMsg("n"))
+	require.Equal(t, 0, m.match)
+
+	// Esc clears the search before closing the viewer
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.Nil(t, cmd)
+	require.Empty(t, m.query)
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.IsType(t, CloseMsg{}, cmd())
+}
+
+func TestTranscript_Export(t *testing.T) {
+	dir := t.TempDir()
+	messages := []chatrender.Message{
+		{Role: "coordinator", Content: "Implement the parser"},
+		{Role: "assistant", Content: "🔧 Read: parser.go", IsToolCall: true},
+		{Role: "assistant", Content: "Done"},
+	}
+	m := New("worker-1", messages, dir).SetSize(100, 30)
+
+	_, cmd := m.Update(keyMsg("e"))
+	exported, ok := cmd().(ExportedMsg)
+	require.True(t, ok)
+	require.NoError(t, exported.Err)
+	require.True(t, strings.HasPrefix(exported.Path, dir))
+
+	data, err := os.ReadFile(exported.Path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "# Transcript: worker-1")
+	require.Contains(t, string(data), "**coordinator**\n\nImplement the parser")
+	require.Contains(t, string(data), "worker-1: 🔧 Read: parser.go")
+}
+
+func TestExport_FileName(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	path, err := Export(dir, "worker-2", nil, now)
+	require.NoError(t, err)
+	require.Equal(t, dir+"/worker-2-transcript-20260102-030405.md", path)
+}
@@ -1,5 +1,12 @@
 package vimtextarea
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
 // ExecuteResult indicates the outcome of command execution.
 type ExecuteResult int
 
@@ -108,95 +115,327 @@ func (InsertBase) IsModeChange() bool   { return false }
 // CommandHistory
 // ============================================================================
 
-// CommandHistory manages the command stack for undo/redo operations.
-// It maintains a list of executed commands and an index pointing to the
-// current position in the history. This enables navigating backward (undo)
-// and forward (redo) through command history.
+// DefaultUndoLevels is the number of changes kept in the undo tree when
+// Config.UndoLevels is not set.
+const DefaultUndoLevels = 1000
+
+// undoNode is one change in the undo tree. The root holds no command and
+// stands for the oldest state still reachable.
+type undoNode struct {
+	cmd      Command
+	parent   *undoNode
+	children []*undoNode // in the order they were made
+	redo     *undoNode   // child that Redo follows (the branch last visited)
+	cursor   *Position   // cursor before the command ran, restored before re-executing it
+	seq      int         // change number, increasing in the order changes were made
+	time     time.Time   // when the change was made
+}
+
+// execute re-applies the node's command from the cursor position it first ran at.
+func (n *undoNode) execute(m *Model) {
+	if n.cursor != nil {
+		m.cursorRow, m.cursorCol = n.cursor.Row, n.cursor.Col
+	}
+	_ = n.cmd.Execute(m)
+}
+
+// CommandHistory manages undo/redo as a tree of changes, like Vim's undo tree.
+// Making a change after undoing starts a new branch instead of discarding the
+// undone changes, and Earlier/Later (g- and g+) step through every state in
+// the order the changes were made, crossing branches as needed.
 //
-// The undoIndex works as follows:
+// commands and undoIndex mirror the active branch: the path from the root to
+// the current state, continued along the redo branch.
 //   - -1 means we're at the base state (nothing to undo)
-//   - 0 to len(commands)-1 points to the last executed command
-//   - When undoing, we call Undo on commands[undoIndex] and decrement
-//   - When redoing, we increment and call Execute on commands[undoIndex]
+//   - 0 to len(commands)-1 points to the last applied command
 //
-// When a new command is pushed after undoing, all "future" commands
-// (those after undoIndex) are discarded, as the new command creates
-// a new branch in history.
+// At most limit changes are kept; the oldest are dropped first.
 type CommandHistory struct {
-	commands  []Command // All executed commands
-	undoIndex int       // Current position (-1 = at base state, len-1 = at latest)
+	commands  []Command // Commands on the active branch
+	undoIndex int       // Current position (-1 = at base state)
+
+	root    *undoNode
+	current *undoNode
+	nodes   map[int]*undoNode // change number -> node, root included
+	lastSeq int
+	limit   int
 }
 
-// NewCommandHistory creates an empty command history.
+// NewCommandHistory creates an empty command history keeping DefaultUndoLevels changes.
 func NewCommandHistory() *CommandHistory {
-	return &CommandHistory{
-		commands:  make([]Command, 0),
-		undoIndex: -1,
+	return NewCommandHistoryWithLimit(DefaultUndoLevels)
+}
+
+// NewCommandHistoryWithLimit creates an empty command history that keeps at
+// most limit changes. A limit of zero or less uses DefaultUndoLevels.
+func NewCommandHistoryWithLimit(limit int) *CommandHistory {
+	if limit <= 0 {
+		limit = DefaultUndoLevels
 	}
+	h := &CommandHistory{limit: limit}
+	h.Clear()
+	return h
 }
 
 // Push adds a new command after execution.
 // This should be called AFTER the command has been executed successfully.
-// Clears any "future" commands (invalidates redo stack) since a new
-// command creates a new branch in history.
+// The command becomes a child of the current state; if that state already
+// has children (we undid before this change), the new command starts a new branch.
 func (h *CommandHistory) Push(cmd Command) {
-	// Truncate redo history - new command invalidates future
-	// Keep only commands up to and including undoIndex
-	h.commands = h.commands[:h.undoIndex+1]
-	h.commands = append(h.commands, cmd)
-	h.undoIndex = len(h.commands) - 1
+	h.push(cmd, nil)
+}
+
+// PushAt is Push for a command that was executed with the cursor at cursor.
+// Commands act on the cursor position, so redo puts the cursor back there
+// before re-executing the command.
+func (h *CommandHistory) PushAt(cmd Command, cursor Position) {
+	h.push(cmd, &cursor)
+}
+
+func (h *CommandHistory) push(cmd Command, cursor *Position) {
+	h.lastSeq++
+	node := &undoNode{cmd: cmd, parent: h.current, seq: h.lastSeq, time: time.Now(), cursor: cursor}
+	h.current.children = append(h.current.children, node)
+	h.current.redo = node
+	h.current = node
+	h.nodes[node.seq] = node
+	h.prune()
+	h.sync()
 }
 
 // Undo reverses the last command and moves back in history.
 // Returns nil if there's nothing to undo (at base state).
 // The command's Undo method is called to reverse its effect.
 func (h *CommandHistory) Undo(m *Model) error {
-	if h.undoIndex < 0 {
+	if h.current == h.root {
 		return nil // Nothing to undo
 	}
-	err := h.commands[h.undoIndex].Undo(m)
-	h.undoIndex--
+	node := h.current
+	err := node.cmd.Undo(m)
+	h.current = node.parent
+	h.current.redo = node
+	h.sync()
 	return err
 }
 
-// Redo re-executes the next command and moves forward in history.
+// Redo re-executes the next command on the redo branch and moves forward in history.
 // Returns nil if there's nothing to redo (at latest command).
 // The command's Execute method is called to re-apply its effect.
 func (h *CommandHistory) Redo(m *Model) error {
-	if h.undoIndex >= len(h.commands)-1 {
+	next := h.current.redo
+	if next == nil {
 		return nil // Nothing to redo
 	}
-	h.undoIndex++
-	_ = h.commands[h.undoIndex].Execute(m)
+	next.execute(m)
+	h.current = next
+	h.sync()
 	return nil
 }
 
+// Earlier moves back count states in the order the changes were made (g-).
+// Unlike Undo this can reach states on other branches.
+func (h *CommandHistory) Earlier(m *Model, count int) error {
+	return h.travel(m, h.step(count, false))
+}
+
+// Later moves forward count states in the order the changes were made (g+).
+func (h *CommandHistory) Later(m *Model, count int) error {
+	return h.travel(m, h.step(count, true))
+}
+
 // CanUndo returns true if there are commands to undo.
 func (h *CommandHistory) CanUndo() bool {
-	return h.undoIndex >= 0
+	return h.current != h.root
 }
 
 // CanRedo returns true if there are commands to redo.
 func (h *CommandHistory) CanRedo() bool {
-	return h.undoIndex < len(h.commands)-1
+	return h.current.redo != nil
 }
 
 // Clear resets the command history to empty state.
 // This is typically called when the content is reset or cleared.
 func (h *CommandHistory) Clear() {
-	h.commands = h.commands[:0]
-	h.undoIndex = -1
+	h.root = &undoNode{}
+	h.current = h.root
+	h.nodes = map[int]*undoNode{0: h.root}
+	h.lastSeq = 0
+	h.sync()
 }
 
 // PopLast removes the most recent command from history without undoing it.
 // This is used when we need to retroactively remove a command that shouldn't
 // have been recorded (e.g., a '[' that turned out to be part of an escape sequence).
 func (h *CommandHistory) PopLast() {
-	if h.undoIndex < 0 {
+	if h.current == h.root {
 		return
 	}
-	h.commands = h.commands[:h.undoIndex]
-	h.undoIndex--
+	node := h.current
+	h.current = node.parent
+	h.drop(node)
+	if node.seq == h.lastSeq {
+		h.lastSeq-- // keep change numbers contiguous for the next Push
+	}
+	h.sync()
+}
+
+// UndoState describes the current position in the undo tree, for hosts that
+// display it (e.g. in a modal's status line).
+type UndoState struct {
+	Seq       int       // Change number of the current state (0 = original text)
+	Latest    int       // Highest change number made so far
+	Changes   int       // Changes kept in the tree
+	Branches  int       // Leaves in the tree (1 for linear history)
+	ChangedAt time.Time // When the current state's change was made; zero for the original text
+	CanUndo   bool
+	CanRedo   bool
+}
+
+// String formats the state like "change 3/5, 2 branches, 14:03:05".
+func (s UndoState) String() string {
+	out := fmt.Sprintf("change %d/%d", s.Seq, s.Latest)
+	if s.Branches > 1 {
+		out += fmt.Sprintf(", %d branches", s.Branches)
+	}
+	if !s.ChangedAt.IsZero() {
+		out += ", " + s.ChangedAt.Format("15:04:05")
+	}
+	return out
+}
+
+// State returns the current position in the undo tree.
+func (h *CommandHistory) State() UndoState {
+	branches := 0
+	for _, node := range h.nodes {
+		if len(node.children) == 0 && node != h.root {
+			branches++
+		}
+	}
+	return UndoState{
+		Seq:       h.current.seq,
+		Latest:    h.lastSeq,
+		Changes:   len(h.nodes) - 1,
+		Branches:  branches,
+		ChangedAt: h.current.time,
+		CanUndo:   h.CanUndo(),
+		CanRedo:   h.CanRedo(),
+	}
+}
+
+// step returns the state count changes earlier or later than the current one,
+// stopping at the oldest or newest state kept.
+func (h *CommandHistory) step(count int, later bool) *undoNode {
+	target := h.current
+	for range count {
+		var next *undoNode
+		for seq, node := range h.nodes {
+			if later && seq > target.seq && (next == nil || seq < next.seq) ||
+				!later && seq < target.seq && (next == nil || seq > next.seq) {
+				next = node
+			}
+		}
+		if next == nil {
+			break
+		}
+		target = next
+	}
+	return target
+}
+
+// travel undoes up to the common ancestor of the current state and target,
+// then redoes down to target, pointing each redo branch on the way at it.
+func (h *CommandHistory) travel(m *Model, target *undoNode) error {
+	onPath := make(map[*undoNode]bool)
+	for n := target; n != nil; n = n.parent {
+		onPath[n] = true
+	}
+
+	var errs []error
+	for !onPath[h.current] {
+		node := h.current
+		if err := node.cmd.Undo(m); err != nil {
+			errs = append(errs, err)
+		}
+		h.current = node.parent
+		h.current.redo = node
+	}
+
+	var path []*undoNode
+	for n := target; n != h.current; n = n.parent {
+		path = append(path, n)
+	}
+	for _, node := range slices.Backward(path) {
+		node.parent.redo = node
+		node.execute(m)
+		h.current = node
+	}
+	h.sync()
+	return errors.Join(errs...)
+}
+
+// prune drops the oldest changes until at most limit are kept. The root's
+// oldest child is either dropped with its subtree (when it is off the current
+// path) or becomes the new root, taking the other branches off the old root with it.
+func (h *CommandHistory) prune() {
+	for len(h.nodes)-1 > h.limit {
+		oldest := h.root.children[0]
+		if !h.isCurrentOrAncestor(oldest) {
+			h.drop(oldest)
+			continue
+		}
+		for _, sibling := range h.root.children[1:] {
+			h.forget(sibling)
+		}
+		delete(h.nodes, h.root.seq)
+		oldest.parent = nil
+		oldest.cmd = nil
+		h.root = oldest
+	}
+}
+
+// isCurrentOrAncestor reports whether node is the current state or one of its ancestors.
+func (h *CommandHistory) isCurrentOrAncestor(node *undoNode) bool {
+	for n := h.current; n != nil; n = n.parent {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
+
+// drop unlinks node from its parent and forgets its subtree.
+func (h *CommandHistory) drop(node *undoNode) {
+	parent := node.parent
+	parent.children = slices.DeleteFunc(parent.children, func(n *undoNode) bool { return n == node })
+	if parent.redo == node {
+		parent.redo = nil
+		if len(parent.children) > 0 {
+			parent.redo = parent.children[len(parent.children)-1]
+		}
+	}
+	h.forget(node)
+}
+
+// forget removes node and its descendants from the change index.
+func (h *CommandHistory) forget(node *undoNode) {
+	delete(h.nodes, node.seq)
+	for _, child := range node.children {
+		h.forget(child)
+	}
+}
+
+// sync rebuilds commands and undoIndex from the tree.
+func (h *CommandHistory) sync() {
+	commands := make([]Command, 0)
+	for n := h.current; n != h.root; n = n.parent {
+		commands = append(commands, n.cmd)
+	}
+	slices.Reverse(commands)
+	h.undoIndex = len(commands) - 1
+	for n := h.current.redo; n != nil; n = n.redo {
+		commands = append(commands, n.cmd)
+	}
+	h.commands = commands
 }
 
 // ============================================================================
@@ -317,6 +556,12 @@ func newDefaultPendingRegistry() *PendingCommandRegistry {
 
 	// g prefix commands
 	r.Register('g', "g", &MoveToFirstLineCommand{})
+	r.Register('g', "-", &UndoEarlierCommand{})
+	r.Register('g', "+", &UndoLaterCommand{})
+
+	// z prefix commands (spelling)
+	r.Register('z', "=", &SpellSuggestCommand{})
+	r.Register('z', "g", &SpellAddWordCommand{})
 
 	// d prefix commands (delete operator + motion)
 	r.Register('d', "d", &DeleteLineCommand{})
@@ -495,6 +740,16 @@ func newDefaultPendingRegistry() *PendingCommandRegistry {
 	r.Register('v', "ib", &VisualSelectTextObjectCommand{object: 'b', inner: true})
 	r.Register('v', "ab", &VisualSelectTextObjectCommand{object: 'b', inner: false})
 
+	// Code fence object commands (di`, da`, ci`, ca`, yi`, ya`, vi`, va`)
+	r.Register('d', "i`", &DeleteTextObjectCommand{object: '`', inner: true})
+	r.Register('d', "a`", &DeleteTextObjectCommand{object: '`', inner: false})
+	r.Register('c', "i`", &ChangeTextObjectCommand{object: '`', inner: true})
+	r.Register('c', "a`", &ChangeTextObjectCommand{object: '`', inner: false})
+	r.Register('y', "i`", &YankTextObjectCommand{object: '`', inner: true})
+	r.Register('y', "a`", &YankTextObjectCommand{object: '`', inner: false})
+	r.Register('v', "i`", &VisualSelectTextObjectCommand{object: '`', inner: true})
+	r.Register('v', "a`", &VisualSelectTextObjectCommand{object: '`', inner: false})
+
 	return r
 }
 
@@ -616,15 +871,30 @@ func newDefaultRegistry() *CommandRegistry {
 	// Special key commands
 	r.Register(&UndoCommand{})
 	r.Register(&ConditionalRedoCommand{})
+	r.Register(&RepeatChangeCommand{})
 	r.Register(&StartPendingCommand{operator: 'g'})
 	r.Register(&StartPendingCommand{operator: 'd'})
 	r.Register(&StartPendingCommand{operator: 'c'})
 	r.Register(&StartPendingCommand{operator: 'r'})
 	r.Register(&StartPendingCommand{operator: 'y'})
 	r.Register(&StartPendingCommand{operator: 'v'}) // Visual mode with text object support (viw, vaw, etc.)
+	r.Register(&StartPendingCommand{operator: 'z'}) // Spelling (z=, zg)
 	r.Register(&YankToEOLCommand{})                 // Y is alias for y$
 	r.Register(&NormalModeEscapeCommand{})
 
+	// Register selection ("ayy, "ap, "+y) - also before visual mode operators
+	r.Register(&StartPendingCommand{operator: '"'})
+	r.registerWithModeKeys(ModeVisual, &StartPendingCommand{operator: '"'})
+	r.registerWithModeKeys(ModeVisualLine, &StartPendingCommand{operator: '"'})
+
+	// Search (/, ?) and repeat (n, N) in Normal and visual modes
+	for _, mode := range []Mode{ModeNormal, ModeVisual, ModeVisualLine} {
+		r.Register(&StartSearchCommand{forward: true, mode: mode})
+		r.Register(&StartSearchCommand{forward: false, mode: mode})
+		r.Register(&SearchNextCommand{reverse: false, mode: mode})
+		r.Register(&SearchNextCommand{reverse: true, mode: mode})
+	}
+
 	// ============================================================================
 	// Insert Mode Commands
 	// ============================================================================
@@ -642,6 +912,7 @@ func newDefaultRegistry() *CommandRegistry {
 	r.Register(&ArrowRightCommand{})
 	r.Register(&ArrowUpCommand{})
 	r.Register(&ArrowDownCommand{})
+	r.Register(&SpellSuggestCommand{})
 
 	// Arrow keys and Ctrl+B/F for Normal mode (reuse Insert mode commands)
 	r.registerWithModeKeys(ModeNormal, &ArrowLeftCommand{})
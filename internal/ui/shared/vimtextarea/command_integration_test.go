@@ -311,14 +311,166 @@ func TestCommandHistory_BranchingHistory(t *testing.T) {
 	_ = h.Undo(m) // undo B
 	assert.Equal(t, 0, h.undoIndex)
 
-	// Push D - creates new branch, B and C are lost
+	// Push D - creates new branch, B and C stay on the older branch
 	h.Push(cmdD)
 	assert.Len(t, h.commands, 2)
 	assert.Same(t, cmdA, h.commands[0])
 	assert.Same(t, cmdD, h.commands[1])
 	assert.Equal(t, 1, h.undoIndex)
 
-	// Verify B and C are no longer accessible
+	// Verify B and C are no longer reachable by redo
+	assert.False(t, h.CanRedo())
+
+	// ...but time travel still reaches them: D is change 4, C is change 3
+	require.NoError(t, h.Earlier(m, 1))
+	assert.True(t, cmdD.undone)
+	assert.True(t, cmdC.executed)
+	assert.Equal(t, []Command{cmdA, cmdB, cmdC}, h.commands)
+	assert.Equal(t, 2, h.undoIndex)
+}
+
+// TestCommandHistory_EarlierLater verifies g-/g+ walk states in the order changes were made.
+func TestCommandHistory_EarlierLater(t *testing.T) {
+	h := NewCommandHistory()
+	m := &Model{}
+	cmdA := newMockCommand("A")
+	cmdB := newMockCommand("B")
+	cmdC := newMockCommand("C")
+
+	// A, then undo and make B and C on a second branch: root -> A, root -> B -> C
+	h.Push(cmdA)
+	_ = h.Undo(m)
+	h.Push(cmdB)
+	h.Push(cmdC)
+	require.Equal(t, 3, h.State().Seq)
+
+	require.NoError(t, h.Earlier(m, 1))
+	assert.Equal(t, 2, h.State().Seq)
+	assert.True(t, cmdC.undone)
+
+	require.NoError(t, h.Earlier(m, 1))
+	assert.Equal(t, 1, h.State().Seq)
+	assert.True(t, cmdB.undone)
+	assert.True(t, cmdA.executed)
+
+	// Count past the oldest state stops at the original text
+	require.NoError(t, h.Earlier(m, 5))
+	assert.Equal(t, 0, h.State().Seq)
+	assert.True(t, cmdA.undone)
+	assert.False(t, h.CanUndo())
+
+	require.NoError(t, h.Later(m, 3))
+	assert.Equal(t, 3, h.State().Seq)
+	assert.True(t, cmdB.executed)
+	assert.True(t, cmdC.executed)
+	assert.Equal(t, []Command{cmdB, cmdC}, h.commands)
+
+	// Later at the newest state is a no-op
+	require.NoError(t, h.Later(m, 1))
+	assert.Equal(t, 3, h.State().Seq)
+}
+
+// TestCommandHistory_RedoFollowsLastBranch verifies redo after time travel follows the branch last visited.
+func TestCommandHistory_RedoFollowsLastBranch(t *testing.T) {
+	h := NewCommandHistory()
+	m := &Model{}
+	cmdA := newMockCommand("A")
+	cmdB := newMockCommand("B")
+
+	h.Push(cmdA)
+	_ = h.Undo(m)
+	h.Push(cmdB)
+
+	require.NoError(t, h.Earlier(m, 1)) // at A
+	_ = h.Undo(m)
+	_ = h.Redo(m)
+	assert.True(t, cmdA.executed)
+	assert.Equal(t, 1, h.State().Seq)
+}
+
+// TestCommandHistory_State verifies the undo state reported to hosts.
+func TestCommandHistory_State(t *testing.T) {
+	h := NewCommandHistory()
+	m := &Model{}
+
+	state := h.State()
+	assert.Equal(t, UndoState{}, state)
+	assert.Equal(t, "change 0/0", state.String())
+
+	h.Push(newMockCommand("A"))
+	_ = h.Undo(m)
+	h.Push(newMockCommand("B"))
+	_ = h.Undo(m)
+
+	state = h.State()
+	assert.Equal(t, 0, state.Seq)
+	assert.Equal(t, 2, state.Latest)
+	assert.Equal(t, 2, state.Changes)
+	assert.Equal(t, 2, state.Branches)
+	assert.True(t, state.ChangedAt.IsZero())
+	assert.False(t, state.CanUndo)
+	assert.True(t, state.CanRedo)
+	assert.Equal(t, "change 0/2, 2 branches", state.String())
+
+	_ = h.Redo(m)
+	state = h.State()
+	assert.Equal(t, 2, state.Seq)
+	assert.False(t, state.ChangedAt.IsZero())
+	assert.Contains(t, state.String(), "change 2/2, 2 branches, ")
+}
+
+// TestCommandHistory_Limit verifies the oldest changes are dropped once the cap is reached.
+func TestCommandHistory_Limit(t *testing.T) {
+	h := NewCommandHistoryWithLimit(3)
+	m := &Model{}
+	cmds := []*mockCommand{newMockCommand("1"), newMockCommand("2"), newMockCommand("3"), newMockCommand("4")}
+
+	for _, cmd := range cmds {
+		h.Push(cmd)
+	}
+	assert.Equal(t, 3, h.State().Changes)
+	assert.Equal(t, []Command{cmds[1], cmds[2], cmds[3]}, h.commands)
+
+	// Undo stops at the oldest kept state
+	for range 5 {
+		_ = h.Undo(m)
+	}
+	assert.False(t, cmds[0].undone)
+	assert.True(t, cmds[1].undone)
+	assert.Equal(t, 1, h.State().Seq)
+}
+
+// TestCommandHistory_LimitDropsOldBranchFirst verifies a branch off the current path is dropped whole.
+func TestCommandHistory_LimitDropsOldBranchFirst(t *testing.T) {
+	h := NewCommandHistoryWithLimit(2)
+	m := &Model{}
+	cmdA := newMockCommand("A")
+	cmdB := newMockCommand("B")
+	cmdC := newMockCommand("C")
+
+	h.Push(cmdA)
+	_ = h.Undo(m)
+	h.Push(cmdB)
+	h.Push(cmdC) // drops the A branch, keeps B -> C
+
+	assert.Equal(t, 2, h.State().Changes)
+	assert.Equal(t, 1, h.State().Branches)
+	require.NoError(t, h.Earlier(m, 5))
+	assert.Equal(t, 0, h.State().Seq)
+	assert.False(t, cmdA.executed)
+}
+
+// TestCommandHistory_PopLast verifies PopLast drops the current change without undoing it.
+func TestCommandHistory_PopLast(t *testing.T) {
+	h := NewCommandHistory()
+	cmdA := newMockCommand("A")
+
+	h.Push(cmdA)
+	h.Push(newMockCommand("B"))
+	h.PopLast()
+
+	assert.Equal(t, []Command{cmdA}, h.commands)
+	assert.Equal(t, 1, h.State().Latest)
 	assert.False(t, h.CanRedo())
 }
 
@@ -38,8 +38,7 @@ func (c *DeleteCharCommand) Execute(m *Model) ExecuteResult {
 	m.content[c.row] = SliceByGraphemes(line, 0, c.col) + SliceByGraphemes(line, c.col+1, graphemeCount)
 
 	// Populate yank register (vim behavior: deletes also yank)
-	m.lastYankedText = c.deletedGrapheme
-	m.lastYankWasLinewise = false
+	m.storeDelete(c.deletedGrapheme, false)
 
 	// If cursor is now past end of line, move back
 	newGraphemeCount := GraphemeCount(m.content[m.cursorRow])
@@ -102,8 +101,7 @@ func (c *DeleteLineCommand) Execute(m *Model) ExecuteResult {
 	c.wasLastLine = m.cursorRow == len(m.content)-1
 
 	// Populate yank register (vim behavior: deletes also yank)
-	m.lastYankedText = c.deletedLine
-	m.lastYankWasLinewise = true
+	m.storeDelete(c.deletedLine, true)
 
 	if c.wasOnlyLine {
 		// Only one line - clear it but keep empty line
@@ -197,8 +195,7 @@ func (c *DeleteToEOLCommand) Execute(m *Model) ExecuteResult {
 	m.content[c.row] = SliceByGraphemes(line, 0, c.col)
 
 	// Populate yank register (vim behavior: deletes also yank)
-	m.lastYankedText = c.deletedText
-	m.lastYankWasLinewise = false
+	m.storeDelete(c.deletedText, false)
 
 	// Move cursor back one if we're now past the end
 	newGraphemeCount := GraphemeCount(m.content[m.cursorRow])
@@ -281,8 +278,7 @@ func (c *DeleteWordCommand) Execute(m *Model) ExecuteResult {
 	}
 
 	// Populate yank register (vim behavior: deletes also yank)
-	m.lastYankedText = c.deletedText
-	m.lastYankWasLinewise = false
+	m.storeDelete(c.deletedText, false)
 
 	// Clamp cursor (using grapheme count)
 	newGraphemeCount := GraphemeCount(m.content[m.cursorRow])
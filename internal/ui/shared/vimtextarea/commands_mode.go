@@ -315,7 +315,8 @@ func (c *EscapeCommand) ID() string {
 }
 
 // NormalModeEscapeCommand handles ESC in Normal mode.
-// It clears pending commands and passes through to parent for quit handling.
+// It clears pending commands and search highlighting, then passes through
+// to parent for quit handling.
 type NormalModeEscapeCommand struct {
 	MotionBase
 }
@@ -323,6 +324,7 @@ type NormalModeEscapeCommand struct {
 // Execute clears pending commands and returns PassThrough.
 func (c *NormalModeEscapeCommand) Execute(m *Model) ExecuteResult {
 	m.pendingBuilder.Clear()
+	m.searchHighlight = false
 	return PassThrough
 }
 
@@ -7,7 +7,8 @@ import "strings"
 // ============================================================================
 
 // PasteAfterCommand pastes text after cursor (p command).
-// Behavior differs based on lastYankWasLinewise:
+// Pastes the selected register ("ap), or the unnamed register by default.
+// Behavior differs based on whether the register is line-wise:
 // - Character-wise: insert after cursor position on same line
 // - Line-wise: insert new line below current line
 type PasteAfterCommand struct {
@@ -27,13 +28,14 @@ type PasteAfterCommand struct {
 // Execute pastes text after cursor.
 func (c *PasteAfterCommand) Execute(m *Model) ExecuteResult {
 	// Skip if nothing to paste
-	if m.lastYankedText == "" {
+	reg, ok := m.pasteRegister()
+	if !ok {
 		return Skipped
 	}
 
 	// Capture state for undo
-	c.pastedText = m.lastYankedText
-	c.wasLinewise = m.lastYankWasLinewise
+	c.pastedText = reg.Text
+	c.wasLinewise = reg.Linewise
 	c.originalRow = m.cursorRow
 	c.originalCol = m.cursorCol
 
@@ -224,7 +226,8 @@ func (c *PasteAfterCommand) ID() string {
 // ============================================================================
 
 // PasteBeforeCommand pastes text before cursor (P command).
-// Behavior differs based on lastYankWasLinewise:
+// Pastes the selected register ("ap), or the unnamed register by default.
+// Behavior differs based on whether the register is line-wise:
 // - Character-wise: insert before cursor position on same line
 // - Line-wise: insert new line above current line
 type PasteBeforeCommand struct {
@@ -244,13 +247,14 @@ type PasteBeforeCommand struct {
 // Execute pastes text before cursor.
 func (c *PasteBeforeCommand) Execute(m *Model) ExecuteResult {
 	// Skip if nothing to paste
-	if m.lastYankedText == "" {
+	reg, ok := m.pasteRegister()
+	if !ok {
 		return Skipped
 	}
 
 	// Capture state for undo
-	c.pastedText = m.lastYankedText
-	c.wasLinewise = m.lastYankWasLinewise
+	c.pastedText = reg.Text
+	c.wasLinewise = reg.Linewise
 	c.originalRow = m.cursorRow
 	c.originalCol = m.cursorCol
 
@@ -139,6 +139,123 @@ func (c *RedoCommand) ChangesContent() bool { return true }
 // IsModeChange returns false - redo doesn't change mode.
 func (c *RedoCommand) IsModeChange() bool { return false }
 
+// UndoEarlierCommand moves to the previous state in time, across undo branches (g-).
+type UndoEarlierCommand struct{}
+
+// Execute goes back one state in the order changes were made.
+func (c *UndoEarlierCommand) Execute(m *Model) ExecuteResult {
+	_ = m.history.Earlier(m, 1)
+	return Executed
+}
+
+// Undo returns nil - time travel is not undoable (use g+).
+func (c *UndoEarlierCommand) Undo(m *Model) error {
+	return nil
+}
+
+// Keys returns the trigger keys for this command.
+func (c *UndoEarlierCommand) Keys() []string {
+	return []string{"g-"}
+}
+
+// Mode returns the mode this command operates in.
+func (c *UndoEarlierCommand) Mode() Mode {
+	return ModeNormal
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *UndoEarlierCommand) ID() string {
+	return "history.earlier"
+}
+
+// IsUndoable returns false - time travel is not added to history (it manipulates history).
+func (c *UndoEarlierCommand) IsUndoable() bool { return false }
+
+// ChangesContent returns true - moving to another state changes content.
+func (c *UndoEarlierCommand) ChangesContent() bool { return true }
+
+// IsModeChange returns false - time travel doesn't change mode.
+func (c *UndoEarlierCommand) IsModeChange() bool { return false }
+
+// UndoLaterCommand moves to the next state in time, across undo branches (g+).
+type UndoLaterCommand struct{}
+
+// Execute goes forward one state in the order changes were made.
+func (c *UndoLaterCommand) Execute(m *Model) ExecuteResult {
+	_ = m.history.Later(m, 1)
+	return Executed
+}
+
+// Undo returns nil - time travel is not undoable (use g-).
+func (c *UndoLaterCommand) Undo(m *Model) error {
+	return nil
+}
+
+// Keys returns the trigger keys for this command.
+func (c *UndoLaterCommand) Keys() []string {
+	return []string{"g+"}
+}
+
+// Mode returns the mode this command operates in.
+func (c *UndoLaterCommand) Mode() Mode {
+	return ModeNormal
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *UndoLaterCommand) ID() string {
+	return "history.later"
+}
+
+// IsUndoable returns false - time travel is not added to history (it manipulates history).
+func (c *UndoLaterCommand) IsUndoable() bool { return false }
+
+// ChangesContent returns true - moving to another state changes content.
+func (c *UndoLaterCommand) ChangesContent() bool { return true }
+
+// IsModeChange returns false - time travel doesn't change mode.
+func (c *UndoLaterCommand) IsModeChange() bool { return false }
+
+// RepeatChangeCommand repeats the last change ('.'). A count repeats it that many times.
+type RepeatChangeCommand struct{}
+
+// Execute replays the keys of the last change.
+func (c *RepeatChangeCommand) Execute(m *Model) ExecuteResult {
+	if len(m.lastChange) == 0 {
+		return Skipped
+	}
+	m.repeatLastChange(max(m.count, 1))
+	return Executed
+}
+
+// Undo returns nil - the repeated change is recorded in history on its own.
+func (c *RepeatChangeCommand) Undo(m *Model) error {
+	return nil
+}
+
+// Keys returns the trigger keys for this command.
+func (c *RepeatChangeCommand) Keys() []string {
+	return []string{"."}
+}
+
+// Mode returns the mode this command operates in.
+func (c *RepeatChangeCommand) Mode() Mode {
+	return ModeNormal
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *RepeatChangeCommand) ID() string {
+	return "history.repeat"
+}
+
+// IsUndoable returns false - the replayed commands are added to history themselves.
+func (c *RepeatChangeCommand) IsUndoable() bool { return false }
+
+// ChangesContent returns true - repeating a change changes content.
+func (c *RepeatChangeCommand) ChangesContent() bool { return true }
+
+// IsModeChange returns false - a repeated change ends back in Normal mode.
+func (c *RepeatChangeCommand) IsModeChange() bool { return false }
+
 // ConditionalRedoCommand only executes redo when redo history is available.
 // If no redo is available, it returns PassThrough so parent can handle Ctrl+R.
 type ConditionalRedoCommand struct{}
@@ -365,3 +365,134 @@ func TestSubmitCommand_Metadata(t *testing.T) {
 	require.False(t, cmd.IsModeChange())
 	require.True(t, cmd.IsSubmit())
 }
+
+// ============================================================================
+// Undo tree time travel (g-/g+) Tests
+// ============================================================================
+
+// TestUndoEarlierLater_Keys verifies g- and g+ reach changes on undone branches
+func TestUndoEarlierLater_Keys(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal})
+	m.SetValue("abc")
+	m.Focus()
+
+	m = typeKeys(m, "x") // "bc"
+	m = typeKeys(m, "u") // "abc"
+	m = typeKeys(m, "$x")
+	require.Equal(t, "ab", m.Value())
+	require.Equal(t, 2, m.UndoState().Seq)
+
+	m = typeKeys(m, "g-")
+	require.Equal(t, "bc", m.Value(), "g- crosses to the undone branch")
+	m = typeKeys(m, "g-")
+	require.Equal(t, "abc", m.Value())
+	m = typeKeys(m, "g+g+")
+	require.Equal(t, "ab", m.Value())
+	require.Equal(t, ModeNormal, m.Mode())
+}
+
+// TestUndoLevels_Config verifies Config.UndoLevels caps the undo tree
+func TestUndoLevels_Config(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, UndoLevels: 2})
+	m.SetValue("abcd")
+	m.Focus()
+
+	m = typeKeys(m, "xxx")
+	require.Equal(t, "d", m.Value())
+	m = typeKeys(m, "uuuu")
+	require.Equal(t, "bcd", m.Value(), "the oldest change is no longer undoable")
+	require.Equal(t, 2, m.UndoState().Changes)
+}
+
+// ============================================================================
+// RepeatChangeCommand ('.') Tests
+// ============================================================================
+
+func newRepeatTestModel(content string) Model {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal})
+	m.SetValue(content)
+	m.Focus()
+	return m
+}
+
+// TestRepeatChange_DeleteChar verifies '.' repeats x
+func TestRepeatChange_DeleteChar(t *testing.T) {
+	m := newRepeatTestModel("abcdef")
+
+	m = typeKeys(m, "x.")
+	require.Equal(t, "cdef", m.Value())
+}
+
+// TestRepeatChange_ChangeWithInsertedText verifies '.' repeats an operator, its text object and the inserted text
+func TestRepeatChange_ChangeWithInsertedText(t *testing.T) {
+	m := newRepeatTestModel("one two three")
+
+	m = typeKeys(m, "ciwONE")
+	m, _ = m.Update(escapeKey())
+	require.Equal(t, "ONE two three", m.Value())
+
+	m = typeKeys(m, "w.")
+	require.Equal(t, "ONE ONE three", m.Value())
+	require.Equal(t, ModeNormal, m.Mode())
+}
+
+// TestRepeatChange_Count verifies a count repeats the change that many times
+func TestRepeatChange_Count(t *testing.T) {
+	m := newRepeatTestModel("abcdefgh")
+
+	m = typeKeys(m, "x3.")
+	require.Equal(t, "efgh", m.Value())
+
+	// Count digits do not leak into the next '.'
+	m = typeKeys(m, ".")
+	require.Equal(t, "fgh", m.Value())
+}
+
+// TestRepeatChange_Visual verifies '.' repeats a change made from Visual mode
+func TestRepeatChange_Visual(t *testing.T) {
+	m := newRepeatTestModel("aabbcc")
+
+	m = typeKeys(m, "vld")
+	require.Equal(t, "bbcc", m.Value())
+	m = typeKeys(m, ".")
+	require.Equal(t, "cc", m.Value())
+}
+
+// TestRepeatChange_IgnoresNonChanges verifies yanks, motions and undo don't replace the last change
+func TestRepeatChange_IgnoresNonChanges(t *testing.T) {
+	m := newRepeatTestModel("abcdef")
+
+	m = typeKeys(m, "x")
+	m = typeKeys(m, "yiwlu")
+	require.Equal(t, "abcdef", m.Value())
+
+	m = typeKeys(m, "0.")
+	require.Equal(t, "bcdef", m.Value())
+}
+
+// TestRepeatChange_UndoesAsChange verifies u undoes a repeated change
+func TestRepeatChange_UndoesAsChange(t *testing.T) {
+	m := newRepeatTestModel("abc")
+
+	m = typeKeys(m, "x.")
+	require.Equal(t, "c", m.Value())
+	m = typeKeys(m, "u")
+	require.Equal(t, "bc", m.Value())
+}
+
+// TestRepeatChange_NothingToRepeat verifies '.' without a previous change is a no-op
+func TestRepeatChange_NothingToRepeat(t *testing.T) {
+	m := newRepeatTestModel("abc")
+
+	m = typeKeys(m, "5.")
+	require.Equal(t, "abc", m.Value())
+	require.Zero(t, m.count)
+}
+
+// TestCount_ZeroIsLineStartWithoutCount verifies '0' stays the line-start motion
+func TestCount_ZeroIsLineStartWithoutCount(t *testing.T) {
+	m := newRepeatTestModel("abcdef")
+
+	m = typeKeys(m, "$0x")
+	require.Equal(t, "bcdef", m.Value())
+}
@@ -0,0 +1,134 @@
+package vimtextarea
+
+// ============================================================================
+// Spell Commands
+// ============================================================================
+
+// SpellSuggestCommand opens the suggestions menu for the misspelled word under
+// (or just before) the cursor: z= in Normal mode, Ctrl+L in Insert mode.
+// Passes through when spell checking is disabled or there is nothing to correct.
+type SpellSuggestCommand struct {
+	MotionBase
+}
+
+// Execute opens the suggestions menu.
+func (c *SpellSuggestCommand) Execute(m *Model) ExecuteResult {
+	if !m.openSpellMenu() {
+		return PassThrough
+	}
+	return Executed
+}
+
+// Keys returns the trigger keys for this command.
+// Note: z= is registered in the pending registry.
+func (c *SpellSuggestCommand) Keys() []string {
+	return []string{"<ctrl+l>"}
+}
+
+// Mode returns the mode this command operates in.
+func (c *SpellSuggestCommand) Mode() Mode {
+	return ModeInsert
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *SpellSuggestCommand) ID() string {
+	return "spell.suggest"
+}
+
+// SpellAddWordCommand accepts the misspelled word under the cursor by adding it
+// to the dictionary for the rest of the session (zg).
+// Skipped if the spell checker does not support adding words.
+type SpellAddWordCommand struct {
+	MotionBase
+}
+
+// Execute adds the word to the dictionary.
+func (c *SpellAddWordCommand) Execute(m *Model) ExecuteResult {
+	adder, ok := m.spell.(interface{ Add(words ...string) })
+	if !ok {
+		return Skipped
+	}
+	w, ok := m.misspellingNearCursor()
+	if !ok {
+		return Skipped
+	}
+	adder.Add(w.Word)
+	return Executed
+}
+
+// Keys returns the trigger keys for this command.
+func (c *SpellAddWordCommand) Keys() []string {
+	return []string{"zg"}
+}
+
+// Mode returns the mode this command operates in.
+func (c *SpellAddWordCommand) Mode() Mode {
+	return ModeNormal
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *SpellAddWordCommand) ID() string {
+	return "spell.add"
+}
+
+// ReplaceSpellingCommand replaces a misspelled word with a chosen suggestion.
+// In Normal mode the cursor lands on the start of the word; in Insert mode
+// it lands after the word so typing can continue.
+type ReplaceSpellingCommand struct {
+	DeleteBase
+	row         int    // Row of the word
+	startCol    int    // Grapheme index of the word's first character
+	endCol      int    // Grapheme index after the word's last character
+	replacement string // The chosen suggestion
+	original    string // The replaced word (for undo)
+	cursorCol   int    // Cursor column before replacement (for undo)
+}
+
+// Execute replaces the word.
+func (c *ReplaceSpellingCommand) Execute(m *Model) ExecuteResult {
+	if c.row >= len(m.content) {
+		return Skipped
+	}
+	line := m.content[c.row]
+	if c.endCol > GraphemeCount(line) || c.startCol >= c.endCol {
+		return Skipped
+	}
+
+	c.original = SliceByGraphemes(line, c.startCol, c.endCol)
+	c.cursorCol = m.cursorCol
+	m.content[c.row] = SliceByGraphemes(line, 0, c.startCol) + c.replacement + SliceByGraphemes(line, c.endCol, GraphemeCount(line))
+
+	m.cursorRow = c.row
+	if m.mode == ModeInsert {
+		m.cursorCol = c.startCol + GraphemeCount(c.replacement)
+	} else {
+		m.cursorCol = c.startCol
+	}
+	return Executed
+}
+
+// Undo restores the misspelled word.
+func (c *ReplaceSpellingCommand) Undo(m *Model) error {
+	line := m.content[c.row]
+	end := c.startCol + GraphemeCount(c.replacement)
+	m.content[c.row] = SliceByGraphemes(line, 0, c.startCol) + c.original + SliceByGraphemes(line, end, GraphemeCount(line))
+	m.cursorRow = c.row
+	m.cursorCol = c.cursorCol
+	return nil
+}
+
+// Keys returns the trigger keys for this command.
+// Note: Suggestions are picked with 1-9 from the menu opened by SpellSuggestCommand.
+func (c *ReplaceSpellingCommand) Keys() []string {
+	return []string{"z="}
+}
+
+// Mode returns the mode this command operates in.
+func (c *ReplaceSpellingCommand) Mode() Mode {
+	return ModeNormal
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *ReplaceSpellingCommand) ID() string {
+	return "spell.replace"
+}
@@ -40,37 +40,20 @@ func (c *DeleteTextObjectCommand) Execute(m *Model) ExecuteResult {
 	c.endPos = end
 	c.deletedText = extractText(m.content, start, end)
 
-	// Delete the text object
-	line := m.content[start.Row]
-	// end.Col is inclusive, so we delete up to and including end.Col
-	newLine := line[:start.Col] + line[end.Col+1:]
-	m.content[start.Row] = newLine
+	// Delete the text object (character-wise, may span lines for code fences);
+	// the cursor ends at the deletion point, clamped to the line
+	m.deleteSelection(start, end, false)
 
 	// Update yank register (vim behavior: deletes also yank)
-	m.lastYankedText = c.deletedText
-	m.lastYankWasLinewise = false
-
-	// Position cursor at deletion point
-	m.cursorRow = start.Row
-	m.cursorCol = start.Col
-
-	// Clamp cursor if it's past end of line
-	if m.cursorCol > 0 && m.cursorCol >= len(m.content[m.cursorRow]) {
-		m.cursorCol = len(m.content[m.cursorRow]) - 1
-	}
-	if m.cursorCol < 0 {
-		m.cursorCol = 0
-	}
+	m.storeDelete(c.deletedText, false)
 
 	return Executed
 }
 
 // Undo restores the deleted text.
 func (c *DeleteTextObjectCommand) Undo(m *Model) error {
-	line := m.content[c.startPos.Row]
-
 	// Restore deleted text at the original position
-	m.content[c.startPos.Row] = line[:c.startPos.Col] + c.deletedText + line[c.startPos.Col:]
+	m.insertTextAt(c.startPos, c.deletedText)
 
 	// Restore cursor position
 	m.cursorRow = c.row
@@ -136,13 +119,10 @@ func (c *ChangeTextObjectCommand) Execute(m *Model) ExecuteResult {
 	c.endPos = end
 	c.deletedText = extractText(m.content, start, end)
 
-	// Delete the text object
-	line := m.content[start.Row]
-	// end.Col is inclusive, so we delete up to and including end.Col
-	newLine := line[:start.Col] + line[end.Col+1:]
-	m.content[start.Row] = newLine
+	// Delete the text object (character-wise, may span lines for code fences)
+	m.deleteSelection(start, end, false)
 
-	// Position cursor at deletion point
+	// Position cursor at deletion point (insert mode allows the end of line)
 	m.cursorRow = start.Row
 	m.cursorCol = start.Col
 
@@ -154,10 +134,8 @@ func (c *ChangeTextObjectCommand) Execute(m *Model) ExecuteResult {
 
 // Undo restores the deleted text and returns to normal mode.
 func (c *ChangeTextObjectCommand) Undo(m *Model) error {
-	line := m.content[c.startPos.Row]
-
 	// Restore deleted text at the original position
-	m.content[c.startPos.Row] = line[:c.startPos.Col] + c.deletedText + line[c.startPos.Col:]
+	m.insertTextAt(c.startPos, c.deletedText)
 
 	// Restore cursor position and mode
 	m.cursorRow = c.row
@@ -223,12 +201,10 @@ func (c *YankTextObjectCommand) Execute(m *Model) ExecuteResult {
 	c.highlightEnd = end
 
 	// Yank the text without modifying content
-	m.lastYankedText = extractText(m.content, start, end)
-	m.lastYankWasLinewise = false
-	c.showHighlight = len(m.lastYankedText) > 0
-
-	// Copy to system clipboard (no-op if clipboard is nil)
-	m.copyToSystemClipboard(m.lastYankedText)
+	// (also copied to the system clipboard unless a named register is selected)
+	text := extractText(m.content, start, end)
+	m.storeYank(text, false)
+	c.showHighlight = len(text) > 0
 
 	return Executed
 }
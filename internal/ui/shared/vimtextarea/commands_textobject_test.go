@@ -372,7 +372,7 @@ func TestChangeTextObjectCommand_BaseProperties(t *testing.T) {
 // ============================================================================
 
 func TestTextObjectCommands_RegisteredInPendingRegistry(t *testing.T) {
-	// Verify diw, daw, ciw, caw are registered
+	// Verify diw, daw, ciw, caw and the code fence objects are registered
 	tests := []struct {
 		operator rune
 		sequence string
@@ -382,6 +382,10 @@ func TestTextObjectCommands_RegisteredInPendingRegistry(t *testing.T) {
 		{'d', "aw", false},
 		{'c', "iw", true},
 		{'c', "aw", false},
+		{'d', "i`", true},
+		{'c', "a`", false},
+		{'y', "i`", true},
+		{'v', "a`", false},
 	}
 
 	for _, tc := range tests {
@@ -2059,3 +2063,84 @@ func TestYankTextObjectCommand_ClipboardError_StillSetsInternalRegister(t *testi
 	// Internal register should still be set
 	require.Equal(t, "hello", m.lastYankedText)
 }
+
+// ============================================================================
+// Grapheme and multi-line text objects
+// ============================================================================
+
+func TestDeleteTextObjectCommand_GraphemeSafe(t *testing.T) {
+	m := newTestModelWithContent(`say "héllo 👋" ok`)
+	m.cursorCol = 7
+
+	cmd := &DeleteTextObjectCommand{object: '"', inner: true}
+	require.Equal(t, Executed, cmd.Execute(m))
+	require.Equal(t, `say "" ok`, m.content[0])
+	require.Equal(t, 5, m.cursorCol)
+
+	require.NoError(t, cmd.Undo(m))
+	require.Equal(t, `say "héllo 👋" ok`, m.content[0])
+}
+
+func TestChangeTextObjectCommand_GraphemeSafe(t *testing.T) {
+	m := newTestModelWithContent("f(café, ü)")
+	m.cursorCol = 4
+
+	cmd := &ChangeTextObjectCommand{object: '(', inner: true}
+	require.Equal(t, Executed, cmd.Execute(m))
+	require.Equal(t, "f()", m.content[0])
+	require.Equal(t, 2, m.cursorCol)
+	require.Equal(t, ModeInsert, m.mode)
+
+	require.NoError(t, cmd.Undo(m))
+	require.Equal(t, "f(café, ü)", m.content[0])
+}
+
+func TestChangeTextObjectCommand_CodeFence(t *testing.T) {
+	m := newTestModelWithContent("```go", "x := 1", "y := 2", "```")
+	m.cursorRow = 1
+
+	cmd := &ChangeTextObjectCommand{object: '`', inner: true}
+	require.Equal(t, Executed, cmd.Execute(m))
+	require.Equal(t, []string{"```go", "", "```"}, m.content)
+	require.Equal(t, Position{Row: 1, Col: 0}, Position{Row: m.cursorRow, Col: m.cursorCol})
+	require.Equal(t, ModeInsert, m.mode)
+
+	require.NoError(t, cmd.Undo(m))
+	require.Equal(t, []string{"```go", "x := 1", "y := 2", "```"}, m.content)
+}
+
+func TestDeleteTextObjectCommand_AroundCodeFence(t *testing.T) {
+	m := newTestModelWithContent("before", "```", "code", "```", "after")
+	m.cursorRow = 2
+
+	cmd := &DeleteTextObjectCommand{object: '`', inner: false}
+	require.Equal(t, Executed, cmd.Execute(m))
+	require.Equal(t, []string{"before", "", "after"}, m.content)
+	require.Equal(t, "```\ncode\n```", m.lastYankedText)
+
+	require.NoError(t, cmd.Undo(m))
+	require.Equal(t, []string{"before", "```", "code", "```", "after"}, m.content)
+}
+
+func TestYankTextObjectCommand_CodeFence(t *testing.T) {
+	m := newTestModelWithContent("```", "a", "b", "```")
+	m.cursorRow = 2
+
+	cmd := &YankTextObjectCommand{object: '`', inner: true}
+	require.Equal(t, Executed, cmd.Execute(m))
+	require.Equal(t, "a\nb", m.lastYankedText)
+	require.Equal(t, []string{"```", "a", "b", "```"}, m.content)
+}
+
+func TestTextObjectCommands_CodeFenceKeys(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal})
+	m.SetValue("text\n```\nold\n```")
+	m.Focus()
+
+	m = typeKeys(m, "jjci`new")
+	require.Equal(t, "text\n```\nnew\n```", m.Value())
+
+	m, _ = m.Update(escapeKey())
+	m = typeKeys(m, "vi`")
+	require.Equal(t, ModeVisual, m.Mode())
+}
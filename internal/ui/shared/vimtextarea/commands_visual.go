@@ -67,8 +67,7 @@ func (c *VisualDeleteCommand) Execute(m *Model) ExecuteResult {
 	c.deletedContent = m.deleteSelection(start, end, c.wasLinewise)
 
 	// Populate yank register (vim behavior: deletes also yank)
-	m.lastYankedText = strings.Join(c.deletedContent, "\n")
-	m.lastYankWasLinewise = c.wasLinewise
+	m.storeDelete(strings.Join(c.deletedContent, "\n"), c.wasLinewise)
 
 	// Mark as executed for redo detection
 	c.executed = true
@@ -256,15 +255,11 @@ func (c *VisualYankCommand) Execute(m *Model) ExecuteResult {
 		return Executed
 	}
 
-	// Store the yanked text in the model
-	m.lastYankedText = selectedText
+	// Store the yanked text in the register (and the system clipboard if available)
 	c.wasLinewise = m.mode == ModeVisualLine
-	m.lastYankWasLinewise = c.wasLinewise
+	m.storeYank(selectedText, c.wasLinewise)
 	c.yankedText = selectedText
 
-	// Copy to system clipboard if available
-	m.copyToSystemClipboard(m.lastYankedText)
-
 	// Capture selection bounds for highlight
 	start, end := m.SelectionBounds()
 	c.highlightStart = start
@@ -348,7 +343,8 @@ type VisualPasteCommand struct {
 // using captured state without requiring visual mode.
 func (c *VisualPasteCommand) Execute(m *Model) ExecuteResult {
 	// Skip if nothing to paste
-	if m.lastYankedText == "" {
+	reg, ok := m.pasteRegister()
+	if !ok {
 		return Skipped
 	}
 
@@ -375,8 +371,8 @@ func (c *VisualPasteCommand) Execute(m *Model) ExecuteResult {
 
 	// Capture selection and paste metadata
 	c.wasLinewiseSelection = m.mode == ModeVisualLine
-	c.pastedText = m.lastYankedText
-	c.pasteWasLinewise = m.lastYankWasLinewise
+	c.pastedText = reg.Text
+	c.pasteWasLinewise = reg.Linewise
 
 	// Get normalized selection bounds
 	start, end := m.SelectionBounds()
@@ -397,8 +393,7 @@ func (c *VisualPasteCommand) Execute(m *Model) ExecuteResult {
 	c.applyPaste(m)
 
 	// Update register: replaced selection becomes new yank content (Vim behavior)
-	m.lastYankedText = strings.Join(c.deletedContent, "\n")
-	m.lastYankWasLinewise = c.wasLinewiseSelection
+	m.setUnnamedRegister(strings.Join(c.deletedContent, "\n"), c.wasLinewiseSelection)
 
 	// Mark as executed for redo detection
 	c.executed = true
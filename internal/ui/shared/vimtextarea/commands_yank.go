@@ -5,7 +5,7 @@ package vimtextarea
 // ============================================================================
 
 // YankLineCommand yanks the entire current line (yy command).
-// Sets lastYankWasLinewise = true for proper paste behavior.
+// Stores the line as line-wise for proper paste behavior.
 type YankLineCommand struct {
 	MotionBase
 	// Capture position for highlight after execute
@@ -19,12 +19,8 @@ func (c *YankLineCommand) Execute(m *Model) ExecuteResult {
 	c.highlightRow = m.cursorRow
 	c.highlightCol = len(m.content[m.cursorRow])
 
-	// Store current line in yank register
-	m.lastYankedText = m.content[m.cursorRow]
-	m.lastYankWasLinewise = true
-
-	// Copy to system clipboard (no-op if clipboard is nil)
-	m.copyToSystemClipboard(m.lastYankedText)
+	// Store current line in yank register (and the system clipboard, if any)
+	m.storeYank(m.content[m.cursorRow], true)
 
 	// Cursor stays in place - yank doesn't move cursor
 	return Executed
@@ -53,7 +49,7 @@ func (c *YankLineCommand) ID() string {
 }
 
 // YankWordCommand yanks from cursor to start of next word (yw command).
-// Sets lastYankWasLinewise = false for proper paste behavior.
+// Stores the text as character-wise for proper paste behavior.
 type YankWordCommand struct {
 	MotionBase
 	// Capture positions for highlight after execute
@@ -69,11 +65,8 @@ func (c *YankWordCommand) Execute(m *Model) ExecuteResult {
 
 	// If at end of line or empty line, yank remaining text (may be empty)
 	if len(line) == 0 || m.cursorCol >= len(line) {
-		m.lastYankedText = ""
-		m.lastYankWasLinewise = false
+		m.storeYank("", false)
 		c.showHighlight = false
-		// Copy to system clipboard (no-op if clipboard is nil)
-		m.copyToSystemClipboard(m.lastYankedText)
 		return Executed
 	}
 
@@ -85,18 +78,16 @@ func (c *YankWordCommand) Execute(m *Model) ExecuteResult {
 	c.highlightStartCol = m.cursorCol
 
 	// Yank from cursor to end position
+	var text string
 	if endCol >= len(line) {
-		m.lastYankedText = line[m.cursorCol:]
+		text = line[m.cursorCol:]
 		c.highlightEndCol = len(line) - 1
 	} else {
-		m.lastYankedText = line[m.cursorCol:endCol]
+		text = line[m.cursorCol:endCol]
 		c.highlightEndCol = endCol - 1
 	}
-	m.lastYankWasLinewise = false
-	c.showHighlight = len(m.lastYankedText) > 0
-
-	// Copy to system clipboard (no-op if clipboard is nil)
-	m.copyToSystemClipboard(m.lastYankedText)
+	m.storeYank(text, false)
+	c.showHighlight = len(text) > 0
 
 	// Cursor stays in place - yank doesn't move cursor
 	return Executed
@@ -125,7 +116,7 @@ func (c *YankWordCommand) ID() string {
 }
 
 // YankToEOLCommand yanks from cursor to end of line (y$ or Y command).
-// Sets lastYankWasLinewise = false for proper paste behavior.
+// Stores the text as character-wise for proper paste behavior.
 type YankToEOLCommand struct {
 	MotionBase
 	// Capture positions for highlight after execute
@@ -141,11 +132,8 @@ func (c *YankToEOLCommand) Execute(m *Model) ExecuteResult {
 
 	// If cursor is at or past end, yank empty string
 	if m.cursorCol >= len(line) {
-		m.lastYankedText = ""
-		m.lastYankWasLinewise = false
+		m.storeYank("", false)
 		c.showHighlight = false
-		// Copy to system clipboard (no-op if clipboard is nil)
-		m.copyToSystemClipboard(m.lastYankedText)
 		return Executed
 	}
 
@@ -155,13 +143,9 @@ func (c *YankToEOLCommand) Execute(m *Model) ExecuteResult {
 	c.highlightEndCol = len(line) - 1
 
 	// Yank from cursor to end of line
-	m.lastYankedText = line[m.cursorCol:]
-	m.lastYankWasLinewise = false
+	m.storeYank(line[m.cursorCol:], false)
 	c.showHighlight = len(m.lastYankedText) > 0
 
-	// Copy to system clipboard (no-op if clipboard is nil)
-	m.copyToSystemClipboard(m.lastYankedText)
-
 	// Cursor stays in place - yank doesn't move cursor
 	return Executed
 }
@@ -0,0 +1,347 @@
+package vimtextarea
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/zjrosen/perles/internal/config"
+)
+
+// DefaultKeyTimeout is how long a partially typed mapping (e.g., the "j" of
+// "jk") waits for its next key before the typed keys are processed as-is.
+// Matches vim's default 'timeoutlen'.
+const DefaultKeyTimeout = time.Second
+
+// DefaultLeader is the key <leader> stands for in mappings, as in vim.
+const DefaultLeader = `\`
+
+// leaderKey is the token for <leader> in a parsed key sequence.
+const leaderKey = "<leader>"
+
+// ErrMappingConflict is returned by Map when the keys are already mapped to
+// something else in the same mode.
+var ErrMappingConflict = errors.New("conflicts with an existing mapping")
+
+// Keymap is a user remap layer that sits in front of DefaultRegistry.
+// Mappings translate one key sequence into another before registry dispatch,
+// so user remaps never modify the built-in commands. Right-hand sides are not
+// remapped again (vim "noremap" semantics), which rules out recursive maps.
+//
+// Keys use the registry notation: single characters ("j") and bracketed
+// names ("<escape>", "<enter>", "<ctrl+a>"). Common vim spellings such as
+// "<esc>", "<cr>" and "<C-a>" are accepted as aliases, and "<leader>" stands
+// for the leader key (see SetLeader).
+type Keymap struct {
+	mappings map[Mode]map[string][]string // mode -> joined lhs -> rhs keys
+	prefixes map[Mode]map[string]bool     // mode -> proper prefixes of every lhs
+	leader   []string                     // keys <leader> expands to
+
+	// Timeout is how long to wait for the next key of a mapped sequence.
+	Timeout time.Duration
+
+	// PendingTimeout cancels a pending multi-key command (e.g., a lone "d")
+	// after this long without input. Zero keeps pending commands open indefinitely.
+	PendingTimeout time.Duration
+}
+
+// NewKeymap creates an empty keymap with DefaultKeyTimeout.
+func NewKeymap() *Keymap {
+	return &Keymap{
+		mappings: make(map[Mode]map[string][]string),
+		prefixes: make(map[Mode]map[string]bool),
+		leader:   []string{DefaultLeader},
+		Timeout:  DefaultKeyTimeout,
+	}
+}
+
+// SetLeader sets the keys that <leader> stands for in mappings added
+// afterwards (e.g. "<space>" or ",").
+func (k *Keymap) SetLeader(seq string) error {
+	keys, err := ParseKeySequence(seq)
+	if err != nil {
+		return fmt.Errorf("leader %q: %w", seq, err)
+	}
+	for _, key := range keys {
+		if _, ok := keyMsgFromString(key); !ok {
+			return fmt.Errorf("leader %q: unsupported key %q", seq, key)
+		}
+	}
+	k.leader = keys
+	return nil
+}
+
+// Map adds a mapping from lhs to rhs in the given mode.
+// Mappings added for ModeVisual also apply in ModeVisualLine.
+// Mapping keys that are already mapped to a different rhs in the mode
+// returns ErrMappingConflict; the existing mapping is kept.
+func (k *Keymap) Map(mode Mode, lhs, rhs string) error {
+	from, err := ParseKeySequence(lhs)
+	if err != nil {
+		return fmt.Errorf("mapping %q: %w", lhs, err)
+	}
+	from = k.expandLeader(from)
+	for _, key := range from {
+		if _, ok := keyMsgFromString(key); !ok {
+			return fmt.Errorf("mapping %q: unsupported key %q", lhs, key)
+		}
+	}
+	to, err := ParseKeySequence(rhs)
+	if err != nil {
+		return fmt.Errorf("mapping %q -> %q: %w", lhs, rhs, err)
+	}
+	to = k.expandLeader(to)
+	for _, key := range to {
+		if _, ok := keyMsgFromString(key); !ok {
+			return fmt.Errorf("mapping %q -> %q: unsupported key %q", lhs, rhs, key)
+		}
+	}
+
+	modes := []Mode{mode}
+	if mode == ModeVisual {
+		modes = append(modes, ModeVisualLine)
+	}
+	for _, md := range modes {
+		if existing, ok := k.mappings[md][joinKeys(from)]; ok && !slices.Equal(existing, to) {
+			return fmt.Errorf("mapping %q: %w to %q", lhs, ErrMappingConflict, strings.Join(existing, ""))
+		}
+	}
+	for _, md := range modes {
+		if k.mappings[md] == nil {
+			k.mappings[md] = make(map[string][]string)
+			k.prefixes[md] = make(map[string]bool)
+		}
+		k.mappings[md][joinKeys(from)] = to
+		for i := 1; i < len(from); i++ {
+			k.prefixes[md][joinKeys(from[:i])] = true
+		}
+	}
+	return nil
+}
+
+// Len returns the number of mappings active in the given mode.
+func (k *Keymap) Len(mode Mode) int {
+	if k == nil {
+		return 0
+	}
+	return len(k.mappings[mode])
+}
+
+// Lookup returns the rhs for an exact lhs match in the given mode.
+func (k *Keymap) Lookup(mode Mode, keys []string) ([]string, bool) {
+	if k == nil {
+		return nil, false
+	}
+	rhs, ok := k.mappings[mode][joinKeys(keys)]
+	return rhs, ok
+}
+
+// IsPrefix returns true if keys is the beginning of a longer mapping.
+func (k *Keymap) IsPrefix(mode Mode, keys []string) bool {
+	if k == nil {
+		return false
+	}
+	return k.prefixes[mode][joinKeys(keys)]
+}
+
+func (k *Keymap) keyTimeout() time.Duration {
+	if k == nil || k.Timeout <= 0 {
+		return DefaultKeyTimeout
+	}
+	return k.Timeout
+}
+
+func (k *Keymap) pendingTimeout() time.Duration {
+	if k == nil {
+		return 0
+	}
+	return k.PendingTimeout
+}
+
+// expandLeader replaces <leader> tokens with the leader keys.
+func (k *Keymap) expandLeader(keys []string) []string {
+	if !slices.Contains(keys, leaderKey) {
+		return keys
+	}
+	expanded := make([]string, 0, len(keys)+len(k.leader))
+	for _, key := range keys {
+		if key == leaderKey {
+			expanded = append(expanded, k.leader...)
+		} else {
+			expanded = append(expanded, key)
+		}
+	}
+	return expanded
+}
+
+// joinKeys joins key tokens with a separator that cannot appear in a token.
+func joinKeys(keys []string) string {
+	return strings.Join(keys, "\x00")
+}
+
+// keyAliases maps common vim key spellings to registry notation.
+var keyAliases = map[string]string{
+	"<esc>":    "<escape>",
+	"<cr>":     "<enter>",
+	"<return>": "<enter>",
+	"<bs>":     "<backspace>",
+	"<del>":    "<delete>",
+}
+
+// ParseKeySequence splits a key sequence such as "jk", "<esc>" or "g<ctrl+a>"
+// into registry key tokens.
+func ParseKeySequence(seq string) ([]string, error) {
+	if seq == "" {
+		return nil, fmt.Errorf("empty key sequence")
+	}
+
+	var keys []string
+	for rest := seq; rest != ""; {
+		if rest[0] == '<' {
+			if end := strings.IndexByte(rest, '>'); end > 1 {
+				keys = append(keys, normalizeKeyName(rest[:end+1]))
+				rest = rest[end+1:]
+				continue
+			}
+		}
+		r := []rune(rest)[0]
+		if r == ' ' {
+			keys = append(keys, "<space>") // as keyToString reports a typed space
+			rest = rest[1:]
+			continue
+		}
+		keys = append(keys, string(r))
+		rest = rest[len(string(r)):]
+	}
+	return keys, nil
+}
+
+// normalizeKeyName lowercases a bracketed key name and resolves aliases,
+// including vim's <C-x> and <A-x> modifier spellings.
+func normalizeKeyName(name string) string {
+	lower := strings.ToLower(name)
+	if alias, ok := keyAliases[lower]; ok {
+		return alias
+	}
+	inner := lower[1 : len(lower)-1]
+	switch {
+	case strings.HasPrefix(inner, "c-"):
+		return "<ctrl+" + inner[2:] + ">"
+	case strings.HasPrefix(inner, "a-"), strings.HasPrefix(inner, "m-"):
+		return "<alt+" + inner[2:] + ">"
+	}
+	return lower
+}
+
+// namedKeys maps registry key names back to key messages for replaying
+// mapping right-hand sides. It mirrors keyToString.
+var namedKeys = map[string]tea.KeyMsg{
+	"<alt+enter>": {Type: tea.KeyEnter, Alt: true},
+	"<ctrl+j>":    {Type: tea.KeyCtrlJ},
+	"<escape>":    {Type: tea.KeyEscape},
+	"<enter>":     {Type: tea.KeyEnter},
+	"<backspace>": {Type: tea.KeyBackspace},
+	"<delete>":    {Type: tea.KeyDelete},
+	"<space>":     {Type: tea.KeySpace, Runes: []rune{' '}},
+	"<ctrl+r>":    {Type: tea.KeyCtrlR},
+	"<ctrl+u>":    {Type: tea.KeyCtrlU},
+	"<ctrl+k>":    {Type: tea.KeyCtrlK},
+	"<ctrl+a>":    {Type: tea.KeyCtrlA},
+	"<ctrl+e>":    {Type: tea.KeyCtrlE},
+	"<left>":      {Type: tea.KeyLeft},
+	"<right>":     {Type: tea.KeyRight},
+	"<up>":        {Type: tea.KeyUp},
+	"<down>":      {Type: tea.KeyDown},
+	"<ctrl+f>":    {Type: tea.KeyCtrlF},
+	"<ctrl+b>":    {Type: tea.KeyCtrlB},
+	"<ctrl+c>":    {Type: tea.KeyCtrlC},
+	"<ctrl+g>":    {Type: tea.KeyCtrlG},
+	"<ctrl+l>":    {Type: tea.KeyCtrlL},
+}
+
+// keyMsgFromString converts a registry key token back into a key message.
+func keyMsgFromString(key string) (tea.KeyMsg, bool) {
+	if msg, ok := namedKeys[key]; ok {
+		return msg, true
+	}
+	if r := []rune(key); len(r) == 1 {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: r}, true
+	}
+	return tea.KeyMsg{}, false
+}
+
+// defaultKeymap is the process-wide keymap used by textareas that do not set Config.Keymap.
+var defaultKeymap atomic.Pointer[Keymap]
+
+// SetDefaultKeymap installs the keymap used by every textarea without its own
+// Config.Keymap. Call it once at startup after loading user configuration.
+// Passing nil removes all user mappings.
+func SetDefaultKeymap(k *Keymap) {
+	defaultKeymap.Store(k)
+}
+
+// DefaultKeymap returns the process-wide keymap, or nil if none is installed.
+func DefaultKeymap() *Keymap {
+	return defaultKeymap.Load()
+}
+
+// KeymapFromConfig builds a keymap from the user's vim keymap configuration.
+// Mappings that are invalid or conflict with an earlier mapping are skipped,
+// leaving those keys with their default behavior; the returned error lists
+// every skipped mapping alongside the keymap built from the rest.
+func KeymapFromConfig(cfg config.VimKeymapConfig) (*Keymap, error) {
+	km := NewKeymap()
+	if cfg.TimeoutMs > 0 {
+		km.Timeout = time.Duration(cfg.TimeoutMs) * time.Millisecond
+	}
+	km.PendingTimeout = time.Duration(cfg.PendingTimeoutMs) * time.Millisecond
+
+	var errs []error
+	if cfg.Leader != "" {
+		if err := km.SetLeader(cfg.Leader); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for i, mapping := range cfg.Mappings {
+		var mode Mode
+		switch mapping.Mode {
+		case config.VimMapModeNormal:
+			mode = ModeNormal
+		case config.VimMapModeInsert:
+			mode = ModeInsert
+		case config.VimMapModeVisual:
+			mode = ModeVisual
+		default:
+			errs = append(errs, fmt.Errorf("mappings[%d]: unknown mode %q", i, mapping.Mode))
+			continue
+		}
+		if err := km.Map(mode, mapping.From, mapping.To); err != nil {
+			errs = append(errs, fmt.Errorf("mappings[%d]: %w", i, err))
+		}
+	}
+	return km, errors.Join(errs...)
+}
+
+// ApplyConfig builds the user's keymap and installs it as the default keymap.
+// Skipped mappings are reported in the returned error; the valid ones are
+// installed regardless, so a bad entry never disables the rest.
+func ApplyConfig(cfg config.VimKeymapConfig) error {
+	km, err := KeymapFromConfig(cfg)
+	SetDefaultKeymap(km)
+	return err
+}
+
+// keymapTimeoutMsg fires when a partially typed mapping has waited too long.
+type keymapTimeoutMsg struct {
+	seq int
+}
+
+// pendingTimeoutMsg fires when a pending multi-key command has waited too long.
+type pendingTimeoutMsg struct {
+	seq int
+}
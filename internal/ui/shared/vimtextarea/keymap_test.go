@@ -0,0 +1,305 @@
+package vimtextarea
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zjrosen/perles/internal/config"
+)
+
+func newKeymap(t *testing.T, mode Mode, lhs, rhs string) *Keymap {
+	t.Helper()
+	km := NewKeymap()
+	require.NoError(t, km.Map(mode, lhs, rhs))
+	return km
+}
+
+func TestParseKeySequence(t *testing.T) {
+	tests := []struct {
+		seq  string
+		want []string
+	}{
+		{"jk", []string{"j", "k"}},
+		{"<esc>", []string{"<escape>"}},
+		{"<Esc>", []string{"<escape>"}},
+		{"<CR>", []string{"<enter>"}},
+		{"g<C-a>", []string{"g", "<ctrl+a>"}},
+		{"<ctrl+r>", []string{"<ctrl+r>"}},
+		{"<", []string{"<"}},
+		{"<>", []string{"<", ">"}},
+		{"é", []string{"é"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.seq, func(t *testing.T) {
+			got, err := ParseKeySequence(tt.seq)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := ParseKeySequence("")
+	require.Error(t, err)
+}
+
+func TestKeymap_MapRejectsUnsupportedRHS(t *testing.T) {
+	km := NewKeymap()
+	err := km.Map(ModeInsert, "jk", "<f13>")
+	require.EqualError(t, err, `mapping "jk" -> "<f13>": unsupported key "<f13>"`)
+}
+
+func TestKeymap_VisualAppliesToVisualLine(t *testing.T) {
+	km := newKeymap(t, ModeVisual, "H", "0")
+	_, ok := km.Lookup(ModeVisualLine, []string{"H"})
+	require.True(t, ok)
+	require.Zero(t, km.Len(ModeNormal))
+}
+
+func TestKeymap_JKEscapesInsertMode(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeInsert, Keymap: newKeymap(t, ModeInsert, "jk", "<esc>")})
+	m.SetValue("hi")
+	m.CursorToEnd()
+
+	m, cmd := m.Update(keyMsg('j'))
+	require.NotNil(t, cmd, "partial mapping should arm the key timeout")
+	require.Equal(t, "hi", m.Value(), "j is held until the mapping resolves")
+
+	m, _ = m.Update(keyMsg('k'))
+	require.Equal(t, ModeNormal, m.Mode())
+	require.Equal(t, "hi", m.Value())
+}
+
+func TestKeymap_DivergingKeyFlushesBuffer(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeInsert, Keymap: newKeymap(t, ModeInsert, "jk", "<esc>")})
+
+	m, _ = m.Update(keyMsg('j'))
+	m, _ = m.Update(keyMsg('a'))
+	require.Equal(t, "ja", m.Value())
+	require.Equal(t, ModeInsert, m.Mode())
+
+	// A repeated prefix key flushes the first and buffers the second.
+	m, _ = m.Update(keyMsg('j'))
+	m, _ = m.Update(keyMsg('j'))
+	require.Equal(t, "jaj", m.Value())
+	m, _ = m.Update(keyMsg('k'))
+	require.Equal(t, "jaj", m.Value())
+	require.Equal(t, ModeNormal, m.Mode())
+}
+
+func TestKeymap_TimeoutFlushesBuffer(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeInsert, Keymap: newKeymap(t, ModeInsert, "jk", "<esc>")})
+
+	m, _ = m.Update(keyMsg('j'))
+	require.Equal(t, "", m.Value())
+
+	// A stale tick is ignored.
+	m, _ = m.Update(keymapTimeoutMsg{seq: m.mapSeq - 1})
+	require.Equal(t, "", m.Value())
+
+	m, _ = m.Update(keymapTimeoutMsg{seq: m.mapSeq})
+	require.Equal(t, "j", m.Value())
+	require.Equal(t, ModeInsert, m.Mode())
+}
+
+func TestKeymap_TimeoutAppliesExactMatchShadowedByLongerMapping(t *testing.T) {
+	km := newKeymap(t, ModeNormal, "x", "dd")
+	require.NoError(t, km.Map(ModeNormal, "xy", "j"))
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, Keymap: km})
+	m.SetValue("one\ntwo")
+	m.cursorRow = 0
+
+	m, _ = m.Update(keyMsg('x'))
+	require.Equal(t, "one\ntwo", m.Value())
+
+	m, _ = m.Update(keymapTimeoutMsg{seq: m.mapSeq})
+	require.Equal(t, "two", m.Value())
+}
+
+func TestKeymap_RHSIsNotRemapped(t *testing.T) {
+	km := newKeymap(t, ModeNormal, "j", "k")
+	require.NoError(t, km.Map(ModeNormal, "k", "j"))
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, Keymap: km})
+	m.SetValue("one\ntwo")
+	m.cursorRow = 0
+
+	m, _ = m.Update(keyMsg('k')) // k -> j (moves down, not back to k)
+	require.Equal(t, 1, m.CursorPosition().Row)
+}
+
+func TestKeymap_IgnoredWhenVimDisabled(t *testing.T) {
+	m := New(Config{VimEnabled: false, Keymap: newKeymap(t, ModeInsert, "jk", "<esc>")})
+
+	m, _ = m.Update(keyMsg('j'))
+	m, _ = m.Update(keyMsg('k'))
+	require.Equal(t, "jk", m.Value())
+}
+
+func TestKeymap_DefaultKeymapUsedWhenConfigUnset(t *testing.T) {
+	SetDefaultKeymap(newKeymap(t, ModeInsert, "jk", "<esc>"))
+	t.Cleanup(func() { SetDefaultKeymap(nil) })
+
+	m := New(Config{VimEnabled: true, DefaultMode: ModeInsert})
+	m, _ = m.Update(keyMsg('j'))
+	m, _ = m.Update(keyMsg('k'))
+	require.Equal(t, ModeNormal, m.Mode())
+}
+
+func TestKeymap_PendingTimeoutCancelsPendingCommand(t *testing.T) {
+	km := NewKeymap()
+	km.PendingTimeout = 500 * time.Millisecond
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, Keymap: km})
+	m.SetValue("one\ntwo")
+
+	m, cmd := m.Update(keyMsg('d'))
+	require.NotNil(t, cmd)
+	require.False(t, m.pendingBuilder.IsEmpty())
+
+	m, _ = m.Update(pendingTimeoutMsg{seq: m.pendingSeq})
+	require.True(t, m.pendingBuilder.IsEmpty())
+
+	// The next d starts a fresh pending command instead of completing dd.
+	m, _ = m.Update(keyMsg('d'))
+	require.Equal(t, "one\ntwo", m.Value())
+}
+
+func TestKeymap_NoPendingTimeoutByDefault(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, Keymap: NewKeymap()})
+	m.SetValue("one\ntwo")
+
+	m, cmd := m.Update(keyMsg('d'))
+	require.Nil(t, cmd)
+	m, _ = m.Update(keyMsg('d'))
+	require.Equal(t, "two", m.Value())
+}
+
+func TestKeymap_PasteFlushesBuffer(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeInsert, Keymap: newKeymap(t, ModeInsert, "jk", "<esc>")})
+
+	m, _ = m.Update(keyMsg('j'))
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("pasted")})
+	require.Equal(t, "jpasted", m.Value())
+}
+
+func TestKeymapFromConfig(t *testing.T) {
+	km, err := KeymapFromConfig(config.VimKeymapConfig{
+		TimeoutMs:        250,
+		PendingTimeoutMs: 1500,
+		Mappings: []config.VimMapping{
+			{Mode: "insert", From: "jk", To: "<Esc>"},
+			{Mode: "visual", From: "H", To: "0"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 250*time.Millisecond, km.Timeout)
+	require.Equal(t, 1500*time.Millisecond, km.PendingTimeout)
+
+	rhs, ok := km.Lookup(ModeInsert, []string{"j", "k"})
+	require.True(t, ok)
+	require.Equal(t, []string{"<escape>"}, rhs)
+	require.Equal(t, 1, km.Len(ModeVisualLine))
+
+	km, err = KeymapFromConfig(config.VimKeymapConfig{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultKeyTimeout, km.Timeout)
+	require.Zero(t, km.PendingTimeout)
+
+	_, err = KeymapFromConfig(config.VimKeymapConfig{
+		Mappings: []config.VimMapping{{Mode: "insert", From: "jk", To: "<f13>"}},
+	})
+	require.EqualError(t, err, `mappings[0]: mapping "jk" -> "<f13>": unsupported key "<f13>"`)
+}
+
+func TestKeymap_Leader(t *testing.T) {
+	km := NewKeymap()
+	require.NoError(t, km.Map(ModeNormal, "<leader>d", "dd"))
+	_, ok := km.Lookup(ModeNormal, []string{`\`, "d"})
+	require.True(t, ok, "default leader is backslash")
+
+	km = NewKeymap()
+	require.NoError(t, km.SetLeader("<space>"))
+	require.NoError(t, km.Map(ModeNormal, "<Leader>d", "dd"))
+	rhs, ok := km.Lookup(ModeNormal, []string{"<space>", "d"})
+	require.True(t, ok)
+	require.Equal(t, []string{"d", "d"}, rhs)
+
+	require.EqualError(t, km.SetLeader("<f13>"), `leader "<f13>": unsupported key "<f13>"`)
+}
+
+func TestKeymap_SpaceLeaderDeletesLine(t *testing.T) {
+	km := NewKeymap()
+	require.NoError(t, km.SetLeader(" "))
+	require.NoError(t, km.Map(ModeNormal, "<leader>d", "dd"))
+
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, Keymap: km})
+	m.SetValue("one\ntwo")
+	m.Focus()
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m = typeKeys(m, "d")
+	require.Equal(t, "two", m.Value())
+}
+
+func TestKeymap_SwapKeys(t *testing.T) {
+	// Swapping keys works because right-hand sides are not remapped
+	km := NewKeymap()
+	require.NoError(t, km.Map(ModeNormal, ";", ":"))
+	require.NoError(t, km.Map(ModeNormal, ":", ";"))
+
+	rhs, _ := km.Lookup(ModeNormal, []string{";"})
+	require.Equal(t, []string{":"}, rhs)
+	rhs, _ = km.Lookup(ModeNormal, []string{":"})
+	require.Equal(t, []string{";"}, rhs)
+}
+
+func TestKeymap_MapDetectsConflicts(t *testing.T) {
+	km := newKeymap(t, ModeInsert, "jk", "<esc>")
+
+	// The same mapping again is not a conflict
+	require.NoError(t, km.Map(ModeInsert, "jk", "<Esc>"))
+
+	err := km.Map(ModeInsert, "jk", "<enter>")
+	require.ErrorIs(t, err, ErrMappingConflict)
+	require.EqualError(t, err, `mapping "jk": conflicts with an existing mapping to "<escape>"`)
+	rhs, _ := km.Lookup(ModeInsert, []string{"j", "k"})
+	require.Equal(t, []string{"<escape>"}, rhs, "first mapping wins")
+
+	// Other modes are independent
+	require.NoError(t, km.Map(ModeNormal, "jk", "<enter>"))
+}
+
+func TestKeymap_MapRejectsUnsupportedLHS(t *testing.T) {
+	km := NewKeymap()
+	err := km.Map(ModeNormal, "<f13>", "x")
+	require.EqualError(t, err, `mapping "<f13>": unsupported key "<f13>"`)
+}
+
+func TestKeymapFromConfig_SkipsBadMappings(t *testing.T) {
+	km, err := KeymapFromConfig(config.VimKeymapConfig{
+		Leader: ",",
+		Mappings: []config.VimMapping{
+			{Mode: "insert", From: "jk", To: "<esc>"},
+			{Mode: "insert", From: "jk", To: "<cr>"},
+			{Mode: "normal", From: "<leader>x", To: "<f13>"},
+			{Mode: "normal", From: "<leader>d", To: "dd"},
+		},
+	})
+	require.EqualError(t, err, "mappings[1]: mapping \"jk\": conflicts with an existing mapping to \"<escape>\"\n"+
+		`mappings[2]: mapping "<leader>x" -> "<f13>": unsupported key "<f13>"`)
+	require.NotNil(t, km)
+	require.Equal(t, 1, km.Len(ModeInsert))
+	_, ok := km.Lookup(ModeNormal, []string{",", "d"})
+	require.True(t, ok)
+}
+
+func TestApplyConfig_InstallsValidMappingsOnError(t *testing.T) {
+	t.Cleanup(func() { SetDefaultKeymap(nil) })
+
+	err := ApplyConfig(config.VimKeymapConfig{Mappings: []config.VimMapping{
+		{Mode: "insert", From: "jk", To: "<esc>"},
+		{Mode: "insert", From: "kj", To: "<f13>"},
+	}})
+	require.Error(t, err)
+	require.Equal(t, 1, DefaultKeymap().Len(ModeInsert))
+}
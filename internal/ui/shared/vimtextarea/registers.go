@@ -0,0 +1,262 @@
+package vimtextarea
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/zjrosen/perles/internal/log"
+)
+
+// Register names with special meaning. Named registers are 'a' through 'z';
+// their uppercase form appends instead of replacing.
+const (
+	RegisterUnnamed   = '"' // Last yank or delete, used when no register is given
+	RegisterYank      = '0' // Last yank
+	RegisterClipboard = '+' // System clipboard
+	RegisterBlackHole = '_' // Discards writes, reads as empty
+)
+
+// ClipboardReader is implemented by clipboards that can also read the system
+// clipboard. It enables pasting from the "+ register.
+type ClipboardReader interface {
+	Paste() (string, error)
+}
+
+// Register is the content of a single register.
+type Register struct {
+	Text     string
+	Linewise bool // Pasted as whole lines (from yy, dd, V)
+}
+
+// Registers holds vim registers: the unnamed register, the yank register "0
+// and the named registers "a-"z. The "+ register is backed by the system
+// clipboard and is not stored here.
+//
+// Textareas that share a *Registers (see Config.Registers) see each other's
+// yanks, e.g. all fields of one form.
+type Registers struct {
+	unnamed Register
+	yank    Register
+	named   map[rune]Register
+}
+
+// NewRegisters creates an empty register set.
+func NewRegisters() *Registers {
+	return &Registers{named: make(map[rune]Register)}
+}
+
+// IsValidRegister returns true if name can follow " to select a register.
+func IsValidRegister(name rune) bool {
+	switch name {
+	case RegisterUnnamed, RegisterYank, RegisterClipboard, RegisterBlackHole:
+		return true
+	}
+	return (name >= 'a' && name <= 'z') || (name >= 'A' && name <= 'Z')
+}
+
+// Get returns the content of a stored register. Uppercase names read the
+// matching named register. The clipboard and black hole registers are never stored.
+func (r *Registers) Get(name rune) (Register, bool) {
+	switch {
+	case name == RegisterUnnamed:
+		return r.unnamed, r.unnamed.Text != ""
+	case name == RegisterYank:
+		return r.yank, r.yank.Text != ""
+	case unicode.IsLetter(name):
+		reg, ok := r.named[unicode.ToLower(name)]
+		return reg, ok && reg.Text != ""
+	}
+	return Register{}, false
+}
+
+// Store writes reg to the named register and to the unnamed register.
+// An uppercase name appends to the named register; appending line-wise
+// text makes the result line-wise. Yanks written without a name also go
+// to "0. Writes to the black hole register are discarded.
+func (r *Registers) Store(name rune, reg Register, isYank bool) {
+	switch {
+	case name == RegisterBlackHole:
+		return
+	case name >= 'A' && name <= 'Z':
+		lower := unicode.ToLower(name)
+		if prev, ok := r.named[lower]; ok && prev.Text != "" {
+			if prev.Linewise || reg.Linewise {
+				reg = Register{Text: prev.Text + "\n" + reg.Text, Linewise: true}
+			} else {
+				reg.Text = prev.Text + reg.Text
+			}
+		}
+		r.named[lower] = reg
+	case name >= 'a' && name <= 'z':
+		r.named[name] = reg
+	case name == 0, name == RegisterUnnamed:
+		if isYank {
+			r.yank = reg
+		}
+	}
+	r.unnamed = reg
+}
+
+// String lists the non-empty registers like vim's :registers.
+// Line-wise content shows line breaks as ^J and long content is cut off.
+func (r *Registers) String() string {
+	var b strings.Builder
+	b.WriteString("Type Name Content")
+	write := func(name rune, reg Register) {
+		if reg.Text == "" {
+			return
+		}
+		kind := "c"
+		if reg.Linewise {
+			kind = "l"
+		}
+		fmt.Fprintf(&b, "\n  %s  \"%c   %s", kind, name, registerPreview(reg.Text))
+	}
+	write(RegisterUnnamed, r.unnamed)
+	write(RegisterYank, r.yank)
+	for name := 'a'; name <= 'z'; name++ {
+		write(name, r.named[name])
+	}
+	return b.String()
+}
+
+// registerPreviewWidth caps the content shown per register in String.
+const registerPreviewWidth = 40
+
+// registerPreview shows text on a single line, cut to registerPreviewWidth runes.
+func registerPreview(text string) string {
+	text = strings.ReplaceAll(text, "\n", "^J")
+	if runes := []rune(text); len(runes) > registerPreviewWidth {
+		return string(runes[:registerPreviewWidth-1]) + "…"
+	}
+	return text
+}
+
+// ============================================================================
+// Model register helpers
+// ============================================================================
+
+// storeYank records yanked text in the selected register (or the unnamed
+// and yank registers) and copies it to the system clipboard unless a named
+// or black hole register was selected.
+func (m *Model) storeYank(text string, linewise bool) {
+	m.storeRegister(Register{Text: text, Linewise: linewise}, true)
+}
+
+// storeDelete records deleted text in the selected register, or the unnamed
+// register if none was selected. Deletes never touch the system clipboard
+// unless "+ was selected explicitly.
+func (m *Model) storeDelete(text string, linewise bool) {
+	m.storeRegister(Register{Text: text, Linewise: linewise}, false)
+}
+
+func (m *Model) storeRegister(reg Register, isYank bool) {
+	name := m.selectedRegister
+	if name == RegisterBlackHole {
+		return
+	}
+	if m.registers != nil {
+		m.registers.Store(name, reg, isYank)
+	}
+	m.lastYankedText = reg.Text
+	m.lastYankWasLinewise = reg.Linewise
+
+	if name == RegisterClipboard || (isYank && (name == 0 || name == RegisterUnnamed)) {
+		m.copyToSystemClipboard(reg.Text)
+	}
+}
+
+// setUnnamedRegister replaces only the unnamed register, leaving the selected
+// register untouched (used when a visual paste yanks the replaced text).
+func (m *Model) setUnnamedRegister(text string, linewise bool) {
+	reg := Register{Text: text, Linewise: linewise}
+	if m.registers != nil {
+		m.registers.Store(RegisterUnnamed, reg, false)
+	}
+	m.lastYankedText = reg.Text
+	m.lastYankWasLinewise = reg.Linewise
+}
+
+// pasteRegister returns the content to paste: the selected register, or the
+// unnamed register if none was selected. Returns false if it is empty.
+func (m *Model) pasteRegister() (Register, bool) {
+	switch name := m.selectedRegister; name {
+	case 0, RegisterUnnamed:
+		return Register{Text: m.lastYankedText, Linewise: m.lastYankWasLinewise}, m.lastYankedText != ""
+	case RegisterBlackHole:
+		return Register{}, false
+	case RegisterClipboard:
+		return m.readSystemClipboard()
+	default:
+		if m.registers == nil {
+			return Register{}, false
+		}
+		return m.registers.Get(name)
+	}
+}
+
+// readSystemClipboard reads the "+ register. Text ending in a newline is
+// pasted line-wise, matching how vim treats clipboard content.
+func (m *Model) readSystemClipboard() (Register, bool) {
+	reader, ok := m.clipboard.(ClipboardReader)
+	if !ok {
+		return Register{}, false
+	}
+	text, err := reader.Paste()
+	if err != nil {
+		log.Error(log.CatUI, "clipboard paste failed", "error", err.Error())
+		return Register{}, false
+	}
+	text = normalizeNewlines(text)
+	if trimmed, linewise := strings.CutSuffix(text, "\n"); linewise {
+		return Register{Text: trimmed, Linewise: true}, true
+	}
+	return Register{Text: text}, text != ""
+}
+
+// ============================================================================
+// Register API
+// ============================================================================
+
+// SetRegisters shares a register set with this textarea. Give every
+// textarea of a form the same set so yanks persist across fields.
+// Passing nil gives the textarea a private set.
+func (m *Model) SetRegisters(r *Registers) {
+	if r == nil {
+		r = NewRegisters()
+	}
+	m.registers = r
+	m.syncUnnamedRegister()
+}
+
+// Registers returns the register set used by this textarea.
+func (m Model) Registers() *Registers {
+	return m.registers
+}
+
+// SelectedRegister returns the register chosen with " for the next command,
+// or 0 if none is selected.
+func (m Model) SelectedRegister() rune {
+	return m.selectedRegister
+}
+
+// RegistersView renders the registers like vim's :registers, for debugging.
+func (m Model) RegistersView() string {
+	if m.registers == nil {
+		return NewRegisters().String()
+	}
+	return m.registers.String()
+}
+
+// syncUnnamedRegister picks up the shared unnamed register, which another
+// textarea sharing the registers may have written.
+func (m *Model) syncUnnamedRegister() {
+	if m.registers == nil {
+		return
+	}
+	if reg, ok := m.registers.Get(RegisterUnnamed); ok {
+		m.lastYankedText = reg.Text
+		m.lastYankWasLinewise = reg.Linewise
+	}
+}
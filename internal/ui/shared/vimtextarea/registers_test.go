@@ -0,0 +1,195 @@
+package vimtextarea
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+)
+
+// readableClipboard is a clipboard that can also be read, for "+ tests.
+type readableClipboard struct {
+	mockClipboard
+	content  string
+	pasteErr error
+}
+
+func (c *readableClipboard) Paste() (string, error) {
+	return c.content, c.pasteErr
+}
+
+// typeKeys sends each rune of keys as a key press.
+func typeKeys(m Model, keys string) Model {
+	for _, r := range keys {
+		m, _ = m.Update(keyMsg(r))
+	}
+	return m
+}
+
+func newRegisterTestModel(content string) Model {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal})
+	m.SetValue(content)
+	m.Focus()
+	return m
+}
+
+func TestRegisters_NamedYankAndPaste(t *testing.T) {
+	m := newRegisterTestModel("hello world")
+
+	m = typeKeys(m, `"ayiw`)
+	require.Zero(t, m.SelectedRegister(), "selection ends with the command")
+	reg, ok := m.Registers().Get('a')
+	require.True(t, ok)
+	require.Equal(t, Register{Text: "hello"}, reg)
+
+	// A plain delete replaces the unnamed register but not "a
+	m = typeKeys(m, "$x")
+	require.Equal(t, "d", m.lastYankedText)
+
+	m = typeKeys(m, `0"aP`)
+	require.Equal(t, "hellohello worl", m.Value())
+}
+
+func TestRegisters_YankRegisterSurvivesDeletes(t *testing.T) {
+	m := newRegisterTestModel("first\nsecond")
+
+	m = typeKeys(m, "yyjdd")
+	reg, ok := m.Registers().Get(RegisterYank)
+	require.True(t, ok)
+	require.Equal(t, Register{Text: "first", Linewise: true}, reg)
+	unnamed, _ := m.Registers().Get(RegisterUnnamed)
+	require.Equal(t, "second", unnamed.Text)
+
+	m = typeKeys(m, `"0p`)
+	require.Equal(t, "first\nfirst", m.Value())
+}
+
+func TestRegisters_UppercaseAppends(t *testing.T) {
+	m := newRegisterTestModel("one two")
+
+	m = typeKeys(m, `"ayiww"Ayiw`)
+	reg, _ := m.Registers().Get('a')
+	require.Equal(t, Register{Text: "onetwo"}, reg)
+
+	// Appending line-wise text makes the register line-wise
+	m = typeKeys(m, `"Ayy`)
+	reg, _ = m.Registers().Get('a')
+	require.Equal(t, Register{Text: "onetwo\none two", Linewise: true}, reg)
+}
+
+func TestRegisters_BlackHoleKeepsUnnamed(t *testing.T) {
+	m := newRegisterTestModel("keep drop")
+
+	m = typeKeys(m, `yiww"_dw`)
+	require.Equal(t, "keep ", m.Value())
+	require.Equal(t, "keep", m.lastYankedText)
+
+	_, ok := m.Registers().Get(RegisterBlackHole)
+	require.False(t, ok)
+}
+
+func TestRegisters_EscapeCancelsSelection(t *testing.T) {
+	m := newRegisterTestModel("text")
+
+	m = typeKeys(m, `"`)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEscape})
+	require.Zero(t, m.SelectedRegister())
+
+	m = typeKeys(m, `"?`)
+	require.Zero(t, m.SelectedRegister(), "invalid register names are ignored")
+}
+
+func TestRegisters_VisualYankIntoNamedRegister(t *testing.T) {
+	m := newRegisterTestModel("abc def")
+
+	m = typeKeys(m, `vll"by`)
+	require.Equal(t, ModeNormal, m.Mode())
+	reg, _ := m.Registers().Get('b')
+	require.Equal(t, "abc", reg.Text)
+}
+
+func TestRegisters_VisualPasteKeepsSourceRegister(t *testing.T) {
+	m := newRegisterTestModel("abc def")
+
+	m = typeKeys(m, `w"ayiw0viw"ap`)
+	require.Equal(t, "def def", m.Value())
+
+	reg, _ := m.Registers().Get('a')
+	require.Equal(t, "def", reg.Text)
+	require.Equal(t, "abc", m.lastYankedText, "replaced text goes to the unnamed register")
+}
+
+func TestRegisters_ClipboardRegister(t *testing.T) {
+	clipboard := &readableClipboard{content: "from clipboard"}
+	m := newRegisterTestModel("x").SetClipboard(clipboard)
+
+	m = typeKeys(m, `"+p`)
+	require.Equal(t, "xfrom clipboard", m.Value())
+
+	// A trailing newline pastes line-wise
+	clipboard.content = "line\n"
+	m = typeKeys(m, `"+p`)
+	require.Equal(t, "xfrom clipboard\nline", m.Value())
+
+	m = typeKeys(m, `"+yy`)
+	require.Equal(t, "line", clipboard.copiedText)
+}
+
+func TestRegisters_ClipboardReadFailures(t *testing.T) {
+	m := newRegisterTestModel("x").SetClipboard(&mockClipboard{})
+	m = typeKeys(m, `"+p`)
+	require.Equal(t, "x", m.Value(), "write-only clipboards have nothing to paste")
+
+	m = m.SetClipboard(&readableClipboard{pasteErr: errors.New("no xclip")})
+	m = typeKeys(m, `"+p`)
+	require.Equal(t, "x", m.Value())
+}
+
+func TestRegisters_NamedYankSkipsSystemClipboard(t *testing.T) {
+	clipboard := &mockClipboard{}
+	m := newRegisterTestModel("word").SetClipboard(clipboard)
+
+	m = typeKeys(m, `"ayiw`)
+	require.False(t, clipboard.copyCalled)
+
+	_ = typeKeys(m, "yiw")
+	require.Equal(t, "word", clipboard.copiedText)
+}
+
+func TestRegisters_SharedAcrossTextareas(t *testing.T) {
+	registers := NewRegisters()
+	first := New(Config{VimEnabled: true, DefaultMode: ModeNormal, Registers: registers})
+	first.SetValue("shared")
+	second := New(Config{VimEnabled: true, DefaultMode: ModeNormal})
+	second.SetRegisters(registers)
+
+	first.Focus()
+	first = typeKeys(first, `"qyyyiw`)
+	first.Blur()
+
+	second.Focus()
+	second = typeKeys(second, "p")
+	require.Equal(t, "shared", second.Value(), "focus picks up the shared unnamed register")
+
+	second = typeKeys(second, `"qp`)
+	require.Equal(t, "shared\nshared", second.Value())
+}
+
+func TestRegisters_String(t *testing.T) {
+	r := NewRegisters()
+	require.Equal(t, "Type Name Content", r.String())
+
+	r.Store(0, Register{Text: "line one\nline two", Linewise: true}, true)
+	r.Store('c', Register{Text: "café"}, false)
+
+	require.Equal(t, "Type Name Content\n"+
+		"  c  \"\"   café\n"+
+		"  l  \"0   line one^Jline two\n"+
+		"  c  \"c   café", r.String())
+}
+
+func TestRegisterPreview_Truncates(t *testing.T) {
+	long := registerPreview(string(make([]rune, registerPreviewWidth+5)))
+	require.Len(t, []rune(long), registerPreviewWidth)
+}
@@ -1,6 +1,7 @@
 package vimtextarea
 
 import (
+	"slices"
 	"strings"
 	"time"
 
@@ -24,6 +25,11 @@ const (
 	// 38;5;232 = 256-color foreground (dark text for contrast)
 	yankHighlightOn  = "\x1b[48;5;178;38;5;232m" // gold background, dark text
 	yankHighlightOff = "\x1b[49;39m"             // reset background and foreground
+	// Search matches use a blue background, like Vim's hlsearch
+	// 48;5;31 = 256-color background (steel blue)
+	// 38;5;255 = 256-color foreground (bright white for contrast)
+	searchHighlightOn  = "\x1b[48;5;31;38;5;255m" // blue background, white text
+	searchHighlightOff = "\x1b[49;39m"            // reset background and foreground
 )
 
 // Style definitions for the vimtextarea
@@ -36,9 +42,26 @@ var (
 // Note: Mode indicator is NOT rendered here - clients should use Mode() and ModeChangeMsg
 // to display mode information in their own UI (e.g., in a BorderedPane footer).
 func (m Model) View() string {
+	if m.spellMenu != nil {
+		return m.renderWithStatusLine(m.renderSpellMenu())
+	}
+	if m.search != nil {
+		return m.renderWithStatusLine(m.renderSearchPrompt())
+	}
 	return m.renderContent()
 }
 
+// renderWithStatusLine renders the content with a status line (the suggestions
+// menu or the search prompt) as the last line.
+// When the textarea is full, the top line makes room for the status line.
+func (m Model) renderWithStatusLine(status string) string {
+	lines := strings.Split(m.renderContent(), "\n")
+	if m.height > 1 && len(lines) >= m.height {
+		lines = lines[len(lines)-m.height+1:]
+	}
+	return strings.Join(append(lines, status), "\n")
+}
+
 // renderContent renders the text content with cursor, handling soft-wrap.
 func (m Model) renderContent() string {
 	// Handle empty content with placeholder
@@ -57,6 +80,8 @@ func (m Model) renderContent() string {
 	inVisualMode := m.focused && m.InVisualMode()
 	// Check if yank highlight is active and not expired
 	hasYankHighlight := m.yankHighlight != nil && time.Now().Before(m.yankHighlight.Expiry)
+	// Matches of the current or last search (hlsearch)
+	searchPattern := m.highlightedSearch()
 
 	for logicalRow, line := range m.content {
 		wrappedLines, graphemeStarts := m.wrapLineWithInfo(line)
@@ -93,6 +118,10 @@ func (m Model) renderContent() string {
 				// Yank highlight: brief flash on yanked region
 				renderedLine := m.renderLineWithYankHighlight(wrappedLine, logicalRow, wrapIdx, colInWrap, isCursorDisplayLine, segmentStartGrapheme)
 				displayLines = append(displayLines, renderedLine)
+			} else if searchRanges := m.searchRangesForRow(searchPattern, logicalRow); len(searchRanges) > 0 {
+				// Search matches on this line
+				renderedLine := m.renderLineWithSearchMatches(wrappedLine, searchRanges, colInWrap, isCursorDisplayLine, segmentStartGrapheme)
+				displayLines = append(displayLines, renderedLine)
 			} else if isCursorDisplayLine {
 				// Normal/Insert mode with cursor on this line
 				// Apply syntax highlighting as base layer, then cursor on top
@@ -188,13 +217,13 @@ func (m Model) renderWrappedLineWithSelection(wrappedLine string, logicalRow int
 
 	// Build byte-to-style map for syntax highlighting on non-selected parts
 	var byteStyles map[int]*lipgloss.Style
-	if m.lexer != nil {
+	if lexer := m.syntaxLexer(); lexer != nil {
 		fullLine := ""
 		if logicalRow < len(m.content) {
 			fullLine = m.content[logicalRow]
 		}
 		if fullLine != "" {
-			tokens := m.lexer.Tokenize(fullLine)
+			tokens := lexer.Tokenize(fullLine)
 			if len(tokens) > 0 {
 				segmentStartByte := GraphemeToByteOffset(fullLine, segmentStartGrapheme)
 				segmentTokens := m.mapTokensToSegment(tokens, segmentStartByte, len(wrappedLine))
@@ -399,7 +428,8 @@ func (m Model) renderLineWithSyntaxAndCursor(segment string, logicalRow int, wra
 	}
 
 	// If no lexer, use simple cursor rendering
-	if m.lexer == nil {
+	lexer := m.syntaxLexer()
+	if lexer == nil {
 		return m.renderLineWithCursor(segment, cursorColInWrap)
 	}
 
@@ -415,7 +445,7 @@ func (m Model) renderLineWithSyntaxAndCursor(segment string, logicalRow int, wra
 	}
 
 	// Tokenize the full logical line (tokens use byte offsets)
-	tokens := m.lexer.Tokenize(fullLine)
+	tokens := lexer.Tokenize(fullLine)
 	if len(tokens) == 0 {
 		return m.renderLineWithCursor(segment, cursorColInWrap)
 	}
@@ -584,6 +614,53 @@ func (m Model) renderLineWithYankHighlight(wrappedLine string, logicalRow int, w
 	return result.String()
 }
 
+// renderLineWithSearchMatches renders a wrapped segment with search matches
+// highlighted. ranges are [start, end) grapheme indices in the full line.
+// The cursor takes precedence over the highlight.
+func (m Model) renderLineWithSearchMatches(wrappedLine string, ranges [][2]int, cursorColInWrap int, isCursorDisplayLine bool, segmentStartGrapheme int) string {
+	if wrappedLine == "" {
+		if isCursorDisplayLine {
+			return cursorOn + " " + cursorOff
+		}
+		return " "
+	}
+
+	var result strings.Builder
+	inHighlightRun := false
+	iter := NewGraphemeIterator(wrappedLine)
+	for iter.Next() {
+		col := segmentStartGrapheme + iter.Index()
+		isCursor := isCursorDisplayLine && iter.Index() == cursorColInWrap
+		isHighlighted := slices.ContainsFunc(ranges, func(r [2]int) bool { return col >= r[0] && col < r[1] })
+
+		if inHighlightRun && (isCursor || !isHighlighted) {
+			result.WriteString(searchHighlightOff)
+			inHighlightRun = false
+		}
+		switch {
+		case isCursor:
+			result.WriteString(cursorOn + iter.Cluster() + cursorOff)
+		case isHighlighted:
+			if !inHighlightRun {
+				result.WriteString(searchHighlightOn)
+				inHighlightRun = true
+			}
+			result.WriteString(iter.Cluster())
+		default:
+			result.WriteString(iter.Cluster())
+		}
+	}
+	if inHighlightRun {
+		result.WriteString(searchHighlightOff)
+	}
+
+	// Handle cursor at end of line
+	if isCursorDisplayLine && cursorColInWrap >= GraphemeCount(wrappedLine) {
+		result.WriteString(cursorOn + " " + cursorOff)
+	}
+	return result.String()
+}
+
 // getYankHighlightRangeForRow returns the column range to highlight on a given row.
 // Returns (startCol, endCol, inHighlight) where endCol is exclusive.
 // Column values are grapheme indices, not byte offsets.
@@ -821,7 +898,8 @@ func (m Model) mapTokensToSegment(tokens []SyntaxToken, wrapStartCol, segmentLen
 // Note: Syntax highlighting is temporarily simplified for grapheme-aware rendering.
 // The lexer returns byte-based tokens which require translation via ByteToGraphemeOffset().
 func (m Model) applySyntaxToSegment(segment string, logicalRow int, _ int, segmentStartGrapheme int) string {
-	if m.lexer == nil || segment == "" {
+	lexer := m.syntaxLexer()
+	if lexer == nil || segment == "" {
 		return segment
 	}
 
@@ -835,7 +913,7 @@ func (m Model) applySyntaxToSegment(segment string, logicalRow int, _ int, segme
 	}
 
 	// Tokenize the full logical line (tokens use byte offsets)
-	tokens := m.lexer.Tokenize(fullLine)
+	tokens := lexer.Tokenize(fullLine)
 	if len(tokens) == 0 {
 		return segment
 	}
@@ -0,0 +1,381 @@
+package vimtextarea
+
+import (
+	"strings"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// ============================================================================
+// Search (/, ?, n, N)
+// ============================================================================
+//
+// Patterns are matched literally. Matching ignores case unless the pattern
+// contains an uppercase letter (Vim's smartcase). Searches wrap around the
+// end of the buffer.
+
+// searchPrompt is the pattern being typed after / or ?.
+type searchPrompt struct {
+	forward  bool     // True for /, false for ?
+	input    []rune   // Pattern typed so far
+	operator rune     // Operator the search is the target of ('d', 'c', 'y'), 0 for a motion
+	origin   Position // Cursor position when the search started
+}
+
+// searchOperators are the operators that accept a search as their target (d/foo).
+const searchOperators = "dcy"
+
+// StartSearchCommand opens the search prompt: / searches forward, ? backward.
+type StartSearchCommand struct {
+	MotionBase
+	forward bool
+	mode    Mode // Which mode this command operates in (set during registration)
+}
+
+// Execute opens the search prompt at the cursor.
+func (c *StartSearchCommand) Execute(m *Model) ExecuteResult {
+	m.openSearch(c.forward, 0)
+	return Executed
+}
+
+// Keys returns the trigger keys for this command.
+func (c *StartSearchCommand) Keys() []string {
+	if c.forward {
+		return []string{"/"}
+	}
+	return []string{"?"}
+}
+
+// Mode returns the mode this command operates in.
+func (c *StartSearchCommand) Mode() Mode {
+	return c.mode
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *StartSearchCommand) ID() string {
+	if c.forward {
+		return "search.forward"
+	}
+	return "search.backward"
+}
+
+// SearchNextCommand repeats the last search: n in the same direction,
+// N in the opposite one. Skipped if nothing was searched yet or the
+// pattern no longer matches.
+type SearchNextCommand struct {
+	MotionBase
+	reverse bool
+	mode    Mode // Which mode this command operates in (set during registration)
+}
+
+// Execute moves the cursor to the next match.
+func (c *SearchNextCommand) Execute(m *Model) ExecuteResult {
+	if m.lastSearch == "" {
+		return Skipped
+	}
+	forward := m.lastSearchForward != c.reverse
+	pos, ok := m.findSearchMatch(m.lastSearch, Position{Row: m.cursorRow, Col: m.cursorCol}, forward)
+	m.searchHighlight = true
+	if !ok {
+		return Skipped
+	}
+	m.cursorRow, m.cursorCol = pos.Row, pos.Col
+	m.preferredCol = pos.Col
+	return Executed
+}
+
+// Keys returns the trigger keys for this command.
+func (c *SearchNextCommand) Keys() []string {
+	if c.reverse {
+		return []string{"N"}
+	}
+	return []string{"n"}
+}
+
+// Mode returns the mode this command operates in.
+func (c *SearchNextCommand) Mode() Mode {
+	return c.mode
+}
+
+// ID returns the hierarchical identifier for this command.
+func (c *SearchNextCommand) ID() string {
+	if c.reverse {
+		return "search.prev"
+	}
+	return "search.next"
+}
+
+// openSearch opens the search prompt. A non-zero operator makes the search
+// the target of that operator once the pattern is confirmed.
+func (m *Model) openSearch(forward bool, operator rune) {
+	m.search = &searchPrompt{
+		forward:  forward,
+		operator: operator,
+		origin:   Position{Row: m.cursorRow, Col: m.cursorCol},
+	}
+}
+
+// handleSearchKey handles a key while the search prompt is open.
+// <Enter> confirms the pattern (an empty pattern repeats the last search),
+// <Escape> cancels and <Backspace> on an empty pattern cancels as well.
+// Without an operator the cursor follows the first match while typing.
+func (m Model) handleSearchKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	s := *m.search
+	switch msg.Type {
+	case tea.KeyEscape, tea.KeyCtrlC:
+		m.cancelSearch()
+		return m, nil
+	case tea.KeyEnter:
+		m, cmd := m.confirmSearch()
+		m.selectedRegister = 0
+		return m, cmd
+	case tea.KeyBackspace:
+		if len(s.input) == 0 {
+			m.cancelSearch()
+			return m, nil
+		}
+		s.input = s.input[:len(s.input)-1]
+	case tea.KeySpace:
+		s.input = append(s.input, ' ')
+	case tea.KeyRunes:
+		s.input = append(s.input, msg.Runes...)
+	default:
+		return m, nil
+	}
+	s.input = append([]rune(nil), s.input...)
+	m.search = &s
+	m.incrementalSearch()
+	return m, nil
+}
+
+// incrementalSearch moves the cursor to the first match of the pattern typed
+// so far, or back to where the search started if there is none.
+func (m *Model) incrementalSearch() {
+	s := m.search
+	m.cursorRow, m.cursorCol = s.origin.Row, s.origin.Col
+	if s.operator != 0 || len(s.input) == 0 {
+		return
+	}
+	if pos, ok := m.findSearchMatch(string(s.input), s.origin, s.forward); ok {
+		m.cursorRow, m.cursorCol = pos.Row, pos.Col
+	}
+}
+
+// cancelSearch closes the search prompt and restores the cursor.
+func (m *Model) cancelSearch() {
+	m.cursorRow, m.cursorCol = m.search.origin.Row, m.search.origin.Col
+	m.search = nil
+	m.selectedRegister = 0
+}
+
+// confirmSearch closes the search prompt, remembers the pattern for n/N and
+// moves the cursor to the match or applies the pending operator up to it.
+func (m Model) confirmSearch() (Model, tea.Cmd) {
+	s := m.search
+	m.search = nil
+	m.cursorRow, m.cursorCol = s.origin.Row, s.origin.Col
+
+	pattern := string(s.input)
+	if pattern == "" {
+		pattern = m.lastSearch
+	}
+	if pattern == "" {
+		return m, nil
+	}
+	m.lastSearch = pattern
+	m.lastSearchForward = s.forward
+	m.searchHighlight = true
+
+	pos, ok := m.findSearchMatch(pattern, s.origin, s.forward)
+	if !ok {
+		return m, nil
+	}
+	if s.operator == 0 {
+		m.cursorRow, m.cursorCol = pos.Row, pos.Col
+		m.preferredCol = pos.Col
+		return m, nil
+	}
+	return m.applySearchOperator(s.operator, s.origin, pos)
+}
+
+// applySearchOperator applies an operator to the text between the cursor and
+// a match. Like Vim, the motion is exclusive: the match itself is kept. A match
+// at the start of a line keeps the line break before it as well.
+// The range is applied as a character-wise visual selection so it shares undo,
+// registers and yank highlighting with the visual operators.
+func (m Model) applySearchOperator(operator rune, from, to Position) (Model, tea.Cmd) {
+	start, end := from, to
+	if comparePositions(end, start) < 0 {
+		start, end = end, start
+	}
+	if start == end {
+		return m, nil
+	}
+	if end.Col > 0 {
+		end.Col--
+	} else {
+		end.Row--
+		end.Col = max(GraphemeCount(m.content[end.Row])-1, 0)
+	}
+
+	var cmd Command
+	switch operator {
+	case 'd':
+		cmd = &VisualDeleteCommand{mode: ModeVisual}
+	case 'c':
+		cmd = &VisualChangeCommand{mode: ModeVisual}
+	case 'y':
+		cmd = &VisualYankCommand{mode: ModeVisual}
+	default:
+		return m, nil
+	}
+
+	previousMode := m.mode
+	m.mode = ModeVisual
+	m.visualAnchor = start
+	m.cursorRow, m.cursorCol = end.Row, end.Col
+	cmd, _, teaCmd := m.executeCommand(cmd)
+	if operator == 'y' {
+		// Like y with any motion, the cursor ends at the start of the yanked text
+		m.cursorRow, m.cursorCol = start.Row, start.Col
+		m.preferredCol = start.Col
+	}
+
+	if yanker, ok := cmd.(YankHighlighter); ok {
+		if hlStart, hlEnd, linewise, show := yanker.YankHighlightRegion(); show {
+			teaCmd = tea.Batch(teaCmd, m.SetYankHighlight(hlStart, hlEnd, linewise))
+		}
+	}
+	// d and y return to Normal mode, so only c changes mode from the user's view
+	if m.mode != previousMode {
+		teaCmd = tea.Batch(teaCmd, m.modeChangeCmd(previousMode))
+	}
+	return m, teaCmd
+}
+
+// comparePositions returns -1, 0 or 1 as a is before, at or after b.
+func comparePositions(a, b Position) int {
+	switch {
+	case a.Row != b.Row:
+		if a.Row < b.Row {
+			return -1
+		}
+		return 1
+	case a.Col < b.Col:
+		return -1
+	case a.Col > b.Col:
+		return 1
+	}
+	return 0
+}
+
+// findSearchMatch returns the position of the next match of pattern after
+// from (forward) or before it (backward), wrapping around the buffer.
+// A match at from is only found after wrapping all the way around.
+func (m Model) findSearchMatch(pattern string, from Position, forward bool) (Position, bool) {
+	n := len(m.content)
+	for i := 0; i <= n; i++ {
+		if forward {
+			row := (from.Row + i) % n
+			for _, col := range searchMatches(m.content[row], pattern) {
+				if i == 0 && col <= from.Col {
+					continue
+				}
+				return Position{Row: row, Col: col}, true
+			}
+			continue
+		}
+		row := ((from.Row-i)%n + n) % n
+		cols := searchMatches(m.content[row], pattern)
+		for j := len(cols) - 1; j >= 0; j-- {
+			if i == 0 && cols[j] >= from.Col {
+				continue
+			}
+			return Position{Row: row, Col: cols[j]}, true
+		}
+	}
+	return Position{}, false
+}
+
+// searchMatches returns the grapheme columns where non-overlapping matches
+// of pattern start in line.
+func searchMatches(line, pattern string) []int {
+	if pattern == "" {
+		return nil
+	}
+	if !strings.ContainsFunc(pattern, unicode.IsUpper) {
+		line = strings.ToLower(line)
+		pattern = strings.ToLower(pattern)
+	}
+	var cols []int
+	for offset := 0; ; {
+		i := strings.Index(line[offset:], pattern)
+		if i < 0 {
+			return cols
+		}
+		cols = append(cols, ByteToGraphemeOffset(line, offset+i))
+		offset += i + len(pattern)
+	}
+}
+
+// highlightedSearch returns the pattern whose matches are highlighted: the
+// pattern being typed while the prompt is open, otherwise the last search
+// until highlighting is cleared.
+func (m Model) highlightedSearch() string {
+	if m.search != nil {
+		if len(m.search.input) > 0 {
+			return string(m.search.input)
+		}
+		return ""
+	}
+	if m.searchHighlight {
+		return m.lastSearch
+	}
+	return ""
+}
+
+// searchRangesForRow returns the [start, end) grapheme ranges of highlighted
+// matches in a row.
+func (m Model) searchRangesForRow(pattern string, row int) [][2]int {
+	var ranges [][2]int
+	width := GraphemeCount(pattern)
+	for _, col := range searchMatches(m.content[row], pattern) {
+		ranges = append(ranges, [2]int{col, col + width})
+	}
+	return ranges
+}
+
+// renderSearchPrompt renders the search prompt, e.g. "/pattern".
+func (m Model) renderSearchPrompt() string {
+	prefix := "?"
+	if m.search.forward {
+		prefix = "/"
+	}
+	muted := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	return muted.Render(prefix) + string(m.search.input) + cursorOn + " " + cursorOff
+}
+
+// ============================================================================
+// Search API
+// ============================================================================
+
+// Searching returns true while the search prompt is open. Parents that treat
+// <Escape> or <Enter> specially should forward them to the textarea in that case.
+func (m Model) Searching() bool {
+	return m.search != nil
+}
+
+// LastSearch returns the last confirmed search pattern, repeated by n and N.
+func (m Model) LastSearch() string {
+	return m.lastSearch
+}
+
+// ClearSearchHighlight stops highlighting matches of the last search
+// until the next search (like Vim's :nohlsearch).
+func (m *Model) ClearSearchHighlight() {
+	m.searchHighlight = false
+}
@@ -0,0 +1,182 @@
+package vimtextarea
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+)
+
+// search types a search prompt and confirms it with <Enter>.
+func search(m Model, prompt string) Model {
+	m = typeKeys(m, prompt)
+	m, _ = m.Update(enterKey())
+	return m
+}
+
+func newSearchTestModel(content string) Model {
+	m := newRegisterTestModel(content)
+	m = typeKeys(m, "gg0")
+	return m
+}
+
+func TestSearch_ForwardMovesToMatch(t *testing.T) {
+	m := newSearchTestModel("one two\nthree two")
+
+	m = search(m, "/two")
+	require.Equal(t, Position{Row: 0, Col: 4}, m.CursorPosition())
+	require.False(t, m.Searching())
+	require.Equal(t, "two", m.LastSearch())
+
+	m = typeKeys(m, "n")
+	require.Equal(t, Position{Row: 1, Col: 6}, m.CursorPosition())
+
+	// n wraps around the end of the buffer
+	m = typeKeys(m, "n")
+	require.Equal(t, Position{Row: 0, Col: 4}, m.CursorPosition())
+
+	// N goes the other way, wrapping around the start
+	m = typeKeys(m, "N")
+	require.Equal(t, Position{Row: 1, Col: 6}, m.CursorPosition())
+}
+
+func TestSearch_BackwardAndRepeat(t *testing.T) {
+	m := newSearchTestModel("foo bar foo bar foo")
+	m = typeKeys(m, "$")
+
+	m = search(m, "?foo")
+	require.Equal(t, Position{Row: 0, Col: 16}, m.CursorPosition())
+
+	// n repeats in the direction of the last search
+	m = typeKeys(m, "n")
+	require.Equal(t, Position{Row: 0, Col: 8}, m.CursorPosition())
+	m = typeKeys(m, "N")
+	require.Equal(t, Position{Row: 0, Col: 16}, m.CursorPosition())
+}
+
+func TestSearch_SmartCase(t *testing.T) {
+	m := newSearchTestModel("Go go GO")
+
+	m = search(m, "/go")
+	require.Equal(t, Position{Row: 0, Col: 3}, m.CursorPosition(), "lowercase matches any case")
+
+	m = search(m, "/GO")
+	require.Equal(t, Position{Row: 0, Col: 6}, m.CursorPosition(), "uppercase matches exactly")
+
+	require.Equal(t, []int{0, 3, 6}, searchMatches("Go go GO", "go"))
+	require.Equal(t, []int{0}, searchMatches("Go go GO", "Go"))
+}
+
+func TestSearch_IncrementalAndCancel(t *testing.T) {
+	m := newSearchTestModel("alpha beta gamma")
+
+	m = typeKeys(m, "/ga")
+	require.True(t, m.Searching())
+	require.Equal(t, Position{Row: 0, Col: 11}, m.CursorPosition(), "cursor follows the match while typing")
+	require.Contains(t, ansi.Strip(m.View()), "/ga")
+
+	m, _ = m.Update(escapeKey())
+	require.False(t, m.Searching())
+	require.Equal(t, Position{Row: 0, Col: 0}, m.CursorPosition())
+	require.Empty(t, m.LastSearch())
+}
+
+func TestSearch_BackspaceEditsAndCancels(t *testing.T) {
+	m := newSearchTestModel("ab ax")
+
+	m = typeKeys(m, "/ax")
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	require.True(t, m.Searching())
+	require.Equal(t, Position{Row: 0, Col: 0}, m.CursorPosition())
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	require.False(t, m.Searching())
+}
+
+func TestSearch_EmptyPatternRepeatsLast(t *testing.T) {
+	m := newSearchTestModel("x a x a")
+
+	m = search(m, "/a")
+	m = search(m, "/")
+	require.Equal(t, Position{Row: 0, Col: 6}, m.CursorPosition())
+}
+
+func TestSearch_NoMatchKeepsCursor(t *testing.T) {
+	m := newSearchTestModel("hello")
+
+	m = search(m, "/zzz")
+	require.Equal(t, Position{Row: 0, Col: 0}, m.CursorPosition())
+	require.Equal(t, "zzz", m.LastSearch())
+}
+
+func TestSearch_DeleteToMatch(t *testing.T) {
+	m := newSearchTestModel("keep drop this end")
+	m = typeKeys(m, "w")
+
+	m = search(m, "d/end")
+	require.Equal(t, "keep end", m.Value())
+	require.Equal(t, ModeNormal, m.Mode())
+	require.Equal(t, "drop this ", m.lastYankedText)
+
+	m = typeKeys(m, "u")
+	require.Equal(t, "keep drop this end", m.Value())
+}
+
+func TestSearch_DeleteBackwardToMatch(t *testing.T) {
+	m := newSearchTestModel("start middle end")
+	m = typeKeys(m, "$")
+
+	m = search(m, "d?mid")
+	require.Equal(t, "start d", m.Value())
+}
+
+func TestSearch_DeleteToMatchAtLineStartKeepsLineBreak(t *testing.T) {
+	m := newSearchTestModel("first line\nsecond\ntarget here")
+	m = typeKeys(m, "w")
+
+	m = search(m, "d/target")
+	require.Equal(t, "first \ntarget here", m.Value())
+}
+
+func TestSearch_YankAndChangeToMatch(t *testing.T) {
+	m := newSearchTestModel("one two three")
+
+	m = search(m, `"ay/three`)
+	reg, ok := m.Registers().Get('a')
+	require.True(t, ok)
+	require.Equal(t, "one two ", reg.Text)
+	require.Equal(t, "one two three", m.Value())
+	require.Zero(t, m.SelectedRegister())
+
+	m = search(m, "c/two")
+	require.Equal(t, ModeInsert, m.Mode())
+	require.Equal(t, "two three", m.Value())
+}
+
+func TestSearch_ExtendsVisualSelection(t *testing.T) {
+	m := newSearchTestModel("select up to here")
+
+	m = typeKeys(m, "v")
+	m = search(m, "/here")
+	require.Equal(t, ModeVisual, m.Mode())
+	require.Equal(t, "select up to h", m.SelectedText())
+}
+
+func TestSearch_HighlightsMatches(t *testing.T) {
+	m := newSearchTestModel("cat dog\ncat")
+	m.SetSize(40, 5)
+
+	m = search(m, "/cat")
+	view := m.View()
+	require.Contains(t, view, searchHighlightOn+"cat"+searchHighlightOff)
+
+	// <Escape> in Normal mode clears the highlight
+	m, _ = m.Update(escapeKey())
+	require.NotContains(t, m.View(), searchHighlightOn)
+
+	// n highlights again
+	m = typeKeys(m, "n")
+	require.Contains(t, m.View(), searchHighlightOn)
+}
@@ -0,0 +1,577 @@
+package vimtextarea
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/zjrosen/perles/internal/ui/styles"
+)
+
+// SpellChecker decides whether words are spelled correctly and suggests corrections.
+// Words are passed as typed (original case); implementations decide case handling.
+type SpellChecker interface {
+	// Check returns true if word is spelled correctly.
+	Check(word string) bool
+
+	// Suggest returns up to limit corrections for word, best first.
+	Suggest(word string, limit int) []string
+}
+
+// SpellSuggestionLimit is the number of suggestions offered by z= (selectable with 1-9).
+const SpellSuggestionLimit = 9
+
+// systemWordList is the word list loaded by DefaultDictionary when present.
+const systemWordList = "/usr/share/dict/words"
+
+//go:embed dict/words.txt
+var embeddedWords string
+
+var (
+	defaultDictOnce sync.Once
+	defaultDict     *Dictionary
+)
+
+// DefaultDictionary returns the shared dictionary used by textareas without
+// Config.SpellChecker. It holds the embedded word list plus the system word
+// list (/usr/share/dict/words) when one is installed. Loaded on first use.
+func DefaultDictionary() *Dictionary {
+	defaultDictOnce.Do(func() {
+		defaultDict = NewDictionary()
+		_ = defaultDict.AddFrom(strings.NewReader(embeddedWords))
+		if f, err := os.Open(systemWordList); err == nil {
+			_ = defaultDict.AddFrom(f)
+			_ = f.Close()
+		}
+	})
+	return defaultDict
+}
+
+// Dictionary is a word-list SpellChecker. Lookups are case-insensitive and
+// accept common inflections of listed words (plurals, -ed, -ing, -ly, -er,
+// -est, un-/re- prefixes), so the list only needs base forms.
+// Safe for concurrent use.
+type Dictionary struct {
+	mu    sync.RWMutex
+	words map[string]struct{}
+}
+
+// NewDictionary creates a dictionary containing words.
+func NewDictionary(words ...string) *Dictionary {
+	d := &Dictionary{words: make(map[string]struct{}, len(words))}
+	d.Add(words...)
+	return d
+}
+
+// Add adds words to the dictionary.
+func (d *Dictionary) Add(words ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, w := range words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			d.words[w] = struct{}{}
+		}
+	}
+}
+
+// AddFrom adds one word per line from r. Blank lines and lines starting with # are skipped.
+func (d *Dictionary) AddFrom(r io.Reader) error {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	d.Add(words...)
+	return nil
+}
+
+// Len returns the number of words in the dictionary.
+func (d *Dictionary) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.words)
+}
+
+// Check returns true if word, or a base form of it, is in the dictionary.
+func (d *Dictionary) Check(word string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.known(strings.ToLower(word), spellMaxAffixes)
+}
+
+// Suggest returns up to limit dictionary words one edit away from word, or
+// two edits away if there are none. Transposed letters ("teh") rank first.
+// Suggestions follow the capitalization of word.
+func (d *Dictionary) Suggest(word string, limit int) []string {
+	lower := strings.ToLower(word)
+	if limit <= 0 || lower == "" {
+		return nil
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	type candidate struct {
+		word string
+		rank int // edit distance * 2, minus one for a single transposition
+	}
+	seen := map[string]bool{lower: true}
+	var found []candidate
+	consider := func(w string, rank int) {
+		if seen[w] {
+			return
+		}
+		seen[w] = true
+		if d.known(w, spellMaxAffixes) {
+			found = append(found, candidate{word: w, rank: rank})
+		}
+	}
+
+	first := spellEdits(lower)
+	for _, e := range first {
+		rank := 2
+		if e.transpose {
+			rank = 1
+		}
+		consider(e.word, rank)
+	}
+	// Second edits are only explored when no single edit is a word; they are
+	// exact lookups (no inflections) since there are ~100k of them for a long word.
+	if len(found) == 0 {
+		for _, e1 := range first {
+			for _, e2 := range spellEdits(e1.word) {
+				if seen[e2.word] {
+					continue
+				}
+				seen[e2.word] = true
+				if _, ok := d.words[e2.word]; ok {
+					found = append(found, candidate{word: e2.word, rank: 4})
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(found, func(a, b candidate) int {
+		if a.rank != b.rank {
+			return a.rank - b.rank
+		}
+		// Prefer keeping the first letter, then similar length
+		if fa, fb := a.word[0] == lower[0], b.word[0] == lower[0]; fa != fb {
+			if fa {
+				return -1
+			}
+			return 1
+		}
+		if la, lb := absInt(len(a.word)-len(lower)), absInt(len(b.word)-len(lower)); la != lb {
+			return la - lb
+		}
+		return strings.Compare(a.word, b.word)
+	})
+
+	suggestions := make([]string, 0, min(limit, len(found)))
+	for _, c := range found[:min(limit, len(found))] {
+		suggestions = append(suggestions, matchCase(c.word, word))
+	}
+	return suggestions
+}
+
+// known reports whether lower is a listed word or an inflection of one,
+// stripping at most depth affixes.
+// Must be called with d.mu held.
+func (d *Dictionary) known(lower string, depth int) bool {
+	if _, ok := d.words[lower]; ok {
+		return true
+	}
+	if depth == 0 || utf8.RuneCountInString(lower) < 4 {
+		return false
+	}
+	for _, base := range spellStems(lower) {
+		if d.known(base, depth-1) {
+			return true
+		}
+	}
+	return false
+}
+
+// spellMaxAffixes is how many affixes are stripped looking for a listed word,
+// enough for "un"+"expect"+"ed"+"ly".
+const spellMaxAffixes = 3
+
+// spellSuffixes are the inflections stripped by spellStems.
+var spellSuffixes = []string{"'s", "s", "es", "ed", "ing", "ly", "er", "ers", "est", "ness", "ment", "ments", "able", "ful", "less"}
+
+// spellPrefixes are the prefixes stripped by spellStems.
+var spellPrefixes = []string{"un", "re", "pre", "non", "sub", "multi", "over", "under"}
+
+// spellStems returns candidate base forms of an inflected word.
+func spellStems(w string) []string {
+	var stems []string
+	for _, suffix := range spellSuffixes {
+		base, ok := strings.CutSuffix(w, suffix)
+		if !ok || len(base) < 2 {
+			continue
+		}
+		stems = append(stems, base)
+		switch suffix {
+		case "ed", "ing", "er", "ers", "est", "able":
+			// used -> use, stopped -> stop
+			stems = append(stems, base+"e")
+			if n := len(base); n >= 3 && base[n-1] == base[n-2] {
+				stems = append(stems, base[:n-1])
+			}
+		}
+		// tries -> try, happily -> happy, tidied -> tidy
+		if base[len(base)-1] == 'i' {
+			stems = append(stems, base[:len(base)-1]+"y")
+		}
+	}
+	for _, prefix := range spellPrefixes {
+		if base, ok := strings.CutPrefix(w, prefix); ok && len(base) >= 3 {
+			stems = append(stems, base)
+		}
+	}
+	return stems
+}
+
+// spellEdit is a word one edit away from another.
+type spellEdit struct {
+	word      string
+	transpose bool
+}
+
+// spellEdits returns all words one deletion, transposition, replacement or
+// insertion (of a-z) away from w.
+func spellEdits(w string) []spellEdit {
+	runes := []rune(w)
+	edits := make([]spellEdit, 0, 54*len(runes)+26)
+	for i := range runes {
+		edits = append(edits, spellEdit{word: string(runes[:i]) + string(runes[i+1:])})
+		if i+1 < len(runes) && runes[i] != runes[i+1] {
+			t := slices.Clone(runes)
+			t[i], t[i+1] = t[i+1], t[i]
+			edits = append(edits, spellEdit{word: string(t), transpose: true})
+		}
+	}
+	for i := 0; i <= len(runes); i++ {
+		for c := 'a'; c <= 'z'; c++ {
+			if i < len(runes) && runes[i] != c {
+				edits = append(edits, spellEdit{word: string(runes[:i]) + string(c) + string(runes[i+1:])})
+			}
+			edits = append(edits, spellEdit{word: string(runes[:i]) + string(c) + string(runes[i:])})
+		}
+	}
+	return edits
+}
+
+// matchCase capitalizes suggestion like word ("Teh" -> "The").
+func matchCase(suggestion, word string) string {
+	r, _ := utf8.DecodeRuneInString(word)
+	if !unicode.IsUpper(r) {
+		return suggestion
+	}
+	s, size := utf8.DecodeRuneInString(suggestion)
+	return string(unicode.ToUpper(s)) + suggestion[size:]
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ============================================================================
+// Word Scanning
+// ============================================================================
+
+// spellWord is a checkable word within a line.
+type spellWord struct {
+	Start int // Byte offset of the first character
+	End   int // Byte offset after the last character
+	Word  string
+}
+
+// spellMinWordLen is the shortest word that is checked. Shorter words are
+// mostly abbreviations and would be flagged far more often than misspelled.
+const spellMinWordLen = 3
+
+// spellTrimChars is punctuation trimmed from both ends of a whitespace-separated chunk.
+const spellTrimChars = "()[]{}<>\"'`,.;:!?*_~"
+
+// spellWords returns the words of line that should be spell checked.
+// Prose is checked; anything that looks like code is skipped: `inline code`,
+// chunks containing digits or any of "_./\@#$=:" (paths, URLs, identifiers),
+// camelCase and ALLCAPS words, and words shorter than three letters.
+// Hyphenated words are checked part by part.
+func spellWords(line string) []spellWord {
+	var words []spellWord
+	inCode := false
+	for i := 0; i < len(line); {
+		// Find the next whitespace-separated chunk
+		for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+			i++
+		}
+		start := i
+		for i < len(line) && line[i] != ' ' && line[i] != '\t' {
+			i++
+		}
+		chunk := line[start:i]
+		if chunk == "" {
+			continue
+		}
+
+		// Skip `inline code` spans, which may contain spaces
+		ticks := strings.Count(chunk, "`")
+		wasCode := inCode
+		if ticks%2 == 1 {
+			inCode = !inCode
+		}
+		if wasCode || ticks > 0 {
+			continue
+		}
+
+		trimmed := strings.TrimLeft(chunk, spellTrimChars)
+		offset := start + len(chunk) - len(trimmed)
+		trimmed = strings.TrimRight(trimmed, spellTrimChars)
+		if trimmed == "" || strings.ContainsAny(trimmed, "_./\\@#$=:0123456789") {
+			continue
+		}
+
+		for part := range strings.SplitSeq(trimmed, "-") {
+			if isSpellCheckable(part) {
+				words = append(words, spellWord{Start: offset, End: offset + len(part), Word: part})
+			}
+			offset += len(part) + 1
+		}
+	}
+	return words
+}
+
+// isSpellCheckable returns true for plain words: letters (and apostrophes),
+// lowercase after the first letter, at least spellMinWordLen letters.
+func isSpellCheckable(word string) bool {
+	letters := 0
+	for i, r := range word {
+		switch {
+		case r == '\'' || r == '’':
+			continue
+		case !unicode.IsLetter(r):
+			return false
+		case i > 0 && unicode.IsUpper(r):
+			return false
+		}
+		letters++
+	}
+	return letters >= spellMinWordLen
+}
+
+// misspellings returns the words of line rejected by checker.
+func misspellings(checker SpellChecker, line string) []spellWord {
+	var bad []spellWord
+	for _, w := range spellWords(line) {
+		if !checker.Check(strings.ReplaceAll(w.Word, "’", "'")) {
+			bad = append(bad, w)
+		}
+	}
+	return bad
+}
+
+// ============================================================================
+// Rendering
+// ============================================================================
+
+// spellLexer underlines misspellings on top of another lexer's tokens.
+// Tokens from the wrapped lexer win where they overlap a misspelling.
+type spellLexer struct {
+	base    SyntaxLexer
+	checker SpellChecker
+}
+
+// Tokenize implements SyntaxLexer.
+func (l spellLexer) Tokenize(line string) []SyntaxToken {
+	var tokens []SyntaxToken
+	if l.base != nil {
+		tokens = l.base.Tokenize(line)
+	}
+	bad := misspellings(l.checker, line)
+	if len(bad) == 0 {
+		return tokens
+	}
+
+	style := lipgloss.NewStyle().Underline(true).Foreground(styles.StatusErrorColor)
+	merged := slices.Clone(tokens)
+	for _, w := range bad {
+		overlaps := slices.ContainsFunc(tokens, func(t SyntaxToken) bool {
+			return t.Start < w.End && w.Start < t.End
+		})
+		if !overlaps {
+			merged = append(merged, SyntaxToken{Start: w.Start, End: w.End, Style: style})
+		}
+	}
+	slices.SortFunc(merged, func(a, b SyntaxToken) int { return a.Start - b.Start })
+	return merged
+}
+
+// syntaxLexer returns the lexer used for rendering: the configured lexer,
+// wrapped to underline misspellings when spell checking is enabled.
+func (m Model) syntaxLexer() SyntaxLexer {
+	if m.spell == nil {
+		return m.lexer
+	}
+	return spellLexer{base: m.lexer, checker: m.spell}
+}
+
+// ============================================================================
+// Suggestions Menu (z=, Ctrl+L)
+// ============================================================================
+
+// spellMenu is the open list of suggestions for a misspelled word.
+type spellMenu struct {
+	row         int    // Line of the misspelled word
+	startCol    int    // Grapheme index of the word's first character
+	endCol      int    // Grapheme index after the word's last character
+	word        string // The misspelled word
+	suggestions []string
+}
+
+// misspellingNearCursor returns the misspelled word under the cursor or,
+// failing that, the closest one before it on the cursor line (so Ctrl+L
+// in Insert mode fixes the word just typed).
+func (m *Model) misspellingNearCursor() (spellWord, bool) {
+	line := m.content[m.cursorRow]
+	cursorByte := GraphemeToByteOffset(line, m.cursorCol)
+
+	var found spellWord
+	ok := false
+	for _, w := range misspellings(m.spell, line) {
+		if w.Start > cursorByte {
+			break
+		}
+		found, ok = w, true
+	}
+	return found, ok
+}
+
+// openSpellMenu opens the suggestions menu for the misspelling near the cursor.
+// Returns false if spell checking is disabled or there is nothing to correct.
+func (m *Model) openSpellMenu() bool {
+	if m.spell == nil {
+		return false
+	}
+	w, ok := m.misspellingNearCursor()
+	if !ok {
+		return false
+	}
+	line := m.content[m.cursorRow]
+	m.spellMenu = &spellMenu{
+		row:         m.cursorRow,
+		startCol:    ByteToGraphemeOffset(line, w.Start),
+		endCol:      ByteToGraphemeOffset(line, w.End),
+		word:        w.Word,
+		suggestions: m.spell.Suggest(w.Word, SpellSuggestionLimit),
+	}
+	return true
+}
+
+// handleSpellMenuKey handles a key while the suggestions menu is open.
+// 1-9 picks a suggestion and <Escape> dismisses the menu; any other key
+// dismisses it and is then processed as usual.
+func (m Model) handleSpellMenuKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	menu := m.spellMenu
+	m.spellMenu = nil
+
+	if msg.Type == tea.KeyEscape {
+		return m, nil
+	}
+	if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && msg.Runes[0] >= '1' && msg.Runes[0] <= '9' {
+		if n := int(msg.Runes[0] - '1'); n < len(menu.suggestions) {
+			_, _, teaCmd := m.executeCommand(&ReplaceSpellingCommand{
+				row:         menu.row,
+				startCol:    menu.startCol,
+				endCol:      menu.endCol,
+				replacement: menu.suggestions[n],
+			})
+			return m, teaCmd
+		}
+	}
+	return m.handleKeyMsg(msg)
+}
+
+// renderSpellMenu renders the suggestions menu as a single line,
+// e.g. "teh → 1 the  2 ten  3 tea  (1-9, esc)".
+func (m Model) renderSpellMenu() string {
+	muted := lipgloss.NewStyle().Foreground(styles.TextMutedColor)
+	word := lipgloss.NewStyle().Underline(true).Foreground(styles.StatusErrorColor).Render(m.spellMenu.word)
+	if len(m.spellMenu.suggestions) == 0 {
+		return word + muted.Render(" → no suggestions")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(word + muted.Render(" →"))
+	for i, s := range m.spellMenu.suggestions {
+		sb.WriteString(muted.Render(" "+string(rune('1'+i))+" ") + s + " ")
+	}
+	sb.WriteString(muted.Render("(1-9, esc)"))
+	return sb.String()
+}
+
+// ============================================================================
+// Spell Checking API
+// ============================================================================
+
+// SetSpellCheck enables or disables spell checking. When enabling, the
+// configured Config.SpellChecker is used, or DefaultDictionary() if none.
+func (m *Model) SetSpellCheck(enabled bool) {
+	m.spellMenu = nil
+	if !enabled {
+		m.spell = nil
+		return
+	}
+	if m.config.SpellChecker != nil {
+		m.spell = m.config.SpellChecker
+		return
+	}
+	m.spell = DefaultDictionary()
+}
+
+// SpellCheckEnabled returns true if misspellings are highlighted.
+func (m Model) SpellCheckEnabled() bool {
+	return m.spell != nil
+}
+
+// SpellMenuOpen returns true while the suggestions menu is shown. Parents that
+// treat <Escape> specially should forward it to the textarea in that case.
+func (m Model) SpellMenuOpen() bool {
+	return m.spellMenu != nil
+}
+
+// Misspellings returns the misspelled words in the content, in order.
+// Returns nil when spell checking is disabled.
+func (m Model) Misspellings() []string {
+	if m.spell == nil {
+		return nil
+	}
+	var words []string
+	for _, line := range m.content {
+		for _, w := range misspellings(m.spell, line) {
+			words = append(words, w.Word)
+		}
+	}
+	return words
+}
@@ -0,0 +1,165 @@
+package vimtextarea
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDictionary() *Dictionary {
+	return NewDictionary("the", "ten", "tea", "quick", "brown", "fox", "stop", "use", "try", "expect", "happy", "issue", "receive")
+}
+
+func TestDictionary_Check(t *testing.T) {
+	d := newTestDictionary()
+
+	for _, w := range []string{"the", "The", "issues", "stopped", "using", "tries", "unexpectedly", "happily", "received"} {
+		require.True(t, d.Check(w), w)
+	}
+	for _, w := range []string{"teh", "recieve", "brwn"} {
+		require.False(t, d.Check(w), w)
+	}
+}
+
+func TestDictionary_Suggest(t *testing.T) {
+	d := newTestDictionary()
+
+	require.Equal(t, []string{"the", "tea", "ten"}, d.Suggest("teh", 3), "transposition ranks first")
+	require.Equal(t, []string{"The"}, d.Suggest("Teh", 1), "suggestions follow capitalization")
+	require.Equal(t, []string{"receive"}, d.Suggest("recieve", 3))
+	require.Equal(t, []string{"brown"}, d.Suggest("bron", 3))
+	require.Empty(t, d.Suggest("zzzzzz", 3))
+}
+
+func TestDictionary_AddFrom(t *testing.T) {
+	d := NewDictionary()
+	require.NoError(t, d.AddFrom(strings.NewReader("# comment\nperles\n\n  Beads  \n")))
+	require.Equal(t, 2, d.Len())
+	require.True(t, d.Check("beads"))
+}
+
+func TestDefaultDictionary(t *testing.T) {
+	d := DefaultDictionary()
+	require.Same(t, d, DefaultDictionary())
+	for _, w := range []string{"the", "description", "coordinator", "workers", "refactoring"} {
+		require.True(t, d.Check(w), w)
+	}
+}
+
+func TestSpellWords_SkipsCode(t *testing.T) {
+	words := func(line string) []string {
+		var out []string
+		for _, w := range spellWords(line) {
+			require.Equal(t, w.Word, line[w.Start:w.End])
+			out = append(out, w.Word)
+		}
+		return out
+	}
+
+	require.Equal(t, []string{"Fix", "teh", "bug"}, words("Fix teh bug."))
+	require.Equal(t, []string{"see", "and"}, words("see `foo bar` and internal/ui/foo.go"))
+	require.Equal(t, []string{"run", "for"}, words("run ProcessTurn for API on perles-x7k at https://example.com"))
+	require.Equal(t, []string{"well", "known", "don't"}, words("(well-known) don't go"))
+	require.Equal(t, []string{"snake", "case"}, words("snake_case snake-case"))
+}
+
+func TestSpellLexer_UnderlinesMisspellings(t *testing.T) {
+	l := spellLexer{checker: newTestDictionary()}
+
+	tokens := l.Tokenize("the quikc brown fox")
+	require.Len(t, tokens, 1)
+	require.Equal(t, 4, tokens[0].Start)
+	require.Equal(t, 9, tokens[0].End)
+
+	require.Empty(t, l.Tokenize("the quick brown fox"))
+}
+
+func TestSpellCheck_Toggle(t *testing.T) {
+	m := New(Config{})
+	require.False(t, m.SpellCheckEnabled())
+	m.SetValue("teh fox")
+	require.Nil(t, m.Misspellings())
+
+	m.SetSpellCheck(true)
+	require.True(t, m.SpellCheckEnabled())
+	require.Equal(t, []string{"teh"}, m.Misspellings())
+
+	m.SetSpellCheck(false)
+	require.False(t, m.SpellCheckEnabled())
+
+	m = New(Config{SpellCheck: true, SpellChecker: newTestDictionary()})
+	require.True(t, m.SpellCheckEnabled())
+	m.SetValue("quikc brown")
+	require.Equal(t, []string{"quikc"}, m.Misspellings())
+}
+
+func TestSpellSuggest_NormalMode(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, SpellCheck: true, SpellChecker: newTestDictionary()})
+	m.Focus()
+	m.SetValue("the quikc fox")
+	m.cursorRow, m.cursorCol = 0, 6
+
+	m, _ = m.Update(keyMsg('z'))
+	m, _ = m.Update(keyMsg('='))
+	require.True(t, m.SpellMenuOpen())
+	require.Contains(t, ansi.Strip(m.View()), "quikc → 1 quick")
+
+	m, _ = m.Update(keyMsg('1'))
+	require.False(t, m.SpellMenuOpen())
+	require.Equal(t, "the quick fox", m.Value())
+	require.Equal(t, Position{Row: 0, Col: 4}, m.CursorPosition())
+
+	m, _ = m.Update(keyMsg('u'))
+	require.Equal(t, "the quikc fox", m.Value())
+}
+
+func TestSpellSuggest_InsertMode(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeInsert, SpellCheck: true, SpellChecker: newTestDictionary()})
+	m.Focus()
+	for _, r := range "teh" {
+		m, _ = m.Update(keyMsg(r))
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	require.True(t, m.SpellMenuOpen())
+
+	m, _ = m.Update(keyMsg('1'))
+	require.Equal(t, "the", m.Value())
+	require.Equal(t, 3, m.CursorPosition().Col, "cursor stays after the word in Insert mode")
+
+	// Typing continues normally; Ctrl+L with nothing to fix passes through
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	require.False(t, m.SpellMenuOpen())
+}
+
+func TestSpellMenu_OtherKeysDismiss(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeInsert, SpellCheck: true, SpellChecker: newTestDictionary()})
+	m.Focus()
+	m.SetValue("teh")
+	m.cursorCol = 3
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	m, _ = m.Update(escapeKey())
+	require.False(t, m.SpellMenuOpen())
+	require.Equal(t, ModeInsert, m.Mode(), "escape only closes the menu")
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlL})
+	m, _ = m.Update(keyMsg('x'))
+	require.False(t, m.SpellMenuOpen())
+	require.Equal(t, "tehx", m.Value(), "other keys are processed after closing the menu")
+}
+
+func TestSpellAddWord(t *testing.T) {
+	m := New(Config{VimEnabled: true, DefaultMode: ModeNormal, SpellCheck: true, SpellChecker: newTestDictionary()})
+	m.SetValue("perles fox")
+	m.cursorCol = 0
+	require.Equal(t, []string{"perles"}, m.Misspellings())
+
+	m, _ = m.Update(keyMsg('z'))
+	m, _ = m.Update(keyMsg('g'))
+	require.Empty(t, m.Misspellings())
+}
@@ -1,5 +1,7 @@
 package vimtextarea
 
+import "strings"
+
 // TextObjectFinder locates text object bounds around the cursor position.
 // Text objects are semantic units of text (words, quoted strings, bracketed expressions)
 // that can be operated on as a whole regardless of cursor position within them.
@@ -26,6 +28,8 @@ var textObjectRegistry = map[rune]TextObjectFinder{
 	'}': &PairedDelimiterTextObject{openChar: '{', closeChar: '}'},
 	// 'b' for any bracket type - finds innermost of (), [], or {}
 	'b': &BracketTextObject{},
+	// '`' for markdown code fences, falling back to inline `code` spans
+	'`': &CodeFenceTextObject{},
 }
 
 // WordTextObject handles 'w' (word) and 'W' (WORD) text objects.
@@ -450,3 +454,93 @@ func (b *BracketTextObject) FindBounds(m *Model, inner bool) (start, end Positio
 
 	return bestStart, bestEnd, bestFound
 }
+
+// CodeFenceTextObject handles the '`' text object for markdown. Inside a fenced
+// code block (``` or ~~~) it selects the block: inner is the code between the
+// fence lines, around includes the fence lines. Elsewhere it behaves like a
+// quote object for inline `code` spans on the cursor line.
+type CodeFenceTextObject struct{}
+
+// FindBounds locates the code block or inline code span around the cursor.
+// For inner=true (i`): returns the code without fences or backticks.
+// For inner=false (a`): includes the fence lines or backticks.
+// Note: Position.Col values are grapheme indices, not byte offsets.
+func (c *CodeFenceTextObject) FindBounds(m *Model, inner bool) (start, end Position, found bool) {
+	if m.cursorRow < 0 || m.cursorRow >= len(m.content) {
+		return Position{}, Position{}, false
+	}
+
+	openRow, closeRow, ok := findCodeFence(m.content, m.cursorRow)
+	if !ok {
+		inline := &PairedDelimiterTextObject{openChar: '`', closeChar: '`'}
+		return inline.FindBounds(m, inner)
+	}
+
+	if inner {
+		// An empty block has no code to select
+		if closeRow == openRow+1 {
+			return Position{}, Position{}, false
+		}
+		lastCol := max(GraphemeCount(m.content[closeRow-1])-1, 0)
+		return Position{Row: openRow + 1, Col: 0},
+			Position{Row: closeRow - 1, Col: lastCol},
+			true
+	}
+
+	lastCol := max(GraphemeCount(m.content[closeRow])-1, 0)
+	return Position{Row: openRow, Col: 0},
+		Position{Row: closeRow, Col: lastCol},
+		true
+}
+
+// findCodeFence returns the opening and closing fence rows of the fenced code
+// block containing row (fence lines included). Fences pair up from the top of
+// the content; a closing fence uses the same character as its opening fence
+// and is at least as long. An unterminated fence encloses nothing.
+func findCodeFence(content []string, row int) (openRow, closeRow int, found bool) {
+	openRow = -1
+	var openMarker string
+	for i, line := range content {
+		marker := fenceMarker(line)
+		if marker == "" {
+			continue
+		}
+		if openRow < 0 {
+			openRow, openMarker = i, marker
+			continue
+		}
+		if marker[0] != openMarker[0] || len(marker) < len(openMarker) {
+			continue
+		}
+		if row >= openRow && row <= i {
+			return openRow, i, true
+		}
+		if openRow > row {
+			break
+		}
+		openRow = -1
+	}
+	return -1, -1, false
+}
+
+// fenceMarker returns the run of backticks or tildes opening a fence line
+// ("```go" -> "```"), or "" if the line is not a fence. Up to three spaces
+// of indentation are allowed, as in CommonMark.
+func fenceMarker(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || len(trimmed) < 3 {
+		return ""
+	}
+	ch := trimmed[0]
+	if ch != '`' && ch != '~' {
+		return ""
+	}
+	n := 0
+	for n < len(trimmed) && trimmed[n] == ch {
+		n++
+	}
+	if n < 3 {
+		return ""
+	}
+	return trimmed[:n]
+}
@@ -1305,3 +1305,101 @@ func TestTextObjectRegistry_BracketRegistered(t *testing.T) {
 	_, isBracket := finder.(*BracketTextObject)
 	assert.True(t, isBracket)
 }
+
+// ============================================================================
+// CodeFenceTextObject Tests (i`/a`)
+// ============================================================================
+
+func TestCodeFenceTextObject_FindBounds_InsideFence(t *testing.T) {
+	m := newTestModelWithContent("intro", "```go", "x := 1", "y := 2", "```", "outro")
+	m.cursorRow = 2
+	m.cursorCol = 3
+
+	finder := &CodeFenceTextObject{}
+	start, end, found := finder.FindBounds(m, true)
+	assert.True(t, found)
+	assert.Equal(t, Position{Row: 2, Col: 0}, start)
+	assert.Equal(t, Position{Row: 3, Col: 5}, end)
+
+	start, end, found = finder.FindBounds(m, false)
+	assert.True(t, found)
+	assert.Equal(t, Position{Row: 1, Col: 0}, start)
+	assert.Equal(t, Position{Row: 4, Col: 2}, end)
+}
+
+func TestCodeFenceTextObject_FindBounds_CursorOnFenceLine(t *testing.T) {
+	m := newTestModelWithContent("~~~", "code", "~~~")
+	m.cursorRow = 2
+
+	finder := &CodeFenceTextObject{}
+	start, end, found := finder.FindBounds(m, true)
+	assert.True(t, found)
+	assert.Equal(t, Position{Row: 1, Col: 0}, start)
+	assert.Equal(t, Position{Row: 1, Col: 3}, end)
+}
+
+func TestCodeFenceTextObject_FindBounds_SecondBlock(t *testing.T) {
+	m := newTestModelWithContent("```", "a", "```", "between", "```", "b", "```")
+	m.cursorRow = 5
+
+	finder := &CodeFenceTextObject{}
+	start, end, found := finder.FindBounds(m, true)
+	assert.True(t, found)
+	assert.Equal(t, Position{Row: 5, Col: 0}, start)
+	assert.Equal(t, Position{Row: 5, Col: 0}, end)
+}
+
+func TestCodeFenceTextObject_FindBounds_ClosingFenceMustMatch(t *testing.T) {
+	// A ~~~ line and a shorter ``` run do not close a ```` fence
+	m := newTestModelWithContent("````md", "~~~", "```", "````")
+	m.cursorRow = 2
+
+	finder := &CodeFenceTextObject{}
+	start, end, found := finder.FindBounds(m, true)
+	assert.True(t, found)
+	assert.Equal(t, Position{Row: 1, Col: 0}, start)
+	assert.Equal(t, Position{Row: 2, Col: 2}, end)
+}
+
+func TestCodeFenceTextObject_FindBounds_EmptyFenceInnerReturnsFalse(t *testing.T) {
+	m := newTestModelWithContent("```", "```")
+
+	finder := &CodeFenceTextObject{}
+	_, _, found := finder.FindBounds(m, true)
+	assert.False(t, found)
+
+	_, _, found = finder.FindBounds(m, false)
+	assert.True(t, found)
+}
+
+func TestCodeFenceTextObject_FindBounds_InlineCode(t *testing.T) {
+	m := newTestModelWithContent("run `go test` now")
+	m.cursorCol = 7
+
+	finder := &CodeFenceTextObject{}
+	start, end, found := finder.FindBounds(m, true)
+	assert.True(t, found)
+	assert.Equal(t, Position{Row: 0, Col: 5}, start)
+	assert.Equal(t, Position{Row: 0, Col: 11}, end)
+}
+
+func TestCodeFenceTextObject_FindBounds_UnterminatedFenceFallsBackToInline(t *testing.T) {
+	m := newTestModelWithContent("```", "no `closing` fence")
+	m.cursorRow = 1
+	m.cursorCol = 5
+
+	finder := &CodeFenceTextObject{}
+	start, end, found := finder.FindBounds(m, true)
+	assert.True(t, found)
+	assert.Equal(t, Position{Row: 1, Col: 4}, start)
+	assert.Equal(t, Position{Row: 1, Col: 10}, end)
+}
+
+func TestFenceMarker(t *testing.T) {
+	assert.Equal(t, "```", fenceMarker("```go"))
+	assert.Equal(t, "~~~~", fenceMarker("~~~~"))
+	assert.Equal(t, "```", fenceMarker("   ```"))
+	assert.Empty(t, fenceMarker("    ```"), "four spaces is an indented code block")
+	assert.Empty(t, fenceMarker("``"))
+	assert.Empty(t, fenceMarker("text ```"))
+}
@@ -3,6 +3,7 @@ package vimtextarea
 import (
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -92,6 +93,27 @@ type Config struct {
 	// OnChange produces a custom message when content changes.
 	// If nil, no message is emitted on content change.
 	OnChange func(content string) tea.Msg
+
+	// Keymap holds user key remaps and timeouts. If nil, DefaultKeymap() is used.
+	// Mappings only apply when VimEnabled is true.
+	Keymap *Keymap
+
+	// SpellCheck underlines misspelled words and enables suggestions
+	// (z= in Normal mode, Ctrl+L in Insert mode). Intended for prose fields.
+	SpellCheck bool
+
+	// SpellChecker overrides the dictionary used when SpellCheck is enabled.
+	// If nil, DefaultDictionary() is used.
+	SpellChecker SpellChecker
+
+	// Registers is the register set for yank, delete and paste. Share one set
+	// between the textareas of a form so yanks persist across its fields.
+	// If nil, the textarea gets its own set.
+	Registers *Registers
+
+	// UndoLevels caps the number of changes kept in the undo tree; the oldest
+	// are dropped first. 0 means DefaultUndoLevels.
+	UndoLevels int
 }
 
 // Position represents a cursor position in the textarea.
@@ -117,6 +139,20 @@ type Model struct {
 	visualAnchor        Position               // Where visual selection started (anchor point)
 	lastYankedText      string                 // Last yanked text (for paste command)
 	lastYankWasLinewise bool                   // Whether the last yank was line-wise (affects paste behavior)
+	registers           *Registers             // Named, yank and shared unnamed registers
+	selectedRegister    rune                   // Register selected with " for the next command (0 = none)
+
+	// Keymap state
+	mapBuffer  []tea.KeyMsg // Keys typed so far of a partially matched mapping
+	mapSeq     int          // Identifies the latest mapping timeout tick
+	pendingSeq int          // Identifies the latest pending-command timeout tick
+
+	// Dot repeat state
+	count       int          // Count typed before the current Normal mode command (0 = none)
+	changeKeys  []tea.KeyMsg // Keys of the command being typed (nil when not recording)
+	changeStart int          // Change number when recording started
+	lastChange  []tea.KeyMsg // Keys of the last completed change, replayed by '.'
+	repeating   bool         // True while '.' replays lastChange
 
 	// Yank highlight (brief flash on yanked text, like Vim's highlightedyank)
 	yankHighlight *YankHighlight // Active yank highlight region (nil when inactive)
@@ -124,6 +160,16 @@ type Model struct {
 	// Syntax highlighting
 	lexer SyntaxLexer // Lexer for syntax highlighting (nil = no highlighting)
 
+	// Spell checking
+	spell     SpellChecker // Spell checker (nil = spell checking disabled)
+	spellMenu *spellMenu   // Open suggestions menu (nil when closed)
+
+	// Search
+	search            *searchPrompt // Open search prompt (nil when closed)
+	lastSearch        string        // Last confirmed search pattern (repeated by n/N)
+	lastSearchForward bool          // Whether the last search was / (true) or ? (false)
+	searchHighlight   bool          // Whether matches of lastSearch are highlighted
+
 	// Clipboard for system clipboard integration (optional, nil = no clipboard)
 	clipboard Clipboard
 
@@ -166,16 +212,24 @@ func New(cfg Config) Model {
 		mode = ModeInsert
 	}
 
-	return Model{
+	m := Model{
 		config:         cfg,
 		content:        []string{""},
 		cursorRow:      0,
 		cursorCol:      0,
 		mode:           mode,
 		pendingBuilder: NewPendingCommandBuilder(),
-		history:        NewCommandHistory(),
+		history:        NewCommandHistoryWithLimit(cfg.UndoLevels),
+		registers:      cfg.Registers,
 		focused:        false,
 	}
+	if m.registers == nil {
+		m.registers = NewRegisters()
+	}
+	if cfg.SpellCheck {
+		m.SetSpellCheck(true)
+	}
+	return m
 }
 
 // Init implements tea.Model.
@@ -191,6 +245,16 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		// Ensure cursor is visible after any key handling
 		m.ensureCursorVisible()
 		return m, cmd
+	case keymapTimeoutMsg:
+		m, cmd := m.handleKeymapTimeout(msg)
+		m.ensureCursorVisible()
+		return m, cmd
+	case pendingTimeoutMsg:
+		// Abandon a pending multi-key command the user never finished
+		if msg.seq == m.pendingSeq {
+			m.pendingBuilder.Clear()
+		}
+		return m, nil
 	case yankHighlightTickMsg:
 		// Tick arrived - the highlight will be cleared automatically in View if expired
 		// Just trigger a re-render by returning
@@ -265,6 +329,8 @@ func keyToString(msg tea.KeyMsg) string {
 		return "<ctrl+c>"
 	case tea.KeyCtrlG:
 		return "<ctrl+g>"
+	case tea.KeyCtrlL:
+		return "<ctrl+l>"
 	default:
 		return ""
 	}
@@ -283,9 +349,56 @@ type YankHighlighter interface {
 	YankHighlightRegion() (start, end Position, linewise bool, show bool)
 }
 
-// handleKeyMsg processes keyboard input via pure registry dispatch.
-// All key handling logic is encapsulated in Command implementations.
+// handleKeyMsg processes keyboard input, applying user key mappings
+// before registry dispatch.
 func (m Model) handleKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.spellMenu != nil {
+		return m.handleSpellMenuKey(msg)
+	}
+	if m.search != nil {
+		// Search keys are part of an operator's change (d/foo<enter>)
+		m.recordChangeKey(msg)
+		m, cmd := m.handleSearchKey(msg)
+		m.finishChange()
+		return m, cmd
+	}
+	if m.config.VimEnabled && m.pendingBuilder.IsEmpty() {
+		if km := m.keymap(); len(m.mapBuffer) > 0 || km.Len(m.mode) > 0 {
+			return m.handleMappedKey(km, msg)
+		}
+	}
+	return m.dispatchKey(msg)
+}
+
+// dispatchKey dispatches a key without applying user mappings and arms the
+// pending-command timeout when the key leaves a multi-key command pending.
+func (m Model) dispatchKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	selectingRegister := m.pendingBuilder.Operator() == '"'
+	m.recordChangeKey(msg)
+	m, cmd := m.dispatchRegistryKey(msg)
+	m.finishChange()
+
+	// A register selected with " applies to the next complete command only,
+	// which includes an operator waiting for its search target (d/foo)
+	if !selectingRegister && m.pendingBuilder.IsEmpty() && m.search == nil {
+		m.selectedRegister = 0
+	}
+
+	if !m.pendingBuilder.IsEmpty() {
+		if timeout := m.keymap().pendingTimeout(); timeout > 0 {
+			m.pendingSeq++
+			seq := m.pendingSeq
+			cmd = tea.Batch(cmd, tea.Tick(timeout, func(time.Time) tea.Msg {
+				return pendingTimeoutMsg{seq: seq}
+			}))
+		}
+	}
+	return m, cmd
+}
+
+// dispatchRegistryKey processes keyboard input via pure registry dispatch.
+// All key handling logic is encapsulated in Command implementations.
+func (m Model) dispatchRegistryKey(msg tea.KeyMsg) (Model, tea.Cmd) {
 	// Handle pending commands first (multi-key sequences like gg, dd, dw)
 	if !m.pendingBuilder.IsEmpty() {
 		return m.handlePendingCommand(msg)
@@ -303,9 +416,15 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 		mode = ModeInsert
 	}
 
+	// A count before a Normal mode command ('0' only continues a count)
+	if mode == ModeNormal && m.acceptCountDigit(msg) {
+		return m, nil
+	}
+
 	// Pure registry dispatch
 	cmd, ok := DefaultRegistry.Get(mode, keyStr)
 	if !ok {
+		m.count = 0
 		// Fallback: character input in Insert mode
 		if mode == ModeInsert && msg.Type == tea.KeyRunes && len(msg.Runes) > 0 {
 			return m.handleCharacterInput(msg.Runes)
@@ -317,8 +436,178 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (Model, tea.Cmd) {
 		return m, nil // Key not handled
 	}
 
-	// Execute and respond
-	return m.executeAndRespond(cmd, mode)
+	// Execute and respond; the count applies to this command only
+	m, teaCmd := m.executeAndRespond(cmd, mode)
+	m.count = 0
+	return m, teaCmd
+}
+
+// maxCount caps a typed count so a stray run of digits can't stall the editor.
+const maxCount = 9999
+
+// acceptCountDigit adds a typed digit to the pending count.
+// Returns false for non-digits and for a leading '0' (the line-start motion).
+func (m *Model) acceptCountDigit(msg tea.KeyMsg) bool {
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 {
+		return false
+	}
+	r := msg.Runes[0]
+	if r < '0' || r > '9' || (r == '0' && m.count == 0) {
+		return false
+	}
+	m.count = min(m.count*10+int(r-'0'), maxCount)
+	return true
+}
+
+// ============================================================================
+// Dot Repeat
+// ============================================================================
+
+// changeIdle reports whether no command is in progress: Normal mode with no
+// pending operator, search prompt, or spell menu. Changes begin and end here.
+func (m Model) changeIdle() bool {
+	return m.mode == ModeNormal && m.pendingBuilder.IsEmpty() && m.search == nil && m.spellMenu == nil
+}
+
+// recordChangeKey records a dispatched key of the command being typed.
+// Recording starts at a key typed while idle and continues through operator
+// keys, visual selection, search targets and inserted text.
+func (m *Model) recordChangeKey(msg tea.KeyMsg) {
+	if !m.config.VimEnabled || m.repeating {
+		return
+	}
+	if m.changeIdle() {
+		m.changeKeys = nil
+		m.changeStart = m.history.State().Latest
+	} else if m.changeKeys == nil {
+		return // Started outside Normal mode (e.g. SetMode(ModeInsert)); not repeatable
+	}
+	m.changeKeys = append(m.changeKeys, msg)
+}
+
+// finishChange keeps the recorded keys as the last change once the command
+// completes, if it changed the content.
+func (m *Model) finishChange() {
+	if m.repeating || m.changeKeys == nil || !m.changeIdle() {
+		return
+	}
+	if m.history.State().Latest != m.changeStart {
+		m.lastChange = m.changeKeys
+	}
+	m.changeKeys = nil
+}
+
+// repeatLastChange replays the keys of the last change count times.
+// Keys are not remapped and the replay is not itself recorded.
+func (m *Model) repeatLastChange(count int) {
+	keys := m.lastChange
+	m.repeating = true
+	for range count {
+		for _, key := range keys {
+			*m, _ = m.dispatchKey(key)
+		}
+	}
+	m.repeating = false
+	m.changeKeys = nil // '.' itself is not a change to repeat
+}
+
+// keymap returns the keymap in effect for this textarea.
+func (m Model) keymap() *Keymap {
+	if m.config.Keymap != nil {
+		return m.config.Keymap
+	}
+	return DefaultKeymap()
+}
+
+// handleMappedKey matches typed keys against the keymap.
+// Keys that may start a mapping are buffered until the mapping completes,
+// diverges, or times out; buffered keys that do not form a mapping are then
+// processed as if no mapping existed.
+func (m Model) handleMappedKey(km *Keymap, msg tea.KeyMsg) (Model, tea.Cmd) {
+	keyStr := keyToString(msg)
+	if keyStr == "" || keyStr == "<runes>" {
+		// Unmappable input (e.g., paste) ends any partial mapping
+		m, flushCmd := m.flushMapBuffer()
+		m, cmd := m.dispatchKey(msg)
+		return m, tea.Batch(flushCmd, cmd)
+	}
+
+	keys := append(keyStrings(m.mapBuffer), keyStr)
+
+	if km.IsPrefix(m.mode, keys) {
+		m.mapBuffer = append(slices.Clone(m.mapBuffer), msg)
+		m.mapSeq++
+		seq := m.mapSeq
+		return m, tea.Tick(km.keyTimeout(), func(time.Time) tea.Msg {
+			return keymapTimeoutMsg{seq: seq}
+		})
+	}
+
+	if rhs, ok := km.Lookup(m.mode, keys); ok {
+		m.mapBuffer = nil
+		return m.replayKeys(rhs)
+	}
+
+	if len(m.mapBuffer) == 0 {
+		return m.dispatchKey(msg)
+	}
+
+	// The buffered keys did not become a mapping - process them as typed,
+	// then give the current key a chance to start a new mapping.
+	m, flushCmd := m.flushMapBuffer()
+	m, cmd := m.handleKeyMsg(msg)
+	return m, tea.Batch(flushCmd, cmd)
+}
+
+// handleKeymapTimeout resolves a partially typed mapping once the key timeout
+// expires: an exact match for the buffered keys wins, otherwise they are
+// processed as typed.
+func (m Model) handleKeymapTimeout(msg keymapTimeoutMsg) (Model, tea.Cmd) {
+	if msg.seq != m.mapSeq || len(m.mapBuffer) == 0 {
+		return m, nil
+	}
+	if rhs, ok := m.keymap().Lookup(m.mode, keyStrings(m.mapBuffer)); ok {
+		m.mapBuffer = nil
+		return m.replayKeys(rhs)
+	}
+	return m.flushMapBuffer()
+}
+
+// flushMapBuffer dispatches buffered keys without applying mappings.
+func (m Model) flushMapBuffer() (Model, tea.Cmd) {
+	buffered := m.mapBuffer
+	m.mapBuffer = nil
+
+	var cmds []tea.Cmd
+	for _, key := range buffered {
+		var cmd tea.Cmd
+		m, cmd = m.dispatchKey(key)
+		cmds = append(cmds, cmd)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+// replayKeys dispatches a mapping's right-hand side. Keys are not remapped.
+func (m Model) replayKeys(keys []string) (Model, tea.Cmd) {
+	var cmds []tea.Cmd
+	for _, key := range keys {
+		msg, ok := keyMsgFromString(key)
+		if !ok {
+			continue
+		}
+		var cmd tea.Cmd
+		m, cmd = m.dispatchKey(msg)
+		cmds = append(cmds, cmd)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+func keyStrings(msgs []tea.KeyMsg) []string {
+	keys := make([]string, len(msgs))
+	for i, msg := range msgs {
+		keys[i] = keyToString(msg)
+	}
+	return keys
 }
 
 // csiCleanupWindow is the time window during which a mouse sequence can "undo" a recently inserted '['.
@@ -570,6 +859,7 @@ func (m *Model) executeCommand(cmd Command) (Command, ExecuteResult, tea.Cmd) {
 		cmd = cloneCommand(cmd)
 	}
 
+	cursor := Position{Row: m.cursorRow, Col: m.cursorCol}
 	result := cmd.Execute(m)
 	if result != Executed {
 		return cmd, result, nil
@@ -577,7 +867,7 @@ func (m *Model) executeCommand(cmd Command) (Command, ExecuteResult, tea.Cmd) {
 
 	// Add to history if undoable (content-mutating)
 	if isUndoable(cmd) {
-		m.history.Push(cmd)
+		m.history.PushAt(cmd, cursor)
 	}
 
 	// Return onChange for commands that change content
@@ -683,6 +973,12 @@ func (m Model) CanRedo() bool {
 	return m.history.CanRedo()
 }
 
+// UndoState returns the position in the undo tree (change number, branches,
+// timestamp) so a host can display it.
+func (m Model) UndoState() UndoState {
+	return m.history.State()
+}
+
 // ============================================================================
 // Pending Command Handler
 // ============================================================================
@@ -699,6 +995,19 @@ func (m Model) handlePendingCommand(msg tea.KeyMsg) (Model, tea.Cmd) {
 		return m.handleReplaceCharPending(msg)
 	}
 
+	// Special case: '"' operator takes a register name for the next command
+	if operator == '"' {
+		return m.handleRegisterPending(msg)
+	}
+
+	// Special case: a search as the target of an operator (d/foo, y?bar)
+	if m.pendingBuilder.KeyBuffer() == "" && strings.ContainsRune(searchOperators, operator) &&
+		msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && (msg.Runes[0] == '/' || msg.Runes[0] == '?') {
+		m.pendingBuilder.Clear()
+		m.openSearch(msg.Runes[0] == '/', operator)
+		return m, nil
+	}
+
 	// Convert key to string for registry lookup
 	var key string
 	if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 {
@@ -787,6 +1096,19 @@ func (m Model) handleReplaceCharPending(msg tea.KeyMsg) (Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleRegisterPending handles the '"' operator pending state.
+// The next character names the register used by the following yank, delete
+// or paste. Anything else cancels the selection.
+func (m Model) handleRegisterPending(msg tea.KeyMsg) (Model, tea.Cmd) {
+	m.pendingBuilder.Clear()
+	if msg.Type == tea.KeyRunes && len(msg.Runes) == 1 && IsValidRegister(msg.Runes[0]) {
+		m.selectedRegister = msg.Runes[0]
+		return m, nil
+	}
+	m.selectedRegister = 0
+	return m, nil
+}
+
 // handleVisualOperatorFallback handles 'v' operator fallback when no text object match is found.
 // This enables sequences like 'vj' (enter visual mode, then move down) to work correctly.
 // When 'v' is followed by a key that's not a text object prefix (like 'i' or 'a'),
@@ -837,15 +1159,21 @@ func (m *Model) SetSize(w, h int) {
 	m.ensureCursorVisible()
 }
 
-// Focus focuses the textarea.
+// Focus focuses the textarea and picks up the latest unnamed register,
+// which another textarea sharing the registers may have written.
 func (m *Model) Focus() {
 	m.focused = true
+	m.syncUnnamedRegister()
 }
 
 // Blur removes focus from the textarea and clears any pending command.
 func (m *Model) Blur() {
 	m.focused = false
 	m.pendingBuilder.Clear()
+	m.selectedRegister = 0
+	m.mapBuffer = nil
+	m.spellMenu = nil
+	m.search = nil
 }
 
 // Focused returns whether the textarea is focused.
@@ -953,6 +1281,8 @@ func (m *Model) Reset() {
 	m.cursorCol = 0
 	m.history.Clear()
 	m.pendingBuilder.Clear()
+	m.selectedRegister = 0
+	m.mapBuffer = nil
 }
 
 // CursorToEnd moves the cursor to the end of the content.
@@ -967,9 +1297,10 @@ func (m *Model) CursorToEnd() {
 	m.cursorCol = GraphemeCount(m.content[m.cursorRow])
 }
 
-// ClearPendingCommand clears any pending multi-key command.
+// ClearPendingCommand clears any pending multi-key command and register selection.
 func (m *Model) ClearPendingCommand() {
 	m.pendingBuilder.Clear()
+	m.selectedRegister = 0
 }
 
 // InVisualMode returns true if currently in any visual mode.
@@ -1257,6 +1588,16 @@ func (m *Model) deleteSelection(start, end Position, wasLinewise bool) []string
 	return deletedContent
 }
 
+// insertTextAt inserts text, which may span lines, at pos (a grapheme index).
+// It is the inverse of a character-wise deleteSelection starting at pos.
+func (m *Model) insertTextAt(pos Position, text string) {
+	line := m.content[pos.Row]
+	lines := strings.Split(text, "\n")
+	lines[0] = SliceByGraphemes(line, 0, pos.Col) + lines[0]
+	lines[len(lines)-1] += SliceByGraphemes(line, pos.Col, GraphemeCount(line))
+	m.content = slices.Concat(m.content[:pos.Row], lines, m.content[pos.Row+1:])
+}
+
 // submitContent triggers the OnSubmit callback with the current content.
 // This is the Enter key behavior.
 func (m Model) submitContent() (Model, tea.Cmd) {
@@ -48,19 +48,16 @@ func ApplyTheme(cfg ThemeConfig) error {
 	}
 
 	// Step 3: Apply individual color overrides
-	for key, value := range cfg.Colors {
-		token := ColorToken(key)
-		if !isValidToken(token) {
-			return fmt.Errorf("unknown color token: %s", key)
-		}
-		if !isValidHexColor(value) {
-			return fmt.Errorf("invalid hex color for %s: %s", key, value)
-		}
-		colors[token] = value
+	overrides, err := parseColors(cfg.Colors)
+	if err != nil {
+		return err
 	}
+	maps.Copy(colors, overrides)
 
 	// Step 4: Apply colors to variables
 	applyColors(colors)
+	activeConfig = ThemeConfig{Preset: cfg.Preset, Mode: cfg.Mode, Colors: maps.Clone(cfg.Colors)}
+	activeColors = colors
 
 	// Step 5: Rebuild all Style objects
 	rebuildStyles()
@@ -68,6 +65,22 @@ func ApplyTheme(cfg ThemeConfig) error {
 	return nil
 }
 
+// parseColors validates dot-notation color overrides and converts them to tokens.
+func parseColors(colors map[string]string) (map[ColorToken]string, error) {
+	result := make(map[ColorToken]string, len(colors))
+	for key, value := range colors {
+		token := ColorToken(key)
+		if !isValidToken(token) {
+			return nil, fmt.Errorf("unknown color token: %s", key)
+		}
+		if !isValidHexColor(value) {
+			return nil, fmt.Errorf("invalid hex color for %s: %s", key, value)
+		}
+		result[token] = value
+	}
+	return result, nil
+}
+
 func applyColors(colors map[ColorToken]string) {
 	// Helper to create adaptive color (uses same color for both modes)
 	makeColor := func(hex string) lipgloss.AdaptiveColor {
@@ -268,6 +281,23 @@ func applyColors(colors map[ColorToken]string) {
 		BQLCommaColor = makeColor(c)
 	}
 
+	// Orchestration agents
+	if c, ok := colors[TokenAgentCoordinator]; ok {
+		AgentCoordinatorColor = makeColor(c)
+	}
+	if c, ok := colors[TokenAgentWorker]; ok {
+		AgentWorkerColor = makeColor(c)
+	}
+	if c, ok := colors[TokenAgentObserver]; ok {
+		AgentObserverColor = makeColor(c)
+	}
+	if c, ok := colors[TokenAgentUser]; ok {
+		AgentUserColor = makeColor(c)
+	}
+	if c, ok := colors[TokenAgentSystem]; ok {
+		AgentSystemColor = makeColor(c)
+	}
+
 	// Misc
 	if c, ok := colors[TokenSpinner]; ok {
 		SpinnerColor = makeColor(c)
@@ -280,6 +310,9 @@ func rebuildStyles() {
 	// Selection indicator
 	SelectionIndicatorStyle = lipgloss.NewStyle().Bold(true).Foreground(SelectionIndicatorColor)
 
+	// Issue flash
+	IssueFlashStyle = lipgloss.NewStyle().Bold(true).Foreground(StatusWarningColor)
+
 	// Buttons
 	baseButtonStyle = lipgloss.NewStyle().Padding(0, 2).Bold(true)
 
@@ -11,6 +11,8 @@ type Preset struct {
 // Presets contains all built-in theme presets.
 var Presets = map[string]Preset{
 	"default":          DefaultPreset,
+	"dark":             DarkPreset,
+	"light":            LightPreset,
 	"catppuccin-mocha": CatppuccinMochaPreset,
 	"catppuccin-latte": CatppuccinLattePreset,
 	"dracula":          DraculaPreset,
@@ -111,11 +113,130 @@ var DefaultPreset = Preset{
 		TokenDiffContext:  "#888888", // gray
 		TokenDiffHunk:     "#89B4FA", // blue
 
+		// Orchestration agents
+		TokenAgentCoordinator: "#179299",
+		TokenAgentWorker:      "#43BF6D",
+		TokenAgentObserver:    "#A066D3",
+		TokenAgentUser:        "#FB923C",
+		TokenAgentSystem:      "#FF8787",
+
 		// Misc
 		TokenSpinner: "#FFFFFF",
 	},
 }
 
+// DarkPreset is the built-in dark theme. It shares the default palette so
+// "dark" can be selected explicitly alongside "light".
+var DarkPreset = Preset{
+	Name:        "dark",
+	Description: "Dark theme (perles defaults)",
+	Colors:      DefaultPreset.Colors,
+}
+
+// LightPreset is the built-in light theme for terminals with a light background.
+// Colors are loosely based on the GitHub light palette.
+var LightPreset = Preset{
+	Name:        "light",
+	Description: "Light theme for light terminal backgrounds",
+	Colors: map[ColorToken]string{
+		// Text hierarchy
+		TokenTextPrimary:     "#24292F", // fg default
+		TokenTextSecondary:   "#57606A", // fg muted
+		TokenTextMuted:       "#8C959F", // fg subtle
+		TokenTextDescription: "#6E7781", // gray
+		TokenTextPlaceholder: "#8C959F", // fg subtle
+
+		// Borders
+		TokenBorderDefault:   "#D0D7DE", // border default
+		TokenBorderFocus:     "#24292F", // fg default
+		TokenBorderHighlight: "#0969DA", // blue
+
+		// Status indicators
+		TokenStatusSuccess: "#1A7F37", // green
+		TokenStatusWarning: "#9A6700", // yellow
+		TokenStatusError:   "#CF222E", // red
+
+		// Selection
+		TokenSelectionIndicator:  "#24292F", // fg default
+		TokenSelectionBackground: "#DDF4FF", // accent subtle
+
+		// Buttons
+		TokenButtonText:             "#FFFFFF", // white
+		TokenButtonPrimaryBg:        "#0969DA", // blue
+		TokenButtonPrimaryFocusBg:   "#0550AE", // blue dark
+		TokenButtonSecondaryBg:      "#6E7781", // gray
+		TokenButtonSecondaryFocusBg: "#57606A", // gray dark
+		TokenButtonDangerBg:         "#CF222E", // red
+		TokenButtonDangerFocusBg:    "#A40E26", // red dark
+		TokenButtonDisabledBg:       "#AFB8C1", // gray light
+
+		// Forms
+		TokenFormBorder:      "#8C959F", // fg subtle
+		TokenFormBorderFocus: "#0969DA", // blue
+		TokenFormLabel:       "#57606A", // fg muted
+		TokenFormLabelFocus:  "#0969DA", // blue
+
+		// Overlays/Modals
+		TokenOverlayTitle:  "#24292F", // fg default
+		TokenOverlayBorder: "#8C959F", // fg subtle
+
+		// Toast notifications
+		TokenToastSuccess: "#1A7F37", // green
+		TokenToastError:   "#CF222E", // red
+		TokenToastInfo:    "#0969DA", // blue
+		TokenToastWarn:    "#9A6700", // yellow
+
+		// Issue status
+		TokenIssueOpen:       "#1A7F37", // green
+		TokenIssueInProgress: "#0969DA", // blue
+		TokenIssueClosed:     "#6E7781", // gray
+		TokenIssueDeferred:   "#8250DF", // purple
+		TokenIssueBlocked:    "#CF222E", // red
+
+		// Issue priority
+		TokenPriorityCritical: "#CF222E", // red
+		TokenPriorityHigh:     "#BC4C00", // orange
+		TokenPriorityMedium:   "#9A6700", // yellow
+		TokenPriorityLow:      "#6E7781", // gray
+		TokenPriorityBacklog:  "#8C959F", // gray light
+
+		// Issue type
+		TokenTypeTask:     "#0969DA", // blue
+		TokenTypeChore:    "#6E7781", // gray
+		TokenTypeEpic:     "#8250DF", // purple
+		TokenTypeBug:      "#CF222E", // red
+		TokenTypeFeature:  "#1A7F37", // green
+		TokenTypeMolecule: "#BC4C00", // orange
+		TokenTypeConvoy:   "#57606A", // gray dark
+		TokenTypeAgent:    "#3F51B5", // indigo
+
+		// BQL syntax highlighting
+		TokenBQLKeyword:  "#8250DF", // purple
+		TokenBQLOperator: "#CF222E", // red
+		TokenBQLField:    "#1B7C83", // teal
+		TokenBQLString:   "#9A6700", // yellow
+		TokenBQLLiteral:  "#BC4C00", // orange
+		TokenBQLParen:    "#0969DA", // blue
+		TokenBQLComma:    "#8C959F", // gray
+
+		// Diff syntax highlighting
+		TokenDiffAddition: "#1A7F37", // green
+		TokenDiffDeletion: "#CF222E", // red
+		TokenDiffContext:  "#6E7781", // gray
+		TokenDiffHunk:     "#0969DA", // blue
+
+		// Orchestration agents
+		TokenAgentCoordinator: "#1B7C83", // teal
+		TokenAgentWorker:      "#1A7F37", // green
+		TokenAgentObserver:    "#8250DF", // purple
+		TokenAgentUser:        "#BC4C00", // orange
+		TokenAgentSystem:      "#CF222E", // red
+
+		// Misc
+		TokenSpinner: "#0969DA", // blue
+	},
+}
+
 // CatppuccinMochaPreset is the Catppuccin Mocha (dark) theme.
 // Colors from: https://catppuccin.com/palette
 // Mocha flavor - warm, cozy dark theme with pastel colors.
@@ -209,6 +330,13 @@ var CatppuccinMochaPreset = Preset{
 		TokenDiffContext:  "#6C7086", // overlay0
 		TokenDiffHunk:     "#89B4FA", // blue
 
+		// Orchestration agents
+		TokenAgentCoordinator: "#94E2D5", // teal
+		TokenAgentWorker:      "#A6E3A1", // green
+		TokenAgentObserver:    "#CBA6F7", // mauve
+		TokenAgentUser:        "#FAB387", // peach
+		TokenAgentSystem:      "#F38BA8", // red
+
 		// Misc
 		TokenSpinner: "#CBA6F7", // mauve
 	},
@@ -307,6 +435,13 @@ var CatppuccinLattePreset = Preset{
 		TokenDiffContext:  "#9CA0B0", // overlay0
 		TokenDiffHunk:     "#1E66F5", // blue
 
+		// Orchestration agents
+		TokenAgentCoordinator: "#179299", // teal
+		TokenAgentWorker:      "#40A02B", // green
+		TokenAgentObserver:    "#8839EF", // mauve
+		TokenAgentUser:        "#FE640B", // peach
+		TokenAgentSystem:      "#D20F39", // red
+
 		// Misc
 		TokenSpinner: "#8839EF", // mauve
 	},
@@ -405,6 +540,13 @@ var DraculaPreset = Preset{
 		TokenDiffContext:  "#6272A4", // comment
 		TokenDiffHunk:     "#8BE9FD", // cyan
 
+		// Orchestration agents
+		TokenAgentCoordinator: "#8BE9FD", // cyan
+		TokenAgentWorker:      "#50FA7B", // green
+		TokenAgentObserver:    "#BD93F9", // purple
+		TokenAgentUser:        "#FFB86C", // orange
+		TokenAgentSystem:      "#FF5555", // red
+
 		// Misc
 		TokenSpinner: "#BD93F9", // purple
 	},
@@ -507,6 +649,13 @@ var NordPreset = Preset{
 		TokenDiffContext:  "#4C566A", // polar night 4
 		TokenDiffHunk:     "#81A1C1", // frost 3
 
+		// Orchestration agents
+		TokenAgentCoordinator: "#8FBCBB", // frost 1
+		TokenAgentWorker:      "#A3BE8C", // aurora green
+		TokenAgentObserver:    "#B48EAD", // aurora purple
+		TokenAgentUser:        "#D08770", // aurora orange
+		TokenAgentSystem:      "#BF616A", // aurora red
+
 		// Misc
 		TokenSpinner: "#88C0D0", // frost 2
 	},
@@ -606,6 +755,13 @@ var HighContrastPreset = Preset{
 		TokenDiffContext:  "#808080", // gray (only muted - context is inactive)
 		TokenDiffHunk:     "#00FFFF", // cyan
 
+		// Orchestration agents
+		TokenAgentCoordinator: "#00FFFF", // cyan
+		TokenAgentWorker:      "#00FF00", // pure green
+		TokenAgentObserver:    "#FF00FF", // magenta
+		TokenAgentUser:        "#FFA500", // orange
+		TokenAgentSystem:      "#FF0000", // pure red
+
 		// Misc
 		TokenSpinner: "#FFFF00", // yellow for visibility
 	},
@@ -703,6 +859,13 @@ var GruvboxPreset = Preset{
 		TokenDiffContext:  "#928374", // gray
 		TokenDiffHunk:     "#83A598", // blue
 
+		// Orchestration agents
+		TokenAgentCoordinator: "#8EC07C", // aqua
+		TokenAgentWorker:      "#B8BB26", // green
+		TokenAgentObserver:    "#D3869B", // purple
+		TokenAgentUser:        "#FE8019", // orange
+		TokenAgentSystem:      "#FB4934", // red
+
 		// Misc
 		TokenSpinner: "#FABD2F", // yellow
 	},
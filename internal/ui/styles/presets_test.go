@@ -121,11 +121,13 @@ func TestPreset_CatppuccinLatte_Golden(t *testing.T) {
 	teatest.RequireEqualOutput(t, []byte(output))
 }
 
-// TestAllPresetsHaveTokenSelectionBackground verifies that all 7 theme presets
+// TestAllPresetsHaveTokenSelectionBackground verifies that all 9 theme presets
 // define TokenSelectionBackground with the correct palette-appropriate colors.
 func TestAllPresetsHaveTokenSelectionBackground(t *testing.T) {
 	expectedColors := map[string]string{
 		"default":          "#1A5276", // current hard-coded value
+		"dark":             "#1A5276", // same as default
+		"light":            "#DDF4FF", // accent subtle
 		"catppuccin-mocha": "#45475A", // surface1
 		"catppuccin-latte": "#BCC0CC", // surface1 (light)
 		"dracula":          "#44475A", // current line
@@ -151,3 +153,13 @@ func TestAllPresetsHaveTokenSelectionBackground(t *testing.T) {
 		})
 	}
 }
+
+// TestAllPresetsDefineAllTokens verifies every built-in preset is complete,
+// so switching themes never leaves a color from the previous theme behind.
+func TestAllPresetsDefineAllTokens(t *testing.T) {
+	for name, preset := range Presets {
+		for _, token := range AllTokens() {
+			require.NotEmpty(t, preset.Colors[token], "preset %q should define %s", name, token)
+		}
+	}
+}
@@ -51,6 +51,9 @@ var (
 	// Selection indicator style (used for ">" prefix in lists: picker, column, search, etc.)
 	SelectionIndicatorStyle = lipgloss.NewStyle().Bold(true).Foreground(SelectionIndicatorColor)
 
+	// Issue flash style (highlights issue rows that just changed live, e.g. closed by a worker)
+	IssueFlashStyle = lipgloss.NewStyle().Bold(true).Foreground(StatusWarningColor)
+
 	// Button colors
 	baseButtonStyle = lipgloss.NewStyle().Padding(0, 2).Bold(true)
 
@@ -166,6 +169,13 @@ var (
 	DiffContextColor  = lipgloss.AdaptiveColor{Light: "#666666", Dark: "#888888"} // gray
 	DiffHunkColor     = lipgloss.AdaptiveColor{Light: "#1E66F5", Dark: "#89B4FA"} // blue
 
+	// Orchestration agent colors - consistent colors for each agent type across all panes
+	AgentCoordinatorColor = lipgloss.AdaptiveColor{Light: "#179299", Dark: "#179299"} // teal
+	AgentWorkerColor      = lipgloss.AdaptiveColor{Light: "#43BF6D", Dark: "#43BF6D"} // green
+	AgentObserverColor    = lipgloss.AdaptiveColor{Light: "#A066D3", Dark: "#A066D3"} // purple
+	AgentUserColor        = lipgloss.AdaptiveColor{Light: "#FB923C", Dark: "#FB923C"} // orange
+	AgentSystemColor      = lipgloss.AdaptiveColor{Light: "#FF6B6B", Dark: "#FF8787"} // red
+
 	// Word-level diff highlight background colors (from master plan v2 Appendix A)
 	DiffWordAdditionBgColor = lipgloss.AdaptiveColor{Light: "#2d4a2d", Dark: "#2d4a2d"} // green background
 	DiffWordDeletionBgColor = lipgloss.AdaptiveColor{Light: "#4a2d2d", Dark: "#4a2d2d"} // red background
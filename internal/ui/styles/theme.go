@@ -0,0 +1,117 @@
+// Package styles contains Lip Gloss style definitions.
+package styles
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// builtinPresets holds the names of the presets shipped with perles.
+// User themes may not shadow them.
+var builtinPresets = slices.Collect(maps.Keys(Presets))
+
+// Active theme state, updated by ApplyTheme.
+var (
+	activeConfig ThemeConfig
+	activeColors = maps.Clone(DefaultPreset.Colors)
+)
+
+// UserTheme is a named color scheme defined in the user's config.
+type UserTheme struct {
+	Name        string
+	Description string
+	Base        string            // Preset the theme starts from (default: "default")
+	Colors      map[string]string // Token overrides in dot notation
+}
+
+// RegisterTheme validates a user theme and adds it to Presets, making it
+// selectable like a built-in preset. Registering the same name again replaces
+// the previous definition.
+func RegisterTheme(theme UserTheme) error {
+	if theme.Name == "" {
+		return errors.New("theme name is required")
+	}
+	if slices.Contains(builtinPresets, theme.Name) {
+		return fmt.Errorf("theme %s: name is reserved by a built-in preset", theme.Name)
+	}
+
+	base := cmp.Or(theme.Base, "default")
+	basePreset, ok := Presets[base]
+	if !ok || base == theme.Name {
+		return fmt.Errorf("theme %s: unknown base preset: %s", theme.Name, base)
+	}
+
+	overrides, err := parseColors(theme.Colors)
+	if err != nil {
+		return fmt.Errorf("theme %s: %w", theme.Name, err)
+	}
+
+	colors := maps.Clone(basePreset.Colors)
+	maps.Copy(colors, overrides)
+	Presets[theme.Name] = Preset{
+		Name:        theme.Name,
+		Description: cmp.Or(theme.Description, "User theme based on "+base),
+		Colors:      colors,
+	}
+	return nil
+}
+
+// IsBuiltinPreset reports whether name is one of the presets shipped with perles.
+func IsBuiltinPreset(name string) bool {
+	return slices.Contains(builtinPresets, name)
+}
+
+// PresetNames returns the names of all registered presets, built-in presets
+// first, each group sorted alphabetically with "default" leading.
+func PresetNames() []string {
+	names := slices.Collect(maps.Keys(Presets))
+	slices.SortFunc(names, func(a, b string) int {
+		return cmp.Or(
+			compareBool(IsBuiltinPreset(b), IsBuiltinPreset(a)),
+			compareBool(b == "default", a == "default"),
+			cmp.Compare(a, b),
+		)
+	})
+	return names
+}
+
+// ActiveTheme returns the name of the preset currently applied.
+func ActiveTheme() string {
+	return cmp.Or(activeConfig.Preset, "default")
+}
+
+// ActiveThemeConfig returns the configuration last passed to ApplyTheme.
+func ActiveThemeConfig() ThemeConfig {
+	cfg := activeConfig
+	cfg.Colors = maps.Clone(cfg.Colors)
+	return cfg
+}
+
+// SetPreset switches to another preset, keeping the individual color
+// overrides of the active theme.
+func SetPreset(name string) error {
+	cfg := ActiveThemeConfig()
+	cfg.Preset = name
+	return ApplyTheme(cfg)
+}
+
+// Hex returns the active hex value of a color token, or "" for unknown tokens.
+// Use it where a plain color string is needed, e.g. as a form field default.
+func Hex(token ColorToken) string {
+	return activeColors[token]
+}
+
+// compareBool orders false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
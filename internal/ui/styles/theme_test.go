@@ -0,0 +1,136 @@
+package styles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// resetTheme restores the default theme and removes the given user themes.
+func resetTheme(t *testing.T, names ...string) {
+	t.Helper()
+	t.Cleanup(func() {
+		for _, name := range names {
+			delete(Presets, name)
+		}
+		require.NoError(t, ApplyTheme(ThemeConfig{}))
+	})
+}
+
+func TestRegisterTheme(t *testing.T) {
+	resetTheme(t, "ocean")
+
+	err := RegisterTheme(UserTheme{
+		Name: "ocean",
+		Base: "light",
+		Colors: map[string]string{
+			"text.primary": "#003366",
+		},
+	})
+	require.NoError(t, err)
+
+	preset, ok := Presets["ocean"]
+	require.True(t, ok)
+	require.Equal(t, "User theme based on light", preset.Description)
+	require.Equal(t, "#003366", preset.Colors[TokenTextPrimary])
+	require.Equal(t, LightPreset.Colors[TokenStatusError], preset.Colors[TokenStatusError])
+
+	require.NoError(t, ApplyTheme(ThemeConfig{Preset: "ocean"}))
+	require.Equal(t, "#003366", TextPrimaryColor.Dark)
+	require.Equal(t, "ocean", ActiveTheme())
+}
+
+func TestRegisterTheme_DefaultBase(t *testing.T) {
+	resetTheme(t, "mine")
+
+	require.NoError(t, RegisterTheme(UserTheme{Name: "mine", Description: "Mine"}))
+	require.Equal(t, DefaultPreset.Colors, Presets["mine"].Colors)
+	require.Equal(t, "Mine", Presets["mine"].Description)
+}
+
+func TestRegisterTheme_Errors(t *testing.T) {
+	resetTheme(t, "bad")
+
+	tests := []struct {
+		name  string
+		theme UserTheme
+		err   string
+	}{
+		{"missing name", UserTheme{}, "theme name is required"},
+		{"builtin name", UserTheme{Name: "dracula"}, "reserved by a built-in preset"},
+		{"unknown base", UserTheme{Name: "bad", Base: "nope"}, "unknown base preset: nope"},
+		{"self base", UserTheme{Name: "bad", Base: "bad"}, "unknown base preset: bad"},
+		{"unknown token", UserTheme{Name: "bad", Colors: map[string]string{"nope": "#FFFFFF"}}, "unknown color token: nope"},
+		{"invalid hex", UserTheme{Name: "bad", Colors: map[string]string{"text.primary": "red"}}, "invalid hex color"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RegisterTheme(tt.theme)
+			require.ErrorContains(t, err, tt.err)
+			_, exists := Presets["bad"]
+			require.False(t, exists)
+		})
+	}
+}
+
+func TestPresetNames(t *testing.T) {
+	resetTheme(t, "aaa-user")
+	require.NoError(t, RegisterTheme(UserTheme{Name: "aaa-user"}))
+
+	names := PresetNames()
+	require.Len(t, names, len(Presets))
+	require.Equal(t, "default", names[0])
+	require.Equal(t, "aaa-user", names[len(names)-1])
+	require.Contains(t, names, "light")
+	require.Contains(t, names, "dark")
+	require.Contains(t, names, "high-contrast")
+}
+
+func TestSetPreset_KeepsOverrides(t *testing.T) {
+	resetTheme(t)
+
+	require.NoError(t, ApplyTheme(ThemeConfig{
+		Preset: "dracula",
+		Colors: map[string]string{"status.error": "#123456"},
+	}))
+	require.NoError(t, SetPreset("nord"))
+
+	require.Equal(t, "nord", ActiveTheme())
+	require.Equal(t, NordPreset.Colors[TokenTextPrimary], TextPrimaryColor.Dark)
+	require.Equal(t, "#123456", StatusErrorColor.Dark)
+	require.Equal(t, "#123456", Hex(TokenStatusError))
+}
+
+func TestSetPreset_UnknownKeepsActiveTheme(t *testing.T) {
+	resetTheme(t)
+
+	require.NoError(t, ApplyTheme(ThemeConfig{Preset: "nord"}))
+	require.ErrorContains(t, SetPreset("nope"), "unknown theme preset")
+	require.Equal(t, "nord", ActiveTheme())
+	require.Equal(t, NordPreset.Colors[TokenTextPrimary], Hex(TokenTextPrimary))
+}
+
+func TestHex(t *testing.T) {
+	resetTheme(t)
+
+	require.NoError(t, ApplyTheme(ThemeConfig{}))
+	require.Equal(t, "default", ActiveTheme())
+	require.Equal(t, DefaultPreset.Colors[TokenStatusSuccess], Hex(TokenStatusSuccess))
+
+	require.NoError(t, ApplyTheme(ThemeConfig{Preset: "light"}))
+	require.Equal(t, LightPreset.Colors[TokenStatusSuccess], Hex(TokenStatusSuccess))
+	require.Equal(t, LightPreset.Colors[TokenAgentWorker], AgentWorkerColor.Dark)
+	require.Empty(t, Hex(ColorToken("nope")))
+}
+
+func TestRebuildersRunOnThemeChange(t *testing.T) {
+	resetTheme(t)
+	saved := styleRebuilders
+	t.Cleanup(func() { styleRebuilders = saved })
+
+	var got string
+	RegisterStyleRebuilder(func() { got = Hex(TokenTextPrimary) })
+
+	require.NoError(t, SetPreset("gruvbox"))
+	require.Equal(t, GruvboxPreset.Colors[TokenTextPrimary], got)
+}
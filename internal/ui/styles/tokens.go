@@ -93,6 +93,13 @@ const (
 	TokenDiffContext  ColorToken = "diff.context"
 	TokenDiffHunk     ColorToken = "diff.hunk"
 
+	// Orchestration agents
+	TokenAgentCoordinator ColorToken = "agent.coordinator"
+	TokenAgentWorker      ColorToken = "agent.worker"
+	TokenAgentObserver    ColorToken = "agent.observer"
+	TokenAgentUser        ColorToken = "agent.user"
+	TokenAgentSystem      ColorToken = "agent.system"
+
 	// Misc
 	TokenSpinner ColorToken = "spinner"
 )
@@ -186,6 +193,13 @@ func AllTokens() []ColorToken {
 		TokenDiffContext,
 		TokenDiffHunk,
 
+		// Orchestration agents
+		TokenAgentCoordinator,
+		TokenAgentWorker,
+		TokenAgentObserver,
+		TokenAgentUser,
+		TokenAgentSystem,
+
 		// Misc
 		TokenSpinner,
 	}